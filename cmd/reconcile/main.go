@@ -0,0 +1,87 @@
+package main
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/service"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/database"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/dotenv"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// reconcile runs one balance reconciliation, the same the server runs on
+// RECONCILIATION_INTERVAL, and prints the cards whose saldo does not match
+// their history or their ledger. It is safe to run against a live database
+// and exits non-zero when discrepancies are found.
+func main() {
+	if err := dotenv.Viper(); err != nil {
+		log.Fatalf("Error loading environment variables: %v", err)
+	}
+
+	lg := &logger.Logger{Log: zap.NewNop()}
+
+	conn, err := database.NewClient(lg)
+	if err != nil {
+		log.Fatalf("Error opening database: %v", err)
+	}
+	defer conn.Close()
+
+	ctx := context.Background()
+	DB := db.New(conn)
+	mapperRecord := recordmapper.NewRecordMapper()
+
+	services := service.NewService(service.Deps{
+		Repositories: repository.NewRepositories(repository.Deps{
+			DB:           DB,
+			Ctx:          ctx,
+			MapperRecord: mapperRecord,
+		}),
+		UnitOfWork: repository.NewUnitOfWork(conn, DB, ctx, mapperRecord),
+		Logger:     lg,
+		Mapper:     *responseservice.NewResponseServiceMapper(),
+	})
+
+	run, errResp := services.Reconciliation.Reconcile()
+	if errResp != nil {
+		log.Fatalf("Error reconciling balances: %s", errResp.Message)
+	}
+
+	log.Printf("run %d: %d cards checked, %d discrepancies", run.ID, run.CardsChecked, run.DiscrepancyCount)
+
+	if run.DiscrepancyCount == 0 {
+		log.Println("OK: every saldo matches its history and its ledger")
+		return
+	}
+
+	for _, status := range []string{requests.BalanceDiscrepancyOpen, requests.BalanceDiscrepancyAcknowledged} {
+		for page := 1; ; page++ {
+			discrepancies, totalRecords, errResp := services.Reconciliation.FindDiscrepancies(&requests.FindBalanceDiscrepancies{
+				Status:   status,
+				Page:     page,
+				PageSize: 100,
+			})
+			if errResp != nil {
+				log.Fatalf("Error fetching balance discrepancies: %s", errResp.Message)
+			}
+
+			for _, d := range discrepancies {
+				log.Printf("%s card %s: saldo=%d expected=%d ledger=%d difference=%d",
+					d.Status, d.CardNumber, d.SaldoBalance, d.ExpectedBalance, d.LedgerBalance, d.Difference)
+			}
+
+			if page*100 >= *totalRecords {
+				break
+			}
+		}
+	}
+
+	os.Exit(1)
+}
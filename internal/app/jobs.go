@@ -0,0 +1,259 @@
+package app
+
+import (
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// runAuthorizationExpiry periodically lapses authorizations that were
+// neither captured nor voided before their expiry, releasing the funds they
+// reserved. It runs until the server context is cancelled.
+func (s *Server) runAuthorizationExpiry() {
+	ticker := time.NewTicker(s.AuthorizationExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.Ctx.Done():
+			return
+		case <-ticker.C:
+			count, errResp := s.Services.Authorization.ExpireStale()
+			if errResp != nil {
+				s.Logger.Error("Failed to expire stale authorizations", zap.String("error", errResp.Message))
+				continue
+			}
+
+			if count > 0 {
+				s.Logger.Debug("Expired stale authorizations", zap.Int("count", count))
+			}
+		}
+	}
+}
+
+// runScheduledTransfers periodically executes the scheduled transfers that
+// are due. It runs until the server context is cancelled.
+func (s *Server) runScheduledTransfers() {
+	ticker := time.NewTicker(s.ScheduledTransferInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.Ctx.Done():
+			return
+		case <-ticker.C:
+			count, errResp := s.Services.ScheduledTransfer.ExecuteDue()
+			if errResp != nil {
+				s.Logger.Error("Failed to execute scheduled transfers", zap.String("error", errResp.Message))
+				continue
+			}
+
+			if count > 0 {
+				s.Logger.Debug("Executed scheduled transfers", zap.Int("count", count))
+			}
+		}
+	}
+}
+
+// runTransferBatches periodically executes the pending rows of accepted
+// transfer batches. It runs until the server context is cancelled.
+func (s *Server) runTransferBatches() {
+	ticker := time.NewTicker(s.TransferBatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.Ctx.Done():
+			return
+		case <-ticker.C:
+			count, errResp := s.Services.TransferBatch.ProcessPending()
+			if errResp != nil {
+				s.Logger.Error("Failed to process transfer batches", zap.String("error", errResp.Message))
+				continue
+			}
+
+			if count > 0 {
+				s.Logger.Debug("Processed transfer batch rows", zap.Int("count", count))
+			}
+		}
+	}
+}
+
+// runWithdrawSettlements periodically pays out withdraws approved after
+// review. It runs until the server context is cancelled.
+func (s *Server) runWithdrawSettlements() {
+	ticker := time.NewTicker(s.WithdrawSettlementInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.Ctx.Done():
+			return
+		case <-ticker.C:
+			count, errResp := s.Services.Withdraw.SettleApproved()
+			if errResp != nil {
+				s.Logger.Error("Failed to settle approved withdraws", zap.String("error", errResp.Message))
+				continue
+			}
+
+			if count > 0 {
+				s.Logger.Debug("Settled approved withdraws", zap.Int("count", count))
+			}
+		}
+	}
+}
+
+// runTopupStatusChecks periodically asks payment providers about topups that
+// are still waiting for their payment. It runs until the server context is
+// cancelled.
+func (s *Server) runTopupStatusChecks() {
+	ticker := time.NewTicker(s.TopupStatusCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.Ctx.Done():
+			return
+		case <-ticker.C:
+			count, errResp := s.Services.Topup.SyncPending()
+			if errResp != nil {
+				s.Logger.Error("Failed to check pending topups", zap.String("error", errResp.Message))
+				continue
+			}
+
+			if count > 0 {
+				s.Logger.Debug("Finished pending topups", zap.Int("count", count))
+			}
+		}
+	}
+}
+
+// runMerchantWebhookDeliveries periodically sends the merchant webhook
+// deliveries that are due, including retries. It runs until the server
+// context is cancelled.
+func (s *Server) runMerchantWebhookDeliveries() {
+	ticker := time.NewTicker(s.MerchantWebhookInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.Ctx.Done():
+			return
+		case <-ticker.C:
+			count, errResp := s.Services.MerchantWebhook.DeliverDue()
+			if errResp != nil {
+				s.Logger.Error("Failed to send merchant webhooks", zap.String("error", errResp.Message))
+				continue
+			}
+
+			if count > 0 {
+				s.Logger.Debug("Sent merchant webhooks", zap.Int("count", count))
+			}
+		}
+	}
+}
+
+// runSettlements periodically pays out the merchant balances accrued before
+// midnight UTC to their settlement cards. It runs until the server context
+// is cancelled.
+func (s *Server) runSettlements() {
+	ticker := time.NewTicker(s.SettlementInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.Ctx.Done():
+			return
+		case <-ticker.C:
+			count, errResp := s.Services.Settlement.SettleDue()
+			if errResp != nil {
+				s.Logger.Error("Failed to settle merchant balances", zap.String("error", errResp.Message))
+				continue
+			}
+
+			if count > 0 {
+				s.Logger.Debug("Settled merchant balances", zap.Int("count", count))
+			}
+		}
+	}
+}
+
+// runReconciliations periodically recomputes the balance of every card from
+// its history and records the cards that do not match. It runs until the
+// server context is cancelled.
+func (s *Server) runReconciliations() {
+	ticker := time.NewTicker(s.ReconciliationInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.Ctx.Done():
+			return
+		case <-ticker.C:
+			run, errResp := s.Services.Reconciliation.Reconcile()
+			if errResp != nil {
+				s.Logger.Error("Failed to reconcile card balances", zap.String("error", errResp.Message))
+				continue
+			}
+
+			if run.DiscrepancyCount > 0 {
+				s.Logger.Error("Card balances do not reconcile",
+					zap.Int("run_id", run.ID),
+					zap.Int("cards_checked", run.CardsChecked),
+					zap.Int("discrepancy_count", run.DiscrepancyCount),
+				)
+			}
+		}
+	}
+}
+
+// runRequestNoncePruning periodically deletes the nonces of signed merchant
+// requests that can no longer be replayed. It runs until the server context
+// is cancelled.
+func (s *Server) runRequestNoncePruning() {
+	ticker := time.NewTicker(s.RequestNoncePruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.Ctx.Done():
+			return
+		case <-ticker.C:
+			count, errResp := s.Services.MerchantApiKey.PruneNonces()
+			if errResp != nil {
+				s.Logger.Error("Failed to prune request nonces", zap.String("error", errResp.Message))
+				continue
+			}
+
+			if count > 0 {
+				s.Logger.Debug("Pruned request nonces", zap.Int("count", count))
+			}
+		}
+	}
+}
+
+// runInvoiceExpiry periodically expires open invoices and payment links
+// whose expiry has passed. Payments already refuse them once they expire;
+// this only brings their status up to date. It runs until the server context
+// is cancelled.
+func (s *Server) runInvoiceExpiry() {
+	ticker := time.NewTicker(s.InvoiceExpiryInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.Ctx.Done():
+			return
+		case <-ticker.C:
+			count, errResp := s.Services.Invoice.ExpireStale()
+			if errResp != nil {
+				s.Logger.Error("Failed to expire invoices", zap.String("error", errResp.Message))
+				continue
+			}
+
+			if count > 0 {
+				s.Logger.Debug("Expired invoices", zap.Int("count", count))
+			}
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql/handler"
 	"github.com/99designs/gqlgen/graphql/handler/extension"
@@ -18,6 +19,7 @@ import (
 	"github.com/MamangRust/paymentgatewaygraphql/internal/permission"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/service"
+	apikey "github.com/MamangRust/paymentgatewaygraphql/pkg/api-key"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/auth"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/database"
 	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
@@ -25,12 +27,48 @@ import (
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/dotenv"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/hash"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	methodtopup "github.com/MamangRust/paymentgatewaygraphql/pkg/method_topup"
+	topupprovider "github.com/MamangRust/paymentgatewaygraphql/pkg/topup_provider"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/webhook"
 	"github.com/spf13/viper"
 	"github.com/vektah/gqlparser/v2/ast"
 	"go.uber.org/zap"
 )
 
-const defaultPort = "8080"
+const (
+	defaultPort              = "8080"
+	defaultIdempotencyKeyTTL = 24 * time.Hour
+
+	defaultAuthorizationTTL            = 7 * 24 * time.Hour
+	defaultAuthorizationExpiryInterval = time.Minute
+	defaultScheduledTransferInterval   = time.Minute
+	defaultTransferBatchInterval       = time.Minute
+
+	defaultWithdrawReviewThreshold    = 10000000
+	defaultWithdrawSettlementInterval = time.Minute
+	defaultWithdrawPayoutProvider     = "simulator"
+
+	defaultTopupStatusCheckInterval = time.Minute
+	defaultTopupSimulatorDelay      = 30 * time.Second
+
+	defaultProviderWebhookTolerance = 5 * time.Minute
+
+	defaultMerchantWebhookInterval = 10 * time.Second
+	defaultMerchantWebhookTimeout  = 10 * time.Second
+
+	defaultSettlementInterval = time.Hour
+
+	defaultReconciliationInterval = 24 * time.Hour
+
+	defaultRequestSignatureTolerance = 5 * time.Minute
+	defaultRequestNoncePruneInterval = 10 * time.Minute
+	defaultRequestMaxBodyBytes       = 1 << 20
+
+	defaultInvoiceExpiryInterval = time.Minute
+
+	defaultQrAcquirerID   = "ID.CO.PAYMENTGATEWAY.WWW"
+	defaultQrMerchantCity = "JAKARTA"
+)
 
 type Server struct {
 	Logger       logger.LoggerInterface
@@ -40,6 +78,19 @@ type Server struct {
 	Resolver     *graph.Resolver
 	Ctx          context.Context
 	Port         string
+
+	AuthorizationExpiryInterval time.Duration
+	ScheduledTransferInterval   time.Duration
+	TransferBatchInterval       time.Duration
+	WithdrawSettlementInterval  time.Duration
+	TopupStatusCheckInterval    time.Duration
+	MerchantWebhookInterval     time.Duration
+	SettlementInterval          time.Duration
+	ReconciliationInterval      time.Duration
+	RequestNoncePruneInterval   time.Duration
+	InvoiceExpiryInterval       time.Duration
+
+	RequestMaxBodyBytes int64
 }
 
 func NewServer() (*Server, error) {
@@ -76,15 +127,173 @@ func NewServer() (*Server, error) {
 		MapperRecord: mapperRecord,
 	})
 
+	unitOfWork := repository.NewUnitOfWork(conn, DB, ctx, mapperRecord)
+
+	idempotencyKeyTTL := viper.GetDuration("IDEMPOTENCY_KEY_TTL")
+	if idempotencyKeyTTL <= 0 {
+		idempotencyKeyTTL = defaultIdempotencyKeyTTL
+	}
+
+	authorizationTTL := viper.GetDuration("AUTHORIZATION_TTL")
+	if authorizationTTL <= 0 {
+		authorizationTTL = defaultAuthorizationTTL
+	}
+
+	authorizationExpiryInterval := viper.GetDuration("AUTHORIZATION_EXPIRY_INTERVAL")
+	if authorizationExpiryInterval <= 0 {
+		authorizationExpiryInterval = defaultAuthorizationExpiryInterval
+	}
+
+	scheduledTransferInterval := viper.GetDuration("SCHEDULED_TRANSFER_INTERVAL")
+	if scheduledTransferInterval <= 0 {
+		scheduledTransferInterval = defaultScheduledTransferInterval
+	}
+
+	transferBatchInterval := viper.GetDuration("TRANSFER_BATCH_INTERVAL")
+	if transferBatchInterval <= 0 {
+		transferBatchInterval = defaultTransferBatchInterval
+	}
+
+	withdrawReviewThreshold := viper.GetInt("WITHDRAW_REVIEW_THRESHOLD")
+	if withdrawReviewThreshold <= 0 {
+		withdrawReviewThreshold = defaultWithdrawReviewThreshold
+	}
+
+	withdrawSettlementInterval := viper.GetDuration("WITHDRAW_SETTLEMENT_INTERVAL")
+	if withdrawSettlementInterval <= 0 {
+		withdrawSettlementInterval = defaultWithdrawSettlementInterval
+	}
+
+	topupStatusCheckInterval := viper.GetDuration("TOPUP_STATUS_CHECK_INTERVAL")
+	if topupStatusCheckInterval <= 0 {
+		topupStatusCheckInterval = defaultTopupStatusCheckInterval
+	}
+
+	topupSimulatorDelay := viper.GetDuration("TOPUP_SIMULATOR_CONFIRM_AFTER")
+	if topupSimulatorDelay <= 0 {
+		topupSimulatorDelay = defaultTopupSimulatorDelay
+	}
+
+	providerWebhookTolerance := viper.GetDuration("PROVIDER_WEBHOOK_TOLERANCE")
+	if providerWebhookTolerance <= 0 {
+		providerWebhookTolerance = defaultProviderWebhookTolerance
+	}
+
+	providerWebhookSecrets := webhook.ParseSecrets(viper.GetString("PROVIDER_WEBHOOK_SECRETS"))
+
+	// Only the payout provider may report the outcome of a withdraw, so it
+	// needs a webhook secret or no payout could ever be confirmed.
+	withdrawPayoutProvider := viper.GetString("WITHDRAW_PAYOUT_PROVIDER")
+	if withdrawPayoutProvider == "" {
+		withdrawPayoutProvider = defaultWithdrawPayoutProvider
+	}
+	if _, ok := providerWebhookSecrets[withdrawPayoutProvider]; !ok {
+		lg.Fatal("Withdraw payout provider has no webhook secret", zap.String("provider", withdrawPayoutProvider))
+	}
+
+	merchantWebhookInterval := viper.GetDuration("MERCHANT_WEBHOOK_DELIVERY_INTERVAL")
+	if merchantWebhookInterval <= 0 {
+		merchantWebhookInterval = defaultMerchantWebhookInterval
+	}
+
+	merchantWebhookTimeout := viper.GetDuration("MERCHANT_WEBHOOK_TIMEOUT")
+	if merchantWebhookTimeout <= 0 {
+		merchantWebhookTimeout = defaultMerchantWebhookTimeout
+	}
+
+	// Settlement pays out what was accrued before midnight UTC, so running
+	// it more often than daily only matters for picking up failed runs.
+	settlementInterval := viper.GetDuration("SETTLEMENT_INTERVAL")
+	if settlementInterval <= 0 {
+		settlementInterval = defaultSettlementInterval
+	}
+
+	// Reconciliation reads every card and its whole history, so it runs
+	// rarely by default; cmd/reconcile runs it on demand.
+	reconciliationInterval := viper.GetDuration("RECONCILIATION_INTERVAL")
+	if reconciliationInterval <= 0 {
+		reconciliationInterval = defaultReconciliationInterval
+	}
+
+	invoiceExpiryInterval := viper.GetDuration("INVOICE_EXPIRY_INTERVAL")
+	if invoiceExpiryInterval <= 0 {
+		invoiceExpiryInterval = defaultInvoiceExpiryInterval
+	}
+
+	// Signed merchant requests may be this far off the server clock, which
+	// is also how long their nonces have to be kept.
+	requestSignatureTolerance := viper.GetDuration("MERCHANT_REQUEST_SIGNATURE_TOLERANCE")
+	if requestSignatureTolerance <= 0 {
+		requestSignatureTolerance = defaultRequestSignatureTolerance
+	}
+
+	requestMaxBodyBytes := viper.GetInt64("MERCHANT_REQUEST_MAX_BODY_BYTES")
+	if requestMaxBodyBytes <= 0 {
+		requestMaxBodyBytes = defaultRequestMaxBodyBytes
+	}
+
+	// Signing secrets are stored encrypted with this key. Losing or changing
+	// it leaves every merchant unable to sign requests until they rotate
+	// their keys.
+	signingSecretSealer, err := apikey.NewSecretSealer(viper.GetString("MERCHANT_SIGNING_SECRET_KEY"))
+	if err != nil {
+		lg.Fatal("Failed to create signing secret sealer", zap.Error(err))
+	}
+
+	requestNoncePruneInterval := viper.GetDuration("MERCHANT_REQUEST_NONCE_PRUNE_INTERVAL")
+	if requestNoncePruneInterval <= 0 {
+		requestNoncePruneInterval = defaultRequestNoncePruneInterval
+	}
+
+	// Scanned QR payloads are only paid when they name this acquirer, so
+	// changing it invalidates every QR code printed before.
+	qrAcquirerID := viper.GetString("QR_ACQUIRER_ID")
+	if qrAcquirerID == "" {
+		qrAcquirerID = defaultQrAcquirerID
+	}
+
+	qrMerchantCity := viper.GetString("QR_MERCHANT_CITY")
+	if qrMerchantCity == "" {
+		qrMerchantCity = defaultQrMerchantCity
+	}
+
+	// Every payment method is collected by the simulator until a real
+	// provider adapter is registered for it.
+	topupProviders := topupprovider.NewRegistry()
+	topupProviders.Register(topupprovider.NewSimulator(topupprovider.SimulatorConfig{
+		Outcome:      viper.GetString("TOPUP_SIMULATOR_OUTCOME"),
+		ConfirmAfter: topupSimulatorDelay,
+	}), methodtopup.PaymentMethods()...)
+
 	services := service.NewService(service.Deps{
-		Repositories: repos,
-		Hash:         hashing,
-		Token:        tokenManager,
-		Logger:       lg,
-		Mapper:       *mapperResponse,
+		Repositories:      repos,
+		UnitOfWork:        unitOfWork,
+		Hash:              hashing,
+		Token:             tokenManager,
+		Logger:            lg,
+		Mapper:            *mapperResponse,
+		IdempotencyKeyTTL: idempotencyKeyTTL,
+		AuthorizationTTL:  authorizationTTL,
+
+		WithdrawReviewThreshold: withdrawReviewThreshold,
+		WithdrawPayoutProvider:  withdrawPayoutProvider,
+		TopupProviders:          topupProviders,
+
+		ProviderWebhookSecrets:   providerWebhookSecrets,
+		ProviderWebhookTolerance: providerWebhookTolerance,
+
+		MerchantWebhookSender: webhook.NewSender(merchantWebhookTimeout),
+
+		RequestSignatureTolerance: requestSignatureTolerance,
+		SigningSecretSealer:       signingSecretSealer,
+
+		MerchantQr: service.MerchantQrConfig{
+			AcquirerID:   qrAcquirerID,
+			MerchantCity: qrMerchantCity,
+		},
 	})
 
-	permission := permission.NewPermission(services.Role, services.Merchant)
+	permission := permission.NewPermission(services.Role, services.MerchantApiKey)
 
 	resolver := graph.NewResolver(
 		services.Auth,
@@ -97,6 +306,24 @@ func NewServer() (*Server, error) {
 		services.Transaction,
 		services.Transfer,
 		services.Withdraw,
+		services.Ledger,
+		services.IdempotencyKey,
+		services.Refund,
+		services.Dispute,
+		services.Authorization,
+		services.ExchangeRate,
+		services.FeeSchedule,
+		services.TransactionLimit,
+		services.ScheduledTransfer,
+		services.TransferBatch,
+		services.StatusHistory,
+		services.ProviderWebhook,
+		services.MerchantWebhook,
+		services.Settlement,
+		services.Reconciliation,
+		services.MerchantOnboarding,
+		services.MerchantApiKey,
+		services.Invoice,
 		mapperGraphql,
 		permission,
 	)
@@ -116,6 +343,16 @@ func NewServer() (*Server, error) {
 		}
 	}
 
+	// Secrets stored before they were encrypted, and those written by the
+	// seeder, are sealed before the server takes signed requests.
+	sealed, errResp := services.MerchantApiKey.SealSigningSecrets()
+	if errResp != nil {
+		lg.Fatal("Failed to seal merchant signing secrets", zap.String("error", errResp.Message))
+	}
+	if sealed > 0 {
+		lg.Debug("Sealed merchant signing secrets", zap.Int("count", sealed))
+	}
+
 	port := viper.GetString("PORT")
 	if port == "" {
 		port = defaultPort
@@ -129,12 +366,36 @@ func NewServer() (*Server, error) {
 		Ctx:          ctx,
 		Port:         port,
 		Resolver:     resolver,
+
+		AuthorizationExpiryInterval: authorizationExpiryInterval,
+		ScheduledTransferInterval:   scheduledTransferInterval,
+		TransferBatchInterval:       transferBatchInterval,
+		WithdrawSettlementInterval:  withdrawSettlementInterval,
+		TopupStatusCheckInterval:    topupStatusCheckInterval,
+		MerchantWebhookInterval:     merchantWebhookInterval,
+		SettlementInterval:          settlementInterval,
+		ReconciliationInterval:      reconciliationInterval,
+		RequestNoncePruneInterval:   requestNoncePruneInterval,
+		InvoiceExpiryInterval:       invoiceExpiryInterval,
+
+		RequestMaxBodyBytes: requestMaxBodyBytes,
 	}, nil
 }
 
 func (s *Server) Run() error {
 	s.Logger.Debug("Starting GraphQL server", zap.Any("port", s.Port))
 
+	go s.runAuthorizationExpiry()
+	go s.runScheduledTransfers()
+	go s.runTransferBatches()
+	go s.runWithdrawSettlements()
+	go s.runTopupStatusChecks()
+	go s.runMerchantWebhookDeliveries()
+	go s.runSettlements()
+	go s.runReconciliations()
+	go s.runRequestNoncePruning()
+	go s.runInvoiceExpiry()
+
 	srv := handler.New(graph.NewExecutableSchema(graph.Config{
 		Resolvers: s.Resolver,
 	}))
@@ -151,7 +412,14 @@ func (s *Server) Run() error {
 	})
 
 	http.Handle("/", playground.Handler("GraphQL Playground", "/query"))
-	http.Handle("/query", middlewares.AuthMiddleware(s.TokenManager, s.Logger)(srv))
+	// The signature is checked first so the body of a signed request is
+	// capped before anything reads it.
+	http.Handle("/query", middlewares.SignatureMiddleware(s.Services.MerchantApiKey, s.RequestMaxBodyBytes, s.Logger)(
+		middlewares.AuthMiddleware(s.TokenManager, s.Logger)(
+			middlewares.IdempotencyMiddleware(srv),
+		),
+	))
+	http.HandleFunc("POST /webhooks/{provider}", s.handleProviderWebhook)
 
 	s.Logger.Debug("GraphQL Playground running at", zap.String("url", "http://localhost:"+s.Port))
 	return http.ListenAndServe(":"+s.Port, nil)
@@ -0,0 +1,52 @@
+package app
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/webhook"
+	"go.uber.org/zap"
+)
+
+// maxProviderWebhookBody caps the size of a provider callback body.
+const maxProviderWebhookBody = 1 << 20
+
+// handleProviderWebhook receives the callbacks payment providers send to
+// /webhooks/{provider}. Any answer other than 200 makes the provider deliver
+// the callback again.
+func (s *Server) handleProviderWebhook(w http.ResponseWriter, r *http.Request) {
+	payload, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxProviderWebhookBody))
+	if err != nil {
+		s.writeWebhookResponse(w, http.StatusBadRequest, response.NewErrorResponse("Failed to read webhook body", http.StatusBadRequest))
+		return
+	}
+
+	_, errResp := s.Services.ProviderWebhook.Receive(&requests.ReceiveProviderWebhook{
+		Provider:  r.PathValue("provider"),
+		EventID:   r.Header.Get(webhook.HeaderID),
+		Timestamp: r.Header.Get(webhook.HeaderTimestamp),
+		Signature: r.Header.Get(webhook.HeaderSignature),
+		Payload:   payload,
+	})
+	if errResp != nil {
+		s.writeWebhookResponse(w, errResp.Code, errResp)
+		return
+	}
+
+	s.writeWebhookResponse(w, http.StatusOK, response.ApiResponse[any]{
+		Status:  "success",
+		Message: "Webhook processed",
+	})
+}
+
+func (s *Server) writeWebhookResponse(w http.ResponseWriter, code int, body any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		s.Logger.Error("Failed to write webhook response", zap.Error(err))
+	}
+}
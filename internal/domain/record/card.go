@@ -8,6 +8,7 @@ type CardRecord struct {
 	ExpireDate   string  `json:"expire_date"`
 	CVV          string  `json:"cvv"`
 	CardProvider string  `json:"card_provider"`
+	Currency     string  `json:"currency"`
 	CreatedAt    string  `json:"created_at"`
 	UpdatedAt    string  `json:"updated_at"`
 	DeletedAt    *string `json:"deleted_at"`
@@ -15,22 +16,33 @@ type CardRecord struct {
 
 type CardMonthBalance struct {
 	Month        string `json:"month"`
+	Currency     string `json:"currency"`
 	TotalBalance int64  `json:"total_balance"`
 }
 
 type CardYearlyBalance struct {
 	Year         string `json:"year"`
+	Currency     string `json:"currency"`
 	TotalBalance int64  `json:"total_balance"`
 }
 
 type CardMonthAmount struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int64  `json:"total_amount"`
 }
 
 type CardYearAmount struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int64  `json:"total_amount"`
 }
 
-
+type CardCurrencyTotals struct {
+	Currency         string `json:"currency"`
+	TotalBalance     int64  `json:"total_balance"`
+	TotalTopup       int64  `json:"total_topup"`
+	TotalWithdraw    int64  `json:"total_withdraw"`
+	TotalTransaction int64  `json:"total_transaction"`
+	TotalTransfer    int64  `json:"total_transfer"`
+}
@@ -0,0 +1,22 @@
+package record
+
+type DisputeRecord struct {
+	ID               int     `json:"id"`
+	DisputeNo        string  `json:"dispute_no"`
+	TransactionID    int     `json:"transaction_id"`
+	CardNumber       string  `json:"card_number"`
+	MerchantID       int     `json:"merchant_id"`
+	Amount           int     `json:"amount"`
+	Currency         string  `json:"currency"`
+	HeldFrom         string  `json:"held_from"`
+	Reason           string  `json:"reason"`
+	MerchantEvidence *string `json:"merchant_evidence"`
+	ResolutionNote   *string `json:"resolution_note"`
+	Status           string  `json:"status"`
+	OpenedBy         int     `json:"opened_by"`
+	ResolvedBy       *int    `json:"resolved_by"`
+	RespondedAt      *string `json:"responded_at"`
+	ResolvedAt       *string `json:"resolved_at"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+}
@@ -0,0 +1,13 @@
+package record
+
+type IdempotencyKeyRecord struct {
+	ID           int     `json:"id"`
+	UserID       int     `json:"user_id"`
+	Scope        string  `json:"scope"`
+	Key          string  `json:"key"`
+	Fingerprint  string  `json:"fingerprint"`
+	ResponseBody *string `json:"response_body"`
+	ExpiresAt    string  `json:"expires_at"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+}
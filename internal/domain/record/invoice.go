@@ -0,0 +1,35 @@
+package record
+
+type InvoiceRecord struct {
+	ID          int     `json:"id"`
+	Code        string  `json:"code"`
+	MerchantID  int     `json:"merchant_id"`
+	Kind        string  `json:"kind"`
+	Description string  `json:"description"`
+	Amount      int     `json:"amount"`
+	Currency    string  `json:"currency"`
+	Status      string  `json:"status"`
+	ExpiresAt   string  `json:"expires_at"`
+	PaidAt      *string `json:"paid_at"`
+	CancelledAt *string `json:"cancelled_at"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
+type InvoiceLineItemRecord struct {
+	ID          int    `json:"id"`
+	InvoiceID   int    `json:"invoice_id"`
+	Description string `json:"description"`
+	Quantity    int    `json:"quantity"`
+	UnitPrice   int    `json:"unit_price"`
+	Amount      int    `json:"amount"`
+	CreatedAt   string `json:"created_at"`
+}
+
+type InvoicePaymentRecord struct {
+	ID            int    `json:"id"`
+	InvoiceID     int    `json:"invoice_id"`
+	TransactionID int    `json:"transaction_id"`
+	Amount        int    `json:"amount"`
+	CreatedAt     string `json:"created_at"`
+}
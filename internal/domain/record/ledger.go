@@ -0,0 +1,40 @@
+package record
+
+type LedgerJournalRecord struct {
+	ID            int                    `json:"id"`
+	JournalNo     string                 `json:"journal_no"`
+	ReferenceType string                 `json:"reference_type"`
+	ReferenceID   *int                   `json:"reference_id"`
+	Description   string                 `json:"description"`
+	PostedAt      string                 `json:"posted_at"`
+	CreatedAt     string                 `json:"created_at"`
+	Postings      []*LedgerPostingRecord `json:"postings"`
+}
+
+type LedgerPostingRecord struct {
+	ID          int     `json:"id"`
+	JournalID   int     `json:"journal_id"`
+	AccountCode string  `json:"account_code"`
+	CardNumber  *string `json:"card_number"`
+	Direction   string  `json:"direction"`
+	Amount      int     `json:"amount"`
+	Currency    string  `json:"currency"`
+	CreatedAt   string  `json:"created_at"`
+}
+
+type LedgerCardPostingRecord struct {
+	ID            int    `json:"id"`
+	JournalID     int    `json:"journal_id"`
+	ReferenceType string `json:"reference_type"`
+	ReferenceID   *int   `json:"reference_id"`
+	CardNumber    string `json:"card_number"`
+	Direction     string `json:"direction"`
+	Amount        int    `json:"amount"`
+	Currency      string `json:"currency"`
+	PostedAt      string `json:"posted_at"`
+}
+
+type LedgerBalanceRecord struct {
+	CardNumber string `json:"card_number"`
+	Balance    int    `json:"balance"`
+}
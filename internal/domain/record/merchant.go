@@ -7,7 +7,6 @@ import (
 type MerchantRecord struct {
 	ID        int     `json:"id"`
 	Name      string  `json:"name"`
-	ApiKey    string  `json:"api_key"`
 	UserID    int     `json:"user_id"`
 	Status    string  `json:"status"`
 	CreatedAt string  `json:"created_at"`
@@ -42,21 +41,39 @@ type MerchantMonthlyPaymentMethod struct {
 
 type MerchantMonthlyAmount struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
 type MerchantYearlyAmount struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
+type MerchantMonthlyDisputeRate struct {
+	Month             string  `json:"month"`
+	TotalTransactions int     `json:"total_transactions"`
+	TotalDisputes     int     `json:"total_disputes"`
+	DisputeRate       float64 `json:"dispute_rate"`
+}
+
+type MerchantYearlyDisputeRate struct {
+	Year              string  `json:"year"`
+	TotalTransactions int     `json:"total_transactions"`
+	TotalDisputes     int     `json:"total_disputes"`
+	DisputeRate       float64 `json:"dispute_rate"`
+}
+
 type MerchantMonthlyTotalAmount struct {
 	Year        string `json:"year"`
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
 type MerchantYearlyTotalAmount struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
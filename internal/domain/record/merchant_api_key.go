@@ -0,0 +1,16 @@
+package record
+
+type MerchantApiKeyRecord struct {
+	ID            int      `json:"id"`
+	MerchantID    int      `json:"merchant_id"`
+	Label         string   `json:"label"`
+	KeyPrefix     string   `json:"key_prefix"`
+	KeyHash       string   `json:"key_hash"`
+	SigningSecret string   `json:"signing_secret"`
+	Scopes        []string `json:"scopes"`
+	ExpiresAt     *string  `json:"expires_at"`
+	LastUsedAt    *string  `json:"last_used_at"`
+	RevokedAt     *string  `json:"revoked_at"`
+	CreatedAt     string   `json:"created_at"`
+	UpdatedAt     string   `json:"updated_at"`
+}
@@ -0,0 +1,15 @@
+package record
+
+type MerchantBusinessProfileRecord struct {
+	MerchantID   int     `json:"merchant_id"`
+	LegalName    string  `json:"legal_name"`
+	BusinessType string  `json:"business_type"`
+	TaxID        string  `json:"tax_id"`
+	Address      string  `json:"address"`
+	Website      *string `json:"website"`
+	ContactEmail string  `json:"contact_email"`
+	ContactPhone string  `json:"contact_phone"`
+	SubmittedAt  string  `json:"submitted_at"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+}
@@ -0,0 +1,39 @@
+package record
+
+type MerchantWebhookEndpointRecord struct {
+	ID         int      `json:"id"`
+	MerchantID int      `json:"merchant_id"`
+	URL        string   `json:"url"`
+	Secret     string   `json:"secret"`
+	Events     []string `json:"events"`
+	IsActive   bool     `json:"is_active"`
+	CreatedAt  string   `json:"created_at"`
+	UpdatedAt  string   `json:"updated_at"`
+	DeletedAt  *string  `json:"deleted_at"`
+}
+
+type MerchantWebhookDeliveryRecord struct {
+	ID               int     `json:"id"`
+	EndpointID       int     `json:"endpoint_id"`
+	EventID          string  `json:"event_id"`
+	EventType        string  `json:"event_type"`
+	Payload          string  `json:"payload"`
+	Status           string  `json:"status"`
+	Attempts         int     `json:"attempts"`
+	NextAttemptAt    *string `json:"next_attempt_at"`
+	LastResponseCode *int    `json:"last_response_code"`
+	LastError        *string `json:"last_error"`
+	DeliveredAt      *string `json:"delivered_at"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+}
+
+type MerchantWebhookDeliveryAttemptRecord struct {
+	ID           int     `json:"id"`
+	DeliveryID   int     `json:"delivery_id"`
+	Attempt      int     `json:"attempt"`
+	ResponseCode *int    `json:"response_code"`
+	Error        *string `json:"error"`
+	DurationMs   int     `json:"duration_ms"`
+	CreatedAt    string  `json:"created_at"`
+}
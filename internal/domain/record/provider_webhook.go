@@ -0,0 +1,17 @@
+package record
+
+type ProviderWebhookEventRecord struct {
+	ID          int     `json:"id"`
+	Provider    string  `json:"provider"`
+	EventID     string  `json:"event_id"`
+	EventType   string  `json:"event_type"`
+	Payload     string  `json:"payload"`
+	Signature   string  `json:"signature"`
+	SentAt      string  `json:"sent_at"`
+	Status      string  `json:"status"`
+	Attempts    int     `json:"attempts"`
+	LastError   *string `json:"last_error"`
+	ProcessedAt *string `json:"processed_at"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
@@ -0,0 +1,9 @@
+package record
+
+type QrPaymentRecord struct {
+	ID            int     `json:"id"`
+	MerchantID    int     `json:"merchant_id"`
+	TransactionID int     `json:"transaction_id"`
+	Reference     *string `json:"reference"`
+	CreatedAt     string  `json:"created_at"`
+}
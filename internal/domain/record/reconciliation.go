@@ -0,0 +1,48 @@
+package record
+
+type ReconciliationRunRecord struct {
+	ID               int     `json:"id"`
+	CardsChecked     int     `json:"cards_checked"`
+	DiscrepancyCount int     `json:"discrepancy_count"`
+	StartedAt        string  `json:"started_at"`
+	FinishedAt       *string `json:"finished_at"`
+}
+
+// CardReconciliationRecord is the saldo of a card next to its ledger
+// balance and the movements its expected balance is recomputed from.
+type CardReconciliationRecord struct {
+	CardNumber        string `json:"card_number"`
+	SaldoBalance      int    `json:"saldo_balance"`
+	LedgerBalance     int    `json:"ledger_balance"`
+	TopupAmount       int    `json:"topup_amount"`
+	WithdrawAmount    int    `json:"withdraw_amount"`
+	TransferOutAmount int    `json:"transfer_out_amount"`
+	TransferInAmount  int    `json:"transfer_in_amount"`
+	PaymentAmount     int    `json:"payment_amount"`
+	SettlementAmount  int    `json:"settlement_amount"`
+	OtherAmount       int    `json:"other_amount"`
+}
+
+type BalanceDiscrepancyRecord struct {
+	ID                  int     `json:"id"`
+	CardNumber          string  `json:"card_number"`
+	ReconciliationRunID int     `json:"reconciliation_run_id"`
+	SaldoBalance        int     `json:"saldo_balance"`
+	ExpectedBalance     int     `json:"expected_balance"`
+	LedgerBalance       int     `json:"ledger_balance"`
+	Difference          int     `json:"difference"`
+	TopupAmount         int     `json:"topup_amount"`
+	WithdrawAmount      int     `json:"withdraw_amount"`
+	TransferOutAmount   int     `json:"transfer_out_amount"`
+	TransferInAmount    int     `json:"transfer_in_amount"`
+	PaymentAmount       int     `json:"payment_amount"`
+	SettlementAmount    int     `json:"settlement_amount"`
+	OtherAmount         int     `json:"other_amount"`
+	Status              string  `json:"status"`
+	AcknowledgedBy      *int    `json:"acknowledged_by"`
+	AcknowledgedAt      *string `json:"acknowledged_at"`
+	Note                *string `json:"note"`
+	ResolvedAt          *string `json:"resolved_at"`
+	CreatedAt           string  `json:"created_at"`
+	UpdatedAt           string  `json:"updated_at"`
+}
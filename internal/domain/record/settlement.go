@@ -0,0 +1,47 @@
+package record
+
+type MerchantBalanceRecord struct {
+	MerchantID           int     `json:"merchant_id"`
+	Currency             string  `json:"currency"`
+	PendingBalance       int     `json:"pending_balance"`
+	SettlementCardNumber *string `json:"settlement_card_number"`
+	CreatedAt            string  `json:"created_at"`
+	UpdatedAt            string  `json:"updated_at"`
+}
+
+type SettlementTotalsRecord struct {
+	GrossAmount      int `json:"gross_amount"`
+	FeeAmount        int `json:"fee_amount"`
+	RefundAmount     int `json:"refund_amount"`
+	AdjustmentAmount int `json:"adjustment_amount"`
+	NetAmount        int `json:"net_amount"`
+	ItemCount        int `json:"item_count"`
+}
+
+type SettlementBatchRecord struct {
+	ID                   int    `json:"id"`
+	MerchantID           int    `json:"merchant_id"`
+	Currency             string `json:"currency"`
+	SettlementCardNumber string `json:"settlement_card_number"`
+	CutoffAt             string `json:"cutoff_at"`
+	GrossAmount          int    `json:"gross_amount"`
+	FeeAmount            int    `json:"fee_amount"`
+	RefundAmount         int    `json:"refund_amount"`
+	AdjustmentAmount     int    `json:"adjustment_amount"`
+	NetAmount            int    `json:"net_amount"`
+	ItemCount            int    `json:"item_count"`
+	CreatedAt            string `json:"created_at"`
+}
+
+type SettlementItemRecord struct {
+	ID                int    `json:"id"`
+	MerchantID        int    `json:"merchant_id"`
+	SettlementBatchID *int   `json:"settlement_batch_id"`
+	ItemType          string `json:"item_type"`
+	ReferenceID       int    `json:"reference_id"`
+	Amount            int    `json:"amount"`
+	Fee               int    `json:"fee"`
+	NetAmount         int    `json:"net_amount"`
+	Currency          string `json:"currency"`
+	CreatedAt         string `json:"created_at"`
+}
@@ -5,8 +5,11 @@ type TopupRecord struct {
 	CardNumber  string  `json:"card_number"`
 	TopupNo     string  `json:"topup_no"`
 	TopupAmount int     `json:"topup_amount"`
+	Currency    string  `json:"currency"`
+	Fee         int     `json:"fee"`
 	TopupMethod string  `json:"topup_method"`
 	TopupTime   string  `json:"topup_time"`
+	Status      string  `json:"status"`
 	CreatedAt   string  `json:"created_at"`
 	UpdatedAt   string  `json:"updated_at"`
 	DeletedAt   *string `json:"deleted_at"`
@@ -54,10 +57,22 @@ type TopupYearlyMethod struct {
 
 type TopupMonthAmount struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
 type TopupYearlyAmount struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
+
+type TopupProviderPaymentRecord struct {
+	ID                int     `json:"id"`
+	TopupID           int     `json:"topup_id"`
+	Provider          string  `json:"provider"`
+	ProviderReference string  `json:"provider_reference"`
+	LastMessage       *string `json:"last_message"`
+	CreatedAt         string  `json:"created_at"`
+	UpdatedAt         string  `json:"updated_at"`
+}
@@ -5,8 +5,12 @@ type TransactionRecord struct {
 	CardNumber      string  `json:"card_number"`
 	TransactionNo   string  `json:"transaction_no"`
 	Amount          int     `json:"amount"`
+	Currency        string  `json:"currency"`
+	Fee             int     `json:"fee"`
 	PaymentMethod   string  `json:"payment_method"`
 	MerchantID      int     `json:"merchant_id"`
+	Status          string  `json:"status"`
+	RefundedAmount  int     `json:"refunded_amount"`
 	TransactionTime string  `json:"transaction_time"`
 	CreatedAt       string  `json:"created_at"`
 	UpdatedAt       string  `json:"updated_at"`
@@ -55,10 +59,12 @@ type TransactionYearMethod struct {
 
 type TransactionMonthAmount struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
 type TransactionYearlyAmount struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
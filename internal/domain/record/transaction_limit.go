@@ -0,0 +1,22 @@
+package record
+
+type TransactionLimitRecord struct {
+	ID                      int     `json:"id"`
+	Name                    string  `json:"name"`
+	OperationType           string  `json:"operation_type"`
+	Period                  string  `json:"period"`
+	CardType                *string `json:"card_type"`
+	UserTier                *string `json:"user_tier"`
+	Currency                *string `json:"currency"`
+	MaxAmountPerTransaction *int    `json:"max_amount_per_transaction"`
+	MaxCount                *int    `json:"max_count"`
+	MaxTotalAmount          *int    `json:"max_total_amount"`
+	IsActive                bool    `json:"is_active"`
+	CreatedAt               string  `json:"created_at"`
+	UpdatedAt               string  `json:"updated_at"`
+}
+
+type TransactionLimitUsageRecord struct {
+	Count       int `json:"count"`
+	TotalAmount int `json:"total_amount"`
+}
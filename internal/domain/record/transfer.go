@@ -1,15 +1,21 @@
 package record
 
 type TransferRecord struct {
-	ID             int     `json:"id"`
-	TransferNo     string  `json:"transfer_no"`
-	TransferFrom   string  `json:"transfer_from"`
-	TransferTo     string  `json:"transfer_to"`
-	TransferAmount int     `json:"transfer_amount"`
-	TransferTime   string  `json:"transfer_time"`
-	CreatedAt      string  `json:"created_at"`
-	UpdatedAt      string  `json:"updated_at"`
-	DeletedAt      *string `json:"deleted_at"`
+	ID              int      `json:"id"`
+	TransferNo      string   `json:"transfer_no"`
+	TransferFrom    string   `json:"transfer_from"`
+	TransferTo      string   `json:"transfer_to"`
+	TransferAmount  int      `json:"transfer_amount"`
+	Currency        string   `json:"currency"`
+	Fee             int      `json:"fee"`
+	ToCurrency      string   `json:"to_currency"`
+	ConvertedAmount int      `json:"converted_amount"`
+	ExchangeRate    *float64 `json:"exchange_rate"`
+	SpreadBps       *int     `json:"spread_bps"`
+	TransferTime    string   `json:"transfer_time"`
+	CreatedAt       string   `json:"created_at"`
+	UpdatedAt       string   `json:"updated_at"`
+	DeletedAt       *string  `json:"deleted_at"`
 }
 
 type TransferRecordMonthStatusSuccess struct {
@@ -40,10 +46,12 @@ type TransferRecordYearStatusFailed struct {
 
 type TransferMonthAmount struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
 type TransferYearAmount struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
@@ -0,0 +1,33 @@
+package record
+
+type TransferBatchRecord struct {
+	ID            int     `json:"id"`
+	UserID        int     `json:"user_id"`
+	TransferFrom  string  `json:"transfer_from"`
+	FileFormat    string  `json:"file_format"`
+	Status        string  `json:"status"`
+	TotalRows     int     `json:"total_rows"`
+	TotalAmount   int     `json:"total_amount"`
+	InvalidRows   int     `json:"invalid_rows"`
+	SuccessRows   int     `json:"success_rows"`
+	FailedRows    int     `json:"failed_rows"`
+	SuccessAmount int     `json:"success_amount"`
+	StartedAt     *string `json:"started_at"`
+	CompletedAt   *string `json:"completed_at"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
+type TransferBatchRowRecord struct {
+	ID              int     `json:"id"`
+	TransferBatchID int     `json:"transfer_batch_id"`
+	RowNumber       int     `json:"row_number"`
+	TransferTo      string  `json:"transfer_to"`
+	TransferAmount  int     `json:"transfer_amount"`
+	Note            *string `json:"note"`
+	Status          string  `json:"status"`
+	TransferID      *int    `json:"transfer_id"`
+	FailureReason   *string `json:"failure_reason"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
@@ -7,6 +7,7 @@ type UserRecord struct {
 	Email           string  `json:"email"`
 	Password        string  `json:"password"`
 	ConfirmPassword string  `json:"confirm_password"`
+	Tier            string  `json:"tier"`
 	CreatedAt       string  `json:"created_at"`
 	UpdatedAt       string  `json:"updated_at"`
 	DeletedAt       *string `json:"deleted_at"`
@@ -5,7 +5,11 @@ type WithdrawRecord struct {
 	WithdrawNo     string  `json:"withdraw_no"`
 	CardNumber     string  `json:"card_number"`
 	WithdrawAmount int     `json:"withdraw_amount"`
+	Currency       string  `json:"currency"`
+	Fee            int     `json:"fee"`
+	PayoutProvider string  `json:"payout_provider"`
 	WithdrawTime   string  `json:"withdraw_time"`
+	Status         string  `json:"status"`
 	CreatedAt      string  `json:"created_at"`
 	UpdatedAt      string  `json:"updated_at"`
 	DeletedAt      *string `json:"deleted_at"`
@@ -39,10 +43,21 @@ type WithdrawRecordYearStatusFailed struct {
 
 type WithdrawMonthlyAmount struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
 type WithdrawYearlyAmount struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
+
+type WithdrawReviewRecord struct {
+	ID         int     `json:"id"`
+	WithdrawID int     `json:"withdraw_id"`
+	Decision   string  `json:"decision"`
+	Note       *string `json:"note"`
+	ReviewedBy int     `json:"reviewed_by"`
+	CreatedAt  string  `json:"created_at"`
+}
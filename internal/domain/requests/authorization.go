@@ -0,0 +1,55 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+const (
+	AuthorizationStatusAuthorized = "authorized"
+	AuthorizationStatusCaptured   = "captured"
+	AuthorizationStatusVoided     = "voided"
+	AuthorizationStatusExpired    = "expired"
+)
+
+type CreateAuthorizationRequest struct {
+	CardNumber    string `json:"card_number" validate:"required,min=1"`
+	Amount        int    `json:"amount" validate:"required,min=1"`
+	PaymentMethod string `json:"payment_method" validate:"required"`
+	MerchantID    *int   `json:"merchant_id"`
+}
+
+type CaptureAuthorizationRequest struct {
+	AuthorizationID int `json:"authorization_id" validate:"required,min=1"`
+
+	// Amount is the amount to capture. It is nil to capture the full
+	// authorized amount; any uncaptured remainder is released.
+	Amount *int `json:"amount" validate:"omitempty,min=1"`
+}
+
+type FindAllAuthorizations struct {
+	Search   string `json:"search"`
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+func (r *CreateAuthorizationRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *CaptureAuthorizationRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/currency"
 	methodtopup "github.com/MamangRust/paymentgatewaygraphql/pkg/method_topup"
 
 	"github.com/go-playground/validator/v10"
@@ -26,6 +27,7 @@ type CreateCardRequest struct {
 	ExpireDate   time.Time `json:"expire_date" validate:"required"`
 	CVV          string    `json:"cvv" validate:"required"`
 	CardProvider string    `json:"card_provider" validate:"required"`
+	Currency     string    `json:"currency" validate:"required,len=3"`
 }
 
 func (r *CreateCardRequest) Validate() error {
@@ -41,6 +43,10 @@ func (r *CreateCardRequest) Validate() error {
 		return fmt.Errorf("card provider not found")
 	}
 
+	if !currency.IsSupported(r.Currency) {
+		return fmt.Errorf("currency %s is not supported", r.Currency)
+	}
+
 	if err != nil {
 		return err
 	}
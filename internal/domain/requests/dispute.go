@@ -0,0 +1,100 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+// Dispute statuses. A dispute starts open, moves to under review once the
+// merchant responds, and is closed by an admin ruling for either side.
+const (
+	DisputeStatusOpen               = "open"
+	DisputeStatusUnderReview        = "under_review"
+	DisputeStatusResolvedCardholder = "resolved_cardholder"
+	DisputeStatusResolvedMerchant   = "resolved_merchant"
+)
+
+const (
+	DisputeOutcomeCardholder = "cardholder"
+	DisputeOutcomeMerchant   = "merchant"
+)
+
+// Where the disputed amount is held from. A payment the merchant balance
+// still covers is held from the balance, so it is not paid out while the
+// dispute is open; otherwise it comes out of the merchant card.
+const (
+	DisputeHeldFromMerchantBalance = "merchant_balance"
+	DisputeHeldFromMerchantCard    = "merchant_card"
+)
+
+type CreateDisputeRequest struct {
+	TransactionID int    `json:"transaction_id" validate:"required,min=1"`
+	Reason        string `json:"reason" validate:"required,min=1,max=1000"`
+	OpenedBy      int    `json:"opened_by" validate:"required,min=1"`
+
+	// RequestedBy restricts the dispute to transactions paid with a card
+	// owned by this user. It is nil for administrators.
+	RequestedBy *int `json:"-"`
+}
+
+type RespondDisputeRequest struct {
+	DisputeID int    `json:"dispute_id" validate:"required,min=1"`
+	Evidence  string `json:"evidence" validate:"required,min=1,max=5000"`
+
+	// RequestedBy restricts the response to the owner of the disputed
+	// merchant. It is nil for administrators.
+	RequestedBy *int `json:"-"`
+}
+
+type ResolveDisputeRequest struct {
+	DisputeID  int    `json:"dispute_id" validate:"required,min=1"`
+	Outcome    string `json:"outcome" validate:"required,oneof=cardholder merchant"`
+	Note       string `json:"note" validate:"required,min=1,max=1000"`
+	ResolvedBy int    `json:"resolved_by" validate:"required,min=1"`
+}
+
+type FindAllDisputes struct {
+	Search   string `json:"search"`
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+type FindAllDisputesByMerchant struct {
+	MerchantID int    `json:"merchant_id" validate:"required,min=1"`
+	Search     string `json:"search"`
+	Page       int    `json:"page" validate:"min=1"`
+	PageSize   int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+func (r *CreateDisputeRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RespondDisputeRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ResolveDisputeRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
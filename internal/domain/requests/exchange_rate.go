@@ -0,0 +1,39 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/currency"
+	"github.com/go-playground/validator/v10"
+)
+
+type UpsertExchangeRateRequest struct {
+	BaseCurrency  string  `json:"base_currency" validate:"required,len=3"`
+	QuoteCurrency string  `json:"quote_currency" validate:"required,len=3"`
+	Rate          float64 `json:"rate" validate:"required,gt=0"`
+	SpreadBps     int     `json:"spread_bps" validate:"min=0,max=9999"`
+}
+
+type FindAllExchangeRates struct {
+	Search   string `json:"search"`
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+func (r *UpsertExchangeRateRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	if !currency.IsSupported(r.BaseCurrency) || !currency.IsSupported(r.QuoteCurrency) {
+		return currency.ErrUnsupportedCurrency
+	}
+
+	if r.BaseCurrency == r.QuoteCurrency {
+		return errors.New("base and quote currency must differ")
+	}
+
+	return nil
+}
@@ -0,0 +1,122 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/currency"
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	FeeTypeFlat       = "flat"
+	FeeTypePercentage = "percentage"
+	FeeTypeTiered     = "tiered"
+)
+
+// Transaction types a fee schedule can apply to.
+const (
+	FeeTransactionTransfer    = "transfer"
+	FeeTransactionWithdraw    = "withdraw"
+	FeeTransactionTopup       = "topup"
+	FeeTransactionTransaction = "transaction"
+)
+
+type FeeScheduleTierRequest struct {
+	MinAmount     int `json:"min_amount" validate:"min=0"`
+	FlatAmount    int `json:"flat_amount" validate:"min=0"`
+	PercentageBps int `json:"percentage_bps" validate:"min=0,max=9999"`
+}
+
+type CreateFeeScheduleRequest struct {
+	Name            string                   `json:"name" validate:"required,max=100"`
+	TransactionType string                   `json:"transaction_type" validate:"required,oneof=transfer withdraw topup transaction"`
+	FeeType         string                   `json:"fee_type" validate:"required,oneof=flat percentage tiered"`
+	PaymentMethod   *string                  `json:"payment_method" validate:"omitempty,min=1,max=50"`
+	CardType        *string                  `json:"card_type" validate:"omitempty,min=1,max=50"`
+	Currency        *string                  `json:"currency" validate:"omitempty,len=3"`
+	FlatAmount      int                      `json:"flat_amount" validate:"min=0"`
+	PercentageBps   int                      `json:"percentage_bps" validate:"min=0,max=9999"`
+	MinFee          *int                     `json:"min_fee" validate:"omitempty,min=0"`
+	MaxFee          *int                     `json:"max_fee" validate:"omitempty,min=0"`
+	IsActive        bool                     `json:"is_active"`
+	Tiers           []FeeScheduleTierRequest `json:"tiers" validate:"dive"`
+}
+
+type UpdateFeeScheduleRequest struct {
+	FeeScheduleID   *int                     `json:"fee_schedule_id"`
+	Name            string                   `json:"name" validate:"required,max=100"`
+	TransactionType string                   `json:"transaction_type" validate:"required,oneof=transfer withdraw topup transaction"`
+	FeeType         string                   `json:"fee_type" validate:"required,oneof=flat percentage tiered"`
+	PaymentMethod   *string                  `json:"payment_method" validate:"omitempty,min=1,max=50"`
+	CardType        *string                  `json:"card_type" validate:"omitempty,min=1,max=50"`
+	Currency        *string                  `json:"currency" validate:"omitempty,len=3"`
+	FlatAmount      int                      `json:"flat_amount" validate:"min=0"`
+	PercentageBps   int                      `json:"percentage_bps" validate:"min=0,max=9999"`
+	MinFee          *int                     `json:"min_fee" validate:"omitempty,min=0"`
+	MaxFee          *int                     `json:"max_fee" validate:"omitempty,min=0"`
+	IsActive        bool                     `json:"is_active"`
+	Tiers           []FeeScheduleTierRequest `json:"tiers" validate:"dive"`
+}
+
+type FindAllFeeSchedules struct {
+	Search   string `json:"search"`
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+// FindApplicableFeeSchedule describes the transaction a fee is being quoted
+// for. PaymentMethod is empty for flows that have none.
+type FindApplicableFeeSchedule struct {
+	TransactionType string
+	PaymentMethod   string
+	CardType        string
+	Currency        string
+}
+
+func (r *CreateFeeScheduleRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return validateFeeSchedule(r.FeeType, r.Currency, r.MinFee, r.MaxFee, r.Tiers)
+}
+
+func (r *UpdateFeeScheduleRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	if r.FeeScheduleID == nil || *r.FeeScheduleID <= 0 {
+		return errors.New("fee schedule id is required")
+	}
+
+	return validateFeeSchedule(r.FeeType, r.Currency, r.MinFee, r.MaxFee, r.Tiers)
+}
+
+func validateFeeSchedule(feeType string, code *string, minFee, maxFee *int, tiers []FeeScheduleTierRequest) error {
+	if code != nil && !currency.IsSupported(*code) {
+		return currency.ErrUnsupportedCurrency
+	}
+
+	if minFee != nil && maxFee != nil && *minFee > *maxFee {
+		return errors.New("min fee must not exceed max fee")
+	}
+
+	if (feeType == FeeTypeTiered) != (len(tiers) > 0) {
+		return errors.New("tiers are required for tiered fees and not allowed otherwise")
+	}
+
+	seen := make(map[int]bool, len(tiers))
+	for _, tier := range tiers {
+		if seen[tier.MinAmount] {
+			return errors.New("tiers must have distinct min amounts")
+		}
+		seen[tier.MinAmount] = true
+	}
+
+	return nil
+}
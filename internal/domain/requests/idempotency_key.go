@@ -0,0 +1,40 @@
+package requests
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Scopes of the mutations that accept an idempotency key.
+const (
+	IdempotencyScopeCreateTransfer       = "create_transfer"
+	IdempotencyScopeCreateTopup          = "create_topup"
+	IdempotencyScopeCreateWithdraw       = "create_withdraw"
+	IdempotencyScopeCreateTransaction    = "create_transaction"
+	IdempotencyScopeAuthorizeTransaction = "authorize_transaction"
+	IdempotencyScopeCaptureTransaction   = "capture_transaction"
+	IdempotencyScopeCreateTransferBatch  = "create_transfer_batch"
+	IdempotencyScopePayInvoice           = "pay_invoice"
+	IdempotencyScopePayQr                = "pay_qr"
+)
+
+type CreateIdempotencyKey struct {
+	UserID      int           `json:"user_id" validate:"required,min=1"`
+	Scope       string        `json:"scope" validate:"required"`
+	Key         string        `json:"key" validate:"required,min=1,max=255"`
+	Fingerprint string        `json:"fingerprint" validate:"required,len=64"`
+	TTL         time.Duration `json:"-"`
+}
+
+func (r *CreateIdempotencyKey) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
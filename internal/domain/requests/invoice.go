@@ -0,0 +1,118 @@
+package requests
+
+import (
+	"fmt"
+	"time"
+
+	methodtopup "github.com/MamangRust/paymentgatewaygraphql/pkg/method_topup"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Kinds of invoices. An invoice is paid once; a payment link takes any
+// number of payments until it expires or is cancelled.
+const (
+	InvoiceKindInvoice     = "invoice"
+	InvoiceKindPaymentLink = "payment_link"
+)
+
+const (
+	InvoiceStatusOpen      = "open"
+	InvoiceStatusPaid      = "paid"
+	InvoiceStatusExpired   = "expired"
+	InvoiceStatusCancelled = "cancelled"
+)
+
+// CreateInvoiceRequest creates an invoice or payment link. When line items
+// are given they must add up to the amount. RequestedBy is nil for admins,
+// who may create invoices for any merchant.
+type CreateInvoiceRequest struct {
+	MerchantID  int                            `json:"merchant_id" validate:"required,min=1"`
+	Kind        string                         `json:"kind" validate:"required,oneof=invoice payment_link"`
+	Description string                         `json:"description" validate:"required,max=1000"`
+	Amount      int                            `json:"amount" validate:"required,min=1"`
+	ExpiresAt   time.Time                      `json:"expires_at" validate:"required"`
+	LineItems   []CreateInvoiceLineItemRequest `json:"line_items" validate:"omitempty,dive"`
+	RequestedBy *int                           `json:"-"`
+}
+
+type CreateInvoiceLineItemRequest struct {
+	Description string `json:"description" validate:"required,max=255"`
+	Quantity    int    `json:"quantity" validate:"required,min=1"`
+	UnitPrice   int    `json:"unit_price" validate:"required,min=1"`
+}
+
+type FindInvoicesByMerchant struct {
+	MerchantID  int    `json:"merchant_id" validate:"required,min=1"`
+	Status      string `json:"status" validate:"omitempty,oneof=open paid expired cancelled"`
+	Page        int    `json:"page" validate:"min=1"`
+	PageSize    int    `json:"page_size" validate:"min=1,max=100"`
+	RequestedBy *int   `json:"-"`
+}
+
+// PayInvoiceRequest pays an invoice or payment link by its public code with
+// a card of the paying user.
+type PayInvoiceRequest struct {
+	Code          string `json:"code" validate:"required,max=32"`
+	CardNumber    string `json:"card_number" validate:"required,min=1"`
+	PaymentMethod string `json:"payment_method" validate:"required"`
+	UserID        int    `json:"-"`
+}
+
+type CreateInvoice struct {
+	Code        string
+	MerchantID  int
+	Kind        string
+	Description string
+	Amount      int
+	Currency    string
+	ExpiresAt   time.Time
+}
+
+type CreateInvoiceLineItem struct {
+	InvoiceID   int
+	Description string
+	Quantity    int
+	UnitPrice   int
+	Amount      int
+}
+
+type CreateInvoicePayment struct {
+	InvoiceID     int
+	TransactionID int
+	Amount        int
+}
+
+func (r *CreateInvoiceRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *FindInvoicesByMerchant) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *PayInvoiceRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	if !methodtopup.PaymentMethodValidator(r.PaymentMethod) {
+		return fmt.Errorf("payment method not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	LedgerDirectionDebit  = "debit"
+	LedgerDirectionCredit = "credit"
+)
+
+// Ledger account codes. CARD accounts are identified by their card number;
+// every other code is a platform-owned system account.
+const (
+	LedgerAccountCard               = "CARD"
+	LedgerAccountTopupClearing      = "TOPUP_CLEARING"
+	LedgerAccountWithdrawClearing   = "WITHDRAW_CLEARING"
+	LedgerAccountOpeningBalance     = "OPENING_BALANCE"
+	LedgerAccountBalanceAdjustment  = "BALANCE_ADJUSTMENT"
+	LedgerAccountDisputeHold        = "DISPUTE_HOLD"
+	LedgerAccountFxClearing         = "FX_CLEARING"
+	LedgerAccountPlatformRevenue    = "PLATFORM_REVENUE"
+	LedgerAccountMerchantSettlement = "MERCHANT_SETTLEMENT"
+)
+
+const (
+	LedgerReferenceTopup           = "topup"
+	LedgerReferenceWithdraw        = "withdraw"
+	LedgerReferenceTransfer        = "transfer"
+	LedgerReferenceTransaction     = "transaction"
+	LedgerReferenceOpeningBalance  = "opening_balance"
+	LedgerReferenceSaldoAdjustment = "saldo_adjustment"
+	LedgerReferenceRefund          = "refund"
+	LedgerReferenceDispute         = "dispute"
+	LedgerReferenceSaldoHold       = "saldo_hold"
+	LedgerReferenceSettlement      = "settlement"
+)
+
+type LedgerAccount struct {
+	Code       string `json:"code" validate:"required"`
+	CardNumber string `json:"card_number"`
+}
+
+func CardLedgerAccount(cardNumber string) LedgerAccount {
+	return LedgerAccount{Code: LedgerAccountCard, CardNumber: cardNumber}
+}
+
+func SystemLedgerAccount(code string) LedgerAccount {
+	return LedgerAccount{Code: code}
+}
+
+type CreateLedgerPosting struct {
+	Account   LedgerAccount `json:"account" validate:"required"`
+	Direction string        `json:"direction" validate:"required,oneof=debit credit"`
+	Amount    int           `json:"amount" validate:"required,min=1"`
+	Currency  string        `json:"currency" validate:"required,len=3"`
+}
+
+type CreateLedgerJournalRequest struct {
+	ReferenceType string                `json:"reference_type" validate:"required"`
+	ReferenceID   *int                  `json:"reference_id"`
+	Description   string                `json:"description"`
+	Postings      []CreateLedgerPosting `json:"postings" validate:"required,min=2,dive"`
+}
+
+type FindAllLedgerJournals struct {
+	Search   string `json:"search"`
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+// FindLedgerPostingsByCardNumber lists the postings of a card. RequestedBy is
+// nil for admins, who may read the postings of any card.
+type FindLedgerPostingsByCardNumber struct {
+	CardNumber  string `json:"card_number" validate:"required,min=1"`
+	Page        int    `json:"page" validate:"min=1"`
+	PageSize    int    `json:"page_size" validate:"min=1,max=100"`
+	RequestedBy *int   `json:"-"`
+}
+
+// NewLedgerJournal builds a two-line journal moving amount from the debit
+// account to the credit account. A negative amount reverses the direction,
+// which is how adjustments to an existing movement are posted.
+func NewLedgerJournal(referenceType string, referenceID int, description string, debit, credit LedgerAccount, amount int, currency string) *CreateLedgerJournalRequest {
+	if amount < 0 {
+		debit, credit = credit, debit
+		amount = -amount
+	}
+
+	return &CreateLedgerJournalRequest{
+		ReferenceType: referenceType,
+		ReferenceID:   &referenceID,
+		Description:   description,
+		Postings: []CreateLedgerPosting{
+			{Account: debit, Direction: LedgerDirectionDebit, Amount: amount, Currency: currency},
+			{Account: credit, Direction: LedgerDirectionCredit, Amount: amount, Currency: currency},
+		},
+	}
+}
+
+// NewConversionLedgerJournal builds a four-line journal for a movement that
+// crosses currencies. The debit account pays amount in currency into
+// FX_CLEARING, which pays convertedAmount in toCurrency to the credit account,
+// so every currency balances on its own and the spread stays on FX_CLEARING.
+func NewConversionLedgerJournal(referenceType string, referenceID int, description string, debit, credit LedgerAccount, amount int, currency string, convertedAmount int, toCurrency string) *CreateLedgerJournalRequest {
+	clearing := SystemLedgerAccount(LedgerAccountFxClearing)
+
+	return &CreateLedgerJournalRequest{
+		ReferenceType: referenceType,
+		ReferenceID:   &referenceID,
+		Description:   description,
+		Postings: []CreateLedgerPosting{
+			{Account: debit, Direction: LedgerDirectionDebit, Amount: amount, Currency: currency},
+			{Account: clearing, Direction: LedgerDirectionCredit, Amount: amount, Currency: currency},
+			{Account: clearing, Direction: LedgerDirectionDebit, Amount: convertedAmount, Currency: toCurrency},
+			{Account: credit, Direction: LedgerDirectionCredit, Amount: convertedAmount, Currency: toCurrency},
+		},
+	}
+}
+
+// WithFee appends the postings moving a fee from the payer to
+// PLATFORM_REVENUE. A negative fee, as when an amended amount lowers it, is
+// moved back to the payer. A zero fee leaves the journal untouched.
+func (r *CreateLedgerJournalRequest) WithFee(payer LedgerAccount, fee int, currency string) *CreateLedgerJournalRequest {
+	if fee == 0 {
+		return r
+	}
+
+	debit, credit := payer, SystemLedgerAccount(LedgerAccountPlatformRevenue)
+	if fee < 0 {
+		debit, credit = credit, debit
+		fee = -fee
+	}
+
+	r.Postings = append(r.Postings,
+		CreateLedgerPosting{Account: debit, Direction: LedgerDirectionDebit, Amount: fee, Currency: currency},
+		CreateLedgerPosting{Account: credit, Direction: LedgerDirectionCredit, Amount: fee, Currency: currency},
+	)
+
+	return r
+}
+
+func (r *CreateLedgerJournalRequest) Validate() error {
+	validate := validator.New()
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	balances := make(map[string]int)
+	for _, posting := range r.Postings {
+		if (posting.Account.Code == LedgerAccountCard) != (posting.Account.CardNumber != "") {
+			return errors.New("card number is required for card accounts only")
+		}
+
+		if posting.Direction == LedgerDirectionDebit {
+			balances[posting.Currency] += posting.Amount
+		} else {
+			balances[posting.Currency] -= posting.Amount
+		}
+	}
+
+	for _, balance := range balances {
+		if balance != 0 {
+			return errors.New("journal debits and credits must balance per currency")
+		}
+	}
+
+	return nil
+}
+
+func (r *FindLedgerPostingsByCardNumber) Validate() error {
+	validate := validator.New()
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+	return nil
+}
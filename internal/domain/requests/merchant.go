@@ -67,12 +67,15 @@ type UpdateMerchantRequest struct {
 	MerchantID *int   `json:"merchant_id"`
 	Name       string `json:"name" validate:"required"`
 	UserID     int    `json:"user_id" validate:"required,min=1"`
-	Status     string `json:"status" validate:"required"`
 }
 
+// UpdateMerchantStatus moves a merchant through the onboarding lifecycle.
+// ChangedBy is the admin who changed it, or nil for the merchant owner.
 type UpdateMerchantStatus struct {
-	MerchantID int    `json:"merchant_id" validate:"required,min=1"`
-	Status     string `json:"status" validate:"required"`
+	MerchantID int     `json:"merchant_id" validate:"required,min=1"`
+	Status     string  `json:"status" validate:"required,oneof=pending in_review active rejected suspended terminated"`
+	Reason     *string `json:"reason" validate:"omitempty,max=1000"`
+	ChangedBy  *int    `json:"-"`
 }
 
 func (r CreateMerchantRequest) Validate() error {
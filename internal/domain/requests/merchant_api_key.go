@@ -0,0 +1,83 @@
+package requests
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// What a merchant API key may be used for.
+const (
+	MerchantApiKeyScopeCreateTransactions = "transactions:create"
+	MerchantApiKeyScopeReadAnalytics      = "analytics:read"
+)
+
+// CreateMerchantApiKeyRequest issues a key. RequestedBy is nil for admins,
+// who may manage the keys of any merchant.
+type CreateMerchantApiKeyRequest struct {
+	MerchantID  int        `json:"merchant_id" validate:"required,min=1"`
+	Label       string     `json:"label" validate:"required,max=100"`
+	Scopes      []string   `json:"scopes" validate:"required,min=1,dive,oneof=transactions:create analytics:read"`
+	ExpiresAt   *time.Time `json:"expires_at"`
+	RequestedBy *int       `json:"-"`
+}
+
+// RotateMerchantApiKeyRequest replaces a key with a new one with the same
+// label and scopes. The old key keeps working for GracePeriodHours so
+// clients can switch over; zero stops it at once.
+type RotateMerchantApiKeyRequest struct {
+	ApiKeyID         int  `json:"api_key_id" validate:"required,min=1"`
+	GracePeriodHours int  `json:"grace_period_hours" validate:"min=0,max=720"`
+	RequestedBy      *int `json:"-"`
+}
+
+// MerchantApiKeyCredential is what a merchant call authenticates with:
+// either the API key itself, or the ID of the key whose signing secret the
+// request was signed with. A key ID is only ever set by the middleware that
+// verified the signature.
+type MerchantApiKeyCredential struct {
+	ApiKey   string
+	ApiKeyID int
+}
+
+// VerifyMerchantRequestSignature carries a signed request as it was
+// received, headers unparsed.
+type VerifyMerchantRequestSignature struct {
+	ApiKeyID  string
+	Method    string
+	Path      string
+	Timestamp string
+	Nonce     string
+	Signature string
+	Body      []byte
+}
+
+type CreateMerchantApiKey struct {
+	MerchantID    int
+	Label         string
+	KeyPrefix     string
+	KeyHash       string
+	Scopes        []string
+	ExpiresAt     *time.Time
+	SigningSecret string
+}
+
+func (r *CreateMerchantApiKeyRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *RotateMerchantApiKeyRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
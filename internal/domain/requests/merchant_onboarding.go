@@ -0,0 +1,61 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+// SubmitMerchantOnboardingRequest carries the business details a merchant
+// owner submits for review.
+type SubmitMerchantOnboardingRequest struct {
+	MerchantID   int     `json:"merchant_id" validate:"required,min=1"`
+	LegalName    string  `json:"legal_name" validate:"required,max=255"`
+	BusinessType string  `json:"business_type" validate:"required,oneof=individual company"`
+	TaxID        string  `json:"tax_id" validate:"required,max=50"`
+	Address      string  `json:"address" validate:"required"`
+	Website      *string `json:"website" validate:"omitempty,url,max=255"`
+	ContactEmail string  `json:"contact_email" validate:"required,email,max=255"`
+	ContactPhone string  `json:"contact_phone" validate:"required,max=30"`
+	RequestedBy  *int    `json:"-"`
+}
+
+// ReviewMerchantRequest is an admin decision on a merchant. A reason is
+// required to reject, suspend or terminate a merchant.
+type ReviewMerchantRequest struct {
+	MerchantID int     `json:"merchant_id" validate:"required,min=1"`
+	Reason     *string `json:"reason" validate:"omitempty,max=1000"`
+	ReviewedBy int     `json:"reviewed_by" validate:"required,min=1"`
+}
+
+type FindMerchantsByStatus struct {
+	Status   string `json:"status" validate:"required,oneof=pending in_review active rejected suspended terminated"`
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+func (r *SubmitMerchantOnboardingRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *ReviewMerchantRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *FindMerchantsByStatus) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,78 @@
+package requests
+
+import (
+	"fmt"
+
+	methodtopup "github.com/MamangRust/paymentgatewaygraphql/pkg/method_topup"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// GenerateMerchantQrRequest asks for the QR code a merchant presents to its
+// customers. Without an amount the QR code is static and the payer enters
+// the amount; with one it is dynamic and can only be paid once, for that
+// amount, and must carry the reference the merchant knows the order by.
+// RequestedBy is nil for admins, who may generate QR codes for any merchant.
+type GenerateMerchantQrRequest struct {
+	MerchantID  int    `json:"merchant_id" validate:"required,min=1"`
+	Amount      int    `json:"amount" validate:"required_with=Reference,omitempty,min=1"`
+	Reference   string `json:"reference" validate:"required_with=Amount,omitempty,max=25,printascii"`
+	RequestedBy *int   `json:"-"`
+}
+
+// PayQrRequest pays the merchant named in a scanned QR payload with a card
+// of the paying user. Amount is only given for static QR codes; a dynamic
+// QR code carries its own.
+type PayQrRequest struct {
+	Payload       string `json:"payload" validate:"required,max=512"`
+	CardNumber    string `json:"card_number" validate:"required,min=1"`
+	PaymentMethod string `json:"payment_method" validate:"required"`
+	Amount        int    `json:"amount" validate:"omitempty,min=1"`
+	UserID        int    `json:"-"`
+}
+
+type FindQrPaymentRequest struct {
+	MerchantID  int    `json:"merchant_id" validate:"required,min=1"`
+	Reference   string `json:"reference" validate:"required,max=25"`
+	RequestedBy *int   `json:"-"`
+}
+
+type CreateQrPayment struct {
+	MerchantID    int
+	TransactionID int
+	Reference     string
+}
+
+func (r *GenerateMerchantQrRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *PayQrRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	if !methodtopup.PaymentMethodValidator(r.PaymentMethod) {
+		return fmt.Errorf("payment method not found")
+	}
+
+	return nil
+}
+
+func (r *FindQrPaymentRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
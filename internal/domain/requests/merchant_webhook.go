@@ -0,0 +1,121 @@
+package requests
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/google/uuid"
+)
+
+// Events a merchant webhook endpoint can subscribe to. Ping is only sent by
+// a test delivery and needs no subscription.
+const (
+	MerchantEventTransactionSuccess = "transaction.success"
+	MerchantEventTransactionFailed  = "transaction.failed"
+	MerchantEventRefundCreated      = "refund.created"
+	MerchantEventPing               = "ping"
+)
+
+const (
+	MerchantWebhookDeliveryPending   = "pending"
+	MerchantWebhookDeliveryDelivered = "delivered"
+	MerchantWebhookDeliveryFailed    = "failed"
+)
+
+// MerchantWebhookEvent is the body every delivery is posted with. Data is
+// the transaction or refund the event is about.
+type MerchantWebhookEvent struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	CreatedAt time.Time `json:"created_at"`
+	Data      any       `json:"data"`
+}
+
+// CreateMerchantWebhookEndpointRequest registers an endpoint. RequestedBy is
+// nil for admins, who may manage the endpoints of any merchant.
+type CreateMerchantWebhookEndpointRequest struct {
+	MerchantID  int      `json:"merchant_id" validate:"required,min=1"`
+	URL         string   `json:"url" validate:"required,max=2048,http_url,startswith=https://"`
+	Events      []string `json:"events" validate:"required,min=1,dive,oneof=transaction.success transaction.failed refund.created"`
+	RequestedBy *int     `json:"-"`
+}
+
+type UpdateMerchantWebhookEndpointRequest struct {
+	EndpointID  int      `json:"endpoint_id" validate:"required,min=1"`
+	URL         string   `json:"url" validate:"required,max=2048,http_url,startswith=https://"`
+	Events      []string `json:"events" validate:"required,min=1,dive,oneof=transaction.success transaction.failed refund.created"`
+	IsActive    bool     `json:"is_active"`
+	RequestedBy *int     `json:"-"`
+}
+
+type CreateMerchantWebhookEndpoint struct {
+	MerchantID int
+	URL        string
+	Secret     string
+	Events     []string
+}
+
+type QueueMerchantWebhookDeliveries struct {
+	MerchantID int
+	EventID    uuid.UUID
+	EventType  string
+	Payload    string
+}
+
+type CreateMerchantWebhookDelivery struct {
+	EndpointID    int
+	EventID       uuid.UUID
+	EventType     string
+	Payload       string
+	NextAttemptAt time.Time
+}
+
+// FinishMerchantWebhookDeliveryAttempt records one attempt. NextAttemptAt is
+// only set when the delivery is retried; ResponseCode is nil when the
+// endpoint could not be reached.
+type FinishMerchantWebhookDeliveryAttempt struct {
+	DeliveryID    int
+	Attempt       int
+	Status        string
+	NextAttemptAt *time.Time
+	ResponseCode  *int
+	Error         *string
+	Duration      time.Duration
+}
+
+type FindMerchantWebhookDeliveries struct {
+	EndpointID  int  `json:"endpoint_id" validate:"required,min=1"`
+	Page        int  `json:"page" validate:"min=1"`
+	PageSize    int  `json:"page_size" validate:"min=1,max=100"`
+	RequestedBy *int `json:"-"`
+}
+
+func (r *CreateMerchantWebhookEndpointRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *UpdateMerchantWebhookEndpointRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *FindMerchantWebhookDeliveries) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
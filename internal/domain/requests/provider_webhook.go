@@ -0,0 +1,69 @@
+package requests
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Kinds of callback a payment provider sends.
+const (
+	ProviderWebhookTopupPayment   = "topup.payment"
+	ProviderWebhookWithdrawPayout = "withdraw.payout"
+)
+
+const (
+	ProviderWebhookReceived  = "received"
+	ProviderWebhookProcessed = "processed"
+	ProviderWebhookFailed    = "failed"
+)
+
+// ReceiveProviderWebhook is a callback as it reached the webhook endpoint,
+// before its signature is checked.
+type ReceiveProviderWebhook struct {
+	Provider  string
+	EventID   string
+	Timestamp string
+	Signature string
+	Payload   []byte
+}
+
+// ProviderWebhookEnvelope is the body every provider callback is sent in.
+// Data is handed to the topup provider adapter for topup.payment callbacks
+// and holds a WithdrawPayoutCallback for withdraw.payout callbacks.
+type ProviderWebhookEnvelope struct {
+	Type string          `json:"type" validate:"required,oneof=topup.payment withdraw.payout"`
+	Data json.RawMessage `json:"data" validate:"required"`
+}
+
+type CreateProviderWebhookEvent struct {
+	Provider  string
+	EventID   string
+	EventType string
+	Payload   string
+	Signature string
+	SentAt    time.Time
+}
+
+type FinishProviderWebhookEvent struct {
+	EventID   int
+	Status    string
+	LastError *string
+}
+
+type FindAllProviderWebhookEvents struct {
+	Search   string `json:"search"`
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+func (r *ProviderWebhookEnvelope) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
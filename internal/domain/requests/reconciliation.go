@@ -0,0 +1,80 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+// Statuses of a balance discrepancy. An open discrepancy is acknowledged by
+// an admin once it is being looked into, and resolved by the reconciliation
+// run that finds the card matching again.
+const (
+	BalanceDiscrepancyOpen         = "open"
+	BalanceDiscrepancyAcknowledged = "acknowledged"
+	BalanceDiscrepancyResolved     = "resolved"
+)
+
+// CreateBalanceDiscrepancy records a card that did not match in a
+// reconciliation run, along with the movements its expected balance was
+// computed from.
+type CreateBalanceDiscrepancy struct {
+	CardNumber          string
+	ReconciliationRunID int
+	SaldoBalance        int
+	ExpectedBalance     int
+	LedgerBalance       int
+	Difference          int
+	TopupAmount         int
+	WithdrawAmount      int
+	TransferOutAmount   int
+	TransferInAmount    int
+	PaymentAmount       int
+	SettlementAmount    int
+	OtherAmount         int
+}
+
+type FindReconciliationRuns struct {
+	Page     int `json:"page" validate:"min=1"`
+	PageSize int `json:"page_size" validate:"min=1,max=100"`
+}
+
+// FindBalanceDiscrepancies lists discrepancies in one status, or in every
+// status when Status is empty.
+type FindBalanceDiscrepancies struct {
+	Status   string `json:"status" validate:"omitempty,oneof=open acknowledged resolved"`
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+type AcknowledgeBalanceDiscrepancyRequest struct {
+	DiscrepancyID  int     `json:"discrepancy_id" validate:"required,min=1"`
+	Note           *string `json:"note" validate:"omitempty,max=1000"`
+	AcknowledgedBy int     `json:"acknowledged_by" validate:"required,min=1"`
+}
+
+func (r *FindReconciliationRuns) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *FindBalanceDiscrepancies) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *AcknowledgeBalanceDiscrepancyRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,31 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+type CreateRefundRequest struct {
+	TransactionID int    `json:"transaction_id" validate:"required,min=1"`
+	Amount        int    `json:"amount" validate:"required,min=1"`
+	Reason        string `json:"reason" validate:"required,min=1,max=500"`
+
+	// RequestedBy restricts the refund to transactions of merchants owned by
+	// this user. It is nil for administrators.
+	RequestedBy *int `json:"-"`
+}
+
+type FindAllRefunds struct {
+	Search   string `json:"search"`
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+func (r *CreateRefundRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -36,7 +36,6 @@ type UpdateSaldoBalance struct {
 
 type UpdateSaldoWithdraw struct {
 	CardNumber     string     `json:"card_number" validate:"required,min=1"`
-	TotalBalance   int        `json:"total_balance" validate:"required,min=50000"`
 	WithdrawAmount *int       `json:"withdraw_amount" validate:"omitempty,gte=0"`
 	WithdrawTime   *time.Time `json:"withdraw_time" validate:"omitempty"`
 }
@@ -79,8 +78,5 @@ func (r *UpdateSaldoWithdraw) Validate() error {
 	if r.WithdrawAmount == nil && r.WithdrawTime != nil {
 		return errors.New("withdraw amount must be provided if withdraw time is provided")
 	}
-	if r.WithdrawAmount != nil && *r.WithdrawAmount > r.TotalBalance {
-		return errors.New("withdraw amount cannot be greater than total balance")
-	}
 	return nil
 }
@@ -0,0 +1,37 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+const (
+	SaldoHoldStatusActive   = "active"
+	SaldoHoldStatusReleased = "released"
+	SaldoHoldStatusConsumed = "consumed"
+)
+
+// Operations that place holds on a saldo. Manual holds have no reference.
+const (
+	SaldoHoldReferenceAuthorization = "authorization"
+	SaldoHoldReferenceWithdraw      = "withdraw"
+)
+
+type CreateSaldoHoldRequest struct {
+	CardNumber string `json:"card_number" validate:"required,min=1"`
+	Amount     int    `json:"amount" validate:"required,min=1"`
+	Reason     string `json:"reason" validate:"required,min=1,max=500"`
+
+	ReferenceType *string `json:"reference_type"`
+	ReferenceID   *int    `json:"reference_id"`
+	CreatedBy     *int    `json:"created_by"`
+}
+
+func (r *CreateSaldoHoldRequest) Validate() error {
+	validate := validator.New()
+
+	err := validate.Struct(r)
+
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
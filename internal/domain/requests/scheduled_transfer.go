@@ -0,0 +1,127 @@
+package requests
+
+import (
+	"errors"
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+const (
+	ScheduleRecurrenceOnce    = "once"
+	ScheduleRecurrenceDaily   = "daily"
+	ScheduleRecurrenceWeekly  = "weekly"
+	ScheduleRecurrenceMonthly = "monthly"
+)
+
+const (
+	ScheduledTransferStatusActive    = "active"
+	ScheduledTransferStatusPaused    = "paused"
+	ScheduledTransferStatusFailed    = "failed"
+	ScheduledTransferStatusCompleted = "completed"
+	ScheduledTransferStatusCancelled = "cancelled"
+)
+
+const (
+	ScheduledTransferRunRunning = "running"
+	ScheduledTransferRunSuccess = "success"
+	ScheduledTransferRunFailed  = "failed"
+)
+
+// CreateScheduledTransferRequest sets up a transfer that runs at StartAt and,
+// unless Recurrence is once, keeps running until EndAt. Monthly schedules run
+// on DayOfMonth, or on the last day of shorter months; it defaults to the day
+// of StartAt. RequestedBy is nil for admins, who may schedule from any card.
+type CreateScheduledTransferRequest struct {
+	TransferFrom   string     `json:"transfer_from" validate:"required,min=1"`
+	TransferTo     string     `json:"transfer_to" validate:"required,min=1"`
+	TransferAmount int        `json:"transfer_amount" validate:"required,min=1"`
+	Recurrence     string     `json:"recurrence" validate:"required,oneof=once daily weekly monthly"`
+	DayOfMonth     *int       `json:"day_of_month" validate:"omitempty,min=1,max=31"`
+	StartAt        time.Time  `json:"start_at" validate:"required"`
+	EndAt          *time.Time `json:"end_at"`
+	RequestedBy    *int       `json:"-"`
+}
+
+// UpdateScheduledTransferRequest replaces what a schedule transfers and when.
+// StartAt is the next run and must be in the future.
+type UpdateScheduledTransferRequest struct {
+	ScheduledTransferID *int       `json:"scheduled_transfer_id"`
+	TransferTo          string     `json:"transfer_to" validate:"required,min=1"`
+	TransferAmount      int        `json:"transfer_amount" validate:"required,min=1"`
+	Recurrence          string     `json:"recurrence" validate:"required,oneof=once daily weekly monthly"`
+	DayOfMonth          *int       `json:"day_of_month" validate:"omitempty,min=1,max=31"`
+	StartAt             time.Time  `json:"start_at" validate:"required"`
+	EndAt               *time.Time `json:"end_at"`
+	RequestedBy         *int       `json:"-"`
+}
+
+// FindAllScheduledTransfers lists schedules. RequestedBy is nil for admins,
+// who see every schedule; other users only see their own.
+type FindAllScheduledTransfers struct {
+	Search      string `json:"search"`
+	Page        int    `json:"page" validate:"min=1"`
+	PageSize    int    `json:"page_size" validate:"min=1,max=100"`
+	RequestedBy *int   `json:"-"`
+}
+
+type FindScheduledTransferRuns struct {
+	ScheduledTransferID int  `json:"scheduled_transfer_id" validate:"required,min=1"`
+	Page                int  `json:"page" validate:"min=1"`
+	PageSize            int  `json:"page_size" validate:"min=1,max=100"`
+	RequestedBy         *int `json:"-"`
+}
+
+func (r *CreateScheduledTransferRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	if r.TransferFrom == r.TransferTo {
+		return errors.New("cannot schedule a transfer to the same card")
+	}
+
+	return validateSchedule(r.Recurrence, r.DayOfMonth, r.StartAt, r.EndAt)
+}
+
+func (r *UpdateScheduledTransferRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	if r.ScheduledTransferID == nil || *r.ScheduledTransferID <= 0 {
+		return errors.New("scheduled transfer id is required")
+	}
+
+	return validateSchedule(r.Recurrence, r.DayOfMonth, r.StartAt, r.EndAt)
+}
+
+func (r *FindScheduledTransferRuns) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateSchedule(recurrence string, dayOfMonth *int, startAt time.Time, endAt *time.Time) error {
+	if !startAt.After(time.Now()) {
+		return errors.New("start_at must be in the future")
+	}
+
+	if dayOfMonth != nil && recurrence != ScheduleRecurrenceMonthly {
+		return errors.New("day_of_month is only allowed for monthly schedules")
+	}
+
+	if endAt != nil && endAt.Before(startAt) {
+		return errors.New("end_at must not be before start_at")
+	}
+
+	return nil
+}
@@ -0,0 +1,76 @@
+package requests
+
+import (
+	"time"
+
+	"github.com/go-playground/validator/v10"
+)
+
+// Kinds of settlement items. Transactions add their amount net of fees to
+// the merchant balance, refunds take their amount out of it and adjustments
+// add the change made to the amount of a transaction.
+const (
+	SettlementItemTransaction = "transaction"
+	SettlementItemRefund      = "refund"
+	SettlementItemAdjustment  = "adjustment"
+)
+
+// CreateSettlementItem accrues one item to the pending balance of a
+// merchant. NetAmount is what the item adds to the balance.
+type CreateSettlementItem struct {
+	MerchantID  int
+	ItemType    string
+	ReferenceID int
+	Amount      int
+	Fee         int
+	NetAmount   int
+	Currency    string
+}
+
+// SetSettlementCardRequest designates the card the balance of a merchant in
+// the card currency is paid out to. RequestedBy is nil for admins.
+type SetSettlementCardRequest struct {
+	MerchantID  int    `json:"merchant_id" validate:"required,min=1"`
+	CardNumber  string `json:"card_number" validate:"required,min=1"`
+	RequestedBy *int   `json:"-"`
+}
+
+type CreateSettlementBatch struct {
+	MerchantID           int
+	Currency             string
+	SettlementCardNumber string
+	CutoffAt             time.Time
+	GrossAmount          int
+	FeeAmount            int
+	RefundAmount         int
+	AdjustmentAmount     int
+	NetAmount            int
+	ItemCount            int
+}
+
+type FindSettlementBatches struct {
+	MerchantID  int  `json:"merchant_id" validate:"required,min=1"`
+	Page        int  `json:"page" validate:"min=1"`
+	PageSize    int  `json:"page_size" validate:"min=1,max=100"`
+	RequestedBy *int `json:"-"`
+}
+
+func (r *SetSettlementCardRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *FindSettlementBatches) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+type FindStatusHistory struct {
+	EntityType  string `json:"entity_type" validate:"required,oneof=topup withdraw transfer transaction merchant"`
+	EntityID    int    `json:"entity_id" validate:"required,min=1"`
+	RequestedBy *int   `json:"-"`
+}
+
+func (r *FindStatusHistory) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -44,15 +44,17 @@ type FindAllTopupsByCardNumber struct {
 
 type CreateTopupRequest struct {
 	CardNumber  string `json:"card_number" validate:"required,min=1"`
-	TopupAmount int    `json:"topup_amount" validate:"required,min=50000"`
+	TopupAmount int    `json:"topup_amount" validate:"required,min=1"`
 	TopupMethod string `json:"topup_method" validate:"required"`
+	Fee         int    `json:"-"`
 }
 
 type UpdateTopupRequest struct {
 	CardNumber  string `json:"card_number" validate:"required,min=1"`
 	TopupID     *int   `json:"topup_id"`
-	TopupAmount int    `json:"topup_amount" validate:"required,min=50000"`
+	TopupAmount int    `json:"topup_amount" validate:"required,min=1"`
 	TopupMethod string `json:"topup_method" validate:"required"`
+	Fee         int    `json:"-"`
 }
 
 type UpdateTopupAmount struct {
@@ -60,6 +62,18 @@ type UpdateTopupAmount struct {
 	TopupAmount int `json:"topup_amount" validate:"required,min=50000"`
 }
 
+type CreateTopupProviderPayment struct {
+	TopupID           int     `json:"topup_id" validate:"required,min=1"`
+	Provider          string  `json:"provider" validate:"required"`
+	ProviderReference string  `json:"provider_reference" validate:"required"`
+	Message           *string `json:"message"`
+}
+
+type TopupProviderCallback struct {
+	Provider string `json:"provider" validate:"required"`
+	Payload  []byte `json:"payload" validate:"required"`
+}
+
 type UpdateTopupStatus struct {
 	TopupID int    `json:"topup_id" validate:"required,min=1"`
 	Status  string `json:"status" validate:"required"`
@@ -140,3 +154,13 @@ func (r *UpdateTopupStatus) Validate() error {
 
 	return nil
 }
+
+func (r *TopupProviderCallback) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
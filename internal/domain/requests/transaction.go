@@ -45,19 +45,21 @@ type FindAllTransactionCardNumber struct {
 
 type CreateTransactionRequest struct {
 	CardNumber      string    `json:"card_number" validate:"required,min=1"`
-	Amount          int       `json:"amount" validate:"required,min=50000"`
+	Amount          int       `json:"amount" validate:"required,min=1"`
 	PaymentMethod   string    `json:"payment_method" validate:"required"`
 	MerchantID      *int      `json:"merchant_id" validate:"required,min=1"`
 	TransactionTime time.Time `json:"transaction_time" validate:"required"`
+	Fee             int       `json:"-"`
 }
 
 type UpdateTransactionRequest struct {
 	TransactionID   *int      `json:"transaction_id"`
 	CardNumber      string    `json:"card_number" validate:"required,min=1"`
-	Amount          int       `json:"amount" validate:"required,min=50000"`
+	Amount          int       `json:"amount" validate:"required,min=1"`
 	PaymentMethod   string    `json:"payment_method" validate:"required"`
 	MerchantID      *int      `json:"merchant_id" validate:"required,min=1"`
 	TransactionTime time.Time `json:"transaction_time" validate:"required"`
+	Fee             int       `json:"-"`
 }
 
 type UpdateTransactionStatus struct {
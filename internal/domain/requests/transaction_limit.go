@@ -0,0 +1,107 @@
+package requests
+
+import (
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/currency"
+	"github.com/go-playground/validator/v10"
+)
+
+// Operation types a transaction limit can apply to.
+const (
+	LimitOperationTransfer    = "transfer"
+	LimitOperationWithdraw    = "withdraw"
+	LimitOperationTransaction = "transaction"
+)
+
+const (
+	LimitPeriodDaily   = "daily"
+	LimitPeriodMonthly = "monthly"
+)
+
+type CreateTransactionLimitRequest struct {
+	Name                    string  `json:"name" validate:"required,max=100"`
+	OperationType           string  `json:"operation_type" validate:"required,oneof=transfer withdraw transaction"`
+	Period                  string  `json:"period" validate:"required,oneof=daily monthly"`
+	CardType                *string `json:"card_type" validate:"omitempty,min=1,max=50"`
+	UserTier                *string `json:"user_tier" validate:"omitempty,oneof=standard premium business"`
+	Currency                *string `json:"currency" validate:"omitempty,len=3"`
+	MaxAmountPerTransaction *int    `json:"max_amount_per_transaction" validate:"omitempty,min=1"`
+	MaxCount                *int    `json:"max_count" validate:"omitempty,min=1"`
+	MaxTotalAmount          *int    `json:"max_total_amount" validate:"omitempty,min=1"`
+	IsActive                bool    `json:"is_active"`
+}
+
+type UpdateTransactionLimitRequest struct {
+	TransactionLimitID      *int    `json:"transaction_limit_id"`
+	Name                    string  `json:"name" validate:"required,max=100"`
+	OperationType           string  `json:"operation_type" validate:"required,oneof=transfer withdraw transaction"`
+	Period                  string  `json:"period" validate:"required,oneof=daily monthly"`
+	CardType                *string `json:"card_type" validate:"omitempty,min=1,max=50"`
+	UserTier                *string `json:"user_tier" validate:"omitempty,oneof=standard premium business"`
+	Currency                *string `json:"currency" validate:"omitempty,len=3"`
+	MaxAmountPerTransaction *int    `json:"max_amount_per_transaction" validate:"omitempty,min=1"`
+	MaxCount                *int    `json:"max_count" validate:"omitempty,min=1"`
+	MaxTotalAmount          *int    `json:"max_total_amount" validate:"omitempty,min=1"`
+	IsActive                bool    `json:"is_active"`
+}
+
+type FindAllTransactionLimits struct {
+	Search   string `json:"search"`
+	Page     int    `json:"page" validate:"min=1"`
+	PageSize int    `json:"page_size" validate:"min=1,max=100"`
+}
+
+// FindTransactionLimitHeadroom asks for the remaining limits of a card.
+// RequestedBy is nil for admins, who may look at any card.
+type FindTransactionLimitHeadroom struct {
+	CardNumber    string `json:"card_number" validate:"required,min=1"`
+	OperationType string `json:"operation_type" validate:"required,oneof=transfer withdraw transaction"`
+	RequestedBy   *int   `json:"-"`
+}
+
+func (r *CreateTransactionLimitRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return validateTransactionLimit(r.Currency, r.MaxAmountPerTransaction, r.MaxCount, r.MaxTotalAmount)
+}
+
+func (r *UpdateTransactionLimitRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	if r.TransactionLimitID == nil || *r.TransactionLimitID <= 0 {
+		return errors.New("transaction limit id is required")
+	}
+
+	return validateTransactionLimit(r.Currency, r.MaxAmountPerTransaction, r.MaxCount, r.MaxTotalAmount)
+}
+
+func (r *FindTransactionLimitHeadroom) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func validateTransactionLimit(code *string, maxAmountPerTransaction, maxCount, maxTotalAmount *int) error {
+	if code != nil && !currency.IsSupported(*code) {
+		return currency.ErrUnsupportedCurrency
+	}
+
+	if maxAmountPerTransaction == nil && maxCount == nil && maxTotalAmount == nil {
+		return errors.New("at least one cap is required")
+	}
+
+	return nil
+}
@@ -34,16 +34,27 @@ type FindAllTranfers struct {
 }
 
 type CreateTransferRequest struct {
-	TransferFrom   string `json:"transfer_from" validate:"required"`
-	TransferTo     string `json:"transfer_to" validate:"required,min=1"`
-	TransferAmount int    `json:"transfer_amount" validate:"required,min=50000"`
+	TransferFrom   string              `json:"transfer_from" validate:"required"`
+	TransferTo     string              `json:"transfer_to" validate:"required,min=1"`
+	TransferAmount int                 `json:"transfer_amount" validate:"required,min=1"`
+	Conversion     *TransferConversion `json:"-"`
+	Fee            int                 `json:"-"`
+}
+
+// TransferConversion is filled in by the service when the sender and receiver
+// cards hold different currencies. It is never taken from the client.
+type TransferConversion struct {
+	ConvertedAmount int
+	ExchangeRate    float64
+	SpreadBps       int
 }
 
 type UpdateTransferRequest struct {
 	TransferID     *int   `json:"transfer_id"`
 	TransferFrom   string `json:"transfer_from" validate:"required"`
 	TransferTo     string `json:"transfer_to" validate:"required,min=1"`
-	TransferAmount int    `json:"transfer_amount" validate:"required,min=50000"`
+	TransferAmount int    `json:"transfer_amount" validate:"required,min=1"`
+	Fee            int    `json:"-"`
 }
 
 type UpdateTransferAmountRequest struct {
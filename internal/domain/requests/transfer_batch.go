@@ -0,0 +1,91 @@
+package requests
+
+import "github.com/go-playground/validator/v10"
+
+const (
+	TransferBatchFormatCSV  = "csv"
+	TransferBatchFormatJSON = "json"
+)
+
+const (
+	TransferBatchStatusPending             = "pending"
+	TransferBatchStatusProcessing          = "processing"
+	TransferBatchStatusCompleted           = "completed"
+	TransferBatchStatusCompletedWithErrors = "completed_with_errors"
+	TransferBatchStatusRejected            = "rejected"
+)
+
+const (
+	TransferBatchRowPending    = "pending"
+	TransferBatchRowProcessing = "processing"
+	TransferBatchRowSuccess    = "success"
+	TransferBatchRowFailed     = "failed"
+	TransferBatchRowInvalid    = "invalid"
+)
+
+// CreateTransferBatchRequest uploads a list of transfers paid from
+// TransferFrom. Content is a CSV file with a transfer_to,amount,note header,
+// or a JSON array of objects with the same keys. RequestedBy is nil for
+// admins, who may pay from any card.
+type CreateTransferBatchRequest struct {
+	TransferFrom string `json:"transfer_from" validate:"required,min=1"`
+	FileFormat   string `json:"file_format" validate:"required,oneof=csv json"`
+	Content      string `json:"content" validate:"required"`
+	RequestedBy  *int   `json:"-"`
+}
+
+// TransferBatchRowInput is one row of an uploaded batch. FailureReason is set
+// when the row did not pass validation.
+type TransferBatchRowInput struct {
+	RowNumber      int
+	TransferTo     string
+	TransferAmount int
+	Note           *string
+	FailureReason  *string
+}
+
+type CreateTransferBatch struct {
+	UserID       int
+	TransferFrom string
+	FileFormat   string
+	Status       string
+	TotalRows    int
+	TotalAmount  int
+	InvalidRows  int
+}
+
+// FindAllTransferBatches lists batches. RequestedBy is nil for admins, who
+// see every batch; other users only see their own.
+type FindAllTransferBatches struct {
+	Search      string `json:"search"`
+	Page        int    `json:"page" validate:"min=1"`
+	PageSize    int    `json:"page_size" validate:"min=1,max=100"`
+	RequestedBy *int   `json:"-"`
+}
+
+type FindTransferBatchRows struct {
+	TransferBatchID int  `json:"transfer_batch_id" validate:"required,min=1"`
+	Page            int  `json:"page" validate:"min=1"`
+	PageSize        int  `json:"page_size" validate:"min=1,max=100"`
+	RequestedBy     *int `json:"-"`
+}
+
+func (r *CreateTransferBatchRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *FindTransferBatchRows) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
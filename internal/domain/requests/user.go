@@ -4,6 +4,13 @@ import (
 	"github.com/go-playground/validator/v10"
 )
 
+// User tiers select which transaction limits apply to a user's cards.
+const (
+	UserTierStandard = "standard"
+	UserTierPremium  = "premium"
+	UserTierBusiness = "business"
+)
+
 type FindAllUsers struct {
 	Search   string `json:"search" validate:"required"`
 	Page     int    `json:"page" validate:"min=1"`
@@ -27,6 +34,11 @@ type UpdateUserRequest struct {
 	ConfirmPassword string `json:"confirm_password" validate:"required,eqfield=Password"`
 }
 
+type UpdateUserTierRequest struct {
+	UserID int    `json:"user_id" validate:"required,min=1"`
+	Tier   string `json:"tier" validate:"required,oneof=standard premium business"`
+}
+
 func (r *CreateUserRequest) Validate() error {
 	validate := validator.New()
 	err := validate.Struct(r)
@@ -44,3 +56,12 @@ func (r *UpdateUserRequest) Validate() error {
 	}
 	return nil
 }
+
+func (r *UpdateUserTierRequest) Validate() error {
+	validate := validator.New()
+	err := validate.Struct(r)
+	if err != nil {
+		return err
+	}
+	return nil
+}
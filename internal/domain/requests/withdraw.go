@@ -43,15 +43,40 @@ type FindAllWithdrawCardNumber struct {
 
 type CreateWithdrawRequest struct {
 	CardNumber     string    `json:"card_number" validate:"required,min=1"`
-	WithdrawAmount int       `json:"withdraw_amount" validate:"required,min=50000"`
+	WithdrawAmount int       `json:"withdraw_amount" validate:"required,min=1"`
 	WithdrawTime   time.Time `json:"withdraw_time" validate:"required"`
+	Fee            int       `json:"-"`
+	PayoutProvider string    `json:"-"`
 }
 
 type UpdateWithdrawRequest struct {
 	CardNumber     string    `json:"card_number" validate:"required,min=1"`
 	WithdrawID     *int      `json:"withdraw_id"`
-	WithdrawAmount int       `json:"withdraw_amount" validate:"required,min=50000"`
+	WithdrawAmount int       `json:"withdraw_amount" validate:"required,min=1"`
 	WithdrawTime   time.Time `json:"withdraw_time" validate:"required"`
+	Fee            int       `json:"-"`
+}
+
+// Decisions an admin can record on a withdraw waiting for review.
+const (
+	WithdrawReviewApproved = "approved"
+	WithdrawReviewRejected = "rejected"
+)
+
+type ReviewWithdrawRequest struct {
+	WithdrawID int     `json:"withdraw_id" validate:"required,min=1"`
+	Note       *string `json:"note" validate:"omitempty,max=1000"`
+	ReviewedBy int     `json:"reviewed_by" validate:"required,min=1"`
+}
+
+// WithdrawPayoutCallback is the outcome of a payout reported by the bank or
+// e-wallet that paid a withdraw out. Provider is the sender whose webhook
+// signature was verified, not a field of the payload.
+type WithdrawPayoutCallback struct {
+	Provider   string `json:"-"`
+	WithdrawNo string `json:"withdraw_no" validate:"required,uuid"`
+	Status     string `json:"status" validate:"required,oneof=success failed"`
+	Message    string `json:"message" validate:"omitempty,max=1000"`
 }
 
 type UpdateWithdrawStatus struct {
@@ -104,3 +129,23 @@ func (r *UpdateWithdrawStatus) Validate() error {
 
 	return nil
 }
+
+func (r *ReviewWithdrawRequest) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (r *WithdrawPayoutCallback) Validate() error {
+	validate := validator.New()
+
+	if err := validate.Struct(r); err != nil {
+		return err
+	}
+
+	return nil
+}
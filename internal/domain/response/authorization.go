@@ -0,0 +1,19 @@
+package response
+
+type AuthorizationResponse struct {
+	ID              int     `json:"id"`
+	AuthorizationNo string  `json:"authorization_no"`
+	CardNumber      string  `json:"card_number"`
+	MerchantID      int     `json:"merchant_id"`
+	Amount          int     `json:"amount"`
+	Currency        string  `json:"currency"`
+	CapturedAmount  int     `json:"captured_amount"`
+	PaymentMethod   string  `json:"payment_method"`
+	Status          string  `json:"status"`
+	TransactionID   *int    `json:"transaction_id"`
+	ExpiresAt       string  `json:"expires_at"`
+	CapturedAt      *string `json:"captured_at"`
+	VoidedAt        *string `json:"voided_at"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
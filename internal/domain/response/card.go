@@ -8,6 +8,7 @@ type CardResponse struct {
 	ExpireDate   string `json:"expire_date"`
 	CVV          string `json:"cvv"`
 	CardProvider string `json:"card_provider"`
+	Currency     string `json:"currency"`
 	CreatedAt    string `json:"created_at"`
 	UpdatedAt    string `json:"updated_at"`
 }
@@ -20,20 +21,32 @@ type CardResponseDeleteAt struct {
 	ExpireDate   string  `json:"expire_date"`
 	CVV          string  `json:"cvv"`
 	CardProvider string  `json:"card_provider"`
+	Currency     string  `json:"currency"`
 	CreatedAt    string  `json:"created_at"`
 	UpdatedAt    string  `json:"updated_at"`
 	DeletedAt    *string `json:"deleted_at"`
 }
 
 type DashboardCard struct {
-	TotalBalance     *int64 `json:"total_balance"`
-	TotalTopup       *int64 `json:"total_topup"`
-	TotalWithdraw    *int64 `json:"total_withdraw"`
-	TotalTransaction *int64 `json:"total_transaction"`
-	TotalTransfer    *int64 `json:"total_transfer"`
+	TotalBalance     *int64                   `json:"total_balance"`
+	TotalTopup       *int64                   `json:"total_topup"`
+	TotalWithdraw    *int64                   `json:"total_withdraw"`
+	TotalTransaction *int64                   `json:"total_transaction"`
+	TotalTransfer    *int64                   `json:"total_transfer"`
+	Currencies       []*DashboardCardCurrency `json:"currencies"`
+}
+
+type DashboardCardCurrency struct {
+	Currency         string `json:"currency"`
+	TotalBalance     int64  `json:"total_balance"`
+	TotalTopup       int64  `json:"total_topup"`
+	TotalWithdraw    int64  `json:"total_withdraw"`
+	TotalTransaction int64  `json:"total_transaction"`
+	TotalTransfer    int64  `json:"total_transfer"`
 }
 
 type DashboardCardCardNumber struct {
+	Currency              string `json:"currency"`
 	TotalBalance          *int64 `json:"total_balance"`
 	TotalTopup            *int64 `json:"total_topup"`
 	TotalWithdraw         *int64 `json:"total_withdraw"`
@@ -44,21 +57,25 @@ type DashboardCardCardNumber struct {
 
 type CardResponseMonthBalance struct {
 	Month        string `json:"month"`
+	Currency     string `json:"currency"`
 	TotalBalance int64  `json:"total_balance"`
 }
 
 type CardResponseYearlyBalance struct {
 	Year         string `json:"year"`
+	Currency     string `json:"currency"`
 	TotalBalance int64  `json:"total_balance"`
 }
 
 type CardResponseMonthAmount struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int64  `json:"total_amount"`
 }
 
 type CardResponseYearAmount struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int64  `json:"total_amount"`
 }
 
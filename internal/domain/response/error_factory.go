@@ -1,6 +1,7 @@
 package response
 
 import (
+	"errors"
 	"fmt"
 
 	"github.com/labstack/echo/v4"
@@ -14,6 +15,22 @@ func NewErrorResponse(message string, code int) *ErrorResponse {
 	}
 }
 
+func (e *ErrorResponse) Error() string {
+	return e.Message
+}
+
+// ToErrorResponse unwraps an *ErrorResponse carried inside err, which is how
+// services surface a specific failure out of a unit of work. When err does
+// not carry one, fallback is returned instead.
+func ToErrorResponse(err error, fallback *ErrorResponse) *ErrorResponse {
+	var errResp *ErrorResponse
+	if errors.As(err, &errResp) {
+		return errResp
+	}
+
+	return fallback
+}
+
 func NewApiErrorResponse(c echo.Context, statusText string, message string, code int) error {
 	return c.JSON(code, ErrorResponse{
 		Status:  statusText,
@@ -0,0 +1,11 @@
+package response
+
+type ExchangeRateResponse struct {
+	ID            int     `json:"id"`
+	BaseCurrency  string  `json:"base_currency"`
+	QuoteCurrency string  `json:"quote_currency"`
+	Rate          float64 `json:"rate"`
+	SpreadBps     int     `json:"spread_bps"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
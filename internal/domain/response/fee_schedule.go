@@ -0,0 +1,40 @@
+package response
+
+type FeeScheduleResponse struct {
+	ID              int                        `json:"id"`
+	Name            string                     `json:"name"`
+	TransactionType string                     `json:"transaction_type"`
+	FeeType         string                     `json:"fee_type"`
+	PaymentMethod   *string                    `json:"payment_method"`
+	CardType        *string                    `json:"card_type"`
+	Currency        *string                    `json:"currency"`
+	FlatAmount      int                        `json:"flat_amount"`
+	PercentageBps   int                        `json:"percentage_bps"`
+	MinFee          *int                       `json:"min_fee"`
+	MaxFee          *int                       `json:"max_fee"`
+	IsActive        bool                       `json:"is_active"`
+	Tiers           []*FeeScheduleTierResponse `json:"tiers"`
+	CreatedAt       string                     `json:"created_at"`
+	UpdatedAt       string                     `json:"updated_at"`
+}
+
+type FeeScheduleTierResponse struct {
+	ID            int `json:"id"`
+	MinAmount     int `json:"min_amount"`
+	FlatAmount    int `json:"flat_amount"`
+	PercentageBps int `json:"percentage_bps"`
+}
+
+type FeeMonthAmountResponse struct {
+	Month           string `json:"month"`
+	TransactionType string `json:"transaction_type"`
+	Currency        string `json:"currency"`
+	TotalFee        int    `json:"total_fee"`
+}
+
+type FeeYearlyAmountResponse struct {
+	Year            string `json:"year"`
+	TransactionType string `json:"transaction_type"`
+	Currency        string `json:"currency"`
+	TotalFee        int    `json:"total_fee"`
+}
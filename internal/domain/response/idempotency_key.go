@@ -0,0 +1,9 @@
+package response
+
+type IdempotencyKeyResponse struct {
+	ID           int     `json:"id"`
+	Scope        string  `json:"scope"`
+	Key          string  `json:"key"`
+	ResponseBody *string `json:"response_body"`
+	ExpiresAt    string  `json:"expires_at"`
+}
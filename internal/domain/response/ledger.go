@@ -0,0 +1,42 @@
+package response
+
+type LedgerJournalResponse struct {
+	ID            int                      `json:"id"`
+	JournalNo     string                   `json:"journal_no"`
+	ReferenceType string                   `json:"reference_type"`
+	ReferenceID   *int                     `json:"reference_id"`
+	Description   string                   `json:"description"`
+	PostedAt      string                   `json:"posted_at"`
+	CreatedAt     string                   `json:"created_at"`
+	Postings      []*LedgerPostingResponse `json:"postings"`
+}
+
+type LedgerPostingResponse struct {
+	ID          int     `json:"id"`
+	JournalID   int     `json:"journal_id"`
+	AccountCode string  `json:"account_code"`
+	CardNumber  *string `json:"card_number"`
+	Direction   string  `json:"direction"`
+	Amount      int     `json:"amount"`
+	Currency    string  `json:"currency"`
+	CreatedAt   string  `json:"created_at"`
+}
+
+type LedgerCardPostingResponse struct {
+	ID            int    `json:"id"`
+	JournalID     int    `json:"journal_id"`
+	ReferenceType string `json:"reference_type"`
+	ReferenceID   *int   `json:"reference_id"`
+	CardNumber    string `json:"card_number"`
+	Direction     string `json:"direction"`
+	Amount        int    `json:"amount"`
+	Currency      string `json:"currency"`
+	PostedAt      string `json:"posted_at"`
+}
+
+type LedgerBalanceResponse struct {
+	CardNumber    string `json:"card_number"`
+	LedgerBalance int    `json:"ledger_balance"`
+	SaldoBalance  int    `json:"saldo_balance"`
+	Difference    int    `json:"difference"`
+}
@@ -4,7 +4,6 @@ type MerchantResponse struct {
 	ID        int    `json:"id"`
 	Name      string `json:"name"`
 	UserID    int    `json:"user_id"`
-	ApiKey    string `json:"api_key"`
 	Status    string `json:"status"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
@@ -14,7 +13,6 @@ type MerchantResponseDeleteAt struct {
 	ID        int     `json:"id"`
 	Name      string  `json:"name"`
 	UserID    int     `json:"user_id"`
-	ApiKey    string  `json:"api_key"`
 	Status    string  `json:"status"`
 	CreatedAt string  `json:"created_at"`
 	UpdatedAt string  `json:"updated_at"`
@@ -47,22 +45,40 @@ type MerchantResponseYearlyPaymentMethod struct {
 
 type MerchantResponseMonthlyAmount struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
 type MerchantResponseYearlyAmount struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
+type MerchantResponseMonthlyDisputeRate struct {
+	Month             string  `json:"month"`
+	TotalTransactions int     `json:"total_transactions"`
+	TotalDisputes     int     `json:"total_disputes"`
+	DisputeRate       float64 `json:"dispute_rate"`
+}
+
+type MerchantResponseYearlyDisputeRate struct {
+	Year              string  `json:"year"`
+	TotalTransactions int     `json:"total_transactions"`
+	TotalDisputes     int     `json:"total_disputes"`
+	DisputeRate       float64 `json:"dispute_rate"`
+}
+
 type MerchantResponseMonthlyTotalAmount struct {
 	Year        string `json:"year"`
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
 type MerchantResponseYearlyTotalAmount struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
@@ -0,0 +1,19 @@
+package response
+
+// MerchantApiKeyResponse is an API key as shown to its merchant. The key
+// itself and its signing secret are only set in the response to its
+// creation or rotation.
+type MerchantApiKeyResponse struct {
+	ID            int      `json:"id"`
+	MerchantID    int      `json:"merchant_id"`
+	Label         string   `json:"label"`
+	KeyPrefix     string   `json:"key_prefix"`
+	ApiKey        *string  `json:"api_key,omitempty"`
+	SigningSecret *string  `json:"signing_secret,omitempty"`
+	Scopes        []string `json:"scopes"`
+	ExpiresAt     *string  `json:"expires_at"`
+	LastUsedAt    *string  `json:"last_used_at"`
+	RevokedAt     *string  `json:"revoked_at"`
+	CreatedAt     string   `json:"created_at"`
+	UpdatedAt     string   `json:"updated_at"`
+}
@@ -0,0 +1,16 @@
+package response
+
+// MerchantQrResponse is a QR code a merchant presents to its customers: the
+// EMV payload a scanner reads, and the same payload as a base64 encoded PNG
+// image and as an SVG document. Amount and reference are only set on
+// dynamic QR codes.
+type MerchantQrResponse struct {
+	MerchantID int     `json:"merchant_id"`
+	Dynamic    bool    `json:"dynamic"`
+	Amount     *int    `json:"amount"`
+	Currency   string  `json:"currency"`
+	Reference  *string `json:"reference"`
+	Payload    string  `json:"payload"`
+	Png        string  `json:"png"`
+	Svg        string  `json:"svg"`
+}
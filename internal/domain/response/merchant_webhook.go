@@ -0,0 +1,40 @@
+package response
+
+// MerchantWebhookEndpointResponse is an endpoint as shown to its merchant.
+// The signing secret is only set in the response to its creation.
+type MerchantWebhookEndpointResponse struct {
+	ID         int      `json:"id"`
+	MerchantID int      `json:"merchant_id"`
+	URL        string   `json:"url"`
+	Secret     *string  `json:"secret,omitempty"`
+	Events     []string `json:"events"`
+	IsActive   bool     `json:"is_active"`
+	CreatedAt  string   `json:"created_at"`
+	UpdatedAt  string   `json:"updated_at"`
+}
+
+type MerchantWebhookDeliveryResponse struct {
+	ID               int     `json:"id"`
+	EndpointID       int     `json:"endpoint_id"`
+	EventID          string  `json:"event_id"`
+	EventType        string  `json:"event_type"`
+	Payload          string  `json:"payload"`
+	Status           string  `json:"status"`
+	Attempts         int     `json:"attempts"`
+	NextAttemptAt    *string `json:"next_attempt_at"`
+	LastResponseCode *int    `json:"last_response_code"`
+	LastError        *string `json:"last_error"`
+	DeliveredAt      *string `json:"delivered_at"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+}
+
+type MerchantWebhookDeliveryAttemptResponse struct {
+	ID           int     `json:"id"`
+	DeliveryID   int     `json:"delivery_id"`
+	Attempt      int     `json:"attempt"`
+	ResponseCode *int    `json:"response_code"`
+	Error        *string `json:"error"`
+	DurationMs   int     `json:"duration_ms"`
+	CreatedAt    string  `json:"created_at"`
+}
@@ -0,0 +1,15 @@
+package response
+
+type RefundResponse struct {
+	ID            int    `json:"id"`
+	RefundNo      string `json:"refund_no"`
+	TransactionID int    `json:"transaction_id"`
+	CardNumber    string `json:"card_number"`
+	MerchantID    int    `json:"merchant_id"`
+	Amount        int    `json:"amount"`
+	Currency      string `json:"currency"`
+	Reason        string `json:"reason"`
+	Status        string `json:"status"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
@@ -1,44 +1,54 @@
 package response
 
 type SaldoResponse struct {
-	ID             int    `json:"id"`
-	CardNumber     string `json:"card_number"`
-	TotalBalance   int    `json:"total_balance"`
-	WithdrawAmount int    `json:"withdraw_amount"`
-	WithdrawTime   string `json:"withdraw_time"`
-	CreatedAt      string `json:"created_at"`
-	UpdatedAt      string `json:"updated_at"`
+	ID               int    `json:"id"`
+	CardNumber       string `json:"card_number"`
+	TotalBalance     int    `json:"total_balance"`
+	ReservedBalance  int    `json:"reserved_balance"`
+	Currency         string `json:"currency"`
+	AvailableBalance int    `json:"available_balance"`
+	WithdrawAmount   int    `json:"withdraw_amount"`
+	WithdrawTime     string `json:"withdraw_time"`
+	CreatedAt        string `json:"created_at"`
+	UpdatedAt        string `json:"updated_at"`
 }
 
 type SaldoResponseDeleteAt struct {
-	ID             int     `json:"id"`
-	CardNumber     string  `json:"card_number"`
-	TotalBalance   int     `json:"total_balance"`
-	WithdrawAmount int     `json:"withdraw_amount"`
-	WithdrawTime   string  `json:"withdraw_time"`
-	CreatedAt      string  `json:"created_at"`
-	UpdatedAt      string  `json:"updated_at"`
-	DeletedAt      *string `json:"deleted_at"`
+	ID               int     `json:"id"`
+	CardNumber       string  `json:"card_number"`
+	TotalBalance     int     `json:"total_balance"`
+	ReservedBalance  int     `json:"reserved_balance"`
+	Currency         string  `json:"currency"`
+	AvailableBalance int     `json:"available_balance"`
+	WithdrawAmount   int     `json:"withdraw_amount"`
+	WithdrawTime     string  `json:"withdraw_time"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+	DeletedAt        *string `json:"deleted_at"`
 }
 
 type SaldoMonthTotalBalanceResponse struct {
 	Month        string `json:"month"`
+	Currency     string `json:"currency"`
 	Year         string `json:"year"`
 	TotalBalance int    `json:"total_balance"`
 }
 
 type SaldoYearTotalBalanceResponse struct {
 	Year         string `json:"year"`
+	Currency     string `json:"currency"`
 	TotalBalance int    `json:"total_balance"`
 }
 
 type SaldoMonthBalanceResponse struct {
 	Month        string `json:"month"`
+	Currency     string `json:"currency"`
 	TotalBalance int    `json:"total_balance"`
 }
 
 type SaldoYearBalanceResponse struct {
 	Year         string `json:"year"`
+	Currency     string `json:"currency"`
 	TotalBalance int    `json:"total_balance"`
 }
 
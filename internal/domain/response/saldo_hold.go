@@ -0,0 +1,18 @@
+package response
+
+type SaldoHoldResponse struct {
+	ID            int     `json:"id"`
+	HoldNo        string  `json:"hold_no"`
+	CardNumber    string  `json:"card_number"`
+	Amount        int     `json:"amount"`
+	Currency      string  `json:"currency"`
+	Reason        string  `json:"reason"`
+	ReferenceType *string `json:"reference_type"`
+	ReferenceID   *int    `json:"reference_id"`
+	Status        string  `json:"status"`
+	CreatedBy     *int    `json:"created_by"`
+	ReleasedAt    *string `json:"released_at"`
+	ConsumedAt    *string `json:"consumed_at"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
@@ -0,0 +1,28 @@
+package response
+
+type ScheduledTransferResponse struct {
+	ID             int     `json:"id"`
+	UserID         int     `json:"user_id"`
+	TransferFrom   string  `json:"transfer_from"`
+	TransferTo     string  `json:"transfer_to"`
+	TransferAmount int     `json:"transfer_amount"`
+	Recurrence     string  `json:"recurrence"`
+	DayOfMonth     *int    `json:"day_of_month"`
+	NextRunAt      *string `json:"next_run_at"`
+	EndAt          *string `json:"end_at"`
+	Status         string  `json:"status"`
+	LastRunAt      *string `json:"last_run_at"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+type ScheduledTransferRunResponse struct {
+	ID                  int     `json:"id"`
+	ScheduledTransferID int     `json:"scheduled_transfer_id"`
+	ScheduledFor        string  `json:"scheduled_for"`
+	Status              string  `json:"status"`
+	TransferID          *int    `json:"transfer_id"`
+	FailureReason       *string `json:"failure_reason"`
+	CreatedAt           string  `json:"created_at"`
+	UpdatedAt           string  `json:"updated_at"`
+}
@@ -0,0 +1,39 @@
+package response
+
+// MerchantBalanceResponse is what a merchant is owed in one currency and the
+// card it is paid out to. SettlementCardNumber is nil when the balance is
+// paid out to the first card of the merchant owner.
+type MerchantBalanceResponse struct {
+	MerchantID           int     `json:"merchant_id"`
+	Currency             string  `json:"currency"`
+	PendingBalance       int     `json:"pending_balance"`
+	SettlementCardNumber *string `json:"settlement_card_number"`
+	UpdatedAt            string  `json:"updated_at"`
+}
+
+type SettlementBatchResponse struct {
+	ID                   int    `json:"id"`
+	MerchantID           int    `json:"merchant_id"`
+	Currency             string `json:"currency"`
+	SettlementCardNumber string `json:"settlement_card_number"`
+	CutoffAt             string `json:"cutoff_at"`
+	GrossAmount          int    `json:"gross_amount"`
+	FeeAmount            int    `json:"fee_amount"`
+	RefundAmount         int    `json:"refund_amount"`
+	AdjustmentAmount     int    `json:"adjustment_amount"`
+	NetAmount            int    `json:"net_amount"`
+	ItemCount            int    `json:"item_count"`
+	CreatedAt            string `json:"created_at"`
+}
+
+type SettlementItemResponse struct {
+	ID                int    `json:"id"`
+	SettlementBatchID *int   `json:"settlement_batch_id"`
+	ItemType          string `json:"item_type"`
+	ReferenceID       int    `json:"reference_id"`
+	Amount            int    `json:"amount"`
+	Fee               int    `json:"fee"`
+	NetAmount         int    `json:"net_amount"`
+	Currency          string `json:"currency"`
+	CreatedAt         string `json:"created_at"`
+}
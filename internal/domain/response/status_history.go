@@ -0,0 +1,12 @@
+package response
+
+type StatusHistoryResponse struct {
+	ID         int    `json:"id"`
+	EntityType string `json:"entity_type"`
+	EntityID   int    `json:"entity_id"`
+	FromStatus string `json:"from_status"`
+	ToStatus   string  `json:"to_status"`
+	Reason     *string `json:"reason"`
+	ChangedBy  *int    `json:"changed_by"`
+	CreatedAt  string  `json:"created_at"`
+}
@@ -5,8 +5,11 @@ type TopupResponse struct {
 	CardNumber  string `json:"card_number"`
 	TopupNo     string `json:"topup_no"`
 	TopupAmount int    `json:"topup_amount"`
+	Currency    string `json:"currency"`
+	Fee         int    `json:"fee"`
 	TopupMethod string `json:"topup_method"`
 	TopupTime   string `json:"topup_time"`
+	Status      string `json:"status"`
 	CreatedAt   string `json:"created_at"`
 	UpdatedAt   string `json:"updated_at"`
 }
@@ -16,8 +19,11 @@ type TopupResponseDeleteAt struct {
 	CardNumber  string  `json:"card_number"`
 	TopupNo     string  `json:"topup_no"`
 	TopupAmount int     `json:"topup_amount"`
+	Currency    string  `json:"currency"`
+	Fee         int     `json:"fee"`
 	TopupMethod string  `json:"topup_method"`
 	TopupTime   string  `json:"topup_time"`
+	Status      string  `json:"status"`
 	CreatedAt   string  `json:"created_at"`
 	UpdatedAt   string  `json:"updated_at"`
 	DeletedAt   *string `json:"deleted_At"`
@@ -65,11 +71,13 @@ type TopupYearlyMethodResponse struct {
 
 type TopupMonthAmountResponse struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
 type TopupYearlyAmountResponse struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
@@ -5,8 +5,12 @@ type TransactionResponse struct {
 	TransactionNo   string `json:"transaction_no"`
 	CardNumber      string `json:"card_number"`
 	Amount          int    `json:"amount"`
+	Currency        string `json:"currency"`
+	Fee             int    `json:"fee"`
 	PaymentMethod   string `json:"payment_method"`
 	MerchantID      int    `json:"merchant_id"`
+	Status          string `json:"status"`
+	RefundedAmount  int    `json:"refunded_amount"`
 	TransactionTime string `json:"transaction_time"`
 	CreatedAt       string `json:"created_at"`
 	UpdatedAt       string `json:"updated_at"`
@@ -17,6 +21,8 @@ type TransactionResponseDeleteAt struct {
 	TransactionNo   string  `json:"transaction_no"`
 	CardNumber      string  `json:"card_number"`
 	Amount          int     `json:"amount"`
+	Currency        string  `json:"currency"`
+	Fee             int     `json:"fee"`
 	PaymentMethod   string  `json:"payment_method"`
 	MerchantID      int     `json:"merchant_id"`
 	TransactionTime string  `json:"transaction_time"`
@@ -67,11 +73,13 @@ type TransactionYearMethodResponse struct {
 
 type TransactionMonthAmountResponse struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
 type TransactionYearlyAmountResponse struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
@@ -0,0 +1,32 @@
+package response
+
+type TransactionLimitResponse struct {
+	ID                      int     `json:"id"`
+	Name                    string  `json:"name"`
+	OperationType           string  `json:"operation_type"`
+	Period                  string  `json:"period"`
+	CardType                *string `json:"card_type"`
+	UserTier                *string `json:"user_tier"`
+	Currency                *string `json:"currency"`
+	MaxAmountPerTransaction *int    `json:"max_amount_per_transaction"`
+	MaxCount                *int    `json:"max_count"`
+	MaxTotalAmount          *int    `json:"max_total_amount"`
+	IsActive                bool    `json:"is_active"`
+	CreatedAt               string  `json:"created_at"`
+	UpdatedAt               string  `json:"updated_at"`
+}
+
+// TransactionLimitHeadroomResponse tells how much of a limit a card has left
+// in the current period. Remaining values are nil when the cap is not set.
+type TransactionLimitHeadroomResponse struct {
+	LimitID                 int    `json:"limit_id"`
+	Name                    string `json:"name"`
+	OperationType           string `json:"operation_type"`
+	Period                  string `json:"period"`
+	MaxAmountPerTransaction *int   `json:"max_amount_per_transaction"`
+	UsedCount               int    `json:"used_count"`
+	RemainingCount          *int   `json:"remaining_count"`
+	UsedAmount              int    `json:"used_amount"`
+	RemainingAmount         *int   `json:"remaining_amount"`
+	ResetsAt                string `json:"resets_at"`
+}
@@ -1,26 +1,38 @@
 package response
 
 type TransferResponse struct {
-	ID             int    `json:"id"`
-	TransferNo     string `json:"transfer_no"`
-	TransferFrom   string `json:"transfer_from"`
-	TransferTo     string `json:"transfer_to"`
-	TransferAmount int    `json:"transfer_amount"`
-	TransferTime   string `json:"transfer_time"`
-	CreatedAt      string `json:"created_at"`
-	UpdatedAt      string `json:"updated_at"`
+	ID              int      `json:"id"`
+	TransferNo      string   `json:"transfer_no"`
+	TransferFrom    string   `json:"transfer_from"`
+	TransferTo      string   `json:"transfer_to"`
+	TransferAmount  int      `json:"transfer_amount"`
+	Currency        string   `json:"currency"`
+	Fee             int      `json:"fee"`
+	ToCurrency      string   `json:"to_currency"`
+	ConvertedAmount int      `json:"converted_amount"`
+	ExchangeRate    *float64 `json:"exchange_rate"`
+	SpreadBps       *int     `json:"spread_bps"`
+	TransferTime    string   `json:"transfer_time"`
+	CreatedAt       string   `json:"created_at"`
+	UpdatedAt       string   `json:"updated_at"`
 }
 
 type TransferResponseDeleteAt struct {
-	ID             int     `json:"id"`
-	TransferNo     string  `json:"transfer_no"`
-	TransferFrom   string  `json:"transfer_from"`
-	TransferTo     string  `json:"transfer_to"`
-	TransferAmount int     `json:"transfer_amount"`
-	TransferTime   string  `json:"transfer_time"`
-	CreatedAt      string  `json:"created_at"`
-	UpdatedAt      string  `json:"updated_at"`
-	DeletedAt      *string `json:"deleted_at"`
+	ID              int      `json:"id"`
+	TransferNo      string   `json:"transfer_no"`
+	TransferFrom    string   `json:"transfer_from"`
+	TransferTo      string   `json:"transfer_to"`
+	TransferAmount  int      `json:"transfer_amount"`
+	Currency        string   `json:"currency"`
+	Fee             int      `json:"fee"`
+	ToCurrency      string   `json:"to_currency"`
+	ConvertedAmount int      `json:"converted_amount"`
+	ExchangeRate    *float64 `json:"exchange_rate"`
+	SpreadBps       *int     `json:"spread_bps"`
+	TransferTime    string   `json:"transfer_time"`
+	CreatedAt       string   `json:"created_at"`
+	UpdatedAt       string   `json:"updated_at"`
+	DeletedAt       *string  `json:"deleted_at"`
 }
 
 type TransferResponseMonthStatusSuccess struct {
@@ -51,11 +63,13 @@ type TransferResponseYearStatusFailed struct {
 
 type TransferMonthAmountResponse struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
 type TransferYearAmountResponse struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
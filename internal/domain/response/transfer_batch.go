@@ -0,0 +1,41 @@
+package response
+
+type TransferBatchResponse struct {
+	ID              int     `json:"id"`
+	UserID          int     `json:"user_id"`
+	TransferFrom    string  `json:"transfer_from"`
+	FileFormat      string  `json:"file_format"`
+	Status          string  `json:"status"`
+	TotalRows       int     `json:"total_rows"`
+	TotalAmount     int     `json:"total_amount"`
+	InvalidRows     int     `json:"invalid_rows"`
+	PendingRows     int     `json:"pending_rows"`
+	SuccessRows     int     `json:"success_rows"`
+	FailedRows      int     `json:"failed_rows"`
+	SuccessAmount   int     `json:"success_amount"`
+	ProgressPercent int     `json:"progress_percent"`
+	StartedAt       *string `json:"started_at"`
+	CompletedAt     *string `json:"completed_at"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+type TransferBatchRowResponse struct {
+	ID              int     `json:"id"`
+	TransferBatchID int     `json:"transfer_batch_id"`
+	RowNumber       int     `json:"row_number"`
+	TransferTo      string  `json:"transfer_to"`
+	TransferAmount  int     `json:"transfer_amount"`
+	Note            *string `json:"note"`
+	Status          string  `json:"status"`
+	TransferID      *int    `json:"transfer_id"`
+	FailureReason   *string `json:"failure_reason"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+type TransferBatchReportResponse struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Content     string `json:"content"`
+}
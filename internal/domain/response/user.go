@@ -5,6 +5,7 @@ type UserResponse struct {
 	FirstName string `json:"firstname"`
 	LastName  string `json:"lastname"`
 	Email     string `json:"email"`
+	Tier      string `json:"tier"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
 }
@@ -14,6 +15,7 @@ type UserResponseDeleteAt struct {
 	FirstName string  `json:"firstname"`
 	LastName  string  `json:"lastname"`
 	Email     string  `json:"email"`
+	Tier      string  `json:"tier"`
 	CreatedAt string  `json:"created_at"`
 	UpdatedAt string  `json:"updated_at"`
 	DeletedAt *string `json:"deleted_at"`
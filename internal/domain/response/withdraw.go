@@ -5,7 +5,10 @@ type WithdrawResponse struct {
 	WithdrawNo     string `json:"withdraw_no"`
 	CardNumber     string `json:"card_number"`
 	WithdrawAmount int    `json:"withdraw_amount"`
+	Currency       string `json:"currency"`
+	Fee            int    `json:"fee"`
 	WithdrawTime   string `json:"withdraw_time"`
+	Status         string `json:"status"`
 	CreatedAt      string `json:"created_at"`
 	UpdatedAt      string `json:"updated_at"`
 }
@@ -15,7 +18,10 @@ type WithdrawResponseDeleteAt struct {
 	WithdrawNo     string  `json:"withdraw_no"`
 	CardNumber     string  `json:"card_number"`
 	WithdrawAmount int     `json:"withdraw_amount"`
+	Currency       string  `json:"currency"`
+	Fee            int     `json:"fee"`
 	WithdrawTime   string  `json:"withdraw_time"`
+	Status         string  `json:"status"`
 	CreatedAt      string  `json:"created_at"`
 	UpdatedAt      string  `json:"updated_at"`
 	DeletedAt      *string `json:"deleted_at"`
@@ -49,11 +55,13 @@ type WithdrawResponseYearStatusFailed struct {
 
 type WithdrawMonthlyAmountResponse struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
 type WithdrawYearlyAmountResponse struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int    `json:"total_amount"`
 }
 
@@ -128,3 +136,12 @@ type ApiResponsePaginationWithdrawDeleteAt struct {
 	Data       []*WithdrawResponseDeleteAt `json:"data"`
 	Pagination PaginationMeta              `json:"pagination"`
 }
+
+type WithdrawReviewResponse struct {
+	ID         int     `json:"id"`
+	WithdrawID int     `json:"withdraw_id"`
+	Decision   string  `json:"decision"`
+	Note       *string `json:"note"`
+	ReviewedBy int     `json:"reviewed_by"`
+	CreatedAt  string  `json:"created_at"`
+}
@@ -0,0 +1,196 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/authorization_errors"
+)
+
+// AuthorizeTransaction is the resolver for the authorizeTransaction field.
+func (r *mutationResolver) AuthorizeTransaction(ctx context.Context, input model.AuthorizeTransactionInput) (*model.APIResponseAuthorization, error) {
+	credential := merchantCredential(ctx, input.APIKey)
+
+	ok, err := r.AuthorizationGraphql.Permission.ValidateApiKey(credential, requests.MerchantApiKeyScopeCreateTransactions)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate API key: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("unauthorized: invalid API key")
+	}
+
+	req := requests.CreateAuthorizationRequest{
+		CardNumber:    input.CardNumber,
+		Amount:        int(input.Amount),
+		PaymentMethod: input.PaymentMethod,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, authorization_errors.ErrGraphqlValidateAuthorize
+	}
+
+	payload := input
+	payload.IdempotencyKey = nil
+
+	return withIdempotency(ctx, r.AuthorizationGraphql.IdempotencyKeyService, requests.IdempotencyScopeAuthorizeTransaction, input.IdempotencyKey, payload, func() (*model.APIResponseAuthorization, error) {
+		res, errResp := r.AuthorizationGraphql.AuthorizationService.Authorize(credential, &req)
+
+		if errResp != nil {
+			return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+		}
+
+		so := r.AuthorizationGraphql.Mapping.ToGraphqlResponseAuthorization("success", "Successfully authorized transaction", res)
+
+		return so, nil
+	})
+}
+
+// CaptureTransaction is the resolver for the captureTransaction field.
+func (r *mutationResolver) CaptureTransaction(ctx context.Context, input model.CaptureTransactionInput) (*model.APIResponseAuthorization, error) {
+	credential := merchantCredential(ctx, input.APIKey)
+
+	ok, err := r.AuthorizationGraphql.Permission.ValidateApiKey(credential, requests.MerchantApiKeyScopeCreateTransactions)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate API key: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("unauthorized: invalid API key")
+	}
+
+	req := requests.CaptureAuthorizationRequest{
+		AuthorizationID: int(input.AuthorizationID),
+	}
+
+	if input.Amount != nil {
+		amount := int(*input.Amount)
+		req.Amount = &amount
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, authorization_errors.ErrGraphqlValidateCaptureAuthorization
+	}
+
+	payload := input
+	payload.IdempotencyKey = nil
+
+	return withIdempotency(ctx, r.AuthorizationGraphql.IdempotencyKeyService, requests.IdempotencyScopeCaptureTransaction, input.IdempotencyKey, payload, func() (*model.APIResponseAuthorization, error) {
+		res, errResp := r.AuthorizationGraphql.AuthorizationService.Capture(credential, &req)
+
+		if errResp != nil {
+			return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+		}
+
+		so := r.AuthorizationGraphql.Mapping.ToGraphqlResponseAuthorization("success", "Successfully captured transaction", res)
+
+		return so, nil
+	})
+}
+
+// VoidAuthorization is the resolver for the voidAuthorization field.
+func (r *mutationResolver) VoidAuthorization(ctx context.Context, input model.VoidAuthorizationInput) (*model.APIResponseAuthorization, error) {
+	credential := merchantCredential(ctx, input.APIKey)
+
+	ok, err := r.AuthorizationGraphql.Permission.ValidateApiKey(credential, requests.MerchantApiKeyScopeCreateTransactions)
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to validate API key: %w", err)
+	}
+	if !ok {
+		return nil, fmt.Errorf("unauthorized: invalid API key")
+	}
+
+	id := int(input.AuthorizationID)
+
+	if id <= 0 {
+		return nil, authorization_errors.ErrGraphqlAuthorizationInvalidID
+	}
+
+	res, errResp := r.AuthorizationGraphql.AuthorizationService.Void(credential, id)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.AuthorizationGraphql.Mapping.ToGraphqlResponseAuthorization("success", "Successfully voided authorization", res)
+
+	return so, nil
+}
+
+// FindAllAuthorization is the resolver for the findAllAuthorization field.
+func (r *queryResolver) FindAllAuthorization(ctx context.Context, input *model.FindAllAuthorizationInput) (*model.APIResponsePaginationAuthorization, error) {
+	if err := requireRole(ctx, r.AuthorizationGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+	search := ""
+
+	if input != nil {
+		if input.Page != nil && *input.Page > 0 {
+			page = int(*input.Page)
+		}
+		if input.PageSize != nil && *input.PageSize > 0 {
+			pageSize = int(*input.PageSize)
+		}
+		if input.Search != nil {
+			search = *input.Search
+		}
+	}
+
+	reqService := requests.FindAllAuthorizations{
+		Page:     page,
+		PageSize: pageSize,
+		Search:   search,
+	}
+
+	authorizations, totalRecords, errResp := r.AuthorizationGraphql.AuthorizationService.FindAll(&reqService)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.AuthorizationGraphql.Mapping.ToGraphqlResponsePaginationAuthorization("success", "authorizations retrieved successfully", authorizations, paginationMeta)
+
+	return so, nil
+}
+
+// FindByIDAuthorization is the resolver for the findByIdAuthorization field.
+func (r *queryResolver) FindByIDAuthorization(ctx context.Context, input model.FindByIDAuthorizationInput) (*model.APIResponseAuthorization, error) {
+	if err := requireRole(ctx, r.AuthorizationGraphql.Permission, "ROLE_ADMIN", "ROLE_MERCHANT"); err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, authorization_errors.ErrGraphqlAuthorizationInvalidID
+	}
+
+	authorization, err := r.AuthorizationGraphql.AuthorizationService.FindById(id)
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.AuthorizationGraphql.Mapping.ToGraphqlResponseAuthorization("success", "Successfully fetched authorization", authorization)
+
+	return so, nil
+}
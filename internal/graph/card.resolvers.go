@@ -13,6 +13,7 @@ import (
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/currency"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
 )
 
@@ -29,6 +30,11 @@ func (r *mutationResolver) CreateCard(ctx context.Context, input model.CreateCar
 		ExpireDate:   expireDate,
 		CVV:          input.Cvv,
 		CardProvider: input.CardProvider,
+		Currency:     currency.Default,
+	}
+
+	if input.Currency != nil {
+		request.Currency = currency.Normalize(*input.Currency)
 	}
 
 	if err := request.Validate(); err != nil {
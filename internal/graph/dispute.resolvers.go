@@ -0,0 +1,226 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	mycontext "github.com/MamangRust/paymentgatewaygraphql/pkg/context"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/dispute_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+)
+
+// OpenDispute is the resolver for the openDispute field.
+func (r *mutationResolver) OpenDispute(ctx context.Context, input model.OpenDisputeInput) (*model.APIResponseDispute, error) {
+	requester, err := requestedBy(ctx, r.DisputeGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	uid, _ := mycontext.UserForContext(ctx)
+
+	req := requests.CreateDisputeRequest{
+		TransactionID: int(input.TransactionID),
+		Reason:        input.Reason,
+		OpenedBy:      uid,
+		RequestedBy:   requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, dispute_errors.ErrGraphqlValidateOpenDispute
+	}
+
+	res, errResp := r.DisputeGraphql.DisputeService.OpenDispute(&req)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.DisputeGraphql.Mapping.ToGraphqlResponseDispute("success", "Successfully opened dispute", res)
+
+	return so, nil
+}
+
+// RespondDispute is the resolver for the respondDispute field.
+func (r *mutationResolver) RespondDispute(ctx context.Context, input model.RespondDisputeInput) (*model.APIResponseDispute, error) {
+	if err := requireRole(ctx, r.DisputeGraphql.Permission, "ROLE_ADMIN", "ROLE_MERCHANT"); err != nil {
+		return nil, err
+	}
+
+	requester, err := requestedBy(ctx, r.DisputeGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	req := requests.RespondDisputeRequest{
+		DisputeID:   int(input.DisputeID),
+		Evidence:    input.Evidence,
+		RequestedBy: requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, dispute_errors.ErrGraphqlValidateRespondDispute
+	}
+
+	res, errResp := r.DisputeGraphql.DisputeService.RespondDispute(&req)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.DisputeGraphql.Mapping.ToGraphqlResponseDispute("success", "Successfully responded to dispute", res)
+
+	return so, nil
+}
+
+// ResolveDispute is the resolver for the resolveDispute field.
+func (r *mutationResolver) ResolveDispute(ctx context.Context, input model.ResolveDisputeInput) (*model.APIResponseDispute, error) {
+	if err := requireRole(ctx, r.DisputeGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	uid, _ := mycontext.UserForContext(ctx)
+
+	req := requests.ResolveDisputeRequest{
+		DisputeID:  int(input.DisputeID),
+		Outcome:    input.Outcome,
+		Note:       input.Note,
+		ResolvedBy: uid,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, dispute_errors.ErrGraphqlValidateResolveDispute
+	}
+
+	res, errResp := r.DisputeGraphql.DisputeService.ResolveDispute(&req)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.DisputeGraphql.Mapping.ToGraphqlResponseDispute("success", "Successfully resolved dispute", res)
+
+	return so, nil
+}
+
+// FindAllDispute is the resolver for the findAllDispute field.
+func (r *queryResolver) FindAllDispute(ctx context.Context, input *model.FindAllDisputeInput) (*model.APIResponsePaginationDispute, error) {
+	if err := requireRole(ctx, r.DisputeGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+	search := ""
+
+	if input != nil {
+		if input.Page != nil && *input.Page > 0 {
+			page = int(*input.Page)
+		}
+		if input.PageSize != nil && *input.PageSize > 0 {
+			pageSize = int(*input.PageSize)
+		}
+		if input.Search != nil {
+			search = *input.Search
+		}
+	}
+
+	reqService := requests.FindAllDisputes{
+		Page:     page,
+		PageSize: pageSize,
+		Search:   search,
+	}
+
+	disputes, totalRecords, errResp := r.DisputeGraphql.DisputeService.FindAll(&reqService)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.DisputeGraphql.Mapping.ToGraphqlResponsePaginationDispute("success", "disputes retrieved successfully", disputes, paginationMeta)
+
+	return so, nil
+}
+
+// FindByIDDispute is the resolver for the findByIdDispute field.
+func (r *queryResolver) FindByIDDispute(ctx context.Context, input model.FindByIDDisputeInput) (*model.APIResponseDispute, error) {
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, dispute_errors.ErrGraphqlDisputeInvalidID
+	}
+
+	dispute, err := r.DisputeGraphql.DisputeService.FindById(id)
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.DisputeGraphql.Mapping.ToGraphqlResponseDispute("success", "Successfully fetched dispute", dispute)
+
+	return so, nil
+}
+
+// FindDisputesByMerchant is the resolver for the findDisputesByMerchant field.
+func (r *queryResolver) FindDisputesByMerchant(ctx context.Context, input model.FindAllDisputeByMerchantInput) (*model.APIResponsePaginationDispute, error) {
+	if err := requireRole(ctx, r.DisputeGraphql.Permission, "ROLE_ADMIN", "ROLE_MERCHANT"); err != nil {
+		return nil, err
+	}
+
+	merchantID := int(input.MerchantID)
+
+	if merchantID <= 0 {
+		return nil, merchant_errors.ErrGraphqlMerchantInvalidID
+	}
+
+	page := 1
+	pageSize := 10
+	search := ""
+
+	if input.Page != nil && *input.Page > 0 {
+		page = int(*input.Page)
+	}
+	if input.PageSize != nil && *input.PageSize > 0 {
+		pageSize = int(*input.PageSize)
+	}
+	if input.Search != nil {
+		search = *input.Search
+	}
+
+	reqService := requests.FindAllDisputesByMerchant{
+		MerchantID: merchantID,
+		Page:       page,
+		PageSize:   pageSize,
+		Search:     search,
+	}
+
+	disputes, totalRecords, errResp := r.DisputeGraphql.DisputeService.FindByMerchant(&reqService)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.DisputeGraphql.Mapping.ToGraphqlResponsePaginationDispute("success", "disputes retrieved successfully", disputes, paginationMeta)
+
+	return so, nil
+}
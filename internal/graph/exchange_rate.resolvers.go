@@ -0,0 +1,131 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/currency"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/exchange_rate_errors"
+)
+
+// UpsertExchangeRate is the resolver for the upsertExchangeRate field.
+func (r *mutationResolver) UpsertExchangeRate(ctx context.Context, input model.UpsertExchangeRateInput) (*model.APIResponseExchangeRate, error) {
+	if err := requireRole(ctx, r.ExchangeRateGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	req := requests.UpsertExchangeRateRequest{
+		BaseCurrency:  currency.Normalize(input.BaseCurrency),
+		QuoteCurrency: currency.Normalize(input.QuoteCurrency),
+		Rate:          input.Rate,
+	}
+
+	if input.SpreadBps != nil {
+		req.SpreadBps = int(*input.SpreadBps)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, exchange_rate_errors.ErrGraphqlValidateUpsertExchangeRate
+	}
+
+	res, errResp := r.ExchangeRateGraphql.ExchangeRateService.UpsertExchangeRate(&req)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.ExchangeRateGraphql.Mapping.ToGraphqlResponseExchangeRate("success", "Successfully saved exchange rate", res)
+
+	return so, nil
+}
+
+// DeleteExchangeRate is the resolver for the deleteExchangeRate field.
+func (r *mutationResolver) DeleteExchangeRate(ctx context.Context, input model.FindByIDExchangeRateInput) (*model.APIResponseExchangeRate, error) {
+	if err := requireRole(ctx, r.ExchangeRateGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, exchange_rate_errors.ErrGraphqlExchangeRateInvalidID
+	}
+
+	res, errResp := r.ExchangeRateGraphql.ExchangeRateService.DeleteExchangeRate(id)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.ExchangeRateGraphql.Mapping.ToGraphqlResponseExchangeRate("success", "Successfully deleted exchange rate", res)
+
+	return so, nil
+}
+
+// FindAllExchangeRate is the resolver for the findAllExchangeRate field.
+func (r *queryResolver) FindAllExchangeRate(ctx context.Context, input *model.FindAllExchangeRateInput) (*model.APIResponsePaginationExchangeRate, error) {
+	page := 1
+	pageSize := 10
+	search := ""
+
+	if input != nil {
+		if input.Page != nil && *input.Page > 0 {
+			page = int(*input.Page)
+		}
+		if input.PageSize != nil && *input.PageSize > 0 {
+			pageSize = int(*input.PageSize)
+		}
+		if input.Search != nil {
+			search = *input.Search
+		}
+	}
+
+	reqService := requests.FindAllExchangeRates{
+		Page:     page,
+		PageSize: pageSize,
+		Search:   search,
+	}
+
+	rates, totalRecords, errResp := r.ExchangeRateGraphql.ExchangeRateService.FindAll(&reqService)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.ExchangeRateGraphql.Mapping.ToGraphqlResponsePaginationExchangeRate("success", "exchange rates retrieved successfully", rates, paginationMeta)
+
+	return so, nil
+}
+
+// FindByIDExchangeRate is the resolver for the findByIdExchangeRate field.
+func (r *queryResolver) FindByIDExchangeRate(ctx context.Context, input model.FindByIDExchangeRateInput) (*model.APIResponseExchangeRate, error) {
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, exchange_rate_errors.ErrGraphqlExchangeRateInvalidID
+	}
+
+	rate, err := r.ExchangeRateGraphql.ExchangeRateService.FindById(id)
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.ExchangeRateGraphql.Mapping.ToGraphqlResponseExchangeRate("success", "Successfully fetched exchange rate", rate)
+
+	return so, nil
+}
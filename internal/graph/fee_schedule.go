@@ -0,0 +1,48 @@
+package graph
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/currency"
+)
+
+// feeScheduleTiers converts the tier inputs of a fee schedule mutation;
+// omitted flat and percentage parts default to zero.
+func feeScheduleTiers(input []*model.FeeScheduleTierInput) []requests.FeeScheduleTierRequest {
+	tiers := make([]requests.FeeScheduleTierRequest, 0, len(input))
+
+	for _, tier := range input {
+		req := requests.FeeScheduleTierRequest{
+			MinAmount: int(tier.MinAmount),
+		}
+
+		if tier.FlatAmount != nil {
+			req.FlatAmount = int(*tier.FlatAmount)
+		}
+		if tier.PercentageBps != nil {
+			req.PercentageBps = int(*tier.PercentageBps)
+		}
+
+		tiers = append(tiers, req)
+	}
+
+	return tiers
+}
+
+func optionalInt(value *int32) *int {
+	if value == nil {
+		return nil
+	}
+
+	v := int(*value)
+	return &v
+}
+
+func optionalCurrency(value *string) *string {
+	if value == nil {
+		return nil
+	}
+
+	code := currency.Normalize(*value)
+	return &code
+}
@@ -0,0 +1,236 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/fee_schedule_errors"
+)
+
+// CreateFeeSchedule is the resolver for the createFeeSchedule field.
+func (r *mutationResolver) CreateFeeSchedule(ctx context.Context, input model.CreateFeeScheduleInput) (*model.APIResponseFeeSchedule, error) {
+	if err := requireRole(ctx, r.FeeScheduleGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	req := requests.CreateFeeScheduleRequest{
+		Name:            input.Name,
+		TransactionType: input.TransactionType,
+		FeeType:         input.FeeType,
+		PaymentMethod:   input.PaymentMethod,
+		CardType:        input.CardType,
+		Currency:        optionalCurrency(input.Currency),
+		MinFee:          optionalInt(input.MinFee),
+		MaxFee:          optionalInt(input.MaxFee),
+		IsActive:        true,
+		Tiers:           feeScheduleTiers(input.Tiers),
+	}
+
+	if input.FlatAmount != nil {
+		req.FlatAmount = int(*input.FlatAmount)
+	}
+	if input.PercentageBps != nil {
+		req.PercentageBps = int(*input.PercentageBps)
+	}
+	if input.IsActive != nil {
+		req.IsActive = *input.IsActive
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, fee_schedule_errors.ErrGraphqlValidateCreateFeeSchedule
+	}
+
+	res, errResp := r.FeeScheduleGraphql.FeeScheduleService.CreateFeeSchedule(&req)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.FeeScheduleGraphql.Mapping.ToGraphqlResponseFeeSchedule("success", "Successfully created fee schedule", res)
+
+	return so, nil
+}
+
+// UpdateFeeSchedule is the resolver for the updateFeeSchedule field.
+func (r *mutationResolver) UpdateFeeSchedule(ctx context.Context, input model.UpdateFeeScheduleInput) (*model.APIResponseFeeSchedule, error) {
+	if err := requireRole(ctx, r.FeeScheduleGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	req := requests.UpdateFeeScheduleRequest{
+		FeeScheduleID:   &id,
+		Name:            input.Name,
+		TransactionType: input.TransactionType,
+		FeeType:         input.FeeType,
+		PaymentMethod:   input.PaymentMethod,
+		CardType:        input.CardType,
+		Currency:        optionalCurrency(input.Currency),
+		MinFee:          optionalInt(input.MinFee),
+		MaxFee:          optionalInt(input.MaxFee),
+		IsActive:        true,
+		Tiers:           feeScheduleTiers(input.Tiers),
+	}
+
+	if input.FlatAmount != nil {
+		req.FlatAmount = int(*input.FlatAmount)
+	}
+	if input.PercentageBps != nil {
+		req.PercentageBps = int(*input.PercentageBps)
+	}
+	if input.IsActive != nil {
+		req.IsActive = *input.IsActive
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, fee_schedule_errors.ErrGraphqlValidateUpdateFeeSchedule
+	}
+
+	res, errResp := r.FeeScheduleGraphql.FeeScheduleService.UpdateFeeSchedule(&req)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.FeeScheduleGraphql.Mapping.ToGraphqlResponseFeeSchedule("success", "Successfully updated fee schedule", res)
+
+	return so, nil
+}
+
+// DeleteFeeSchedule is the resolver for the deleteFeeSchedule field.
+func (r *mutationResolver) DeleteFeeSchedule(ctx context.Context, input model.FindByIDFeeScheduleInput) (*model.APIResponseFeeSchedule, error) {
+	if err := requireRole(ctx, r.FeeScheduleGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, fee_schedule_errors.ErrGraphqlFeeScheduleInvalidID
+	}
+
+	res, errResp := r.FeeScheduleGraphql.FeeScheduleService.DeleteFeeSchedule(id)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.FeeScheduleGraphql.Mapping.ToGraphqlResponseFeeSchedule("success", "Successfully deleted fee schedule", res)
+
+	return so, nil
+}
+
+// FindAllFeeSchedule is the resolver for the findAllFeeSchedule field.
+func (r *queryResolver) FindAllFeeSchedule(ctx context.Context, input *model.FindAllFeeScheduleInput) (*model.APIResponsePaginationFeeSchedule, error) {
+	page := 1
+	pageSize := 10
+	search := ""
+
+	if input != nil {
+		if input.Page != nil && *input.Page > 0 {
+			page = int(*input.Page)
+		}
+		if input.PageSize != nil && *input.PageSize > 0 {
+			pageSize = int(*input.PageSize)
+		}
+		if input.Search != nil {
+			search = *input.Search
+		}
+	}
+
+	reqService := requests.FindAllFeeSchedules{
+		Page:     page,
+		PageSize: pageSize,
+		Search:   search,
+	}
+
+	schedules, totalRecords, errResp := r.FeeScheduleGraphql.FeeScheduleService.FindAll(&reqService)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.FeeScheduleGraphql.Mapping.ToGraphqlResponsePaginationFeeSchedule("success", "fee schedules retrieved successfully", schedules, paginationMeta)
+
+	return so, nil
+}
+
+// FindByIDFeeSchedule is the resolver for the findByIdFeeSchedule field.
+func (r *queryResolver) FindByIDFeeSchedule(ctx context.Context, input model.FindByIDFeeScheduleInput) (*model.APIResponseFeeSchedule, error) {
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, fee_schedule_errors.ErrGraphqlFeeScheduleInvalidID
+	}
+
+	schedule, err := r.FeeScheduleGraphql.FeeScheduleService.FindById(id)
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.FeeScheduleGraphql.Mapping.ToGraphqlResponseFeeSchedule("success", "Successfully fetched fee schedule", schedule)
+
+	return so, nil
+}
+
+// FindMonthlyFeeAmounts is the resolver for the findMonthlyFeeAmounts field.
+func (r *queryResolver) FindMonthlyFeeAmounts(ctx context.Context, input model.FindYearFeeInput) (*model.APIResponseFeeMonthAmount, error) {
+	if err := requireRole(ctx, r.FeeScheduleGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	year := int(input.Year)
+
+	if year <= 0 {
+		return nil, fee_schedule_errors.ErrGraphqlInvalidYear
+	}
+
+	res, err := r.FeeScheduleGraphql.FeeScheduleService.FindMonthlyFeeAmounts(year)
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.FeeScheduleGraphql.Mapping.ToGraphqlResponseFeeMonthAmount("success", "Successfully fetched monthly fee amounts", res)
+
+	return so, nil
+}
+
+// FindYearlyFeeAmounts is the resolver for the findYearlyFeeAmounts field.
+func (r *queryResolver) FindYearlyFeeAmounts(ctx context.Context, input model.FindYearFeeInput) (*model.APIResponseFeeYearAmount, error) {
+	if err := requireRole(ctx, r.FeeScheduleGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	year := int(input.Year)
+
+	if year <= 0 {
+		return nil, fee_schedule_errors.ErrGraphqlInvalidYear
+	}
+
+	res, err := r.FeeScheduleGraphql.FeeScheduleService.FindYearlyFeeAmounts(year)
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.FeeScheduleGraphql.Mapping.ToGraphqlResponseFeeYearAmount("success", "Successfully fetched yearly fee amounts", res)
+
+	return so, nil
+}
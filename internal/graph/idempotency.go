@@ -0,0 +1,91 @@
+package graph
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/service"
+	mycontext "github.com/MamangRust/paymentgatewaygraphql/pkg/context"
+)
+
+// withIdempotency runs a money-creating mutation at most once per key. The key
+// is taken from the input field, falling back to the Idempotency-Key header.
+// A completed key replays the stored response; a failed run releases the key
+// so the client can retry it. A run that succeeded keeps its key even when its
+// response cannot be stored, so a retry cannot run it a second time.
+func withIdempotency[T any](ctx context.Context, idempotency service.IdempotencyKeyService, scope string, inputKey *string, payload any, run func() (*T, error)) (*T, error) {
+	key := ""
+	if inputKey != nil {
+		key = strings.TrimSpace(*inputKey)
+	}
+	if key == "" {
+		key, _ = mycontext.IdempotencyKeyFromContext(ctx)
+	}
+	if key == "" {
+		return run()
+	}
+
+	uid, ok := mycontext.UserForContext(ctx)
+	if !ok || uid == 0 {
+		return nil, fmt.Errorf("unauthorized: user ID not found in request context")
+	}
+
+	fingerprint, err := requestFingerprint(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fingerprint request: %w", err)
+	}
+
+	reserved, errResp := idempotency.Reserve(&requests.CreateIdempotencyKey{
+		UserID:      uid,
+		Scope:       scope,
+		Key:         key,
+		Fingerprint: fingerprint,
+	})
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	if reserved.ResponseBody != nil {
+		var replay T
+		if err := json.Unmarshal([]byte(*reserved.ResponseBody), &replay); err != nil {
+			return nil, fmt.Errorf("failed to decode stored response: %w", err)
+		}
+		return &replay, nil
+	}
+
+	res, err := run()
+	if err != nil {
+		idempotency.Release(reserved.ID)
+		return nil, err
+	}
+
+	body, err := json.Marshal(res)
+	if err != nil {
+		idempotency.Release(reserved.ID)
+		return nil, fmt.Errorf("failed to encode response: %w", err)
+	}
+
+	// The mutation has run, so its result is returned even if it could not be
+	// stored: reporting an error would only make the client retry a request
+	// that already went through. Complete logs the failure.
+	idempotency.Complete(reserved.ID, string(body))
+
+	return res, nil
+}
+
+func requestFingerprint(payload any) (string, error) {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(body)
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,229 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	mycontext "github.com/MamangRust/paymentgatewaygraphql/pkg/context"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/invoice_errors"
+)
+
+// CreateInvoice is the resolver for the createInvoice field.
+func (r *mutationResolver) CreateInvoice(ctx context.Context, input model.CreateInvoiceInput) (*model.APIResponseInvoice, error) {
+	requester, err := requestedBy(ctx, r.InvoiceGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	expiresAt, err := time.Parse(time.RFC3339, input.ExpiresAt)
+	if err != nil {
+		return nil, invoice_errors.ErrGraphqlValidateCreateInvoice
+	}
+
+	req := requests.CreateInvoiceRequest{
+		MerchantID:  int(input.MerchantID),
+		Kind:        input.Kind,
+		Description: input.Description,
+		Amount:      int(input.Amount),
+		ExpiresAt:   expiresAt,
+		RequestedBy: requester,
+	}
+
+	for _, item := range input.LineItems {
+		req.LineItems = append(req.LineItems, requests.CreateInvoiceLineItemRequest{
+			Description: item.Description,
+			Quantity:    int(item.Quantity),
+			UnitPrice:   int(item.UnitPrice),
+		})
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, invoice_errors.ErrGraphqlValidateCreateInvoice
+	}
+
+	invoice, errResp := r.InvoiceGraphql.InvoiceService.Create(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.InvoiceGraphql.Mapping.ToGraphqlResponseInvoice("success", "Successfully created invoice", invoice)
+
+	return so, nil
+}
+
+// CancelInvoice is the resolver for the cancelInvoice field.
+func (r *mutationResolver) CancelInvoice(ctx context.Context, input model.FindByIDInvoiceInput) (*model.APIResponseInvoice, error) {
+	requester, err := requestedBy(ctx, r.InvoiceGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceID := int(input.ID)
+
+	if invoiceID == 0 {
+		return nil, invoice_errors.ErrGraphqlInvoiceInvalidID
+	}
+
+	invoice, errResp := r.InvoiceGraphql.InvoiceService.Cancel(invoiceID, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.InvoiceGraphql.Mapping.ToGraphqlResponseInvoice("success", "Successfully cancelled invoice", invoice)
+
+	return so, nil
+}
+
+// PayInvoice is the resolver for the payInvoice field.
+func (r *mutationResolver) PayInvoice(ctx context.Context, input model.PayInvoiceInput) (*model.APIResponseInvoicePayment, error) {
+	uid, ok := mycontext.UserForContext(ctx)
+	if !ok || uid == 0 {
+		return nil, fmt.Errorf("unauthorized: user ID not found in request context")
+	}
+
+	req := requests.PayInvoiceRequest{
+		Code:          input.Code,
+		CardNumber:    input.CardNumber,
+		PaymentMethod: input.PaymentMethod,
+		UserID:        uid,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, invoice_errors.ErrGraphqlValidatePayInvoice
+	}
+
+	payload := input
+	payload.IdempotencyKey = nil
+
+	return withIdempotency(ctx, r.InvoiceGraphql.IdempotencyKeyService, requests.IdempotencyScopePayInvoice, input.IdempotencyKey, payload, func() (*model.APIResponseInvoicePayment, error) {
+		payment, errResp := r.InvoiceGraphql.InvoiceService.Pay(&req)
+		if errResp != nil {
+			return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+		}
+
+		so := r.InvoiceGraphql.Mapping.ToGraphqlResponseInvoicePayment("success", "Successfully paid invoice", payment)
+		return so, nil
+	})
+}
+
+// FindInvoices is the resolver for the findInvoices field.
+func (r *queryResolver) FindInvoices(ctx context.Context, input model.FindInvoicesInput) (*model.APIResponsePaginationInvoice, error) {
+	requester, err := requestedBy(ctx, r.InvoiceGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+	status := ""
+
+	if input.Page != nil && *input.Page > 0 {
+		page = int(*input.Page)
+	}
+	if input.PageSize != nil && *input.PageSize > 0 {
+		pageSize = int(*input.PageSize)
+	}
+	if input.Status != nil {
+		status = *input.Status
+	}
+
+	req := requests.FindInvoicesByMerchant{
+		MerchantID:  int(input.MerchantID),
+		Status:      status,
+		Page:        page,
+		PageSize:    pageSize,
+		RequestedBy: requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, invoice_errors.ErrGraphqlValidateFindInvoices
+	}
+
+	invoices, totalRecords, errResp := r.InvoiceGraphql.InvoiceService.FindByMerchant(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.InvoiceGraphql.Mapping.ToGraphqlResponsePaginationInvoice("success", "invoices retrieved successfully", invoices, paginationMeta)
+
+	return so, nil
+}
+
+// FindInvoiceByID is the resolver for the findInvoiceById field.
+func (r *queryResolver) FindInvoiceByID(ctx context.Context, input model.FindByIDInvoiceInput) (*model.APIResponseInvoice, error) {
+	requester, err := requestedBy(ctx, r.InvoiceGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceID := int(input.ID)
+
+	if invoiceID == 0 {
+		return nil, invoice_errors.ErrGraphqlInvoiceInvalidID
+	}
+
+	invoice, errResp := r.InvoiceGraphql.InvoiceService.FindById(invoiceID, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.InvoiceGraphql.Mapping.ToGraphqlResponseInvoice("success", "invoice retrieved successfully", invoice)
+
+	return so, nil
+}
+
+// FindInvoiceByCode is the resolver for the findInvoiceByCode field.
+func (r *queryResolver) FindInvoiceByCode(ctx context.Context, input model.FindByCodeInvoiceInput) (*model.APIResponseInvoice, error) {
+	if uid, ok := mycontext.UserForContext(ctx); !ok || uid == 0 {
+		return nil, fmt.Errorf("unauthorized: user ID not found in request context")
+	}
+
+	invoice, errResp := r.InvoiceGraphql.InvoiceService.FindByCode(input.Code)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.InvoiceGraphql.Mapping.ToGraphqlResponseInvoice("success", "invoice retrieved successfully", invoice)
+
+	return so, nil
+}
+
+// FindInvoicePayments is the resolver for the findInvoicePayments field.
+func (r *queryResolver) FindInvoicePayments(ctx context.Context, input model.FindByIDInvoiceInput) (*model.APIResponseInvoicePayments, error) {
+	requester, err := requestedBy(ctx, r.InvoiceGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	invoiceID := int(input.ID)
+
+	if invoiceID == 0 {
+		return nil, invoice_errors.ErrGraphqlInvoiceInvalidID
+	}
+
+	payments, errResp := r.InvoiceGraphql.InvoiceService.FindPayments(invoiceID, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.InvoiceGraphql.Mapping.ToGraphqlResponseInvoicePayments("success", "invoice payments retrieved successfully", payments)
+
+	return so, nil
+}
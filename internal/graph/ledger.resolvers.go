@@ -0,0 +1,152 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/ledger_errors"
+)
+
+// FindAllLedgerJournal is the resolver for the findAllLedgerJournal field.
+func (r *queryResolver) FindAllLedgerJournal(ctx context.Context, input *model.FindAllLedgerJournalInput) (*model.APIResponsePaginationLedgerJournal, error) {
+	if err := requireRole(ctx, r.LedgerGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+	search := ""
+
+	if input != nil {
+		if input.Page != nil && *input.Page > 0 {
+			page = int(*input.Page)
+		}
+		if input.PageSize != nil && *input.PageSize > 0 {
+			pageSize = int(*input.PageSize)
+		}
+		if input.Search != nil {
+			search = *input.Search
+		}
+	}
+
+	reqService := requests.FindAllLedgerJournals{
+		Page:     page,
+		PageSize: pageSize,
+		Search:   search,
+	}
+
+	journals, totalRecords, errResp := r.LedgerGraphql.LedgerService.FindAllJournals(&reqService)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.LedgerGraphql.Mapping.ToGraphqlResponsePaginationLedgerJournal("success", "ledger journals retrieved successfully", journals, paginationMeta)
+
+	return so, nil
+}
+
+// FindByIDLedgerJournal is the resolver for the findByIdLedgerJournal field.
+func (r *queryResolver) FindByIDLedgerJournal(ctx context.Context, input model.FindByIDLedgerJournalInput) (*model.APIResponseLedgerJournal, error) {
+	if err := requireRole(ctx, r.LedgerGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, ledger_errors.ErrGraphqlLedgerJournalInvalidID
+	}
+
+	journal, err := r.LedgerGraphql.LedgerService.FindJournalById(id)
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.LedgerGraphql.Mapping.ToGraphqlResponseLedgerJournal("success", "Successfully fetched ledger journal", journal)
+
+	return so, nil
+}
+
+// FindLedgerPostingsByCardNumber is the resolver for the findLedgerPostingsByCardNumber field.
+func (r *queryResolver) FindLedgerPostingsByCardNumber(ctx context.Context, input model.FindLedgerPostingsByCardNumberInput) (*model.APIResponsePaginationLedgerCardPosting, error) {
+	requester, err := requestedBy(ctx, r.LedgerGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+
+	if input.Page != nil && *input.Page > 0 {
+		page = int(*input.Page)
+	}
+	if input.PageSize != nil && *input.PageSize > 0 {
+		pageSize = int(*input.PageSize)
+	}
+
+	reqService := requests.FindLedgerPostingsByCardNumber{
+		CardNumber:  input.CardNumber,
+		Page:        page,
+		PageSize:    pageSize,
+		RequestedBy: requester,
+	}
+
+	if err := reqService.Validate(); err != nil {
+		return nil, ledger_errors.ErrGraphqlLedgerInvalidCardNumber
+	}
+
+	postings, totalRecords, errResp := r.LedgerGraphql.LedgerService.FindPostingsByCardNumber(&reqService)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.LedgerGraphql.Mapping.ToGraphqlResponsePaginationLedgerCardPosting("success", "ledger postings retrieved successfully", postings, paginationMeta)
+
+	return so, nil
+}
+
+// FindLedgerBalanceByCardNumber is the resolver for the findLedgerBalanceByCardNumber field.
+func (r *queryResolver) FindLedgerBalanceByCardNumber(ctx context.Context, cardNumber string) (*model.APIResponseLedgerBalance, error) {
+	requester, err := requestedBy(ctx, r.LedgerGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	if cardNumber == "" {
+		return nil, ledger_errors.ErrGraphqlLedgerInvalidCardNumber
+	}
+
+	balance, errResp := r.LedgerGraphql.LedgerService.FindBalanceByCardNumber(cardNumber, requester)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.LedgerGraphql.Mapping.ToGraphqlResponseLedgerBalance("success", "Successfully fetched ledger balance", balance)
+
+	return so, nil
+}
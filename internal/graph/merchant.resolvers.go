@@ -46,7 +46,6 @@ func (r *mutationResolver) UpdateMerchant(ctx context.Context, input model.Updat
 		MerchantID: &id,
 		Name:       *input.Name,
 		UserID:     int(*input.UserID),
-		Status:     *input.Status,
 	}
 
 	if err := request.Validate(); err != nil {
@@ -641,6 +640,64 @@ func (r *queryResolver) FindYearlyAmountByMerchants(ctx context.Context, input m
 	return so, nil
 }
 
+// FindMonthlyDisputeRateByMerchants is the resolver for the findMonthlyDisputeRateByMerchants field.
+func (r *queryResolver) FindMonthlyDisputeRateByMerchants(ctx context.Context, input model.FindYearMerchantByIDInput) (*model.APIResponseMerchantMonthlyDisputeRate, error) {
+	year := int(input.Year)
+	merchantId := int(input.MerchantID)
+
+	if year <= 0 {
+		return nil, merchant_errors.ErrGraphqlMerchantInvalidYear
+	}
+
+	if merchantId <= 0 {
+		return nil, merchant_errors.ErrGraphqlMerchantInvalidID
+	}
+
+	reqService := requests.MonthYearAmountMerchant{
+		MerchantID: merchantId,
+		Year:       year,
+	}
+
+	res, errResp := r.MerchantGraphql.MerchantService.FindMonthlyDisputeRateByMerchants(&reqService)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantGraphql.Mapping.ToGraphqlMonthlyDisputeRates("success", "Successfully fetched monthly dispute rate for merchant", res)
+
+	return so, nil
+}
+
+// FindYearlyDisputeRateByMerchants is the resolver for the findYearlyDisputeRateByMerchants field.
+func (r *queryResolver) FindYearlyDisputeRateByMerchants(ctx context.Context, input model.FindYearMerchantByIDInput) (*model.APIResponseMerchantYearlyDisputeRate, error) {
+	year := int(input.Year)
+	merchantId := int(input.MerchantID)
+
+	if year <= 0 {
+		return nil, merchant_errors.ErrGraphqlMerchantInvalidYear
+	}
+
+	if merchantId <= 0 {
+		return nil, merchant_errors.ErrGraphqlMerchantInvalidID
+	}
+
+	reqService := requests.MonthYearAmountMerchant{
+		MerchantID: merchantId,
+		Year:       year,
+	}
+
+	res, errResp := r.MerchantGraphql.MerchantService.FindYearlyDisputeRateByMerchants(&reqService)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantGraphql.Mapping.ToGraphqlYearlyDisputeRates("success", "Successfully fetched yearly dispute rate for merchant", res)
+
+	return so, nil
+}
+
 // FindMonthlyTotalAmountByMerchants is the resolver for the findMonthlyTotalAmountByMerchants field.
 func (r *queryResolver) FindMonthlyTotalAmountByMerchants(ctx context.Context, input model.FindYearMerchantByIDInput) (*model.APIResponseMerchantMonthlyTotalAmount, error) {
 	year := int(input.Year)
@@ -0,0 +1,132 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_api_key_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+)
+
+// CreateMerchantAPIKey is the resolver for the createMerchantApiKey field.
+func (r *mutationResolver) CreateMerchantAPIKey(ctx context.Context, input model.CreateMerchantAPIKeyInput) (*model.APIResponseMerchantAPIKey, error) {
+	requester, err := requestedBy(ctx, r.MerchantApiKeyGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	req := requests.CreateMerchantApiKeyRequest{
+		MerchantID:  int(input.MerchantID),
+		Label:       input.Label,
+		Scopes:      input.Scopes,
+		RequestedBy: requester,
+	}
+
+	if input.ExpiresAt != nil {
+		expiresAt, err := time.Parse("2006-01-02", *input.ExpiresAt)
+		if err != nil {
+			return nil, merchant_api_key_errors.ErrGraphqlValidateCreateMerchantApiKey
+		}
+
+		req.ExpiresAt = &expiresAt
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, merchant_api_key_errors.ErrGraphqlValidateCreateMerchantApiKey
+	}
+
+	key, errResp := r.MerchantApiKeyGraphql.MerchantApiKeyService.Create(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantApiKeyGraphql.Mapping.ToGraphqlResponseMerchantApiKey("success", "Successfully created merchant api key; store the key, it is not shown again", key)
+
+	return so, nil
+}
+
+// RotateMerchantAPIKey is the resolver for the rotateMerchantApiKey field.
+func (r *mutationResolver) RotateMerchantAPIKey(ctx context.Context, input model.RotateMerchantAPIKeyInput) (*model.APIResponseMerchantAPIKey, error) {
+	requester, err := requestedBy(ctx, r.MerchantApiKeyGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	gracePeriodHours := 24
+
+	if input.GracePeriodHours != nil {
+		gracePeriodHours = int(*input.GracePeriodHours)
+	}
+
+	req := requests.RotateMerchantApiKeyRequest{
+		ApiKeyID:         int(input.ID),
+		GracePeriodHours: gracePeriodHours,
+		RequestedBy:      requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, merchant_api_key_errors.ErrGraphqlValidateRotateMerchantApiKey
+	}
+
+	key, errResp := r.MerchantApiKeyGraphql.MerchantApiKeyService.Rotate(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantApiKeyGraphql.Mapping.ToGraphqlResponseMerchantApiKey("success", "Successfully rotated merchant api key; store the key, it is not shown again", key)
+
+	return so, nil
+}
+
+// RevokeMerchantAPIKey is the resolver for the revokeMerchantApiKey field.
+func (r *mutationResolver) RevokeMerchantAPIKey(ctx context.Context, input model.FindByIDMerchantAPIKeyInput) (*model.APIResponseMerchantAPIKey, error) {
+	requester, err := requestedBy(ctx, r.MerchantApiKeyGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, merchant_api_key_errors.ErrGraphqlMerchantApiKeyInvalidID
+	}
+
+	key, errResp := r.MerchantApiKeyGraphql.MerchantApiKeyService.Revoke(id, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantApiKeyGraphql.Mapping.ToGraphqlResponseMerchantApiKey("success", "Successfully revoked merchant api key", key)
+
+	return so, nil
+}
+
+// FindMerchantAPIKeys is the resolver for the findMerchantApiKeys field.
+func (r *queryResolver) FindMerchantAPIKeys(ctx context.Context, input model.FindMerchantAPIKeysInput) (*model.APIResponseMerchantAPIKeys, error) {
+	requester, err := requestedBy(ctx, r.MerchantApiKeyGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	merchantID := int(input.MerchantID)
+
+	if merchantID == 0 {
+		return nil, merchant_errors.ErrGraphqlMerchantInvalidID
+	}
+
+	keys, errResp := r.MerchantApiKeyGraphql.MerchantApiKeyService.FindByMerchant(merchantID, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantApiKeyGraphql.Mapping.ToGraphqlResponseMerchantApiKeys("success", "merchant api keys retrieved successfully", keys)
+
+	return so, nil
+}
@@ -0,0 +1,257 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	mycontext "github.com/MamangRust/paymentgatewaygraphql/pkg/context"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_onboarding_errors"
+)
+
+// SubmitMerchantOnboarding is the resolver for the submitMerchantOnboarding field.
+func (r *mutationResolver) SubmitMerchantOnboarding(ctx context.Context, input model.SubmitMerchantOnboardingInput) (*model.APIResponseMerchantBusinessProfile, error) {
+	requester, err := requestedBy(ctx, r.MerchantOnboardingGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	req := requests.SubmitMerchantOnboardingRequest{
+		MerchantID:   int(input.MerchantID),
+		LegalName:    input.LegalName,
+		BusinessType: input.BusinessType,
+		TaxID:        input.TaxID,
+		Address:      input.Address,
+		Website:      input.Website,
+		ContactEmail: input.ContactEmail,
+		ContactPhone: input.ContactPhone,
+		RequestedBy:  requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, merchant_onboarding_errors.ErrGraphqlValidateSubmitOnboarding
+	}
+
+	profile, errResp := r.MerchantOnboardingGraphql.MerchantOnboardingService.Submit(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantOnboardingGraphql.Mapping.ToGraphqlResponseMerchantBusinessProfile("success", "Merchant onboarding submitted for review", profile)
+
+	return so, nil
+}
+
+// ApproveMerchant is the resolver for the approveMerchant field.
+func (r *mutationResolver) ApproveMerchant(ctx context.Context, input model.ReviewMerchantInput) (*model.APIResponseMerchant, error) {
+	if err := requireRole(ctx, r.MerchantOnboardingGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	uid, _ := mycontext.UserForContext(ctx)
+
+	req := requests.ReviewMerchantRequest{
+		MerchantID: int(input.MerchantID),
+		Reason:     input.Reason,
+		ReviewedBy: uid,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, merchant_onboarding_errors.ErrGraphqlValidateReviewMerchant
+	}
+
+	merchant, errResp := r.MerchantOnboardingGraphql.MerchantOnboardingService.Approve(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantGraphql.Mapping.ToGraphqlResponseMerchant("success", "Successfully approved merchant", merchant)
+
+	return so, nil
+}
+
+// RejectMerchant is the resolver for the rejectMerchant field.
+func (r *mutationResolver) RejectMerchant(ctx context.Context, input model.ReviewMerchantInput) (*model.APIResponseMerchant, error) {
+	if err := requireRole(ctx, r.MerchantOnboardingGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	uid, _ := mycontext.UserForContext(ctx)
+
+	req := requests.ReviewMerchantRequest{
+		MerchantID: int(input.MerchantID),
+		Reason:     input.Reason,
+		ReviewedBy: uid,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, merchant_onboarding_errors.ErrGraphqlValidateReviewMerchant
+	}
+
+	merchant, errResp := r.MerchantOnboardingGraphql.MerchantOnboardingService.Reject(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantGraphql.Mapping.ToGraphqlResponseMerchant("success", "Successfully rejected merchant", merchant)
+
+	return so, nil
+}
+
+// SuspendMerchant is the resolver for the suspendMerchant field.
+func (r *mutationResolver) SuspendMerchant(ctx context.Context, input model.ReviewMerchantInput) (*model.APIResponseMerchant, error) {
+	if err := requireRole(ctx, r.MerchantOnboardingGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	uid, _ := mycontext.UserForContext(ctx)
+
+	req := requests.ReviewMerchantRequest{
+		MerchantID: int(input.MerchantID),
+		Reason:     input.Reason,
+		ReviewedBy: uid,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, merchant_onboarding_errors.ErrGraphqlValidateReviewMerchant
+	}
+
+	merchant, errResp := r.MerchantOnboardingGraphql.MerchantOnboardingService.Suspend(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantGraphql.Mapping.ToGraphqlResponseMerchant("success", "Successfully suspended merchant", merchant)
+
+	return so, nil
+}
+
+// ReactivateMerchant is the resolver for the reactivateMerchant field.
+func (r *mutationResolver) ReactivateMerchant(ctx context.Context, input model.ReviewMerchantInput) (*model.APIResponseMerchant, error) {
+	if err := requireRole(ctx, r.MerchantOnboardingGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	uid, _ := mycontext.UserForContext(ctx)
+
+	req := requests.ReviewMerchantRequest{
+		MerchantID: int(input.MerchantID),
+		Reason:     input.Reason,
+		ReviewedBy: uid,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, merchant_onboarding_errors.ErrGraphqlValidateReviewMerchant
+	}
+
+	merchant, errResp := r.MerchantOnboardingGraphql.MerchantOnboardingService.Reactivate(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantGraphql.Mapping.ToGraphqlResponseMerchant("success", "Successfully reactivated merchant", merchant)
+
+	return so, nil
+}
+
+// TerminateMerchant is the resolver for the terminateMerchant field.
+func (r *mutationResolver) TerminateMerchant(ctx context.Context, input model.ReviewMerchantInput) (*model.APIResponseMerchant, error) {
+	if err := requireRole(ctx, r.MerchantOnboardingGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	uid, _ := mycontext.UserForContext(ctx)
+
+	req := requests.ReviewMerchantRequest{
+		MerchantID: int(input.MerchantID),
+		Reason:     input.Reason,
+		ReviewedBy: uid,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, merchant_onboarding_errors.ErrGraphqlValidateReviewMerchant
+	}
+
+	merchant, errResp := r.MerchantOnboardingGraphql.MerchantOnboardingService.Terminate(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantGraphql.Mapping.ToGraphqlResponseMerchant("success", "Successfully terminated merchant", merchant)
+
+	return so, nil
+}
+
+// FindMerchantBusinessProfile is the resolver for the findMerchantBusinessProfile field.
+func (r *queryResolver) FindMerchantBusinessProfile(ctx context.Context, input model.FindByIDMerchantInput) (*model.APIResponseMerchantBusinessProfile, error) {
+	requester, err := requestedBy(ctx, r.MerchantOnboardingGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, merchant_errors.ErrGraphqlMerchantInvalidID
+	}
+
+	profile, errResp := r.MerchantOnboardingGraphql.MerchantOnboardingService.FindBusinessProfile(id, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantOnboardingGraphql.Mapping.ToGraphqlResponseMerchantBusinessProfile("success", "merchant business profile retrieved successfully", profile)
+
+	return so, nil
+}
+
+// FindMerchantsByStatus is the resolver for the findMerchantsByStatus field.
+func (r *queryResolver) FindMerchantsByStatus(ctx context.Context, input model.FindMerchantsByStatusInput) (*model.APIResponseMerchantPagination, error) {
+	if err := requireRole(ctx, r.MerchantOnboardingGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+
+	if input.Page != nil && *input.Page > 0 {
+		page = int(*input.Page)
+	}
+	if input.PageSize != nil && *input.PageSize > 0 {
+		pageSize = int(*input.PageSize)
+	}
+
+	req := requests.FindMerchantsByStatus{
+		Status:   input.Status,
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, merchant_onboarding_errors.ErrGraphqlValidateFindMerchantsByStatus
+	}
+
+	merchants, totalRecords, errResp := r.MerchantOnboardingGraphql.MerchantOnboardingService.FindByStatus(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.MerchantGraphql.Mapping.ToGraphqlResponsePaginationMerchant("success", "merchants retrieved successfully", merchants, paginationMeta)
+
+	return so, nil
+}
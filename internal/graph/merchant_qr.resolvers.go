@@ -0,0 +1,112 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	mycontext "github.com/MamangRust/paymentgatewaygraphql/pkg/context"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/qr_payment_errors"
+)
+
+// PayQR is the resolver for the payQr field.
+func (r *mutationResolver) PayQR(ctx context.Context, input model.PayQRInput) (*model.APIResponseTransaction, error) {
+	uid, ok := mycontext.UserForContext(ctx)
+	if !ok || uid == 0 {
+		return nil, fmt.Errorf("unauthorized: user ID not found in request context")
+	}
+
+	req := requests.PayQrRequest{
+		Payload:       input.Payload,
+		CardNumber:    input.CardNumber,
+		PaymentMethod: input.PaymentMethod,
+		UserID:        uid,
+	}
+
+	if input.Amount != nil {
+		req.Amount = int(*input.Amount)
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, qr_payment_errors.ErrGraphqlValidatePayQr
+	}
+
+	payload := input
+	payload.IdempotencyKey = nil
+
+	return withIdempotency(ctx, r.TransactionGraphql.IdempotencyKeyService, requests.IdempotencyScopePayQr, input.IdempotencyKey, payload, func() (*model.APIResponseTransaction, error) {
+		res, errResp := r.TransactionGraphql.TransactionService.PayQr(&req)
+		if errResp != nil {
+			return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+		}
+
+		so := r.TransactionGraphql.Mapping.ToGraphqlResponseTransaction("success", "Successfully paid QR code", res)
+		return so, nil
+	})
+}
+
+// GenerateMerchantQR is the resolver for the generateMerchantQr field.
+func (r *queryResolver) GenerateMerchantQR(ctx context.Context, input model.GenerateMerchantQRInput) (*model.APIResponseMerchantQR, error) {
+	requester, err := requestedBy(ctx, r.TransactionGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	req := requests.GenerateMerchantQrRequest{
+		MerchantID:  int(input.MerchantID),
+		RequestedBy: requester,
+	}
+
+	if input.Amount != nil {
+		req.Amount = int(*input.Amount)
+	}
+	if input.Reference != nil {
+		req.Reference = *input.Reference
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, qr_payment_errors.ErrGraphqlValidateGenerateMerchantQr
+	}
+
+	qr, errResp := r.TransactionGraphql.TransactionService.GenerateMerchantQr(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.TransactionGraphql.Mapping.ToGraphqlResponseMerchantQr("success", "Successfully generated merchant QR code", qr)
+
+	return so, nil
+}
+
+// FindQRPayment is the resolver for the findQrPayment field.
+func (r *queryResolver) FindQRPayment(ctx context.Context, input model.FindQRPaymentInput) (*model.APIResponseTransaction, error) {
+	requester, err := requestedBy(ctx, r.TransactionGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	req := requests.FindQrPaymentRequest{
+		MerchantID:  int(input.MerchantID),
+		Reference:   input.Reference,
+		RequestedBy: requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, qr_payment_errors.ErrGraphqlValidateFindQrPayment
+	}
+
+	res, errResp := r.TransactionGraphql.TransactionService.FindQrPayment(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.TransactionGraphql.Mapping.ToGraphqlResponseTransaction("success", "QR payment retrieved successfully", res)
+
+	return so, nil
+}
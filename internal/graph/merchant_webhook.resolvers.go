@@ -0,0 +1,234 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_webhook_errors"
+)
+
+// CreateMerchantWebhookEndpoint is the resolver for the createMerchantWebhookEndpoint field.
+func (r *mutationResolver) CreateMerchantWebhookEndpoint(ctx context.Context, input model.CreateMerchantWebhookEndpointInput) (*model.APIResponseMerchantWebhookEndpoint, error) {
+	requester, err := requestedBy(ctx, r.MerchantWebhookGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	req := requests.CreateMerchantWebhookEndpointRequest{
+		MerchantID:  int(input.MerchantID),
+		URL:         input.URL,
+		Events:      input.Events,
+		RequestedBy: requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, merchant_webhook_errors.ErrGraphqlValidateCreateMerchantWebhook
+	}
+
+	endpoint, errResp := r.MerchantWebhookGraphql.MerchantWebhookService.CreateEndpoint(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantWebhookGraphql.Mapping.ToGraphqlResponseMerchantWebhookEndpoint("success", "Successfully created merchant webhook endpoint; store the secret, it is not shown again", endpoint)
+
+	return so, nil
+}
+
+// UpdateMerchantWebhookEndpoint is the resolver for the updateMerchantWebhookEndpoint field.
+func (r *mutationResolver) UpdateMerchantWebhookEndpoint(ctx context.Context, input model.UpdateMerchantWebhookEndpointInput) (*model.APIResponseMerchantWebhookEndpoint, error) {
+	requester, err := requestedBy(ctx, r.MerchantWebhookGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	req := requests.UpdateMerchantWebhookEndpointRequest{
+		EndpointID:  int(input.ID),
+		URL:         input.URL,
+		Events:      input.Events,
+		IsActive:    input.IsActive,
+		RequestedBy: requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, merchant_webhook_errors.ErrGraphqlValidateUpdateMerchantWebhook
+	}
+
+	endpoint, errResp := r.MerchantWebhookGraphql.MerchantWebhookService.UpdateEndpoint(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantWebhookGraphql.Mapping.ToGraphqlResponseMerchantWebhookEndpoint("success", "Successfully updated merchant webhook endpoint", endpoint)
+
+	return so, nil
+}
+
+// DeleteMerchantWebhookEndpoint is the resolver for the deleteMerchantWebhookEndpoint field.
+func (r *mutationResolver) DeleteMerchantWebhookEndpoint(ctx context.Context, input model.FindByIDMerchantWebhookEndpointInput) (*model.APIResponseMerchantWebhookEndpoint, error) {
+	requester, err := requestedBy(ctx, r.MerchantWebhookGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, merchant_webhook_errors.ErrGraphqlMerchantWebhookEndpointInvalidID
+	}
+
+	endpoint, errResp := r.MerchantWebhookGraphql.MerchantWebhookService.DeleteEndpoint(id, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantWebhookGraphql.Mapping.ToGraphqlResponseMerchantWebhookEndpoint("success", "Successfully deleted merchant webhook endpoint", endpoint)
+
+	return so, nil
+}
+
+// SendMerchantWebhookTest is the resolver for the sendMerchantWebhookTest field.
+func (r *mutationResolver) SendMerchantWebhookTest(ctx context.Context, input model.FindByIDMerchantWebhookEndpointInput) (*model.APIResponseMerchantWebhookDelivery, error) {
+	requester, err := requestedBy(ctx, r.MerchantWebhookGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, merchant_webhook_errors.ErrGraphqlMerchantWebhookEndpointInvalidID
+	}
+
+	delivery, errResp := r.MerchantWebhookGraphql.MerchantWebhookService.SendTest(id, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantWebhookGraphql.Mapping.ToGraphqlResponseMerchantWebhookDelivery("success", "Test event sent", delivery)
+
+	return so, nil
+}
+
+// RedeliverMerchantWebhook is the resolver for the redeliverMerchantWebhook field.
+func (r *mutationResolver) RedeliverMerchantWebhook(ctx context.Context, input model.FindByIDMerchantWebhookDeliveryInput) (*model.APIResponseMerchantWebhookDelivery, error) {
+	requester, err := requestedBy(ctx, r.MerchantWebhookGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, merchant_webhook_errors.ErrGraphqlMerchantWebhookDeliveryInvalidID
+	}
+
+	delivery, errResp := r.MerchantWebhookGraphql.MerchantWebhookService.Redeliver(id, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantWebhookGraphql.Mapping.ToGraphqlResponseMerchantWebhookDelivery("success", "Event redelivered", delivery)
+
+	return so, nil
+}
+
+// FindMerchantWebhookEndpoints is the resolver for the findMerchantWebhookEndpoints field.
+func (r *queryResolver) FindMerchantWebhookEndpoints(ctx context.Context, input model.FindMerchantWebhookEndpointsInput) (*model.APIResponseMerchantWebhookEndpoints, error) {
+	requester, err := requestedBy(ctx, r.MerchantWebhookGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	merchantID := int(input.MerchantID)
+
+	if merchantID == 0 {
+		return nil, merchant_errors.ErrGraphqlMerchantInvalidID
+	}
+
+	endpoints, errResp := r.MerchantWebhookGraphql.MerchantWebhookService.FindEndpoints(merchantID, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantWebhookGraphql.Mapping.ToGraphqlResponseMerchantWebhookEndpoints("success", "merchant webhook endpoints retrieved successfully", endpoints)
+
+	return so, nil
+}
+
+// FindMerchantWebhookDeliveries is the resolver for the findMerchantWebhookDeliveries field.
+func (r *queryResolver) FindMerchantWebhookDeliveries(ctx context.Context, input model.FindMerchantWebhookDeliveriesInput) (*model.APIResponsePaginationMerchantWebhookDelivery, error) {
+	requester, err := requestedBy(ctx, r.MerchantWebhookGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+
+	if input.Page != nil && *input.Page > 0 {
+		page = int(*input.Page)
+	}
+	if input.PageSize != nil && *input.PageSize > 0 {
+		pageSize = int(*input.PageSize)
+	}
+
+	req := requests.FindMerchantWebhookDeliveries{
+		EndpointID:  int(input.EndpointID),
+		Page:        page,
+		PageSize:    pageSize,
+		RequestedBy: requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, merchant_webhook_errors.ErrGraphqlValidateMerchantWebhookDelivery
+	}
+
+	deliveries, totalRecords, errResp := r.MerchantWebhookGraphql.MerchantWebhookService.FindDeliveries(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.MerchantWebhookGraphql.Mapping.ToGraphqlResponsePaginationMerchantWebhookDelivery("success", "merchant webhook deliveries retrieved successfully", deliveries, paginationMeta)
+
+	return so, nil
+}
+
+// FindMerchantWebhookDeliveryAttempts is the resolver for the findMerchantWebhookDeliveryAttempts field.
+func (r *queryResolver) FindMerchantWebhookDeliveryAttempts(ctx context.Context, input model.FindByIDMerchantWebhookDeliveryInput) (*model.APIResponseMerchantWebhookDeliveryAttempts, error) {
+	requester, err := requestedBy(ctx, r.MerchantWebhookGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, merchant_webhook_errors.ErrGraphqlMerchantWebhookDeliveryInvalidID
+	}
+
+	attempts, errResp := r.MerchantWebhookGraphql.MerchantWebhookService.FindDeliveryAttempts(id, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.MerchantWebhookGraphql.Mapping.ToGraphqlResponseMerchantWebhookDeliveryAttempts("success", "merchant webhook delivery log retrieved successfully", attempts)
+
+	return so, nil
+}
@@ -2,6 +2,23 @@
 
 package model
 
+type AcknowledgeBalanceDiscrepancyInput struct {
+	ID   int32   `json:"id"`
+	Note *string `json:"note,omitempty"`
+}
+
+type APIResponseAuthorization struct {
+	Status  string                 `json:"status"`
+	Message string                 `json:"message"`
+	Data    *AuthorizationResponse `json:"data,omitempty"`
+}
+
+type APIResponseBalanceDiscrepancy struct {
+	Status  string                      `json:"status"`
+	Message string                      `json:"message"`
+	Data    *BalanceDiscrepancyResponse `json:"data,omitempty"`
+}
+
 type APIResponseCard struct {
 	Status  string        `json:"status"`
 	Message string        `json:"message"`
@@ -36,12 +53,72 @@ type APIResponseDashboardCardNumber struct {
 	Data    *CardDashboardByNumberResponse `json:"data,omitempty"`
 }
 
+type APIResponseDispute struct {
+	Status  string           `json:"status"`
+	Message string           `json:"message"`
+	Data    *DisputeResponse `json:"data,omitempty"`
+}
+
+type APIResponseExchangeRate struct {
+	Status  string                `json:"status"`
+	Message string                `json:"message"`
+	Data    *ExchangeRateResponse `json:"data,omitempty"`
+}
+
+type APIResponseFeeMonthAmount struct {
+	Status  string                    `json:"status"`
+	Message string                    `json:"message"`
+	Data    []*FeeMonthAmountResponse `json:"data,omitempty"`
+}
+
+type APIResponseFeeSchedule struct {
+	Status  string               `json:"status"`
+	Message string               `json:"message"`
+	Data    *FeeScheduleResponse `json:"data,omitempty"`
+}
+
+type APIResponseFeeYearAmount struct {
+	Status  string                   `json:"status"`
+	Message string                   `json:"message"`
+	Data    []*FeeYearAmountResponse `json:"data,omitempty"`
+}
+
 type APIResponseGetMe struct {
 	Status  string        `json:"status"`
 	Message string        `json:"message"`
 	Data    *UserResponse `json:"data,omitempty"`
 }
 
+type APIResponseInvoice struct {
+	Status  string           `json:"status"`
+	Message string           `json:"message"`
+	Data    *InvoiceResponse `json:"data,omitempty"`
+}
+
+type APIResponseInvoicePayment struct {
+	Status  string                  `json:"status"`
+	Message string                  `json:"message"`
+	Data    *InvoicePaymentResponse `json:"data,omitempty"`
+}
+
+type APIResponseInvoicePayments struct {
+	Status  string                    `json:"status"`
+	Message string                    `json:"message"`
+	Data    []*InvoicePaymentResponse `json:"data,omitempty"`
+}
+
+type APIResponseLedgerBalance struct {
+	Status  string                 `json:"status"`
+	Message string                 `json:"message"`
+	Data    *LedgerBalanceResponse `json:"data,omitempty"`
+}
+
+type APIResponseLedgerJournal struct {
+	Status  string                 `json:"status"`
+	Message string                 `json:"message"`
+	Data    *LedgerJournalResponse `json:"data,omitempty"`
+}
+
 type APIResponseLogin struct {
 	Status  string         `json:"status"`
 	Message string         `json:"message"`
@@ -59,6 +136,36 @@ type APIResponseMerchantAll struct {
 	Message string `json:"message"`
 }
 
+type APIResponseMerchantAPIKey struct {
+	Status  string                  `json:"status"`
+	Message string                  `json:"message"`
+	Data    *MerchantAPIKeyResponse `json:"data,omitempty"`
+}
+
+type APIResponseMerchantAPIKeys struct {
+	Status  string                    `json:"status"`
+	Message string                    `json:"message"`
+	Data    []*MerchantAPIKeyResponse `json:"data,omitempty"`
+}
+
+type APIResponseMerchantBalance struct {
+	Status  string                   `json:"status"`
+	Message string                   `json:"message"`
+	Data    *MerchantBalanceResponse `json:"data,omitempty"`
+}
+
+type APIResponseMerchantBalances struct {
+	Status  string                     `json:"status"`
+	Message string                     `json:"message"`
+	Data    []*MerchantBalanceResponse `json:"data,omitempty"`
+}
+
+type APIResponseMerchantBusinessProfile struct {
+	Status  string                           `json:"status"`
+	Message string                           `json:"message"`
+	Data    *MerchantBusinessProfileResponse `json:"data,omitempty"`
+}
+
 type APIResponseMerchantDelete struct {
 	Status  string `json:"status"`
 	Message string `json:"message"`
@@ -83,6 +190,12 @@ type APIResponseMerchantMonthlyAmount struct {
 	Data    []*MerchantMonthlyAmountResponse `json:"data"`
 }
 
+type APIResponseMerchantMonthlyDisputeRate struct {
+	Status  string                                `json:"status"`
+	Message string                                `json:"message"`
+	Data    []*MerchantMonthlyDisputeRateResponse `json:"data"`
+}
+
 type APIResponseMerchantMonthlyPaymentMethod struct {
 	Status  string                                  `json:"status"`
 	Message string                                  `json:"message"`
@@ -102,6 +215,12 @@ type APIResponseMerchantPagination struct {
 	Pagination *PaginationMeta     `json:"pagination,omitempty"`
 }
 
+type APIResponseMerchantQR struct {
+	Status  string              `json:"status"`
+	Message string              `json:"message"`
+	Data    *MerchantQRResponse `json:"data,omitempty"`
+}
+
 type APIResponseMerchantTransactionPagination struct {
 	Status     string                         `json:"status"`
 	Message    string                         `json:"message"`
@@ -109,12 +228,42 @@ type APIResponseMerchantTransactionPagination struct {
 	Pagination *PaginationMeta                `json:"pagination,omitempty"`
 }
 
+type APIResponseMerchantWebhookDelivery struct {
+	Status  string                           `json:"status"`
+	Message string                           `json:"message"`
+	Data    *MerchantWebhookDeliveryResponse `json:"data,omitempty"`
+}
+
+type APIResponseMerchantWebhookDeliveryAttempts struct {
+	Status  string                                    `json:"status"`
+	Message string                                    `json:"message"`
+	Data    []*MerchantWebhookDeliveryAttemptResponse `json:"data,omitempty"`
+}
+
+type APIResponseMerchantWebhookEndpoint struct {
+	Status  string                           `json:"status"`
+	Message string                           `json:"message"`
+	Data    *MerchantWebhookEndpointResponse `json:"data,omitempty"`
+}
+
+type APIResponseMerchantWebhookEndpoints struct {
+	Status  string                             `json:"status"`
+	Message string                             `json:"message"`
+	Data    []*MerchantWebhookEndpointResponse `json:"data,omitempty"`
+}
+
 type APIResponseMerchantYearlyAmount struct {
 	Status  string                          `json:"status"`
 	Message string                          `json:"message"`
 	Data    []*MerchantYearlyAmountResponse `json:"data"`
 }
 
+type APIResponseMerchantYearlyDisputeRate struct {
+	Status  string                               `json:"status"`
+	Message string                               `json:"message"`
+	Data    []*MerchantYearlyDisputeRateResponse `json:"data"`
+}
+
 type APIResponseMerchantYearlyPaymentMethod struct {
 	Status  string                                 `json:"status"`
 	Message string                                 `json:"message"`
@@ -151,6 +300,20 @@ type APIResponseMonthlyBalance struct {
 	Data    []*CardMonthlyBalanceResponse `json:"data"`
 }
 
+type APIResponsePaginationAuthorization struct {
+	Status     string                   `json:"status"`
+	Message    string                   `json:"message"`
+	Data       []*AuthorizationResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta          `json:"pagination,omitempty"`
+}
+
+type APIResponsePaginationBalanceDiscrepancy struct {
+	Status     string                        `json:"status"`
+	Message    string                        `json:"message"`
+	Data       []*BalanceDiscrepancyResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta               `json:"pagination,omitempty"`
+}
+
 type APIResponsePaginationCard struct {
 	Status     string          `json:"status"`
 	Message    string          `json:"message"`
@@ -165,6 +328,76 @@ type APIResponsePaginationCardDeleteAt struct {
 	Pagination *PaginationMeta         `json:"pagination"`
 }
 
+type APIResponsePaginationDispute struct {
+	Status     string             `json:"status"`
+	Message    string             `json:"message"`
+	Data       []*DisputeResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta    `json:"pagination,omitempty"`
+}
+
+type APIResponsePaginationExchangeRate struct {
+	Status     string                  `json:"status"`
+	Message    string                  `json:"message"`
+	Data       []*ExchangeRateResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta         `json:"pagination,omitempty"`
+}
+
+type APIResponsePaginationFeeSchedule struct {
+	Status     string                 `json:"status"`
+	Message    string                 `json:"message"`
+	Data       []*FeeScheduleResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta        `json:"pagination,omitempty"`
+}
+
+type APIResponsePaginationInvoice struct {
+	Status     string             `json:"status"`
+	Message    string             `json:"message"`
+	Data       []*InvoiceResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta    `json:"pagination,omitempty"`
+}
+
+type APIResponsePaginationLedgerCardPosting struct {
+	Status     string                       `json:"status"`
+	Message    string                       `json:"message"`
+	Data       []*LedgerCardPostingResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta              `json:"pagination,omitempty"`
+}
+
+type APIResponsePaginationLedgerJournal struct {
+	Status     string                   `json:"status"`
+	Message    string                   `json:"message"`
+	Data       []*LedgerJournalResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta          `json:"pagination,omitempty"`
+}
+
+type APIResponsePaginationMerchantWebhookDelivery struct {
+	Status     string                             `json:"status"`
+	Message    string                             `json:"message"`
+	Data       []*MerchantWebhookDeliveryResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta                    `json:"pagination,omitempty"`
+}
+
+type APIResponsePaginationProviderWebhookEvent struct {
+	Status     string                          `json:"status"`
+	Message    string                          `json:"message"`
+	Data       []*ProviderWebhookEventResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta                 `json:"pagination,omitempty"`
+}
+
+type APIResponsePaginationReconciliationRun struct {
+	Status     string                       `json:"status"`
+	Message    string                       `json:"message"`
+	Data       []*ReconciliationRunResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta              `json:"pagination,omitempty"`
+}
+
+type APIResponsePaginationRefund struct {
+	Status     string            `json:"status"`
+	Message    string            `json:"message"`
+	Data       []*RefundResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta   `json:"pagination,omitempty"`
+}
+
 type APIResponsePaginationRole struct {
 	Status     string          `json:"status"`
 	Message    string          `json:"message"`
@@ -193,6 +426,27 @@ type APIResponsePaginationSaldoDeleteAt struct {
 	Pagination *PaginationMeta          `json:"pagination,omitempty"`
 }
 
+type APIResponsePaginationScheduledTransfer struct {
+	Status     string                       `json:"status"`
+	Message    string                       `json:"message"`
+	Data       []*ScheduledTransferResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta              `json:"pagination,omitempty"`
+}
+
+type APIResponsePaginationScheduledTransferRun struct {
+	Status     string                          `json:"status"`
+	Message    string                          `json:"message"`
+	Data       []*ScheduledTransferRunResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta                 `json:"pagination,omitempty"`
+}
+
+type APIResponsePaginationSettlementBatch struct {
+	Status     string                     `json:"status"`
+	Message    string                     `json:"message"`
+	Data       []*SettlementBatchResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta            `json:"pagination,omitempty"`
+}
+
 type APIResponsePaginationTopup struct {
 	Status     string           `json:"status"`
 	Message    string           `json:"message"`
@@ -221,6 +475,13 @@ type APIResponsePaginationTransactionDeleteAt struct {
 	Pagination *PaginationMeta                `json:"pagination,omitempty"`
 }
 
+type APIResponsePaginationTransactionLimit struct {
+	Status     string                      `json:"status"`
+	Message    string                      `json:"message"`
+	Data       []*TransactionLimitResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta             `json:"pagination,omitempty"`
+}
+
 type APIResponsePaginationTransfer struct {
 	Status     string              `json:"status"`
 	Message    string              `json:"message"`
@@ -228,6 +489,20 @@ type APIResponsePaginationTransfer struct {
 	Pagination *PaginationMeta     `json:"pagination,omitempty"`
 }
 
+type APIResponsePaginationTransferBatch struct {
+	Status     string                   `json:"status"`
+	Message    string                   `json:"message"`
+	Data       []*TransferBatchResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta          `json:"pagination,omitempty"`
+}
+
+type APIResponsePaginationTransferBatchRow struct {
+	Status     string                      `json:"status"`
+	Message    string                      `json:"message"`
+	Data       []*TransferBatchRowResponse `json:"data,omitempty"`
+	Pagination *PaginationMeta             `json:"pagination,omitempty"`
+}
+
 type APIResponsePaginationTransferDeleteAt struct {
 	Status     string                      `json:"status"`
 	Message    string                      `json:"message"`
@@ -263,12 +538,24 @@ type APIResponsePaginationWithdrawDeleteAt struct {
 	Pagination *PaginationMeta             `json:"pagination,omitempty"`
 }
 
+type APIResponseProviderWebhookEvent struct {
+	Status  string                        `json:"status"`
+	Message string                        `json:"message"`
+	Data    *ProviderWebhookEventResponse `json:"data,omitempty"`
+}
+
 type APIResponseRefreshToken struct {
 	Status  string         `json:"status"`
 	Message string         `json:"message"`
 	Data    *TokenResponse `json:"data,omitempty"`
 }
 
+type APIResponseRefund struct {
+	Status  string          `json:"status"`
+	Message string          `json:"message"`
+	Data    *RefundResponse `json:"data,omitempty"`
+}
+
 type APIResponseRegister struct {
 	Status  string        `json:"status"`
 	Message string        `json:"message"`
@@ -307,6 +594,12 @@ type APIResponseSaldoDelete struct {
 	Message string `json:"message"`
 }
 
+type APIResponseSaldoHold struct {
+	Status  string             `json:"status"`
+	Message string             `json:"message"`
+	Data    *SaldoHoldResponse `json:"data,omitempty"`
+}
+
 type APIResponseSaldoResponse struct {
 	Status  string         `json:"status"`
 	Message string         `json:"message"`
@@ -319,6 +612,30 @@ type APIResponseSaldoResponseDeleteAt struct {
 	Data    *SaldoResponseDeleteAt `json:"data,omitempty"`
 }
 
+type APIResponseScheduledTransfer struct {
+	Status  string                     `json:"status"`
+	Message string                     `json:"message"`
+	Data    *ScheduledTransferResponse `json:"data,omitempty"`
+}
+
+type APIResponseSettlementBatch struct {
+	Status  string                   `json:"status"`
+	Message string                   `json:"message"`
+	Data    *SettlementBatchResponse `json:"data,omitempty"`
+}
+
+type APIResponseSettlementItems struct {
+	Status  string                    `json:"status"`
+	Message string                    `json:"message"`
+	Data    []*SettlementItemResponse `json:"data,omitempty"`
+}
+
+type APIResponseStatusHistory struct {
+	Status  string                   `json:"status"`
+	Message string                   `json:"message"`
+	Data    []*StatusHistoryResponse `json:"data,omitempty"`
+}
+
 type APIResponseTopup struct {
 	Status  string         `json:"status"`
 	Message string         `json:"message"`
@@ -411,6 +728,18 @@ type APIResponseTransactionDeleteAt struct {
 	Data    *TransactionResponseDeleteAt `json:"data,omitempty"`
 }
 
+type APIResponseTransactionLimit struct {
+	Status  string                    `json:"status"`
+	Message string                    `json:"message"`
+	Data    *TransactionLimitResponse `json:"data,omitempty"`
+}
+
+type APIResponseTransactionLimitHeadroom struct {
+	Status  string                              `json:"status"`
+	Message string                              `json:"message"`
+	Data    []*TransactionLimitHeadroomResponse `json:"data,omitempty"`
+}
+
 type APIResponseTransactionMonthAmount struct {
 	Status  string                            `json:"status"`
 	Message string                            `json:"message"`
@@ -476,6 +805,18 @@ type APIResponseTransferAll struct {
 	Message string `json:"message"`
 }
 
+type APIResponseTransferBatch struct {
+	Status  string                 `json:"status"`
+	Message string                 `json:"message"`
+	Data    *TransferBatchResponse `json:"data,omitempty"`
+}
+
+type APIResponseTransferBatchReport struct {
+	Status  string                       `json:"status"`
+	Message string                       `json:"message"`
+	Data    *TransferBatchReportResponse `json:"data,omitempty"`
+}
+
 type APIResponseTransferDelete struct {
 	Status  string `json:"status"`
 	Message string `json:"message"`
@@ -591,6 +932,12 @@ type APIResponseWithdrawMonthStatusSuccess struct {
 	Data    []*WithdrawMonthStatusSuccessResponse `json:"data"`
 }
 
+type APIResponseWithdrawReview struct {
+	Status  string                  `json:"status"`
+	Message string                  `json:"message"`
+	Data    *WithdrawReviewResponse `json:"data,omitempty"`
+}
+
 type APIResponseWithdrawYearAmount struct {
 	Status  string                          `json:"status"`
 	Message string                          `json:"message"`
@@ -639,6 +986,12 @@ type APIResponsesMerchant struct {
 	Data    []*MerchantResponse `json:"data"`
 }
 
+type APIResponsesRefund struct {
+	Status  string            `json:"status"`
+	Message string            `json:"message"`
+	Data    []*RefundResponse `json:"data,omitempty"`
+}
+
 type APIResponsesRole struct {
 	Status  string          `json:"status"`
 	Message string          `json:"message"`
@@ -651,6 +1004,12 @@ type APIResponsesSaldo struct {
 	Data    []*SaldoResponse `json:"data,omitempty"`
 }
 
+type APIResponsesSaldoHold struct {
+	Status  string               `json:"status"`
+	Message string               `json:"message"`
+	Data    []*SaldoHoldResponse `json:"data,omitempty"`
+}
+
 type APIResponsesTopup struct {
 	Status  string           `json:"status"`
 	Message string           `json:"message"`
@@ -669,30 +1028,104 @@ type APIResponsesWithdraw struct {
 	Data    []*WithdrawResponse `json:"data"`
 }
 
+type AuthorizationResponse struct {
+	ID              int32   `json:"id"`
+	AuthorizationNo string  `json:"authorization_no"`
+	CardNumber      string  `json:"card_number"`
+	MerchantID      int32   `json:"merchant_id"`
+	Amount          int32   `json:"amount"`
+	Currency        string  `json:"currency"`
+	CapturedAmount  int32   `json:"captured_amount"`
+	PaymentMethod   string  `json:"payment_method"`
+	Status          string  `json:"status"`
+	TransactionID   *int32  `json:"transaction_id,omitempty"`
+	ExpiresAt       string  `json:"expires_at"`
+	CapturedAt      *string `json:"captured_at,omitempty"`
+	VoidedAt        *string `json:"voided_at,omitempty"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+type AuthorizeTransactionInput struct {
+	// Only needed when the request is not signed. Prefer signing the request with
+	// the key's signing secret, see MerchantApiKeyResponse.signing_secret.
+	APIKey         *string `json:"api_key,omitempty"`
+	CardNumber     string  `json:"card_number"`
+	Amount         int32   `json:"amount"`
+	PaymentMethod  string  `json:"payment_method"`
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
+}
+
+type BalanceDiscrepancyResponse struct {
+	ID                  int32   `json:"id"`
+	CardNumber          string  `json:"card_number"`
+	ReconciliationRunID int32   `json:"reconciliation_run_id"`
+	SaldoBalance        int32   `json:"saldo_balance"`
+	ExpectedBalance     int32   `json:"expected_balance"`
+	LedgerBalance       int32   `json:"ledger_balance"`
+	Difference          int32   `json:"difference"`
+	TopupAmount         int32   `json:"topup_amount"`
+	WithdrawAmount      int32   `json:"withdraw_amount"`
+	TransferOutAmount   int32   `json:"transfer_out_amount"`
+	TransferInAmount    int32   `json:"transfer_in_amount"`
+	PaymentAmount       int32   `json:"payment_amount"`
+	SettlementAmount    int32   `json:"settlement_amount"`
+	OtherAmount         int32   `json:"other_amount"`
+	Status              string  `json:"status"`
+	AcknowledgedBy      *int32  `json:"acknowledged_by,omitempty"`
+	AcknowledgedAt      *string `json:"acknowledged_at,omitempty"`
+	Note                *string `json:"note,omitempty"`
+	ResolvedAt          *string `json:"resolved_at,omitempty"`
+	CreatedAt           string  `json:"created_at"`
+	UpdatedAt           string  `json:"updated_at"`
+}
+
+type CaptureTransactionInput struct {
+	// Only needed when the request is not signed. Prefer signing the request with
+	// the key's signing secret, see MerchantApiKeyResponse.signing_secret.
+	APIKey          *string `json:"api_key,omitempty"`
+	AuthorizationID int32   `json:"authorization_id"`
+	Amount          *int32  `json:"amount,omitempty"`
+	IdempotencyKey  *string `json:"idempotency_key,omitempty"`
+}
+
 type CardDashboardByNumberResponse struct {
-	TotalBalance          int32 `json:"total_balance"`
-	TotalTopup            int32 `json:"total_topup"`
-	TotalWithdraw         int32 `json:"total_withdraw"`
-	TotalTransaction      int32 `json:"total_transaction"`
-	TotalTransferSend     int32 `json:"total_transfer_send"`
-	TotalTransferReceiver int32 `json:"total_transfer_receiver"`
+	Currency              string `json:"currency"`
+	TotalBalance          int32  `json:"total_balance"`
+	TotalTopup            int32  `json:"total_topup"`
+	TotalWithdraw         int32  `json:"total_withdraw"`
+	TotalTransaction      int32  `json:"total_transaction"`
+	TotalTransferSend     int32  `json:"total_transfer_send"`
+	TotalTransferReceiver int32  `json:"total_transfer_receiver"`
+}
+
+type CardDashboardCurrencyResponse struct {
+	Currency         string `json:"currency"`
+	TotalBalance     int32  `json:"total_balance"`
+	TotalTopup       int32  `json:"total_topup"`
+	TotalWithdraw    int32  `json:"total_withdraw"`
+	TotalTransaction int32  `json:"total_transaction"`
+	TotalTransfer    int32  `json:"total_transfer"`
 }
 
 type CardDashboardResponse struct {
-	TotalBalance     int32 `json:"total_balance"`
-	TotalTopup       int32 `json:"total_topup"`
-	TotalWithdraw    int32 `json:"total_withdraw"`
-	TotalTransaction int32 `json:"total_transaction"`
-	TotalTransfer    int32 `json:"total_transfer"`
+	TotalBalance     int32                            `json:"total_balance"`
+	TotalTopup       int32                            `json:"total_topup"`
+	TotalWithdraw    int32                            `json:"total_withdraw"`
+	TotalTransaction int32                            `json:"total_transaction"`
+	TotalTransfer    int32                            `json:"total_transfer"`
+	Currencies       []*CardDashboardCurrencyResponse `json:"currencies"`
 }
 
 type CardMonthlyAmountResponse struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
 type CardMonthlyBalanceResponse struct {
 	Month        string `json:"month"`
+	Currency     string `json:"currency"`
 	TotalBalance int32  `json:"total_balance"`
 }
 
@@ -704,6 +1137,7 @@ type CardResponse struct {
 	ExpireDate   string `json:"expire_date"`
 	Cvv          string `json:"cvv"`
 	CardProvider string `json:"card_provider"`
+	Currency     string `json:"currency"`
 	CreatedAt    string `json:"created_at"`
 	UpdatedAt    string `json:"updated_at"`
 }
@@ -716,6 +1150,7 @@ type CardResponseDeleteAt struct {
 	ExpireDate   string  `json:"expire_date"`
 	Cvv          string  `json:"cvv"`
 	CardProvider string  `json:"card_provider"`
+	Currency     string  `json:"currency"`
 	CreatedAt    string  `json:"created_at"`
 	UpdatedAt    string  `json:"updated_at"`
 	DeletedAt    *string `json:"deleted_at,omitempty"`
@@ -723,20 +1158,64 @@ type CardResponseDeleteAt struct {
 
 type CardYearlyAmountResponse struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
 type CardYearlyBalanceResponse struct {
 	Year         string `json:"year"`
+	Currency     string `json:"currency"`
 	TotalBalance int32  `json:"total_balance"`
 }
 
 type CreateCardInput struct {
-	UserID       int32  `json:"user_id"`
-	CardType     string `json:"card_type"`
-	ExpireDate   string `json:"expire_date"`
-	Cvv          string `json:"cvv"`
-	CardProvider string `json:"card_provider"`
+	UserID       int32   `json:"user_id"`
+	CardType     string  `json:"card_type"`
+	ExpireDate   string  `json:"expire_date"`
+	Cvv          string  `json:"cvv"`
+	CardProvider string  `json:"card_provider"`
+	Currency     *string `json:"currency,omitempty"`
+}
+
+type CreateFeeScheduleInput struct {
+	Name            string                  `json:"name"`
+	TransactionType string                  `json:"transaction_type"`
+	FeeType         string                  `json:"fee_type"`
+	PaymentMethod   *string                 `json:"payment_method,omitempty"`
+	CardType        *string                 `json:"card_type,omitempty"`
+	Currency        *string                 `json:"currency,omitempty"`
+	FlatAmount      *int32                  `json:"flat_amount,omitempty"`
+	PercentageBps   *int32                  `json:"percentage_bps,omitempty"`
+	MinFee          *int32                  `json:"min_fee,omitempty"`
+	MaxFee          *int32                  `json:"max_fee,omitempty"`
+	IsActive        *bool                   `json:"is_active,omitempty"`
+	Tiers           []*FeeScheduleTierInput `json:"tiers,omitempty"`
+}
+
+type CreateInvoiceInput struct {
+	MerchantID int32 `json:"merchant_id"`
+	// invoice is paid once; payment_link takes any number of payments until it
+	// expires or is cancelled.
+	Kind        string `json:"kind"`
+	Description string `json:"description"`
+	Amount      int32  `json:"amount"`
+	// RFC 3339 time after which the invoice can no longer be paid.
+	ExpiresAt string `json:"expires_at"`
+	// Optional breakdown of the amount. The line items must add up to it.
+	LineItems []*CreateInvoiceLineItemInput `json:"line_items,omitempty"`
+}
+
+type CreateInvoiceLineItemInput struct {
+	Description string `json:"description"`
+	Quantity    int32  `json:"quantity"`
+	UnitPrice   int32  `json:"unit_price"`
+}
+
+type CreateMerchantAPIKeyInput struct {
+	MerchantID int32    `json:"merchant_id"`
+	Label      string   `json:"label"`
+	Scopes     []string `json:"scopes"`
+	ExpiresAt  *string  `json:"expires_at,omitempty"`
 }
 
 type CreateMerchantInput struct {
@@ -744,35 +1223,82 @@ type CreateMerchantInput struct {
 	UserID int32  `json:"userId"`
 }
 
+type CreateMerchantWebhookEndpointInput struct {
+	MerchantID int32    `json:"merchant_id"`
+	URL        string   `json:"url"`
+	Events     []string `json:"events"`
+}
+
 type CreateRoleInput struct {
 	Name string `json:"name"`
 }
 
+type CreateSaldoHoldInput struct {
+	CardNumber string `json:"card_number"`
+	Amount     int32  `json:"amount"`
+	Reason     string `json:"reason"`
+}
+
 type CreateSaldoInput struct {
 	CardNumber   string `json:"card_number"`
 	TotalBalance int32  `json:"total_balance"`
 }
 
+type CreateScheduledTransferInput struct {
+	TransferFrom   string  `json:"transfer_from"`
+	TransferTo     string  `json:"transfer_to"`
+	TransferAmount int32   `json:"transfer_amount"`
+	Recurrence     string  `json:"recurrence"`
+	DayOfMonth     *int32  `json:"day_of_month,omitempty"`
+	StartAt        string  `json:"start_at"`
+	EndAt          *string `json:"end_at,omitempty"`
+}
+
 type CreateTopupInput struct {
-	CardNumber  string `json:"card_number"`
-	TopupNo     string `json:"topup_no"`
-	TopupAmount int32  `json:"topup_amount"`
-	TopupMethod string `json:"topup_method"`
+	CardNumber     string  `json:"card_number"`
+	TopupNo        string  `json:"topup_no"`
+	TopupAmount    int32   `json:"topup_amount"`
+	TopupMethod    string  `json:"topup_method"`
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
+}
+
+type CreateTransactionLimitInput struct {
+	Name                    string  `json:"name"`
+	OperationType           string  `json:"operation_type"`
+	Period                  string  `json:"period"`
+	CardType                *string `json:"card_type,omitempty"`
+	UserTier                *string `json:"user_tier,omitempty"`
+	Currency                *string `json:"currency,omitempty"`
+	MaxAmountPerTransaction *int32  `json:"max_amount_per_transaction,omitempty"`
+	MaxCount                *int32  `json:"max_count,omitempty"`
+	MaxTotalAmount          *int32  `json:"max_total_amount,omitempty"`
+	IsActive                *bool   `json:"is_active,omitempty"`
 }
 
 type CreateTransactionRequest struct {
-	APIKey          string `json:"api_key"`
-	CardNumber      string `json:"card_number"`
-	Amount          int32  `json:"amount"`
-	PaymentMethod   string `json:"payment_method"`
-	MerchantID      int32  `json:"merchant_id"`
-	TransactionTime string `json:"transaction_time"`
+	// Only needed when the request is not signed. Prefer signing the request with
+	// the key's signing secret, see MerchantApiKeyResponse.signing_secret.
+	APIKey          *string `json:"api_key,omitempty"`
+	CardNumber      string  `json:"card_number"`
+	Amount          int32   `json:"amount"`
+	PaymentMethod   string  `json:"payment_method"`
+	MerchantID      int32   `json:"merchant_id"`
+	TransactionTime string  `json:"transaction_time"`
+	IdempotencyKey  *string `json:"idempotency_key,omitempty"`
+}
+
+type CreateTransferBatchInput struct {
+	TransferFrom   string  `json:"transfer_from"`
+	FileFormat     string  `json:"file_format"`
+	Content        string  `json:"content"`
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
 }
 
 type CreateTransferRequest struct {
-	TransferFrom   string `json:"transfer_from"`
-	TransferTo     string `json:"transfer_to"`
-	TransferAmount int32  `json:"transfer_amount"`
+	TransferFrom   string  `json:"transfer_from"`
+	TransferTo     string  `json:"transfer_to"`
+	TransferAmount int32   `json:"transfer_amount"`
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
 }
 
 type CreateUserInput struct {
@@ -784,12 +1310,125 @@ type CreateUserInput struct {
 }
 
 type CreateWithdrawInput struct {
-	CardNumber     string `json:"cardNumber"`
-	WithdrawAmount int32  `json:"withdrawAmount"`
-	WithdrawTime   string `json:"withdrawTime"`
+	CardNumber     string  `json:"cardNumber"`
+	WithdrawAmount int32   `json:"withdrawAmount"`
+	WithdrawTime   string  `json:"withdrawTime"`
+	IdempotencyKey *string `json:"idempotencyKey,omitempty"`
+}
+
+type DisputeResponse struct {
+	ID               int32   `json:"id"`
+	DisputeNo        string  `json:"dispute_no"`
+	TransactionID    int32   `json:"transaction_id"`
+	CardNumber       string  `json:"card_number"`
+	MerchantID       int32   `json:"merchant_id"`
+	Amount           int32   `json:"amount"`
+	Currency         string  `json:"currency"`
+	Reason           string  `json:"reason"`
+	MerchantEvidence *string `json:"merchant_evidence,omitempty"`
+	ResolutionNote   *string `json:"resolution_note,omitempty"`
+	Status           string  `json:"status"`
+	OpenedBy         int32   `json:"opened_by"`
+	ResolvedBy       *int32  `json:"resolved_by,omitempty"`
+	RespondedAt      *string `json:"responded_at,omitempty"`
+	ResolvedAt       *string `json:"resolved_at,omitempty"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+}
+
+type ExchangeRateResponse struct {
+	ID            int32   `json:"id"`
+	BaseCurrency  string  `json:"base_currency"`
+	QuoteCurrency string  `json:"quote_currency"`
+	Rate          float64 `json:"rate"`
+	SpreadBps     int32   `json:"spread_bps"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
+type FeeMonthAmountResponse struct {
+	Month           string `json:"month"`
+	TransactionType string `json:"transaction_type"`
+	Currency        string `json:"currency"`
+	TotalFee        int32  `json:"total_fee"`
+}
+
+type FeeScheduleResponse struct {
+	ID              int32                      `json:"id"`
+	Name            string                     `json:"name"`
+	TransactionType string                     `json:"transaction_type"`
+	FeeType         string                     `json:"fee_type"`
+	PaymentMethod   *string                    `json:"payment_method,omitempty"`
+	CardType        *string                    `json:"card_type,omitempty"`
+	Currency        *string                    `json:"currency,omitempty"`
+	FlatAmount      int32                      `json:"flat_amount"`
+	PercentageBps   int32                      `json:"percentage_bps"`
+	MinFee          *int32                     `json:"min_fee,omitempty"`
+	MaxFee          *int32                     `json:"max_fee,omitempty"`
+	IsActive        bool                       `json:"is_active"`
+	Tiers           []*FeeScheduleTierResponse `json:"tiers"`
+	CreatedAt       string                     `json:"created_at"`
+	UpdatedAt       string                     `json:"updated_at"`
+}
+
+type FeeScheduleTierInput struct {
+	MinAmount     int32  `json:"min_amount"`
+	FlatAmount    *int32 `json:"flat_amount,omitempty"`
+	PercentageBps *int32 `json:"percentage_bps,omitempty"`
+}
+
+type FeeScheduleTierResponse struct {
+	ID            int32 `json:"id"`
+	MinAmount     int32 `json:"min_amount"`
+	FlatAmount    int32 `json:"flat_amount"`
+	PercentageBps int32 `json:"percentage_bps"`
+}
+
+type FeeYearAmountResponse struct {
+	Year            string `json:"year"`
+	TransactionType string `json:"transaction_type"`
+	Currency        string `json:"currency"`
+	TotalFee        int32  `json:"total_fee"`
+}
+
+type FindAllAuthorizationInput struct {
+	Page     *int32  `json:"page,omitempty"`
+	PageSize *int32  `json:"page_size,omitempty"`
+	Search   *string `json:"search,omitempty"`
+}
+
+type FindAllCardInput struct {
+	Page     *int32  `json:"page,omitempty"`
+	PageSize *int32  `json:"page_size,omitempty"`
+	Search   *string `json:"search,omitempty"`
+}
+
+type FindAllDisputeByMerchantInput struct {
+	MerchantID int32   `json:"merchant_id"`
+	Page       *int32  `json:"page,omitempty"`
+	PageSize   *int32  `json:"page_size,omitempty"`
+	Search     *string `json:"search,omitempty"`
+}
+
+type FindAllDisputeInput struct {
+	Page     *int32  `json:"page,omitempty"`
+	PageSize *int32  `json:"page_size,omitempty"`
+	Search   *string `json:"search,omitempty"`
+}
+
+type FindAllExchangeRateInput struct {
+	Page     *int32  `json:"page,omitempty"`
+	PageSize *int32  `json:"page_size,omitempty"`
+	Search   *string `json:"search,omitempty"`
+}
+
+type FindAllFeeScheduleInput struct {
+	Page     *int32  `json:"page,omitempty"`
+	PageSize *int32  `json:"page_size,omitempty"`
+	Search   *string `json:"search,omitempty"`
 }
 
-type FindAllCardInput struct {
+type FindAllLedgerJournalInput struct {
 	Page     *int32  `json:"page,omitempty"`
 	PageSize *int32  `json:"page_size,omitempty"`
 	Search   *string `json:"search,omitempty"`
@@ -815,6 +1454,18 @@ type FindAllMerchantTransactionInput struct {
 	MerchantID *int32  `json:"merchantId,omitempty"`
 }
 
+type FindAllProviderWebhookEventInput struct {
+	Page     *int32  `json:"page,omitempty"`
+	PageSize *int32  `json:"page_size,omitempty"`
+	Search   *string `json:"search,omitempty"`
+}
+
+type FindAllRefundInput struct {
+	Page     *int32  `json:"page,omitempty"`
+	PageSize *int32  `json:"page_size,omitempty"`
+	Search   *string `json:"search,omitempty"`
+}
+
 type FindAllRoleInput struct {
 	Page     *int32  `json:"page,omitempty"`
 	PageSize *int32  `json:"page_size,omitempty"`
@@ -827,6 +1478,12 @@ type FindAllSaldoInput struct {
 	Search   *string `json:"search,omitempty"`
 }
 
+type FindAllScheduledTransferInput struct {
+	Page     *int32  `json:"page,omitempty"`
+	PageSize *int32  `json:"page_size,omitempty"`
+	Search   *string `json:"search,omitempty"`
+}
+
 type FindAllTopupByCardNumberInput struct {
 	CardNumber string  `json:"card_number"`
 	Page       *int32  `json:"page,omitempty"`
@@ -847,12 +1504,24 @@ type FindAllTransactionCardNumberRequest struct {
 	Search     *string `json:"search,omitempty"`
 }
 
+type FindAllTransactionLimitInput struct {
+	Page     *int32  `json:"page,omitempty"`
+	PageSize *int32  `json:"page_size,omitempty"`
+	Search   *string `json:"search,omitempty"`
+}
+
 type FindAllTransactionRequest struct {
 	Page     *int32  `json:"page,omitempty"`
 	PageSize *int32  `json:"page_size,omitempty"`
 	Search   *string `json:"search,omitempty"`
 }
 
+type FindAllTransferBatchInput struct {
+	Page     *int32  `json:"page,omitempty"`
+	PageSize *int32  `json:"page_size,omitempty"`
+	Search   *string `json:"search,omitempty"`
+}
+
 type FindAllTransferRequest struct {
 	Page     *int32  `json:"page,omitempty"`
 	PageSize *int32  `json:"page_size,omitempty"`
@@ -878,6 +1547,12 @@ type FindAllWithdrawInput struct {
 	Search   *string `json:"search,omitempty"`
 }
 
+type FindBalanceDiscrepanciesInput struct {
+	Status   *string `json:"status,omitempty"`
+	Page     *int32  `json:"page,omitempty"`
+	PageSize *int32  `json:"page_size,omitempty"`
+}
+
 type FindByAPIKeyInput struct {
 	APIKey string `json:"apiKey"`
 }
@@ -891,14 +1566,66 @@ type FindByCardNumberTransferRequest struct {
 	Year       int32  `json:"year"`
 }
 
+type FindByCodeInvoiceInput struct {
+	Code string `json:"code"`
+}
+
+type FindByIDAuthorizationInput struct {
+	ID int32 `json:"id"`
+}
+
+type FindByIDBalanceDiscrepancyInput struct {
+	ID int32 `json:"id"`
+}
+
 type FindByIDCardInput struct {
 	CardID int32 `json:"card_id"`
 }
 
+type FindByIDDisputeInput struct {
+	ID int32 `json:"id"`
+}
+
+type FindByIDExchangeRateInput struct {
+	ID int32 `json:"id"`
+}
+
+type FindByIDFeeScheduleInput struct {
+	ID int32 `json:"id"`
+}
+
+type FindByIDInvoiceInput struct {
+	ID int32 `json:"id"`
+}
+
+type FindByIDLedgerJournalInput struct {
+	ID int32 `json:"id"`
+}
+
+type FindByIDMerchantAPIKeyInput struct {
+	ID int32 `json:"id"`
+}
+
 type FindByIDMerchantInput struct {
 	ID int32 `json:"id"`
 }
 
+type FindByIDMerchantWebhookDeliveryInput struct {
+	ID int32 `json:"id"`
+}
+
+type FindByIDMerchantWebhookEndpointInput struct {
+	ID int32 `json:"id"`
+}
+
+type FindByIDProviderWebhookEventInput struct {
+	ID int32 `json:"id"`
+}
+
+type FindByIDRefundInput struct {
+	ID int32 `json:"id"`
+}
+
 type FindByIDRoleInput struct {
 	RoleID int32 `json:"role_id"`
 }
@@ -907,14 +1634,30 @@ type FindByIDSaldoInput struct {
 	ID int32 `json:"id"`
 }
 
+type FindByIDScheduledTransferInput struct {
+	ID int32 `json:"id"`
+}
+
+type FindByIDSettlementBatchInput struct {
+	ID int32 `json:"id"`
+}
+
 type FindByIDTopupInput struct {
 	TopupID int32 `json:"topup_id"`
 }
 
+type FindByIDTransactionLimitInput struct {
+	ID int32 `json:"id"`
+}
+
 type FindByIDTransactionRequest struct {
 	TransactionID int32 `json:"transaction_id"`
 }
 
+type FindByIDTransferBatchInput struct {
+	ID int32 `json:"id"`
+}
+
 type FindByIDTransferRequest struct {
 	TransferID int32 `json:"transfer_id"`
 }
@@ -944,6 +1687,44 @@ type FindByYearCardNumberTransactionRequest struct {
 	Year       int32  `json:"year"`
 }
 
+type FindInvoicesInput struct {
+	MerchantID int32 `json:"merchant_id"`
+	// open, paid, expired or cancelled; all invoices when left out.
+	Status   *string `json:"status,omitempty"`
+	Page     *int32  `json:"page,omitempty"`
+	PageSize *int32  `json:"page_size,omitempty"`
+}
+
+type FindLedgerPostingsByCardNumberInput struct {
+	CardNumber string `json:"card_number"`
+	Page       *int32 `json:"page,omitempty"`
+	PageSize   *int32 `json:"page_size,omitempty"`
+}
+
+type FindMerchantAPIKeysInput struct {
+	MerchantID int32 `json:"merchant_id"`
+}
+
+type FindMerchantBalancesInput struct {
+	MerchantID int32 `json:"merchant_id"`
+}
+
+type FindMerchantWebhookDeliveriesInput struct {
+	EndpointID int32  `json:"endpoint_id"`
+	Page       *int32 `json:"page,omitempty"`
+	PageSize   *int32 `json:"page_size,omitempty"`
+}
+
+type FindMerchantWebhookEndpointsInput struct {
+	MerchantID int32 `json:"merchant_id"`
+}
+
+type FindMerchantsByStatusInput struct {
+	Status   string `json:"status"`
+	Page     *int32 `json:"page,omitempty"`
+	PageSize *int32 `json:"page_size,omitempty"`
+}
+
 type FindMonthlySaldoTotalBalanceInput struct {
 	Year  int32 `json:"year"`
 	Month int32 `json:"month"`
@@ -993,10 +1774,48 @@ type FindMonthlyWithdrawStatusInput struct {
 	Month int32 `json:"month"`
 }
 
+type FindQRPaymentInput struct {
+	MerchantID int32  `json:"merchant_id"`
+	Reference  string `json:"reference"`
+}
+
+type FindReconciliationRunsInput struct {
+	Page     *int32 `json:"page,omitempty"`
+	PageSize *int32 `json:"page_size,omitempty"`
+}
+
+type FindScheduledTransferRunsInput struct {
+	ScheduledTransferID int32  `json:"scheduled_transfer_id"`
+	Page                *int32 `json:"page,omitempty"`
+	PageSize            *int32 `json:"page_size,omitempty"`
+}
+
+type FindSettlementBatchesInput struct {
+	MerchantID int32  `json:"merchant_id"`
+	Page       *int32 `json:"page,omitempty"`
+	PageSize   *int32 `json:"page_size,omitempty"`
+}
+
+type FindStatusHistoryInput struct {
+	EntityType string `json:"entity_type"`
+	EntityID   int32  `json:"entity_id"`
+}
+
 type FindTransactionByMerchantIDRequest struct {
 	MerchantID int32 `json:"merchant_id"`
 }
 
+type FindTransactionLimitHeadroomInput struct {
+	CardNumber    string `json:"card_number"`
+	OperationType string `json:"operation_type"`
+}
+
+type FindTransferBatchRowsInput struct {
+	TransferBatchID int32  `json:"transfer_batch_id"`
+	Page            *int32 `json:"page,omitempty"`
+	PageSize        *int32 `json:"page_size,omitempty"`
+}
+
 type FindTransferByTransferFromRequest struct {
 	TransferFrom string `json:"transfer_from"`
 }
@@ -1023,6 +1842,10 @@ type FindYearBalanceInput struct {
 	Year int32 `json:"year"`
 }
 
+type FindYearFeeInput struct {
+	Year int32 `json:"year"`
+}
+
 type FindYearMerchantByApikeyInput struct {
 	Year   int32  `json:"year"`
 	APIKey string `json:"apiKey"`
@@ -1087,20 +1910,168 @@ type FindYearlySaldoInput struct {
 	Year int32 `json:"year"`
 }
 
+type GenerateMerchantQRInput struct {
+	MerchantID int32 `json:"merchant_id"`
+	// Amount of a dynamic QR code, which can be paid only once. Leave it and the
+	// reference out for a static QR code the payer enters the amount for.
+	Amount *int32 `json:"amount,omitempty"`
+	// Reference of a dynamic QR code, e.g. an order number, at most 25
+	// characters. The merchant finds the payment with it.
+	Reference *string `json:"reference,omitempty"`
+}
+
 type GetMeInput struct {
 	AccessToken string `json:"access_token"`
 }
 
+type InvoiceLineItemResponse struct {
+	ID          int32  `json:"id"`
+	Description string `json:"description"`
+	Quantity    int32  `json:"quantity"`
+	UnitPrice   int32  `json:"unit_price"`
+	Amount      int32  `json:"amount"`
+}
+
+type InvoicePaymentResponse struct {
+	ID        int32 `json:"id"`
+	InvoiceID int32 `json:"invoice_id"`
+	// Merchant transaction the payment created.
+	TransactionID int32  `json:"transaction_id"`
+	Amount        int32  `json:"amount"`
+	CreatedAt     string `json:"created_at"`
+}
+
+type InvoiceResponse struct {
+	ID int32 `json:"id"`
+	// Public code customers pay the invoice with.
+	Code        string  `json:"code"`
+	MerchantID  int32   `json:"merchant_id"`
+	Kind        string  `json:"kind"`
+	Description string  `json:"description"`
+	Amount      int32   `json:"amount"`
+	Currency    string  `json:"currency"`
+	Status      string  `json:"status"`
+	ExpiresAt   string  `json:"expires_at"`
+	PaidAt      *string `json:"paid_at,omitempty"`
+	CancelledAt *string `json:"cancelled_at,omitempty"`
+	// Empty in invoice lists; fetch the invoice itself for its line items.
+	LineItems []*InvoiceLineItemResponse `json:"line_items"`
+	CreatedAt string                     `json:"created_at"`
+	UpdatedAt string                     `json:"updated_at"`
+}
+
+type LedgerBalanceResponse struct {
+	CardNumber    string `json:"card_number"`
+	LedgerBalance int32  `json:"ledger_balance"`
+	SaldoBalance  int32  `json:"saldo_balance"`
+	Difference    int32  `json:"difference"`
+}
+
+type LedgerCardPostingResponse struct {
+	ID            int32  `json:"id"`
+	JournalID     int32  `json:"journal_id"`
+	ReferenceType string `json:"reference_type"`
+	ReferenceID   *int32 `json:"reference_id,omitempty"`
+	CardNumber    string `json:"card_number"`
+	Direction     string `json:"direction"`
+	Amount        int32  `json:"amount"`
+	Currency      string `json:"currency"`
+	PostedAt      string `json:"posted_at"`
+}
+
+type LedgerJournalResponse struct {
+	ID            int32                    `json:"id"`
+	JournalNo     string                   `json:"journal_no"`
+	ReferenceType string                   `json:"reference_type"`
+	ReferenceID   *int32                   `json:"reference_id,omitempty"`
+	Description   string                   `json:"description"`
+	PostedAt      string                   `json:"posted_at"`
+	CreatedAt     string                   `json:"created_at"`
+	Postings      []*LedgerPostingResponse `json:"postings,omitempty"`
+}
+
+type LedgerPostingResponse struct {
+	ID          int32   `json:"id"`
+	JournalID   int32   `json:"journal_id"`
+	AccountCode string  `json:"account_code"`
+	CardNumber  *string `json:"card_number,omitempty"`
+	Direction   string  `json:"direction"`
+	Amount      int32   `json:"amount"`
+	Currency    string  `json:"currency"`
+	CreatedAt   string  `json:"created_at"`
+}
+
 type LoginInput struct {
 	Email    string `json:"email"`
 	Password string `json:"password"`
 }
 
+type MerchantAPIKeyResponse struct {
+	ID         int32   `json:"id"`
+	MerchantID int32   `json:"merchant_id"`
+	Label      string  `json:"label"`
+	KeyPrefix  string  `json:"key_prefix"`
+	APIKey     *string `json:"api_key,omitempty"`
+	// Secret that requests made with the key are signed with. Like the key, it is
+	// only returned when the key is created or rotated.
+	//
+	// A signed request leaves out api_key and sends these headers instead:
+	//
+	// - X-Api-Key-Id: the id of the key
+	// - X-Signature-Timestamp: the current unix time in seconds
+	// - X-Signature-Nonce: 16 to 64 random letters, digits, '-' or '_', never
+	//   reused with the same key
+	// - X-Signature: hex HMAC-SHA256 of the lines below, joined by a newline,
+	//   under this secret
+	//
+	// The signed lines are the upper case HTTP method, the request path including
+	// any query string, the timestamp, the nonce, and the hex SHA-256 of the raw
+	// request body. Requests whose timestamp is further off the server clock than
+	// the allowed skew (5 minutes by default) or that reuse a nonce are rejected.
+	SigningSecret *string  `json:"signing_secret,omitempty"`
+	Scopes        []string `json:"scopes"`
+	ExpiresAt     *string  `json:"expires_at,omitempty"`
+	LastUsedAt    *string  `json:"last_used_at,omitempty"`
+	RevokedAt     *string  `json:"revoked_at,omitempty"`
+	CreatedAt     string   `json:"created_at"`
+	UpdatedAt     string   `json:"updated_at"`
+}
+
+type MerchantBalanceResponse struct {
+	MerchantID           int32   `json:"merchant_id"`
+	Currency             string  `json:"currency"`
+	PendingBalance       int32   `json:"pending_balance"`
+	SettlementCardNumber *string `json:"settlement_card_number,omitempty"`
+	UpdatedAt            string  `json:"updated_at"`
+}
+
+type MerchantBusinessProfileResponse struct {
+	MerchantID   int32   `json:"merchant_id"`
+	LegalName    string  `json:"legal_name"`
+	BusinessType string  `json:"business_type"`
+	TaxID        string  `json:"tax_id"`
+	Address      string  `json:"address"`
+	Website      *string `json:"website,omitempty"`
+	ContactEmail string  `json:"contact_email"`
+	ContactPhone string  `json:"contact_phone"`
+	SubmittedAt  string  `json:"submitted_at"`
+	CreatedAt    string  `json:"created_at"`
+	UpdatedAt    string  `json:"updated_at"`
+}
+
 type MerchantMonthlyAmountResponse struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"totalAmount"`
 }
 
+type MerchantMonthlyDisputeRateResponse struct {
+	Month             string  `json:"month"`
+	TotalTransactions int32   `json:"totalTransactions"`
+	TotalDisputes     int32   `json:"totalDisputes"`
+	DisputeRate       float64 `json:"disputeRate"`
+}
+
 type MerchantMonthlyPaymentMethodResponse struct {
 	Month         string `json:"month"`
 	PaymentMethod string `json:"paymentMethod"`
@@ -1109,14 +2080,28 @@ type MerchantMonthlyPaymentMethodResponse struct {
 
 type MerchantMonthlyTotalAmountResponse struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	Year        string `json:"year"`
 	TotalAmount int32  `json:"totalAmount"`
 }
 
+type MerchantQRResponse struct {
+	MerchantID int32   `json:"merchant_id"`
+	Dynamic    bool    `json:"dynamic"`
+	Amount     *int32  `json:"amount,omitempty"`
+	Currency   string  `json:"currency"`
+	Reference  *string `json:"reference,omitempty"`
+	// EMV payload the QR code encodes.
+	Payload string `json:"payload"`
+	// Base64 encoded PNG image of the QR code.
+	Png string `json:"png"`
+	// SVG document of the QR code.
+	SVG string `json:"svg"`
+}
+
 type MerchantResponse struct {
 	ID        int32  `json:"id"`
 	Name      string `json:"name"`
-	APIKey    string `json:"apiKey"`
 	Status    string `json:"status"`
 	UserID    int32  `json:"userId"`
 	CreatedAt string `json:"createdAt"`
@@ -1126,7 +2111,6 @@ type MerchantResponse struct {
 type MerchantResponseDeleteAt struct {
 	ID        int32  `json:"id"`
 	Name      string `json:"name"`
-	APIKey    string `json:"apiKey"`
 	Status    string `json:"status"`
 	UserID    int32  `json:"userId"`
 	CreatedAt string `json:"createdAt"`
@@ -1147,11 +2131,56 @@ type MerchantTransactionResponse struct {
 	DeletedAt       *string `json:"deletedAt,omitempty"`
 }
 
+type MerchantWebhookDeliveryAttemptResponse struct {
+	ID           int32   `json:"id"`
+	DeliveryID   int32   `json:"delivery_id"`
+	Attempt      int32   `json:"attempt"`
+	ResponseCode *int32  `json:"response_code,omitempty"`
+	Error        *string `json:"error,omitempty"`
+	DurationMs   int32   `json:"duration_ms"`
+	CreatedAt    string  `json:"created_at"`
+}
+
+type MerchantWebhookDeliveryResponse struct {
+	ID               int32   `json:"id"`
+	EndpointID       int32   `json:"endpoint_id"`
+	EventID          string  `json:"event_id"`
+	EventType        string  `json:"event_type"`
+	Payload          string  `json:"payload"`
+	Status           string  `json:"status"`
+	Attempts         int32   `json:"attempts"`
+	NextAttemptAt    *string `json:"next_attempt_at,omitempty"`
+	LastResponseCode *int32  `json:"last_response_code,omitempty"`
+	LastError        *string `json:"last_error,omitempty"`
+	DeliveredAt      *string `json:"delivered_at,omitempty"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+}
+
+type MerchantWebhookEndpointResponse struct {
+	ID         int32    `json:"id"`
+	MerchantID int32    `json:"merchant_id"`
+	URL        string   `json:"url"`
+	Secret     *string  `json:"secret,omitempty"`
+	Events     []string `json:"events"`
+	IsActive   bool     `json:"is_active"`
+	CreatedAt  string   `json:"created_at"`
+	UpdatedAt  string   `json:"updated_at"`
+}
+
 type MerchantYearlyAmountResponse struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"totalAmount"`
 }
 
+type MerchantYearlyDisputeRateResponse struct {
+	Year              string  `json:"year"`
+	TotalTransactions int32   `json:"totalTransactions"`
+	TotalDisputes     int32   `json:"totalDisputes"`
+	DisputeRate       float64 `json:"disputeRate"`
+}
+
 type MerchantYearlyPaymentMethodResponse struct {
 	Year          string `json:"year"`
 	PaymentMethod string `json:"paymentMethod"`
@@ -1160,12 +2189,18 @@ type MerchantYearlyPaymentMethodResponse struct {
 
 type MerchantYearlyTotalAmountResponse struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"totalAmount"`
 }
 
 type Mutation struct {
 }
 
+type OpenDisputeInput struct {
+	TransactionID int32  `json:"transaction_id"`
+	Reason        string `json:"reason"`
+}
+
 type PaginationMeta struct {
 	CurrentPage  int32 `json:"current_page"`
 	PageSize     int32 `json:"page_size"`
@@ -1173,13 +2208,73 @@ type PaginationMeta struct {
 	TotalRecords int32 `json:"total_records"`
 }
 
+type PayInvoiceInput struct {
+	Code           string  `json:"code"`
+	CardNumber     string  `json:"card_number"`
+	PaymentMethod  string  `json:"payment_method"`
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
+}
+
+type PayQRInput struct {
+	// Payload read from the scanned QR code.
+	Payload       string `json:"payload"`
+	CardNumber    string `json:"card_number"`
+	PaymentMethod string `json:"payment_method"`
+	// Amount to pay a static QR code. Dynamic QR codes carry their own amount.
+	Amount         *int32  `json:"amount,omitempty"`
+	IdempotencyKey *string `json:"idempotency_key,omitempty"`
+}
+
+type ProviderWebhookEventResponse struct {
+	ID          int32   `json:"id"`
+	Provider    string  `json:"provider"`
+	EventID     string  `json:"event_id"`
+	EventType   string  `json:"event_type"`
+	Payload     string  `json:"payload"`
+	SentAt      string  `json:"sent_at"`
+	Status      string  `json:"status"`
+	Attempts    int32   `json:"attempts"`
+	LastError   *string `json:"last_error,omitempty"`
+	ProcessedAt *string `json:"processed_at,omitempty"`
+	CreatedAt   string  `json:"created_at"`
+	UpdatedAt   string  `json:"updated_at"`
+}
+
 type Query struct {
 }
 
+type ReconciliationRunResponse struct {
+	ID               int32   `json:"id"`
+	CardsChecked     int32   `json:"cards_checked"`
+	DiscrepancyCount int32   `json:"discrepancy_count"`
+	StartedAt        string  `json:"started_at"`
+	FinishedAt       *string `json:"finished_at,omitempty"`
+}
+
 type RefreshTokenInput struct {
 	RefreshToken string `json:"refresh_token"`
 }
 
+type RefundResponse struct {
+	ID            int32  `json:"id"`
+	RefundNo      string `json:"refund_no"`
+	TransactionID int32  `json:"transaction_id"`
+	CardNumber    string `json:"card_number"`
+	MerchantID    int32  `json:"merchant_id"`
+	Amount        int32  `json:"amount"`
+	Currency      string `json:"currency"`
+	Reason        string `json:"reason"`
+	Status        string `json:"status"`
+	CreatedAt     string `json:"created_at"`
+	UpdatedAt     string `json:"updated_at"`
+}
+
+type RefundTransactionInput struct {
+	TransactionID int32  `json:"transaction_id"`
+	Amount        int32  `json:"amount"`
+	Reason        string `json:"reason"`
+}
+
 type RegisterInput struct {
 	Firstname       string `json:"firstname"`
 	Lastname        string `json:"lastname"`
@@ -1188,6 +2283,27 @@ type RegisterInput struct {
 	ConfirmPassword string `json:"confirm_password"`
 }
 
+type ResolveDisputeInput struct {
+	DisputeID int32  `json:"dispute_id"`
+	Outcome   string `json:"outcome"`
+	Note      string `json:"note"`
+}
+
+type RespondDisputeInput struct {
+	DisputeID int32  `json:"dispute_id"`
+	Evidence  string `json:"evidence"`
+}
+
+type ReviewMerchantInput struct {
+	MerchantID int32   `json:"merchant_id"`
+	Reason     *string `json:"reason,omitempty"`
+}
+
+type ReviewWithdrawInput struct {
+	WithdrawID int32   `json:"withdrawId"`
+	Note       *string `json:"note,omitempty"`
+}
+
 type RoleResponse struct {
 	ID        int32  `json:"id"`
 	Name      string `json:"name"`
@@ -1203,48 +2319,161 @@ type RoleResponseDeleteAt struct {
 	DeletedAt *string `json:"deleted_at,omitempty"`
 }
 
+type RotateMerchantAPIKeyInput struct {
+	ID               int32  `json:"id"`
+	GracePeriodHours *int32 `json:"grace_period_hours,omitempty"`
+}
+
+type SaldoHoldResponse struct {
+	ID            int32   `json:"id"`
+	HoldNo        string  `json:"hold_no"`
+	CardNumber    string  `json:"card_number"`
+	Amount        int32   `json:"amount"`
+	Currency      string  `json:"currency"`
+	Reason        string  `json:"reason"`
+	ReferenceType *string `json:"reference_type,omitempty"`
+	ReferenceID   *int32  `json:"reference_id,omitempty"`
+	Status        string  `json:"status"`
+	CreatedBy     *int32  `json:"created_by,omitempty"`
+	ReleasedAt    *string `json:"released_at,omitempty"`
+	ConsumedAt    *string `json:"consumed_at,omitempty"`
+	CreatedAt     string  `json:"created_at"`
+	UpdatedAt     string  `json:"updated_at"`
+}
+
 type SaldoMonthBalanceResponse struct {
 	Month        string `json:"month"`
+	Currency     string `json:"currency"`
 	TotalBalance int32  `json:"total_balance"`
 }
 
 type SaldoMonthTotalBalanceResponse struct {
 	Month        string `json:"month"`
 	Year         string `json:"year"`
+	Currency     string `json:"currency"`
 	TotalBalance int32  `json:"total_balance"`
 }
 
 type SaldoResponse struct {
-	ID             int32   `json:"id"`
-	CardNumber     string  `json:"card_number"`
-	TotalBalance   int32   `json:"total_balance"`
-	WithdrawTime   *string `json:"withdraw_time,omitempty"`
-	WithdrawAmount *int32  `json:"withdraw_amount,omitempty"`
-	CreatedAt      string  `json:"created_at"`
-	UpdatedAt      string  `json:"updated_at"`
+	ID               int32   `json:"id"`
+	CardNumber       string  `json:"card_number"`
+	TotalBalance     int32   `json:"total_balance"`
+	ReservedBalance  int32   `json:"reserved_balance"`
+	Currency         string  `json:"currency"`
+	AvailableBalance int32   `json:"available_balance"`
+	WithdrawTime     *string `json:"withdraw_time,omitempty"`
+	WithdrawAmount   *int32  `json:"withdraw_amount,omitempty"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
 }
 
 type SaldoResponseDeleteAt struct {
-	ID             int32   `json:"id"`
-	CardNumber     string  `json:"card_number"`
-	TotalBalance   int32   `json:"total_balance"`
-	WithdrawTime   *string `json:"withdraw_time,omitempty"`
-	WithdrawAmount *int32  `json:"withdraw_amount,omitempty"`
-	CreatedAt      string  `json:"created_at"`
-	UpdatedAt      string  `json:"updated_at"`
-	DeletedAt      *string `json:"deleted_at,omitempty"`
+	ID               int32   `json:"id"`
+	CardNumber       string  `json:"card_number"`
+	TotalBalance     int32   `json:"total_balance"`
+	ReservedBalance  int32   `json:"reserved_balance"`
+	Currency         string  `json:"currency"`
+	AvailableBalance int32   `json:"available_balance"`
+	WithdrawTime     *string `json:"withdraw_time,omitempty"`
+	WithdrawAmount   *int32  `json:"withdraw_amount,omitempty"`
+	CreatedAt        string  `json:"created_at"`
+	UpdatedAt        string  `json:"updated_at"`
+	DeletedAt        *string `json:"deleted_at,omitempty"`
 }
 
 type SaldoYearBalanceResponse struct {
 	Year         string `json:"year"`
+	Currency     string `json:"currency"`
 	TotalBalance int32  `json:"total_balance"`
 }
 
 type SaldoYearTotalBalanceResponse struct {
 	Year         string `json:"year"`
+	Currency     string `json:"currency"`
 	TotalBalance int32  `json:"total_balance"`
 }
 
+type ScheduledTransferResponse struct {
+	ID             int32   `json:"id"`
+	UserID         int32   `json:"user_id"`
+	TransferFrom   string  `json:"transfer_from"`
+	TransferTo     string  `json:"transfer_to"`
+	TransferAmount int32   `json:"transfer_amount"`
+	Recurrence     string  `json:"recurrence"`
+	DayOfMonth     *int32  `json:"day_of_month,omitempty"`
+	NextRunAt      *string `json:"next_run_at,omitempty"`
+	EndAt          *string `json:"end_at,omitempty"`
+	Status         string  `json:"status"`
+	LastRunAt      *string `json:"last_run_at,omitempty"`
+	CreatedAt      string  `json:"created_at"`
+	UpdatedAt      string  `json:"updated_at"`
+}
+
+type ScheduledTransferRunResponse struct {
+	ID                  int32   `json:"id"`
+	ScheduledTransferID int32   `json:"scheduled_transfer_id"`
+	ScheduledFor        string  `json:"scheduled_for"`
+	Status              string  `json:"status"`
+	TransferID          *int32  `json:"transfer_id,omitempty"`
+	FailureReason       *string `json:"failure_reason,omitempty"`
+	CreatedAt           string  `json:"created_at"`
+	UpdatedAt           string  `json:"updated_at"`
+}
+
+type SetSettlementCardInput struct {
+	MerchantID int32  `json:"merchant_id"`
+	CardNumber string `json:"card_number"`
+}
+
+type SettlementBatchResponse struct {
+	ID                   int32  `json:"id"`
+	MerchantID           int32  `json:"merchant_id"`
+	Currency             string `json:"currency"`
+	SettlementCardNumber string `json:"settlement_card_number"`
+	CutoffAt             string `json:"cutoff_at"`
+	GrossAmount          int32  `json:"gross_amount"`
+	FeeAmount            int32  `json:"fee_amount"`
+	RefundAmount         int32  `json:"refund_amount"`
+	AdjustmentAmount     int32  `json:"adjustment_amount"`
+	NetAmount            int32  `json:"net_amount"`
+	ItemCount            int32  `json:"item_count"`
+	CreatedAt            string `json:"created_at"`
+}
+
+type SettlementItemResponse struct {
+	ID                int32  `json:"id"`
+	SettlementBatchID *int32 `json:"settlement_batch_id,omitempty"`
+	ItemType          string `json:"item_type"`
+	ReferenceID       int32  `json:"reference_id"`
+	Amount            int32  `json:"amount"`
+	Fee               int32  `json:"fee"`
+	NetAmount         int32  `json:"net_amount"`
+	Currency          string `json:"currency"`
+	CreatedAt         string `json:"created_at"`
+}
+
+type StatusHistoryResponse struct {
+	ID         int32   `json:"id"`
+	EntityType string  `json:"entity_type"`
+	EntityID   int32   `json:"entity_id"`
+	FromStatus string  `json:"from_status"`
+	ToStatus   string  `json:"to_status"`
+	Reason     *string `json:"reason,omitempty"`
+	ChangedBy  *int32  `json:"changed_by,omitempty"`
+	CreatedAt  string  `json:"created_at"`
+}
+
+type SubmitMerchantOnboardingInput struct {
+	MerchantID   int32   `json:"merchant_id"`
+	LegalName    string  `json:"legal_name"`
+	BusinessType string  `json:"business_type"`
+	TaxID        string  `json:"tax_id"`
+	Address      string  `json:"address"`
+	Website      *string `json:"website,omitempty"`
+	ContactEmail string  `json:"contact_email"`
+	ContactPhone string  `json:"contact_phone"`
+}
+
 type TokenResponse struct {
 	AccessToken  string `json:"access_token"`
 	RefreshToken string `json:"refresh_token"`
@@ -1252,6 +2481,7 @@ type TokenResponse struct {
 
 type TopupMonthAmountResponse struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -1281,8 +2511,11 @@ type TopupResponse struct {
 	CardNumber  string  `json:"card_number"`
 	TopupNo     string  `json:"topup_no"`
 	TopupAmount int32   `json:"topup_amount"`
+	Currency    string  `json:"currency"`
+	Fee         int32   `json:"fee"`
 	TopupMethod string  `json:"topup_method"`
 	TopupTime   *string `json:"topup_time,omitempty"`
+	Status      string  `json:"status"`
 	CreatedAt   string  `json:"created_at"`
 	UpdatedAt   string  `json:"updated_at"`
 }
@@ -1292,8 +2525,11 @@ type TopupResponseDeleteAt struct {
 	CardNumber  string  `json:"card_number"`
 	TopupNo     string  `json:"topup_no"`
 	TopupAmount int32   `json:"topup_amount"`
+	Currency    string  `json:"currency"`
+	Fee         int32   `json:"fee"`
 	TopupMethod string  `json:"topup_method"`
 	TopupTime   *string `json:"topup_time,omitempty"`
+	Status      string  `json:"status"`
 	CreatedAt   string  `json:"created_at"`
 	UpdatedAt   string  `json:"updated_at"`
 	DeletedAt   *string `json:"deleted_at,omitempty"`
@@ -1301,6 +2537,7 @@ type TopupResponseDeleteAt struct {
 
 type TopupYearAmountResponse struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -1323,8 +2560,38 @@ type TopupYearStatusSuccessResponse struct {
 	TotalAmount  int32  `json:"total_amount"`
 }
 
+type TransactionLimitHeadroomResponse struct {
+	LimitID                 int32  `json:"limit_id"`
+	Name                    string `json:"name"`
+	OperationType           string `json:"operation_type"`
+	Period                  string `json:"period"`
+	MaxAmountPerTransaction *int32 `json:"max_amount_per_transaction,omitempty"`
+	UsedCount               int32  `json:"used_count"`
+	RemainingCount          *int32 `json:"remaining_count,omitempty"`
+	UsedAmount              int32  `json:"used_amount"`
+	RemainingAmount         *int32 `json:"remaining_amount,omitempty"`
+	ResetsAt                string `json:"resets_at"`
+}
+
+type TransactionLimitResponse struct {
+	ID                      int32   `json:"id"`
+	Name                    string  `json:"name"`
+	OperationType           string  `json:"operation_type"`
+	Period                  string  `json:"period"`
+	CardType                *string `json:"card_type,omitempty"`
+	UserTier                *string `json:"user_tier,omitempty"`
+	Currency                *string `json:"currency,omitempty"`
+	MaxAmountPerTransaction *int32  `json:"max_amount_per_transaction,omitempty"`
+	MaxCount                *int32  `json:"max_count,omitempty"`
+	MaxTotalAmount          *int32  `json:"max_total_amount,omitempty"`
+	IsActive                bool    `json:"is_active"`
+	CreatedAt               string  `json:"created_at"`
+	UpdatedAt               string  `json:"updated_at"`
+}
+
 type TransactionMonthAmountResponse struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -1354,8 +2621,12 @@ type TransactionResponse struct {
 	CardNumber      string `json:"card_number"`
 	TransactionNo   string `json:"transaction_no"`
 	Amount          int32  `json:"amount"`
+	Currency        string `json:"currency"`
+	Fee             int32  `json:"fee"`
 	PaymentMethod   string `json:"payment_method"`
 	MerchantID      int32  `json:"merchant_id"`
+	Status          string `json:"status"`
+	RefundedAmount  int32  `json:"refunded_amount"`
 	TransactionTime string `json:"transaction_time"`
 	CreatedAt       string `json:"created_at"`
 	UpdatedAt       string `json:"updated_at"`
@@ -1366,6 +2637,8 @@ type TransactionResponseDeleteAt struct {
 	CardNumber      string  `json:"card_number"`
 	TransactionNo   string  `json:"transaction_no"`
 	Amount          int32   `json:"amount"`
+	Currency        string  `json:"currency"`
+	Fee             int32   `json:"fee"`
 	PaymentMethod   string  `json:"payment_method"`
 	MerchantID      int32   `json:"merchant_id"`
 	TransactionTime string  `json:"transaction_time"`
@@ -1395,11 +2668,53 @@ type TransactionYearStatusSuccessResponse struct {
 
 type TransactionYearlyAmountResponse struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
+type TransferBatchReportResponse struct {
+	FileName    string `json:"file_name"`
+	ContentType string `json:"content_type"`
+	Content     string `json:"content"`
+}
+
+type TransferBatchResponse struct {
+	ID              int32   `json:"id"`
+	UserID          int32   `json:"user_id"`
+	TransferFrom    string  `json:"transfer_from"`
+	FileFormat      string  `json:"file_format"`
+	Status          string  `json:"status"`
+	TotalRows       int32   `json:"total_rows"`
+	TotalAmount     int32   `json:"total_amount"`
+	InvalidRows     int32   `json:"invalid_rows"`
+	PendingRows     int32   `json:"pending_rows"`
+	SuccessRows     int32   `json:"success_rows"`
+	FailedRows      int32   `json:"failed_rows"`
+	SuccessAmount   int32   `json:"success_amount"`
+	ProgressPercent int32   `json:"progress_percent"`
+	StartedAt       *string `json:"started_at,omitempty"`
+	CompletedAt     *string `json:"completed_at,omitempty"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+type TransferBatchRowResponse struct {
+	ID              int32   `json:"id"`
+	TransferBatchID int32   `json:"transfer_batch_id"`
+	RowNumber       int32   `json:"row_number"`
+	TransferTo      string  `json:"transfer_to"`
+	TransferAmount  int32   `json:"transfer_amount"`
+	Note            *string `json:"note,omitempty"`
+	Status          string  `json:"status"`
+	TransferID      *int32  `json:"transfer_id,omitempty"`
+	FailureReason   *string `json:"failure_reason,omitempty"`
+	CreatedAt       string  `json:"created_at"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
 type TransferMonthAmountResponse struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -1418,30 +2733,43 @@ type TransferMonthStatusSuccessResponse struct {
 }
 
 type TransferResponse struct {
-	ID             int32  `json:"id"`
-	TransferNo     string `json:"transfer_no"`
-	TransferFrom   string `json:"transfer_from"`
-	TransferTo     string `json:"transfer_to"`
-	TransferAmount int32  `json:"transfer_amount"`
-	TransferTime   string `json:"transfer_time"`
-	CreatedAt      string `json:"created_at"`
-	UpdatedAt      string `json:"updated_at"`
+	ID              int32    `json:"id"`
+	TransferNo      string   `json:"transfer_no"`
+	TransferFrom    string   `json:"transfer_from"`
+	TransferTo      string   `json:"transfer_to"`
+	TransferAmount  int32    `json:"transfer_amount"`
+	Currency        string   `json:"currency"`
+	Fee             int32    `json:"fee"`
+	ToCurrency      string   `json:"to_currency"`
+	ConvertedAmount int32    `json:"converted_amount"`
+	ExchangeRate    *float64 `json:"exchange_rate,omitempty"`
+	SpreadBps       *int32   `json:"spread_bps,omitempty"`
+	TransferTime    string   `json:"transfer_time"`
+	CreatedAt       string   `json:"created_at"`
+	UpdatedAt       string   `json:"updated_at"`
 }
 
 type TransferResponseDeleteAt struct {
-	ID             int32   `json:"id"`
-	TransferNo     string  `json:"transfer_no"`
-	TransferFrom   string  `json:"transfer_from"`
-	TransferTo     string  `json:"transfer_to"`
-	TransferAmount int32   `json:"transfer_amount"`
-	TransferTime   string  `json:"transfer_time"`
-	CreatedAt      string  `json:"created_at"`
-	UpdatedAt      string  `json:"updated_at"`
-	DeletedAt      *string `json:"deleted_at,omitempty"`
+	ID              int32    `json:"id"`
+	TransferNo      string   `json:"transfer_no"`
+	TransferFrom    string   `json:"transfer_from"`
+	TransferTo      string   `json:"transfer_to"`
+	TransferAmount  int32    `json:"transfer_amount"`
+	Currency        string   `json:"currency"`
+	Fee             int32    `json:"fee"`
+	ToCurrency      string   `json:"to_currency"`
+	ConvertedAmount int32    `json:"converted_amount"`
+	ExchangeRate    *float64 `json:"exchange_rate,omitempty"`
+	SpreadBps       *int32   `json:"spread_bps,omitempty"`
+	TransferTime    string   `json:"transfer_time"`
+	CreatedAt       string   `json:"created_at"`
+	UpdatedAt       string   `json:"updated_at"`
+	DeletedAt       *string  `json:"deleted_at,omitempty"`
 }
 
 type TransferYearAmountResponse struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -1466,11 +2794,33 @@ type UpdateCardInput struct {
 	CardProvider string `json:"card_provider"`
 }
 
+type UpdateFeeScheduleInput struct {
+	ID              int32                   `json:"id"`
+	Name            string                  `json:"name"`
+	TransactionType string                  `json:"transaction_type"`
+	FeeType         string                  `json:"fee_type"`
+	PaymentMethod   *string                 `json:"payment_method,omitempty"`
+	CardType        *string                 `json:"card_type,omitempty"`
+	Currency        *string                 `json:"currency,omitempty"`
+	FlatAmount      *int32                  `json:"flat_amount,omitempty"`
+	PercentageBps   *int32                  `json:"percentage_bps,omitempty"`
+	MinFee          *int32                  `json:"min_fee,omitempty"`
+	MaxFee          *int32                  `json:"max_fee,omitempty"`
+	IsActive        *bool                   `json:"is_active,omitempty"`
+	Tiers           []*FeeScheduleTierInput `json:"tiers,omitempty"`
+}
+
 type UpdateMerchantInput struct {
 	MerchantID int32   `json:"merchantId"`
 	Name       *string `json:"name,omitempty"`
 	UserID     *int32  `json:"userId,omitempty"`
-	Status     *string `json:"status,omitempty"`
+}
+
+type UpdateMerchantWebhookEndpointInput struct {
+	ID       int32    `json:"id"`
+	URL      string   `json:"url"`
+	Events   []string `json:"events"`
+	IsActive bool     `json:"is_active"`
 }
 
 type UpdateRoleInput struct {
@@ -1484,6 +2834,16 @@ type UpdateSaldoInput struct {
 	TotalBalance *int32  `json:"total_balance,omitempty"`
 }
 
+type UpdateScheduledTransferInput struct {
+	ID             int32   `json:"id"`
+	TransferTo     string  `json:"transfer_to"`
+	TransferAmount int32   `json:"transfer_amount"`
+	Recurrence     string  `json:"recurrence"`
+	DayOfMonth     *int32  `json:"day_of_month,omitempty"`
+	StartAt        string  `json:"start_at"`
+	EndAt          *string `json:"end_at,omitempty"`
+}
+
 type UpdateTopupInput struct {
 	TopupID     int32   `json:"topup_id"`
 	CardNumber  *string `json:"card_number,omitempty"`
@@ -1491,14 +2851,30 @@ type UpdateTopupInput struct {
 	TopupMethod *string `json:"topup_method,omitempty"`
 }
 
+type UpdateTransactionLimitInput struct {
+	ID                      int32   `json:"id"`
+	Name                    string  `json:"name"`
+	OperationType           string  `json:"operation_type"`
+	Period                  string  `json:"period"`
+	CardType                *string `json:"card_type,omitempty"`
+	UserTier                *string `json:"user_tier,omitempty"`
+	Currency                *string `json:"currency,omitempty"`
+	MaxAmountPerTransaction *int32  `json:"max_amount_per_transaction,omitempty"`
+	MaxCount                *int32  `json:"max_count,omitempty"`
+	MaxTotalAmount          *int32  `json:"max_total_amount,omitempty"`
+	IsActive                *bool   `json:"is_active,omitempty"`
+}
+
 type UpdateTransactionRequest struct {
-	ID              int32  `json:"id"`
-	APIKey          string `json:"api_key"`
-	CardNumber      string `json:"card_number"`
-	Amount          int32  `json:"amount"`
-	PaymentMethod   string `json:"payment_method"`
-	MerchantID      int32  `json:"merchant_id"`
-	TransactionTime string `json:"transaction_time"`
+	ID int32 `json:"id"`
+	// Only needed when the request is not signed. Prefer signing the request with
+	// the key's signing secret, see MerchantApiKeyResponse.signing_secret.
+	APIKey          *string `json:"api_key,omitempty"`
+	CardNumber      string  `json:"card_number"`
+	Amount          int32   `json:"amount"`
+	PaymentMethod   string  `json:"payment_method"`
+	MerchantID      int32   `json:"merchant_id"`
+	TransactionTime string  `json:"transaction_time"`
 }
 
 type UpdateTransferRequest struct {
@@ -1517,6 +2893,11 @@ type UpdateUserInput struct {
 	ConfirmPassword *string `json:"confirm_password,omitempty"`
 }
 
+type UpdateUserTierInput struct {
+	ID   int32  `json:"id"`
+	Tier string `json:"tier"`
+}
+
 type UpdateWithdrawInput struct {
 	WithdrawID     int32  `json:"withdrawId"`
 	CardNumber     string `json:"cardNumber"`
@@ -1524,11 +2905,19 @@ type UpdateWithdrawInput struct {
 	WithdrawTime   string `json:"withdrawTime"`
 }
 
+type UpsertExchangeRateInput struct {
+	BaseCurrency  string  `json:"base_currency"`
+	QuoteCurrency string  `json:"quote_currency"`
+	Rate          float64 `json:"rate"`
+	SpreadBps     *int32  `json:"spread_bps,omitempty"`
+}
+
 type UserResponse struct {
 	ID        int32  `json:"id"`
 	Firstname string `json:"firstname"`
 	Lastname  string `json:"lastname"`
 	Email     string `json:"email"`
+	Tier      string `json:"tier"`
 	CreatedAt string `json:"created_at"`
 	UpdatedAt string `json:"updated_at"`
 }
@@ -1538,11 +2927,19 @@ type UserResponseDeleteAt struct {
 	Firstname string  `json:"firstname"`
 	Lastname  string  `json:"lastname"`
 	Email     string  `json:"email"`
+	Tier      string  `json:"tier"`
 	CreatedAt string  `json:"created_at"`
 	UpdatedAt string  `json:"updated_at"`
 	DeletedAt *string `json:"deleted_at,omitempty"`
 }
 
+type VoidAuthorizationInput struct {
+	// Only needed when the request is not signed. Prefer signing the request with
+	// the key's signing secret, see MerchantApiKeyResponse.signing_secret.
+	APIKey          *string `json:"api_key,omitempty"`
+	AuthorizationID int32   `json:"authorization_id"`
+}
+
 type WithdrawMonthStatusFailedResponse struct {
 	Year        string `json:"year"`
 	Month       string `json:"month"`
@@ -1559,6 +2956,7 @@ type WithdrawMonthStatusSuccessResponse struct {
 
 type WithdrawMonthlyAmountResponse struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"totalAmount"`
 }
 
@@ -1567,7 +2965,10 @@ type WithdrawResponse struct {
 	WithdrawNo     string `json:"withdrawNo"`
 	CardNumber     string `json:"cardNumber"`
 	WithdrawAmount int32  `json:"withdrawAmount"`
+	Currency       string `json:"currency"`
+	Fee            int32  `json:"fee"`
 	WithdrawTime   string `json:"withdrawTime"`
+	Status         string `json:"status"`
 	CreatedAt      string `json:"createdAt"`
 	UpdatedAt      string `json:"updatedAt"`
 }
@@ -1577,12 +2978,24 @@ type WithdrawResponseDeleteAt struct {
 	WithdrawNo     string  `json:"withdrawNo"`
 	CardNumber     string  `json:"cardNumber"`
 	WithdrawAmount int32   `json:"withdrawAmount"`
+	Currency       string  `json:"currency"`
+	Fee            int32   `json:"fee"`
 	WithdrawTime   string  `json:"withdrawTime"`
+	Status         string  `json:"status"`
 	CreatedAt      string  `json:"createdAt"`
 	UpdatedAt      string  `json:"updatedAt"`
 	DeletedAt      *string `json:"deletedAt,omitempty"`
 }
 
+type WithdrawReviewResponse struct {
+	ID         int32   `json:"id"`
+	WithdrawID int32   `json:"withdrawId"`
+	Decision   string  `json:"decision"`
+	Note       *string `json:"note,omitempty"`
+	ReviewedBy int32   `json:"reviewedBy"`
+	CreatedAt  string  `json:"createdAt"`
+}
+
 type WithdrawYearStatusFailedResponse struct {
 	Year        string `json:"year"`
 	TotalFailed int32  `json:"totalFailed"`
@@ -1597,5 +3010,6 @@ type WithdrawYearStatusSuccessResponse struct {
 
 type WithdrawYearlyAmountResponse struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"totalAmount"`
 }
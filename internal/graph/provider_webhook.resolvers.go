@@ -0,0 +1,107 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/provider_webhook_errors"
+)
+
+// ReplayProviderWebhookEvent is the resolver for the replayProviderWebhookEvent field.
+func (r *mutationResolver) ReplayProviderWebhookEvent(ctx context.Context, input model.FindByIDProviderWebhookEventInput) (*model.APIResponseProviderWebhookEvent, error) {
+	if err := requireRole(ctx, r.ProviderWebhookGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, provider_webhook_errors.ErrGraphqlProviderWebhookEventInvalidID
+	}
+
+	event, errResp := r.ProviderWebhookGraphql.ProviderWebhookService.Replay(id)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.ProviderWebhookGraphql.Mapping.ToGraphqlResponseProviderWebhookEvent("success", "Successfully replayed provider webhook event", event)
+
+	return so, nil
+}
+
+// FindAllProviderWebhookEvent is the resolver for the findAllProviderWebhookEvent field.
+func (r *queryResolver) FindAllProviderWebhookEvent(ctx context.Context, input *model.FindAllProviderWebhookEventInput) (*model.APIResponsePaginationProviderWebhookEvent, error) {
+	if err := requireRole(ctx, r.ProviderWebhookGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+	search := ""
+
+	if input != nil {
+		if input.Page != nil && *input.Page > 0 {
+			page = int(*input.Page)
+		}
+		if input.PageSize != nil && *input.PageSize > 0 {
+			pageSize = int(*input.PageSize)
+		}
+		if input.Search != nil {
+			search = *input.Search
+		}
+	}
+
+	reqService := requests.FindAllProviderWebhookEvents{
+		Page:     page,
+		PageSize: pageSize,
+		Search:   search,
+	}
+
+	events, totalRecords, errResp := r.ProviderWebhookGraphql.ProviderWebhookService.FindAll(&reqService)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.ProviderWebhookGraphql.Mapping.ToGraphqlResponsePaginationProviderWebhookEvent("success", "provider webhook events retrieved successfully", events, paginationMeta)
+
+	return so, nil
+}
+
+// FindByIDProviderWebhookEvent is the resolver for the findByIdProviderWebhookEvent field.
+func (r *queryResolver) FindByIDProviderWebhookEvent(ctx context.Context, input model.FindByIDProviderWebhookEventInput) (*model.APIResponseProviderWebhookEvent, error) {
+	if err := requireRole(ctx, r.ProviderWebhookGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, provider_webhook_errors.ErrGraphqlProviderWebhookEventInvalidID
+	}
+
+	event, errResp := r.ProviderWebhookGraphql.ProviderWebhookService.FindById(id)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.ProviderWebhookGraphql.Mapping.ToGraphqlResponseProviderWebhookEvent("success", "provider webhook event retrieved successfully", event)
+
+	return so, nil
+}
@@ -0,0 +1,157 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	mycontext "github.com/MamangRust/paymentgatewaygraphql/pkg/context"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/reconciliation_errors"
+)
+
+// AcknowledgeBalanceDiscrepancy is the resolver for the acknowledgeBalanceDiscrepancy field.
+func (r *mutationResolver) AcknowledgeBalanceDiscrepancy(ctx context.Context, input model.AcknowledgeBalanceDiscrepancyInput) (*model.APIResponseBalanceDiscrepancy, error) {
+	if err := requireRole(ctx, r.ReconciliationGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	uid, _ := mycontext.UserForContext(ctx)
+
+	req := requests.AcknowledgeBalanceDiscrepancyRequest{
+		DiscrepancyID:  int(input.ID),
+		Note:           input.Note,
+		AcknowledgedBy: uid,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, reconciliation_errors.ErrGraphqlValidateAcknowledgeDiscrepancy
+	}
+
+	discrepancy, errResp := r.ReconciliationGraphql.ReconciliationService.Acknowledge(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.ReconciliationGraphql.Mapping.ToGraphqlResponseBalanceDiscrepancy("success", "Successfully acknowledged balance discrepancy", discrepancy)
+
+	return so, nil
+}
+
+// FindReconciliationRuns is the resolver for the findReconciliationRuns field.
+func (r *queryResolver) FindReconciliationRuns(ctx context.Context, input model.FindReconciliationRunsInput) (*model.APIResponsePaginationReconciliationRun, error) {
+	if err := requireRole(ctx, r.ReconciliationGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+
+	if input.Page != nil && *input.Page > 0 {
+		page = int(*input.Page)
+	}
+	if input.PageSize != nil && *input.PageSize > 0 {
+		pageSize = int(*input.PageSize)
+	}
+
+	req := requests.FindReconciliationRuns{
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, reconciliation_errors.ErrGraphqlValidateFindReconciliationRuns
+	}
+
+	runs, totalRecords, errResp := r.ReconciliationGraphql.ReconciliationService.FindRuns(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.ReconciliationGraphql.Mapping.ToGraphqlResponsePaginationReconciliationRun("success", "reconciliation runs retrieved successfully", runs, paginationMeta)
+
+	return so, nil
+}
+
+// FindBalanceDiscrepancies is the resolver for the findBalanceDiscrepancies field.
+func (r *queryResolver) FindBalanceDiscrepancies(ctx context.Context, input model.FindBalanceDiscrepanciesInput) (*model.APIResponsePaginationBalanceDiscrepancy, error) {
+	if err := requireRole(ctx, r.ReconciliationGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+	status := ""
+
+	if input.Page != nil && *input.Page > 0 {
+		page = int(*input.Page)
+	}
+	if input.PageSize != nil && *input.PageSize > 0 {
+		pageSize = int(*input.PageSize)
+	}
+	if input.Status != nil {
+		status = *input.Status
+	}
+
+	req := requests.FindBalanceDiscrepancies{
+		Status:   status,
+		Page:     page,
+		PageSize: pageSize,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, reconciliation_errors.ErrGraphqlValidateFindBalanceDiscrepancies
+	}
+
+	discrepancies, totalRecords, errResp := r.ReconciliationGraphql.ReconciliationService.FindDiscrepancies(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.ReconciliationGraphql.Mapping.ToGraphqlResponsePaginationBalanceDiscrepancy("success", "balance discrepancies retrieved successfully", discrepancies, paginationMeta)
+
+	return so, nil
+}
+
+// FindBalanceDiscrepancyByID is the resolver for the findBalanceDiscrepancyById field.
+func (r *queryResolver) FindBalanceDiscrepancyByID(ctx context.Context, input model.FindByIDBalanceDiscrepancyInput) (*model.APIResponseBalanceDiscrepancy, error) {
+	if err := requireRole(ctx, r.ReconciliationGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, reconciliation_errors.ErrGraphqlBalanceDiscrepancyInvalidID
+	}
+
+	discrepancy, errResp := r.ReconciliationGraphql.ReconciliationService.FindDiscrepancyById(id)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.ReconciliationGraphql.Mapping.ToGraphqlResponseBalanceDiscrepancy("success", "balance discrepancy retrieved successfully", discrepancy)
+
+	return so, nil
+}
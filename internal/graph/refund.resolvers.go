@@ -0,0 +1,130 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/refund_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transaction_errors"
+)
+
+// RefundTransaction is the resolver for the refundTransaction field.
+func (r *mutationResolver) RefundTransaction(ctx context.Context, input model.RefundTransactionInput) (*model.APIResponseRefund, error) {
+	if err := requireRole(ctx, r.RefundGraphql.Permission, "ROLE_ADMIN", "ROLE_MERCHANT"); err != nil {
+		return nil, err
+	}
+
+	req := requests.CreateRefundRequest{
+		TransactionID: int(input.TransactionID),
+		Amount:        int(input.Amount),
+		Reason:        input.Reason,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, refund_errors.ErrGraphqlValidateCreateRefund
+	}
+
+	requester, err := requestedBy(ctx, r.RefundGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	req.RequestedBy = requester
+
+	res, errResp := r.RefundGraphql.RefundService.RefundTransaction(&req)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.RefundGraphql.Mapping.ToGraphqlResponseRefund("success", "Successfully refunded transaction", res)
+
+	return so, nil
+}
+
+// FindAllRefund is the resolver for the findAllRefund field.
+func (r *queryResolver) FindAllRefund(ctx context.Context, input *model.FindAllRefundInput) (*model.APIResponsePaginationRefund, error) {
+	page := 1
+	pageSize := 10
+	search := ""
+
+	if input != nil {
+		if input.Page != nil && *input.Page > 0 {
+			page = int(*input.Page)
+		}
+		if input.PageSize != nil && *input.PageSize > 0 {
+			pageSize = int(*input.PageSize)
+		}
+		if input.Search != nil {
+			search = *input.Search
+		}
+	}
+
+	reqService := requests.FindAllRefunds{
+		Page:     page,
+		PageSize: pageSize,
+		Search:   search,
+	}
+
+	refunds, totalRecords, errResp := r.RefundGraphql.RefundService.FindAll(&reqService)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.RefundGraphql.Mapping.ToGraphqlResponsePaginationRefund("success", "refunds retrieved successfully", refunds, paginationMeta)
+
+	return so, nil
+}
+
+// FindByIDRefund is the resolver for the findByIdRefund field.
+func (r *queryResolver) FindByIDRefund(ctx context.Context, input model.FindByIDRefundInput) (*model.APIResponseRefund, error) {
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, refund_errors.ErrGraphqlRefundInvalidID
+	}
+
+	refund, err := r.RefundGraphql.RefundService.FindById(id)
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.RefundGraphql.Mapping.ToGraphqlResponseRefund("success", "Successfully fetched refund", refund)
+
+	return so, nil
+}
+
+// FindRefundsByTransactionID is the resolver for the findRefundsByTransactionId field.
+func (r *queryResolver) FindRefundsByTransactionID(ctx context.Context, transactionID int32) (*model.APIResponsesRefund, error) {
+	id := int(transactionID)
+
+	if id == 0 {
+		return nil, transaction_errors.ErrGraphqlTransactionInvalidID
+	}
+
+	refunds, err := r.RefundGraphql.RefundService.FindByTransactionId(id)
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.RefundGraphql.Mapping.ToGraphqlResponsesRefund("success", "Successfully fetched refunds by transaction", refunds)
+
+	return so, nil
+}
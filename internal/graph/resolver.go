@@ -1,9 +1,14 @@
 package graph
 
 import (
+	"context"
+	"fmt"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/graphql"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/permission"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/service"
+	mycontext "github.com/MamangRust/paymentgatewaygraphql/pkg/context"
 )
 
 // This file will not be regenerated automatically.
@@ -11,16 +16,33 @@ import (
 // It serves as dependency injection for your app, add any dependencies you require here.
 
 type Resolver struct {
-	AuthGraphql        AuthHandleGraphql
-	RoleGraphql        RoleHandleGraphql
-	UserGraphql        UserHandleGraphql
-	CardGraphql        CardHandleGraphql
-	MerchantGraphql    MerchantHandleGraphql
-	SaldoGraphql       SaldoHandleGraphql
-	TopupGraphql       TopupHandleGraphql
-	TransactionGraphql TransactionHandleGraphql
-	TransferGraphql    TransferHandleGraphql
-	WithdrawGraphql    WithdrawHandleGraphql
+	AuthGraphql               AuthHandleGraphql
+	RoleGraphql               RoleHandleGraphql
+	UserGraphql               UserHandleGraphql
+	CardGraphql               CardHandleGraphql
+	MerchantGraphql           MerchantHandleGraphql
+	SaldoGraphql              SaldoHandleGraphql
+	TopupGraphql              TopupHandleGraphql
+	TransactionGraphql        TransactionHandleGraphql
+	TransferGraphql           TransferHandleGraphql
+	WithdrawGraphql           WithdrawHandleGraphql
+	LedgerGraphql             LedgerHandleGraphql
+	RefundGraphql             RefundHandleGraphql
+	DisputeGraphql            DisputeHandleGraphql
+	AuthorizationGraphql      AuthorizationHandleGraphql
+	ExchangeRateGraphql       ExchangeRateHandleGraphql
+	FeeScheduleGraphql        FeeScheduleHandleGraphql
+	TransactionLimitGraphql   TransactionLimitHandleGraphql
+	ScheduledTransferGraphql  ScheduledTransferHandleGraphql
+	TransferBatchGraphql      TransferBatchHandleGraphql
+	StatusHistoryGraphql      StatusHistoryHandleGraphql
+	ProviderWebhookGraphql    ProviderWebhookHandleGraphql
+	MerchantWebhookGraphql    MerchantWebhookHandleGraphql
+	SettlementGraphql         SettlementHandleGraphql
+	ReconciliationGraphql     ReconciliationHandleGraphql
+	MerchantOnboardingGraphql MerchantOnboardingHandleGraphql
+	MerchantApiKeyGraphql     MerchantApiKeyHandleGraphql
+	InvoiceGraphql            InvoiceHandleGraphql
 }
 
 type AuthHandleGraphql struct {
@@ -36,6 +58,7 @@ type RoleHandleGraphql struct {
 type UserHandleGraphql struct {
 	UserService service.UserService
 	Mapping     graphql.UserGraphqlMapper
+	Permission  permission.Permission
 }
 
 type CardHandleGraphql struct {
@@ -51,27 +74,138 @@ type MerchantHandleGraphql struct {
 type SaldoHandleGraphql struct {
 	SaldoService service.SaldoService
 	Mapping      graphql.SaldoGraphqMapper
+	Permission   permission.Permission
 }
 
 type TopupHandleGraphql struct {
-	TopupService service.TopupService
-	Mapping      graphql.TopupGraphqlMapper
+	TopupService          service.TopupService
+	Mapping               graphql.TopupGraphqlMapper
+	IdempotencyKeyService service.IdempotencyKeyService
 }
 
 type TransactionHandleGraphql struct {
-	TransactionService service.TransactionService
-	Mapping            graphql.TransactionGraphqlMapper
-	Permission         permission.Permission
+	TransactionService    service.TransactionService
+	Mapping               graphql.TransactionGraphqlMapper
+	Permission            permission.Permission
+	IdempotencyKeyService service.IdempotencyKeyService
 }
 
 type TransferHandleGraphql struct {
-	TransferService service.TransferService
-	Mapping         graphql.TransferGraphqlMapper
+	TransferService       service.TransferService
+	Mapping               graphql.TransferGraphqlMapper
+	IdempotencyKeyService service.IdempotencyKeyService
 }
 
 type WithdrawHandleGraphql struct {
-	WithdrawService service.WithdrawService
-	Mapping         graphql.WithdrawGraphqlMapper
+	WithdrawService       service.WithdrawService
+	Mapping               graphql.WithdrawGraphqlMapper
+	Permission            permission.Permission
+	IdempotencyKeyService service.IdempotencyKeyService
+}
+
+type LedgerHandleGraphql struct {
+	LedgerService service.LedgerService
+	Mapping       graphql.LedgerGraphqlMapper
+	Permission    permission.Permission
+}
+
+type RefundHandleGraphql struct {
+	RefundService service.RefundService
+	Mapping       graphql.RefundGraphqlMapper
+	Permission    permission.Permission
+}
+
+type DisputeHandleGraphql struct {
+	DisputeService service.DisputeService
+	Mapping        graphql.DisputeGraphqlMapper
+	Permission     permission.Permission
+}
+
+type AuthorizationHandleGraphql struct {
+	AuthorizationService  service.AuthorizationService
+	Mapping               graphql.AuthorizationGraphqlMapper
+	Permission            permission.Permission
+	IdempotencyKeyService service.IdempotencyKeyService
+}
+
+type ExchangeRateHandleGraphql struct {
+	ExchangeRateService service.ExchangeRateService
+	Mapping             graphql.ExchangeRateGraphqlMapper
+	Permission          permission.Permission
+}
+
+type FeeScheduleHandleGraphql struct {
+	FeeScheduleService service.FeeScheduleService
+	Mapping            graphql.FeeScheduleGraphqlMapper
+	Permission         permission.Permission
+}
+
+type TransactionLimitHandleGraphql struct {
+	TransactionLimitService service.TransactionLimitService
+	Mapping                 graphql.TransactionLimitGraphqlMapper
+	Permission              permission.Permission
+}
+
+type ScheduledTransferHandleGraphql struct {
+	ScheduledTransferService service.ScheduledTransferService
+	Mapping                  graphql.ScheduledTransferGraphqlMapper
+	Permission               permission.Permission
+}
+
+type TransferBatchHandleGraphql struct {
+	TransferBatchService  service.TransferBatchService
+	Mapping               graphql.TransferBatchGraphqlMapper
+	Permission            permission.Permission
+	IdempotencyKeyService service.IdempotencyKeyService
+}
+
+type StatusHistoryHandleGraphql struct {
+	StatusHistoryService service.StatusHistoryService
+	Mapping              graphql.StatusHistoryGraphqlMapper
+	Permission           permission.Permission
+}
+
+type ProviderWebhookHandleGraphql struct {
+	ProviderWebhookService service.ProviderWebhookService
+	Mapping                graphql.ProviderWebhookGraphqlMapper
+	Permission             permission.Permission
+}
+
+type MerchantWebhookHandleGraphql struct {
+	MerchantWebhookService service.MerchantWebhookService
+	Mapping                graphql.MerchantWebhookGraphqlMapper
+	Permission             permission.Permission
+}
+
+type SettlementHandleGraphql struct {
+	SettlementService service.SettlementService
+	Mapping           graphql.SettlementGraphqlMapper
+	Permission        permission.Permission
+}
+
+type ReconciliationHandleGraphql struct {
+	ReconciliationService service.ReconciliationService
+	Mapping               graphql.ReconciliationGraphqlMapper
+	Permission            permission.Permission
+}
+
+type MerchantOnboardingHandleGraphql struct {
+	MerchantOnboardingService service.MerchantOnboardingService
+	Mapping                   graphql.MerchantOnboardingGraphqlMapper
+	Permission                permission.Permission
+}
+
+type MerchantApiKeyHandleGraphql struct {
+	MerchantApiKeyService service.MerchantApiKeyService
+	Mapping               graphql.MerchantApiKeyGraphqlMapper
+	Permission            permission.Permission
+}
+
+type InvoiceHandleGraphql struct {
+	InvoiceService        service.InvoiceService
+	Mapping               graphql.InvoiceGraphqlMapper
+	Permission            permission.Permission
+	IdempotencyKeyService service.IdempotencyKeyService
 }
 
 func NewResolver(
@@ -85,6 +219,24 @@ func NewResolver(
 	transactionService service.TransactionService,
 	transferService service.TransferService,
 	withdrawService service.WithdrawService,
+	ledgerService service.LedgerService,
+	idempotencyKeyService service.IdempotencyKeyService,
+	refundService service.RefundService,
+	disputeService service.DisputeService,
+	authorizationService service.AuthorizationService,
+	exchangeRateService service.ExchangeRateService,
+	feeScheduleService service.FeeScheduleService,
+	transactionLimitService service.TransactionLimitService,
+	scheduledTransferService service.ScheduledTransferService,
+	transferBatchService service.TransferBatchService,
+	statusHistoryService service.StatusHistoryService,
+	providerWebhookService service.ProviderWebhookService,
+	merchantWebhookService service.MerchantWebhookService,
+	settlementService service.SettlementService,
+	reconciliationService service.ReconciliationService,
+	merchantOnboardingService service.MerchantOnboardingService,
+	merchantApiKeyService service.MerchantApiKeyService,
+	invoiceService service.InvoiceService,
 	mapper *graphql.GraphqlMapper,
 	permission permission.Permission,
 ) *Resolver {
@@ -100,6 +252,7 @@ func NewResolver(
 		UserGraphql: UserHandleGraphql{
 			UserService: userService,
 			Mapping:     mapper.UserGraphqlMapper,
+			Permission:  permission,
 		},
 		CardGraphql: CardHandleGraphql{
 			CardService: cardService,
@@ -112,23 +265,173 @@ func NewResolver(
 		SaldoGraphql: SaldoHandleGraphql{
 			SaldoService: saldoService,
 			Mapping:      mapper.SaldoGraphqMapper,
+			Permission:   permission,
 		},
 		TopupGraphql: TopupHandleGraphql{
-			TopupService: topupService,
-			Mapping:      mapper.TopupGraphqlMapper,
+			TopupService:          topupService,
+			Mapping:               mapper.TopupGraphqlMapper,
+			IdempotencyKeyService: idempotencyKeyService,
 		},
 		TransactionGraphql: TransactionHandleGraphql{
-			TransactionService: transactionService,
-			Mapping:            mapper.TransactionGraphqlMapper,
-			Permission:         permission,
+			TransactionService:    transactionService,
+			Mapping:               mapper.TransactionGraphqlMapper,
+			Permission:            permission,
+			IdempotencyKeyService: idempotencyKeyService,
 		},
 		TransferGraphql: TransferHandleGraphql{
-			TransferService: transferService,
-			Mapping:         mapper.TransferGraphqlMapper,
+			TransferService:       transferService,
+			Mapping:               mapper.TransferGraphqlMapper,
+			IdempotencyKeyService: idempotencyKeyService,
 		},
 		WithdrawGraphql: WithdrawHandleGraphql{
-			WithdrawService: withdrawService,
-			Mapping:         mapper.WithdrawGraphqlMapper,
+			WithdrawService:       withdrawService,
+			Mapping:               mapper.WithdrawGraphqlMapper,
+			Permission:            permission,
+			IdempotencyKeyService: idempotencyKeyService,
+		},
+		LedgerGraphql: LedgerHandleGraphql{
+			LedgerService: ledgerService,
+			Mapping:       mapper.LedgerGraphqlMapper,
+			Permission:    permission,
+		},
+		RefundGraphql: RefundHandleGraphql{
+			RefundService: refundService,
+			Mapping:       mapper.RefundGraphqlMapper,
+			Permission:    permission,
 		},
+		DisputeGraphql: DisputeHandleGraphql{
+			DisputeService: disputeService,
+			Mapping:        mapper.DisputeGraphqlMapper,
+			Permission:     permission,
+		},
+		AuthorizationGraphql: AuthorizationHandleGraphql{
+			AuthorizationService:  authorizationService,
+			Mapping:               mapper.AuthorizationGraphqlMapper,
+			Permission:            permission,
+			IdempotencyKeyService: idempotencyKeyService,
+		},
+		ExchangeRateGraphql: ExchangeRateHandleGraphql{
+			ExchangeRateService: exchangeRateService,
+			Mapping:             mapper.ExchangeRateGraphqlMapper,
+			Permission:          permission,
+		},
+		FeeScheduleGraphql: FeeScheduleHandleGraphql{
+			FeeScheduleService: feeScheduleService,
+			Mapping:            mapper.FeeScheduleGraphqlMapper,
+			Permission:         permission,
+		},
+		TransactionLimitGraphql: TransactionLimitHandleGraphql{
+			TransactionLimitService: transactionLimitService,
+			Mapping:                 mapper.TransactionLimitGraphqlMapper,
+			Permission:              permission,
+		},
+		ScheduledTransferGraphql: ScheduledTransferHandleGraphql{
+			ScheduledTransferService: scheduledTransferService,
+			Mapping:                  mapper.ScheduledTransferGraphqlMapper,
+			Permission:               permission,
+		},
+		TransferBatchGraphql: TransferBatchHandleGraphql{
+			TransferBatchService:  transferBatchService,
+			Mapping:               mapper.TransferBatchGraphqlMapper,
+			Permission:            permission,
+			IdempotencyKeyService: idempotencyKeyService,
+		},
+		StatusHistoryGraphql: StatusHistoryHandleGraphql{
+			StatusHistoryService: statusHistoryService,
+			Mapping:              mapper.StatusHistoryGraphqlMapper,
+			Permission:           permission,
+		},
+		ProviderWebhookGraphql: ProviderWebhookHandleGraphql{
+			ProviderWebhookService: providerWebhookService,
+			Mapping:                mapper.ProviderWebhookGraphqlMapper,
+			Permission:             permission,
+		},
+		MerchantWebhookGraphql: MerchantWebhookHandleGraphql{
+			MerchantWebhookService: merchantWebhookService,
+			Mapping:                mapper.MerchantWebhookGraphqlMapper,
+			Permission:             permission,
+		},
+		SettlementGraphql: SettlementHandleGraphql{
+			SettlementService: settlementService,
+			Mapping:           mapper.SettlementGraphqlMapper,
+			Permission:        permission,
+		},
+		ReconciliationGraphql: ReconciliationHandleGraphql{
+			ReconciliationService: reconciliationService,
+			Mapping:               mapper.ReconciliationGraphqlMapper,
+			Permission:            permission,
+		},
+		MerchantOnboardingGraphql: MerchantOnboardingHandleGraphql{
+			MerchantOnboardingService: merchantOnboardingService,
+			Mapping:                   mapper.MerchantOnboardingGraphqlMapper,
+			Permission:                permission,
+		},
+		MerchantApiKeyGraphql: MerchantApiKeyHandleGraphql{
+			MerchantApiKeyService: merchantApiKeyService,
+			Mapping:               mapper.MerchantApiKeyGraphqlMapper,
+			Permission:            permission,
+		},
+		InvoiceGraphql: InvoiceHandleGraphql{
+			InvoiceService:        invoiceService,
+			Mapping:               mapper.InvoiceGraphqlMapper,
+			Permission:            permission,
+			IdempotencyKeyService: idempotencyKeyService,
+		},
+	}
+}
+
+// requireRole rejects the request unless the authenticated user holds one of
+// the given roles.
+func requireRole(ctx context.Context, p permission.Permission, roles ...string) error {
+	uid, ok := mycontext.UserForContext(ctx)
+
+	if !ok || uid == 0 {
+		return fmt.Errorf("unauthorized: user ID not found in request context")
 	}
+
+	allowed, err := p.HasRole(uid, roles...)
+
+	if err != nil {
+		return fmt.Errorf("failed to verify user role: %w", err)
+	}
+	if !allowed {
+		return fmt.Errorf("unauthorized: insufficient role")
+	}
+
+	return nil
+}
+
+// requestedBy returns the authenticated user ID for ownership checks in the
+// service layer, or nil when the user is an admin and may act on any record.
+func requestedBy(ctx context.Context, p permission.Permission) (*int, error) {
+	uid, ok := mycontext.UserForContext(ctx)
+
+	if !ok || uid == 0 {
+		return nil, fmt.Errorf("unauthorized: user ID not found in request context")
+	}
+
+	isAdmin, err := p.HasRole(uid, "ROLE_ADMIN")
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify user role: %w", err)
+	}
+	if isAdmin {
+		return nil, nil
+	}
+
+	return &uid, nil
+}
+
+// merchantCredential returns what a merchant call authenticates with: the
+// key its request was signed with, or else the api_key of its input.
+func merchantCredential(ctx context.Context, apiKey *string) requests.MerchantApiKeyCredential {
+	if apiKeyID, ok := mycontext.MerchantApiKeyIDFromContext(ctx); ok {
+		return requests.MerchantApiKeyCredential{ApiKeyID: apiKeyID}
+	}
+
+	if apiKey != nil {
+		return requests.MerchantApiKeyCredential{ApiKey: *apiKey}
+	}
+
+	return requests.MerchantApiKeyCredential{}
 }
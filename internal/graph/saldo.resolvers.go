@@ -11,6 +11,7 @@ import (
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	mycontext "github.com/MamangRust/paymentgatewaygraphql/pkg/context"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
 )
 
@@ -146,6 +147,95 @@ func (r *mutationResolver) DeleteAllSaldoPermanent(ctx context.Context) (*model.
 	return so, nil
 }
 
+// RebuildSaldoFromLedger is the resolver for the rebuildSaldoFromLedger field.
+func (r *mutationResolver) RebuildSaldoFromLedger(ctx context.Context) (*model.APIResponsesSaldo, error) {
+	if err := requireRole(ctx, r.SaldoGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	saldos, err := r.SaldoGraphql.SaldoService.RebuildFromLedger()
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.SaldoGraphql.Mapping.ToGraphqlResponsesSaldo("success", "Successfully rebuilt saldo balances from ledger", saldos)
+
+	return so, nil
+}
+
+// CreateSaldoHold is the resolver for the createSaldoHold field.
+func (r *mutationResolver) CreateSaldoHold(ctx context.Context, input model.CreateSaldoHoldInput) (*model.APIResponseSaldoHold, error) {
+	if err := requireRole(ctx, r.SaldoGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	uid, _ := mycontext.UserForContext(ctx)
+
+	req := requests.CreateSaldoHoldRequest{
+		CardNumber: input.CardNumber,
+		Amount:     int(input.Amount),
+		Reason:     input.Reason,
+		CreatedBy:  &uid,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, saldo_errors.ErrGraphqlValidateCreateSaldoHold
+	}
+
+	hold, err := r.SaldoGraphql.SaldoService.CreateHold(&req)
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.SaldoGraphql.Mapping.ToGraphqlResponseSaldoHold("success", "Successfully placed saldo hold", hold)
+
+	return so, nil
+}
+
+// ReleaseSaldoHold is the resolver for the releaseSaldoHold field.
+func (r *mutationResolver) ReleaseSaldoHold(ctx context.Context, id int32) (*model.APIResponseSaldoHold, error) {
+	if err := requireRole(ctx, r.SaldoGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	if id <= 0 {
+		return nil, saldo_errors.ErrGraphqlSaldoHoldInvalidID
+	}
+
+	hold, err := r.SaldoGraphql.SaldoService.ReleaseHold(int(id))
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.SaldoGraphql.Mapping.ToGraphqlResponseSaldoHold("success", "Successfully released saldo hold", hold)
+
+	return so, nil
+}
+
+// ConsumeSaldoHold is the resolver for the consumeSaldoHold field.
+func (r *mutationResolver) ConsumeSaldoHold(ctx context.Context, id int32) (*model.APIResponseSaldoHold, error) {
+	if err := requireRole(ctx, r.SaldoGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	if id <= 0 {
+		return nil, saldo_errors.ErrGraphqlSaldoHoldInvalidID
+	}
+
+	hold, err := r.SaldoGraphql.SaldoService.ConsumeHold(int(id))
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.SaldoGraphql.Mapping.ToGraphqlResponseSaldoHold("success", "Successfully consumed saldo hold", hold)
+
+	return so, nil
+}
+
 // FindAllSaldo is the resolver for the findAllSaldo field.
 func (r *queryResolver) FindAllSaldo(ctx context.Context, input *model.FindAllSaldoInput) (*model.APIResponsePaginationSaldo, error) {
 	page := int(*input.Page)
@@ -378,3 +468,24 @@ func (r *queryResolver) FindByTrashedSaldo(ctx context.Context, input *model.Fin
 
 	return so, nil
 }
+
+// FindActiveSaldoHoldsByCardNumber is the resolver for the findActiveSaldoHoldsByCardNumber field.
+func (r *queryResolver) FindActiveSaldoHoldsByCardNumber(ctx context.Context, cardNumber string) (*model.APIResponsesSaldoHold, error) {
+	if err := requireRole(ctx, r.SaldoGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	if cardNumber == "" {
+		return nil, saldo_errors.ErrGraphqlSaldoInvalidCardNumber
+	}
+
+	holds, err := r.SaldoGraphql.SaldoService.FindActiveHoldsByCardNumber(cardNumber)
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.SaldoGraphql.Mapping.ToGraphqlResponsesSaldoHold("success", "Successfully fetched active saldo holds", holds)
+
+	return so, nil
+}
@@ -0,0 +1,22 @@
+package graph
+
+import "time"
+
+// scheduleWindow parses the RFC 3339 start and optional end of a schedule.
+func scheduleWindow(startAt string, endAt *string) (time.Time, *time.Time, error) {
+	start, err := time.Parse(time.RFC3339, startAt)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	if endAt == nil {
+		return start, nil, nil
+	}
+
+	end, err := time.Parse(time.RFC3339, *endAt)
+	if err != nil {
+		return time.Time{}, nil, err
+	}
+
+	return start, &end, nil
+}
@@ -0,0 +1,283 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/scheduled_transfer_errors"
+)
+
+// CreateScheduledTransfer is the resolver for the createScheduledTransfer field.
+func (r *mutationResolver) CreateScheduledTransfer(ctx context.Context, input model.CreateScheduledTransferInput) (*model.APIResponseScheduledTransfer, error) {
+	requester, err := requestedBy(ctx, r.ScheduledTransferGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	startAt, endAt, err := scheduleWindow(input.StartAt, input.EndAt)
+	if err != nil {
+		return nil, scheduled_transfer_errors.ErrGraphqlInvalidScheduleTime
+	}
+
+	req := requests.CreateScheduledTransferRequest{
+		TransferFrom:   input.TransferFrom,
+		TransferTo:     input.TransferTo,
+		TransferAmount: int(input.TransferAmount),
+		Recurrence:     input.Recurrence,
+		DayOfMonth:     optionalInt(input.DayOfMonth),
+		StartAt:        startAt,
+		EndAt:          endAt,
+		RequestedBy:    requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, scheduled_transfer_errors.ErrGraphqlValidateCreateScheduledTransfer
+	}
+
+	res, errResp := r.ScheduledTransferGraphql.ScheduledTransferService.CreateScheduledTransfer(&req)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.ScheduledTransferGraphql.Mapping.ToGraphqlResponseScheduledTransfer("success", "Successfully created scheduled transfer", res)
+
+	return so, nil
+}
+
+// UpdateScheduledTransfer is the resolver for the updateScheduledTransfer field.
+func (r *mutationResolver) UpdateScheduledTransfer(ctx context.Context, input model.UpdateScheduledTransferInput) (*model.APIResponseScheduledTransfer, error) {
+	requester, err := requestedBy(ctx, r.ScheduledTransferGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	startAt, endAt, err := scheduleWindow(input.StartAt, input.EndAt)
+	if err != nil {
+		return nil, scheduled_transfer_errors.ErrGraphqlInvalidScheduleTime
+	}
+
+	id := int(input.ID)
+
+	req := requests.UpdateScheduledTransferRequest{
+		ScheduledTransferID: &id,
+		TransferTo:          input.TransferTo,
+		TransferAmount:      int(input.TransferAmount),
+		Recurrence:          input.Recurrence,
+		DayOfMonth:          optionalInt(input.DayOfMonth),
+		StartAt:             startAt,
+		EndAt:               endAt,
+		RequestedBy:         requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, scheduled_transfer_errors.ErrGraphqlValidateUpdateScheduledTransfer
+	}
+
+	res, errResp := r.ScheduledTransferGraphql.ScheduledTransferService.UpdateScheduledTransfer(&req)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.ScheduledTransferGraphql.Mapping.ToGraphqlResponseScheduledTransfer("success", "Successfully updated scheduled transfer", res)
+
+	return so, nil
+}
+
+// PauseScheduledTransfer is the resolver for the pauseScheduledTransfer field.
+func (r *mutationResolver) PauseScheduledTransfer(ctx context.Context, input model.FindByIDScheduledTransferInput) (*model.APIResponseScheduledTransfer, error) {
+	requester, err := requestedBy(ctx, r.ScheduledTransferGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, scheduled_transfer_errors.ErrGraphqlScheduledTransferInvalidID
+	}
+
+	res, errResp := r.ScheduledTransferGraphql.ScheduledTransferService.PauseScheduledTransfer(id, requester)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.ScheduledTransferGraphql.Mapping.ToGraphqlResponseScheduledTransfer("success", "Successfully paused scheduled transfer", res)
+
+	return so, nil
+}
+
+// ResumeScheduledTransfer is the resolver for the resumeScheduledTransfer field.
+func (r *mutationResolver) ResumeScheduledTransfer(ctx context.Context, input model.FindByIDScheduledTransferInput) (*model.APIResponseScheduledTransfer, error) {
+	requester, err := requestedBy(ctx, r.ScheduledTransferGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, scheduled_transfer_errors.ErrGraphqlScheduledTransferInvalidID
+	}
+
+	res, errResp := r.ScheduledTransferGraphql.ScheduledTransferService.ResumeScheduledTransfer(id, requester)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.ScheduledTransferGraphql.Mapping.ToGraphqlResponseScheduledTransfer("success", "Successfully resumed scheduled transfer", res)
+
+	return so, nil
+}
+
+// CancelScheduledTransfer is the resolver for the cancelScheduledTransfer field.
+func (r *mutationResolver) CancelScheduledTransfer(ctx context.Context, input model.FindByIDScheduledTransferInput) (*model.APIResponseScheduledTransfer, error) {
+	requester, err := requestedBy(ctx, r.ScheduledTransferGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, scheduled_transfer_errors.ErrGraphqlScheduledTransferInvalidID
+	}
+
+	res, errResp := r.ScheduledTransferGraphql.ScheduledTransferService.CancelScheduledTransfer(id, requester)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.ScheduledTransferGraphql.Mapping.ToGraphqlResponseScheduledTransfer("success", "Successfully cancelled scheduled transfer", res)
+
+	return so, nil
+}
+
+// FindAllScheduledTransfer is the resolver for the findAllScheduledTransfer field.
+func (r *queryResolver) FindAllScheduledTransfer(ctx context.Context, input *model.FindAllScheduledTransferInput) (*model.APIResponsePaginationScheduledTransfer, error) {
+	requester, err := requestedBy(ctx, r.ScheduledTransferGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+	search := ""
+
+	if input != nil {
+		if input.Page != nil && *input.Page > 0 {
+			page = int(*input.Page)
+		}
+		if input.PageSize != nil && *input.PageSize > 0 {
+			pageSize = int(*input.PageSize)
+		}
+		if input.Search != nil {
+			search = *input.Search
+		}
+	}
+
+	reqService := requests.FindAllScheduledTransfers{
+		Page:        page,
+		PageSize:    pageSize,
+		Search:      search,
+		RequestedBy: requester,
+	}
+
+	schedules, totalRecords, errResp := r.ScheduledTransferGraphql.ScheduledTransferService.FindAll(&reqService)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.ScheduledTransferGraphql.Mapping.ToGraphqlResponsePaginationScheduledTransfer("success", "scheduled transfers retrieved successfully", schedules, paginationMeta)
+
+	return so, nil
+}
+
+// FindByIDScheduledTransfer is the resolver for the findByIdScheduledTransfer field.
+func (r *queryResolver) FindByIDScheduledTransfer(ctx context.Context, input model.FindByIDScheduledTransferInput) (*model.APIResponseScheduledTransfer, error) {
+	requester, err := requestedBy(ctx, r.ScheduledTransferGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, scheduled_transfer_errors.ErrGraphqlScheduledTransferInvalidID
+	}
+
+	schedule, errResp := r.ScheduledTransferGraphql.ScheduledTransferService.FindById(id, requester)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.ScheduledTransferGraphql.Mapping.ToGraphqlResponseScheduledTransfer("success", "Successfully fetched scheduled transfer", schedule)
+
+	return so, nil
+}
+
+// FindScheduledTransferRuns is the resolver for the findScheduledTransferRuns field.
+func (r *queryResolver) FindScheduledTransferRuns(ctx context.Context, input model.FindScheduledTransferRunsInput) (*model.APIResponsePaginationScheduledTransferRun, error) {
+	requester, err := requestedBy(ctx, r.ScheduledTransferGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+
+	if input.Page != nil && *input.Page > 0 {
+		page = int(*input.Page)
+	}
+	if input.PageSize != nil && *input.PageSize > 0 {
+		pageSize = int(*input.PageSize)
+	}
+
+	req := requests.FindScheduledTransferRuns{
+		ScheduledTransferID: int(input.ScheduledTransferID),
+		Page:                page,
+		PageSize:            pageSize,
+		RequestedBy:         requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, scheduled_transfer_errors.ErrGraphqlValidateScheduledTransferRuns
+	}
+
+	runs, totalRecords, errResp := r.ScheduledTransferGraphql.ScheduledTransferService.FindRuns(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.ScheduledTransferGraphql.Mapping.ToGraphqlResponsePaginationScheduledTransferRun("success", "scheduled transfer runs retrieved successfully", runs, paginationMeta)
+
+	return so, nil
+}
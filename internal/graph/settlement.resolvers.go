@@ -0,0 +1,158 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/settlement_errors"
+)
+
+// SetSettlementCard is the resolver for the setSettlementCard field.
+func (r *mutationResolver) SetSettlementCard(ctx context.Context, input model.SetSettlementCardInput) (*model.APIResponseMerchantBalance, error) {
+	requester, err := requestedBy(ctx, r.SettlementGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	req := requests.SetSettlementCardRequest{
+		MerchantID:  int(input.MerchantID),
+		CardNumber:  input.CardNumber,
+		RequestedBy: requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, settlement_errors.ErrGraphqlValidateSetSettlementCard
+	}
+
+	balance, errResp := r.SettlementGraphql.SettlementService.SetSettlementCard(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.SettlementGraphql.Mapping.ToGraphqlResponseMerchantBalance("success", "Successfully set settlement card", balance)
+
+	return so, nil
+}
+
+// FindMerchantBalances is the resolver for the findMerchantBalances field.
+func (r *queryResolver) FindMerchantBalances(ctx context.Context, input model.FindMerchantBalancesInput) (*model.APIResponseMerchantBalances, error) {
+	requester, err := requestedBy(ctx, r.SettlementGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	merchantID := int(input.MerchantID)
+
+	if merchantID == 0 {
+		return nil, merchant_errors.ErrGraphqlMerchantInvalidID
+	}
+
+	balances, errResp := r.SettlementGraphql.SettlementService.FindBalances(merchantID, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.SettlementGraphql.Mapping.ToGraphqlResponseMerchantBalances("success", "merchant balances retrieved successfully", balances)
+
+	return so, nil
+}
+
+// FindSettlementBatches is the resolver for the findSettlementBatches field.
+func (r *queryResolver) FindSettlementBatches(ctx context.Context, input model.FindSettlementBatchesInput) (*model.APIResponsePaginationSettlementBatch, error) {
+	requester, err := requestedBy(ctx, r.SettlementGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+
+	if input.Page != nil && *input.Page > 0 {
+		page = int(*input.Page)
+	}
+	if input.PageSize != nil && *input.PageSize > 0 {
+		pageSize = int(*input.PageSize)
+	}
+
+	req := requests.FindSettlementBatches{
+		MerchantID:  int(input.MerchantID),
+		Page:        page,
+		PageSize:    pageSize,
+		RequestedBy: requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, settlement_errors.ErrGraphqlValidateFindSettlementBatch
+	}
+
+	batches, totalRecords, errResp := r.SettlementGraphql.SettlementService.FindBatches(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.SettlementGraphql.Mapping.ToGraphqlResponsePaginationSettlementBatch("success", "settlement batches retrieved successfully", batches, paginationMeta)
+
+	return so, nil
+}
+
+// FindSettlementBatchByID is the resolver for the findSettlementBatchById field.
+func (r *queryResolver) FindSettlementBatchByID(ctx context.Context, input model.FindByIDSettlementBatchInput) (*model.APIResponseSettlementBatch, error) {
+	requester, err := requestedBy(ctx, r.SettlementGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, settlement_errors.ErrGraphqlSettlementBatchInvalidID
+	}
+
+	batch, errResp := r.SettlementGraphql.SettlementService.FindBatchById(id, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.SettlementGraphql.Mapping.ToGraphqlResponseSettlementBatch("success", "settlement batch retrieved successfully", batch)
+
+	return so, nil
+}
+
+// FindSettlementBatchItems is the resolver for the findSettlementBatchItems field.
+func (r *queryResolver) FindSettlementBatchItems(ctx context.Context, input model.FindByIDSettlementBatchInput) (*model.APIResponseSettlementItems, error) {
+	requester, err := requestedBy(ctx, r.SettlementGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, settlement_errors.ErrGraphqlSettlementBatchInvalidID
+	}
+
+	items, errResp := r.SettlementGraphql.SettlementService.FindBatchItems(id, requester)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.SettlementGraphql.Mapping.ToGraphqlResponseSettlementItems("success", "settlement batch items retrieved successfully", items)
+
+	return so, nil
+}
@@ -0,0 +1,41 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/status_history_errors"
+)
+
+// FindStatusHistory is the resolver for the findStatusHistory field.
+func (r *queryResolver) FindStatusHistory(ctx context.Context, input model.FindStatusHistoryInput) (*model.APIResponseStatusHistory, error) {
+	requester, err := requestedBy(ctx, r.StatusHistoryGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	req := requests.FindStatusHistory{
+		EntityType:  input.EntityType,
+		EntityID:    int(input.EntityID),
+		RequestedBy: requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, status_history_errors.ErrGraphqlValidateFindStatusHistory
+	}
+
+	histories, errResp := r.StatusHistoryGraphql.StatusHistoryService.FindByEntity(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.StatusHistoryGraphql.Mapping.ToGraphqlResponseStatusHistory("success", "Successfully fetched status history", histories)
+
+	return so, nil
+}
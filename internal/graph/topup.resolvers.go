@@ -26,15 +26,20 @@ func (r *mutationResolver) CreateTopup(ctx context.Context, input model.CreateTo
 		return nil, topup_errors.ErrGraphqlValidateCreateTopup
 	}
 
-	res, err := r.TopupGraphql.TopupService.CreateTopup(&request)
+	payload := input
+	payload.IdempotencyKey = nil
 
-	if err != nil {
-		return nil, response.ToGraphqlErrorFromErrorResponse(err)
-	}
+	return withIdempotency(ctx, r.TopupGraphql.IdempotencyKeyService, requests.IdempotencyScopeCreateTopup, input.IdempotencyKey, payload, func() (*model.APIResponseTopup, error) {
+		res, err := r.TopupGraphql.TopupService.CreateTopup(&request)
 
-	so := r.TopupGraphql.Mapping.ToGraphqlResponseTopup("success", "Successfully created topup", res)
+		if err != nil {
+			return nil, response.ToGraphqlErrorFromErrorResponse(err)
+		}
 
-	return so, nil
+		so := r.TopupGraphql.Mapping.ToGraphqlResponseTopup("success", "Successfully created topup", res)
+
+		return so, nil
+	})
 }
 
 // UpdateTopup is the resolver for the updateTopup field.
@@ -18,7 +18,9 @@ import (
 
 // CreateTransaction is the resolver for the createTransaction field.
 func (r *mutationResolver) CreateTransaction(ctx context.Context, input model.CreateTransactionRequest) (*model.APIResponseTransaction, error) {
-	ok, err := r.TransactionGraphql.Permission.ValidateApiKey(input.APIKey)
+	credential := merchantCredential(ctx, input.APIKey)
+
+	ok, err := r.TransactionGraphql.Permission.ValidateApiKey(credential, requests.MerchantApiKeyScopeCreateTransactions)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate API key: %w", err)
@@ -45,15 +47,20 @@ func (r *mutationResolver) CreateTransaction(ctx context.Context, input model.Cr
 		return nil, transaction_errors.ErrGraphqlValidateCreateTransactionRequest
 	}
 
-	res, errResp := r.TransactionGraphql.TransactionService.Create(input.APIKey, &req)
+	payload := input
+	payload.IdempotencyKey = nil
 
-	if errResp != nil {
-		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
-	}
+	return withIdempotency(ctx, r.TransactionGraphql.IdempotencyKeyService, requests.IdempotencyScopeCreateTransaction, input.IdempotencyKey, payload, func() (*model.APIResponseTransaction, error) {
+		res, errResp := r.TransactionGraphql.TransactionService.Create(credential, &req)
 
-	so := r.TransactionGraphql.Mapping.ToGraphqlResponseTransaction("success", "Successfully created transaction", res)
+		if errResp != nil {
+			return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+		}
 
-	return so, nil
+		so := r.TransactionGraphql.Mapping.ToGraphqlResponseTransaction("success", "Successfully created transaction", res)
+
+		return so, nil
+	})
 }
 
 // UpdateTransaction is the resolver for the updateTransaction field.
@@ -64,7 +71,9 @@ func (r *mutationResolver) UpdateTransaction(ctx context.Context, input model.Up
 		return nil, transaction_errors.ErrGraphqlTransactionInvalidID
 	}
 
-	ok, err := r.TransactionGraphql.Permission.ValidateApiKey(input.APIKey)
+	credential := merchantCredential(ctx, input.APIKey)
+
+	ok, err := r.TransactionGraphql.Permission.ValidateApiKey(credential, requests.MerchantApiKeyScopeCreateTransactions)
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to validate API key: %w", err)
@@ -92,7 +101,7 @@ func (r *mutationResolver) UpdateTransaction(ctx context.Context, input model.Up
 		return nil, transaction_errors.ErrGraphqlValidateCreateTransactionRequest
 	}
 
-	res, errResp := r.TransactionGraphql.TransactionService.Update(input.APIKey, &req)
+	res, errResp := r.TransactionGraphql.TransactionService.Update(credential, &req)
 
 	if errResp != nil {
 		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
@@ -0,0 +1,214 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transaction_limit_errors"
+)
+
+// CreateTransactionLimit is the resolver for the createTransactionLimit field.
+func (r *mutationResolver) CreateTransactionLimit(ctx context.Context, input model.CreateTransactionLimitInput) (*model.APIResponseTransactionLimit, error) {
+	if err := requireRole(ctx, r.TransactionLimitGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	req := requests.CreateTransactionLimitRequest{
+		Name:                    input.Name,
+		OperationType:           input.OperationType,
+		Period:                  input.Period,
+		CardType:                input.CardType,
+		UserTier:                input.UserTier,
+		Currency:                optionalCurrency(input.Currency),
+		MaxAmountPerTransaction: optionalInt(input.MaxAmountPerTransaction),
+		MaxCount:                optionalInt(input.MaxCount),
+		MaxTotalAmount:          optionalInt(input.MaxTotalAmount),
+		IsActive:                true,
+	}
+
+	if input.IsActive != nil {
+		req.IsActive = *input.IsActive
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, transaction_limit_errors.ErrGraphqlValidateCreateTransactionLimit
+	}
+
+	res, errResp := r.TransactionLimitGraphql.TransactionLimitService.CreateTransactionLimit(&req)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.TransactionLimitGraphql.Mapping.ToGraphqlResponseTransactionLimit("success", "Successfully created transaction limit", res)
+
+	return so, nil
+}
+
+// UpdateTransactionLimit is the resolver for the updateTransactionLimit field.
+func (r *mutationResolver) UpdateTransactionLimit(ctx context.Context, input model.UpdateTransactionLimitInput) (*model.APIResponseTransactionLimit, error) {
+	if err := requireRole(ctx, r.TransactionLimitGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	req := requests.UpdateTransactionLimitRequest{
+		TransactionLimitID:      &id,
+		Name:                    input.Name,
+		OperationType:           input.OperationType,
+		Period:                  input.Period,
+		CardType:                input.CardType,
+		UserTier:                input.UserTier,
+		Currency:                optionalCurrency(input.Currency),
+		MaxAmountPerTransaction: optionalInt(input.MaxAmountPerTransaction),
+		MaxCount:                optionalInt(input.MaxCount),
+		MaxTotalAmount:          optionalInt(input.MaxTotalAmount),
+		IsActive:                true,
+	}
+
+	if input.IsActive != nil {
+		req.IsActive = *input.IsActive
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, transaction_limit_errors.ErrGraphqlValidateUpdateTransactionLimit
+	}
+
+	res, errResp := r.TransactionLimitGraphql.TransactionLimitService.UpdateTransactionLimit(&req)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.TransactionLimitGraphql.Mapping.ToGraphqlResponseTransactionLimit("success", "Successfully updated transaction limit", res)
+
+	return so, nil
+}
+
+// DeleteTransactionLimit is the resolver for the deleteTransactionLimit field.
+func (r *mutationResolver) DeleteTransactionLimit(ctx context.Context, input model.FindByIDTransactionLimitInput) (*model.APIResponseTransactionLimit, error) {
+	if err := requireRole(ctx, r.TransactionLimitGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, transaction_limit_errors.ErrGraphqlTransactionLimitInvalidID
+	}
+
+	res, errResp := r.TransactionLimitGraphql.TransactionLimitService.DeleteTransactionLimit(id)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.TransactionLimitGraphql.Mapping.ToGraphqlResponseTransactionLimit("success", "Successfully deleted transaction limit", res)
+
+	return so, nil
+}
+
+// FindAllTransactionLimit is the resolver for the findAllTransactionLimit field.
+func (r *queryResolver) FindAllTransactionLimit(ctx context.Context, input *model.FindAllTransactionLimitInput) (*model.APIResponsePaginationTransactionLimit, error) {
+	if err := requireRole(ctx, r.TransactionLimitGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+	search := ""
+
+	if input != nil {
+		if input.Page != nil && *input.Page > 0 {
+			page = int(*input.Page)
+		}
+		if input.PageSize != nil && *input.PageSize > 0 {
+			pageSize = int(*input.PageSize)
+		}
+		if input.Search != nil {
+			search = *input.Search
+		}
+	}
+
+	reqService := requests.FindAllTransactionLimits{
+		Page:     page,
+		PageSize: pageSize,
+		Search:   search,
+	}
+
+	limits, totalRecords, errResp := r.TransactionLimitGraphql.TransactionLimitService.FindAll(&reqService)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.TransactionLimitGraphql.Mapping.ToGraphqlResponsePaginationTransactionLimit("success", "transaction limits retrieved successfully", limits, paginationMeta)
+
+	return so, nil
+}
+
+// FindByIDTransactionLimit is the resolver for the findByIdTransactionLimit field.
+func (r *queryResolver) FindByIDTransactionLimit(ctx context.Context, input model.FindByIDTransactionLimitInput) (*model.APIResponseTransactionLimit, error) {
+	if err := requireRole(ctx, r.TransactionLimitGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, transaction_limit_errors.ErrGraphqlTransactionLimitInvalidID
+	}
+
+	limit, err := r.TransactionLimitGraphql.TransactionLimitService.FindById(id)
+
+	if err != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(err)
+	}
+
+	so := r.TransactionLimitGraphql.Mapping.ToGraphqlResponseTransactionLimit("success", "Successfully fetched transaction limit", limit)
+
+	return so, nil
+}
+
+// FindTransactionLimitHeadroom is the resolver for the findTransactionLimitHeadroom field.
+func (r *queryResolver) FindTransactionLimitHeadroom(ctx context.Context, input model.FindTransactionLimitHeadroomInput) (*model.APIResponseTransactionLimitHeadroom, error) {
+	requester, err := requestedBy(ctx, r.TransactionLimitGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	req := requests.FindTransactionLimitHeadroom{
+		CardNumber:    input.CardNumber,
+		OperationType: input.OperationType,
+		RequestedBy:   requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, transaction_limit_errors.ErrGraphqlValidateHeadroom
+	}
+
+	headrooms, errResp := r.TransactionLimitGraphql.TransactionLimitService.FindHeadroom(&req)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.TransactionLimitGraphql.Mapping.ToGraphqlResponseTransactionLimitHeadroom("success", "Successfully fetched transaction limit headroom", headrooms)
+
+	return so, nil
+}
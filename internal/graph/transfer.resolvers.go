@@ -26,13 +26,18 @@ func (r *mutationResolver) CreateTransfer(ctx context.Context, input model.Creat
 		return nil, transfer_errors.ErrGraphqlValidateCreateTransferRequest
 	}
 
-	res, err := r.TransferGraphql.TransferService.CreateTransaction(&request)
-	if err != nil {
-		return nil, response.ToGraphqlErrorFromErrorResponse(err)
-	}
-
-	so := r.TransferGraphql.Mapping.ToGraphqlResponseTransfer("success", "Successfully created transfer", res)
-	return so, nil
+	payload := input
+	payload.IdempotencyKey = nil
+
+	return withIdempotency(ctx, r.TransferGraphql.IdempotencyKeyService, requests.IdempotencyScopeCreateTransfer, input.IdempotencyKey, payload, func() (*model.APIResponseTransfer, error) {
+		res, err := r.TransferGraphql.TransferService.CreateTransaction(&request)
+		if err != nil {
+			return nil, response.ToGraphqlErrorFromErrorResponse(err)
+		}
+
+		so := r.TransferGraphql.Mapping.ToGraphqlResponseTransfer("success", "Successfully created transfer", res)
+		return so, nil
+	})
 }
 
 // UpdateTransfer is the resolver for the updateTransfer field.
@@ -0,0 +1,196 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.81
+
+import (
+	"context"
+	"math"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transfer_batch_errors"
+)
+
+// CreateTransferBatch is the resolver for the createTransferBatch field.
+func (r *mutationResolver) CreateTransferBatch(ctx context.Context, input model.CreateTransferBatchInput) (*model.APIResponseTransferBatch, error) {
+	requester, err := requestedBy(ctx, r.TransferBatchGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	req := requests.CreateTransferBatchRequest{
+		TransferFrom: input.TransferFrom,
+		FileFormat:   input.FileFormat,
+		Content:      input.Content,
+		RequestedBy:  requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, transfer_batch_errors.ErrGraphqlValidateCreateTransferBatch
+	}
+
+	payload := input
+	payload.IdempotencyKey = nil
+
+	return withIdempotency(ctx, r.TransferBatchGraphql.IdempotencyKeyService, requests.IdempotencyScopeCreateTransferBatch, input.IdempotencyKey, payload, func() (*model.APIResponseTransferBatch, error) {
+		res, errResp := r.TransferBatchGraphql.TransferBatchService.CreateTransferBatch(&req)
+
+		if errResp != nil {
+			return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+		}
+
+		message := "Successfully created transfer batch"
+		if res.Status == requests.TransferBatchStatusRejected {
+			message = "Transfer batch rejected: some rows are invalid"
+		}
+
+		so := r.TransferBatchGraphql.Mapping.ToGraphqlResponseTransferBatch("success", message, res)
+
+		return so, nil
+	})
+}
+
+// FindAllTransferBatch is the resolver for the findAllTransferBatch field.
+func (r *queryResolver) FindAllTransferBatch(ctx context.Context, input *model.FindAllTransferBatchInput) (*model.APIResponsePaginationTransferBatch, error) {
+	requester, err := requestedBy(ctx, r.TransferBatchGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+	search := ""
+
+	if input != nil {
+		if input.Page != nil && *input.Page > 0 {
+			page = int(*input.Page)
+		}
+		if input.PageSize != nil && *input.PageSize > 0 {
+			pageSize = int(*input.PageSize)
+		}
+		if input.Search != nil {
+			search = *input.Search
+		}
+	}
+
+	reqService := requests.FindAllTransferBatches{
+		Page:        page,
+		PageSize:    pageSize,
+		Search:      search,
+		RequestedBy: requester,
+	}
+
+	batches, totalRecords, errResp := r.TransferBatchGraphql.TransferBatchService.FindAll(&reqService)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.TransferBatchGraphql.Mapping.ToGraphqlResponsePaginationTransferBatch("success", "transfer batches retrieved successfully", batches, paginationMeta)
+
+	return so, nil
+}
+
+// FindByIDTransferBatch is the resolver for the findByIdTransferBatch field.
+func (r *queryResolver) FindByIDTransferBatch(ctx context.Context, input model.FindByIDTransferBatchInput) (*model.APIResponseTransferBatch, error) {
+	requester, err := requestedBy(ctx, r.TransferBatchGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, transfer_batch_errors.ErrGraphqlTransferBatchInvalidID
+	}
+
+	batch, errResp := r.TransferBatchGraphql.TransferBatchService.FindById(id, requester)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.TransferBatchGraphql.Mapping.ToGraphqlResponseTransferBatch("success", "Successfully fetched transfer batch", batch)
+
+	return so, nil
+}
+
+// FindTransferBatchRows is the resolver for the findTransferBatchRows field.
+func (r *queryResolver) FindTransferBatchRows(ctx context.Context, input model.FindTransferBatchRowsInput) (*model.APIResponsePaginationTransferBatchRow, error) {
+	requester, err := requestedBy(ctx, r.TransferBatchGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	page := 1
+	pageSize := 10
+
+	if input.Page != nil && *input.Page > 0 {
+		page = int(*input.Page)
+	}
+	if input.PageSize != nil && *input.PageSize > 0 {
+		pageSize = int(*input.PageSize)
+	}
+
+	req := requests.FindTransferBatchRows{
+		TransferBatchID: int(input.TransferBatchID),
+		Page:            page,
+		PageSize:        pageSize,
+		RequestedBy:     requester,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, transfer_batch_errors.ErrGraphqlValidateTransferBatchRows
+	}
+
+	rows, totalRecords, errResp := r.TransferBatchGraphql.TransferBatchService.FindRows(&req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	totalPages := int(math.Ceil(float64(*totalRecords) / float64(pageSize)))
+	paginationMeta := &response.PaginationMeta{
+		CurrentPage:  page,
+		PageSize:     pageSize,
+		TotalRecords: *totalRecords,
+		TotalPages:   totalPages,
+	}
+
+	so := r.TransferBatchGraphql.Mapping.ToGraphqlResponsePaginationTransferBatchRow("success", "transfer batch rows retrieved successfully", rows, paginationMeta)
+
+	return so, nil
+}
+
+// FindTransferBatchReport is the resolver for the findTransferBatchReport field.
+func (r *queryResolver) FindTransferBatchReport(ctx context.Context, input model.FindByIDTransferBatchInput) (*model.APIResponseTransferBatchReport, error) {
+	requester, err := requestedBy(ctx, r.TransferBatchGraphql.Permission)
+	if err != nil {
+		return nil, err
+	}
+
+	id := int(input.ID)
+
+	if id == 0 {
+		return nil, transfer_batch_errors.ErrGraphqlTransferBatchInvalidID
+	}
+
+	report, errResp := r.TransferBatchGraphql.TransferBatchService.FindReport(id, requester)
+
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.TransferBatchGraphql.Mapping.ToGraphqlResponseTransferBatchReport("success", "Successfully built transfer batch report", report)
+
+	return so, nil
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/user_errors"
 )
 
 // CreateUser is the resolver for the createUser field.
@@ -60,6 +61,29 @@ func (r *mutationResolver) UpdateUser(ctx context.Context, input model.UpdateUse
 	return r.UserGraphql.Mapping.ToGraphqlResponseUser("success", "user updated successfully", user), nil
 }
 
+// UpdateUserTier is the resolver for the updateUserTier field.
+func (r *mutationResolver) UpdateUserTier(ctx context.Context, input model.UpdateUserTierInput) (*model.APIResponseUserResponse, error) {
+	if err := requireRole(ctx, r.UserGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	req := &requests.UpdateUserTierRequest{
+		UserID: int(input.ID),
+		Tier:   input.Tier,
+	}
+
+	if err := req.Validate(); err != nil {
+		return nil, user_errors.ErrGraphqlValidateTier
+	}
+
+	user, errResp := r.UserGraphql.UserService.UpdateUserTier(req)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	return r.UserGraphql.Mapping.ToGraphqlResponseUser("success", "user tier updated successfully", user), nil
+}
+
 // TrashedUser is the resolver for the trashedUser field.
 func (r *mutationResolver) TrashedUser(ctx context.Context, input model.FindByIDUserInput) (*model.APIResponseUserResponseDeleteAt, error) {
 	id := int(input.ID)
@@ -13,6 +13,7 @@ import (
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+	mycontext "github.com/MamangRust/paymentgatewaygraphql/pkg/context"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/withdraw_errors"
 )
 
@@ -33,13 +34,18 @@ func (r *mutationResolver) CreateWithdraw(ctx context.Context, input model.Creat
 		return nil, withdraw_errors.ErrGraphqlValidateCreateWithdrawRequest
 	}
 
-	res, errResp := r.WithdrawGraphql.WithdrawService.Create(request)
-	if errResp != nil {
-		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
-	}
+	payload := input
+	payload.IdempotencyKey = nil
 
-	so := r.WithdrawGraphql.Mapping.ToGraphqlResponseWithdraw("success", "Successfully created withdraw", res)
-	return so, nil
+	return withIdempotency(ctx, r.WithdrawGraphql.IdempotencyKeyService, requests.IdempotencyScopeCreateWithdraw, input.IdempotencyKey, payload, func() (*model.APIResponseWithdraw, error) {
+		res, errResp := r.WithdrawGraphql.WithdrawService.Create(request)
+		if errResp != nil {
+			return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+		}
+
+		so := r.WithdrawGraphql.Mapping.ToGraphqlResponseWithdraw("success", "Successfully created withdraw", res)
+		return so, nil
+	})
 }
 
 // UpdateWithdraw is the resolver for the updateWithdraw field.
@@ -74,6 +80,60 @@ func (r *mutationResolver) UpdateWithdraw(ctx context.Context, input model.Updat
 	return so, nil
 }
 
+// ApproveWithdraw is the resolver for the approveWithdraw field.
+func (r *mutationResolver) ApproveWithdraw(ctx context.Context, input model.ReviewWithdrawInput) (*model.APIResponseWithdraw, error) {
+	if err := requireRole(ctx, r.WithdrawGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	uid, _ := mycontext.UserForContext(ctx)
+
+	request := &requests.ReviewWithdrawRequest{
+		WithdrawID: int(input.WithdrawID),
+		Note:       input.Note,
+		ReviewedBy: uid,
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, withdraw_errors.ErrGraphqlValidateReviewWithdrawRequest
+	}
+
+	res, errResp := r.WithdrawGraphql.WithdrawService.ApproveWithdraw(request)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.WithdrawGraphql.Mapping.ToGraphqlResponseWithdraw("success", "Successfully approved withdraw", res)
+	return so, nil
+}
+
+// RejectWithdraw is the resolver for the rejectWithdraw field.
+func (r *mutationResolver) RejectWithdraw(ctx context.Context, input model.ReviewWithdrawInput) (*model.APIResponseWithdraw, error) {
+	if err := requireRole(ctx, r.WithdrawGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	uid, _ := mycontext.UserForContext(ctx)
+
+	request := &requests.ReviewWithdrawRequest{
+		WithdrawID: int(input.WithdrawID),
+		Note:       input.Note,
+		ReviewedBy: uid,
+	}
+
+	if err := request.Validate(); err != nil {
+		return nil, withdraw_errors.ErrGraphqlValidateReviewWithdrawRequest
+	}
+
+	res, errResp := r.WithdrawGraphql.WithdrawService.RejectWithdraw(request)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.WithdrawGraphql.Mapping.ToGraphqlResponseWithdraw("success", "Successfully rejected withdraw", res)
+	return so, nil
+}
+
 // TrashedWithdraw is the resolver for the trashedWithdraw field.
 func (r *mutationResolver) TrashedWithdraw(ctx context.Context, input model.FindByIDWithdrawInput) (*model.APIResponseWithdrawDeleteAt, error) {
 	id := int(input.WithdrawID)
@@ -251,6 +311,31 @@ func (r *queryResolver) FindByIDWithdraw(ctx context.Context, input model.FindBy
 	return so, nil
 }
 
+// FindWithdrawReview is the resolver for the findWithdrawReview field.
+func (r *queryResolver) FindWithdrawReview(ctx context.Context, input model.FindByIDWithdrawInput) (*model.APIResponseWithdrawReview, error) {
+	if err := requireRole(ctx, r.WithdrawGraphql.Permission, "ROLE_ADMIN"); err != nil {
+		return nil, err
+	}
+
+	id := int(input.WithdrawID)
+	if id == 0 {
+		return nil, withdraw_errors.ErrGraphqlWithdrawInvalidID
+	}
+
+	review, errResp := r.WithdrawGraphql.WithdrawService.FindReview(id)
+	if errResp != nil {
+		return nil, response.ToGraphqlErrorFromErrorResponse(errResp)
+	}
+
+	so := r.WithdrawGraphql.Mapping.ToGraphqlResponseWithdrawReview(
+		"success",
+		"withdraw review retrieved successfully",
+		review,
+	)
+
+	return so, nil
+}
+
 // FindMonthlyWithdrawStatusSuccess is the resolver for the findMonthlyWithdrawStatusSuccess field.
 func (r *queryResolver) FindMonthlyWithdrawStatusSuccess(ctx context.Context, input model.FindMonthlyWithdrawStatusInput) (*model.APIResponseWithdrawMonthStatusSuccess, error) {
 	year := int(input.Year)
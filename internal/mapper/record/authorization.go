@@ -0,0 +1,90 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type authorizationRecordMapper struct {
+}
+
+func NewAuthorizationRecordMapper() *authorizationRecordMapper {
+	return &authorizationRecordMapper{}
+}
+
+func (a *authorizationRecordMapper) ToAuthorizationRecord(authorization *db.Authorization) *record.AuthorizationRecord {
+	var transactionID *int
+	if authorization.TransactionID.Valid {
+		id := int(authorization.TransactionID.Int32)
+		transactionID = &id
+	}
+
+	var capturedAt *string
+	if authorization.CapturedAt.Valid {
+		formatedCapturedAt := authorization.CapturedAt.Time.Format("2006-01-02 15:04:05")
+		capturedAt = &formatedCapturedAt
+	}
+
+	var voidedAt *string
+	if authorization.VoidedAt.Valid {
+		formatedVoidedAt := authorization.VoidedAt.Time.Format("2006-01-02 15:04:05")
+		voidedAt = &formatedVoidedAt
+	}
+
+	return &record.AuthorizationRecord{
+		ID:              int(authorization.AuthorizationID),
+		AuthorizationNo: authorization.AuthorizationNo.String(),
+		CardNumber:      authorization.CardNumber,
+		MerchantID:      int(authorization.MerchantID),
+		Amount:          int(authorization.Amount),
+		Currency:        authorization.Currency,
+		CapturedAmount:  int(authorization.CapturedAmount),
+		PaymentMethod:   authorization.PaymentMethod,
+		Status:          authorization.Status,
+		TransactionID:   transactionID,
+		ExpiresAt:       authorization.ExpiresAt.Format("2006-01-02 15:04:05"),
+		CapturedAt:      capturedAt,
+		VoidedAt:        voidedAt,
+		CreatedAt:       authorization.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       authorization.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (a *authorizationRecordMapper) ToAuthorizationsRecord(authorizations []*db.Authorization) []*record.AuthorizationRecord {
+	var authorizationRecords []*record.AuthorizationRecord
+
+	for _, authorization := range authorizations {
+		authorizationRecords = append(authorizationRecords, a.ToAuthorizationRecord(authorization))
+	}
+
+	return authorizationRecords
+}
+
+func (a *authorizationRecordMapper) ToAuthorizationRecordAll(authorization *db.GetAuthorizationsRow) *record.AuthorizationRecord {
+	return a.ToAuthorizationRecord(&db.Authorization{
+		AuthorizationID: authorization.AuthorizationID,
+		AuthorizationNo: authorization.AuthorizationNo,
+		CardNumber:      authorization.CardNumber,
+		MerchantID:      authorization.MerchantID,
+		Amount:          authorization.Amount,
+		CapturedAmount:  authorization.CapturedAmount,
+		PaymentMethod:   authorization.PaymentMethod,
+		Status:          authorization.Status,
+		TransactionID:   authorization.TransactionID,
+		ExpiresAt:       authorization.ExpiresAt,
+		CapturedAt:      authorization.CapturedAt,
+		VoidedAt:        authorization.VoidedAt,
+		CreatedAt:       authorization.CreatedAt,
+		UpdatedAt:       authorization.UpdatedAt,
+	})
+}
+
+func (a *authorizationRecordMapper) ToAuthorizationsRecordAll(authorizations []*db.GetAuthorizationsRow) []*record.AuthorizationRecord {
+	var authorizationRecords []*record.AuthorizationRecord
+
+	for _, authorization := range authorizations {
+		authorizationRecords = append(authorizationRecords, a.ToAuthorizationRecordAll(authorization))
+	}
+
+	return authorizationRecords
+}
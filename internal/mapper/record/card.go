@@ -28,6 +28,7 @@ func (s *cardRecordMapper) ToCardRecord(card *db.Card) *record.CardRecord {
 		ExpireDate:   card.ExpireDate.Format("2006-01-02"),
 		CVV:          card.Cvv,
 		CardProvider: card.CardProvider,
+		Currency:     card.Currency,
 		CreatedAt:    card.CreatedAt.Time.Format("2006-01-02"),
 		UpdatedAt:    card.UpdatedAt.Time.Format("2006-01-02"),
 		DeletedAt:    deletedAt,
@@ -50,6 +51,7 @@ func (s *cardRecordMapper) ToCardGetAll(card *db.GetCardsRow) *record.CardRecord
 		ExpireDate:   card.ExpireDate.Format("2006-01-02"),
 		CVV:          card.Cvv,
 		CardProvider: card.CardProvider,
+		Currency:     card.Currency,
 		CreatedAt:    card.CreatedAt.Time.Format("2006-01-02"),
 		UpdatedAt:    card.UpdatedAt.Time.Format("2006-01-02"),
 		DeletedAt:    deletedAt,
@@ -80,6 +82,7 @@ func (s *cardRecordMapper) ToCardRecordActive(card *db.GetActiveCardsWithCountRo
 		ExpireDate:   card.ExpireDate.Format("2006-01-02"),
 		CVV:          card.Cvv,
 		CardProvider: card.CardProvider,
+		Currency:     card.Currency,
 		CreatedAt:    card.CreatedAt.Time.Format("2006-01-02"),
 		UpdatedAt:    card.UpdatedAt.Time.Format("2006-01-02"),
 		DeletedAt:    deletedAt,
@@ -110,6 +113,7 @@ func (s *cardRecordMapper) ToCardRecordTrashed(card *db.GetTrashedCardsWithCount
 		ExpireDate:   card.ExpireDate.Format("2006-01-02"),
 		CVV:          card.Cvv,
 		CardProvider: card.CardProvider,
+		Currency:     card.Currency,
 		CreatedAt:    card.CreatedAt.Time.Format("2006-01-02"),
 		UpdatedAt:    card.UpdatedAt.Time.Format("2006-01-02"),
 		DeletedAt:    deletedAt,
@@ -124,9 +128,29 @@ func (s *cardRecordMapper) ToCardRecordsTrashed(cards []*db.GetTrashedCardsWithC
 	return records
 }
 
+func (s *cardRecordMapper) ToCurrencyTotals(card *db.GetTotalAmountsByCurrencyRow) *record.CardCurrencyTotals {
+	return &record.CardCurrencyTotals{
+		Currency:         card.Currency,
+		TotalBalance:     card.TotalBalance,
+		TotalTopup:       card.TotalTopupAmount,
+		TotalWithdraw:    card.TotalWithdrawAmount,
+		TotalTransaction: card.TotalTransactionAmount,
+		TotalTransfer:    card.TotalTransferAmount,
+	}
+}
+
+func (s *cardRecordMapper) ToCurrencyTotalsList(cards []*db.GetTotalAmountsByCurrencyRow) []*record.CardCurrencyTotals {
+	var records []*record.CardCurrencyTotals
+	for _, card := range cards {
+		records = append(records, s.ToCurrencyTotals(card))
+	}
+	return records
+}
+
 func (s *cardRecordMapper) ToMonthlyBalance(card *db.GetMonthlyBalancesRow) *record.CardMonthBalance {
 	return &record.CardMonthBalance{
 		Month:        card.Month,
+		Currency:     card.Currency,
 		TotalBalance: int64(card.TotalBalance),
 	}
 }
@@ -142,6 +166,7 @@ func (s *cardRecordMapper) ToMonthlyBalances(cards []*db.GetMonthlyBalancesRow)
 func (s *cardRecordMapper) ToYearlyBalance(card *db.GetYearlyBalancesRow) *record.CardYearlyBalance {
 	return &record.CardYearlyBalance{
 		Year:         card.Year,
+		Currency:     card.Currency,
 		TotalBalance: card.TotalBalance,
 	}
 }
@@ -157,6 +182,7 @@ func (s *cardRecordMapper) ToYearlyBalances(cards []*db.GetYearlyBalancesRow) []
 func (s *cardRecordMapper) ToMonthlyTopupAmount(card *db.GetMonthlyTopupAmountRow) *record.CardMonthAmount {
 	return &record.CardMonthAmount{
 		Month:       card.Month,
+		Currency:    card.Currency,
 		TotalAmount: int64(card.TotalTopupAmount),
 	}
 }
@@ -172,6 +198,7 @@ func (s *cardRecordMapper) ToMonthlyTopupAmounts(cards []*db.GetMonthlyTopupAmou
 func (s *cardRecordMapper) ToYearlyTopupAmount(card *db.GetYearlyTopupAmountRow) *record.CardYearAmount {
 	return &record.CardYearAmount{
 		Year:        card.Year,
+		Currency:    card.Currency,
 		TotalAmount: card.TotalTopupAmount,
 	}
 }
@@ -187,6 +214,7 @@ func (s *cardRecordMapper) ToYearlyTopupAmounts(cards []*db.GetYearlyTopupAmount
 func (s *cardRecordMapper) ToMonthlyWithdrawAmount(card *db.GetMonthlyWithdrawAmountRow) *record.CardMonthAmount {
 	return &record.CardMonthAmount{
 		Month:       card.Month,
+		Currency:    card.Currency,
 		TotalAmount: int64(card.TotalWithdrawAmount),
 	}
 }
@@ -202,6 +230,7 @@ func (s *cardRecordMapper) ToMonthlyWithdrawAmounts(cards []*db.GetMonthlyWithdr
 func (s *cardRecordMapper) ToYearlyWithdrawAmount(card *db.GetYearlyWithdrawAmountRow) *record.CardYearAmount {
 	return &record.CardYearAmount{
 		Year:        card.Year,
+		Currency:    card.Currency,
 		TotalAmount: card.TotalWithdrawAmount,
 	}
 }
@@ -217,6 +246,7 @@ func (s *cardRecordMapper) ToYearlyWithdrawAmounts(cards []*db.GetYearlyWithdraw
 func (s *cardRecordMapper) ToMonthlyTransactionAmount(card *db.GetMonthlyTransactionAmountRow) *record.CardMonthAmount {
 	return &record.CardMonthAmount{
 		Month:       card.Month,
+		Currency:    card.Currency,
 		TotalAmount: int64(card.TotalTransactionAmount),
 	}
 }
@@ -232,6 +262,7 @@ func (s *cardRecordMapper) ToMonthlyTransactionAmounts(cards []*db.GetMonthlyTra
 func (s *cardRecordMapper) ToYearlyTransactionAmount(card *db.GetYearlyTransactionAmountRow) *record.CardYearAmount {
 	return &record.CardYearAmount{
 		Year:        card.Year,
+		Currency:    card.Currency,
 		TotalAmount: card.TotalTransactionAmount,
 	}
 }
@@ -247,6 +278,7 @@ func (s *cardRecordMapper) ToYearlyTransactionAmounts(cards []*db.GetYearlyTrans
 func (s *cardRecordMapper) ToMonthlyTransferSenderAmount(card *db.GetMonthlyTransferAmountSenderRow) *record.CardMonthAmount {
 	return &record.CardMonthAmount{
 		Month:       card.Month,
+		Currency:    card.Currency,
 		TotalAmount: int64(card.TotalSentAmount),
 	}
 }
@@ -262,6 +294,7 @@ func (s *cardRecordMapper) ToMonthlyTransferSenderAmounts(cards []*db.GetMonthly
 func (s *cardRecordMapper) ToYearlyTransferSenderAmount(card *db.GetYearlyTransferAmountSenderRow) *record.CardYearAmount {
 	return &record.CardYearAmount{
 		Year:        card.Year,
+		Currency:    card.Currency,
 		TotalAmount: card.TotalSentAmount,
 	}
 }
@@ -277,6 +310,7 @@ func (s *cardRecordMapper) ToYearlyTransferSenderAmounts(cards []*db.GetYearlyTr
 func (s *cardRecordMapper) ToMonthlyTransferReceiverAmount(card *db.GetMonthlyTransferAmountReceiverRow) *record.CardMonthAmount {
 	return &record.CardMonthAmount{
 		Month:       card.Month,
+		Currency:    card.Currency,
 		TotalAmount: int64(card.TotalReceivedAmount),
 	}
 }
@@ -292,6 +326,7 @@ func (s *cardRecordMapper) ToMonthlyTransferReceiverAmounts(cards []*db.GetMonth
 func (s *cardRecordMapper) ToYearlyTransferReceiverAmount(card *db.GetYearlyTransferAmountReceiverRow) *record.CardYearAmount {
 	return &record.CardYearAmount{
 		Year:        card.Year,
+		Currency:    card.Currency,
 		TotalAmount: card.TotalReceivedAmount,
 	}
 }
@@ -307,6 +342,7 @@ func (s *cardRecordMapper) ToYearlyTransferReceiverAmounts(cards []*db.GetYearly
 func (s *cardRecordMapper) ToMonthlyBalanceCardNumber(card *db.GetMonthlyBalancesByCardNumberRow) *record.CardMonthBalance {
 	return &record.CardMonthBalance{
 		Month:        card.Month,
+		Currency:     card.Currency,
 		TotalBalance: int64(card.TotalBalance),
 	}
 }
@@ -322,6 +358,7 @@ func (s *cardRecordMapper) ToMonthlyBalancesCardNumber(cards []*db.GetMonthlyBal
 func (s *cardRecordMapper) ToYearlyBalanceCardNumber(card *db.GetYearlyBalancesByCardNumberRow) *record.CardYearlyBalance {
 	return &record.CardYearlyBalance{
 		Year:         card.Year,
+		Currency:     card.Currency,
 		TotalBalance: card.TotalBalance,
 	}
 }
@@ -337,6 +374,7 @@ func (s *cardRecordMapper) ToYearlyBalancesCardNumber(cards []*db.GetYearlyBalan
 func (s *cardRecordMapper) ToMonthlyTopupAmountByCardNumber(card *db.GetMonthlyTopupAmountByCardNumberRow) *record.CardMonthAmount {
 	return &record.CardMonthAmount{
 		Month:       card.Month,
+		Currency:    card.Currency,
 		TotalAmount: int64(card.TotalTopupAmount),
 	}
 }
@@ -352,6 +390,7 @@ func (s *cardRecordMapper) ToMonthlyTopupAmountsByCardNumber(cards []*db.GetMont
 func (s *cardRecordMapper) ToYearlyTopupAmountByCardNumber(card *db.GetYearlyTopupAmountByCardNumberRow) *record.CardYearAmount {
 	return &record.CardYearAmount{
 		Year:        card.Year,
+		Currency:    card.Currency,
 		TotalAmount: card.TotalTopupAmount,
 	}
 }
@@ -367,6 +406,7 @@ func (s *cardRecordMapper) ToYearlyTopupAmountsByCardNumber(cards []*db.GetYearl
 func (s *cardRecordMapper) ToMonthlyWithdrawAmountByCardNumber(card *db.GetMonthlyWithdrawAmountByCardNumberRow) *record.CardMonthAmount {
 	return &record.CardMonthAmount{
 		Month:       card.Month,
+		Currency:    card.Currency,
 		TotalAmount: int64(card.TotalWithdrawAmount),
 	}
 }
@@ -382,6 +422,7 @@ func (s *cardRecordMapper) ToMonthlyWithdrawAmountsByCardNumber(cards []*db.GetM
 func (s *cardRecordMapper) ToYearlyWithdrawAmountByCardNumber(card *db.GetYearlyWithdrawAmountByCardNumberRow) *record.CardYearAmount {
 	return &record.CardYearAmount{
 		Year:        card.Year,
+		Currency:    card.Currency,
 		TotalAmount: card.TotalWithdrawAmount,
 	}
 }
@@ -397,6 +438,7 @@ func (s *cardRecordMapper) ToYearlyWithdrawAmountsByCardNumber(cards []*db.GetYe
 func (s *cardRecordMapper) ToMonthlyTransactionAmountByCardNumber(card *db.GetMonthlyTransactionAmountByCardNumberRow) *record.CardMonthAmount {
 	return &record.CardMonthAmount{
 		Month:       card.Month,
+		Currency:    card.Currency,
 		TotalAmount: int64(card.TotalTransactionAmount),
 	}
 }
@@ -412,6 +454,7 @@ func (s *cardRecordMapper) ToMonthlyTransactionAmountsByCardNumber(cards []*db.G
 func (s *cardRecordMapper) ToYearlyTransactionAmountByCardNumber(card *db.GetYearlyTransactionAmountByCardNumberRow) *record.CardYearAmount {
 	return &record.CardYearAmount{
 		Year:        card.Year,
+		Currency:    card.Currency,
 		TotalAmount: card.TotalTransactionAmount,
 	}
 }
@@ -427,6 +470,7 @@ func (s *cardRecordMapper) ToYearlyTransactionAmountsByCardNumber(cards []*db.Ge
 func (s *cardRecordMapper) ToMonthlyTransferSenderAmountByCardNumber(card *db.GetMonthlyTransferAmountBySenderRow) *record.CardMonthAmount {
 	return &record.CardMonthAmount{
 		Month:       card.Month,
+		Currency:    card.Currency,
 		TotalAmount: int64(card.TotalSentAmount),
 	}
 }
@@ -442,6 +486,7 @@ func (s *cardRecordMapper) ToMonthlyTransferSenderAmountsByCardNumber(cards []*d
 func (s *cardRecordMapper) ToYearlyTransferSenderAmountByCardNumber(card *db.GetYearlyTransferAmountBySenderRow) *record.CardYearAmount {
 	return &record.CardYearAmount{
 		Year:        card.Year,
+		Currency:    card.Currency,
 		TotalAmount: card.TotalSentAmount,
 	}
 }
@@ -457,6 +502,7 @@ func (s *cardRecordMapper) ToYearlyTransferSenderAmountsByCardNumber(cards []*db
 func (s *cardRecordMapper) ToMonthlyTransferReceiverAmountByCardNumber(card *db.GetMonthlyTransferAmountByReceiverRow) *record.CardMonthAmount {
 	return &record.CardMonthAmount{
 		Month:       card.Month,
+		Currency:    card.Currency,
 		TotalAmount: int64(card.TotalReceivedAmount),
 	}
 }
@@ -472,6 +518,7 @@ func (s *cardRecordMapper) ToMonthlyTransferReceiverAmountsByCardNumber(cards []
 func (s *cardRecordMapper) ToYearlyTransferReceiverAmountByCardNumber(card *db.GetYearlyTransferAmountByReceiverRow) *record.CardYearAmount {
 	return &record.CardYearAmount{
 		Year:        card.Year,
+		Currency:    card.Currency,
 		TotalAmount: card.TotalReceivedAmount,
 	}
 }
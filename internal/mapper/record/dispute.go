@@ -0,0 +1,140 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type disputeRecordMapper struct {
+}
+
+func NewDisputeRecordMapper() *disputeRecordMapper {
+	return &disputeRecordMapper{}
+}
+
+func (d *disputeRecordMapper) ToDisputeRecord(dispute *db.Dispute) *record.DisputeRecord {
+	var merchantEvidence *string
+	if dispute.MerchantEvidence.Valid {
+		merchantEvidence = &dispute.MerchantEvidence.String
+	}
+
+	var resolutionNote *string
+	if dispute.ResolutionNote.Valid {
+		resolutionNote = &dispute.ResolutionNote.String
+	}
+
+	var resolvedBy *int
+	if dispute.ResolvedBy.Valid {
+		userID := int(dispute.ResolvedBy.Int32)
+		resolvedBy = &userID
+	}
+
+	var respondedAt *string
+	if dispute.RespondedAt.Valid {
+		formatedRespondedAt := dispute.RespondedAt.Time.Format("2006-01-02 15:04:05")
+		respondedAt = &formatedRespondedAt
+	}
+
+	var resolvedAt *string
+	if dispute.ResolvedAt.Valid {
+		formatedResolvedAt := dispute.ResolvedAt.Time.Format("2006-01-02 15:04:05")
+		resolvedAt = &formatedResolvedAt
+	}
+
+	return &record.DisputeRecord{
+		ID:               int(dispute.DisputeID),
+		DisputeNo:        dispute.DisputeNo.String(),
+		TransactionID:    int(dispute.TransactionID),
+		CardNumber:       dispute.CardNumber,
+		MerchantID:       int(dispute.MerchantID),
+		Amount:           int(dispute.Amount),
+		Currency:         dispute.Currency,
+		HeldFrom:         dispute.HeldFrom,
+		Reason:           dispute.Reason,
+		MerchantEvidence: merchantEvidence,
+		ResolutionNote:   resolutionNote,
+		Status:           dispute.Status,
+		OpenedBy:         int(dispute.OpenedBy),
+		ResolvedBy:       resolvedBy,
+		RespondedAt:      respondedAt,
+		ResolvedAt:       resolvedAt,
+		CreatedAt:        dispute.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:        dispute.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (d *disputeRecordMapper) ToDisputesRecord(disputes []*db.Dispute) []*record.DisputeRecord {
+	var disputeRecords []*record.DisputeRecord
+
+	for _, dispute := range disputes {
+		disputeRecords = append(disputeRecords, d.ToDisputeRecord(dispute))
+	}
+
+	return disputeRecords
+}
+
+func (d *disputeRecordMapper) ToDisputeRecordAll(dispute *db.GetDisputesRow) *record.DisputeRecord {
+	return d.ToDisputeRecord(&db.Dispute{
+		DisputeID:        dispute.DisputeID,
+		DisputeNo:        dispute.DisputeNo,
+		TransactionID:    dispute.TransactionID,
+		CardNumber:       dispute.CardNumber,
+		MerchantID:       dispute.MerchantID,
+		Amount:           dispute.Amount,
+		Reason:           dispute.Reason,
+		MerchantEvidence: dispute.MerchantEvidence,
+		ResolutionNote:   dispute.ResolutionNote,
+		Status:           dispute.Status,
+		OpenedBy:         dispute.OpenedBy,
+		ResolvedBy:       dispute.ResolvedBy,
+		RespondedAt:      dispute.RespondedAt,
+		ResolvedAt:       dispute.ResolvedAt,
+		CreatedAt:        dispute.CreatedAt,
+		UpdatedAt:        dispute.UpdatedAt,
+		Currency:         dispute.Currency,
+		HeldFrom:         dispute.HeldFrom,
+	})
+}
+
+func (d *disputeRecordMapper) ToDisputesRecordAll(disputes []*db.GetDisputesRow) []*record.DisputeRecord {
+	var disputeRecords []*record.DisputeRecord
+
+	for _, dispute := range disputes {
+		disputeRecords = append(disputeRecords, d.ToDisputeRecordAll(dispute))
+	}
+
+	return disputeRecords
+}
+
+func (d *disputeRecordMapper) ToDisputeRecordByMerchant(dispute *db.GetDisputesByMerchantIDRow) *record.DisputeRecord {
+	return d.ToDisputeRecord(&db.Dispute{
+		DisputeID:        dispute.DisputeID,
+		DisputeNo:        dispute.DisputeNo,
+		TransactionID:    dispute.TransactionID,
+		CardNumber:       dispute.CardNumber,
+		MerchantID:       dispute.MerchantID,
+		Amount:           dispute.Amount,
+		Reason:           dispute.Reason,
+		MerchantEvidence: dispute.MerchantEvidence,
+		ResolutionNote:   dispute.ResolutionNote,
+		Status:           dispute.Status,
+		OpenedBy:         dispute.OpenedBy,
+		ResolvedBy:       dispute.ResolvedBy,
+		RespondedAt:      dispute.RespondedAt,
+		ResolvedAt:       dispute.ResolvedAt,
+		CreatedAt:        dispute.CreatedAt,
+		UpdatedAt:        dispute.UpdatedAt,
+		Currency:         dispute.Currency,
+		HeldFrom:         dispute.HeldFrom,
+	})
+}
+
+func (d *disputeRecordMapper) ToDisputesRecordByMerchant(disputes []*db.GetDisputesByMerchantIDRow) []*record.DisputeRecord {
+	var disputeRecords []*record.DisputeRecord
+
+	for _, dispute := range disputes {
+		disputeRecords = append(disputeRecords, d.ToDisputeRecordByMerchant(dispute))
+	}
+
+	return disputeRecords
+}
@@ -0,0 +1,57 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type exchangeRateRecordMapper struct {
+}
+
+func NewExchangeRateRecordMapper() *exchangeRateRecordMapper {
+	return &exchangeRateRecordMapper{}
+}
+
+func (e *exchangeRateRecordMapper) ToExchangeRateRecord(rate *db.ExchangeRate) *record.ExchangeRateRecord {
+	return &record.ExchangeRateRecord{
+		ID:            int(rate.ExchangeRateID),
+		BaseCurrency:  rate.BaseCurrency,
+		QuoteCurrency: rate.QuoteCurrency,
+		Rate:          rate.Rate,
+		SpreadBps:     int(rate.SpreadBps),
+		CreatedAt:     rate.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:     rate.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (e *exchangeRateRecordMapper) ToExchangeRatesRecord(rates []*db.ExchangeRate) []*record.ExchangeRateRecord {
+	var rateRecords []*record.ExchangeRateRecord
+
+	for _, rate := range rates {
+		rateRecords = append(rateRecords, e.ToExchangeRateRecord(rate))
+	}
+
+	return rateRecords
+}
+
+func (e *exchangeRateRecordMapper) ToExchangeRateRecordAll(rate *db.GetExchangeRatesRow) *record.ExchangeRateRecord {
+	return &record.ExchangeRateRecord{
+		ID:            int(rate.ExchangeRateID),
+		BaseCurrency:  rate.BaseCurrency,
+		QuoteCurrency: rate.QuoteCurrency,
+		Rate:          rate.Rate,
+		SpreadBps:     int(rate.SpreadBps),
+		CreatedAt:     rate.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:     rate.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (e *exchangeRateRecordMapper) ToExchangeRatesRecordAll(rates []*db.GetExchangeRatesRow) []*record.ExchangeRateRecord {
+	var rateRecords []*record.ExchangeRateRecord
+
+	for _, rate := range rates {
+		rateRecords = append(rateRecords, e.ToExchangeRateRecordAll(rate))
+	}
+
+	return rateRecords
+}
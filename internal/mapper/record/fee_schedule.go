@@ -0,0 +1,145 @@
+package recordmapper
+
+import (
+	"database/sql"
+	"strconv"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type feeScheduleRecordMapper struct {
+}
+
+func NewFeeScheduleRecordMapper() *feeScheduleRecordMapper {
+	return &feeScheduleRecordMapper{}
+}
+
+func (f *feeScheduleRecordMapper) ToFeeScheduleRecord(schedule *db.FeeSchedule, tiers []*db.FeeScheduleTier) *record.FeeScheduleRecord {
+	return &record.FeeScheduleRecord{
+		ID:              int(schedule.FeeScheduleID),
+		Name:            schedule.Name,
+		TransactionType: schedule.TransactionType,
+		FeeType:         schedule.FeeType,
+		PaymentMethod:   toFeeScheduleFilter(schedule.PaymentMethod),
+		CardType:        toFeeScheduleFilter(schedule.CardType),
+		Currency:        toFeeScheduleFilter(schedule.Currency),
+		FlatAmount:      int(schedule.FlatAmount),
+		PercentageBps:   int(schedule.PercentageBps),
+		MinFee:          toFeeScheduleBound(schedule.MinFee),
+		MaxFee:          toFeeScheduleBound(schedule.MaxFee),
+		IsActive:        schedule.IsActive,
+		Tiers:           f.ToFeeScheduleTiersRecord(tiers),
+		CreatedAt:       schedule.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       schedule.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (f *feeScheduleRecordMapper) ToFeeScheduleRecordAll(schedule *db.GetFeeSchedulesRow, tiers []*db.FeeScheduleTier) *record.FeeScheduleRecord {
+	return f.ToFeeScheduleRecord(&db.FeeSchedule{
+		FeeScheduleID:   schedule.FeeScheduleID,
+		Name:            schedule.Name,
+		TransactionType: schedule.TransactionType,
+		FeeType:         schedule.FeeType,
+		PaymentMethod:   schedule.PaymentMethod,
+		CardType:        schedule.CardType,
+		Currency:        schedule.Currency,
+		FlatAmount:      schedule.FlatAmount,
+		PercentageBps:   schedule.PercentageBps,
+		MinFee:          schedule.MinFee,
+		MaxFee:          schedule.MaxFee,
+		IsActive:        schedule.IsActive,
+		CreatedAt:       schedule.CreatedAt,
+		UpdatedAt:       schedule.UpdatedAt,
+	}, tiers)
+}
+
+// ToFeeSchedulesRecordAll maps a page of schedules, attaching to each
+// schedule the tiers from the batch that belong to it.
+func (f *feeScheduleRecordMapper) ToFeeSchedulesRecordAll(schedules []*db.GetFeeSchedulesRow, tiers []*db.FeeScheduleTier) []*record.FeeScheduleRecord {
+	tiersBySchedule := make(map[int32][]*db.FeeScheduleTier)
+	for _, tier := range tiers {
+		tiersBySchedule[tier.FeeScheduleID] = append(tiersBySchedule[tier.FeeScheduleID], tier)
+	}
+
+	var records []*record.FeeScheduleRecord
+	for _, schedule := range schedules {
+		records = append(records, f.ToFeeScheduleRecordAll(schedule, tiersBySchedule[schedule.FeeScheduleID]))
+	}
+	return records
+}
+
+func (f *feeScheduleRecordMapper) ToFeeScheduleTierRecord(tier *db.FeeScheduleTier) *record.FeeScheduleTierRecord {
+	return &record.FeeScheduleTierRecord{
+		ID:            int(tier.FeeScheduleTierID),
+		FeeScheduleID: int(tier.FeeScheduleID),
+		MinAmount:     int(tier.MinAmount),
+		FlatAmount:    int(tier.FlatAmount),
+		PercentageBps: int(tier.PercentageBps),
+	}
+}
+
+func (f *feeScheduleRecordMapper) ToFeeScheduleTiersRecord(tiers []*db.FeeScheduleTier) []*record.FeeScheduleTierRecord {
+	var tierRecords []*record.FeeScheduleTierRecord
+
+	for _, tier := range tiers {
+		tierRecords = append(tierRecords, f.ToFeeScheduleTierRecord(tier))
+	}
+
+	return tierRecords
+}
+
+func (f *feeScheduleRecordMapper) ToFeeMonthlyAmount(s *db.GetMonthlyFeeAmountsRow) *record.FeeMonthAmount {
+	return &record.FeeMonthAmount{
+		Month:           s.Month,
+		TransactionType: s.TransactionType,
+		Currency:        s.Currency,
+		TotalFee:        int(s.TotalFee),
+	}
+}
+
+func (f *feeScheduleRecordMapper) ToFeeMonthlyAmounts(s []*db.GetMonthlyFeeAmountsRow) []*record.FeeMonthAmount {
+	var feeRecords []*record.FeeMonthAmount
+
+	for _, fee := range s {
+		feeRecords = append(feeRecords, f.ToFeeMonthlyAmount(fee))
+	}
+
+	return feeRecords
+}
+
+func (f *feeScheduleRecordMapper) ToFeeYearlyAmount(s *db.GetYearlyFeeAmountsRow) *record.FeeYearlyAmount {
+	return &record.FeeYearlyAmount{
+		Year:            strconv.Itoa(int(s.Year)),
+		TransactionType: s.TransactionType,
+		Currency:        s.Currency,
+		TotalFee:        int(s.TotalFee),
+	}
+}
+
+func (f *feeScheduleRecordMapper) ToFeeYearlyAmounts(s []*db.GetYearlyFeeAmountsRow) []*record.FeeYearlyAmount {
+	var feeRecords []*record.FeeYearlyAmount
+
+	for _, fee := range s {
+		feeRecords = append(feeRecords, f.ToFeeYearlyAmount(fee))
+	}
+
+	return feeRecords
+}
+
+func toFeeScheduleFilter(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.String
+}
+
+func toFeeScheduleBound(value sql.NullInt32) *int {
+	if !value.Valid {
+		return nil
+	}
+
+	bound := int(value.Int32)
+	return &bound
+}
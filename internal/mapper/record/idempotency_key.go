@@ -0,0 +1,32 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type idempotencyKeyRecordMapper struct {
+}
+
+func NewIdempotencyKeyRecordMapper() *idempotencyKeyRecordMapper {
+	return &idempotencyKeyRecordMapper{}
+}
+
+func (i *idempotencyKeyRecordMapper) ToIdempotencyKeyRecord(key *db.IdempotencyKey) *record.IdempotencyKeyRecord {
+	var responseBody *string
+	if key.ResponseBody.Valid {
+		responseBody = &key.ResponseBody.String
+	}
+
+	return &record.IdempotencyKeyRecord{
+		ID:           int(key.IdempotencyKeyID),
+		UserID:       int(key.UserID),
+		Scope:        key.Scope,
+		Key:          key.IdempotencyKey,
+		Fingerprint:  key.RequestFingerprint,
+		ResponseBody: responseBody,
+		ExpiresAt:    key.ExpiresAt.Format("2006-01-02 15:04:05"),
+		CreatedAt:    key.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:    key.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -115,6 +115,9 @@ type TopupRecordMapping interface {
 	ToTopupRecordsActive(topups []*db.GetActiveTopupsRow) []*record.TopupRecord
 	ToTopupRecordTrashed(topup *db.GetTrashedTopupsRow) *record.TopupRecord
 	ToTopupRecordsTrashed(topups []*db.GetTrashedTopupsRow) []*record.TopupRecord
+
+	ToTopupProviderPaymentRecord(payment *db.TopupProviderPayment) *record.TopupProviderPaymentRecord
+	ToTopupProviderPaymentRecords(payments []*db.TopupProviderPayment) []*record.TopupProviderPaymentRecord
 }
 
 type TransferRecordMapping interface {
@@ -212,6 +215,8 @@ type WithdrawRecordMapping interface {
 
 	ToWithdrawRecordTrashed(withdraw *db.GetTrashedWithdrawsRow) *record.WithdrawRecord
 	ToWithdrawsRecordTrashed(withdraws []*db.GetTrashedWithdrawsRow) []*record.WithdrawRecord
+
+	ToWithdrawReviewRecord(review *db.WithdrawReview) *record.WithdrawReviewRecord
 }
 
 type CardRecordMapping interface {
@@ -224,6 +229,9 @@ type CardRecordMapping interface {
 	ToCardRecordTrashed(card *db.GetTrashedCardsWithCountRow) *record.CardRecord
 	ToCardRecordsTrashed(cards []*db.GetTrashedCardsWithCountRow) []*record.CardRecord
 
+	ToCurrencyTotals(card *db.GetTotalAmountsByCurrencyRow) *record.CardCurrencyTotals
+	ToCurrencyTotalsList(cards []*db.GetTotalAmountsByCurrencyRow) []*record.CardCurrencyTotals
+
 	ToMonthlyBalance(card *db.GetMonthlyBalancesRow) *record.CardMonthBalance
 	ToMonthlyBalances(cards []*db.GetMonthlyBalancesRow) []*record.CardMonthBalance
 
@@ -391,31 +399,174 @@ type MerchantRecordMapping interface {
 	ToMerchantYearlyAmountByMerchant(ms *db.GetYearlyAmountByMerchantsRow) *record.MerchantYearlyAmount
 	ToMerchantYearlyAmountsByMerchant(ms []*db.GetYearlyAmountByMerchantsRow) []*record.MerchantYearlyAmount
 
+	ToMerchantMonthlyDisputeRateByMerchant(ms *db.GetMonthlyDisputeRateByMerchantsRow) *record.MerchantMonthlyDisputeRate
+	ToMerchantMonthlyDisputeRatesByMerchant(ms []*db.GetMonthlyDisputeRateByMerchantsRow) []*record.MerchantMonthlyDisputeRate
+	ToMerchantYearlyDisputeRateByMerchant(ms *db.GetYearlyDisputeRateByMerchantsRow) *record.MerchantYearlyDisputeRate
+	ToMerchantYearlyDisputeRatesByMerchant(ms []*db.GetYearlyDisputeRateByMerchantsRow) []*record.MerchantYearlyDisputeRate
+
 	ToMerchantMonthlyTotalAmountByMerchant(ms *db.GetMonthlyTotalAmountByMerchantRow) *record.MerchantMonthlyTotalAmount
 	ToMerchantMonthlyTotalAmountsByMerchant(ms []*db.GetMonthlyTotalAmountByMerchantRow) []*record.MerchantMonthlyTotalAmount
 	ToMerchantYearlyTotalAmountByMerchant(ms *db.GetYearlyTotalAmountByMerchantRow) *record.MerchantYearlyTotalAmount
 	ToMerchantYearlyTotalAmountsByMerchant(ms []*db.GetYearlyTotalAmountByMerchantRow) []*record.MerchantYearlyTotalAmount
 
-	ToMerchantTransactionByApikeyRecord(merchant *db.FindAllTransactionsByApikeyRow) *record.MerchantTransactionsRecord
-	ToMerchantsTransactionByApikeyRecord(merchants []*db.FindAllTransactionsByApikeyRow) []*record.MerchantTransactionsRecord
-
-	ToMerchantMonthlyPaymentMethodByApikey(ms *db.GetMonthlyPaymentMethodByApikeyRow) *record.MerchantMonthlyPaymentMethod
-	ToMerchantMonthlyPaymentMethodsByApikey(ms []*db.GetMonthlyPaymentMethodByApikeyRow) []*record.MerchantMonthlyPaymentMethod
-	ToMerchantYearlyPaymentMethodByApikey(ms *db.GetYearlyPaymentMethodByApikeyRow) *record.MerchantYearlyPaymentMethod
-	ToMerchantYearlyPaymentMethodsByApikey(ms []*db.GetYearlyPaymentMethodByApikeyRow) []*record.MerchantYearlyPaymentMethod
-
-	ToMerchantMonthlyAmountByApikey(ms *db.GetMonthlyAmountByApikeyRow) *record.MerchantMonthlyAmount
-	ToMerchantMonthlyAmountsByApikey(ms []*db.GetMonthlyAmountByApikeyRow) []*record.MerchantMonthlyAmount
-	ToMerchantYearlyAmountByApikey(ms *db.GetYearlyAmountByApikeyRow) *record.MerchantYearlyAmount
-	ToMerchantYearlyAmountsByApikey(ms []*db.GetYearlyAmountByApikeyRow) []*record.MerchantYearlyAmount
-
-	ToMerchantMonthlyTotalAmountByApikey(ms *db.GetMonthlyTotalAmountByApikeyRow) *record.MerchantMonthlyTotalAmount
-	ToMerchantMonthlyTotalAmountsByApikey(ms []*db.GetMonthlyTotalAmountByApikeyRow) []*record.MerchantMonthlyTotalAmount
-	ToMerchantYearlyTotalAmountByApikey(ms *db.GetYearlyTotalAmountByApikeyRow) *record.MerchantYearlyTotalAmount
-	ToMerchantYearlyTotalAmountsByApikey(ms []*db.GetYearlyTotalAmountByApikeyRow) []*record.MerchantYearlyTotalAmount
-
 	ToMerchantActiveRecord(merchant *db.GetActiveMerchantsRow) *record.MerchantRecord
 	ToMerchantsActiveRecord(merchants []*db.GetActiveMerchantsRow) []*record.MerchantRecord
 	ToMerchantTrashedRecord(merchant *db.GetTrashedMerchantsRow) *record.MerchantRecord
 	ToMerchantsTrashedRecord(merchants []*db.GetTrashedMerchantsRow) []*record.MerchantRecord
 }
+
+type LedgerRecordMapping interface {
+	ToLedgerJournalRecord(journal *db.LedgerJournal, postings []*db.LedgerPosting) *record.LedgerJournalRecord
+	ToLedgerJournalRecordPagination(journal *db.GetLedgerJournalsRow, postings []*db.LedgerPosting) *record.LedgerJournalRecord
+	ToLedgerJournalsRecordPagination(journals []*db.GetLedgerJournalsRow, postings []*db.LedgerPosting) []*record.LedgerJournalRecord
+
+	ToLedgerPostingRecord(posting *db.LedgerPosting) *record.LedgerPostingRecord
+	ToLedgerPostingsRecord(postings []*db.LedgerPosting) []*record.LedgerPostingRecord
+
+	ToLedgerCardPostingRecord(posting *db.GetLedgerPostingsByCardNumberRow) *record.LedgerCardPostingRecord
+	ToLedgerCardPostingsRecord(postings []*db.GetLedgerPostingsByCardNumberRow) []*record.LedgerCardPostingRecord
+}
+
+type IdempotencyKeyRecordMapping interface {
+	ToIdempotencyKeyRecord(key *db.IdempotencyKey) *record.IdempotencyKeyRecord
+}
+
+type RefundRecordMapping interface {
+	ToRefundRecord(refund *db.Refund) *record.RefundRecord
+	ToRefundsRecord(refunds []*db.Refund) []*record.RefundRecord
+	ToRefundRecordAll(refund *db.GetRefundsRow) *record.RefundRecord
+	ToRefundsRecordAll(refunds []*db.GetRefundsRow) []*record.RefundRecord
+}
+
+type DisputeRecordMapping interface {
+	ToDisputeRecord(dispute *db.Dispute) *record.DisputeRecord
+	ToDisputesRecord(disputes []*db.Dispute) []*record.DisputeRecord
+	ToDisputeRecordAll(dispute *db.GetDisputesRow) *record.DisputeRecord
+	ToDisputesRecordAll(disputes []*db.GetDisputesRow) []*record.DisputeRecord
+	ToDisputeRecordByMerchant(dispute *db.GetDisputesByMerchantIDRow) *record.DisputeRecord
+	ToDisputesRecordByMerchant(disputes []*db.GetDisputesByMerchantIDRow) []*record.DisputeRecord
+}
+
+type AuthorizationRecordMapping interface {
+	ToAuthorizationRecord(authorization *db.Authorization) *record.AuthorizationRecord
+	ToAuthorizationsRecord(authorizations []*db.Authorization) []*record.AuthorizationRecord
+	ToAuthorizationRecordAll(authorization *db.GetAuthorizationsRow) *record.AuthorizationRecord
+	ToAuthorizationsRecordAll(authorizations []*db.GetAuthorizationsRow) []*record.AuthorizationRecord
+}
+
+type SaldoHoldRecordMapping interface {
+	ToSaldoHoldRecord(hold *db.SaldoHold) *record.SaldoHoldRecord
+	ToSaldoHoldsRecord(holds []*db.SaldoHold) []*record.SaldoHoldRecord
+}
+
+type ExchangeRateRecordMapping interface {
+	ToExchangeRateRecord(rate *db.ExchangeRate) *record.ExchangeRateRecord
+	ToExchangeRatesRecord(rates []*db.ExchangeRate) []*record.ExchangeRateRecord
+	ToExchangeRateRecordAll(rate *db.GetExchangeRatesRow) *record.ExchangeRateRecord
+	ToExchangeRatesRecordAll(rates []*db.GetExchangeRatesRow) []*record.ExchangeRateRecord
+}
+
+type FeeScheduleRecordMapping interface {
+	ToFeeScheduleRecord(schedule *db.FeeSchedule, tiers []*db.FeeScheduleTier) *record.FeeScheduleRecord
+	ToFeeScheduleRecordAll(schedule *db.GetFeeSchedulesRow, tiers []*db.FeeScheduleTier) *record.FeeScheduleRecord
+	ToFeeSchedulesRecordAll(schedules []*db.GetFeeSchedulesRow, tiers []*db.FeeScheduleTier) []*record.FeeScheduleRecord
+
+	ToFeeScheduleTierRecord(tier *db.FeeScheduleTier) *record.FeeScheduleTierRecord
+	ToFeeScheduleTiersRecord(tiers []*db.FeeScheduleTier) []*record.FeeScheduleTierRecord
+
+	ToFeeMonthlyAmount(s *db.GetMonthlyFeeAmountsRow) *record.FeeMonthAmount
+	ToFeeMonthlyAmounts(s []*db.GetMonthlyFeeAmountsRow) []*record.FeeMonthAmount
+	ToFeeYearlyAmount(s *db.GetYearlyFeeAmountsRow) *record.FeeYearlyAmount
+	ToFeeYearlyAmounts(s []*db.GetYearlyFeeAmountsRow) []*record.FeeYearlyAmount
+}
+
+type TransactionLimitRecordMapping interface {
+	ToTransactionLimitRecord(limit *db.TransactionLimit) *record.TransactionLimitRecord
+	ToTransactionLimitsRecord(limits []*db.TransactionLimit) []*record.TransactionLimitRecord
+	ToTransactionLimitRecordAll(limit *db.GetTransactionLimitsRow) *record.TransactionLimitRecord
+	ToTransactionLimitsRecordAll(limits []*db.GetTransactionLimitsRow) []*record.TransactionLimitRecord
+
+	ToTransactionLimitUsageRecord(usage *db.GetCardUsageSinceRow) *record.TransactionLimitUsageRecord
+}
+
+type ScheduledTransferRecordMapping interface {
+	ToScheduledTransferRecord(schedule *db.ScheduledTransfer) *record.ScheduledTransferRecord
+	ToScheduledTransfersRecord(schedules []*db.ScheduledTransfer) []*record.ScheduledTransferRecord
+	ToScheduledTransferRecordAll(schedule *db.GetScheduledTransfersRow) *record.ScheduledTransferRecord
+	ToScheduledTransfersRecordAll(schedules []*db.GetScheduledTransfersRow) []*record.ScheduledTransferRecord
+
+	ToScheduledTransferRunRecord(run *db.ScheduledTransferRun) *record.ScheduledTransferRunRecord
+	ToScheduledTransferRunsRecordAll(runs []*db.GetScheduledTransferRunsRow) []*record.ScheduledTransferRunRecord
+}
+
+type TransferBatchRecordMapping interface {
+	ToTransferBatchRecord(batch *db.TransferBatch) *record.TransferBatchRecord
+	ToTransferBatchesRecord(batches []*db.TransferBatch) []*record.TransferBatchRecord
+	ToTransferBatchesRecordAll(batches []*db.GetTransferBatchesRow) []*record.TransferBatchRecord
+
+	ToTransferBatchRowRecord(row *db.TransferBatchRow) *record.TransferBatchRowRecord
+	ToTransferBatchRowsRecord(rows []*db.TransferBatchRow) []*record.TransferBatchRowRecord
+	ToTransferBatchRowsRecordAll(rows []*db.GetTransferBatchRowsRow) []*record.TransferBatchRowRecord
+}
+
+type StatusHistoryRecordMapping interface {
+	ToStatusHistoryRecord(history *db.StatusHistory) *record.StatusHistoryRecord
+	ToStatusHistoriesRecord(histories []*db.StatusHistory) []*record.StatusHistoryRecord
+}
+
+type ProviderWebhookRecordMapping interface {
+	ToProviderWebhookEventRecord(event *db.ProviderWebhookEvent) *record.ProviderWebhookEventRecord
+	ToProviderWebhookEventsRecordAll(events []*db.GetProviderWebhookEventsRow) []*record.ProviderWebhookEventRecord
+}
+
+type MerchantWebhookRecordMapping interface {
+	ToMerchantWebhookEndpointRecord(endpoint *db.MerchantWebhookEndpoint) *record.MerchantWebhookEndpointRecord
+	ToMerchantWebhookEndpointsRecord(endpoints []*db.MerchantWebhookEndpoint) []*record.MerchantWebhookEndpointRecord
+	ToMerchantWebhookDeliveryRecord(delivery *db.MerchantWebhookDelivery) *record.MerchantWebhookDeliveryRecord
+	ToMerchantWebhookDeliveriesRecord(deliveries []*db.MerchantWebhookDelivery) []*record.MerchantWebhookDeliveryRecord
+	ToMerchantWebhookDeliveriesRecordAll(deliveries []*db.GetMerchantWebhookDeliveriesRow) []*record.MerchantWebhookDeliveryRecord
+	ToMerchantWebhookDeliveryAttemptRecord(attempt *db.MerchantWebhookDeliveryAttempt) *record.MerchantWebhookDeliveryAttemptRecord
+	ToMerchantWebhookDeliveryAttemptsRecord(attempts []*db.MerchantWebhookDeliveryAttempt) []*record.MerchantWebhookDeliveryAttemptRecord
+}
+
+type SettlementRecordMapping interface {
+	ToMerchantBalanceRecord(balance *db.MerchantBalance) *record.MerchantBalanceRecord
+	ToMerchantBalancesRecord(balances []*db.MerchantBalance) []*record.MerchantBalanceRecord
+	ToSettlementTotalsRecord(totals *db.GetUnsettledSettlementTotalsRow) *record.SettlementTotalsRecord
+	ToSettlementBatchRecord(batch *db.SettlementBatch) *record.SettlementBatchRecord
+	ToSettlementBatchesRecordAll(batches []*db.GetSettlementBatchesByMerchantRow) []*record.SettlementBatchRecord
+	ToSettlementItemRecord(item *db.SettlementItem) *record.SettlementItemRecord
+	ToSettlementItemsRecord(items []*db.SettlementItem) []*record.SettlementItemRecord
+}
+
+type MerchantOnboardingRecordMapping interface {
+	ToMerchantBusinessProfileRecord(profile *db.MerchantBusinessProfile) *record.MerchantBusinessProfileRecord
+	ToMerchantByStatusRecord(merchant *db.GetMerchantsByStatusRow) *record.MerchantRecord
+	ToMerchantsByStatusRecord(merchants []*db.GetMerchantsByStatusRow) []*record.MerchantRecord
+}
+
+type ReconciliationRecordMapping interface {
+	ToReconciliationRunRecord(run *db.ReconciliationRun) *record.ReconciliationRunRecord
+	ToReconciliationRunsRecordAll(runs []*db.GetReconciliationRunsRow) []*record.ReconciliationRunRecord
+	ToCardReconciliationsRecord(cards []*db.GetCardReconciliationsRow) []*record.CardReconciliationRecord
+	ToBalanceDiscrepancyRecord(discrepancy *db.BalanceDiscrepancy) *record.BalanceDiscrepancyRecord
+	ToBalanceDiscrepanciesRecordAll(discrepancies []*db.GetBalanceDiscrepanciesRow) []*record.BalanceDiscrepancyRecord
+}
+
+type MerchantApiKeyRecordMapping interface {
+	ToMerchantApiKeyRecord(key *db.MerchantApiKey) *record.MerchantApiKeyRecord
+	ToMerchantApiKeysRecord(keys []*db.MerchantApiKey) []*record.MerchantApiKeyRecord
+}
+
+type InvoiceRecordMapping interface {
+	ToInvoiceRecord(invoice *db.Invoice) *record.InvoiceRecord
+	ToInvoicesRecordAll(invoices []*db.GetInvoicesByMerchantRow) []*record.InvoiceRecord
+	ToInvoiceLineItemRecord(item *db.InvoiceLineItem) *record.InvoiceLineItemRecord
+	ToInvoiceLineItemsRecord(items []*db.InvoiceLineItem) []*record.InvoiceLineItemRecord
+	ToInvoicePaymentRecord(payment *db.InvoicePayment) *record.InvoicePaymentRecord
+	ToInvoicePaymentsRecord(payments []*db.InvoicePayment) []*record.InvoicePaymentRecord
+}
+
+type QrPaymentRecordMapping interface {
+	ToQrPaymentRecord(payment *db.QrPayment) *record.QrPaymentRecord
+}
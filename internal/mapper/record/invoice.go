@@ -0,0 +1,109 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type invoiceRecordMapper struct {
+}
+
+func NewInvoiceRecordMapper() *invoiceRecordMapper {
+	return &invoiceRecordMapper{}
+}
+
+func (i *invoiceRecordMapper) ToInvoiceRecord(invoice *db.Invoice) *record.InvoiceRecord {
+	var paidAt *string
+	if invoice.PaidAt.Valid {
+		formatedPaidAt := invoice.PaidAt.Time.Format("2006-01-02 15:04:05")
+		paidAt = &formatedPaidAt
+	}
+
+	var cancelledAt *string
+	if invoice.CancelledAt.Valid {
+		formatedCancelledAt := invoice.CancelledAt.Time.Format("2006-01-02 15:04:05")
+		cancelledAt = &formatedCancelledAt
+	}
+
+	return &record.InvoiceRecord{
+		ID:          int(invoice.InvoiceID),
+		Code:        invoice.Code,
+		MerchantID:  int(invoice.MerchantID),
+		Kind:        invoice.Kind,
+		Description: invoice.Description,
+		Amount:      int(invoice.Amount),
+		Currency:    invoice.Currency,
+		Status:      invoice.Status,
+		ExpiresAt:   invoice.ExpiresAt.Format("2006-01-02 15:04:05"),
+		PaidAt:      paidAt,
+		CancelledAt: cancelledAt,
+		CreatedAt:   invoice.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:   invoice.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (i *invoiceRecordMapper) ToInvoicesRecordAll(invoices []*db.GetInvoicesByMerchantRow) []*record.InvoiceRecord {
+	var records []*record.InvoiceRecord
+
+	for _, invoice := range invoices {
+		records = append(records, i.ToInvoiceRecord(&db.Invoice{
+			InvoiceID:   invoice.InvoiceID,
+			Code:        invoice.Code,
+			MerchantID:  invoice.MerchantID,
+			Kind:        invoice.Kind,
+			Description: invoice.Description,
+			Amount:      invoice.Amount,
+			Currency:    invoice.Currency,
+			Status:      invoice.Status,
+			ExpiresAt:   invoice.ExpiresAt,
+			PaidAt:      invoice.PaidAt,
+			CancelledAt: invoice.CancelledAt,
+			CreatedAt:   invoice.CreatedAt,
+			UpdatedAt:   invoice.UpdatedAt,
+		}))
+	}
+
+	return records
+}
+
+func (i *invoiceRecordMapper) ToInvoiceLineItemRecord(item *db.InvoiceLineItem) *record.InvoiceLineItemRecord {
+	return &record.InvoiceLineItemRecord{
+		ID:          int(item.InvoiceLineItemID),
+		InvoiceID:   int(item.InvoiceID),
+		Description: item.Description,
+		Quantity:    int(item.Quantity),
+		UnitPrice:   int(item.UnitPrice),
+		Amount:      int(item.Amount),
+		CreatedAt:   item.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (i *invoiceRecordMapper) ToInvoiceLineItemsRecord(items []*db.InvoiceLineItem) []*record.InvoiceLineItemRecord {
+	var records []*record.InvoiceLineItemRecord
+
+	for _, item := range items {
+		records = append(records, i.ToInvoiceLineItemRecord(item))
+	}
+
+	return records
+}
+
+func (i *invoiceRecordMapper) ToInvoicePaymentRecord(payment *db.InvoicePayment) *record.InvoicePaymentRecord {
+	return &record.InvoicePaymentRecord{
+		ID:            int(payment.InvoicePaymentID),
+		InvoiceID:     int(payment.InvoiceID),
+		TransactionID: int(payment.TransactionID),
+		Amount:        int(payment.Amount),
+		CreatedAt:     payment.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (i *invoiceRecordMapper) ToInvoicePaymentsRecord(payments []*db.InvoicePayment) []*record.InvoicePaymentRecord {
+	var records []*record.InvoicePaymentRecord
+
+	for _, payment := range payments {
+		records = append(records, i.ToInvoicePaymentRecord(payment))
+	}
+
+	return records
+}
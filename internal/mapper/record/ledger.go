@@ -0,0 +1,115 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type ledgerRecordMapper struct{}
+
+func NewLedgerRecordMapper() *ledgerRecordMapper {
+	return &ledgerRecordMapper{}
+}
+
+func (l *ledgerRecordMapper) ToLedgerJournalRecord(journal *db.LedgerJournal, postings []*db.LedgerPosting) *record.LedgerJournalRecord {
+	var referenceID *int
+
+	if journal.ReferenceID.Valid {
+		id := int(journal.ReferenceID.Int32)
+		referenceID = &id
+	}
+
+	return &record.LedgerJournalRecord{
+		ID:            int(journal.JournalID),
+		JournalNo:     journal.JournalNo.String(),
+		ReferenceType: journal.ReferenceType,
+		ReferenceID:   referenceID,
+		Description:   journal.Description,
+		PostedAt:      journal.PostedAt.Format("2006-01-02 15:04:05"),
+		CreatedAt:     journal.CreatedAt.Time.Format("2006-01-02"),
+		Postings:      l.ToLedgerPostingsRecord(postings),
+	}
+}
+
+func (l *ledgerRecordMapper) ToLedgerJournalRecordPagination(journal *db.GetLedgerJournalsRow, postings []*db.LedgerPosting) *record.LedgerJournalRecord {
+	return l.ToLedgerJournalRecord(&db.LedgerJournal{
+		JournalID:     journal.JournalID,
+		JournalNo:     journal.JournalNo,
+		ReferenceType: journal.ReferenceType,
+		ReferenceID:   journal.ReferenceID,
+		Description:   journal.Description,
+		PostedAt:      journal.PostedAt,
+		CreatedAt:     journal.CreatedAt,
+	}, postings)
+}
+
+// ToLedgerJournalsRecordPagination maps a page of journals, attaching to
+// each journal the postings from the batch that belong to it.
+func (l *ledgerRecordMapper) ToLedgerJournalsRecordPagination(journals []*db.GetLedgerJournalsRow, postings []*db.LedgerPosting) []*record.LedgerJournalRecord {
+	postingsByJournal := make(map[int32][]*db.LedgerPosting)
+	for _, posting := range postings {
+		postingsByJournal[posting.JournalID] = append(postingsByJournal[posting.JournalID], posting)
+	}
+
+	var records []*record.LedgerJournalRecord
+	for _, journal := range journals {
+		records = append(records, l.ToLedgerJournalRecordPagination(journal, postingsByJournal[journal.JournalID]))
+	}
+	return records
+}
+
+func (l *ledgerRecordMapper) ToLedgerPostingRecord(posting *db.LedgerPosting) *record.LedgerPostingRecord {
+	var cardNumber *string
+
+	if posting.CardNumber.Valid {
+		cardNumber = &posting.CardNumber.String
+	}
+
+	return &record.LedgerPostingRecord{
+		ID:          int(posting.PostingID),
+		JournalID:   int(posting.JournalID),
+		AccountCode: posting.AccountCode,
+		CardNumber:  cardNumber,
+		Direction:   posting.Direction,
+		Amount:      int(posting.Amount),
+		Currency:    posting.Currency,
+		CreatedAt:   posting.CreatedAt.Time.Format("2006-01-02"),
+	}
+}
+
+func (l *ledgerRecordMapper) ToLedgerPostingsRecord(postings []*db.LedgerPosting) []*record.LedgerPostingRecord {
+	var records []*record.LedgerPostingRecord
+	for _, posting := range postings {
+		records = append(records, l.ToLedgerPostingRecord(posting))
+	}
+	return records
+}
+
+func (l *ledgerRecordMapper) ToLedgerCardPostingRecord(posting *db.GetLedgerPostingsByCardNumberRow) *record.LedgerCardPostingRecord {
+	var referenceID *int
+
+	if posting.ReferenceID.Valid {
+		id := int(posting.ReferenceID.Int32)
+		referenceID = &id
+	}
+
+	return &record.LedgerCardPostingRecord{
+		ID:            int(posting.PostingID),
+		JournalID:     int(posting.JournalID),
+		ReferenceType: posting.ReferenceType,
+		ReferenceID:   referenceID,
+		CardNumber:    posting.CardNumber.String,
+		Direction:     posting.Direction,
+		Amount:        int(posting.Amount),
+		Currency:      posting.Currency,
+		PostedAt:      posting.PostedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (l *ledgerRecordMapper) ToLedgerCardPostingsRecord(postings []*db.GetLedgerPostingsByCardNumberRow) []*record.LedgerCardPostingRecord {
+	var records []*record.LedgerCardPostingRecord
+	for _, posting := range postings {
+		records = append(records, l.ToLedgerCardPostingRecord(posting))
+	}
+	return records
+}
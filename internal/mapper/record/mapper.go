@@ -1,31 +1,71 @@
 package recordmapper
 
 type RecordMapper struct {
-	UserRecordMapper         UserRecordMapping
-	RoleRecordMapper         RoleRecordMapping
-	UserRoleRecordMapper     UserRoleRecordMapping
-	RefreshTokenRecordMapper RefreshTokenRecordMapping
-	SaldoRecordMapper        SaldoRecordMapping
-	TopupRecordMapper        TopupRecordMapping
-	TransferRecordMapper     TransferRecordMapping
-	WithdrawRecordMapper     WithdrawRecordMapping
-	CardRecordMapper         CardRecordMapping
-	TransactionRecordMapper  TransactionRecordMapping
-	MerchantRecordMapper     MerchantRecordMapping
+	UserRecordMapper               UserRecordMapping
+	RoleRecordMapper               RoleRecordMapping
+	UserRoleRecordMapper           UserRoleRecordMapping
+	RefreshTokenRecordMapper       RefreshTokenRecordMapping
+	SaldoRecordMapper              SaldoRecordMapping
+	TopupRecordMapper              TopupRecordMapping
+	TransferRecordMapper           TransferRecordMapping
+	WithdrawRecordMapper           WithdrawRecordMapping
+	CardRecordMapper               CardRecordMapping
+	TransactionRecordMapper        TransactionRecordMapping
+	MerchantRecordMapper           MerchantRecordMapping
+	LedgerRecordMapper             LedgerRecordMapping
+	IdempotencyKeyRecordMapper     IdempotencyKeyRecordMapping
+	RefundRecordMapper             RefundRecordMapping
+	DisputeRecordMapper            DisputeRecordMapping
+	AuthorizationRecordMapper      AuthorizationRecordMapping
+	SaldoHoldRecordMapper          SaldoHoldRecordMapping
+	ExchangeRateRecordMapper       ExchangeRateRecordMapping
+	FeeScheduleRecordMapper        FeeScheduleRecordMapping
+	TransactionLimitRecordMapper   TransactionLimitRecordMapping
+	ScheduledTransferRecordMapper  ScheduledTransferRecordMapping
+	TransferBatchRecordMapper      TransferBatchRecordMapping
+	StatusHistoryRecordMapper      StatusHistoryRecordMapping
+	ProviderWebhookRecordMapper    ProviderWebhookRecordMapping
+	MerchantWebhookRecordMapper    MerchantWebhookRecordMapping
+	SettlementRecordMapper         SettlementRecordMapping
+	ReconciliationRecordMapper     ReconciliationRecordMapping
+	MerchantOnboardingRecordMapper MerchantOnboardingRecordMapping
+	MerchantApiKeyRecordMapper     MerchantApiKeyRecordMapping
+	InvoiceRecordMapper            InvoiceRecordMapping
+	QrPaymentRecordMapper          QrPaymentRecordMapping
 }
 
 func NewRecordMapper() *RecordMapper {
 	return &RecordMapper{
-		UserRecordMapper:         NewUserRecordMapper(),
-		RoleRecordMapper:         NewRoleRecordMapper(),
-		UserRoleRecordMapper:     NewUserRoleRecordMapper(),
-		RefreshTokenRecordMapper: NewRefreshTokenRecordMapper(),
-		SaldoRecordMapper:        NewSaldoRecordMapper(),
-		TopupRecordMapper:        NewTopupRecordMapper(),
-		TransferRecordMapper:     NewTransferRecordMapper(),
-		WithdrawRecordMapper:     NewWithdrawRecordMapper(),
-		CardRecordMapper:         NewCardRecordMapper(),
-		TransactionRecordMapper:  NewTransactionRecordMapper(),
-		MerchantRecordMapper:     NewMerchantRecordMapper(),
+		UserRecordMapper:               NewUserRecordMapper(),
+		RoleRecordMapper:               NewRoleRecordMapper(),
+		UserRoleRecordMapper:           NewUserRoleRecordMapper(),
+		RefreshTokenRecordMapper:       NewRefreshTokenRecordMapper(),
+		SaldoRecordMapper:              NewSaldoRecordMapper(),
+		TopupRecordMapper:              NewTopupRecordMapper(),
+		TransferRecordMapper:           NewTransferRecordMapper(),
+		WithdrawRecordMapper:           NewWithdrawRecordMapper(),
+		CardRecordMapper:               NewCardRecordMapper(),
+		TransactionRecordMapper:        NewTransactionRecordMapper(),
+		MerchantRecordMapper:           NewMerchantRecordMapper(),
+		LedgerRecordMapper:             NewLedgerRecordMapper(),
+		IdempotencyKeyRecordMapper:     NewIdempotencyKeyRecordMapper(),
+		RefundRecordMapper:             NewRefundRecordMapper(),
+		DisputeRecordMapper:            NewDisputeRecordMapper(),
+		AuthorizationRecordMapper:      NewAuthorizationRecordMapper(),
+		SaldoHoldRecordMapper:          NewSaldoHoldRecordMapper(),
+		ExchangeRateRecordMapper:       NewExchangeRateRecordMapper(),
+		FeeScheduleRecordMapper:        NewFeeScheduleRecordMapper(),
+		TransactionLimitRecordMapper:   NewTransactionLimitRecordMapper(),
+		ScheduledTransferRecordMapper:  NewScheduledTransferRecordMapper(),
+		TransferBatchRecordMapper:      NewTransferBatchRecordMapper(),
+		StatusHistoryRecordMapper:      NewStatusHistoryRecordMapper(),
+		ProviderWebhookRecordMapper:    NewProviderWebhookRecordMapper(),
+		MerchantWebhookRecordMapper:    NewMerchantWebhookRecordMapper(),
+		SettlementRecordMapper:         NewSettlementRecordMapper(),
+		ReconciliationRecordMapper:     NewReconciliationRecordMapper(),
+		MerchantOnboardingRecordMapper: NewMerchantOnboardingRecordMapper(),
+		MerchantApiKeyRecordMapper:     NewMerchantApiKeyRecordMapper(),
+		InvoiceRecordMapper:            NewInvoiceRecordMapper(),
+		QrPaymentRecordMapper:          NewQrPaymentRecordMapper(),
 	}
 }
@@ -23,7 +23,6 @@ func (m *merchantRecordMapper) ToMerchantRecord(merchant *db.Merchant) *record.M
 	return &record.MerchantRecord{
 		ID:        int(merchant.MerchantID),
 		Name:      merchant.Name,
-		ApiKey:    merchant.ApiKey,
 		UserID:    int(merchant.UserID),
 		Status:    merchant.Status,
 		CreatedAt: merchant.CreatedAt.Time.Format("2006-01-02"),
@@ -81,7 +80,6 @@ func (m *merchantRecordMapper) ToMerchantGetAllRecord(merchant *db.GetMerchantsR
 	return &record.MerchantRecord{
 		ID:        int(merchant.MerchantID),
 		Name:      merchant.Name,
-		ApiKey:    merchant.ApiKey,
 		UserID:    int(merchant.UserID),
 		Status:    merchant.Status,
 		CreatedAt: merchant.CreatedAt.Time.Format("2006-01-02"),
@@ -133,6 +131,7 @@ func (m *merchantRecordMapper) ToMerchantYearlyPaymentMethods(ms []*db.GetYearly
 func (m *merchantRecordMapper) ToMerchantMonthlyAmount(ms *db.GetMonthlyAmountMerchantRow) *record.MerchantMonthlyAmount {
 	return &record.MerchantMonthlyAmount{
 		Month:       ms.Month,
+		Currency:    ms.Currency,
 		TotalAmount: int(ms.TotalAmount),
 	}
 }
@@ -148,6 +147,7 @@ func (m *merchantRecordMapper) ToMerchantMonthlyAmounts(ms []*db.GetMonthlyAmoun
 func (m *merchantRecordMapper) ToMerchantYearlyAmount(ms *db.GetYearlyAmountMerchantRow) *record.MerchantYearlyAmount {
 	return &record.MerchantYearlyAmount{
 		Year:        ms.Year,
+		Currency:    ms.Currency,
 		TotalAmount: int(ms.TotalAmount),
 	}
 }
@@ -163,6 +163,7 @@ func (m *merchantRecordMapper) ToMerchantYearlyAmounts(ms []*db.GetYearlyAmountM
 func (m *merchantRecordMapper) ToMerchantMonthlyTotalAmount(ms *db.GetMonthlyTotalAmountMerchantRow) *record.MerchantMonthlyTotalAmount {
 	return &record.MerchantMonthlyTotalAmount{
 		Month:       ms.Month,
+		Currency:    ms.Currency,
 		Year:        ms.Year,
 		TotalAmount: int(ms.TotalAmount),
 	}
@@ -179,6 +180,7 @@ func (m *merchantRecordMapper) ToMerchantMonthlyTotalAmounts(ms []*db.GetMonthly
 func (m *merchantRecordMapper) ToMerchantYearlyTotalAmount(ms *db.GetYearlyTotalAmountMerchantRow) *record.MerchantYearlyTotalAmount {
 	return &record.MerchantYearlyTotalAmount{
 		Year:        ms.Year,
+		Currency:    ms.Currency,
 		TotalAmount: int(ms.TotalAmount),
 	}
 }
@@ -256,6 +258,7 @@ func (m *merchantRecordMapper) ToMerchantYearlyPaymentMethodsByMerchant(ms []*db
 func (m *merchantRecordMapper) ToMerchantMonthlyAmountByMerchant(ms *db.GetMonthlyAmountByMerchantsRow) *record.MerchantMonthlyAmount {
 	return &record.MerchantMonthlyAmount{
 		Month:       ms.Month,
+		Currency:    ms.Currency,
 		TotalAmount: int(ms.TotalAmount),
 	}
 }
@@ -271,6 +274,7 @@ func (m *merchantRecordMapper) ToMerchantMonthlyAmountsByMerchant(ms []*db.GetMo
 func (m *merchantRecordMapper) ToMerchantYearlyAmountByMerchant(ms *db.GetYearlyAmountByMerchantsRow) *record.MerchantYearlyAmount {
 	return &record.MerchantYearlyAmount{
 		Year:        ms.Year,
+		Currency:    ms.Currency,
 		TotalAmount: int(ms.TotalAmount),
 	}
 }
@@ -283,164 +287,77 @@ func (m *merchantRecordMapper) ToMerchantYearlyAmountsByMerchant(ms []*db.GetYea
 	return records
 }
 
-func (m *merchantRecordMapper) ToMerchantMonthlyTotalAmountByMerchant(ms *db.GetMonthlyTotalAmountByMerchantRow) *record.MerchantMonthlyTotalAmount {
-	return &record.MerchantMonthlyTotalAmount{
-		Month:       ms.Month,
-		Year:        ms.Year,
-		TotalAmount: int(ms.TotalAmount),
-	}
-}
-
-func (m *merchantRecordMapper) ToMerchantMonthlyTotalAmountsByMerchant(ms []*db.GetMonthlyTotalAmountByMerchantRow) []*record.MerchantMonthlyTotalAmount {
-	var records []*record.MerchantMonthlyTotalAmount
-	for _, merchant := range ms {
-		records = append(records, m.ToMerchantMonthlyTotalAmountByMerchant(merchant))
-	}
-	return records
-}
-
-func (m *merchantRecordMapper) ToMerchantYearlyTotalAmountByMerchant(ms *db.GetYearlyTotalAmountByMerchantRow) *record.MerchantYearlyTotalAmount {
-	return &record.MerchantYearlyTotalAmount{
-		Year:        ms.Year,
-		TotalAmount: int(ms.TotalAmount),
-	}
-}
-
-func (m *merchantRecordMapper) ToMerchantYearlyTotalAmountsByMerchant(ms []*db.GetYearlyTotalAmountByMerchantRow) []*record.MerchantYearlyTotalAmount {
-	var records []*record.MerchantYearlyTotalAmount
-	for _, merchant := range ms {
-		records = append(records, m.ToMerchantYearlyTotalAmountByMerchant(merchant))
-	}
-	return records
-}
-
-//
-
-func (m *merchantRecordMapper) ToMerchantTransactionByApikeyRecord(merchant *db.FindAllTransactionsByApikeyRow) *record.MerchantTransactionsRecord {
-	var deletedAt *string
-
-	if merchant.DeletedAt.Valid {
-		formatedDeletedAt := merchant.DeletedAt.Time.Format("2006-01-02")
-		deletedAt = &formatedDeletedAt
-	}
-
-	return &record.MerchantTransactionsRecord{
-		TransactionID:   merchant.TransactionID,
-		CardNumber:      merchant.CardNumber,
-		Amount:          merchant.Amount,
-		PaymentMethod:   merchant.PaymentMethod,
-		MerchantID:      merchant.MerchantID,
-		MerchantName:    merchant.MerchantName,
-		TransactionTime: merchant.TransactionTime,
-		CreatedAt:       merchant.CreatedAt.Time.Format("2006-01-02"),
-		UpdatedAt:       merchant.UpdatedAt.Time.Format("2006-01-02"),
-		DeletedAt:       deletedAt,
+func (m *merchantRecordMapper) ToMerchantMonthlyDisputeRateByMerchant(ms *db.GetMonthlyDisputeRateByMerchantsRow) *record.MerchantMonthlyDisputeRate {
+	return &record.MerchantMonthlyDisputeRate{
+		Month:             ms.Month,
+		TotalTransactions: int(ms.TotalTransactions),
+		TotalDisputes:     int(ms.TotalDisputes),
+		DisputeRate:       ms.DisputeRate,
 	}
 }
 
-func (m *merchantRecordMapper) ToMerchantsTransactionByApikeyRecord(merchants []*db.FindAllTransactionsByApikeyRow) []*record.MerchantTransactionsRecord {
-	var records []*record.MerchantTransactionsRecord
-	for _, merchant := range merchants {
-		records = append(records, m.ToMerchantTransactionByApikeyRecord(merchant))
-	}
-	return records
-}
-
-func (m *merchantRecordMapper) ToMerchantMonthlyPaymentMethodByApikey(ms *db.GetMonthlyPaymentMethodByApikeyRow) *record.MerchantMonthlyPaymentMethod {
-	return &record.MerchantMonthlyPaymentMethod{
-		Month:         ms.Month,
-		PaymentMethod: ms.PaymentMethod,
-		TotalAmount:   int(ms.TotalAmount),
-	}
-}
-
-func (m *merchantRecordMapper) ToMerchantMonthlyPaymentMethodsByApikey(ms []*db.GetMonthlyPaymentMethodByApikeyRow) []*record.MerchantMonthlyPaymentMethod {
-	var records []*record.MerchantMonthlyPaymentMethod
+func (m *merchantRecordMapper) ToMerchantMonthlyDisputeRatesByMerchant(ms []*db.GetMonthlyDisputeRateByMerchantsRow) []*record.MerchantMonthlyDisputeRate {
+	var records []*record.MerchantMonthlyDisputeRate
 	for _, merchant := range ms {
-		records = append(records, m.ToMerchantMonthlyPaymentMethodByApikey(merchant))
+		records = append(records, m.ToMerchantMonthlyDisputeRateByMerchant(merchant))
 	}
 	return records
 }
 
-func (m *merchantRecordMapper) ToMerchantYearlyPaymentMethodByApikey(ms *db.GetYearlyPaymentMethodByApikeyRow) *record.MerchantYearlyPaymentMethod {
-	return &record.MerchantYearlyPaymentMethod{
-		Year:          ms.Year,
-		PaymentMethod: ms.PaymentMethod,
-		TotalAmount:   int(ms.TotalAmount),
+func (m *merchantRecordMapper) ToMerchantYearlyDisputeRateByMerchant(ms *db.GetYearlyDisputeRateByMerchantsRow) *record.MerchantYearlyDisputeRate {
+	return &record.MerchantYearlyDisputeRate{
+		Year:              ms.Year,
+		TotalTransactions: int(ms.TotalTransactions),
+		TotalDisputes:     int(ms.TotalDisputes),
+		DisputeRate:       ms.DisputeRate,
 	}
 }
 
-func (m *merchantRecordMapper) ToMerchantYearlyPaymentMethodsByApikey(ms []*db.GetYearlyPaymentMethodByApikeyRow) []*record.MerchantYearlyPaymentMethod {
-	var records []*record.MerchantYearlyPaymentMethod
+func (m *merchantRecordMapper) ToMerchantYearlyDisputeRatesByMerchant(ms []*db.GetYearlyDisputeRateByMerchantsRow) []*record.MerchantYearlyDisputeRate {
+	var records []*record.MerchantYearlyDisputeRate
 	for _, merchant := range ms {
-		records = append(records, m.ToMerchantYearlyPaymentMethodByApikey(merchant))
+		records = append(records, m.ToMerchantYearlyDisputeRateByMerchant(merchant))
 	}
 	return records
 }
 
-func (m *merchantRecordMapper) ToMerchantMonthlyAmountByApikey(ms *db.GetMonthlyAmountByApikeyRow) *record.MerchantMonthlyAmount {
-	return &record.MerchantMonthlyAmount{
-		Month:       ms.Month,
-		TotalAmount: int(ms.TotalAmount),
-	}
-}
-
-func (m *merchantRecordMapper) ToMerchantMonthlyAmountsByApikey(ms []*db.GetMonthlyAmountByApikeyRow) []*record.MerchantMonthlyAmount {
-	var records []*record.MerchantMonthlyAmount
-	for _, merchant := range ms {
-		records = append(records, m.ToMerchantMonthlyAmountByApikey(merchant))
-	}
-	return records
-}
-
-func (m *merchantRecordMapper) ToMerchantYearlyAmountByApikey(ms *db.GetYearlyAmountByApikeyRow) *record.MerchantYearlyAmount {
-	return &record.MerchantYearlyAmount{
-		Year:        ms.Year,
-		TotalAmount: int(ms.TotalAmount),
-	}
-}
-
-func (m *merchantRecordMapper) ToMerchantYearlyAmountsByApikey(ms []*db.GetYearlyAmountByApikeyRow) []*record.MerchantYearlyAmount {
-	var records []*record.MerchantYearlyAmount
-	for _, merchant := range ms {
-		records = append(records, m.ToMerchantYearlyAmountByApikey(merchant))
-	}
-	return records
-}
-
-func (m *merchantRecordMapper) ToMerchantMonthlyTotalAmountByApikey(ms *db.GetMonthlyTotalAmountByApikeyRow) *record.MerchantMonthlyTotalAmount {
+func (m *merchantRecordMapper) ToMerchantMonthlyTotalAmountByMerchant(ms *db.GetMonthlyTotalAmountByMerchantRow) *record.MerchantMonthlyTotalAmount {
 	return &record.MerchantMonthlyTotalAmount{
 		Month:       ms.Month,
+		Currency:    ms.Currency,
 		Year:        ms.Year,
 		TotalAmount: int(ms.TotalAmount),
 	}
 }
 
-func (m *merchantRecordMapper) ToMerchantMonthlyTotalAmountsByApikey(ms []*db.GetMonthlyTotalAmountByApikeyRow) []*record.MerchantMonthlyTotalAmount {
+func (m *merchantRecordMapper) ToMerchantMonthlyTotalAmountsByMerchant(ms []*db.GetMonthlyTotalAmountByMerchantRow) []*record.MerchantMonthlyTotalAmount {
 	var records []*record.MerchantMonthlyTotalAmount
 	for _, merchant := range ms {
-		records = append(records, m.ToMerchantMonthlyTotalAmountByApikey(merchant))
+		records = append(records, m.ToMerchantMonthlyTotalAmountByMerchant(merchant))
 	}
 	return records
 }
 
-func (m *merchantRecordMapper) ToMerchantYearlyTotalAmountByApikey(ms *db.GetYearlyTotalAmountByApikeyRow) *record.MerchantYearlyTotalAmount {
+func (m *merchantRecordMapper) ToMerchantYearlyTotalAmountByMerchant(ms *db.GetYearlyTotalAmountByMerchantRow) *record.MerchantYearlyTotalAmount {
 	return &record.MerchantYearlyTotalAmount{
 		Year:        ms.Year,
+		Currency:    ms.Currency,
 		TotalAmount: int(ms.TotalAmount),
 	}
 }
 
-func (m *merchantRecordMapper) ToMerchantYearlyTotalAmountsByApikey(ms []*db.GetYearlyTotalAmountByApikeyRow) []*record.MerchantYearlyTotalAmount {
+func (m *merchantRecordMapper) ToMerchantYearlyTotalAmountsByMerchant(ms []*db.GetYearlyTotalAmountByMerchantRow) []*record.MerchantYearlyTotalAmount {
 	var records []*record.MerchantYearlyTotalAmount
 	for _, merchant := range ms {
-		records = append(records, m.ToMerchantYearlyTotalAmountByApikey(merchant))
+		records = append(records, m.ToMerchantYearlyTotalAmountByMerchant(merchant))
 	}
 	return records
 }
 
 //
 
+//
+
 func (m *merchantRecordMapper) ToMerchantActiveRecord(merchant *db.GetActiveMerchantsRow) *record.MerchantRecord {
 	var deletedAt *string
 
@@ -452,7 +369,6 @@ func (m *merchantRecordMapper) ToMerchantActiveRecord(merchant *db.GetActiveMerc
 	return &record.MerchantRecord{
 		ID:        int(merchant.MerchantID),
 		Name:      merchant.Name,
-		ApiKey:    merchant.ApiKey,
 		UserID:    int(merchant.UserID),
 		Status:    merchant.Status,
 		CreatedAt: merchant.CreatedAt.Time.Format("2006-01-02"),
@@ -480,7 +396,6 @@ func (m *merchantRecordMapper) ToMerchantTrashedRecord(merchant *db.GetTrashedMe
 	return &record.MerchantRecord{
 		ID:        int(merchant.MerchantID),
 		Name:      merchant.Name,
-		ApiKey:    merchant.ApiKey,
 		UserID:    int(merchant.UserID),
 		Status:    merchant.Status,
 		CreatedAt: merchant.CreatedAt.Time.Format("2006-01-02"),
@@ -0,0 +1,40 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type merchantApiKeyRecordMapper struct {
+}
+
+func NewMerchantApiKeyRecordMapper() *merchantApiKeyRecordMapper {
+	return &merchantApiKeyRecordMapper{}
+}
+
+func (s *merchantApiKeyRecordMapper) ToMerchantApiKeyRecord(key *db.MerchantApiKey) *record.MerchantApiKeyRecord {
+	return &record.MerchantApiKeyRecord{
+		ID:            int(key.MerchantApiKeyID),
+		MerchantID:    int(key.MerchantID),
+		Label:         key.Label,
+		KeyPrefix:     key.KeyPrefix,
+		KeyHash:       key.KeyHash,
+		SigningSecret: key.SigningSecret,
+		Scopes:        key.Scopes,
+		ExpiresAt:     toScheduleTime(key.ExpiresAt),
+		LastUsedAt:    toScheduleTime(key.LastUsedAt),
+		RevokedAt:     toScheduleTime(key.RevokedAt),
+		CreatedAt:     key.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:     key.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *merchantApiKeyRecordMapper) ToMerchantApiKeysRecord(keys []*db.MerchantApiKey) []*record.MerchantApiKeyRecord {
+	var records []*record.MerchantApiKeyRecord
+
+	for _, key := range keys {
+		records = append(records, s.ToMerchantApiKeyRecord(key))
+	}
+
+	return records
+}
@@ -0,0 +1,63 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type merchantOnboardingRecordMapper struct {
+}
+
+func NewMerchantOnboardingRecordMapper() *merchantOnboardingRecordMapper {
+	return &merchantOnboardingRecordMapper{}
+}
+
+func (m *merchantOnboardingRecordMapper) ToMerchantBusinessProfileRecord(profile *db.MerchantBusinessProfile) *record.MerchantBusinessProfileRecord {
+	var website *string
+	if profile.Website.Valid {
+		website = &profile.Website.String
+	}
+
+	return &record.MerchantBusinessProfileRecord{
+		MerchantID:   int(profile.MerchantID),
+		LegalName:    profile.LegalName,
+		BusinessType: profile.BusinessType,
+		TaxID:        profile.TaxID,
+		Address:      profile.Address,
+		Website:      website,
+		ContactEmail: profile.ContactEmail,
+		ContactPhone: profile.ContactPhone,
+		SubmittedAt:  profile.SubmittedAt.Format("2006-01-02 15:04:05"),
+		CreatedAt:    profile.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:    profile.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (m *merchantOnboardingRecordMapper) ToMerchantByStatusRecord(merchant *db.GetMerchantsByStatusRow) *record.MerchantRecord {
+	var deletedAt *string
+
+	if merchant.DeletedAt.Valid {
+		formatedDeletedAt := merchant.DeletedAt.Time.Format("2006-01-02")
+		deletedAt = &formatedDeletedAt
+	}
+
+	return &record.MerchantRecord{
+		ID:        int(merchant.MerchantID),
+		Name:      merchant.Name,
+		UserID:    int(merchant.UserID),
+		Status:    merchant.Status,
+		CreatedAt: merchant.CreatedAt.Time.Format("2006-01-02"),
+		UpdatedAt: merchant.UpdatedAt.Time.Format("2006-01-02"),
+		DeletedAt: deletedAt,
+	}
+}
+
+func (m *merchantOnboardingRecordMapper) ToMerchantsByStatusRecord(merchants []*db.GetMerchantsByStatusRow) []*record.MerchantRecord {
+	var records []*record.MerchantRecord
+
+	for _, merchant := range merchants {
+		records = append(records, m.ToMerchantByStatusRecord(merchant))
+	}
+
+	return records
+}
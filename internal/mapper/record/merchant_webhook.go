@@ -0,0 +1,132 @@
+package recordmapper
+
+import (
+	"database/sql"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type merchantWebhookRecordMapper struct {
+}
+
+func NewMerchantWebhookRecordMapper() *merchantWebhookRecordMapper {
+	return &merchantWebhookRecordMapper{}
+}
+
+func (s *merchantWebhookRecordMapper) ToMerchantWebhookEndpointRecord(endpoint *db.MerchantWebhookEndpoint) *record.MerchantWebhookEndpointRecord {
+	return &record.MerchantWebhookEndpointRecord{
+		ID:         int(endpoint.MerchantWebhookEndpointID),
+		MerchantID: int(endpoint.MerchantID),
+		URL:        endpoint.Url,
+		Secret:     endpoint.Secret,
+		Events:     endpoint.Events,
+		IsActive:   endpoint.IsActive,
+		CreatedAt:  endpoint.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:  endpoint.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+		DeletedAt:  toScheduleTime(endpoint.DeletedAt),
+	}
+}
+
+func (s *merchantWebhookRecordMapper) ToMerchantWebhookEndpointsRecord(endpoints []*db.MerchantWebhookEndpoint) []*record.MerchantWebhookEndpointRecord {
+	var records []*record.MerchantWebhookEndpointRecord
+
+	for _, endpoint := range endpoints {
+		records = append(records, s.ToMerchantWebhookEndpointRecord(endpoint))
+	}
+
+	return records
+}
+
+func (s *merchantWebhookRecordMapper) ToMerchantWebhookDeliveryRecord(delivery *db.MerchantWebhookDelivery) *record.MerchantWebhookDeliveryRecord {
+	var lastError *string
+	if delivery.LastError.Valid {
+		lastError = &delivery.LastError.String
+	}
+
+	return &record.MerchantWebhookDeliveryRecord{
+		ID:               int(delivery.MerchantWebhookDeliveryID),
+		EndpointID:       int(delivery.MerchantWebhookEndpointID),
+		EventID:          delivery.EventID.String(),
+		EventType:        delivery.EventType,
+		Payload:          delivery.Payload,
+		Status:           delivery.Status,
+		Attempts:         int(delivery.Attempts),
+		NextAttemptAt:    toScheduleTime(delivery.NextAttemptAt),
+		LastResponseCode: toWebhookResponseCode(delivery.LastResponseCode),
+		LastError:        lastError,
+		DeliveredAt:      toScheduleTime(delivery.DeliveredAt),
+		CreatedAt:        delivery.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:        delivery.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *merchantWebhookRecordMapper) ToMerchantWebhookDeliveriesRecord(deliveries []*db.MerchantWebhookDelivery) []*record.MerchantWebhookDeliveryRecord {
+	var records []*record.MerchantWebhookDeliveryRecord
+
+	for _, delivery := range deliveries {
+		records = append(records, s.ToMerchantWebhookDeliveryRecord(delivery))
+	}
+
+	return records
+}
+
+func (s *merchantWebhookRecordMapper) ToMerchantWebhookDeliveriesRecordAll(deliveries []*db.GetMerchantWebhookDeliveriesRow) []*record.MerchantWebhookDeliveryRecord {
+	var records []*record.MerchantWebhookDeliveryRecord
+
+	for _, delivery := range deliveries {
+		records = append(records, s.ToMerchantWebhookDeliveryRecord(&db.MerchantWebhookDelivery{
+			MerchantWebhookDeliveryID: delivery.MerchantWebhookDeliveryID,
+			MerchantWebhookEndpointID: delivery.MerchantWebhookEndpointID,
+			EventID:                   delivery.EventID,
+			EventType:                 delivery.EventType,
+			Payload:                   delivery.Payload,
+			Status:                    delivery.Status,
+			Attempts:                  delivery.Attempts,
+			NextAttemptAt:             delivery.NextAttemptAt,
+			LastResponseCode:          delivery.LastResponseCode,
+			LastError:                 delivery.LastError,
+			DeliveredAt:               delivery.DeliveredAt,
+			CreatedAt:                 delivery.CreatedAt,
+			UpdatedAt:                 delivery.UpdatedAt,
+		}))
+	}
+
+	return records
+}
+
+func (s *merchantWebhookRecordMapper) ToMerchantWebhookDeliveryAttemptRecord(attempt *db.MerchantWebhookDeliveryAttempt) *record.MerchantWebhookDeliveryAttemptRecord {
+	var attemptError *string
+	if attempt.Error.Valid {
+		attemptError = &attempt.Error.String
+	}
+
+	return &record.MerchantWebhookDeliveryAttemptRecord{
+		ID:           int(attempt.MerchantWebhookDeliveryAttemptID),
+		DeliveryID:   int(attempt.MerchantWebhookDeliveryID),
+		Attempt:      int(attempt.Attempt),
+		ResponseCode: toWebhookResponseCode(attempt.ResponseCode),
+		Error:        attemptError,
+		DurationMs:   int(attempt.DurationMs),
+		CreatedAt:    attempt.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *merchantWebhookRecordMapper) ToMerchantWebhookDeliveryAttemptsRecord(attempts []*db.MerchantWebhookDeliveryAttempt) []*record.MerchantWebhookDeliveryAttemptRecord {
+	var records []*record.MerchantWebhookDeliveryAttemptRecord
+
+	for _, attempt := range attempts {
+		records = append(records, s.ToMerchantWebhookDeliveryAttemptRecord(attempt))
+	}
+
+	return records
+}
+
+func toWebhookResponseCode(value sql.NullInt32) *int {
+	if !value.Valid {
+		return nil
+	}
+
+	code := int(value.Int32)
+	return &code
+}
@@ -3712,20 +3712,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantMonthlyAmount(ms any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantMonthlyAmount", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantMonthlyAmount), ms)
 }
 
-// ToMerchantMonthlyAmountByApikey mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantMonthlyAmountByApikey(ms *db.GetMonthlyAmountByApikeyRow) *record.MerchantMonthlyAmount {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantMonthlyAmountByApikey", ms)
-	ret0, _ := ret[0].(*record.MerchantMonthlyAmount)
-	return ret0
-}
-
-// ToMerchantMonthlyAmountByApikey indicates an expected call of ToMerchantMonthlyAmountByApikey.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantMonthlyAmountByApikey(ms any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantMonthlyAmountByApikey", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantMonthlyAmountByApikey), ms)
-}
-
 // ToMerchantMonthlyAmountByMerchant mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantMonthlyAmountByMerchant(ms *db.GetMonthlyAmountByMerchantsRow) *record.MerchantMonthlyAmount {
 	m.ctrl.T.Helper()
@@ -3754,20 +3740,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantMonthlyAmounts(ms any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantMonthlyAmounts", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantMonthlyAmounts), ms)
 }
 
-// ToMerchantMonthlyAmountsByApikey mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantMonthlyAmountsByApikey(ms []*db.GetMonthlyAmountByApikeyRow) []*record.MerchantMonthlyAmount {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantMonthlyAmountsByApikey", ms)
-	ret0, _ := ret[0].([]*record.MerchantMonthlyAmount)
-	return ret0
-}
-
-// ToMerchantMonthlyAmountsByApikey indicates an expected call of ToMerchantMonthlyAmountsByApikey.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantMonthlyAmountsByApikey(ms any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantMonthlyAmountsByApikey", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantMonthlyAmountsByApikey), ms)
-}
-
 // ToMerchantMonthlyAmountsByMerchant mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantMonthlyAmountsByMerchant(ms []*db.GetMonthlyAmountByMerchantsRow) []*record.MerchantMonthlyAmount {
 	m.ctrl.T.Helper()
@@ -3796,20 +3768,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantMonthlyPaymentMethod(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantMonthlyPaymentMethod", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantMonthlyPaymentMethod), ms)
 }
 
-// ToMerchantMonthlyPaymentMethodByApikey mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantMonthlyPaymentMethodByApikey(ms *db.GetMonthlyPaymentMethodByApikeyRow) *record.MerchantMonthlyPaymentMethod {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantMonthlyPaymentMethodByApikey", ms)
-	ret0, _ := ret[0].(*record.MerchantMonthlyPaymentMethod)
-	return ret0
-}
-
-// ToMerchantMonthlyPaymentMethodByApikey indicates an expected call of ToMerchantMonthlyPaymentMethodByApikey.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantMonthlyPaymentMethodByApikey(ms any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantMonthlyPaymentMethodByApikey", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantMonthlyPaymentMethodByApikey), ms)
-}
-
 // ToMerchantMonthlyPaymentMethodByMerchant mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantMonthlyPaymentMethodByMerchant(ms *db.GetMonthlyPaymentMethodByMerchantsRow) *record.MerchantMonthlyPaymentMethod {
 	m.ctrl.T.Helper()
@@ -3838,20 +3796,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantMonthlyPaymentMethods
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantMonthlyPaymentMethods", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantMonthlyPaymentMethods), ms)
 }
 
-// ToMerchantMonthlyPaymentMethodsByApikey mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantMonthlyPaymentMethodsByApikey(ms []*db.GetMonthlyPaymentMethodByApikeyRow) []*record.MerchantMonthlyPaymentMethod {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantMonthlyPaymentMethodsByApikey", ms)
-	ret0, _ := ret[0].([]*record.MerchantMonthlyPaymentMethod)
-	return ret0
-}
-
-// ToMerchantMonthlyPaymentMethodsByApikey indicates an expected call of ToMerchantMonthlyPaymentMethodsByApikey.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantMonthlyPaymentMethodsByApikey(ms any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantMonthlyPaymentMethodsByApikey", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantMonthlyPaymentMethodsByApikey), ms)
-}
-
 // ToMerchantMonthlyPaymentMethodsByMerchant mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantMonthlyPaymentMethodsByMerchant(ms []*db.GetMonthlyPaymentMethodByMerchantsRow) []*record.MerchantMonthlyPaymentMethod {
 	m.ctrl.T.Helper()
@@ -3880,20 +3824,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantMonthlyTotalAmount(ms
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantMonthlyTotalAmount", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantMonthlyTotalAmount), ms)
 }
 
-// ToMerchantMonthlyTotalAmountByApikey mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantMonthlyTotalAmountByApikey(ms *db.GetMonthlyTotalAmountByApikeyRow) *record.MerchantMonthlyTotalAmount {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantMonthlyTotalAmountByApikey", ms)
-	ret0, _ := ret[0].(*record.MerchantMonthlyTotalAmount)
-	return ret0
-}
-
-// ToMerchantMonthlyTotalAmountByApikey indicates an expected call of ToMerchantMonthlyTotalAmountByApikey.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantMonthlyTotalAmountByApikey(ms any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantMonthlyTotalAmountByApikey", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantMonthlyTotalAmountByApikey), ms)
-}
-
 // ToMerchantMonthlyTotalAmountByMerchant mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantMonthlyTotalAmountByMerchant(ms *db.GetMonthlyTotalAmountByMerchantRow) *record.MerchantMonthlyTotalAmount {
 	m.ctrl.T.Helper()
@@ -3922,20 +3852,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantMonthlyTotalAmounts(m
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantMonthlyTotalAmounts", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantMonthlyTotalAmounts), ms)
 }
 
-// ToMerchantMonthlyTotalAmountsByApikey mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantMonthlyTotalAmountsByApikey(ms []*db.GetMonthlyTotalAmountByApikeyRow) []*record.MerchantMonthlyTotalAmount {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantMonthlyTotalAmountsByApikey", ms)
-	ret0, _ := ret[0].([]*record.MerchantMonthlyTotalAmount)
-	return ret0
-}
-
-// ToMerchantMonthlyTotalAmountsByApikey indicates an expected call of ToMerchantMonthlyTotalAmountsByApikey.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantMonthlyTotalAmountsByApikey(ms any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantMonthlyTotalAmountsByApikey", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantMonthlyTotalAmountsByApikey), ms)
-}
-
 // ToMerchantMonthlyTotalAmountsByMerchant mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantMonthlyTotalAmountsByMerchant(ms []*db.GetMonthlyTotalAmountByMerchantRow) []*record.MerchantMonthlyTotalAmount {
 	m.ctrl.T.Helper()
@@ -3964,20 +3880,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantRecord(merchant any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantRecord", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantRecord), merchant)
 }
 
-// ToMerchantTransactionByApikeyRecord mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantTransactionByApikeyRecord(merchant *db.FindAllTransactionsByApikeyRow) *record.MerchantTransactionsRecord {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantTransactionByApikeyRecord", merchant)
-	ret0, _ := ret[0].(*record.MerchantTransactionsRecord)
-	return ret0
-}
-
-// ToMerchantTransactionByApikeyRecord indicates an expected call of ToMerchantTransactionByApikeyRecord.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantTransactionByApikeyRecord(merchant any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantTransactionByApikeyRecord", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantTransactionByApikeyRecord), merchant)
-}
-
 // ToMerchantTransactionByMerchantRecord mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantTransactionByMerchantRecord(merchant *db.FindAllTransactionsByMerchantRow) *record.MerchantTransactionsRecord {
 	m.ctrl.T.Helper()
@@ -4034,20 +3936,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantYearlyAmount(ms any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantYearlyAmount", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantYearlyAmount), ms)
 }
 
-// ToMerchantYearlyAmountByApikey mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantYearlyAmountByApikey(ms *db.GetYearlyAmountByApikeyRow) *record.MerchantYearlyAmount {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantYearlyAmountByApikey", ms)
-	ret0, _ := ret[0].(*record.MerchantYearlyAmount)
-	return ret0
-}
-
-// ToMerchantYearlyAmountByApikey indicates an expected call of ToMerchantYearlyAmountByApikey.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantYearlyAmountByApikey(ms any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantYearlyAmountByApikey", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantYearlyAmountByApikey), ms)
-}
-
 // ToMerchantYearlyAmountByMerchant mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantYearlyAmountByMerchant(ms *db.GetYearlyAmountByMerchantsRow) *record.MerchantYearlyAmount {
 	m.ctrl.T.Helper()
@@ -4076,20 +3964,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantYearlyAmounts(ms any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantYearlyAmounts", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantYearlyAmounts), ms)
 }
 
-// ToMerchantYearlyAmountsByApikey mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantYearlyAmountsByApikey(ms []*db.GetYearlyAmountByApikeyRow) []*record.MerchantYearlyAmount {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantYearlyAmountsByApikey", ms)
-	ret0, _ := ret[0].([]*record.MerchantYearlyAmount)
-	return ret0
-}
-
-// ToMerchantYearlyAmountsByApikey indicates an expected call of ToMerchantYearlyAmountsByApikey.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantYearlyAmountsByApikey(ms any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantYearlyAmountsByApikey", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantYearlyAmountsByApikey), ms)
-}
-
 // ToMerchantYearlyAmountsByMerchant mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantYearlyAmountsByMerchant(ms []*db.GetYearlyAmountByMerchantsRow) []*record.MerchantYearlyAmount {
 	m.ctrl.T.Helper()
@@ -4118,20 +3992,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantYearlyPaymentMethod(m
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantYearlyPaymentMethod", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantYearlyPaymentMethod), ms)
 }
 
-// ToMerchantYearlyPaymentMethodByApikey mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantYearlyPaymentMethodByApikey(ms *db.GetYearlyPaymentMethodByApikeyRow) *record.MerchantYearlyPaymentMethod {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantYearlyPaymentMethodByApikey", ms)
-	ret0, _ := ret[0].(*record.MerchantYearlyPaymentMethod)
-	return ret0
-}
-
-// ToMerchantYearlyPaymentMethodByApikey indicates an expected call of ToMerchantYearlyPaymentMethodByApikey.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantYearlyPaymentMethodByApikey(ms any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantYearlyPaymentMethodByApikey", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantYearlyPaymentMethodByApikey), ms)
-}
-
 // ToMerchantYearlyPaymentMethodByMerchant mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantYearlyPaymentMethodByMerchant(ms *db.GetYearlyPaymentMethodByMerchantsRow) *record.MerchantYearlyPaymentMethod {
 	m.ctrl.T.Helper()
@@ -4160,20 +4020,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantYearlyPaymentMethods(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantYearlyPaymentMethods", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantYearlyPaymentMethods), ms)
 }
 
-// ToMerchantYearlyPaymentMethodsByApikey mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantYearlyPaymentMethodsByApikey(ms []*db.GetYearlyPaymentMethodByApikeyRow) []*record.MerchantYearlyPaymentMethod {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantYearlyPaymentMethodsByApikey", ms)
-	ret0, _ := ret[0].([]*record.MerchantYearlyPaymentMethod)
-	return ret0
-}
-
-// ToMerchantYearlyPaymentMethodsByApikey indicates an expected call of ToMerchantYearlyPaymentMethodsByApikey.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantYearlyPaymentMethodsByApikey(ms any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantYearlyPaymentMethodsByApikey", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantYearlyPaymentMethodsByApikey), ms)
-}
-
 // ToMerchantYearlyPaymentMethodsByMerchant mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantYearlyPaymentMethodsByMerchant(ms []*db.GetYearlyPaymentMethodByMerchantsRow) []*record.MerchantYearlyPaymentMethod {
 	m.ctrl.T.Helper()
@@ -4202,20 +4048,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantYearlyTotalAmount(ms
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantYearlyTotalAmount", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantYearlyTotalAmount), ms)
 }
 
-// ToMerchantYearlyTotalAmountByApikey mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantYearlyTotalAmountByApikey(ms *db.GetYearlyTotalAmountByApikeyRow) *record.MerchantYearlyTotalAmount {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantYearlyTotalAmountByApikey", ms)
-	ret0, _ := ret[0].(*record.MerchantYearlyTotalAmount)
-	return ret0
-}
-
-// ToMerchantYearlyTotalAmountByApikey indicates an expected call of ToMerchantYearlyTotalAmountByApikey.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantYearlyTotalAmountByApikey(ms any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantYearlyTotalAmountByApikey", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantYearlyTotalAmountByApikey), ms)
-}
-
 // ToMerchantYearlyTotalAmountByMerchant mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantYearlyTotalAmountByMerchant(ms *db.GetYearlyTotalAmountByMerchantRow) *record.MerchantYearlyTotalAmount {
 	m.ctrl.T.Helper()
@@ -4244,20 +4076,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantYearlyTotalAmounts(ms
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantYearlyTotalAmounts", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantYearlyTotalAmounts), ms)
 }
 
-// ToMerchantYearlyTotalAmountsByApikey mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantYearlyTotalAmountsByApikey(ms []*db.GetYearlyTotalAmountByApikeyRow) []*record.MerchantYearlyTotalAmount {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantYearlyTotalAmountsByApikey", ms)
-	ret0, _ := ret[0].([]*record.MerchantYearlyTotalAmount)
-	return ret0
-}
-
-// ToMerchantYearlyTotalAmountsByApikey indicates an expected call of ToMerchantYearlyTotalAmountsByApikey.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantYearlyTotalAmountsByApikey(ms any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantYearlyTotalAmountsByApikey", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantYearlyTotalAmountsByApikey), ms)
-}
-
 // ToMerchantYearlyTotalAmountsByMerchant mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantYearlyTotalAmountsByMerchant(ms []*db.GetYearlyTotalAmountByMerchantRow) []*record.MerchantYearlyTotalAmount {
 	m.ctrl.T.Helper()
@@ -4314,20 +4132,6 @@ func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantsRecord(merchants any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantsRecord", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantsRecord), merchants)
 }
 
-// ToMerchantsTransactionByApikeyRecord mocks base method.
-func (m *MockMerchantRecordMapping) ToMerchantsTransactionByApikeyRecord(merchants []*db.FindAllTransactionsByApikeyRow) []*record.MerchantTransactionsRecord {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ToMerchantsTransactionByApikeyRecord", merchants)
-	ret0, _ := ret[0].([]*record.MerchantTransactionsRecord)
-	return ret0
-}
-
-// ToMerchantsTransactionByApikeyRecord indicates an expected call of ToMerchantsTransactionByApikeyRecord.
-func (mr *MockMerchantRecordMappingMockRecorder) ToMerchantsTransactionByApikeyRecord(merchants any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ToMerchantsTransactionByApikeyRecord", reflect.TypeOf((*MockMerchantRecordMapping)(nil).ToMerchantsTransactionByApikeyRecord), merchants)
-}
-
 // ToMerchantsTransactionByMerchantRecord mocks base method.
 func (m *MockMerchantRecordMapping) ToMerchantsTransactionByMerchantRecord(merchants []*db.FindAllTransactionsByMerchantRow) []*record.MerchantTransactionsRecord {
 	m.ctrl.T.Helper()
@@ -0,0 +1,60 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type providerWebhookRecordMapper struct {
+}
+
+func NewProviderWebhookRecordMapper() *providerWebhookRecordMapper {
+	return &providerWebhookRecordMapper{}
+}
+
+func (s *providerWebhookRecordMapper) ToProviderWebhookEventRecord(event *db.ProviderWebhookEvent) *record.ProviderWebhookEventRecord {
+	var lastError *string
+	if event.LastError.Valid {
+		lastError = &event.LastError.String
+	}
+
+	return &record.ProviderWebhookEventRecord{
+		ID:          int(event.ProviderWebhookEventID),
+		Provider:    event.Provider,
+		EventID:     event.EventID,
+		EventType:   event.EventType,
+		Payload:     event.Payload,
+		Signature:   event.Signature,
+		SentAt:      event.SentAt.Format("2006-01-02 15:04:05"),
+		Status:      event.Status,
+		Attempts:    int(event.Attempts),
+		LastError:   lastError,
+		ProcessedAt: toScheduleTime(event.ProcessedAt),
+		CreatedAt:   event.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:   event.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *providerWebhookRecordMapper) ToProviderWebhookEventsRecordAll(events []*db.GetProviderWebhookEventsRow) []*record.ProviderWebhookEventRecord {
+	var records []*record.ProviderWebhookEventRecord
+
+	for _, event := range events {
+		records = append(records, s.ToProviderWebhookEventRecord(&db.ProviderWebhookEvent{
+			ProviderWebhookEventID: event.ProviderWebhookEventID,
+			Provider:               event.Provider,
+			EventID:                event.EventID,
+			EventType:              event.EventType,
+			Payload:                event.Payload,
+			Signature:              event.Signature,
+			SentAt:                 event.SentAt,
+			Status:                 event.Status,
+			Attempts:               event.Attempts,
+			LastError:              event.LastError,
+			ProcessedAt:            event.ProcessedAt,
+			CreatedAt:              event.CreatedAt,
+			UpdatedAt:              event.UpdatedAt,
+		}))
+	}
+
+	return records
+}
@@ -0,0 +1,28 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type qrPaymentRecordMapper struct {
+}
+
+func NewQrPaymentRecordMapper() *qrPaymentRecordMapper {
+	return &qrPaymentRecordMapper{}
+}
+
+func (q *qrPaymentRecordMapper) ToQrPaymentRecord(payment *db.QrPayment) *record.QrPaymentRecord {
+	var reference *string
+	if payment.Reference.Valid {
+		reference = &payment.Reference.String
+	}
+
+	return &record.QrPaymentRecord{
+		ID:            int(payment.QrPaymentID),
+		MerchantID:    int(payment.MerchantID),
+		TransactionID: int(payment.TransactionID),
+		Reference:     reference,
+		CreatedAt:     payment.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -0,0 +1,147 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type reconciliationRecordMapper struct {
+}
+
+func NewReconciliationRecordMapper() *reconciliationRecordMapper {
+	return &reconciliationRecordMapper{}
+}
+
+func (s *reconciliationRecordMapper) ToReconciliationRunRecord(run *db.ReconciliationRun) *record.ReconciliationRunRecord {
+	var finishedAt *string
+	if run.FinishedAt.Valid {
+		formatedFinishedAt := run.FinishedAt.Time.Format("2006-01-02 15:04:05")
+		finishedAt = &formatedFinishedAt
+	}
+
+	return &record.ReconciliationRunRecord{
+		ID:               int(run.ReconciliationRunID),
+		CardsChecked:     int(run.CardsChecked),
+		DiscrepancyCount: int(run.DiscrepancyCount),
+		StartedAt:        run.StartedAt.Format("2006-01-02 15:04:05"),
+		FinishedAt:       finishedAt,
+	}
+}
+
+func (s *reconciliationRecordMapper) ToReconciliationRunsRecordAll(runs []*db.GetReconciliationRunsRow) []*record.ReconciliationRunRecord {
+	var records []*record.ReconciliationRunRecord
+
+	for _, run := range runs {
+		records = append(records, s.ToReconciliationRunRecord(&db.ReconciliationRun{
+			ReconciliationRunID: run.ReconciliationRunID,
+			CardsChecked:        run.CardsChecked,
+			DiscrepancyCount:    run.DiscrepancyCount,
+			StartedAt:           run.StartedAt,
+			FinishedAt:          run.FinishedAt,
+		}))
+	}
+
+	return records
+}
+
+func (s *reconciliationRecordMapper) ToCardReconciliationsRecord(cards []*db.GetCardReconciliationsRow) []*record.CardReconciliationRecord {
+	var records []*record.CardReconciliationRecord
+
+	for _, card := range cards {
+		records = append(records, &record.CardReconciliationRecord{
+			CardNumber:        card.CardNumber,
+			SaldoBalance:      int(card.SaldoBalance),
+			LedgerBalance:     int(card.LedgerBalance),
+			TopupAmount:       int(card.TopupAmount),
+			WithdrawAmount:    int(card.WithdrawAmount),
+			TransferOutAmount: int(card.TransferOutAmount),
+			TransferInAmount:  int(card.TransferInAmount),
+			PaymentAmount:     int(card.PaymentAmount),
+			SettlementAmount:  int(card.SettlementAmount),
+			OtherAmount:       int(card.OtherAmount),
+		})
+	}
+
+	return records
+}
+
+func (s *reconciliationRecordMapper) ToBalanceDiscrepancyRecord(discrepancy *db.BalanceDiscrepancy) *record.BalanceDiscrepancyRecord {
+	var acknowledgedBy *int
+	if discrepancy.AcknowledgedBy.Valid {
+		id := int(discrepancy.AcknowledgedBy.Int32)
+		acknowledgedBy = &id
+	}
+
+	var acknowledgedAt *string
+	if discrepancy.AcknowledgedAt.Valid {
+		formatedAcknowledgedAt := discrepancy.AcknowledgedAt.Time.Format("2006-01-02 15:04:05")
+		acknowledgedAt = &formatedAcknowledgedAt
+	}
+
+	var note *string
+	if discrepancy.Note.Valid {
+		note = &discrepancy.Note.String
+	}
+
+	var resolvedAt *string
+	if discrepancy.ResolvedAt.Valid {
+		formatedResolvedAt := discrepancy.ResolvedAt.Time.Format("2006-01-02 15:04:05")
+		resolvedAt = &formatedResolvedAt
+	}
+
+	return &record.BalanceDiscrepancyRecord{
+		ID:                  int(discrepancy.BalanceDiscrepancyID),
+		CardNumber:          discrepancy.CardNumber,
+		ReconciliationRunID: int(discrepancy.ReconciliationRunID),
+		SaldoBalance:        int(discrepancy.SaldoBalance),
+		ExpectedBalance:     int(discrepancy.ExpectedBalance),
+		LedgerBalance:       int(discrepancy.LedgerBalance),
+		Difference:          int(discrepancy.Difference),
+		TopupAmount:         int(discrepancy.TopupAmount),
+		WithdrawAmount:      int(discrepancy.WithdrawAmount),
+		TransferOutAmount:   int(discrepancy.TransferOutAmount),
+		TransferInAmount:    int(discrepancy.TransferInAmount),
+		PaymentAmount:       int(discrepancy.PaymentAmount),
+		SettlementAmount:    int(discrepancy.SettlementAmount),
+		OtherAmount:         int(discrepancy.OtherAmount),
+		Status:              discrepancy.Status,
+		AcknowledgedBy:      acknowledgedBy,
+		AcknowledgedAt:      acknowledgedAt,
+		Note:                note,
+		ResolvedAt:          resolvedAt,
+		CreatedAt:           discrepancy.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:           discrepancy.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *reconciliationRecordMapper) ToBalanceDiscrepanciesRecordAll(discrepancies []*db.GetBalanceDiscrepanciesRow) []*record.BalanceDiscrepancyRecord {
+	var records []*record.BalanceDiscrepancyRecord
+
+	for _, discrepancy := range discrepancies {
+		records = append(records, s.ToBalanceDiscrepancyRecord(&db.BalanceDiscrepancy{
+			BalanceDiscrepancyID: discrepancy.BalanceDiscrepancyID,
+			CardNumber:           discrepancy.CardNumber,
+			ReconciliationRunID:  discrepancy.ReconciliationRunID,
+			SaldoBalance:         discrepancy.SaldoBalance,
+			ExpectedBalance:      discrepancy.ExpectedBalance,
+			LedgerBalance:        discrepancy.LedgerBalance,
+			Difference:           discrepancy.Difference,
+			TopupAmount:          discrepancy.TopupAmount,
+			WithdrawAmount:       discrepancy.WithdrawAmount,
+			TransferOutAmount:    discrepancy.TransferOutAmount,
+			TransferInAmount:     discrepancy.TransferInAmount,
+			PaymentAmount:        discrepancy.PaymentAmount,
+			SettlementAmount:     discrepancy.SettlementAmount,
+			OtherAmount:          discrepancy.OtherAmount,
+			Status:               discrepancy.Status,
+			AcknowledgedBy:       discrepancy.AcknowledgedBy,
+			AcknowledgedAt:       discrepancy.AcknowledgedAt,
+			Note:                 discrepancy.Note,
+			ResolvedAt:           discrepancy.ResolvedAt,
+			CreatedAt:            discrepancy.CreatedAt,
+			UpdatedAt:            discrepancy.UpdatedAt,
+		}))
+	}
+
+	return records
+}
@@ -0,0 +1,65 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type refundRecordMapper struct {
+}
+
+func NewRefundRecordMapper() *refundRecordMapper {
+	return &refundRecordMapper{}
+}
+
+func (r *refundRecordMapper) ToRefundRecord(refund *db.Refund) *record.RefundRecord {
+	return &record.RefundRecord{
+		ID:            int(refund.RefundID),
+		RefundNo:      refund.RefundNo.String(),
+		TransactionID: int(refund.TransactionID),
+		CardNumber:    refund.CardNumber,
+		MerchantID:    int(refund.MerchantID),
+		Amount:        int(refund.Amount),
+		Currency:      refund.Currency,
+		Reason:        refund.Reason,
+		Status:        refund.Status,
+		CreatedAt:     refund.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:     refund.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (r *refundRecordMapper) ToRefundsRecord(refunds []*db.Refund) []*record.RefundRecord {
+	var refundRecords []*record.RefundRecord
+
+	for _, refund := range refunds {
+		refundRecords = append(refundRecords, r.ToRefundRecord(refund))
+	}
+
+	return refundRecords
+}
+
+func (r *refundRecordMapper) ToRefundRecordAll(refund *db.GetRefundsRow) *record.RefundRecord {
+	return &record.RefundRecord{
+		ID:            int(refund.RefundID),
+		RefundNo:      refund.RefundNo.String(),
+		TransactionID: int(refund.TransactionID),
+		CardNumber:    refund.CardNumber,
+		MerchantID:    int(refund.MerchantID),
+		Amount:        int(refund.Amount),
+		Currency:      refund.Currency,
+		Reason:        refund.Reason,
+		Status:        refund.Status,
+		CreatedAt:     refund.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:     refund.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (r *refundRecordMapper) ToRefundsRecordAll(refunds []*db.GetRefundsRow) []*record.RefundRecord {
+	var refundRecords []*record.RefundRecord
+
+	for _, refund := range refunds {
+		refundRecords = append(refundRecords, r.ToRefundRecordAll(refund))
+	}
+
+	return refundRecords
+}
@@ -20,14 +20,16 @@ func (s *saldoRecordMapper) ToSaldoRecord(saldo *db.Saldo) *record.SaldoRecord {
 	}
 
 	return &record.SaldoRecord{
-		ID:             int(saldo.SaldoID),
-		CardNumber:     saldo.CardNumber,
-		TotalBalance:   int(saldo.TotalBalance),
-		WithdrawAmount: int(saldo.WithdrawAmount.Int32),
-		WithdrawTime:   saldo.WithdrawTime.Time.Format("2006-01-02"),
-		CreatedAt:      saldo.CreatedAt.Time.Format("2006-01-02"),
-		UpdatedAt:      saldo.UpdatedAt.Time.Format("2006-01-02"),
-		DeletedAt:      deletedAt,
+		ID:              int(saldo.SaldoID),
+		CardNumber:      saldo.CardNumber,
+		TotalBalance:    int(saldo.TotalBalance),
+		ReservedBalance: int(saldo.ReservedBalance),
+		Currency:        saldo.Currency,
+		WithdrawAmount:  int(saldo.WithdrawAmount.Int32),
+		WithdrawTime:    saldo.WithdrawTime.Time.Format("2006-01-02"),
+		CreatedAt:       saldo.CreatedAt.Time.Format("2006-01-02"),
+		UpdatedAt:       saldo.UpdatedAt.Time.Format("2006-01-02"),
+		DeletedAt:       deletedAt,
 	}
 }
 
@@ -48,14 +50,16 @@ func (s *saldoRecordMapper) ToSaldoRecordAll(saldo *db.GetSaldosRow) *record.Sal
 	}
 
 	return &record.SaldoRecord{
-		ID:             int(saldo.SaldoID),
-		CardNumber:     saldo.CardNumber,
-		TotalBalance:   int(saldo.TotalBalance),
-		WithdrawAmount: int(saldo.WithdrawAmount.Int32),
-		WithdrawTime:   saldo.WithdrawTime.Time.Format("2006-01-02"),
-		CreatedAt:      saldo.CreatedAt.Time.Format("2006-01-02"),
-		UpdatedAt:      saldo.UpdatedAt.Time.Format("2006-01-02"),
-		DeletedAt:      deletedAt,
+		ID:              int(saldo.SaldoID),
+		CardNumber:      saldo.CardNumber,
+		TotalBalance:    int(saldo.TotalBalance),
+		ReservedBalance: int(saldo.ReservedBalance),
+		Currency:        saldo.Currency,
+		WithdrawAmount:  int(saldo.WithdrawAmount.Int32),
+		WithdrawTime:    saldo.WithdrawTime.Time.Format("2006-01-02"),
+		CreatedAt:       saldo.CreatedAt.Time.Format("2006-01-02"),
+		UpdatedAt:       saldo.UpdatedAt.Time.Format("2006-01-02"),
+		DeletedAt:       deletedAt,
 	}
 }
 
@@ -76,14 +80,16 @@ func (s *saldoRecordMapper) ToSaldoRecordActive(saldo *db.GetActiveSaldosRow) *r
 	}
 
 	return &record.SaldoRecord{
-		ID:             int(saldo.SaldoID),
-		CardNumber:     saldo.CardNumber,
-		TotalBalance:   int(saldo.TotalBalance),
-		WithdrawAmount: int(saldo.WithdrawAmount.Int32),
-		WithdrawTime:   saldo.WithdrawTime.Time.Format("2006-01-02"),
-		CreatedAt:      saldo.CreatedAt.Time.Format("2006-01-02"),
-		UpdatedAt:      saldo.UpdatedAt.Time.Format("2006-01-02"),
-		DeletedAt:      deletedAt,
+		ID:              int(saldo.SaldoID),
+		CardNumber:      saldo.CardNumber,
+		TotalBalance:    int(saldo.TotalBalance),
+		ReservedBalance: int(saldo.ReservedBalance),
+		Currency:        saldo.Currency,
+		WithdrawAmount:  int(saldo.WithdrawAmount.Int32),
+		WithdrawTime:    saldo.WithdrawTime.Time.Format("2006-01-02"),
+		CreatedAt:       saldo.CreatedAt.Time.Format("2006-01-02"),
+		UpdatedAt:       saldo.UpdatedAt.Time.Format("2006-01-02"),
+		DeletedAt:       deletedAt,
 	}
 }
 
@@ -104,14 +110,16 @@ func (s *saldoRecordMapper) ToSaldoRecordTrashed(saldo *db.GetTrashedSaldosRow)
 	}
 
 	return &record.SaldoRecord{
-		ID:             int(saldo.SaldoID),
-		CardNumber:     saldo.CardNumber,
-		TotalBalance:   int(saldo.TotalBalance),
-		WithdrawAmount: int(saldo.WithdrawAmount.Int32),
-		WithdrawTime:   saldo.WithdrawTime.Time.Format("2006-01-02"),
-		CreatedAt:      saldo.CreatedAt.Time.Format("2006-01-02"),
-		UpdatedAt:      saldo.UpdatedAt.Time.Format("2006-01-02"),
-		DeletedAt:      deletedAt,
+		ID:              int(saldo.SaldoID),
+		CardNumber:      saldo.CardNumber,
+		TotalBalance:    int(saldo.TotalBalance),
+		ReservedBalance: int(saldo.ReservedBalance),
+		Currency:        saldo.Currency,
+		WithdrawAmount:  int(saldo.WithdrawAmount.Int32),
+		WithdrawTime:    saldo.WithdrawTime.Time.Format("2006-01-02"),
+		CreatedAt:       saldo.CreatedAt.Time.Format("2006-01-02"),
+		UpdatedAt:       saldo.UpdatedAt.Time.Format("2006-01-02"),
+		DeletedAt:       deletedAt,
 	}
 }
 
@@ -131,6 +139,7 @@ func (s *saldoRecordMapper) ToSaldoMonthTotalBalance(ss *db.GetMonthlyTotalSaldo
 
 	return &record.SaldoMonthTotalBalance{
 		Month:        ss.Month,
+		Currency:     ss.Currency,
 		Year:         ss.Year,
 		TotalBalance: totalBalance,
 	}
@@ -152,6 +161,7 @@ func (s *saldoRecordMapper) ToSaldoYearTotalBalance(ss *db.GetYearlyTotalSaldoBa
 
 	return &record.SaldoYearTotalBalance{
 		Year:         ss.Year,
+		Currency:     ss.Currency,
 		TotalBalance: int(totalBalance),
 	}
 }
@@ -167,6 +177,7 @@ func (s *saldoRecordMapper) ToSaldoYearTotalBalances(ss []*db.GetYearlyTotalSald
 func (s *saldoRecordMapper) ToSaldoMonthBalance(ss *db.GetMonthlySaldoBalancesRow) *record.SaldoMonthSaldoBalance {
 	return &record.SaldoMonthSaldoBalance{
 		Month:        ss.Month,
+		Currency:     ss.Currency,
 		TotalBalance: int(ss.TotalBalance),
 	}
 }
@@ -182,6 +193,7 @@ func (s *saldoRecordMapper) ToSaldoMonthBalances(ss []*db.GetMonthlySaldoBalance
 func (s *saldoRecordMapper) ToSaldoYearSaldoBalance(ss *db.GetYearlySaldoBalancesRow) *record.SaldoYearSaldoBalance {
 	return &record.SaldoYearSaldoBalance{
 		Year:         ss.Year,
+		Currency:     ss.Currency,
 		TotalBalance: int(ss.TotalBalance),
 	}
 }
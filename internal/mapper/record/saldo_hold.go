@@ -0,0 +1,71 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type saldoHoldRecordMapper struct {
+}
+
+func NewSaldoHoldRecordMapper() *saldoHoldRecordMapper {
+	return &saldoHoldRecordMapper{}
+}
+
+func (s *saldoHoldRecordMapper) ToSaldoHoldRecord(hold *db.SaldoHold) *record.SaldoHoldRecord {
+	var referenceType *string
+	if hold.ReferenceType.Valid {
+		referenceType = &hold.ReferenceType.String
+	}
+
+	var referenceID *int
+	if hold.ReferenceID.Valid {
+		id := int(hold.ReferenceID.Int32)
+		referenceID = &id
+	}
+
+	var createdBy *int
+	if hold.CreatedBy.Valid {
+		userID := int(hold.CreatedBy.Int32)
+		createdBy = &userID
+	}
+
+	var releasedAt *string
+	if hold.ReleasedAt.Valid {
+		formatedReleasedAt := hold.ReleasedAt.Time.Format("2006-01-02 15:04:05")
+		releasedAt = &formatedReleasedAt
+	}
+
+	var consumedAt *string
+	if hold.ConsumedAt.Valid {
+		formatedConsumedAt := hold.ConsumedAt.Time.Format("2006-01-02 15:04:05")
+		consumedAt = &formatedConsumedAt
+	}
+
+	return &record.SaldoHoldRecord{
+		ID:            int(hold.HoldID),
+		HoldNo:        hold.HoldNo.String(),
+		CardNumber:    hold.CardNumber,
+		Amount:        int(hold.Amount),
+		Currency:      hold.Currency,
+		Reason:        hold.Reason,
+		ReferenceType: referenceType,
+		ReferenceID:   referenceID,
+		Status:        hold.Status,
+		CreatedBy:     createdBy,
+		ReleasedAt:    releasedAt,
+		ConsumedAt:    consumedAt,
+		CreatedAt:     hold.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:     hold.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *saldoHoldRecordMapper) ToSaldoHoldsRecord(holds []*db.SaldoHold) []*record.SaldoHoldRecord {
+	var holdRecords []*record.SaldoHoldRecord
+
+	for _, hold := range holds {
+		holdRecords = append(holdRecords, s.ToSaldoHoldRecord(hold))
+	}
+
+	return holdRecords
+}
@@ -0,0 +1,129 @@
+package recordmapper
+
+import (
+	"database/sql"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type scheduledTransferRecordMapper struct {
+}
+
+func NewScheduledTransferRecordMapper() *scheduledTransferRecordMapper {
+	return &scheduledTransferRecordMapper{}
+}
+
+func (s *scheduledTransferRecordMapper) ToScheduledTransferRecord(schedule *db.ScheduledTransfer) *record.ScheduledTransferRecord {
+	var dayOfMonth *int
+	if schedule.DayOfMonth.Valid {
+		day := int(schedule.DayOfMonth.Int32)
+		dayOfMonth = &day
+	}
+
+	return &record.ScheduledTransferRecord{
+		ID:             int(schedule.ScheduledTransferID),
+		UserID:         int(schedule.UserID),
+		TransferFrom:   schedule.TransferFrom,
+		TransferTo:     schedule.TransferTo,
+		TransferAmount: int(schedule.TransferAmount),
+		Recurrence:     schedule.Recurrence,
+		DayOfMonth:     dayOfMonth,
+		NextRunAt:      toScheduleTime(schedule.NextRunAt),
+		EndAt:          toScheduleTime(schedule.EndAt),
+		Status:         schedule.Status,
+		LastRunAt:      toScheduleTime(schedule.LastRunAt),
+		CreatedAt:      schedule.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:      schedule.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *scheduledTransferRecordMapper) ToScheduledTransfersRecord(schedules []*db.ScheduledTransfer) []*record.ScheduledTransferRecord {
+	var records []*record.ScheduledTransferRecord
+
+	for _, schedule := range schedules {
+		records = append(records, s.ToScheduledTransferRecord(schedule))
+	}
+
+	return records
+}
+
+func (s *scheduledTransferRecordMapper) ToScheduledTransferRecordAll(schedule *db.GetScheduledTransfersRow) *record.ScheduledTransferRecord {
+	return s.ToScheduledTransferRecord(&db.ScheduledTransfer{
+		ScheduledTransferID: schedule.ScheduledTransferID,
+		UserID:              schedule.UserID,
+		TransferFrom:        schedule.TransferFrom,
+		TransferTo:          schedule.TransferTo,
+		TransferAmount:      schedule.TransferAmount,
+		Recurrence:          schedule.Recurrence,
+		DayOfMonth:          schedule.DayOfMonth,
+		NextRunAt:           schedule.NextRunAt,
+		EndAt:               schedule.EndAt,
+		Status:              schedule.Status,
+		LastRunAt:           schedule.LastRunAt,
+		CreatedAt:           schedule.CreatedAt,
+		UpdatedAt:           schedule.UpdatedAt,
+	})
+}
+
+func (s *scheduledTransferRecordMapper) ToScheduledTransfersRecordAll(schedules []*db.GetScheduledTransfersRow) []*record.ScheduledTransferRecord {
+	var records []*record.ScheduledTransferRecord
+
+	for _, schedule := range schedules {
+		records = append(records, s.ToScheduledTransferRecordAll(schedule))
+	}
+
+	return records
+}
+
+func (s *scheduledTransferRecordMapper) ToScheduledTransferRunRecord(run *db.ScheduledTransferRun) *record.ScheduledTransferRunRecord {
+	var transferID *int
+	if run.TransferID.Valid {
+		id := int(run.TransferID.Int32)
+		transferID = &id
+	}
+
+	var failureReason *string
+	if run.FailureReason.Valid {
+		failureReason = &run.FailureReason.String
+	}
+
+	return &record.ScheduledTransferRunRecord{
+		ID:                  int(run.ScheduledTransferRunID),
+		ScheduledTransferID: int(run.ScheduledTransferID),
+		ScheduledFor:        run.ScheduledFor.Format("2006-01-02 15:04:05"),
+		Status:              run.Status,
+		TransferID:          transferID,
+		FailureReason:       failureReason,
+		CreatedAt:           run.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:           run.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *scheduledTransferRecordMapper) ToScheduledTransferRunsRecordAll(runs []*db.GetScheduledTransferRunsRow) []*record.ScheduledTransferRunRecord {
+	var records []*record.ScheduledTransferRunRecord
+
+	for _, run := range runs {
+		records = append(records, s.ToScheduledTransferRunRecord(&db.ScheduledTransferRun{
+			ScheduledTransferRunID: run.ScheduledTransferRunID,
+			ScheduledTransferID:    run.ScheduledTransferID,
+			ScheduledFor:           run.ScheduledFor,
+			Status:                 run.Status,
+			TransferID:             run.TransferID,
+			FailureReason:          run.FailureReason,
+			CreatedAt:              run.CreatedAt,
+			UpdatedAt:              run.UpdatedAt,
+		}))
+	}
+
+	return records
+}
+
+func toScheduleTime(value sql.NullTime) *string {
+	if !value.Valid {
+		return nil
+	}
+
+	formatted := value.Time.Format("2006-01-02 15:04:05")
+	return &formatted
+}
@@ -0,0 +1,121 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type settlementRecordMapper struct {
+}
+
+func NewSettlementRecordMapper() *settlementRecordMapper {
+	return &settlementRecordMapper{}
+}
+
+func (s *settlementRecordMapper) ToMerchantBalanceRecord(balance *db.MerchantBalance) *record.MerchantBalanceRecord {
+	var settlementCardNumber *string
+	if balance.SettlementCardNumber.Valid {
+		settlementCardNumber = &balance.SettlementCardNumber.String
+	}
+
+	return &record.MerchantBalanceRecord{
+		MerchantID:           int(balance.MerchantID),
+		Currency:             balance.Currency,
+		PendingBalance:       int(balance.PendingBalance),
+		SettlementCardNumber: settlementCardNumber,
+		CreatedAt:            balance.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:            balance.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *settlementRecordMapper) ToMerchantBalancesRecord(balances []*db.MerchantBalance) []*record.MerchantBalanceRecord {
+	var records []*record.MerchantBalanceRecord
+
+	for _, balance := range balances {
+		records = append(records, s.ToMerchantBalanceRecord(balance))
+	}
+
+	return records
+}
+
+func (s *settlementRecordMapper) ToSettlementTotalsRecord(totals *db.GetUnsettledSettlementTotalsRow) *record.SettlementTotalsRecord {
+	return &record.SettlementTotalsRecord{
+		GrossAmount:      int(totals.GrossAmount),
+		FeeAmount:        int(totals.FeeAmount),
+		RefundAmount:     int(totals.RefundAmount),
+		AdjustmentAmount: int(totals.AdjustmentAmount),
+		NetAmount:        int(totals.NetAmount),
+		ItemCount:        int(totals.ItemCount),
+	}
+}
+
+func (s *settlementRecordMapper) ToSettlementBatchRecord(batch *db.SettlementBatch) *record.SettlementBatchRecord {
+	return &record.SettlementBatchRecord{
+		ID:                   int(batch.SettlementBatchID),
+		MerchantID:           int(batch.MerchantID),
+		Currency:             batch.Currency,
+		SettlementCardNumber: batch.SettlementCardNumber,
+		CutoffAt:             batch.CutoffAt.Format("2006-01-02 15:04:05"),
+		GrossAmount:          int(batch.GrossAmount),
+		FeeAmount:            int(batch.FeeAmount),
+		RefundAmount:         int(batch.RefundAmount),
+		AdjustmentAmount:     int(batch.AdjustmentAmount),
+		NetAmount:            int(batch.NetAmount),
+		ItemCount:            int(batch.ItemCount),
+		CreatedAt:            batch.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *settlementRecordMapper) ToSettlementBatchesRecordAll(batches []*db.GetSettlementBatchesByMerchantRow) []*record.SettlementBatchRecord {
+	var records []*record.SettlementBatchRecord
+
+	for _, batch := range batches {
+		records = append(records, s.ToSettlementBatchRecord(&db.SettlementBatch{
+			SettlementBatchID:    batch.SettlementBatchID,
+			MerchantID:           batch.MerchantID,
+			Currency:             batch.Currency,
+			SettlementCardNumber: batch.SettlementCardNumber,
+			CutoffAt:             batch.CutoffAt,
+			GrossAmount:          batch.GrossAmount,
+			FeeAmount:            batch.FeeAmount,
+			RefundAmount:         batch.RefundAmount,
+			AdjustmentAmount:     batch.AdjustmentAmount,
+			NetAmount:            batch.NetAmount,
+			ItemCount:            batch.ItemCount,
+			CreatedAt:            batch.CreatedAt,
+		}))
+	}
+
+	return records
+}
+
+func (s *settlementRecordMapper) ToSettlementItemRecord(item *db.SettlementItem) *record.SettlementItemRecord {
+	var batchID *int
+	if item.SettlementBatchID.Valid {
+		id := int(item.SettlementBatchID.Int32)
+		batchID = &id
+	}
+
+	return &record.SettlementItemRecord{
+		ID:                int(item.SettlementItemID),
+		MerchantID:        int(item.MerchantID),
+		SettlementBatchID: batchID,
+		ItemType:          item.ItemType,
+		ReferenceID:       int(item.ReferenceID),
+		Amount:            int(item.Amount),
+		Fee:               int(item.Fee),
+		NetAmount:         int(item.NetAmount),
+		Currency:          item.Currency,
+		CreatedAt:         item.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *settlementRecordMapper) ToSettlementItemsRecord(items []*db.SettlementItem) []*record.SettlementItemRecord {
+	var records []*record.SettlementItemRecord
+
+	for _, item := range items {
+		records = append(records, s.ToSettlementItemRecord(item))
+	}
+
+	return records
+}
@@ -0,0 +1,47 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type statusHistoryRecordMapper struct {
+}
+
+func NewStatusHistoryRecordMapper() *statusHistoryRecordMapper {
+	return &statusHistoryRecordMapper{}
+}
+
+func (s *statusHistoryRecordMapper) ToStatusHistoryRecord(history *db.StatusHistory) *record.StatusHistoryRecord {
+	var reason *string
+	if history.Reason.Valid {
+		reason = &history.Reason.String
+	}
+
+	var changedBy *int
+	if history.ChangedBy.Valid {
+		id := int(history.ChangedBy.Int32)
+		changedBy = &id
+	}
+
+	return &record.StatusHistoryRecord{
+		ID:         int(history.StatusHistoryID),
+		EntityType: history.EntityType,
+		EntityID:   int(history.EntityID),
+		FromStatus: history.FromStatus,
+		ToStatus:   history.ToStatus,
+		Reason:     reason,
+		ChangedBy:  changedBy,
+		CreatedAt:  history.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *statusHistoryRecordMapper) ToStatusHistoriesRecord(histories []*db.StatusHistory) []*record.StatusHistoryRecord {
+	var historyRecords []*record.StatusHistoryRecord
+
+	for _, history := range histories {
+		historyRecords = append(historyRecords, s.ToStatusHistoryRecord(history))
+	}
+
+	return historyRecords
+}
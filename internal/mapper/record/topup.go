@@ -25,8 +25,11 @@ func (t *topupRecordMapper) ToTopupRecord(topup *db.Topup) *record.TopupRecord {
 		CardNumber:  topup.CardNumber,
 		TopupNo:     topup.TopupNo.String(),
 		TopupAmount: int(topup.TopupAmount),
+		Currency:    topup.Currency,
+		Fee:         int(topup.Fee),
 		TopupMethod: topup.TopupMethod,
 		TopupTime:   topup.TopupTime.Format("2006-01-02 15:04:05.000"),
+		Status:      topup.Status,
 		CreatedAt:   topup.CreatedAt.Time.Format("2006-01-02 15:04:05.000"),
 		UpdatedAt:   topup.UpdatedAt.Time.Format("2006-01-02 15:04:05.000"),
 		DeletedAt:   deleted_at,
@@ -56,8 +59,11 @@ func (t *topupRecordMapper) ToTopupByCardNumberRecord(topup *db.GetTopupsByCardN
 		CardNumber:  topup.CardNumber,
 		TopupNo:     topup.TopupNo.String(),
 		TopupAmount: int(topup.TopupAmount),
+		Currency:    topup.Currency,
+		Fee:         int(topup.Fee),
 		TopupMethod: topup.TopupMethod,
 		TopupTime:   topup.TopupTime.Format("2006-01-02 15:04:05.000"),
+		Status:      topup.Status,
 		CreatedAt:   topup.CreatedAt.Time.Format("2006-01-02 15:04:05.000"),
 		UpdatedAt:   topup.UpdatedAt.Time.Format("2006-01-02 15:04:05.000"),
 		DeletedAt:   deleted_at,
@@ -87,8 +93,11 @@ func (t *topupRecordMapper) ToTopupRecordAll(topup *db.GetTopupsRow) *record.Top
 		CardNumber:  topup.CardNumber,
 		TopupNo:     topup.TopupNo.String(),
 		TopupAmount: int(topup.TopupAmount),
+		Currency:    topup.Currency,
+		Fee:         int(topup.Fee),
 		TopupMethod: topup.TopupMethod,
 		TopupTime:   topup.TopupTime.Format("2006-01-02 15:04:05.000"),
+		Status:      topup.Status,
 		CreatedAt:   topup.CreatedAt.Time.Format("2006-01-02 15:04:05.000"),
 		UpdatedAt:   topup.UpdatedAt.Time.Format("2006-01-02 15:04:05.000"),
 		DeletedAt:   deleted_at,
@@ -118,8 +127,11 @@ func (t *topupRecordMapper) ToTopupRecordActive(topup *db.GetActiveTopupsRow) *r
 		CardNumber:  topup.CardNumber,
 		TopupNo:     topup.TopupNo.String(),
 		TopupAmount: int(topup.TopupAmount),
+		Currency:    topup.Currency,
+		Fee:         int(topup.Fee),
 		TopupMethod: topup.TopupMethod,
 		TopupTime:   topup.TopupTime.Format("2006-01-02 15:04:05.000"),
+		Status:      topup.Status,
 		CreatedAt:   topup.CreatedAt.Time.Format("2006-01-02 15:04:05.000"),
 		UpdatedAt:   topup.UpdatedAt.Time.Format("2006-01-02 15:04:05.000"),
 		DeletedAt:   deleted_at,
@@ -149,8 +161,11 @@ func (t *topupRecordMapper) ToTopupRecordTrashed(topup *db.GetTrashedTopupsRow)
 		CardNumber:  topup.CardNumber,
 		TopupNo:     topup.TopupNo.String(),
 		TopupAmount: int(topup.TopupAmount),
+		Currency:    topup.Currency,
+		Fee:         int(topup.Fee),
 		TopupMethod: topup.TopupMethod,
 		TopupTime:   topup.TopupTime.Format("2006-01-02 15:04:05.000"),
+		Status:      topup.Status,
 		CreatedAt:   topup.CreatedAt.Time.Format("2006-01-02 15:04:05.000"),
 		UpdatedAt:   topup.UpdatedAt.Time.Format("2006-01-02 15:04:05.000"),
 		DeletedAt:   deleted_at,
@@ -356,6 +371,7 @@ func (t *topupRecordMapper) ToTopupYearlyMethods(s []*db.GetYearlyTopupMethodsRo
 func (t *topupRecordMapper) ToTopupMonthlyAmount(s *db.GetMonthlyTopupAmountsRow) *record.TopupMonthAmount {
 	return &record.TopupMonthAmount{
 		Month:       s.Month,
+		Currency:    s.Currency,
 		TotalAmount: int(s.TotalAmount),
 	}
 }
@@ -373,6 +389,7 @@ func (t *topupRecordMapper) ToTopupMonthlyAmounts(s []*db.GetMonthlyTopupAmounts
 func (t *topupRecordMapper) ToTopupYearlyAmount(s *db.GetYearlyTopupAmountsRow) *record.TopupYearlyAmount {
 	return &record.TopupYearlyAmount{
 		Year:        s.Year,
+		Currency:    s.Currency,
 		TotalAmount: int(s.TotalAmount),
 	}
 }
@@ -430,6 +447,7 @@ func (t *topupRecordMapper) ToTopupYearlyMethodsByCardNumber(s []*db.GetYearlyTo
 func (t *topupRecordMapper) ToTopupMonthlyAmountByCardNumber(s *db.GetMonthlyTopupAmountsByCardNumberRow) *record.TopupMonthAmount {
 	return &record.TopupMonthAmount{
 		Month:       s.Month,
+		Currency:    s.Currency,
 		TotalAmount: int(s.TotalAmount),
 	}
 }
@@ -447,6 +465,7 @@ func (t *topupRecordMapper) ToTopupMonthlyAmountsByCardNumber(s []*db.GetMonthly
 func (t *topupRecordMapper) ToTopupYearlyAmountByCardNumber(s *db.GetYearlyTopupAmountsByCardNumberRow) *record.TopupYearlyAmount {
 	return &record.TopupYearlyAmount{
 		Year:        s.Year,
+		Currency:    s.Currency,
 		TotalAmount: int(s.TotalAmount),
 	}
 }
@@ -460,3 +479,30 @@ func (t *topupRecordMapper) ToTopupYearlyAmountsByCardNumber(s []*db.GetYearlyTo
 
 	return topupRecords
 }
+
+func (t *topupRecordMapper) ToTopupProviderPaymentRecord(payment *db.TopupProviderPayment) *record.TopupProviderPaymentRecord {
+	var lastMessage *string
+	if payment.LastMessage.Valid {
+		lastMessage = &payment.LastMessage.String
+	}
+
+	return &record.TopupProviderPaymentRecord{
+		ID:                int(payment.TopupProviderPaymentID),
+		TopupID:           int(payment.TopupID),
+		Provider:          payment.Provider,
+		ProviderReference: payment.ProviderReference,
+		LastMessage:       lastMessage,
+		CreatedAt:         payment.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:         payment.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (t *topupRecordMapper) ToTopupProviderPaymentRecords(payments []*db.TopupProviderPayment) []*record.TopupProviderPaymentRecord {
+	var records []*record.TopupProviderPaymentRecord
+
+	for _, payment := range payments {
+		records = append(records, t.ToTopupProviderPaymentRecord(payment))
+	}
+
+	return records
+}
@@ -24,8 +24,12 @@ func (s *transactionRecordMapper) ToTransactionRecord(transaction *db.Transactio
 		TransactionNo:   transaction.TransactionNo.String(),
 		CardNumber:      transaction.CardNumber,
 		Amount:          int(transaction.Amount),
+		Currency:        transaction.Currency,
+		Fee:             int(transaction.Fee),
 		PaymentMethod:   transaction.PaymentMethod,
 		MerchantID:      int(transaction.MerchantID),
+		Status:          transaction.Status,
+		RefundedAmount:  int(transaction.RefundedAmount),
 		TransactionTime: transaction.TransactionTime.Format("2006-01-02 15:04:05"),
 		CreatedAt:       transaction.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt:       transaction.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
@@ -54,8 +58,12 @@ func (s *transactionRecordMapper) ToTransactionByCardNumberRecord(transaction *d
 		TransactionNo:   transaction.TransactionNo.String(),
 		CardNumber:      transaction.CardNumber,
 		Amount:          int(transaction.Amount),
+		Currency:        transaction.Currency,
+		Fee:             int(transaction.Fee),
 		PaymentMethod:   transaction.PaymentMethod,
 		MerchantID:      int(transaction.MerchantID),
+		Status:          transaction.Status,
+		RefundedAmount:  int(transaction.RefundedAmount),
 		TransactionTime: transaction.TransactionTime.Format("2006-01-02 15:04:05"),
 		CreatedAt:       transaction.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt:       transaction.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
@@ -84,8 +92,12 @@ func (s *transactionRecordMapper) ToTransactionRecordAll(transaction *db.GetTran
 		TransactionNo:   transaction.TransactionNo.String(),
 		CardNumber:      transaction.CardNumber,
 		Amount:          int(transaction.Amount),
+		Currency:        transaction.Currency,
+		Fee:             int(transaction.Fee),
 		PaymentMethod:   transaction.PaymentMethod,
 		MerchantID:      int(transaction.MerchantID),
+		Status:          transaction.Status,
+		RefundedAmount:  int(transaction.RefundedAmount),
 		TransactionTime: transaction.TransactionTime.Format("2006-01-02 15:04:05"),
 		CreatedAt:       transaction.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt:       transaction.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
@@ -114,8 +126,12 @@ func (s *transactionRecordMapper) ToTransactionRecordActive(transaction *db.GetA
 		TransactionNo:   transaction.TransactionNo.String(),
 		CardNumber:      transaction.CardNumber,
 		Amount:          int(transaction.Amount),
+		Currency:        transaction.Currency,
+		Fee:             int(transaction.Fee),
 		PaymentMethod:   transaction.PaymentMethod,
 		MerchantID:      int(transaction.MerchantID),
+		Status:          transaction.Status,
+		RefundedAmount:  int(transaction.RefundedAmount),
 		TransactionTime: transaction.TransactionTime.Format("2006-01-02 15:04:05"),
 		CreatedAt:       transaction.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt:       transaction.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
@@ -144,8 +160,12 @@ func (s *transactionRecordMapper) ToTransactionRecordTrashed(transaction *db.Get
 		TransactionNo:   transaction.TransactionNo.String(),
 		CardNumber:      transaction.CardNumber,
 		Amount:          int(transaction.Amount),
+		Currency:        transaction.Currency,
+		Fee:             int(transaction.Fee),
 		PaymentMethod:   transaction.PaymentMethod,
 		MerchantID:      int(transaction.MerchantID),
+		Status:          transaction.Status,
+		RefundedAmount:  int(transaction.RefundedAmount),
 		TransactionTime: transaction.TransactionTime.Format("2006-01-02 15:04:05"),
 		CreatedAt:       transaction.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt:       transaction.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
@@ -348,6 +368,7 @@ func (s *transactionRecordMapper) ToTransactionYearlyMethods(ss []*db.GetYearlyP
 func (s *transactionRecordMapper) ToTransactionMonthlyAmount(ss *db.GetMonthlyAmountsRow) *record.TransactionMonthAmount {
 	return &record.TransactionMonthAmount{
 		Month:       ss.Month,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalAmount),
 	}
 }
@@ -363,6 +384,7 @@ func (s *transactionRecordMapper) ToTransactionMonthlyAmounts(ss []*db.GetMonthl
 func (s *transactionRecordMapper) ToTransactionYearlyAmount(ss *db.GetYearlyAmountsRow) *record.TransactionYearlyAmount {
 	return &record.TransactionYearlyAmount{
 		Year:        ss.Year,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalAmount),
 	}
 }
@@ -416,6 +438,7 @@ func (s *transactionRecordMapper) ToTransactionYearlyMethodsByCardNumber(ss []*d
 func (s *transactionRecordMapper) ToTransactionMonthlyAmountByCardNumber(ss *db.GetMonthlyAmountsByCardNumberRow) *record.TransactionMonthAmount {
 	return &record.TransactionMonthAmount{
 		Month:       ss.Month,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalAmount),
 	}
 
@@ -433,6 +456,7 @@ func (s *transactionRecordMapper) ToTransactionMonthlyAmountsByCardNumber(ss []*
 func (s *transactionRecordMapper) ToTransactionYearlyAmountByCardNumber(ss *db.GetYearlyAmountsByCardNumberRow) *record.TransactionYearlyAmount {
 	return &record.TransactionYearlyAmount{
 		Year:        ss.Year,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalAmount),
 	}
 }
@@ -0,0 +1,95 @@
+package recordmapper
+
+import (
+	"database/sql"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type transactionLimitRecordMapper struct {
+}
+
+func NewTransactionLimitRecordMapper() *transactionLimitRecordMapper {
+	return &transactionLimitRecordMapper{}
+}
+
+func (t *transactionLimitRecordMapper) ToTransactionLimitRecord(limit *db.TransactionLimit) *record.TransactionLimitRecord {
+	return &record.TransactionLimitRecord{
+		ID:                      int(limit.TransactionLimitID),
+		Name:                    limit.Name,
+		OperationType:           limit.OperationType,
+		Period:                  limit.Period,
+		CardType:                toTransactionLimitFilter(limit.CardType),
+		UserTier:                toTransactionLimitFilter(limit.UserTier),
+		Currency:                toTransactionLimitFilter(limit.Currency),
+		MaxAmountPerTransaction: toTransactionLimitCap(limit.MaxAmountPerTransaction),
+		MaxCount:                toTransactionLimitCap(limit.MaxCount),
+		MaxTotalAmount:          toTransactionLimitCap(limit.MaxTotalAmount),
+		IsActive:                limit.IsActive,
+		CreatedAt:               limit.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:               limit.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (t *transactionLimitRecordMapper) ToTransactionLimitsRecord(limits []*db.TransactionLimit) []*record.TransactionLimitRecord {
+	var records []*record.TransactionLimitRecord
+
+	for _, limit := range limits {
+		records = append(records, t.ToTransactionLimitRecord(limit))
+	}
+
+	return records
+}
+
+func (t *transactionLimitRecordMapper) ToTransactionLimitRecordAll(limit *db.GetTransactionLimitsRow) *record.TransactionLimitRecord {
+	return t.ToTransactionLimitRecord(&db.TransactionLimit{
+		TransactionLimitID:      limit.TransactionLimitID,
+		Name:                    limit.Name,
+		OperationType:           limit.OperationType,
+		Period:                  limit.Period,
+		CardType:                limit.CardType,
+		UserTier:                limit.UserTier,
+		Currency:                limit.Currency,
+		MaxAmountPerTransaction: limit.MaxAmountPerTransaction,
+		MaxCount:                limit.MaxCount,
+		MaxTotalAmount:          limit.MaxTotalAmount,
+		IsActive:                limit.IsActive,
+		CreatedAt:               limit.CreatedAt,
+		UpdatedAt:               limit.UpdatedAt,
+	})
+}
+
+func (t *transactionLimitRecordMapper) ToTransactionLimitsRecordAll(limits []*db.GetTransactionLimitsRow) []*record.TransactionLimitRecord {
+	var records []*record.TransactionLimitRecord
+
+	for _, limit := range limits {
+		records = append(records, t.ToTransactionLimitRecordAll(limit))
+	}
+
+	return records
+}
+
+func (t *transactionLimitRecordMapper) ToTransactionLimitUsageRecord(usage *db.GetCardUsageSinceRow) *record.TransactionLimitUsageRecord {
+	return &record.TransactionLimitUsageRecord{
+		Count:       int(usage.TotalCount),
+		TotalAmount: int(usage.TotalAmount),
+	}
+}
+
+func toTransactionLimitFilter(value sql.NullString) *string {
+	if !value.Valid {
+		return nil
+	}
+
+	return &value.String
+}
+
+func toTransactionLimitCap(value sql.NullInt32) *int {
+	if !value.Valid {
+		return nil
+	}
+
+	limitCap := int(value.Int32)
+	return &limitCap
+}
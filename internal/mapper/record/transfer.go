@@ -1,6 +1,9 @@
 package recordmapper
 
 import (
+	"database/sql"
+	"strconv"
+
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
 	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
 )
@@ -14,17 +17,24 @@ func NewTransferRecordMapper() *transferRecordMapper {
 
 func (t *transferRecordMapper) ToTransferRecord(transfer *db.Transfer) *record.TransferRecord {
 	var deletedAt *string
+	exchangeRate, spreadBps := toTransferConversion(transfer.ExchangeRate, transfer.SpreadBps)
 
 	return &record.TransferRecord{
-		ID:             int(transfer.TransferID),
-		TransferNo:     transfer.TransferNo.String(),
-		TransferFrom:   transfer.TransferFrom,
-		TransferTo:     transfer.TransferTo,
-		TransferAmount: int(transfer.TransferAmount),
-		TransferTime:   transfer.TransferTime.String(),
-		CreatedAt:      transfer.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-		UpdatedAt:      transfer.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
-		DeletedAt:      deletedAt,
+		ID:              int(transfer.TransferID),
+		TransferNo:      transfer.TransferNo.String(),
+		TransferFrom:    transfer.TransferFrom,
+		TransferTo:      transfer.TransferTo,
+		TransferAmount:  int(transfer.TransferAmount),
+		Currency:        transfer.Currency,
+		Fee:             int(transfer.Fee),
+		ToCurrency:      transfer.ToCurrency,
+		ConvertedAmount: int(transfer.ConvertedAmount),
+		ExchangeRate:    exchangeRate,
+		SpreadBps:       spreadBps,
+		TransferTime:    transfer.TransferTime.String(),
+		CreatedAt:       transfer.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       transfer.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+		DeletedAt:       deletedAt,
 	}
 }
 
@@ -40,17 +50,24 @@ func (s *transferRecordMapper) ToTransfersRecord(transfers []*db.Transfer) []*re
 
 func (t *transferRecordMapper) ToTransferRecordAll(transfer *db.GetTransfersRow) *record.TransferRecord {
 	var deletedAt *string
+	exchangeRate, spreadBps := toTransferConversion(transfer.ExchangeRate, transfer.SpreadBps)
 
 	return &record.TransferRecord{
-		ID:             int(transfer.TransferID),
-		TransferNo:     transfer.TransferNo.String(),
-		TransferFrom:   transfer.TransferFrom,
-		TransferTo:     transfer.TransferTo,
-		TransferAmount: int(transfer.TransferAmount),
-		TransferTime:   transfer.TransferTime.String(),
-		CreatedAt:      transfer.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-		UpdatedAt:      transfer.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
-		DeletedAt:      deletedAt,
+		ID:              int(transfer.TransferID),
+		TransferNo:      transfer.TransferNo.String(),
+		TransferFrom:    transfer.TransferFrom,
+		TransferTo:      transfer.TransferTo,
+		TransferAmount:  int(transfer.TransferAmount),
+		Currency:        transfer.Currency,
+		Fee:             int(transfer.Fee),
+		ToCurrency:      transfer.ToCurrency,
+		ConvertedAmount: int(transfer.ConvertedAmount),
+		ExchangeRate:    exchangeRate,
+		SpreadBps:       spreadBps,
+		TransferTime:    transfer.TransferTime.String(),
+		CreatedAt:       transfer.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       transfer.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+		DeletedAt:       deletedAt,
 	}
 }
 
@@ -66,17 +83,24 @@ func (s *transferRecordMapper) ToTransfersRecordAll(transfers []*db.GetTransfers
 
 func (t *transferRecordMapper) ToTransferRecordActive(transfer *db.GetActiveTransfersRow) *record.TransferRecord {
 	var deletedAt *string
+	exchangeRate, spreadBps := toTransferConversion(transfer.ExchangeRate, transfer.SpreadBps)
 
 	return &record.TransferRecord{
-		ID:             int(transfer.TransferID),
-		TransferNo:     transfer.TransferNo.String(),
-		TransferFrom:   transfer.TransferFrom,
-		TransferTo:     transfer.TransferTo,
-		TransferAmount: int(transfer.TransferAmount),
-		TransferTime:   transfer.TransferTime.String(),
-		CreatedAt:      transfer.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-		UpdatedAt:      transfer.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
-		DeletedAt:      deletedAt,
+		ID:              int(transfer.TransferID),
+		TransferNo:      transfer.TransferNo.String(),
+		TransferFrom:    transfer.TransferFrom,
+		TransferTo:      transfer.TransferTo,
+		TransferAmount:  int(transfer.TransferAmount),
+		Currency:        transfer.Currency,
+		Fee:             int(transfer.Fee),
+		ToCurrency:      transfer.ToCurrency,
+		ConvertedAmount: int(transfer.ConvertedAmount),
+		ExchangeRate:    exchangeRate,
+		SpreadBps:       spreadBps,
+		TransferTime:    transfer.TransferTime.String(),
+		CreatedAt:       transfer.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       transfer.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+		DeletedAt:       deletedAt,
 	}
 }
 
@@ -92,6 +116,7 @@ func (s *transferRecordMapper) ToTransfersRecordActive(transfers []*db.GetActive
 
 func (t *transferRecordMapper) ToTransferRecordTrashed(transfer *db.GetTrashedTransfersRow) *record.TransferRecord {
 	var deletedAt *string
+	exchangeRate, spreadBps := toTransferConversion(transfer.ExchangeRate, transfer.SpreadBps)
 
 	if transfer.DeletedAt.Valid {
 		formatedDeletedAt := transfer.DeletedAt.Time.Format("2006-01-02")
@@ -99,15 +124,21 @@ func (t *transferRecordMapper) ToTransferRecordTrashed(transfer *db.GetTrashedTr
 	}
 
 	return &record.TransferRecord{
-		ID:             int(transfer.TransferID),
-		TransferNo:     transfer.TransferNo.String(),
-		TransferFrom:   transfer.TransferFrom,
-		TransferTo:     transfer.TransferTo,
-		TransferAmount: int(transfer.TransferAmount),
-		TransferTime:   transfer.TransferTime.String(),
-		CreatedAt:      transfer.CreatedAt.Time.Format("2006-01-02 15:04:05"),
-		UpdatedAt:      transfer.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
-		DeletedAt:      deletedAt,
+		ID:              int(transfer.TransferID),
+		TransferNo:      transfer.TransferNo.String(),
+		TransferFrom:    transfer.TransferFrom,
+		TransferTo:      transfer.TransferTo,
+		TransferAmount:  int(transfer.TransferAmount),
+		Currency:        transfer.Currency,
+		Fee:             int(transfer.Fee),
+		ToCurrency:      transfer.ToCurrency,
+		ConvertedAmount: int(transfer.ConvertedAmount),
+		ExchangeRate:    exchangeRate,
+		SpreadBps:       spreadBps,
+		TransferTime:    transfer.TransferTime.String(),
+		CreatedAt:       transfer.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       transfer.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+		DeletedAt:       deletedAt,
 	}
 }
 
@@ -272,6 +303,7 @@ func (t *transferRecordMapper) ToTransferRecordsYearStatusFailedCardNumber(Trans
 func (s *transferRecordMapper) ToTransferMonthAmount(ss *db.GetMonthlyTransferAmountsRow) *record.TransferMonthAmount {
 	return &record.TransferMonthAmount{
 		Month:       ss.Month,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalTransferAmount),
 	}
 }
@@ -289,6 +321,7 @@ func (s *transferRecordMapper) ToTransferMonthAmounts(ss []*db.GetMonthlyTransfe
 func (s *transferRecordMapper) ToTransferYearAmount(ss *db.GetYearlyTransferAmountsRow) *record.TransferYearAmount {
 	return &record.TransferYearAmount{
 		Year:        ss.Year,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalTransferAmount),
 	}
 }
@@ -306,6 +339,7 @@ func (s *transferRecordMapper) ToTransferYearAmounts(ss []*db.GetYearlyTransferA
 func (s *transferRecordMapper) ToTransferMonthAmountSender(ss *db.GetMonthlyTransferAmountsBySenderCardNumberRow) *record.TransferMonthAmount {
 	return &record.TransferMonthAmount{
 		Month:       ss.Month,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalTransferAmount),
 	}
 }
@@ -323,6 +357,7 @@ func (s *transferRecordMapper) ToTransferMonthAmountsSender(ss []*db.GetMonthlyT
 func (s *transferRecordMapper) ToTransferYearAmountSender(ss *db.GetYearlyTransferAmountsBySenderCardNumberRow) *record.TransferYearAmount {
 	return &record.TransferYearAmount{
 		Year:        ss.Year,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalTransferAmount),
 	}
 }
@@ -340,6 +375,7 @@ func (s *transferRecordMapper) ToTransferYearAmountsSender(ss []*db.GetYearlyTra
 func (s *transferRecordMapper) ToTransferMonthAmountReceiver(ss *db.GetMonthlyTransferAmountsByReceiverCardNumberRow) *record.TransferMonthAmount {
 	return &record.TransferMonthAmount{
 		Month:       ss.Month,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalTransferAmount),
 	}
 }
@@ -357,6 +393,7 @@ func (s *transferRecordMapper) ToTransferMonthAmountsReceiver(ss []*db.GetMonthl
 func (s *transferRecordMapper) ToTransferYearAmountReceiver(ss *db.GetYearlyTransferAmountsByReceiverCardNumberRow) *record.TransferYearAmount {
 	return &record.TransferYearAmount{
 		Year:        ss.Year,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalTransferAmount),
 	}
 }
@@ -370,3 +407,20 @@ func (s *transferRecordMapper) ToTransferYearAmountsReceiver(ss []*db.GetYearlyT
 
 	return transferRecords
 }
+
+// toTransferConversion reads the rate and spread recorded on transfers that
+// crossed currencies; both are nil for same-currency transfers.
+func toTransferConversion(rate sql.NullString, spread sql.NullInt32) (*float64, *int) {
+	if !rate.Valid || !spread.Valid {
+		return nil, nil
+	}
+
+	parsedRate, err := strconv.ParseFloat(rate.String, 64)
+	if err != nil {
+		return nil, nil
+	}
+
+	spreadBps := int(spread.Int32)
+
+	return &parsedRate, &spreadBps
+}
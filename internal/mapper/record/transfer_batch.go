@@ -0,0 +1,133 @@
+package recordmapper
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+)
+
+type transferBatchRecordMapper struct {
+}
+
+func NewTransferBatchRecordMapper() *transferBatchRecordMapper {
+	return &transferBatchRecordMapper{}
+}
+
+func (s *transferBatchRecordMapper) ToTransferBatchRecord(batch *db.TransferBatch) *record.TransferBatchRecord {
+	return &record.TransferBatchRecord{
+		ID:            int(batch.TransferBatchID),
+		UserID:        int(batch.UserID),
+		TransferFrom:  batch.TransferFrom,
+		FileFormat:    batch.FileFormat,
+		Status:        batch.Status,
+		TotalRows:     int(batch.TotalRows),
+		TotalAmount:   int(batch.TotalAmount),
+		InvalidRows:   int(batch.InvalidRows),
+		SuccessRows:   int(batch.SuccessRows),
+		FailedRows:    int(batch.FailedRows),
+		SuccessAmount: int(batch.SuccessAmount),
+		StartedAt:     toScheduleTime(batch.StartedAt),
+		CompletedAt:   toScheduleTime(batch.CompletedAt),
+		CreatedAt:     batch.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:     batch.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *transferBatchRecordMapper) ToTransferBatchesRecord(batches []*db.TransferBatch) []*record.TransferBatchRecord {
+	var records []*record.TransferBatchRecord
+
+	for _, batch := range batches {
+		records = append(records, s.ToTransferBatchRecord(batch))
+	}
+
+	return records
+}
+
+func (s *transferBatchRecordMapper) ToTransferBatchesRecordAll(batches []*db.GetTransferBatchesRow) []*record.TransferBatchRecord {
+	var records []*record.TransferBatchRecord
+
+	for _, batch := range batches {
+		records = append(records, s.ToTransferBatchRecord(&db.TransferBatch{
+			TransferBatchID: batch.TransferBatchID,
+			UserID:          batch.UserID,
+			TransferFrom:    batch.TransferFrom,
+			FileFormat:      batch.FileFormat,
+			Status:          batch.Status,
+			TotalRows:       batch.TotalRows,
+			TotalAmount:     batch.TotalAmount,
+			InvalidRows:     batch.InvalidRows,
+			SuccessRows:     batch.SuccessRows,
+			FailedRows:      batch.FailedRows,
+			SuccessAmount:   batch.SuccessAmount,
+			StartedAt:       batch.StartedAt,
+			CompletedAt:     batch.CompletedAt,
+			CreatedAt:       batch.CreatedAt,
+			UpdatedAt:       batch.UpdatedAt,
+		}))
+	}
+
+	return records
+}
+
+func (s *transferBatchRecordMapper) ToTransferBatchRowRecord(row *db.TransferBatchRow) *record.TransferBatchRowRecord {
+	var note *string
+	if row.Note.Valid {
+		note = &row.Note.String
+	}
+
+	var transferID *int
+	if row.TransferID.Valid {
+		id := int(row.TransferID.Int32)
+		transferID = &id
+	}
+
+	var failureReason *string
+	if row.FailureReason.Valid {
+		failureReason = &row.FailureReason.String
+	}
+
+	return &record.TransferBatchRowRecord{
+		ID:              int(row.TransferBatchRowID),
+		TransferBatchID: int(row.TransferBatchID),
+		RowNumber:       int(row.RowNumber),
+		TransferTo:      row.TransferTo,
+		TransferAmount:  int(row.TransferAmount),
+		Note:            note,
+		Status:          row.Status,
+		TransferID:      transferID,
+		FailureReason:   failureReason,
+		CreatedAt:       row.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+		UpdatedAt:       row.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
+
+func (s *transferBatchRecordMapper) ToTransferBatchRowsRecord(rows []*db.TransferBatchRow) []*record.TransferBatchRowRecord {
+	var records []*record.TransferBatchRowRecord
+
+	for _, row := range rows {
+		records = append(records, s.ToTransferBatchRowRecord(row))
+	}
+
+	return records
+}
+
+func (s *transferBatchRecordMapper) ToTransferBatchRowsRecordAll(rows []*db.GetTransferBatchRowsRow) []*record.TransferBatchRowRecord {
+	var records []*record.TransferBatchRowRecord
+
+	for _, row := range rows {
+		records = append(records, s.ToTransferBatchRowRecord(&db.TransferBatchRow{
+			TransferBatchRowID: row.TransferBatchRowID,
+			TransferBatchID:    row.TransferBatchID,
+			RowNumber:          row.RowNumber,
+			TransferTo:         row.TransferTo,
+			TransferAmount:     row.TransferAmount,
+			Note:               row.Note,
+			Status:             row.Status,
+			TransferID:         row.TransferID,
+			FailureReason:      row.FailureReason,
+			CreatedAt:          row.CreatedAt,
+			UpdatedAt:          row.UpdatedAt,
+		}))
+	}
+
+	return records
+}
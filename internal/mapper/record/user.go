@@ -27,6 +27,7 @@ func (s *userRecordMapper) ToUserRecord(user *db.User) *record.UserRecord {
 		LastName:  user.Lastname,
 		Email:     user.Email,
 		Password:  user.Password,
+		Tier:      user.Tier,
 		CreatedAt: user.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt: user.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
 		DeletedAt: deletedAt,
@@ -48,6 +49,7 @@ func (s *userRecordMapper) ToUserRecordPagination(user *db.GetUsersWithPaginatio
 		LastName:  user.Lastname,
 		Email:     user.Email,
 		Password:  user.Password,
+		Tier:      user.Tier,
 		CreatedAt: user.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt: user.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
 		DeletedAt: deletedAt,
@@ -79,6 +81,7 @@ func (s *userRecordMapper) ToUserRecordActivePagination(user *db.GetActiveUsersW
 		LastName:  user.Lastname,
 		Email:     user.Email,
 		Password:  user.Password,
+		Tier:      user.Tier,
 		CreatedAt: user.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt: user.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
 		DeletedAt: deletedAt,
@@ -110,6 +113,7 @@ func (s *userRecordMapper) ToUserRecordTrashedPagination(user *db.GetTrashedUser
 		LastName:  user.Lastname,
 		Email:     user.Email,
 		Password:  user.Password,
+		Tier:      user.Tier,
 		CreatedAt: user.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt: user.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
 		DeletedAt: deletedAt,
@@ -24,7 +24,11 @@ func (s *withdrawRecordMapper) ToWithdrawRecord(withdraw *db.Withdraw) *record.W
 		WithdrawNo:     withdraw.WithdrawNo.String(),
 		CardNumber:     withdraw.CardNumber,
 		WithdrawAmount: int(withdraw.WithdrawAmount),
+		Currency:       withdraw.Currency,
+		Fee:            int(withdraw.Fee),
+		PayoutProvider: withdraw.PayoutProvider,
 		WithdrawTime:   withdraw.WithdrawTime.String(),
+		Status:         withdraw.Status,
 		CreatedAt:      withdraw.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt:      withdraw.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
 		DeletedAt:      deletedAt,
@@ -54,7 +58,11 @@ func (s *withdrawRecordMapper) ToWithdrawByCardNumberRecord(withdraw *db.GetWith
 		WithdrawNo:     withdraw.WithdrawNo.String(),
 		CardNumber:     withdraw.CardNumber,
 		WithdrawAmount: int(withdraw.WithdrawAmount),
+		Currency:       withdraw.Currency,
+		Fee:            int(withdraw.Fee),
+		PayoutProvider: withdraw.PayoutProvider,
 		WithdrawTime:   withdraw.WithdrawTime.String(),
+		Status:         withdraw.Status,
 		CreatedAt:      withdraw.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt:      withdraw.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
 		DeletedAt:      deletedAt,
@@ -84,7 +92,11 @@ func (s *withdrawRecordMapper) ToWithdrawRecordAll(withdraw *db.GetWithdrawsRow)
 		WithdrawNo:     withdraw.WithdrawNo.String(),
 		CardNumber:     withdraw.CardNumber,
 		WithdrawAmount: int(withdraw.WithdrawAmount),
+		Currency:       withdraw.Currency,
+		Fee:            int(withdraw.Fee),
+		PayoutProvider: withdraw.PayoutProvider,
 		WithdrawTime:   withdraw.WithdrawTime.String(),
+		Status:         withdraw.Status,
 		CreatedAt:      withdraw.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt:      withdraw.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
 		DeletedAt:      deletedAt,
@@ -114,7 +126,11 @@ func (s *withdrawRecordMapper) ToWithdrawRecordActive(withdraw *db.GetActiveWith
 		WithdrawNo:     withdraw.WithdrawNo.String(),
 		CardNumber:     withdraw.CardNumber,
 		WithdrawAmount: int(withdraw.WithdrawAmount),
+		Currency:       withdraw.Currency,
+		Fee:            int(withdraw.Fee),
+		PayoutProvider: withdraw.PayoutProvider,
 		WithdrawTime:   withdraw.WithdrawTime.String(),
+		Status:         withdraw.Status,
 		CreatedAt:      withdraw.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt:      withdraw.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
 		DeletedAt:      deletedAt,
@@ -144,7 +160,11 @@ func (s *withdrawRecordMapper) ToWithdrawRecordTrashed(withdraw *db.GetTrashedWi
 		WithdrawNo:     withdraw.WithdrawNo.String(),
 		CardNumber:     withdraw.CardNumber,
 		WithdrawAmount: int(withdraw.WithdrawAmount),
+		Currency:       withdraw.Currency,
+		Fee:            int(withdraw.Fee),
+		PayoutProvider: withdraw.PayoutProvider,
 		WithdrawTime:   withdraw.WithdrawTime.String(),
+		Status:         withdraw.Status,
 		CreatedAt:      withdraw.CreatedAt.Time.Format("2006-01-02 15:04:05"),
 		UpdatedAt:      withdraw.UpdatedAt.Time.Format("2006-01-02 15:04:05"),
 		DeletedAt:      deletedAt,
@@ -312,6 +332,7 @@ func (t *withdrawRecordMapper) ToWithdrawRecordsYearStatusFailedCardNumber(Withd
 func (r *withdrawRecordMapper) ToWithdrawAmountMonthly(ss *db.GetMonthlyWithdrawsRow) *record.WithdrawMonthlyAmount {
 	return &record.WithdrawMonthlyAmount{
 		Month:       ss.Month,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalWithdrawAmount),
 	}
 }
@@ -329,6 +350,7 @@ func (s *withdrawRecordMapper) ToWithdrawsAmountMonthly(ss []*db.GetMonthlyWithd
 func (r *withdrawRecordMapper) ToWithdrawAmountYearly(ss *db.GetYearlyWithdrawsRow) *record.WithdrawYearlyAmount {
 	return &record.WithdrawYearlyAmount{
 		Year:        ss.Year,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalWithdrawAmount),
 	}
 }
@@ -346,6 +368,7 @@ func (s *withdrawRecordMapper) ToWithdrawsAmountYearly(ss []*db.GetYearlyWithdra
 func (r *withdrawRecordMapper) ToWithdrawAmountMonthlyByCardNumber(ss *db.GetMonthlyWithdrawsByCardNumberRow) *record.WithdrawMonthlyAmount {
 	return &record.WithdrawMonthlyAmount{
 		Month:       ss.Month,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalWithdrawAmount),
 	}
 }
@@ -363,6 +386,7 @@ func (s *withdrawRecordMapper) ToWithdrawsAmountMonthlyByCardNumber(ss []*db.Get
 func (r *withdrawRecordMapper) ToWithdrawAmountYearlyByCardNumber(ss *db.GetYearlyWithdrawsByCardNumberRow) *record.WithdrawYearlyAmount {
 	return &record.WithdrawYearlyAmount{
 		Year:        ss.Year,
+		Currency:    ss.Currency,
 		TotalAmount: int(ss.TotalWithdrawAmount),
 	}
 }
@@ -376,3 +400,19 @@ func (s *withdrawRecordMapper) ToWithdrawsAmountYearlyByCardNumber(ss []*db.GetY
 
 	return withdrawRecords
 }
+
+func (s *withdrawRecordMapper) ToWithdrawReviewRecord(review *db.WithdrawReview) *record.WithdrawReviewRecord {
+	var note *string
+	if review.Note.Valid {
+		note = &review.Note.String
+	}
+
+	return &record.WithdrawReviewRecord{
+		ID:         int(review.WithdrawReviewID),
+		WithdrawID: int(review.WithdrawID),
+		Decision:   review.Decision,
+		Note:       note,
+		ReviewedBy: int(review.ReviewedBy),
+		CreatedAt:  review.CreatedAt.Time.Format("2006-01-02 15:04:05"),
+	}
+}
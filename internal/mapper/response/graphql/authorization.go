@@ -0,0 +1,66 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type authorizationResponse struct {
+}
+
+func NewAuthorizationResponseMapper() *authorizationResponse {
+	return &authorizationResponse{}
+}
+
+func (a *authorizationResponse) ToGraphqlResponseAuthorization(status, message string, authorization *response.AuthorizationResponse) *model.APIResponseAuthorization {
+	return &model.APIResponseAuthorization{
+		Status:  status,
+		Message: message,
+		Data:    a.mapResponseAuthorization(authorization),
+	}
+}
+
+func (a *authorizationResponse) ToGraphqlResponsePaginationAuthorization(status, message string, authorizations []*response.AuthorizationResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationAuthorization {
+	return &model.APIResponsePaginationAuthorization{
+		Status:     status,
+		Message:    message,
+		Data:       a.mapResponsesAuthorization(authorizations),
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (a *authorizationResponse) mapResponseAuthorization(authorization *response.AuthorizationResponse) *model.AuthorizationResponse {
+	var transactionID *int32
+	if authorization.TransactionID != nil {
+		id := int32(*authorization.TransactionID)
+		transactionID = &id
+	}
+
+	return &model.AuthorizationResponse{
+		ID:              int32(authorization.ID),
+		AuthorizationNo: authorization.AuthorizationNo,
+		CardNumber:      authorization.CardNumber,
+		MerchantID:      int32(authorization.MerchantID),
+		Amount:          int32(authorization.Amount),
+		Currency:        authorization.Currency,
+		CapturedAmount:  int32(authorization.CapturedAmount),
+		PaymentMethod:   authorization.PaymentMethod,
+		Status:          authorization.Status,
+		TransactionID:   transactionID,
+		ExpiresAt:       authorization.ExpiresAt,
+		CapturedAt:      authorization.CapturedAt,
+		VoidedAt:        authorization.VoidedAt,
+		CreatedAt:       authorization.CreatedAt,
+		UpdatedAt:       authorization.UpdatedAt,
+	}
+}
+
+func (a *authorizationResponse) mapResponsesAuthorization(authorizations []*response.AuthorizationResponse) []*model.AuthorizationResponse {
+	var responseAuthorizations []*model.AuthorizationResponse
+
+	for _, authorization := range authorizations {
+		responseAuthorizations = append(responseAuthorizations, a.mapResponseAuthorization(authorization))
+	}
+
+	return responseAuthorizations
+}
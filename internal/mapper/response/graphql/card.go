@@ -119,6 +119,7 @@ func (s *cardResponseMapper) mapCardResponse(card *response.CardResponse) *model
 		ExpireDate:   card.ExpireDate,
 		Cvv:          card.CVV,
 		CardProvider: card.CardProvider,
+		Currency:     card.Currency,
 		CreatedAt:    card.CreatedAt,
 		UpdatedAt:    card.UpdatedAt,
 	}
@@ -143,6 +144,7 @@ func (s *cardResponseMapper) mapCardResponseDeleteAt(card *response.CardResponse
 		ExpireDate:   card.ExpireDate,
 		Cvv:          card.CVV,
 		CardProvider: card.CardProvider,
+		Currency:     card.Currency,
 		CreatedAt:    card.CreatedAt,
 		UpdatedAt:    card.UpdatedAt,
 		DeletedAt:    card.DeletedAt,
@@ -160,17 +162,32 @@ func (s *cardResponseMapper) mapCardDeleteAtResponses(cards []*response.CardResp
 }
 
 func (s *cardResponseMapper) mapDashboardCard(dash *response.DashboardCard) *model.CardDashboardResponse {
+	currencies := make([]*model.CardDashboardCurrencyResponse, 0, len(dash.Currencies))
+
+	for _, totals := range dash.Currencies {
+		currencies = append(currencies, &model.CardDashboardCurrencyResponse{
+			Currency:         totals.Currency,
+			TotalBalance:     int32(totals.TotalBalance),
+			TotalWithdraw:    int32(totals.TotalWithdraw),
+			TotalTopup:       int32(totals.TotalTopup),
+			TotalTransfer:    int32(totals.TotalTransfer),
+			TotalTransaction: int32(totals.TotalTransaction),
+		})
+	}
+
 	return &model.CardDashboardResponse{
 		TotalBalance:     int32(*dash.TotalBalance),
 		TotalWithdraw:    int32(*dash.TotalWithdraw),
 		TotalTopup:       int32(*dash.TotalTopup),
 		TotalTransfer:    int32(*dash.TotalTransfer),
 		TotalTransaction: int32(*dash.TotalTransaction),
+		Currencies:       currencies,
 	}
 }
 
 func (s *cardResponseMapper) mapDashboardCardCardNumber(dash *response.DashboardCardCardNumber) *model.CardDashboardByNumberResponse {
 	return &model.CardDashboardByNumberResponse{
+		Currency:              dash.Currency,
 		TotalBalance:          int32(*dash.TotalBalance),
 		TotalWithdraw:         int32(*dash.TotalWithdraw),
 		TotalTopup:            int32(*dash.TotalTopup),
@@ -183,6 +200,7 @@ func (s *cardResponseMapper) mapDashboardCardCardNumber(dash *response.Dashboard
 func (s *cardResponseMapper) mapMonthlyBalance(cards *response.CardResponseMonthBalance) *model.CardMonthlyBalanceResponse {
 	return &model.CardMonthlyBalanceResponse{
 		Month:        cards.Month,
+		Currency:     cards.Currency,
 		TotalBalance: int32(cards.TotalBalance),
 	}
 }
@@ -200,6 +218,7 @@ func (s *cardResponseMapper) mapMonthlyBalances(cards []*response.CardResponseMo
 func (s *cardResponseMapper) mapYearlyBalance(cards *response.CardResponseYearlyBalance) *model.CardYearlyBalanceResponse {
 	return &model.CardYearlyBalanceResponse{
 		Year:         cards.Year,
+		Currency:     cards.Currency,
 		TotalBalance: int32(cards.TotalBalance),
 	}
 }
@@ -217,6 +236,7 @@ func (s *cardResponseMapper) mapYearlyBalances(cards []*response.CardResponseYea
 func (s *cardResponseMapper) mapMonthlyAmount(cards *response.CardResponseMonthAmount) *model.CardMonthlyAmountResponse {
 	return &model.CardMonthlyAmountResponse{
 		Month:       cards.Month,
+		Currency:    cards.Currency,
 		TotalAmount: int32(cards.TotalAmount),
 	}
 }
@@ -234,6 +254,7 @@ func (s *cardResponseMapper) mapMonthlyAmounts(cards []*response.CardResponseMon
 func (s *cardResponseMapper) mapYearlyAmount(cards *response.CardResponseYearAmount) *model.CardYearlyAmountResponse {
 	return &model.CardYearlyAmountResponse{
 		Year:        cards.Year,
+		Currency:    cards.Currency,
 		TotalAmount: int32(cards.TotalAmount),
 	}
 }
@@ -0,0 +1,68 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type disputeResponse struct {
+}
+
+func NewDisputeResponseMapper() *disputeResponse {
+	return &disputeResponse{}
+}
+
+func (d *disputeResponse) ToGraphqlResponseDispute(status, message string, dispute *response.DisputeResponse) *model.APIResponseDispute {
+	return &model.APIResponseDispute{
+		Status:  status,
+		Message: message,
+		Data:    d.mapResponseDispute(dispute),
+	}
+}
+
+func (d *disputeResponse) ToGraphqlResponsePaginationDispute(status, message string, disputes []*response.DisputeResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationDispute {
+	return &model.APIResponsePaginationDispute{
+		Status:     status,
+		Message:    message,
+		Data:       d.mapResponsesDispute(disputes),
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (d *disputeResponse) mapResponseDispute(dispute *response.DisputeResponse) *model.DisputeResponse {
+	var resolvedBy *int32
+	if dispute.ResolvedBy != nil {
+		userID := int32(*dispute.ResolvedBy)
+		resolvedBy = &userID
+	}
+
+	return &model.DisputeResponse{
+		ID:               int32(dispute.ID),
+		DisputeNo:        dispute.DisputeNo,
+		TransactionID:    int32(dispute.TransactionID),
+		CardNumber:       dispute.CardNumber,
+		MerchantID:       int32(dispute.MerchantID),
+		Amount:           int32(dispute.Amount),
+		Currency:         dispute.Currency,
+		Reason:           dispute.Reason,
+		MerchantEvidence: dispute.MerchantEvidence,
+		ResolutionNote:   dispute.ResolutionNote,
+		Status:           dispute.Status,
+		OpenedBy:         int32(dispute.OpenedBy),
+		ResolvedBy:       resolvedBy,
+		RespondedAt:      dispute.RespondedAt,
+		ResolvedAt:       dispute.ResolvedAt,
+		CreatedAt:        dispute.CreatedAt,
+		UpdatedAt:        dispute.UpdatedAt,
+	}
+}
+
+func (d *disputeResponse) mapResponsesDispute(disputes []*response.DisputeResponse) []*model.DisputeResponse {
+	var responseDisputes []*model.DisputeResponse
+
+	for _, dispute := range disputes {
+		responseDisputes = append(responseDisputes, d.mapResponseDispute(dispute))
+	}
+
+	return responseDisputes
+}
@@ -0,0 +1,52 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type exchangeRateResponse struct {
+}
+
+func NewExchangeRateResponseMapper() *exchangeRateResponse {
+	return &exchangeRateResponse{}
+}
+
+func (e *exchangeRateResponse) ToGraphqlResponseExchangeRate(status, message string, rate *response.ExchangeRateResponse) *model.APIResponseExchangeRate {
+	return &model.APIResponseExchangeRate{
+		Status:  status,
+		Message: message,
+		Data:    e.mapResponseExchangeRate(rate),
+	}
+}
+
+func (e *exchangeRateResponse) ToGraphqlResponsePaginationExchangeRate(status, message string, rates []*response.ExchangeRateResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationExchangeRate {
+	return &model.APIResponsePaginationExchangeRate{
+		Status:     status,
+		Message:    message,
+		Data:       e.mapResponsesExchangeRate(rates),
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (e *exchangeRateResponse) mapResponseExchangeRate(rate *response.ExchangeRateResponse) *model.ExchangeRateResponse {
+	return &model.ExchangeRateResponse{
+		ID:            int32(rate.ID),
+		BaseCurrency:  rate.BaseCurrency,
+		QuoteCurrency: rate.QuoteCurrency,
+		Rate:          rate.Rate,
+		SpreadBps:     int32(rate.SpreadBps),
+		CreatedAt:     rate.CreatedAt,
+		UpdatedAt:     rate.UpdatedAt,
+	}
+}
+
+func (e *exchangeRateResponse) mapResponsesExchangeRate(rates []*response.ExchangeRateResponse) []*model.ExchangeRateResponse {
+	var responses []*model.ExchangeRateResponse
+
+	for _, rate := range rates {
+		responses = append(responses, e.mapResponseExchangeRate(rate))
+	}
+
+	return responses
+}
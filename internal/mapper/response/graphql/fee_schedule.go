@@ -0,0 +1,118 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type feeScheduleResponse struct {
+}
+
+func NewFeeScheduleResponseMapper() *feeScheduleResponse {
+	return &feeScheduleResponse{}
+}
+
+func (f *feeScheduleResponse) ToGraphqlResponseFeeSchedule(status, message string, schedule *response.FeeScheduleResponse) *model.APIResponseFeeSchedule {
+	return &model.APIResponseFeeSchedule{
+		Status:  status,
+		Message: message,
+		Data:    f.mapResponseFeeSchedule(schedule),
+	}
+}
+
+func (f *feeScheduleResponse) ToGraphqlResponsePaginationFeeSchedule(status, message string, schedules []*response.FeeScheduleResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationFeeSchedule {
+	return &model.APIResponsePaginationFeeSchedule{
+		Status:     status,
+		Message:    message,
+		Data:       f.mapResponsesFeeSchedule(schedules),
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (f *feeScheduleResponse) ToGraphqlResponseFeeMonthAmount(status, message string, fees []*response.FeeMonthAmountResponse) *model.APIResponseFeeMonthAmount {
+	var data []*model.FeeMonthAmountResponse
+
+	for _, fee := range fees {
+		data = append(data, &model.FeeMonthAmountResponse{
+			Month:           fee.Month,
+			TransactionType: fee.TransactionType,
+			Currency:        fee.Currency,
+			TotalFee:        int32(fee.TotalFee),
+		})
+	}
+
+	return &model.APIResponseFeeMonthAmount{
+		Status:  status,
+		Message: message,
+		Data:    data,
+	}
+}
+
+func (f *feeScheduleResponse) ToGraphqlResponseFeeYearAmount(status, message string, fees []*response.FeeYearlyAmountResponse) *model.APIResponseFeeYearAmount {
+	var data []*model.FeeYearAmountResponse
+
+	for _, fee := range fees {
+		data = append(data, &model.FeeYearAmountResponse{
+			Year:            fee.Year,
+			TransactionType: fee.TransactionType,
+			Currency:        fee.Currency,
+			TotalFee:        int32(fee.TotalFee),
+		})
+	}
+
+	return &model.APIResponseFeeYearAmount{
+		Status:  status,
+		Message: message,
+		Data:    data,
+	}
+}
+
+func (f *feeScheduleResponse) mapResponseFeeSchedule(schedule *response.FeeScheduleResponse) *model.FeeScheduleResponse {
+	tiers := make([]*model.FeeScheduleTierResponse, 0, len(schedule.Tiers))
+
+	for _, tier := range schedule.Tiers {
+		tiers = append(tiers, &model.FeeScheduleTierResponse{
+			ID:            int32(tier.ID),
+			MinAmount:     int32(tier.MinAmount),
+			FlatAmount:    int32(tier.FlatAmount),
+			PercentageBps: int32(tier.PercentageBps),
+		})
+	}
+
+	return &model.FeeScheduleResponse{
+		ID:              int32(schedule.ID),
+		Name:            schedule.Name,
+		TransactionType: schedule.TransactionType,
+		FeeType:         schedule.FeeType,
+		PaymentMethod:   schedule.PaymentMethod,
+		CardType:        schedule.CardType,
+		Currency:        schedule.Currency,
+		FlatAmount:      int32(schedule.FlatAmount),
+		PercentageBps:   int32(schedule.PercentageBps),
+		MinFee:          mapFeeBound(schedule.MinFee),
+		MaxFee:          mapFeeBound(schedule.MaxFee),
+		IsActive:        schedule.IsActive,
+		Tiers:           tiers,
+		CreatedAt:       schedule.CreatedAt,
+		UpdatedAt:       schedule.UpdatedAt,
+	}
+}
+
+func (f *feeScheduleResponse) mapResponsesFeeSchedule(schedules []*response.FeeScheduleResponse) []*model.FeeScheduleResponse {
+	var responses []*model.FeeScheduleResponse
+
+	for _, schedule := range schedules {
+		responses = append(responses, f.mapResponseFeeSchedule(schedule))
+	}
+
+	return responses
+}
+
+func mapFeeBound(bound *int) *int32 {
+	if bound == nil {
+		return nil
+	}
+
+	value := int32(*bound)
+	return &value
+}
@@ -58,6 +58,8 @@ type MerchantGraphqlMapper interface {
 	ToGraphqlYearlyPaymentMethods(status, message string, merchant []*response.MerchantResponseYearlyPaymentMethod) *model.APIResponseMerchantYearlyPaymentMethod
 	ToGraphqlMonthlyAmounts(status, message string, ms []*response.MerchantResponseMonthlyAmount) *model.APIResponseMerchantMonthlyAmount
 	ToGraphqlYearlyAmounts(status, message string, ms []*response.MerchantResponseYearlyAmount) *model.APIResponseMerchantYearlyAmount
+	ToGraphqlMonthlyDisputeRates(status, message string, ms []*response.MerchantResponseMonthlyDisputeRate) *model.APIResponseMerchantMonthlyDisputeRate
+	ToGraphqlYearlyDisputeRates(status, message string, ms []*response.MerchantResponseYearlyDisputeRate) *model.APIResponseMerchantYearlyDisputeRate
 	ToGraphqlMonthlyTotalAmounts(status, message string, ms []*response.MerchantResponseMonthlyTotalAmount) *model.APIResponseMerchantMonthlyTotalAmount
 	ToGraphqlYearlyTotalAmounts(status, message string, ms []*response.MerchantResponseYearlyTotalAmount) *model.APIResponseMerchantYearlyTotalAmount
 	ToGraphqlMerchantDeleteAll(status, message string) *model.APIResponseMerchantDelete
@@ -77,6 +79,9 @@ type SaldoGraphqMapper interface {
 	ToGraphqlResponseYearTotalSaldo(status, message string, response []*response.SaldoYearTotalBalanceResponse) *model.APIResponseYearTotalSaldo
 	ToGraphqlResponseMonthSaldoBalances(status, message string, response []*response.SaldoMonthBalanceResponse) *model.APIResponseMonthSaldoBalances
 	ToGraphqlResponseYearBalance(status, message string, response []*response.SaldoYearBalanceResponse) *model.APIResponseYearSaldoBalances
+
+	ToGraphqlResponseSaldoHold(status, message string, hold *response.SaldoHoldResponse) *model.APIResponseSaldoHold
+	ToGraphqlResponsesSaldoHold(status, message string, holds []*response.SaldoHoldResponse) *model.APIResponsesSaldoHold
 }
 
 type TopupGraphqlMapper interface {
@@ -112,6 +117,7 @@ type TransactionGraphqlMapper interface {
 	ToGraphqlResponseTransaction(status, message string, data *response.TransactionResponse) *model.APIResponseTransaction
 	ToGraphqlResponseTransactions(status, message string, data []*response.TransactionResponse) *model.APIResponseTransactions
 	ToGraphqlResponseTransactionDeleteAt(status, message string, data *response.TransactionResponseDeleteAt) *model.APIResponseTransactionDeleteAt
+	ToGraphqlResponseMerchantQr(status, message string, data *response.MerchantQrResponse) *model.APIResponseMerchantQR
 }
 
 type TransferGraphqlMapper interface {
@@ -144,4 +150,108 @@ type WithdrawGraphqlMapper interface {
 	ToGraphqlResponseWithdrawYearStatusSuccess(status, message string, data []*response.WithdrawResponseYearStatusSuccess) *model.APIResponseWithdrawYearStatusSuccess
 	ToGraphqlResponseWithdrawMonthStatusFailed(status, message string, data []*response.WithdrawResponseMonthStatusFailed) *model.APIResponseWithdrawMonthStatusFailed
 	ToGraphqlResponseWithdrawYearStatusFailed(status, message string, data []*response.WithdrawResponseYearStatusFailed) *model.APIResponseWithdrawYearStatusFailed
+	ToGraphqlResponseWithdrawReview(status, message string, data *response.WithdrawReviewResponse) *model.APIResponseWithdrawReview
+}
+
+type LedgerGraphqlMapper interface {
+	ToGraphqlResponseLedgerJournal(status, message string, journal *response.LedgerJournalResponse) *model.APIResponseLedgerJournal
+	ToGraphqlResponsePaginationLedgerJournal(status, message string, journals []*response.LedgerJournalResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationLedgerJournal
+	ToGraphqlResponsePaginationLedgerCardPosting(status, message string, postings []*response.LedgerCardPostingResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationLedgerCardPosting
+	ToGraphqlResponseLedgerBalance(status, message string, balance *response.LedgerBalanceResponse) *model.APIResponseLedgerBalance
+}
+
+type RefundGraphqlMapper interface {
+	ToGraphqlResponseRefund(status, message string, refund *response.RefundResponse) *model.APIResponseRefund
+	ToGraphqlResponsesRefund(status, message string, refunds []*response.RefundResponse) *model.APIResponsesRefund
+	ToGraphqlResponsePaginationRefund(status, message string, refunds []*response.RefundResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationRefund
+}
+
+type DisputeGraphqlMapper interface {
+	ToGraphqlResponseDispute(status, message string, dispute *response.DisputeResponse) *model.APIResponseDispute
+	ToGraphqlResponsePaginationDispute(status, message string, disputes []*response.DisputeResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationDispute
+}
+
+type AuthorizationGraphqlMapper interface {
+	ToGraphqlResponseAuthorization(status, message string, authorization *response.AuthorizationResponse) *model.APIResponseAuthorization
+	ToGraphqlResponsePaginationAuthorization(status, message string, authorizations []*response.AuthorizationResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationAuthorization
+}
+
+type ExchangeRateGraphqlMapper interface {
+	ToGraphqlResponseExchangeRate(status, message string, rate *response.ExchangeRateResponse) *model.APIResponseExchangeRate
+	ToGraphqlResponsePaginationExchangeRate(status, message string, rates []*response.ExchangeRateResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationExchangeRate
+}
+
+type FeeScheduleGraphqlMapper interface {
+	ToGraphqlResponseFeeSchedule(status, message string, schedule *response.FeeScheduleResponse) *model.APIResponseFeeSchedule
+	ToGraphqlResponsePaginationFeeSchedule(status, message string, schedules []*response.FeeScheduleResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationFeeSchedule
+
+	ToGraphqlResponseFeeMonthAmount(status, message string, fees []*response.FeeMonthAmountResponse) *model.APIResponseFeeMonthAmount
+	ToGraphqlResponseFeeYearAmount(status, message string, fees []*response.FeeYearlyAmountResponse) *model.APIResponseFeeYearAmount
+}
+
+type TransactionLimitGraphqlMapper interface {
+	ToGraphqlResponseTransactionLimit(status, message string, limit *response.TransactionLimitResponse) *model.APIResponseTransactionLimit
+	ToGraphqlResponsePaginationTransactionLimit(status, message string, limits []*response.TransactionLimitResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationTransactionLimit
+
+	ToGraphqlResponseTransactionLimitHeadroom(status, message string, headrooms []*response.TransactionLimitHeadroomResponse) *model.APIResponseTransactionLimitHeadroom
+}
+
+type ScheduledTransferGraphqlMapper interface {
+	ToGraphqlResponseScheduledTransfer(status, message string, schedule *response.ScheduledTransferResponse) *model.APIResponseScheduledTransfer
+	ToGraphqlResponsePaginationScheduledTransfer(status, message string, schedules []*response.ScheduledTransferResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationScheduledTransfer
+	ToGraphqlResponsePaginationScheduledTransferRun(status, message string, runs []*response.ScheduledTransferRunResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationScheduledTransferRun
+}
+
+type TransferBatchGraphqlMapper interface {
+	ToGraphqlResponseTransferBatch(status, message string, batch *response.TransferBatchResponse) *model.APIResponseTransferBatch
+	ToGraphqlResponsePaginationTransferBatch(status, message string, batches []*response.TransferBatchResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationTransferBatch
+	ToGraphqlResponsePaginationTransferBatchRow(status, message string, rows []*response.TransferBatchRowResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationTransferBatchRow
+	ToGraphqlResponseTransferBatchReport(status, message string, report *response.TransferBatchReportResponse) *model.APIResponseTransferBatchReport
+}
+
+type StatusHistoryGraphqlMapper interface {
+	ToGraphqlResponseStatusHistory(status, message string, histories []*response.StatusHistoryResponse) *model.APIResponseStatusHistory
+}
+
+type ProviderWebhookGraphqlMapper interface {
+	ToGraphqlResponseProviderWebhookEvent(status, message string, event *response.ProviderWebhookEventResponse) *model.APIResponseProviderWebhookEvent
+	ToGraphqlResponsePaginationProviderWebhookEvent(status, message string, events []*response.ProviderWebhookEventResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationProviderWebhookEvent
+}
+
+type MerchantWebhookGraphqlMapper interface {
+	ToGraphqlResponseMerchantWebhookEndpoint(status, message string, endpoint *response.MerchantWebhookEndpointResponse) *model.APIResponseMerchantWebhookEndpoint
+	ToGraphqlResponseMerchantWebhookEndpoints(status, message string, endpoints []*response.MerchantWebhookEndpointResponse) *model.APIResponseMerchantWebhookEndpoints
+	ToGraphqlResponseMerchantWebhookDelivery(status, message string, delivery *response.MerchantWebhookDeliveryResponse) *model.APIResponseMerchantWebhookDelivery
+	ToGraphqlResponsePaginationMerchantWebhookDelivery(status, message string, deliveries []*response.MerchantWebhookDeliveryResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationMerchantWebhookDelivery
+	ToGraphqlResponseMerchantWebhookDeliveryAttempts(status, message string, attempts []*response.MerchantWebhookDeliveryAttemptResponse) *model.APIResponseMerchantWebhookDeliveryAttempts
+}
+
+type SettlementGraphqlMapper interface {
+	ToGraphqlResponseMerchantBalance(status, message string, balance *response.MerchantBalanceResponse) *model.APIResponseMerchantBalance
+	ToGraphqlResponseMerchantBalances(status, message string, balances []*response.MerchantBalanceResponse) *model.APIResponseMerchantBalances
+	ToGraphqlResponseSettlementBatch(status, message string, batch *response.SettlementBatchResponse) *model.APIResponseSettlementBatch
+	ToGraphqlResponsePaginationSettlementBatch(status, message string, batches []*response.SettlementBatchResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationSettlementBatch
+	ToGraphqlResponseSettlementItems(status, message string, items []*response.SettlementItemResponse) *model.APIResponseSettlementItems
+}
+
+type MerchantOnboardingGraphqlMapper interface {
+	ToGraphqlResponseMerchantBusinessProfile(status, message string, profile *response.MerchantBusinessProfileResponse) *model.APIResponseMerchantBusinessProfile
+}
+
+type ReconciliationGraphqlMapper interface {
+	ToGraphqlResponsePaginationReconciliationRun(status, message string, runs []*response.ReconciliationRunResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationReconciliationRun
+	ToGraphqlResponseBalanceDiscrepancy(status, message string, discrepancy *response.BalanceDiscrepancyResponse) *model.APIResponseBalanceDiscrepancy
+	ToGraphqlResponsePaginationBalanceDiscrepancy(status, message string, discrepancies []*response.BalanceDiscrepancyResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationBalanceDiscrepancy
+}
+
+type MerchantApiKeyGraphqlMapper interface {
+	ToGraphqlResponseMerchantApiKey(status, message string, key *response.MerchantApiKeyResponse) *model.APIResponseMerchantAPIKey
+	ToGraphqlResponseMerchantApiKeys(status, message string, keys []*response.MerchantApiKeyResponse) *model.APIResponseMerchantAPIKeys
+}
+
+type InvoiceGraphqlMapper interface {
+	ToGraphqlResponseInvoice(status, message string, invoice *response.InvoiceResponse) *model.APIResponseInvoice
+	ToGraphqlResponsePaginationInvoice(status, message string, invoices []*response.InvoiceResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationInvoice
+	ToGraphqlResponseInvoicePayment(status, message string, payment *response.InvoicePaymentResponse) *model.APIResponseInvoicePayment
+	ToGraphqlResponseInvoicePayments(status, message string, payments []*response.InvoicePaymentResponse) *model.APIResponseInvoicePayments
 }
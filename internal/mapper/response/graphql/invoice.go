@@ -0,0 +1,99 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type invoiceResponse struct {
+}
+
+func NewInvoiceResponseMapper() *invoiceResponse {
+	return &invoiceResponse{}
+}
+
+func (s *invoiceResponse) ToGraphqlResponseInvoice(status, message string, invoice *response.InvoiceResponse) *model.APIResponseInvoice {
+	return &model.APIResponseInvoice{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponseInvoice(invoice),
+	}
+}
+
+func (s *invoiceResponse) ToGraphqlResponsePaginationInvoice(status, message string, invoices []*response.InvoiceResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationInvoice {
+	var data []*model.InvoiceResponse
+
+	for _, invoice := range invoices {
+		data = append(data, s.mapResponseInvoice(invoice))
+	}
+
+	return &model.APIResponsePaginationInvoice{
+		Status:     status,
+		Message:    message,
+		Data:       data,
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (s *invoiceResponse) ToGraphqlResponseInvoicePayment(status, message string, payment *response.InvoicePaymentResponse) *model.APIResponseInvoicePayment {
+	return &model.APIResponseInvoicePayment{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponseInvoicePayment(payment),
+	}
+}
+
+func (s *invoiceResponse) ToGraphqlResponseInvoicePayments(status, message string, payments []*response.InvoicePaymentResponse) *model.APIResponseInvoicePayments {
+	var data []*model.InvoicePaymentResponse
+
+	for _, payment := range payments {
+		data = append(data, s.mapResponseInvoicePayment(payment))
+	}
+
+	return &model.APIResponseInvoicePayments{
+		Status:  status,
+		Message: message,
+		Data:    data,
+	}
+}
+
+func (s *invoiceResponse) mapResponseInvoice(invoice *response.InvoiceResponse) *model.InvoiceResponse {
+	lineItems := make([]*model.InvoiceLineItemResponse, 0, len(invoice.LineItems))
+
+	for _, item := range invoice.LineItems {
+		lineItems = append(lineItems, &model.InvoiceLineItemResponse{
+			ID:          int32(item.ID),
+			Description: item.Description,
+			Quantity:    int32(item.Quantity),
+			UnitPrice:   int32(item.UnitPrice),
+			Amount:      int32(item.Amount),
+		})
+	}
+
+	return &model.InvoiceResponse{
+		ID:          int32(invoice.ID),
+		Code:        invoice.Code,
+		MerchantID:  int32(invoice.MerchantID),
+		Kind:        invoice.Kind,
+		Description: invoice.Description,
+		Amount:      int32(invoice.Amount),
+		Currency:    invoice.Currency,
+		Status:      invoice.Status,
+		ExpiresAt:   invoice.ExpiresAt,
+		PaidAt:      invoice.PaidAt,
+		CancelledAt: invoice.CancelledAt,
+		LineItems:   lineItems,
+		CreatedAt:   invoice.CreatedAt,
+		UpdatedAt:   invoice.UpdatedAt,
+	}
+}
+
+func (s *invoiceResponse) mapResponseInvoicePayment(payment *response.InvoicePaymentResponse) *model.InvoicePaymentResponse {
+	return &model.InvoicePaymentResponse{
+		ID:            int32(payment.ID),
+		InvoiceID:     int32(payment.InvoiceID),
+		TransactionID: int32(payment.TransactionID),
+		Amount:        int32(payment.Amount),
+		CreatedAt:     payment.CreatedAt,
+	}
+}
@@ -0,0 +1,132 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type ledgerResponse struct {
+}
+
+func NewLedgerResponseMapper() *ledgerResponse {
+	return &ledgerResponse{}
+}
+
+func (l *ledgerResponse) ToGraphqlResponseLedgerJournal(status, message string, journal *response.LedgerJournalResponse) *model.APIResponseLedgerJournal {
+	return &model.APIResponseLedgerJournal{
+		Status:  status,
+		Message: message,
+		Data:    l.mapResponseLedgerJournal(journal),
+	}
+}
+
+func (l *ledgerResponse) ToGraphqlResponsePaginationLedgerJournal(status, message string, journals []*response.LedgerJournalResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationLedgerJournal {
+	return &model.APIResponsePaginationLedgerJournal{
+		Status:     status,
+		Message:    message,
+		Data:       l.mapResponsesLedgerJournal(journals),
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (l *ledgerResponse) ToGraphqlResponsePaginationLedgerCardPosting(status, message string, postings []*response.LedgerCardPostingResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationLedgerCardPosting {
+	return &model.APIResponsePaginationLedgerCardPosting{
+		Status:     status,
+		Message:    message,
+		Data:       l.mapResponsesLedgerCardPosting(postings),
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (l *ledgerResponse) ToGraphqlResponseLedgerBalance(status, message string, balance *response.LedgerBalanceResponse) *model.APIResponseLedgerBalance {
+	return &model.APIResponseLedgerBalance{
+		Status:  status,
+		Message: message,
+		Data: &model.LedgerBalanceResponse{
+			CardNumber:    balance.CardNumber,
+			LedgerBalance: int32(balance.LedgerBalance),
+			SaldoBalance:  int32(balance.SaldoBalance),
+			Difference:    int32(balance.Difference),
+		},
+	}
+}
+
+func (l *ledgerResponse) mapResponseLedgerJournal(journal *response.LedgerJournalResponse) *model.LedgerJournalResponse {
+	return &model.LedgerJournalResponse{
+		ID:            int32(journal.ID),
+		JournalNo:     journal.JournalNo,
+		ReferenceType: journal.ReferenceType,
+		ReferenceID:   l.mapReferenceID(journal.ReferenceID),
+		Description:   journal.Description,
+		PostedAt:      journal.PostedAt,
+		CreatedAt:     journal.CreatedAt,
+		Postings:      l.mapResponsesLedgerPosting(journal.Postings),
+	}
+}
+
+func (l *ledgerResponse) mapResponsesLedgerJournal(journals []*response.LedgerJournalResponse) []*model.LedgerJournalResponse {
+	var responseJournals []*model.LedgerJournalResponse
+
+	for _, journal := range journals {
+		responseJournals = append(responseJournals, l.mapResponseLedgerJournal(journal))
+	}
+
+	return responseJournals
+}
+
+func (l *ledgerResponse) mapResponseLedgerPosting(posting *response.LedgerPostingResponse) *model.LedgerPostingResponse {
+	return &model.LedgerPostingResponse{
+		ID:          int32(posting.ID),
+		JournalID:   int32(posting.JournalID),
+		AccountCode: posting.AccountCode,
+		CardNumber:  posting.CardNumber,
+		Direction:   posting.Direction,
+		Amount:      int32(posting.Amount),
+		Currency:    posting.Currency,
+		CreatedAt:   posting.CreatedAt,
+	}
+}
+
+func (l *ledgerResponse) mapResponsesLedgerPosting(postings []*response.LedgerPostingResponse) []*model.LedgerPostingResponse {
+	var responsePostings []*model.LedgerPostingResponse
+
+	for _, posting := range postings {
+		responsePostings = append(responsePostings, l.mapResponseLedgerPosting(posting))
+	}
+
+	return responsePostings
+}
+
+func (l *ledgerResponse) mapResponseLedgerCardPosting(posting *response.LedgerCardPostingResponse) *model.LedgerCardPostingResponse {
+	return &model.LedgerCardPostingResponse{
+		ID:            int32(posting.ID),
+		JournalID:     int32(posting.JournalID),
+		ReferenceType: posting.ReferenceType,
+		ReferenceID:   l.mapReferenceID(posting.ReferenceID),
+		CardNumber:    posting.CardNumber,
+		Direction:     posting.Direction,
+		Amount:        int32(posting.Amount),
+		Currency:      posting.Currency,
+		PostedAt:      posting.PostedAt,
+	}
+}
+
+func (l *ledgerResponse) mapResponsesLedgerCardPosting(postings []*response.LedgerCardPostingResponse) []*model.LedgerCardPostingResponse {
+	var responsePostings []*model.LedgerCardPostingResponse
+
+	for _, posting := range postings {
+		responsePostings = append(responsePostings, l.mapResponseLedgerCardPosting(posting))
+	}
+
+	return responsePostings
+}
+
+func (l *ledgerResponse) mapReferenceID(referenceID *int) *int32 {
+	if referenceID == nil {
+		return nil
+	}
+
+	id := int32(*referenceID)
+
+	return &id
+}
@@ -11,19 +11,53 @@ type GraphqlMapper struct {
 	TransactionGraphqlMapper
 	TransferGraphqlMapper
 	WithdrawGraphqlMapper
+	LedgerGraphqlMapper
+	RefundGraphqlMapper
+	DisputeGraphqlMapper
+	AuthorizationGraphqlMapper
+	ExchangeRateGraphqlMapper
+	FeeScheduleGraphqlMapper
+	TransactionLimitGraphqlMapper
+	ScheduledTransferGraphqlMapper
+	TransferBatchGraphqlMapper
+	StatusHistoryGraphqlMapper
+	ProviderWebhookGraphqlMapper
+	MerchantWebhookGraphqlMapper
+	SettlementGraphqlMapper
+	ReconciliationGraphqlMapper
+	MerchantOnboardingGraphqlMapper
+	MerchantApiKeyGraphqlMapper
+	InvoiceGraphqlMapper
 }
 
 func NewGraphqlMapper() *GraphqlMapper {
 	return &GraphqlMapper{
-		AuthGraphqlMapper:        NewAuthResponseMapper(),
-		UserGraphqlMapper:        NewUserResponseMapper(),
-		RoleGraphqlMapper:        NewRoleResponseMapper(),
-		MerchantGraphqlMapper:    NewMerchantResponseMapper(),
-		CardGraphqlMapper:        NewCardResponseMapper(),
-		SaldoGraphqMapper:        NewSaldoResponseMapper(),
-		TopupGraphqlMapper:       NewTopupResponseMapper(),
-		TransactionGraphqlMapper: NewTransactionResponseMapper(),
-		TransferGraphqlMapper:    NewTransferResponseMapper(),
-		WithdrawGraphqlMapper:    NewWithdrawResponseMapper(),
+		AuthGraphqlMapper:               NewAuthResponseMapper(),
+		UserGraphqlMapper:               NewUserResponseMapper(),
+		RoleGraphqlMapper:               NewRoleResponseMapper(),
+		MerchantGraphqlMapper:           NewMerchantResponseMapper(),
+		CardGraphqlMapper:               NewCardResponseMapper(),
+		SaldoGraphqMapper:               NewSaldoResponseMapper(),
+		TopupGraphqlMapper:              NewTopupResponseMapper(),
+		TransactionGraphqlMapper:        NewTransactionResponseMapper(),
+		TransferGraphqlMapper:           NewTransferResponseMapper(),
+		WithdrawGraphqlMapper:           NewWithdrawResponseMapper(),
+		LedgerGraphqlMapper:             NewLedgerResponseMapper(),
+		RefundGraphqlMapper:             NewRefundResponseMapper(),
+		DisputeGraphqlMapper:            NewDisputeResponseMapper(),
+		AuthorizationGraphqlMapper:      NewAuthorizationResponseMapper(),
+		ExchangeRateGraphqlMapper:       NewExchangeRateResponseMapper(),
+		FeeScheduleGraphqlMapper:        NewFeeScheduleResponseMapper(),
+		TransactionLimitGraphqlMapper:   NewTransactionLimitResponseMapper(),
+		ScheduledTransferGraphqlMapper:  NewScheduledTransferResponseMapper(),
+		TransferBatchGraphqlMapper:      NewTransferBatchResponseMapper(),
+		StatusHistoryGraphqlMapper:      NewStatusHistoryResponseMapper(),
+		ProviderWebhookGraphqlMapper:    NewProviderWebhookResponseMapper(),
+		MerchantWebhookGraphqlMapper:    NewMerchantWebhookResponseMapper(),
+		SettlementGraphqlMapper:         NewSettlementResponseMapper(),
+		ReconciliationGraphqlMapper:     NewReconciliationResponseMapper(),
+		MerchantOnboardingGraphqlMapper: NewMerchantOnboardingResponseMapper(),
+		MerchantApiKeyGraphqlMapper:     NewMerchantApiKeyResponseMapper(),
+		InvoiceGraphqlMapper:            NewInvoiceResponseMapper(),
 	}
 }
@@ -95,6 +95,22 @@ func (m *merchantResponse) ToGraphqlYearlyAmounts(status, message string, ms []*
 	}
 }
 
+func (m *merchantResponse) ToGraphqlMonthlyDisputeRates(status, message string, ms []*response.MerchantResponseMonthlyDisputeRate) *model.APIResponseMerchantMonthlyDisputeRate {
+	return &model.APIResponseMerchantMonthlyDisputeRate{
+		Status:  status,
+		Message: message,
+		Data:    m.mapResponsesMonthlyDisputeRate(ms),
+	}
+}
+
+func (m *merchantResponse) ToGraphqlYearlyDisputeRates(status, message string, ms []*response.MerchantResponseYearlyDisputeRate) *model.APIResponseMerchantYearlyDisputeRate {
+	return &model.APIResponseMerchantYearlyDisputeRate{
+		Status:  status,
+		Message: message,
+		Data:    m.mapResponsesYearlyDisputeRate(ms),
+	}
+}
+
 func (m *merchantResponse) ToGraphqlMonthlyTotalAmounts(status, message string, ms []*response.MerchantResponseMonthlyTotalAmount) *model.APIResponseMerchantMonthlyTotalAmount {
 	return &model.APIResponseMerchantMonthlyTotalAmount{
 		Status:  status,
@@ -131,7 +147,6 @@ func (m *merchantResponse) mapMerchantResponse(merchant *response.MerchantRespon
 		Name:      merchant.Name,
 		UserID:    int32(merchant.UserID),
 		Status:    merchant.Status,
-		APIKey:    merchant.ApiKey,
 		CreatedAt: merchant.CreatedAt,
 		UpdatedAt: merchant.UpdatedAt,
 	}
@@ -153,7 +168,6 @@ func (m *merchantResponse) mapMerchantResponseDeleteAt(merchant *response.Mercha
 		Name:      merchant.Name,
 		UserID:    int32(merchant.UserID),
 		Status:    merchant.Status,
-		APIKey:    merchant.ApiKey,
 		CreatedAt: merchant.CreatedAt,
 		UpdatedAt: merchant.UpdatedAt,
 		DeletedAt: *merchant.DeletedAt,
@@ -230,6 +244,7 @@ func (m *merchantResponse) mapResponsesYearlyPaymentMethod(r []*response.Merchan
 func (m *merchantResponse) mapResponseMonthlyAmount(ms *response.MerchantResponseMonthlyAmount) *model.MerchantMonthlyAmountResponse {
 	return &model.MerchantMonthlyAmountResponse{
 		Month:       ms.Month,
+		Currency:    ms.Currency,
 		TotalAmount: int32(ms.TotalAmount),
 	}
 }
@@ -243,9 +258,46 @@ func (m *merchantResponse) mapResponsesMonthlyAmount(r []*response.MerchantRespo
 	return responseMerchants
 }
 
+func (m *merchantResponse) mapResponseMonthlyDisputeRate(ms *response.MerchantResponseMonthlyDisputeRate) *model.MerchantMonthlyDisputeRateResponse {
+	return &model.MerchantMonthlyDisputeRateResponse{
+		Month:             ms.Month,
+		TotalTransactions: int32(ms.TotalTransactions),
+		TotalDisputes:     int32(ms.TotalDisputes),
+		DisputeRate:       ms.DisputeRate,
+	}
+}
+
+func (m *merchantResponse) mapResponsesMonthlyDisputeRate(r []*response.MerchantResponseMonthlyDisputeRate) []*model.MerchantMonthlyDisputeRateResponse {
+	var responseMerchants []*model.MerchantMonthlyDisputeRateResponse
+	for _, merchant := range r {
+		responseMerchants = append(responseMerchants, m.mapResponseMonthlyDisputeRate(merchant))
+	}
+
+	return responseMerchants
+}
+
+func (m *merchantResponse) mapResponseYearlyDisputeRate(ms *response.MerchantResponseYearlyDisputeRate) *model.MerchantYearlyDisputeRateResponse {
+	return &model.MerchantYearlyDisputeRateResponse{
+		Year:              ms.Year,
+		TotalTransactions: int32(ms.TotalTransactions),
+		TotalDisputes:     int32(ms.TotalDisputes),
+		DisputeRate:       ms.DisputeRate,
+	}
+}
+
+func (m *merchantResponse) mapResponsesYearlyDisputeRate(r []*response.MerchantResponseYearlyDisputeRate) []*model.MerchantYearlyDisputeRateResponse {
+	var responseMerchants []*model.MerchantYearlyDisputeRateResponse
+	for _, merchant := range r {
+		responseMerchants = append(responseMerchants, m.mapResponseYearlyDisputeRate(merchant))
+	}
+
+	return responseMerchants
+}
+
 func (m *merchantResponse) mapResponseYearlyAmount(ms *response.MerchantResponseYearlyAmount) *model.MerchantYearlyAmountResponse {
 	return &model.MerchantYearlyAmountResponse{
 		Year:        ms.Year,
+		Currency:    ms.Currency,
 		TotalAmount: int32(ms.TotalAmount),
 	}
 }
@@ -262,6 +314,7 @@ func (m *merchantResponse) mapResponsesYearlyAmount(r []*response.MerchantRespon
 func (m *merchantResponse) mapResponseMonthlyTotalAmount(ms *response.MerchantResponseMonthlyTotalAmount) *model.MerchantMonthlyTotalAmountResponse {
 	return &model.MerchantMonthlyTotalAmountResponse{
 		Month:       ms.Month,
+		Currency:    ms.Currency,
 		Year:        ms.Year,
 		TotalAmount: int32(ms.TotalAmount),
 	}
@@ -279,6 +332,7 @@ func (m *merchantResponse) mapResponsesMonthlyTotalAmount(r []*response.Merchant
 func (m *merchantResponse) mapResponseYearlyTotalAmount(ms *response.MerchantResponseYearlyTotalAmount) *model.MerchantYearlyTotalAmountResponse {
 	return &model.MerchantYearlyTotalAmountResponse{
 		Year:        ms.Year,
+		Currency:    ms.Currency,
 		TotalAmount: int32(ms.TotalAmount),
 	}
 }
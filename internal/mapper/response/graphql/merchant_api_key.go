@@ -0,0 +1,52 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type merchantApiKeyResponse struct {
+}
+
+func NewMerchantApiKeyResponseMapper() *merchantApiKeyResponse {
+	return &merchantApiKeyResponse{}
+}
+
+func (s *merchantApiKeyResponse) ToGraphqlResponseMerchantApiKey(status, message string, key *response.MerchantApiKeyResponse) *model.APIResponseMerchantAPIKey {
+	return &model.APIResponseMerchantAPIKey{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponseMerchantApiKey(key),
+	}
+}
+
+func (s *merchantApiKeyResponse) ToGraphqlResponseMerchantApiKeys(status, message string, keys []*response.MerchantApiKeyResponse) *model.APIResponseMerchantAPIKeys {
+	var data []*model.MerchantAPIKeyResponse
+
+	for _, key := range keys {
+		data = append(data, s.mapResponseMerchantApiKey(key))
+	}
+
+	return &model.APIResponseMerchantAPIKeys{
+		Status:  status,
+		Message: message,
+		Data:    data,
+	}
+}
+
+func (s *merchantApiKeyResponse) mapResponseMerchantApiKey(key *response.MerchantApiKeyResponse) *model.MerchantAPIKeyResponse {
+	return &model.MerchantAPIKeyResponse{
+		ID:            int32(key.ID),
+		MerchantID:    int32(key.MerchantID),
+		Label:         key.Label,
+		KeyPrefix:     key.KeyPrefix,
+		APIKey:        key.ApiKey,
+		SigningSecret: key.SigningSecret,
+		Scopes:        key.Scopes,
+		ExpiresAt:     key.ExpiresAt,
+		LastUsedAt:    key.LastUsedAt,
+		RevokedAt:     key.RevokedAt,
+		CreatedAt:     key.CreatedAt,
+		UpdatedAt:     key.UpdatedAt,
+	}
+}
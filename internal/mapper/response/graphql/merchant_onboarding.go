@@ -0,0 +1,33 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type merchantOnboardingResponse struct {
+}
+
+func NewMerchantOnboardingResponseMapper() *merchantOnboardingResponse {
+	return &merchantOnboardingResponse{}
+}
+
+func (s *merchantOnboardingResponse) ToGraphqlResponseMerchantBusinessProfile(status, message string, profile *response.MerchantBusinessProfileResponse) *model.APIResponseMerchantBusinessProfile {
+	return &model.APIResponseMerchantBusinessProfile{
+		Status:  status,
+		Message: message,
+		Data: &model.MerchantBusinessProfileResponse{
+			MerchantID:   int32(profile.MerchantID),
+			LegalName:    profile.LegalName,
+			BusinessType: profile.BusinessType,
+			TaxID:        profile.TaxID,
+			Address:      profile.Address,
+			Website:      profile.Website,
+			ContactEmail: profile.ContactEmail,
+			ContactPhone: profile.ContactPhone,
+			SubmittedAt:  profile.SubmittedAt,
+			CreatedAt:    profile.CreatedAt,
+			UpdatedAt:    profile.UpdatedAt,
+		},
+	}
+}
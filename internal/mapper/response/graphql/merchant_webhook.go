@@ -0,0 +1,120 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type merchantWebhookResponse struct {
+}
+
+func NewMerchantWebhookResponseMapper() *merchantWebhookResponse {
+	return &merchantWebhookResponse{}
+}
+
+func (s *merchantWebhookResponse) ToGraphqlResponseMerchantWebhookEndpoint(status, message string, endpoint *response.MerchantWebhookEndpointResponse) *model.APIResponseMerchantWebhookEndpoint {
+	return &model.APIResponseMerchantWebhookEndpoint{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponseMerchantWebhookEndpoint(endpoint),
+	}
+}
+
+func (s *merchantWebhookResponse) ToGraphqlResponseMerchantWebhookEndpoints(status, message string, endpoints []*response.MerchantWebhookEndpointResponse) *model.APIResponseMerchantWebhookEndpoints {
+	var data []*model.MerchantWebhookEndpointResponse
+
+	for _, endpoint := range endpoints {
+		data = append(data, s.mapResponseMerchantWebhookEndpoint(endpoint))
+	}
+
+	return &model.APIResponseMerchantWebhookEndpoints{
+		Status:  status,
+		Message: message,
+		Data:    data,
+	}
+}
+
+func (s *merchantWebhookResponse) ToGraphqlResponseMerchantWebhookDelivery(status, message string, delivery *response.MerchantWebhookDeliveryResponse) *model.APIResponseMerchantWebhookDelivery {
+	return &model.APIResponseMerchantWebhookDelivery{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponseMerchantWebhookDelivery(delivery),
+	}
+}
+
+func (s *merchantWebhookResponse) ToGraphqlResponsePaginationMerchantWebhookDelivery(status, message string, deliveries []*response.MerchantWebhookDeliveryResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationMerchantWebhookDelivery {
+	var data []*model.MerchantWebhookDeliveryResponse
+
+	for _, delivery := range deliveries {
+		data = append(data, s.mapResponseMerchantWebhookDelivery(delivery))
+	}
+
+	return &model.APIResponsePaginationMerchantWebhookDelivery{
+		Status:     status,
+		Message:    message,
+		Data:       data,
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (s *merchantWebhookResponse) ToGraphqlResponseMerchantWebhookDeliveryAttempts(status, message string, attempts []*response.MerchantWebhookDeliveryAttemptResponse) *model.APIResponseMerchantWebhookDeliveryAttempts {
+	var data []*model.MerchantWebhookDeliveryAttemptResponse
+
+	for _, attempt := range attempts {
+		data = append(data, &model.MerchantWebhookDeliveryAttemptResponse{
+			ID:           int32(attempt.ID),
+			DeliveryID:   int32(attempt.DeliveryID),
+			Attempt:      int32(attempt.Attempt),
+			ResponseCode: mapWebhookResponseCode(attempt.ResponseCode),
+			Error:        attempt.Error,
+			DurationMs:   int32(attempt.DurationMs),
+			CreatedAt:    attempt.CreatedAt,
+		})
+	}
+
+	return &model.APIResponseMerchantWebhookDeliveryAttempts{
+		Status:  status,
+		Message: message,
+		Data:    data,
+	}
+}
+
+func (s *merchantWebhookResponse) mapResponseMerchantWebhookEndpoint(endpoint *response.MerchantWebhookEndpointResponse) *model.MerchantWebhookEndpointResponse {
+	return &model.MerchantWebhookEndpointResponse{
+		ID:         int32(endpoint.ID),
+		MerchantID: int32(endpoint.MerchantID),
+		URL:        endpoint.URL,
+		Secret:     endpoint.Secret,
+		Events:     endpoint.Events,
+		IsActive:   endpoint.IsActive,
+		CreatedAt:  endpoint.CreatedAt,
+		UpdatedAt:  endpoint.UpdatedAt,
+	}
+}
+
+func (s *merchantWebhookResponse) mapResponseMerchantWebhookDelivery(delivery *response.MerchantWebhookDeliveryResponse) *model.MerchantWebhookDeliveryResponse {
+	return &model.MerchantWebhookDeliveryResponse{
+		ID:               int32(delivery.ID),
+		EndpointID:       int32(delivery.EndpointID),
+		EventID:          delivery.EventID,
+		EventType:        delivery.EventType,
+		Payload:          delivery.Payload,
+		Status:           delivery.Status,
+		Attempts:         int32(delivery.Attempts),
+		NextAttemptAt:    delivery.NextAttemptAt,
+		LastResponseCode: mapWebhookResponseCode(delivery.LastResponseCode),
+		LastError:        delivery.LastError,
+		DeliveredAt:      delivery.DeliveredAt,
+		CreatedAt:        delivery.CreatedAt,
+		UpdatedAt:        delivery.UpdatedAt,
+	}
+}
+
+func mapWebhookResponseCode(code *int) *int32 {
+	if code == nil {
+		return nil
+	}
+
+	value := int32(*code)
+	return &value
+}
@@ -0,0 +1,57 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type providerWebhookResponse struct {
+}
+
+func NewProviderWebhookResponseMapper() *providerWebhookResponse {
+	return &providerWebhookResponse{}
+}
+
+func (s *providerWebhookResponse) ToGraphqlResponseProviderWebhookEvent(status, message string, event *response.ProviderWebhookEventResponse) *model.APIResponseProviderWebhookEvent {
+	return &model.APIResponseProviderWebhookEvent{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponseProviderWebhookEvent(event),
+	}
+}
+
+func (s *providerWebhookResponse) ToGraphqlResponsePaginationProviderWebhookEvent(status, message string, events []*response.ProviderWebhookEventResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationProviderWebhookEvent {
+	return &model.APIResponsePaginationProviderWebhookEvent{
+		Status:     status,
+		Message:    message,
+		Data:       s.mapResponsesProviderWebhookEvent(events),
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (s *providerWebhookResponse) mapResponseProviderWebhookEvent(event *response.ProviderWebhookEventResponse) *model.ProviderWebhookEventResponse {
+	return &model.ProviderWebhookEventResponse{
+		ID:          int32(event.ID),
+		Provider:    event.Provider,
+		EventID:     event.EventID,
+		EventType:   event.EventType,
+		Payload:     event.Payload,
+		SentAt:      event.SentAt,
+		Status:      event.Status,
+		Attempts:    int32(event.Attempts),
+		LastError:   event.LastError,
+		ProcessedAt: event.ProcessedAt,
+		CreatedAt:   event.CreatedAt,
+		UpdatedAt:   event.UpdatedAt,
+	}
+}
+
+func (s *providerWebhookResponse) mapResponsesProviderWebhookEvent(events []*response.ProviderWebhookEventResponse) []*model.ProviderWebhookEventResponse {
+	var responses []*model.ProviderWebhookEventResponse
+
+	for _, event := range events {
+		responses = append(responses, s.mapResponseProviderWebhookEvent(event))
+	}
+
+	return responses
+}
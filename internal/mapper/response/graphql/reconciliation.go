@@ -0,0 +1,89 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type reconciliationResponse struct {
+}
+
+func NewReconciliationResponseMapper() *reconciliationResponse {
+	return &reconciliationResponse{}
+}
+
+func (s *reconciliationResponse) ToGraphqlResponsePaginationReconciliationRun(status, message string, runs []*response.ReconciliationRunResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationReconciliationRun {
+	var data []*model.ReconciliationRunResponse
+
+	for _, run := range runs {
+		data = append(data, &model.ReconciliationRunResponse{
+			ID:               int32(run.ID),
+			CardsChecked:     int32(run.CardsChecked),
+			DiscrepancyCount: int32(run.DiscrepancyCount),
+			StartedAt:        run.StartedAt,
+			FinishedAt:       run.FinishedAt,
+		})
+	}
+
+	return &model.APIResponsePaginationReconciliationRun{
+		Status:     status,
+		Message:    message,
+		Data:       data,
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (s *reconciliationResponse) ToGraphqlResponseBalanceDiscrepancy(status, message string, discrepancy *response.BalanceDiscrepancyResponse) *model.APIResponseBalanceDiscrepancy {
+	return &model.APIResponseBalanceDiscrepancy{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponseBalanceDiscrepancy(discrepancy),
+	}
+}
+
+func (s *reconciliationResponse) ToGraphqlResponsePaginationBalanceDiscrepancy(status, message string, discrepancies []*response.BalanceDiscrepancyResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationBalanceDiscrepancy {
+	var data []*model.BalanceDiscrepancyResponse
+
+	for _, discrepancy := range discrepancies {
+		data = append(data, s.mapResponseBalanceDiscrepancy(discrepancy))
+	}
+
+	return &model.APIResponsePaginationBalanceDiscrepancy{
+		Status:     status,
+		Message:    message,
+		Data:       data,
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (s *reconciliationResponse) mapResponseBalanceDiscrepancy(discrepancy *response.BalanceDiscrepancyResponse) *model.BalanceDiscrepancyResponse {
+	var acknowledgedBy *int32
+	if discrepancy.AcknowledgedBy != nil {
+		id := int32(*discrepancy.AcknowledgedBy)
+		acknowledgedBy = &id
+	}
+
+	return &model.BalanceDiscrepancyResponse{
+		ID:                  int32(discrepancy.ID),
+		CardNumber:          discrepancy.CardNumber,
+		ReconciliationRunID: int32(discrepancy.ReconciliationRunID),
+		SaldoBalance:        int32(discrepancy.SaldoBalance),
+		ExpectedBalance:     int32(discrepancy.ExpectedBalance),
+		LedgerBalance:       int32(discrepancy.LedgerBalance),
+		Difference:          int32(discrepancy.Difference),
+		TopupAmount:         int32(discrepancy.TopupAmount),
+		WithdrawAmount:      int32(discrepancy.WithdrawAmount),
+		TransferOutAmount:   int32(discrepancy.TransferOutAmount),
+		TransferInAmount:    int32(discrepancy.TransferInAmount),
+		PaymentAmount:       int32(discrepancy.PaymentAmount),
+		SettlementAmount:    int32(discrepancy.SettlementAmount),
+		OtherAmount:         int32(discrepancy.OtherAmount),
+		Status:              discrepancy.Status,
+		AcknowledgedBy:      acknowledgedBy,
+		AcknowledgedAt:      discrepancy.AcknowledgedAt,
+		Note:                discrepancy.Note,
+		ResolvedAt:          discrepancy.ResolvedAt,
+		CreatedAt:           discrepancy.CreatedAt,
+		UpdatedAt:           discrepancy.UpdatedAt,
+	}
+}
@@ -0,0 +1,64 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type refundResponse struct {
+}
+
+func NewRefundResponseMapper() *refundResponse {
+	return &refundResponse{}
+}
+
+func (r *refundResponse) ToGraphqlResponseRefund(status, message string, refund *response.RefundResponse) *model.APIResponseRefund {
+	return &model.APIResponseRefund{
+		Status:  status,
+		Message: message,
+		Data:    r.mapResponseRefund(refund),
+	}
+}
+
+func (r *refundResponse) ToGraphqlResponsesRefund(status, message string, refunds []*response.RefundResponse) *model.APIResponsesRefund {
+	return &model.APIResponsesRefund{
+		Status:  status,
+		Message: message,
+		Data:    r.mapResponsesRefund(refunds),
+	}
+}
+
+func (r *refundResponse) ToGraphqlResponsePaginationRefund(status, message string, refunds []*response.RefundResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationRefund {
+	return &model.APIResponsePaginationRefund{
+		Status:     status,
+		Message:    message,
+		Data:       r.mapResponsesRefund(refunds),
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (r *refundResponse) mapResponseRefund(refund *response.RefundResponse) *model.RefundResponse {
+	return &model.RefundResponse{
+		ID:            int32(refund.ID),
+		RefundNo:      refund.RefundNo,
+		TransactionID: int32(refund.TransactionID),
+		CardNumber:    refund.CardNumber,
+		MerchantID:    int32(refund.MerchantID),
+		Amount:        int32(refund.Amount),
+		Currency:      refund.Currency,
+		Reason:        refund.Reason,
+		Status:        refund.Status,
+		CreatedAt:     refund.CreatedAt,
+		UpdatedAt:     refund.UpdatedAt,
+	}
+}
+
+func (r *refundResponse) mapResponsesRefund(refunds []*response.RefundResponse) []*model.RefundResponse {
+	var responseRefunds []*model.RefundResponse
+
+	for _, refund := range refunds {
+		responseRefunds = append(responseRefunds, r.mapResponseRefund(refund))
+	}
+
+	return responseRefunds
+}
@@ -103,13 +103,16 @@ func (s *saldoResponse) mapResponseSaldo(saldo *response.SaldoResponse) *model.S
 	withdrawAmount := int32(saldo.WithdrawAmount)
 
 	return &model.SaldoResponse{
-		ID:             int32(saldo.ID),
-		CardNumber:     saldo.CardNumber,
-		TotalBalance:   int32(saldo.TotalBalance),
-		WithdrawTime:   &saldo.WithdrawTime,
-		WithdrawAmount: &withdrawAmount,
-		CreatedAt:      saldo.CreatedAt,
-		UpdatedAt:      saldo.UpdatedAt,
+		ID:               int32(saldo.ID),
+		CardNumber:       saldo.CardNumber,
+		TotalBalance:     int32(saldo.TotalBalance),
+		ReservedBalance:  int32(saldo.ReservedBalance),
+		Currency:         saldo.Currency,
+		AvailableBalance: int32(saldo.AvailableBalance),
+		WithdrawTime:     &saldo.WithdrawTime,
+		WithdrawAmount:   &withdrawAmount,
+		CreatedAt:        saldo.CreatedAt,
+		UpdatedAt:        saldo.UpdatedAt,
 	}
 }
 
@@ -127,13 +130,16 @@ func (s *saldoResponse) mapResponseSaldoDeleteAt(saldo *response.SaldoResponseDe
 	withdrawAmount := int32(saldo.WithdrawAmount)
 
 	return &model.SaldoResponseDeleteAt{
-		ID:             int32(saldo.ID),
-		CardNumber:     saldo.CardNumber,
-		TotalBalance:   int32(saldo.TotalBalance),
-		WithdrawTime:   &saldo.WithdrawTime,
-		WithdrawAmount: &withdrawAmount,
-		CreatedAt:      saldo.CreatedAt,
-		UpdatedAt:      saldo.UpdatedAt,
+		ID:               int32(saldo.ID),
+		CardNumber:       saldo.CardNumber,
+		TotalBalance:     int32(saldo.TotalBalance),
+		ReservedBalance:  int32(saldo.ReservedBalance),
+		Currency:         saldo.Currency,
+		AvailableBalance: int32(saldo.AvailableBalance),
+		WithdrawTime:     &saldo.WithdrawTime,
+		WithdrawAmount:   &withdrawAmount,
+		CreatedAt:        saldo.CreatedAt,
+		UpdatedAt:        saldo.UpdatedAt,
 	}
 }
 
@@ -157,6 +163,7 @@ func (s *saldoResponse) mapSaldoMonthTotalBalanceResponse(saldo *response.SaldoM
 	return &model.SaldoMonthTotalBalanceResponse{
 		Month:        saldo.Month,
 		Year:         saldo.Year,
+		Currency:     saldo.Currency,
 		TotalBalance: int32(totalBalance),
 	}
 }
@@ -180,6 +187,7 @@ func (s *saldoResponse) mapSaldoYearTotalBalanceResponse(saldo *response.SaldoYe
 
 	return &model.SaldoYearTotalBalanceResponse{
 		Year:         saldo.Year,
+		Currency:     saldo.Currency,
 		TotalBalance: int32(totalBalance),
 	}
 }
@@ -203,6 +211,7 @@ func (s *saldoResponse) mapSaldoMonthBalanceResponse(saldo *response.SaldoMonthB
 
 	return &model.SaldoMonthBalanceResponse{
 		Month:        saldo.Month,
+		Currency:     saldo.Currency,
 		TotalBalance: int32(totalBalance),
 	}
 }
@@ -226,6 +235,7 @@ func (s *saldoResponse) mapSaldoYearBalanceResponse(saldo *response.SaldoYearBal
 
 	return &model.SaldoYearBalanceResponse{
 		Year:         saldo.Year,
+		Currency:     saldo.Currency,
 		TotalBalance: int32(totalBalance),
 	}
 }
@@ -239,3 +249,60 @@ func (s *saldoResponse) mapSaldoYearBalanceResponses(saldos []*response.SaldoYea
 
 	return responsesSaldo
 }
+
+func (s *saldoResponse) ToGraphqlResponseSaldoHold(status, message string, hold *response.SaldoHoldResponse) *model.APIResponseSaldoHold {
+	return &model.APIResponseSaldoHold{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponseSaldoHold(hold),
+	}
+}
+
+func (s *saldoResponse) ToGraphqlResponsesSaldoHold(status, message string, holds []*response.SaldoHoldResponse) *model.APIResponsesSaldoHold {
+	return &model.APIResponsesSaldoHold{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponsesSaldoHold(holds),
+	}
+}
+
+func (s *saldoResponse) mapResponseSaldoHold(hold *response.SaldoHoldResponse) *model.SaldoHoldResponse {
+	var referenceID *int32
+	if hold.ReferenceID != nil {
+		id := int32(*hold.ReferenceID)
+		referenceID = &id
+	}
+
+	var createdBy *int32
+	if hold.CreatedBy != nil {
+		userID := int32(*hold.CreatedBy)
+		createdBy = &userID
+	}
+
+	return &model.SaldoHoldResponse{
+		ID:            int32(hold.ID),
+		HoldNo:        hold.HoldNo,
+		CardNumber:    hold.CardNumber,
+		Amount:        int32(hold.Amount),
+		Currency:      hold.Currency,
+		Reason:        hold.Reason,
+		ReferenceType: hold.ReferenceType,
+		ReferenceID:   referenceID,
+		Status:        hold.Status,
+		CreatedBy:     createdBy,
+		ReleasedAt:    hold.ReleasedAt,
+		ConsumedAt:    hold.ConsumedAt,
+		CreatedAt:     hold.CreatedAt,
+		UpdatedAt:     hold.UpdatedAt,
+	}
+}
+
+func (s *saldoResponse) mapResponsesSaldoHold(holds []*response.SaldoHoldResponse) []*model.SaldoHoldResponse {
+	var responseHolds []*model.SaldoHoldResponse
+
+	for _, hold := range holds {
+		responseHolds = append(responseHolds, s.mapResponseSaldoHold(hold))
+	}
+
+	return responseHolds
+}
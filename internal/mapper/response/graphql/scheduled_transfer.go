@@ -0,0 +1,94 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type scheduledTransferResponse struct {
+}
+
+func NewScheduledTransferResponseMapper() *scheduledTransferResponse {
+	return &scheduledTransferResponse{}
+}
+
+func (s *scheduledTransferResponse) ToGraphqlResponseScheduledTransfer(status, message string, schedule *response.ScheduledTransferResponse) *model.APIResponseScheduledTransfer {
+	return &model.APIResponseScheduledTransfer{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponseScheduledTransfer(schedule),
+	}
+}
+
+func (s *scheduledTransferResponse) ToGraphqlResponsePaginationScheduledTransfer(status, message string, schedules []*response.ScheduledTransferResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationScheduledTransfer {
+	return &model.APIResponsePaginationScheduledTransfer{
+		Status:     status,
+		Message:    message,
+		Data:       s.mapResponsesScheduledTransfer(schedules),
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (s *scheduledTransferResponse) ToGraphqlResponsePaginationScheduledTransferRun(status, message string, runs []*response.ScheduledTransferRunResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationScheduledTransferRun {
+	data := make([]*model.ScheduledTransferRunResponse, 0, len(runs))
+
+	for _, run := range runs {
+		var transferID *int32
+		if run.TransferID != nil {
+			id := int32(*run.TransferID)
+			transferID = &id
+		}
+
+		data = append(data, &model.ScheduledTransferRunResponse{
+			ID:                  int32(run.ID),
+			ScheduledTransferID: int32(run.ScheduledTransferID),
+			ScheduledFor:        run.ScheduledFor,
+			Status:              run.Status,
+			TransferID:          transferID,
+			FailureReason:       run.FailureReason,
+			CreatedAt:           run.CreatedAt,
+			UpdatedAt:           run.UpdatedAt,
+		})
+	}
+
+	return &model.APIResponsePaginationScheduledTransferRun{
+		Status:     status,
+		Message:    message,
+		Data:       data,
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (s *scheduledTransferResponse) mapResponseScheduledTransfer(schedule *response.ScheduledTransferResponse) *model.ScheduledTransferResponse {
+	var dayOfMonth *int32
+	if schedule.DayOfMonth != nil {
+		day := int32(*schedule.DayOfMonth)
+		dayOfMonth = &day
+	}
+
+	return &model.ScheduledTransferResponse{
+		ID:             int32(schedule.ID),
+		UserID:         int32(schedule.UserID),
+		TransferFrom:   schedule.TransferFrom,
+		TransferTo:     schedule.TransferTo,
+		TransferAmount: int32(schedule.TransferAmount),
+		Recurrence:     schedule.Recurrence,
+		DayOfMonth:     dayOfMonth,
+		NextRunAt:      schedule.NextRunAt,
+		EndAt:          schedule.EndAt,
+		Status:         schedule.Status,
+		LastRunAt:      schedule.LastRunAt,
+		CreatedAt:      schedule.CreatedAt,
+		UpdatedAt:      schedule.UpdatedAt,
+	}
+}
+
+func (s *scheduledTransferResponse) mapResponsesScheduledTransfer(schedules []*response.ScheduledTransferResponse) []*model.ScheduledTransferResponse {
+	var responses []*model.ScheduledTransferResponse
+
+	for _, schedule := range schedules {
+		responses = append(responses, s.mapResponseScheduledTransfer(schedule))
+	}
+
+	return responses
+}
@@ -0,0 +1,115 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type settlementResponse struct {
+}
+
+func NewSettlementResponseMapper() *settlementResponse {
+	return &settlementResponse{}
+}
+
+func (s *settlementResponse) ToGraphqlResponseMerchantBalance(status, message string, balance *response.MerchantBalanceResponse) *model.APIResponseMerchantBalance {
+	return &model.APIResponseMerchantBalance{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponseMerchantBalance(balance),
+	}
+}
+
+func (s *settlementResponse) ToGraphqlResponseMerchantBalances(status, message string, balances []*response.MerchantBalanceResponse) *model.APIResponseMerchantBalances {
+	var data []*model.MerchantBalanceResponse
+
+	for _, balance := range balances {
+		data = append(data, s.mapResponseMerchantBalance(balance))
+	}
+
+	return &model.APIResponseMerchantBalances{
+		Status:  status,
+		Message: message,
+		Data:    data,
+	}
+}
+
+func (s *settlementResponse) ToGraphqlResponseSettlementBatch(status, message string, batch *response.SettlementBatchResponse) *model.APIResponseSettlementBatch {
+	return &model.APIResponseSettlementBatch{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponseSettlementBatch(batch),
+	}
+}
+
+func (s *settlementResponse) ToGraphqlResponsePaginationSettlementBatch(status, message string, batches []*response.SettlementBatchResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationSettlementBatch {
+	var data []*model.SettlementBatchResponse
+
+	for _, batch := range batches {
+		data = append(data, s.mapResponseSettlementBatch(batch))
+	}
+
+	return &model.APIResponsePaginationSettlementBatch{
+		Status:     status,
+		Message:    message,
+		Data:       data,
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (s *settlementResponse) ToGraphqlResponseSettlementItems(status, message string, items []*response.SettlementItemResponse) *model.APIResponseSettlementItems {
+	var data []*model.SettlementItemResponse
+
+	for _, item := range items {
+		var batchID *int32
+		if item.SettlementBatchID != nil {
+			id := int32(*item.SettlementBatchID)
+			batchID = &id
+		}
+
+		data = append(data, &model.SettlementItemResponse{
+			ID:                int32(item.ID),
+			SettlementBatchID: batchID,
+			ItemType:          item.ItemType,
+			ReferenceID:       int32(item.ReferenceID),
+			Amount:            int32(item.Amount),
+			Fee:               int32(item.Fee),
+			NetAmount:         int32(item.NetAmount),
+			Currency:          item.Currency,
+			CreatedAt:         item.CreatedAt,
+		})
+	}
+
+	return &model.APIResponseSettlementItems{
+		Status:  status,
+		Message: message,
+		Data:    data,
+	}
+}
+
+func (s *settlementResponse) mapResponseMerchantBalance(balance *response.MerchantBalanceResponse) *model.MerchantBalanceResponse {
+	return &model.MerchantBalanceResponse{
+		MerchantID:           int32(balance.MerchantID),
+		Currency:             balance.Currency,
+		PendingBalance:       int32(balance.PendingBalance),
+		SettlementCardNumber: balance.SettlementCardNumber,
+		UpdatedAt:            balance.UpdatedAt,
+	}
+}
+
+func (s *settlementResponse) mapResponseSettlementBatch(batch *response.SettlementBatchResponse) *model.SettlementBatchResponse {
+	return &model.SettlementBatchResponse{
+		ID:                   int32(batch.ID),
+		MerchantID:           int32(batch.MerchantID),
+		Currency:             batch.Currency,
+		SettlementCardNumber: batch.SettlementCardNumber,
+		CutoffAt:             batch.CutoffAt,
+		GrossAmount:          int32(batch.GrossAmount),
+		FeeAmount:            int32(batch.FeeAmount),
+		RefundAmount:         int32(batch.RefundAmount),
+		AdjustmentAmount:     int32(batch.AdjustmentAmount),
+		NetAmount:            int32(batch.NetAmount),
+		ItemCount:            int32(batch.ItemCount),
+		CreatedAt:            batch.CreatedAt,
+	}
+}
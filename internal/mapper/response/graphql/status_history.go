@@ -0,0 +1,50 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type statusHistoryResponse struct {
+}
+
+func NewStatusHistoryResponseMapper() *statusHistoryResponse {
+	return &statusHistoryResponse{}
+}
+
+func (s *statusHistoryResponse) ToGraphqlResponseStatusHistory(status, message string, histories []*response.StatusHistoryResponse) *model.APIResponseStatusHistory {
+	return &model.APIResponseStatusHistory{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponsesStatusHistory(histories),
+	}
+}
+
+func (s *statusHistoryResponse) mapResponseStatusHistory(history *response.StatusHistoryResponse) *model.StatusHistoryResponse {
+	var changedBy *int32
+	if history.ChangedBy != nil {
+		id := int32(*history.ChangedBy)
+		changedBy = &id
+	}
+
+	return &model.StatusHistoryResponse{
+		ID:         int32(history.ID),
+		EntityType: history.EntityType,
+		EntityID:   int32(history.EntityID),
+		FromStatus: history.FromStatus,
+		ToStatus:   history.ToStatus,
+		Reason:     history.Reason,
+		ChangedBy:  changedBy,
+		CreatedAt:  history.CreatedAt,
+	}
+}
+
+func (s *statusHistoryResponse) mapResponsesStatusHistory(histories []*response.StatusHistoryResponse) []*model.StatusHistoryResponse {
+	var responses []*model.StatusHistoryResponse
+
+	for _, history := range histories {
+		responses = append(responses, s.mapResponseStatusHistory(history))
+	}
+
+	return responses
+}
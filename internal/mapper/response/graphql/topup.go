@@ -130,8 +130,11 @@ func (t *topupResponseMapper) mapResponseTopup(topup *response.TopupResponse) *m
 		CardNumber:  topup.CardNumber,
 		TopupNo:     topup.TopupNo,
 		TopupAmount: int32(topup.TopupAmount),
+		Currency:    topup.Currency,
+		Fee:         int32(topup.Fee),
 		TopupMethod: topup.TopupMethod,
 		TopupTime:   &topup.TopupTime,
+		Status:      topup.Status,
 		CreatedAt:   topup.CreatedAt,
 		UpdatedAt:   topup.UpdatedAt,
 	}
@@ -153,8 +156,11 @@ func (t *topupResponseMapper) mapResponseTopupDeleteAt(topup *response.TopupResp
 		CardNumber:  topup.CardNumber,
 		TopupNo:     topup.TopupNo,
 		TopupAmount: int32(topup.TopupAmount),
+		Currency:    topup.Currency,
+		Fee:         int32(topup.Fee),
 		TopupMethod: topup.TopupMethod,
 		TopupTime:   &topup.TopupTime,
+		Status:      topup.Status,
 		CreatedAt:   topup.CreatedAt,
 		UpdatedAt:   topup.UpdatedAt,
 		DeletedAt:   topup.DeletedAt,
@@ -286,6 +292,7 @@ func (s *topupResponseMapper) mapResponsesTopupYearlyMethod(topups []*response.T
 func (t *topupResponseMapper) mapResponseTopupMonthlyAmount(s *response.TopupMonthAmountResponse) *model.TopupMonthAmountResponse {
 	return &model.TopupMonthAmountResponse{
 		Month:       s.Month,
+		Currency:    s.Currency,
 		TotalAmount: int32(s.TotalAmount),
 	}
 }
@@ -303,6 +310,7 @@ func (s *topupResponseMapper) mapResponsesTopupMonthlyAmount(topups []*response.
 func (t *topupResponseMapper) mapResponseTopupYearlyAmount(s *response.TopupYearlyAmountResponse) *model.TopupYearAmountResponse {
 	return &model.TopupYearAmountResponse{
 		Year:        s.Year,
+		Currency:    s.Currency,
 		TotalAmount: int32(s.TotalAmount),
 	}
 }
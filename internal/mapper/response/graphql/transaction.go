@@ -138,9 +138,13 @@ func (t *transactionResponseMapper) mapResponseTransaction(transaction *response
 		TransactionNo:   transaction.TransactionNo,
 		CardNumber:      transaction.CardNumber,
 		Amount:          int32(transaction.Amount),
+		Currency:        transaction.Currency,
+		Fee:             int32(transaction.Fee),
 		PaymentMethod:   transaction.PaymentMethod,
 		TransactionTime: transaction.TransactionTime,
 		MerchantID:      int32(transaction.MerchantID),
+		Status:          transaction.Status,
+		RefundedAmount:  int32(transaction.RefundedAmount),
 		CreatedAt:       transaction.CreatedAt,
 		UpdatedAt:       transaction.UpdatedAt,
 	}
@@ -162,6 +166,8 @@ func (t *transactionResponseMapper) mapResponseTransactionDeleteAt(transaction *
 		TransactionNo:   transaction.TransactionNo,
 		CardNumber:      transaction.CardNumber,
 		Amount:          int32(transaction.Amount),
+		Currency:        transaction.Currency,
+		Fee:             int32(transaction.Fee),
 		PaymentMethod:   transaction.PaymentMethod,
 		TransactionTime: transaction.TransactionTime,
 		MerchantID:      int32(transaction.MerchantID),
@@ -296,6 +302,7 @@ func (s *transactionResponseMapper) mapResponsesTransactionYearlyMethod(Transact
 func (t *transactionResponseMapper) mapResponseTransactionMonthlyAmount(s *response.TransactionMonthAmountResponse) *model.TransactionMonthAmountResponse {
 	return &model.TransactionMonthAmountResponse{
 		Month:       s.Month,
+		Currency:    s.Currency,
 		TotalAmount: int32(s.TotalAmount),
 	}
 }
@@ -313,6 +320,7 @@ func (s *transactionResponseMapper) mapResponsesTransactionMonthlyAmount(Transac
 func (t *transactionResponseMapper) mapResponseTransactionYearlyAmount(s *response.TransactionYearlyAmountResponse) *model.TransactionYearlyAmountResponse {
 	return &model.TransactionYearlyAmountResponse{
 		Year:        s.Year,
+		Currency:    s.Currency,
 		TotalAmount: int32(s.TotalAmount),
 	}
 }
@@ -326,3 +334,26 @@ func (s *transactionResponseMapper) mapResponsesTransactionYearlyAmount(Transact
 
 	return responses
 }
+
+func (t *transactionResponseMapper) ToGraphqlResponseMerchantQr(status, message string, data *response.MerchantQrResponse) *model.APIResponseMerchantQR {
+	var amount *int32
+	if data.Amount != nil {
+		a := int32(*data.Amount)
+		amount = &a
+	}
+
+	return &model.APIResponseMerchantQR{
+		Status:  status,
+		Message: message,
+		Data: &model.MerchantQRResponse{
+			MerchantID: int32(data.MerchantID),
+			Dynamic:    data.Dynamic,
+			Amount:     amount,
+			Currency:   data.Currency,
+			Reference:  data.Reference,
+			Payload:    data.Payload,
+			Png:        data.Png,
+			SVG:        data.Svg,
+		},
+	}
+}
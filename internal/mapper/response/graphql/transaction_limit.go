@@ -0,0 +1,92 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type transactionLimitResponse struct {
+}
+
+func NewTransactionLimitResponseMapper() *transactionLimitResponse {
+	return &transactionLimitResponse{}
+}
+
+func (t *transactionLimitResponse) ToGraphqlResponseTransactionLimit(status, message string, limit *response.TransactionLimitResponse) *model.APIResponseTransactionLimit {
+	return &model.APIResponseTransactionLimit{
+		Status:  status,
+		Message: message,
+		Data:    t.mapResponseTransactionLimit(limit),
+	}
+}
+
+func (t *transactionLimitResponse) ToGraphqlResponsePaginationTransactionLimit(status, message string, limits []*response.TransactionLimitResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationTransactionLimit {
+	return &model.APIResponsePaginationTransactionLimit{
+		Status:     status,
+		Message:    message,
+		Data:       t.mapResponsesTransactionLimit(limits),
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (t *transactionLimitResponse) ToGraphqlResponseTransactionLimitHeadroom(status, message string, headrooms []*response.TransactionLimitHeadroomResponse) *model.APIResponseTransactionLimitHeadroom {
+	data := make([]*model.TransactionLimitHeadroomResponse, 0, len(headrooms))
+
+	for _, headroom := range headrooms {
+		data = append(data, &model.TransactionLimitHeadroomResponse{
+			LimitID:                 int32(headroom.LimitID),
+			Name:                    headroom.Name,
+			OperationType:           headroom.OperationType,
+			Period:                  headroom.Period,
+			MaxAmountPerTransaction: mapLimitCap(headroom.MaxAmountPerTransaction),
+			UsedCount:               int32(headroom.UsedCount),
+			RemainingCount:          mapLimitCap(headroom.RemainingCount),
+			UsedAmount:              int32(headroom.UsedAmount),
+			RemainingAmount:         mapLimitCap(headroom.RemainingAmount),
+			ResetsAt:                headroom.ResetsAt,
+		})
+	}
+
+	return &model.APIResponseTransactionLimitHeadroom{
+		Status:  status,
+		Message: message,
+		Data:    data,
+	}
+}
+
+func (t *transactionLimitResponse) mapResponseTransactionLimit(limit *response.TransactionLimitResponse) *model.TransactionLimitResponse {
+	return &model.TransactionLimitResponse{
+		ID:                      int32(limit.ID),
+		Name:                    limit.Name,
+		OperationType:           limit.OperationType,
+		Period:                  limit.Period,
+		CardType:                limit.CardType,
+		UserTier:                limit.UserTier,
+		Currency:                limit.Currency,
+		MaxAmountPerTransaction: mapLimitCap(limit.MaxAmountPerTransaction),
+		MaxCount:                mapLimitCap(limit.MaxCount),
+		MaxTotalAmount:          mapLimitCap(limit.MaxTotalAmount),
+		IsActive:                limit.IsActive,
+		CreatedAt:               limit.CreatedAt,
+		UpdatedAt:               limit.UpdatedAt,
+	}
+}
+
+func (t *transactionLimitResponse) mapResponsesTransactionLimit(limits []*response.TransactionLimitResponse) []*model.TransactionLimitResponse {
+	var responses []*model.TransactionLimitResponse
+
+	for _, limit := range limits {
+		responses = append(responses, t.mapResponseTransactionLimit(limit))
+	}
+
+	return responses
+}
+
+func mapLimitCap(limitCap *int) *int32 {
+	if limitCap == nil {
+		return nil
+	}
+
+	value := int32(*limitCap)
+	return &value
+}
@@ -117,15 +117,27 @@ func (t *transferResponseMapper) ToGraphqlResponseTransferYearStatusFailed(statu
 }
 
 func (t *transferResponseMapper) mapResponseTransfer(transfer *response.TransferResponse) *model.TransferResponse {
+	var spreadBps *int32
+	if transfer.SpreadBps != nil {
+		bps := int32(*transfer.SpreadBps)
+		spreadBps = &bps
+	}
+
 	return &model.TransferResponse{
-		ID:             int32(transfer.ID),
-		TransferNo:     transfer.TransferNo,
-		TransferFrom:   transfer.TransferFrom,
-		TransferTo:     transfer.TransferTo,
-		TransferAmount: int32(transfer.TransferAmount),
-		TransferTime:   transfer.TransferTime,
-		CreatedAt:      transfer.CreatedAt,
-		UpdatedAt:      transfer.UpdatedAt,
+		ID:              int32(transfer.ID),
+		TransferNo:      transfer.TransferNo,
+		TransferFrom:    transfer.TransferFrom,
+		TransferTo:      transfer.TransferTo,
+		TransferAmount:  int32(transfer.TransferAmount),
+		Currency:        transfer.Currency,
+		Fee:             int32(transfer.Fee),
+		ToCurrency:      transfer.ToCurrency,
+		ConvertedAmount: int32(transfer.ConvertedAmount),
+		ExchangeRate:    transfer.ExchangeRate,
+		SpreadBps:       spreadBps,
+		TransferTime:    transfer.TransferTime,
+		CreatedAt:       transfer.CreatedAt,
+		UpdatedAt:       transfer.UpdatedAt,
 	}
 }
 
@@ -140,16 +152,28 @@ func (t *transferResponseMapper) mapResponsesTransfer(transfers []*response.Tran
 }
 
 func (t *transferResponseMapper) mapResponseTransferDeleteAt(transfer *response.TransferResponseDeleteAt) *model.TransferResponseDeleteAt {
+	var spreadBps *int32
+	if transfer.SpreadBps != nil {
+		bps := int32(*transfer.SpreadBps)
+		spreadBps = &bps
+	}
+
 	return &model.TransferResponseDeleteAt{
-		ID:             int32(transfer.ID),
-		TransferNo:     transfer.TransferNo,
-		TransferFrom:   transfer.TransferFrom,
-		TransferTo:     transfer.TransferTo,
-		TransferAmount: int32(transfer.TransferAmount),
-		TransferTime:   transfer.TransferTime,
-		CreatedAt:      transfer.CreatedAt,
-		UpdatedAt:      transfer.UpdatedAt,
-		DeletedAt:      transfer.DeletedAt,
+		ID:              int32(transfer.ID),
+		TransferNo:      transfer.TransferNo,
+		TransferFrom:    transfer.TransferFrom,
+		TransferTo:      transfer.TransferTo,
+		TransferAmount:  int32(transfer.TransferAmount),
+		Currency:        transfer.Currency,
+		Fee:             int32(transfer.Fee),
+		ToCurrency:      transfer.ToCurrency,
+		ConvertedAmount: int32(transfer.ConvertedAmount),
+		ExchangeRate:    transfer.ExchangeRate,
+		SpreadBps:       spreadBps,
+		TransferTime:    transfer.TransferTime,
+		CreatedAt:       transfer.CreatedAt,
+		UpdatedAt:       transfer.UpdatedAt,
+		DeletedAt:       transfer.DeletedAt,
 	}
 }
 
@@ -240,6 +264,7 @@ func (t *transferResponseMapper) mapResponsesYearStatusFailed(transfers []*respo
 func (t *transferResponseMapper) mapResponseTransferMonthAmount(transfer *response.TransferMonthAmountResponse) *model.TransferMonthAmountResponse {
 	return &model.TransferMonthAmountResponse{
 		Month:       transfer.Month,
+		Currency:    transfer.Currency,
 		TotalAmount: int32(transfer.TotalAmount),
 	}
 }
@@ -257,6 +282,7 @@ func (t *transferResponseMapper) mapResponsesTransferMonthAmount(transfers []*re
 func (t *transferResponseMapper) mapResponseTransferYearAmount(transfer *response.TransferYearAmountResponse) *model.TransferYearAmountResponse {
 	return &model.TransferYearAmountResponse{
 		Year:        transfer.Year,
+		Currency:    transfer.Currency,
 		TotalAmount: int32(transfer.TotalAmount),
 	}
 }
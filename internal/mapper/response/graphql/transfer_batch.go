@@ -0,0 +1,107 @@
+package graphql
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/graph/model"
+)
+
+type transferBatchResponse struct {
+}
+
+func NewTransferBatchResponseMapper() *transferBatchResponse {
+	return &transferBatchResponse{}
+}
+
+func (s *transferBatchResponse) ToGraphqlResponseTransferBatch(status, message string, batch *response.TransferBatchResponse) *model.APIResponseTransferBatch {
+	return &model.APIResponseTransferBatch{
+		Status:  status,
+		Message: message,
+		Data:    s.mapResponseTransferBatch(batch),
+	}
+}
+
+func (s *transferBatchResponse) ToGraphqlResponsePaginationTransferBatch(status, message string, batches []*response.TransferBatchResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationTransferBatch {
+	return &model.APIResponsePaginationTransferBatch{
+		Status:     status,
+		Message:    message,
+		Data:       s.mapResponsesTransferBatch(batches),
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (s *transferBatchResponse) ToGraphqlResponsePaginationTransferBatchRow(status, message string, rows []*response.TransferBatchRowResponse, pagination *response.PaginationMeta) *model.APIResponsePaginationTransferBatchRow {
+	data := make([]*model.TransferBatchRowResponse, 0, len(rows))
+
+	for _, row := range rows {
+		var transferID *int32
+		if row.TransferID != nil {
+			id := int32(*row.TransferID)
+			transferID = &id
+		}
+
+		data = append(data, &model.TransferBatchRowResponse{
+			ID:              int32(row.ID),
+			TransferBatchID: int32(row.TransferBatchID),
+			RowNumber:       int32(row.RowNumber),
+			TransferTo:      row.TransferTo,
+			TransferAmount:  int32(row.TransferAmount),
+			Note:            row.Note,
+			Status:          row.Status,
+			TransferID:      transferID,
+			FailureReason:   row.FailureReason,
+			CreatedAt:       row.CreatedAt,
+			UpdatedAt:       row.UpdatedAt,
+		})
+	}
+
+	return &model.APIResponsePaginationTransferBatchRow{
+		Status:     status,
+		Message:    message,
+		Data:       data,
+		Pagination: mapPaginationMeta(pagination),
+	}
+}
+
+func (s *transferBatchResponse) ToGraphqlResponseTransferBatchReport(status, message string, report *response.TransferBatchReportResponse) *model.APIResponseTransferBatchReport {
+	return &model.APIResponseTransferBatchReport{
+		Status:  status,
+		Message: message,
+		Data: &model.TransferBatchReportResponse{
+			FileName:    report.FileName,
+			ContentType: report.ContentType,
+			Content:     report.Content,
+		},
+	}
+}
+
+func (s *transferBatchResponse) mapResponseTransferBatch(batch *response.TransferBatchResponse) *model.TransferBatchResponse {
+	return &model.TransferBatchResponse{
+		ID:              int32(batch.ID),
+		UserID:          int32(batch.UserID),
+		TransferFrom:    batch.TransferFrom,
+		FileFormat:      batch.FileFormat,
+		Status:          batch.Status,
+		TotalRows:       int32(batch.TotalRows),
+		TotalAmount:     int32(batch.TotalAmount),
+		InvalidRows:     int32(batch.InvalidRows),
+		PendingRows:     int32(batch.PendingRows),
+		SuccessRows:     int32(batch.SuccessRows),
+		FailedRows:      int32(batch.FailedRows),
+		SuccessAmount:   int32(batch.SuccessAmount),
+		ProgressPercent: int32(batch.ProgressPercent),
+		StartedAt:       batch.StartedAt,
+		CompletedAt:     batch.CompletedAt,
+		CreatedAt:       batch.CreatedAt,
+		UpdatedAt:       batch.UpdatedAt,
+	}
+}
+
+func (s *transferBatchResponse) mapResponsesTransferBatch(batches []*response.TransferBatchResponse) []*model.TransferBatchResponse {
+	var responses []*model.TransferBatchResponse
+
+	for _, batch := range batches {
+		responses = append(responses, s.mapResponseTransferBatch(batch))
+	}
+
+	return responses
+}
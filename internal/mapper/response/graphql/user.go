@@ -74,6 +74,7 @@ func (u *userResponseMapper) mapUserResponse(user *response.UserResponse) *model
 		Firstname: user.FirstName,
 		Lastname:  user.LastName,
 		Email:     user.Email,
+		Tier:      user.Tier,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}
@@ -95,6 +96,7 @@ func (u *userResponseMapper) mapUserResponseDeleteAt(user *response.UserResponse
 		Firstname: user.FirstName,
 		Lastname:  user.LastName,
 		Email:     user.Email,
+		Tier:      user.Tier,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 		DeletedAt: user.DeletedAt,
@@ -122,7 +122,10 @@ func (t *withdrawResponseMapper) mapResponseWithdraw(Withdraw *response.Withdraw
 		WithdrawNo:     Withdraw.WithdrawNo,
 		CardNumber:     Withdraw.CardNumber,
 		WithdrawAmount: int32(Withdraw.WithdrawAmount),
+		Currency:       Withdraw.Currency,
+		Fee:            int32(Withdraw.Fee),
 		WithdrawTime:   Withdraw.WithdrawTime,
+		Status:         Withdraw.Status,
 		CreatedAt:      Withdraw.CreatedAt,
 		UpdatedAt:      Withdraw.UpdatedAt,
 	}
@@ -144,7 +147,10 @@ func (t *withdrawResponseMapper) mapResponseWithdrawDeleteAt(Withdraw *response.
 		WithdrawNo:     Withdraw.WithdrawNo,
 		CardNumber:     Withdraw.CardNumber,
 		WithdrawAmount: int32(Withdraw.WithdrawAmount),
+		Currency:       Withdraw.Currency,
+		Fee:            int32(Withdraw.Fee),
 		WithdrawTime:   Withdraw.WithdrawTime,
+		Status:         Withdraw.Status,
 		CreatedAt:      Withdraw.CreatedAt,
 		UpdatedAt:      Withdraw.UpdatedAt,
 		DeletedAt:      Withdraw.DeletedAt,
@@ -238,6 +244,7 @@ func (t *withdrawResponseMapper) mapResponsesYearStatusFailed(Withdraws []*respo
 func (t *withdrawResponseMapper) mapResponseWithdrawMonthAmount(Withdraw *response.WithdrawMonthlyAmountResponse) *model.WithdrawMonthlyAmountResponse {
 	return &model.WithdrawMonthlyAmountResponse{
 		Month:       Withdraw.Month,
+		Currency:    Withdraw.Currency,
 		TotalAmount: int32(Withdraw.TotalAmount),
 	}
 }
@@ -255,6 +262,7 @@ func (t *withdrawResponseMapper) mapResponsesWithdrawMonthAmount(Withdraws []*re
 func (t *withdrawResponseMapper) mapResponseWithdrawYearAmount(Withdraw *response.WithdrawYearlyAmountResponse) *model.WithdrawYearlyAmountResponse {
 	return &model.WithdrawYearlyAmountResponse{
 		Year:        Withdraw.Year,
+		Currency:    Withdraw.Currency,
 		TotalAmount: int32(Withdraw.TotalAmount),
 	}
 }
@@ -268,3 +276,22 @@ func (t *withdrawResponseMapper) mapResponsesWithdrawYearAmount(Withdraws []*res
 
 	return responses
 }
+
+func (t *withdrawResponseMapper) ToGraphqlResponseWithdrawReview(status, message string, data *response.WithdrawReviewResponse) *model.APIResponseWithdrawReview {
+	return &model.APIResponseWithdrawReview{
+		Status:  status,
+		Message: message,
+		Data:    t.mapResponseWithdrawReview(data),
+	}
+}
+
+func (t *withdrawResponseMapper) mapResponseWithdrawReview(review *response.WithdrawReviewResponse) *model.WithdrawReviewResponse {
+	return &model.WithdrawReviewResponse{
+		ID:         int32(review.ID),
+		WithdrawID: int32(review.WithdrawID),
+		Decision:   review.Decision,
+		Note:       review.Note,
+		ReviewedBy: int32(review.ReviewedBy),
+		CreatedAt:  review.CreatedAt,
+	}
+}
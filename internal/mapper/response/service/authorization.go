@@ -0,0 +1,43 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type authorizationResponseMapper struct {
+}
+
+func NewAuthorizationResponseMapper() *authorizationResponseMapper {
+	return &authorizationResponseMapper{}
+}
+
+func (a *authorizationResponseMapper) ToAuthorizationResponse(authorization *record.AuthorizationRecord) *response.AuthorizationResponse {
+	return &response.AuthorizationResponse{
+		ID:              authorization.ID,
+		AuthorizationNo: authorization.AuthorizationNo,
+		CardNumber:      authorization.CardNumber,
+		MerchantID:      authorization.MerchantID,
+		Amount:          authorization.Amount,
+		Currency:        authorization.Currency,
+		CapturedAmount:  authorization.CapturedAmount,
+		PaymentMethod:   authorization.PaymentMethod,
+		Status:          authorization.Status,
+		TransactionID:   authorization.TransactionID,
+		ExpiresAt:       authorization.ExpiresAt,
+		CapturedAt:      authorization.CapturedAt,
+		VoidedAt:        authorization.VoidedAt,
+		CreatedAt:       authorization.CreatedAt,
+		UpdatedAt:       authorization.UpdatedAt,
+	}
+}
+
+func (a *authorizationResponseMapper) ToAuthorizationsResponse(authorizations []*record.AuthorizationRecord) []*response.AuthorizationResponse {
+	var responses []*response.AuthorizationResponse
+
+	for _, authorization := range authorizations {
+		responses = append(responses, a.ToAuthorizationResponse(authorization))
+	}
+
+	return responses
+}
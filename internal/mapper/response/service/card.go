@@ -21,6 +21,7 @@ func (s *cardResponseMapper) ToCardResponse(card *record.CardRecord) *response.C
 		ExpireDate:   card.ExpireDate,
 		CVV:          card.CVV,
 		CardProvider: card.CardProvider,
+		Currency:     card.Currency,
 		CreatedAt:    card.CreatedAt,
 		UpdatedAt:    card.UpdatedAt,
 	}
@@ -45,6 +46,7 @@ func (s *cardResponseMapper) ToCardResponseDeleteAt(card *record.CardRecord) *re
 		ExpireDate:   card.ExpireDate,
 		CVV:          card.CVV,
 		CardProvider: card.CardProvider,
+		Currency:     card.Currency,
 		CreatedAt:    card.CreatedAt,
 		UpdatedAt:    card.UpdatedAt,
 		DeletedAt:    card.DeletedAt,
@@ -64,6 +66,7 @@ func (s *cardResponseMapper) ToCardsResponseDeleteAt(cards []*record.CardRecord)
 func (s *cardResponseMapper) ToGetMonthlyBalance(card *record.CardMonthBalance) *response.CardResponseMonthBalance {
 	return &response.CardResponseMonthBalance{
 		Month:        card.Month,
+		Currency:     card.Currency,
 		TotalBalance: card.TotalBalance,
 	}
 }
@@ -81,6 +84,7 @@ func (s *cardResponseMapper) ToGetMonthlyBalances(cards []*record.CardMonthBalan
 func (s *cardResponseMapper) ToGetYearlyBalance(card *record.CardYearlyBalance) *response.CardResponseYearlyBalance {
 	return &response.CardResponseYearlyBalance{
 		Year:         card.Year,
+		Currency:     card.Currency,
 		TotalBalance: card.TotalBalance,
 	}
 }
@@ -98,6 +102,7 @@ func (s *cardResponseMapper) ToGetYearlyBalances(cards []*record.CardYearlyBalan
 func (s *cardResponseMapper) ToGetMonthlyAmount(card *record.CardMonthAmount) *response.CardResponseMonthAmount {
 	return &response.CardResponseMonthAmount{
 		Month:       card.Month,
+		Currency:    card.Currency,
 		TotalAmount: card.TotalAmount,
 	}
 }
@@ -115,6 +120,7 @@ func (s *cardResponseMapper) ToGetMonthlyAmounts(cards []*record.CardMonthAmount
 func (s *cardResponseMapper) ToGetYearlyAmount(card *record.CardYearAmount) *response.CardResponseYearAmount {
 	return &response.CardResponseYearAmount{
 		Year:        card.Year,
+		Currency:    card.Currency,
 		TotalAmount: card.TotalAmount,
 	}
 }
@@ -128,3 +134,24 @@ func (s *cardResponseMapper) ToGetYearlyAmounts(cards []*record.CardYearAmount)
 
 	return records
 }
+
+func (s *cardResponseMapper) ToDashboardCardCurrency(totals *record.CardCurrencyTotals) *response.DashboardCardCurrency {
+	return &response.DashboardCardCurrency{
+		Currency:         totals.Currency,
+		TotalBalance:     totals.TotalBalance,
+		TotalTopup:       totals.TotalTopup,
+		TotalWithdraw:    totals.TotalWithdraw,
+		TotalTransaction: totals.TotalTransaction,
+		TotalTransfer:    totals.TotalTransfer,
+	}
+}
+
+func (s *cardResponseMapper) ToDashboardCardCurrencies(totals []*record.CardCurrencyTotals) []*response.DashboardCardCurrency {
+	var records []*response.DashboardCardCurrency
+
+	for _, total := range totals {
+		records = append(records, s.ToDashboardCardCurrency(total))
+	}
+
+	return records
+}
@@ -0,0 +1,45 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type disputeResponseMapper struct {
+}
+
+func NewDisputeResponseMapper() *disputeResponseMapper {
+	return &disputeResponseMapper{}
+}
+
+func (d *disputeResponseMapper) ToDisputeResponse(dispute *record.DisputeRecord) *response.DisputeResponse {
+	return &response.DisputeResponse{
+		ID:               dispute.ID,
+		DisputeNo:        dispute.DisputeNo,
+		TransactionID:    dispute.TransactionID,
+		CardNumber:       dispute.CardNumber,
+		MerchantID:       dispute.MerchantID,
+		Amount:           dispute.Amount,
+		Currency:         dispute.Currency,
+		Reason:           dispute.Reason,
+		MerchantEvidence: dispute.MerchantEvidence,
+		ResolutionNote:   dispute.ResolutionNote,
+		Status:           dispute.Status,
+		OpenedBy:         dispute.OpenedBy,
+		ResolvedBy:       dispute.ResolvedBy,
+		RespondedAt:      dispute.RespondedAt,
+		ResolvedAt:       dispute.ResolvedAt,
+		CreatedAt:        dispute.CreatedAt,
+		UpdatedAt:        dispute.UpdatedAt,
+	}
+}
+
+func (d *disputeResponseMapper) ToDisputesResponse(disputes []*record.DisputeRecord) []*response.DisputeResponse {
+	var responses []*response.DisputeResponse
+
+	for _, dispute := range disputes {
+		responses = append(responses, d.ToDisputeResponse(dispute))
+	}
+
+	return responses
+}
@@ -0,0 +1,35 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type exchangeRateResponseMapper struct {
+}
+
+func NewExchangeRateResponseMapper() *exchangeRateResponseMapper {
+	return &exchangeRateResponseMapper{}
+}
+
+func (e *exchangeRateResponseMapper) ToExchangeRateResponse(rate *record.ExchangeRateRecord) *response.ExchangeRateResponse {
+	return &response.ExchangeRateResponse{
+		ID:            rate.ID,
+		BaseCurrency:  rate.BaseCurrency,
+		QuoteCurrency: rate.QuoteCurrency,
+		Rate:          rate.Rate,
+		SpreadBps:     rate.SpreadBps,
+		CreatedAt:     rate.CreatedAt,
+		UpdatedAt:     rate.UpdatedAt,
+	}
+}
+
+func (e *exchangeRateResponseMapper) ToExchangeRatesResponse(rates []*record.ExchangeRateRecord) []*response.ExchangeRateResponse {
+	var responses []*response.ExchangeRateResponse
+
+	for _, rate := range rates {
+		responses = append(responses, e.ToExchangeRateResponse(rate))
+	}
+
+	return responses
+}
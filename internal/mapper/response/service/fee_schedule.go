@@ -0,0 +1,84 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type feeScheduleResponseMapper struct {
+}
+
+func NewFeeScheduleResponseMapper() *feeScheduleResponseMapper {
+	return &feeScheduleResponseMapper{}
+}
+
+func (f *feeScheduleResponseMapper) ToFeeScheduleResponse(schedule *record.FeeScheduleRecord) *response.FeeScheduleResponse {
+	var tiers []*response.FeeScheduleTierResponse
+
+	for _, tier := range schedule.Tiers {
+		tiers = append(tiers, &response.FeeScheduleTierResponse{
+			ID:            tier.ID,
+			MinAmount:     tier.MinAmount,
+			FlatAmount:    tier.FlatAmount,
+			PercentageBps: tier.PercentageBps,
+		})
+	}
+
+	return &response.FeeScheduleResponse{
+		ID:              schedule.ID,
+		Name:            schedule.Name,
+		TransactionType: schedule.TransactionType,
+		FeeType:         schedule.FeeType,
+		PaymentMethod:   schedule.PaymentMethod,
+		CardType:        schedule.CardType,
+		Currency:        schedule.Currency,
+		FlatAmount:      schedule.FlatAmount,
+		PercentageBps:   schedule.PercentageBps,
+		MinFee:          schedule.MinFee,
+		MaxFee:          schedule.MaxFee,
+		IsActive:        schedule.IsActive,
+		Tiers:           tiers,
+		CreatedAt:       schedule.CreatedAt,
+		UpdatedAt:       schedule.UpdatedAt,
+	}
+}
+
+func (f *feeScheduleResponseMapper) ToFeeSchedulesResponse(schedules []*record.FeeScheduleRecord) []*response.FeeScheduleResponse {
+	var responses []*response.FeeScheduleResponse
+
+	for _, schedule := range schedules {
+		responses = append(responses, f.ToFeeScheduleResponse(schedule))
+	}
+
+	return responses
+}
+
+func (f *feeScheduleResponseMapper) ToFeeMonthlyAmounts(s []*record.FeeMonthAmount) []*response.FeeMonthAmountResponse {
+	var responses []*response.FeeMonthAmountResponse
+
+	for _, fee := range s {
+		responses = append(responses, &response.FeeMonthAmountResponse{
+			Month:           fee.Month,
+			TransactionType: fee.TransactionType,
+			Currency:        fee.Currency,
+			TotalFee:        fee.TotalFee,
+		})
+	}
+
+	return responses
+}
+
+func (f *feeScheduleResponseMapper) ToFeeYearlyAmounts(s []*record.FeeYearlyAmount) []*response.FeeYearlyAmountResponse {
+	var responses []*response.FeeYearlyAmountResponse
+
+	for _, fee := range s {
+		responses = append(responses, &response.FeeYearlyAmountResponse{
+			Year:            fee.Year,
+			TransactionType: fee.TransactionType,
+			Currency:        fee.Currency,
+			TotalFee:        fee.TotalFee,
+		})
+	}
+
+	return responses
+}
@@ -0,0 +1,23 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type idempotencyKeyResponseMapper struct {
+}
+
+func NewIdempotencyKeyResponseMapper() *idempotencyKeyResponseMapper {
+	return &idempotencyKeyResponseMapper{}
+}
+
+func (i *idempotencyKeyResponseMapper) ToIdempotencyKeyResponse(key *record.IdempotencyKeyRecord) *response.IdempotencyKeyResponse {
+	return &response.IdempotencyKeyResponse{
+		ID:           key.ID,
+		Scope:        key.Scope,
+		Key:          key.Key,
+		ResponseBody: key.ResponseBody,
+		ExpiresAt:    key.ExpiresAt,
+	}
+}
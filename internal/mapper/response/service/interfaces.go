@@ -1,6 +1,8 @@
 package responseservice
 
 import (
+	"time"
+
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
 )
@@ -23,6 +25,9 @@ type CardResponseMapper interface {
 	ToGetMonthlyAmounts(cards []*record.CardMonthAmount) []*response.CardResponseMonthAmount
 	ToGetYearlyAmount(card *record.CardYearAmount) *response.CardResponseYearAmount
 	ToGetYearlyAmounts(cards []*record.CardYearAmount) []*response.CardResponseYearAmount
+
+	ToDashboardCardCurrency(totals *record.CardCurrencyTotals) *response.DashboardCardCurrency
+	ToDashboardCardCurrencies(totals []*record.CardCurrencyTotals) []*response.DashboardCardCurrency
 }
 
 type UserResponseMapper interface {
@@ -64,6 +69,9 @@ type SaldoResponseMapper interface {
 
 	ToSaldoResponseDeleteAt(saldo *record.SaldoRecord) *response.SaldoResponseDeleteAt
 	ToSaldoResponsesDeleteAt(saldos []*record.SaldoRecord) []*response.SaldoResponseDeleteAt
+
+	ToSaldoHoldResponse(hold *record.SaldoHoldRecord) *response.SaldoHoldResponse
+	ToSaldoHoldResponses(holds []*record.SaldoHoldRecord) []*response.SaldoHoldResponse
 }
 
 type TopupResponseMapper interface {
@@ -168,6 +176,8 @@ type WithdrawResponseMapper interface {
 
 	ToWithdrawResponseDeleteAt(withdraw *record.WithdrawRecord) *response.WithdrawResponseDeleteAt
 	ToWithdrawsResponseDeleteAt(withdraws []*record.WithdrawRecord) []*response.WithdrawResponseDeleteAt
+
+	ToWithdrawReviewResponse(review *record.WithdrawReviewRecord) *response.WithdrawReviewResponse
 }
 
 type MerchantResponseMapper interface {
@@ -192,6 +202,128 @@ type MerchantResponseMapper interface {
 	ToMerchantYearlyAmount(ms *record.MerchantYearlyAmount) *response.MerchantResponseYearlyAmount
 	ToMerchantYearlyAmounts(ms []*record.MerchantYearlyAmount) []*response.MerchantResponseYearlyAmount
 
+	ToMerchantMonthlyDisputeRate(ms *record.MerchantMonthlyDisputeRate) *response.MerchantResponseMonthlyDisputeRate
+	ToMerchantMonthlyDisputeRates(ms []*record.MerchantMonthlyDisputeRate) []*response.MerchantResponseMonthlyDisputeRate
+	ToMerchantYearlyDisputeRate(ms *record.MerchantYearlyDisputeRate) *response.MerchantResponseYearlyDisputeRate
+	ToMerchantYearlyDisputeRates(ms []*record.MerchantYearlyDisputeRate) []*response.MerchantResponseYearlyDisputeRate
+
 	ToMerchantResponseDeleteAt(merchant *record.MerchantRecord) *response.MerchantResponseDeleteAt
 	ToMerchantsResponseDeleteAt(merchants []*record.MerchantRecord) []*response.MerchantResponseDeleteAt
 }
+
+type LedgerResponseMapper interface {
+	ToLedgerJournalResponse(journal *record.LedgerJournalRecord) *response.LedgerJournalResponse
+	ToLedgerJournalResponses(journals []*record.LedgerJournalRecord) []*response.LedgerJournalResponse
+
+	ToLedgerPostingResponse(posting *record.LedgerPostingRecord) *response.LedgerPostingResponse
+	ToLedgerPostingResponses(postings []*record.LedgerPostingRecord) []*response.LedgerPostingResponse
+
+	ToLedgerCardPostingResponse(posting *record.LedgerCardPostingRecord) *response.LedgerCardPostingResponse
+	ToLedgerCardPostingResponses(postings []*record.LedgerCardPostingRecord) []*response.LedgerCardPostingResponse
+
+	ToLedgerBalanceResponse(ledger *record.LedgerBalanceRecord, saldo *record.SaldoRecord) *response.LedgerBalanceResponse
+}
+
+type IdempotencyKeyResponseMapper interface {
+	ToIdempotencyKeyResponse(key *record.IdempotencyKeyRecord) *response.IdempotencyKeyResponse
+}
+
+type RefundResponseMapper interface {
+	ToRefundResponse(refund *record.RefundRecord) *response.RefundResponse
+	ToRefundsResponse(refunds []*record.RefundRecord) []*response.RefundResponse
+}
+
+type DisputeResponseMapper interface {
+	ToDisputeResponse(dispute *record.DisputeRecord) *response.DisputeResponse
+	ToDisputesResponse(disputes []*record.DisputeRecord) []*response.DisputeResponse
+}
+
+type AuthorizationResponseMapper interface {
+	ToAuthorizationResponse(authorization *record.AuthorizationRecord) *response.AuthorizationResponse
+	ToAuthorizationsResponse(authorizations []*record.AuthorizationRecord) []*response.AuthorizationResponse
+}
+
+type ExchangeRateResponseMapper interface {
+	ToExchangeRateResponse(rate *record.ExchangeRateRecord) *response.ExchangeRateResponse
+	ToExchangeRatesResponse(rates []*record.ExchangeRateRecord) []*response.ExchangeRateResponse
+}
+
+type FeeScheduleResponseMapper interface {
+	ToFeeScheduleResponse(schedule *record.FeeScheduleRecord) *response.FeeScheduleResponse
+	ToFeeSchedulesResponse(schedules []*record.FeeScheduleRecord) []*response.FeeScheduleResponse
+
+	ToFeeMonthlyAmounts(s []*record.FeeMonthAmount) []*response.FeeMonthAmountResponse
+	ToFeeYearlyAmounts(s []*record.FeeYearlyAmount) []*response.FeeYearlyAmountResponse
+}
+
+type TransactionLimitResponseMapper interface {
+	ToTransactionLimitResponse(limit *record.TransactionLimitRecord) *response.TransactionLimitResponse
+	ToTransactionLimitsResponse(limits []*record.TransactionLimitRecord) []*response.TransactionLimitResponse
+
+	ToTransactionLimitHeadroomResponse(limit *record.TransactionLimitRecord, usage *record.TransactionLimitUsageRecord, resetsAt time.Time) *response.TransactionLimitHeadroomResponse
+}
+
+type ScheduledTransferResponseMapper interface {
+	ToScheduledTransferResponse(schedule *record.ScheduledTransferRecord) *response.ScheduledTransferResponse
+	ToScheduledTransfersResponse(schedules []*record.ScheduledTransferRecord) []*response.ScheduledTransferResponse
+
+	ToScheduledTransferRunResponse(run *record.ScheduledTransferRunRecord) *response.ScheduledTransferRunResponse
+	ToScheduledTransferRunsResponse(runs []*record.ScheduledTransferRunRecord) []*response.ScheduledTransferRunResponse
+}
+
+type TransferBatchResponseMapper interface {
+	ToTransferBatchResponse(batch *record.TransferBatchRecord) *response.TransferBatchResponse
+	ToTransferBatchesResponse(batches []*record.TransferBatchRecord) []*response.TransferBatchResponse
+
+	ToTransferBatchRowResponse(row *record.TransferBatchRowRecord) *response.TransferBatchRowResponse
+	ToTransferBatchRowsResponse(rows []*record.TransferBatchRowRecord) []*response.TransferBatchRowResponse
+}
+
+type StatusHistoryResponseMapper interface {
+	ToStatusHistoryResponse(history *record.StatusHistoryRecord) *response.StatusHistoryResponse
+	ToStatusHistoriesResponse(histories []*record.StatusHistoryRecord) []*response.StatusHistoryResponse
+}
+
+type ProviderWebhookResponseMapper interface {
+	ToProviderWebhookEventResponse(event *record.ProviderWebhookEventRecord) *response.ProviderWebhookEventResponse
+	ToProviderWebhookEventsResponse(events []*record.ProviderWebhookEventRecord) []*response.ProviderWebhookEventResponse
+}
+
+type MerchantWebhookResponseMapper interface {
+	ToMerchantWebhookEndpointResponse(endpoint *record.MerchantWebhookEndpointRecord) *response.MerchantWebhookEndpointResponse
+	ToMerchantWebhookEndpointsResponse(endpoints []*record.MerchantWebhookEndpointRecord) []*response.MerchantWebhookEndpointResponse
+	ToMerchantWebhookDeliveryResponse(delivery *record.MerchantWebhookDeliveryRecord) *response.MerchantWebhookDeliveryResponse
+	ToMerchantWebhookDeliveriesResponse(deliveries []*record.MerchantWebhookDeliveryRecord) []*response.MerchantWebhookDeliveryResponse
+	ToMerchantWebhookDeliveryAttemptsResponse(attempts []*record.MerchantWebhookDeliveryAttemptRecord) []*response.MerchantWebhookDeliveryAttemptResponse
+}
+
+type SettlementResponseMapper interface {
+	ToMerchantBalanceResponse(balance *record.MerchantBalanceRecord) *response.MerchantBalanceResponse
+	ToMerchantBalancesResponse(balances []*record.MerchantBalanceRecord) []*response.MerchantBalanceResponse
+	ToSettlementBatchResponse(batch *record.SettlementBatchRecord) *response.SettlementBatchResponse
+	ToSettlementBatchesResponse(batches []*record.SettlementBatchRecord) []*response.SettlementBatchResponse
+	ToSettlementItemsResponse(items []*record.SettlementItemRecord) []*response.SettlementItemResponse
+}
+
+type MerchantOnboardingResponseMapper interface {
+	ToMerchantBusinessProfileResponse(profile *record.MerchantBusinessProfileRecord) *response.MerchantBusinessProfileResponse
+}
+
+type ReconciliationResponseMapper interface {
+	ToReconciliationRunResponse(run *record.ReconciliationRunRecord) *response.ReconciliationRunResponse
+	ToReconciliationRunsResponse(runs []*record.ReconciliationRunRecord) []*response.ReconciliationRunResponse
+	ToBalanceDiscrepancyResponse(discrepancy *record.BalanceDiscrepancyRecord) *response.BalanceDiscrepancyResponse
+	ToBalanceDiscrepanciesResponse(discrepancies []*record.BalanceDiscrepancyRecord) []*response.BalanceDiscrepancyResponse
+}
+
+type MerchantApiKeyResponseMapper interface {
+	ToMerchantApiKeyResponse(key *record.MerchantApiKeyRecord) *response.MerchantApiKeyResponse
+	ToMerchantApiKeysResponse(keys []*record.MerchantApiKeyRecord) []*response.MerchantApiKeyResponse
+}
+
+type InvoiceResponseMapper interface {
+	ToInvoiceResponse(invoice *record.InvoiceRecord, lineItems []*record.InvoiceLineItemRecord) *response.InvoiceResponse
+	ToInvoicesResponse(invoices []*record.InvoiceRecord) []*response.InvoiceResponse
+	ToInvoicePaymentResponse(payment *record.InvoicePaymentRecord) *response.InvoicePaymentResponse
+	ToInvoicePaymentsResponse(payments []*record.InvoicePaymentRecord) []*response.InvoicePaymentResponse
+}
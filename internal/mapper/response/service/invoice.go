@@ -0,0 +1,75 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type invoiceResponseMapper struct {
+}
+
+func NewInvoiceResponseMapper() *invoiceResponseMapper {
+	return &invoiceResponseMapper{}
+}
+
+func (s *invoiceResponseMapper) ToInvoiceResponse(invoice *record.InvoiceRecord, lineItems []*record.InvoiceLineItemRecord) *response.InvoiceResponse {
+	items := make([]*response.InvoiceLineItemResponse, 0, len(lineItems))
+
+	for _, item := range lineItems {
+		items = append(items, &response.InvoiceLineItemResponse{
+			ID:          item.ID,
+			Description: item.Description,
+			Quantity:    item.Quantity,
+			UnitPrice:   item.UnitPrice,
+			Amount:      item.Amount,
+		})
+	}
+
+	return &response.InvoiceResponse{
+		ID:          invoice.ID,
+		Code:        invoice.Code,
+		MerchantID:  invoice.MerchantID,
+		Kind:        invoice.Kind,
+		Description: invoice.Description,
+		Amount:      invoice.Amount,
+		Currency:    invoice.Currency,
+		Status:      invoice.Status,
+		ExpiresAt:   invoice.ExpiresAt,
+		PaidAt:      invoice.PaidAt,
+		CancelledAt: invoice.CancelledAt,
+		LineItems:   items,
+		CreatedAt:   invoice.CreatedAt,
+		UpdatedAt:   invoice.UpdatedAt,
+	}
+}
+
+// ToInvoicesResponse maps invoices without their line items.
+func (s *invoiceResponseMapper) ToInvoicesResponse(invoices []*record.InvoiceRecord) []*response.InvoiceResponse {
+	var responses []*response.InvoiceResponse
+
+	for _, invoice := range invoices {
+		responses = append(responses, s.ToInvoiceResponse(invoice, nil))
+	}
+
+	return responses
+}
+
+func (s *invoiceResponseMapper) ToInvoicePaymentResponse(payment *record.InvoicePaymentRecord) *response.InvoicePaymentResponse {
+	return &response.InvoicePaymentResponse{
+		ID:            payment.ID,
+		InvoiceID:     payment.InvoiceID,
+		TransactionID: payment.TransactionID,
+		Amount:        payment.Amount,
+		CreatedAt:     payment.CreatedAt,
+	}
+}
+
+func (s *invoiceResponseMapper) ToInvoicePaymentsResponse(payments []*record.InvoicePaymentRecord) []*response.InvoicePaymentResponse {
+	var responses []*response.InvoicePaymentResponse
+
+	for _, payment := range payments {
+		responses = append(responses, s.ToInvoicePaymentResponse(payment))
+	}
+
+	return responses
+}
@@ -0,0 +1,92 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type ledgerResponseMapper struct {
+}
+
+func NewLedgerResponseMapper() *ledgerResponseMapper {
+	return &ledgerResponseMapper{}
+}
+
+func (l *ledgerResponseMapper) ToLedgerJournalResponse(journal *record.LedgerJournalRecord) *response.LedgerJournalResponse {
+	return &response.LedgerJournalResponse{
+		ID:            journal.ID,
+		JournalNo:     journal.JournalNo,
+		ReferenceType: journal.ReferenceType,
+		ReferenceID:   journal.ReferenceID,
+		Description:   journal.Description,
+		PostedAt:      journal.PostedAt,
+		CreatedAt:     journal.CreatedAt,
+		Postings:      l.ToLedgerPostingResponses(journal.Postings),
+	}
+}
+
+func (l *ledgerResponseMapper) ToLedgerJournalResponses(journals []*record.LedgerJournalRecord) []*response.LedgerJournalResponse {
+	var responses []*response.LedgerJournalResponse
+
+	for _, journal := range journals {
+		responses = append(responses, l.ToLedgerJournalResponse(journal))
+	}
+
+	return responses
+}
+
+func (l *ledgerResponseMapper) ToLedgerPostingResponse(posting *record.LedgerPostingRecord) *response.LedgerPostingResponse {
+	return &response.LedgerPostingResponse{
+		ID:          posting.ID,
+		JournalID:   posting.JournalID,
+		AccountCode: posting.AccountCode,
+		CardNumber:  posting.CardNumber,
+		Direction:   posting.Direction,
+		Amount:      posting.Amount,
+		Currency:    posting.Currency,
+		CreatedAt:   posting.CreatedAt,
+	}
+}
+
+func (l *ledgerResponseMapper) ToLedgerPostingResponses(postings []*record.LedgerPostingRecord) []*response.LedgerPostingResponse {
+	var responses []*response.LedgerPostingResponse
+
+	for _, posting := range postings {
+		responses = append(responses, l.ToLedgerPostingResponse(posting))
+	}
+
+	return responses
+}
+
+func (l *ledgerResponseMapper) ToLedgerCardPostingResponse(posting *record.LedgerCardPostingRecord) *response.LedgerCardPostingResponse {
+	return &response.LedgerCardPostingResponse{
+		ID:            posting.ID,
+		JournalID:     posting.JournalID,
+		ReferenceType: posting.ReferenceType,
+		ReferenceID:   posting.ReferenceID,
+		CardNumber:    posting.CardNumber,
+		Direction:     posting.Direction,
+		Amount:        posting.Amount,
+		Currency:      posting.Currency,
+		PostedAt:      posting.PostedAt,
+	}
+}
+
+func (l *ledgerResponseMapper) ToLedgerCardPostingResponses(postings []*record.LedgerCardPostingRecord) []*response.LedgerCardPostingResponse {
+	var responses []*response.LedgerCardPostingResponse
+
+	for _, posting := range postings {
+		responses = append(responses, l.ToLedgerCardPostingResponse(posting))
+	}
+
+	return responses
+}
+
+func (l *ledgerResponseMapper) ToLedgerBalanceResponse(ledger *record.LedgerBalanceRecord, saldo *record.SaldoRecord) *response.LedgerBalanceResponse {
+	return &response.LedgerBalanceResponse{
+		CardNumber:    ledger.CardNumber,
+		LedgerBalance: ledger.Balance,
+		SaldoBalance:  saldo.TotalBalance,
+		Difference:    saldo.TotalBalance - ledger.Balance,
+	}
+}
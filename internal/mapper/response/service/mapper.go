@@ -1,29 +1,65 @@
 package responseservice
 
 type ResponseServiceMapper struct {
-	CardResponseMapper         CardResponseMapper
-	RoleResponseMapper         RoleResponseMapper
-	RefreshTokenResponseMapper RefreshTokenResponseMapper
-	SaldoResponseMapper        SaldoResponseMapper
-	TransactionResponseMapper  TransactionResponseMapper
-	TransferResponseMapper     TransferResponseMapper
-	TopupResponseMapper        TopupResponseMapper
-	WithdrawResponseMapper     WithdrawResponseMapper
-	UserResponseMapper         UserResponseMapper
-	MerchantResponseMapper     MerchantResponseMapper
+	CardResponseMapper               CardResponseMapper
+	RoleResponseMapper               RoleResponseMapper
+	RefreshTokenResponseMapper       RefreshTokenResponseMapper
+	SaldoResponseMapper              SaldoResponseMapper
+	TransactionResponseMapper        TransactionResponseMapper
+	TransferResponseMapper           TransferResponseMapper
+	TopupResponseMapper              TopupResponseMapper
+	WithdrawResponseMapper           WithdrawResponseMapper
+	UserResponseMapper               UserResponseMapper
+	MerchantResponseMapper           MerchantResponseMapper
+	LedgerResponseMapper             LedgerResponseMapper
+	IdempotencyKeyResponseMapper     IdempotencyKeyResponseMapper
+	RefundResponseMapper             RefundResponseMapper
+	DisputeResponseMapper            DisputeResponseMapper
+	AuthorizationResponseMapper      AuthorizationResponseMapper
+	ExchangeRateResponseMapper       ExchangeRateResponseMapper
+	FeeScheduleResponseMapper        FeeScheduleResponseMapper
+	TransactionLimitResponseMapper   TransactionLimitResponseMapper
+	ScheduledTransferResponseMapper  ScheduledTransferResponseMapper
+	TransferBatchResponseMapper      TransferBatchResponseMapper
+	StatusHistoryResponseMapper      StatusHistoryResponseMapper
+	ProviderWebhookResponseMapper    ProviderWebhookResponseMapper
+	MerchantWebhookResponseMapper    MerchantWebhookResponseMapper
+	SettlementResponseMapper         SettlementResponseMapper
+	ReconciliationResponseMapper     ReconciliationResponseMapper
+	MerchantOnboardingResponseMapper MerchantOnboardingResponseMapper
+	MerchantApiKeyResponseMapper     MerchantApiKeyResponseMapper
+	InvoiceResponseMapper            InvoiceResponseMapper
 }
 
 func NewResponseServiceMapper() *ResponseServiceMapper {
 	return &ResponseServiceMapper{
-		CardResponseMapper:         NewCardResponseMapper(),
-		SaldoResponseMapper:        NewSaldoResponseMapper(),
-		TransactionResponseMapper:  NewTransactionResponseMapper(),
-		TransferResponseMapper:     NewTransferResponseMapper(),
-		TopupResponseMapper:        NewTopupResponseMapper(),
-		WithdrawResponseMapper:     NewWithdrawResponseMapper(),
-		UserResponseMapper:         NewUserResponseMapper(),
-		RefreshTokenResponseMapper: NewRefreshTokenResponseMapper(),
-		RoleResponseMapper:         NewRoleResponseMapper(),
-		MerchantResponseMapper:     NewMerchantResponseMapper(),
+		CardResponseMapper:               NewCardResponseMapper(),
+		SaldoResponseMapper:              NewSaldoResponseMapper(),
+		TransactionResponseMapper:        NewTransactionResponseMapper(),
+		TransferResponseMapper:           NewTransferResponseMapper(),
+		TopupResponseMapper:              NewTopupResponseMapper(),
+		WithdrawResponseMapper:           NewWithdrawResponseMapper(),
+		UserResponseMapper:               NewUserResponseMapper(),
+		RefreshTokenResponseMapper:       NewRefreshTokenResponseMapper(),
+		RoleResponseMapper:               NewRoleResponseMapper(),
+		MerchantResponseMapper:           NewMerchantResponseMapper(),
+		LedgerResponseMapper:             NewLedgerResponseMapper(),
+		IdempotencyKeyResponseMapper:     NewIdempotencyKeyResponseMapper(),
+		RefundResponseMapper:             NewRefundResponseMapper(),
+		DisputeResponseMapper:            NewDisputeResponseMapper(),
+		AuthorizationResponseMapper:      NewAuthorizationResponseMapper(),
+		ExchangeRateResponseMapper:       NewExchangeRateResponseMapper(),
+		FeeScheduleResponseMapper:        NewFeeScheduleResponseMapper(),
+		TransactionLimitResponseMapper:   NewTransactionLimitResponseMapper(),
+		ScheduledTransferResponseMapper:  NewScheduledTransferResponseMapper(),
+		TransferBatchResponseMapper:      NewTransferBatchResponseMapper(),
+		StatusHistoryResponseMapper:      NewStatusHistoryResponseMapper(),
+		ProviderWebhookResponseMapper:    NewProviderWebhookResponseMapper(),
+		MerchantWebhookResponseMapper:    NewMerchantWebhookResponseMapper(),
+		SettlementResponseMapper:         NewSettlementResponseMapper(),
+		ReconciliationResponseMapper:     NewReconciliationResponseMapper(),
+		MerchantOnboardingResponseMapper: NewMerchantOnboardingResponseMapper(),
+		MerchantApiKeyResponseMapper:     NewMerchantApiKeyResponseMapper(),
+		InvoiceResponseMapper:            NewInvoiceResponseMapper(),
 	}
 }
@@ -17,7 +17,6 @@ func (s *merchantResponseMapper) ToMerchantResponse(merchant *record.MerchantRec
 		Name:      merchant.Name,
 		UserID:    merchant.UserID,
 		Status:    merchant.Status,
-		ApiKey:    merchant.ApiKey,
 		CreatedAt: merchant.CreatedAt,
 		UpdatedAt: merchant.UpdatedAt,
 	}
@@ -37,7 +36,6 @@ func (s *merchantResponseMapper) ToMerchantResponseDeleteAt(merchant *record.Mer
 		Name:      merchant.Name,
 		UserID:    merchant.UserID,
 		Status:    merchant.Status,
-		ApiKey:    merchant.ApiKey,
 		CreatedAt: merchant.CreatedAt,
 		UpdatedAt: merchant.UpdatedAt,
 		DeletedAt: merchant.DeletedAt,
@@ -110,6 +108,7 @@ func (s *merchantResponseMapper) ToMerchantYearlyPaymentMethods(ms []*record.Mer
 func (s *merchantResponseMapper) ToMerchantMonthlyAmount(ms *record.MerchantMonthlyAmount) *response.MerchantResponseMonthlyAmount {
 	return &response.MerchantResponseMonthlyAmount{
 		Month:       ms.Month,
+		Currency:    ms.Currency,
 		TotalAmount: ms.TotalAmount,
 	}
 }
@@ -122,9 +121,44 @@ func (s *merchantResponseMapper) ToMerchantMonthlyAmounts(ms []*record.MerchantM
 	return response
 }
 
+func (s *merchantResponseMapper) ToMerchantMonthlyDisputeRate(ms *record.MerchantMonthlyDisputeRate) *response.MerchantResponseMonthlyDisputeRate {
+	return &response.MerchantResponseMonthlyDisputeRate{
+		Month:             ms.Month,
+		TotalTransactions: ms.TotalTransactions,
+		TotalDisputes:     ms.TotalDisputes,
+		DisputeRate:       ms.DisputeRate,
+	}
+}
+
+func (s *merchantResponseMapper) ToMerchantMonthlyDisputeRates(ms []*record.MerchantMonthlyDisputeRate) []*response.MerchantResponseMonthlyDisputeRate {
+	var response []*response.MerchantResponseMonthlyDisputeRate
+	for _, merchant := range ms {
+		response = append(response, s.ToMerchantMonthlyDisputeRate(merchant))
+	}
+	return response
+}
+
+func (s *merchantResponseMapper) ToMerchantYearlyDisputeRate(ms *record.MerchantYearlyDisputeRate) *response.MerchantResponseYearlyDisputeRate {
+	return &response.MerchantResponseYearlyDisputeRate{
+		Year:              ms.Year,
+		TotalTransactions: ms.TotalTransactions,
+		TotalDisputes:     ms.TotalDisputes,
+		DisputeRate:       ms.DisputeRate,
+	}
+}
+
+func (s *merchantResponseMapper) ToMerchantYearlyDisputeRates(ms []*record.MerchantYearlyDisputeRate) []*response.MerchantResponseYearlyDisputeRate {
+	var response []*response.MerchantResponseYearlyDisputeRate
+	for _, merchant := range ms {
+		response = append(response, s.ToMerchantYearlyDisputeRate(merchant))
+	}
+	return response
+}
+
 func (s *merchantResponseMapper) ToMerchantYearlyAmount(ms *record.MerchantYearlyAmount) *response.MerchantResponseYearlyAmount {
 	return &response.MerchantResponseYearlyAmount{
 		Year:        ms.Year,
+		Currency:    ms.Currency,
 		TotalAmount: ms.TotalAmount,
 	}
 }
@@ -140,6 +174,7 @@ func (s *merchantResponseMapper) ToMerchantYearlyAmounts(ms []*record.MerchantYe
 func (s *merchantResponseMapper) ToMerchantMonthlyTotalAmount(ms *record.MerchantMonthlyTotalAmount) *response.MerchantResponseMonthlyTotalAmount {
 	return &response.MerchantResponseMonthlyTotalAmount{
 		Month:       ms.Month,
+		Currency:    ms.Currency,
 		Year:        ms.Year,
 		TotalAmount: ms.TotalAmount,
 	}
@@ -156,6 +191,7 @@ func (s *merchantResponseMapper) ToMerchantMonthlyTotalAmounts(ms []*record.Merc
 func (s *merchantResponseMapper) ToMerchantYearlyTotalAmount(ms *record.MerchantYearlyTotalAmount) *response.MerchantResponseYearlyTotalAmount {
 	return &response.MerchantResponseYearlyTotalAmount{
 		Year:        ms.Year,
+		Currency:    ms.Currency,
 		TotalAmount: ms.TotalAmount,
 	}
 }
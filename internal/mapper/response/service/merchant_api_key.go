@@ -0,0 +1,38 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type merchantApiKeyResponseMapper struct {
+}
+
+func NewMerchantApiKeyResponseMapper() *merchantApiKeyResponseMapper {
+	return &merchantApiKeyResponseMapper{}
+}
+
+func (s *merchantApiKeyResponseMapper) ToMerchantApiKeyResponse(key *record.MerchantApiKeyRecord) *response.MerchantApiKeyResponse {
+	return &response.MerchantApiKeyResponse{
+		ID:         key.ID,
+		MerchantID: key.MerchantID,
+		Label:      key.Label,
+		KeyPrefix:  key.KeyPrefix,
+		Scopes:     key.Scopes,
+		ExpiresAt:  key.ExpiresAt,
+		LastUsedAt: key.LastUsedAt,
+		RevokedAt:  key.RevokedAt,
+		CreatedAt:  key.CreatedAt,
+		UpdatedAt:  key.UpdatedAt,
+	}
+}
+
+func (s *merchantApiKeyResponseMapper) ToMerchantApiKeysResponse(keys []*record.MerchantApiKeyRecord) []*response.MerchantApiKeyResponse {
+	var responses []*response.MerchantApiKeyResponse
+
+	for _, key := range keys {
+		responses = append(responses, s.ToMerchantApiKeyResponse(key))
+	}
+
+	return responses
+}
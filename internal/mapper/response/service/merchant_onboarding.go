@@ -0,0 +1,29 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type merchantOnboardingResponseMapper struct {
+}
+
+func NewMerchantOnboardingResponseMapper() *merchantOnboardingResponseMapper {
+	return &merchantOnboardingResponseMapper{}
+}
+
+func (s *merchantOnboardingResponseMapper) ToMerchantBusinessProfileResponse(profile *record.MerchantBusinessProfileRecord) *response.MerchantBusinessProfileResponse {
+	return &response.MerchantBusinessProfileResponse{
+		MerchantID:   profile.MerchantID,
+		LegalName:    profile.LegalName,
+		BusinessType: profile.BusinessType,
+		TaxID:        profile.TaxID,
+		Address:      profile.Address,
+		Website:      profile.Website,
+		ContactEmail: profile.ContactEmail,
+		ContactPhone: profile.ContactPhone,
+		SubmittedAt:  profile.SubmittedAt,
+		CreatedAt:    profile.CreatedAt,
+		UpdatedAt:    profile.UpdatedAt,
+	}
+}
@@ -0,0 +1,81 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type merchantWebhookResponseMapper struct {
+}
+
+func NewMerchantWebhookResponseMapper() *merchantWebhookResponseMapper {
+	return &merchantWebhookResponseMapper{}
+}
+
+func (s *merchantWebhookResponseMapper) ToMerchantWebhookEndpointResponse(endpoint *record.MerchantWebhookEndpointRecord) *response.MerchantWebhookEndpointResponse {
+	return &response.MerchantWebhookEndpointResponse{
+		ID:         endpoint.ID,
+		MerchantID: endpoint.MerchantID,
+		URL:        endpoint.URL,
+		Events:     endpoint.Events,
+		IsActive:   endpoint.IsActive,
+		CreatedAt:  endpoint.CreatedAt,
+		UpdatedAt:  endpoint.UpdatedAt,
+	}
+}
+
+func (s *merchantWebhookResponseMapper) ToMerchantWebhookEndpointsResponse(endpoints []*record.MerchantWebhookEndpointRecord) []*response.MerchantWebhookEndpointResponse {
+	var responses []*response.MerchantWebhookEndpointResponse
+
+	for _, endpoint := range endpoints {
+		responses = append(responses, s.ToMerchantWebhookEndpointResponse(endpoint))
+	}
+
+	return responses
+}
+
+func (s *merchantWebhookResponseMapper) ToMerchantWebhookDeliveryResponse(delivery *record.MerchantWebhookDeliveryRecord) *response.MerchantWebhookDeliveryResponse {
+	return &response.MerchantWebhookDeliveryResponse{
+		ID:               delivery.ID,
+		EndpointID:       delivery.EndpointID,
+		EventID:          delivery.EventID,
+		EventType:        delivery.EventType,
+		Payload:          delivery.Payload,
+		Status:           delivery.Status,
+		Attempts:         delivery.Attempts,
+		NextAttemptAt:    delivery.NextAttemptAt,
+		LastResponseCode: delivery.LastResponseCode,
+		LastError:        delivery.LastError,
+		DeliveredAt:      delivery.DeliveredAt,
+		CreatedAt:        delivery.CreatedAt,
+		UpdatedAt:        delivery.UpdatedAt,
+	}
+}
+
+func (s *merchantWebhookResponseMapper) ToMerchantWebhookDeliveriesResponse(deliveries []*record.MerchantWebhookDeliveryRecord) []*response.MerchantWebhookDeliveryResponse {
+	var responses []*response.MerchantWebhookDeliveryResponse
+
+	for _, delivery := range deliveries {
+		responses = append(responses, s.ToMerchantWebhookDeliveryResponse(delivery))
+	}
+
+	return responses
+}
+
+func (s *merchantWebhookResponseMapper) ToMerchantWebhookDeliveryAttemptsResponse(attempts []*record.MerchantWebhookDeliveryAttemptRecord) []*response.MerchantWebhookDeliveryAttemptResponse {
+	var responses []*response.MerchantWebhookDeliveryAttemptResponse
+
+	for _, attempt := range attempts {
+		responses = append(responses, &response.MerchantWebhookDeliveryAttemptResponse{
+			ID:           attempt.ID,
+			DeliveryID:   attempt.DeliveryID,
+			Attempt:      attempt.Attempt,
+			ResponseCode: attempt.ResponseCode,
+			Error:        attempt.Error,
+			DurationMs:   attempt.DurationMs,
+			CreatedAt:    attempt.CreatedAt,
+		})
+	}
+
+	return responses
+}
@@ -0,0 +1,40 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type providerWebhookResponseMapper struct {
+}
+
+func NewProviderWebhookResponseMapper() *providerWebhookResponseMapper {
+	return &providerWebhookResponseMapper{}
+}
+
+func (s *providerWebhookResponseMapper) ToProviderWebhookEventResponse(event *record.ProviderWebhookEventRecord) *response.ProviderWebhookEventResponse {
+	return &response.ProviderWebhookEventResponse{
+		ID:          event.ID,
+		Provider:    event.Provider,
+		EventID:     event.EventID,
+		EventType:   event.EventType,
+		Payload:     event.Payload,
+		SentAt:      event.SentAt,
+		Status:      event.Status,
+		Attempts:    event.Attempts,
+		LastError:   event.LastError,
+		ProcessedAt: event.ProcessedAt,
+		CreatedAt:   event.CreatedAt,
+		UpdatedAt:   event.UpdatedAt,
+	}
+}
+
+func (s *providerWebhookResponseMapper) ToProviderWebhookEventsResponse(events []*record.ProviderWebhookEventRecord) []*response.ProviderWebhookEventResponse {
+	var responses []*response.ProviderWebhookEventResponse
+
+	for _, event := range events {
+		responses = append(responses, s.ToProviderWebhookEventResponse(event))
+	}
+
+	return responses
+}
@@ -0,0 +1,69 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type reconciliationResponseMapper struct {
+}
+
+func NewReconciliationResponseMapper() *reconciliationResponseMapper {
+	return &reconciliationResponseMapper{}
+}
+
+func (s *reconciliationResponseMapper) ToReconciliationRunResponse(run *record.ReconciliationRunRecord) *response.ReconciliationRunResponse {
+	return &response.ReconciliationRunResponse{
+		ID:               run.ID,
+		CardsChecked:     run.CardsChecked,
+		DiscrepancyCount: run.DiscrepancyCount,
+		StartedAt:        run.StartedAt,
+		FinishedAt:       run.FinishedAt,
+	}
+}
+
+func (s *reconciliationResponseMapper) ToReconciliationRunsResponse(runs []*record.ReconciliationRunRecord) []*response.ReconciliationRunResponse {
+	var responses []*response.ReconciliationRunResponse
+
+	for _, run := range runs {
+		responses = append(responses, s.ToReconciliationRunResponse(run))
+	}
+
+	return responses
+}
+
+func (s *reconciliationResponseMapper) ToBalanceDiscrepancyResponse(discrepancy *record.BalanceDiscrepancyRecord) *response.BalanceDiscrepancyResponse {
+	return &response.BalanceDiscrepancyResponse{
+		ID:                  discrepancy.ID,
+		CardNumber:          discrepancy.CardNumber,
+		ReconciliationRunID: discrepancy.ReconciliationRunID,
+		SaldoBalance:        discrepancy.SaldoBalance,
+		ExpectedBalance:     discrepancy.ExpectedBalance,
+		LedgerBalance:       discrepancy.LedgerBalance,
+		Difference:          discrepancy.Difference,
+		TopupAmount:         discrepancy.TopupAmount,
+		WithdrawAmount:      discrepancy.WithdrawAmount,
+		TransferOutAmount:   discrepancy.TransferOutAmount,
+		TransferInAmount:    discrepancy.TransferInAmount,
+		PaymentAmount:       discrepancy.PaymentAmount,
+		SettlementAmount:    discrepancy.SettlementAmount,
+		OtherAmount:         discrepancy.OtherAmount,
+		Status:              discrepancy.Status,
+		AcknowledgedBy:      discrepancy.AcknowledgedBy,
+		AcknowledgedAt:      discrepancy.AcknowledgedAt,
+		Note:                discrepancy.Note,
+		ResolvedAt:          discrepancy.ResolvedAt,
+		CreatedAt:           discrepancy.CreatedAt,
+		UpdatedAt:           discrepancy.UpdatedAt,
+	}
+}
+
+func (s *reconciliationResponseMapper) ToBalanceDiscrepanciesResponse(discrepancies []*record.BalanceDiscrepancyRecord) []*response.BalanceDiscrepancyResponse {
+	var responses []*response.BalanceDiscrepancyResponse
+
+	for _, discrepancy := range discrepancies {
+		responses = append(responses, s.ToBalanceDiscrepancyResponse(discrepancy))
+	}
+
+	return responses
+}
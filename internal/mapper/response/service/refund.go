@@ -0,0 +1,39 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type refundResponseMapper struct {
+}
+
+func NewRefundResponseMapper() *refundResponseMapper {
+	return &refundResponseMapper{}
+}
+
+func (r *refundResponseMapper) ToRefundResponse(refund *record.RefundRecord) *response.RefundResponse {
+	return &response.RefundResponse{
+		ID:            refund.ID,
+		RefundNo:      refund.RefundNo,
+		TransactionID: refund.TransactionID,
+		CardNumber:    refund.CardNumber,
+		MerchantID:    refund.MerchantID,
+		Amount:        refund.Amount,
+		Currency:      refund.Currency,
+		Reason:        refund.Reason,
+		Status:        refund.Status,
+		CreatedAt:     refund.CreatedAt,
+		UpdatedAt:     refund.UpdatedAt,
+	}
+}
+
+func (r *refundResponseMapper) ToRefundsResponse(refunds []*record.RefundRecord) []*response.RefundResponse {
+	var responses []*response.RefundResponse
+
+	for _, refund := range refunds {
+		responses = append(responses, r.ToRefundResponse(refund))
+	}
+
+	return responses
+}
@@ -14,13 +14,16 @@ func NewSaldoResponseMapper() *saldoResponseMapper {
 
 func (s *saldoResponseMapper) ToSaldoResponse(saldo *record.SaldoRecord) *response.SaldoResponse {
 	return &response.SaldoResponse{
-		ID:             saldo.ID,
-		CardNumber:     saldo.CardNumber,
-		TotalBalance:   saldo.TotalBalance,
-		WithdrawAmount: saldo.WithdrawAmount,
-		WithdrawTime:   saldo.WithdrawTime,
-		CreatedAt:      saldo.CreatedAt,
-		UpdatedAt:      saldo.UpdatedAt,
+		ID:               saldo.ID,
+		CardNumber:       saldo.CardNumber,
+		TotalBalance:     saldo.TotalBalance,
+		ReservedBalance:  saldo.ReservedBalance,
+		Currency:         saldo.Currency,
+		AvailableBalance: saldo.TotalBalance - saldo.ReservedBalance,
+		WithdrawAmount:   saldo.WithdrawAmount,
+		WithdrawTime:     saldo.WithdrawTime,
+		CreatedAt:        saldo.CreatedAt,
+		UpdatedAt:        saldo.UpdatedAt,
 	}
 }
 
@@ -36,14 +39,17 @@ func (s *saldoResponseMapper) ToSaldoResponses(saldos []*record.SaldoRecord) []*
 
 func (s *saldoResponseMapper) ToSaldoResponseDeleteAt(saldo *record.SaldoRecord) *response.SaldoResponseDeleteAt {
 	return &response.SaldoResponseDeleteAt{
-		ID:             saldo.ID,
-		CardNumber:     saldo.CardNumber,
-		TotalBalance:   saldo.TotalBalance,
-		WithdrawAmount: saldo.WithdrawAmount,
-		WithdrawTime:   saldo.WithdrawTime,
-		CreatedAt:      saldo.CreatedAt,
-		UpdatedAt:      saldo.UpdatedAt,
-		DeletedAt:      saldo.DeletedAt,
+		ID:               saldo.ID,
+		CardNumber:       saldo.CardNumber,
+		TotalBalance:     saldo.TotalBalance,
+		ReservedBalance:  saldo.ReservedBalance,
+		Currency:         saldo.Currency,
+		AvailableBalance: saldo.TotalBalance - saldo.ReservedBalance,
+		WithdrawAmount:   saldo.WithdrawAmount,
+		WithdrawTime:     saldo.WithdrawTime,
+		CreatedAt:        saldo.CreatedAt,
+		UpdatedAt:        saldo.UpdatedAt,
+		DeletedAt:        saldo.DeletedAt,
 	}
 }
 
@@ -66,6 +72,7 @@ func (s *saldoResponseMapper) ToSaldoMonthTotalBalanceResponse(ss *record.SaldoM
 
 	return &response.SaldoMonthTotalBalanceResponse{
 		Month:        ss.Month,
+		Currency:     ss.Currency,
 		Year:         ss.Year,
 		TotalBalance: totalBalance,
 	}
@@ -82,6 +89,7 @@ func (s *saldoResponseMapper) ToSaldoMonthTotalBalanceResponses(ss []*record.Sal
 func (s *saldoResponseMapper) ToSaldoYearTotalBalanceResponse(ss *record.SaldoYearTotalBalance) *response.SaldoYearTotalBalanceResponse {
 	return &response.SaldoYearTotalBalanceResponse{
 		Year:         ss.Year,
+		Currency:     ss.Currency,
 		TotalBalance: ss.TotalBalance,
 	}
 }
@@ -97,6 +105,7 @@ func (s *saldoResponseMapper) ToSaldoYearTotalBalanceResponses(ss []*record.Sald
 func (s *saldoResponseMapper) ToSaldoMonthBalanceResponse(ss *record.SaldoMonthSaldoBalance) *response.SaldoMonthBalanceResponse {
 	return &response.SaldoMonthBalanceResponse{
 		Month:        ss.Month,
+		Currency:     ss.Currency,
 		TotalBalance: ss.TotalBalance,
 	}
 }
@@ -112,6 +121,7 @@ func (s *saldoResponseMapper) ToSaldoMonthBalanceResponses(ss []*record.SaldoMon
 func (s *saldoResponseMapper) ToSaldoYearBalanceResponse(ss *record.SaldoYearSaldoBalance) *response.SaldoYearBalanceResponse {
 	return &response.SaldoYearBalanceResponse{
 		Year:         ss.Year,
+		Currency:     ss.Currency,
 		TotalBalance: ss.TotalBalance,
 	}
 }
@@ -123,3 +133,32 @@ func (s *saldoResponseMapper) ToSaldoYearBalanceResponses(ss []*record.SaldoYear
 	}
 	return saldoResponses
 }
+
+func (s *saldoResponseMapper) ToSaldoHoldResponse(hold *record.SaldoHoldRecord) *response.SaldoHoldResponse {
+	return &response.SaldoHoldResponse{
+		ID:            hold.ID,
+		HoldNo:        hold.HoldNo,
+		CardNumber:    hold.CardNumber,
+		Amount:        hold.Amount,
+		Currency:      hold.Currency,
+		Reason:        hold.Reason,
+		ReferenceType: hold.ReferenceType,
+		ReferenceID:   hold.ReferenceID,
+		Status:        hold.Status,
+		CreatedBy:     hold.CreatedBy,
+		ReleasedAt:    hold.ReleasedAt,
+		ConsumedAt:    hold.ConsumedAt,
+		CreatedAt:     hold.CreatedAt,
+		UpdatedAt:     hold.UpdatedAt,
+	}
+}
+
+func (s *saldoResponseMapper) ToSaldoHoldResponses(holds []*record.SaldoHoldRecord) []*response.SaldoHoldResponse {
+	var responses []*response.SaldoHoldResponse
+
+	for _, hold := range holds {
+		responses = append(responses, s.ToSaldoHoldResponse(hold))
+	}
+
+	return responses
+}
@@ -0,0 +1,64 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type scheduledTransferResponseMapper struct {
+}
+
+func NewScheduledTransferResponseMapper() *scheduledTransferResponseMapper {
+	return &scheduledTransferResponseMapper{}
+}
+
+func (s *scheduledTransferResponseMapper) ToScheduledTransferResponse(schedule *record.ScheduledTransferRecord) *response.ScheduledTransferResponse {
+	return &response.ScheduledTransferResponse{
+		ID:             schedule.ID,
+		UserID:         schedule.UserID,
+		TransferFrom:   schedule.TransferFrom,
+		TransferTo:     schedule.TransferTo,
+		TransferAmount: schedule.TransferAmount,
+		Recurrence:     schedule.Recurrence,
+		DayOfMonth:     schedule.DayOfMonth,
+		NextRunAt:      schedule.NextRunAt,
+		EndAt:          schedule.EndAt,
+		Status:         schedule.Status,
+		LastRunAt:      schedule.LastRunAt,
+		CreatedAt:      schedule.CreatedAt,
+		UpdatedAt:      schedule.UpdatedAt,
+	}
+}
+
+func (s *scheduledTransferResponseMapper) ToScheduledTransfersResponse(schedules []*record.ScheduledTransferRecord) []*response.ScheduledTransferResponse {
+	var responses []*response.ScheduledTransferResponse
+
+	for _, schedule := range schedules {
+		responses = append(responses, s.ToScheduledTransferResponse(schedule))
+	}
+
+	return responses
+}
+
+func (s *scheduledTransferResponseMapper) ToScheduledTransferRunResponse(run *record.ScheduledTransferRunRecord) *response.ScheduledTransferRunResponse {
+	return &response.ScheduledTransferRunResponse{
+		ID:                  run.ID,
+		ScheduledTransferID: run.ScheduledTransferID,
+		ScheduledFor:        run.ScheduledFor,
+		Status:              run.Status,
+		TransferID:          run.TransferID,
+		FailureReason:       run.FailureReason,
+		CreatedAt:           run.CreatedAt,
+		UpdatedAt:           run.UpdatedAt,
+	}
+}
+
+func (s *scheduledTransferResponseMapper) ToScheduledTransferRunsResponse(runs []*record.ScheduledTransferRunRecord) []*response.ScheduledTransferRunResponse {
+	var responses []*response.ScheduledTransferRunResponse
+
+	for _, run := range runs {
+		responses = append(responses, s.ToScheduledTransferRunResponse(run))
+	}
+
+	return responses
+}
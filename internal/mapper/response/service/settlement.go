@@ -0,0 +1,84 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type settlementResponseMapper struct {
+}
+
+func NewSettlementResponseMapper() *settlementResponseMapper {
+	return &settlementResponseMapper{}
+}
+
+func (s *settlementResponseMapper) ToMerchantBalanceResponse(balance *record.MerchantBalanceRecord) *response.MerchantBalanceResponse {
+	return &response.MerchantBalanceResponse{
+		MerchantID:           balance.MerchantID,
+		Currency:             balance.Currency,
+		PendingBalance:       balance.PendingBalance,
+		SettlementCardNumber: balance.SettlementCardNumber,
+		UpdatedAt:            balance.UpdatedAt,
+	}
+}
+
+func (s *settlementResponseMapper) ToMerchantBalancesResponse(balances []*record.MerchantBalanceRecord) []*response.MerchantBalanceResponse {
+	var responses []*response.MerchantBalanceResponse
+
+	for _, balance := range balances {
+		responses = append(responses, s.ToMerchantBalanceResponse(balance))
+	}
+
+	return responses
+}
+
+func (s *settlementResponseMapper) ToSettlementBatchResponse(batch *record.SettlementBatchRecord) *response.SettlementBatchResponse {
+	return &response.SettlementBatchResponse{
+		ID:                   batch.ID,
+		MerchantID:           batch.MerchantID,
+		Currency:             batch.Currency,
+		SettlementCardNumber: batch.SettlementCardNumber,
+		CutoffAt:             batch.CutoffAt,
+		GrossAmount:          batch.GrossAmount,
+		FeeAmount:            batch.FeeAmount,
+		RefundAmount:         batch.RefundAmount,
+		AdjustmentAmount:     batch.AdjustmentAmount,
+		NetAmount:            batch.NetAmount,
+		ItemCount:            batch.ItemCount,
+		CreatedAt:            batch.CreatedAt,
+	}
+}
+
+func (s *settlementResponseMapper) ToSettlementBatchesResponse(batches []*record.SettlementBatchRecord) []*response.SettlementBatchResponse {
+	var responses []*response.SettlementBatchResponse
+
+	for _, batch := range batches {
+		responses = append(responses, s.ToSettlementBatchResponse(batch))
+	}
+
+	return responses
+}
+
+func (s *settlementResponseMapper) ToSettlementItemResponse(item *record.SettlementItemRecord) *response.SettlementItemResponse {
+	return &response.SettlementItemResponse{
+		ID:                item.ID,
+		SettlementBatchID: item.SettlementBatchID,
+		ItemType:          item.ItemType,
+		ReferenceID:       item.ReferenceID,
+		Amount:            item.Amount,
+		Fee:               item.Fee,
+		NetAmount:         item.NetAmount,
+		Currency:          item.Currency,
+		CreatedAt:         item.CreatedAt,
+	}
+}
+
+func (s *settlementResponseMapper) ToSettlementItemsResponse(items []*record.SettlementItemRecord) []*response.SettlementItemResponse {
+	var responses []*response.SettlementItemResponse
+
+	for _, item := range items {
+		responses = append(responses, s.ToSettlementItemResponse(item))
+	}
+
+	return responses
+}
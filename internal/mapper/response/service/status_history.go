@@ -0,0 +1,36 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type statusHistoryResponseMapper struct {
+}
+
+func NewStatusHistoryResponseMapper() *statusHistoryResponseMapper {
+	return &statusHistoryResponseMapper{}
+}
+
+func (s *statusHistoryResponseMapper) ToStatusHistoryResponse(history *record.StatusHistoryRecord) *response.StatusHistoryResponse {
+	return &response.StatusHistoryResponse{
+		ID:         history.ID,
+		EntityType: history.EntityType,
+		EntityID:   history.EntityID,
+		FromStatus: history.FromStatus,
+		ToStatus:   history.ToStatus,
+		Reason:     history.Reason,
+		ChangedBy:  history.ChangedBy,
+		CreatedAt:  history.CreatedAt,
+	}
+}
+
+func (s *statusHistoryResponseMapper) ToStatusHistoriesResponse(histories []*record.StatusHistoryRecord) []*response.StatusHistoryResponse {
+	var responses []*response.StatusHistoryResponse
+
+	for _, history := range histories {
+		responses = append(responses, s.ToStatusHistoryResponse(history))
+	}
+
+	return responses
+}
@@ -18,8 +18,11 @@ func (s *topupResponseMapper) ToTopupResponse(topup *record.TopupRecord) *respon
 		CardNumber:  topup.CardNumber,
 		TopupNo:     topup.TopupNo,
 		TopupAmount: topup.TopupAmount,
+		Currency:    topup.Currency,
+		Fee:         topup.Fee,
 		TopupMethod: topup.TopupMethod,
 		TopupTime:   topup.TopupTime,
+		Status:      topup.Status,
 		CreatedAt:   topup.CreatedAt,
 		UpdatedAt:   topup.UpdatedAt,
 	}
@@ -41,8 +44,11 @@ func (s *topupResponseMapper) ToTopupResponseDeleteAt(topup *record.TopupRecord)
 		CardNumber:  topup.CardNumber,
 		TopupNo:     topup.TopupNo,
 		TopupAmount: topup.TopupAmount,
+		Currency:    topup.Currency,
+		Fee:         topup.Fee,
 		TopupMethod: topup.TopupMethod,
 		TopupTime:   topup.TopupTime,
+		Status:      topup.Status,
 		CreatedAt:   topup.CreatedAt,
 		UpdatedAt:   topup.UpdatedAt,
 		DeletedAt:   topup.DeletedAt,
@@ -170,6 +176,7 @@ func (t *topupResponseMapper) ToTopupYearlyMethodResponses(s []*record.TopupYear
 func (t *topupResponseMapper) ToTopupMonthlyAmountResponse(s *record.TopupMonthAmount) *response.TopupMonthAmountResponse {
 	return &response.TopupMonthAmountResponse{
 		Month:       s.Month,
+		Currency:    s.Currency,
 		TotalAmount: int(s.TotalAmount),
 	}
 }
@@ -185,6 +192,7 @@ func (t *topupResponseMapper) ToTopupMonthlyAmountResponses(s []*record.TopupMon
 func (t *topupResponseMapper) ToTopupYearlyAmountResponse(s *record.TopupYearlyAmount) *response.TopupYearlyAmountResponse {
 	return &response.TopupYearlyAmountResponse{
 		Year:        s.Year,
+		Currency:    s.Currency,
 		TotalAmount: int(s.TotalAmount),
 	}
 }
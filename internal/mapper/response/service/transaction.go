@@ -18,8 +18,12 @@ func (s *transactionResponseMapper) ToTransactionResponse(transaction *record.Tr
 		TransactionNo:   transaction.TransactionNo,
 		CardNumber:      transaction.CardNumber,
 		Amount:          transaction.Amount,
+		Currency:        transaction.Currency,
+		Fee:             transaction.Fee,
 		PaymentMethod:   transaction.PaymentMethod,
 		MerchantID:      transaction.MerchantID,
+		Status:          transaction.Status,
+		RefundedAmount:  transaction.RefundedAmount,
 		TransactionTime: transaction.TransactionTime,
 		CreatedAt:       transaction.CreatedAt,
 		UpdatedAt:       transaction.UpdatedAt,
@@ -40,6 +44,8 @@ func (s *transactionResponseMapper) ToTransactionResponseDeleteAt(transaction *r
 		TransactionNo:   transaction.TransactionNo,
 		CardNumber:      transaction.CardNumber,
 		Amount:          transaction.Amount,
+		Currency:        transaction.Currency,
+		Fee:             transaction.Fee,
 		PaymentMethod:   transaction.PaymentMethod,
 		MerchantID:      transaction.MerchantID,
 		TransactionTime: transaction.TransactionTime,
@@ -169,6 +175,7 @@ func (t *transactionResponseMapper) ToTransactionYearlyMethodResponses(s []*reco
 func (t *transactionResponseMapper) ToTransactionMonthlyAmountResponse(s *record.TransactionMonthAmount) *response.TransactionMonthAmountResponse {
 	return &response.TransactionMonthAmountResponse{
 		Month:       s.Month,
+		Currency:    s.Currency,
 		TotalAmount: int(s.TotalAmount),
 	}
 }
@@ -184,6 +191,7 @@ func (t *transactionResponseMapper) ToTransactionMonthlyAmountResponses(s []*rec
 func (t *transactionResponseMapper) ToTransactionYearlyAmountResponse(s *record.TransactionYearlyAmount) *response.TransactionYearlyAmountResponse {
 	return &response.TransactionYearlyAmountResponse{
 		Year:        s.Year,
+		Currency:    s.Currency,
 		TotalAmount: int(s.TotalAmount),
 	}
 }
@@ -0,0 +1,69 @@
+package responseservice
+
+import (
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type transactionLimitResponseMapper struct {
+}
+
+func NewTransactionLimitResponseMapper() *transactionLimitResponseMapper {
+	return &transactionLimitResponseMapper{}
+}
+
+func (t *transactionLimitResponseMapper) ToTransactionLimitResponse(limit *record.TransactionLimitRecord) *response.TransactionLimitResponse {
+	return &response.TransactionLimitResponse{
+		ID:                      limit.ID,
+		Name:                    limit.Name,
+		OperationType:           limit.OperationType,
+		Period:                  limit.Period,
+		CardType:                limit.CardType,
+		UserTier:                limit.UserTier,
+		Currency:                limit.Currency,
+		MaxAmountPerTransaction: limit.MaxAmountPerTransaction,
+		MaxCount:                limit.MaxCount,
+		MaxTotalAmount:          limit.MaxTotalAmount,
+		IsActive:                limit.IsActive,
+		CreatedAt:               limit.CreatedAt,
+		UpdatedAt:               limit.UpdatedAt,
+	}
+}
+
+func (t *transactionLimitResponseMapper) ToTransactionLimitsResponse(limits []*record.TransactionLimitRecord) []*response.TransactionLimitResponse {
+	var responses []*response.TransactionLimitResponse
+
+	for _, limit := range limits {
+		responses = append(responses, t.ToTransactionLimitResponse(limit))
+	}
+
+	return responses
+}
+
+func (t *transactionLimitResponseMapper) ToTransactionLimitHeadroomResponse(limit *record.TransactionLimitRecord, usage *record.TransactionLimitUsageRecord, resetsAt time.Time) *response.TransactionLimitHeadroomResponse {
+	return &response.TransactionLimitHeadroomResponse{
+		LimitID:                 limit.ID,
+		Name:                    limit.Name,
+		OperationType:           limit.OperationType,
+		Period:                  limit.Period,
+		MaxAmountPerTransaction: limit.MaxAmountPerTransaction,
+		UsedCount:               usage.Count,
+		RemainingCount:          remainingOf(limit.MaxCount, usage.Count),
+		UsedAmount:              usage.TotalAmount,
+		RemainingAmount:         remainingOf(limit.MaxTotalAmount, usage.TotalAmount),
+		ResetsAt:                resetsAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// remainingOf returns what is left of a cap, never below zero, or nil when
+// the cap is not set.
+func remainingOf(limitCap *int, used int) *int {
+	if limitCap == nil {
+		return nil
+	}
+
+	remaining := max(*limitCap-used, 0)
+	return &remaining
+}
@@ -14,14 +14,20 @@ func NewTransferResponseMapper() *transferResponseMapper {
 
 func (s *transferResponseMapper) ToTransferResponse(transfer *record.TransferRecord) *response.TransferResponse {
 	return &response.TransferResponse{
-		ID:             transfer.ID,
-		TransferNo:     transfer.TransferNo,
-		TransferFrom:   transfer.TransferFrom,
-		TransferTo:     transfer.TransferTo,
-		TransferAmount: transfer.TransferAmount,
-		TransferTime:   transfer.TransferTime,
-		CreatedAt:      transfer.CreatedAt,
-		UpdatedAt:      transfer.UpdatedAt,
+		ID:              transfer.ID,
+		TransferNo:      transfer.TransferNo,
+		TransferFrom:    transfer.TransferFrom,
+		TransferTo:      transfer.TransferTo,
+		TransferAmount:  transfer.TransferAmount,
+		Currency:        transfer.Currency,
+		Fee:             transfer.Fee,
+		ToCurrency:      transfer.ToCurrency,
+		ConvertedAmount: transfer.ConvertedAmount,
+		ExchangeRate:    transfer.ExchangeRate,
+		SpreadBps:       transfer.SpreadBps,
+		TransferTime:    transfer.TransferTime,
+		CreatedAt:       transfer.CreatedAt,
+		UpdatedAt:       transfer.UpdatedAt,
 	}
 }
 
@@ -37,15 +43,21 @@ func (s *transferResponseMapper) ToTransfersResponse(transfers []*record.Transfe
 
 func (s *transferResponseMapper) ToTransferResponseDeleteAt(transfer *record.TransferRecord) *response.TransferResponseDeleteAt {
 	return &response.TransferResponseDeleteAt{
-		ID:             transfer.ID,
-		TransferNo:     transfer.TransferNo,
-		TransferFrom:   transfer.TransferFrom,
-		TransferTo:     transfer.TransferTo,
-		TransferAmount: transfer.TransferAmount,
-		TransferTime:   transfer.TransferTime,
-		CreatedAt:      transfer.CreatedAt,
-		UpdatedAt:      transfer.UpdatedAt,
-		DeletedAt:      transfer.DeletedAt,
+		ID:              transfer.ID,
+		TransferNo:      transfer.TransferNo,
+		TransferFrom:    transfer.TransferFrom,
+		TransferTo:      transfer.TransferTo,
+		TransferAmount:  transfer.TransferAmount,
+		Currency:        transfer.Currency,
+		Fee:             transfer.Fee,
+		ToCurrency:      transfer.ToCurrency,
+		ConvertedAmount: transfer.ConvertedAmount,
+		ExchangeRate:    transfer.ExchangeRate,
+		SpreadBps:       transfer.SpreadBps,
+		TransferTime:    transfer.TransferTime,
+		CreatedAt:       transfer.CreatedAt,
+		UpdatedAt:       transfer.UpdatedAt,
+		DeletedAt:       transfer.DeletedAt,
 	}
 }
 
@@ -136,6 +148,7 @@ func (t *transferResponseMapper) ToTransferResponsesYearStatusFailed(Transfers [
 func (t *transferResponseMapper) ToTransferResponseMonthAmount(s *record.TransferMonthAmount) *response.TransferMonthAmountResponse {
 	return &response.TransferMonthAmountResponse{
 		Month:       s.Month,
+		Currency:    s.Currency,
 		TotalAmount: int(s.TotalAmount),
 	}
 }
@@ -151,6 +164,7 @@ func (t *transferResponseMapper) ToTransferResponsesMonthAmount(s []*record.Tran
 func (t *transferResponseMapper) ToTransferResponseYearAmount(s *record.TransferYearAmount) *response.TransferYearAmountResponse {
 	return &response.TransferYearAmountResponse{
 		Year:        s.Year,
+		Currency:    s.Currency,
 		TotalAmount: int(s.TotalAmount),
 	}
 }
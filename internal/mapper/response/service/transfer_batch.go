@@ -0,0 +1,84 @@
+package responseservice
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+type transferBatchResponseMapper struct {
+}
+
+func NewTransferBatchResponseMapper() *transferBatchResponseMapper {
+	return &transferBatchResponseMapper{}
+}
+
+func (s *transferBatchResponseMapper) ToTransferBatchResponse(batch *record.TransferBatchRecord) *response.TransferBatchResponse {
+	runnable := batch.TotalRows - batch.InvalidRows
+	finished := batch.SuccessRows + batch.FailedRows
+
+	pending := runnable - finished
+	progress := 100
+
+	if batch.Status == requests.TransferBatchStatusRejected {
+		pending = 0
+	} else if runnable > 0 {
+		progress = finished * 100 / runnable
+	}
+
+	return &response.TransferBatchResponse{
+		ID:              batch.ID,
+		UserID:          batch.UserID,
+		TransferFrom:    batch.TransferFrom,
+		FileFormat:      batch.FileFormat,
+		Status:          batch.Status,
+		TotalRows:       batch.TotalRows,
+		TotalAmount:     batch.TotalAmount,
+		InvalidRows:     batch.InvalidRows,
+		PendingRows:     pending,
+		SuccessRows:     batch.SuccessRows,
+		FailedRows:      batch.FailedRows,
+		SuccessAmount:   batch.SuccessAmount,
+		ProgressPercent: progress,
+		StartedAt:       batch.StartedAt,
+		CompletedAt:     batch.CompletedAt,
+		CreatedAt:       batch.CreatedAt,
+		UpdatedAt:       batch.UpdatedAt,
+	}
+}
+
+func (s *transferBatchResponseMapper) ToTransferBatchesResponse(batches []*record.TransferBatchRecord) []*response.TransferBatchResponse {
+	var responses []*response.TransferBatchResponse
+
+	for _, batch := range batches {
+		responses = append(responses, s.ToTransferBatchResponse(batch))
+	}
+
+	return responses
+}
+
+func (s *transferBatchResponseMapper) ToTransferBatchRowResponse(row *record.TransferBatchRowRecord) *response.TransferBatchRowResponse {
+	return &response.TransferBatchRowResponse{
+		ID:              row.ID,
+		TransferBatchID: row.TransferBatchID,
+		RowNumber:       row.RowNumber,
+		TransferTo:      row.TransferTo,
+		TransferAmount:  row.TransferAmount,
+		Note:            row.Note,
+		Status:          row.Status,
+		TransferID:      row.TransferID,
+		FailureReason:   row.FailureReason,
+		CreatedAt:       row.CreatedAt,
+		UpdatedAt:       row.UpdatedAt,
+	}
+}
+
+func (s *transferBatchResponseMapper) ToTransferBatchRowsResponse(rows []*record.TransferBatchRowRecord) []*response.TransferBatchRowResponse {
+	var responses []*response.TransferBatchRowResponse
+
+	for _, row := range rows {
+		responses = append(responses, s.ToTransferBatchRowResponse(row))
+	}
+
+	return responses
+}
@@ -18,6 +18,7 @@ func (s *userResponseMapper) ToUserResponse(user *record.UserRecord) *response.U
 		FirstName: user.FirstName,
 		LastName:  user.LastName,
 		Email:     user.Email,
+		Tier:      user.Tier,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 	}
@@ -39,6 +40,7 @@ func (s *userResponseMapper) ToUserResponseDeleteAt(user *record.UserRecord) *re
 		FirstName: user.FirstName,
 		LastName:  user.LastName,
 		Email:     user.Email,
+		Tier:      user.Tier,
 		CreatedAt: user.CreatedAt,
 		UpdatedAt: user.UpdatedAt,
 		DeletedAt: user.DeletedAt,
@@ -18,7 +18,10 @@ func (s *withdrawResponseMapper) ToWithdrawResponse(withdraw *record.WithdrawRec
 		WithdrawNo:     withdraw.WithdrawNo,
 		CardNumber:     withdraw.CardNumber,
 		WithdrawAmount: withdraw.WithdrawAmount,
+		Currency:       withdraw.Currency,
+		Fee:            withdraw.Fee,
 		WithdrawTime:   withdraw.WithdrawTime,
+		Status:         withdraw.Status,
 		CreatedAt:      withdraw.CreatedAt,
 		UpdatedAt:      withdraw.UpdatedAt,
 	}
@@ -38,7 +41,10 @@ func (s *withdrawResponseMapper) ToWithdrawResponseDeleteAt(withdraw *record.Wit
 		WithdrawNo:     withdraw.WithdrawNo,
 		CardNumber:     withdraw.CardNumber,
 		WithdrawAmount: withdraw.WithdrawAmount,
+		Currency:       withdraw.Currency,
+		Fee:            withdraw.Fee,
 		WithdrawTime:   withdraw.WithdrawTime,
+		Status:         withdraw.Status,
 		CreatedAt:      withdraw.CreatedAt,
 		UpdatedAt:      withdraw.UpdatedAt,
 		DeletedAt:      withdraw.DeletedAt,
@@ -131,6 +137,7 @@ func (t *withdrawResponseMapper) ToWithdrawResponsesYearStatusFailed(Withdraws [
 func (w *withdrawResponseMapper) ToWithdrawAmountMonthlyResponse(s *record.WithdrawMonthlyAmount) *response.WithdrawMonthlyAmountResponse {
 	return &response.WithdrawMonthlyAmountResponse{
 		Month:       s.Month,
+		Currency:    s.Currency,
 		TotalAmount: s.TotalAmount,
 	}
 }
@@ -146,6 +153,7 @@ func (w *withdrawResponseMapper) ToWithdrawsAmountMonthlyResponses(s []*record.W
 func (w *withdrawResponseMapper) ToWithdrawAmountYearlyResponse(s *record.WithdrawYearlyAmount) *response.WithdrawYearlyAmountResponse {
 	return &response.WithdrawYearlyAmountResponse{
 		Year:        s.Year,
+		Currency:    s.Currency,
 		TotalAmount: s.TotalAmount,
 	}
 }
@@ -157,3 +165,14 @@ func (w *withdrawResponseMapper) ToWithdrawsAmountYearlyResponses(s []*record.Wi
 	}
 	return withdrawResponses
 }
+
+func (w *withdrawResponseMapper) ToWithdrawReviewResponse(review *record.WithdrawReviewRecord) *response.WithdrawReviewResponse {
+	return &response.WithdrawReviewResponse{
+		ID:         review.ID,
+		WithdrawID: review.WithdrawID,
+		Decision:   review.Decision,
+		Note:       review.Note,
+		ReviewedBy: review.ReviewedBy,
+		CreatedAt:  review.CreatedAt,
+	}
+}
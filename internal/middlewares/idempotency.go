@@ -0,0 +1,24 @@
+package middlewares
+
+import (
+	"net/http"
+	"strings"
+
+	mycontext "github.com/MamangRust/paymentgatewaygraphql/pkg/context"
+)
+
+const IdempotencyKeyHeader = "Idempotency-Key"
+
+// IdempotencyMiddleware exposes the Idempotency-Key header to the resolvers.
+func IdempotencyMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		key := strings.TrimSpace(r.Header.Get(IdempotencyKeyHeader))
+		if key == "" {
+			next.ServeHTTP(w, r)
+			return
+		}
+
+		ctx := mycontext.WithIdempotencyKey(r.Context(), key)
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
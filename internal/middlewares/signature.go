@@ -0,0 +1,65 @@
+package middlewares
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/service"
+	apikey "github.com/MamangRust/paymentgatewaygraphql/pkg/api-key"
+	mycontext "github.com/MamangRust/paymentgatewaygraphql/pkg/context"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// SignatureMiddleware verifies requests a merchant signed with the signing
+// secret of one of its API keys, and exposes the key to the resolvers so
+// merchant calls don't have to carry the key in their input. Requests
+// without a signature pass through untouched; a request with a bad, stale
+// or replayed signature is rejected before it reaches the resolvers. The
+// body of a signed request is read to check the signature, so it is capped
+// at maxBodyBytes.
+func SignatureMiddleware(apiKeys service.MerchantApiKeyService, maxBodyBytes int64, logger logger.LoggerInterface) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get(apikey.HeaderKeyID) == "" && r.Header.Get(apikey.HeaderSignature) == "" {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxBodyBytes))
+			if err != nil {
+				var tooLarge *http.MaxBytesError
+				if errors.As(err, &tooLarge) {
+					logger.Error("Signed request body too large", zap.Int64("limit", tooLarge.Limit))
+					writeJSONError(w, "request body too large", http.StatusRequestEntityTooLarge)
+					return
+				}
+
+				logger.Error("Failed to read request body", zap.Error(err))
+				writeJSONError(w, "failed to read request body", http.StatusBadRequest)
+				return
+			}
+			r.Body = io.NopCloser(bytes.NewBuffer(body))
+
+			key, errResp := apiKeys.VerifySignature(&requests.VerifyMerchantRequestSignature{
+				ApiKeyID:  r.Header.Get(apikey.HeaderKeyID),
+				Method:    r.Method,
+				Path:      r.URL.RequestURI(),
+				Timestamp: r.Header.Get(apikey.HeaderTimestamp),
+				Nonce:     r.Header.Get(apikey.HeaderNonce),
+				Signature: r.Header.Get(apikey.HeaderSignature),
+				Body:      body,
+			})
+			if errResp != nil {
+				writeJSONError(w, errResp.Message, errResp.Code)
+				return
+			}
+
+			ctx := mycontext.WithMerchantApiKeyID(r.Context(), key.ID)
+			next.ServeHTTP(w, r.WithContext(ctx))
+		})
+	}
+}
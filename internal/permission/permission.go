@@ -3,23 +3,25 @@ package permission
 import (
 	"errors"
 
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/service"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/statemachine"
 )
 
 type Permission interface {
 	HasRole(userID int, allowedRoles ...string) (bool, error)
-	ValidateApiKey(apiKey string) (bool, error)
+	ValidateApiKey(credential requests.MerchantApiKeyCredential, scope string) (bool, error)
 }
 
 type permission struct {
-	roleService     service.RoleService
-	merchantService service.MerchantService
+	roleService           service.RoleService
+	merchantApiKeyService service.MerchantApiKeyService
 }
 
-func NewPermission(roleService service.RoleService, merchantService service.MerchantService) *permission {
+func NewPermission(roleService service.RoleService, merchantApiKeyService service.MerchantApiKeyService) *permission {
 	return &permission{
-		roleService:     roleService,
-		merchantService: merchantService,
+		roleService:           roleService,
+		merchantApiKeyService: merchantApiKeyService,
 	}
 }
 
@@ -39,14 +41,20 @@ func (s *permission) HasRole(userID int, allowedRoles ...string) (bool, error) {
 	return false, nil
 }
 
-func (p *permission) ValidateApiKey(apiKey string) (bool, error) {
-	if apiKey == "" {
-		return false, errors.New("missing API key")
+// ValidateApiKey reports whether the API key of credential is live, allows
+// scope and belongs to an active merchant.
+func (p *permission) ValidateApiKey(credential requests.MerchantApiKeyCredential, scope string) (bool, error) {
+	if credential.ApiKey == "" && credential.ApiKeyID == 0 {
+		return false, errors.New("missing API key or request signature")
 	}
 
-	_, err := p.merchantService.FindByApiKey(apiKey)
-	if err != nil {
-		return false, errors.New("invalid API key")
+	merchant, errResp := p.merchantApiKeyService.Authenticate(credential, scope)
+	if errResp != nil {
+		return false, errors.New(errResp.Message)
+	}
+
+	if merchant.Status != statemachine.StatusActive {
+		return false, errors.New("merchant is not active")
 	}
 
 	return true, nil
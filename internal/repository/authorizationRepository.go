@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/authorization_errors"
+)
+
+type authorizationRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.AuthorizationRecordMapping
+}
+
+func NewAuthorizationRepository(db *db.Queries, ctx context.Context, mapping recordmapper.AuthorizationRecordMapping) *authorizationRepository {
+	return &authorizationRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *authorizationRepository) FindAll(req *requests.FindAllAuthorizations) ([]*record.AuthorizationRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetAuthorizationsParams{
+		Column1: req.Search,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	}
+
+	authorizations, err := r.db.GetAuthorizations(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, authorization_errors.ErrFindAllAuthorizationsFailed
+	}
+
+	var totalCount int
+	if len(authorizations) > 0 {
+		totalCount = int(authorizations[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToAuthorizationsRecordAll(authorizations), &totalCount, nil
+}
+
+func (r *authorizationRepository) FindById(authorization_id int) (*record.AuthorizationRecord, error) {
+	res, err := r.db.GetAuthorizationByID(r.ctx, int32(authorization_id))
+
+	if err != nil {
+		return nil, authorization_errors.ErrFindAuthorizationByIdFailed
+	}
+
+	return r.mapping.ToAuthorizationRecord(res), nil
+}
+
+func (r *authorizationRepository) CreateAuthorization(request *requests.CreateAuthorizationRequest, expiresAt time.Time) (*record.AuthorizationRecord, error) {
+	req := db.CreateAuthorizationParams{
+		CardNumber:    request.CardNumber,
+		MerchantID:    int32(*request.MerchantID),
+		Amount:        int32(request.Amount),
+		PaymentMethod: request.PaymentMethod,
+		ExpiresAt:     expiresAt,
+	}
+
+	res, err := r.db.CreateAuthorization(r.ctx, req)
+
+	if err != nil {
+		return nil, authorization_errors.ErrCreateAuthorizationFailed
+	}
+
+	return r.mapping.ToAuthorizationRecord(res), nil
+}
+
+func (r *authorizationRepository) CaptureAuthorization(authorization_id int, amount int, transaction_id int) (*record.AuthorizationRecord, error) {
+	res, err := r.db.CaptureAuthorization(r.ctx, db.CaptureAuthorizationParams{
+		AuthorizationID: int32(authorization_id),
+		CapturedAmount:  int32(amount),
+		TransactionID:   sql.NullInt32{Int32: int32(transaction_id), Valid: true},
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, authorization_errors.ErrAuthorizationStatusConflict
+		}
+		return nil, authorization_errors.ErrCaptureAuthorizationFailed
+	}
+
+	return r.mapping.ToAuthorizationRecord(res), nil
+}
+
+func (r *authorizationRepository) VoidAuthorization(authorization_id int) (*record.AuthorizationRecord, error) {
+	res, err := r.db.VoidAuthorization(r.ctx, int32(authorization_id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, authorization_errors.ErrAuthorizationStatusConflict
+		}
+		return nil, authorization_errors.ErrVoidAuthorizationFailed
+	}
+
+	return r.mapping.ToAuthorizationRecord(res), nil
+}
+
+func (r *authorizationRepository) ExpireAuthorizations(limit int) ([]*record.AuthorizationRecord, error) {
+	res, err := r.db.ExpireAuthorizations(r.ctx, int32(limit))
+
+	if err != nil {
+		return nil, authorization_errors.ErrExpireAuthorizationsFailed
+	}
+
+	return r.mapping.ToAuthorizationsRecord(res), nil
+}
@@ -184,6 +184,16 @@ func (r *cardRepository) GetTotalTransferAmount() (*int64, error) {
 	return &res, nil
 }
 
+func (r *cardRepository) GetTotalAmountsByCurrency() ([]*record.CardCurrencyTotals, error) {
+	res, err := r.db.GetTotalAmountsByCurrency(r.ctx)
+
+	if err != nil {
+		return nil, card_errors.ErrGetTotalAmountsByCurrencyFailed
+	}
+
+	return r.mapping.ToCurrencyTotalsList(res), nil
+}
+
 func (r *cardRepository) GetTotalBalanceByCardNumber(cardNumber string) (*int64, error) {
 	res, err := r.db.GetTotalBalanceByCardNumber(r.ctx, cardNumber)
 
@@ -558,6 +568,7 @@ func (r *cardRepository) CreateCard(request *requests.CreateCardRequest) (*recor
 		ExpireDate:   request.ExpireDate,
 		Cvv:          request.CVV,
 		CardProvider: request.CardProvider,
+		Currency:     request.Currency,
 	}
 
 	res, err := r.db.CreateCard(r.ctx, req)
@@ -0,0 +1,142 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/dispute_errors"
+)
+
+type disputeRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.DisputeRecordMapping
+}
+
+func NewDisputeRepository(db *db.Queries, ctx context.Context, mapping recordmapper.DisputeRecordMapping) *disputeRepository {
+	return &disputeRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *disputeRepository) FindAll(req *requests.FindAllDisputes) ([]*record.DisputeRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetDisputesParams{
+		Column1: req.Search,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	}
+
+	disputes, err := r.db.GetDisputes(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, dispute_errors.ErrFindAllDisputesFailed
+	}
+
+	var totalCount int
+	if len(disputes) > 0 {
+		totalCount = int(disputes[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToDisputesRecordAll(disputes), &totalCount, nil
+}
+
+func (r *disputeRepository) FindByMerchant(req *requests.FindAllDisputesByMerchant) ([]*record.DisputeRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetDisputesByMerchantIDParams{
+		MerchantID: int32(req.MerchantID),
+		Column2:    req.Search,
+		Limit:      int32(req.PageSize),
+		Offset:     int32(offset),
+	}
+
+	disputes, err := r.db.GetDisputesByMerchantID(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, dispute_errors.ErrFindDisputesByMerchantFailed
+	}
+
+	var totalCount int
+	if len(disputes) > 0 {
+		totalCount = int(disputes[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToDisputesRecordByMerchant(disputes), &totalCount, nil
+}
+
+func (r *disputeRepository) FindById(dispute_id int) (*record.DisputeRecord, error) {
+	res, err := r.db.GetDisputeByID(r.ctx, int32(dispute_id))
+
+	if err != nil {
+		return nil, dispute_errors.ErrFindDisputeByIdFailed
+	}
+
+	return r.mapping.ToDisputeRecord(res), nil
+}
+
+func (r *disputeRepository) CreateDispute(transaction *record.TransactionRecord, amount int, heldFrom string, request *requests.CreateDisputeRequest) (*record.DisputeRecord, error) {
+	req := db.CreateDisputeParams{
+		TransactionID: int32(transaction.ID),
+		CardNumber:    transaction.CardNumber,
+		MerchantID:    int32(transaction.MerchantID),
+		Amount:        int32(amount),
+		Reason:        request.Reason,
+		OpenedBy:      int32(request.OpenedBy),
+		HeldFrom:      heldFrom,
+	}
+
+	res, err := r.db.CreateDispute(r.ctx, req)
+
+	if err != nil {
+		return nil, dispute_errors.ErrCreateDisputeFailed
+	}
+
+	return r.mapping.ToDisputeRecord(res), nil
+}
+
+func (r *disputeRepository) RespondDispute(request *requests.RespondDisputeRequest) (*record.DisputeRecord, error) {
+	res, err := r.db.RespondDispute(r.ctx, db.RespondDisputeParams{
+		DisputeID:        int32(request.DisputeID),
+		MerchantEvidence: sql.NullString{String: request.Evidence, Valid: true},
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, dispute_errors.ErrDisputeStatusConflict
+		}
+		return nil, dispute_errors.ErrRespondDisputeFailed
+	}
+
+	return r.mapping.ToDisputeRecord(res), nil
+}
+
+func (r *disputeRepository) ResolveDispute(request *requests.ResolveDisputeRequest, status string) (*record.DisputeRecord, error) {
+	res, err := r.db.ResolveDispute(r.ctx, db.ResolveDisputeParams{
+		DisputeID:      int32(request.DisputeID),
+		Status:         status,
+		ResolutionNote: sql.NullString{String: request.Note, Valid: true},
+		ResolvedBy:     sql.NullInt32{Int32: int32(request.ResolvedBy), Valid: true},
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, dispute_errors.ErrDisputeStatusConflict
+		}
+		return nil, dispute_errors.ErrResolveDisputeFailed
+	}
+
+	return r.mapping.ToDisputeRecord(res), nil
+}
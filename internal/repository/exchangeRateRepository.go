@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/exchange_rate_errors"
+)
+
+type exchangeRateRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.ExchangeRateRecordMapping
+}
+
+func NewExchangeRateRepository(db *db.Queries, ctx context.Context, mapping recordmapper.ExchangeRateRecordMapping) *exchangeRateRepository {
+	return &exchangeRateRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *exchangeRateRepository) FindAll(req *requests.FindAllExchangeRates) ([]*record.ExchangeRateRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetExchangeRatesParams{
+		Column1: req.Search,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	}
+
+	rates, err := r.db.GetExchangeRates(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, exchange_rate_errors.ErrFindAllExchangeRatesFailed
+	}
+
+	var totalCount int
+	if len(rates) > 0 {
+		totalCount = int(rates[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToExchangeRatesRecordAll(rates), &totalCount, nil
+}
+
+func (r *exchangeRateRepository) FindById(exchange_rate_id int) (*record.ExchangeRateRecord, error) {
+	res, err := r.db.GetExchangeRateByID(r.ctx, int32(exchange_rate_id))
+
+	if err != nil {
+		return nil, exchange_rate_errors.ErrFindExchangeRateByIdFailed
+	}
+
+	return r.mapping.ToExchangeRateRecord(res), nil
+}
+
+func (r *exchangeRateRepository) FindByPair(base_currency string, quote_currency string) (*record.ExchangeRateRecord, error) {
+	res, err := r.db.GetExchangeRateByPair(r.ctx, db.GetExchangeRateByPairParams{
+		BaseCurrency:  base_currency,
+		QuoteCurrency: quote_currency,
+	})
+
+	if err != nil {
+		return nil, exchange_rate_errors.ErrFindExchangeRateByPairFailed
+	}
+
+	return r.mapping.ToExchangeRateRecord(res), nil
+}
+
+func (r *exchangeRateRepository) UpsertExchangeRate(request *requests.UpsertExchangeRateRequest) (*record.ExchangeRateRecord, error) {
+	req := db.UpsertExchangeRateParams{
+		BaseCurrency:  request.BaseCurrency,
+		QuoteCurrency: request.QuoteCurrency,
+		Rate:          request.Rate,
+		SpreadBps:     int32(request.SpreadBps),
+	}
+
+	res, err := r.db.UpsertExchangeRate(r.ctx, req)
+
+	if err != nil {
+		return nil, exchange_rate_errors.ErrUpsertExchangeRateFailed
+	}
+
+	return r.mapping.ToExchangeRateRecord(res), nil
+}
+
+func (r *exchangeRateRepository) DeleteExchangeRate(exchange_rate_id int) (*record.ExchangeRateRecord, error) {
+	res, err := r.db.DeleteExchangeRate(r.ctx, int32(exchange_rate_id))
+
+	if err != nil {
+		return nil, exchange_rate_errors.ErrDeleteExchangeRateFailed
+	}
+
+	return r.mapping.ToExchangeRateRecord(res), nil
+}
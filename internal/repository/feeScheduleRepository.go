@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/fee_schedule_errors"
+)
+
+type feeScheduleRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.FeeScheduleRecordMapping
+}
+
+func NewFeeScheduleRepository(db *db.Queries, ctx context.Context, mapping recordmapper.FeeScheduleRecordMapping) *feeScheduleRepository {
+	return &feeScheduleRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *feeScheduleRepository) FindAll(req *requests.FindAllFeeSchedules) ([]*record.FeeScheduleRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetFeeSchedulesParams{
+		Column1: req.Search,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	}
+
+	schedules, err := r.db.GetFeeSchedules(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, fee_schedule_errors.ErrFindAllFeeSchedulesFailed
+	}
+
+	var totalCount int
+	if len(schedules) > 0 {
+		totalCount = int(schedules[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	ids := make([]int32, 0, len(schedules))
+	for _, schedule := range schedules {
+		ids = append(ids, schedule.FeeScheduleID)
+	}
+
+	tiers, err := r.db.GetFeeScheduleTiersByScheduleIDs(r.ctx, ids)
+
+	if err != nil {
+		return nil, nil, fee_schedule_errors.ErrFindFeeScheduleTiersFailed
+	}
+
+	return r.mapping.ToFeeSchedulesRecordAll(schedules, tiers), &totalCount, nil
+}
+
+func (r *feeScheduleRepository) FindById(fee_schedule_id int) (*record.FeeScheduleRecord, error) {
+	res, err := r.db.GetFeeScheduleByID(r.ctx, int32(fee_schedule_id))
+
+	if err != nil {
+		return nil, fee_schedule_errors.ErrFindFeeScheduleByIdFailed
+	}
+
+	return r.withTiers(res)
+}
+
+// FindApplicable returns the most specific active schedule for the
+// transaction, or ErrNoApplicableFeeSchedule when it is free of charge.
+func (r *feeScheduleRepository) FindApplicable(req *requests.FindApplicableFeeSchedule) (*record.FeeScheduleRecord, error) {
+	res, err := r.db.GetApplicableFeeSchedule(r.ctx, db.GetApplicableFeeScheduleParams{
+		TransactionType: req.TransactionType,
+		Column2:         req.PaymentMethod,
+		Column3:         req.CardType,
+		Column4:         req.Currency,
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, fee_schedule_errors.ErrNoApplicableFeeSchedule
+		}
+		return nil, fee_schedule_errors.ErrFindApplicableFeeScheduleFailed
+	}
+
+	return r.withTiers(res)
+}
+
+func (r *feeScheduleRepository) CreateFeeSchedule(request *requests.CreateFeeScheduleRequest) (*record.FeeScheduleRecord, error) {
+	req := db.CreateFeeScheduleParams{
+		Name:            request.Name,
+		TransactionType: request.TransactionType,
+		FeeType:         request.FeeType,
+		PaymentMethod:   nullableFeeFilter(request.PaymentMethod),
+		CardType:        nullableFeeFilter(request.CardType),
+		Currency:        nullableFeeFilter(request.Currency),
+		FlatAmount:      int32(request.FlatAmount),
+		PercentageBps:   int32(request.PercentageBps),
+		MinFee:          nullableFeeBound(request.MinFee),
+		MaxFee:          nullableFeeBound(request.MaxFee),
+		IsActive:        request.IsActive,
+	}
+
+	res, err := r.db.CreateFeeSchedule(r.ctx, req)
+
+	if err != nil {
+		return nil, fee_schedule_errors.ErrCreateFeeScheduleFailed
+	}
+
+	tiers, err := r.createTiers(res.FeeScheduleID, request.Tiers)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapping.ToFeeScheduleRecord(res, tiers), nil
+}
+
+// UpdateFeeSchedule updates the schedule and replaces all of its tiers, so it
+// must run inside a transaction.
+func (r *feeScheduleRepository) UpdateFeeSchedule(request *requests.UpdateFeeScheduleRequest) (*record.FeeScheduleRecord, error) {
+	req := db.UpdateFeeScheduleParams{
+		FeeScheduleID:   int32(*request.FeeScheduleID),
+		Name:            request.Name,
+		TransactionType: request.TransactionType,
+		FeeType:         request.FeeType,
+		PaymentMethod:   nullableFeeFilter(request.PaymentMethod),
+		CardType:        nullableFeeFilter(request.CardType),
+		Currency:        nullableFeeFilter(request.Currency),
+		FlatAmount:      int32(request.FlatAmount),
+		PercentageBps:   int32(request.PercentageBps),
+		MinFee:          nullableFeeBound(request.MinFee),
+		MaxFee:          nullableFeeBound(request.MaxFee),
+		IsActive:        request.IsActive,
+	}
+
+	res, err := r.db.UpdateFeeSchedule(r.ctx, req)
+
+	if err != nil {
+		return nil, fee_schedule_errors.ErrUpdateFeeScheduleFailed
+	}
+
+	if err := r.db.DeleteFeeScheduleTiers(r.ctx, res.FeeScheduleID); err != nil {
+		return nil, fee_schedule_errors.ErrReplaceFeeScheduleTiersFailed
+	}
+
+	tiers, err := r.createTiers(res.FeeScheduleID, request.Tiers)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return r.mapping.ToFeeScheduleRecord(res, tiers), nil
+}
+
+func (r *feeScheduleRepository) DeleteFeeSchedule(fee_schedule_id int) (*record.FeeScheduleRecord, error) {
+	res, err := r.db.DeleteFeeSchedule(r.ctx, int32(fee_schedule_id))
+
+	if err != nil {
+		return nil, fee_schedule_errors.ErrDeleteFeeScheduleFailed
+	}
+
+	return r.mapping.ToFeeScheduleRecord(res, nil), nil
+}
+
+func (r *feeScheduleRepository) GetMonthlyFeeAmounts(year int) ([]*record.FeeMonthAmount, error) {
+	yearStart := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	res, err := r.db.GetMonthlyFeeAmounts(r.ctx, yearStart)
+
+	if err != nil {
+		return nil, fee_schedule_errors.ErrGetMonthlyFeeAmountsFailed
+	}
+
+	return r.mapping.ToFeeMonthlyAmounts(res), nil
+}
+
+func (r *feeScheduleRepository) GetYearlyFeeAmounts(year int) ([]*record.FeeYearlyAmount, error) {
+	res, err := r.db.GetYearlyFeeAmounts(r.ctx, year)
+
+	if err != nil {
+		return nil, fee_schedule_errors.ErrGetYearlyFeeAmountsFailed
+	}
+
+	return r.mapping.ToFeeYearlyAmounts(res), nil
+}
+
+func (r *feeScheduleRepository) withTiers(schedule *db.FeeSchedule) (*record.FeeScheduleRecord, error) {
+	tiers, err := r.db.GetFeeScheduleTiersByScheduleIDs(r.ctx, []int32{schedule.FeeScheduleID})
+
+	if err != nil {
+		return nil, fee_schedule_errors.ErrFindFeeScheduleTiersFailed
+	}
+
+	return r.mapping.ToFeeScheduleRecord(schedule, tiers), nil
+}
+
+func (r *feeScheduleRepository) createTiers(fee_schedule_id int32, tiers []requests.FeeScheduleTierRequest) ([]*db.FeeScheduleTier, error) {
+	created := make([]*db.FeeScheduleTier, 0, len(tiers))
+
+	for _, tier := range tiers {
+		res, err := r.db.CreateFeeScheduleTier(r.ctx, db.CreateFeeScheduleTierParams{
+			FeeScheduleID: fee_schedule_id,
+			MinAmount:     int32(tier.MinAmount),
+			FlatAmount:    int32(tier.FlatAmount),
+			PercentageBps: int32(tier.PercentageBps),
+		})
+		if err != nil {
+			return nil, fee_schedule_errors.ErrReplaceFeeScheduleTiersFailed
+		}
+
+		created = append(created, res)
+	}
+
+	return created, nil
+}
+
+func nullableFeeFilter(value *string) sql.NullString {
+	if value == nil {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: *value, Valid: true}
+}
+
+func nullableFeeBound(value *int) sql.NullInt32 {
+	if value == nil {
+		return sql.NullInt32{}
+	}
+
+	return sql.NullInt32{Int32: int32(*value), Valid: true}
+}
@@ -0,0 +1,87 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/idempotency_errors"
+)
+
+type idempotencyKeyRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.IdempotencyKeyRecordMapping
+}
+
+func NewIdempotencyKeyRepository(db *db.Queries, ctx context.Context, mapping recordmapper.IdempotencyKeyRecordMapping) *idempotencyKeyRepository {
+	return &idempotencyKeyRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *idempotencyKeyRepository) FindByKey(user_id int, scope string, key string) (*record.IdempotencyKeyRecord, error) {
+	res, err := r.db.FindIdempotencyKey(r.ctx, db.FindIdempotencyKeyParams{
+		UserID:         int32(user_id),
+		Scope:          scope,
+		IdempotencyKey: key,
+	})
+
+	if err != nil {
+		return nil, idempotency_errors.ErrIdempotencyKeyNotFound
+	}
+
+	return r.mapping.ToIdempotencyKeyRecord(res), nil
+}
+
+func (r *idempotencyKeyRepository) CreateIdempotencyKey(req *requests.CreateIdempotencyKey) (*record.IdempotencyKeyRecord, error) {
+	res, err := r.db.CreateIdempotencyKey(r.ctx, db.CreateIdempotencyKeyParams{
+		UserID:             int32(req.UserID),
+		Scope:              req.Scope,
+		IdempotencyKey:     req.Key,
+		RequestFingerprint: req.Fingerprint,
+		TtlSeconds:         int32(req.TTL / time.Second),
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, idempotency_errors.ErrIdempotencyKeyAlreadyExists
+		}
+		return nil, idempotency_errors.ErrCreateIdempotencyKeyFailed
+	}
+
+	return r.mapping.ToIdempotencyKeyRecord(res), nil
+}
+
+func (r *idempotencyKeyRepository) UpdateResponse(idempotency_key_id int, response_body string) (*record.IdempotencyKeyRecord, error) {
+	res, err := r.db.UpdateIdempotencyKeyResponse(r.ctx, db.UpdateIdempotencyKeyResponseParams{
+		IdempotencyKeyID: int32(idempotency_key_id),
+		ResponseBody: sql.NullString{
+			String: response_body,
+			Valid:  true,
+		},
+	})
+
+	if err != nil {
+		return nil, idempotency_errors.ErrUpdateIdempotencyKeyFailed
+	}
+
+	return r.mapping.ToIdempotencyKeyRecord(res), nil
+}
+
+func (r *idempotencyKeyRepository) DeleteIdempotencyKey(idempotency_key_id int) error {
+	err := r.db.DeleteIdempotencyKey(r.ctx, int32(idempotency_key_id))
+
+	if err != nil {
+		return idempotency_errors.ErrDeleteIdempotencyKeyFailed
+	}
+
+	return nil
+}
@@ -1,6 +1,8 @@
 package repository
 
 import (
+	"time"
+
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 )
@@ -14,6 +16,7 @@ type UserRepository interface {
 	FindByEmail(email string) (*record.UserRecord, error)
 	CreateUser(request *requests.CreateUserRequest) (*record.UserRecord, error)
 	UpdateUser(request *requests.UpdateUserRequest) (*record.UserRecord, error)
+	UpdateUserTier(request *requests.UpdateUserTierRequest) (*record.UserRecord, error)
 	TrashedUser(user_id int) (*record.UserRecord, error)
 	RestoreUser(user_id int) (*record.UserRecord, error)
 	DeleteUserPermanent(user_id int) (bool, error)
@@ -64,6 +67,7 @@ type CardRepository interface {
 	GetTotalWithdrawAmount() (*int64, error)
 	GetTotalTransactionAmount() (*int64, error)
 	GetTotalTransferAmount() (*int64, error)
+	GetTotalAmountsByCurrency() ([]*record.CardCurrencyTotals, error)
 
 	GetTotalBalanceByCardNumber(cardNumber string) (*int64, error)
 	GetTotalTopupAmountByCardNumber(cardNumber string) (*int64, error)
@@ -126,19 +130,12 @@ type MerchantRepository interface {
 	GetMonthlyPaymentMethodByMerchants(req *requests.MonthYearPaymentMethodMerchant) ([]*record.MerchantMonthlyPaymentMethod, error)
 	GetYearlyPaymentMethodByMerchants(req *requests.MonthYearPaymentMethodMerchant) ([]*record.MerchantYearlyPaymentMethod, error)
 	GetMonthlyAmountByMerchants(req *requests.MonthYearAmountMerchant) ([]*record.MerchantMonthlyAmount, error)
+	GetMonthlyDisputeRateByMerchants(req *requests.MonthYearAmountMerchant) ([]*record.MerchantMonthlyDisputeRate, error)
+	GetYearlyDisputeRateByMerchants(req *requests.MonthYearAmountMerchant) ([]*record.MerchantYearlyDisputeRate, error)
 	GetYearlyAmountByMerchants(req *requests.MonthYearAmountMerchant) ([]*record.MerchantYearlyAmount, error)
 	GetMonthlyTotalAmountByMerchants(req *requests.MonthYearTotalAmountMerchant) ([]*record.MerchantMonthlyTotalAmount, error)
 	GetYearlyTotalAmountByMerchants(req *requests.MonthYearTotalAmountMerchant) ([]*record.MerchantYearlyTotalAmount, error)
 
-	FindAllTransactionsByApikey(req *requests.FindAllMerchantTransactionsByApiKey) ([]*record.MerchantTransactionsRecord, *int, error)
-	GetMonthlyPaymentMethodByApikey(req *requests.MonthYearPaymentMethodApiKey) ([]*record.MerchantMonthlyPaymentMethod, error)
-	GetYearlyPaymentMethodByApikey(req *requests.MonthYearPaymentMethodApiKey) ([]*record.MerchantYearlyPaymentMethod, error)
-	GetMonthlyAmountByApikey(req *requests.MonthYearAmountApiKey) ([]*record.MerchantMonthlyAmount, error)
-	GetYearlyAmountByApikey(req *requests.MonthYearAmountApiKey) ([]*record.MerchantYearlyAmount, error)
-	GetMonthlyTotalAmountByApikey(req *requests.MonthYearTotalAmountApiKey) ([]*record.MerchantMonthlyTotalAmount, error)
-	GetYearlyTotalAmountByApikey(req *requests.MonthYearTotalAmountApiKey) ([]*record.MerchantYearlyTotalAmount, error)
-
-	FindByApiKey(api_key string) (*record.MerchantRecord, error)
 	FindByName(name string) (*record.MerchantRecord, error)
 	FindByMerchantUserId(user_id int) ([]*record.MerchantRecord, error)
 
@@ -170,6 +167,10 @@ type SaldoRepository interface {
 	UpdateSaldo(request *requests.UpdateSaldoRequest) (*record.SaldoRecord, error)
 	UpdateSaldoBalance(request *requests.UpdateSaldoBalance) (*record.SaldoRecord, error)
 	UpdateSaldoWithdraw(request *requests.UpdateSaldoWithdraw) (*record.SaldoRecord, error)
+	RebuildFromLedger() ([]*record.SaldoRecord, error)
+	LockByCardNumbers(card_numbers []string) ([]*record.SaldoRecord, error)
+	ReserveBalance(card_number string, amount int) (*record.SaldoRecord, error)
+	ReleaseReservation(card_number string, amount int) (*record.SaldoRecord, error)
 	TrashedSaldo(saldoID int) (*record.SaldoRecord, error)
 	RestoreSaldo(saldoID int) (*record.SaldoRecord, error)
 	DeleteSaldoPermanent(saldo_id int) (bool, error)
@@ -178,6 +179,15 @@ type SaldoRepository interface {
 	DeleteAllSaldoPermanent() (bool, error)
 }
 
+type SaldoHoldRepository interface {
+	FindById(hold_id int) (*record.SaldoHoldRecord, error)
+	FindActiveByReference(reference_type string, reference_id int) (*record.SaldoHoldRecord, error)
+	FindActiveByCardNumber(card_number string) ([]*record.SaldoHoldRecord, error)
+	CreateHold(request *requests.CreateSaldoHoldRequest) (*record.SaldoHoldRecord, error)
+	ReleaseHold(hold_id int) (*record.SaldoHoldRecord, error)
+	ConsumeHold(hold_id int) (*record.SaldoHoldRecord, error)
+}
+
 type TopupRepository interface {
 	FindAllTopups(req *requests.FindAllTopups) ([]*record.TopupRecord, *int, error)
 	FindByActive(req *requests.FindAllTopups) ([]*record.TopupRecord, *int, error)
@@ -214,6 +224,11 @@ type TopupRepository interface {
 	UpdateTopupAmount(request *requests.UpdateTopupAmount) (*record.TopupRecord, error)
 	UpdateTopupStatus(request *requests.UpdateTopupStatus) (*record.TopupRecord, error)
 
+	CreateProviderPayment(request *requests.CreateTopupProviderPayment) (*record.TopupProviderPaymentRecord, error)
+	FindProviderPaymentByReference(provider string, reference string) (*record.TopupProviderPaymentRecord, error)
+	FindPendingProviderPayments(limit int) ([]*record.TopupProviderPaymentRecord, error)
+	TouchProviderPayment(payment_id int, message *string) (*record.TopupProviderPaymentRecord, error)
+
 	TrashedTopup(topup_id int) (*record.TopupRecord, error)
 	RestoreTopup(topup_id int) (*record.TopupRecord, error)
 	DeleteTopupPermanent(topup_id int) (bool, error)
@@ -255,6 +270,10 @@ type TransactionRepository interface {
 	CreateTransaction(request *requests.CreateTransactionRequest) (*record.TransactionRecord, error)
 	UpdateTransaction(request *requests.UpdateTransactionRequest) (*record.TransactionRecord, error)
 	UpdateTransactionStatus(request *requests.UpdateTransactionStatus) (*record.TransactionRecord, error)
+	ApplyRefund(transaction_id int, amount int) (*record.TransactionRecord, error)
+	MarkDisputed(transaction_id int) (*record.TransactionRecord, error)
+	ReleaseDispute(transaction_id int) (*record.TransactionRecord, error)
+	ChargeBack(transaction_id int, amount int) (*record.TransactionRecord, error)
 	TrashedTransaction(transaction_id int) (*record.TransactionRecord, error)
 	RestoreTransaction(topup_id int) (*record.TransactionRecord, error)
 	DeleteTransactionPermanent(topup_id int) (bool, error)
@@ -310,6 +329,8 @@ type WithdrawRepository interface {
 	FindAllByCardNumber(req *requests.FindAllWithdrawCardNumber) ([]*record.WithdrawRecord, *int, error)
 
 	FindById(id int) (*record.WithdrawRecord, error)
+	FindByWithdrawNo(withdraw_no string) (*record.WithdrawRecord, error)
+	FindByWithdrawNoForUpdate(withdraw_no string) (*record.WithdrawRecord, error)
 
 	GetMonthWithdrawStatusSuccess(req *requests.MonthStatusWithdraw) ([]*record.WithdrawRecordMonthStatusSuccess, error)
 	GetYearlyWithdrawStatusSuccess(year int) ([]*record.WithdrawRecordYearStatusSuccess, error)
@@ -330,6 +351,10 @@ type WithdrawRepository interface {
 	UpdateWithdraw(request *requests.UpdateWithdrawRequest) (*record.WithdrawRecord, error)
 	UpdateWithdrawStatus(request *requests.UpdateWithdrawStatus) (*record.WithdrawRecord, error)
 
+	FindSettleable(limit int) ([]*record.WithdrawRecord, error)
+	CreateReview(request *requests.ReviewWithdrawRequest, decision string) (*record.WithdrawReviewRecord, error)
+	FindReview(withdraw_id int) (*record.WithdrawReviewRecord, error)
+
 	TrashedWithdraw(WithdrawID int) (*record.WithdrawRecord, error)
 	RestoreWithdraw(WithdrawID int) (*record.WithdrawRecord, error)
 	DeleteWithdrawPermanent(WithdrawID int) (bool, error)
@@ -337,3 +362,208 @@ type WithdrawRepository interface {
 	RestoreAllWithdraw() (bool, error)
 	DeleteAllWithdrawPermanent() (bool, error)
 }
+
+type LedgerRepository interface {
+	PostJournal(request *requests.CreateLedgerJournalRequest) (*record.LedgerJournalRecord, error)
+
+	FindAllJournals(req *requests.FindAllLedgerJournals) ([]*record.LedgerJournalRecord, *int, error)
+	FindJournalById(journal_id int) (*record.LedgerJournalRecord, error)
+	FindPostingsByCardNumber(req *requests.FindLedgerPostingsByCardNumber) ([]*record.LedgerCardPostingRecord, *int, error)
+	FindBalanceByCardNumber(card_number string) (*record.LedgerBalanceRecord, error)
+}
+
+type IdempotencyKeyRepository interface {
+	FindByKey(user_id int, scope string, key string) (*record.IdempotencyKeyRecord, error)
+	CreateIdempotencyKey(req *requests.CreateIdempotencyKey) (*record.IdempotencyKeyRecord, error)
+	UpdateResponse(idempotency_key_id int, response_body string) (*record.IdempotencyKeyRecord, error)
+	DeleteIdempotencyKey(idempotency_key_id int) error
+}
+
+type UnitOfWork interface {
+	WithinTransaction(fn func(repos *Repositories) error) error
+}
+
+type RefundRepository interface {
+	FindAll(req *requests.FindAllRefunds) ([]*record.RefundRecord, *int, error)
+	FindById(refund_id int) (*record.RefundRecord, error)
+	FindByTransactionId(transaction_id int) ([]*record.RefundRecord, error)
+	CreateRefund(transaction *record.TransactionRecord, request *requests.CreateRefundRequest) (*record.RefundRecord, error)
+}
+
+type DisputeRepository interface {
+	FindAll(req *requests.FindAllDisputes) ([]*record.DisputeRecord, *int, error)
+	FindByMerchant(req *requests.FindAllDisputesByMerchant) ([]*record.DisputeRecord, *int, error)
+	FindById(dispute_id int) (*record.DisputeRecord, error)
+	CreateDispute(transaction *record.TransactionRecord, amount int, held_from string, request *requests.CreateDisputeRequest) (*record.DisputeRecord, error)
+	RespondDispute(request *requests.RespondDisputeRequest) (*record.DisputeRecord, error)
+	ResolveDispute(request *requests.ResolveDisputeRequest, status string) (*record.DisputeRecord, error)
+}
+
+type AuthorizationRepository interface {
+	FindAll(req *requests.FindAllAuthorizations) ([]*record.AuthorizationRecord, *int, error)
+	FindById(authorization_id int) (*record.AuthorizationRecord, error)
+	CreateAuthorization(request *requests.CreateAuthorizationRequest, expiresAt time.Time) (*record.AuthorizationRecord, error)
+	CaptureAuthorization(authorization_id int, amount int, transaction_id int) (*record.AuthorizationRecord, error)
+	VoidAuthorization(authorization_id int) (*record.AuthorizationRecord, error)
+	ExpireAuthorizations(limit int) ([]*record.AuthorizationRecord, error)
+}
+
+type ExchangeRateRepository interface {
+	FindAll(req *requests.FindAllExchangeRates) ([]*record.ExchangeRateRecord, *int, error)
+	FindById(exchange_rate_id int) (*record.ExchangeRateRecord, error)
+	FindByPair(base_currency string, quote_currency string) (*record.ExchangeRateRecord, error)
+	UpsertExchangeRate(request *requests.UpsertExchangeRateRequest) (*record.ExchangeRateRecord, error)
+	DeleteExchangeRate(exchange_rate_id int) (*record.ExchangeRateRecord, error)
+}
+
+type FeeScheduleRepository interface {
+	FindAll(req *requests.FindAllFeeSchedules) ([]*record.FeeScheduleRecord, *int, error)
+	FindById(fee_schedule_id int) (*record.FeeScheduleRecord, error)
+	FindApplicable(req *requests.FindApplicableFeeSchedule) (*record.FeeScheduleRecord, error)
+	CreateFeeSchedule(request *requests.CreateFeeScheduleRequest) (*record.FeeScheduleRecord, error)
+	UpdateFeeSchedule(request *requests.UpdateFeeScheduleRequest) (*record.FeeScheduleRecord, error)
+	DeleteFeeSchedule(fee_schedule_id int) (*record.FeeScheduleRecord, error)
+
+	GetMonthlyFeeAmounts(year int) ([]*record.FeeMonthAmount, error)
+	GetYearlyFeeAmounts(year int) ([]*record.FeeYearlyAmount, error)
+}
+
+type TransactionLimitRepository interface {
+	FindAll(req *requests.FindAllTransactionLimits) ([]*record.TransactionLimitRecord, *int, error)
+	FindById(transaction_limit_id int) (*record.TransactionLimitRecord, error)
+	FindApplicable(operation_type string, card_number string) ([]*record.TransactionLimitRecord, error)
+	FindUsage(operation_type string, card_number string, since time.Time) (*record.TransactionLimitUsageRecord, error)
+	CreateTransactionLimit(request *requests.CreateTransactionLimitRequest) (*record.TransactionLimitRecord, error)
+	UpdateTransactionLimit(request *requests.UpdateTransactionLimitRequest) (*record.TransactionLimitRecord, error)
+	DeleteTransactionLimit(transaction_limit_id int) (*record.TransactionLimitRecord, error)
+}
+
+type ScheduledTransferRepository interface {
+	FindAll(req *requests.FindAllScheduledTransfers) ([]*record.ScheduledTransferRecord, *int, error)
+	FindById(scheduled_transfer_id int) (*record.ScheduledTransferRecord, error)
+	FindDue(now time.Time, limit int) ([]*record.ScheduledTransferRecord, error)
+	FindRuns(req *requests.FindScheduledTransferRuns) ([]*record.ScheduledTransferRunRecord, *int, error)
+	CreateScheduledTransfer(user_id int, request *requests.CreateScheduledTransferRequest, nextRunAt time.Time) (*record.ScheduledTransferRecord, error)
+	UpdateScheduledTransfer(request *requests.UpdateScheduledTransferRequest, nextRunAt time.Time) (*record.ScheduledTransferRecord, error)
+	PauseScheduledTransfer(scheduled_transfer_id int) (*record.ScheduledTransferRecord, error)
+	ResumeScheduledTransfer(scheduled_transfer_id int, nextRunAt time.Time) (*record.ScheduledTransferRecord, error)
+	CancelScheduledTransfer(scheduled_transfer_id int) (*record.ScheduledTransferRecord, error)
+	FailScheduledTransfer(scheduled_transfer_id int) (*record.ScheduledTransferRecord, error)
+	AdvanceScheduledTransfer(scheduled_transfer_id int, nextRunAt *time.Time, status string, lastRunAt time.Time) (*record.ScheduledTransferRecord, error)
+	ClaimRun(scheduled_transfer_id int, scheduledFor time.Time) (*record.ScheduledTransferRunRecord, error)
+	FinishRun(scheduled_transfer_run_id int, status string, transfer_id *int, failureReason *string) (*record.ScheduledTransferRunRecord, error)
+}
+
+type TransferBatchRepository interface {
+	FindAll(req *requests.FindAllTransferBatches) ([]*record.TransferBatchRecord, *int, error)
+	FindById(transfer_batch_id int) (*record.TransferBatchRecord, error)
+	FindRunnable(limit int) ([]*record.TransferBatchRecord, error)
+	FindRows(req *requests.FindTransferBatchRows) ([]*record.TransferBatchRowRecord, *int, error)
+	FindReportRows(transfer_batch_id int) ([]*record.TransferBatchRowRecord, error)
+	FindPendingRows(transfer_batch_id int, limit int) ([]*record.TransferBatchRowRecord, error)
+	CreateTransferBatch(request *requests.CreateTransferBatch) (*record.TransferBatchRecord, error)
+	CreateTransferBatchRow(transfer_batch_id int, status string, row *requests.TransferBatchRowInput) (*record.TransferBatchRowRecord, error)
+	StartTransferBatch(transfer_batch_id int) (*record.TransferBatchRecord, error)
+	CompleteTransferBatch(transfer_batch_id int, status string) (*record.TransferBatchRecord, error)
+	AddTransferBatchResult(transfer_batch_id int, successRows int, failedRows int, successAmount int) (*record.TransferBatchRecord, error)
+	ClaimRow(transfer_batch_row_id int) (*record.TransferBatchRowRecord, error)
+	FinishRow(transfer_batch_row_id int, status string, transfer_id *int, failureReason *string) (*record.TransferBatchRowRecord, error)
+}
+
+type StatusHistoryRepository interface {
+	FindByEntity(entity_type string, entity_id int) ([]*record.StatusHistoryRecord, error)
+}
+
+type ProviderWebhookRepository interface {
+	FindAll(req *requests.FindAllProviderWebhookEvents) ([]*record.ProviderWebhookEventRecord, *int, error)
+	FindById(id int) (*record.ProviderWebhookEventRecord, error)
+	FindByEventID(provider string, event_id string) (*record.ProviderWebhookEventRecord, error)
+	CreateEvent(request *requests.CreateProviderWebhookEvent) (*record.ProviderWebhookEventRecord, error)
+	FinishEvent(request *requests.FinishProviderWebhookEvent) (*record.ProviderWebhookEventRecord, error)
+}
+
+type MerchantWebhookRepository interface {
+	CreateEndpoint(request *requests.CreateMerchantWebhookEndpoint) (*record.MerchantWebhookEndpointRecord, error)
+	FindEndpointById(id int) (*record.MerchantWebhookEndpointRecord, error)
+	FindEndpointsByMerchant(merchant_id int) ([]*record.MerchantWebhookEndpointRecord, error)
+	UpdateEndpoint(request *requests.UpdateMerchantWebhookEndpointRequest) (*record.MerchantWebhookEndpointRecord, error)
+	DeleteEndpoint(id int) (*record.MerchantWebhookEndpointRecord, error)
+	QueueDeliveries(request *requests.QueueMerchantWebhookDeliveries) ([]*record.MerchantWebhookDeliveryRecord, error)
+	CreateDelivery(request *requests.CreateMerchantWebhookDelivery) (*record.MerchantWebhookDeliveryRecord, error)
+	FindDeliveryById(id int) (*record.MerchantWebhookDeliveryRecord, error)
+	FindDeliveries(req *requests.FindMerchantWebhookDeliveries) ([]*record.MerchantWebhookDeliveryRecord, *int, error)
+	ClaimDueDeliveries(limit int, leaseUntil time.Time) ([]*record.MerchantWebhookDeliveryRecord, error)
+	CreateDeliveryAttempt(request *requests.FinishMerchantWebhookDeliveryAttempt) (*record.MerchantWebhookDeliveryAttemptRecord, error)
+	FinishDeliveryAttempt(request *requests.FinishMerchantWebhookDeliveryAttempt) (*record.MerchantWebhookDeliveryRecord, error)
+	FindDeliveryAttempts(delivery_id int) ([]*record.MerchantWebhookDeliveryAttemptRecord, error)
+}
+
+type SettlementRepository interface {
+	AddPendingBalance(merchant_id int, currency string, amount int) (*record.MerchantBalanceRecord, error)
+	DeductPendingBalance(merchant_id int, currency string, amount int) (*record.MerchantBalanceRecord, error)
+	SetSettlementCard(merchant_id int, currency string, card_number string) (*record.MerchantBalanceRecord, error)
+	FindBalancesByMerchant(merchant_id int) ([]*record.MerchantBalanceRecord, error)
+	FindBalanceForUpdate(merchant_id int, currency string) (*record.MerchantBalanceRecord, error)
+	FindBalancesDue(cutoff time.Time) ([]*record.MerchantBalanceRecord, error)
+	CreateItem(request *requests.CreateSettlementItem) (*record.SettlementItemRecord, error)
+	FindUnsettledTotals(merchant_id int, currency string, cutoff time.Time) (*record.SettlementTotalsRecord, error)
+	CreateBatch(request *requests.CreateSettlementBatch) (*record.SettlementBatchRecord, error)
+	AssignItems(batch *record.SettlementBatchRecord, cutoff time.Time) error
+	FindBatchById(id int) (*record.SettlementBatchRecord, error)
+	FindBatches(req *requests.FindSettlementBatches) ([]*record.SettlementBatchRecord, *int, error)
+	FindItemsByBatch(batch_id int) ([]*record.SettlementItemRecord, error)
+}
+
+type MerchantOnboardingRepository interface {
+	UpsertBusinessProfile(req *requests.SubmitMerchantOnboardingRequest) (*record.MerchantBusinessProfileRecord, error)
+	FindBusinessProfile(merchant_id int) (*record.MerchantBusinessProfileRecord, error)
+	FindByStatus(req *requests.FindMerchantsByStatus) ([]*record.MerchantRecord, *int, error)
+}
+
+type ReconciliationRepository interface {
+	TryLock() error
+	CreateRun() (*record.ReconciliationRunRecord, error)
+	FinishRun(run_id int, cards_checked int, discrepancy_count int) (*record.ReconciliationRunRecord, error)
+	FindRuns(req *requests.FindReconciliationRuns) ([]*record.ReconciliationRunRecord, *int, error)
+	FindCardReconciliations() ([]*record.CardReconciliationRecord, error)
+	UpsertDiscrepancy(req *requests.CreateBalanceDiscrepancy) (*record.BalanceDiscrepancyRecord, error)
+	ResolveDiscrepancies(run_id int) error
+	FindDiscrepancyById(id int) (*record.BalanceDiscrepancyRecord, error)
+	FindDiscrepancies(req *requests.FindBalanceDiscrepancies) ([]*record.BalanceDiscrepancyRecord, *int, error)
+	AcknowledgeDiscrepancy(req *requests.AcknowledgeBalanceDiscrepancyRequest) (*record.BalanceDiscrepancyRecord, error)
+}
+
+type MerchantApiKeyRepository interface {
+	CreateApiKey(request *requests.CreateMerchantApiKey) (*record.MerchantApiKeyRecord, error)
+	FindById(id int) (*record.MerchantApiKeyRecord, error)
+	FindByHash(key_hash string) (*record.MerchantApiKeyRecord, error)
+	FindActiveById(id int) (*record.MerchantApiKeyRecord, error)
+	FindByMerchant(merchant_id int) ([]*record.MerchantApiKeyRecord, error)
+	FindWithPlainSigningSecret() ([]*record.MerchantApiKeyRecord, error)
+	SealSigningSecret(id int, plain, sealed string) (bool, error)
+	TouchApiKey(id int) error
+	ExpireApiKey(id int, expiresAt time.Time) (*record.MerchantApiKeyRecord, error)
+	RevokeApiKey(id int) (*record.MerchantApiKeyRecord, error)
+	UseNonce(id int, nonce string) error
+	DeleteNoncesBefore(before time.Time) (int, error)
+}
+
+type InvoiceRepository interface {
+	FindById(invoice_id int) (*record.InvoiceRecord, error)
+	FindByCode(code string) (*record.InvoiceRecord, error)
+	FindOpenByCodeForUpdate(code string) (*record.InvoiceRecord, error)
+	FindByMerchant(req *requests.FindInvoicesByMerchant) ([]*record.InvoiceRecord, *int, error)
+	FindLineItems(invoice_id int) ([]*record.InvoiceLineItemRecord, error)
+	FindPayments(invoice_id int) ([]*record.InvoicePaymentRecord, error)
+	CreateInvoice(request *requests.CreateInvoice) (*record.InvoiceRecord, error)
+	CreateLineItem(request *requests.CreateInvoiceLineItem) (*record.InvoiceLineItemRecord, error)
+	CreatePayment(request *requests.CreateInvoicePayment) (*record.InvoicePaymentRecord, error)
+	MarkPaid(invoice_id int) (*record.InvoiceRecord, error)
+	Cancel(invoice_id int) (*record.InvoiceRecord, error)
+	ExpireInvoices() (int, error)
+}
+
+type QrPaymentRepository interface {
+	FindByReference(merchant_id int, reference string) (*record.QrPaymentRecord, error)
+	CreateQrPayment(request *requests.CreateQrPayment) (*record.QrPaymentRecord, error)
+}
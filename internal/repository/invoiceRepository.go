@@ -0,0 +1,193 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/invoice_errors"
+)
+
+type invoiceRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.InvoiceRecordMapping
+}
+
+func NewInvoiceRepository(db *db.Queries, ctx context.Context, mapping recordmapper.InvoiceRecordMapping) *invoiceRepository {
+	return &invoiceRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *invoiceRepository) FindById(invoice_id int) (*record.InvoiceRecord, error) {
+	res, err := r.db.GetInvoiceByID(r.ctx, int32(invoice_id))
+
+	if err != nil {
+		return nil, invoice_errors.ErrFindInvoiceByIdFailed
+	}
+
+	return r.mapping.ToInvoiceRecord(res), nil
+}
+
+func (r *invoiceRepository) FindByCode(code string) (*record.InvoiceRecord, error) {
+	res, err := r.db.GetInvoiceByCode(r.ctx, code)
+
+	if err != nil {
+		return nil, invoice_errors.ErrFindInvoiceByCodeFailed
+	}
+
+	return r.mapping.ToInvoiceRecord(res), nil
+}
+
+// FindOpenByCodeForUpdate locks an invoice that can still be paid until the
+// surrounding transaction ends, so it can't be paid twice or cancelled while
+// it is being paid.
+func (r *invoiceRepository) FindOpenByCodeForUpdate(code string) (*record.InvoiceRecord, error) {
+	res, err := r.db.GetOpenInvoiceByCodeForUpdate(r.ctx, code)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, invoice_errors.ErrInvoiceStatusConflict
+		}
+		return nil, invoice_errors.ErrFindInvoiceByCodeFailed
+	}
+
+	return r.mapping.ToInvoiceRecord(res), nil
+}
+
+func (r *invoiceRepository) FindByMerchant(req *requests.FindInvoicesByMerchant) ([]*record.InvoiceRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetInvoicesByMerchantParams{
+		MerchantID: int32(req.MerchantID),
+		Column2:    req.Status,
+		Limit:      int32(req.PageSize),
+		Offset:     int32(offset),
+	}
+
+	invoices, err := r.db.GetInvoicesByMerchant(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, invoice_errors.ErrFindInvoicesByMerchantFailed
+	}
+
+	var totalCount int
+	if len(invoices) > 0 {
+		totalCount = int(invoices[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToInvoicesRecordAll(invoices), &totalCount, nil
+}
+
+func (r *invoiceRepository) FindLineItems(invoice_id int) ([]*record.InvoiceLineItemRecord, error) {
+	res, err := r.db.GetInvoiceLineItems(r.ctx, int32(invoice_id))
+
+	if err != nil {
+		return nil, invoice_errors.ErrFindInvoiceLineItemsFailed
+	}
+
+	return r.mapping.ToInvoiceLineItemsRecord(res), nil
+}
+
+func (r *invoiceRepository) FindPayments(invoice_id int) ([]*record.InvoicePaymentRecord, error) {
+	res, err := r.db.GetInvoicePayments(r.ctx, int32(invoice_id))
+
+	if err != nil {
+		return nil, invoice_errors.ErrFindInvoicePaymentsFailed
+	}
+
+	return r.mapping.ToInvoicePaymentsRecord(res), nil
+}
+
+func (r *invoiceRepository) CreateInvoice(request *requests.CreateInvoice) (*record.InvoiceRecord, error) {
+	res, err := r.db.CreateInvoice(r.ctx, db.CreateInvoiceParams{
+		Code:        request.Code,
+		MerchantID:  int32(request.MerchantID),
+		Kind:        request.Kind,
+		Description: request.Description,
+		Amount:      int32(request.Amount),
+		Currency:    request.Currency,
+		ExpiresAt:   request.ExpiresAt,
+	})
+
+	if err != nil {
+		return nil, invoice_errors.ErrCreateInvoiceFailed
+	}
+
+	return r.mapping.ToInvoiceRecord(res), nil
+}
+
+func (r *invoiceRepository) CreateLineItem(request *requests.CreateInvoiceLineItem) (*record.InvoiceLineItemRecord, error) {
+	res, err := r.db.CreateInvoiceLineItem(r.ctx, db.CreateInvoiceLineItemParams{
+		InvoiceID:   int32(request.InvoiceID),
+		Description: request.Description,
+		Quantity:    int32(request.Quantity),
+		UnitPrice:   int32(request.UnitPrice),
+		Amount:      int32(request.Amount),
+	})
+
+	if err != nil {
+		return nil, invoice_errors.ErrCreateInvoiceLineItemFailed
+	}
+
+	return r.mapping.ToInvoiceLineItemRecord(res), nil
+}
+
+func (r *invoiceRepository) CreatePayment(request *requests.CreateInvoicePayment) (*record.InvoicePaymentRecord, error) {
+	res, err := r.db.CreateInvoicePayment(r.ctx, db.CreateInvoicePaymentParams{
+		InvoiceID:     int32(request.InvoiceID),
+		TransactionID: int32(request.TransactionID),
+		Amount:        int32(request.Amount),
+	})
+
+	if err != nil {
+		return nil, invoice_errors.ErrCreateInvoicePaymentFailed
+	}
+
+	return r.mapping.ToInvoicePaymentRecord(res), nil
+}
+
+func (r *invoiceRepository) MarkPaid(invoice_id int) (*record.InvoiceRecord, error) {
+	res, err := r.db.MarkInvoicePaid(r.ctx, int32(invoice_id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, invoice_errors.ErrInvoiceStatusConflict
+		}
+		return nil, invoice_errors.ErrMarkInvoicePaidFailed
+	}
+
+	return r.mapping.ToInvoiceRecord(res), nil
+}
+
+func (r *invoiceRepository) Cancel(invoice_id int) (*record.InvoiceRecord, error) {
+	res, err := r.db.CancelInvoice(r.ctx, int32(invoice_id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, invoice_errors.ErrInvoiceStatusConflict
+		}
+		return nil, invoice_errors.ErrCancelInvoiceFailed
+	}
+
+	return r.mapping.ToInvoiceRecord(res), nil
+}
+
+func (r *invoiceRepository) ExpireInvoices() (int, error) {
+	count, err := r.db.ExpireInvoices(r.ctx)
+
+	if err != nil {
+		return 0, invoice_errors.ErrExpireInvoicesFailed
+	}
+
+	return int(count), nil
+}
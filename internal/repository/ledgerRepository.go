@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/ledger_errors"
+)
+
+type ledgerRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.LedgerRecordMapping
+}
+
+func NewLedgerRepository(db *db.Queries, ctx context.Context, mapping recordmapper.LedgerRecordMapping) *ledgerRepository {
+	return &ledgerRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+// PostJournal writes a balanced journal with its postings and projects every
+// card posting onto the matching saldo. It issues several statements, so
+// callers must run it inside a unit of work.
+func (r *ledgerRepository) PostJournal(request *requests.CreateLedgerJournalRequest) (*record.LedgerJournalRecord, error) {
+	if err := request.Validate(); err != nil {
+		return nil, ledger_errors.ErrInvalidLedgerJournal
+	}
+
+	var referenceID sql.NullInt32
+	if request.ReferenceID != nil {
+		referenceID = sql.NullInt32{Int32: int32(*request.ReferenceID), Valid: true}
+	}
+
+	journal, err := r.db.CreateLedgerJournal(r.ctx, db.CreateLedgerJournalParams{
+		ReferenceType: request.ReferenceType,
+		ReferenceID:   referenceID,
+		Description:   request.Description,
+	})
+	if err != nil {
+		return nil, ledger_errors.ErrCreateLedgerJournalFailed
+	}
+
+	postings := make([]*db.LedgerPosting, 0, len(request.Postings))
+
+	for _, p := range request.Postings {
+		posting, err := r.db.CreateLedgerPosting(r.ctx, db.CreateLedgerPostingParams{
+			JournalID:   journal.JournalID,
+			AccountCode: p.Account.Code,
+			CardNumber: sql.NullString{
+				String: p.Account.CardNumber,
+				Valid:  p.Account.CardNumber != "",
+			},
+			Direction: p.Direction,
+			Amount:    int32(p.Amount),
+			Currency:  p.Currency,
+		})
+		if err != nil {
+			return nil, ledger_errors.ErrCreateLedgerPostingFailed
+		}
+
+		postings = append(postings, posting)
+
+		if p.Account.Code != requests.LedgerAccountCard {
+			continue
+		}
+
+		delta := p.Amount
+		if p.Direction == requests.LedgerDirectionDebit {
+			delta = -delta
+		}
+
+		if _, err := r.db.ApplyLedgerPostingToSaldo(r.ctx, db.ApplyLedgerPostingToSaldoParams{
+			Delta:      int32(delta),
+			CardNumber: p.Account.CardNumber,
+			Currency:   p.Currency,
+		}); err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				return nil, ledger_errors.ErrLedgerPostingOverdraft
+			}
+			return nil, ledger_errors.ErrApplyLedgerPostingFailed
+		}
+	}
+
+	return r.mapping.ToLedgerJournalRecord(journal, postings), nil
+}
+
+func (r *ledgerRepository) FindAllJournals(req *requests.FindAllLedgerJournals) ([]*record.LedgerJournalRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetLedgerJournalsParams{
+		Column1: req.Search,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	}
+
+	journals, err := r.db.GetLedgerJournals(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, ledger_errors.ErrFindAllLedgerJournalsFailed
+	}
+
+	journalIDs := make([]int32, 0, len(journals))
+	for _, journal := range journals {
+		journalIDs = append(journalIDs, journal.JournalID)
+	}
+
+	postings, err := r.db.GetLedgerPostingsByJournalIDs(r.ctx, journalIDs)
+
+	if err != nil {
+		return nil, nil, ledger_errors.ErrFindLedgerPostingsFailed
+	}
+
+	var totalCount int
+	if len(journals) > 0 {
+		totalCount = int(journals[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToLedgerJournalsRecordPagination(journals, postings), &totalCount, nil
+}
+
+func (r *ledgerRepository) FindJournalById(journal_id int) (*record.LedgerJournalRecord, error) {
+	journal, err := r.db.GetLedgerJournalByID(r.ctx, int32(journal_id))
+
+	if err != nil {
+		return nil, ledger_errors.ErrFindLedgerJournalByIdFailed
+	}
+
+	postings, err := r.db.GetLedgerPostingsByJournalIDs(r.ctx, []int32{journal.JournalID})
+
+	if err != nil {
+		return nil, ledger_errors.ErrFindLedgerPostingsFailed
+	}
+
+	return r.mapping.ToLedgerJournalRecord(journal, postings), nil
+}
+
+func (r *ledgerRepository) FindPostingsByCardNumber(req *requests.FindLedgerPostingsByCardNumber) ([]*record.LedgerCardPostingRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetLedgerPostingsByCardNumberParams{
+		CardNumber: sql.NullString{String: req.CardNumber, Valid: true},
+		Limit:      int32(req.PageSize),
+		Offset:     int32(offset),
+	}
+
+	postings, err := r.db.GetLedgerPostingsByCardNumber(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, ledger_errors.ErrFindLedgerPostingsFailed
+	}
+
+	var totalCount int
+	if len(postings) > 0 {
+		totalCount = int(postings[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToLedgerCardPostingsRecord(postings), &totalCount, nil
+}
+
+func (r *ledgerRepository) FindBalanceByCardNumber(card_number string) (*record.LedgerBalanceRecord, error) {
+	balance, err := r.db.GetLedgerBalanceByCardNumber(r.ctx, sql.NullString{String: card_number, Valid: true})
+
+	if err != nil {
+		return nil, ledger_errors.ErrFindLedgerBalanceFailed
+	}
+
+	return &record.LedgerBalanceRecord{
+		CardNumber: card_number,
+		Balance:    int(balance),
+	}, nil
+}
@@ -0,0 +1,184 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_api_key_errors"
+)
+
+type merchantApiKeyRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.MerchantApiKeyRecordMapping
+}
+
+func NewMerchantApiKeyRepository(db *db.Queries, ctx context.Context, mapping recordmapper.MerchantApiKeyRecordMapping) *merchantApiKeyRepository {
+	return &merchantApiKeyRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *merchantApiKeyRepository) CreateApiKey(request *requests.CreateMerchantApiKey) (*record.MerchantApiKeyRecord, error) {
+	req := db.CreateMerchantApiKeyParams{
+		MerchantID:    int32(request.MerchantID),
+		Label:         request.Label,
+		KeyPrefix:     request.KeyPrefix,
+		KeyHash:       request.KeyHash,
+		Scopes:        request.Scopes,
+		SigningSecret: request.SigningSecret,
+	}
+
+	if request.ExpiresAt != nil {
+		req.ExpiresAt = sql.NullTime{Time: *request.ExpiresAt, Valid: true}
+	}
+
+	res, err := r.db.CreateMerchantApiKey(r.ctx, req)
+
+	if err != nil {
+		return nil, merchant_api_key_errors.ErrCreateMerchantApiKeyFailed
+	}
+
+	return r.mapping.ToMerchantApiKeyRecord(res), nil
+}
+
+func (r *merchantApiKeyRepository) FindById(id int) (*record.MerchantApiKeyRecord, error) {
+	res, err := r.db.GetMerchantApiKeyByID(r.ctx, int32(id))
+
+	if err != nil {
+		return nil, merchant_api_key_errors.ErrFindMerchantApiKeyByIdFailed
+	}
+
+	return r.mapping.ToMerchantApiKeyRecord(res), nil
+}
+
+// FindByHash returns the key with the given hash, unless it was revoked or
+// has expired.
+func (r *merchantApiKeyRepository) FindByHash(key_hash string) (*record.MerchantApiKeyRecord, error) {
+	res, err := r.db.GetMerchantApiKeyByHash(r.ctx, key_hash)
+
+	if err != nil {
+		return nil, merchant_api_key_errors.ErrFindMerchantApiKeyByHashFailed
+	}
+
+	return r.mapping.ToMerchantApiKeyRecord(res), nil
+}
+
+// FindActiveById returns the key with the given ID, unless it was revoked or
+// has expired.
+func (r *merchantApiKeyRepository) FindActiveById(id int) (*record.MerchantApiKeyRecord, error) {
+	res, err := r.db.GetActiveMerchantApiKeyByID(r.ctx, int32(id))
+
+	if err != nil {
+		return nil, merchant_api_key_errors.ErrFindMerchantApiKeyByIdFailed
+	}
+
+	return r.mapping.ToMerchantApiKeyRecord(res), nil
+}
+
+func (r *merchantApiKeyRepository) FindByMerchant(merchant_id int) ([]*record.MerchantApiKeyRecord, error) {
+	res, err := r.db.GetMerchantApiKeysByMerchant(r.ctx, int32(merchant_id))
+
+	if err != nil {
+		return nil, merchant_api_key_errors.ErrFindMerchantApiKeysFailed
+	}
+
+	return r.mapping.ToMerchantApiKeysRecord(res), nil
+}
+
+// FindWithPlainSigningSecret returns the keys whose signing secret was
+// stored before secrets were encrypted.
+func (r *merchantApiKeyRepository) FindWithPlainSigningSecret() ([]*record.MerchantApiKeyRecord, error) {
+	res, err := r.db.GetMerchantApiKeysWithPlainSigningSecret(r.ctx)
+
+	if err != nil {
+		return nil, merchant_api_key_errors.ErrFindPlainSigningSecretsFailed
+	}
+
+	return r.mapping.ToMerchantApiKeysRecord(res), nil
+}
+
+// SealSigningSecret replaces the plain signing secret of a key with sealed,
+// and reports false when the key no longer has that plain secret.
+func (r *merchantApiKeyRepository) SealSigningSecret(id int, plain, sealed string) (bool, error) {
+	count, err := r.db.SealMerchantApiKeySigningSecret(r.ctx, db.SealMerchantApiKeySigningSecretParams{
+		SigningSecret:      sealed,
+		MerchantApiKeyID:   int32(id),
+		PlainSigningSecret: plain,
+	})
+
+	if err != nil {
+		return false, merchant_api_key_errors.ErrSealSigningSecretFailed
+	}
+
+	return count > 0, nil
+}
+
+func (r *merchantApiKeyRepository) TouchApiKey(id int) error {
+	if err := r.db.TouchMerchantApiKey(r.ctx, int32(id)); err != nil {
+		return merchant_api_key_errors.ErrTouchMerchantApiKeyFailed
+	}
+
+	return nil
+}
+
+// ExpireApiKey makes a key stop working at expiresAt, unless it already
+// expires earlier.
+func (r *merchantApiKeyRepository) ExpireApiKey(id int, expiresAt time.Time) (*record.MerchantApiKeyRecord, error) {
+	res, err := r.db.ExpireMerchantApiKey(r.ctx, db.ExpireMerchantApiKeyParams{
+		MerchantApiKeyID: int32(id),
+		ExpiresAt:        expiresAt,
+	})
+
+	if err != nil {
+		return nil, merchant_api_key_errors.ErrExpireMerchantApiKeyFailed
+	}
+
+	return r.mapping.ToMerchantApiKeyRecord(res), nil
+}
+
+func (r *merchantApiKeyRepository) RevokeApiKey(id int) (*record.MerchantApiKeyRecord, error) {
+	res, err := r.db.RevokeMerchantApiKey(r.ctx, int32(id))
+
+	if err != nil {
+		return nil, merchant_api_key_errors.ErrRevokeMerchantApiKeyFailed
+	}
+
+	return r.mapping.ToMerchantApiKeyRecord(res), nil
+}
+
+// UseNonce records that a signed request made with a key carried nonce, or
+// returns ErrMerchantRequestNonceUsed when an earlier request already did.
+func (r *merchantApiKeyRepository) UseNonce(id int, nonce string) error {
+	_, err := r.db.CreateMerchantRequestNonce(r.ctx, db.CreateMerchantRequestNonceParams{
+		MerchantApiKeyID: int32(id),
+		Nonce:            nonce,
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return merchant_api_key_errors.ErrMerchantRequestNonceUsed
+		}
+		return merchant_api_key_errors.ErrUseMerchantRequestNonceFailed
+	}
+
+	return nil
+}
+
+func (r *merchantApiKeyRepository) DeleteNoncesBefore(before time.Time) (int, error) {
+	count, err := r.db.DeleteMerchantRequestNoncesBefore(r.ctx, before)
+
+	if err != nil {
+		return 0, merchant_api_key_errors.ErrDeleteMerchantRequestNonces
+	}
+
+	return int(count), nil
+}
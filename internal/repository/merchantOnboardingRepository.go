@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_onboarding_errors"
+)
+
+type merchantOnboardingRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.MerchantOnboardingRecordMapping
+}
+
+func NewMerchantOnboardingRepository(db *db.Queries, ctx context.Context, mapping recordmapper.MerchantOnboardingRecordMapping) *merchantOnboardingRepository {
+	return &merchantOnboardingRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *merchantOnboardingRepository) UpsertBusinessProfile(req *requests.SubmitMerchantOnboardingRequest) (*record.MerchantBusinessProfileRecord, error) {
+	var website sql.NullString
+	if req.Website != nil {
+		website = sql.NullString{String: *req.Website, Valid: true}
+	}
+
+	res, err := r.db.UpsertMerchantBusinessProfile(r.ctx, db.UpsertMerchantBusinessProfileParams{
+		MerchantID:   int32(req.MerchantID),
+		LegalName:    req.LegalName,
+		BusinessType: req.BusinessType,
+		TaxID:        req.TaxID,
+		Address:      req.Address,
+		Website:      website,
+		ContactEmail: req.ContactEmail,
+		ContactPhone: req.ContactPhone,
+	})
+
+	if err != nil {
+		return nil, merchant_onboarding_errors.ErrUpsertBusinessProfileFailed
+	}
+
+	return r.mapping.ToMerchantBusinessProfileRecord(res), nil
+}
+
+func (r *merchantOnboardingRepository) FindBusinessProfile(merchant_id int) (*record.MerchantBusinessProfileRecord, error) {
+	res, err := r.db.GetMerchantBusinessProfile(r.ctx, int32(merchant_id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, merchant_onboarding_errors.ErrBusinessProfileNotFound
+		}
+		return nil, merchant_onboarding_errors.ErrFindBusinessProfileFailed
+	}
+
+	return r.mapping.ToMerchantBusinessProfileRecord(res), nil
+}
+
+func (r *merchantOnboardingRepository) FindByStatus(req *requests.FindMerchantsByStatus) ([]*record.MerchantRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetMerchantsByStatusParams{
+		Status: req.Status,
+		Limit:  int32(req.PageSize),
+		Offset: int32(offset),
+	}
+
+	merchants, err := r.db.GetMerchantsByStatus(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, merchant_onboarding_errors.ErrFindMerchantsByStatusFailed
+	}
+
+	var totalCount int
+	if len(merchants) > 0 {
+		totalCount = int(merchants[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToMerchantsByStatusRecord(merchants), &totalCount, nil
+}
@@ -2,14 +2,16 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
-	apikey "github.com/MamangRust/paymentgatewaygraphql/pkg/api-key"
 	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/statemachine"
 )
 
 type merchantRepository struct {
@@ -150,31 +152,6 @@ func (r *merchantRepository) FindAllTransactionsByMerchant(req *requests.FindAll
 	return r.mapping.ToMerchantsTransactionByMerchantRecord(merchant), &totalCount, nil
 }
 
-func (r *merchantRepository) FindAllTransactionsByApikey(req *requests.FindAllMerchantTransactionsByApiKey) ([]*record.MerchantTransactionsRecord, *int, error) {
-	offset := (req.Page - 1) * req.PageSize
-
-	reqDb := db.FindAllTransactionsByApikeyParams{
-		ApiKey:  req.ApiKey,
-		Column2: req.Search,
-		Limit:   int32(req.PageSize),
-		Offset:  int32(offset),
-	}
-
-	merchant, err := r.db.FindAllTransactionsByApikey(r.ctx, reqDb)
-
-	if err != nil {
-		return nil, nil, merchant_errors.ErrFindAllTransactionsByApiKeyFailed
-	}
-
-	var totalCount int
-	if len(merchant) > 0 {
-		totalCount = int(merchant[0].TotalCount)
-	} else {
-		totalCount = 0
-	}
-	return r.mapping.ToMerchantsTransactionByApikeyRecord(merchant), &totalCount, nil
-}
-
 func (r *merchantRepository) FindById(merchant_id int) (*record.MerchantRecord, error) {
 	res, err := r.db.GetMerchantByID(r.ctx, int32(merchant_id))
 
@@ -185,16 +162,6 @@ func (r *merchantRepository) FindById(merchant_id int) (*record.MerchantRecord,
 	return r.mapping.ToMerchantRecord(res), nil
 }
 
-func (r *merchantRepository) FindByApiKey(api_key string) (*record.MerchantRecord, error) {
-	res, err := r.db.GetMerchantByApiKey(r.ctx, api_key)
-
-	if err != nil {
-		return nil, merchant_errors.ErrFindMerchantByApiKeyFailed
-	}
-
-	return r.mapping.ToMerchantRecord(res), nil
-}
-
 func (r *merchantRepository) FindByName(name string) (*record.MerchantRecord, error) {
 	res, err := r.db.GetMerchantByName(r.ctx, name)
 
@@ -313,6 +280,33 @@ func (r *merchantRepository) GetMonthlyAmountByMerchants(req *requests.MonthYear
 	return r.mapping.ToMerchantMonthlyAmountsByMerchant(res), nil
 }
 
+func (r *merchantRepository) GetMonthlyDisputeRateByMerchants(req *requests.MonthYearAmountMerchant) ([]*record.MerchantMonthlyDisputeRate, error) {
+	yearStart := time.Date(req.Year, 1, 1, 0, 0, 0, 0, time.UTC)
+	res, err := r.db.GetMonthlyDisputeRateByMerchants(r.ctx, db.GetMonthlyDisputeRateByMerchantsParams{
+		MerchantID: int32(req.MerchantID),
+		Column1:    yearStart,
+	})
+
+	if err != nil {
+		return nil, merchant_errors.ErrGetMonthlyDisputeRateByMerchantsFailed
+	}
+
+	return r.mapping.ToMerchantMonthlyDisputeRatesByMerchant(res), nil
+}
+
+func (r *merchantRepository) GetYearlyDisputeRateByMerchants(req *requests.MonthYearAmountMerchant) ([]*record.MerchantYearlyDisputeRate, error) {
+	res, err := r.db.GetYearlyDisputeRateByMerchants(r.ctx, db.GetYearlyDisputeRateByMerchantsParams{
+		MerchantID: int32(req.MerchantID),
+		Column2:    int32(req.Year),
+	})
+
+	if err != nil {
+		return nil, merchant_errors.ErrGetYearlyDisputeRateByMerchantsFailed
+	}
+
+	return r.mapping.ToMerchantYearlyDisputeRatesByMerchant(res), nil
+}
+
 func (r *merchantRepository) GetYearlyAmountByMerchants(req *requests.MonthYearAmountMerchant) ([]*record.MerchantYearlyAmount, error) {
 	res, err := r.db.GetYearlyAmountByMerchants(r.ctx, db.GetYearlyAmountByMerchantsParams{
 		MerchantID: int32(req.MerchantID),
@@ -353,87 +347,6 @@ func (r *merchantRepository) GetYearlyTotalAmountByMerchants(req *requests.Month
 	return r.mapping.ToMerchantYearlyTotalAmountsByMerchant(res), nil
 }
 
-func (r *merchantRepository) GetMonthlyPaymentMethodByApikey(req *requests.MonthYearPaymentMethodApiKey) ([]*record.MerchantMonthlyPaymentMethod, error) {
-	yearStart := time.Date(req.Year, 1, 1, 0, 0, 0, 0, time.UTC)
-	res, err := r.db.GetMonthlyPaymentMethodByApikey(r.ctx, db.GetMonthlyPaymentMethodByApikeyParams{
-		ApiKey:  req.Apikey,
-		Column1: yearStart,
-	})
-
-	if err != nil {
-		return nil, merchant_errors.ErrGetMonthlyPaymentMethodByApikeyFailed
-	}
-
-	return r.mapping.ToMerchantMonthlyPaymentMethodsByApikey(res), nil
-}
-
-func (r *merchantRepository) GetYearlyPaymentMethodByApikey(req *requests.MonthYearPaymentMethodApiKey) ([]*record.MerchantYearlyPaymentMethod, error) {
-	res, err := r.db.GetYearlyPaymentMethodByApikey(r.ctx, db.GetYearlyPaymentMethodByApikeyParams{
-		ApiKey:  req.Apikey,
-		Column2: req.Year,
-	})
-
-	if err != nil {
-		return nil, merchant_errors.ErrGetYearlyPaymentMethodByApikeyFailed
-	}
-
-	return r.mapping.ToMerchantYearlyPaymentMethodsByApikey(res), nil
-}
-
-func (r *merchantRepository) GetMonthlyAmountByApikey(req *requests.MonthYearAmountApiKey) ([]*record.MerchantMonthlyAmount, error) {
-	yearStart := time.Date(req.Year, 1, 1, 0, 0, 0, 0, time.UTC)
-	res, err := r.db.GetMonthlyAmountByApikey(r.ctx, db.GetMonthlyAmountByApikeyParams{
-		ApiKey:  req.Apikey,
-		Column1: yearStart,
-	})
-
-	if err != nil {
-		return nil, merchant_errors.ErrGetMonthlyAmountByApikeyFailed
-	}
-
-	return r.mapping.ToMerchantMonthlyAmountsByApikey(res), nil
-}
-
-func (r *merchantRepository) GetYearlyAmountByApikey(req *requests.MonthYearAmountApiKey) ([]*record.MerchantYearlyAmount, error) {
-	res, err := r.db.GetYearlyAmountByApikey(r.ctx, db.GetYearlyAmountByApikeyParams{
-		ApiKey:  req.Apikey,
-		Column2: req.Year,
-	})
-
-	if err != nil {
-		return nil, merchant_errors.ErrGetYearlyAmountByApikeyFailed
-	}
-
-	return r.mapping.ToMerchantYearlyAmountsByApikey(res), nil
-}
-
-func (r *merchantRepository) GetMonthlyTotalAmountByApikey(req *requests.MonthYearTotalAmountApiKey) ([]*record.MerchantMonthlyTotalAmount, error) {
-	yearStart := time.Date(req.Year, 1, 1, 0, 0, 0, 0, time.UTC)
-	res, err := r.db.GetMonthlyTotalAmountByApikey(r.ctx, db.GetMonthlyTotalAmountByApikeyParams{
-		ApiKey:  req.Apikey,
-		Column1: yearStart,
-	})
-
-	if err != nil {
-		return nil, merchant_errors.ErrGetMonthlyTotalAmountByApikeyFailed
-	}
-
-	return r.mapping.ToMerchantMonthlyTotalAmountsByApikey(res), nil
-}
-
-func (r *merchantRepository) GetYearlyTotalAmountByApikey(req *requests.MonthYearTotalAmountApiKey) ([]*record.MerchantYearlyTotalAmount, error) {
-	res, err := r.db.GetYearlyTotalAmountByApikey(r.ctx, db.GetYearlyTotalAmountByApikeyParams{
-		ApiKey:  req.Apikey,
-		Column1: int32(req.Year),
-	})
-
-	if err != nil {
-		return nil, merchant_errors.ErrGetYearlyTotalAmountByApikeyFailed
-	}
-
-	return r.mapping.ToMerchantYearlyTotalAmountsByApikey(res), nil
-}
-
 func (r *merchantRepository) FindByMerchantUserId(user_id int) ([]*record.MerchantRecord, error) {
 	res, err := r.db.GetMerchantsByUserID(r.ctx, int32(user_id))
 
@@ -447,9 +360,8 @@ func (r *merchantRepository) FindByMerchantUserId(user_id int) ([]*record.Mercha
 func (r *merchantRepository) CreateMerchant(request *requests.CreateMerchantRequest) (*record.MerchantRecord, error) {
 	req := db.CreateMerchantParams{
 		Name:   request.Name,
-		ApiKey: apikey.GenerateApiKey(),
 		UserID: int32(request.UserID),
-		Status: "inactive",
+		Status: statemachine.StatusPending,
 	}
 
 	res, err := r.db.CreateMerchant(r.ctx, req)
@@ -466,7 +378,6 @@ func (r *merchantRepository) UpdateMerchant(request *requests.UpdateMerchantRequ
 		MerchantID: int32(*request.MerchantID),
 		Name:       request.Name,
 		UserID:     int32(request.UserID),
-		Status:     request.Status,
 	}
 
 	res, err := r.db.UpdateMerchant(r.ctx, req)
@@ -479,14 +390,36 @@ func (r *merchantRepository) UpdateMerchant(request *requests.UpdateMerchantRequ
 }
 
 func (r *merchantRepository) UpdateMerchantStatus(request *requests.UpdateMerchantStatus) (*record.MerchantRecord, error) {
-	req := db.UpdateMerchantStatusParams{
+	current, err := r.db.GetMerchantByID(r.ctx, int32(request.MerchantID))
+
+	if err != nil {
+		return nil, merchant_errors.ErrUpdateMerchantStatusFailed
+	}
+
+	if err := statemachine.Merchant.Transition(current.Status, request.Status); err != nil {
+		return nil, merchant_errors.ErrMerchantStatusConflict
+	}
+
+	req := db.TransitionMerchantStatusParams{
 		MerchantID: int32(request.MerchantID),
 		Status:     request.Status,
+		FromStatus: current.Status,
+	}
+
+	if request.Reason != nil {
+		req.Reason = sql.NullString{String: *request.Reason, Valid: true}
+	}
+
+	if request.ChangedBy != nil {
+		req.ChangedBy = sql.NullInt32{Int32: int32(*request.ChangedBy), Valid: true}
 	}
 
-	res, err := r.db.UpdateMerchantStatus(r.ctx, req)
+	res, err := r.db.TransitionMerchantStatus(r.ctx, req)
 
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, merchant_errors.ErrMerchantStatusConflict
+		}
 		return nil, merchant_errors.ErrUpdateMerchantStatusFailed
 	}
 
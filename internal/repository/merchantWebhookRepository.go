@@ -0,0 +1,235 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_webhook_errors"
+)
+
+type merchantWebhookRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.MerchantWebhookRecordMapping
+}
+
+func NewMerchantWebhookRepository(db *db.Queries, ctx context.Context, mapping recordmapper.MerchantWebhookRecordMapping) *merchantWebhookRepository {
+	return &merchantWebhookRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *merchantWebhookRepository) CreateEndpoint(request *requests.CreateMerchantWebhookEndpoint) (*record.MerchantWebhookEndpointRecord, error) {
+	req := db.CreateMerchantWebhookEndpointParams{
+		MerchantID: int32(request.MerchantID),
+		Url:        request.URL,
+		Secret:     request.Secret,
+		Events:     request.Events,
+	}
+
+	res, err := r.db.CreateMerchantWebhookEndpoint(r.ctx, req)
+
+	if err != nil {
+		return nil, merchant_webhook_errors.ErrCreateMerchantWebhookEndpointFailed
+	}
+
+	return r.mapping.ToMerchantWebhookEndpointRecord(res), nil
+}
+
+func (r *merchantWebhookRepository) FindEndpointById(id int) (*record.MerchantWebhookEndpointRecord, error) {
+	res, err := r.db.GetMerchantWebhookEndpointByID(r.ctx, int32(id))
+
+	if err != nil {
+		return nil, merchant_webhook_errors.ErrFindMerchantWebhookEndpointByIdFailed
+	}
+
+	return r.mapping.ToMerchantWebhookEndpointRecord(res), nil
+}
+
+func (r *merchantWebhookRepository) FindEndpointsByMerchant(merchant_id int) ([]*record.MerchantWebhookEndpointRecord, error) {
+	res, err := r.db.GetMerchantWebhookEndpointsByMerchant(r.ctx, int32(merchant_id))
+
+	if err != nil {
+		return nil, merchant_webhook_errors.ErrFindMerchantWebhookEndpointsFailed
+	}
+
+	return r.mapping.ToMerchantWebhookEndpointsRecord(res), nil
+}
+
+func (r *merchantWebhookRepository) UpdateEndpoint(request *requests.UpdateMerchantWebhookEndpointRequest) (*record.MerchantWebhookEndpointRecord, error) {
+	req := db.UpdateMerchantWebhookEndpointParams{
+		MerchantWebhookEndpointID: int32(request.EndpointID),
+		Url:                       request.URL,
+		Events:                    request.Events,
+		IsActive:                  request.IsActive,
+	}
+
+	res, err := r.db.UpdateMerchantWebhookEndpoint(r.ctx, req)
+
+	if err != nil {
+		return nil, merchant_webhook_errors.ErrUpdateMerchantWebhookEndpointFailed
+	}
+
+	return r.mapping.ToMerchantWebhookEndpointRecord(res), nil
+}
+
+func (r *merchantWebhookRepository) DeleteEndpoint(id int) (*record.MerchantWebhookEndpointRecord, error) {
+	res, err := r.db.DeleteMerchantWebhookEndpoint(r.ctx, int32(id))
+
+	if err != nil {
+		return nil, merchant_webhook_errors.ErrDeleteMerchantWebhookEndpointFailed
+	}
+
+	return r.mapping.ToMerchantWebhookEndpointRecord(res), nil
+}
+
+// QueueDeliveries queues an event for every active endpoint of the merchant
+// subscribed to it. It returns no deliveries when no endpoint is.
+func (r *merchantWebhookRepository) QueueDeliveries(request *requests.QueueMerchantWebhookDeliveries) ([]*record.MerchantWebhookDeliveryRecord, error) {
+	req := db.QueueMerchantWebhookDeliveriesParams{
+		MerchantID: int32(request.MerchantID),
+		EventID:    request.EventID,
+		EventType:  request.EventType,
+		Payload:    request.Payload,
+	}
+
+	res, err := r.db.QueueMerchantWebhookDeliveries(r.ctx, req)
+
+	if err != nil {
+		return nil, merchant_webhook_errors.ErrQueueMerchantWebhookDeliveriesFailed
+	}
+
+	return r.mapping.ToMerchantWebhookDeliveriesRecord(res), nil
+}
+
+func (r *merchantWebhookRepository) CreateDelivery(request *requests.CreateMerchantWebhookDelivery) (*record.MerchantWebhookDeliveryRecord, error) {
+	req := db.CreateMerchantWebhookDeliveryParams{
+		MerchantWebhookEndpointID: int32(request.EndpointID),
+		EventID:                   request.EventID,
+		EventType:                 request.EventType,
+		Payload:                   request.Payload,
+		NextAttemptAt:             sql.NullTime{Time: request.NextAttemptAt, Valid: true},
+	}
+
+	res, err := r.db.CreateMerchantWebhookDelivery(r.ctx, req)
+
+	if err != nil {
+		return nil, merchant_webhook_errors.ErrCreateMerchantWebhookDeliveryFailed
+	}
+
+	return r.mapping.ToMerchantWebhookDeliveryRecord(res), nil
+}
+
+func (r *merchantWebhookRepository) FindDeliveryById(id int) (*record.MerchantWebhookDeliveryRecord, error) {
+	res, err := r.db.GetMerchantWebhookDeliveryByID(r.ctx, int32(id))
+
+	if err != nil {
+		return nil, merchant_webhook_errors.ErrFindMerchantWebhookDeliveryByIdFailed
+	}
+
+	return r.mapping.ToMerchantWebhookDeliveryRecord(res), nil
+}
+
+func (r *merchantWebhookRepository) FindDeliveries(req *requests.FindMerchantWebhookDeliveries) ([]*record.MerchantWebhookDeliveryRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetMerchantWebhookDeliveriesParams{
+		MerchantWebhookEndpointID: int32(req.EndpointID),
+		Limit:                     int32(req.PageSize),
+		Offset:                    int32(offset),
+	}
+
+	deliveries, err := r.db.GetMerchantWebhookDeliveries(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, merchant_webhook_errors.ErrFindMerchantWebhookDeliveriesFailed
+	}
+
+	var totalCount int
+	if len(deliveries) > 0 {
+		totalCount = int(deliveries[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToMerchantWebhookDeliveriesRecordAll(deliveries), &totalCount, nil
+}
+
+// ClaimDueDeliveries claims up to limit pending deliveries whose next attempt
+// is due and pushes their next attempt back to leaseUntil.
+func (r *merchantWebhookRepository) ClaimDueDeliveries(limit int, leaseUntil time.Time) ([]*record.MerchantWebhookDeliveryRecord, error) {
+	res, err := r.db.ClaimDueMerchantWebhookDeliveries(r.ctx, db.ClaimDueMerchantWebhookDeliveriesParams{
+		Limit:         int32(limit),
+		NextAttemptAt: sql.NullTime{Time: leaseUntil, Valid: true},
+	})
+
+	if err != nil {
+		return nil, merchant_webhook_errors.ErrClaimMerchantWebhookDeliveriesFailed
+	}
+
+	return r.mapping.ToMerchantWebhookDeliveriesRecord(res), nil
+}
+
+func (r *merchantWebhookRepository) CreateDeliveryAttempt(request *requests.FinishMerchantWebhookDeliveryAttempt) (*record.MerchantWebhookDeliveryAttemptRecord, error) {
+	req := db.CreateMerchantWebhookDeliveryAttemptParams{
+		MerchantWebhookDeliveryID: int32(request.DeliveryID),
+		Attempt:                   int32(request.Attempt),
+		ResponseCode:              nullableResponseCode(request.ResponseCode),
+		Error:                     nullableWebhookError(request.Error),
+		DurationMs:                int32(request.Duration.Milliseconds()),
+	}
+
+	res, err := r.db.CreateMerchantWebhookDeliveryAttempt(r.ctx, req)
+
+	if err != nil {
+		return nil, merchant_webhook_errors.ErrFinishMerchantWebhookDeliveryFailed
+	}
+
+	return r.mapping.ToMerchantWebhookDeliveryAttemptRecord(res), nil
+}
+
+func (r *merchantWebhookRepository) FinishDeliveryAttempt(request *requests.FinishMerchantWebhookDeliveryAttempt) (*record.MerchantWebhookDeliveryRecord, error) {
+	req := db.FinishMerchantWebhookDeliveryAttemptParams{
+		MerchantWebhookDeliveryID: int32(request.DeliveryID),
+		Status:                    request.Status,
+		LastResponseCode:          nullableResponseCode(request.ResponseCode),
+		LastError:                 nullableWebhookError(request.Error),
+	}
+
+	if request.NextAttemptAt != nil {
+		req.NextAttemptAt = sql.NullTime{Time: *request.NextAttemptAt, Valid: true}
+	}
+
+	res, err := r.db.FinishMerchantWebhookDeliveryAttempt(r.ctx, req)
+
+	if err != nil {
+		return nil, merchant_webhook_errors.ErrFinishMerchantWebhookDeliveryFailed
+	}
+
+	return r.mapping.ToMerchantWebhookDeliveryRecord(res), nil
+}
+
+func (r *merchantWebhookRepository) FindDeliveryAttempts(delivery_id int) ([]*record.MerchantWebhookDeliveryAttemptRecord, error) {
+	res, err := r.db.GetMerchantWebhookDeliveryAttempts(r.ctx, int32(delivery_id))
+
+	if err != nil {
+		return nil, merchant_webhook_errors.ErrFindMerchantWebhookDeliveryAttemptsFailed
+	}
+
+	return r.mapping.ToMerchantWebhookDeliveryAttemptsRecord(res), nil
+}
+
+func nullableResponseCode(value *int) sql.NullInt32 {
+	if value == nil {
+		return sql.NullInt32{}
+	}
+
+	return sql.NullInt32{Int32: int32(*value), Valid: true}
+}
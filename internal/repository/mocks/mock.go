@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: internal/repository/interfaces.go
+// Source: interfaces.go
 //
 // Generated by this command:
 //
-//	mockgen -source=internal/repository/interfaces.go -destination=internal/repository/mocks/mock.go
+//	mockgen -source=interfaces.go -destination=mocks/mock.go
 //
 
 // Package mock_repository is a generated GoMock package.
@@ -11,10 +11,11 @@ package mock_repository
 
 import (
 	reflect "reflect"
+	time "time"
 
 	record "github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
 	requests "github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
-
+	repository "github.com/MamangRust/paymentgatewaygraphql/internal/repository"
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -225,6 +226,21 @@ func (mr *MockUserRepositoryMockRecorder) UpdateUser(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserRepository)(nil).UpdateUser), request)
 }
 
+// UpdateUserTier mocks base method.
+func (m *MockUserRepository) UpdateUserTier(request *requests.UpdateUserTierRequest) (*record.UserRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTier", request)
+	ret0, _ := ret[0].(*record.UserRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserTier indicates an expected call of UpdateUserTier.
+func (mr *MockUserRepositoryMockRecorder) UpdateUserTier(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTier", reflect.TypeOf((*MockUserRepository)(nil).UpdateUserTier), request)
+}
+
 // MockRoleRepository is a mock of RoleRepository interface.
 type MockRoleRepository struct {
 	ctrl     *gomock.Controller
@@ -954,6 +970,21 @@ func (mr *MockCardRepositoryMockRecorder) GetMonthlyWithdrawAmountByCardNumber(r
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthlyWithdrawAmountByCardNumber", reflect.TypeOf((*MockCardRepository)(nil).GetMonthlyWithdrawAmountByCardNumber), req)
 }
 
+// GetTotalAmountsByCurrency mocks base method.
+func (m *MockCardRepository) GetTotalAmountsByCurrency() ([]*record.CardCurrencyTotals, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTotalAmountsByCurrency")
+	ret0, _ := ret[0].([]*record.CardCurrencyTotals)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTotalAmountsByCurrency indicates an expected call of GetTotalAmountsByCurrency.
+func (mr *MockCardRepositoryMockRecorder) GetTotalAmountsByCurrency() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalAmountsByCurrency", reflect.TypeOf((*MockCardRepository)(nil).GetTotalAmountsByCurrency))
+}
+
 // GetTotalBalanceByCardNumber mocks base method.
 func (m *MockCardRepository) GetTotalBalanceByCardNumber(cardNumber string) (*int64, error) {
 	m.ctrl.T.Helper()
@@ -1460,22 +1491,6 @@ func (mr *MockMerchantRepositoryMockRecorder) FindAllTransactions(req any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllTransactions", reflect.TypeOf((*MockMerchantRepository)(nil).FindAllTransactions), req)
 }
 
-// FindAllTransactionsByApikey mocks base method.
-func (m *MockMerchantRepository) FindAllTransactionsByApikey(req *requests.FindAllMerchantTransactionsByApiKey) ([]*record.MerchantTransactionsRecord, *int, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindAllTransactionsByApikey", req)
-	ret0, _ := ret[0].([]*record.MerchantTransactionsRecord)
-	ret1, _ := ret[1].(*int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
-}
-
-// FindAllTransactionsByApikey indicates an expected call of FindAllTransactionsByApikey.
-func (mr *MockMerchantRepositoryMockRecorder) FindAllTransactionsByApikey(req any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllTransactionsByApikey", reflect.TypeOf((*MockMerchantRepository)(nil).FindAllTransactionsByApikey), req)
-}
-
 // FindAllTransactionsByMerchant mocks base method.
 func (m *MockMerchantRepository) FindAllTransactionsByMerchant(req *requests.FindAllMerchantTransactionsById) ([]*record.MerchantTransactionsRecord, *int, error) {
 	m.ctrl.T.Helper()
@@ -1508,21 +1523,6 @@ func (mr *MockMerchantRepositoryMockRecorder) FindByActive(req any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByActive", reflect.TypeOf((*MockMerchantRepository)(nil).FindByActive), req)
 }
 
-// FindByApiKey mocks base method.
-func (m *MockMerchantRepository) FindByApiKey(api_key string) (*record.MerchantRecord, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "FindByApiKey", api_key)
-	ret0, _ := ret[0].(*record.MerchantRecord)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// FindByApiKey indicates an expected call of FindByApiKey.
-func (mr *MockMerchantRepositoryMockRecorder) FindByApiKey(api_key any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByApiKey", reflect.TypeOf((*MockMerchantRepository)(nil).FindByApiKey), api_key)
-}
-
 // FindById mocks base method.
 func (m *MockMerchantRepository) FindById(merchant_id int) (*record.MerchantRecord, error) {
 	m.ctrl.T.Helper()
@@ -1584,21 +1584,6 @@ func (mr *MockMerchantRepositoryMockRecorder) FindByTrashed(req any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTrashed", reflect.TypeOf((*MockMerchantRepository)(nil).FindByTrashed), req)
 }
 
-// GetMonthlyAmountByApikey mocks base method.
-func (m *MockMerchantRepository) GetMonthlyAmountByApikey(req *requests.MonthYearAmountApiKey) ([]*record.MerchantMonthlyAmount, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMonthlyAmountByApikey", req)
-	ret0, _ := ret[0].([]*record.MerchantMonthlyAmount)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetMonthlyAmountByApikey indicates an expected call of GetMonthlyAmountByApikey.
-func (mr *MockMerchantRepositoryMockRecorder) GetMonthlyAmountByApikey(req any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthlyAmountByApikey", reflect.TypeOf((*MockMerchantRepository)(nil).GetMonthlyAmountByApikey), req)
-}
-
 // GetMonthlyAmountByMerchants mocks base method.
 func (m *MockMerchantRepository) GetMonthlyAmountByMerchants(req *requests.MonthYearAmountMerchant) ([]*record.MerchantMonthlyAmount, error) {
 	m.ctrl.T.Helper()
@@ -1629,19 +1614,19 @@ func (mr *MockMerchantRepositoryMockRecorder) GetMonthlyAmountMerchant(year any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthlyAmountMerchant", reflect.TypeOf((*MockMerchantRepository)(nil).GetMonthlyAmountMerchant), year)
 }
 
-// GetMonthlyPaymentMethodByApikey mocks base method.
-func (m *MockMerchantRepository) GetMonthlyPaymentMethodByApikey(req *requests.MonthYearPaymentMethodApiKey) ([]*record.MerchantMonthlyPaymentMethod, error) {
+// GetMonthlyDisputeRateByMerchants mocks base method.
+func (m *MockMerchantRepository) GetMonthlyDisputeRateByMerchants(req *requests.MonthYearAmountMerchant) ([]*record.MerchantMonthlyDisputeRate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMonthlyPaymentMethodByApikey", req)
-	ret0, _ := ret[0].([]*record.MerchantMonthlyPaymentMethod)
+	ret := m.ctrl.Call(m, "GetMonthlyDisputeRateByMerchants", req)
+	ret0, _ := ret[0].([]*record.MerchantMonthlyDisputeRate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMonthlyPaymentMethodByApikey indicates an expected call of GetMonthlyPaymentMethodByApikey.
-func (mr *MockMerchantRepositoryMockRecorder) GetMonthlyPaymentMethodByApikey(req any) *gomock.Call {
+// GetMonthlyDisputeRateByMerchants indicates an expected call of GetMonthlyDisputeRateByMerchants.
+func (mr *MockMerchantRepositoryMockRecorder) GetMonthlyDisputeRateByMerchants(req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthlyPaymentMethodByApikey", reflect.TypeOf((*MockMerchantRepository)(nil).GetMonthlyPaymentMethodByApikey), req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthlyDisputeRateByMerchants", reflect.TypeOf((*MockMerchantRepository)(nil).GetMonthlyDisputeRateByMerchants), req)
 }
 
 // GetMonthlyPaymentMethodByMerchants mocks base method.
@@ -1674,21 +1659,6 @@ func (mr *MockMerchantRepositoryMockRecorder) GetMonthlyPaymentMethodsMerchant(y
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthlyPaymentMethodsMerchant", reflect.TypeOf((*MockMerchantRepository)(nil).GetMonthlyPaymentMethodsMerchant), year)
 }
 
-// GetMonthlyTotalAmountByApikey mocks base method.
-func (m *MockMerchantRepository) GetMonthlyTotalAmountByApikey(req *requests.MonthYearTotalAmountApiKey) ([]*record.MerchantMonthlyTotalAmount, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMonthlyTotalAmountByApikey", req)
-	ret0, _ := ret[0].([]*record.MerchantMonthlyTotalAmount)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetMonthlyTotalAmountByApikey indicates an expected call of GetMonthlyTotalAmountByApikey.
-func (mr *MockMerchantRepositoryMockRecorder) GetMonthlyTotalAmountByApikey(req any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthlyTotalAmountByApikey", reflect.TypeOf((*MockMerchantRepository)(nil).GetMonthlyTotalAmountByApikey), req)
-}
-
 // GetMonthlyTotalAmountByMerchants mocks base method.
 func (m *MockMerchantRepository) GetMonthlyTotalAmountByMerchants(req *requests.MonthYearTotalAmountMerchant) ([]*record.MerchantMonthlyTotalAmount, error) {
 	m.ctrl.T.Helper()
@@ -1719,21 +1689,6 @@ func (mr *MockMerchantRepositoryMockRecorder) GetMonthlyTotalAmountMerchant(year
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthlyTotalAmountMerchant", reflect.TypeOf((*MockMerchantRepository)(nil).GetMonthlyTotalAmountMerchant), year)
 }
 
-// GetYearlyAmountByApikey mocks base method.
-func (m *MockMerchantRepository) GetYearlyAmountByApikey(req *requests.MonthYearAmountApiKey) ([]*record.MerchantYearlyAmount, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetYearlyAmountByApikey", req)
-	ret0, _ := ret[0].([]*record.MerchantYearlyAmount)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetYearlyAmountByApikey indicates an expected call of GetYearlyAmountByApikey.
-func (mr *MockMerchantRepositoryMockRecorder) GetYearlyAmountByApikey(req any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearlyAmountByApikey", reflect.TypeOf((*MockMerchantRepository)(nil).GetYearlyAmountByApikey), req)
-}
-
 // GetYearlyAmountByMerchants mocks base method.
 func (m *MockMerchantRepository) GetYearlyAmountByMerchants(req *requests.MonthYearAmountMerchant) ([]*record.MerchantYearlyAmount, error) {
 	m.ctrl.T.Helper()
@@ -1764,19 +1719,19 @@ func (mr *MockMerchantRepositoryMockRecorder) GetYearlyAmountMerchant(year any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearlyAmountMerchant", reflect.TypeOf((*MockMerchantRepository)(nil).GetYearlyAmountMerchant), year)
 }
 
-// GetYearlyPaymentMethodByApikey mocks base method.
-func (m *MockMerchantRepository) GetYearlyPaymentMethodByApikey(req *requests.MonthYearPaymentMethodApiKey) ([]*record.MerchantYearlyPaymentMethod, error) {
+// GetYearlyDisputeRateByMerchants mocks base method.
+func (m *MockMerchantRepository) GetYearlyDisputeRateByMerchants(req *requests.MonthYearAmountMerchant) ([]*record.MerchantYearlyDisputeRate, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetYearlyPaymentMethodByApikey", req)
-	ret0, _ := ret[0].([]*record.MerchantYearlyPaymentMethod)
+	ret := m.ctrl.Call(m, "GetYearlyDisputeRateByMerchants", req)
+	ret0, _ := ret[0].([]*record.MerchantYearlyDisputeRate)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetYearlyPaymentMethodByApikey indicates an expected call of GetYearlyPaymentMethodByApikey.
-func (mr *MockMerchantRepositoryMockRecorder) GetYearlyPaymentMethodByApikey(req any) *gomock.Call {
+// GetYearlyDisputeRateByMerchants indicates an expected call of GetYearlyDisputeRateByMerchants.
+func (mr *MockMerchantRepositoryMockRecorder) GetYearlyDisputeRateByMerchants(req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearlyPaymentMethodByApikey", reflect.TypeOf((*MockMerchantRepository)(nil).GetYearlyPaymentMethodByApikey), req)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearlyDisputeRateByMerchants", reflect.TypeOf((*MockMerchantRepository)(nil).GetYearlyDisputeRateByMerchants), req)
 }
 
 // GetYearlyPaymentMethodByMerchants mocks base method.
@@ -1809,21 +1764,6 @@ func (mr *MockMerchantRepositoryMockRecorder) GetYearlyPaymentMethodMerchant(yea
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearlyPaymentMethodMerchant", reflect.TypeOf((*MockMerchantRepository)(nil).GetYearlyPaymentMethodMerchant), year)
 }
 
-// GetYearlyTotalAmountByApikey mocks base method.
-func (m *MockMerchantRepository) GetYearlyTotalAmountByApikey(req *requests.MonthYearTotalAmountApiKey) ([]*record.MerchantYearlyTotalAmount, error) {
-	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetYearlyTotalAmountByApikey", req)
-	ret0, _ := ret[0].([]*record.MerchantYearlyTotalAmount)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
-}
-
-// GetYearlyTotalAmountByApikey indicates an expected call of GetYearlyTotalAmountByApikey.
-func (mr *MockMerchantRepositoryMockRecorder) GetYearlyTotalAmountByApikey(req any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearlyTotalAmountByApikey", reflect.TypeOf((*MockMerchantRepository)(nil).GetYearlyTotalAmountByApikey), req)
-}
-
 // GetYearlyTotalAmountByMerchants mocks base method.
 func (m *MockMerchantRepository) GetYearlyTotalAmountByMerchants(req *requests.MonthYearTotalAmountMerchant) ([]*record.MerchantYearlyTotalAmount, error) {
 	m.ctrl.T.Helper()
@@ -2136,6 +2076,66 @@ func (mr *MockSaldoRepositoryMockRecorder) GetYearlySaldoBalances(year any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearlySaldoBalances", reflect.TypeOf((*MockSaldoRepository)(nil).GetYearlySaldoBalances), year)
 }
 
+// LockByCardNumbers mocks base method.
+func (m *MockSaldoRepository) LockByCardNumbers(card_numbers []string) ([]*record.SaldoRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockByCardNumbers", card_numbers)
+	ret0, _ := ret[0].([]*record.SaldoRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockByCardNumbers indicates an expected call of LockByCardNumbers.
+func (mr *MockSaldoRepositoryMockRecorder) LockByCardNumbers(card_numbers any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockByCardNumbers", reflect.TypeOf((*MockSaldoRepository)(nil).LockByCardNumbers), card_numbers)
+}
+
+// RebuildFromLedger mocks base method.
+func (m *MockSaldoRepository) RebuildFromLedger() ([]*record.SaldoRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildFromLedger")
+	ret0, _ := ret[0].([]*record.SaldoRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebuildFromLedger indicates an expected call of RebuildFromLedger.
+func (mr *MockSaldoRepositoryMockRecorder) RebuildFromLedger() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildFromLedger", reflect.TypeOf((*MockSaldoRepository)(nil).RebuildFromLedger))
+}
+
+// ReleaseReservation mocks base method.
+func (m *MockSaldoRepository) ReleaseReservation(card_number string, amount int) (*record.SaldoRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseReservation", card_number, amount)
+	ret0, _ := ret[0].(*record.SaldoRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseReservation indicates an expected call of ReleaseReservation.
+func (mr *MockSaldoRepositoryMockRecorder) ReleaseReservation(card_number, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseReservation", reflect.TypeOf((*MockSaldoRepository)(nil).ReleaseReservation), card_number, amount)
+}
+
+// ReserveBalance mocks base method.
+func (m *MockSaldoRepository) ReserveBalance(card_number string, amount int) (*record.SaldoRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveBalance", card_number, amount)
+	ret0, _ := ret[0].(*record.SaldoRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveBalance indicates an expected call of ReserveBalance.
+func (mr *MockSaldoRepositoryMockRecorder) ReserveBalance(card_number, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveBalance", reflect.TypeOf((*MockSaldoRepository)(nil).ReserveBalance), card_number, amount)
+}
+
 // RestoreAllSaldo mocks base method.
 func (m *MockSaldoRepository) RestoreAllSaldo() (bool, error) {
 	m.ctrl.T.Helper()
@@ -2226,6 +2226,120 @@ func (mr *MockSaldoRepositoryMockRecorder) UpdateSaldoWithdraw(request any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSaldoWithdraw", reflect.TypeOf((*MockSaldoRepository)(nil).UpdateSaldoWithdraw), request)
 }
 
+// MockSaldoHoldRepository is a mock of SaldoHoldRepository interface.
+type MockSaldoHoldRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSaldoHoldRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSaldoHoldRepositoryMockRecorder is the mock recorder for MockSaldoHoldRepository.
+type MockSaldoHoldRepositoryMockRecorder struct {
+	mock *MockSaldoHoldRepository
+}
+
+// NewMockSaldoHoldRepository creates a new mock instance.
+func NewMockSaldoHoldRepository(ctrl *gomock.Controller) *MockSaldoHoldRepository {
+	mock := &MockSaldoHoldRepository{ctrl: ctrl}
+	mock.recorder = &MockSaldoHoldRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSaldoHoldRepository) EXPECT() *MockSaldoHoldRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ConsumeHold mocks base method.
+func (m *MockSaldoHoldRepository) ConsumeHold(hold_id int) (*record.SaldoHoldRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeHold", hold_id)
+	ret0, _ := ret[0].(*record.SaldoHoldRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConsumeHold indicates an expected call of ConsumeHold.
+func (mr *MockSaldoHoldRepositoryMockRecorder) ConsumeHold(hold_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeHold", reflect.TypeOf((*MockSaldoHoldRepository)(nil).ConsumeHold), hold_id)
+}
+
+// CreateHold mocks base method.
+func (m *MockSaldoHoldRepository) CreateHold(request *requests.CreateSaldoHoldRequest) (*record.SaldoHoldRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHold", request)
+	ret0, _ := ret[0].(*record.SaldoHoldRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateHold indicates an expected call of CreateHold.
+func (mr *MockSaldoHoldRepositoryMockRecorder) CreateHold(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*MockSaldoHoldRepository)(nil).CreateHold), request)
+}
+
+// FindActiveByCardNumber mocks base method.
+func (m *MockSaldoHoldRepository) FindActiveByCardNumber(card_number string) ([]*record.SaldoHoldRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveByCardNumber", card_number)
+	ret0, _ := ret[0].([]*record.SaldoHoldRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveByCardNumber indicates an expected call of FindActiveByCardNumber.
+func (mr *MockSaldoHoldRepositoryMockRecorder) FindActiveByCardNumber(card_number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveByCardNumber", reflect.TypeOf((*MockSaldoHoldRepository)(nil).FindActiveByCardNumber), card_number)
+}
+
+// FindActiveByReference mocks base method.
+func (m *MockSaldoHoldRepository) FindActiveByReference(reference_type string, reference_id int) (*record.SaldoHoldRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveByReference", reference_type, reference_id)
+	ret0, _ := ret[0].(*record.SaldoHoldRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveByReference indicates an expected call of FindActiveByReference.
+func (mr *MockSaldoHoldRepositoryMockRecorder) FindActiveByReference(reference_type, reference_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveByReference", reflect.TypeOf((*MockSaldoHoldRepository)(nil).FindActiveByReference), reference_type, reference_id)
+}
+
+// FindById mocks base method.
+func (m *MockSaldoHoldRepository) FindById(hold_id int) (*record.SaldoHoldRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", hold_id)
+	ret0, _ := ret[0].(*record.SaldoHoldRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockSaldoHoldRepositoryMockRecorder) FindById(hold_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockSaldoHoldRepository)(nil).FindById), hold_id)
+}
+
+// ReleaseHold mocks base method.
+func (m *MockSaldoHoldRepository) ReleaseHold(hold_id int) (*record.SaldoHoldRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHold", hold_id)
+	ret0, _ := ret[0].(*record.SaldoHoldRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockSaldoHoldRepositoryMockRecorder) ReleaseHold(hold_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockSaldoHoldRepository)(nil).ReleaseHold), hold_id)
+}
+
 // MockTopupRepository is a mock of TopupRepository interface.
 type MockTopupRepository struct {
 	ctrl     *gomock.Controller
@@ -2250,6 +2364,21 @@ func (m *MockTopupRepository) EXPECT() *MockTopupRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CreateProviderPayment mocks base method.
+func (m *MockTopupRepository) CreateProviderPayment(request *requests.CreateTopupProviderPayment) (*record.TopupProviderPaymentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProviderPayment", request)
+	ret0, _ := ret[0].(*record.TopupProviderPaymentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProviderPayment indicates an expected call of CreateProviderPayment.
+func (mr *MockTopupRepositoryMockRecorder) CreateProviderPayment(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProviderPayment", reflect.TypeOf((*MockTopupRepository)(nil).CreateProviderPayment), request)
+}
+
 // CreateTopup mocks base method.
 func (m *MockTopupRepository) CreateTopup(request *requests.CreateTopupRequest) (*record.TopupRecord, error) {
 	m.ctrl.T.Helper()
@@ -2374,6 +2503,36 @@ func (mr *MockTopupRepositoryMockRecorder) FindByTrashed(req any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTrashed", reflect.TypeOf((*MockTopupRepository)(nil).FindByTrashed), req)
 }
 
+// FindPendingProviderPayments mocks base method.
+func (m *MockTopupRepository) FindPendingProviderPayments(limit int) ([]*record.TopupProviderPaymentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingProviderPayments", limit)
+	ret0, _ := ret[0].([]*record.TopupProviderPaymentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingProviderPayments indicates an expected call of FindPendingProviderPayments.
+func (mr *MockTopupRepositoryMockRecorder) FindPendingProviderPayments(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingProviderPayments", reflect.TypeOf((*MockTopupRepository)(nil).FindPendingProviderPayments), limit)
+}
+
+// FindProviderPaymentByReference mocks base method.
+func (m *MockTopupRepository) FindProviderPaymentByReference(provider, reference string) (*record.TopupProviderPaymentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindProviderPaymentByReference", provider, reference)
+	ret0, _ := ret[0].(*record.TopupProviderPaymentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindProviderPaymentByReference indicates an expected call of FindProviderPaymentByReference.
+func (mr *MockTopupRepositoryMockRecorder) FindProviderPaymentByReference(provider, reference any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindProviderPaymentByReference", reflect.TypeOf((*MockTopupRepository)(nil).FindProviderPaymentByReference), provider, reference)
+}
+
 // GetMonthTopupStatusFailed mocks base method.
 func (m *MockTopupRepository) GetMonthTopupStatusFailed(req *requests.MonthTopupStatus) ([]*record.TopupRecordMonthStatusFailed, error) {
 	m.ctrl.T.Helper()
@@ -2644,6 +2803,21 @@ func (mr *MockTopupRepositoryMockRecorder) RestoreTopup(topup_id any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTopup", reflect.TypeOf((*MockTopupRepository)(nil).RestoreTopup), topup_id)
 }
 
+// TouchProviderPayment mocks base method.
+func (m *MockTopupRepository) TouchProviderPayment(payment_id int, message *string) (*record.TopupProviderPaymentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchProviderPayment", payment_id, message)
+	ret0, _ := ret[0].(*record.TopupProviderPaymentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TouchProviderPayment indicates an expected call of TouchProviderPayment.
+func (mr *MockTopupRepositoryMockRecorder) TouchProviderPayment(payment_id, message any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchProviderPayment", reflect.TypeOf((*MockTopupRepository)(nil).TouchProviderPayment), payment_id, message)
+}
+
 // TrashedTopup mocks base method.
 func (m *MockTopupRepository) TrashedTopup(topup_id int) (*record.TopupRecord, error) {
 	m.ctrl.T.Helper()
@@ -2728,6 +2902,36 @@ func (m *MockTransactionRepository) EXPECT() *MockTransactionRepositoryMockRecor
 	return m.recorder
 }
 
+// ApplyRefund mocks base method.
+func (m *MockTransactionRepository) ApplyRefund(transaction_id, amount int) (*record.TransactionRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyRefund", transaction_id, amount)
+	ret0, _ := ret[0].(*record.TransactionRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ApplyRefund indicates an expected call of ApplyRefund.
+func (mr *MockTransactionRepositoryMockRecorder) ApplyRefund(transaction_id, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyRefund", reflect.TypeOf((*MockTransactionRepository)(nil).ApplyRefund), transaction_id, amount)
+}
+
+// ChargeBack mocks base method.
+func (m *MockTransactionRepository) ChargeBack(transaction_id, amount int) (*record.TransactionRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChargeBack", transaction_id, amount)
+	ret0, _ := ret[0].(*record.TransactionRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChargeBack indicates an expected call of ChargeBack.
+func (mr *MockTransactionRepositoryMockRecorder) ChargeBack(transaction_id, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChargeBack", reflect.TypeOf((*MockTransactionRepository)(nil).ChargeBack), transaction_id, amount)
+}
+
 // CreateTransaction mocks base method.
 func (m *MockTransactionRepository) CreateTransaction(request *requests.CreateTransactionRequest) (*record.TransactionRecord, error) {
 	m.ctrl.T.Helper()
@@ -3107,6 +3311,36 @@ func (mr *MockTransactionRepositoryMockRecorder) GetYearlyTransactionStatusSucce
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearlyTransactionStatusSuccessByCardNumber", reflect.TypeOf((*MockTransactionRepository)(nil).GetYearlyTransactionStatusSuccessByCardNumber), req)
 }
 
+// MarkDisputed mocks base method.
+func (m *MockTransactionRepository) MarkDisputed(transaction_id int) (*record.TransactionRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDisputed", transaction_id)
+	ret0, _ := ret[0].(*record.TransactionRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkDisputed indicates an expected call of MarkDisputed.
+func (mr *MockTransactionRepositoryMockRecorder) MarkDisputed(transaction_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDisputed", reflect.TypeOf((*MockTransactionRepository)(nil).MarkDisputed), transaction_id)
+}
+
+// ReleaseDispute mocks base method.
+func (m *MockTransactionRepository) ReleaseDispute(transaction_id int) (*record.TransactionRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDispute", transaction_id)
+	ret0, _ := ret[0].(*record.TransactionRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDispute indicates an expected call of ReleaseDispute.
+func (mr *MockTransactionRepositoryMockRecorder) ReleaseDispute(transaction_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDispute", reflect.TypeOf((*MockTransactionRepository)(nil).ReleaseDispute), transaction_id)
+}
+
 // RestoreAllTransaction mocks base method.
 func (m *MockTransactionRepository) RestoreAllTransaction() (bool, error) {
 	m.ctrl.T.Helper()
@@ -3668,6 +3902,21 @@ func (m *MockWithdrawRepository) EXPECT() *MockWithdrawRepositoryMockRecorder {
 	return m.recorder
 }
 
+// CreateReview mocks base method.
+func (m *MockWithdrawRepository) CreateReview(request *requests.ReviewWithdrawRequest, decision string) (*record.WithdrawReviewRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateReview", request, decision)
+	ret0, _ := ret[0].(*record.WithdrawReviewRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateReview indicates an expected call of CreateReview.
+func (mr *MockWithdrawRepositoryMockRecorder) CreateReview(request, decision any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateReview", reflect.TypeOf((*MockWithdrawRepository)(nil).CreateReview), request, decision)
+}
+
 // CreateWithdraw mocks base method.
 func (m *MockWithdrawRepository) CreateWithdraw(request *requests.CreateWithdrawRequest) (*record.WithdrawRecord, error) {
 	m.ctrl.T.Helper()
@@ -3792,16 +4041,76 @@ func (mr *MockWithdrawRepositoryMockRecorder) FindByTrashed(req any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTrashed", reflect.TypeOf((*MockWithdrawRepository)(nil).FindByTrashed), req)
 }
 
-// GetMonthWithdrawStatusFailed mocks base method.
-func (m *MockWithdrawRepository) GetMonthWithdrawStatusFailed(req *requests.MonthStatusWithdraw) ([]*record.WithdrawRecordMonthStatusFailed, error) {
+// FindByWithdrawNo mocks base method.
+func (m *MockWithdrawRepository) FindByWithdrawNo(withdraw_no string) (*record.WithdrawRecord, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMonthWithdrawStatusFailed", req)
-	ret0, _ := ret[0].([]*record.WithdrawRecordMonthStatusFailed)
+	ret := m.ctrl.Call(m, "FindByWithdrawNo", withdraw_no)
+	ret0, _ := ret[0].(*record.WithdrawRecord)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// GetMonthWithdrawStatusFailed indicates an expected call of GetMonthWithdrawStatusFailed.
+// FindByWithdrawNo indicates an expected call of FindByWithdrawNo.
+func (mr *MockWithdrawRepositoryMockRecorder) FindByWithdrawNo(withdraw_no any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByWithdrawNo", reflect.TypeOf((*MockWithdrawRepository)(nil).FindByWithdrawNo), withdraw_no)
+}
+
+// FindByWithdrawNoForUpdate mocks base method.
+func (m *MockWithdrawRepository) FindByWithdrawNoForUpdate(withdraw_no string) (*record.WithdrawRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByWithdrawNoForUpdate", withdraw_no)
+	ret0, _ := ret[0].(*record.WithdrawRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByWithdrawNoForUpdate indicates an expected call of FindByWithdrawNoForUpdate.
+func (mr *MockWithdrawRepositoryMockRecorder) FindByWithdrawNoForUpdate(withdraw_no any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByWithdrawNoForUpdate", reflect.TypeOf((*MockWithdrawRepository)(nil).FindByWithdrawNoForUpdate), withdraw_no)
+}
+
+// FindReview mocks base method.
+func (m *MockWithdrawRepository) FindReview(withdraw_id int) (*record.WithdrawReviewRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReview", withdraw_id)
+	ret0, _ := ret[0].(*record.WithdrawReviewRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReview indicates an expected call of FindReview.
+func (mr *MockWithdrawRepositoryMockRecorder) FindReview(withdraw_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReview", reflect.TypeOf((*MockWithdrawRepository)(nil).FindReview), withdraw_id)
+}
+
+// FindSettleable mocks base method.
+func (m *MockWithdrawRepository) FindSettleable(limit int) ([]*record.WithdrawRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindSettleable", limit)
+	ret0, _ := ret[0].([]*record.WithdrawRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindSettleable indicates an expected call of FindSettleable.
+func (mr *MockWithdrawRepositoryMockRecorder) FindSettleable(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindSettleable", reflect.TypeOf((*MockWithdrawRepository)(nil).FindSettleable), limit)
+}
+
+// GetMonthWithdrawStatusFailed mocks base method.
+func (m *MockWithdrawRepository) GetMonthWithdrawStatusFailed(req *requests.MonthStatusWithdraw) ([]*record.WithdrawRecordMonthStatusFailed, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonthWithdrawStatusFailed", req)
+	ret0, _ := ret[0].([]*record.WithdrawRecordMonthStatusFailed)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonthWithdrawStatusFailed indicates an expected call of GetMonthWithdrawStatusFailed.
 func (mr *MockWithdrawRepositoryMockRecorder) GetMonthWithdrawStatusFailed(req any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthWithdrawStatusFailed", reflect.TypeOf((*MockWithdrawRepository)(nil).GetMonthWithdrawStatusFailed), req)
@@ -4046,3 +4355,2641 @@ func (mr *MockWithdrawRepositoryMockRecorder) UpdateWithdrawStatus(request any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWithdrawStatus", reflect.TypeOf((*MockWithdrawRepository)(nil).UpdateWithdrawStatus), request)
 }
+
+// MockLedgerRepository is a mock of LedgerRepository interface.
+type MockLedgerRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockLedgerRepositoryMockRecorder is the mock recorder for MockLedgerRepository.
+type MockLedgerRepositoryMockRecorder struct {
+	mock *MockLedgerRepository
+}
+
+// NewMockLedgerRepository creates a new mock instance.
+func NewMockLedgerRepository(ctrl *gomock.Controller) *MockLedgerRepository {
+	mock := &MockLedgerRepository{ctrl: ctrl}
+	mock.recorder = &MockLedgerRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerRepository) EXPECT() *MockLedgerRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindAllJournals mocks base method.
+func (m *MockLedgerRepository) FindAllJournals(req *requests.FindAllLedgerJournals) ([]*record.LedgerJournalRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllJournals", req)
+	ret0, _ := ret[0].([]*record.LedgerJournalRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAllJournals indicates an expected call of FindAllJournals.
+func (mr *MockLedgerRepositoryMockRecorder) FindAllJournals(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllJournals", reflect.TypeOf((*MockLedgerRepository)(nil).FindAllJournals), req)
+}
+
+// FindBalanceByCardNumber mocks base method.
+func (m *MockLedgerRepository) FindBalanceByCardNumber(card_number string) (*record.LedgerBalanceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBalanceByCardNumber", card_number)
+	ret0, _ := ret[0].(*record.LedgerBalanceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBalanceByCardNumber indicates an expected call of FindBalanceByCardNumber.
+func (mr *MockLedgerRepositoryMockRecorder) FindBalanceByCardNumber(card_number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBalanceByCardNumber", reflect.TypeOf((*MockLedgerRepository)(nil).FindBalanceByCardNumber), card_number)
+}
+
+// FindJournalById mocks base method.
+func (m *MockLedgerRepository) FindJournalById(journal_id int) (*record.LedgerJournalRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindJournalById", journal_id)
+	ret0, _ := ret[0].(*record.LedgerJournalRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindJournalById indicates an expected call of FindJournalById.
+func (mr *MockLedgerRepositoryMockRecorder) FindJournalById(journal_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindJournalById", reflect.TypeOf((*MockLedgerRepository)(nil).FindJournalById), journal_id)
+}
+
+// FindPostingsByCardNumber mocks base method.
+func (m *MockLedgerRepository) FindPostingsByCardNumber(req *requests.FindLedgerPostingsByCardNumber) ([]*record.LedgerCardPostingRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPostingsByCardNumber", req)
+	ret0, _ := ret[0].([]*record.LedgerCardPostingRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindPostingsByCardNumber indicates an expected call of FindPostingsByCardNumber.
+func (mr *MockLedgerRepositoryMockRecorder) FindPostingsByCardNumber(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPostingsByCardNumber", reflect.TypeOf((*MockLedgerRepository)(nil).FindPostingsByCardNumber), req)
+}
+
+// PostJournal mocks base method.
+func (m *MockLedgerRepository) PostJournal(request *requests.CreateLedgerJournalRequest) (*record.LedgerJournalRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PostJournal", request)
+	ret0, _ := ret[0].(*record.LedgerJournalRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PostJournal indicates an expected call of PostJournal.
+func (mr *MockLedgerRepositoryMockRecorder) PostJournal(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PostJournal", reflect.TypeOf((*MockLedgerRepository)(nil).PostJournal), request)
+}
+
+// MockIdempotencyKeyRepository is a mock of IdempotencyKeyRepository interface.
+type MockIdempotencyKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyKeyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockIdempotencyKeyRepositoryMockRecorder is the mock recorder for MockIdempotencyKeyRepository.
+type MockIdempotencyKeyRepositoryMockRecorder struct {
+	mock *MockIdempotencyKeyRepository
+}
+
+// NewMockIdempotencyKeyRepository creates a new mock instance.
+func NewMockIdempotencyKeyRepository(ctrl *gomock.Controller) *MockIdempotencyKeyRepository {
+	mock := &MockIdempotencyKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyKeyRepository) EXPECT() *MockIdempotencyKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateIdempotencyKey mocks base method.
+func (m *MockIdempotencyKeyRepository) CreateIdempotencyKey(req *requests.CreateIdempotencyKey) (*record.IdempotencyKeyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIdempotencyKey", req)
+	ret0, _ := ret[0].(*record.IdempotencyKeyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIdempotencyKey indicates an expected call of CreateIdempotencyKey.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) CreateIdempotencyKey(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIdempotencyKey", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).CreateIdempotencyKey), req)
+}
+
+// DeleteIdempotencyKey mocks base method.
+func (m *MockIdempotencyKeyRepository) DeleteIdempotencyKey(idempotency_key_id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIdempotencyKey", idempotency_key_id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIdempotencyKey indicates an expected call of DeleteIdempotencyKey.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) DeleteIdempotencyKey(idempotency_key_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIdempotencyKey", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).DeleteIdempotencyKey), idempotency_key_id)
+}
+
+// FindByKey mocks base method.
+func (m *MockIdempotencyKeyRepository) FindByKey(user_id int, scope, key string) (*record.IdempotencyKeyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByKey", user_id, scope, key)
+	ret0, _ := ret[0].(*record.IdempotencyKeyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByKey indicates an expected call of FindByKey.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) FindByKey(user_id, scope, key any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByKey", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).FindByKey), user_id, scope, key)
+}
+
+// UpdateResponse mocks base method.
+func (m *MockIdempotencyKeyRepository) UpdateResponse(idempotency_key_id int, response_body string) (*record.IdempotencyKeyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResponse", idempotency_key_id, response_body)
+	ret0, _ := ret[0].(*record.IdempotencyKeyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResponse indicates an expected call of UpdateResponse.
+func (mr *MockIdempotencyKeyRepositoryMockRecorder) UpdateResponse(idempotency_key_id, response_body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResponse", reflect.TypeOf((*MockIdempotencyKeyRepository)(nil).UpdateResponse), idempotency_key_id, response_body)
+}
+
+// MockUnitOfWork is a mock of UnitOfWork interface.
+type MockUnitOfWork struct {
+	ctrl     *gomock.Controller
+	recorder *MockUnitOfWorkMockRecorder
+	isgomock struct{}
+}
+
+// MockUnitOfWorkMockRecorder is the mock recorder for MockUnitOfWork.
+type MockUnitOfWorkMockRecorder struct {
+	mock *MockUnitOfWork
+}
+
+// NewMockUnitOfWork creates a new mock instance.
+func NewMockUnitOfWork(ctrl *gomock.Controller) *MockUnitOfWork {
+	mock := &MockUnitOfWork{ctrl: ctrl}
+	mock.recorder = &MockUnitOfWorkMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockUnitOfWork) EXPECT() *MockUnitOfWorkMockRecorder {
+	return m.recorder
+}
+
+// WithinTransaction mocks base method.
+func (m *MockUnitOfWork) WithinTransaction(fn func(*repository.Repositories) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WithinTransaction", fn)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// WithinTransaction indicates an expected call of WithinTransaction.
+func (mr *MockUnitOfWorkMockRecorder) WithinTransaction(fn any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WithinTransaction", reflect.TypeOf((*MockUnitOfWork)(nil).WithinTransaction), fn)
+}
+
+// MockRefundRepository is a mock of RefundRepository interface.
+type MockRefundRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefundRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockRefundRepositoryMockRecorder is the mock recorder for MockRefundRepository.
+type MockRefundRepositoryMockRecorder struct {
+	mock *MockRefundRepository
+}
+
+// NewMockRefundRepository creates a new mock instance.
+func NewMockRefundRepository(ctrl *gomock.Controller) *MockRefundRepository {
+	mock := &MockRefundRepository{ctrl: ctrl}
+	mock.recorder = &MockRefundRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefundRepository) EXPECT() *MockRefundRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateRefund mocks base method.
+func (m *MockRefundRepository) CreateRefund(transaction *record.TransactionRecord, request *requests.CreateRefundRequest) (*record.RefundRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRefund", transaction, request)
+	ret0, _ := ret[0].(*record.RefundRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRefund indicates an expected call of CreateRefund.
+func (mr *MockRefundRepositoryMockRecorder) CreateRefund(transaction, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRefund", reflect.TypeOf((*MockRefundRepository)(nil).CreateRefund), transaction, request)
+}
+
+// FindAll mocks base method.
+func (m *MockRefundRepository) FindAll(req *requests.FindAllRefunds) ([]*record.RefundRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*record.RefundRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockRefundRepositoryMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRefundRepository)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockRefundRepository) FindById(refund_id int) (*record.RefundRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", refund_id)
+	ret0, _ := ret[0].(*record.RefundRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockRefundRepositoryMockRecorder) FindById(refund_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockRefundRepository)(nil).FindById), refund_id)
+}
+
+// FindByTransactionId mocks base method.
+func (m *MockRefundRepository) FindByTransactionId(transaction_id int) ([]*record.RefundRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTransactionId", transaction_id)
+	ret0, _ := ret[0].([]*record.RefundRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByTransactionId indicates an expected call of FindByTransactionId.
+func (mr *MockRefundRepositoryMockRecorder) FindByTransactionId(transaction_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTransactionId", reflect.TypeOf((*MockRefundRepository)(nil).FindByTransactionId), transaction_id)
+}
+
+// MockDisputeRepository is a mock of DisputeRepository interface.
+type MockDisputeRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockDisputeRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockDisputeRepositoryMockRecorder is the mock recorder for MockDisputeRepository.
+type MockDisputeRepositoryMockRecorder struct {
+	mock *MockDisputeRepository
+}
+
+// NewMockDisputeRepository creates a new mock instance.
+func NewMockDisputeRepository(ctrl *gomock.Controller) *MockDisputeRepository {
+	mock := &MockDisputeRepository{ctrl: ctrl}
+	mock.recorder = &MockDisputeRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDisputeRepository) EXPECT() *MockDisputeRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateDispute mocks base method.
+func (m *MockDisputeRepository) CreateDispute(transaction *record.TransactionRecord, amount int, held_from string, request *requests.CreateDisputeRequest) (*record.DisputeRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDispute", transaction, amount, held_from, request)
+	ret0, _ := ret[0].(*record.DisputeRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDispute indicates an expected call of CreateDispute.
+func (mr *MockDisputeRepositoryMockRecorder) CreateDispute(transaction, amount, held_from, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDispute", reflect.TypeOf((*MockDisputeRepository)(nil).CreateDispute), transaction, amount, held_from, request)
+}
+
+// FindAll mocks base method.
+func (m *MockDisputeRepository) FindAll(req *requests.FindAllDisputes) ([]*record.DisputeRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*record.DisputeRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockDisputeRepositoryMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockDisputeRepository)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockDisputeRepository) FindById(dispute_id int) (*record.DisputeRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", dispute_id)
+	ret0, _ := ret[0].(*record.DisputeRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockDisputeRepositoryMockRecorder) FindById(dispute_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockDisputeRepository)(nil).FindById), dispute_id)
+}
+
+// FindByMerchant mocks base method.
+func (m *MockDisputeRepository) FindByMerchant(req *requests.FindAllDisputesByMerchant) ([]*record.DisputeRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMerchant", req)
+	ret0, _ := ret[0].([]*record.DisputeRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByMerchant indicates an expected call of FindByMerchant.
+func (mr *MockDisputeRepositoryMockRecorder) FindByMerchant(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMerchant", reflect.TypeOf((*MockDisputeRepository)(nil).FindByMerchant), req)
+}
+
+// ResolveDispute mocks base method.
+func (m *MockDisputeRepository) ResolveDispute(request *requests.ResolveDisputeRequest, status string) (*record.DisputeRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveDispute", request, status)
+	ret0, _ := ret[0].(*record.DisputeRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResolveDispute indicates an expected call of ResolveDispute.
+func (mr *MockDisputeRepositoryMockRecorder) ResolveDispute(request, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDispute", reflect.TypeOf((*MockDisputeRepository)(nil).ResolveDispute), request, status)
+}
+
+// RespondDispute mocks base method.
+func (m *MockDisputeRepository) RespondDispute(request *requests.RespondDisputeRequest) (*record.DisputeRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondDispute", request)
+	ret0, _ := ret[0].(*record.DisputeRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RespondDispute indicates an expected call of RespondDispute.
+func (mr *MockDisputeRepositoryMockRecorder) RespondDispute(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondDispute", reflect.TypeOf((*MockDisputeRepository)(nil).RespondDispute), request)
+}
+
+// MockAuthorizationRepository is a mock of AuthorizationRepository interface.
+type MockAuthorizationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthorizationRepositoryMockRecorder is the mock recorder for MockAuthorizationRepository.
+type MockAuthorizationRepositoryMockRecorder struct {
+	mock *MockAuthorizationRepository
+}
+
+// NewMockAuthorizationRepository creates a new mock instance.
+func NewMockAuthorizationRepository(ctrl *gomock.Controller) *MockAuthorizationRepository {
+	mock := &MockAuthorizationRepository{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationRepository) EXPECT() *MockAuthorizationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CaptureAuthorization mocks base method.
+func (m *MockAuthorizationRepository) CaptureAuthorization(authorization_id, amount, transaction_id int) (*record.AuthorizationRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CaptureAuthorization", authorization_id, amount, transaction_id)
+	ret0, _ := ret[0].(*record.AuthorizationRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CaptureAuthorization indicates an expected call of CaptureAuthorization.
+func (mr *MockAuthorizationRepositoryMockRecorder) CaptureAuthorization(authorization_id, amount, transaction_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CaptureAuthorization", reflect.TypeOf((*MockAuthorizationRepository)(nil).CaptureAuthorization), authorization_id, amount, transaction_id)
+}
+
+// CreateAuthorization mocks base method.
+func (m *MockAuthorizationRepository) CreateAuthorization(request *requests.CreateAuthorizationRequest, expiresAt time.Time) (*record.AuthorizationRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAuthorization", request, expiresAt)
+	ret0, _ := ret[0].(*record.AuthorizationRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAuthorization indicates an expected call of CreateAuthorization.
+func (mr *MockAuthorizationRepositoryMockRecorder) CreateAuthorization(request, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAuthorization", reflect.TypeOf((*MockAuthorizationRepository)(nil).CreateAuthorization), request, expiresAt)
+}
+
+// ExpireAuthorizations mocks base method.
+func (m *MockAuthorizationRepository) ExpireAuthorizations(limit int) ([]*record.AuthorizationRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireAuthorizations", limit)
+	ret0, _ := ret[0].([]*record.AuthorizationRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireAuthorizations indicates an expected call of ExpireAuthorizations.
+func (mr *MockAuthorizationRepositoryMockRecorder) ExpireAuthorizations(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireAuthorizations", reflect.TypeOf((*MockAuthorizationRepository)(nil).ExpireAuthorizations), limit)
+}
+
+// FindAll mocks base method.
+func (m *MockAuthorizationRepository) FindAll(req *requests.FindAllAuthorizations) ([]*record.AuthorizationRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*record.AuthorizationRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockAuthorizationRepositoryMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockAuthorizationRepository)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockAuthorizationRepository) FindById(authorization_id int) (*record.AuthorizationRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", authorization_id)
+	ret0, _ := ret[0].(*record.AuthorizationRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockAuthorizationRepositoryMockRecorder) FindById(authorization_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockAuthorizationRepository)(nil).FindById), authorization_id)
+}
+
+// VoidAuthorization mocks base method.
+func (m *MockAuthorizationRepository) VoidAuthorization(authorization_id int) (*record.AuthorizationRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VoidAuthorization", authorization_id)
+	ret0, _ := ret[0].(*record.AuthorizationRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VoidAuthorization indicates an expected call of VoidAuthorization.
+func (mr *MockAuthorizationRepositoryMockRecorder) VoidAuthorization(authorization_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VoidAuthorization", reflect.TypeOf((*MockAuthorizationRepository)(nil).VoidAuthorization), authorization_id)
+}
+
+// MockExchangeRateRepository is a mock of ExchangeRateRepository interface.
+type MockExchangeRateRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockExchangeRateRepositoryMockRecorder is the mock recorder for MockExchangeRateRepository.
+type MockExchangeRateRepositoryMockRecorder struct {
+	mock *MockExchangeRateRepository
+}
+
+// NewMockExchangeRateRepository creates a new mock instance.
+func NewMockExchangeRateRepository(ctrl *gomock.Controller) *MockExchangeRateRepository {
+	mock := &MockExchangeRateRepository{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateRepository) EXPECT() *MockExchangeRateRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteExchangeRate mocks base method.
+func (m *MockExchangeRateRepository) DeleteExchangeRate(exchange_rate_id int) (*record.ExchangeRateRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExchangeRate", exchange_rate_id)
+	ret0, _ := ret[0].(*record.ExchangeRateRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExchangeRate indicates an expected call of DeleteExchangeRate.
+func (mr *MockExchangeRateRepositoryMockRecorder) DeleteExchangeRate(exchange_rate_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExchangeRate", reflect.TypeOf((*MockExchangeRateRepository)(nil).DeleteExchangeRate), exchange_rate_id)
+}
+
+// FindAll mocks base method.
+func (m *MockExchangeRateRepository) FindAll(req *requests.FindAllExchangeRates) ([]*record.ExchangeRateRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*record.ExchangeRateRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockExchangeRateRepositoryMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockExchangeRateRepository)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockExchangeRateRepository) FindById(exchange_rate_id int) (*record.ExchangeRateRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", exchange_rate_id)
+	ret0, _ := ret[0].(*record.ExchangeRateRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockExchangeRateRepositoryMockRecorder) FindById(exchange_rate_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockExchangeRateRepository)(nil).FindById), exchange_rate_id)
+}
+
+// FindByPair mocks base method.
+func (m *MockExchangeRateRepository) FindByPair(base_currency, quote_currency string) (*record.ExchangeRateRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByPair", base_currency, quote_currency)
+	ret0, _ := ret[0].(*record.ExchangeRateRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByPair indicates an expected call of FindByPair.
+func (mr *MockExchangeRateRepositoryMockRecorder) FindByPair(base_currency, quote_currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByPair", reflect.TypeOf((*MockExchangeRateRepository)(nil).FindByPair), base_currency, quote_currency)
+}
+
+// UpsertExchangeRate mocks base method.
+func (m *MockExchangeRateRepository) UpsertExchangeRate(request *requests.UpsertExchangeRateRequest) (*record.ExchangeRateRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertExchangeRate", request)
+	ret0, _ := ret[0].(*record.ExchangeRateRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertExchangeRate indicates an expected call of UpsertExchangeRate.
+func (mr *MockExchangeRateRepositoryMockRecorder) UpsertExchangeRate(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertExchangeRate", reflect.TypeOf((*MockExchangeRateRepository)(nil).UpsertExchangeRate), request)
+}
+
+// MockFeeScheduleRepository is a mock of FeeScheduleRepository interface.
+type MockFeeScheduleRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeScheduleRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockFeeScheduleRepositoryMockRecorder is the mock recorder for MockFeeScheduleRepository.
+type MockFeeScheduleRepositoryMockRecorder struct {
+	mock *MockFeeScheduleRepository
+}
+
+// NewMockFeeScheduleRepository creates a new mock instance.
+func NewMockFeeScheduleRepository(ctrl *gomock.Controller) *MockFeeScheduleRepository {
+	mock := &MockFeeScheduleRepository{ctrl: ctrl}
+	mock.recorder = &MockFeeScheduleRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeScheduleRepository) EXPECT() *MockFeeScheduleRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateFeeSchedule mocks base method.
+func (m *MockFeeScheduleRepository) CreateFeeSchedule(request *requests.CreateFeeScheduleRequest) (*record.FeeScheduleRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeeSchedule", request)
+	ret0, _ := ret[0].(*record.FeeScheduleRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFeeSchedule indicates an expected call of CreateFeeSchedule.
+func (mr *MockFeeScheduleRepositoryMockRecorder) CreateFeeSchedule(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeeSchedule", reflect.TypeOf((*MockFeeScheduleRepository)(nil).CreateFeeSchedule), request)
+}
+
+// DeleteFeeSchedule mocks base method.
+func (m *MockFeeScheduleRepository) DeleteFeeSchedule(fee_schedule_id int) (*record.FeeScheduleRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeeSchedule", fee_schedule_id)
+	ret0, _ := ret[0].(*record.FeeScheduleRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFeeSchedule indicates an expected call of DeleteFeeSchedule.
+func (mr *MockFeeScheduleRepositoryMockRecorder) DeleteFeeSchedule(fee_schedule_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeeSchedule", reflect.TypeOf((*MockFeeScheduleRepository)(nil).DeleteFeeSchedule), fee_schedule_id)
+}
+
+// FindAll mocks base method.
+func (m *MockFeeScheduleRepository) FindAll(req *requests.FindAllFeeSchedules) ([]*record.FeeScheduleRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*record.FeeScheduleRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockFeeScheduleRepositoryMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFeeScheduleRepository)(nil).FindAll), req)
+}
+
+// FindApplicable mocks base method.
+func (m *MockFeeScheduleRepository) FindApplicable(req *requests.FindApplicableFeeSchedule) (*record.FeeScheduleRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindApplicable", req)
+	ret0, _ := ret[0].(*record.FeeScheduleRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindApplicable indicates an expected call of FindApplicable.
+func (mr *MockFeeScheduleRepositoryMockRecorder) FindApplicable(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindApplicable", reflect.TypeOf((*MockFeeScheduleRepository)(nil).FindApplicable), req)
+}
+
+// FindById mocks base method.
+func (m *MockFeeScheduleRepository) FindById(fee_schedule_id int) (*record.FeeScheduleRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", fee_schedule_id)
+	ret0, _ := ret[0].(*record.FeeScheduleRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockFeeScheduleRepositoryMockRecorder) FindById(fee_schedule_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockFeeScheduleRepository)(nil).FindById), fee_schedule_id)
+}
+
+// GetMonthlyFeeAmounts mocks base method.
+func (m *MockFeeScheduleRepository) GetMonthlyFeeAmounts(year int) ([]*record.FeeMonthAmount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetMonthlyFeeAmounts", year)
+	ret0, _ := ret[0].([]*record.FeeMonthAmount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetMonthlyFeeAmounts indicates an expected call of GetMonthlyFeeAmounts.
+func (mr *MockFeeScheduleRepositoryMockRecorder) GetMonthlyFeeAmounts(year any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMonthlyFeeAmounts", reflect.TypeOf((*MockFeeScheduleRepository)(nil).GetMonthlyFeeAmounts), year)
+}
+
+// GetYearlyFeeAmounts mocks base method.
+func (m *MockFeeScheduleRepository) GetYearlyFeeAmounts(year int) ([]*record.FeeYearlyAmount, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetYearlyFeeAmounts", year)
+	ret0, _ := ret[0].([]*record.FeeYearlyAmount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetYearlyFeeAmounts indicates an expected call of GetYearlyFeeAmounts.
+func (mr *MockFeeScheduleRepositoryMockRecorder) GetYearlyFeeAmounts(year any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetYearlyFeeAmounts", reflect.TypeOf((*MockFeeScheduleRepository)(nil).GetYearlyFeeAmounts), year)
+}
+
+// UpdateFeeSchedule mocks base method.
+func (m *MockFeeScheduleRepository) UpdateFeeSchedule(request *requests.UpdateFeeScheduleRequest) (*record.FeeScheduleRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFeeSchedule", request)
+	ret0, _ := ret[0].(*record.FeeScheduleRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFeeSchedule indicates an expected call of UpdateFeeSchedule.
+func (mr *MockFeeScheduleRepositoryMockRecorder) UpdateFeeSchedule(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFeeSchedule", reflect.TypeOf((*MockFeeScheduleRepository)(nil).UpdateFeeSchedule), request)
+}
+
+// MockTransactionLimitRepository is a mock of TransactionLimitRepository interface.
+type MockTransactionLimitRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionLimitRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionLimitRepositoryMockRecorder is the mock recorder for MockTransactionLimitRepository.
+type MockTransactionLimitRepositoryMockRecorder struct {
+	mock *MockTransactionLimitRepository
+}
+
+// NewMockTransactionLimitRepository creates a new mock instance.
+func NewMockTransactionLimitRepository(ctrl *gomock.Controller) *MockTransactionLimitRepository {
+	mock := &MockTransactionLimitRepository{ctrl: ctrl}
+	mock.recorder = &MockTransactionLimitRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionLimitRepository) EXPECT() *MockTransactionLimitRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateTransactionLimit mocks base method.
+func (m *MockTransactionLimitRepository) CreateTransactionLimit(request *requests.CreateTransactionLimitRequest) (*record.TransactionLimitRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionLimit", request)
+	ret0, _ := ret[0].(*record.TransactionLimitRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransactionLimit indicates an expected call of CreateTransactionLimit.
+func (mr *MockTransactionLimitRepositoryMockRecorder) CreateTransactionLimit(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionLimit", reflect.TypeOf((*MockTransactionLimitRepository)(nil).CreateTransactionLimit), request)
+}
+
+// DeleteTransactionLimit mocks base method.
+func (m *MockTransactionLimitRepository) DeleteTransactionLimit(transaction_limit_id int) (*record.TransactionLimitRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransactionLimit", transaction_limit_id)
+	ret0, _ := ret[0].(*record.TransactionLimitRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTransactionLimit indicates an expected call of DeleteTransactionLimit.
+func (mr *MockTransactionLimitRepositoryMockRecorder) DeleteTransactionLimit(transaction_limit_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransactionLimit", reflect.TypeOf((*MockTransactionLimitRepository)(nil).DeleteTransactionLimit), transaction_limit_id)
+}
+
+// FindAll mocks base method.
+func (m *MockTransactionLimitRepository) FindAll(req *requests.FindAllTransactionLimits) ([]*record.TransactionLimitRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*record.TransactionLimitRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockTransactionLimitRepositoryMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTransactionLimitRepository)(nil).FindAll), req)
+}
+
+// FindApplicable mocks base method.
+func (m *MockTransactionLimitRepository) FindApplicable(operation_type, card_number string) ([]*record.TransactionLimitRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindApplicable", operation_type, card_number)
+	ret0, _ := ret[0].([]*record.TransactionLimitRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindApplicable indicates an expected call of FindApplicable.
+func (mr *MockTransactionLimitRepositoryMockRecorder) FindApplicable(operation_type, card_number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindApplicable", reflect.TypeOf((*MockTransactionLimitRepository)(nil).FindApplicable), operation_type, card_number)
+}
+
+// FindById mocks base method.
+func (m *MockTransactionLimitRepository) FindById(transaction_limit_id int) (*record.TransactionLimitRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", transaction_limit_id)
+	ret0, _ := ret[0].(*record.TransactionLimitRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockTransactionLimitRepositoryMockRecorder) FindById(transaction_limit_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockTransactionLimitRepository)(nil).FindById), transaction_limit_id)
+}
+
+// FindUsage mocks base method.
+func (m *MockTransactionLimitRepository) FindUsage(operation_type, card_number string, since time.Time) (*record.TransactionLimitUsageRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUsage", operation_type, card_number, since)
+	ret0, _ := ret[0].(*record.TransactionLimitUsageRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUsage indicates an expected call of FindUsage.
+func (mr *MockTransactionLimitRepositoryMockRecorder) FindUsage(operation_type, card_number, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUsage", reflect.TypeOf((*MockTransactionLimitRepository)(nil).FindUsage), operation_type, card_number, since)
+}
+
+// UpdateTransactionLimit mocks base method.
+func (m *MockTransactionLimitRepository) UpdateTransactionLimit(request *requests.UpdateTransactionLimitRequest) (*record.TransactionLimitRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransactionLimit", request)
+	ret0, _ := ret[0].(*record.TransactionLimitRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTransactionLimit indicates an expected call of UpdateTransactionLimit.
+func (mr *MockTransactionLimitRepositoryMockRecorder) UpdateTransactionLimit(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransactionLimit", reflect.TypeOf((*MockTransactionLimitRepository)(nil).UpdateTransactionLimit), request)
+}
+
+// MockScheduledTransferRepository is a mock of ScheduledTransferRepository interface.
+type MockScheduledTransferRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledTransferRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduledTransferRepositoryMockRecorder is the mock recorder for MockScheduledTransferRepository.
+type MockScheduledTransferRepositoryMockRecorder struct {
+	mock *MockScheduledTransferRepository
+}
+
+// NewMockScheduledTransferRepository creates a new mock instance.
+func NewMockScheduledTransferRepository(ctrl *gomock.Controller) *MockScheduledTransferRepository {
+	mock := &MockScheduledTransferRepository{ctrl: ctrl}
+	mock.recorder = &MockScheduledTransferRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledTransferRepository) EXPECT() *MockScheduledTransferRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AdvanceScheduledTransfer mocks base method.
+func (m *MockScheduledTransferRepository) AdvanceScheduledTransfer(scheduled_transfer_id int, nextRunAt *time.Time, status string, lastRunAt time.Time) (*record.ScheduledTransferRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AdvanceScheduledTransfer", scheduled_transfer_id, nextRunAt, status, lastRunAt)
+	ret0, _ := ret[0].(*record.ScheduledTransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AdvanceScheduledTransfer indicates an expected call of AdvanceScheduledTransfer.
+func (mr *MockScheduledTransferRepositoryMockRecorder) AdvanceScheduledTransfer(scheduled_transfer_id, nextRunAt, status, lastRunAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AdvanceScheduledTransfer", reflect.TypeOf((*MockScheduledTransferRepository)(nil).AdvanceScheduledTransfer), scheduled_transfer_id, nextRunAt, status, lastRunAt)
+}
+
+// CancelScheduledTransfer mocks base method.
+func (m *MockScheduledTransferRepository) CancelScheduledTransfer(scheduled_transfer_id int) (*record.ScheduledTransferRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledTransfer", scheduled_transfer_id)
+	ret0, _ := ret[0].(*record.ScheduledTransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelScheduledTransfer indicates an expected call of CancelScheduledTransfer.
+func (mr *MockScheduledTransferRepositoryMockRecorder) CancelScheduledTransfer(scheduled_transfer_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledTransfer", reflect.TypeOf((*MockScheduledTransferRepository)(nil).CancelScheduledTransfer), scheduled_transfer_id)
+}
+
+// ClaimRun mocks base method.
+func (m *MockScheduledTransferRepository) ClaimRun(scheduled_transfer_id int, scheduledFor time.Time) (*record.ScheduledTransferRunRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimRun", scheduled_transfer_id, scheduledFor)
+	ret0, _ := ret[0].(*record.ScheduledTransferRunRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimRun indicates an expected call of ClaimRun.
+func (mr *MockScheduledTransferRepositoryMockRecorder) ClaimRun(scheduled_transfer_id, scheduledFor any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimRun", reflect.TypeOf((*MockScheduledTransferRepository)(nil).ClaimRun), scheduled_transfer_id, scheduledFor)
+}
+
+// CreateScheduledTransfer mocks base method.
+func (m *MockScheduledTransferRepository) CreateScheduledTransfer(user_id int, request *requests.CreateScheduledTransferRequest, nextRunAt time.Time) (*record.ScheduledTransferRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledTransfer", user_id, request, nextRunAt)
+	ret0, _ := ret[0].(*record.ScheduledTransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateScheduledTransfer indicates an expected call of CreateScheduledTransfer.
+func (mr *MockScheduledTransferRepositoryMockRecorder) CreateScheduledTransfer(user_id, request, nextRunAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledTransfer", reflect.TypeOf((*MockScheduledTransferRepository)(nil).CreateScheduledTransfer), user_id, request, nextRunAt)
+}
+
+// FailScheduledTransfer mocks base method.
+func (m *MockScheduledTransferRepository) FailScheduledTransfer(scheduled_transfer_id int) (*record.ScheduledTransferRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FailScheduledTransfer", scheduled_transfer_id)
+	ret0, _ := ret[0].(*record.ScheduledTransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FailScheduledTransfer indicates an expected call of FailScheduledTransfer.
+func (mr *MockScheduledTransferRepositoryMockRecorder) FailScheduledTransfer(scheduled_transfer_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FailScheduledTransfer", reflect.TypeOf((*MockScheduledTransferRepository)(nil).FailScheduledTransfer), scheduled_transfer_id)
+}
+
+// FindAll mocks base method.
+func (m *MockScheduledTransferRepository) FindAll(req *requests.FindAllScheduledTransfers) ([]*record.ScheduledTransferRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*record.ScheduledTransferRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockScheduledTransferRepositoryMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockScheduledTransferRepository)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockScheduledTransferRepository) FindById(scheduled_transfer_id int) (*record.ScheduledTransferRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", scheduled_transfer_id)
+	ret0, _ := ret[0].(*record.ScheduledTransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockScheduledTransferRepositoryMockRecorder) FindById(scheduled_transfer_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockScheduledTransferRepository)(nil).FindById), scheduled_transfer_id)
+}
+
+// FindDue mocks base method.
+func (m *MockScheduledTransferRepository) FindDue(now time.Time, limit int) ([]*record.ScheduledTransferRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDue", now, limit)
+	ret0, _ := ret[0].([]*record.ScheduledTransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDue indicates an expected call of FindDue.
+func (mr *MockScheduledTransferRepositoryMockRecorder) FindDue(now, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDue", reflect.TypeOf((*MockScheduledTransferRepository)(nil).FindDue), now, limit)
+}
+
+// FindRuns mocks base method.
+func (m *MockScheduledTransferRepository) FindRuns(req *requests.FindScheduledTransferRuns) ([]*record.ScheduledTransferRunRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRuns", req)
+	ret0, _ := ret[0].([]*record.ScheduledTransferRunRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindRuns indicates an expected call of FindRuns.
+func (mr *MockScheduledTransferRepositoryMockRecorder) FindRuns(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRuns", reflect.TypeOf((*MockScheduledTransferRepository)(nil).FindRuns), req)
+}
+
+// FinishRun mocks base method.
+func (m *MockScheduledTransferRepository) FinishRun(scheduled_transfer_run_id int, status string, transfer_id *int, failureReason *string) (*record.ScheduledTransferRunRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishRun", scheduled_transfer_run_id, status, transfer_id, failureReason)
+	ret0, _ := ret[0].(*record.ScheduledTransferRunRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishRun indicates an expected call of FinishRun.
+func (mr *MockScheduledTransferRepositoryMockRecorder) FinishRun(scheduled_transfer_run_id, status, transfer_id, failureReason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishRun", reflect.TypeOf((*MockScheduledTransferRepository)(nil).FinishRun), scheduled_transfer_run_id, status, transfer_id, failureReason)
+}
+
+// PauseScheduledTransfer mocks base method.
+func (m *MockScheduledTransferRepository) PauseScheduledTransfer(scheduled_transfer_id int) (*record.ScheduledTransferRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseScheduledTransfer", scheduled_transfer_id)
+	ret0, _ := ret[0].(*record.ScheduledTransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PauseScheduledTransfer indicates an expected call of PauseScheduledTransfer.
+func (mr *MockScheduledTransferRepositoryMockRecorder) PauseScheduledTransfer(scheduled_transfer_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseScheduledTransfer", reflect.TypeOf((*MockScheduledTransferRepository)(nil).PauseScheduledTransfer), scheduled_transfer_id)
+}
+
+// ResumeScheduledTransfer mocks base method.
+func (m *MockScheduledTransferRepository) ResumeScheduledTransfer(scheduled_transfer_id int, nextRunAt time.Time) (*record.ScheduledTransferRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeScheduledTransfer", scheduled_transfer_id, nextRunAt)
+	ret0, _ := ret[0].(*record.ScheduledTransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResumeScheduledTransfer indicates an expected call of ResumeScheduledTransfer.
+func (mr *MockScheduledTransferRepositoryMockRecorder) ResumeScheduledTransfer(scheduled_transfer_id, nextRunAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeScheduledTransfer", reflect.TypeOf((*MockScheduledTransferRepository)(nil).ResumeScheduledTransfer), scheduled_transfer_id, nextRunAt)
+}
+
+// UpdateScheduledTransfer mocks base method.
+func (m *MockScheduledTransferRepository) UpdateScheduledTransfer(request *requests.UpdateScheduledTransferRequest, nextRunAt time.Time) (*record.ScheduledTransferRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledTransfer", request, nextRunAt)
+	ret0, _ := ret[0].(*record.ScheduledTransferRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateScheduledTransfer indicates an expected call of UpdateScheduledTransfer.
+func (mr *MockScheduledTransferRepositoryMockRecorder) UpdateScheduledTransfer(request, nextRunAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledTransfer", reflect.TypeOf((*MockScheduledTransferRepository)(nil).UpdateScheduledTransfer), request, nextRunAt)
+}
+
+// MockTransferBatchRepository is a mock of TransferBatchRepository interface.
+type MockTransferBatchRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferBatchRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockTransferBatchRepositoryMockRecorder is the mock recorder for MockTransferBatchRepository.
+type MockTransferBatchRepositoryMockRecorder struct {
+	mock *MockTransferBatchRepository
+}
+
+// NewMockTransferBatchRepository creates a new mock instance.
+func NewMockTransferBatchRepository(ctrl *gomock.Controller) *MockTransferBatchRepository {
+	mock := &MockTransferBatchRepository{ctrl: ctrl}
+	mock.recorder = &MockTransferBatchRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferBatchRepository) EXPECT() *MockTransferBatchRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddTransferBatchResult mocks base method.
+func (m *MockTransferBatchRepository) AddTransferBatchResult(transfer_batch_id, successRows, failedRows, successAmount int) (*record.TransferBatchRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTransferBatchResult", transfer_batch_id, successRows, failedRows, successAmount)
+	ret0, _ := ret[0].(*record.TransferBatchRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTransferBatchResult indicates an expected call of AddTransferBatchResult.
+func (mr *MockTransferBatchRepositoryMockRecorder) AddTransferBatchResult(transfer_batch_id, successRows, failedRows, successAmount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTransferBatchResult", reflect.TypeOf((*MockTransferBatchRepository)(nil).AddTransferBatchResult), transfer_batch_id, successRows, failedRows, successAmount)
+}
+
+// ClaimRow mocks base method.
+func (m *MockTransferBatchRepository) ClaimRow(transfer_batch_row_id int) (*record.TransferBatchRowRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimRow", transfer_batch_row_id)
+	ret0, _ := ret[0].(*record.TransferBatchRowRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimRow indicates an expected call of ClaimRow.
+func (mr *MockTransferBatchRepositoryMockRecorder) ClaimRow(transfer_batch_row_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimRow", reflect.TypeOf((*MockTransferBatchRepository)(nil).ClaimRow), transfer_batch_row_id)
+}
+
+// CompleteTransferBatch mocks base method.
+func (m *MockTransferBatchRepository) CompleteTransferBatch(transfer_batch_id int, status string) (*record.TransferBatchRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CompleteTransferBatch", transfer_batch_id, status)
+	ret0, _ := ret[0].(*record.TransferBatchRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CompleteTransferBatch indicates an expected call of CompleteTransferBatch.
+func (mr *MockTransferBatchRepositoryMockRecorder) CompleteTransferBatch(transfer_batch_id, status any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CompleteTransferBatch", reflect.TypeOf((*MockTransferBatchRepository)(nil).CompleteTransferBatch), transfer_batch_id, status)
+}
+
+// CreateTransferBatch mocks base method.
+func (m *MockTransferBatchRepository) CreateTransferBatch(request *requests.CreateTransferBatch) (*record.TransferBatchRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransferBatch", request)
+	ret0, _ := ret[0].(*record.TransferBatchRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransferBatch indicates an expected call of CreateTransferBatch.
+func (mr *MockTransferBatchRepositoryMockRecorder) CreateTransferBatch(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferBatch", reflect.TypeOf((*MockTransferBatchRepository)(nil).CreateTransferBatch), request)
+}
+
+// CreateTransferBatchRow mocks base method.
+func (m *MockTransferBatchRepository) CreateTransferBatchRow(transfer_batch_id int, status string, row *requests.TransferBatchRowInput) (*record.TransferBatchRowRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransferBatchRow", transfer_batch_id, status, row)
+	ret0, _ := ret[0].(*record.TransferBatchRowRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTransferBatchRow indicates an expected call of CreateTransferBatchRow.
+func (mr *MockTransferBatchRepositoryMockRecorder) CreateTransferBatchRow(transfer_batch_id, status, row any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferBatchRow", reflect.TypeOf((*MockTransferBatchRepository)(nil).CreateTransferBatchRow), transfer_batch_id, status, row)
+}
+
+// FindAll mocks base method.
+func (m *MockTransferBatchRepository) FindAll(req *requests.FindAllTransferBatches) ([]*record.TransferBatchRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*record.TransferBatchRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockTransferBatchRepositoryMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTransferBatchRepository)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockTransferBatchRepository) FindById(transfer_batch_id int) (*record.TransferBatchRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", transfer_batch_id)
+	ret0, _ := ret[0].(*record.TransferBatchRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockTransferBatchRepositoryMockRecorder) FindById(transfer_batch_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockTransferBatchRepository)(nil).FindById), transfer_batch_id)
+}
+
+// FindPendingRows mocks base method.
+func (m *MockTransferBatchRepository) FindPendingRows(transfer_batch_id, limit int) ([]*record.TransferBatchRowRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPendingRows", transfer_batch_id, limit)
+	ret0, _ := ret[0].([]*record.TransferBatchRowRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPendingRows indicates an expected call of FindPendingRows.
+func (mr *MockTransferBatchRepositoryMockRecorder) FindPendingRows(transfer_batch_id, limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPendingRows", reflect.TypeOf((*MockTransferBatchRepository)(nil).FindPendingRows), transfer_batch_id, limit)
+}
+
+// FindReportRows mocks base method.
+func (m *MockTransferBatchRepository) FindReportRows(transfer_batch_id int) ([]*record.TransferBatchRowRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReportRows", transfer_batch_id)
+	ret0, _ := ret[0].([]*record.TransferBatchRowRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindReportRows indicates an expected call of FindReportRows.
+func (mr *MockTransferBatchRepositoryMockRecorder) FindReportRows(transfer_batch_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReportRows", reflect.TypeOf((*MockTransferBatchRepository)(nil).FindReportRows), transfer_batch_id)
+}
+
+// FindRows mocks base method.
+func (m *MockTransferBatchRepository) FindRows(req *requests.FindTransferBatchRows) ([]*record.TransferBatchRowRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRows", req)
+	ret0, _ := ret[0].([]*record.TransferBatchRowRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindRows indicates an expected call of FindRows.
+func (mr *MockTransferBatchRepositoryMockRecorder) FindRows(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRows", reflect.TypeOf((*MockTransferBatchRepository)(nil).FindRows), req)
+}
+
+// FindRunnable mocks base method.
+func (m *MockTransferBatchRepository) FindRunnable(limit int) ([]*record.TransferBatchRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRunnable", limit)
+	ret0, _ := ret[0].([]*record.TransferBatchRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindRunnable indicates an expected call of FindRunnable.
+func (mr *MockTransferBatchRepositoryMockRecorder) FindRunnable(limit any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRunnable", reflect.TypeOf((*MockTransferBatchRepository)(nil).FindRunnable), limit)
+}
+
+// FinishRow mocks base method.
+func (m *MockTransferBatchRepository) FinishRow(transfer_batch_row_id int, status string, transfer_id *int, failureReason *string) (*record.TransferBatchRowRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishRow", transfer_batch_row_id, status, transfer_id, failureReason)
+	ret0, _ := ret[0].(*record.TransferBatchRowRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishRow indicates an expected call of FinishRow.
+func (mr *MockTransferBatchRepositoryMockRecorder) FinishRow(transfer_batch_row_id, status, transfer_id, failureReason any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishRow", reflect.TypeOf((*MockTransferBatchRepository)(nil).FinishRow), transfer_batch_row_id, status, transfer_id, failureReason)
+}
+
+// StartTransferBatch mocks base method.
+func (m *MockTransferBatchRepository) StartTransferBatch(transfer_batch_id int) (*record.TransferBatchRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartTransferBatch", transfer_batch_id)
+	ret0, _ := ret[0].(*record.TransferBatchRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartTransferBatch indicates an expected call of StartTransferBatch.
+func (mr *MockTransferBatchRepositoryMockRecorder) StartTransferBatch(transfer_batch_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartTransferBatch", reflect.TypeOf((*MockTransferBatchRepository)(nil).StartTransferBatch), transfer_batch_id)
+}
+
+// MockStatusHistoryRepository is a mock of StatusHistoryRepository interface.
+type MockStatusHistoryRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusHistoryRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockStatusHistoryRepositoryMockRecorder is the mock recorder for MockStatusHistoryRepository.
+type MockStatusHistoryRepositoryMockRecorder struct {
+	mock *MockStatusHistoryRepository
+}
+
+// NewMockStatusHistoryRepository creates a new mock instance.
+func NewMockStatusHistoryRepository(ctrl *gomock.Controller) *MockStatusHistoryRepository {
+	mock := &MockStatusHistoryRepository{ctrl: ctrl}
+	mock.recorder = &MockStatusHistoryRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusHistoryRepository) EXPECT() *MockStatusHistoryRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindByEntity mocks base method.
+func (m *MockStatusHistoryRepository) FindByEntity(entity_type string, entity_id int) ([]*record.StatusHistoryRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEntity", entity_type, entity_id)
+	ret0, _ := ret[0].([]*record.StatusHistoryRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEntity indicates an expected call of FindByEntity.
+func (mr *MockStatusHistoryRepositoryMockRecorder) FindByEntity(entity_type, entity_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEntity", reflect.TypeOf((*MockStatusHistoryRepository)(nil).FindByEntity), entity_type, entity_id)
+}
+
+// MockProviderWebhookRepository is a mock of ProviderWebhookRepository interface.
+type MockProviderWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockProviderWebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockProviderWebhookRepositoryMockRecorder is the mock recorder for MockProviderWebhookRepository.
+type MockProviderWebhookRepositoryMockRecorder struct {
+	mock *MockProviderWebhookRepository
+}
+
+// NewMockProviderWebhookRepository creates a new mock instance.
+func NewMockProviderWebhookRepository(ctrl *gomock.Controller) *MockProviderWebhookRepository {
+	mock := &MockProviderWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockProviderWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProviderWebhookRepository) EXPECT() *MockProviderWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateEvent mocks base method.
+func (m *MockProviderWebhookRepository) CreateEvent(request *requests.CreateProviderWebhookEvent) (*record.ProviderWebhookEventRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEvent", request)
+	ret0, _ := ret[0].(*record.ProviderWebhookEventRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEvent indicates an expected call of CreateEvent.
+func (mr *MockProviderWebhookRepositoryMockRecorder) CreateEvent(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEvent", reflect.TypeOf((*MockProviderWebhookRepository)(nil).CreateEvent), request)
+}
+
+// FindAll mocks base method.
+func (m *MockProviderWebhookRepository) FindAll(req *requests.FindAllProviderWebhookEvents) ([]*record.ProviderWebhookEventRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*record.ProviderWebhookEventRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockProviderWebhookRepositoryMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockProviderWebhookRepository)(nil).FindAll), req)
+}
+
+// FindByEventID mocks base method.
+func (m *MockProviderWebhookRepository) FindByEventID(provider, event_id string) (*record.ProviderWebhookEventRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEventID", provider, event_id)
+	ret0, _ := ret[0].(*record.ProviderWebhookEventRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByEventID indicates an expected call of FindByEventID.
+func (mr *MockProviderWebhookRepositoryMockRecorder) FindByEventID(provider, event_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEventID", reflect.TypeOf((*MockProviderWebhookRepository)(nil).FindByEventID), provider, event_id)
+}
+
+// FindById mocks base method.
+func (m *MockProviderWebhookRepository) FindById(id int) (*record.ProviderWebhookEventRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", id)
+	ret0, _ := ret[0].(*record.ProviderWebhookEventRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockProviderWebhookRepositoryMockRecorder) FindById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockProviderWebhookRepository)(nil).FindById), id)
+}
+
+// FinishEvent mocks base method.
+func (m *MockProviderWebhookRepository) FinishEvent(request *requests.FinishProviderWebhookEvent) (*record.ProviderWebhookEventRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishEvent", request)
+	ret0, _ := ret[0].(*record.ProviderWebhookEventRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishEvent indicates an expected call of FinishEvent.
+func (mr *MockProviderWebhookRepositoryMockRecorder) FinishEvent(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishEvent", reflect.TypeOf((*MockProviderWebhookRepository)(nil).FinishEvent), request)
+}
+
+// MockMerchantWebhookRepository is a mock of MerchantWebhookRepository interface.
+type MockMerchantWebhookRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMerchantWebhookRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMerchantWebhookRepositoryMockRecorder is the mock recorder for MockMerchantWebhookRepository.
+type MockMerchantWebhookRepositoryMockRecorder struct {
+	mock *MockMerchantWebhookRepository
+}
+
+// NewMockMerchantWebhookRepository creates a new mock instance.
+func NewMockMerchantWebhookRepository(ctrl *gomock.Controller) *MockMerchantWebhookRepository {
+	mock := &MockMerchantWebhookRepository{ctrl: ctrl}
+	mock.recorder = &MockMerchantWebhookRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMerchantWebhookRepository) EXPECT() *MockMerchantWebhookRepositoryMockRecorder {
+	return m.recorder
+}
+
+// ClaimDueDeliveries mocks base method.
+func (m *MockMerchantWebhookRepository) ClaimDueDeliveries(limit int, leaseUntil time.Time) ([]*record.MerchantWebhookDeliveryRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClaimDueDeliveries", limit, leaseUntil)
+	ret0, _ := ret[0].([]*record.MerchantWebhookDeliveryRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ClaimDueDeliveries indicates an expected call of ClaimDueDeliveries.
+func (mr *MockMerchantWebhookRepositoryMockRecorder) ClaimDueDeliveries(limit, leaseUntil any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClaimDueDeliveries", reflect.TypeOf((*MockMerchantWebhookRepository)(nil).ClaimDueDeliveries), limit, leaseUntil)
+}
+
+// CreateDelivery mocks base method.
+func (m *MockMerchantWebhookRepository) CreateDelivery(request *requests.CreateMerchantWebhookDelivery) (*record.MerchantWebhookDeliveryRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDelivery", request)
+	ret0, _ := ret[0].(*record.MerchantWebhookDeliveryRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDelivery indicates an expected call of CreateDelivery.
+func (mr *MockMerchantWebhookRepositoryMockRecorder) CreateDelivery(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDelivery", reflect.TypeOf((*MockMerchantWebhookRepository)(nil).CreateDelivery), request)
+}
+
+// CreateDeliveryAttempt mocks base method.
+func (m *MockMerchantWebhookRepository) CreateDeliveryAttempt(request *requests.FinishMerchantWebhookDeliveryAttempt) (*record.MerchantWebhookDeliveryAttemptRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDeliveryAttempt", request)
+	ret0, _ := ret[0].(*record.MerchantWebhookDeliveryAttemptRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDeliveryAttempt indicates an expected call of CreateDeliveryAttempt.
+func (mr *MockMerchantWebhookRepositoryMockRecorder) CreateDeliveryAttempt(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeliveryAttempt", reflect.TypeOf((*MockMerchantWebhookRepository)(nil).CreateDeliveryAttempt), request)
+}
+
+// CreateEndpoint mocks base method.
+func (m *MockMerchantWebhookRepository) CreateEndpoint(request *requests.CreateMerchantWebhookEndpoint) (*record.MerchantWebhookEndpointRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEndpoint", request)
+	ret0, _ := ret[0].(*record.MerchantWebhookEndpointRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEndpoint indicates an expected call of CreateEndpoint.
+func (mr *MockMerchantWebhookRepositoryMockRecorder) CreateEndpoint(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEndpoint", reflect.TypeOf((*MockMerchantWebhookRepository)(nil).CreateEndpoint), request)
+}
+
+// DeleteEndpoint mocks base method.
+func (m *MockMerchantWebhookRepository) DeleteEndpoint(id int) (*record.MerchantWebhookEndpointRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEndpoint", id)
+	ret0, _ := ret[0].(*record.MerchantWebhookEndpointRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEndpoint indicates an expected call of DeleteEndpoint.
+func (mr *MockMerchantWebhookRepositoryMockRecorder) DeleteEndpoint(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEndpoint", reflect.TypeOf((*MockMerchantWebhookRepository)(nil).DeleteEndpoint), id)
+}
+
+// FindDeliveries mocks base method.
+func (m *MockMerchantWebhookRepository) FindDeliveries(req *requests.FindMerchantWebhookDeliveries) ([]*record.MerchantWebhookDeliveryRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeliveries", req)
+	ret0, _ := ret[0].([]*record.MerchantWebhookDeliveryRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindDeliveries indicates an expected call of FindDeliveries.
+func (mr *MockMerchantWebhookRepositoryMockRecorder) FindDeliveries(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeliveries", reflect.TypeOf((*MockMerchantWebhookRepository)(nil).FindDeliveries), req)
+}
+
+// FindDeliveryAttempts mocks base method.
+func (m *MockMerchantWebhookRepository) FindDeliveryAttempts(delivery_id int) ([]*record.MerchantWebhookDeliveryAttemptRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeliveryAttempts", delivery_id)
+	ret0, _ := ret[0].([]*record.MerchantWebhookDeliveryAttemptRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeliveryAttempts indicates an expected call of FindDeliveryAttempts.
+func (mr *MockMerchantWebhookRepositoryMockRecorder) FindDeliveryAttempts(delivery_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeliveryAttempts", reflect.TypeOf((*MockMerchantWebhookRepository)(nil).FindDeliveryAttempts), delivery_id)
+}
+
+// FindDeliveryById mocks base method.
+func (m *MockMerchantWebhookRepository) FindDeliveryById(id int) (*record.MerchantWebhookDeliveryRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeliveryById", id)
+	ret0, _ := ret[0].(*record.MerchantWebhookDeliveryRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDeliveryById indicates an expected call of FindDeliveryById.
+func (mr *MockMerchantWebhookRepositoryMockRecorder) FindDeliveryById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeliveryById", reflect.TypeOf((*MockMerchantWebhookRepository)(nil).FindDeliveryById), id)
+}
+
+// FindEndpointById mocks base method.
+func (m *MockMerchantWebhookRepository) FindEndpointById(id int) (*record.MerchantWebhookEndpointRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEndpointById", id)
+	ret0, _ := ret[0].(*record.MerchantWebhookEndpointRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEndpointById indicates an expected call of FindEndpointById.
+func (mr *MockMerchantWebhookRepositoryMockRecorder) FindEndpointById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEndpointById", reflect.TypeOf((*MockMerchantWebhookRepository)(nil).FindEndpointById), id)
+}
+
+// FindEndpointsByMerchant mocks base method.
+func (m *MockMerchantWebhookRepository) FindEndpointsByMerchant(merchant_id int) ([]*record.MerchantWebhookEndpointRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEndpointsByMerchant", merchant_id)
+	ret0, _ := ret[0].([]*record.MerchantWebhookEndpointRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindEndpointsByMerchant indicates an expected call of FindEndpointsByMerchant.
+func (mr *MockMerchantWebhookRepositoryMockRecorder) FindEndpointsByMerchant(merchant_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEndpointsByMerchant", reflect.TypeOf((*MockMerchantWebhookRepository)(nil).FindEndpointsByMerchant), merchant_id)
+}
+
+// FinishDeliveryAttempt mocks base method.
+func (m *MockMerchantWebhookRepository) FinishDeliveryAttempt(request *requests.FinishMerchantWebhookDeliveryAttempt) (*record.MerchantWebhookDeliveryRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishDeliveryAttempt", request)
+	ret0, _ := ret[0].(*record.MerchantWebhookDeliveryRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishDeliveryAttempt indicates an expected call of FinishDeliveryAttempt.
+func (mr *MockMerchantWebhookRepositoryMockRecorder) FinishDeliveryAttempt(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishDeliveryAttempt", reflect.TypeOf((*MockMerchantWebhookRepository)(nil).FinishDeliveryAttempt), request)
+}
+
+// QueueDeliveries mocks base method.
+func (m *MockMerchantWebhookRepository) QueueDeliveries(request *requests.QueueMerchantWebhookDeliveries) ([]*record.MerchantWebhookDeliveryRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueueDeliveries", request)
+	ret0, _ := ret[0].([]*record.MerchantWebhookDeliveryRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueueDeliveries indicates an expected call of QueueDeliveries.
+func (mr *MockMerchantWebhookRepositoryMockRecorder) QueueDeliveries(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueueDeliveries", reflect.TypeOf((*MockMerchantWebhookRepository)(nil).QueueDeliveries), request)
+}
+
+// UpdateEndpoint mocks base method.
+func (m *MockMerchantWebhookRepository) UpdateEndpoint(request *requests.UpdateMerchantWebhookEndpointRequest) (*record.MerchantWebhookEndpointRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEndpoint", request)
+	ret0, _ := ret[0].(*record.MerchantWebhookEndpointRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEndpoint indicates an expected call of UpdateEndpoint.
+func (mr *MockMerchantWebhookRepositoryMockRecorder) UpdateEndpoint(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEndpoint", reflect.TypeOf((*MockMerchantWebhookRepository)(nil).UpdateEndpoint), request)
+}
+
+// MockSettlementRepository is a mock of SettlementRepository interface.
+type MockSettlementRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockSettlementRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockSettlementRepositoryMockRecorder is the mock recorder for MockSettlementRepository.
+type MockSettlementRepositoryMockRecorder struct {
+	mock *MockSettlementRepository
+}
+
+// NewMockSettlementRepository creates a new mock instance.
+func NewMockSettlementRepository(ctrl *gomock.Controller) *MockSettlementRepository {
+	mock := &MockSettlementRepository{ctrl: ctrl}
+	mock.recorder = &MockSettlementRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSettlementRepository) EXPECT() *MockSettlementRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AddPendingBalance mocks base method.
+func (m *MockSettlementRepository) AddPendingBalance(merchant_id int, currency string, amount int) (*record.MerchantBalanceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPendingBalance", merchant_id, currency, amount)
+	ret0, _ := ret[0].(*record.MerchantBalanceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPendingBalance indicates an expected call of AddPendingBalance.
+func (mr *MockSettlementRepositoryMockRecorder) AddPendingBalance(merchant_id, currency, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPendingBalance", reflect.TypeOf((*MockSettlementRepository)(nil).AddPendingBalance), merchant_id, currency, amount)
+}
+
+// AssignItems mocks base method.
+func (m *MockSettlementRepository) AssignItems(batch *record.SettlementBatchRecord, cutoff time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignItems", batch, cutoff)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssignItems indicates an expected call of AssignItems.
+func (mr *MockSettlementRepositoryMockRecorder) AssignItems(batch, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignItems", reflect.TypeOf((*MockSettlementRepository)(nil).AssignItems), batch, cutoff)
+}
+
+// CreateBatch mocks base method.
+func (m *MockSettlementRepository) CreateBatch(request *requests.CreateSettlementBatch) (*record.SettlementBatchRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBatch", request)
+	ret0, _ := ret[0].(*record.SettlementBatchRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBatch indicates an expected call of CreateBatch.
+func (mr *MockSettlementRepositoryMockRecorder) CreateBatch(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBatch", reflect.TypeOf((*MockSettlementRepository)(nil).CreateBatch), request)
+}
+
+// CreateItem mocks base method.
+func (m *MockSettlementRepository) CreateItem(request *requests.CreateSettlementItem) (*record.SettlementItemRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateItem", request)
+	ret0, _ := ret[0].(*record.SettlementItemRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateItem indicates an expected call of CreateItem.
+func (mr *MockSettlementRepositoryMockRecorder) CreateItem(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateItem", reflect.TypeOf((*MockSettlementRepository)(nil).CreateItem), request)
+}
+
+// DeductPendingBalance mocks base method.
+func (m *MockSettlementRepository) DeductPendingBalance(merchant_id int, currency string, amount int) (*record.MerchantBalanceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeductPendingBalance", merchant_id, currency, amount)
+	ret0, _ := ret[0].(*record.MerchantBalanceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeductPendingBalance indicates an expected call of DeductPendingBalance.
+func (mr *MockSettlementRepositoryMockRecorder) DeductPendingBalance(merchant_id, currency, amount any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeductPendingBalance", reflect.TypeOf((*MockSettlementRepository)(nil).DeductPendingBalance), merchant_id, currency, amount)
+}
+
+// FindBalanceForUpdate mocks base method.
+func (m *MockSettlementRepository) FindBalanceForUpdate(merchant_id int, currency string) (*record.MerchantBalanceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBalanceForUpdate", merchant_id, currency)
+	ret0, _ := ret[0].(*record.MerchantBalanceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBalanceForUpdate indicates an expected call of FindBalanceForUpdate.
+func (mr *MockSettlementRepositoryMockRecorder) FindBalanceForUpdate(merchant_id, currency any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBalanceForUpdate", reflect.TypeOf((*MockSettlementRepository)(nil).FindBalanceForUpdate), merchant_id, currency)
+}
+
+// FindBalancesByMerchant mocks base method.
+func (m *MockSettlementRepository) FindBalancesByMerchant(merchant_id int) ([]*record.MerchantBalanceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBalancesByMerchant", merchant_id)
+	ret0, _ := ret[0].([]*record.MerchantBalanceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBalancesByMerchant indicates an expected call of FindBalancesByMerchant.
+func (mr *MockSettlementRepositoryMockRecorder) FindBalancesByMerchant(merchant_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBalancesByMerchant", reflect.TypeOf((*MockSettlementRepository)(nil).FindBalancesByMerchant), merchant_id)
+}
+
+// FindBalancesDue mocks base method.
+func (m *MockSettlementRepository) FindBalancesDue(cutoff time.Time) ([]*record.MerchantBalanceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBalancesDue", cutoff)
+	ret0, _ := ret[0].([]*record.MerchantBalanceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBalancesDue indicates an expected call of FindBalancesDue.
+func (mr *MockSettlementRepositoryMockRecorder) FindBalancesDue(cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBalancesDue", reflect.TypeOf((*MockSettlementRepository)(nil).FindBalancesDue), cutoff)
+}
+
+// FindBatchById mocks base method.
+func (m *MockSettlementRepository) FindBatchById(id int) (*record.SettlementBatchRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBatchById", id)
+	ret0, _ := ret[0].(*record.SettlementBatchRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBatchById indicates an expected call of FindBatchById.
+func (mr *MockSettlementRepositoryMockRecorder) FindBatchById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBatchById", reflect.TypeOf((*MockSettlementRepository)(nil).FindBatchById), id)
+}
+
+// FindBatches mocks base method.
+func (m *MockSettlementRepository) FindBatches(req *requests.FindSettlementBatches) ([]*record.SettlementBatchRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBatches", req)
+	ret0, _ := ret[0].([]*record.SettlementBatchRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindBatches indicates an expected call of FindBatches.
+func (mr *MockSettlementRepositoryMockRecorder) FindBatches(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBatches", reflect.TypeOf((*MockSettlementRepository)(nil).FindBatches), req)
+}
+
+// FindItemsByBatch mocks base method.
+func (m *MockSettlementRepository) FindItemsByBatch(batch_id int) ([]*record.SettlementItemRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindItemsByBatch", batch_id)
+	ret0, _ := ret[0].([]*record.SettlementItemRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindItemsByBatch indicates an expected call of FindItemsByBatch.
+func (mr *MockSettlementRepositoryMockRecorder) FindItemsByBatch(batch_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindItemsByBatch", reflect.TypeOf((*MockSettlementRepository)(nil).FindItemsByBatch), batch_id)
+}
+
+// FindUnsettledTotals mocks base method.
+func (m *MockSettlementRepository) FindUnsettledTotals(merchant_id int, currency string, cutoff time.Time) (*record.SettlementTotalsRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindUnsettledTotals", merchant_id, currency, cutoff)
+	ret0, _ := ret[0].(*record.SettlementTotalsRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindUnsettledTotals indicates an expected call of FindUnsettledTotals.
+func (mr *MockSettlementRepositoryMockRecorder) FindUnsettledTotals(merchant_id, currency, cutoff any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindUnsettledTotals", reflect.TypeOf((*MockSettlementRepository)(nil).FindUnsettledTotals), merchant_id, currency, cutoff)
+}
+
+// SetSettlementCard mocks base method.
+func (m *MockSettlementRepository) SetSettlementCard(merchant_id int, currency, card_number string) (*record.MerchantBalanceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSettlementCard", merchant_id, currency, card_number)
+	ret0, _ := ret[0].(*record.MerchantBalanceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetSettlementCard indicates an expected call of SetSettlementCard.
+func (mr *MockSettlementRepositoryMockRecorder) SetSettlementCard(merchant_id, currency, card_number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSettlementCard", reflect.TypeOf((*MockSettlementRepository)(nil).SetSettlementCard), merchant_id, currency, card_number)
+}
+
+// MockMerchantOnboardingRepository is a mock of MerchantOnboardingRepository interface.
+type MockMerchantOnboardingRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMerchantOnboardingRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMerchantOnboardingRepositoryMockRecorder is the mock recorder for MockMerchantOnboardingRepository.
+type MockMerchantOnboardingRepositoryMockRecorder struct {
+	mock *MockMerchantOnboardingRepository
+}
+
+// NewMockMerchantOnboardingRepository creates a new mock instance.
+func NewMockMerchantOnboardingRepository(ctrl *gomock.Controller) *MockMerchantOnboardingRepository {
+	mock := &MockMerchantOnboardingRepository{ctrl: ctrl}
+	mock.recorder = &MockMerchantOnboardingRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMerchantOnboardingRepository) EXPECT() *MockMerchantOnboardingRepositoryMockRecorder {
+	return m.recorder
+}
+
+// FindBusinessProfile mocks base method.
+func (m *MockMerchantOnboardingRepository) FindBusinessProfile(merchant_id int) (*record.MerchantBusinessProfileRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBusinessProfile", merchant_id)
+	ret0, _ := ret[0].(*record.MerchantBusinessProfileRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindBusinessProfile indicates an expected call of FindBusinessProfile.
+func (mr *MockMerchantOnboardingRepositoryMockRecorder) FindBusinessProfile(merchant_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBusinessProfile", reflect.TypeOf((*MockMerchantOnboardingRepository)(nil).FindBusinessProfile), merchant_id)
+}
+
+// FindByStatus mocks base method.
+func (m *MockMerchantOnboardingRepository) FindByStatus(req *requests.FindMerchantsByStatus) ([]*record.MerchantRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByStatus", req)
+	ret0, _ := ret[0].([]*record.MerchantRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByStatus indicates an expected call of FindByStatus.
+func (mr *MockMerchantOnboardingRepositoryMockRecorder) FindByStatus(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByStatus", reflect.TypeOf((*MockMerchantOnboardingRepository)(nil).FindByStatus), req)
+}
+
+// UpsertBusinessProfile mocks base method.
+func (m *MockMerchantOnboardingRepository) UpsertBusinessProfile(req *requests.SubmitMerchantOnboardingRequest) (*record.MerchantBusinessProfileRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertBusinessProfile", req)
+	ret0, _ := ret[0].(*record.MerchantBusinessProfileRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertBusinessProfile indicates an expected call of UpsertBusinessProfile.
+func (mr *MockMerchantOnboardingRepositoryMockRecorder) UpsertBusinessProfile(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertBusinessProfile", reflect.TypeOf((*MockMerchantOnboardingRepository)(nil).UpsertBusinessProfile), req)
+}
+
+// MockReconciliationRepository is a mock of ReconciliationRepository interface.
+type MockReconciliationRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockReconciliationRepositoryMockRecorder is the mock recorder for MockReconciliationRepository.
+type MockReconciliationRepositoryMockRecorder struct {
+	mock *MockReconciliationRepository
+}
+
+// NewMockReconciliationRepository creates a new mock instance.
+func NewMockReconciliationRepository(ctrl *gomock.Controller) *MockReconciliationRepository {
+	mock := &MockReconciliationRepository{ctrl: ctrl}
+	mock.recorder = &MockReconciliationRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliationRepository) EXPECT() *MockReconciliationRepositoryMockRecorder {
+	return m.recorder
+}
+
+// AcknowledgeDiscrepancy mocks base method.
+func (m *MockReconciliationRepository) AcknowledgeDiscrepancy(req *requests.AcknowledgeBalanceDiscrepancyRequest) (*record.BalanceDiscrepancyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcknowledgeDiscrepancy", req)
+	ret0, _ := ret[0].(*record.BalanceDiscrepancyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcknowledgeDiscrepancy indicates an expected call of AcknowledgeDiscrepancy.
+func (mr *MockReconciliationRepositoryMockRecorder) AcknowledgeDiscrepancy(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcknowledgeDiscrepancy", reflect.TypeOf((*MockReconciliationRepository)(nil).AcknowledgeDiscrepancy), req)
+}
+
+// CreateRun mocks base method.
+func (m *MockReconciliationRepository) CreateRun() (*record.ReconciliationRunRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRun")
+	ret0, _ := ret[0].(*record.ReconciliationRunRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRun indicates an expected call of CreateRun.
+func (mr *MockReconciliationRepositoryMockRecorder) CreateRun() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRun", reflect.TypeOf((*MockReconciliationRepository)(nil).CreateRun))
+}
+
+// FindCardReconciliations mocks base method.
+func (m *MockReconciliationRepository) FindCardReconciliations() ([]*record.CardReconciliationRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindCardReconciliations")
+	ret0, _ := ret[0].([]*record.CardReconciliationRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindCardReconciliations indicates an expected call of FindCardReconciliations.
+func (mr *MockReconciliationRepositoryMockRecorder) FindCardReconciliations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindCardReconciliations", reflect.TypeOf((*MockReconciliationRepository)(nil).FindCardReconciliations))
+}
+
+// FindDiscrepancies mocks base method.
+func (m *MockReconciliationRepository) FindDiscrepancies(req *requests.FindBalanceDiscrepancies) ([]*record.BalanceDiscrepancyRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDiscrepancies", req)
+	ret0, _ := ret[0].([]*record.BalanceDiscrepancyRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindDiscrepancies indicates an expected call of FindDiscrepancies.
+func (mr *MockReconciliationRepositoryMockRecorder) FindDiscrepancies(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDiscrepancies", reflect.TypeOf((*MockReconciliationRepository)(nil).FindDiscrepancies), req)
+}
+
+// FindDiscrepancyById mocks base method.
+func (m *MockReconciliationRepository) FindDiscrepancyById(id int) (*record.BalanceDiscrepancyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDiscrepancyById", id)
+	ret0, _ := ret[0].(*record.BalanceDiscrepancyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindDiscrepancyById indicates an expected call of FindDiscrepancyById.
+func (mr *MockReconciliationRepositoryMockRecorder) FindDiscrepancyById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDiscrepancyById", reflect.TypeOf((*MockReconciliationRepository)(nil).FindDiscrepancyById), id)
+}
+
+// FindRuns mocks base method.
+func (m *MockReconciliationRepository) FindRuns(req *requests.FindReconciliationRuns) ([]*record.ReconciliationRunRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRuns", req)
+	ret0, _ := ret[0].([]*record.ReconciliationRunRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindRuns indicates an expected call of FindRuns.
+func (mr *MockReconciliationRepositoryMockRecorder) FindRuns(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRuns", reflect.TypeOf((*MockReconciliationRepository)(nil).FindRuns), req)
+}
+
+// FinishRun mocks base method.
+func (m *MockReconciliationRepository) FinishRun(run_id, cards_checked, discrepancy_count int) (*record.ReconciliationRunRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FinishRun", run_id, cards_checked, discrepancy_count)
+	ret0, _ := ret[0].(*record.ReconciliationRunRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FinishRun indicates an expected call of FinishRun.
+func (mr *MockReconciliationRepositoryMockRecorder) FinishRun(run_id, cards_checked, discrepancy_count any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FinishRun", reflect.TypeOf((*MockReconciliationRepository)(nil).FinishRun), run_id, cards_checked, discrepancy_count)
+}
+
+// ResolveDiscrepancies mocks base method.
+func (m *MockReconciliationRepository) ResolveDiscrepancies(run_id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveDiscrepancies", run_id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ResolveDiscrepancies indicates an expected call of ResolveDiscrepancies.
+func (mr *MockReconciliationRepositoryMockRecorder) ResolveDiscrepancies(run_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDiscrepancies", reflect.TypeOf((*MockReconciliationRepository)(nil).ResolveDiscrepancies), run_id)
+}
+
+// TryLock mocks base method.
+func (m *MockReconciliationRepository) TryLock() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TryLock")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TryLock indicates an expected call of TryLock.
+func (mr *MockReconciliationRepositoryMockRecorder) TryLock() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TryLock", reflect.TypeOf((*MockReconciliationRepository)(nil).TryLock))
+}
+
+// UpsertDiscrepancy mocks base method.
+func (m *MockReconciliationRepository) UpsertDiscrepancy(req *requests.CreateBalanceDiscrepancy) (*record.BalanceDiscrepancyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertDiscrepancy", req)
+	ret0, _ := ret[0].(*record.BalanceDiscrepancyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpsertDiscrepancy indicates an expected call of UpsertDiscrepancy.
+func (mr *MockReconciliationRepositoryMockRecorder) UpsertDiscrepancy(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertDiscrepancy", reflect.TypeOf((*MockReconciliationRepository)(nil).UpsertDiscrepancy), req)
+}
+
+// MockMerchantApiKeyRepository is a mock of MerchantApiKeyRepository interface.
+type MockMerchantApiKeyRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockMerchantApiKeyRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockMerchantApiKeyRepositoryMockRecorder is the mock recorder for MockMerchantApiKeyRepository.
+type MockMerchantApiKeyRepositoryMockRecorder struct {
+	mock *MockMerchantApiKeyRepository
+}
+
+// NewMockMerchantApiKeyRepository creates a new mock instance.
+func NewMockMerchantApiKeyRepository(ctrl *gomock.Controller) *MockMerchantApiKeyRepository {
+	mock := &MockMerchantApiKeyRepository{ctrl: ctrl}
+	mock.recorder = &MockMerchantApiKeyRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMerchantApiKeyRepository) EXPECT() *MockMerchantApiKeyRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateApiKey mocks base method.
+func (m *MockMerchantApiKeyRepository) CreateApiKey(request *requests.CreateMerchantApiKey) (*record.MerchantApiKeyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateApiKey", request)
+	ret0, _ := ret[0].(*record.MerchantApiKeyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateApiKey indicates an expected call of CreateApiKey.
+func (mr *MockMerchantApiKeyRepositoryMockRecorder) CreateApiKey(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateApiKey", reflect.TypeOf((*MockMerchantApiKeyRepository)(nil).CreateApiKey), request)
+}
+
+// DeleteNoncesBefore mocks base method.
+func (m *MockMerchantApiKeyRepository) DeleteNoncesBefore(before time.Time) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNoncesBefore", before)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNoncesBefore indicates an expected call of DeleteNoncesBefore.
+func (mr *MockMerchantApiKeyRepositoryMockRecorder) DeleteNoncesBefore(before any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNoncesBefore", reflect.TypeOf((*MockMerchantApiKeyRepository)(nil).DeleteNoncesBefore), before)
+}
+
+// ExpireApiKey mocks base method.
+func (m *MockMerchantApiKeyRepository) ExpireApiKey(id int, expiresAt time.Time) (*record.MerchantApiKeyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireApiKey", id, expiresAt)
+	ret0, _ := ret[0].(*record.MerchantApiKeyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireApiKey indicates an expected call of ExpireApiKey.
+func (mr *MockMerchantApiKeyRepositoryMockRecorder) ExpireApiKey(id, expiresAt any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireApiKey", reflect.TypeOf((*MockMerchantApiKeyRepository)(nil).ExpireApiKey), id, expiresAt)
+}
+
+// FindActiveById mocks base method.
+func (m *MockMerchantApiKeyRepository) FindActiveById(id int) (*record.MerchantApiKeyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveById", id)
+	ret0, _ := ret[0].(*record.MerchantApiKeyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindActiveById indicates an expected call of FindActiveById.
+func (mr *MockMerchantApiKeyRepositoryMockRecorder) FindActiveById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveById", reflect.TypeOf((*MockMerchantApiKeyRepository)(nil).FindActiveById), id)
+}
+
+// FindByHash mocks base method.
+func (m *MockMerchantApiKeyRepository) FindByHash(key_hash string) (*record.MerchantApiKeyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByHash", key_hash)
+	ret0, _ := ret[0].(*record.MerchantApiKeyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByHash indicates an expected call of FindByHash.
+func (mr *MockMerchantApiKeyRepositoryMockRecorder) FindByHash(key_hash any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByHash", reflect.TypeOf((*MockMerchantApiKeyRepository)(nil).FindByHash), key_hash)
+}
+
+// FindById mocks base method.
+func (m *MockMerchantApiKeyRepository) FindById(id int) (*record.MerchantApiKeyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", id)
+	ret0, _ := ret[0].(*record.MerchantApiKeyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockMerchantApiKeyRepositoryMockRecorder) FindById(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockMerchantApiKeyRepository)(nil).FindById), id)
+}
+
+// FindByMerchant mocks base method.
+func (m *MockMerchantApiKeyRepository) FindByMerchant(merchant_id int) ([]*record.MerchantApiKeyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMerchant", merchant_id)
+	ret0, _ := ret[0].([]*record.MerchantApiKeyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByMerchant indicates an expected call of FindByMerchant.
+func (mr *MockMerchantApiKeyRepositoryMockRecorder) FindByMerchant(merchant_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMerchant", reflect.TypeOf((*MockMerchantApiKeyRepository)(nil).FindByMerchant), merchant_id)
+}
+
+// FindWithPlainSigningSecret mocks base method.
+func (m *MockMerchantApiKeyRepository) FindWithPlainSigningSecret() ([]*record.MerchantApiKeyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindWithPlainSigningSecret")
+	ret0, _ := ret[0].([]*record.MerchantApiKeyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindWithPlainSigningSecret indicates an expected call of FindWithPlainSigningSecret.
+func (mr *MockMerchantApiKeyRepositoryMockRecorder) FindWithPlainSigningSecret() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindWithPlainSigningSecret", reflect.TypeOf((*MockMerchantApiKeyRepository)(nil).FindWithPlainSigningSecret))
+}
+
+// RevokeApiKey mocks base method.
+func (m *MockMerchantApiKeyRepository) RevokeApiKey(id int) (*record.MerchantApiKeyRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeApiKey", id)
+	ret0, _ := ret[0].(*record.MerchantApiKeyRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeApiKey indicates an expected call of RevokeApiKey.
+func (mr *MockMerchantApiKeyRepositoryMockRecorder) RevokeApiKey(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeApiKey", reflect.TypeOf((*MockMerchantApiKeyRepository)(nil).RevokeApiKey), id)
+}
+
+// SealSigningSecret mocks base method.
+func (m *MockMerchantApiKeyRepository) SealSigningSecret(id int, plain, sealed string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SealSigningSecret", id, plain, sealed)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SealSigningSecret indicates an expected call of SealSigningSecret.
+func (mr *MockMerchantApiKeyRepositoryMockRecorder) SealSigningSecret(id, plain, sealed any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SealSigningSecret", reflect.TypeOf((*MockMerchantApiKeyRepository)(nil).SealSigningSecret), id, plain, sealed)
+}
+
+// TouchApiKey mocks base method.
+func (m *MockMerchantApiKeyRepository) TouchApiKey(id int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TouchApiKey", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// TouchApiKey indicates an expected call of TouchApiKey.
+func (mr *MockMerchantApiKeyRepositoryMockRecorder) TouchApiKey(id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TouchApiKey", reflect.TypeOf((*MockMerchantApiKeyRepository)(nil).TouchApiKey), id)
+}
+
+// UseNonce mocks base method.
+func (m *MockMerchantApiKeyRepository) UseNonce(id int, nonce string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UseNonce", id, nonce)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UseNonce indicates an expected call of UseNonce.
+func (mr *MockMerchantApiKeyRepositoryMockRecorder) UseNonce(id, nonce any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UseNonce", reflect.TypeOf((*MockMerchantApiKeyRepository)(nil).UseNonce), id, nonce)
+}
+
+// MockInvoiceRepository is a mock of InvoiceRepository interface.
+type MockInvoiceRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockInvoiceRepositoryMockRecorder is the mock recorder for MockInvoiceRepository.
+type MockInvoiceRepositoryMockRecorder struct {
+	mock *MockInvoiceRepository
+}
+
+// NewMockInvoiceRepository creates a new mock instance.
+func NewMockInvoiceRepository(ctrl *gomock.Controller) *MockInvoiceRepository {
+	mock := &MockInvoiceRepository{ctrl: ctrl}
+	mock.recorder = &MockInvoiceRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceRepository) EXPECT() *MockInvoiceRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockInvoiceRepository) Cancel(invoice_id int) (*record.InvoiceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", invoice_id)
+	ret0, _ := ret[0].(*record.InvoiceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockInvoiceRepositoryMockRecorder) Cancel(invoice_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockInvoiceRepository)(nil).Cancel), invoice_id)
+}
+
+// CreateInvoice mocks base method.
+func (m *MockInvoiceRepository) CreateInvoice(request *requests.CreateInvoice) (*record.InvoiceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInvoice", request)
+	ret0, _ := ret[0].(*record.InvoiceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInvoice indicates an expected call of CreateInvoice.
+func (mr *MockInvoiceRepositoryMockRecorder) CreateInvoice(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInvoice", reflect.TypeOf((*MockInvoiceRepository)(nil).CreateInvoice), request)
+}
+
+// CreateLineItem mocks base method.
+func (m *MockInvoiceRepository) CreateLineItem(request *requests.CreateInvoiceLineItem) (*record.InvoiceLineItemRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLineItem", request)
+	ret0, _ := ret[0].(*record.InvoiceLineItemRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLineItem indicates an expected call of CreateLineItem.
+func (mr *MockInvoiceRepositoryMockRecorder) CreateLineItem(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLineItem", reflect.TypeOf((*MockInvoiceRepository)(nil).CreateLineItem), request)
+}
+
+// CreatePayment mocks base method.
+func (m *MockInvoiceRepository) CreatePayment(request *requests.CreateInvoicePayment) (*record.InvoicePaymentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePayment", request)
+	ret0, _ := ret[0].(*record.InvoicePaymentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePayment indicates an expected call of CreatePayment.
+func (mr *MockInvoiceRepositoryMockRecorder) CreatePayment(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePayment", reflect.TypeOf((*MockInvoiceRepository)(nil).CreatePayment), request)
+}
+
+// ExpireInvoices mocks base method.
+func (m *MockInvoiceRepository) ExpireInvoices() (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireInvoices")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpireInvoices indicates an expected call of ExpireInvoices.
+func (mr *MockInvoiceRepositoryMockRecorder) ExpireInvoices() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireInvoices", reflect.TypeOf((*MockInvoiceRepository)(nil).ExpireInvoices))
+}
+
+// FindByCode mocks base method.
+func (m *MockInvoiceRepository) FindByCode(code string) (*record.InvoiceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", code)
+	ret0, _ := ret[0].(*record.InvoiceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockInvoiceRepositoryMockRecorder) FindByCode(code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockInvoiceRepository)(nil).FindByCode), code)
+}
+
+// FindById mocks base method.
+func (m *MockInvoiceRepository) FindById(invoice_id int) (*record.InvoiceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", invoice_id)
+	ret0, _ := ret[0].(*record.InvoiceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockInvoiceRepositoryMockRecorder) FindById(invoice_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockInvoiceRepository)(nil).FindById), invoice_id)
+}
+
+// FindByMerchant mocks base method.
+func (m *MockInvoiceRepository) FindByMerchant(req *requests.FindInvoicesByMerchant) ([]*record.InvoiceRecord, *int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMerchant", req)
+	ret0, _ := ret[0].([]*record.InvoiceRecord)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// FindByMerchant indicates an expected call of FindByMerchant.
+func (mr *MockInvoiceRepositoryMockRecorder) FindByMerchant(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMerchant", reflect.TypeOf((*MockInvoiceRepository)(nil).FindByMerchant), req)
+}
+
+// FindLineItems mocks base method.
+func (m *MockInvoiceRepository) FindLineItems(invoice_id int) ([]*record.InvoiceLineItemRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLineItems", invoice_id)
+	ret0, _ := ret[0].([]*record.InvoiceLineItemRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLineItems indicates an expected call of FindLineItems.
+func (mr *MockInvoiceRepositoryMockRecorder) FindLineItems(invoice_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLineItems", reflect.TypeOf((*MockInvoiceRepository)(nil).FindLineItems), invoice_id)
+}
+
+// FindOpenByCodeForUpdate mocks base method.
+func (m *MockInvoiceRepository) FindOpenByCodeForUpdate(code string) (*record.InvoiceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindOpenByCodeForUpdate", code)
+	ret0, _ := ret[0].(*record.InvoiceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindOpenByCodeForUpdate indicates an expected call of FindOpenByCodeForUpdate.
+func (mr *MockInvoiceRepositoryMockRecorder) FindOpenByCodeForUpdate(code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindOpenByCodeForUpdate", reflect.TypeOf((*MockInvoiceRepository)(nil).FindOpenByCodeForUpdate), code)
+}
+
+// FindPayments mocks base method.
+func (m *MockInvoiceRepository) FindPayments(invoice_id int) ([]*record.InvoicePaymentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPayments", invoice_id)
+	ret0, _ := ret[0].([]*record.InvoicePaymentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindPayments indicates an expected call of FindPayments.
+func (mr *MockInvoiceRepositoryMockRecorder) FindPayments(invoice_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPayments", reflect.TypeOf((*MockInvoiceRepository)(nil).FindPayments), invoice_id)
+}
+
+// MarkPaid mocks base method.
+func (m *MockInvoiceRepository) MarkPaid(invoice_id int) (*record.InvoiceRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkPaid", invoice_id)
+	ret0, _ := ret[0].(*record.InvoiceRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkPaid indicates an expected call of MarkPaid.
+func (mr *MockInvoiceRepositoryMockRecorder) MarkPaid(invoice_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkPaid", reflect.TypeOf((*MockInvoiceRepository)(nil).MarkPaid), invoice_id)
+}
+
+// MockQrPaymentRepository is a mock of QrPaymentRepository interface.
+type MockQrPaymentRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockQrPaymentRepositoryMockRecorder
+	isgomock struct{}
+}
+
+// MockQrPaymentRepositoryMockRecorder is the mock recorder for MockQrPaymentRepository.
+type MockQrPaymentRepositoryMockRecorder struct {
+	mock *MockQrPaymentRepository
+}
+
+// NewMockQrPaymentRepository creates a new mock instance.
+func NewMockQrPaymentRepository(ctrl *gomock.Controller) *MockQrPaymentRepository {
+	mock := &MockQrPaymentRepository{ctrl: ctrl}
+	mock.recorder = &MockQrPaymentRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockQrPaymentRepository) EXPECT() *MockQrPaymentRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CreateQrPayment mocks base method.
+func (m *MockQrPaymentRepository) CreateQrPayment(request *requests.CreateQrPayment) (*record.QrPaymentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateQrPayment", request)
+	ret0, _ := ret[0].(*record.QrPaymentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateQrPayment indicates an expected call of CreateQrPayment.
+func (mr *MockQrPaymentRepositoryMockRecorder) CreateQrPayment(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateQrPayment", reflect.TypeOf((*MockQrPaymentRepository)(nil).CreateQrPayment), request)
+}
+
+// FindByReference mocks base method.
+func (m *MockQrPaymentRepository) FindByReference(merchant_id int, reference string) (*record.QrPaymentRecord, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByReference", merchant_id, reference)
+	ret0, _ := ret[0].(*record.QrPaymentRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindByReference indicates an expected call of FindByReference.
+func (mr *MockQrPaymentRepositoryMockRecorder) FindByReference(merchant_id, reference any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByReference", reflect.TypeOf((*MockQrPaymentRepository)(nil).FindByReference), merchant_id, reference)
+}
@@ -0,0 +1,123 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/provider_webhook_errors"
+)
+
+type providerWebhookRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.ProviderWebhookRecordMapping
+}
+
+func NewProviderWebhookRepository(db *db.Queries, ctx context.Context, mapping recordmapper.ProviderWebhookRecordMapping) *providerWebhookRepository {
+	return &providerWebhookRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *providerWebhookRepository) FindAll(req *requests.FindAllProviderWebhookEvents) ([]*record.ProviderWebhookEventRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetProviderWebhookEventsParams{
+		Column1: req.Search,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	}
+
+	events, err := r.db.GetProviderWebhookEvents(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, provider_webhook_errors.ErrFindAllProviderWebhookEventsFailed
+	}
+
+	var totalCount int
+	if len(events) > 0 {
+		totalCount = int(events[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToProviderWebhookEventsRecordAll(events), &totalCount, nil
+}
+
+func (r *providerWebhookRepository) FindById(id int) (*record.ProviderWebhookEventRecord, error) {
+	res, err := r.db.GetProviderWebhookEventByID(r.ctx, int32(id))
+
+	if err != nil {
+		return nil, provider_webhook_errors.ErrFindProviderWebhookEventByIdFailed
+	}
+
+	return r.mapping.ToProviderWebhookEventRecord(res), nil
+}
+
+func (r *providerWebhookRepository) FindByEventID(provider string, event_id string) (*record.ProviderWebhookEventRecord, error) {
+	res, err := r.db.GetProviderWebhookEventByEventID(r.ctx, db.GetProviderWebhookEventByEventIDParams{
+		Provider: provider,
+		EventID:  event_id,
+	})
+
+	if err != nil {
+		return nil, provider_webhook_errors.ErrFindProviderWebhookEventFailed
+	}
+
+	return r.mapping.ToProviderWebhookEventRecord(res), nil
+}
+
+// CreateEvent stores a callback, or returns ErrProviderWebhookEventDuplicate
+// when the provider already sent one with the same event ID.
+func (r *providerWebhookRepository) CreateEvent(request *requests.CreateProviderWebhookEvent) (*record.ProviderWebhookEventRecord, error) {
+	req := db.CreateProviderWebhookEventParams{
+		Provider:  request.Provider,
+		EventID:   request.EventID,
+		EventType: request.EventType,
+		Payload:   request.Payload,
+		Signature: request.Signature,
+		SentAt:    request.SentAt,
+	}
+
+	res, err := r.db.CreateProviderWebhookEvent(r.ctx, req)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, provider_webhook_errors.ErrProviderWebhookEventDuplicate
+		}
+		return nil, provider_webhook_errors.ErrCreateProviderWebhookEventFailed
+	}
+
+	return r.mapping.ToProviderWebhookEventRecord(res), nil
+}
+
+func (r *providerWebhookRepository) FinishEvent(request *requests.FinishProviderWebhookEvent) (*record.ProviderWebhookEventRecord, error) {
+	req := db.FinishProviderWebhookEventParams{
+		ProviderWebhookEventID: int32(request.EventID),
+		Status:                 request.Status,
+		LastError:              nullableWebhookError(request.LastError),
+	}
+
+	res, err := r.db.FinishProviderWebhookEvent(r.ctx, req)
+
+	if err != nil {
+		return nil, provider_webhook_errors.ErrFinishProviderWebhookEventFailed
+	}
+
+	return r.mapping.ToProviderWebhookEventRecord(res), nil
+}
+
+func nullableWebhookError(value *string) sql.NullString {
+	if value == nil || *value == "" {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: *value, Valid: true}
+}
@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/qr_payment_errors"
+)
+
+type qrPaymentRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.QrPaymentRecordMapping
+}
+
+func NewQrPaymentRepository(db *db.Queries, ctx context.Context, mapping recordmapper.QrPaymentRecordMapping) *qrPaymentRepository {
+	return &qrPaymentRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *qrPaymentRepository) FindByReference(merchant_id int, reference string) (*record.QrPaymentRecord, error) {
+	res, err := r.db.GetQrPaymentByReference(r.ctx, db.GetQrPaymentByReferenceParams{
+		MerchantID: int32(merchant_id),
+		Reference:  sql.NullString{String: reference, Valid: true},
+	})
+
+	if err != nil {
+		return nil, qr_payment_errors.ErrFindQrPaymentByReferenceFailed
+	}
+
+	return r.mapping.ToQrPaymentRecord(res), nil
+}
+
+// CreateQrPayment records a QR payment, or returns ErrQrReferenceUsed when
+// the merchant was already paid for the reference of a dynamic QR code.
+func (r *qrPaymentRepository) CreateQrPayment(request *requests.CreateQrPayment) (*record.QrPaymentRecord, error) {
+	res, err := r.db.CreateQrPayment(r.ctx, db.CreateQrPaymentParams{
+		MerchantID:    int32(request.MerchantID),
+		TransactionID: int32(request.TransactionID),
+		Reference:     sql.NullString{String: request.Reference, Valid: request.Reference != ""},
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, qr_payment_errors.ErrQrReferenceUsed
+		}
+		return nil, qr_payment_errors.ErrCreateQrPaymentFailed
+	}
+
+	return r.mapping.ToQrPaymentRecord(res), nil
+}
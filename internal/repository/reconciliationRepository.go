@@ -0,0 +1,202 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/reconciliation_errors"
+)
+
+type reconciliationRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.ReconciliationRecordMapping
+}
+
+func NewReconciliationRepository(db *db.Queries, ctx context.Context, mapping recordmapper.ReconciliationRecordMapping) *reconciliationRepository {
+	return &reconciliationRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+// TryLock takes the reconciliation lock until the surrounding transaction
+// ends, or fails with ErrReconciliationAlreadyRunning when another run
+// holds it.
+func (r *reconciliationRepository) TryLock() error {
+	locked, err := r.db.TryLockReconciliation(r.ctx)
+
+	if err != nil {
+		return reconciliation_errors.ErrLockReconciliationFailed
+	}
+
+	if !locked {
+		return reconciliation_errors.ErrReconciliationAlreadyRunning
+	}
+
+	return nil
+}
+
+func (r *reconciliationRepository) CreateRun() (*record.ReconciliationRunRecord, error) {
+	res, err := r.db.CreateReconciliationRun(r.ctx)
+
+	if err != nil {
+		return nil, reconciliation_errors.ErrCreateReconciliationRunFailed
+	}
+
+	return r.mapping.ToReconciliationRunRecord(res), nil
+}
+
+func (r *reconciliationRepository) FinishRun(run_id int, cards_checked int, discrepancy_count int) (*record.ReconciliationRunRecord, error) {
+	res, err := r.db.FinishReconciliationRun(r.ctx, db.FinishReconciliationRunParams{
+		ReconciliationRunID: int32(run_id),
+		CardsChecked:        int32(cards_checked),
+		DiscrepancyCount:    int32(discrepancy_count),
+	})
+
+	if err != nil {
+		return nil, reconciliation_errors.ErrFinishReconciliationRunFailed
+	}
+
+	return r.mapping.ToReconciliationRunRecord(res), nil
+}
+
+func (r *reconciliationRepository) FindRuns(req *requests.FindReconciliationRuns) ([]*record.ReconciliationRunRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetReconciliationRunsParams{
+		Limit:  int32(req.PageSize),
+		Offset: int32(offset),
+	}
+
+	runs, err := r.db.GetReconciliationRuns(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, reconciliation_errors.ErrFindReconciliationRunsFailed
+	}
+
+	var totalCount int
+	if len(runs) > 0 {
+		totalCount = int(runs[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToReconciliationRunsRecordAll(runs), &totalCount, nil
+}
+
+// FindCardReconciliations recomputes the balance of every card from its
+// history in a single statement.
+func (r *reconciliationRepository) FindCardReconciliations() ([]*record.CardReconciliationRecord, error) {
+	res, err := r.db.GetCardReconciliations(r.ctx)
+
+	if err != nil {
+		return nil, reconciliation_errors.ErrFindCardReconciliationsFailed
+	}
+
+	return r.mapping.ToCardReconciliationsRecord(res), nil
+}
+
+// UpsertDiscrepancy records a card that did not match, or refreshes its
+// unresolved discrepancy.
+func (r *reconciliationRepository) UpsertDiscrepancy(req *requests.CreateBalanceDiscrepancy) (*record.BalanceDiscrepancyRecord, error) {
+	res, err := r.db.UpsertBalanceDiscrepancy(r.ctx, db.UpsertBalanceDiscrepancyParams{
+		CardNumber:          req.CardNumber,
+		ReconciliationRunID: int32(req.ReconciliationRunID),
+		SaldoBalance:        int32(req.SaldoBalance),
+		ExpectedBalance:     int32(req.ExpectedBalance),
+		LedgerBalance:       int32(req.LedgerBalance),
+		Difference:          int32(req.Difference),
+		TopupAmount:         int32(req.TopupAmount),
+		WithdrawAmount:      int32(req.WithdrawAmount),
+		TransferOutAmount:   int32(req.TransferOutAmount),
+		TransferInAmount:    int32(req.TransferInAmount),
+		PaymentAmount:       int32(req.PaymentAmount),
+		SettlementAmount:    int32(req.SettlementAmount),
+		OtherAmount:         int32(req.OtherAmount),
+	})
+
+	if err != nil {
+		return nil, reconciliation_errors.ErrUpsertBalanceDiscrepancyFailed
+	}
+
+	return r.mapping.ToBalanceDiscrepancyRecord(res), nil
+}
+
+// ResolveDiscrepancies resolves every unresolved discrepancy the run did
+// not refresh, that is of every card that matches again.
+func (r *reconciliationRepository) ResolveDiscrepancies(run_id int) error {
+	if err := r.db.ResolveBalanceDiscrepancies(r.ctx, int32(run_id)); err != nil {
+		return reconciliation_errors.ErrResolveBalanceDiscrepancyFailed
+	}
+
+	return nil
+}
+
+func (r *reconciliationRepository) FindDiscrepancyById(id int) (*record.BalanceDiscrepancyRecord, error) {
+	res, err := r.db.GetBalanceDiscrepancyByID(r.ctx, int32(id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, reconciliation_errors.ErrBalanceDiscrepancyNotFound
+		}
+		return nil, reconciliation_errors.ErrFindBalanceDiscrepancyFailed
+	}
+
+	return r.mapping.ToBalanceDiscrepancyRecord(res), nil
+}
+
+func (r *reconciliationRepository) FindDiscrepancies(req *requests.FindBalanceDiscrepancies) ([]*record.BalanceDiscrepancyRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetBalanceDiscrepanciesParams{
+		Column1: req.Status,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	}
+
+	discrepancies, err := r.db.GetBalanceDiscrepancies(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, reconciliation_errors.ErrFindBalanceDiscrepanciesFailed
+	}
+
+	var totalCount int
+	if len(discrepancies) > 0 {
+		totalCount = int(discrepancies[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToBalanceDiscrepanciesRecordAll(discrepancies), &totalCount, nil
+}
+
+// AcknowledgeDiscrepancy marks an open discrepancy as acknowledged, or fails
+// with ErrBalanceDiscrepancyNotOpen when it is not open.
+func (r *reconciliationRepository) AcknowledgeDiscrepancy(req *requests.AcknowledgeBalanceDiscrepancyRequest) (*record.BalanceDiscrepancyRecord, error) {
+	var note sql.NullString
+	if req.Note != nil {
+		note = sql.NullString{String: *req.Note, Valid: true}
+	}
+
+	res, err := r.db.AcknowledgeBalanceDiscrepancy(r.ctx, db.AcknowledgeBalanceDiscrepancyParams{
+		BalanceDiscrepancyID: int32(req.DiscrepancyID),
+		AcknowledgedBy:       sql.NullInt32{Int32: int32(req.AcknowledgedBy), Valid: true},
+		Note:                 note,
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, reconciliation_errors.ErrBalanceDiscrepancyNotOpen
+		}
+		return nil, reconciliation_errors.ErrAcknowledgeDiscrepancyFailed
+	}
+
+	return r.mapping.ToBalanceDiscrepancyRecord(res), nil
+}
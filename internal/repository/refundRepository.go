@@ -0,0 +1,88 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/refund_errors"
+)
+
+type refundRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.RefundRecordMapping
+}
+
+func NewRefundRepository(db *db.Queries, ctx context.Context, mapping recordmapper.RefundRecordMapping) *refundRepository {
+	return &refundRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *refundRepository) FindAll(req *requests.FindAllRefunds) ([]*record.RefundRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetRefundsParams{
+		Column1: req.Search,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	}
+
+	refunds, err := r.db.GetRefunds(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, refund_errors.ErrFindAllRefundsFailed
+	}
+
+	var totalCount int
+	if len(refunds) > 0 {
+		totalCount = int(refunds[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToRefundsRecordAll(refunds), &totalCount, nil
+}
+
+func (r *refundRepository) FindById(refund_id int) (*record.RefundRecord, error) {
+	res, err := r.db.GetRefundByID(r.ctx, int32(refund_id))
+
+	if err != nil {
+		return nil, refund_errors.ErrFindRefundByIdFailed
+	}
+
+	return r.mapping.ToRefundRecord(res), nil
+}
+
+func (r *refundRepository) FindByTransactionId(transaction_id int) ([]*record.RefundRecord, error) {
+	res, err := r.db.GetRefundsByTransactionID(r.ctx, int32(transaction_id))
+
+	if err != nil {
+		return nil, refund_errors.ErrFindRefundsByTransactionFailed
+	}
+
+	return r.mapping.ToRefundsRecord(res), nil
+}
+
+func (r *refundRepository) CreateRefund(transaction *record.TransactionRecord, request *requests.CreateRefundRequest) (*record.RefundRecord, error) {
+	req := db.CreateRefundParams{
+		TransactionID: int32(transaction.ID),
+		CardNumber:    transaction.CardNumber,
+		MerchantID:    int32(transaction.MerchantID),
+		Amount:        int32(request.Amount),
+		Reason:        request.Reason,
+	}
+
+	res, err := r.db.CreateRefund(r.ctx, req)
+
+	if err != nil {
+		return nil, refund_errors.ErrCreateRefundFailed
+	}
+
+	return r.mapping.ToRefundRecord(res), nil
+}
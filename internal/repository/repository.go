@@ -8,17 +8,37 @@ import (
 )
 
 type Repositories struct {
-	User         UserRepository
-	Saldo        SaldoRepository
-	Role         RoleRepository
-	UserRole     UserRoleRepository
-	RefreshToken RefreshTokenRepository
-	Topup        TopupRepository
-	Withdraw     WithdrawRepository
-	Transfer     TransferRepository
-	Merchant     MerchantRepository
-	Card         CardRepository
-	Transaction  TransactionRepository
+	User               UserRepository
+	Saldo              SaldoRepository
+	SaldoHold          SaldoHoldRepository
+	Role               RoleRepository
+	UserRole           UserRoleRepository
+	RefreshToken       RefreshTokenRepository
+	Topup              TopupRepository
+	Withdraw           WithdrawRepository
+	Transfer           TransferRepository
+	Merchant           MerchantRepository
+	Card               CardRepository
+	Transaction        TransactionRepository
+	Ledger             LedgerRepository
+	IdempotencyKey     IdempotencyKeyRepository
+	Refund             RefundRepository
+	Dispute            DisputeRepository
+	Authorization      AuthorizationRepository
+	ExchangeRate       ExchangeRateRepository
+	FeeSchedule        FeeScheduleRepository
+	TransactionLimit   TransactionLimitRepository
+	ScheduledTransfer  ScheduledTransferRepository
+	TransferBatch      TransferBatchRepository
+	StatusHistory      StatusHistoryRepository
+	ProviderWebhook    ProviderWebhookRepository
+	MerchantWebhook    MerchantWebhookRepository
+	Settlement         SettlementRepository
+	Reconciliation     ReconciliationRepository
+	MerchantOnboarding MerchantOnboardingRepository
+	MerchantApiKey     MerchantApiKeyRepository
+	Invoice            InvoiceRepository
+	QrPayment          QrPaymentRepository
 }
 
 type Deps struct {
@@ -29,16 +49,36 @@ type Deps struct {
 
 func NewRepositories(deps Deps) *Repositories {
 	return &Repositories{
-		User:         NewUserRepository(deps.DB, deps.Ctx, deps.MapperRecord.UserRecordMapper),
-		Role:         NewRoleRepository(deps.DB, deps.Ctx, deps.MapperRecord.RoleRecordMapper),
-		UserRole:     NewUserRoleRepository(deps.DB, deps.Ctx, deps.MapperRecord.UserRoleRecordMapper),
-		RefreshToken: NewRefreshTokenRepository(deps.DB, deps.Ctx, deps.MapperRecord.RefreshTokenRecordMapper),
-		Saldo:        NewSaldoRepository(deps.DB, deps.Ctx, deps.MapperRecord.SaldoRecordMapper),
-		Topup:        NewTopupRepository(deps.DB, deps.Ctx, deps.MapperRecord.TopupRecordMapper),
-		Withdraw:     NewWithdrawRepository(deps.DB, deps.Ctx, deps.MapperRecord.WithdrawRecordMapper),
-		Transfer:     NewTransferRepository(deps.DB, deps.Ctx, deps.MapperRecord.TransferRecordMapper),
-		Merchant:     NewMerchantRepository(deps.DB, deps.Ctx, deps.MapperRecord.MerchantRecordMapper),
-		Card:         NewCardRepository(deps.DB, deps.Ctx, deps.MapperRecord.CardRecordMapper),
-		Transaction:  NewTransactionRepository(deps.DB, deps.Ctx, deps.MapperRecord.TransactionRecordMapper),
+		User:               NewUserRepository(deps.DB, deps.Ctx, deps.MapperRecord.UserRecordMapper),
+		Role:               NewRoleRepository(deps.DB, deps.Ctx, deps.MapperRecord.RoleRecordMapper),
+		UserRole:           NewUserRoleRepository(deps.DB, deps.Ctx, deps.MapperRecord.UserRoleRecordMapper),
+		RefreshToken:       NewRefreshTokenRepository(deps.DB, deps.Ctx, deps.MapperRecord.RefreshTokenRecordMapper),
+		Saldo:              NewSaldoRepository(deps.DB, deps.Ctx, deps.MapperRecord.SaldoRecordMapper),
+		SaldoHold:          NewSaldoHoldRepository(deps.DB, deps.Ctx, deps.MapperRecord.SaldoHoldRecordMapper),
+		Topup:              NewTopupRepository(deps.DB, deps.Ctx, deps.MapperRecord.TopupRecordMapper),
+		Withdraw:           NewWithdrawRepository(deps.DB, deps.Ctx, deps.MapperRecord.WithdrawRecordMapper),
+		Transfer:           NewTransferRepository(deps.DB, deps.Ctx, deps.MapperRecord.TransferRecordMapper),
+		Merchant:           NewMerchantRepository(deps.DB, deps.Ctx, deps.MapperRecord.MerchantRecordMapper),
+		Card:               NewCardRepository(deps.DB, deps.Ctx, deps.MapperRecord.CardRecordMapper),
+		Transaction:        NewTransactionRepository(deps.DB, deps.Ctx, deps.MapperRecord.TransactionRecordMapper),
+		Ledger:             NewLedgerRepository(deps.DB, deps.Ctx, deps.MapperRecord.LedgerRecordMapper),
+		IdempotencyKey:     NewIdempotencyKeyRepository(deps.DB, deps.Ctx, deps.MapperRecord.IdempotencyKeyRecordMapper),
+		Refund:             NewRefundRepository(deps.DB, deps.Ctx, deps.MapperRecord.RefundRecordMapper),
+		Dispute:            NewDisputeRepository(deps.DB, deps.Ctx, deps.MapperRecord.DisputeRecordMapper),
+		Authorization:      NewAuthorizationRepository(deps.DB, deps.Ctx, deps.MapperRecord.AuthorizationRecordMapper),
+		ExchangeRate:       NewExchangeRateRepository(deps.DB, deps.Ctx, deps.MapperRecord.ExchangeRateRecordMapper),
+		FeeSchedule:        NewFeeScheduleRepository(deps.DB, deps.Ctx, deps.MapperRecord.FeeScheduleRecordMapper),
+		TransactionLimit:   NewTransactionLimitRepository(deps.DB, deps.Ctx, deps.MapperRecord.TransactionLimitRecordMapper),
+		ScheduledTransfer:  NewScheduledTransferRepository(deps.DB, deps.Ctx, deps.MapperRecord.ScheduledTransferRecordMapper),
+		TransferBatch:      NewTransferBatchRepository(deps.DB, deps.Ctx, deps.MapperRecord.TransferBatchRecordMapper),
+		StatusHistory:      NewStatusHistoryRepository(deps.DB, deps.Ctx, deps.MapperRecord.StatusHistoryRecordMapper),
+		ProviderWebhook:    NewProviderWebhookRepository(deps.DB, deps.Ctx, deps.MapperRecord.ProviderWebhookRecordMapper),
+		MerchantWebhook:    NewMerchantWebhookRepository(deps.DB, deps.Ctx, deps.MapperRecord.MerchantWebhookRecordMapper),
+		Settlement:         NewSettlementRepository(deps.DB, deps.Ctx, deps.MapperRecord.SettlementRecordMapper),
+		Reconciliation:     NewReconciliationRepository(deps.DB, deps.Ctx, deps.MapperRecord.ReconciliationRecordMapper),
+		MerchantOnboarding: NewMerchantOnboardingRepository(deps.DB, deps.Ctx, deps.MapperRecord.MerchantOnboardingRecordMapper),
+		MerchantApiKey:     NewMerchantApiKeyRepository(deps.DB, deps.Ctx, deps.MapperRecord.MerchantApiKeyRecordMapper),
+		Invoice:            NewInvoiceRepository(deps.DB, deps.Ctx, deps.MapperRecord.InvoiceRecordMapper),
+		QrPayment:          NewQrPaymentRepository(deps.DB, deps.Ctx, deps.MapperRecord.QrPaymentRecordMapper),
 	}
 }
@@ -0,0 +1,114 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
+)
+
+type saldoHoldRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.SaldoHoldRecordMapping
+}
+
+func NewSaldoHoldRepository(db *db.Queries, ctx context.Context, mapping recordmapper.SaldoHoldRecordMapping) *saldoHoldRepository {
+	return &saldoHoldRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *saldoHoldRepository) FindById(hold_id int) (*record.SaldoHoldRecord, error) {
+	res, err := r.db.GetSaldoHoldByID(r.ctx, int32(hold_id))
+
+	if err != nil {
+		return nil, saldo_errors.ErrFindSaldoHoldByIdFailed
+	}
+
+	return r.mapping.ToSaldoHoldRecord(res), nil
+}
+
+func (r *saldoHoldRepository) FindActiveByReference(reference_type string, reference_id int) (*record.SaldoHoldRecord, error) {
+	res, err := r.db.GetActiveSaldoHoldByReference(r.ctx, db.GetActiveSaldoHoldByReferenceParams{
+		ReferenceType: sql.NullString{String: reference_type, Valid: true},
+		ReferenceID:   sql.NullInt32{Int32: int32(reference_id), Valid: true},
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, saldo_errors.ErrSaldoHoldStatusConflict
+		}
+		return nil, saldo_errors.ErrFindSaldoHoldByIdFailed
+	}
+
+	return r.mapping.ToSaldoHoldRecord(res), nil
+}
+
+func (r *saldoHoldRepository) FindActiveByCardNumber(card_number string) ([]*record.SaldoHoldRecord, error) {
+	res, err := r.db.GetActiveSaldoHoldsByCardNumber(r.ctx, card_number)
+
+	if err != nil {
+		return nil, saldo_errors.ErrFindActiveSaldoHoldsFailed
+	}
+
+	return r.mapping.ToSaldoHoldsRecord(res), nil
+}
+
+func (r *saldoHoldRepository) CreateHold(request *requests.CreateSaldoHoldRequest) (*record.SaldoHoldRecord, error) {
+	req := db.CreateSaldoHoldParams{
+		CardNumber: request.CardNumber,
+		Amount:     int32(request.Amount),
+		Reason:     request.Reason,
+	}
+
+	if request.ReferenceType != nil && request.ReferenceID != nil {
+		req.ReferenceType = sql.NullString{String: *request.ReferenceType, Valid: true}
+		req.ReferenceID = sql.NullInt32{Int32: int32(*request.ReferenceID), Valid: true}
+	}
+
+	if request.CreatedBy != nil {
+		req.CreatedBy = sql.NullInt32{Int32: int32(*request.CreatedBy), Valid: true}
+	}
+
+	res, err := r.db.CreateSaldoHold(r.ctx, req)
+
+	if err != nil {
+		return nil, saldo_errors.ErrCreateSaldoHoldFailed
+	}
+
+	return r.mapping.ToSaldoHoldRecord(res), nil
+}
+
+func (r *saldoHoldRepository) ReleaseHold(hold_id int) (*record.SaldoHoldRecord, error) {
+	res, err := r.db.ReleaseSaldoHold(r.ctx, int32(hold_id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, saldo_errors.ErrSaldoHoldStatusConflict
+		}
+		return nil, saldo_errors.ErrReleaseSaldoHoldFailed
+	}
+
+	return r.mapping.ToSaldoHoldRecord(res), nil
+}
+
+func (r *saldoHoldRepository) ConsumeHold(hold_id int) (*record.SaldoHoldRecord, error) {
+	res, err := r.db.ConsumeSaldoHold(r.ctx, int32(hold_id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, saldo_errors.ErrSaldoHoldStatusConflict
+		}
+		return nil, saldo_errors.ErrConsumeSaldoHoldFailed
+	}
+
+	return r.mapping.ToSaldoHoldRecord(res), nil
+}
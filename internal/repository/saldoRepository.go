@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
@@ -112,6 +113,45 @@ func (r *saldoRepository) FindByCardNumber(card_number string) (*record.SaldoRec
 	return r.mapping.ToSaldoRecord(res), nil
 }
 
+func (r *saldoRepository) LockByCardNumbers(card_numbers []string) ([]*record.SaldoRecord, error) {
+	res, err := r.db.LockSaldosByCardNumbers(r.ctx, card_numbers)
+
+	if err != nil {
+		return nil, saldo_errors.ErrLockSaldoFailed
+	}
+
+	return r.mapping.ToSaldosRecord(res), nil
+}
+
+func (r *saldoRepository) ReserveBalance(card_number string, amount int) (*record.SaldoRecord, error) {
+	res, err := r.db.ReserveSaldoBalance(r.ctx, db.ReserveSaldoBalanceParams{
+		Amount:     int32(amount),
+		CardNumber: card_number,
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, saldo_errors.ErrInsufficientAvailableSaldo
+		}
+		return nil, saldo_errors.ErrReserveSaldoFailed
+	}
+
+	return r.mapping.ToSaldoRecord(res), nil
+}
+
+func (r *saldoRepository) ReleaseReservation(card_number string, amount int) (*record.SaldoRecord, error) {
+	res, err := r.db.ReleaseSaldoReservation(r.ctx, db.ReleaseSaldoReservationParams{
+		Amount:     int32(amount),
+		CardNumber: card_number,
+	})
+
+	if err != nil {
+		return nil, saldo_errors.ErrReleaseSaldoFailed
+	}
+
+	return r.mapping.ToSaldoRecord(res), nil
+}
+
 func (r *saldoRepository) FindById(saldo_id int) (*record.SaldoRecord, error) {
 	res, err := r.db.GetSaldoByID(r.ctx, int32(saldo_id))
 
@@ -202,7 +242,6 @@ func (r *saldoRepository) CreateSaldo(request *requests.CreateSaldoRequest) (*re
 func (r *saldoRepository) UpdateSaldo(request *requests.UpdateSaldoRequest) (*record.SaldoRecord, error) {
 	req := db.UpdateSaldoParams{
 		SaldoID:      int32(*request.SaldoID),
-		CardNumber:   request.CardNumber,
 		TotalBalance: int32(request.TotalBalance),
 	}
 
@@ -258,6 +297,16 @@ func (r *saldoRepository) UpdateSaldoWithdraw(request *requests.UpdateSaldoWithd
 	return r.mapping.ToSaldoRecord(res), nil
 }
 
+func (r *saldoRepository) RebuildFromLedger() ([]*record.SaldoRecord, error) {
+	res, err := r.db.RebuildSaldoBalancesFromLedger(r.ctx)
+
+	if err != nil {
+		return nil, saldo_errors.ErrRebuildSaldosFailed
+	}
+
+	return r.mapping.ToSaldosRecord(res), nil
+}
+
 func (r *saldoRepository) TrashedSaldo(saldo_id int) (*record.SaldoRecord, error) {
 	res, err := r.db.TrashSaldo(r.ctx, int32(saldo_id))
 	if err != nil {
@@ -0,0 +1,274 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/scheduled_transfer_errors"
+)
+
+type scheduledTransferRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.ScheduledTransferRecordMapping
+}
+
+func NewScheduledTransferRepository(db *db.Queries, ctx context.Context, mapping recordmapper.ScheduledTransferRecordMapping) *scheduledTransferRepository {
+	return &scheduledTransferRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *scheduledTransferRepository) FindAll(req *requests.FindAllScheduledTransfers) ([]*record.ScheduledTransferRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetScheduledTransfersParams{
+		Column1: req.Search,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+		UserID:  nullableScheduleInt(req.RequestedBy),
+	}
+
+	schedules, err := r.db.GetScheduledTransfers(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, scheduled_transfer_errors.ErrFindAllScheduledTransfersFailed
+	}
+
+	var totalCount int
+	if len(schedules) > 0 {
+		totalCount = int(schedules[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToScheduledTransfersRecordAll(schedules), &totalCount, nil
+}
+
+func (r *scheduledTransferRepository) FindById(scheduled_transfer_id int) (*record.ScheduledTransferRecord, error) {
+	res, err := r.db.GetScheduledTransferByID(r.ctx, int32(scheduled_transfer_id))
+
+	if err != nil {
+		return nil, scheduled_transfer_errors.ErrFindScheduledTransferByIdFailed
+	}
+
+	return r.mapping.ToScheduledTransferRecord(res), nil
+}
+
+func (r *scheduledTransferRepository) FindDue(now time.Time, limit int) ([]*record.ScheduledTransferRecord, error) {
+	res, err := r.db.GetDueScheduledTransfers(r.ctx, db.GetDueScheduledTransfersParams{
+		Now:      now,
+		RowLimit: int32(limit),
+	})
+
+	if err != nil {
+		return nil, scheduled_transfer_errors.ErrFindDueScheduledTransfersFailed
+	}
+
+	return r.mapping.ToScheduledTransfersRecord(res), nil
+}
+
+func (r *scheduledTransferRepository) FindRuns(req *requests.FindScheduledTransferRuns) ([]*record.ScheduledTransferRunRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	runs, err := r.db.GetScheduledTransferRuns(r.ctx, db.GetScheduledTransferRunsParams{
+		ScheduledTransferID: int32(req.ScheduledTransferID),
+		Limit:               int32(req.PageSize),
+		Offset:              int32(offset),
+	})
+
+	if err != nil {
+		return nil, nil, scheduled_transfer_errors.ErrFindScheduledTransferRunsFailed
+	}
+
+	var totalCount int
+	if len(runs) > 0 {
+		totalCount = int(runs[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToScheduledTransferRunsRecordAll(runs), &totalCount, nil
+}
+
+func (r *scheduledTransferRepository) CreateScheduledTransfer(user_id int, request *requests.CreateScheduledTransferRequest, nextRunAt time.Time) (*record.ScheduledTransferRecord, error) {
+	req := db.CreateScheduledTransferParams{
+		UserID:         int32(user_id),
+		TransferFrom:   request.TransferFrom,
+		TransferTo:     request.TransferTo,
+		TransferAmount: int32(request.TransferAmount),
+		Recurrence:     request.Recurrence,
+		DayOfMonth:     nullableScheduleInt(request.DayOfMonth),
+		NextRunAt:      sql.NullTime{Time: nextRunAt, Valid: true},
+		EndAt:          nullableScheduleTime(request.EndAt),
+	}
+
+	res, err := r.db.CreateScheduledTransfer(r.ctx, req)
+
+	if err != nil {
+		return nil, scheduled_transfer_errors.ErrCreateScheduledTransferFailed
+	}
+
+	return r.mapping.ToScheduledTransferRecord(res), nil
+}
+
+func (r *scheduledTransferRepository) UpdateScheduledTransfer(request *requests.UpdateScheduledTransferRequest, nextRunAt time.Time) (*record.ScheduledTransferRecord, error) {
+	req := db.UpdateScheduledTransferParams{
+		ScheduledTransferID: int32(*request.ScheduledTransferID),
+		TransferTo:          request.TransferTo,
+		TransferAmount:      int32(request.TransferAmount),
+		Recurrence:          request.Recurrence,
+		DayOfMonth:          nullableScheduleInt(request.DayOfMonth),
+		NextRunAt:           sql.NullTime{Time: nextRunAt, Valid: true},
+		EndAt:               nullableScheduleTime(request.EndAt),
+	}
+
+	res, err := r.db.UpdateScheduledTransfer(r.ctx, req)
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, scheduled_transfer_errors.ErrScheduledTransferStatusConflict
+		}
+		return nil, scheduled_transfer_errors.ErrUpdateScheduledTransferFailed
+	}
+
+	return r.mapping.ToScheduledTransferRecord(res), nil
+}
+
+func (r *scheduledTransferRepository) PauseScheduledTransfer(scheduled_transfer_id int) (*record.ScheduledTransferRecord, error) {
+	res, err := r.db.PauseScheduledTransfer(r.ctx, int32(scheduled_transfer_id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, scheduled_transfer_errors.ErrScheduledTransferStatusConflict
+		}
+		return nil, scheduled_transfer_errors.ErrPauseScheduledTransferFailed
+	}
+
+	return r.mapping.ToScheduledTransferRecord(res), nil
+}
+
+func (r *scheduledTransferRepository) ResumeScheduledTransfer(scheduled_transfer_id int, nextRunAt time.Time) (*record.ScheduledTransferRecord, error) {
+	res, err := r.db.ResumeScheduledTransfer(r.ctx, db.ResumeScheduledTransferParams{
+		ScheduledTransferID: int32(scheduled_transfer_id),
+		NextRunAt:           sql.NullTime{Time: nextRunAt, Valid: true},
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, scheduled_transfer_errors.ErrScheduledTransferStatusConflict
+		}
+		return nil, scheduled_transfer_errors.ErrResumeScheduledTransferFailed
+	}
+
+	return r.mapping.ToScheduledTransferRecord(res), nil
+}
+
+func (r *scheduledTransferRepository) CancelScheduledTransfer(scheduled_transfer_id int) (*record.ScheduledTransferRecord, error) {
+	res, err := r.db.CancelScheduledTransfer(r.ctx, int32(scheduled_transfer_id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, scheduled_transfer_errors.ErrScheduledTransferStatusConflict
+		}
+		return nil, scheduled_transfer_errors.ErrCancelScheduledTransferFailed
+	}
+
+	return r.mapping.ToScheduledTransferRecord(res), nil
+}
+
+// FailScheduledTransfer stops a schedule whose run failed. It returns
+// ErrScheduledTransferStatusConflict when the schedule was paused or
+// cancelled while the run was in flight, which leaves it as it is.
+func (r *scheduledTransferRepository) FailScheduledTransfer(scheduled_transfer_id int) (*record.ScheduledTransferRecord, error) {
+	res, err := r.db.FailScheduledTransfer(r.ctx, int32(scheduled_transfer_id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, scheduled_transfer_errors.ErrScheduledTransferStatusConflict
+		}
+		return nil, scheduled_transfer_errors.ErrFailScheduledTransferFailed
+	}
+
+	return r.mapping.ToScheduledTransferRecord(res), nil
+}
+
+// AdvanceScheduledTransfer moves the schedule to its next occurrence, or
+// completes it when nextRunAt is nil.
+func (r *scheduledTransferRepository) AdvanceScheduledTransfer(scheduled_transfer_id int, nextRunAt *time.Time, status string, lastRunAt time.Time) (*record.ScheduledTransferRecord, error) {
+	res, err := r.db.AdvanceScheduledTransfer(r.ctx, db.AdvanceScheduledTransferParams{
+		ScheduledTransferID: int32(scheduled_transfer_id),
+		NextRunAt:           nullableScheduleTime(nextRunAt),
+		Status:              status,
+		LastRunAt:           sql.NullTime{Time: lastRunAt, Valid: true},
+	})
+
+	if err != nil {
+		return nil, scheduled_transfer_errors.ErrAdvanceScheduledTransferFailed
+	}
+
+	return r.mapping.ToScheduledTransferRecord(res), nil
+}
+
+// ClaimRun records the start of the run due at scheduledFor. It returns
+// ErrScheduledTransferRunAlreadyClaimed when another executor claimed it
+// first, or the schedule is no longer active or due at that time.
+func (r *scheduledTransferRepository) ClaimRun(scheduled_transfer_id int, scheduledFor time.Time) (*record.ScheduledTransferRunRecord, error) {
+	res, err := r.db.ClaimScheduledTransferRun(r.ctx, db.ClaimScheduledTransferRunParams{
+		ScheduledTransferID: int32(scheduled_transfer_id),
+		ScheduledFor:        scheduledFor,
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, scheduled_transfer_errors.ErrScheduledTransferRunAlreadyClaimed
+		}
+		return nil, scheduled_transfer_errors.ErrClaimScheduledTransferRunFailed
+	}
+
+	return r.mapping.ToScheduledTransferRunRecord(res), nil
+}
+
+func (r *scheduledTransferRepository) FinishRun(scheduled_transfer_run_id int, status string, transfer_id *int, failureReason *string) (*record.ScheduledTransferRunRecord, error) {
+	reason := sql.NullString{}
+	if failureReason != nil {
+		reason = sql.NullString{String: *failureReason, Valid: true}
+	}
+
+	res, err := r.db.FinishScheduledTransferRun(r.ctx, db.FinishScheduledTransferRunParams{
+		ScheduledTransferRunID: int32(scheduled_transfer_run_id),
+		Status:                 status,
+		TransferID:             nullableScheduleInt(transfer_id),
+		FailureReason:          reason,
+	})
+
+	if err != nil {
+		return nil, scheduled_transfer_errors.ErrFinishScheduledTransferRunFailed
+	}
+
+	return r.mapping.ToScheduledTransferRunRecord(res), nil
+}
+
+func nullableScheduleInt(value *int) sql.NullInt32 {
+	if value == nil {
+		return sql.NullInt32{}
+	}
+
+	return sql.NullInt32{Int32: int32(*value), Valid: true}
+}
+
+func nullableScheduleTime(value *time.Time) sql.NullTime {
+	if value == nil {
+		return sql.NullTime{}
+	}
+
+	return sql.NullTime{Time: *value, Valid: true}
+}
@@ -0,0 +1,228 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/settlement_errors"
+)
+
+type settlementRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.SettlementRecordMapping
+}
+
+func NewSettlementRepository(db *db.Queries, ctx context.Context, mapping recordmapper.SettlementRecordMapping) *settlementRepository {
+	return &settlementRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+// AddPendingBalance adds amount to the pending balance of a merchant in a
+// currency and keeps the balance row locked until the transaction ends.
+func (r *settlementRepository) AddPendingBalance(merchant_id int, currency string, amount int) (*record.MerchantBalanceRecord, error) {
+	res, err := r.db.AddMerchantPendingBalance(r.ctx, db.AddMerchantPendingBalanceParams{
+		MerchantID:     int32(merchant_id),
+		Currency:       currency,
+		PendingBalance: int32(amount),
+	})
+
+	if err != nil {
+		return nil, settlement_errors.ErrAddMerchantPendingBalanceFailed
+	}
+
+	return r.mapping.ToMerchantBalanceRecord(res), nil
+}
+
+// DeductPendingBalance takes amount out of the pending balance of a merchant
+// in a currency, or fails with ErrInsufficientMerchantPendingBalance when the
+// balance does not cover it.
+func (r *settlementRepository) DeductPendingBalance(merchant_id int, currency string, amount int) (*record.MerchantBalanceRecord, error) {
+	res, err := r.db.DeductMerchantPendingBalance(r.ctx, db.DeductMerchantPendingBalanceParams{
+		MerchantID:     int32(merchant_id),
+		Currency:       currency,
+		PendingBalance: int32(amount),
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, settlement_errors.ErrInsufficientMerchantPendingBalance
+		}
+		return nil, settlement_errors.ErrDeductMerchantPendingBalanceFailed
+	}
+
+	return r.mapping.ToMerchantBalanceRecord(res), nil
+}
+
+func (r *settlementRepository) SetSettlementCard(merchant_id int, currency string, card_number string) (*record.MerchantBalanceRecord, error) {
+	res, err := r.db.SetMerchantSettlementCard(r.ctx, db.SetMerchantSettlementCardParams{
+		MerchantID:           int32(merchant_id),
+		Currency:             currency,
+		SettlementCardNumber: sql.NullString{String: card_number, Valid: true},
+	})
+
+	if err != nil {
+		return nil, settlement_errors.ErrSetMerchantSettlementCardFailed
+	}
+
+	return r.mapping.ToMerchantBalanceRecord(res), nil
+}
+
+func (r *settlementRepository) FindBalancesByMerchant(merchant_id int) ([]*record.MerchantBalanceRecord, error) {
+	res, err := r.db.GetMerchantBalances(r.ctx, int32(merchant_id))
+
+	if err != nil {
+		return nil, settlement_errors.ErrFindMerchantBalancesFailed
+	}
+
+	return r.mapping.ToMerchantBalancesRecord(res), nil
+}
+
+func (r *settlementRepository) FindBalanceForUpdate(merchant_id int, currency string) (*record.MerchantBalanceRecord, error) {
+	res, err := r.db.GetMerchantBalanceForUpdate(r.ctx, db.GetMerchantBalanceForUpdateParams{
+		MerchantID: int32(merchant_id),
+		Currency:   currency,
+	})
+
+	if err != nil {
+		return nil, settlement_errors.ErrFindMerchantBalanceFailed
+	}
+
+	return r.mapping.ToMerchantBalanceRecord(res), nil
+}
+
+func (r *settlementRepository) FindBalancesDue(cutoff time.Time) ([]*record.MerchantBalanceRecord, error) {
+	res, err := r.db.GetMerchantBalancesDueForSettlement(r.ctx, sql.NullTime{Time: cutoff, Valid: true})
+
+	if err != nil {
+		return nil, settlement_errors.ErrFindMerchantBalancesDueFailed
+	}
+
+	return r.mapping.ToMerchantBalancesRecord(res), nil
+}
+
+func (r *settlementRepository) CreateItem(request *requests.CreateSettlementItem) (*record.SettlementItemRecord, error) {
+	req := db.CreateSettlementItemParams{
+		MerchantID:  int32(request.MerchantID),
+		ItemType:    request.ItemType,
+		ReferenceID: int32(request.ReferenceID),
+		Amount:      int32(request.Amount),
+		Fee:         int32(request.Fee),
+		NetAmount:   int32(request.NetAmount),
+		Currency:    request.Currency,
+	}
+
+	res, err := r.db.CreateSettlementItem(r.ctx, req)
+
+	if err != nil {
+		return nil, settlement_errors.ErrCreateSettlementItemFailed
+	}
+
+	return r.mapping.ToSettlementItemRecord(res), nil
+}
+
+func (r *settlementRepository) FindUnsettledTotals(merchant_id int, currency string, cutoff time.Time) (*record.SettlementTotalsRecord, error) {
+	res, err := r.db.GetUnsettledSettlementTotals(r.ctx, db.GetUnsettledSettlementTotalsParams{
+		MerchantID: int32(merchant_id),
+		Currency:   currency,
+		CreatedAt:  sql.NullTime{Time: cutoff, Valid: true},
+	})
+
+	if err != nil {
+		return nil, settlement_errors.ErrFindUnsettledSettlementTotalsFailed
+	}
+
+	return r.mapping.ToSettlementTotalsRecord(res), nil
+}
+
+func (r *settlementRepository) CreateBatch(request *requests.CreateSettlementBatch) (*record.SettlementBatchRecord, error) {
+	req := db.CreateSettlementBatchParams{
+		MerchantID:           int32(request.MerchantID),
+		Currency:             request.Currency,
+		SettlementCardNumber: request.SettlementCardNumber,
+		CutoffAt:             request.CutoffAt,
+		GrossAmount:          int32(request.GrossAmount),
+		FeeAmount:            int32(request.FeeAmount),
+		RefundAmount:         int32(request.RefundAmount),
+		AdjustmentAmount:     int32(request.AdjustmentAmount),
+		NetAmount:            int32(request.NetAmount),
+		ItemCount:            int32(request.ItemCount),
+	}
+
+	res, err := r.db.CreateSettlementBatch(r.ctx, req)
+
+	if err != nil {
+		return nil, settlement_errors.ErrCreateSettlementBatchFailed
+	}
+
+	return r.mapping.ToSettlementBatchRecord(res), nil
+}
+
+func (r *settlementRepository) AssignItems(batch *record.SettlementBatchRecord, cutoff time.Time) error {
+	err := r.db.AssignSettlementItems(r.ctx, db.AssignSettlementItemsParams{
+		SettlementBatchID: sql.NullInt32{Int32: int32(batch.ID), Valid: true},
+		MerchantID:        int32(batch.MerchantID),
+		Currency:          batch.Currency,
+		CreatedAt:         sql.NullTime{Time: cutoff, Valid: true},
+	})
+
+	if err != nil {
+		return settlement_errors.ErrAssignSettlementItemsFailed
+	}
+
+	return nil
+}
+
+func (r *settlementRepository) FindBatchById(id int) (*record.SettlementBatchRecord, error) {
+	res, err := r.db.GetSettlementBatchByID(r.ctx, int32(id))
+
+	if err != nil {
+		return nil, settlement_errors.ErrFindSettlementBatchByIdFailed
+	}
+
+	return r.mapping.ToSettlementBatchRecord(res), nil
+}
+
+func (r *settlementRepository) FindBatches(req *requests.FindSettlementBatches) ([]*record.SettlementBatchRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetSettlementBatchesByMerchantParams{
+		MerchantID: int32(req.MerchantID),
+		Limit:      int32(req.PageSize),
+		Offset:     int32(offset),
+	}
+
+	batches, err := r.db.GetSettlementBatchesByMerchant(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, settlement_errors.ErrFindSettlementBatchesFailed
+	}
+
+	var totalCount int
+	if len(batches) > 0 {
+		totalCount = int(batches[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToSettlementBatchesRecordAll(batches), &totalCount, nil
+}
+
+func (r *settlementRepository) FindItemsByBatch(batch_id int) ([]*record.SettlementItemRecord, error) {
+	res, err := r.db.GetSettlementItemsByBatch(r.ctx, sql.NullInt32{Int32: int32(batch_id), Valid: true})
+
+	if err != nil {
+		return nil, settlement_errors.ErrFindSettlementItemsFailed
+	}
+
+	return r.mapping.ToSettlementItemsRecord(res), nil
+}
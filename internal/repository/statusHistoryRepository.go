@@ -0,0 +1,37 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/status_history_errors"
+)
+
+type statusHistoryRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.StatusHistoryRecordMapping
+}
+
+func NewStatusHistoryRepository(db *db.Queries, ctx context.Context, mapping recordmapper.StatusHistoryRecordMapping) *statusHistoryRepository {
+	return &statusHistoryRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *statusHistoryRepository) FindByEntity(entity_type string, entity_id int) ([]*record.StatusHistoryRecord, error) {
+	res, err := r.db.GetStatusHistory(r.ctx, db.GetStatusHistoryParams{
+		EntityType: entity_type,
+		EntityID:   int32(entity_id),
+	})
+
+	if err != nil {
+		return nil, status_history_errors.ErrFindStatusHistoryFailed
+	}
+
+	return r.mapping.ToStatusHistoriesRecord(res), nil
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
@@ -9,6 +11,7 @@ import (
 	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
 	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/topup_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/statemachine"
 )
 
 type topupRepository struct {
@@ -405,6 +408,8 @@ func (r *topupRepository) CreateTopup(request *requests.CreateTopupRequest) (*re
 		CardNumber:  request.CardNumber,
 		TopupAmount: int32(request.TopupAmount),
 		TopupMethod: request.TopupMethod,
+		TopupTime:   time.Now(),
+		Fee:         int32(request.Fee),
 	}
 
 	res, err := r.db.CreateTopup(r.ctx, req)
@@ -419,9 +424,9 @@ func (r *topupRepository) CreateTopup(request *requests.CreateTopupRequest) (*re
 func (r *topupRepository) UpdateTopup(request *requests.UpdateTopupRequest) (*record.TopupRecord, error) {
 	req := db.UpdateTopupParams{
 		TopupID:     int32(*request.TopupID),
-		CardNumber:  request.CardNumber,
 		TopupAmount: int32(request.TopupAmount),
 		TopupMethod: request.TopupMethod,
+		Fee:         int32(request.Fee),
 	}
 
 	res, err := r.db.UpdateTopup(r.ctx, req)
@@ -448,21 +453,94 @@ func (r *topupRepository) UpdateTopupAmount(request *requests.UpdateTopupAmount)
 	return r.mapping.ToTopupRecord(res), nil
 }
 
+// UpdateTopupStatus only applies transitions allowed by statemachine.Topup.
+// Setting the status a topup already has is a no-op and records no history.
 func (r *topupRepository) UpdateTopupStatus(request *requests.UpdateTopupStatus) (*record.TopupRecord, error) {
+	current, err := r.db.GetTopupByID(r.ctx, int32(request.TopupID))
+
+	if err != nil {
+		return nil, topup_errors.ErrUpdateTopupStatusFailed
+	}
+
+	if current.Status == request.Status {
+		return r.mapping.ToTopupRecord(current), nil
+	}
+
+	if err := statemachine.Topup.Transition(current.Status, request.Status); err != nil {
+		return nil, topup_errors.ErrTopupStatusConflict
+	}
+
 	req := db.UpdateTopupStatusParams{
-		TopupID: int32(request.TopupID),
-		Status:  request.Status,
+		TopupID:    int32(request.TopupID),
+		Status:     request.Status,
+		FromStatus: current.Status,
 	}
 
 	res, err := r.db.UpdateTopupStatus(r.ctx, req)
 
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, topup_errors.ErrTopupStatusConflict
+		}
 		return nil, topup_errors.ErrUpdateTopupStatusFailed
 	}
 
 	return r.mapping.ToTopupRecord(res), nil
 }
 
+func (r *topupRepository) CreateProviderPayment(request *requests.CreateTopupProviderPayment) (*record.TopupProviderPaymentRecord, error) {
+	req := db.CreateTopupProviderPaymentParams{
+		TopupID:           int32(request.TopupID),
+		Provider:          request.Provider,
+		ProviderReference: request.ProviderReference,
+		LastMessage:       nullableProviderMessage(request.Message),
+	}
+
+	res, err := r.db.CreateTopupProviderPayment(r.ctx, req)
+
+	if err != nil {
+		return nil, topup_errors.ErrCreateTopupProviderPaymentFailed
+	}
+
+	return r.mapping.ToTopupProviderPaymentRecord(res), nil
+}
+
+func (r *topupRepository) FindProviderPaymentByReference(provider string, reference string) (*record.TopupProviderPaymentRecord, error) {
+	res, err := r.db.GetTopupProviderPaymentByReference(r.ctx, db.GetTopupProviderPaymentByReferenceParams{
+		Provider:          provider,
+		ProviderReference: reference,
+	})
+
+	if err != nil {
+		return nil, topup_errors.ErrFindTopupProviderPaymentFailed
+	}
+
+	return r.mapping.ToTopupProviderPaymentRecord(res), nil
+}
+
+func (r *topupRepository) FindPendingProviderPayments(limit int) ([]*record.TopupProviderPaymentRecord, error) {
+	res, err := r.db.GetPendingTopupProviderPayments(r.ctx, int32(limit))
+
+	if err != nil {
+		return nil, topup_errors.ErrFindPendingTopupProviderPaymentsFailed
+	}
+
+	return r.mapping.ToTopupProviderPaymentRecords(res), nil
+}
+
+func (r *topupRepository) TouchProviderPayment(payment_id int, message *string) (*record.TopupProviderPaymentRecord, error) {
+	res, err := r.db.TouchTopupProviderPayment(r.ctx, db.TouchTopupProviderPaymentParams{
+		TopupProviderPaymentID: int32(payment_id),
+		LastMessage:            nullableProviderMessage(message),
+	})
+
+	if err != nil {
+		return nil, topup_errors.ErrUpdateTopupProviderPaymentFailed
+	}
+
+	return r.mapping.ToTopupProviderPaymentRecord(res), nil
+}
+
 func (r *topupRepository) TrashedTopup(topup_id int) (*record.TopupRecord, error) {
 	res, err := r.db.TrashTopup(r.ctx, int32(topup_id))
 	if err != nil {
@@ -506,3 +584,11 @@ func (r *topupRepository) DeleteAllTopupPermanent() (bool, error) {
 
 	return true, nil
 }
+
+func nullableProviderMessage(value *string) sql.NullString {
+	if value == nil || *value == "" {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: *value, Valid: true}
+}
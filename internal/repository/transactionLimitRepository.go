@@ -0,0 +1,164 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transaction_limit_errors"
+)
+
+type transactionLimitRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.TransactionLimitRecordMapping
+}
+
+func NewTransactionLimitRepository(db *db.Queries, ctx context.Context, mapping recordmapper.TransactionLimitRecordMapping) *transactionLimitRepository {
+	return &transactionLimitRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *transactionLimitRepository) FindAll(req *requests.FindAllTransactionLimits) ([]*record.TransactionLimitRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetTransactionLimitsParams{
+		Column1: req.Search,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+	}
+
+	limits, err := r.db.GetTransactionLimits(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, transaction_limit_errors.ErrFindAllTransactionLimitsFailed
+	}
+
+	var totalCount int
+	if len(limits) > 0 {
+		totalCount = int(limits[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToTransactionLimitsRecordAll(limits), &totalCount, nil
+}
+
+func (r *transactionLimitRepository) FindById(transaction_limit_id int) (*record.TransactionLimitRecord, error) {
+	res, err := r.db.GetTransactionLimitByID(r.ctx, int32(transaction_limit_id))
+
+	if err != nil {
+		return nil, transaction_limit_errors.ErrFindTransactionLimitByIdFailed
+	}
+
+	return r.mapping.ToTransactionLimitRecord(res), nil
+}
+
+// FindApplicable returns the limits enforced on an operation of the card, at
+// most one per period. An empty result means the card is not limited.
+func (r *transactionLimitRepository) FindApplicable(operation_type string, card_number string) ([]*record.TransactionLimitRecord, error) {
+	res, err := r.db.GetApplicableTransactionLimits(r.ctx, db.GetApplicableTransactionLimitsParams{
+		OperationType: operation_type,
+		CardNumber:    card_number,
+	})
+
+	if err != nil {
+		return nil, transaction_limit_errors.ErrFindApplicableTransactionLimitsFailed
+	}
+
+	return r.mapping.ToTransactionLimitsRecord(res), nil
+}
+
+func (r *transactionLimitRepository) FindUsage(operation_type string, card_number string, since time.Time) (*record.TransactionLimitUsageRecord, error) {
+	res, err := r.db.GetCardUsageSince(r.ctx, db.GetCardUsageSinceParams{
+		OperationType: operation_type,
+		CardNumber:    card_number,
+		Since:         since,
+	})
+
+	if err != nil {
+		return nil, transaction_limit_errors.ErrFindCardUsageFailed
+	}
+
+	return r.mapping.ToTransactionLimitUsageRecord(res), nil
+}
+
+func (r *transactionLimitRepository) CreateTransactionLimit(request *requests.CreateTransactionLimitRequest) (*record.TransactionLimitRecord, error) {
+	req := db.CreateTransactionLimitParams{
+		Name:                    request.Name,
+		OperationType:           request.OperationType,
+		Period:                  request.Period,
+		CardType:                nullableLimitFilter(request.CardType),
+		UserTier:                nullableLimitFilter(request.UserTier),
+		Currency:                nullableLimitFilter(request.Currency),
+		MaxAmountPerTransaction: nullableLimitCap(request.MaxAmountPerTransaction),
+		MaxCount:                nullableLimitCap(request.MaxCount),
+		MaxTotalAmount:          nullableLimitCap(request.MaxTotalAmount),
+		IsActive:                request.IsActive,
+	}
+
+	res, err := r.db.CreateTransactionLimit(r.ctx, req)
+
+	if err != nil {
+		return nil, transaction_limit_errors.ErrCreateTransactionLimitFailed
+	}
+
+	return r.mapping.ToTransactionLimitRecord(res), nil
+}
+
+func (r *transactionLimitRepository) UpdateTransactionLimit(request *requests.UpdateTransactionLimitRequest) (*record.TransactionLimitRecord, error) {
+	req := db.UpdateTransactionLimitParams{
+		TransactionLimitID:      int32(*request.TransactionLimitID),
+		Name:                    request.Name,
+		OperationType:           request.OperationType,
+		Period:                  request.Period,
+		CardType:                nullableLimitFilter(request.CardType),
+		UserTier:                nullableLimitFilter(request.UserTier),
+		Currency:                nullableLimitFilter(request.Currency),
+		MaxAmountPerTransaction: nullableLimitCap(request.MaxAmountPerTransaction),
+		MaxCount:                nullableLimitCap(request.MaxCount),
+		MaxTotalAmount:          nullableLimitCap(request.MaxTotalAmount),
+		IsActive:                request.IsActive,
+	}
+
+	res, err := r.db.UpdateTransactionLimit(r.ctx, req)
+
+	if err != nil {
+		return nil, transaction_limit_errors.ErrUpdateTransactionLimitFailed
+	}
+
+	return r.mapping.ToTransactionLimitRecord(res), nil
+}
+
+func (r *transactionLimitRepository) DeleteTransactionLimit(transaction_limit_id int) (*record.TransactionLimitRecord, error) {
+	res, err := r.db.DeleteTransactionLimit(r.ctx, int32(transaction_limit_id))
+
+	if err != nil {
+		return nil, transaction_limit_errors.ErrDeleteTransactionLimitFailed
+	}
+
+	return r.mapping.ToTransactionLimitRecord(res), nil
+}
+
+func nullableLimitFilter(value *string) sql.NullString {
+	if value == nil {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: *value, Valid: true}
+}
+
+func nullableLimitCap(value *int) sql.NullInt32 {
+	if value == nil {
+		return sql.NullInt32{}
+	}
+
+	return sql.NullInt32{Int32: int32(*value), Valid: true}
+}
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
@@ -9,6 +11,7 @@ import (
 	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
 	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transaction_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/statemachine"
 )
 
 type transactionRepository struct {
@@ -407,6 +410,7 @@ func (r *transactionRepository) CreateTransaction(request *requests.CreateTransa
 		PaymentMethod:   request.PaymentMethod,
 		MerchantID:      int32(*request.MerchantID),
 		TransactionTime: request.TransactionTime,
+		Fee:             int32(request.Fee),
 	}
 
 	res, err := r.db.CreateTransaction(r.ctx, req)
@@ -426,6 +430,7 @@ func (r *transactionRepository) UpdateTransaction(request *requests.UpdateTransa
 		PaymentMethod:   request.PaymentMethod,
 		MerchantID:      int32(*request.MerchantID),
 		TransactionTime: request.TransactionTime,
+		Fee:             int32(request.Fee),
 	}
 
 	res, err := r.db.UpdateTransaction(r.ctx, req)
@@ -437,21 +442,99 @@ func (r *transactionRepository) UpdateTransaction(request *requests.UpdateTransa
 	return r.mapping.ToTransactionRecord(res), nil
 }
 
+// UpdateTransactionStatus only applies transitions allowed by statemachine.Transaction.
+// Setting the status a transaction already has is a no-op and records no history.
 func (r *transactionRepository) UpdateTransactionStatus(request *requests.UpdateTransactionStatus) (*record.TransactionRecord, error) {
+	current, err := r.db.GetTransactionByID(r.ctx, int32(request.TransactionID))
+
+	if err != nil {
+		return nil, transaction_errors.ErrUpdateTransactionStatusFailed
+	}
+
+	if current.Status == request.Status {
+		return r.mapping.ToTransactionRecord(current), nil
+	}
+
+	if err := statemachine.Transaction.Transition(current.Status, request.Status); err != nil {
+		return nil, transaction_errors.ErrTransactionStatusConflict
+	}
+
 	req := db.UpdateTransactionStatusParams{
 		TransactionID: int32(request.TransactionID),
 		Status:        request.Status,
+		FromStatus:    current.Status,
 	}
 
 	res, err := r.db.UpdateTransactionStatus(r.ctx, req)
 
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, transaction_errors.ErrTransactionStatusConflict
+		}
 		return nil, transaction_errors.ErrUpdateTransactionStatusFailed
 	}
 
 	return r.mapping.ToTransactionRecord(res), nil
 }
 
+func (r *transactionRepository) ApplyRefund(transaction_id int, amount int) (*record.TransactionRecord, error) {
+	res, err := r.db.ApplyTransactionRefund(r.ctx, db.ApplyTransactionRefundParams{
+		Amount:        int32(amount),
+		TransactionID: int32(transaction_id),
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, transaction_errors.ErrTransactionNotRefundable
+		}
+		return nil, transaction_errors.ErrApplyTransactionRefundFailed
+	}
+
+	return r.mapping.ToTransactionRecord(res), nil
+}
+
+func (r *transactionRepository) MarkDisputed(transaction_id int) (*record.TransactionRecord, error) {
+	res, err := r.db.MarkTransactionDisputed(r.ctx, int32(transaction_id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, transaction_errors.ErrTransactionNotDisputable
+		}
+		return nil, transaction_errors.ErrMarkTransactionDisputedFailed
+	}
+
+	return r.mapping.ToTransactionRecord(res), nil
+}
+
+func (r *transactionRepository) ReleaseDispute(transaction_id int) (*record.TransactionRecord, error) {
+	res, err := r.db.ReleaseTransactionDispute(r.ctx, int32(transaction_id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, transaction_errors.ErrTransactionNotDisputable
+		}
+		return nil, transaction_errors.ErrReleaseTransactionDispute
+	}
+
+	return r.mapping.ToTransactionRecord(res), nil
+}
+
+func (r *transactionRepository) ChargeBack(transaction_id int, amount int) (*record.TransactionRecord, error) {
+	res, err := r.db.ChargeBackTransaction(r.ctx, db.ChargeBackTransactionParams{
+		Amount:        int32(amount),
+		TransactionID: int32(transaction_id),
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, transaction_errors.ErrTransactionNotDisputable
+		}
+		return nil, transaction_errors.ErrChargeBackTransactionFailed
+	}
+
+	return r.mapping.ToTransactionRecord(res), nil
+}
+
 func (r *transactionRepository) TrashedTransaction(transaction_id int) (*record.TransactionRecord, error) {
 	res, err := r.db.TrashTransaction(r.ctx, int32(transaction_id))
 	if err != nil {
@@ -0,0 +1,239 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transfer_batch_errors"
+)
+
+type transferBatchRepository struct {
+	db      *db.Queries
+	ctx     context.Context
+	mapping recordmapper.TransferBatchRecordMapping
+}
+
+func NewTransferBatchRepository(db *db.Queries, ctx context.Context, mapping recordmapper.TransferBatchRecordMapping) *transferBatchRepository {
+	return &transferBatchRepository{
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+func (r *transferBatchRepository) FindAll(req *requests.FindAllTransferBatches) ([]*record.TransferBatchRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	reqDb := db.GetTransferBatchesParams{
+		Column1: req.Search,
+		Limit:   int32(req.PageSize),
+		Offset:  int32(offset),
+		UserID:  nullableScheduleInt(req.RequestedBy),
+	}
+
+	batches, err := r.db.GetTransferBatches(r.ctx, reqDb)
+
+	if err != nil {
+		return nil, nil, transfer_batch_errors.ErrFindAllTransferBatchesFailed
+	}
+
+	var totalCount int
+	if len(batches) > 0 {
+		totalCount = int(batches[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToTransferBatchesRecordAll(batches), &totalCount, nil
+}
+
+func (r *transferBatchRepository) FindById(transfer_batch_id int) (*record.TransferBatchRecord, error) {
+	res, err := r.db.GetTransferBatchByID(r.ctx, int32(transfer_batch_id))
+
+	if err != nil {
+		return nil, transfer_batch_errors.ErrFindTransferBatchByIdFailed
+	}
+
+	return r.mapping.ToTransferBatchRecord(res), nil
+}
+
+func (r *transferBatchRepository) FindRunnable(limit int) ([]*record.TransferBatchRecord, error) {
+	res, err := r.db.GetRunnableTransferBatches(r.ctx, int32(limit))
+
+	if err != nil {
+		return nil, transfer_batch_errors.ErrFindRunnableTransferBatchesFailed
+	}
+
+	return r.mapping.ToTransferBatchesRecord(res), nil
+}
+
+func (r *transferBatchRepository) FindRows(req *requests.FindTransferBatchRows) ([]*record.TransferBatchRowRecord, *int, error) {
+	offset := (req.Page - 1) * req.PageSize
+
+	rows, err := r.db.GetTransferBatchRows(r.ctx, db.GetTransferBatchRowsParams{
+		TransferBatchID: int32(req.TransferBatchID),
+		Limit:           int32(req.PageSize),
+		Offset:          int32(offset),
+	})
+
+	if err != nil {
+		return nil, nil, transfer_batch_errors.ErrFindTransferBatchRowsFailed
+	}
+
+	var totalCount int
+	if len(rows) > 0 {
+		totalCount = int(rows[0].TotalCount)
+	} else {
+		totalCount = 0
+	}
+
+	return r.mapping.ToTransferBatchRowsRecordAll(rows), &totalCount, nil
+}
+
+func (r *transferBatchRepository) FindReportRows(transfer_batch_id int) ([]*record.TransferBatchRowRecord, error) {
+	res, err := r.db.GetTransferBatchReportRows(r.ctx, int32(transfer_batch_id))
+
+	if err != nil {
+		return nil, transfer_batch_errors.ErrFindTransferBatchRowsFailed
+	}
+
+	return r.mapping.ToTransferBatchRowsRecord(res), nil
+}
+
+func (r *transferBatchRepository) FindPendingRows(transfer_batch_id int, limit int) ([]*record.TransferBatchRowRecord, error) {
+	res, err := r.db.GetPendingTransferBatchRows(r.ctx, db.GetPendingTransferBatchRowsParams{
+		TransferBatchID: int32(transfer_batch_id),
+		RowLimit:        int32(limit),
+	})
+
+	if err != nil {
+		return nil, transfer_batch_errors.ErrFindPendingTransferBatchRowsFailed
+	}
+
+	return r.mapping.ToTransferBatchRowsRecord(res), nil
+}
+
+func (r *transferBatchRepository) CreateTransferBatch(request *requests.CreateTransferBatch) (*record.TransferBatchRecord, error) {
+	res, err := r.db.CreateTransferBatch(r.ctx, db.CreateTransferBatchParams{
+		UserID:       int32(request.UserID),
+		TransferFrom: request.TransferFrom,
+		FileFormat:   request.FileFormat,
+		Status:       request.Status,
+		TotalRows:    int32(request.TotalRows),
+		TotalAmount:  int32(request.TotalAmount),
+		InvalidRows:  int32(request.InvalidRows),
+	})
+
+	if err != nil {
+		return nil, transfer_batch_errors.ErrCreateTransferBatchFailed
+	}
+
+	return r.mapping.ToTransferBatchRecord(res), nil
+}
+
+func (r *transferBatchRepository) CreateTransferBatchRow(transfer_batch_id int, status string, row *requests.TransferBatchRowInput) (*record.TransferBatchRowRecord, error) {
+	res, err := r.db.CreateTransferBatchRow(r.ctx, db.CreateTransferBatchRowParams{
+		TransferBatchID: int32(transfer_batch_id),
+		RowNumber:       int32(row.RowNumber),
+		TransferTo:      row.TransferTo,
+		TransferAmount:  int32(row.TransferAmount),
+		Note:            nullableBatchString(row.Note),
+		Status:          status,
+		FailureReason:   nullableBatchString(row.FailureReason),
+	})
+
+	if err != nil {
+		return nil, transfer_batch_errors.ErrCreateTransferBatchRowFailed
+	}
+
+	return r.mapping.ToTransferBatchRowRecord(res), nil
+}
+
+// StartTransferBatch marks a pending batch as processing. It returns
+// ErrTransferBatchStatusConflict when the batch already finished.
+func (r *transferBatchRepository) StartTransferBatch(transfer_batch_id int) (*record.TransferBatchRecord, error) {
+	res, err := r.db.StartTransferBatch(r.ctx, int32(transfer_batch_id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, transfer_batch_errors.ErrTransferBatchStatusConflict
+		}
+		return nil, transfer_batch_errors.ErrStartTransferBatchFailed
+	}
+
+	return r.mapping.ToTransferBatchRecord(res), nil
+}
+
+func (r *transferBatchRepository) CompleteTransferBatch(transfer_batch_id int, status string) (*record.TransferBatchRecord, error) {
+	res, err := r.db.CompleteTransferBatch(r.ctx, db.CompleteTransferBatchParams{
+		TransferBatchID: int32(transfer_batch_id),
+		Status:          status,
+	})
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, transfer_batch_errors.ErrTransferBatchStatusConflict
+		}
+		return nil, transfer_batch_errors.ErrCompleteTransferBatchFailed
+	}
+
+	return r.mapping.ToTransferBatchRecord(res), nil
+}
+
+func (r *transferBatchRepository) AddTransferBatchResult(transfer_batch_id int, successRows int, failedRows int, successAmount int) (*record.TransferBatchRecord, error) {
+	res, err := r.db.AddTransferBatchResult(r.ctx, db.AddTransferBatchResultParams{
+		SuccessRows:     int32(successRows),
+		FailedRows:      int32(failedRows),
+		SuccessAmount:   int32(successAmount),
+		TransferBatchID: int32(transfer_batch_id),
+	})
+
+	if err != nil {
+		return nil, transfer_batch_errors.ErrAddTransferBatchResultFailed
+	}
+
+	return r.mapping.ToTransferBatchRecord(res), nil
+}
+
+// ClaimRow marks a pending row as processing. It returns
+// ErrTransferBatchRowAlreadyClaimed when another executor claimed it first.
+func (r *transferBatchRepository) ClaimRow(transfer_batch_row_id int) (*record.TransferBatchRowRecord, error) {
+	res, err := r.db.ClaimTransferBatchRow(r.ctx, int32(transfer_batch_row_id))
+
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, transfer_batch_errors.ErrTransferBatchRowAlreadyClaimed
+		}
+		return nil, transfer_batch_errors.ErrClaimTransferBatchRowFailed
+	}
+
+	return r.mapping.ToTransferBatchRowRecord(res), nil
+}
+
+func (r *transferBatchRepository) FinishRow(transfer_batch_row_id int, status string, transfer_id *int, failureReason *string) (*record.TransferBatchRowRecord, error) {
+	res, err := r.db.FinishTransferBatchRow(r.ctx, db.FinishTransferBatchRowParams{
+		TransferBatchRowID: int32(transfer_batch_row_id),
+		Status:             status,
+		TransferID:         nullableScheduleInt(transfer_id),
+		FailureReason:      nullableBatchString(failureReason),
+	})
+
+	if err != nil {
+		return nil, transfer_batch_errors.ErrFinishTransferBatchRowFailed
+	}
+
+	return r.mapping.ToTransferBatchRowRecord(res), nil
+}
+
+func nullableBatchString(value *string) sql.NullString {
+	if value == nil {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: *value, Valid: true}
+}
@@ -2,6 +2,9 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"strconv"
 	"time"
 
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
@@ -9,6 +12,7 @@ import (
 	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
 	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transfer_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/statemachine"
 )
 
 type transferRepository struct {
@@ -351,9 +355,19 @@ func (r *transferRepository) FindTransferByTransferTo(transfer_to string) ([]*re
 
 func (r *transferRepository) CreateTransfer(request *requests.CreateTransferRequest) (*record.TransferRecord, error) {
 	req := db.CreateTransferParams{
-		TransferFrom:   request.TransferFrom,
-		TransferTo:     request.TransferTo,
-		TransferAmount: int32(request.TransferAmount),
+		TransferFrom:    request.TransferFrom,
+		TransferTo:      request.TransferTo,
+		TransferAmount:  int32(request.TransferAmount),
+		ConvertedAmount: int32(request.TransferAmount),
+		TransferTime:    time.Now(),
+		Status:          "pending",
+		Fee:             int32(request.Fee),
+	}
+
+	if request.Conversion != nil {
+		req.ConvertedAmount = int32(request.Conversion.ConvertedAmount)
+		req.ExchangeRate = sql.NullString{String: strconv.FormatFloat(request.Conversion.ExchangeRate, 'f', -1, 64), Valid: true}
+		req.SpreadBps = sql.NullInt32{Int32: int32(request.Conversion.SpreadBps), Valid: true}
 	}
 
 	res, err := r.db.CreateTransfer(r.ctx, req)
@@ -368,9 +382,8 @@ func (r *transferRepository) CreateTransfer(request *requests.CreateTransferRequ
 func (r *transferRepository) UpdateTransfer(request *requests.UpdateTransferRequest) (*record.TransferRecord, error) {
 	req := db.UpdateTransferParams{
 		TransferID:     int32(*request.TransferID),
-		TransferFrom:   request.TransferFrom,
-		TransferTo:     request.TransferTo,
 		TransferAmount: int32(request.TransferAmount),
+		Fee:            int32(request.Fee),
 	}
 
 	res, err := r.db.UpdateTransfer(r.ctx, req)
@@ -398,15 +411,35 @@ func (r *transferRepository) UpdateTransferAmount(request *requests.UpdateTransf
 	return r.mapping.ToTransferRecord(res), nil
 }
 
+// UpdateTransferStatus only applies transitions allowed by statemachine.Transfer.
+// Setting the status a transfer already has is a no-op and records no history.
 func (r *transferRepository) UpdateTransferStatus(request *requests.UpdateTransferStatus) (*record.TransferRecord, error) {
+	current, err := r.db.GetTransferByID(r.ctx, int32(request.TransferID))
+
+	if err != nil {
+		return nil, transfer_errors.ErrUpdateTransferStatusFailed
+	}
+
+	if current.Status == request.Status {
+		return r.mapping.ToTransferRecord(current), nil
+	}
+
+	if err := statemachine.Transfer.Transition(current.Status, request.Status); err != nil {
+		return nil, transfer_errors.ErrTransferStatusConflict
+	}
+
 	req := db.UpdateTransferStatusParams{
 		TransferID: int32(request.TransferID),
 		Status:     request.Status,
+		FromStatus: current.Status,
 	}
 
 	res, err := r.db.UpdateTransferStatus(r.ctx, req)
 
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, transfer_errors.ErrTransferStatusConflict
+		}
 		return nil, transfer_errors.ErrUpdateTransferStatusFailed
 	}
 
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/unit_of_work_errors"
+)
+
+type unitOfWork struct {
+	conn    *sql.DB
+	db      *db.Queries
+	ctx     context.Context
+	mapping *recordmapper.RecordMapper
+}
+
+func NewUnitOfWork(conn *sql.DB, db *db.Queries, ctx context.Context, mapping *recordmapper.RecordMapper) *unitOfWork {
+	return &unitOfWork{
+		conn:    conn,
+		db:      db,
+		ctx:     ctx,
+		mapping: mapping,
+	}
+}
+
+// WithinTransaction begins a database transaction and hands fn a set of
+// repositories bound to it. The transaction is committed when fn returns
+// nil and rolled back otherwise; the error returned by fn is passed through
+// unchanged so callers can inspect it.
+func (u *unitOfWork) WithinTransaction(fn func(repos *Repositories) error) error {
+	tx, err := u.conn.BeginTx(u.ctx, nil)
+	if err != nil {
+		return errors.Join(unit_of_work_errors.ErrBeginTransactionFailed, err)
+	}
+
+	defer func() {
+		if p := recover(); p != nil {
+			_ = tx.Rollback()
+			panic(p)
+		}
+	}()
+
+	repos := NewRepositories(Deps{
+		DB:           u.db.WithTx(tx),
+		Ctx:          u.ctx,
+		MapperRecord: u.mapping,
+	})
+
+	if err := fn(repos); err != nil {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Join(err, unit_of_work_errors.ErrRollbackTransactionFailed, rbErr)
+		}
+
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return errors.Join(unit_of_work_errors.ErrCommitTransactionFailed, err)
+	}
+
+	return nil
+}
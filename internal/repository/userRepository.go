@@ -164,6 +164,19 @@ func (r *userRepository) UpdateUser(request *requests.UpdateUserRequest) (*recor
 	return r.mapping.ToUserRecord(res), nil
 }
 
+func (r *userRepository) UpdateUserTier(request *requests.UpdateUserTierRequest) (*record.UserRecord, error) {
+	res, err := r.db.UpdateUserTier(r.ctx, db.UpdateUserTierParams{
+		UserID: int32(request.UserID),
+		Tier:   request.Tier,
+	})
+
+	if err != nil {
+		return nil, user_errors.ErrUpdateUserTier
+	}
+
+	return r.mapping.ToUserRecord(res), nil
+}
+
 func (r *userRepository) TrashedUser(user_id int) (*record.UserRecord, error) {
 	res, err := r.db.TrashUser(r.ctx, int32(user_id))
 
@@ -2,6 +2,8 @@ package repository
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
@@ -9,6 +11,8 @@ import (
 	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
 	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/withdraw_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/statemachine"
+	"github.com/google/uuid"
 )
 
 type withdrawRepository struct {
@@ -137,6 +141,40 @@ func (r *withdrawRepository) FindById(id int) (*record.WithdrawRecord, error) {
 	return r.mapping.ToWithdrawRecord(withdraw), nil
 }
 
+func (r *withdrawRepository) FindByWithdrawNo(withdraw_no string) (*record.WithdrawRecord, error) {
+	withdrawNo, err := uuid.Parse(withdraw_no)
+
+	if err != nil {
+		return nil, withdraw_errors.ErrFindWithdrawByNoFailed
+	}
+
+	withdraw, err := r.db.GetWithdrawByNo(r.ctx, withdrawNo)
+
+	if err != nil {
+		return nil, withdraw_errors.ErrFindWithdrawByNoFailed
+	}
+
+	return r.mapping.ToWithdrawRecord(withdraw), nil
+}
+
+// FindByWithdrawNoForUpdate locks a withdraw until the surrounding
+// transaction ends, so two deliveries of a payout callback apply one at a time.
+func (r *withdrawRepository) FindByWithdrawNoForUpdate(withdraw_no string) (*record.WithdrawRecord, error) {
+	withdrawNo, err := uuid.Parse(withdraw_no)
+
+	if err != nil {
+		return nil, withdraw_errors.ErrFindWithdrawByNoFailed
+	}
+
+	withdraw, err := r.db.GetWithdrawByNoForUpdate(r.ctx, withdrawNo)
+
+	if err != nil {
+		return nil, withdraw_errors.ErrFindWithdrawByNoFailed
+	}
+
+	return r.mapping.ToWithdrawRecord(withdraw), nil
+}
+
 func (r *withdrawRepository) GetMonthWithdrawStatusSuccess(req *requests.MonthStatusWithdraw) ([]*record.WithdrawRecordMonthStatusSuccess, error) {
 	year := req.Year
 	month := req.Month
@@ -346,6 +384,8 @@ func (r *withdrawRepository) CreateWithdraw(request *requests.CreateWithdrawRequ
 		CardNumber:     request.CardNumber,
 		WithdrawAmount: int32(request.WithdrawAmount),
 		WithdrawTime:   request.WithdrawTime,
+		Fee:            int32(request.Fee),
+		PayoutProvider: request.PayoutProvider,
 	}
 
 	res, err := r.db.CreateWithdraw(r.ctx, req)
@@ -360,9 +400,9 @@ func (r *withdrawRepository) CreateWithdraw(request *requests.CreateWithdrawRequ
 func (r *withdrawRepository) UpdateWithdraw(request *requests.UpdateWithdrawRequest) (*record.WithdrawRecord, error) {
 	req := db.UpdateWithdrawParams{
 		WithdrawID:     int32(*request.WithdrawID),
-		CardNumber:     request.CardNumber,
 		WithdrawAmount: int32(request.WithdrawAmount),
 		WithdrawTime:   request.WithdrawTime,
+		Fee:            int32(request.Fee),
 	}
 
 	res, err := r.db.UpdateWithdraw(r.ctx, req)
@@ -374,21 +414,78 @@ func (r *withdrawRepository) UpdateWithdraw(request *requests.UpdateWithdrawRequ
 	return r.mapping.ToWithdrawRecord(res), nil
 }
 
+// UpdateWithdrawStatus only applies transitions allowed by statemachine.Withdraw.
+// Setting the status a withdraw already has is a no-op and records no history.
 func (r *withdrawRepository) UpdateWithdrawStatus(request *requests.UpdateWithdrawStatus) (*record.WithdrawRecord, error) {
+	current, err := r.db.GetWithdrawByID(r.ctx, int32(request.WithdrawID))
+
+	if err != nil {
+		return nil, withdraw_errors.ErrUpdateWithdrawStatusFailed
+	}
+
+	if current.Status == request.Status {
+		return r.mapping.ToWithdrawRecord(current), nil
+	}
+
+	if err := statemachine.Withdraw.Transition(current.Status, request.Status); err != nil {
+		return nil, withdraw_errors.ErrWithdrawStatusConflict
+	}
+
 	req := db.UpdateWithdrawStatusParams{
 		WithdrawID: int32(request.WithdrawID),
 		Status:     request.Status,
+		FromStatus: current.Status,
 	}
 
 	res, err := r.db.UpdateWithdrawStatus(r.ctx, req)
 
 	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, withdraw_errors.ErrWithdrawStatusConflict
+		}
 		return nil, withdraw_errors.ErrUpdateWithdrawStatusFailed
 	}
 
 	return r.mapping.ToWithdrawRecord(res), nil
 }
 
+func (r *withdrawRepository) FindSettleable(limit int) ([]*record.WithdrawRecord, error) {
+	res, err := r.db.GetSettleableWithdraws(r.ctx, int32(limit))
+
+	if err != nil {
+		return nil, withdraw_errors.ErrFindSettleableWithdrawsFailed
+	}
+
+	return r.mapping.ToWithdrawsRecord(res), nil
+}
+
+func (r *withdrawRepository) CreateReview(request *requests.ReviewWithdrawRequest, decision string) (*record.WithdrawReviewRecord, error) {
+	req := db.CreateWithdrawReviewParams{
+		WithdrawID: int32(request.WithdrawID),
+		Decision:   decision,
+		Note:       nullableReviewNote(request.Note),
+		ReviewedBy: int32(request.ReviewedBy),
+	}
+
+	res, err := r.db.CreateWithdrawReview(r.ctx, req)
+
+	if err != nil {
+		return nil, withdraw_errors.ErrCreateWithdrawReviewFailed
+	}
+
+	return r.mapping.ToWithdrawReviewRecord(res), nil
+}
+
+func (r *withdrawRepository) FindReview(withdraw_id int) (*record.WithdrawReviewRecord, error) {
+	res, err := r.db.GetWithdrawReviewByWithdrawID(r.ctx, int32(withdraw_id))
+
+	if err != nil {
+		return nil, withdraw_errors.ErrFindWithdrawReviewFailed
+	}
+
+	return r.mapping.ToWithdrawReviewRecord(res), nil
+}
+
 func (r *withdrawRepository) TrashedWithdraw(withdraw_id int) (*record.WithdrawRecord, error) {
 	res, err := r.db.TrashWithdraw(r.ctx, int32(withdraw_id))
 
@@ -438,3 +535,11 @@ func (r *withdrawRepository) DeleteAllWithdrawPermanent() (bool, error) {
 
 	return true, nil
 }
+
+func nullableReviewNote(value *string) sql.NullString {
+	if value == nil {
+		return sql.NullString{}
+	}
+
+	return sql.NullString{String: *value, Valid: true}
+}
@@ -0,0 +1,473 @@
+package service
+
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/authorization_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/fee_schedule_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/ledger_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/settlement_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transaction_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// authorizationExpiryBatchSize caps how many stale authorizations a single
+// ExpireStale call lapses, keeping the sweeping transaction short.
+const authorizationExpiryBatchSize = 100
+
+type authorizationService struct {
+	authorizationRepository  repository.AuthorizationRepository
+	merchantRepository       repository.MerchantRepository
+	merchantApiKeyRepository repository.MerchantApiKeyRepository
+	cardRepository           repository.CardRepository
+	feeScheduleRepository    repository.FeeScheduleRepository
+	unitOfWork               repository.UnitOfWork
+	ttl                      time.Duration
+	logger                   logger.LoggerInterface
+	mapping                  responseservice.AuthorizationResponseMapper
+	transactionMapping       responseservice.TransactionResponseMapper
+}
+
+func NewAuthorizationService(
+	authorizationRepository repository.AuthorizationRepository,
+	merchantRepository repository.MerchantRepository,
+	merchantApiKeyRepository repository.MerchantApiKeyRepository,
+	cardRepository repository.CardRepository,
+	feeScheduleRepository repository.FeeScheduleRepository,
+	unitOfWork repository.UnitOfWork,
+	ttl time.Duration,
+	logger logger.LoggerInterface,
+	mapping responseservice.AuthorizationResponseMapper,
+	transactionMapping responseservice.TransactionResponseMapper,
+) *authorizationService {
+	return &authorizationService{
+		authorizationRepository:  authorizationRepository,
+		merchantRepository:       merchantRepository,
+		merchantApiKeyRepository: merchantApiKeyRepository,
+		cardRepository:           cardRepository,
+		feeScheduleRepository:    feeScheduleRepository,
+		unitOfWork:               unitOfWork,
+		ttl:                      ttl,
+		logger:                   logger,
+		mapping:                  mapping,
+		transactionMapping:       transactionMapping,
+	}
+}
+
+func (s *authorizationService) FindAll(req *requests.FindAllAuthorizations) ([]*response.AuthorizationResponse, *int, *response.ErrorResponse) {
+	page := req.Page
+	pageSize := req.PageSize
+	search := req.Search
+
+	s.logger.Debug("Fetching authorizations",
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+		zap.String("search", search))
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	req.Page = page
+	req.PageSize = pageSize
+
+	authorizations, totalRecords, err := s.authorizationRepository.FindAll(req)
+
+	if err != nil {
+		s.logger.Error("Failed to fetch authorizations",
+			zap.Error(err),
+			zap.Int("page", page),
+			zap.Int("pageSize", pageSize),
+			zap.String("search", search))
+
+		return nil, nil, authorization_errors.ErrFailedFindAllAuthorizations
+	}
+
+	so := s.mapping.ToAuthorizationsResponse(authorizations)
+
+	s.logger.Debug("Successfully fetched authorizations",
+		zap.Int("totalRecords", *totalRecords),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return so, totalRecords, nil
+}
+
+func (s *authorizationService) FindById(authorizationID int) (*response.AuthorizationResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching authorization by ID", zap.Int("authorization_id", authorizationID))
+
+	authorization, err := s.authorizationRepository.FindById(authorizationID)
+
+	if err != nil {
+		s.logger.Error("failed to find authorization", zap.Error(err))
+
+		return nil, authorization_errors.ErrAuthorizationNotFound
+	}
+
+	so := s.mapping.ToAuthorizationResponse(authorization)
+
+	s.logger.Debug("Successfully fetched authorization", zap.Int("authorization_id", authorizationID))
+
+	return so, nil
+}
+
+func (s *authorizationService) Authorize(credential requests.MerchantApiKeyCredential, request *requests.CreateAuthorizationRequest) (*response.AuthorizationResponse, *response.ErrorResponse) {
+	s.logger.Debug("Starting Authorize process",
+		zap.String("card_number", request.CardNumber),
+		zap.Int("amount", request.Amount),
+	)
+
+	merchant, errResp := authenticateMerchantApiKey(s.merchantApiKeyRepository, s.merchantRepository, s.logger, credential, requests.MerchantApiKeyScopeCreateTransactions)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if errResp := checkMerchantActive(merchant); errResp != nil {
+		s.logger.Error("payment with the API key of an inactive merchant",
+			zap.Int("merchant_id", merchant.ID),
+			zap.String("status", merchant.Status),
+		)
+		return nil, errResp
+	}
+
+	card, err := s.cardRepository.FindCardByCardNumber(request.CardNumber)
+	if err != nil {
+		s.logger.Error("failed to find card", zap.Error(err))
+		return nil, card_errors.ErrFailedFindByCardNumber
+	}
+
+	merchantCard, err := s.cardRepository.FindCardByUserId(merchant.UserID)
+	if err != nil {
+		s.logger.Error("failed to find merchant card", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	if card.Currency != merchantCard.Currency {
+		s.logger.Error("card and merchant currencies differ",
+			zap.String("card_currency", card.Currency),
+			zap.String("merchant_currency", merchantCard.Currency),
+		)
+		return nil, card_errors.ErrCurrencyMismatch
+	}
+
+	if errResp := checkMinimumAmount(request.Amount, card.Currency); errResp != nil {
+		s.logger.Error("authorization amount below the currency minimum", zap.Int("amount", request.Amount), zap.String("currency", card.Currency))
+		return nil, errResp
+	}
+
+	request.MerchantID = &merchant.ID
+
+	var authorization *record.AuthorizationRecord
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		authorization, err = repos.Authorization.CreateAuthorization(request, time.Now().Add(s.ttl))
+		if err != nil {
+			s.logger.Error("failed to create authorization", zap.Error(err))
+			return authorization_errors.ErrFailedAuthorizeTransaction
+		}
+
+		referenceType := requests.SaldoHoldReferenceAuthorization
+
+		if _, err := placeHold(repos, &requests.CreateSaldoHoldRequest{
+			CardNumber:    request.CardNumber,
+			Amount:        request.Amount,
+			Reason:        "Authorization " + authorization.AuthorizationNo + " for merchant " + merchant.Name,
+			ReferenceType: &referenceType,
+			ReferenceID:   &authorization.ID,
+		}); err != nil {
+			s.logger.Error("failed to hold card balance", zap.Error(err))
+
+			if errors.Is(err, saldo_errors.ErrInsufficientAvailableSaldo) {
+				return authorization_errors.ErrInsufficientAvailableBalance
+			}
+			return authorization_errors.ErrFailedAuthorizeTransaction
+		}
+
+		// Captures become merchant transactions, so the limits are checked
+		// when the funds are reserved rather than when they are taken.
+		if err := enforceTransactionLimits(repos, requests.LimitOperationTransaction, request.CardNumber, request.Amount, time.Now()); err != nil {
+			s.logger.Error("authorization rejected by transaction limits", zap.Error(err), zap.String("card_number", request.CardNumber))
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to authorize transaction, transaction rolled back", zap.Error(err))
+
+		return nil, response.ToErrorResponse(err, authorization_errors.ErrFailedAuthorizeTransaction)
+	}
+
+	so := s.mapping.ToAuthorizationResponse(authorization)
+
+	s.logger.Debug("Authorize process completed",
+		zap.Int("authorization_id", authorization.ID),
+		zap.String("expires_at", authorization.ExpiresAt),
+	)
+
+	return so, nil
+}
+
+func (s *authorizationService) Capture(credential requests.MerchantApiKeyCredential, request *requests.CaptureAuthorizationRequest) (*response.AuthorizationResponse, *response.ErrorResponse) {
+	s.logger.Debug("Starting Capture process", zap.Int("authorization_id", request.AuthorizationID))
+
+	merchant, authorization, errResp := s.findMerchantAuthorization(credential, request.AuthorizationID)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	amount := authorization.Amount
+	if request.Amount != nil {
+		amount = *request.Amount
+	}
+
+	if amount > authorization.Amount {
+		s.logger.Error("capture exceeds authorized amount",
+			zap.Int("authorized", authorization.Amount),
+			zap.Int("capture", amount),
+		)
+		return nil, authorization_errors.ErrCaptureExceedsAuthorization
+	}
+
+	card, err := s.cardRepository.FindCardByCardNumber(authorization.CardNumber)
+	if err != nil {
+		s.logger.Error("failed to find card", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	// Captures are merchant transactions and carry the same discount rate.
+	fee, err := quoteFee(s.feeScheduleRepository, &requests.FindApplicableFeeSchedule{
+		TransactionType: requests.FeeTransactionTransaction,
+		PaymentMethod:   authorization.PaymentMethod,
+		CardType:        card.CardType,
+		Currency:        authorization.Currency,
+	}, amount)
+	if err != nil {
+		s.logger.Error("failed to quote merchant discount rate", zap.Error(err))
+		return nil, fee_schedule_errors.ErrFailedQuoteFee
+	}
+
+	if fee >= amount {
+		s.logger.Error("merchant discount rate exceeds amount", zap.Int("fee", fee), zap.Int("amount", amount))
+		return nil, fee_schedule_errors.ErrFeeExceedsAmount
+	}
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		if _, err := lockSaldos(repos, authorization.CardNumber); err != nil {
+			s.logger.Error("failed to lock card saldo", zap.Error(err))
+			return saldo_errors.ErrFailedSaldoNotFound
+		}
+
+		transaction, err := repos.Transaction.CreateTransaction(&requests.CreateTransactionRequest{
+			CardNumber:      authorization.CardNumber,
+			Amount:          amount,
+			PaymentMethod:   authorization.PaymentMethod,
+			MerchantID:      &merchant.ID,
+			TransactionTime: time.Now(),
+			Fee:             fee,
+		})
+		if err != nil {
+			s.logger.Error("failed to create capture transaction", zap.Error(err))
+			return transaction_errors.ErrFailedCreateTransaction
+		}
+
+		authorization, err = repos.Authorization.CaptureAuthorization(authorization.ID, amount, transaction.ID)
+		if err != nil {
+			s.logger.Error("failed to capture authorization", zap.Error(err))
+
+			if errors.Is(err, authorization_errors.ErrAuthorizationStatusConflict) {
+				return authorization_errors.ErrAuthorizationNotCapturable
+			}
+			return authorization_errors.ErrFailedCaptureAuthorization
+		}
+
+		// The whole hold is consumed: the captured part is spent by the
+		// journal below and any remainder becomes available again.
+		if err := s.settleHold(repos, authorization.ID, consumeHold); err != nil {
+			return authorization_errors.ErrFailedCaptureAuthorization
+		}
+
+		if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+			requests.LedgerReferenceTransaction,
+			transaction.ID,
+			"Capture of authorization "+strconv.Itoa(authorization.ID)+" from card "+authorization.CardNumber+" to merchant "+merchant.Name,
+			requests.CardLedgerAccount(authorization.CardNumber),
+			requests.SystemLedgerAccount(requests.LedgerAccountMerchantSettlement),
+			amount,
+			authorization.Currency,
+		).WithFee(requests.SystemLedgerAccount(requests.LedgerAccountMerchantSettlement), transaction.Fee, transaction.Currency)); err != nil {
+			s.logger.Error("failed to post capture journal", zap.Error(err))
+			return ledger_errors.ErrFailedPostLedgerJournal
+		}
+
+		if err := accrueSettlementItem(repos.Settlement, &requests.CreateSettlementItem{
+			MerchantID:  merchant.ID,
+			ItemType:    requests.SettlementItemTransaction,
+			ReferenceID: transaction.ID,
+			Amount:      transaction.Amount,
+			Fee:         transaction.Fee,
+			NetAmount:   transaction.Amount - transaction.Fee,
+			Currency:    transaction.Currency,
+		}); err != nil {
+			s.logger.Error("failed to accrue capture to merchant balance", zap.Error(err))
+			return settlement_errors.ErrFailedAccrueSettlementItem
+		}
+
+		transaction, err = repos.Transaction.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
+			TransactionID: transaction.ID,
+			Status:        "success",
+		})
+		if err != nil {
+			s.logger.Error("failed to update transaction status", zap.Error(err))
+			return transaction_errors.ErrFailedUpdateTransaction
+		}
+
+		if err := publishMerchantEvent(repos.MerchantWebhook, merchant.ID, requests.MerchantEventTransactionSuccess, s.transactionMapping.ToTransactionResponse(transaction)); err != nil {
+			s.logger.Error("failed to queue merchant webhook", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to capture authorization, transaction rolled back", zap.Error(err))
+
+		return nil, response.ToErrorResponse(err, authorization_errors.ErrFailedCaptureAuthorization)
+	}
+
+	so := s.mapping.ToAuthorizationResponse(authorization)
+
+	s.logger.Debug("Capture process completed",
+		zap.Int("authorization_id", authorization.ID),
+		zap.Int("captured_amount", authorization.CapturedAmount),
+	)
+
+	return so, nil
+}
+
+func (s *authorizationService) Void(credential requests.MerchantApiKeyCredential, authorizationID int) (*response.AuthorizationResponse, *response.ErrorResponse) {
+	s.logger.Debug("Starting Void process", zap.Int("authorization_id", authorizationID))
+
+	_, authorization, errResp := s.findMerchantAuthorization(credential, authorizationID)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	err := s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		var err error
+
+		authorization, err = repos.Authorization.VoidAuthorization(authorization.ID)
+		if err != nil {
+			s.logger.Error("failed to void authorization", zap.Error(err))
+
+			if errors.Is(err, authorization_errors.ErrAuthorizationStatusConflict) {
+				return authorization_errors.ErrAuthorizationNotVoidable
+			}
+			return authorization_errors.ErrFailedVoidAuthorization
+		}
+
+		if err := s.settleHold(repos, authorization.ID, releaseHold); err != nil {
+			return authorization_errors.ErrFailedVoidAuthorization
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to void authorization, transaction rolled back", zap.Error(err))
+
+		return nil, response.ToErrorResponse(err, authorization_errors.ErrFailedVoidAuthorization)
+	}
+
+	so := s.mapping.ToAuthorizationResponse(authorization)
+
+	s.logger.Debug("Void process completed", zap.Int("authorization_id", authorization.ID))
+
+	return so, nil
+}
+
+func (s *authorizationService) ExpireStale() (int, *response.ErrorResponse) {
+	var expired []*record.AuthorizationRecord
+
+	err := s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		var err error
+
+		expired, err = repos.Authorization.ExpireAuthorizations(authorizationExpiryBatchSize)
+		if err != nil {
+			s.logger.Error("failed to expire authorizations", zap.Error(err))
+			return authorization_errors.ErrFailedExpireAuthorizations
+		}
+
+		// Release in card-number order, matching lockSaldos, so the sweep
+		// cannot deadlock with concurrent balance movements.
+		sort.Slice(expired, func(i, j int) bool {
+			return expired[i].CardNumber < expired[j].CardNumber
+		})
+
+		for _, authorization := range expired {
+			if err := s.settleHold(repos, authorization.ID, releaseHold); err != nil {
+				return authorization_errors.ErrFailedExpireAuthorizations
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return 0, response.ToErrorResponse(err, authorization_errors.ErrFailedExpireAuthorizations)
+	}
+
+	return len(expired), nil
+}
+
+// settleHold releases or consumes the hold placed by the authorization.
+func (s *authorizationService) settleHold(repos *repository.Repositories, authorizationID int, settle func(*repository.Repositories, int) (*record.SaldoHoldRecord, error)) error {
+	hold, err := repos.SaldoHold.FindActiveByReference(requests.SaldoHoldReferenceAuthorization, authorizationID)
+	if err != nil {
+		s.logger.Error("failed to find authorization hold", zap.Int("authorization_id", authorizationID), zap.Error(err))
+		return err
+	}
+
+	if _, err := settle(repos, hold.ID); err != nil {
+		s.logger.Error("failed to settle authorization hold", zap.Int("hold_id", hold.ID), zap.Error(err))
+		return err
+	}
+
+	return nil
+}
+
+func (s *authorizationService) findMerchantAuthorization(credential requests.MerchantApiKeyCredential, authorizationID int) (*record.MerchantRecord, *record.AuthorizationRecord, *response.ErrorResponse) {
+	merchant, errResp := authenticateMerchantApiKey(s.merchantApiKeyRepository, s.merchantRepository, s.logger, credential, requests.MerchantApiKeyScopeCreateTransactions)
+	if errResp != nil {
+		return nil, nil, errResp
+	}
+
+	authorization, err := s.authorizationRepository.FindById(authorizationID)
+	if err != nil {
+		s.logger.Error("failed to find authorization", zap.Error(err))
+		return nil, nil, authorization_errors.ErrAuthorizationNotFound
+	}
+
+	if authorization.MerchantID != merchant.ID {
+		s.logger.Error("authorization belongs to another merchant",
+			zap.Int("authorization_id", authorization.ID),
+			zap.Int("merchant_id", merchant.ID),
+		)
+		return nil, nil, authorization_errors.ErrAuthorizationNotAllowed
+	}
+
+	return merchant, authorization, nil
+}
@@ -0,0 +1,243 @@
+package service
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+)
+
+const (
+	// maxTransferBatchRows caps the size of one uploaded batch.
+	maxTransferBatchRows = 1000
+
+	// maxTransferBatchNote is the longest note a row may carry.
+	maxTransferBatchNote = 255
+
+	// minTransferBatchAmount matches the minimum of a single transfer.
+	minTransferBatchAmount = 50000
+)
+
+var (
+	errTransferBatchEmpty    = errors.New("file has no rows")
+	errTransferBatchTooLarge = fmt.Errorf("file has more than %d rows", maxTransferBatchRows)
+)
+
+type transferBatchJSONRow struct {
+	TransferTo string          `json:"transfer_to"`
+	Amount     json.RawMessage `json:"amount"`
+	Note       *string         `json:"note"`
+}
+
+// parseTransferBatchFile reads the rows of an uploaded batch. Problems with
+// the file as a whole are returned as an error; problems with a single row
+// are recorded in its FailureReason so every row can be reported at once.
+func parseTransferBatchFile(format, content string) ([]*requests.TransferBatchRowInput, error) {
+	var (
+		rows []*requests.TransferBatchRowInput
+		err  error
+	)
+
+	switch format {
+	case requests.TransferBatchFormatCSV:
+		rows, err = parseTransferBatchCSV(content)
+	case requests.TransferBatchFormatJSON:
+		rows, err = parseTransferBatchJSON(content)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", format)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, errTransferBatchEmpty
+	}
+
+	if len(rows) > maxTransferBatchRows {
+		return nil, errTransferBatchTooLarge
+	}
+
+	return rows, nil
+}
+
+// parseTransferBatchCSV expects a header naming the transfer_to and amount
+// columns, and optionally a note column, in any order.
+func parseTransferBatchCSV(content string) ([]*requests.TransferBatchRowInput, error) {
+	reader := csv.NewReader(strings.NewReader(content))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, errTransferBatchEmpty
+		}
+		return nil, fmt.Errorf("invalid CSV header: %w", err)
+	}
+
+	columns := make(map[string]int, len(header))
+	for i, name := range header {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+
+	toColumn, ok := columns["transfer_to"]
+	if !ok {
+		return nil, errors.New("CSV header has no transfer_to column")
+	}
+
+	amountColumn, ok := columns["amount"]
+	if !ok {
+		return nil, errors.New("CSV header has no amount column")
+	}
+
+	noteColumn, hasNote := columns["note"]
+
+	var rows []*requests.TransferBatchRowInput
+
+	for {
+		fields, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("invalid CSV: %w", err)
+		}
+
+		if len(rows) == maxTransferBatchRows {
+			return nil, errTransferBatchTooLarge
+		}
+
+		row := &requests.TransferBatchRowInput{
+			RowNumber:  len(rows) + 1,
+			TransferTo: csvField(fields, toColumn),
+		}
+
+		if hasNote {
+			row.Note = optionalBatchNote(csvField(fields, noteColumn))
+		}
+
+		amount, err := strconv.Atoi(csvField(fields, amountColumn))
+		if err != nil {
+			rejectBatchRow(row, "amount is not a whole number")
+		}
+		row.TransferAmount = amount
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// parseTransferBatchJSON expects an array of objects with transfer_to,
+// amount and an optional note. The amount may be a number or a string.
+func parseTransferBatchJSON(content string) ([]*requests.TransferBatchRowInput, error) {
+	var items []transferBatchJSONRow
+
+	if err := json.Unmarshal([]byte(content), &items); err != nil {
+		return nil, fmt.Errorf("invalid JSON: %w", err)
+	}
+
+	if len(items) > maxTransferBatchRows {
+		return nil, errTransferBatchTooLarge
+	}
+
+	rows := make([]*requests.TransferBatchRowInput, 0, len(items))
+
+	for i, item := range items {
+		row := &requests.TransferBatchRowInput{
+			RowNumber:  i + 1,
+			TransferTo: strings.TrimSpace(item.TransferTo),
+		}
+
+		if item.Note != nil {
+			row.Note = optionalBatchNote(*item.Note)
+		}
+
+		raw := strings.Trim(string(bytes.TrimSpace(item.Amount)), `"`)
+
+		amount, err := strconv.Atoi(raw)
+		if err != nil {
+			rejectBatchRow(row, "amount is not a whole number")
+		}
+		row.TransferAmount = amount
+
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+// buildTransferBatchReport renders the outcome of every row as CSV.
+func buildTransferBatchReport(rows []*record.TransferBatchRowRecord) (string, error) {
+	var buf bytes.Buffer
+
+	writer := csv.NewWriter(&buf)
+
+	if err := writer.Write([]string{"row_number", "transfer_to", "transfer_amount", "note", "status", "transfer_id", "failure_reason"}); err != nil {
+		return "", err
+	}
+
+	for _, row := range rows {
+		var note, transferID, failureReason string
+
+		if row.Note != nil {
+			note = *row.Note
+		}
+		if row.TransferID != nil {
+			transferID = strconv.Itoa(*row.TransferID)
+		}
+		if row.FailureReason != nil {
+			failureReason = *row.FailureReason
+		}
+
+		err := writer.Write([]string{
+			strconv.Itoa(row.RowNumber),
+			row.TransferTo,
+			strconv.Itoa(row.TransferAmount),
+			note,
+			row.Status,
+			transferID,
+			failureReason,
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	writer.Flush()
+
+	return buf.String(), writer.Error()
+}
+
+// rejectBatchRow records why a row is invalid. Only the first reason is kept.
+func rejectBatchRow(row *requests.TransferBatchRowInput, reason string) {
+	if row.FailureReason == nil {
+		row.FailureReason = &reason
+	}
+}
+
+func csvField(fields []string, column int) string {
+	if column >= len(fields) {
+		return ""
+	}
+
+	return strings.TrimSpace(fields[column])
+}
+
+func optionalBatchNote(note string) *string {
+	note = strings.TrimSpace(note)
+	if note == "" {
+		return nil
+	}
+
+	return &note
+}
@@ -235,6 +235,14 @@ func (s *cardService) DashboardCard() (*response.DashboardCard, *response.ErrorR
 		return nil, card_errors.ErrFailedFindTotalTransferAmount
 	}
 
+	totalsByCurrency, err := s.cardRepository.GetTotalAmountsByCurrency()
+	if err != nil {
+		s.logger.Error("Failed to retrieve totals by currency",
+			zap.Error(err),
+		)
+		return nil, card_errors.ErrFailedFindTotalAmountsByCurrency
+	}
+
 	s.logger.Debug("Completed DashboardCard service",
 		zap.Int("total_balance", int(*totalBalance)),
 		zap.Int("total_topup", int(*totalTopup)),
@@ -249,6 +257,7 @@ func (s *cardService) DashboardCard() (*response.DashboardCard, *response.ErrorR
 		TotalWithdraw:    totalWithdraw,
 		TotalTransaction: totalTransaction,
 		TotalTransfer:    totalTransfer,
+		Currencies:       s.mapping.ToDashboardCardCurrencies(totalsByCurrency),
 	}, nil
 }
 
@@ -257,6 +266,15 @@ func (s *cardService) DashboardCardCardNumber(cardNumber string) (*response.Dash
 		zap.String("card_number", cardNumber),
 	)
 
+	card, err := s.cardRepository.FindCardByCardNumber(cardNumber)
+	if err != nil {
+		s.logger.Error("Failed to retrieve card",
+			zap.String("card_number", cardNumber),
+			zap.Error(err),
+		)
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
 	totalBalance, err := s.cardRepository.GetTotalBalanceByCardNumber(cardNumber)
 	if err != nil {
 		s.logger.Error("Failed to retrieve total balance for card",
@@ -322,6 +340,7 @@ func (s *cardService) DashboardCardCardNumber(cardNumber string) (*response.Dash
 	)
 
 	return &response.DashboardCardCardNumber{
+		Currency:              card.Currency,
 		TotalBalance:          totalBalance,
 		TotalTopup:            totalTopup,
 		TotalWithdraw:         totalWithdraw,
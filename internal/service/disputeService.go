@@ -0,0 +1,453 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/dispute_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/ledger_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/settlement_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transaction_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type disputeService struct {
+	disputeRepository     repository.DisputeRepository
+	transactionRepository repository.TransactionRepository
+	merchantRepository    repository.MerchantRepository
+	cardRepository        repository.CardRepository
+	unitOfWork            repository.UnitOfWork
+	logger                logger.LoggerInterface
+	mapping               responseservice.DisputeResponseMapper
+}
+
+func NewDisputeService(
+	disputeRepository repository.DisputeRepository,
+	transactionRepository repository.TransactionRepository,
+	merchantRepository repository.MerchantRepository,
+	cardRepository repository.CardRepository,
+	unitOfWork repository.UnitOfWork,
+	logger logger.LoggerInterface,
+	mapping responseservice.DisputeResponseMapper,
+) *disputeService {
+	return &disputeService{
+		disputeRepository:     disputeRepository,
+		transactionRepository: transactionRepository,
+		merchantRepository:    merchantRepository,
+		cardRepository:        cardRepository,
+		unitOfWork:            unitOfWork,
+		logger:                logger,
+		mapping:               mapping,
+	}
+}
+
+func (s *disputeService) FindAll(req *requests.FindAllDisputes) ([]*response.DisputeResponse, *int, *response.ErrorResponse) {
+	page := req.Page
+	pageSize := req.PageSize
+	search := req.Search
+
+	s.logger.Debug("Fetching disputes",
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+		zap.String("search", search))
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	req.Page = page
+	req.PageSize = pageSize
+
+	disputes, totalRecords, err := s.disputeRepository.FindAll(req)
+
+	if err != nil {
+		s.logger.Error("Failed to fetch disputes",
+			zap.Error(err),
+			zap.Int("page", page),
+			zap.Int("pageSize", pageSize),
+			zap.String("search", search))
+
+		return nil, nil, dispute_errors.ErrFailedFindAllDisputes
+	}
+
+	so := s.mapping.ToDisputesResponse(disputes)
+
+	s.logger.Debug("Successfully fetched disputes",
+		zap.Int("totalRecords", *totalRecords),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return so, totalRecords, nil
+}
+
+func (s *disputeService) FindByMerchant(req *requests.FindAllDisputesByMerchant) ([]*response.DisputeResponse, *int, *response.ErrorResponse) {
+	page := req.Page
+	pageSize := req.PageSize
+	search := req.Search
+
+	s.logger.Debug("Fetching disputes by merchant",
+		zap.Int("merchant_id", req.MerchantID),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+		zap.String("search", search))
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	req.Page = page
+	req.PageSize = pageSize
+
+	disputes, totalRecords, err := s.disputeRepository.FindByMerchant(req)
+
+	if err != nil {
+		s.logger.Error("Failed to fetch disputes by merchant",
+			zap.Error(err),
+			zap.Int("merchant_id", req.MerchantID),
+			zap.Int("page", page),
+			zap.Int("pageSize", pageSize),
+			zap.String("search", search))
+
+		return nil, nil, dispute_errors.ErrFailedFindDisputesByMerchant
+	}
+
+	so := s.mapping.ToDisputesResponse(disputes)
+
+	s.logger.Debug("Successfully fetched disputes by merchant",
+		zap.Int("merchant_id", req.MerchantID),
+		zap.Int("totalRecords", *totalRecords),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return so, totalRecords, nil
+}
+
+func (s *disputeService) FindById(disputeID int) (*response.DisputeResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching dispute by ID", zap.Int("dispute_id", disputeID))
+
+	dispute, err := s.disputeRepository.FindById(disputeID)
+
+	if err != nil {
+		s.logger.Error("failed to find dispute", zap.Error(err))
+
+		return nil, dispute_errors.ErrDisputeNotFound
+	}
+
+	so := s.mapping.ToDisputeResponse(dispute)
+
+	s.logger.Debug("Successfully fetched dispute", zap.Int("dispute_id", disputeID))
+
+	return so, nil
+}
+
+// OpenDispute puts the transaction under dispute and moves the unrefunded
+// remainder of the payment from the merchant balance or card into the dispute
+// hold account until an admin rules on it.
+func (s *disputeService) OpenDispute(request *requests.CreateDisputeRequest) (*response.DisputeResponse, *response.ErrorResponse) {
+	s.logger.Debug("Starting OpenDispute process",
+		zap.Int("transaction_id", request.TransactionID),
+		zap.Int("opened_by", request.OpenedBy),
+	)
+
+	transaction, err := s.transactionRepository.FindById(request.TransactionID)
+	if err != nil {
+		s.logger.Error("failed to find transaction", zap.Error(err))
+		return nil, transaction_errors.ErrTransactionNotFound
+	}
+
+	if request.RequestedBy != nil {
+		card, err := s.cardRepository.FindCardByCardNumber(transaction.CardNumber)
+		if err != nil {
+			s.logger.Error("failed to find card", zap.Error(err))
+			return nil, card_errors.ErrCardNotFoundRes
+		}
+
+		if card.UserID != *request.RequestedBy {
+			s.logger.Error("unauthorized dispute of transaction",
+				zap.Int("transaction_id", transaction.ID),
+				zap.Int("requested_by", *request.RequestedBy),
+			)
+			return nil, dispute_errors.ErrDisputeNotAllowed
+		}
+	}
+
+	merchantCard, errResp := s.findMerchantCard(transaction.MerchantID)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	var dispute *record.DisputeRecord
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		disputed, err := repos.Transaction.MarkDisputed(transaction.ID)
+		if err != nil {
+			s.logger.Error("failed to mark transaction as disputed", zap.Error(err))
+
+			if errors.Is(err, transaction_errors.ErrTransactionNotDisputable) {
+				return dispute_errors.ErrTransactionNotDisputable
+			}
+			return dispute_errors.ErrFailedOpenDispute
+		}
+
+		amount := disputed.Amount - disputed.RefundedAmount
+
+		// The merchant saldo is locked before the merchant balance, in the
+		// order payments lock them.
+		saldos, err := lockSaldos(repos, merchantCard.CardNumber)
+		if err != nil {
+			s.logger.Error("failed to lock merchant saldo", zap.Error(err))
+			return saldo_errors.ErrFailedSaldoNotFound
+		}
+
+		// A payment that was not paid out yet is held from the pending
+		// balance of the merchant, so settlement cannot pay it out while it
+		// is disputed. Once the balance no longer covers it, the hold comes
+		// out of the merchant card instead.
+		heldFrom := requests.DisputeHeldFromMerchantBalance
+		source := requests.SystemLedgerAccount(requests.LedgerAccountMerchantSettlement)
+
+		_, err = repos.Settlement.DeductPendingBalance(disputed.MerchantID, disputed.Currency, amount)
+		switch {
+		case err == nil:
+		case errors.Is(err, settlement_errors.ErrInsufficientMerchantPendingBalance):
+			if availableBalance(saldos[merchantCard.CardNumber]) < amount {
+				s.logger.Error("insufficient merchant balance for dispute hold",
+					zap.Int("AvailableBalance", availableBalance(saldos[merchantCard.CardNumber])),
+					zap.Int("DisputeAmount", amount),
+				)
+				return dispute_errors.ErrInsufficientMerchantSaldo
+			}
+
+			heldFrom = requests.DisputeHeldFromMerchantCard
+			source = requests.CardLedgerAccount(merchantCard.CardNumber)
+		default:
+			s.logger.Error("failed to deduct dispute hold from merchant balance", zap.Error(err))
+			return settlement_errors.ErrFailedAccrueSettlementItem
+		}
+
+		dispute, err = repos.Dispute.CreateDispute(disputed, amount, heldFrom, request)
+		if err != nil {
+			s.logger.Error("failed to create dispute", zap.Error(err))
+			return dispute_errors.ErrFailedOpenDispute
+		}
+
+		if heldFrom == requests.DisputeHeldFromMerchantBalance {
+			if _, err := repos.Settlement.CreateItem(&requests.CreateSettlementItem{
+				MerchantID:  disputed.MerchantID,
+				ItemType:    requests.SettlementItemAdjustment,
+				ReferenceID: disputed.ID,
+				Amount:      -amount,
+				NetAmount:   -amount,
+				Currency:    dispute.Currency,
+			}); err != nil {
+				s.logger.Error("failed to record dispute hold in merchant balance", zap.Error(err))
+				return settlement_errors.ErrFailedAccrueSettlementItem
+			}
+		}
+
+		if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+			requests.LedgerReferenceDispute,
+			dispute.ID,
+			"Provisional hold for dispute on transaction "+strconv.Itoa(transaction.ID),
+			source,
+			requests.SystemLedgerAccount(requests.LedgerAccountDisputeHold),
+			amount,
+			dispute.Currency,
+		)); err != nil {
+			s.logger.Error("failed to post dispute hold journal", zap.Error(err))
+			return ledger_errors.ErrFailedPostLedgerJournal
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to open dispute, transaction rolled back", zap.Error(err))
+
+		return nil, response.ToErrorResponse(err, dispute_errors.ErrFailedOpenDispute)
+	}
+
+	so := s.mapping.ToDisputeResponse(dispute)
+
+	s.logger.Debug("OpenDispute process completed",
+		zap.Int("transaction_id", transaction.ID),
+		zap.Int("dispute_id", dispute.ID),
+	)
+
+	return so, nil
+}
+
+func (s *disputeService) RespondDispute(request *requests.RespondDisputeRequest) (*response.DisputeResponse, *response.ErrorResponse) {
+	s.logger.Debug("Starting RespondDispute process", zap.Int("dispute_id", request.DisputeID))
+
+	dispute, err := s.disputeRepository.FindById(request.DisputeID)
+	if err != nil {
+		s.logger.Error("failed to find dispute", zap.Error(err))
+		return nil, dispute_errors.ErrDisputeNotFound
+	}
+
+	if request.RequestedBy != nil {
+		merchant, err := s.merchantRepository.FindById(dispute.MerchantID)
+		if err != nil {
+			s.logger.Error("failed to find merchant", zap.Error(err))
+			return nil, merchant_errors.ErrMerchantNotFoundRes
+		}
+
+		if merchant.UserID != *request.RequestedBy {
+			s.logger.Error("unauthorized response to dispute",
+				zap.Int("dispute_id", dispute.ID),
+				zap.Int("requested_by", *request.RequestedBy),
+			)
+			return nil, dispute_errors.ErrDisputeNotAllowed
+		}
+	}
+
+	res, err := s.disputeRepository.RespondDispute(request)
+	if err != nil {
+		s.logger.Error("failed to respond to dispute", zap.Error(err))
+
+		if errors.Is(err, dispute_errors.ErrDisputeStatusConflict) {
+			return nil, dispute_errors.ErrDisputeNotOpen
+		}
+		return nil, dispute_errors.ErrFailedRespondDispute
+	}
+
+	so := s.mapping.ToDisputeResponse(res)
+
+	s.logger.Debug("RespondDispute process completed", zap.Int("dispute_id", res.ID))
+
+	return so, nil
+}
+
+// ResolveDispute closes the dispute and moves the held funds out of the
+// dispute hold account: back to the cardholder as a chargeback, or back to
+// where they were held from when the charge is upheld.
+func (s *disputeService) ResolveDispute(request *requests.ResolveDisputeRequest) (*response.DisputeResponse, *response.ErrorResponse) {
+	s.logger.Debug("Starting ResolveDispute process",
+		zap.Int("dispute_id", request.DisputeID),
+		zap.String("outcome", request.Outcome),
+	)
+
+	dispute, err := s.disputeRepository.FindById(request.DisputeID)
+	if err != nil {
+		s.logger.Error("failed to find dispute", zap.Error(err))
+		return nil, dispute_errors.ErrDisputeNotFound
+	}
+
+	merchantCard, errResp := s.findMerchantCard(dispute.MerchantID)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	status := requests.DisputeStatusResolvedMerchant
+	creditAccount := requests.CardLedgerAccount(merchantCard.CardNumber)
+	if dispute.HeldFrom == requests.DisputeHeldFromMerchantBalance {
+		creditAccount = requests.SystemLedgerAccount(requests.LedgerAccountMerchantSettlement)
+	}
+	description := "Release of disputed funds for transaction " + strconv.Itoa(dispute.TransactionID)
+
+	if request.Outcome == requests.DisputeOutcomeCardholder {
+		status = requests.DisputeStatusResolvedCardholder
+		creditAccount = requests.CardLedgerAccount(dispute.CardNumber)
+		description = "Chargeback for transaction " + strconv.Itoa(dispute.TransactionID)
+	}
+
+	var resolved *record.DisputeRecord
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		resolved, err = repos.Dispute.ResolveDispute(request, status)
+		if err != nil {
+			s.logger.Error("failed to resolve dispute", zap.Error(err))
+
+			if errors.Is(err, dispute_errors.ErrDisputeStatusConflict) {
+				return dispute_errors.ErrDisputeAlreadyResolved
+			}
+			return dispute_errors.ErrFailedResolveDispute
+		}
+
+		if status == requests.DisputeStatusResolvedCardholder {
+			_, err = repos.Transaction.ChargeBack(dispute.TransactionID, dispute.Amount)
+		} else {
+			_, err = repos.Transaction.ReleaseDispute(dispute.TransactionID)
+		}
+		if err != nil {
+			s.logger.Error("failed to update disputed transaction", zap.Error(err))
+			return dispute_errors.ErrFailedResolveDispute
+		}
+
+		if status == requests.DisputeStatusResolvedMerchant && dispute.HeldFrom == requests.DisputeHeldFromMerchantBalance {
+			if err := accrueSettlementItem(repos.Settlement, &requests.CreateSettlementItem{
+				MerchantID:  dispute.MerchantID,
+				ItemType:    requests.SettlementItemAdjustment,
+				ReferenceID: dispute.TransactionID,
+				Amount:      dispute.Amount,
+				NetAmount:   dispute.Amount,
+				Currency:    dispute.Currency,
+			}); err != nil {
+				s.logger.Error("failed to return dispute hold to merchant balance", zap.Error(err))
+				return settlement_errors.ErrFailedAccrueSettlementItem
+			}
+		}
+
+		if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+			requests.LedgerReferenceDispute,
+			dispute.ID,
+			description,
+			requests.SystemLedgerAccount(requests.LedgerAccountDisputeHold),
+			creditAccount,
+			dispute.Amount,
+			dispute.Currency,
+		)); err != nil {
+			s.logger.Error("failed to post dispute resolution journal", zap.Error(err))
+			return ledger_errors.ErrFailedPostLedgerJournal
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to resolve dispute, transaction rolled back", zap.Error(err))
+
+		return nil, response.ToErrorResponse(err, dispute_errors.ErrFailedResolveDispute)
+	}
+
+	so := s.mapping.ToDisputeResponse(resolved)
+
+	s.logger.Debug("ResolveDispute process completed",
+		zap.Int("dispute_id", resolved.ID),
+		zap.String("status", resolved.Status),
+	)
+
+	return so, nil
+}
+
+func (s *disputeService) findMerchantCard(merchantID int) (*record.CardRecord, *response.ErrorResponse) {
+	merchant, err := s.merchantRepository.FindById(merchantID)
+	if err != nil {
+		s.logger.Error("failed to find merchant", zap.Error(err))
+		return nil, merchant_errors.ErrMerchantNotFoundRes
+	}
+
+	merchantCard, err := s.cardRepository.FindCardByUserId(merchant.UserID)
+	if err != nil {
+		s.logger.Error("failed to find merchant card", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	return merchantCard, nil
+}
@@ -0,0 +1,131 @@
+package service
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/exchange_rate_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type exchangeRateService struct {
+	exchangeRateRepository repository.ExchangeRateRepository
+	logger                 logger.LoggerInterface
+	mapping                responseservice.ExchangeRateResponseMapper
+}
+
+func NewExchangeRateService(
+	exchangeRateRepository repository.ExchangeRateRepository,
+	logger logger.LoggerInterface,
+	mapping responseservice.ExchangeRateResponseMapper,
+) *exchangeRateService {
+	return &exchangeRateService{
+		exchangeRateRepository: exchangeRateRepository,
+		logger:                 logger,
+		mapping:                mapping,
+	}
+}
+
+func (s *exchangeRateService) FindAll(req *requests.FindAllExchangeRates) ([]*response.ExchangeRateResponse, *int, *response.ErrorResponse) {
+	page := req.Page
+	pageSize := req.PageSize
+	search := req.Search
+
+	s.logger.Debug("Fetching exchange rates",
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+		zap.String("search", search))
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	req.Page = page
+	req.PageSize = pageSize
+
+	rates, totalRecords, err := s.exchangeRateRepository.FindAll(req)
+
+	if err != nil {
+		s.logger.Error("Failed to fetch exchange rates",
+			zap.Error(err),
+			zap.Int("page", page),
+			zap.Int("pageSize", pageSize),
+			zap.String("search", search))
+
+		return nil, nil, exchange_rate_errors.ErrFailedFindAllExchangeRates
+	}
+
+	so := s.mapping.ToExchangeRatesResponse(rates)
+
+	s.logger.Debug("Successfully fetched exchange rates",
+		zap.Int("totalRecords", *totalRecords),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return so, totalRecords, nil
+}
+
+func (s *exchangeRateService) FindById(exchangeRateID int) (*response.ExchangeRateResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching exchange rate by ID", zap.Int("exchange_rate_id", exchangeRateID))
+
+	rate, err := s.exchangeRateRepository.FindById(exchangeRateID)
+
+	if err != nil {
+		s.logger.Error("failed to find exchange rate", zap.Error(err))
+
+		return nil, exchange_rate_errors.ErrExchangeRateNotFound
+	}
+
+	so := s.mapping.ToExchangeRateResponse(rate)
+
+	s.logger.Debug("Successfully fetched exchange rate", zap.Int("exchange_rate_id", exchangeRateID))
+
+	return so, nil
+}
+
+func (s *exchangeRateService) UpsertExchangeRate(request *requests.UpsertExchangeRateRequest) (*response.ExchangeRateResponse, *response.ErrorResponse) {
+	s.logger.Debug("Upserting exchange rate",
+		zap.String("base_currency", request.BaseCurrency),
+		zap.String("quote_currency", request.QuoteCurrency),
+		zap.Float64("rate", request.Rate),
+		zap.Int("spread_bps", request.SpreadBps),
+	)
+
+	rate, err := s.exchangeRateRepository.UpsertExchangeRate(request)
+
+	if err != nil {
+		s.logger.Error("failed to upsert exchange rate", zap.Error(err))
+
+		return nil, exchange_rate_errors.ErrFailedUpsertExchangeRate
+	}
+
+	so := s.mapping.ToExchangeRateResponse(rate)
+
+	s.logger.Debug("Successfully upserted exchange rate", zap.Int("exchange_rate_id", so.ID))
+
+	return so, nil
+}
+
+func (s *exchangeRateService) DeleteExchangeRate(exchangeRateID int) (*response.ExchangeRateResponse, *response.ErrorResponse) {
+	s.logger.Debug("Deleting exchange rate", zap.Int("exchange_rate_id", exchangeRateID))
+
+	rate, err := s.exchangeRateRepository.DeleteExchangeRate(exchangeRateID)
+
+	if err != nil {
+		s.logger.Error("failed to delete exchange rate", zap.Error(err))
+
+		return nil, exchange_rate_errors.ErrFailedDeleteExchangeRate
+	}
+
+	so := s.mapping.ToExchangeRateResponse(rate)
+
+	s.logger.Debug("Successfully deleted exchange rate", zap.Int("exchange_rate_id", exchangeRateID))
+
+	return so, nil
+}
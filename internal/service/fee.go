@@ -0,0 +1,59 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/fee_schedule_errors"
+)
+
+// quoteFee returns the fee charged for a transaction of the given amount by
+// the most specific active schedule, or 0 when no schedule applies.
+func quoteFee(feeScheduleRepository repository.FeeScheduleRepository, req *requests.FindApplicableFeeSchedule, amount int) (int, error) {
+	schedule, err := feeScheduleRepository.FindApplicable(req)
+	if err != nil {
+		if errors.Is(err, fee_schedule_errors.ErrNoApplicableFeeSchedule) {
+			return 0, nil
+		}
+		return 0, err
+	}
+
+	return computeFee(schedule, amount), nil
+}
+
+// computeFee applies a schedule to an amount. Proportional parts are rounded
+// down, and the result is clamped to the schedule's min and max fee.
+func computeFee(schedule *record.FeeScheduleRecord, amount int) int {
+	var fee int
+
+	switch schedule.FeeType {
+	case requests.FeeTypeFlat:
+		fee = schedule.FlatAmount
+	case requests.FeeTypePercentage:
+		fee = basisPointsOf(amount, schedule.PercentageBps)
+	case requests.FeeTypeTiered:
+		// Tiers are ordered by min_amount, so the last one reached applies.
+		for _, tier := range schedule.Tiers {
+			if tier.MinAmount > amount {
+				break
+			}
+			fee = tier.FlatAmount + basisPointsOf(amount, tier.PercentageBps)
+		}
+	}
+
+	if schedule.MinFee != nil && fee < *schedule.MinFee {
+		fee = *schedule.MinFee
+	}
+
+	if schedule.MaxFee != nil && fee > *schedule.MaxFee {
+		fee = *schedule.MaxFee
+	}
+
+	return fee
+}
+
+func basisPointsOf(amount, bps int) int {
+	return int(int64(amount) * int64(bps) / 10000)
+}
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/fee_schedule_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type feeScheduleService struct {
+	feeScheduleRepository repository.FeeScheduleRepository
+	unitOfWork            repository.UnitOfWork
+	logger                logger.LoggerInterface
+	mapping               responseservice.FeeScheduleResponseMapper
+}
+
+func NewFeeScheduleService(
+	feeScheduleRepository repository.FeeScheduleRepository,
+	unitOfWork repository.UnitOfWork,
+	logger logger.LoggerInterface,
+	mapping responseservice.FeeScheduleResponseMapper,
+) *feeScheduleService {
+	return &feeScheduleService{
+		feeScheduleRepository: feeScheduleRepository,
+		unitOfWork:            unitOfWork,
+		logger:                logger,
+		mapping:               mapping,
+	}
+}
+
+func (s *feeScheduleService) FindAll(req *requests.FindAllFeeSchedules) ([]*response.FeeScheduleResponse, *int, *response.ErrorResponse) {
+	page := req.Page
+	pageSize := req.PageSize
+	search := req.Search
+
+	s.logger.Debug("Fetching fee schedules",
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+		zap.String("search", search))
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	req.Page = page
+	req.PageSize = pageSize
+
+	schedules, totalRecords, err := s.feeScheduleRepository.FindAll(req)
+
+	if err != nil {
+		s.logger.Error("Failed to fetch fee schedules",
+			zap.Error(err),
+			zap.Int("page", page),
+			zap.Int("pageSize", pageSize),
+			zap.String("search", search))
+
+		return nil, nil, fee_schedule_errors.ErrFailedFindAllFeeSchedules
+	}
+
+	so := s.mapping.ToFeeSchedulesResponse(schedules)
+
+	s.logger.Debug("Successfully fetched fee schedules",
+		zap.Int("totalRecords", *totalRecords),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return so, totalRecords, nil
+}
+
+func (s *feeScheduleService) FindById(feeScheduleID int) (*response.FeeScheduleResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching fee schedule by ID", zap.Int("fee_schedule_id", feeScheduleID))
+
+	schedule, err := s.feeScheduleRepository.FindById(feeScheduleID)
+
+	if err != nil {
+		s.logger.Error("failed to find fee schedule", zap.Error(err))
+
+		return nil, fee_schedule_errors.ErrFeeScheduleNotFound
+	}
+
+	so := s.mapping.ToFeeScheduleResponse(schedule)
+
+	s.logger.Debug("Successfully fetched fee schedule", zap.Int("fee_schedule_id", feeScheduleID))
+
+	return so, nil
+}
+
+func (s *feeScheduleService) CreateFeeSchedule(request *requests.CreateFeeScheduleRequest) (*response.FeeScheduleResponse, *response.ErrorResponse) {
+	s.logger.Debug("Creating fee schedule",
+		zap.String("name", request.Name),
+		zap.String("transaction_type", request.TransactionType),
+		zap.String("fee_type", request.FeeType),
+	)
+
+	var schedule *record.FeeScheduleRecord
+
+	err := s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		var err error
+
+		schedule, err = repos.FeeSchedule.CreateFeeSchedule(request)
+
+		return err
+	})
+
+	if err != nil {
+		s.logger.Error("failed to create fee schedule", zap.Error(err))
+
+		return nil, fee_schedule_errors.ErrFailedCreateFeeSchedule
+	}
+
+	so := s.mapping.ToFeeScheduleResponse(schedule)
+
+	s.logger.Debug("Successfully created fee schedule", zap.Int("fee_schedule_id", so.ID))
+
+	return so, nil
+}
+
+func (s *feeScheduleService) UpdateFeeSchedule(request *requests.UpdateFeeScheduleRequest) (*response.FeeScheduleResponse, *response.ErrorResponse) {
+	s.logger.Debug("Updating fee schedule", zap.Int("fee_schedule_id", *request.FeeScheduleID))
+
+	if _, err := s.feeScheduleRepository.FindById(*request.FeeScheduleID); err != nil {
+		s.logger.Error("failed to find fee schedule", zap.Error(err))
+
+		return nil, fee_schedule_errors.ErrFeeScheduleNotFound
+	}
+
+	var schedule *record.FeeScheduleRecord
+
+	err := s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		var err error
+
+		schedule, err = repos.FeeSchedule.UpdateFeeSchedule(request)
+
+		return err
+	})
+
+	if err != nil {
+		s.logger.Error("failed to update fee schedule", zap.Error(err))
+
+		return nil, fee_schedule_errors.ErrFailedUpdateFeeSchedule
+	}
+
+	so := s.mapping.ToFeeScheduleResponse(schedule)
+
+	s.logger.Debug("Successfully updated fee schedule", zap.Int("fee_schedule_id", so.ID))
+
+	return so, nil
+}
+
+func (s *feeScheduleService) DeleteFeeSchedule(feeScheduleID int) (*response.FeeScheduleResponse, *response.ErrorResponse) {
+	s.logger.Debug("Deleting fee schedule", zap.Int("fee_schedule_id", feeScheduleID))
+
+	schedule, err := s.feeScheduleRepository.DeleteFeeSchedule(feeScheduleID)
+
+	if err != nil {
+		s.logger.Error("failed to delete fee schedule", zap.Error(err))
+
+		return nil, fee_schedule_errors.ErrFailedDeleteFeeSchedule
+	}
+
+	so := s.mapping.ToFeeScheduleResponse(schedule)
+
+	s.logger.Debug("Successfully deleted fee schedule", zap.Int("fee_schedule_id", feeScheduleID))
+
+	return so, nil
+}
+
+func (s *feeScheduleService) FindMonthlyFeeAmounts(year int) ([]*response.FeeMonthAmountResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching monthly fee amounts", zap.Int("year", year))
+
+	records, err := s.feeScheduleRepository.GetMonthlyFeeAmounts(year)
+	if err != nil {
+		s.logger.Error("Failed to fetch monthly fee amounts", zap.Error(err), zap.Int("year", year))
+
+		return nil, fee_schedule_errors.ErrFailedFindMonthlyFeeAmounts
+	}
+
+	responses := s.mapping.ToFeeMonthlyAmounts(records)
+
+	s.logger.Debug("Successfully fetched monthly fee amounts", zap.Int("year", year))
+
+	return responses, nil
+}
+
+func (s *feeScheduleService) FindYearlyFeeAmounts(year int) ([]*response.FeeYearlyAmountResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching yearly fee amounts", zap.Int("year", year))
+
+	records, err := s.feeScheduleRepository.GetYearlyFeeAmounts(year)
+	if err != nil {
+		s.logger.Error("Failed to fetch yearly fee amounts", zap.Error(err), zap.Int("year", year))
+
+		return nil, fee_schedule_errors.ErrFailedFindYearlyFeeAmounts
+	}
+
+	responses := s.mapping.ToFeeYearlyAmounts(records)
+
+	s.logger.Debug("Successfully fetched yearly fee amounts", zap.Int("year", year))
+
+	return responses, nil
+}
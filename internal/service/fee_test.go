@@ -0,0 +1,55 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+)
+
+func intPtr(v int) *int {
+	return &v
+}
+
+func TestComputeFee(t *testing.T) {
+	tiered := []*record.FeeScheduleTierRecord{
+		{MinAmount: 0, FlatAmount: 1000},
+		{MinAmount: 100000, FlatAmount: 500, PercentageBps: 50},
+		{MinAmount: 1000000, PercentageBps: 25},
+	}
+
+	tests := []struct {
+		name     string
+		schedule record.FeeScheduleRecord
+		amount   int
+		want     int
+	}{
+		{"flat", record.FeeScheduleRecord{FeeType: requests.FeeTypeFlat, FlatAmount: 2500}, 100000, 2500},
+		{"flat ignores amount", record.FeeScheduleRecord{FeeType: requests.FeeTypeFlat, FlatAmount: 2500}, 1, 2500},
+		{"percentage", record.FeeScheduleRecord{FeeType: requests.FeeTypePercentage, PercentageBps: 150}, 200000, 3000},
+		{"percentage rounds down", record.FeeScheduleRecord{FeeType: requests.FeeTypePercentage, PercentageBps: 150}, 199999, 2999},
+		{"percentage of small amount is zero", record.FeeScheduleRecord{FeeType: requests.FeeTypePercentage, PercentageBps: 1}, 9999, 0},
+		{"zero amount", record.FeeScheduleRecord{FeeType: requests.FeeTypePercentage, PercentageBps: 150}, 0, 0},
+		{"zero rate", record.FeeScheduleRecord{FeeType: requests.FeeTypePercentage}, 200000, 0},
+		{"min fee raises", record.FeeScheduleRecord{FeeType: requests.FeeTypePercentage, PercentageBps: 100, MinFee: intPtr(1000)}, 50000, 1000},
+		{"min fee above computed zero", record.FeeScheduleRecord{FeeType: requests.FeeTypePercentage, MinFee: intPtr(1000)}, 0, 1000},
+		{"max fee caps", record.FeeScheduleRecord{FeeType: requests.FeeTypePercentage, PercentageBps: 100, MaxFee: intPtr(5000)}, 1000000, 5000},
+		{"at max fee", record.FeeScheduleRecord{FeeType: requests.FeeTypePercentage, PercentageBps: 100, MaxFee: intPtr(5000)}, 500000, 5000},
+		{"between min and max", record.FeeScheduleRecord{FeeType: requests.FeeTypePercentage, PercentageBps: 100, MinFee: intPtr(1000), MaxFee: intPtr(5000)}, 300000, 3000},
+		{"first tier", record.FeeScheduleRecord{FeeType: requests.FeeTypeTiered, Tiers: tiered}, 99999, 1000},
+		{"tier boundary", record.FeeScheduleRecord{FeeType: requests.FeeTypeTiered, Tiers: tiered}, 100000, 1000},
+		{"second tier", record.FeeScheduleRecord{FeeType: requests.FeeTypeTiered, Tiers: tiered}, 500000, 3000},
+		{"last tier", record.FeeScheduleRecord{FeeType: requests.FeeTypeTiered, Tiers: tiered}, 2000000, 5000},
+		{"tiered below first tier", record.FeeScheduleRecord{FeeType: requests.FeeTypeTiered, Tiers: tiered[1:]}, 50000, 0},
+		{"tiered capped", record.FeeScheduleRecord{FeeType: requests.FeeTypeTiered, Tiers: tiered, MaxFee: intPtr(4000)}, 2000000, 4000},
+		{"large amount does not overflow", record.FeeScheduleRecord{FeeType: requests.FeeTypePercentage, PercentageBps: 9999}, 2000000000, 1999800000},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := computeFee(&tt.schedule, tt.amount); got != tt.want {
+				t.Errorf("computeFee(%d) = %d, want %d", tt.amount, got, tt.want)
+			}
+		})
+	}
+}
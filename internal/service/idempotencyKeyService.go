@@ -0,0 +1,134 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/idempotency_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+// idempotencyCompleteAttempts is how often Complete tries to store a
+// response. The mutation has already run by then, so a transient database
+// error must not leave its key in progress.
+const idempotencyCompleteAttempts = 3
+
+// idempotencyCompleteBackoff is the wait before the second attempt, doubled
+// before each one after it.
+const idempotencyCompleteBackoff = 50 * time.Millisecond
+
+type idempotencyKeyService struct {
+	idempotencyKeyRepository repository.IdempotencyKeyRepository
+	ttl                      time.Duration
+	logger                   logger.LoggerInterface
+	mapping                  responseservice.IdempotencyKeyResponseMapper
+}
+
+func NewIdempotencyKeyService(idempotencyKey repository.IdempotencyKeyRepository, ttl time.Duration, logger logger.LoggerInterface, mapping responseservice.IdempotencyKeyResponseMapper) *idempotencyKeyService {
+	return &idempotencyKeyService{
+		idempotencyKeyRepository: idempotencyKey,
+		ttl:                      ttl,
+		logger:                   logger,
+		mapping:                  mapping,
+	}
+}
+
+// Reserve claims the key for a new request. When the key is already live the
+// stored record is returned instead, so the caller can replay its response;
+// a different fingerprint or a request still in flight is rejected.
+func (s *idempotencyKeyService) Reserve(request *requests.CreateIdempotencyKey) (*response.IdempotencyKeyResponse, *response.ErrorResponse) {
+	s.logger.Debug("Reserving idempotency key",
+		zap.Int("user_id", request.UserID),
+		zap.String("scope", request.Scope),
+		zap.String("key", request.Key))
+
+	request.TTL = s.ttl
+
+	if err := request.Validate(); err != nil {
+		s.logger.Error("Invalid idempotency key request", zap.Error(err))
+		return nil, idempotency_errors.ErrInvalidIdempotencyKey
+	}
+
+	res, err := s.idempotencyKeyRepository.CreateIdempotencyKey(request)
+
+	if err == nil {
+		return s.mapping.ToIdempotencyKeyResponse(res), nil
+	}
+
+	if !errors.Is(err, idempotency_errors.ErrIdempotencyKeyAlreadyExists) {
+		s.logger.Error("Failed to reserve idempotency key", zap.Error(err))
+		return nil, idempotency_errors.ErrFailedReserveIdempotencyKey
+	}
+
+	existing, err := s.idempotencyKeyRepository.FindByKey(request.UserID, request.Scope, request.Key)
+
+	if err != nil {
+		s.logger.Error("Failed to find existing idempotency key", zap.Error(err))
+		return nil, idempotency_errors.ErrFailedReserveIdempotencyKey
+	}
+
+	if existing.Fingerprint != request.Fingerprint {
+		s.logger.Debug("Idempotency key reused with a different payload",
+			zap.String("scope", request.Scope),
+			zap.String("key", request.Key))
+
+		return nil, idempotency_errors.ErrIdempotencyKeyReused
+	}
+
+	if existing.ResponseBody == nil {
+		return nil, idempotency_errors.ErrIdempotencyKeyInProgress
+	}
+
+	s.logger.Debug("Replaying stored response for idempotency key",
+		zap.String("scope", request.Scope),
+		zap.String("key", request.Key))
+
+	return s.mapping.ToIdempotencyKeyResponse(existing), nil
+}
+
+// Complete stores the response of a request that succeeded, retrying a few
+// times. The key is never released here: the request already ran, and a
+// released key would let a retry run it again.
+func (s *idempotencyKeyService) Complete(idempotency_key_id int, response_body string) *response.ErrorResponse {
+	backoff := idempotencyCompleteBackoff
+
+	for attempt := 1; ; attempt++ {
+		_, err := s.idempotencyKeyRepository.UpdateResponse(idempotency_key_id, response_body)
+		if err == nil {
+			return nil
+		}
+
+		s.logger.Error("Failed to store idempotent response",
+			zap.Int("idempotency_key_id", idempotency_key_id),
+			zap.Int("attempt", attempt),
+			zap.Error(err))
+
+		if attempt == idempotencyCompleteAttempts {
+			return idempotency_errors.ErrFailedCompleteIdempotencyKey
+		}
+
+		time.Sleep(backoff)
+		backoff *= 2
+	}
+}
+
+// Release drops a key whose request failed so the client may retry with it.
+func (s *idempotencyKeyService) Release(idempotency_key_id int) *response.ErrorResponse {
+	err := s.idempotencyKeyRepository.DeleteIdempotencyKey(idempotency_key_id)
+
+	if err != nil {
+		s.logger.Error("Failed to release idempotency key",
+			zap.Int("idempotency_key_id", idempotency_key_id),
+			zap.Error(err))
+
+		return idempotency_errors.ErrFailedReleaseIdempotencyKey
+	}
+
+	return nil
+}
@@ -94,6 +94,8 @@ type MerchantService interface {
 	FindMonthlyPaymentMethodByMerchants(req *requests.MonthYearPaymentMethodMerchant) ([]*response.MerchantResponseMonthlyPaymentMethod, *response.ErrorResponse)
 	FindYearlyPaymentMethodByMerchants(req *requests.MonthYearPaymentMethodMerchant) ([]*response.MerchantResponseYearlyPaymentMethod, *response.ErrorResponse)
 	FindMonthlyAmountByMerchants(req *requests.MonthYearAmountMerchant) ([]*response.MerchantResponseMonthlyAmount, *response.ErrorResponse)
+	FindMonthlyDisputeRateByMerchants(req *requests.MonthYearAmountMerchant) ([]*response.MerchantResponseMonthlyDisputeRate, *response.ErrorResponse)
+	FindYearlyDisputeRateByMerchants(req *requests.MonthYearAmountMerchant) ([]*response.MerchantResponseYearlyDisputeRate, *response.ErrorResponse)
 	FindYearlyAmountByMerchants(req *requests.MonthYearAmountMerchant) ([]*response.MerchantResponseYearlyAmount, *response.ErrorResponse)
 	FindMonthlyTotalAmountByMerchants(req *requests.MonthYearTotalAmountMerchant) ([]*response.MerchantResponseMonthlyTotalAmount, *response.ErrorResponse)
 	FindYearlyTotalAmountByMerchants(req *requests.MonthYearTotalAmountMerchant) ([]*response.MerchantResponseYearlyTotalAmount, *response.ErrorResponse)
@@ -134,6 +136,13 @@ type SaldoService interface {
 	FindByTrashed(req *requests.FindAllSaldos) ([]*response.SaldoResponseDeleteAt, *int, *response.ErrorResponse)
 	CreateSaldo(request *requests.CreateSaldoRequest) (*response.SaldoResponse, *response.ErrorResponse)
 	UpdateSaldo(request *requests.UpdateSaldoRequest) (*response.SaldoResponse, *response.ErrorResponse)
+	RebuildFromLedger() ([]*response.SaldoResponse, *response.ErrorResponse)
+
+	FindActiveHoldsByCardNumber(card_number string) ([]*response.SaldoHoldResponse, *response.ErrorResponse)
+	CreateHold(request *requests.CreateSaldoHoldRequest) (*response.SaldoHoldResponse, *response.ErrorResponse)
+	ReleaseHold(hold_id int) (*response.SaldoHoldResponse, *response.ErrorResponse)
+	ConsumeHold(hold_id int) (*response.SaldoHoldResponse, *response.ErrorResponse)
+
 	TrashSaldo(saldo_id int) (*response.SaldoResponseDeleteAt, *response.ErrorResponse)
 	RestoreSaldo(saldo_id int) (*response.SaldoResponseDeleteAt, *response.ErrorResponse)
 	DeleteSaldoPermanent(saldo_id int) (bool, *response.ErrorResponse)
@@ -178,6 +187,9 @@ type TopupService interface {
 
 	RestoreAllTopup() (bool, *response.ErrorResponse)
 	DeleteAllTopupPermanent() (bool, *response.ErrorResponse)
+
+	HandleProviderCallback(request *requests.TopupProviderCallback) (*response.TopupResponse, *response.ErrorResponse)
+	SyncPending() (int, *response.ErrorResponse)
 }
 
 type TransactionService interface {
@@ -209,8 +221,11 @@ type TransactionService interface {
 	FindByActive(req *requests.FindAllTransactions) ([]*response.TransactionResponseDeleteAt, *int, *response.ErrorResponse)
 	FindByTrashed(req *requests.FindAllTransactions) ([]*response.TransactionResponseDeleteAt, *int, *response.ErrorResponse)
 	FindTransactionByMerchantId(merchant_id int) ([]*response.TransactionResponse, *response.ErrorResponse)
-	Create(apiKey string, request *requests.CreateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse)
-	Update(apiKey string, request *requests.UpdateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse)
+	Create(credential requests.MerchantApiKeyCredential, request *requests.CreateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse)
+	Update(credential requests.MerchantApiKeyCredential, request *requests.UpdateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse)
+	GenerateMerchantQr(request *requests.GenerateMerchantQrRequest) (*response.MerchantQrResponse, *response.ErrorResponse)
+	PayQr(request *requests.PayQrRequest) (*response.TransactionResponse, *response.ErrorResponse)
+	FindQrPayment(request *requests.FindQrPaymentRequest) (*response.TransactionResponse, *response.ErrorResponse)
 	TrashedTransaction(transaction_id int) (*response.TransactionResponseDeleteAt, *response.ErrorResponse)
 	RestoreTransaction(transaction_id int) (*response.TransactionResponseDeleteAt, *response.ErrorResponse)
 	DeleteTransactionPermanent(transaction_id int) (bool, *response.ErrorResponse)
@@ -261,6 +276,7 @@ type UserService interface {
 	FindByTrashed(req *requests.FindAllUsers) ([]*response.UserResponseDeleteAt, *int, *response.ErrorResponse)
 	CreateUser(request *requests.CreateUserRequest) (*response.UserResponse, *response.ErrorResponse)
 	UpdateUser(request *requests.UpdateUserRequest) (*response.UserResponse, *response.ErrorResponse)
+	UpdateUserTier(request *requests.UpdateUserTierRequest) (*response.UserResponse, *response.ErrorResponse)
 	TrashedUser(user_id int) (*response.UserResponseDeleteAt, *response.ErrorResponse)
 	RestoreUser(user_id int) (*response.UserResponseDeleteAt, *response.ErrorResponse)
 	DeleteUserPermanent(user_id int) (bool, *response.ErrorResponse)
@@ -294,6 +310,11 @@ type WithdrawService interface {
 	FindByTrashed(req *requests.FindAllWithdraws) ([]*response.WithdrawResponseDeleteAt, *int, *response.ErrorResponse)
 	Create(request *requests.CreateWithdrawRequest) (*response.WithdrawResponse, *response.ErrorResponse)
 	Update(request *requests.UpdateWithdrawRequest) (*response.WithdrawResponse, *response.ErrorResponse)
+	ApproveWithdraw(request *requests.ReviewWithdrawRequest) (*response.WithdrawResponse, *response.ErrorResponse)
+	RejectWithdraw(request *requests.ReviewWithdrawRequest) (*response.WithdrawResponse, *response.ErrorResponse)
+	FindReview(withdraw_id int) (*response.WithdrawReviewResponse, *response.ErrorResponse)
+	SettleApproved() (int, *response.ErrorResponse)
+	HandlePayoutCallback(request *requests.WithdrawPayoutCallback) (*response.WithdrawResponse, *response.ErrorResponse)
 	TrashedWithdraw(withdraw_id int) (*response.WithdrawResponseDeleteAt, *response.ErrorResponse)
 	RestoreWithdraw(withdraw_id int) (*response.WithdrawResponseDeleteAt, *response.ErrorResponse)
 	DeleteWithdrawPermanent(withdraw_id int) (bool, *response.ErrorResponse)
@@ -301,3 +322,162 @@ type WithdrawService interface {
 	RestoreAllWithdraw() (bool, *response.ErrorResponse)
 	DeleteAllWithdrawPermanent() (bool, *response.ErrorResponse)
 }
+
+type LedgerService interface {
+	FindAllJournals(req *requests.FindAllLedgerJournals) ([]*response.LedgerJournalResponse, *int, *response.ErrorResponse)
+	FindJournalById(journal_id int) (*response.LedgerJournalResponse, *response.ErrorResponse)
+	FindPostingsByCardNumber(req *requests.FindLedgerPostingsByCardNumber) ([]*response.LedgerCardPostingResponse, *int, *response.ErrorResponse)
+	FindBalanceByCardNumber(card_number string, requestedBy *int) (*response.LedgerBalanceResponse, *response.ErrorResponse)
+}
+
+type IdempotencyKeyService interface {
+	Reserve(request *requests.CreateIdempotencyKey) (*response.IdempotencyKeyResponse, *response.ErrorResponse)
+	Complete(idempotency_key_id int, response_body string) *response.ErrorResponse
+	Release(idempotency_key_id int) *response.ErrorResponse
+}
+
+type RefundService interface {
+	FindAll(req *requests.FindAllRefunds) ([]*response.RefundResponse, *int, *response.ErrorResponse)
+	FindById(refund_id int) (*response.RefundResponse, *response.ErrorResponse)
+	FindByTransactionId(transaction_id int) ([]*response.RefundResponse, *response.ErrorResponse)
+	RefundTransaction(request *requests.CreateRefundRequest) (*response.RefundResponse, *response.ErrorResponse)
+}
+
+type DisputeService interface {
+	FindAll(req *requests.FindAllDisputes) ([]*response.DisputeResponse, *int, *response.ErrorResponse)
+	FindByMerchant(req *requests.FindAllDisputesByMerchant) ([]*response.DisputeResponse, *int, *response.ErrorResponse)
+	FindById(dispute_id int) (*response.DisputeResponse, *response.ErrorResponse)
+	OpenDispute(request *requests.CreateDisputeRequest) (*response.DisputeResponse, *response.ErrorResponse)
+	RespondDispute(request *requests.RespondDisputeRequest) (*response.DisputeResponse, *response.ErrorResponse)
+	ResolveDispute(request *requests.ResolveDisputeRequest) (*response.DisputeResponse, *response.ErrorResponse)
+}
+
+type AuthorizationService interface {
+	FindAll(req *requests.FindAllAuthorizations) ([]*response.AuthorizationResponse, *int, *response.ErrorResponse)
+	FindById(authorization_id int) (*response.AuthorizationResponse, *response.ErrorResponse)
+	Authorize(credential requests.MerchantApiKeyCredential, request *requests.CreateAuthorizationRequest) (*response.AuthorizationResponse, *response.ErrorResponse)
+	Capture(credential requests.MerchantApiKeyCredential, request *requests.CaptureAuthorizationRequest) (*response.AuthorizationResponse, *response.ErrorResponse)
+	Void(credential requests.MerchantApiKeyCredential, authorization_id int) (*response.AuthorizationResponse, *response.ErrorResponse)
+	ExpireStale() (int, *response.ErrorResponse)
+}
+
+type ExchangeRateService interface {
+	FindAll(req *requests.FindAllExchangeRates) ([]*response.ExchangeRateResponse, *int, *response.ErrorResponse)
+	FindById(exchange_rate_id int) (*response.ExchangeRateResponse, *response.ErrorResponse)
+	UpsertExchangeRate(request *requests.UpsertExchangeRateRequest) (*response.ExchangeRateResponse, *response.ErrorResponse)
+	DeleteExchangeRate(exchange_rate_id int) (*response.ExchangeRateResponse, *response.ErrorResponse)
+}
+
+type FeeScheduleService interface {
+	FindAll(req *requests.FindAllFeeSchedules) ([]*response.FeeScheduleResponse, *int, *response.ErrorResponse)
+	FindById(fee_schedule_id int) (*response.FeeScheduleResponse, *response.ErrorResponse)
+	CreateFeeSchedule(request *requests.CreateFeeScheduleRequest) (*response.FeeScheduleResponse, *response.ErrorResponse)
+	UpdateFeeSchedule(request *requests.UpdateFeeScheduleRequest) (*response.FeeScheduleResponse, *response.ErrorResponse)
+	DeleteFeeSchedule(fee_schedule_id int) (*response.FeeScheduleResponse, *response.ErrorResponse)
+
+	FindMonthlyFeeAmounts(year int) ([]*response.FeeMonthAmountResponse, *response.ErrorResponse)
+	FindYearlyFeeAmounts(year int) ([]*response.FeeYearlyAmountResponse, *response.ErrorResponse)
+}
+
+type TransactionLimitService interface {
+	FindAll(req *requests.FindAllTransactionLimits) ([]*response.TransactionLimitResponse, *int, *response.ErrorResponse)
+	FindById(transaction_limit_id int) (*response.TransactionLimitResponse, *response.ErrorResponse)
+	FindHeadroom(req *requests.FindTransactionLimitHeadroom) ([]*response.TransactionLimitHeadroomResponse, *response.ErrorResponse)
+	CreateTransactionLimit(request *requests.CreateTransactionLimitRequest) (*response.TransactionLimitResponse, *response.ErrorResponse)
+	UpdateTransactionLimit(request *requests.UpdateTransactionLimitRequest) (*response.TransactionLimitResponse, *response.ErrorResponse)
+	DeleteTransactionLimit(transaction_limit_id int) (*response.TransactionLimitResponse, *response.ErrorResponse)
+}
+
+type ScheduledTransferService interface {
+	FindAll(req *requests.FindAllScheduledTransfers) ([]*response.ScheduledTransferResponse, *int, *response.ErrorResponse)
+	FindById(scheduled_transfer_id int, requestedBy *int) (*response.ScheduledTransferResponse, *response.ErrorResponse)
+	FindRuns(req *requests.FindScheduledTransferRuns) ([]*response.ScheduledTransferRunResponse, *int, *response.ErrorResponse)
+	CreateScheduledTransfer(request *requests.CreateScheduledTransferRequest) (*response.ScheduledTransferResponse, *response.ErrorResponse)
+	UpdateScheduledTransfer(request *requests.UpdateScheduledTransferRequest) (*response.ScheduledTransferResponse, *response.ErrorResponse)
+	PauseScheduledTransfer(scheduled_transfer_id int, requestedBy *int) (*response.ScheduledTransferResponse, *response.ErrorResponse)
+	ResumeScheduledTransfer(scheduled_transfer_id int, requestedBy *int) (*response.ScheduledTransferResponse, *response.ErrorResponse)
+	CancelScheduledTransfer(scheduled_transfer_id int, requestedBy *int) (*response.ScheduledTransferResponse, *response.ErrorResponse)
+	ExecuteDue() (int, *response.ErrorResponse)
+}
+
+type TransferBatchService interface {
+	FindAll(req *requests.FindAllTransferBatches) ([]*response.TransferBatchResponse, *int, *response.ErrorResponse)
+	FindById(transfer_batch_id int, requestedBy *int) (*response.TransferBatchResponse, *response.ErrorResponse)
+	FindRows(req *requests.FindTransferBatchRows) ([]*response.TransferBatchRowResponse, *int, *response.ErrorResponse)
+	FindReport(transfer_batch_id int, requestedBy *int) (*response.TransferBatchReportResponse, *response.ErrorResponse)
+	CreateTransferBatch(request *requests.CreateTransferBatchRequest) (*response.TransferBatchResponse, *response.ErrorResponse)
+	ProcessPending() (int, *response.ErrorResponse)
+}
+
+type StatusHistoryService interface {
+	FindByEntity(req *requests.FindStatusHistory) ([]*response.StatusHistoryResponse, *response.ErrorResponse)
+}
+
+type ProviderWebhookService interface {
+	FindAll(req *requests.FindAllProviderWebhookEvents) ([]*response.ProviderWebhookEventResponse, *int, *response.ErrorResponse)
+	FindById(event_id int) (*response.ProviderWebhookEventResponse, *response.ErrorResponse)
+	Receive(request *requests.ReceiveProviderWebhook) (*response.ProviderWebhookEventResponse, *response.ErrorResponse)
+	Replay(event_id int) (*response.ProviderWebhookEventResponse, *response.ErrorResponse)
+}
+
+type MerchantWebhookService interface {
+	FindEndpoints(merchant_id int, requestedBy *int) ([]*response.MerchantWebhookEndpointResponse, *response.ErrorResponse)
+	FindDeliveries(req *requests.FindMerchantWebhookDeliveries) ([]*response.MerchantWebhookDeliveryResponse, *int, *response.ErrorResponse)
+	FindDeliveryAttempts(delivery_id int, requestedBy *int) ([]*response.MerchantWebhookDeliveryAttemptResponse, *response.ErrorResponse)
+	CreateEndpoint(request *requests.CreateMerchantWebhookEndpointRequest) (*response.MerchantWebhookEndpointResponse, *response.ErrorResponse)
+	UpdateEndpoint(request *requests.UpdateMerchantWebhookEndpointRequest) (*response.MerchantWebhookEndpointResponse, *response.ErrorResponse)
+	DeleteEndpoint(endpoint_id int, requestedBy *int) (*response.MerchantWebhookEndpointResponse, *response.ErrorResponse)
+	SendTest(endpoint_id int, requestedBy *int) (*response.MerchantWebhookDeliveryResponse, *response.ErrorResponse)
+	Redeliver(delivery_id int, requestedBy *int) (*response.MerchantWebhookDeliveryResponse, *response.ErrorResponse)
+	DeliverDue() (int, *response.ErrorResponse)
+}
+
+type SettlementService interface {
+	FindBalances(merchant_id int, requestedBy *int) ([]*response.MerchantBalanceResponse, *response.ErrorResponse)
+	SetSettlementCard(request *requests.SetSettlementCardRequest) (*response.MerchantBalanceResponse, *response.ErrorResponse)
+	FindBatches(req *requests.FindSettlementBatches) ([]*response.SettlementBatchResponse, *int, *response.ErrorResponse)
+	FindBatchById(batch_id int, requestedBy *int) (*response.SettlementBatchResponse, *response.ErrorResponse)
+	FindBatchItems(batch_id int, requestedBy *int) ([]*response.SettlementItemResponse, *response.ErrorResponse)
+	SettleDue() (int, *response.ErrorResponse)
+}
+
+type MerchantOnboardingService interface {
+	Submit(request *requests.SubmitMerchantOnboardingRequest) (*response.MerchantBusinessProfileResponse, *response.ErrorResponse)
+	Approve(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse)
+	Reject(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse)
+	Suspend(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse)
+	Reactivate(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse)
+	Terminate(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse)
+	FindBusinessProfile(merchantID int, requestedBy *int) (*response.MerchantBusinessProfileResponse, *response.ErrorResponse)
+	FindByStatus(req *requests.FindMerchantsByStatus) ([]*response.MerchantResponse, *int, *response.ErrorResponse)
+}
+
+type ReconciliationService interface {
+	Reconcile() (*response.ReconciliationRunResponse, *response.ErrorResponse)
+	FindRuns(req *requests.FindReconciliationRuns) ([]*response.ReconciliationRunResponse, *int, *response.ErrorResponse)
+	FindDiscrepancies(req *requests.FindBalanceDiscrepancies) ([]*response.BalanceDiscrepancyResponse, *int, *response.ErrorResponse)
+	FindDiscrepancyById(discrepancy_id int) (*response.BalanceDiscrepancyResponse, *response.ErrorResponse)
+	Acknowledge(request *requests.AcknowledgeBalanceDiscrepancyRequest) (*response.BalanceDiscrepancyResponse, *response.ErrorResponse)
+}
+
+type MerchantApiKeyService interface {
+	FindByMerchant(merchant_id int, requestedBy *int) ([]*response.MerchantApiKeyResponse, *response.ErrorResponse)
+	Create(request *requests.CreateMerchantApiKeyRequest) (*response.MerchantApiKeyResponse, *response.ErrorResponse)
+	Rotate(request *requests.RotateMerchantApiKeyRequest) (*response.MerchantApiKeyResponse, *response.ErrorResponse)
+	Revoke(api_key_id int, requestedBy *int) (*response.MerchantApiKeyResponse, *response.ErrorResponse)
+	Authenticate(credential requests.MerchantApiKeyCredential, scope string) (*response.MerchantResponse, *response.ErrorResponse)
+	VerifySignature(request *requests.VerifyMerchantRequestSignature) (*response.MerchantApiKeyResponse, *response.ErrorResponse)
+	PruneNonces() (int, *response.ErrorResponse)
+	SealSigningSecrets() (int, *response.ErrorResponse)
+}
+
+type InvoiceService interface {
+	FindByMerchant(req *requests.FindInvoicesByMerchant) ([]*response.InvoiceResponse, *int, *response.ErrorResponse)
+	FindById(invoice_id int, requestedBy *int) (*response.InvoiceResponse, *response.ErrorResponse)
+	FindByCode(code string) (*response.InvoiceResponse, *response.ErrorResponse)
+	FindPayments(invoice_id int, requestedBy *int) ([]*response.InvoicePaymentResponse, *response.ErrorResponse)
+	Create(request *requests.CreateInvoiceRequest) (*response.InvoiceResponse, *response.ErrorResponse)
+	Cancel(invoice_id int, requestedBy *int) (*response.InvoiceResponse, *response.ErrorResponse)
+	Pay(request *requests.PayInvoiceRequest) (*response.InvoicePaymentResponse, *response.ErrorResponse)
+	ExpireStale() (int, *response.ErrorResponse)
+}
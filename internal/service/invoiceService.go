@@ -0,0 +1,508 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/fee_schedule_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/invoice_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/ledger_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/settlement_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transaction_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/statemachine"
+	"go.uber.org/zap"
+)
+
+// invoiceCodeBytes is how much randomness goes into the public code of an
+// invoice; 10 bytes encode to 16 characters.
+const invoiceCodeBytes = 10
+
+type invoiceService struct {
+	invoiceRepository     repository.InvoiceRepository
+	merchantRepository    repository.MerchantRepository
+	cardRepository        repository.CardRepository
+	feeScheduleRepository repository.FeeScheduleRepository
+	unitOfWork            repository.UnitOfWork
+	logger                logger.LoggerInterface
+	mapping               responseservice.InvoiceResponseMapper
+	transactionMapping    responseservice.TransactionResponseMapper
+}
+
+func NewInvoiceService(
+	invoiceRepository repository.InvoiceRepository,
+	merchantRepository repository.MerchantRepository,
+	cardRepository repository.CardRepository,
+	feeScheduleRepository repository.FeeScheduleRepository,
+	unitOfWork repository.UnitOfWork,
+	logger logger.LoggerInterface,
+	mapping responseservice.InvoiceResponseMapper,
+	transactionMapping responseservice.TransactionResponseMapper,
+) *invoiceService {
+	return &invoiceService{
+		invoiceRepository:     invoiceRepository,
+		merchantRepository:    merchantRepository,
+		cardRepository:        cardRepository,
+		feeScheduleRepository: feeScheduleRepository,
+		unitOfWork:            unitOfWork,
+		logger:                logger,
+		mapping:               mapping,
+		transactionMapping:    transactionMapping,
+	}
+}
+
+func (s *invoiceService) FindByMerchant(req *requests.FindInvoicesByMerchant) ([]*response.InvoiceResponse, *int, *response.ErrorResponse) {
+	s.logger.Debug("Fetching invoices", zap.Int("merchant_id", req.MerchantID))
+
+	if _, errResp := s.checkMerchant(req.MerchantID, req.RequestedBy); errResp != nil {
+		return nil, nil, errResp
+	}
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	invoices, totalRecords, err := s.invoiceRepository.FindByMerchant(req)
+	if err != nil {
+		s.logger.Error("Failed to fetch invoices", zap.Error(err))
+		return nil, nil, invoice_errors.ErrFailedFindInvoices
+	}
+
+	return s.mapping.ToInvoicesResponse(invoices), totalRecords, nil
+}
+
+func (s *invoiceService) FindById(invoiceID int, requestedBy *int) (*response.InvoiceResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching invoice", zap.Int("invoice_id", invoiceID))
+
+	invoice, errResp := s.findOwnedInvoice(invoiceID, requestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	return s.withLineItems(invoice)
+}
+
+// FindByCode shows any customer holding the code what it asks them to pay.
+func (s *invoiceService) FindByCode(code string) (*response.InvoiceResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching invoice by code")
+
+	invoice, err := s.invoiceRepository.FindByCode(code)
+	if err != nil {
+		s.logger.Error("Failed to find invoice by code", zap.Error(err))
+		return nil, invoice_errors.ErrInvoiceNotFound
+	}
+
+	return s.withLineItems(invoice)
+}
+
+func (s *invoiceService) FindPayments(invoiceID int, requestedBy *int) ([]*response.InvoicePaymentResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching invoice payments", zap.Int("invoice_id", invoiceID))
+
+	if _, errResp := s.findOwnedInvoice(invoiceID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	payments, err := s.invoiceRepository.FindPayments(invoiceID)
+	if err != nil {
+		s.logger.Error("Failed to fetch invoice payments", zap.Error(err))
+		return nil, invoice_errors.ErrFailedFindInvoicePayments
+	}
+
+	return s.mapping.ToInvoicePaymentsResponse(payments), nil
+}
+
+// Create issues an invoice or payment link in the currency the merchant is
+// settled in, which is the currency of the first card of its owner.
+func (s *invoiceService) Create(request *requests.CreateInvoiceRequest) (*response.InvoiceResponse, *response.ErrorResponse) {
+	s.logger.Debug("Creating invoice",
+		zap.Int("merchant_id", request.MerchantID),
+		zap.String("kind", request.Kind),
+		zap.Int("amount", request.Amount),
+	)
+
+	merchant, errResp := s.checkMerchant(request.MerchantID, request.RequestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if errResp := checkMerchantActive(merchant); errResp != nil {
+		s.logger.Error("invoice for an inactive merchant",
+			zap.Int("merchant_id", merchant.ID),
+			zap.String("status", merchant.Status),
+		)
+		return nil, errResp
+	}
+
+	if !request.ExpiresAt.After(time.Now()) {
+		return nil, invoice_errors.ErrInvoiceExpiryInPast
+	}
+
+	if len(request.LineItems) > 0 {
+		total := 0
+		for _, item := range request.LineItems {
+			total += item.Quantity * item.UnitPrice
+		}
+
+		if total != request.Amount {
+			s.logger.Error("invoice line items do not add up to the amount",
+				zap.Int("amount", request.Amount),
+				zap.Int("line_items_total", total),
+			)
+			return nil, invoice_errors.ErrInvoiceLineItemsMismatch
+		}
+	}
+
+	merchantCard, err := s.cardRepository.FindCardByUserId(merchant.UserID)
+	if err != nil {
+		s.logger.Error("failed to find merchant card", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	if errResp := checkMinimumAmount(request.Amount, merchantCard.Currency); errResp != nil {
+		s.logger.Error("invoice amount below the currency minimum", zap.Int("amount", request.Amount), zap.String("currency", merchantCard.Currency))
+		return nil, errResp
+	}
+
+	code, err := generateInvoiceCode()
+	if err != nil {
+		s.logger.Error("failed to generate invoice code", zap.Error(err))
+		return nil, invoice_errors.ErrFailedGenerateInvoiceCode
+	}
+
+	var (
+		invoice   *record.InvoiceRecord
+		lineItems []*record.InvoiceLineItemRecord
+	)
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		var err error
+
+		invoice, err = repos.Invoice.CreateInvoice(&requests.CreateInvoice{
+			Code:        code,
+			MerchantID:  merchant.ID,
+			Kind:        request.Kind,
+			Description: request.Description,
+			Amount:      request.Amount,
+			Currency:    merchantCard.Currency,
+			ExpiresAt:   request.ExpiresAt,
+		})
+		if err != nil {
+			s.logger.Error("failed to create invoice", zap.Error(err))
+			return invoice_errors.ErrFailedCreateInvoice
+		}
+
+		for _, item := range request.LineItems {
+			lineItem, err := repos.Invoice.CreateLineItem(&requests.CreateInvoiceLineItem{
+				InvoiceID:   invoice.ID,
+				Description: item.Description,
+				Quantity:    item.Quantity,
+				UnitPrice:   item.UnitPrice,
+				Amount:      item.Quantity * item.UnitPrice,
+			})
+			if err != nil {
+				s.logger.Error("failed to create invoice line item", zap.Error(err))
+				return invoice_errors.ErrFailedCreateInvoice
+			}
+
+			lineItems = append(lineItems, lineItem)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, response.ToErrorResponse(err, invoice_errors.ErrFailedCreateInvoice)
+	}
+
+	s.logger.Debug("Invoice created", zap.Int("invoice_id", invoice.ID))
+
+	return s.mapping.ToInvoiceResponse(invoice, lineItems), nil
+}
+
+func (s *invoiceService) Cancel(invoiceID int, requestedBy *int) (*response.InvoiceResponse, *response.ErrorResponse) {
+	s.logger.Debug("Cancelling invoice", zap.Int("invoice_id", invoiceID))
+
+	invoice, errResp := s.findOwnedInvoice(invoiceID, requestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if !statemachine.Invoice.CanTransition(invoice.Status, statemachine.StatusCancelled) {
+		return nil, invoice_errors.ErrInvoiceNotOpen
+	}
+
+	invoice, err := s.invoiceRepository.Cancel(invoiceID)
+	if err != nil {
+		s.logger.Error("failed to cancel invoice", zap.Error(err))
+
+		if errors.Is(err, invoice_errors.ErrInvoiceStatusConflict) {
+			return nil, invoice_errors.ErrInvoiceNotOpen
+		}
+		return nil, invoice_errors.ErrFailedCancelInvoice
+	}
+
+	return s.withLineItems(invoice)
+}
+
+// Pay charges a card of the paying user for an invoice or payment link and
+// runs it as a merchant transaction: the amount goes to the pending balance
+// of the merchant less its discount rate. The transaction is only created
+// once the payment succeeded, so a failed payment leaves nothing behind.
+func (s *invoiceService) Pay(request *requests.PayInvoiceRequest) (*response.InvoicePaymentResponse, *response.ErrorResponse) {
+	s.logger.Debug("Starting PayInvoice process", zap.Int("user_id", request.UserID))
+
+	invoice, err := s.invoiceRepository.FindByCode(request.Code)
+	if err != nil {
+		s.logger.Error("failed to find invoice by code", zap.Error(err))
+		return nil, invoice_errors.ErrInvoiceNotFound
+	}
+
+	merchant, err := s.merchantRepository.FindById(invoice.MerchantID)
+	if err != nil {
+		s.logger.Error("failed to find merchant", zap.Error(err), zap.Int("merchant_id", invoice.MerchantID))
+		return nil, merchant_errors.ErrMerchantNotFoundRes
+	}
+
+	if errResp := checkMerchantActive(merchant); errResp != nil {
+		s.logger.Error("invoice payment to an inactive merchant",
+			zap.Int("merchant_id", merchant.ID),
+			zap.String("status", merchant.Status),
+		)
+		return nil, errResp
+	}
+
+	card, err := s.cardRepository.FindCardByCardNumber(request.CardNumber)
+	if err != nil {
+		s.logger.Error("failed to find card", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	if card.UserID != request.UserID {
+		s.logger.Error("invoice paid with a card of another user",
+			zap.Int("user_id", request.UserID),
+			zap.Int("card_id", card.ID),
+		)
+		return nil, invoice_errors.ErrInvoiceCardNotOwned
+	}
+
+	if card.Currency != invoice.Currency {
+		s.logger.Error("card and invoice currencies differ",
+			zap.String("card_currency", card.Currency),
+			zap.String("invoice_currency", invoice.Currency),
+		)
+		return nil, card_errors.ErrCurrencyMismatch
+	}
+
+	fee, err := quoteFee(s.feeScheduleRepository, &requests.FindApplicableFeeSchedule{
+		TransactionType: requests.FeeTransactionTransaction,
+		PaymentMethod:   request.PaymentMethod,
+		CardType:        card.CardType,
+		Currency:        invoice.Currency,
+	}, invoice.Amount)
+	if err != nil {
+		s.logger.Error("failed to quote merchant discount rate", zap.Error(err))
+		return nil, fee_schedule_errors.ErrFailedQuoteFee
+	}
+
+	if fee >= invoice.Amount {
+		s.logger.Error("merchant discount rate exceeds amount", zap.Int("fee", fee), zap.Int("amount", invoice.Amount))
+		return nil, fee_schedule_errors.ErrFeeExceedsAmount
+	}
+
+	var payment *record.InvoicePaymentRecord
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		locked, err := repos.Invoice.FindOpenByCodeForUpdate(request.Code)
+		if err != nil {
+			s.logger.Error("failed to lock invoice", zap.Error(err))
+
+			if errors.Is(err, invoice_errors.ErrInvoiceStatusConflict) {
+				return invoice_errors.ErrInvoiceNotPayable
+			}
+			return invoice_errors.ErrFailedPayInvoice
+		}
+
+		// The invoice may have changed since it was looked up; from here on
+		// only the locked row counts.
+		saldos, err := lockSaldos(repos, card.CardNumber)
+		if err != nil {
+			s.logger.Error("failed to lock card saldo", zap.Error(err))
+			return saldo_errors.ErrFailedSaldoNotFound
+		}
+
+		if availableBalance(saldos[card.CardNumber]) < locked.Amount {
+			s.logger.Error("insufficient balance",
+				zap.Int("AvailableBalance", availableBalance(saldos[card.CardNumber])),
+				zap.Int("InvoiceAmount", locked.Amount),
+			)
+			return invoice_errors.ErrInsufficientAvailableBalance
+		}
+
+		if err := enforceTransactionLimits(repos, requests.LimitOperationTransaction, card.CardNumber, locked.Amount, time.Now()); err != nil {
+			s.logger.Error("invoice payment rejected by transaction limits", zap.Error(err), zap.String("card_number", card.CardNumber))
+			return err
+		}
+
+		transaction, err := repos.Transaction.CreateTransaction(&requests.CreateTransactionRequest{
+			CardNumber:      card.CardNumber,
+			Amount:          locked.Amount,
+			PaymentMethod:   request.PaymentMethod,
+			MerchantID:      &merchant.ID,
+			TransactionTime: time.Now(),
+			Fee:             fee,
+		})
+		if err != nil {
+			s.logger.Error("failed to create invoice transaction", zap.Error(err))
+			return transaction_errors.ErrFailedCreateTransaction
+		}
+
+		if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+			requests.LedgerReferenceTransaction,
+			transaction.ID,
+			"Payment of invoice "+locked.Code+" from card "+card.CardNumber+" to merchant "+merchant.Name,
+			requests.CardLedgerAccount(card.CardNumber),
+			requests.SystemLedgerAccount(requests.LedgerAccountMerchantSettlement),
+			locked.Amount,
+			locked.Currency,
+		).WithFee(requests.SystemLedgerAccount(requests.LedgerAccountMerchantSettlement), transaction.Fee, transaction.Currency)); err != nil {
+			s.logger.Error("failed to post invoice payment journal", zap.Error(err))
+			return ledger_errors.ErrFailedPostLedgerJournal
+		}
+
+		if err := accrueSettlementItem(repos.Settlement, &requests.CreateSettlementItem{
+			MerchantID:  merchant.ID,
+			ItemType:    requests.SettlementItemTransaction,
+			ReferenceID: transaction.ID,
+			Amount:      transaction.Amount,
+			Fee:         transaction.Fee,
+			NetAmount:   transaction.Amount - transaction.Fee,
+			Currency:    transaction.Currency,
+		}); err != nil {
+			s.logger.Error("failed to accrue invoice payment to merchant balance", zap.Error(err))
+			return settlement_errors.ErrFailedAccrueSettlementItem
+		}
+
+		transaction, err = repos.Transaction.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
+			TransactionID: transaction.ID,
+			Status:        "success",
+		})
+		if err != nil {
+			s.logger.Error("failed to update transaction status", zap.Error(err))
+			return transaction_errors.ErrFailedUpdateTransaction
+		}
+
+		payment, err = repos.Invoice.CreatePayment(&requests.CreateInvoicePayment{
+			InvoiceID:     locked.ID,
+			TransactionID: transaction.ID,
+			Amount:        transaction.Amount,
+		})
+		if err != nil {
+			s.logger.Error("failed to record invoice payment", zap.Error(err))
+			return invoice_errors.ErrFailedPayInvoice
+		}
+
+		// A payment link stays open for the next customer.
+		if locked.Kind == requests.InvoiceKindInvoice {
+			if _, err := repos.Invoice.MarkPaid(locked.ID); err != nil {
+				s.logger.Error("failed to mark invoice paid", zap.Error(err))
+				return invoice_errors.ErrFailedPayInvoice
+			}
+		}
+
+		if err := publishMerchantEvent(repos.MerchantWebhook, merchant.ID, requests.MerchantEventTransactionSuccess, s.transactionMapping.ToTransactionResponse(transaction)); err != nil {
+			s.logger.Error("failed to queue merchant webhook", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to pay invoice, transaction rolled back", zap.Error(err))
+
+		return nil, response.ToErrorResponse(err, invoice_errors.ErrFailedPayInvoice)
+	}
+
+	s.logger.Debug("PayInvoice process completed",
+		zap.Int("invoice_id", payment.InvoiceID),
+		zap.Int("transaction_id", payment.TransactionID),
+	)
+
+	return s.mapping.ToInvoicePaymentResponse(payment), nil
+}
+
+func (s *invoiceService) ExpireStale() (int, *response.ErrorResponse) {
+	count, err := s.invoiceRepository.ExpireInvoices()
+	if err != nil {
+		s.logger.Error("failed to expire invoices", zap.Error(err))
+		return 0, invoice_errors.ErrFailedExpireInvoices
+	}
+
+	return count, nil
+}
+
+func (s *invoiceService) withLineItems(invoice *record.InvoiceRecord) (*response.InvoiceResponse, *response.ErrorResponse) {
+	lineItems, err := s.invoiceRepository.FindLineItems(invoice.ID)
+	if err != nil {
+		s.logger.Error("Failed to fetch invoice line items", zap.Error(err), zap.Int("invoice_id", invoice.ID))
+		return nil, invoice_errors.ErrFailedFindInvoices
+	}
+
+	return s.mapping.ToInvoiceResponse(invoice, lineItems), nil
+}
+
+func (s *invoiceService) checkMerchant(merchantID int, requestedBy *int) (*record.MerchantRecord, *response.ErrorResponse) {
+	merchant, err := s.merchantRepository.FindById(merchantID)
+	if err != nil {
+		s.logger.Error("Failed to find merchant", zap.Error(err), zap.Int("merchant_id", merchantID))
+		return nil, merchant_errors.ErrMerchantNotFoundRes
+	}
+
+	if requestedBy != nil && merchant.UserID != *requestedBy {
+		s.logger.Error("unauthorized invoice request",
+			zap.Int("merchant_id", merchantID),
+			zap.Int("requested_by", *requestedBy),
+		)
+		return nil, invoice_errors.ErrInvoiceNotAllowed
+	}
+
+	return merchant, nil
+}
+
+func (s *invoiceService) findOwnedInvoice(invoiceID int, requestedBy *int) (*record.InvoiceRecord, *response.ErrorResponse) {
+	invoice, err := s.invoiceRepository.FindById(invoiceID)
+	if err != nil {
+		s.logger.Error("Failed to find invoice", zap.Error(err), zap.Int("invoice_id", invoiceID))
+		return nil, invoice_errors.ErrInvoiceNotFound
+	}
+
+	if _, errResp := s.checkMerchant(invoice.MerchantID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	return invoice, nil
+}
+
+// generateInvoiceCode returns a random code that is safe to put in a URL and
+// read out over the phone.
+func generateInvoiceCode() (string, error) {
+	b := make([]byte, invoiceCodeBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return strings.ToLower(base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString(b)), nil
+}
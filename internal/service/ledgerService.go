@@ -0,0 +1,163 @@
+package service
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/ledger_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+
+	"go.uber.org/zap"
+)
+
+type ledgerService struct {
+	ledgerRepository repository.LedgerRepository
+	saldoRepository  repository.SaldoRepository
+	cardRepository   repository.CardRepository
+	logger           logger.LoggerInterface
+	mapping          responseservice.LedgerResponseMapper
+}
+
+func NewLedgerService(ledger repository.LedgerRepository, saldo repository.SaldoRepository, card repository.CardRepository, logger logger.LoggerInterface, mapping responseservice.LedgerResponseMapper) *ledgerService {
+	return &ledgerService{
+		ledgerRepository: ledger,
+		saldoRepository:  saldo,
+		cardRepository:   card,
+		logger:           logger,
+		mapping:          mapping,
+	}
+}
+
+func (s *ledgerService) FindAllJournals(req *requests.FindAllLedgerJournals) ([]*response.LedgerJournalResponse, *int, *response.ErrorResponse) {
+	page := req.Page
+	pageSize := req.PageSize
+	search := req.Search
+
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	s.logger.Debug("Fetching ledger journals", zap.Int("page", page), zap.Int("pageSize", pageSize), zap.String("search", search))
+
+	res, totalRecords, err := s.ledgerRepository.FindAllJournals(req)
+
+	if err != nil {
+		s.logger.Error("Failed to fetch ledger journals",
+			zap.Error(err),
+			zap.Int("page", page),
+			zap.Int("pageSize", pageSize),
+			zap.String("search", search))
+
+		return nil, nil, ledger_errors.ErrFailedFindAllLedgerJournals
+	}
+
+	so := s.mapping.ToLedgerJournalResponses(res)
+
+	s.logger.Debug("Successfully fetched ledger journals",
+		zap.Int("totalRecords", *totalRecords),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return so, totalRecords, nil
+}
+
+func (s *ledgerService) FindJournalById(journal_id int) (*response.LedgerJournalResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching ledger journal by ID", zap.Int("journal_id", journal_id))
+
+	res, err := s.ledgerRepository.FindJournalById(journal_id)
+
+	if err != nil {
+		s.logger.Error("Failed to find ledger journal", zap.Error(err), zap.Int("journal_id", journal_id))
+		return nil, ledger_errors.ErrLedgerJournalNotFound
+	}
+
+	so := s.mapping.ToLedgerJournalResponse(res)
+
+	s.logger.Debug("Successfully fetched ledger journal", zap.Int("journal_id", journal_id))
+
+	return so, nil
+}
+
+func (s *ledgerService) FindPostingsByCardNumber(req *requests.FindLedgerPostingsByCardNumber) ([]*response.LedgerCardPostingResponse, *int, *response.ErrorResponse) {
+	s.logger.Debug("Fetching ledger postings by card number",
+		zap.String("card_number", req.CardNumber),
+		zap.Int("page", req.Page),
+		zap.Int("pageSize", req.PageSize))
+
+	if errResp := s.checkCardOwner(req.CardNumber, req.RequestedBy); errResp != nil {
+		return nil, nil, errResp
+	}
+
+	res, totalRecords, err := s.ledgerRepository.FindPostingsByCardNumber(req)
+
+	if err != nil {
+		s.logger.Error("Failed to fetch ledger postings", zap.Error(err), zap.String("card_number", req.CardNumber))
+		return nil, nil, ledger_errors.ErrFailedFindLedgerPostings
+	}
+
+	so := s.mapping.ToLedgerCardPostingResponses(res)
+
+	s.logger.Debug("Successfully fetched ledger postings",
+		zap.String("card_number", req.CardNumber),
+		zap.Int("totalRecords", *totalRecords))
+
+	return so, totalRecords, nil
+}
+
+func (s *ledgerService) FindBalanceByCardNumber(card_number string, requestedBy *int) (*response.LedgerBalanceResponse, *response.ErrorResponse) {
+	s.logger.Debug("Computing ledger balance", zap.String("card_number", card_number))
+
+	if errResp := s.checkCardOwner(card_number, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	saldo, err := s.saldoRepository.FindByCardNumber(card_number)
+
+	if err != nil {
+		s.logger.Error("Failed to find saldo by card number", zap.Error(err), zap.String("card_number", card_number))
+		return nil, saldo_errors.ErrFailedSaldoNotFound
+	}
+
+	balance, err := s.ledgerRepository.FindBalanceByCardNumber(card_number)
+
+	if err != nil {
+		s.logger.Error("Failed to compute ledger balance", zap.Error(err), zap.String("card_number", card_number))
+		return nil, ledger_errors.ErrFailedFindLedgerBalance
+	}
+
+	so := s.mapping.ToLedgerBalanceResponse(balance, saldo)
+
+	if so.Difference != 0 {
+		s.logger.Error("Saldo projection differs from ledger",
+			zap.String("card_number", card_number),
+			zap.Int("ledger_balance", so.LedgerBalance),
+			zap.Int("saldo_balance", so.SaldoBalance))
+	}
+
+	return so, nil
+}
+
+// checkCardOwner makes sure the card exists and, unless requestedBy is nil
+// for an admin, belongs to the requesting user.
+func (s *ledgerService) checkCardOwner(cardNumber string, requestedBy *int) *response.ErrorResponse {
+	card, err := s.cardRepository.FindCardByCardNumber(cardNumber)
+	if err != nil {
+		s.logger.Error("Failed to find card", zap.Error(err), zap.String("card_number", cardNumber))
+		return card_errors.ErrCardNotFoundRes
+	}
+
+	if requestedBy != nil && card.UserID != *requestedBy {
+		s.logger.Error("Unauthorized ledger request",
+			zap.String("card_number", cardNumber),
+			zap.Int("requested_by", *requestedBy))
+		return ledger_errors.ErrLedgerCardNotAllowed
+	}
+
+	return nil
+}
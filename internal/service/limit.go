@@ -0,0 +1,85 @@
+package service
+
+import (
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/currency"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transaction_limit_errors"
+)
+
+// checkMinimumAmount rejects an amount below the smallest one accepted in the
+// currency of the card it is moved from.
+func checkMinimumAmount(amount int, cardCurrency string) *response.ErrorResponse {
+	if amount < currency.MinimumAmount(cardCurrency) {
+		return transaction_limit_errors.ErrAmountBelowMinimum
+	}
+
+	return nil
+}
+
+// enforceTransactionLimits rejects an operation that would breach a limit of
+// the card. It must run after the card's saldo is locked, so operations on
+// the same card are serialized and every accepted one is counted.
+func enforceTransactionLimits(repos *repository.Repositories, operationType, cardNumber string, amount int, now time.Time) error {
+	return checkTransactionLimits(repos, operationType, cardNumber, amount, 1, amount, now)
+}
+
+// enforceAmendedTransactionLimits rejects raising the amount of an operation
+// to amount when that would breach a limit of the card. The operation is
+// already part of the card's usage, so only the increase is added to it.
+// Like enforceTransactionLimits, it must run after the saldo is locked.
+func enforceAmendedTransactionLimits(repos *repository.Repositories, operationType, cardNumber string, amount, increase int, now time.Time) error {
+	return checkTransactionLimits(repos, operationType, cardNumber, amount, 0, increase, now)
+}
+
+// checkTransactionLimits checks an operation of amount against the limits of
+// the card, as if addedCount operations totalling addedAmount were added to
+// its usage.
+func checkTransactionLimits(repos *repository.Repositories, operationType, cardNumber string, amount, addedCount, addedAmount int, now time.Time) error {
+	limits, err := repos.TransactionLimit.FindApplicable(operationType, cardNumber)
+	if err != nil {
+		return transaction_limit_errors.ErrFailedCheckTransactionLimits
+	}
+
+	for _, limit := range limits {
+		if limit.MaxAmountPerTransaction != nil && amount > *limit.MaxAmountPerTransaction {
+			return transaction_limit_errors.ErrAmountPerTransactionExceeded
+		}
+
+		if limit.MaxCount == nil && limit.MaxTotalAmount == nil {
+			continue
+		}
+
+		start, _ := limitPeriod(limit.Period, now)
+
+		usage, err := repos.TransactionLimit.FindUsage(operationType, cardNumber, start)
+		if err != nil {
+			return transaction_limit_errors.ErrFailedCheckTransactionLimits
+		}
+
+		if limit.MaxCount != nil && addedCount > 0 && usage.Count+addedCount > *limit.MaxCount {
+			return transaction_limit_errors.ErrCountLimitExceeded
+		}
+
+		if limit.MaxTotalAmount != nil && usage.TotalAmount+addedAmount > *limit.MaxTotalAmount {
+			return transaction_limit_errors.ErrTotalAmountLimitExceeded
+		}
+	}
+
+	return nil
+}
+
+// limitPeriod returns the start of the period containing now and the moment
+// the period resets.
+func limitPeriod(period string, now time.Time) (time.Time, time.Time) {
+	if period == requests.LimitPeriodMonthly {
+		start := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+		return start, start.AddDate(0, 1, 0)
+	}
+
+	start := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return start, start.AddDate(0, 0, 1)
+}
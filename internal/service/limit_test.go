@@ -0,0 +1,261 @@
+package service
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	mock_repository "github.com/MamangRust/paymentgatewaygraphql/internal/repository/mocks"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transaction_limit_errors"
+	"go.uber.org/mock/gomock"
+)
+
+func TestLimitPeriod(t *testing.T) {
+	jakarta := time.FixedZone("WIB", 7*60*60)
+
+	tests := []struct {
+		name      string
+		period    string
+		now       time.Time
+		wantStart time.Time
+		wantReset time.Time
+	}{
+		{
+			name:      "daily",
+			period:    requests.LimitPeriodDaily,
+			now:       time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC),
+			wantStart: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "daily at midnight",
+			period:    requests.LimitPeriodDaily,
+			now:       time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "daily just before midnight",
+			period:    requests.LimitPeriodDaily,
+			now:       time.Date(2025, 3, 14, 23, 59, 59, 999999999, time.UTC),
+			wantStart: time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2025, 3, 15, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "daily across month end",
+			period:    requests.LimitPeriodDaily,
+			now:       time.Date(2025, 1, 31, 12, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2025, 1, 31, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "daily in the server time zone",
+			period:    requests.LimitPeriodDaily,
+			now:       time.Date(2025, 3, 14, 3, 0, 0, 0, jakarta),
+			wantStart: time.Date(2025, 3, 14, 0, 0, 0, 0, jakarta),
+			wantReset: time.Date(2025, 3, 15, 0, 0, 0, 0, jakarta),
+		},
+		{
+			name:      "monthly",
+			period:    requests.LimitPeriodMonthly,
+			now:       time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC),
+			wantStart: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "monthly on the first instant",
+			period:    requests.LimitPeriodMonthly,
+			now:       time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2025, 4, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "monthly on the last day",
+			period:    requests.LimitPeriodMonthly,
+			now:       time.Date(2025, 1, 31, 23, 59, 59, 0, time.UTC),
+			wantStart: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2025, 2, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "monthly in a leap february",
+			period:    requests.LimitPeriodMonthly,
+			now:       time.Date(2024, 2, 29, 8, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "monthly across year end",
+			period:    requests.LimitPeriodMonthly,
+			now:       time.Date(2025, 12, 31, 18, 0, 0, 0, time.UTC),
+			wantStart: time.Date(2025, 12, 1, 0, 0, 0, 0, time.UTC),
+			wantReset: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start, reset := limitPeriod(tt.period, tt.now)
+			if !start.Equal(tt.wantStart) || !reset.Equal(tt.wantReset) {
+				t.Errorf("limitPeriod = %v, %v, want %v, %v", start, reset, tt.wantStart, tt.wantReset)
+			}
+		})
+	}
+}
+
+func TestCheckTransactionLimits(t *testing.T) {
+	const cardNumber = "4111111111111111"
+
+	now := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+	dayStart := time.Date(2025, 3, 14, 0, 0, 0, 0, time.UTC)
+	monthStart := time.Date(2025, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	daily := func(maxCount, maxTotal *int) *record.TransactionLimitRecord {
+		return &record.TransactionLimitRecord{Period: requests.LimitPeriodDaily, MaxCount: maxCount, MaxTotalAmount: maxTotal}
+	}
+
+	errRepo := errors.New("connection reset")
+
+	tests := []struct {
+		name        string
+		limits      []*record.TransactionLimitRecord
+		findErr     error
+		usage       *record.TransactionLimitUsageRecord
+		usageErr    error
+		usageSince  time.Time
+		amount      int
+		addedCount  int
+		addedAmount int
+		want        error
+	}{
+		{
+			name:        "no limits",
+			amount:      1000000,
+			addedCount:  1,
+			addedAmount: 1000000,
+		},
+		{
+			name:        "at per-transaction cap",
+			limits:      []*record.TransactionLimitRecord{{MaxAmountPerTransaction: intPtr(500000)}},
+			amount:      500000,
+			addedCount:  1,
+			addedAmount: 500000,
+		},
+		{
+			name:        "above per-transaction cap",
+			limits:      []*record.TransactionLimitRecord{{MaxAmountPerTransaction: intPtr(500000)}},
+			amount:      500001,
+			addedCount:  1,
+			addedAmount: 500001,
+			want:        transaction_limit_errors.ErrAmountPerTransactionExceeded,
+		},
+		{
+			name:        "last operation allowed by count",
+			limits:      []*record.TransactionLimitRecord{daily(intPtr(5), nil)},
+			usage:       &record.TransactionLimitUsageRecord{Count: 4, TotalAmount: 200000},
+			usageSince:  dayStart,
+			amount:      50000,
+			addedCount:  1,
+			addedAmount: 50000,
+		},
+		{
+			name:        "count exceeded",
+			limits:      []*record.TransactionLimitRecord{daily(intPtr(5), nil)},
+			usage:       &record.TransactionLimitUsageRecord{Count: 5, TotalAmount: 250000},
+			usageSince:  dayStart,
+			amount:      50000,
+			addedCount:  1,
+			addedAmount: 50000,
+			want:        transaction_limit_errors.ErrCountLimitExceeded,
+		},
+		{
+			name:        "amendment is not counted again",
+			limits:      []*record.TransactionLimitRecord{daily(intPtr(5), nil)},
+			usage:       &record.TransactionLimitUsageRecord{Count: 5, TotalAmount: 250000},
+			usageSince:  dayStart,
+			amount:      80000,
+			addedAmount: 30000,
+		},
+		{
+			name:        "total reaches the limit",
+			limits:      []*record.TransactionLimitRecord{daily(nil, intPtr(1000000))},
+			usage:       &record.TransactionLimitUsageRecord{Count: 3, TotalAmount: 900000},
+			usageSince:  dayStart,
+			amount:      100000,
+			addedCount:  1,
+			addedAmount: 100000,
+		},
+		{
+			name:        "total exceeded",
+			limits:      []*record.TransactionLimitRecord{daily(nil, intPtr(1000000))},
+			usage:       &record.TransactionLimitUsageRecord{Count: 3, TotalAmount: 900000},
+			usageSince:  dayStart,
+			amount:      100001,
+			addedCount:  1,
+			addedAmount: 100001,
+			want:        transaction_limit_errors.ErrTotalAmountLimitExceeded,
+		},
+		{
+			name:        "amendment increase exceeds total",
+			limits:      []*record.TransactionLimitRecord{daily(nil, intPtr(1000000))},
+			usage:       &record.TransactionLimitUsageRecord{Count: 3, TotalAmount: 950000},
+			usageSince:  dayStart,
+			amount:      200000,
+			addedAmount: 60000,
+			want:        transaction_limit_errors.ErrTotalAmountLimitExceeded,
+		},
+		{
+			name:        "monthly usage counted from the first of the month",
+			limits:      []*record.TransactionLimitRecord{{Period: requests.LimitPeriodMonthly, MaxTotalAmount: intPtr(5000000)}},
+			usage:       &record.TransactionLimitUsageRecord{Count: 10, TotalAmount: 4000000},
+			usageSince:  monthStart,
+			amount:      1000000,
+			addedCount:  1,
+			addedAmount: 1000000,
+		},
+		{
+			name:        "limits cannot be read",
+			findErr:     errRepo,
+			amount:      50000,
+			addedCount:  1,
+			addedAmount: 50000,
+			want:        transaction_limit_errors.ErrFailedCheckTransactionLimits,
+		},
+		{
+			name:        "usage cannot be read",
+			limits:      []*record.TransactionLimitRecord{daily(intPtr(5), nil)},
+			usageErr:    errRepo,
+			usageSince:  dayStart,
+			amount:      50000,
+			addedCount:  1,
+			addedAmount: 50000,
+			want:        transaction_limit_errors.ErrFailedCheckTransactionLimits,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			limitRepo := mock_repository.NewMockTransactionLimitRepository(ctrl)
+
+			limitRepo.EXPECT().
+				FindApplicable(requests.LimitOperationTransfer, cardNumber).
+				Return(tt.limits, tt.findErr)
+
+			if tt.usage != nil || tt.usageErr != nil {
+				limitRepo.EXPECT().
+					FindUsage(requests.LimitOperationTransfer, cardNumber, tt.usageSince).
+					Return(tt.usage, tt.usageErr)
+			}
+
+			repos := &repository.Repositories{TransactionLimit: limitRepo}
+
+			err := checkTransactionLimits(repos, requests.LimitOperationTransfer, cardNumber, tt.amount, tt.addedCount, tt.addedAmount, now)
+			if err != tt.want {
+				t.Errorf("checkTransactionLimits = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
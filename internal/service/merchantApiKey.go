@@ -0,0 +1,66 @@
+package service
+
+import (
+	"slices"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	apikey "github.com/MamangRust/paymentgatewaygraphql/pkg/api-key"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_api_key_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// authenticateMerchantApiKey returns the merchant the API key of credential
+// belongs to. The key must not be revoked or expired, and must carry scope
+// unless scope is empty. Using a key is recorded on it; failing to record
+// that doesn't fail the call.
+func authenticateMerchantApiKey(
+	apiKeyRepository repository.MerchantApiKeyRepository,
+	merchantRepository repository.MerchantRepository,
+	logger logger.LoggerInterface,
+	credential requests.MerchantApiKeyCredential,
+	scope string,
+) (*record.MerchantRecord, *response.ErrorResponse) {
+	var (
+		key *record.MerchantApiKeyRecord
+		err error
+	)
+
+	if credential.ApiKeyID != 0 {
+		key, err = apiKeyRepository.FindActiveById(credential.ApiKeyID)
+	} else {
+		key, err = apiKeyRepository.FindByHash(apikey.Hash(credential.ApiKey))
+	}
+	if err != nil {
+		logger.Error("unknown, revoked or expired merchant api key",
+			zap.Error(err),
+			zap.Int("api_key_id", credential.ApiKeyID),
+			zap.String("key_prefix", apikey.Prefix(credential.ApiKey)),
+		)
+		return nil, merchant_api_key_errors.ErrInvalidMerchantApiKey
+	}
+
+	if scope != "" && !slices.Contains(key.Scopes, scope) {
+		logger.Error("merchant api key is missing a scope",
+			zap.Int("api_key_id", key.ID),
+			zap.String("scope", scope),
+		)
+		return nil, merchant_api_key_errors.ErrMerchantApiKeyMissingScope
+	}
+
+	merchant, err := merchantRepository.FindById(key.MerchantID)
+	if err != nil {
+		logger.Error("failed to find merchant of api key", zap.Error(err), zap.Int("api_key_id", key.ID))
+		return nil, merchant_errors.ErrMerchantNotFoundRes
+	}
+
+	if err := apiKeyRepository.TouchApiKey(key.ID); err != nil {
+		logger.Error("failed to record merchant api key use", zap.Error(err), zap.Int("api_key_id", key.ID))
+	}
+
+	return merchant, nil
+}
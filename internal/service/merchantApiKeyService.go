@@ -0,0 +1,338 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	apikey "github.com/MamangRust/paymentgatewaygraphql/pkg/api-key"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_api_key_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type merchantApiKeyService struct {
+	merchantApiKeyRepository repository.MerchantApiKeyRepository
+	merchantRepository       repository.MerchantRepository
+	unitOfWork               repository.UnitOfWork
+	signatureTolerance       time.Duration
+	secretSealer             *apikey.SecretSealer
+	logger                   logger.LoggerInterface
+	mapping                  responseservice.MerchantApiKeyResponseMapper
+	merchantMapping          responseservice.MerchantResponseMapper
+}
+
+func NewMerchantApiKeyService(
+	merchantApiKeyRepository repository.MerchantApiKeyRepository,
+	merchantRepository repository.MerchantRepository,
+	unitOfWork repository.UnitOfWork,
+	signatureTolerance time.Duration,
+	secretSealer *apikey.SecretSealer,
+	logger logger.LoggerInterface,
+	mapping responseservice.MerchantApiKeyResponseMapper,
+	merchantMapping responseservice.MerchantResponseMapper,
+) *merchantApiKeyService {
+	return &merchantApiKeyService{
+		merchantApiKeyRepository: merchantApiKeyRepository,
+		merchantRepository:       merchantRepository,
+		unitOfWork:               unitOfWork,
+		signatureTolerance:       signatureTolerance,
+		secretSealer:             secretSealer,
+		logger:                   logger,
+		mapping:                  mapping,
+		merchantMapping:          merchantMapping,
+	}
+}
+
+func (s *merchantApiKeyService) FindByMerchant(merchantID int, requestedBy *int) ([]*response.MerchantApiKeyResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching merchant api keys", zap.Int("merchant_id", merchantID))
+
+	if errResp := s.checkMerchant(merchantID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	keys, err := s.merchantApiKeyRepository.FindByMerchant(merchantID)
+	if err != nil {
+		s.logger.Error("Failed to fetch merchant api keys", zap.Error(err), zap.Int("merchant_id", merchantID))
+		return nil, merchant_api_key_errors.ErrFailedFindMerchantApiKeys
+	}
+
+	return s.mapping.ToMerchantApiKeysResponse(keys), nil
+}
+
+// Create issues a new key with its signing secret. Only the hash of the key
+// is stored, so the key and its secret are only returned here; a merchant
+// that loses them creates or rotates a key.
+func (s *merchantApiKeyService) Create(request *requests.CreateMerchantApiKeyRequest) (*response.MerchantApiKeyResponse, *response.ErrorResponse) {
+	s.logger.Debug("Creating merchant api key", zap.Int("merchant_id", request.MerchantID))
+
+	if errResp := s.checkMerchant(request.MerchantID, request.RequestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	if request.ExpiresAt != nil && !request.ExpiresAt.After(time.Now()) {
+		s.logger.Error("merchant api key expiry is in the past", zap.Time("expires_at", *request.ExpiresAt))
+		return nil, merchant_api_key_errors.ErrMerchantApiKeyExpiryInPast
+	}
+
+	key := apikey.GenerateApiKey()
+	secret := apikey.GenerateSigningSecret()
+
+	sealedSecret, err := s.secretSealer.Seal(secret)
+	if err != nil {
+		s.logger.Error("Failed to seal merchant api key signing secret", zap.Error(err))
+		return nil, merchant_api_key_errors.ErrFailedCreateMerchantApiKey
+	}
+
+	created, err := s.merchantApiKeyRepository.CreateApiKey(&requests.CreateMerchantApiKey{
+		MerchantID:    request.MerchantID,
+		Label:         request.Label,
+		KeyPrefix:     apikey.Prefix(key),
+		KeyHash:       apikey.Hash(key),
+		Scopes:        request.Scopes,
+		ExpiresAt:     request.ExpiresAt,
+		SigningSecret: sealedSecret,
+	})
+	if err != nil {
+		s.logger.Error("Failed to create merchant api key", zap.Error(err))
+		return nil, merchant_api_key_errors.ErrFailedCreateMerchantApiKey
+	}
+
+	so := s.mapping.ToMerchantApiKeyResponse(created)
+	so.ApiKey = &key
+	so.SigningSecret = &secret
+
+	s.logger.Debug("Successfully created merchant api key", zap.Int("api_key_id", created.ID))
+
+	return so, nil
+}
+
+// Rotate issues a key with the label and scopes of an existing one and lets
+// the old key expire after the grace period, so clients can switch over
+// without downtime. The new key and its signing secret are only returned
+// here.
+func (s *merchantApiKeyService) Rotate(request *requests.RotateMerchantApiKeyRequest) (*response.MerchantApiKeyResponse, *response.ErrorResponse) {
+	s.logger.Debug("Rotating merchant api key", zap.Int("api_key_id", request.ApiKeyID))
+
+	old, errResp := s.findOwnedApiKey(request.ApiKeyID, request.RequestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if old.RevokedAt != nil {
+		s.logger.Error("cannot rotate a revoked merchant api key", zap.Int("api_key_id", old.ID))
+		return nil, merchant_api_key_errors.ErrMerchantApiKeyRevoked
+	}
+
+	key := apikey.GenerateApiKey()
+	secret := apikey.GenerateSigningSecret()
+	gracePeriodEnd := time.Now().Add(time.Duration(request.GracePeriodHours) * time.Hour)
+
+	sealedSecret, err := s.secretSealer.Seal(secret)
+	if err != nil {
+		s.logger.Error("Failed to seal merchant api key signing secret", zap.Error(err))
+		return nil, merchant_api_key_errors.ErrFailedRotateMerchantApiKey
+	}
+
+	var created *record.MerchantApiKeyRecord
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		var err error
+
+		created, err = repos.MerchantApiKey.CreateApiKey(&requests.CreateMerchantApiKey{
+			MerchantID:    old.MerchantID,
+			Label:         old.Label,
+			KeyPrefix:     apikey.Prefix(key),
+			KeyHash:       apikey.Hash(key),
+			Scopes:        old.Scopes,
+			SigningSecret: sealedSecret,
+		})
+		if err != nil {
+			return err
+		}
+
+		_, err = repos.MerchantApiKey.ExpireApiKey(old.ID, gracePeriodEnd)
+
+		return err
+	})
+	if err != nil {
+		s.logger.Error("Failed to rotate merchant api key", zap.Error(err), zap.Int("api_key_id", old.ID))
+		return nil, merchant_api_key_errors.ErrFailedRotateMerchantApiKey
+	}
+
+	so := s.mapping.ToMerchantApiKeyResponse(created)
+	so.ApiKey = &key
+	so.SigningSecret = &secret
+
+	s.logger.Debug("Successfully rotated merchant api key",
+		zap.Int("old_api_key_id", old.ID),
+		zap.Int("api_key_id", created.ID),
+		zap.Time("old_key_expires_at", gracePeriodEnd),
+	)
+
+	return so, nil
+}
+
+// Revoke stops a key from working at once.
+func (s *merchantApiKeyService) Revoke(apiKeyID int, requestedBy *int) (*response.MerchantApiKeyResponse, *response.ErrorResponse) {
+	s.logger.Debug("Revoking merchant api key", zap.Int("api_key_id", apiKeyID))
+
+	key, errResp := s.findOwnedApiKey(apiKeyID, requestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if key.RevokedAt != nil {
+		s.logger.Error("merchant api key is already revoked", zap.Int("api_key_id", key.ID))
+		return nil, merchant_api_key_errors.ErrMerchantApiKeyRevoked
+	}
+
+	revoked, err := s.merchantApiKeyRepository.RevokeApiKey(key.ID)
+	if err != nil {
+		s.logger.Error("Failed to revoke merchant api key", zap.Error(err), zap.Int("api_key_id", key.ID))
+		return nil, merchant_api_key_errors.ErrFailedRevokeMerchantApiKey
+	}
+
+	return s.mapping.ToMerchantApiKeyResponse(revoked), nil
+}
+
+// Authenticate returns the merchant the API key of credential belongs to,
+// provided the key is live and carries scope.
+func (s *merchantApiKeyService) Authenticate(credential requests.MerchantApiKeyCredential, scope string) (*response.MerchantResponse, *response.ErrorResponse) {
+	merchant, errResp := authenticateMerchantApiKey(s.merchantApiKeyRepository, s.merchantRepository, s.logger, credential, scope)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	return s.merchantMapping.ToMerchantResponse(merchant), nil
+}
+
+// VerifySignature checks a request signed with the signing secret of a live
+// key and returns that key. The nonce is only recorded once the signature
+// checks out, so nobody but the merchant can use up its nonces; a nonce the
+// key already used means the request is a replay.
+func (s *merchantApiKeyService) VerifySignature(request *requests.VerifyMerchantRequestSignature) (*response.MerchantApiKeyResponse, *response.ErrorResponse) {
+	apiKeyID, err := strconv.Atoi(request.ApiKeyID)
+	if err != nil || apiKeyID <= 0 {
+		s.logger.Error("signed request without a valid api key id", zap.String("api_key_id", request.ApiKeyID))
+		return nil, merchant_api_key_errors.ErrInvalidMerchantApiKey
+	}
+
+	key, err := s.merchantApiKeyRepository.FindActiveById(apiKeyID)
+	if err != nil {
+		s.logger.Error("signed request with an unknown, revoked or expired api key", zap.Error(err), zap.Int("api_key_id", apiKeyID))
+		return nil, merchant_api_key_errors.ErrInvalidMerchantApiKey
+	}
+
+	secret, err := s.secretSealer.Open(key.SigningSecret)
+	if err != nil {
+		s.logger.Error("failed to open merchant api key signing secret", zap.Error(err), zap.Int("api_key_id", key.ID))
+		return nil, merchant_api_key_errors.ErrFailedVerifyRequest
+	}
+
+	err = apikey.VerifyRequest(secret, request.Method, request.Path, request.Timestamp, request.Nonce, request.Signature, request.Body, s.signatureTolerance, time.Now())
+	if err != nil {
+		s.logger.Error("rejected signed request", zap.Error(err), zap.Int("api_key_id", key.ID))
+		return nil, merchant_api_key_errors.ErrInvalidRequestSignature
+	}
+
+	if err := s.merchantApiKeyRepository.UseNonce(key.ID, request.Nonce); err != nil {
+		if errors.Is(err, merchant_api_key_errors.ErrMerchantRequestNonceUsed) {
+			s.logger.Error("replayed signed request", zap.Int("api_key_id", key.ID), zap.String("nonce", request.Nonce))
+			return nil, merchant_api_key_errors.ErrMerchantRequestReplayed
+		}
+
+		s.logger.Error("failed to record request nonce", zap.Error(err), zap.Int("api_key_id", key.ID))
+		return nil, merchant_api_key_errors.ErrFailedVerifyRequest
+	}
+
+	return s.mapping.ToMerchantApiKeyResponse(key), nil
+}
+
+// PruneNonces deletes the nonces that can no longer be replayed. A nonce is
+// recorded up to the allowed skew before or after the timestamp it was
+// signed with, and that timestamp is accepted up to the skew after it, so a
+// nonce older than twice the skew only comes with rejected timestamps.
+func (s *merchantApiKeyService) PruneNonces() (int, *response.ErrorResponse) {
+	before := time.Now().Add(-2 * s.signatureTolerance)
+
+	count, err := s.merchantApiKeyRepository.DeleteNoncesBefore(before)
+	if err != nil {
+		s.logger.Error("Failed to prune request nonces", zap.Error(err))
+		return 0, merchant_api_key_errors.ErrFailedPruneRequestNonces
+	}
+
+	return count, nil
+}
+
+// SealSigningSecrets encrypts the signing secrets stored in plain text,
+// either before secrets were encrypted or by the seeder, and returns how
+// many it sealed. Signatures made with a plain secret can't be verified
+// until it is sealed.
+func (s *merchantApiKeyService) SealSigningSecrets() (int, *response.ErrorResponse) {
+	keys, err := s.merchantApiKeyRepository.FindWithPlainSigningSecret()
+	if err != nil {
+		s.logger.Error("Failed to find plain signing secrets", zap.Error(err))
+		return 0, merchant_api_key_errors.ErrFailedSealSigningSecret
+	}
+
+	sealedCount := 0
+
+	for _, key := range keys {
+		sealed, err := s.secretSealer.Seal(key.SigningSecret)
+		if err != nil {
+			s.logger.Error("Failed to seal signing secret", zap.Error(err), zap.Int("api_key_id", key.ID))
+			return sealedCount, merchant_api_key_errors.ErrFailedSealSigningSecret
+		}
+
+		ok, err := s.merchantApiKeyRepository.SealSigningSecret(key.ID, key.SigningSecret, sealed)
+		if err != nil {
+			s.logger.Error("Failed to seal signing secret", zap.Error(err), zap.Int("api_key_id", key.ID))
+			return sealedCount, merchant_api_key_errors.ErrFailedSealSigningSecret
+		}
+
+		if ok {
+			sealedCount++
+		}
+	}
+
+	return sealedCount, nil
+}
+
+func (s *merchantApiKeyService) checkMerchant(merchantID int, requestedBy *int) *response.ErrorResponse {
+	merchant, err := s.merchantRepository.FindById(merchantID)
+	if err != nil {
+		s.logger.Error("Failed to find merchant", zap.Error(err), zap.Int("merchant_id", merchantID))
+		return merchant_errors.ErrMerchantNotFoundRes
+	}
+
+	if requestedBy != nil && merchant.UserID != *requestedBy {
+		s.logger.Error("unauthorized merchant api key request",
+			zap.Int("merchant_id", merchantID),
+			zap.Int("requested_by", *requestedBy),
+		)
+		return merchant_api_key_errors.ErrMerchantApiKeyNotAllowed
+	}
+
+	return nil
+}
+
+func (s *merchantApiKeyService) findOwnedApiKey(apiKeyID int, requestedBy *int) (*record.MerchantApiKeyRecord, *response.ErrorResponse) {
+	key, err := s.merchantApiKeyRepository.FindById(apiKeyID)
+	if err != nil {
+		s.logger.Error("Failed to find merchant api key", zap.Error(err), zap.Int("api_key_id", apiKeyID))
+		return nil, merchant_api_key_errors.ErrMerchantApiKeyNotFound
+	}
+
+	if errResp := s.checkMerchant(key.MerchantID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	return key, nil
+}
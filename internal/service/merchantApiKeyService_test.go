@@ -0,0 +1,92 @@
+package service
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	mock_repository "github.com/MamangRust/paymentgatewaygraphql/internal/repository/mocks"
+	apikey "github.com/MamangRust/paymentgatewaygraphql/pkg/api-key"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_api_key_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/mock/gomock"
+	"go.uber.org/zap"
+)
+
+func TestVerifySignatureNonces(t *testing.T) {
+	const (
+		apiKeyID      = 7
+		signingSecret = "sk_sign_test"
+		nonce         = "3f1c9a0e6b2d4f8a"
+	)
+
+	sealer, err := apikey.NewSecretSealer(base64.StdEncoding.EncodeToString(make([]byte, 32)))
+	if err != nil {
+		t.Fatalf("NewSecretSealer: %v", err)
+	}
+
+	sealed, err := sealer.Seal(signingSecret)
+	if err != nil {
+		t.Fatalf("Seal: %v", err)
+	}
+
+	body := []byte(`{"query":"mutation { createTransaction }"}`)
+	now := time.Now()
+
+	signed := func(secret string) *requests.VerifyMerchantRequestSignature {
+		return &requests.VerifyMerchantRequestSignature{
+			ApiKeyID:  strconv.Itoa(apiKeyID),
+			Method:    "POST",
+			Path:      "/query",
+			Timestamp: strconv.FormatInt(now.Unix(), 10),
+			Nonce:     nonce,
+			Signature: apikey.SignRequest(secret, "POST", "/query", now, nonce, body),
+			Body:      body,
+		}
+	}
+
+	tests := []struct {
+		name     string
+		request  *requests.VerifyMerchantRequestSignature
+		useNonce bool
+		nonceErr error
+		want     *response.ErrorResponse
+	}{
+		{"first use", signed(signingSecret), true, nil, nil},
+		{"replayed nonce", signed(signingSecret), true, merchant_api_key_errors.ErrMerchantRequestNonceUsed, merchant_api_key_errors.ErrMerchantRequestReplayed},
+		{"nonce cannot be recorded", signed(signingSecret), true, errors.New("connection reset"), merchant_api_key_errors.ErrFailedVerifyRequest},
+		{"invalid signature spends no nonce", signed("sk_sign_other"), false, nil, merchant_api_key_errors.ErrInvalidRequestSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			keyRepo := mock_repository.NewMockMerchantApiKeyRepository(ctrl)
+
+			keyRepo.EXPECT().
+				FindActiveById(apiKeyID).
+				Return(&record.MerchantApiKeyRecord{ID: apiKeyID, MerchantID: 1, SigningSecret: sealed}, nil)
+
+			if tt.useNonce {
+				keyRepo.EXPECT().UseNonce(apiKeyID, nonce).Return(tt.nonceErr)
+			}
+
+			s := NewMerchantApiKeyService(keyRepo, nil, nil, 5*time.Minute, sealer, &logger.Logger{Log: zap.NewNop()},
+				responseservice.NewMerchantApiKeyResponseMapper(), responseservice.NewMerchantResponseMapper())
+
+			res, errResp := s.VerifySignature(tt.request)
+			if errResp != tt.want {
+				t.Fatalf("VerifySignature error = %v, want %v", errResp, tt.want)
+			}
+			if tt.want == nil && res.ID != apiKeyID {
+				t.Errorf("VerifySignature key = %d, want %d", res.ID, apiKeyID)
+			}
+		})
+	}
+}
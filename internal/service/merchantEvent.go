@@ -0,0 +1,37 @@
+package service
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/google/uuid"
+)
+
+// publishMerchantEvent queues an event for every webhook endpoint of the
+// merchant subscribed to it; the delivery job sends it. Called with the
+// repositories of a unit of work, the event is only sent if the change it
+// describes is committed.
+func publishMerchantEvent(repo repository.MerchantWebhookRepository, merchantID int, eventType string, data any) error {
+	eventID := uuid.New()
+
+	payload, err := json.Marshal(&requests.MerchantWebhookEvent{
+		ID:        eventID.String(),
+		Type:      eventType,
+		CreatedAt: time.Now().UTC(),
+		Data:      data,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = repo.QueueDeliveries(&requests.QueueMerchantWebhookDeliveries{
+		MerchantID: merchantID,
+		EventID:    eventID,
+		EventType:  eventType,
+		Payload:    string(payload),
+	})
+
+	return err
+}
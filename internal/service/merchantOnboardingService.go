@@ -0,0 +1,225 @@
+package service
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_onboarding_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/statemachine"
+	"go.uber.org/zap"
+)
+
+type merchantOnboardingService struct {
+	merchantOnboardingRepository repository.MerchantOnboardingRepository
+	merchantRepository           repository.MerchantRepository
+	unitOfWork                   repository.UnitOfWork
+	logger                       logger.LoggerInterface
+	mapping                      responseservice.MerchantOnboardingResponseMapper
+	merchantMapping              responseservice.MerchantResponseMapper
+}
+
+func NewMerchantOnboardingService(
+	merchantOnboardingRepository repository.MerchantOnboardingRepository,
+	merchantRepository repository.MerchantRepository,
+	unitOfWork repository.UnitOfWork,
+	logger logger.LoggerInterface,
+	mapping responseservice.MerchantOnboardingResponseMapper,
+	merchantMapping responseservice.MerchantResponseMapper,
+) *merchantOnboardingService {
+	return &merchantOnboardingService{
+		merchantOnboardingRepository: merchantOnboardingRepository,
+		merchantRepository:           merchantRepository,
+		unitOfWork:                   unitOfWork,
+		logger:                       logger,
+		mapping:                      mapping,
+		merchantMapping:              merchantMapping,
+	}
+}
+
+// Submit stores the business details of a pending or rejected merchant and
+// puts it in review.
+func (s *merchantOnboardingService) Submit(request *requests.SubmitMerchantOnboardingRequest) (*response.MerchantBusinessProfileResponse, *response.ErrorResponse) {
+	s.logger.Debug("Submitting merchant onboarding", zap.Int("merchant_id", request.MerchantID))
+
+	merchant, errResp := s.findOwnedMerchant(request.MerchantID, request.RequestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if !statemachine.Merchant.CanTransition(merchant.Status, statemachine.StatusInReview) {
+		s.logger.Error("Merchant onboarding submitted in the wrong status",
+			zap.Int("merchant_id", request.MerchantID),
+			zap.String("status", merchant.Status),
+		)
+		return nil, merchant_onboarding_errors.ErrOnboardingAlreadySubmitted
+	}
+
+	var profile *record.MerchantBusinessProfileRecord
+
+	err := s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		var err error
+
+		profile, err = repos.MerchantOnboarding.UpsertBusinessProfile(request)
+		if err != nil {
+			return err
+		}
+
+		_, err = repos.Merchant.UpdateMerchantStatus(&requests.UpdateMerchantStatus{
+			MerchantID: request.MerchantID,
+			Status:     statemachine.StatusInReview,
+			ChangedBy:  request.RequestedBy,
+		})
+
+		return err
+	})
+
+	if err != nil {
+		s.logger.Error("Failed to submit merchant onboarding", zap.Error(err), zap.Int("merchant_id", request.MerchantID))
+
+		if errors.Is(err, merchant_errors.ErrMerchantStatusConflict) {
+			return nil, merchant_onboarding_errors.ErrOnboardingAlreadySubmitted
+		}
+		return nil, merchant_onboarding_errors.ErrFailedSubmitOnboarding
+	}
+
+	s.logger.Debug("Merchant onboarding submitted", zap.Int("merchant_id", request.MerchantID))
+
+	return s.mapping.ToMerchantBusinessProfileResponse(profile), nil
+}
+
+func (s *merchantOnboardingService) Approve(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse) {
+	return s.review(request, statemachine.StatusActive, false)
+}
+
+func (s *merchantOnboardingService) Reject(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse) {
+	return s.review(request, statemachine.StatusRejected, true)
+}
+
+func (s *merchantOnboardingService) Suspend(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse) {
+	return s.review(request, statemachine.StatusSuspended, true)
+}
+
+func (s *merchantOnboardingService) Reactivate(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse) {
+	return s.review(request, statemachine.StatusActive, false)
+}
+
+func (s *merchantOnboardingService) Terminate(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse) {
+	return s.review(request, statemachine.StatusTerminated, true)
+}
+
+func (s *merchantOnboardingService) FindBusinessProfile(merchantID int, requestedBy *int) (*response.MerchantBusinessProfileResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching merchant business profile", zap.Int("merchant_id", merchantID))
+
+	if _, errResp := s.findOwnedMerchant(merchantID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	profile, err := s.merchantOnboardingRepository.FindBusinessProfile(merchantID)
+	if err != nil {
+		s.logger.Error("Failed to fetch merchant business profile", zap.Error(err), zap.Int("merchant_id", merchantID))
+
+		if errors.Is(err, merchant_onboarding_errors.ErrBusinessProfileNotFound) {
+			return nil, merchant_onboarding_errors.ErrBusinessProfileNotFoundRes
+		}
+		return nil, merchant_onboarding_errors.ErrFailedFindBusinessProfile
+	}
+
+	return s.mapping.ToMerchantBusinessProfileResponse(profile), nil
+}
+
+func (s *merchantOnboardingService) FindByStatus(req *requests.FindMerchantsByStatus) ([]*response.MerchantResponse, *int, *response.ErrorResponse) {
+	s.logger.Debug("Fetching merchants by status", zap.String("status", req.Status))
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	merchants, totalRecords, err := s.merchantOnboardingRepository.FindByStatus(req)
+	if err != nil {
+		s.logger.Error("Failed to fetch merchants by status", zap.Error(err), zap.String("status", req.Status))
+		return nil, nil, merchant_onboarding_errors.ErrFailedFindMerchantsByStatus
+	}
+
+	return s.merchantMapping.ToMerchantsResponse(merchants), totalRecords, nil
+}
+
+// review moves a merchant to status on behalf of an admin. Decisions that
+// take a merchant out of business must say why.
+func (s *merchantOnboardingService) review(request *requests.ReviewMerchantRequest, status string, reasonRequired bool) (*response.MerchantResponse, *response.ErrorResponse) {
+	s.logger.Debug("Reviewing merchant",
+		zap.Int("merchant_id", request.MerchantID),
+		zap.String("status", status),
+		zap.Int("reviewed_by", request.ReviewedBy),
+	)
+
+	if reasonRequired && (request.Reason == nil || strings.TrimSpace(*request.Reason) == "") {
+		return nil, merchant_onboarding_errors.ErrMerchantReviewReasonRequired
+	}
+
+	if _, err := s.merchantRepository.FindById(request.MerchantID); err != nil {
+		s.logger.Error("Failed to find merchant", zap.Error(err), zap.Int("merchant_id", request.MerchantID))
+		return nil, merchant_errors.ErrMerchantNotFoundRes
+	}
+
+	merchant, err := s.merchantRepository.UpdateMerchantStatus(&requests.UpdateMerchantStatus{
+		MerchantID: request.MerchantID,
+		Status:     status,
+		Reason:     request.Reason,
+		ChangedBy:  &request.ReviewedBy,
+	})
+
+	if err != nil {
+		s.logger.Error("Failed to update merchant status", zap.Error(err),
+			zap.Int("merchant_id", request.MerchantID),
+			zap.String("status", status),
+		)
+
+		if errors.Is(err, merchant_errors.ErrMerchantStatusConflict) {
+			return nil, merchant_onboarding_errors.ErrMerchantStatusConflictRes
+		}
+		return nil, merchant_onboarding_errors.ErrFailedUpdateMerchantStatusRes
+	}
+
+	s.logger.Debug("Merchant reviewed", zap.Int("merchant_id", merchant.ID), zap.String("status", merchant.Status))
+
+	return s.merchantMapping.ToMerchantResponse(merchant), nil
+}
+
+func (s *merchantOnboardingService) findOwnedMerchant(merchantID int, requestedBy *int) (*record.MerchantRecord, *response.ErrorResponse) {
+	merchant, err := s.merchantRepository.FindById(merchantID)
+	if err != nil {
+		s.logger.Error("Failed to find merchant", zap.Error(err), zap.Int("merchant_id", merchantID))
+		return nil, merchant_errors.ErrMerchantNotFoundRes
+	}
+
+	if requestedBy != nil && merchant.UserID != *requestedBy {
+		s.logger.Error("unauthorized merchant onboarding request",
+			zap.Int("merchant_id", merchantID),
+			zap.Int("requested_by", *requestedBy),
+		)
+		return nil, merchant_onboarding_errors.ErrMerchantOnboardingNotAllowed
+	}
+
+	return merchant, nil
+}
+
+// checkMerchantActive rejects payments made with the API key of a merchant
+// that has not been approved or was suspended or terminated.
+func checkMerchantActive(merchant *record.MerchantRecord) *response.ErrorResponse {
+	if merchant.Status != statemachine.StatusActive {
+		return merchant_errors.ErrMerchantNotActive
+	}
+
+	return nil
+}
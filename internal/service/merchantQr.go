@@ -0,0 +1,111 @@
+package service
+
+import (
+	"encoding/base64"
+	"strconv"
+	"strings"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/currency"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/qrcode"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/qris"
+)
+
+// MerchantQrConfig is what every merchant QR code carries besides the
+// merchant itself. AcquirerID is the globally unique identifier payloads
+// name this payment gateway by; only payloads carrying it can be paid here.
+type MerchantQrConfig struct {
+	AcquirerID   string
+	MerchantCity string
+}
+
+const (
+	// merchantQrAccountTag is the merchant account template the merchant ID
+	// is written to, the first one EMV leaves to payment networks.
+	merchantQrAccountTag = "26"
+
+	merchantQrCategoryCode = "5999"
+	merchantQrCountryCode  = "ID"
+	merchantQrNameLength   = 25
+	merchantQrCityLength   = 15
+	merchantQrPngScale     = 8
+)
+
+// encodeMerchantQr renders the QR code of a merchant. A zero amount gives a
+// static QR code the payer enters the amount for.
+func encodeMerchantQr(config MerchantQrConfig, merchant *record.MerchantRecord, cardCurrency string, amount int, reference string) (*response.MerchantQrResponse, error) {
+	name := merchantQrText(merchant.Name, merchantQrNameLength)
+	if name == "" {
+		name = "MERCHANT " + strconv.Itoa(merchant.ID)
+	}
+
+	payload := qris.Payload{
+		Dynamic: amount > 0,
+		MerchantAccounts: []qris.MerchantAccount{{
+			Tag:                      merchantQrAccountTag,
+			GloballyUniqueIdentifier: config.AcquirerID,
+			MerchantID:               strconv.Itoa(merchant.ID),
+		}},
+		MerchantCategoryCode: merchantQrCategoryCode,
+		Currency:             currency.NumericCode(cardCurrency),
+		CountryCode:          merchantQrCountryCode,
+		MerchantName:         name,
+		MerchantCity:         merchantQrText(config.MerchantCity, merchantQrCityLength),
+		Reference:            reference,
+	}
+
+	if amount > 0 {
+		payload.Amount = qris.FormatAmount(amount, currency.Exponent(cardCurrency))
+	}
+
+	encoded, err := qris.Encode(payload)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := qrcode.Encode([]byte(encoded), qrcode.Medium)
+	if err != nil {
+		return nil, err
+	}
+
+	png, err := code.PNG(merchantQrPngScale)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &response.MerchantQrResponse{
+		MerchantID: merchant.ID,
+		Dynamic:    payload.Dynamic,
+		Currency:   cardCurrency,
+		Payload:    encoded,
+		Png:        base64.StdEncoding.EncodeToString(png),
+		Svg:        code.SVG(),
+	}
+
+	if payload.Dynamic {
+		res.Amount = &amount
+		res.Reference = &reference
+	}
+
+	return res, nil
+}
+
+// merchantQrText keeps the printable ASCII characters of s, which is all a
+// payload field may hold, cut to the length the field allows.
+func merchantQrText(s string, length int) string {
+	var sb strings.Builder
+
+	for _, r := range s {
+		if r >= 0x20 && r <= 0x7E {
+			sb.WriteRune(r)
+		}
+	}
+
+	text := strings.TrimSpace(sb.String())
+	if len(text) > length {
+		text = strings.TrimSpace(text[:length])
+	}
+
+	return text
+}
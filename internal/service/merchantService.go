@@ -12,20 +12,23 @@ import (
 )
 
 type merchantService struct {
-	merchantRepository repository.MerchantRepository
-	logger             logger.LoggerInterface
-	mapping            responseservice.MerchantResponseMapper
+	merchantRepository       repository.MerchantRepository
+	merchantApiKeyRepository repository.MerchantApiKeyRepository
+	logger                   logger.LoggerInterface
+	mapping                  responseservice.MerchantResponseMapper
 }
 
 func NewMerchantService(
 	merchantRepository repository.MerchantRepository,
+	merchantApiKeyRepository repository.MerchantApiKeyRepository,
 	logger logger.LoggerInterface,
 	mapping responseservice.MerchantResponseMapper,
 ) *merchantService {
 	return &merchantService{
-		merchantRepository: merchantRepository,
-		logger:             logger,
-		mapping:            mapping,
+		merchantRepository:       merchantRepository,
+		merchantApiKeyRepository: merchantApiKeyRepository,
+		logger:                   logger,
+		mapping:                  mapping,
 	}
 }
 
@@ -332,6 +335,46 @@ func (s *merchantService) FindMonthlyAmountByMerchants(req *requests.MonthYearAm
 	return so, nil
 }
 
+func (s *merchantService) FindMonthlyDisputeRateByMerchants(req *requests.MonthYearAmountMerchant) ([]*response.MerchantResponseMonthlyDisputeRate, *response.ErrorResponse) {
+	year := req.Year
+	merchantID := req.MerchantID
+
+	s.logger.Debug("Finding monthly dispute rate by merchant", zap.Int("merchant_id", merchantID), zap.Int("year", year))
+
+	res, err := s.merchantRepository.GetMonthlyDisputeRateByMerchants(req)
+	if err != nil {
+		s.logger.Error("Failed to find monthly dispute rate by merchant", zap.Error(err), zap.Int("merchantID", merchantID), zap.Int("year", year))
+
+		return nil, merchant_errors.ErrFailedFindMonthlyDisputeRateByMerchants
+	}
+
+	so := s.mapping.ToMerchantMonthlyDisputeRates(res)
+
+	s.logger.Debug("Successfully found monthly dispute rate by merchant", zap.Int("merchantID", merchantID), zap.Int("year", year))
+
+	return so, nil
+}
+
+func (s *merchantService) FindYearlyDisputeRateByMerchants(req *requests.MonthYearAmountMerchant) ([]*response.MerchantResponseYearlyDisputeRate, *response.ErrorResponse) {
+	year := req.Year
+	merchantID := req.MerchantID
+
+	s.logger.Debug("Finding yearly dispute rate by merchant", zap.Int("merchant_id", merchantID), zap.Int("year", year))
+
+	res, err := s.merchantRepository.GetYearlyDisputeRateByMerchants(req)
+	if err != nil {
+		s.logger.Error("Failed to find yearly dispute rate by merchant", zap.Error(err), zap.Int("merchantID", merchantID), zap.Int("year", year))
+
+		return nil, merchant_errors.ErrFailedFindYearlyDisputeRateByMerchants
+	}
+
+	so := s.mapping.ToMerchantYearlyDisputeRates(res)
+
+	s.logger.Debug("Successfully found yearly dispute rate by merchant", zap.Int("merchantID", merchantID), zap.Int("year", year))
+
+	return so, nil
+}
+
 func (s *merchantService) FindYearlyAmountByMerchants(req *requests.MonthYearAmountMerchant) ([]*response.MerchantResponseYearlyAmount, *response.ErrorResponse) {
 	year := req.Year
 	merchantID := req.MerchantID
@@ -414,14 +457,24 @@ func (s *merchantService) FindAllTransactionsByApikey(req *requests.FindAllMerch
 		pageSize = 10
 	}
 
-	merchants, totalRecords, err := s.merchantRepository.FindAllTransactionsByApikey(req)
+	merchant, errResp := authenticateMerchantApiKey(s.merchantApiKeyRepository, s.merchantRepository, s.logger, requests.MerchantApiKeyCredential{ApiKey: req.ApiKey}, requests.MerchantApiKeyScopeReadAnalytics)
+	if errResp != nil {
+		return nil, nil, errResp
+	}
+
+	merchants, totalRecords, err := s.merchantRepository.FindAllTransactionsByMerchant(&requests.FindAllMerchantTransactionsById{
+		MerchantID: merchant.ID,
+		Search:     search,
+		Page:       page,
+		PageSize:   pageSize,
+	})
 
 	if err != nil {
 		s.logger.Error("Failed to retrieve transaction merchant",
 			zap.Error(err),
-			zap.Int("page", req.Page),
-			zap.Int("pageSize", req.PageSize),
-			zap.String("search", req.Search))
+			zap.Int("page", page),
+			zap.Int("pageSize", pageSize),
+			zap.String("search", search))
 
 		return nil, nil, merchant_errors.ErrFailedFindAllTransactionsByApikey
 	}
@@ -437,127 +490,169 @@ func (s *merchantService) FindAllTransactionsByApikey(req *requests.FindAllMerch
 }
 
 func (s *merchantService) FindMonthlyPaymentMethodByApikeys(req *requests.MonthYearPaymentMethodApiKey) ([]*response.MerchantResponseMonthlyPaymentMethod, *response.ErrorResponse) {
-	api_key := req.Apikey
 	year := req.Year
 
-	s.logger.Debug("Finding monthly payment methods by merchant", zap.String("api_key", api_key), zap.Int("year", year))
+	s.logger.Debug("Finding monthly payment methods by API key", zap.Int("year", year))
+
+	merchant, errResp := authenticateMerchantApiKey(s.merchantApiKeyRepository, s.merchantRepository, s.logger, requests.MerchantApiKeyCredential{ApiKey: req.Apikey}, requests.MerchantApiKeyScopeReadAnalytics)
+	if errResp != nil {
+		return nil, errResp
+	}
 
-	res, err := s.merchantRepository.GetMonthlyPaymentMethodByApikey(req)
+	res, err := s.merchantRepository.GetMonthlyPaymentMethodByMerchants(&requests.MonthYearPaymentMethodMerchant{
+		MerchantID: merchant.ID,
+		Year:       year,
+	})
 
 	if err != nil {
-		s.logger.Error("Failed to find monthly payment methods by merchant", zap.Error(err), zap.String("api_key", api_key), zap.Int("year", year))
+		s.logger.Error("Failed to find monthly payment methods by merchant", zap.Error(err), zap.Int("merchantID", merchant.ID), zap.Int("year", year))
 
 		return nil, merchant_errors.ErrFailedFindMonthlyPaymentMethodByApikeys
 	}
 
 	so := s.mapping.ToMerchantMonthlyPaymentMethods(res)
 
-	s.logger.Debug("Successfully found monthly payment methods by merchant", zap.String("api_key", api_key), zap.Int("year", year))
+	s.logger.Debug("Successfully found monthly payment methods by merchant", zap.Int("merchantID", merchant.ID), zap.Int("year", year))
 
 	return so, nil
 }
 
 func (s *merchantService) FindYearlyPaymentMethodByApikeys(req *requests.MonthYearPaymentMethodApiKey) ([]*response.MerchantResponseYearlyPaymentMethod, *response.ErrorResponse) {
-	api_key := req.Apikey
 	year := req.Year
 
-	s.logger.Debug("Finding yearly payment methods by merchant", zap.String("api_key", api_key), zap.Int("year", year))
+	s.logger.Debug("Finding yearly payment methods by API key", zap.Int("year", year))
 
-	res, err := s.merchantRepository.GetYearlyPaymentMethodByApikey(req)
+	merchant, errResp := authenticateMerchantApiKey(s.merchantApiKeyRepository, s.merchantRepository, s.logger, requests.MerchantApiKeyCredential{ApiKey: req.Apikey}, requests.MerchantApiKeyScopeReadAnalytics)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	res, err := s.merchantRepository.GetYearlyPaymentMethodByMerchants(&requests.MonthYearPaymentMethodMerchant{
+		MerchantID: merchant.ID,
+		Year:       year,
+	})
 
 	if err != nil {
-		s.logger.Error("Failed to find yearly payment methods by merchant", zap.Error(err), zap.String("api_key", api_key), zap.Int("year", year))
+		s.logger.Error("Failed to find yearly payment methods by merchant", zap.Error(err), zap.Int("merchantID", merchant.ID), zap.Int("year", year))
 
 		return nil, merchant_errors.ErrFailedFindYearlyPaymentMethodByApikeys
 	}
 
 	so := s.mapping.ToMerchantYearlyPaymentMethods(res)
 
-	s.logger.Debug("Successfully found yearly payment methods by merchant", zap.String("api_key", api_key), zap.Int("year", year))
+	s.logger.Debug("Successfully found yearly payment methods by merchant", zap.Int("merchantID", merchant.ID), zap.Int("year", year))
 
 	return so, nil
 }
 
 func (s *merchantService) FindMonthlyAmountByApikeys(req *requests.MonthYearAmountApiKey) ([]*response.MerchantResponseMonthlyAmount, *response.ErrorResponse) {
-	api_key := req.Apikey
 	year := req.Year
 
-	s.logger.Debug("Finding monthly amount by merchant", zap.String("api_key", api_key), zap.Int("year", year))
+	s.logger.Debug("Finding monthly amount by API key", zap.Int("year", year))
 
-	res, err := s.merchantRepository.GetMonthlyAmountByApikey(req)
+	merchant, errResp := authenticateMerchantApiKey(s.merchantApiKeyRepository, s.merchantRepository, s.logger, requests.MerchantApiKeyCredential{ApiKey: req.Apikey}, requests.MerchantApiKeyScopeReadAnalytics)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	res, err := s.merchantRepository.GetMonthlyAmountByMerchants(&requests.MonthYearAmountMerchant{
+		MerchantID: merchant.ID,
+		Year:       year,
+	})
 
 	if err != nil {
-		s.logger.Error("Failed to find monthly amount by merchant", zap.Error(err), zap.String("api_key", api_key), zap.Int("year", year))
+		s.logger.Error("Failed to find monthly amount by merchant", zap.Error(err), zap.Int("merchantID", merchant.ID), zap.Int("year", year))
 
 		return nil, merchant_errors.ErrFailedFindMonthlyAmountByApikeys
 	}
 
 	so := s.mapping.ToMerchantMonthlyAmounts(res)
 
-	s.logger.Debug("Successfully found monthly amount by merchant", zap.String("api_key", api_key), zap.Int("year", year))
+	s.logger.Debug("Successfully found monthly amount by merchant", zap.Int("merchantID", merchant.ID), zap.Int("year", year))
 
 	return so, nil
 }
 
 func (s *merchantService) FindYearlyAmountByApikeys(req *requests.MonthYearAmountApiKey) ([]*response.MerchantResponseYearlyAmount, *response.ErrorResponse) {
-	api_key := req.Apikey
 	year := req.Year
 
-	s.logger.Debug("Finding yearly amount by merchant", zap.String("api_key", api_key), zap.Int("year", year))
+	s.logger.Debug("Finding yearly amount by API key", zap.Int("year", year))
+
+	merchant, errResp := authenticateMerchantApiKey(s.merchantApiKeyRepository, s.merchantRepository, s.logger, requests.MerchantApiKeyCredential{ApiKey: req.Apikey}, requests.MerchantApiKeyScopeReadAnalytics)
+	if errResp != nil {
+		return nil, errResp
+	}
 
-	res, err := s.merchantRepository.GetYearlyAmountByApikey(req)
+	res, err := s.merchantRepository.GetYearlyAmountByMerchants(&requests.MonthYearAmountMerchant{
+		MerchantID: merchant.ID,
+		Year:       year,
+	})
 
 	if err != nil {
-		s.logger.Error("Failed to find yearly amount by merchant", zap.Error(err), zap.String("api_key", api_key), zap.Int("year", year))
+		s.logger.Error("Failed to find yearly amount by merchant", zap.Error(err), zap.Int("merchantID", merchant.ID), zap.Int("year", year))
 
 		return nil, merchant_errors.ErrFailedFindYearlyAmountByApikeys
 	}
 
 	so := s.mapping.ToMerchantYearlyAmounts(res)
 
-	s.logger.Debug("Successfully found yearly amount by merchant", zap.String("api_key", api_key), zap.Int("year", year))
+	s.logger.Debug("Successfully found yearly amount by merchant", zap.Int("merchantID", merchant.ID), zap.Int("year", year))
 
 	return so, nil
 }
 
 func (s *merchantService) FindMonthlyTotalAmountByApikeys(req *requests.MonthYearTotalAmountApiKey) ([]*response.MerchantResponseMonthlyTotalAmount, *response.ErrorResponse) {
-	api_key := req.Apikey
 	year := req.Year
 
-	s.logger.Debug("Finding monthly amount by merchant", zap.String("api_key", api_key), zap.Int("year", year))
+	s.logger.Debug("Finding monthly amount by API key", zap.Int("year", year))
 
-	res, err := s.merchantRepository.GetMonthlyTotalAmountByApikey(req)
+	merchant, errResp := authenticateMerchantApiKey(s.merchantApiKeyRepository, s.merchantRepository, s.logger, requests.MerchantApiKeyCredential{ApiKey: req.Apikey}, requests.MerchantApiKeyScopeReadAnalytics)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	res, err := s.merchantRepository.GetMonthlyTotalAmountByMerchants(&requests.MonthYearTotalAmountMerchant{
+		MerchantID: merchant.ID,
+		Year:       year,
+	})
 
 	if err != nil {
-		s.logger.Error("Failed to find monthly amount by merchant", zap.Error(err), zap.String("api_key", api_key), zap.Int("year", year))
+		s.logger.Error("Failed to find monthly amount by merchant", zap.Error(err), zap.Int("merchantID", merchant.ID), zap.Int("year", year))
 
 		return nil, merchant_errors.ErrFailedFindMonthlyTotalAmountByApikeys
 	}
 
 	so := s.mapping.ToMerchantMonthlyTotalAmounts(res)
 
-	s.logger.Debug("Successfully found monthly amount by merchant", zap.String("api_key", api_key), zap.Int("year", year))
+	s.logger.Debug("Successfully found monthly amount by merchant", zap.Int("merchantID", merchant.ID), zap.Int("year", year))
 
 	return so, nil
 }
 
 func (s *merchantService) FindYearlyTotalAmountByApikeys(req *requests.MonthYearTotalAmountApiKey) ([]*response.MerchantResponseYearlyTotalAmount, *response.ErrorResponse) {
-	api_key := req.Apikey
 	year := req.Year
 
-	s.logger.Debug("Finding yearly amount by merchant", zap.String("api_key", api_key), zap.Int("year", year))
+	s.logger.Debug("Finding yearly amount by API key", zap.Int("year", year))
+
+	merchant, errResp := authenticateMerchantApiKey(s.merchantApiKeyRepository, s.merchantRepository, s.logger, requests.MerchantApiKeyCredential{ApiKey: req.Apikey}, requests.MerchantApiKeyScopeReadAnalytics)
+	if errResp != nil {
+		return nil, errResp
+	}
 
-	res, err := s.merchantRepository.GetYearlyTotalAmountByApikey(req)
+	res, err := s.merchantRepository.GetYearlyTotalAmountByMerchants(&requests.MonthYearTotalAmountMerchant{
+		MerchantID: merchant.ID,
+		Year:       year,
+	})
 
 	if err != nil {
-		s.logger.Error("Failed to find yearly amount by merchant", zap.Error(err), zap.String("api_key", api_key), zap.Int("year", year))
+		s.logger.Error("Failed to find yearly amount by merchant", zap.Error(err), zap.Int("merchantID", merchant.ID), zap.Int("year", year))
 
 		return nil, merchant_errors.ErrFailedFindYearlyTotalAmountByApikeys
 	}
 
 	so := s.mapping.ToMerchantYearlyTotalAmounts(res)
 
-	s.logger.Debug("Successfully found yearly amount by merchant", zap.String("api_key", api_key), zap.Int("year", year))
+	s.logger.Debug("Successfully found yearly amount by merchant", zap.Int("merchantID", merchant.ID), zap.Int("year", year))
 
 	return so, nil
 }
@@ -642,22 +737,19 @@ func (s *merchantService) FindByTrashed(req *requests.FindAllMerchants) ([]*resp
 	return so, totalRecords, nil
 }
 
+// FindByApiKey returns the merchant a live API key belongs to, whatever the
+// scopes of the key.
 func (s *merchantService) FindByApiKey(api_key string) (*response.MerchantResponse, *response.ErrorResponse) {
-	s.logger.Debug("Finding merchant by API key", zap.String("api_key", api_key))
-
-	res, err := s.merchantRepository.FindByApiKey(api_key)
-
-	if err != nil {
-		s.logger.Error("Failed to retrieve merchant by api_key",
-			zap.Error(err),
-			zap.String("api_key", api_key))
+	s.logger.Debug("Finding merchant by API key")
 
-		return nil, merchant_errors.ErrMerchantNotFoundRes
+	res, errResp := authenticateMerchantApiKey(s.merchantApiKeyRepository, s.merchantRepository, s.logger, requests.MerchantApiKeyCredential{ApiKey: api_key}, "")
+	if errResp != nil {
+		return nil, errResp
 	}
 
 	so := s.mapping.ToMerchantResponse(res)
 
-	s.logger.Debug("Successfully found merchant by API key", zap.String("api_key", api_key))
+	s.logger.Debug("Successfully found merchant by API key", zap.Int("merchant_id", res.ID))
 
 	return so, nil
 }
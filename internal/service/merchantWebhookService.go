@@ -0,0 +1,406 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	apikey "github.com/MamangRust/paymentgatewaygraphql/pkg/api-key"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_webhook_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/webhook"
+	"github.com/google/uuid"
+	"go.uber.org/zap"
+)
+
+const (
+	// merchantWebhookBatchSize caps how many deliveries a single DeliverDue
+	// call sends; the rest are picked up by the next call.
+	merchantWebhookBatchSize = 20
+
+	// merchantWebhookLease is how long a claimed delivery is kept from other
+	// workers. It must cover sending a whole batch.
+	merchantWebhookLease = 10 * time.Minute
+
+	// A delivery is retried after 30s, 1m, 2m and so on, and given up after
+	// merchantWebhookMaxAttempts attempts, a little over an hour later.
+	merchantWebhookRetryBase   = 30 * time.Second
+	merchantWebhookMaxAttempts = 8
+)
+
+type merchantWebhookService struct {
+	merchantWebhookRepository repository.MerchantWebhookRepository
+	merchantRepository        repository.MerchantRepository
+	unitOfWork                repository.UnitOfWork
+	sender                    *webhook.Sender
+	logger                    logger.LoggerInterface
+	mapping                   responseservice.MerchantWebhookResponseMapper
+}
+
+func NewMerchantWebhookService(
+	merchantWebhookRepository repository.MerchantWebhookRepository,
+	merchantRepository repository.MerchantRepository,
+	unitOfWork repository.UnitOfWork,
+	sender *webhook.Sender,
+	logger logger.LoggerInterface,
+	mapping responseservice.MerchantWebhookResponseMapper,
+) *merchantWebhookService {
+	return &merchantWebhookService{
+		merchantWebhookRepository: merchantWebhookRepository,
+		merchantRepository:        merchantRepository,
+		unitOfWork:                unitOfWork,
+		sender:                    sender,
+		logger:                    logger,
+		mapping:                   mapping,
+	}
+}
+
+func (s *merchantWebhookService) FindEndpoints(merchantID int, requestedBy *int) ([]*response.MerchantWebhookEndpointResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching merchant webhook endpoints", zap.Int("merchant_id", merchantID))
+
+	if errResp := s.checkMerchant(merchantID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	endpoints, err := s.merchantWebhookRepository.FindEndpointsByMerchant(merchantID)
+	if err != nil {
+		s.logger.Error("Failed to fetch merchant webhook endpoints", zap.Error(err), zap.Int("merchant_id", merchantID))
+		return nil, merchant_webhook_errors.ErrFailedFindMerchantWebhookEndpoints
+	}
+
+	return s.mapping.ToMerchantWebhookEndpointsResponse(endpoints), nil
+}
+
+func (s *merchantWebhookService) FindDeliveries(req *requests.FindMerchantWebhookDeliveries) ([]*response.MerchantWebhookDeliveryResponse, *int, *response.ErrorResponse) {
+	s.logger.Debug("Fetching merchant webhook deliveries", zap.Int("endpoint_id", req.EndpointID))
+
+	if _, errResp := s.findOwnedEndpoint(req.EndpointID, req.RequestedBy); errResp != nil {
+		return nil, nil, errResp
+	}
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	deliveries, totalRecords, err := s.merchantWebhookRepository.FindDeliveries(req)
+	if err != nil {
+		s.logger.Error("Failed to fetch merchant webhook deliveries", zap.Error(err))
+		return nil, nil, merchant_webhook_errors.ErrFailedFindMerchantWebhookDeliveries
+	}
+
+	return s.mapping.ToMerchantWebhookDeliveriesResponse(deliveries), totalRecords, nil
+}
+
+// FindDeliveryAttempts returns the delivery log of a delivery: every attempt
+// with the code the endpoint answered with.
+func (s *merchantWebhookService) FindDeliveryAttempts(deliveryID int, requestedBy *int) ([]*response.MerchantWebhookDeliveryAttemptResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching merchant webhook delivery log", zap.Int("delivery_id", deliveryID))
+
+	if _, _, errResp := s.findOwnedDelivery(deliveryID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	attempts, err := s.merchantWebhookRepository.FindDeliveryAttempts(deliveryID)
+	if err != nil {
+		s.logger.Error("Failed to fetch merchant webhook delivery log", zap.Error(err))
+		return nil, merchant_webhook_errors.ErrFailedFindMerchantWebhookDeliveryLog
+	}
+
+	return s.mapping.ToMerchantWebhookDeliveryAttemptsResponse(attempts), nil
+}
+
+// CreateEndpoint registers an endpoint with a new signing secret. The secret
+// is only returned here; a merchant that loses it registers a new endpoint.
+func (s *merchantWebhookService) CreateEndpoint(request *requests.CreateMerchantWebhookEndpointRequest) (*response.MerchantWebhookEndpointResponse, *response.ErrorResponse) {
+	s.logger.Debug("Creating merchant webhook endpoint", zap.Int("merchant_id", request.MerchantID))
+
+	if errResp := s.checkMerchant(request.MerchantID, request.RequestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	endpoint, err := s.merchantWebhookRepository.CreateEndpoint(&requests.CreateMerchantWebhookEndpoint{
+		MerchantID: request.MerchantID,
+		URL:        request.URL,
+		Secret:     "whsec_" + apikey.GenerateApiKey(),
+		Events:     request.Events,
+	})
+	if err != nil {
+		s.logger.Error("Failed to create merchant webhook endpoint", zap.Error(err))
+		return nil, merchant_webhook_errors.ErrFailedCreateMerchantWebhookEndpoint
+	}
+
+	so := s.mapping.ToMerchantWebhookEndpointResponse(endpoint)
+	so.Secret = &endpoint.Secret
+
+	s.logger.Debug("Successfully created merchant webhook endpoint", zap.Int("endpoint_id", endpoint.ID))
+
+	return so, nil
+}
+
+func (s *merchantWebhookService) UpdateEndpoint(request *requests.UpdateMerchantWebhookEndpointRequest) (*response.MerchantWebhookEndpointResponse, *response.ErrorResponse) {
+	s.logger.Debug("Updating merchant webhook endpoint", zap.Int("endpoint_id", request.EndpointID))
+
+	if _, errResp := s.findOwnedEndpoint(request.EndpointID, request.RequestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	endpoint, err := s.merchantWebhookRepository.UpdateEndpoint(request)
+	if err != nil {
+		s.logger.Error("Failed to update merchant webhook endpoint", zap.Error(err))
+		return nil, merchant_webhook_errors.ErrFailedUpdateMerchantWebhookEndpoint
+	}
+
+	return s.mapping.ToMerchantWebhookEndpointResponse(endpoint), nil
+}
+
+// DeleteEndpoint stops sending events to an endpoint. Its delivery log is
+// kept and its pending deliveries are no longer attempted.
+func (s *merchantWebhookService) DeleteEndpoint(endpointID int, requestedBy *int) (*response.MerchantWebhookEndpointResponse, *response.ErrorResponse) {
+	s.logger.Debug("Deleting merchant webhook endpoint", zap.Int("endpoint_id", endpointID))
+
+	if _, errResp := s.findOwnedEndpoint(endpointID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	endpoint, err := s.merchantWebhookRepository.DeleteEndpoint(endpointID)
+	if err != nil {
+		s.logger.Error("Failed to delete merchant webhook endpoint", zap.Error(err))
+		return nil, merchant_webhook_errors.ErrFailedDeleteMerchantWebhookEndpoint
+	}
+
+	return s.mapping.ToMerchantWebhookEndpointResponse(endpoint), nil
+}
+
+// SendTest sends a ping event to an endpoint and returns the outcome. A ping
+// is attempted once, whether or not the endpoint is active.
+func (s *merchantWebhookService) SendTest(endpointID int, requestedBy *int) (*response.MerchantWebhookDeliveryResponse, *response.ErrorResponse) {
+	s.logger.Debug("Sending merchant webhook ping", zap.Int("endpoint_id", endpointID))
+
+	endpoint, errResp := s.findOwnedEndpoint(endpointID, requestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	eventID := uuid.New()
+
+	payload, err := json.Marshal(&requests.MerchantWebhookEvent{
+		ID:        eventID.String(),
+		Type:      requests.MerchantEventPing,
+		CreatedAt: time.Now().UTC(),
+		Data: map[string]int{
+			"endpoint_id": endpoint.ID,
+			"merchant_id": endpoint.MerchantID,
+		},
+	})
+	if err != nil {
+		s.logger.Error("Failed to encode merchant webhook ping", zap.Error(err))
+		return nil, merchant_webhook_errors.ErrFailedCreateMerchantWebhookDelivery
+	}
+
+	return s.deliverNow(endpoint, eventID, requests.MerchantEventPing, string(payload))
+}
+
+// Redeliver sends an event to its endpoint again. The new delivery carries
+// the same event ID and body, so a merchant that already handled the event
+// can recognise it.
+func (s *merchantWebhookService) Redeliver(deliveryID int, requestedBy *int) (*response.MerchantWebhookDeliveryResponse, *response.ErrorResponse) {
+	s.logger.Debug("Redelivering merchant webhook", zap.Int("delivery_id", deliveryID))
+
+	delivery, endpoint, errResp := s.findOwnedDelivery(deliveryID, requestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	eventID, err := uuid.Parse(delivery.EventID)
+	if err != nil {
+		s.logger.Error("Invalid merchant webhook event ID", zap.Error(err), zap.String("event_id", delivery.EventID))
+		return nil, merchant_webhook_errors.ErrFailedCreateMerchantWebhookDelivery
+	}
+
+	return s.deliverNow(endpoint, eventID, delivery.EventType, delivery.Payload)
+}
+
+// DeliverDue sends the deliveries whose next attempt is due and returns how
+// many were attempted. A delivery that fails is retried with exponential
+// backoff until it has been attempted merchantWebhookMaxAttempts times.
+func (s *merchantWebhookService) DeliverDue() (int, *response.ErrorResponse) {
+	deliveries, err := s.merchantWebhookRepository.ClaimDueDeliveries(merchantWebhookBatchSize, time.Now().Add(merchantWebhookLease))
+	if err != nil {
+		s.logger.Error("Failed to claim due merchant webhook deliveries", zap.Error(err))
+		return 0, merchant_webhook_errors.ErrFailedClaimMerchantWebhookDeliveries
+	}
+
+	attempted := 0
+
+	for _, delivery := range deliveries {
+		endpoint, err := s.merchantWebhookRepository.FindEndpointById(delivery.EndpointID)
+		if err != nil {
+			s.logger.Error("Failed to find merchant webhook endpoint", zap.Error(err), zap.Int("delivery_id", delivery.ID))
+			continue
+		}
+
+		if _, err := s.deliver(endpoint, delivery); err != nil {
+			s.logger.Error("Failed to record merchant webhook delivery attempt", zap.Error(err), zap.Int("delivery_id", delivery.ID))
+			continue
+		}
+
+		attempted++
+	}
+
+	return attempted, nil
+}
+
+// deliverNow creates a delivery and sends it right away. The delivery is
+// created already leased, so the delivery job does not send it as well.
+func (s *merchantWebhookService) deliverNow(endpoint *record.MerchantWebhookEndpointRecord, eventID uuid.UUID, eventType, payload string) (*response.MerchantWebhookDeliveryResponse, *response.ErrorResponse) {
+	delivery, err := s.merchantWebhookRepository.CreateDelivery(&requests.CreateMerchantWebhookDelivery{
+		EndpointID:    endpoint.ID,
+		EventID:       eventID,
+		EventType:     eventType,
+		Payload:       payload,
+		NextAttemptAt: time.Now().Add(merchantWebhookLease),
+	})
+	if err != nil {
+		s.logger.Error("Failed to create merchant webhook delivery", zap.Error(err))
+		return nil, merchant_webhook_errors.ErrFailedCreateMerchantWebhookDelivery
+	}
+
+	delivery, err = s.deliver(endpoint, delivery)
+	if err != nil {
+		s.logger.Error("Failed to record merchant webhook delivery attempt", zap.Error(err), zap.Int("delivery_id", delivery.ID))
+		return nil, merchant_webhook_errors.ErrFailedRecordMerchantWebhookDeliveryAttempt
+	}
+
+	return s.mapping.ToMerchantWebhookDeliveryResponse(delivery), nil
+}
+
+// deliver makes one attempt to send a delivery and records its outcome in
+// the delivery log. Any 2xx answer counts as delivered.
+func (s *merchantWebhookService) deliver(endpoint *record.MerchantWebhookEndpointRecord, delivery *record.MerchantWebhookDeliveryRecord) (*record.MerchantWebhookDeliveryRecord, error) {
+	started := time.Now()
+
+	code, sendErr := s.sender.Send(context.Background(), endpoint.URL, endpoint.Secret, delivery.EventID, []byte(delivery.Payload))
+
+	attempt := &requests.FinishMerchantWebhookDeliveryAttempt{
+		DeliveryID: delivery.ID,
+		Attempt:    delivery.Attempts + 1,
+		Status:     requests.MerchantWebhookDeliveryDelivered,
+		Duration:   time.Since(started),
+	}
+
+	if code != 0 {
+		attempt.ResponseCode = &code
+	}
+
+	var failure string
+
+	switch {
+	case sendErr != nil:
+		failure = sendErr.Error()
+	case code < 200 || code > 299:
+		failure = fmt.Sprintf("endpoint answered with status %d", code)
+	}
+
+	if failure != "" {
+		attempt.Error = &failure
+		attempt.Status = requests.MerchantWebhookDeliveryFailed
+
+		if delivery.EventType != requests.MerchantEventPing && attempt.Attempt < merchantWebhookMaxAttempts {
+			next := time.Now().Add(merchantWebhookBackoff(attempt.Attempt))
+
+			attempt.Status = requests.MerchantWebhookDeliveryPending
+			attempt.NextAttemptAt = &next
+		}
+
+		s.logger.Debug("Merchant webhook delivery attempt failed",
+			zap.Int("delivery_id", delivery.ID),
+			zap.Int("attempt", attempt.Attempt),
+			zap.String("error", failure))
+	}
+
+	var finished *record.MerchantWebhookDeliveryRecord
+
+	err := s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		if _, err := repos.MerchantWebhook.CreateDeliveryAttempt(attempt); err != nil {
+			return err
+		}
+
+		var err error
+
+		finished, err = repos.MerchantWebhook.FinishDeliveryAttempt(attempt)
+
+		return err
+	})
+	if err != nil {
+		return delivery, err
+	}
+
+	return finished, nil
+}
+
+// merchantWebhookBackoff is the wait before the attempt following the given
+// one: merchantWebhookRetryBase doubled for every earlier failure.
+func merchantWebhookBackoff(attempt int) time.Duration {
+	return time.Duration(float64(merchantWebhookRetryBase) * math.Pow(2, float64(attempt-1)))
+}
+
+// checkMerchant makes sure the merchant exists and, unless requestedBy is
+// nil for an admin, belongs to the requesting user.
+func (s *merchantWebhookService) checkMerchant(merchantID int, requestedBy *int) *response.ErrorResponse {
+	merchant, err := s.merchantRepository.FindById(merchantID)
+	if err != nil {
+		s.logger.Error("Failed to find merchant", zap.Error(err), zap.Int("merchant_id", merchantID))
+		return merchant_errors.ErrMerchantNotFoundRes
+	}
+
+	if requestedBy != nil && merchant.UserID != *requestedBy {
+		s.logger.Error("unauthorized merchant webhook request",
+			zap.Int("merchant_id", merchantID),
+			zap.Int("requested_by", *requestedBy),
+		)
+		return merchant_webhook_errors.ErrMerchantWebhookNotAllowed
+	}
+
+	return nil
+}
+
+func (s *merchantWebhookService) findOwnedEndpoint(endpointID int, requestedBy *int) (*record.MerchantWebhookEndpointRecord, *response.ErrorResponse) {
+	endpoint, err := s.merchantWebhookRepository.FindEndpointById(endpointID)
+	if err != nil {
+		s.logger.Error("Failed to find merchant webhook endpoint", zap.Error(err), zap.Int("endpoint_id", endpointID))
+		return nil, merchant_webhook_errors.ErrMerchantWebhookEndpointNotFound
+	}
+
+	if errResp := s.checkMerchant(endpoint.MerchantID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	return endpoint, nil
+}
+
+func (s *merchantWebhookService) findOwnedDelivery(deliveryID int, requestedBy *int) (*record.MerchantWebhookDeliveryRecord, *record.MerchantWebhookEndpointRecord, *response.ErrorResponse) {
+	delivery, err := s.merchantWebhookRepository.FindDeliveryById(deliveryID)
+	if err != nil {
+		s.logger.Error("Failed to find merchant webhook delivery", zap.Error(err), zap.Int("delivery_id", deliveryID))
+		return nil, nil, merchant_webhook_errors.ErrMerchantWebhookDeliveryNotFound
+	}
+
+	endpoint, errResp := s.findOwnedEndpoint(delivery.EndpointID, requestedBy)
+	if errResp != nil {
+		return nil, nil, errResp
+	}
+
+	return delivery, endpoint, nil
+}
@@ -1,9 +1,9 @@
 // Code generated by MockGen. DO NOT EDIT.
-// Source: internal/service/interfaces.go
+// Source: interfaces.go
 //
 // Generated by this command:
 //
-//	mockgen -source=internal/service/interfaces.go -destination=internal/service/mocks/mock.go
+//	mockgen -source=interfaces.go -destination=mocks/mock.go
 //
 
 // Package mock_service is a generated GoMock package.
@@ -14,7 +14,6 @@ import (
 
 	requests "github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 	response "github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
-
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -43,18 +42,18 @@ func (m *MockAuthService) EXPECT() *MockAuthServiceMockRecorder {
 }
 
 // GetMe mocks base method.
-func (m *MockAuthService) GetMe(token string) (*response.UserResponse, *response.ErrorResponse) {
+func (m *MockAuthService) GetMe(userId int) (*response.UserResponse, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "GetMe", token)
+	ret := m.ctrl.Call(m, "GetMe", userId)
 	ret0, _ := ret[0].(*response.UserResponse)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
 
 // GetMe indicates an expected call of GetMe.
-func (mr *MockAuthServiceMockRecorder) GetMe(token any) *gomock.Call {
+func (mr *MockAuthServiceMockRecorder) GetMe(userId any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMe", reflect.TypeOf((*MockAuthService)(nil).GetMe), token)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetMe", reflect.TypeOf((*MockAuthService)(nil).GetMe), userId)
 }
 
 // Login mocks base method.
@@ -265,10 +264,10 @@ func (mr *MockRoleServiceMockRecorder) RestoreAllRole() *gomock.Call {
 }
 
 // RestoreRole mocks base method.
-func (m *MockRoleService) RestoreRole(role_id int) (*response.RoleResponse, *response.ErrorResponse) {
+func (m *MockRoleService) RestoreRole(role_id int) (*response.RoleResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RestoreRole", role_id)
-	ret0, _ := ret[0].(*response.RoleResponse)
+	ret0, _ := ret[0].(*response.RoleResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -280,10 +279,10 @@ func (mr *MockRoleServiceMockRecorder) RestoreRole(role_id any) *gomock.Call {
 }
 
 // TrashedRole mocks base method.
-func (m *MockRoleService) TrashedRole(role_id int) (*response.RoleResponse, *response.ErrorResponse) {
+func (m *MockRoleService) TrashedRole(role_id int) (*response.RoleResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TrashedRole", role_id)
-	ret0, _ := ret[0].(*response.RoleResponse)
+	ret0, _ := ret[0].(*response.RoleResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -877,10 +876,10 @@ func (mr *MockCardServiceMockRecorder) RestoreAllCard() *gomock.Call {
 }
 
 // RestoreCard mocks base method.
-func (m *MockCardService) RestoreCard(cardId int) (*response.CardResponse, *response.ErrorResponse) {
+func (m *MockCardService) RestoreCard(cardId int) (*response.CardResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RestoreCard", cardId)
-	ret0, _ := ret[0].(*response.CardResponse)
+	ret0, _ := ret[0].(*response.CardResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -892,10 +891,10 @@ func (mr *MockCardServiceMockRecorder) RestoreCard(cardId any) *gomock.Call {
 }
 
 // TrashedCard mocks base method.
-func (m *MockCardService) TrashedCard(cardId int) (*response.CardResponse, *response.ErrorResponse) {
+func (m *MockCardService) TrashedCard(cardId int) (*response.CardResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TrashedCard", cardId)
-	ret0, _ := ret[0].(*response.CardResponse)
+	ret0, _ := ret[0].(*response.CardResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -1176,6 +1175,21 @@ func (mr *MockMerchantServiceMockRecorder) FindMonthlyAmountMerchant(year any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMonthlyAmountMerchant", reflect.TypeOf((*MockMerchantService)(nil).FindMonthlyAmountMerchant), year)
 }
 
+// FindMonthlyDisputeRateByMerchants mocks base method.
+func (m *MockMerchantService) FindMonthlyDisputeRateByMerchants(req *requests.MonthYearAmountMerchant) ([]*response.MerchantResponseMonthlyDisputeRate, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMonthlyDisputeRateByMerchants", req)
+	ret0, _ := ret[0].([]*response.MerchantResponseMonthlyDisputeRate)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindMonthlyDisputeRateByMerchants indicates an expected call of FindMonthlyDisputeRateByMerchants.
+func (mr *MockMerchantServiceMockRecorder) FindMonthlyDisputeRateByMerchants(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMonthlyDisputeRateByMerchants", reflect.TypeOf((*MockMerchantService)(nil).FindMonthlyDisputeRateByMerchants), req)
+}
+
 // FindMonthlyPaymentMethodByApikeys mocks base method.
 func (m *MockMerchantService) FindMonthlyPaymentMethodByApikeys(req *requests.MonthYearPaymentMethodApiKey) ([]*response.MerchantResponseMonthlyPaymentMethod, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -1311,6 +1325,21 @@ func (mr *MockMerchantServiceMockRecorder) FindYearlyAmountMerchant(year any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindYearlyAmountMerchant", reflect.TypeOf((*MockMerchantService)(nil).FindYearlyAmountMerchant), year)
 }
 
+// FindYearlyDisputeRateByMerchants mocks base method.
+func (m *MockMerchantService) FindYearlyDisputeRateByMerchants(req *requests.MonthYearAmountMerchant) ([]*response.MerchantResponseYearlyDisputeRate, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindYearlyDisputeRateByMerchants", req)
+	ret0, _ := ret[0].([]*response.MerchantResponseYearlyDisputeRate)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindYearlyDisputeRateByMerchants indicates an expected call of FindYearlyDisputeRateByMerchants.
+func (mr *MockMerchantServiceMockRecorder) FindYearlyDisputeRateByMerchants(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindYearlyDisputeRateByMerchants", reflect.TypeOf((*MockMerchantService)(nil).FindYearlyDisputeRateByMerchants), req)
+}
+
 // FindYearlyPaymentMethodByApikeys mocks base method.
 func (m *MockMerchantService) FindYearlyPaymentMethodByApikeys(req *requests.MonthYearPaymentMethodApiKey) ([]*response.MerchantResponseYearlyPaymentMethod, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -1417,10 +1446,10 @@ func (mr *MockMerchantServiceMockRecorder) RestoreAllMerchant() *gomock.Call {
 }
 
 // RestoreMerchant mocks base method.
-func (m *MockMerchantService) RestoreMerchant(merchant_id int) (*response.MerchantResponse, *response.ErrorResponse) {
+func (m *MockMerchantService) RestoreMerchant(merchant_id int) (*response.MerchantResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RestoreMerchant", merchant_id)
-	ret0, _ := ret[0].(*response.MerchantResponse)
+	ret0, _ := ret[0].(*response.MerchantResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -1432,10 +1461,10 @@ func (mr *MockMerchantServiceMockRecorder) RestoreMerchant(merchant_id any) *gom
 }
 
 // TrashedMerchant mocks base method.
-func (m *MockMerchantService) TrashedMerchant(merchant_id int) (*response.MerchantResponse, *response.ErrorResponse) {
+func (m *MockMerchantService) TrashedMerchant(merchant_id int) (*response.MerchantResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TrashedMerchant", merchant_id)
-	ret0, _ := ret[0].(*response.MerchantResponse)
+	ret0, _ := ret[0].(*response.MerchantResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -1485,6 +1514,36 @@ func (m *MockSaldoService) EXPECT() *MockSaldoServiceMockRecorder {
 	return m.recorder
 }
 
+// ConsumeHold mocks base method.
+func (m *MockSaldoService) ConsumeHold(hold_id int) (*response.SaldoHoldResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConsumeHold", hold_id)
+	ret0, _ := ret[0].(*response.SaldoHoldResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// ConsumeHold indicates an expected call of ConsumeHold.
+func (mr *MockSaldoServiceMockRecorder) ConsumeHold(hold_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConsumeHold", reflect.TypeOf((*MockSaldoService)(nil).ConsumeHold), hold_id)
+}
+
+// CreateHold mocks base method.
+func (m *MockSaldoService) CreateHold(request *requests.CreateSaldoHoldRequest) (*response.SaldoHoldResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateHold", request)
+	ret0, _ := ret[0].(*response.SaldoHoldResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// CreateHold indicates an expected call of CreateHold.
+func (mr *MockSaldoServiceMockRecorder) CreateHold(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateHold", reflect.TypeOf((*MockSaldoService)(nil).CreateHold), request)
+}
+
 // CreateSaldo mocks base method.
 func (m *MockSaldoService) CreateSaldo(request *requests.CreateSaldoRequest) (*response.SaldoResponse, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -1530,6 +1589,21 @@ func (mr *MockSaldoServiceMockRecorder) DeleteSaldoPermanent(saldo_id any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSaldoPermanent", reflect.TypeOf((*MockSaldoService)(nil).DeleteSaldoPermanent), saldo_id)
 }
 
+// FindActiveHoldsByCardNumber mocks base method.
+func (m *MockSaldoService) FindActiveHoldsByCardNumber(card_number string) ([]*response.SaldoHoldResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindActiveHoldsByCardNumber", card_number)
+	ret0, _ := ret[0].([]*response.SaldoHoldResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindActiveHoldsByCardNumber indicates an expected call of FindActiveHoldsByCardNumber.
+func (mr *MockSaldoServiceMockRecorder) FindActiveHoldsByCardNumber(card_number any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindActiveHoldsByCardNumber", reflect.TypeOf((*MockSaldoService)(nil).FindActiveHoldsByCardNumber), card_number)
+}
+
 // FindAll mocks base method.
 func (m *MockSaldoService) FindAll(req *requests.FindAllSaldos) ([]*response.SaldoResponse, *int, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -1668,6 +1742,36 @@ func (mr *MockSaldoServiceMockRecorder) FindYearlySaldoBalances(year any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindYearlySaldoBalances", reflect.TypeOf((*MockSaldoService)(nil).FindYearlySaldoBalances), year)
 }
 
+// RebuildFromLedger mocks base method.
+func (m *MockSaldoService) RebuildFromLedger() ([]*response.SaldoResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebuildFromLedger")
+	ret0, _ := ret[0].([]*response.SaldoResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// RebuildFromLedger indicates an expected call of RebuildFromLedger.
+func (mr *MockSaldoServiceMockRecorder) RebuildFromLedger() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebuildFromLedger", reflect.TypeOf((*MockSaldoService)(nil).RebuildFromLedger))
+}
+
+// ReleaseHold mocks base method.
+func (m *MockSaldoService) ReleaseHold(hold_id int) (*response.SaldoHoldResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHold", hold_id)
+	ret0, _ := ret[0].(*response.SaldoHoldResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// ReleaseHold indicates an expected call of ReleaseHold.
+func (mr *MockSaldoServiceMockRecorder) ReleaseHold(hold_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHold", reflect.TypeOf((*MockSaldoService)(nil).ReleaseHold), hold_id)
+}
+
 // RestoreAllSaldo mocks base method.
 func (m *MockSaldoService) RestoreAllSaldo() (bool, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -1684,10 +1788,10 @@ func (mr *MockSaldoServiceMockRecorder) RestoreAllSaldo() *gomock.Call {
 }
 
 // RestoreSaldo mocks base method.
-func (m *MockSaldoService) RestoreSaldo(saldo_id int) (*response.SaldoResponse, *response.ErrorResponse) {
+func (m *MockSaldoService) RestoreSaldo(saldo_id int) (*response.SaldoResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RestoreSaldo", saldo_id)
-	ret0, _ := ret[0].(*response.SaldoResponse)
+	ret0, _ := ret[0].(*response.SaldoResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -1699,10 +1803,10 @@ func (mr *MockSaldoServiceMockRecorder) RestoreSaldo(saldo_id any) *gomock.Call
 }
 
 // TrashSaldo mocks base method.
-func (m *MockSaldoService) TrashSaldo(saldo_id int) (*response.SaldoResponse, *response.ErrorResponse) {
+func (m *MockSaldoService) TrashSaldo(saldo_id int) (*response.SaldoResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TrashSaldo", saldo_id)
-	ret0, _ := ret[0].(*response.SaldoResponse)
+	ret0, _ := ret[0].(*response.SaldoResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -2116,6 +2220,21 @@ func (mr *MockTopupServiceMockRecorder) FindYearlyTopupStatusSuccessByCardNumber
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindYearlyTopupStatusSuccessByCardNumber", reflect.TypeOf((*MockTopupService)(nil).FindYearlyTopupStatusSuccessByCardNumber), req)
 }
 
+// HandleProviderCallback mocks base method.
+func (m *MockTopupService) HandleProviderCallback(request *requests.TopupProviderCallback) (*response.TopupResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandleProviderCallback", request)
+	ret0, _ := ret[0].(*response.TopupResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// HandleProviderCallback indicates an expected call of HandleProviderCallback.
+func (mr *MockTopupServiceMockRecorder) HandleProviderCallback(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandleProviderCallback", reflect.TypeOf((*MockTopupService)(nil).HandleProviderCallback), request)
+}
+
 // RestoreAllTopup mocks base method.
 func (m *MockTopupService) RestoreAllTopup() (bool, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -2132,10 +2251,10 @@ func (mr *MockTopupServiceMockRecorder) RestoreAllTopup() *gomock.Call {
 }
 
 // RestoreTopup mocks base method.
-func (m *MockTopupService) RestoreTopup(topup_id int) (*response.TopupResponse, *response.ErrorResponse) {
+func (m *MockTopupService) RestoreTopup(topup_id int) (*response.TopupResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RestoreTopup", topup_id)
-	ret0, _ := ret[0].(*response.TopupResponse)
+	ret0, _ := ret[0].(*response.TopupResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -2146,11 +2265,26 @@ func (mr *MockTopupServiceMockRecorder) RestoreTopup(topup_id any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreTopup", reflect.TypeOf((*MockTopupService)(nil).RestoreTopup), topup_id)
 }
 
+// SyncPending mocks base method.
+func (m *MockTopupService) SyncPending() (int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncPending")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// SyncPending indicates an expected call of SyncPending.
+func (mr *MockTopupServiceMockRecorder) SyncPending() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncPending", reflect.TypeOf((*MockTopupService)(nil).SyncPending))
+}
+
 // TrashedTopup mocks base method.
-func (m *MockTopupService) TrashedTopup(topup_id int) (*response.TopupResponse, *response.ErrorResponse) {
+func (m *MockTopupService) TrashedTopup(topup_id int) (*response.TopupResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TrashedTopup", topup_id)
-	ret0, _ := ret[0].(*response.TopupResponse)
+	ret0, _ := ret[0].(*response.TopupResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -2201,18 +2335,18 @@ func (m *MockTransactionService) EXPECT() *MockTransactionServiceMockRecorder {
 }
 
 // Create mocks base method.
-func (m *MockTransactionService) Create(apiKey string, request *requests.CreateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse) {
+func (m *MockTransactionService) Create(credential requests.MerchantApiKeyCredential, request *requests.CreateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Create", apiKey, request)
+	ret := m.ctrl.Call(m, "Create", credential, request)
 	ret0, _ := ret[0].(*response.TransactionResponse)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
 
 // Create indicates an expected call of Create.
-func (mr *MockTransactionServiceMockRecorder) Create(apiKey, request any) *gomock.Call {
+func (mr *MockTransactionServiceMockRecorder) Create(credential, request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransactionService)(nil).Create), apiKey, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockTransactionService)(nil).Create), credential, request)
 }
 
 // DeleteAllTransactionPermanent mocks base method.
@@ -2444,6 +2578,21 @@ func (mr *MockTransactionServiceMockRecorder) FindMonthlyPaymentMethodsByCardNum
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMonthlyPaymentMethodsByCardNumber", reflect.TypeOf((*MockTransactionService)(nil).FindMonthlyPaymentMethodsByCardNumber), req)
 }
 
+// FindQrPayment mocks base method.
+func (m *MockTransactionService) FindQrPayment(request *requests.FindQrPaymentRequest) (*response.TransactionResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindQrPayment", request)
+	ret0, _ := ret[0].(*response.TransactionResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindQrPayment indicates an expected call of FindQrPayment.
+func (mr *MockTransactionServiceMockRecorder) FindQrPayment(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindQrPayment", reflect.TypeOf((*MockTransactionService)(nil).FindQrPayment), request)
+}
+
 // FindTransactionByMerchantId mocks base method.
 func (m *MockTransactionService) FindTransactionByMerchantId(merchant_id int) ([]*response.TransactionResponse, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -2579,6 +2728,36 @@ func (mr *MockTransactionServiceMockRecorder) FindYearlyTransactionStatusSuccess
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindYearlyTransactionStatusSuccessByCardNumber", reflect.TypeOf((*MockTransactionService)(nil).FindYearlyTransactionStatusSuccessByCardNumber), req)
 }
 
+// GenerateMerchantQr mocks base method.
+func (m *MockTransactionService) GenerateMerchantQr(request *requests.GenerateMerchantQrRequest) (*response.MerchantQrResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateMerchantQr", request)
+	ret0, _ := ret[0].(*response.MerchantQrResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// GenerateMerchantQr indicates an expected call of GenerateMerchantQr.
+func (mr *MockTransactionServiceMockRecorder) GenerateMerchantQr(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateMerchantQr", reflect.TypeOf((*MockTransactionService)(nil).GenerateMerchantQr), request)
+}
+
+// PayQr mocks base method.
+func (m *MockTransactionService) PayQr(request *requests.PayQrRequest) (*response.TransactionResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PayQr", request)
+	ret0, _ := ret[0].(*response.TransactionResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// PayQr indicates an expected call of PayQr.
+func (mr *MockTransactionServiceMockRecorder) PayQr(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PayQr", reflect.TypeOf((*MockTransactionService)(nil).PayQr), request)
+}
+
 // RestoreAllTransaction mocks base method.
 func (m *MockTransactionService) RestoreAllTransaction() (bool, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -2595,10 +2774,10 @@ func (mr *MockTransactionServiceMockRecorder) RestoreAllTransaction() *gomock.Ca
 }
 
 // RestoreTransaction mocks base method.
-func (m *MockTransactionService) RestoreTransaction(transaction_id int) (*response.TransactionResponse, *response.ErrorResponse) {
+func (m *MockTransactionService) RestoreTransaction(transaction_id int) (*response.TransactionResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RestoreTransaction", transaction_id)
-	ret0, _ := ret[0].(*response.TransactionResponse)
+	ret0, _ := ret[0].(*response.TransactionResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -2610,10 +2789,10 @@ func (mr *MockTransactionServiceMockRecorder) RestoreTransaction(transaction_id
 }
 
 // TrashedTransaction mocks base method.
-func (m *MockTransactionService) TrashedTransaction(transaction_id int) (*response.TransactionResponse, *response.ErrorResponse) {
+func (m *MockTransactionService) TrashedTransaction(transaction_id int) (*response.TransactionResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TrashedTransaction", transaction_id)
-	ret0, _ := ret[0].(*response.TransactionResponse)
+	ret0, _ := ret[0].(*response.TransactionResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -2625,18 +2804,18 @@ func (mr *MockTransactionServiceMockRecorder) TrashedTransaction(transaction_id
 }
 
 // Update mocks base method.
-func (m *MockTransactionService) Update(apiKey string, request *requests.UpdateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse) {
+func (m *MockTransactionService) Update(credential requests.MerchantApiKeyCredential, request *requests.UpdateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Update", apiKey, request)
+	ret := m.ctrl.Call(m, "Update", credential, request)
 	ret0, _ := ret[0].(*response.TransactionResponse)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
 
 // Update indicates an expected call of Update.
-func (mr *MockTransactionServiceMockRecorder) Update(apiKey, request any) *gomock.Call {
+func (mr *MockTransactionServiceMockRecorder) Update(credential, request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransactionService)(nil).Update), apiKey, request)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockTransactionService)(nil).Update), credential, request)
 }
 
 // MockTransferService is a mock of TransferService interface.
@@ -3027,10 +3206,10 @@ func (mr *MockTransferServiceMockRecorder) RestoreAllTransfer() *gomock.Call {
 }
 
 // RestoreTransfer mocks base method.
-func (m *MockTransferService) RestoreTransfer(transfer_id int) (*response.TransferResponse, *response.ErrorResponse) {
+func (m *MockTransferService) RestoreTransfer(transfer_id int) (*response.TransferResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RestoreTransfer", transfer_id)
-	ret0, _ := ret[0].(*response.TransferResponse)
+	ret0, _ := ret[0].(*response.TransferResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -3042,10 +3221,10 @@ func (mr *MockTransferServiceMockRecorder) RestoreTransfer(transfer_id any) *gom
 }
 
 // TrashedTransfer mocks base method.
-func (m *MockTransferService) TrashedTransfer(transfer_id int) (*response.TransferResponse, *response.ErrorResponse) {
+func (m *MockTransferService) TrashedTransfer(transfer_id int) (*response.TransferResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TrashedTransfer", transfer_id)
-	ret0, _ := ret[0].(*response.TransferResponse)
+	ret0, _ := ret[0].(*response.TransferResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -3263,6 +3442,21 @@ func (mr *MockUserServiceMockRecorder) UpdateUser(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserService)(nil).UpdateUser), request)
 }
 
+// UpdateUserTier mocks base method.
+func (m *MockUserService) UpdateUserTier(request *requests.UpdateUserTierRequest) (*response.UserResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserTier", request)
+	ret0, _ := ret[0].(*response.UserResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// UpdateUserTier indicates an expected call of UpdateUserTier.
+func (mr *MockUserServiceMockRecorder) UpdateUserTier(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserTier", reflect.TypeOf((*MockUserService)(nil).UpdateUserTier), request)
+}
+
 // MockWithdrawService is a mock of WithdrawService interface.
 type MockWithdrawService struct {
 	ctrl     *gomock.Controller
@@ -3287,6 +3481,21 @@ func (m *MockWithdrawService) EXPECT() *MockWithdrawServiceMockRecorder {
 	return m.recorder
 }
 
+// ApproveWithdraw mocks base method.
+func (m *MockWithdrawService) ApproveWithdraw(request *requests.ReviewWithdrawRequest) (*response.WithdrawResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApproveWithdraw", request)
+	ret0, _ := ret[0].(*response.WithdrawResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// ApproveWithdraw indicates an expected call of ApproveWithdraw.
+func (mr *MockWithdrawServiceMockRecorder) ApproveWithdraw(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApproveWithdraw", reflect.TypeOf((*MockWithdrawService)(nil).ApproveWithdraw), request)
+}
+
 // Create mocks base method.
 func (m *MockWithdrawService) Create(request *requests.CreateWithdrawRequest) (*response.WithdrawResponse, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -3501,6 +3710,21 @@ func (mr *MockWithdrawServiceMockRecorder) FindMonthlyWithdrawsByCardNumber(req
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMonthlyWithdrawsByCardNumber", reflect.TypeOf((*MockWithdrawService)(nil).FindMonthlyWithdrawsByCardNumber), req)
 }
 
+// FindReview mocks base method.
+func (m *MockWithdrawService) FindReview(withdraw_id int) (*response.WithdrawReviewResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReview", withdraw_id)
+	ret0, _ := ret[0].(*response.WithdrawReviewResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindReview indicates an expected call of FindReview.
+func (mr *MockWithdrawServiceMockRecorder) FindReview(withdraw_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReview", reflect.TypeOf((*MockWithdrawService)(nil).FindReview), withdraw_id)
+}
+
 // FindYearlyWithdrawStatusFailed mocks base method.
 func (m *MockWithdrawService) FindYearlyWithdrawStatusFailed(year int) ([]*response.WithdrawResponseYearStatusFailed, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -3591,6 +3815,36 @@ func (mr *MockWithdrawServiceMockRecorder) FindYearlyWithdrawsByCardNumber(req a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindYearlyWithdrawsByCardNumber", reflect.TypeOf((*MockWithdrawService)(nil).FindYearlyWithdrawsByCardNumber), req)
 }
 
+// HandlePayoutCallback mocks base method.
+func (m *MockWithdrawService) HandlePayoutCallback(request *requests.WithdrawPayoutCallback) (*response.WithdrawResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "HandlePayoutCallback", request)
+	ret0, _ := ret[0].(*response.WithdrawResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// HandlePayoutCallback indicates an expected call of HandlePayoutCallback.
+func (mr *MockWithdrawServiceMockRecorder) HandlePayoutCallback(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "HandlePayoutCallback", reflect.TypeOf((*MockWithdrawService)(nil).HandlePayoutCallback), request)
+}
+
+// RejectWithdraw mocks base method.
+func (m *MockWithdrawService) RejectWithdraw(request *requests.ReviewWithdrawRequest) (*response.WithdrawResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RejectWithdraw", request)
+	ret0, _ := ret[0].(*response.WithdrawResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// RejectWithdraw indicates an expected call of RejectWithdraw.
+func (mr *MockWithdrawServiceMockRecorder) RejectWithdraw(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RejectWithdraw", reflect.TypeOf((*MockWithdrawService)(nil).RejectWithdraw), request)
+}
+
 // RestoreAllWithdraw mocks base method.
 func (m *MockWithdrawService) RestoreAllWithdraw() (bool, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
@@ -3607,10 +3861,10 @@ func (mr *MockWithdrawServiceMockRecorder) RestoreAllWithdraw() *gomock.Call {
 }
 
 // RestoreWithdraw mocks base method.
-func (m *MockWithdrawService) RestoreWithdraw(withdraw_id int) (*response.WithdrawResponse, *response.ErrorResponse) {
+func (m *MockWithdrawService) RestoreWithdraw(withdraw_id int) (*response.WithdrawResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "RestoreWithdraw", withdraw_id)
-	ret0, _ := ret[0].(*response.WithdrawResponse)
+	ret0, _ := ret[0].(*response.WithdrawResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -3621,11 +3875,26 @@ func (mr *MockWithdrawServiceMockRecorder) RestoreWithdraw(withdraw_id any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreWithdraw", reflect.TypeOf((*MockWithdrawService)(nil).RestoreWithdraw), withdraw_id)
 }
 
+// SettleApproved mocks base method.
+func (m *MockWithdrawService) SettleApproved() (int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleApproved")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// SettleApproved indicates an expected call of SettleApproved.
+func (mr *MockWithdrawServiceMockRecorder) SettleApproved() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleApproved", reflect.TypeOf((*MockWithdrawService)(nil).SettleApproved))
+}
+
 // TrashedWithdraw mocks base method.
-func (m *MockWithdrawService) TrashedWithdraw(withdraw_id int) (*response.WithdrawResponse, *response.ErrorResponse) {
+func (m *MockWithdrawService) TrashedWithdraw(withdraw_id int) (*response.WithdrawResponseDeleteAt, *response.ErrorResponse) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TrashedWithdraw", withdraw_id)
-	ret0, _ := ret[0].(*response.WithdrawResponse)
+	ret0, _ := ret[0].(*response.WithdrawResponseDeleteAt)
 	ret1, _ := ret[1].(*response.ErrorResponse)
 	return ret0, ret1
 }
@@ -3650,3 +3919,2013 @@ func (mr *MockWithdrawServiceMockRecorder) Update(request any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Update", reflect.TypeOf((*MockWithdrawService)(nil).Update), request)
 }
+
+// MockLedgerService is a mock of LedgerService interface.
+type MockLedgerService struct {
+	ctrl     *gomock.Controller
+	recorder *MockLedgerServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockLedgerServiceMockRecorder is the mock recorder for MockLedgerService.
+type MockLedgerServiceMockRecorder struct {
+	mock *MockLedgerService
+}
+
+// NewMockLedgerService creates a new mock instance.
+func NewMockLedgerService(ctrl *gomock.Controller) *MockLedgerService {
+	mock := &MockLedgerService{ctrl: ctrl}
+	mock.recorder = &MockLedgerServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockLedgerService) EXPECT() *MockLedgerServiceMockRecorder {
+	return m.recorder
+}
+
+// FindAllJournals mocks base method.
+func (m *MockLedgerService) FindAllJournals(req *requests.FindAllLedgerJournals) ([]*response.LedgerJournalResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAllJournals", req)
+	ret0, _ := ret[0].([]*response.LedgerJournalResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindAllJournals indicates an expected call of FindAllJournals.
+func (mr *MockLedgerServiceMockRecorder) FindAllJournals(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAllJournals", reflect.TypeOf((*MockLedgerService)(nil).FindAllJournals), req)
+}
+
+// FindBalanceByCardNumber mocks base method.
+func (m *MockLedgerService) FindBalanceByCardNumber(card_number string, requestedBy *int) (*response.LedgerBalanceResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBalanceByCardNumber", card_number, requestedBy)
+	ret0, _ := ret[0].(*response.LedgerBalanceResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindBalanceByCardNumber indicates an expected call of FindBalanceByCardNumber.
+func (mr *MockLedgerServiceMockRecorder) FindBalanceByCardNumber(card_number, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBalanceByCardNumber", reflect.TypeOf((*MockLedgerService)(nil).FindBalanceByCardNumber), card_number, requestedBy)
+}
+
+// FindJournalById mocks base method.
+func (m *MockLedgerService) FindJournalById(journal_id int) (*response.LedgerJournalResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindJournalById", journal_id)
+	ret0, _ := ret[0].(*response.LedgerJournalResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindJournalById indicates an expected call of FindJournalById.
+func (mr *MockLedgerServiceMockRecorder) FindJournalById(journal_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindJournalById", reflect.TypeOf((*MockLedgerService)(nil).FindJournalById), journal_id)
+}
+
+// FindPostingsByCardNumber mocks base method.
+func (m *MockLedgerService) FindPostingsByCardNumber(req *requests.FindLedgerPostingsByCardNumber) ([]*response.LedgerCardPostingResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPostingsByCardNumber", req)
+	ret0, _ := ret[0].([]*response.LedgerCardPostingResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindPostingsByCardNumber indicates an expected call of FindPostingsByCardNumber.
+func (mr *MockLedgerServiceMockRecorder) FindPostingsByCardNumber(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPostingsByCardNumber", reflect.TypeOf((*MockLedgerService)(nil).FindPostingsByCardNumber), req)
+}
+
+// MockIdempotencyKeyService is a mock of IdempotencyKeyService interface.
+type MockIdempotencyKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockIdempotencyKeyServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockIdempotencyKeyServiceMockRecorder is the mock recorder for MockIdempotencyKeyService.
+type MockIdempotencyKeyServiceMockRecorder struct {
+	mock *MockIdempotencyKeyService
+}
+
+// NewMockIdempotencyKeyService creates a new mock instance.
+func NewMockIdempotencyKeyService(ctrl *gomock.Controller) *MockIdempotencyKeyService {
+	mock := &MockIdempotencyKeyService{ctrl: ctrl}
+	mock.recorder = &MockIdempotencyKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockIdempotencyKeyService) EXPECT() *MockIdempotencyKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// Complete mocks base method.
+func (m *MockIdempotencyKeyService) Complete(idempotency_key_id int, response_body string) *response.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Complete", idempotency_key_id, response_body)
+	ret0, _ := ret[0].(*response.ErrorResponse)
+	return ret0
+}
+
+// Complete indicates an expected call of Complete.
+func (mr *MockIdempotencyKeyServiceMockRecorder) Complete(idempotency_key_id, response_body any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Complete", reflect.TypeOf((*MockIdempotencyKeyService)(nil).Complete), idempotency_key_id, response_body)
+}
+
+// Release mocks base method.
+func (m *MockIdempotencyKeyService) Release(idempotency_key_id int) *response.ErrorResponse {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Release", idempotency_key_id)
+	ret0, _ := ret[0].(*response.ErrorResponse)
+	return ret0
+}
+
+// Release indicates an expected call of Release.
+func (mr *MockIdempotencyKeyServiceMockRecorder) Release(idempotency_key_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Release", reflect.TypeOf((*MockIdempotencyKeyService)(nil).Release), idempotency_key_id)
+}
+
+// Reserve mocks base method.
+func (m *MockIdempotencyKeyService) Reserve(request *requests.CreateIdempotencyKey) (*response.IdempotencyKeyResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reserve", request)
+	ret0, _ := ret[0].(*response.IdempotencyKeyResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Reserve indicates an expected call of Reserve.
+func (mr *MockIdempotencyKeyServiceMockRecorder) Reserve(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reserve", reflect.TypeOf((*MockIdempotencyKeyService)(nil).Reserve), request)
+}
+
+// MockRefundService is a mock of RefundService interface.
+type MockRefundService struct {
+	ctrl     *gomock.Controller
+	recorder *MockRefundServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockRefundServiceMockRecorder is the mock recorder for MockRefundService.
+type MockRefundServiceMockRecorder struct {
+	mock *MockRefundService
+}
+
+// NewMockRefundService creates a new mock instance.
+func NewMockRefundService(ctrl *gomock.Controller) *MockRefundService {
+	mock := &MockRefundService{ctrl: ctrl}
+	mock.recorder = &MockRefundServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRefundService) EXPECT() *MockRefundServiceMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockRefundService) FindAll(req *requests.FindAllRefunds) ([]*response.RefundResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*response.RefundResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockRefundServiceMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockRefundService)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockRefundService) FindById(refund_id int) (*response.RefundResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", refund_id)
+	ret0, _ := ret[0].(*response.RefundResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockRefundServiceMockRecorder) FindById(refund_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockRefundService)(nil).FindById), refund_id)
+}
+
+// FindByTransactionId mocks base method.
+func (m *MockRefundService) FindByTransactionId(transaction_id int) ([]*response.RefundResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByTransactionId", transaction_id)
+	ret0, _ := ret[0].([]*response.RefundResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindByTransactionId indicates an expected call of FindByTransactionId.
+func (mr *MockRefundServiceMockRecorder) FindByTransactionId(transaction_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByTransactionId", reflect.TypeOf((*MockRefundService)(nil).FindByTransactionId), transaction_id)
+}
+
+// RefundTransaction mocks base method.
+func (m *MockRefundService) RefundTransaction(request *requests.CreateRefundRequest) (*response.RefundResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RefundTransaction", request)
+	ret0, _ := ret[0].(*response.RefundResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// RefundTransaction indicates an expected call of RefundTransaction.
+func (mr *MockRefundServiceMockRecorder) RefundTransaction(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RefundTransaction", reflect.TypeOf((*MockRefundService)(nil).RefundTransaction), request)
+}
+
+// MockDisputeService is a mock of DisputeService interface.
+type MockDisputeService struct {
+	ctrl     *gomock.Controller
+	recorder *MockDisputeServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockDisputeServiceMockRecorder is the mock recorder for MockDisputeService.
+type MockDisputeServiceMockRecorder struct {
+	mock *MockDisputeService
+}
+
+// NewMockDisputeService creates a new mock instance.
+func NewMockDisputeService(ctrl *gomock.Controller) *MockDisputeService {
+	mock := &MockDisputeService{ctrl: ctrl}
+	mock.recorder = &MockDisputeServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockDisputeService) EXPECT() *MockDisputeServiceMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockDisputeService) FindAll(req *requests.FindAllDisputes) ([]*response.DisputeResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*response.DisputeResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockDisputeServiceMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockDisputeService)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockDisputeService) FindById(dispute_id int) (*response.DisputeResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", dispute_id)
+	ret0, _ := ret[0].(*response.DisputeResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockDisputeServiceMockRecorder) FindById(dispute_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockDisputeService)(nil).FindById), dispute_id)
+}
+
+// FindByMerchant mocks base method.
+func (m *MockDisputeService) FindByMerchant(req *requests.FindAllDisputesByMerchant) ([]*response.DisputeResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMerchant", req)
+	ret0, _ := ret[0].([]*response.DisputeResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindByMerchant indicates an expected call of FindByMerchant.
+func (mr *MockDisputeServiceMockRecorder) FindByMerchant(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMerchant", reflect.TypeOf((*MockDisputeService)(nil).FindByMerchant), req)
+}
+
+// OpenDispute mocks base method.
+func (m *MockDisputeService) OpenDispute(request *requests.CreateDisputeRequest) (*response.DisputeResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OpenDispute", request)
+	ret0, _ := ret[0].(*response.DisputeResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// OpenDispute indicates an expected call of OpenDispute.
+func (mr *MockDisputeServiceMockRecorder) OpenDispute(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OpenDispute", reflect.TypeOf((*MockDisputeService)(nil).OpenDispute), request)
+}
+
+// ResolveDispute mocks base method.
+func (m *MockDisputeService) ResolveDispute(request *requests.ResolveDisputeRequest) (*response.DisputeResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResolveDispute", request)
+	ret0, _ := ret[0].(*response.DisputeResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// ResolveDispute indicates an expected call of ResolveDispute.
+func (mr *MockDisputeServiceMockRecorder) ResolveDispute(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResolveDispute", reflect.TypeOf((*MockDisputeService)(nil).ResolveDispute), request)
+}
+
+// RespondDispute mocks base method.
+func (m *MockDisputeService) RespondDispute(request *requests.RespondDisputeRequest) (*response.DisputeResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RespondDispute", request)
+	ret0, _ := ret[0].(*response.DisputeResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// RespondDispute indicates an expected call of RespondDispute.
+func (mr *MockDisputeServiceMockRecorder) RespondDispute(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RespondDispute", reflect.TypeOf((*MockDisputeService)(nil).RespondDispute), request)
+}
+
+// MockAuthorizationService is a mock of AuthorizationService interface.
+type MockAuthorizationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockAuthorizationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockAuthorizationServiceMockRecorder is the mock recorder for MockAuthorizationService.
+type MockAuthorizationServiceMockRecorder struct {
+	mock *MockAuthorizationService
+}
+
+// NewMockAuthorizationService creates a new mock instance.
+func NewMockAuthorizationService(ctrl *gomock.Controller) *MockAuthorizationService {
+	mock := &MockAuthorizationService{ctrl: ctrl}
+	mock.recorder = &MockAuthorizationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAuthorizationService) EXPECT() *MockAuthorizationServiceMockRecorder {
+	return m.recorder
+}
+
+// Authorize mocks base method.
+func (m *MockAuthorizationService) Authorize(credential requests.MerchantApiKeyCredential, request *requests.CreateAuthorizationRequest) (*response.AuthorizationResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authorize", credential, request)
+	ret0, _ := ret[0].(*response.AuthorizationResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Authorize indicates an expected call of Authorize.
+func (mr *MockAuthorizationServiceMockRecorder) Authorize(credential, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authorize", reflect.TypeOf((*MockAuthorizationService)(nil).Authorize), credential, request)
+}
+
+// Capture mocks base method.
+func (m *MockAuthorizationService) Capture(credential requests.MerchantApiKeyCredential, request *requests.CaptureAuthorizationRequest) (*response.AuthorizationResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Capture", credential, request)
+	ret0, _ := ret[0].(*response.AuthorizationResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Capture indicates an expected call of Capture.
+func (mr *MockAuthorizationServiceMockRecorder) Capture(credential, request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Capture", reflect.TypeOf((*MockAuthorizationService)(nil).Capture), credential, request)
+}
+
+// ExpireStale mocks base method.
+func (m *MockAuthorizationService) ExpireStale() (int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireStale")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// ExpireStale indicates an expected call of ExpireStale.
+func (mr *MockAuthorizationServiceMockRecorder) ExpireStale() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireStale", reflect.TypeOf((*MockAuthorizationService)(nil).ExpireStale))
+}
+
+// FindAll mocks base method.
+func (m *MockAuthorizationService) FindAll(req *requests.FindAllAuthorizations) ([]*response.AuthorizationResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*response.AuthorizationResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockAuthorizationServiceMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockAuthorizationService)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockAuthorizationService) FindById(authorization_id int) (*response.AuthorizationResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", authorization_id)
+	ret0, _ := ret[0].(*response.AuthorizationResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockAuthorizationServiceMockRecorder) FindById(authorization_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockAuthorizationService)(nil).FindById), authorization_id)
+}
+
+// Void mocks base method.
+func (m *MockAuthorizationService) Void(credential requests.MerchantApiKeyCredential, authorization_id int) (*response.AuthorizationResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Void", credential, authorization_id)
+	ret0, _ := ret[0].(*response.AuthorizationResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Void indicates an expected call of Void.
+func (mr *MockAuthorizationServiceMockRecorder) Void(credential, authorization_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Void", reflect.TypeOf((*MockAuthorizationService)(nil).Void), credential, authorization_id)
+}
+
+// MockExchangeRateService is a mock of ExchangeRateService interface.
+type MockExchangeRateService struct {
+	ctrl     *gomock.Controller
+	recorder *MockExchangeRateServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockExchangeRateServiceMockRecorder is the mock recorder for MockExchangeRateService.
+type MockExchangeRateServiceMockRecorder struct {
+	mock *MockExchangeRateService
+}
+
+// NewMockExchangeRateService creates a new mock instance.
+func NewMockExchangeRateService(ctrl *gomock.Controller) *MockExchangeRateService {
+	mock := &MockExchangeRateService{ctrl: ctrl}
+	mock.recorder = &MockExchangeRateServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockExchangeRateService) EXPECT() *MockExchangeRateServiceMockRecorder {
+	return m.recorder
+}
+
+// DeleteExchangeRate mocks base method.
+func (m *MockExchangeRateService) DeleteExchangeRate(exchange_rate_id int) (*response.ExchangeRateResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExchangeRate", exchange_rate_id)
+	ret0, _ := ret[0].(*response.ExchangeRateResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// DeleteExchangeRate indicates an expected call of DeleteExchangeRate.
+func (mr *MockExchangeRateServiceMockRecorder) DeleteExchangeRate(exchange_rate_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExchangeRate", reflect.TypeOf((*MockExchangeRateService)(nil).DeleteExchangeRate), exchange_rate_id)
+}
+
+// FindAll mocks base method.
+func (m *MockExchangeRateService) FindAll(req *requests.FindAllExchangeRates) ([]*response.ExchangeRateResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*response.ExchangeRateResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockExchangeRateServiceMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockExchangeRateService)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockExchangeRateService) FindById(exchange_rate_id int) (*response.ExchangeRateResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", exchange_rate_id)
+	ret0, _ := ret[0].(*response.ExchangeRateResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockExchangeRateServiceMockRecorder) FindById(exchange_rate_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockExchangeRateService)(nil).FindById), exchange_rate_id)
+}
+
+// UpsertExchangeRate mocks base method.
+func (m *MockExchangeRateService) UpsertExchangeRate(request *requests.UpsertExchangeRateRequest) (*response.ExchangeRateResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpsertExchangeRate", request)
+	ret0, _ := ret[0].(*response.ExchangeRateResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// UpsertExchangeRate indicates an expected call of UpsertExchangeRate.
+func (mr *MockExchangeRateServiceMockRecorder) UpsertExchangeRate(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpsertExchangeRate", reflect.TypeOf((*MockExchangeRateService)(nil).UpsertExchangeRate), request)
+}
+
+// MockFeeScheduleService is a mock of FeeScheduleService interface.
+type MockFeeScheduleService struct {
+	ctrl     *gomock.Controller
+	recorder *MockFeeScheduleServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockFeeScheduleServiceMockRecorder is the mock recorder for MockFeeScheduleService.
+type MockFeeScheduleServiceMockRecorder struct {
+	mock *MockFeeScheduleService
+}
+
+// NewMockFeeScheduleService creates a new mock instance.
+func NewMockFeeScheduleService(ctrl *gomock.Controller) *MockFeeScheduleService {
+	mock := &MockFeeScheduleService{ctrl: ctrl}
+	mock.recorder = &MockFeeScheduleServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockFeeScheduleService) EXPECT() *MockFeeScheduleServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateFeeSchedule mocks base method.
+func (m *MockFeeScheduleService) CreateFeeSchedule(request *requests.CreateFeeScheduleRequest) (*response.FeeScheduleResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFeeSchedule", request)
+	ret0, _ := ret[0].(*response.FeeScheduleResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// CreateFeeSchedule indicates an expected call of CreateFeeSchedule.
+func (mr *MockFeeScheduleServiceMockRecorder) CreateFeeSchedule(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFeeSchedule", reflect.TypeOf((*MockFeeScheduleService)(nil).CreateFeeSchedule), request)
+}
+
+// DeleteFeeSchedule mocks base method.
+func (m *MockFeeScheduleService) DeleteFeeSchedule(fee_schedule_id int) (*response.FeeScheduleResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFeeSchedule", fee_schedule_id)
+	ret0, _ := ret[0].(*response.FeeScheduleResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// DeleteFeeSchedule indicates an expected call of DeleteFeeSchedule.
+func (mr *MockFeeScheduleServiceMockRecorder) DeleteFeeSchedule(fee_schedule_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFeeSchedule", reflect.TypeOf((*MockFeeScheduleService)(nil).DeleteFeeSchedule), fee_schedule_id)
+}
+
+// FindAll mocks base method.
+func (m *MockFeeScheduleService) FindAll(req *requests.FindAllFeeSchedules) ([]*response.FeeScheduleResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*response.FeeScheduleResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockFeeScheduleServiceMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockFeeScheduleService)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockFeeScheduleService) FindById(fee_schedule_id int) (*response.FeeScheduleResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", fee_schedule_id)
+	ret0, _ := ret[0].(*response.FeeScheduleResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockFeeScheduleServiceMockRecorder) FindById(fee_schedule_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockFeeScheduleService)(nil).FindById), fee_schedule_id)
+}
+
+// FindMonthlyFeeAmounts mocks base method.
+func (m *MockFeeScheduleService) FindMonthlyFeeAmounts(year int) ([]*response.FeeMonthAmountResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindMonthlyFeeAmounts", year)
+	ret0, _ := ret[0].([]*response.FeeMonthAmountResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindMonthlyFeeAmounts indicates an expected call of FindMonthlyFeeAmounts.
+func (mr *MockFeeScheduleServiceMockRecorder) FindMonthlyFeeAmounts(year any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindMonthlyFeeAmounts", reflect.TypeOf((*MockFeeScheduleService)(nil).FindMonthlyFeeAmounts), year)
+}
+
+// FindYearlyFeeAmounts mocks base method.
+func (m *MockFeeScheduleService) FindYearlyFeeAmounts(year int) ([]*response.FeeYearlyAmountResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindYearlyFeeAmounts", year)
+	ret0, _ := ret[0].([]*response.FeeYearlyAmountResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindYearlyFeeAmounts indicates an expected call of FindYearlyFeeAmounts.
+func (mr *MockFeeScheduleServiceMockRecorder) FindYearlyFeeAmounts(year any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindYearlyFeeAmounts", reflect.TypeOf((*MockFeeScheduleService)(nil).FindYearlyFeeAmounts), year)
+}
+
+// UpdateFeeSchedule mocks base method.
+func (m *MockFeeScheduleService) UpdateFeeSchedule(request *requests.UpdateFeeScheduleRequest) (*response.FeeScheduleResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFeeSchedule", request)
+	ret0, _ := ret[0].(*response.FeeScheduleResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// UpdateFeeSchedule indicates an expected call of UpdateFeeSchedule.
+func (mr *MockFeeScheduleServiceMockRecorder) UpdateFeeSchedule(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFeeSchedule", reflect.TypeOf((*MockFeeScheduleService)(nil).UpdateFeeSchedule), request)
+}
+
+// MockTransactionLimitService is a mock of TransactionLimitService interface.
+type MockTransactionLimitService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransactionLimitServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTransactionLimitServiceMockRecorder is the mock recorder for MockTransactionLimitService.
+type MockTransactionLimitServiceMockRecorder struct {
+	mock *MockTransactionLimitService
+}
+
+// NewMockTransactionLimitService creates a new mock instance.
+func NewMockTransactionLimitService(ctrl *gomock.Controller) *MockTransactionLimitService {
+	mock := &MockTransactionLimitService{ctrl: ctrl}
+	mock.recorder = &MockTransactionLimitServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransactionLimitService) EXPECT() *MockTransactionLimitServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateTransactionLimit mocks base method.
+func (m *MockTransactionLimitService) CreateTransactionLimit(request *requests.CreateTransactionLimitRequest) (*response.TransactionLimitResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransactionLimit", request)
+	ret0, _ := ret[0].(*response.TransactionLimitResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// CreateTransactionLimit indicates an expected call of CreateTransactionLimit.
+func (mr *MockTransactionLimitServiceMockRecorder) CreateTransactionLimit(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransactionLimit", reflect.TypeOf((*MockTransactionLimitService)(nil).CreateTransactionLimit), request)
+}
+
+// DeleteTransactionLimit mocks base method.
+func (m *MockTransactionLimitService) DeleteTransactionLimit(transaction_limit_id int) (*response.TransactionLimitResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTransactionLimit", transaction_limit_id)
+	ret0, _ := ret[0].(*response.TransactionLimitResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// DeleteTransactionLimit indicates an expected call of DeleteTransactionLimit.
+func (mr *MockTransactionLimitServiceMockRecorder) DeleteTransactionLimit(transaction_limit_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTransactionLimit", reflect.TypeOf((*MockTransactionLimitService)(nil).DeleteTransactionLimit), transaction_limit_id)
+}
+
+// FindAll mocks base method.
+func (m *MockTransactionLimitService) FindAll(req *requests.FindAllTransactionLimits) ([]*response.TransactionLimitResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*response.TransactionLimitResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockTransactionLimitServiceMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTransactionLimitService)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockTransactionLimitService) FindById(transaction_limit_id int) (*response.TransactionLimitResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", transaction_limit_id)
+	ret0, _ := ret[0].(*response.TransactionLimitResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockTransactionLimitServiceMockRecorder) FindById(transaction_limit_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockTransactionLimitService)(nil).FindById), transaction_limit_id)
+}
+
+// FindHeadroom mocks base method.
+func (m *MockTransactionLimitService) FindHeadroom(req *requests.FindTransactionLimitHeadroom) ([]*response.TransactionLimitHeadroomResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHeadroom", req)
+	ret0, _ := ret[0].([]*response.TransactionLimitHeadroomResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindHeadroom indicates an expected call of FindHeadroom.
+func (mr *MockTransactionLimitServiceMockRecorder) FindHeadroom(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHeadroom", reflect.TypeOf((*MockTransactionLimitService)(nil).FindHeadroom), req)
+}
+
+// UpdateTransactionLimit mocks base method.
+func (m *MockTransactionLimitService) UpdateTransactionLimit(request *requests.UpdateTransactionLimitRequest) (*response.TransactionLimitResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTransactionLimit", request)
+	ret0, _ := ret[0].(*response.TransactionLimitResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// UpdateTransactionLimit indicates an expected call of UpdateTransactionLimit.
+func (mr *MockTransactionLimitServiceMockRecorder) UpdateTransactionLimit(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTransactionLimit", reflect.TypeOf((*MockTransactionLimitService)(nil).UpdateTransactionLimit), request)
+}
+
+// MockScheduledTransferService is a mock of ScheduledTransferService interface.
+type MockScheduledTransferService struct {
+	ctrl     *gomock.Controller
+	recorder *MockScheduledTransferServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockScheduledTransferServiceMockRecorder is the mock recorder for MockScheduledTransferService.
+type MockScheduledTransferServiceMockRecorder struct {
+	mock *MockScheduledTransferService
+}
+
+// NewMockScheduledTransferService creates a new mock instance.
+func NewMockScheduledTransferService(ctrl *gomock.Controller) *MockScheduledTransferService {
+	mock := &MockScheduledTransferService{ctrl: ctrl}
+	mock.recorder = &MockScheduledTransferServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockScheduledTransferService) EXPECT() *MockScheduledTransferServiceMockRecorder {
+	return m.recorder
+}
+
+// CancelScheduledTransfer mocks base method.
+func (m *MockScheduledTransferService) CancelScheduledTransfer(scheduled_transfer_id int, requestedBy *int) (*response.ScheduledTransferResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelScheduledTransfer", scheduled_transfer_id, requestedBy)
+	ret0, _ := ret[0].(*response.ScheduledTransferResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// CancelScheduledTransfer indicates an expected call of CancelScheduledTransfer.
+func (mr *MockScheduledTransferServiceMockRecorder) CancelScheduledTransfer(scheduled_transfer_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelScheduledTransfer", reflect.TypeOf((*MockScheduledTransferService)(nil).CancelScheduledTransfer), scheduled_transfer_id, requestedBy)
+}
+
+// CreateScheduledTransfer mocks base method.
+func (m *MockScheduledTransferService) CreateScheduledTransfer(request *requests.CreateScheduledTransferRequest) (*response.ScheduledTransferResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateScheduledTransfer", request)
+	ret0, _ := ret[0].(*response.ScheduledTransferResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// CreateScheduledTransfer indicates an expected call of CreateScheduledTransfer.
+func (mr *MockScheduledTransferServiceMockRecorder) CreateScheduledTransfer(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateScheduledTransfer", reflect.TypeOf((*MockScheduledTransferService)(nil).CreateScheduledTransfer), request)
+}
+
+// ExecuteDue mocks base method.
+func (m *MockScheduledTransferService) ExecuteDue() (int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteDue")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// ExecuteDue indicates an expected call of ExecuteDue.
+func (mr *MockScheduledTransferServiceMockRecorder) ExecuteDue() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteDue", reflect.TypeOf((*MockScheduledTransferService)(nil).ExecuteDue))
+}
+
+// FindAll mocks base method.
+func (m *MockScheduledTransferService) FindAll(req *requests.FindAllScheduledTransfers) ([]*response.ScheduledTransferResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*response.ScheduledTransferResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockScheduledTransferServiceMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockScheduledTransferService)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockScheduledTransferService) FindById(scheduled_transfer_id int, requestedBy *int) (*response.ScheduledTransferResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", scheduled_transfer_id, requestedBy)
+	ret0, _ := ret[0].(*response.ScheduledTransferResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockScheduledTransferServiceMockRecorder) FindById(scheduled_transfer_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockScheduledTransferService)(nil).FindById), scheduled_transfer_id, requestedBy)
+}
+
+// FindRuns mocks base method.
+func (m *MockScheduledTransferService) FindRuns(req *requests.FindScheduledTransferRuns) ([]*response.ScheduledTransferRunResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRuns", req)
+	ret0, _ := ret[0].([]*response.ScheduledTransferRunResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindRuns indicates an expected call of FindRuns.
+func (mr *MockScheduledTransferServiceMockRecorder) FindRuns(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRuns", reflect.TypeOf((*MockScheduledTransferService)(nil).FindRuns), req)
+}
+
+// PauseScheduledTransfer mocks base method.
+func (m *MockScheduledTransferService) PauseScheduledTransfer(scheduled_transfer_id int, requestedBy *int) (*response.ScheduledTransferResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PauseScheduledTransfer", scheduled_transfer_id, requestedBy)
+	ret0, _ := ret[0].(*response.ScheduledTransferResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// PauseScheduledTransfer indicates an expected call of PauseScheduledTransfer.
+func (mr *MockScheduledTransferServiceMockRecorder) PauseScheduledTransfer(scheduled_transfer_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PauseScheduledTransfer", reflect.TypeOf((*MockScheduledTransferService)(nil).PauseScheduledTransfer), scheduled_transfer_id, requestedBy)
+}
+
+// ResumeScheduledTransfer mocks base method.
+func (m *MockScheduledTransferService) ResumeScheduledTransfer(scheduled_transfer_id int, requestedBy *int) (*response.ScheduledTransferResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResumeScheduledTransfer", scheduled_transfer_id, requestedBy)
+	ret0, _ := ret[0].(*response.ScheduledTransferResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// ResumeScheduledTransfer indicates an expected call of ResumeScheduledTransfer.
+func (mr *MockScheduledTransferServiceMockRecorder) ResumeScheduledTransfer(scheduled_transfer_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResumeScheduledTransfer", reflect.TypeOf((*MockScheduledTransferService)(nil).ResumeScheduledTransfer), scheduled_transfer_id, requestedBy)
+}
+
+// UpdateScheduledTransfer mocks base method.
+func (m *MockScheduledTransferService) UpdateScheduledTransfer(request *requests.UpdateScheduledTransferRequest) (*response.ScheduledTransferResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateScheduledTransfer", request)
+	ret0, _ := ret[0].(*response.ScheduledTransferResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// UpdateScheduledTransfer indicates an expected call of UpdateScheduledTransfer.
+func (mr *MockScheduledTransferServiceMockRecorder) UpdateScheduledTransfer(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateScheduledTransfer", reflect.TypeOf((*MockScheduledTransferService)(nil).UpdateScheduledTransfer), request)
+}
+
+// MockTransferBatchService is a mock of TransferBatchService interface.
+type MockTransferBatchService struct {
+	ctrl     *gomock.Controller
+	recorder *MockTransferBatchServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockTransferBatchServiceMockRecorder is the mock recorder for MockTransferBatchService.
+type MockTransferBatchServiceMockRecorder struct {
+	mock *MockTransferBatchService
+}
+
+// NewMockTransferBatchService creates a new mock instance.
+func NewMockTransferBatchService(ctrl *gomock.Controller) *MockTransferBatchService {
+	mock := &MockTransferBatchService{ctrl: ctrl}
+	mock.recorder = &MockTransferBatchServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTransferBatchService) EXPECT() *MockTransferBatchServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateTransferBatch mocks base method.
+func (m *MockTransferBatchService) CreateTransferBatch(request *requests.CreateTransferBatchRequest) (*response.TransferBatchResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTransferBatch", request)
+	ret0, _ := ret[0].(*response.TransferBatchResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// CreateTransferBatch indicates an expected call of CreateTransferBatch.
+func (mr *MockTransferBatchServiceMockRecorder) CreateTransferBatch(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTransferBatch", reflect.TypeOf((*MockTransferBatchService)(nil).CreateTransferBatch), request)
+}
+
+// FindAll mocks base method.
+func (m *MockTransferBatchService) FindAll(req *requests.FindAllTransferBatches) ([]*response.TransferBatchResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*response.TransferBatchResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockTransferBatchServiceMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockTransferBatchService)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockTransferBatchService) FindById(transfer_batch_id int, requestedBy *int) (*response.TransferBatchResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", transfer_batch_id, requestedBy)
+	ret0, _ := ret[0].(*response.TransferBatchResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockTransferBatchServiceMockRecorder) FindById(transfer_batch_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockTransferBatchService)(nil).FindById), transfer_batch_id, requestedBy)
+}
+
+// FindReport mocks base method.
+func (m *MockTransferBatchService) FindReport(transfer_batch_id int, requestedBy *int) (*response.TransferBatchReportResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindReport", transfer_batch_id, requestedBy)
+	ret0, _ := ret[0].(*response.TransferBatchReportResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindReport indicates an expected call of FindReport.
+func (mr *MockTransferBatchServiceMockRecorder) FindReport(transfer_batch_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindReport", reflect.TypeOf((*MockTransferBatchService)(nil).FindReport), transfer_batch_id, requestedBy)
+}
+
+// FindRows mocks base method.
+func (m *MockTransferBatchService) FindRows(req *requests.FindTransferBatchRows) ([]*response.TransferBatchRowResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRows", req)
+	ret0, _ := ret[0].([]*response.TransferBatchRowResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindRows indicates an expected call of FindRows.
+func (mr *MockTransferBatchServiceMockRecorder) FindRows(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRows", reflect.TypeOf((*MockTransferBatchService)(nil).FindRows), req)
+}
+
+// ProcessPending mocks base method.
+func (m *MockTransferBatchService) ProcessPending() (int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProcessPending")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// ProcessPending indicates an expected call of ProcessPending.
+func (mr *MockTransferBatchServiceMockRecorder) ProcessPending() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessPending", reflect.TypeOf((*MockTransferBatchService)(nil).ProcessPending))
+}
+
+// MockStatusHistoryService is a mock of StatusHistoryService interface.
+type MockStatusHistoryService struct {
+	ctrl     *gomock.Controller
+	recorder *MockStatusHistoryServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockStatusHistoryServiceMockRecorder is the mock recorder for MockStatusHistoryService.
+type MockStatusHistoryServiceMockRecorder struct {
+	mock *MockStatusHistoryService
+}
+
+// NewMockStatusHistoryService creates a new mock instance.
+func NewMockStatusHistoryService(ctrl *gomock.Controller) *MockStatusHistoryService {
+	mock := &MockStatusHistoryService{ctrl: ctrl}
+	mock.recorder = &MockStatusHistoryServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStatusHistoryService) EXPECT() *MockStatusHistoryServiceMockRecorder {
+	return m.recorder
+}
+
+// FindByEntity mocks base method.
+func (m *MockStatusHistoryService) FindByEntity(req *requests.FindStatusHistory) ([]*response.StatusHistoryResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByEntity", req)
+	ret0, _ := ret[0].([]*response.StatusHistoryResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindByEntity indicates an expected call of FindByEntity.
+func (mr *MockStatusHistoryServiceMockRecorder) FindByEntity(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByEntity", reflect.TypeOf((*MockStatusHistoryService)(nil).FindByEntity), req)
+}
+
+// MockProviderWebhookService is a mock of ProviderWebhookService interface.
+type MockProviderWebhookService struct {
+	ctrl     *gomock.Controller
+	recorder *MockProviderWebhookServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockProviderWebhookServiceMockRecorder is the mock recorder for MockProviderWebhookService.
+type MockProviderWebhookServiceMockRecorder struct {
+	mock *MockProviderWebhookService
+}
+
+// NewMockProviderWebhookService creates a new mock instance.
+func NewMockProviderWebhookService(ctrl *gomock.Controller) *MockProviderWebhookService {
+	mock := &MockProviderWebhookService{ctrl: ctrl}
+	mock.recorder = &MockProviderWebhookServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockProviderWebhookService) EXPECT() *MockProviderWebhookServiceMockRecorder {
+	return m.recorder
+}
+
+// FindAll mocks base method.
+func (m *MockProviderWebhookService) FindAll(req *requests.FindAllProviderWebhookEvents) ([]*response.ProviderWebhookEventResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindAll", req)
+	ret0, _ := ret[0].([]*response.ProviderWebhookEventResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindAll indicates an expected call of FindAll.
+func (mr *MockProviderWebhookServiceMockRecorder) FindAll(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindAll", reflect.TypeOf((*MockProviderWebhookService)(nil).FindAll), req)
+}
+
+// FindById mocks base method.
+func (m *MockProviderWebhookService) FindById(event_id int) (*response.ProviderWebhookEventResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", event_id)
+	ret0, _ := ret[0].(*response.ProviderWebhookEventResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockProviderWebhookServiceMockRecorder) FindById(event_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockProviderWebhookService)(nil).FindById), event_id)
+}
+
+// Receive mocks base method.
+func (m *MockProviderWebhookService) Receive(request *requests.ReceiveProviderWebhook) (*response.ProviderWebhookEventResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Receive", request)
+	ret0, _ := ret[0].(*response.ProviderWebhookEventResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Receive indicates an expected call of Receive.
+func (mr *MockProviderWebhookServiceMockRecorder) Receive(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Receive", reflect.TypeOf((*MockProviderWebhookService)(nil).Receive), request)
+}
+
+// Replay mocks base method.
+func (m *MockProviderWebhookService) Replay(event_id int) (*response.ProviderWebhookEventResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Replay", event_id)
+	ret0, _ := ret[0].(*response.ProviderWebhookEventResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Replay indicates an expected call of Replay.
+func (mr *MockProviderWebhookServiceMockRecorder) Replay(event_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Replay", reflect.TypeOf((*MockProviderWebhookService)(nil).Replay), event_id)
+}
+
+// MockMerchantWebhookService is a mock of MerchantWebhookService interface.
+type MockMerchantWebhookService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMerchantWebhookServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMerchantWebhookServiceMockRecorder is the mock recorder for MockMerchantWebhookService.
+type MockMerchantWebhookServiceMockRecorder struct {
+	mock *MockMerchantWebhookService
+}
+
+// NewMockMerchantWebhookService creates a new mock instance.
+func NewMockMerchantWebhookService(ctrl *gomock.Controller) *MockMerchantWebhookService {
+	mock := &MockMerchantWebhookService{ctrl: ctrl}
+	mock.recorder = &MockMerchantWebhookServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMerchantWebhookService) EXPECT() *MockMerchantWebhookServiceMockRecorder {
+	return m.recorder
+}
+
+// CreateEndpoint mocks base method.
+func (m *MockMerchantWebhookService) CreateEndpoint(request *requests.CreateMerchantWebhookEndpointRequest) (*response.MerchantWebhookEndpointResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEndpoint", request)
+	ret0, _ := ret[0].(*response.MerchantWebhookEndpointResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// CreateEndpoint indicates an expected call of CreateEndpoint.
+func (mr *MockMerchantWebhookServiceMockRecorder) CreateEndpoint(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEndpoint", reflect.TypeOf((*MockMerchantWebhookService)(nil).CreateEndpoint), request)
+}
+
+// DeleteEndpoint mocks base method.
+func (m *MockMerchantWebhookService) DeleteEndpoint(endpoint_id int, requestedBy *int) (*response.MerchantWebhookEndpointResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEndpoint", endpoint_id, requestedBy)
+	ret0, _ := ret[0].(*response.MerchantWebhookEndpointResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// DeleteEndpoint indicates an expected call of DeleteEndpoint.
+func (mr *MockMerchantWebhookServiceMockRecorder) DeleteEndpoint(endpoint_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEndpoint", reflect.TypeOf((*MockMerchantWebhookService)(nil).DeleteEndpoint), endpoint_id, requestedBy)
+}
+
+// DeliverDue mocks base method.
+func (m *MockMerchantWebhookService) DeliverDue() (int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverDue")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// DeliverDue indicates an expected call of DeliverDue.
+func (mr *MockMerchantWebhookServiceMockRecorder) DeliverDue() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverDue", reflect.TypeOf((*MockMerchantWebhookService)(nil).DeliverDue))
+}
+
+// FindDeliveries mocks base method.
+func (m *MockMerchantWebhookService) FindDeliveries(req *requests.FindMerchantWebhookDeliveries) ([]*response.MerchantWebhookDeliveryResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeliveries", req)
+	ret0, _ := ret[0].([]*response.MerchantWebhookDeliveryResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindDeliveries indicates an expected call of FindDeliveries.
+func (mr *MockMerchantWebhookServiceMockRecorder) FindDeliveries(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeliveries", reflect.TypeOf((*MockMerchantWebhookService)(nil).FindDeliveries), req)
+}
+
+// FindDeliveryAttempts mocks base method.
+func (m *MockMerchantWebhookService) FindDeliveryAttempts(delivery_id int, requestedBy *int) ([]*response.MerchantWebhookDeliveryAttemptResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDeliveryAttempts", delivery_id, requestedBy)
+	ret0, _ := ret[0].([]*response.MerchantWebhookDeliveryAttemptResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindDeliveryAttempts indicates an expected call of FindDeliveryAttempts.
+func (mr *MockMerchantWebhookServiceMockRecorder) FindDeliveryAttempts(delivery_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDeliveryAttempts", reflect.TypeOf((*MockMerchantWebhookService)(nil).FindDeliveryAttempts), delivery_id, requestedBy)
+}
+
+// FindEndpoints mocks base method.
+func (m *MockMerchantWebhookService) FindEndpoints(merchant_id int, requestedBy *int) ([]*response.MerchantWebhookEndpointResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindEndpoints", merchant_id, requestedBy)
+	ret0, _ := ret[0].([]*response.MerchantWebhookEndpointResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindEndpoints indicates an expected call of FindEndpoints.
+func (mr *MockMerchantWebhookServiceMockRecorder) FindEndpoints(merchant_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEndpoints", reflect.TypeOf((*MockMerchantWebhookService)(nil).FindEndpoints), merchant_id, requestedBy)
+}
+
+// Redeliver mocks base method.
+func (m *MockMerchantWebhookService) Redeliver(delivery_id int, requestedBy *int) (*response.MerchantWebhookDeliveryResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Redeliver", delivery_id, requestedBy)
+	ret0, _ := ret[0].(*response.MerchantWebhookDeliveryResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Redeliver indicates an expected call of Redeliver.
+func (mr *MockMerchantWebhookServiceMockRecorder) Redeliver(delivery_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Redeliver", reflect.TypeOf((*MockMerchantWebhookService)(nil).Redeliver), delivery_id, requestedBy)
+}
+
+// SendTest mocks base method.
+func (m *MockMerchantWebhookService) SendTest(endpoint_id int, requestedBy *int) (*response.MerchantWebhookDeliveryResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendTest", endpoint_id, requestedBy)
+	ret0, _ := ret[0].(*response.MerchantWebhookDeliveryResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// SendTest indicates an expected call of SendTest.
+func (mr *MockMerchantWebhookServiceMockRecorder) SendTest(endpoint_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendTest", reflect.TypeOf((*MockMerchantWebhookService)(nil).SendTest), endpoint_id, requestedBy)
+}
+
+// UpdateEndpoint mocks base method.
+func (m *MockMerchantWebhookService) UpdateEndpoint(request *requests.UpdateMerchantWebhookEndpointRequest) (*response.MerchantWebhookEndpointResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEndpoint", request)
+	ret0, _ := ret[0].(*response.MerchantWebhookEndpointResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// UpdateEndpoint indicates an expected call of UpdateEndpoint.
+func (mr *MockMerchantWebhookServiceMockRecorder) UpdateEndpoint(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEndpoint", reflect.TypeOf((*MockMerchantWebhookService)(nil).UpdateEndpoint), request)
+}
+
+// MockSettlementService is a mock of SettlementService interface.
+type MockSettlementService struct {
+	ctrl     *gomock.Controller
+	recorder *MockSettlementServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockSettlementServiceMockRecorder is the mock recorder for MockSettlementService.
+type MockSettlementServiceMockRecorder struct {
+	mock *MockSettlementService
+}
+
+// NewMockSettlementService creates a new mock instance.
+func NewMockSettlementService(ctrl *gomock.Controller) *MockSettlementService {
+	mock := &MockSettlementService{ctrl: ctrl}
+	mock.recorder = &MockSettlementServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockSettlementService) EXPECT() *MockSettlementServiceMockRecorder {
+	return m.recorder
+}
+
+// FindBalances mocks base method.
+func (m *MockSettlementService) FindBalances(merchant_id int, requestedBy *int) ([]*response.MerchantBalanceResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBalances", merchant_id, requestedBy)
+	ret0, _ := ret[0].([]*response.MerchantBalanceResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindBalances indicates an expected call of FindBalances.
+func (mr *MockSettlementServiceMockRecorder) FindBalances(merchant_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBalances", reflect.TypeOf((*MockSettlementService)(nil).FindBalances), merchant_id, requestedBy)
+}
+
+// FindBatchById mocks base method.
+func (m *MockSettlementService) FindBatchById(batch_id int, requestedBy *int) (*response.SettlementBatchResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBatchById", batch_id, requestedBy)
+	ret0, _ := ret[0].(*response.SettlementBatchResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindBatchById indicates an expected call of FindBatchById.
+func (mr *MockSettlementServiceMockRecorder) FindBatchById(batch_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBatchById", reflect.TypeOf((*MockSettlementService)(nil).FindBatchById), batch_id, requestedBy)
+}
+
+// FindBatchItems mocks base method.
+func (m *MockSettlementService) FindBatchItems(batch_id int, requestedBy *int) ([]*response.SettlementItemResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBatchItems", batch_id, requestedBy)
+	ret0, _ := ret[0].([]*response.SettlementItemResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindBatchItems indicates an expected call of FindBatchItems.
+func (mr *MockSettlementServiceMockRecorder) FindBatchItems(batch_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBatchItems", reflect.TypeOf((*MockSettlementService)(nil).FindBatchItems), batch_id, requestedBy)
+}
+
+// FindBatches mocks base method.
+func (m *MockSettlementService) FindBatches(req *requests.FindSettlementBatches) ([]*response.SettlementBatchResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBatches", req)
+	ret0, _ := ret[0].([]*response.SettlementBatchResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindBatches indicates an expected call of FindBatches.
+func (mr *MockSettlementServiceMockRecorder) FindBatches(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBatches", reflect.TypeOf((*MockSettlementService)(nil).FindBatches), req)
+}
+
+// SetSettlementCard mocks base method.
+func (m *MockSettlementService) SetSettlementCard(request *requests.SetSettlementCardRequest) (*response.MerchantBalanceResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetSettlementCard", request)
+	ret0, _ := ret[0].(*response.MerchantBalanceResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// SetSettlementCard indicates an expected call of SetSettlementCard.
+func (mr *MockSettlementServiceMockRecorder) SetSettlementCard(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetSettlementCard", reflect.TypeOf((*MockSettlementService)(nil).SetSettlementCard), request)
+}
+
+// SettleDue mocks base method.
+func (m *MockSettlementService) SettleDue() (int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SettleDue")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// SettleDue indicates an expected call of SettleDue.
+func (mr *MockSettlementServiceMockRecorder) SettleDue() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SettleDue", reflect.TypeOf((*MockSettlementService)(nil).SettleDue))
+}
+
+// MockMerchantOnboardingService is a mock of MerchantOnboardingService interface.
+type MockMerchantOnboardingService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMerchantOnboardingServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMerchantOnboardingServiceMockRecorder is the mock recorder for MockMerchantOnboardingService.
+type MockMerchantOnboardingServiceMockRecorder struct {
+	mock *MockMerchantOnboardingService
+}
+
+// NewMockMerchantOnboardingService creates a new mock instance.
+func NewMockMerchantOnboardingService(ctrl *gomock.Controller) *MockMerchantOnboardingService {
+	mock := &MockMerchantOnboardingService{ctrl: ctrl}
+	mock.recorder = &MockMerchantOnboardingServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMerchantOnboardingService) EXPECT() *MockMerchantOnboardingServiceMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockMerchantOnboardingService) Approve(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", request)
+	ret0, _ := ret[0].(*response.MerchantResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockMerchantOnboardingServiceMockRecorder) Approve(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockMerchantOnboardingService)(nil).Approve), request)
+}
+
+// FindBusinessProfile mocks base method.
+func (m *MockMerchantOnboardingService) FindBusinessProfile(merchantID int, requestedBy *int) (*response.MerchantBusinessProfileResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindBusinessProfile", merchantID, requestedBy)
+	ret0, _ := ret[0].(*response.MerchantBusinessProfileResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindBusinessProfile indicates an expected call of FindBusinessProfile.
+func (mr *MockMerchantOnboardingServiceMockRecorder) FindBusinessProfile(merchantID, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindBusinessProfile", reflect.TypeOf((*MockMerchantOnboardingService)(nil).FindBusinessProfile), merchantID, requestedBy)
+}
+
+// FindByStatus mocks base method.
+func (m *MockMerchantOnboardingService) FindByStatus(req *requests.FindMerchantsByStatus) ([]*response.MerchantResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByStatus", req)
+	ret0, _ := ret[0].([]*response.MerchantResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindByStatus indicates an expected call of FindByStatus.
+func (mr *MockMerchantOnboardingServiceMockRecorder) FindByStatus(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByStatus", reflect.TypeOf((*MockMerchantOnboardingService)(nil).FindByStatus), req)
+}
+
+// Reactivate mocks base method.
+func (m *MockMerchantOnboardingService) Reactivate(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reactivate", request)
+	ret0, _ := ret[0].(*response.MerchantResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Reactivate indicates an expected call of Reactivate.
+func (mr *MockMerchantOnboardingServiceMockRecorder) Reactivate(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reactivate", reflect.TypeOf((*MockMerchantOnboardingService)(nil).Reactivate), request)
+}
+
+// Reject mocks base method.
+func (m *MockMerchantOnboardingService) Reject(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", request)
+	ret0, _ := ret[0].(*response.MerchantResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockMerchantOnboardingServiceMockRecorder) Reject(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockMerchantOnboardingService)(nil).Reject), request)
+}
+
+// Submit mocks base method.
+func (m *MockMerchantOnboardingService) Submit(request *requests.SubmitMerchantOnboardingRequest) (*response.MerchantBusinessProfileResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Submit", request)
+	ret0, _ := ret[0].(*response.MerchantBusinessProfileResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Submit indicates an expected call of Submit.
+func (mr *MockMerchantOnboardingServiceMockRecorder) Submit(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Submit", reflect.TypeOf((*MockMerchantOnboardingService)(nil).Submit), request)
+}
+
+// Suspend mocks base method.
+func (m *MockMerchantOnboardingService) Suspend(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Suspend", request)
+	ret0, _ := ret[0].(*response.MerchantResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Suspend indicates an expected call of Suspend.
+func (mr *MockMerchantOnboardingServiceMockRecorder) Suspend(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspend", reflect.TypeOf((*MockMerchantOnboardingService)(nil).Suspend), request)
+}
+
+// Terminate mocks base method.
+func (m *MockMerchantOnboardingService) Terminate(request *requests.ReviewMerchantRequest) (*response.MerchantResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Terminate", request)
+	ret0, _ := ret[0].(*response.MerchantResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Terminate indicates an expected call of Terminate.
+func (mr *MockMerchantOnboardingServiceMockRecorder) Terminate(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Terminate", reflect.TypeOf((*MockMerchantOnboardingService)(nil).Terminate), request)
+}
+
+// MockReconciliationService is a mock of ReconciliationService interface.
+type MockReconciliationService struct {
+	ctrl     *gomock.Controller
+	recorder *MockReconciliationServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockReconciliationServiceMockRecorder is the mock recorder for MockReconciliationService.
+type MockReconciliationServiceMockRecorder struct {
+	mock *MockReconciliationService
+}
+
+// NewMockReconciliationService creates a new mock instance.
+func NewMockReconciliationService(ctrl *gomock.Controller) *MockReconciliationService {
+	mock := &MockReconciliationService{ctrl: ctrl}
+	mock.recorder = &MockReconciliationServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockReconciliationService) EXPECT() *MockReconciliationServiceMockRecorder {
+	return m.recorder
+}
+
+// Acknowledge mocks base method.
+func (m *MockReconciliationService) Acknowledge(request *requests.AcknowledgeBalanceDiscrepancyRequest) (*response.BalanceDiscrepancyResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Acknowledge", request)
+	ret0, _ := ret[0].(*response.BalanceDiscrepancyResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Acknowledge indicates an expected call of Acknowledge.
+func (mr *MockReconciliationServiceMockRecorder) Acknowledge(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Acknowledge", reflect.TypeOf((*MockReconciliationService)(nil).Acknowledge), request)
+}
+
+// FindDiscrepancies mocks base method.
+func (m *MockReconciliationService) FindDiscrepancies(req *requests.FindBalanceDiscrepancies) ([]*response.BalanceDiscrepancyResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDiscrepancies", req)
+	ret0, _ := ret[0].([]*response.BalanceDiscrepancyResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindDiscrepancies indicates an expected call of FindDiscrepancies.
+func (mr *MockReconciliationServiceMockRecorder) FindDiscrepancies(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDiscrepancies", reflect.TypeOf((*MockReconciliationService)(nil).FindDiscrepancies), req)
+}
+
+// FindDiscrepancyById mocks base method.
+func (m *MockReconciliationService) FindDiscrepancyById(discrepancy_id int) (*response.BalanceDiscrepancyResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindDiscrepancyById", discrepancy_id)
+	ret0, _ := ret[0].(*response.BalanceDiscrepancyResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindDiscrepancyById indicates an expected call of FindDiscrepancyById.
+func (mr *MockReconciliationServiceMockRecorder) FindDiscrepancyById(discrepancy_id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindDiscrepancyById", reflect.TypeOf((*MockReconciliationService)(nil).FindDiscrepancyById), discrepancy_id)
+}
+
+// FindRuns mocks base method.
+func (m *MockReconciliationService) FindRuns(req *requests.FindReconciliationRuns) ([]*response.ReconciliationRunResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindRuns", req)
+	ret0, _ := ret[0].([]*response.ReconciliationRunResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindRuns indicates an expected call of FindRuns.
+func (mr *MockReconciliationServiceMockRecorder) FindRuns(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindRuns", reflect.TypeOf((*MockReconciliationService)(nil).FindRuns), req)
+}
+
+// Reconcile mocks base method.
+func (m *MockReconciliationService) Reconcile() (*response.ReconciliationRunResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reconcile")
+	ret0, _ := ret[0].(*response.ReconciliationRunResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Reconcile indicates an expected call of Reconcile.
+func (mr *MockReconciliationServiceMockRecorder) Reconcile() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reconcile", reflect.TypeOf((*MockReconciliationService)(nil).Reconcile))
+}
+
+// MockMerchantApiKeyService is a mock of MerchantApiKeyService interface.
+type MockMerchantApiKeyService struct {
+	ctrl     *gomock.Controller
+	recorder *MockMerchantApiKeyServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockMerchantApiKeyServiceMockRecorder is the mock recorder for MockMerchantApiKeyService.
+type MockMerchantApiKeyServiceMockRecorder struct {
+	mock *MockMerchantApiKeyService
+}
+
+// NewMockMerchantApiKeyService creates a new mock instance.
+func NewMockMerchantApiKeyService(ctrl *gomock.Controller) *MockMerchantApiKeyService {
+	mock := &MockMerchantApiKeyService{ctrl: ctrl}
+	mock.recorder = &MockMerchantApiKeyServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockMerchantApiKeyService) EXPECT() *MockMerchantApiKeyServiceMockRecorder {
+	return m.recorder
+}
+
+// Authenticate mocks base method.
+func (m *MockMerchantApiKeyService) Authenticate(credential requests.MerchantApiKeyCredential, scope string) (*response.MerchantResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Authenticate", credential, scope)
+	ret0, _ := ret[0].(*response.MerchantResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Authenticate indicates an expected call of Authenticate.
+func (mr *MockMerchantApiKeyServiceMockRecorder) Authenticate(credential, scope any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Authenticate", reflect.TypeOf((*MockMerchantApiKeyService)(nil).Authenticate), credential, scope)
+}
+
+// Create mocks base method.
+func (m *MockMerchantApiKeyService) Create(request *requests.CreateMerchantApiKeyRequest) (*response.MerchantApiKeyResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", request)
+	ret0, _ := ret[0].(*response.MerchantApiKeyResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockMerchantApiKeyServiceMockRecorder) Create(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockMerchantApiKeyService)(nil).Create), request)
+}
+
+// FindByMerchant mocks base method.
+func (m *MockMerchantApiKeyService) FindByMerchant(merchant_id int, requestedBy *int) ([]*response.MerchantApiKeyResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMerchant", merchant_id, requestedBy)
+	ret0, _ := ret[0].([]*response.MerchantApiKeyResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindByMerchant indicates an expected call of FindByMerchant.
+func (mr *MockMerchantApiKeyServiceMockRecorder) FindByMerchant(merchant_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMerchant", reflect.TypeOf((*MockMerchantApiKeyService)(nil).FindByMerchant), merchant_id, requestedBy)
+}
+
+// PruneNonces mocks base method.
+func (m *MockMerchantApiKeyService) PruneNonces() (int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PruneNonces")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// PruneNonces indicates an expected call of PruneNonces.
+func (mr *MockMerchantApiKeyServiceMockRecorder) PruneNonces() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PruneNonces", reflect.TypeOf((*MockMerchantApiKeyService)(nil).PruneNonces))
+}
+
+// Revoke mocks base method.
+func (m *MockMerchantApiKeyService) Revoke(api_key_id int, requestedBy *int) (*response.MerchantApiKeyResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Revoke", api_key_id, requestedBy)
+	ret0, _ := ret[0].(*response.MerchantApiKeyResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Revoke indicates an expected call of Revoke.
+func (mr *MockMerchantApiKeyServiceMockRecorder) Revoke(api_key_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revoke", reflect.TypeOf((*MockMerchantApiKeyService)(nil).Revoke), api_key_id, requestedBy)
+}
+
+// Rotate mocks base method.
+func (m *MockMerchantApiKeyService) Rotate(request *requests.RotateMerchantApiKeyRequest) (*response.MerchantApiKeyResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Rotate", request)
+	ret0, _ := ret[0].(*response.MerchantApiKeyResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Rotate indicates an expected call of Rotate.
+func (mr *MockMerchantApiKeyServiceMockRecorder) Rotate(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Rotate", reflect.TypeOf((*MockMerchantApiKeyService)(nil).Rotate), request)
+}
+
+// SealSigningSecrets mocks base method.
+func (m *MockMerchantApiKeyService) SealSigningSecrets() (int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SealSigningSecrets")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// SealSigningSecrets indicates an expected call of SealSigningSecrets.
+func (mr *MockMerchantApiKeyServiceMockRecorder) SealSigningSecrets() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SealSigningSecrets", reflect.TypeOf((*MockMerchantApiKeyService)(nil).SealSigningSecrets))
+}
+
+// VerifySignature mocks base method.
+func (m *MockMerchantApiKeyService) VerifySignature(request *requests.VerifyMerchantRequestSignature) (*response.MerchantApiKeyResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifySignature", request)
+	ret0, _ := ret[0].(*response.MerchantApiKeyResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// VerifySignature indicates an expected call of VerifySignature.
+func (mr *MockMerchantApiKeyServiceMockRecorder) VerifySignature(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifySignature", reflect.TypeOf((*MockMerchantApiKeyService)(nil).VerifySignature), request)
+}
+
+// MockInvoiceService is a mock of InvoiceService interface.
+type MockInvoiceService struct {
+	ctrl     *gomock.Controller
+	recorder *MockInvoiceServiceMockRecorder
+	isgomock struct{}
+}
+
+// MockInvoiceServiceMockRecorder is the mock recorder for MockInvoiceService.
+type MockInvoiceServiceMockRecorder struct {
+	mock *MockInvoiceService
+}
+
+// NewMockInvoiceService creates a new mock instance.
+func NewMockInvoiceService(ctrl *gomock.Controller) *MockInvoiceService {
+	mock := &MockInvoiceService{ctrl: ctrl}
+	mock.recorder = &MockInvoiceServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockInvoiceService) EXPECT() *MockInvoiceServiceMockRecorder {
+	return m.recorder
+}
+
+// Cancel mocks base method.
+func (m *MockInvoiceService) Cancel(invoice_id int, requestedBy *int) (*response.InvoiceResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Cancel", invoice_id, requestedBy)
+	ret0, _ := ret[0].(*response.InvoiceResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Cancel indicates an expected call of Cancel.
+func (mr *MockInvoiceServiceMockRecorder) Cancel(invoice_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Cancel", reflect.TypeOf((*MockInvoiceService)(nil).Cancel), invoice_id, requestedBy)
+}
+
+// Create mocks base method.
+func (m *MockInvoiceService) Create(request *requests.CreateInvoiceRequest) (*response.InvoiceResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", request)
+	ret0, _ := ret[0].(*response.InvoiceResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockInvoiceServiceMockRecorder) Create(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockInvoiceService)(nil).Create), request)
+}
+
+// ExpireStale mocks base method.
+func (m *MockInvoiceService) ExpireStale() (int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpireStale")
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// ExpireStale indicates an expected call of ExpireStale.
+func (mr *MockInvoiceServiceMockRecorder) ExpireStale() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpireStale", reflect.TypeOf((*MockInvoiceService)(nil).ExpireStale))
+}
+
+// FindByCode mocks base method.
+func (m *MockInvoiceService) FindByCode(code string) (*response.InvoiceResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByCode", code)
+	ret0, _ := ret[0].(*response.InvoiceResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindByCode indicates an expected call of FindByCode.
+func (mr *MockInvoiceServiceMockRecorder) FindByCode(code any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByCode", reflect.TypeOf((*MockInvoiceService)(nil).FindByCode), code)
+}
+
+// FindById mocks base method.
+func (m *MockInvoiceService) FindById(invoice_id int, requestedBy *int) (*response.InvoiceResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindById", invoice_id, requestedBy)
+	ret0, _ := ret[0].(*response.InvoiceResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindById indicates an expected call of FindById.
+func (mr *MockInvoiceServiceMockRecorder) FindById(invoice_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindById", reflect.TypeOf((*MockInvoiceService)(nil).FindById), invoice_id, requestedBy)
+}
+
+// FindByMerchant mocks base method.
+func (m *MockInvoiceService) FindByMerchant(req *requests.FindInvoicesByMerchant) ([]*response.InvoiceResponse, *int, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindByMerchant", req)
+	ret0, _ := ret[0].([]*response.InvoiceResponse)
+	ret1, _ := ret[1].(*int)
+	ret2, _ := ret[2].(*response.ErrorResponse)
+	return ret0, ret1, ret2
+}
+
+// FindByMerchant indicates an expected call of FindByMerchant.
+func (mr *MockInvoiceServiceMockRecorder) FindByMerchant(req any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindByMerchant", reflect.TypeOf((*MockInvoiceService)(nil).FindByMerchant), req)
+}
+
+// FindPayments mocks base method.
+func (m *MockInvoiceService) FindPayments(invoice_id int, requestedBy *int) ([]*response.InvoicePaymentResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindPayments", invoice_id, requestedBy)
+	ret0, _ := ret[0].([]*response.InvoicePaymentResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// FindPayments indicates an expected call of FindPayments.
+func (mr *MockInvoiceServiceMockRecorder) FindPayments(invoice_id, requestedBy any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPayments", reflect.TypeOf((*MockInvoiceService)(nil).FindPayments), invoice_id, requestedBy)
+}
+
+// Pay mocks base method.
+func (m *MockInvoiceService) Pay(request *requests.PayInvoiceRequest) (*response.InvoicePaymentResponse, *response.ErrorResponse) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Pay", request)
+	ret0, _ := ret[0].(*response.InvoicePaymentResponse)
+	ret1, _ := ret[1].(*response.ErrorResponse)
+	return ret0, ret1
+}
+
+// Pay indicates an expected call of Pay.
+func (mr *MockInvoiceServiceMockRecorder) Pay(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Pay", reflect.TypeOf((*MockInvoiceService)(nil).Pay), request)
+}
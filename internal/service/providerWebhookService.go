@@ -0,0 +1,250 @@
+package service
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/provider_webhook_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/withdraw_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/webhook"
+	"go.uber.org/zap"
+)
+
+type providerWebhookService struct {
+	providerWebhookRepository repository.ProviderWebhookRepository
+	topupService              TopupService
+	withdrawService           WithdrawService
+	secrets                   map[string]string
+	tolerance                 time.Duration
+	logger                    logger.LoggerInterface
+	mapping                   responseservice.ProviderWebhookResponseMapper
+}
+
+func NewProviderWebhookService(
+	providerWebhookRepository repository.ProviderWebhookRepository,
+	topupService TopupService,
+	withdrawService WithdrawService,
+	secrets map[string]string,
+	tolerance time.Duration,
+	logger logger.LoggerInterface,
+	mapping responseservice.ProviderWebhookResponseMapper,
+) *providerWebhookService {
+	return &providerWebhookService{
+		providerWebhookRepository: providerWebhookRepository,
+		topupService:              topupService,
+		withdrawService:           withdrawService,
+		secrets:                   secrets,
+		tolerance:                 tolerance,
+		logger:                    logger,
+		mapping:                   mapping,
+	}
+}
+
+func (s *providerWebhookService) FindAll(req *requests.FindAllProviderWebhookEvents) ([]*response.ProviderWebhookEventResponse, *int, *response.ErrorResponse) {
+	page := req.Page
+	pageSize := req.PageSize
+	search := req.Search
+
+	s.logger.Debug("Fetching provider webhook events",
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+		zap.String("search", search))
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	req.Page = page
+	req.PageSize = pageSize
+
+	events, totalRecords, err := s.providerWebhookRepository.FindAll(req)
+
+	if err != nil {
+		s.logger.Error("Failed to fetch provider webhook events",
+			zap.Error(err),
+			zap.Int("page", page),
+			zap.Int("pageSize", pageSize),
+			zap.String("search", search))
+
+		return nil, nil, provider_webhook_errors.ErrFailedFindAllProviderWebhookEvents
+	}
+
+	so := s.mapping.ToProviderWebhookEventsResponse(events)
+
+	s.logger.Debug("Successfully fetched provider webhook events",
+		zap.Int("totalRecords", *totalRecords),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return so, totalRecords, nil
+}
+
+func (s *providerWebhookService) FindById(eventID int) (*response.ProviderWebhookEventResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching provider webhook event by ID", zap.Int("event_id", eventID))
+
+	event, err := s.providerWebhookRepository.FindById(eventID)
+	if err != nil {
+		s.logger.Error("Failed to find provider webhook event", zap.Int("event_id", eventID), zap.Error(err))
+		return nil, provider_webhook_errors.ErrProviderWebhookEventNotFound
+	}
+
+	return s.mapping.ToProviderWebhookEventResponse(event), nil
+}
+
+// Receive verifies a callback sent by a payment provider, stores it and
+// applies it. A callback the provider already sent under the same event ID
+// is not stored again, and is only applied again when its earlier attempts
+// failed.
+func (s *providerWebhookService) Receive(request *requests.ReceiveProviderWebhook) (*response.ProviderWebhookEventResponse, *response.ErrorResponse) {
+	s.logger.Debug("Receiving provider webhook", zap.String("provider", request.Provider), zap.String("event_id", request.EventID))
+
+	secret, ok := s.secrets[request.Provider]
+	if !ok {
+		s.logger.Error("webhook from unknown provider", zap.String("provider", request.Provider))
+		return nil, provider_webhook_errors.ErrUnknownWebhookProvider
+	}
+
+	sentAt, err := webhook.Verify(secret, request.EventID, request.Timestamp, request.Signature, request.Payload, s.tolerance, time.Now())
+	if err != nil {
+		s.logger.Error("rejected provider webhook", zap.String("provider", request.Provider), zap.String("event_id", request.EventID), zap.Error(err))
+		return nil, provider_webhook_errors.ErrInvalidProviderWebhookSignature
+	}
+
+	envelope, errResp := parseProviderWebhook(request.Payload)
+	if errResp != nil {
+		s.logger.Error("invalid provider webhook payload", zap.String("provider", request.Provider), zap.String("event_id", request.EventID))
+		return nil, errResp
+	}
+
+	event, err := s.providerWebhookRepository.CreateEvent(&requests.CreateProviderWebhookEvent{
+		Provider:  request.Provider,
+		EventID:   request.EventID,
+		EventType: envelope.Type,
+		Payload:   string(request.Payload),
+		Signature: request.Signature,
+		SentAt:    sentAt,
+	})
+	if errors.Is(err, provider_webhook_errors.ErrProviderWebhookEventDuplicate) {
+		event, err = s.providerWebhookRepository.FindByEventID(request.Provider, request.EventID)
+		if err == nil && event.Status == requests.ProviderWebhookProcessed {
+			s.logger.Debug("provider webhook already processed", zap.String("provider", request.Provider), zap.String("event_id", request.EventID))
+			return s.mapping.ToProviderWebhookEventResponse(event), nil
+		}
+	}
+	if err != nil {
+		s.logger.Error("failed to store provider webhook", zap.String("provider", request.Provider), zap.String("event_id", request.EventID), zap.Error(err))
+		return nil, provider_webhook_errors.ErrFailedStoreProviderWebhookEvent
+	}
+
+	return s.process(event)
+}
+
+// Replay applies a stored callback again, whatever the outcome of its earlier
+// attempts. It lets support finish a callback that failed, for example one
+// that arrived before the payment it confirms was recorded.
+func (s *providerWebhookService) Replay(eventID int) (*response.ProviderWebhookEventResponse, *response.ErrorResponse) {
+	s.logger.Debug("Replaying provider webhook event", zap.Int("event_id", eventID))
+
+	event, err := s.providerWebhookRepository.FindById(eventID)
+	if err != nil {
+		s.logger.Error("Failed to find provider webhook event", zap.Int("event_id", eventID), zap.Error(err))
+		return nil, provider_webhook_errors.ErrProviderWebhookEventNotFound
+	}
+
+	return s.process(event)
+}
+
+// process applies a stored callback and records the outcome on it.
+func (s *providerWebhookService) process(event *record.ProviderWebhookEventRecord) (*response.ProviderWebhookEventResponse, *response.ErrorResponse) {
+	errResp := s.apply(event)
+
+	finish := &requests.FinishProviderWebhookEvent{
+		EventID: event.ID,
+		Status:  requests.ProviderWebhookProcessed,
+	}
+	if errResp != nil {
+		finish.Status = requests.ProviderWebhookFailed
+		finish.LastError = &errResp.Message
+	}
+
+	finished, err := s.providerWebhookRepository.FinishEvent(finish)
+	if err != nil {
+		s.logger.Error("failed to record provider webhook outcome", zap.Int("event_id", event.ID), zap.Error(err))
+		if errResp == nil {
+			errResp = provider_webhook_errors.ErrFailedStoreProviderWebhookEvent
+		}
+	}
+
+	if errResp != nil {
+		s.logger.Error("failed to process provider webhook",
+			zap.Int("event_id", event.ID),
+			zap.String("event_type", event.EventType),
+			zap.String("error", errResp.Message),
+		)
+		return nil, errResp
+	}
+
+	s.logger.Debug("Processed provider webhook", zap.Int("event_id", event.ID), zap.String("event_type", event.EventType))
+	return s.mapping.ToProviderWebhookEventResponse(finished), nil
+}
+
+// apply drives the topup or withdraw a callback is about.
+func (s *providerWebhookService) apply(event *record.ProviderWebhookEventRecord) *response.ErrorResponse {
+	envelope, errResp := parseProviderWebhook([]byte(event.Payload))
+	if errResp != nil {
+		return errResp
+	}
+
+	switch envelope.Type {
+	case requests.ProviderWebhookTopupPayment:
+		_, errResp = s.topupService.HandleProviderCallback(&requests.TopupProviderCallback{
+			Provider: event.Provider,
+			Payload:  envelope.Data,
+		})
+
+	case requests.ProviderWebhookWithdrawPayout:
+		var callback requests.WithdrawPayoutCallback
+
+		if err := json.Unmarshal(envelope.Data, &callback); err != nil {
+			return withdraw_errors.ErrInvalidWithdrawPayoutCallback
+		}
+
+		if err := callback.Validate(); err != nil {
+			return withdraw_errors.ErrInvalidWithdrawPayoutCallback
+		}
+
+		callback.Provider = event.Provider
+
+		_, errResp = s.withdrawService.HandlePayoutCallback(&callback)
+
+	default:
+		errResp = provider_webhook_errors.ErrInvalidProviderWebhookPayload
+	}
+
+	return errResp
+}
+
+func parseProviderWebhook(payload []byte) (*requests.ProviderWebhookEnvelope, *response.ErrorResponse) {
+	var envelope requests.ProviderWebhookEnvelope
+
+	if err := json.Unmarshal(payload, &envelope); err != nil {
+		return nil, provider_webhook_errors.ErrInvalidProviderWebhookPayload
+	}
+
+	if err := envelope.Validate(); err != nil {
+		return nil, provider_webhook_errors.ErrInvalidProviderWebhookPayload
+	}
+
+	return &envelope, nil
+}
@@ -0,0 +1,206 @@
+package service
+
+import (
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/reconciliation_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type reconciliationService struct {
+	reconciliationRepository repository.ReconciliationRepository
+	unitOfWork               repository.UnitOfWork
+	logger                   logger.LoggerInterface
+	mapping                  responseservice.ReconciliationResponseMapper
+}
+
+func NewReconciliationService(
+	reconciliationRepository repository.ReconciliationRepository,
+	unitOfWork repository.UnitOfWork,
+	logger logger.LoggerInterface,
+	mapping responseservice.ReconciliationResponseMapper,
+) *reconciliationService {
+	return &reconciliationService{
+		reconciliationRepository: reconciliationRepository,
+		unitOfWork:               unitOfWork,
+		logger:                   logger,
+		mapping:                  mapping,
+	}
+}
+
+// Reconcile recomputes the balance of every card from its history and
+// records the cards whose saldo does not match it, or does not match the
+// ledger. Cards are read in a single statement, so the run sees one
+// consistent snapshot without locking saldos against live traffic; an
+// advisory lock keeps a second run from starting until this one commits.
+func (s *reconciliationService) Reconcile() (*response.ReconciliationRunResponse, *response.ErrorResponse) {
+	s.logger.Debug("Reconciling card balances")
+
+	var run *record.ReconciliationRunRecord
+
+	err := s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		if err := repos.Reconciliation.TryLock(); err != nil {
+			return err
+		}
+
+		started, err := repos.Reconciliation.CreateRun()
+		if err != nil {
+			return err
+		}
+
+		cards, err := repos.Reconciliation.FindCardReconciliations()
+		if err != nil {
+			return err
+		}
+
+		discrepancies := 0
+
+		for _, card := range cards {
+			expected := expectedBalance(card)
+
+			if card.SaldoBalance == expected && card.SaldoBalance == card.LedgerBalance {
+				continue
+			}
+
+			if _, err := repos.Reconciliation.UpsertDiscrepancy(&requests.CreateBalanceDiscrepancy{
+				CardNumber:          card.CardNumber,
+				ReconciliationRunID: started.ID,
+				SaldoBalance:        card.SaldoBalance,
+				ExpectedBalance:     expected,
+				LedgerBalance:       card.LedgerBalance,
+				Difference:          card.SaldoBalance - expected,
+				TopupAmount:         card.TopupAmount,
+				WithdrawAmount:      card.WithdrawAmount,
+				TransferOutAmount:   card.TransferOutAmount,
+				TransferInAmount:    card.TransferInAmount,
+				PaymentAmount:       card.PaymentAmount,
+				SettlementAmount:    card.SettlementAmount,
+				OtherAmount:         card.OtherAmount,
+			}); err != nil {
+				return err
+			}
+
+			s.logger.Error("card balance does not reconcile",
+				zap.String("card_number", card.CardNumber),
+				zap.Int("saldo_balance", card.SaldoBalance),
+				zap.Int("expected_balance", expected),
+				zap.Int("ledger_balance", card.LedgerBalance),
+			)
+
+			discrepancies++
+		}
+
+		if err := repos.Reconciliation.ResolveDiscrepancies(started.ID); err != nil {
+			return err
+		}
+
+		run, err = repos.Reconciliation.FinishRun(started.ID, len(cards), discrepancies)
+
+		return err
+	})
+	if err != nil {
+		if errors.Is(err, reconciliation_errors.ErrReconciliationAlreadyRunning) {
+			return nil, reconciliation_errors.ErrReconciliationInProgress
+		}
+
+		s.logger.Error("Failed to reconcile card balances", zap.Error(err))
+		return nil, reconciliation_errors.ErrFailedReconcileBalances
+	}
+
+	return s.mapping.ToReconciliationRunResponse(run), nil
+}
+
+func (s *reconciliationService) FindRuns(req *requests.FindReconciliationRuns) ([]*response.ReconciliationRunResponse, *int, *response.ErrorResponse) {
+	s.logger.Debug("Fetching reconciliation runs", zap.Int("page", req.Page), zap.Int("pageSize", req.PageSize))
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	runs, totalRecords, err := s.reconciliationRepository.FindRuns(req)
+	if err != nil {
+		s.logger.Error("Failed to fetch reconciliation runs", zap.Error(err))
+		return nil, nil, reconciliation_errors.ErrFailedFindReconciliationRuns
+	}
+
+	return s.mapping.ToReconciliationRunsResponse(runs), totalRecords, nil
+}
+
+func (s *reconciliationService) FindDiscrepancies(req *requests.FindBalanceDiscrepancies) ([]*response.BalanceDiscrepancyResponse, *int, *response.ErrorResponse) {
+	s.logger.Debug("Fetching balance discrepancies", zap.String("status", req.Status), zap.Int("page", req.Page), zap.Int("pageSize", req.PageSize))
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	discrepancies, totalRecords, err := s.reconciliationRepository.FindDiscrepancies(req)
+	if err != nil {
+		s.logger.Error("Failed to fetch balance discrepancies", zap.Error(err))
+		return nil, nil, reconciliation_errors.ErrFailedFindBalanceDiscrepancies
+	}
+
+	return s.mapping.ToBalanceDiscrepanciesResponse(discrepancies), totalRecords, nil
+}
+
+func (s *reconciliationService) FindDiscrepancyById(discrepancyID int) (*response.BalanceDiscrepancyResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching balance discrepancy", zap.Int("discrepancy_id", discrepancyID))
+
+	discrepancy, err := s.reconciliationRepository.FindDiscrepancyById(discrepancyID)
+	if err != nil {
+		s.logger.Error("Failed to find balance discrepancy", zap.Error(err), zap.Int("discrepancy_id", discrepancyID))
+		return nil, reconciliation_errors.ErrBalanceDiscrepancyNotFoundRes
+	}
+
+	return s.mapping.ToBalanceDiscrepancyResponse(discrepancy), nil
+}
+
+// Acknowledge marks an open discrepancy as being looked into. It stays
+// acknowledged while later runs refresh it, until a run resolves it.
+func (s *reconciliationService) Acknowledge(request *requests.AcknowledgeBalanceDiscrepancyRequest) (*response.BalanceDiscrepancyResponse, *response.ErrorResponse) {
+	s.logger.Debug("Acknowledging balance discrepancy", zap.Int("discrepancy_id", request.DiscrepancyID))
+
+	if _, err := s.reconciliationRepository.FindDiscrepancyById(request.DiscrepancyID); err != nil {
+		s.logger.Error("Failed to find balance discrepancy", zap.Error(err), zap.Int("discrepancy_id", request.DiscrepancyID))
+		return nil, reconciliation_errors.ErrBalanceDiscrepancyNotFoundRes
+	}
+
+	discrepancy, err := s.reconciliationRepository.AcknowledgeDiscrepancy(request)
+	if err != nil {
+		s.logger.Error("Failed to acknowledge balance discrepancy", zap.Error(err), zap.Int("discrepancy_id", request.DiscrepancyID))
+
+		if errors.Is(err, reconciliation_errors.ErrBalanceDiscrepancyNotOpen) {
+			return nil, reconciliation_errors.ErrBalanceDiscrepancyNotOpenRes
+		}
+		return nil, reconciliation_errors.ErrFailedAcknowledgeDiscrepancy
+	}
+
+	return s.mapping.ToBalanceDiscrepancyResponse(discrepancy), nil
+}
+
+// expectedBalance is what the saldo of a card should be given its history:
+// topups and incoming transfers and settlements add to it, withdraws,
+// outgoing transfers and payments take from it, and other_amount carries
+// the movements only the ledger records.
+func expectedBalance(card *record.CardReconciliationRecord) int {
+	return card.TopupAmount -
+		card.WithdrawAmount -
+		card.TransferOutAmount +
+		card.TransferInAmount -
+		card.PaymentAmount +
+		card.SettlementAmount +
+		card.OtherAmount
+}
@@ -0,0 +1,259 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/ledger_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/refund_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/settlement_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transaction_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type refundService struct {
+	refundRepository      repository.RefundRepository
+	transactionRepository repository.TransactionRepository
+	merchantRepository    repository.MerchantRepository
+	cardRepository        repository.CardRepository
+	unitOfWork            repository.UnitOfWork
+	logger                logger.LoggerInterface
+	mapping               responseservice.RefundResponseMapper
+}
+
+func NewRefundService(
+	refundRepository repository.RefundRepository,
+	transactionRepository repository.TransactionRepository,
+	merchantRepository repository.MerchantRepository,
+	cardRepository repository.CardRepository,
+	unitOfWork repository.UnitOfWork,
+	logger logger.LoggerInterface,
+	mapping responseservice.RefundResponseMapper,
+) *refundService {
+	return &refundService{
+		refundRepository:      refundRepository,
+		transactionRepository: transactionRepository,
+		merchantRepository:    merchantRepository,
+		cardRepository:        cardRepository,
+		unitOfWork:            unitOfWork,
+		logger:                logger,
+		mapping:               mapping,
+	}
+}
+
+func (s *refundService) FindAll(req *requests.FindAllRefunds) ([]*response.RefundResponse, *int, *response.ErrorResponse) {
+	page := req.Page
+	pageSize := req.PageSize
+	search := req.Search
+
+	s.logger.Debug("Fetching refunds",
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+		zap.String("search", search))
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	req.Page = page
+	req.PageSize = pageSize
+
+	refunds, totalRecords, err := s.refundRepository.FindAll(req)
+
+	if err != nil {
+		s.logger.Error("Failed to fetch refunds",
+			zap.Error(err),
+			zap.Int("page", page),
+			zap.Int("pageSize", pageSize),
+			zap.String("search", search))
+
+		return nil, nil, refund_errors.ErrFailedFindAllRefunds
+	}
+
+	so := s.mapping.ToRefundsResponse(refunds)
+
+	s.logger.Debug("Successfully fetched refunds",
+		zap.Int("totalRecords", *totalRecords),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return so, totalRecords, nil
+}
+
+func (s *refundService) FindById(refundID int) (*response.RefundResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching refund by ID", zap.Int("refund_id", refundID))
+
+	refund, err := s.refundRepository.FindById(refundID)
+
+	if err != nil {
+		s.logger.Error("failed to find refund", zap.Error(err))
+
+		return nil, refund_errors.ErrRefundNotFound
+	}
+
+	so := s.mapping.ToRefundResponse(refund)
+
+	s.logger.Debug("Successfully fetched refund", zap.Int("refund_id", refundID))
+
+	return so, nil
+}
+
+func (s *refundService) FindByTransactionId(transactionID int) ([]*response.RefundResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching refunds by transaction ID", zap.Int("transaction_id", transactionID))
+
+	refunds, err := s.refundRepository.FindByTransactionId(transactionID)
+
+	if err != nil {
+		s.logger.Error("failed to find refunds by transaction", zap.Error(err))
+
+		return nil, refund_errors.ErrFailedFindRefundsByTransaction
+	}
+
+	so := s.mapping.ToRefundsResponse(refunds)
+
+	s.logger.Debug("Successfully fetched refunds by transaction", zap.Int("transaction_id", transactionID))
+
+	return so, nil
+}
+
+func (s *refundService) RefundTransaction(request *requests.CreateRefundRequest) (*response.RefundResponse, *response.ErrorResponse) {
+	s.logger.Debug("Starting RefundTransaction process",
+		zap.Int("transaction_id", request.TransactionID),
+		zap.Int("amount", request.Amount),
+	)
+
+	transaction, err := s.transactionRepository.FindById(request.TransactionID)
+	if err != nil {
+		s.logger.Error("failed to find transaction", zap.Error(err))
+		return nil, transaction_errors.ErrTransactionNotFound
+	}
+
+	merchant, err := s.merchantRepository.FindById(transaction.MerchantID)
+	if err != nil {
+		s.logger.Error("failed to find merchant", zap.Error(err))
+		return nil, merchant_errors.ErrMerchantNotFoundRes
+	}
+
+	if request.RequestedBy != nil && merchant.UserID != *request.RequestedBy {
+		s.logger.Error("unauthorized refund of transaction",
+			zap.Int("transaction_id", transaction.ID),
+			zap.Int("requested_by", *request.RequestedBy),
+		)
+		return nil, refund_errors.ErrRefundNotAllowed
+	}
+
+	merchantCard, err := s.cardRepository.FindCardByUserId(merchant.UserID)
+	if err != nil {
+		s.logger.Error("failed to find merchant card", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	var refund *record.RefundRecord
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		// Saldos are locked before the merchant balance, in the order every
+		// other movement takes them, so the two cannot deadlock.
+		saldos, err := lockSaldos(repos, transaction.CardNumber, merchantCard.CardNumber)
+		if err != nil {
+			s.logger.Error("failed to lock card and merchant saldo", zap.Error(err))
+			return saldo_errors.ErrFailedSaldoNotFound
+		}
+
+		if _, err := repos.Transaction.ApplyRefund(transaction.ID, request.Amount); err != nil {
+			s.logger.Error("failed to apply refund to transaction", zap.Error(err))
+
+			if errors.Is(err, transaction_errors.ErrTransactionNotRefundable) {
+				return refund_errors.ErrRefundExceedsTransaction
+			}
+			return refund_errors.ErrFailedCreateRefund
+		}
+
+		refund, err = repos.Refund.CreateRefund(transaction, request)
+		if err != nil {
+			s.logger.Error("failed to create refund", zap.Error(err))
+			return refund_errors.ErrFailedCreateRefund
+		}
+
+		// Refunds are netted against the pending balance of the merchant
+		// while it covers them. Once the payment was paid out, the refund
+		// comes out of the merchant card instead.
+		source := requests.SystemLedgerAccount(requests.LedgerAccountMerchantSettlement)
+
+		_, err = repos.Settlement.DeductPendingBalance(merchant.ID, refund.Currency, request.Amount)
+		switch {
+		case err == nil:
+			if _, err := repos.Settlement.CreateItem(&requests.CreateSettlementItem{
+				MerchantID:  merchant.ID,
+				ItemType:    requests.SettlementItemRefund,
+				ReferenceID: refund.ID,
+				Amount:      request.Amount,
+				NetAmount:   -request.Amount,
+				Currency:    refund.Currency,
+			}); err != nil {
+				s.logger.Error("failed to record refund in merchant balance", zap.Error(err))
+				return settlement_errors.ErrFailedAccrueSettlementItem
+			}
+		case errors.Is(err, settlement_errors.ErrInsufficientMerchantPendingBalance):
+			merchantSaldo := saldos[merchantCard.CardNumber]
+			if availableBalance(merchantSaldo) < request.Amount {
+				s.logger.Error("insufficient merchant balance for refund",
+					zap.Int("AvailableBalance", availableBalance(merchantSaldo)),
+					zap.Int("RefundAmount", request.Amount),
+				)
+				return refund_errors.ErrInsufficientMerchantSaldo
+			}
+
+			source = requests.CardLedgerAccount(merchantCard.CardNumber)
+		default:
+			s.logger.Error("failed to deduct refund from merchant balance", zap.Error(err))
+			return settlement_errors.ErrFailedAccrueSettlementItem
+		}
+
+		if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+			requests.LedgerReferenceRefund,
+			refund.ID,
+			"Refund of transaction "+strconv.Itoa(transaction.ID)+" from merchant "+merchant.Name+" to card "+transaction.CardNumber,
+			source,
+			requests.CardLedgerAccount(transaction.CardNumber),
+			request.Amount,
+			refund.Currency,
+		)); err != nil {
+			s.logger.Error("failed to post refund journal", zap.Error(err))
+			return ledger_errors.ErrFailedPostLedgerJournal
+		}
+
+		if err := publishMerchantEvent(repos.MerchantWebhook, merchant.ID, requests.MerchantEventRefundCreated, s.mapping.ToRefundResponse(refund)); err != nil {
+			s.logger.Error("failed to queue merchant webhook", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to refund transaction, transaction rolled back", zap.Error(err))
+
+		return nil, response.ToErrorResponse(err, refund_errors.ErrFailedCreateRefund)
+	}
+
+	so := s.mapping.ToRefundResponse(refund)
+
+	s.logger.Debug("RefundTransaction process completed",
+		zap.Int("transaction_id", transaction.ID),
+		zap.Int("refund_id", refund.ID),
+	)
+
+	return so, nil
+}
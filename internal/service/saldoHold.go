@@ -0,0 +1,56 @@
+package service
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+)
+
+// availableBalance is the part of a saldo that debits may spend: the ledger
+// balance minus the funds set aside by active holds.
+func availableBalance(saldo *record.SaldoRecord) int {
+	return saldo.TotalBalance - saldo.ReservedBalance
+}
+
+// placeHold reserves the requested amount on the card's saldo and records the
+// hold. It must be called inside a unit of work. When the available balance
+// is too low it returns saldo_errors.ErrInsufficientAvailableSaldo.
+func placeHold(repos *repository.Repositories, request *requests.CreateSaldoHoldRequest) (*record.SaldoHoldRecord, error) {
+	if _, err := repos.Saldo.ReserveBalance(request.CardNumber, request.Amount); err != nil {
+		return nil, err
+	}
+
+	return repos.SaldoHold.CreateHold(request)
+}
+
+// releaseHold returns the held funds to the available balance. It must be
+// called inside a unit of work.
+func releaseHold(repos *repository.Repositories, holdID int) (*record.SaldoHoldRecord, error) {
+	hold, err := repos.SaldoHold.ReleaseHold(holdID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := repos.Saldo.ReleaseReservation(hold.CardNumber, hold.Amount); err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
+
+// consumeHold marks the hold as spent and lifts its reservation so that the
+// caller can post the debit journal for the held funds in the same unit of
+// work. A debit posted without lifting the reservation first would be
+// rejected, since the ledger never lets a balance drop below its reserved part.
+func consumeHold(repos *repository.Repositories, holdID int) (*record.SaldoHoldRecord, error) {
+	hold, err := repos.SaldoHold.ConsumeHold(holdID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := repos.Saldo.ReleaseReservation(hold.CardNumber, hold.Amount); err != nil {
+		return nil, err
+	}
+
+	return hold, nil
+}
@@ -0,0 +1,33 @@
+package service
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
+)
+
+// lockSaldos takes row locks on the saldos of the given cards and returns them
+// keyed by card number. It must be called inside a unit of work; the locks are
+// held until it commits, so balance checks made on the returned saldos cannot
+// be invalidated by a concurrent movement on the same cards.
+func lockSaldos(repos *repository.Repositories, cardNumbers ...string) (map[string]*record.SaldoRecord, error) {
+	saldos, err := repos.Saldo.LockByCardNumbers(cardNumbers)
+	if err != nil {
+		return nil, err
+	}
+
+	locked := make(map[string]*record.SaldoRecord, len(saldos))
+	for _, saldo := range saldos {
+		if _, ok := locked[saldo.CardNumber]; !ok {
+			locked[saldo.CardNumber] = saldo
+		}
+	}
+
+	for _, cardNumber := range cardNumbers {
+		if _, ok := locked[cardNumber]; !ok {
+			return nil, saldo_errors.ErrFindSaldoByCardNumberFailed
+		}
+	}
+
+	return locked, nil
+}
@@ -1,11 +1,16 @@
 package service
 
 import (
+	"errors"
+	"strconv"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
 	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/ledger_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
 
@@ -13,18 +18,22 @@ import (
 )
 
 type saldoService struct {
-	cardRepository  repository.CardRepository
-	saldoRepository repository.SaldoRepository
-	logger          logger.LoggerInterface
-	mapping         responseservice.SaldoResponseMapper
+	cardRepository      repository.CardRepository
+	saldoRepository     repository.SaldoRepository
+	saldoHoldRepository repository.SaldoHoldRepository
+	unitOfWork          repository.UnitOfWork
+	logger              logger.LoggerInterface
+	mapping             responseservice.SaldoResponseMapper
 }
 
-func NewSaldoService(saldo repository.SaldoRepository, card repository.CardRepository, logger logger.LoggerInterface, mapping responseservice.SaldoResponseMapper) *saldoService {
+func NewSaldoService(saldo repository.SaldoRepository, saldoHold repository.SaldoHoldRepository, card repository.CardRepository, unitOfWork repository.UnitOfWork, logger logger.LoggerInterface, mapping responseservice.SaldoResponseMapper) *saldoService {
 	return &saldoService{
-		saldoRepository: saldo,
-		cardRepository:  card,
-		logger:          logger,
-		mapping:         mapping,
+		saldoRepository:     saldo,
+		saldoHoldRepository: saldoHold,
+		cardRepository:      card,
+		unitOfWork:          unitOfWork,
+		logger:              logger,
+		mapping:             mapping,
 	}
 }
 
@@ -274,13 +283,43 @@ func (s *saldoService) CreateSaldo(request *requests.CreateSaldoRequest) (*respo
 		return nil, card_errors.ErrCardNotFoundRes
 	}
 
-	res, err := s.saldoRepository.CreateSaldo(request)
-
+	var res *record.SaldoRecord
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		saldo, err := repos.Saldo.CreateSaldo(&requests.CreateSaldoRequest{
+			CardNumber:   request.CardNumber,
+			TotalBalance: 0,
+		})
+		if err != nil {
+			s.logger.Error("Failed to create saldo record", zap.Error(err))
+			return saldo_errors.ErrFailedCreateSaldo
+		}
+
+		if request.TotalBalance != 0 {
+			if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+				requests.LedgerReferenceOpeningBalance,
+				saldo.ID,
+				"Opening balance for card "+request.CardNumber,
+				requests.SystemLedgerAccount(requests.LedgerAccountOpeningBalance),
+				requests.CardLedgerAccount(request.CardNumber),
+				request.TotalBalance,
+				saldo.Currency,
+			)); err != nil {
+				s.logger.Error("Failed to post opening balance journal", zap.Error(err))
+				return ledger_errors.ErrFailedPostLedgerJournal
+			}
+		}
+
+		res, err = repos.Saldo.FindById(saldo.ID)
+		if err != nil {
+			s.logger.Error("Failed to reload saldo record", zap.Error(err))
+			return saldo_errors.ErrFailedCreateSaldo
+		}
+
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Failed to create saldo record",
-			zap.Error(err))
-
-		return nil, saldo_errors.ErrFailedCreateSaldo
+		return nil, response.ToErrorResponse(err, saldo_errors.ErrFailedCreateSaldo)
 	}
 
 	so := s.mapping.ToSaldoResponse(res)
@@ -303,11 +342,78 @@ func (s *saldoService) UpdateSaldo(request *requests.UpdateSaldoRequest) (*respo
 		return nil, card_errors.ErrCardNotFoundRes
 	}
 
-	res, err := s.saldoRepository.UpdateSaldo(request)
-
+	var res *record.SaldoRecord
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		existing, err := repos.Saldo.FindById(*request.SaldoID)
+		if err != nil {
+			s.logger.Error("Failed to find saldo", zap.Error(err), zap.Int("saldo_id", *request.SaldoID))
+			return saldo_errors.ErrFailedSaldoNotFound
+		}
+
+		if _, err := lockSaldos(repos, existing.CardNumber); err != nil {
+			s.logger.Error("Failed to lock saldo", zap.Error(err), zap.Int("saldo_id", *request.SaldoID))
+			return saldo_errors.ErrFailedSaldoNotFound
+		}
+
+		// Re-read under the lock so the adjustment is computed from the
+		// balance that is actually being replaced.
+		existing, err = repos.Saldo.FindById(*request.SaldoID)
+		if err != nil {
+			s.logger.Error("Failed to find saldo", zap.Error(err), zap.Int("saldo_id", *request.SaldoID))
+			return saldo_errors.ErrFailedSaldoNotFound
+		}
+
+		if request.CardNumber != existing.CardNumber {
+			s.logger.Error("Saldo cannot be moved to another card",
+				zap.Int("saldo_id", *request.SaldoID),
+				zap.String("card_number", request.CardNumber),
+			)
+			return saldo_errors.ErrSaldoCardNotUpdatable
+		}
+
+		if request.TotalBalance < existing.ReservedBalance {
+			s.logger.Error("Balance would drop below held funds",
+				zap.Int("requested", request.TotalBalance),
+				zap.Int("reserved", existing.ReservedBalance),
+			)
+			return saldo_errors.ErrInsufficientAvailableBalance
+		}
+
+		if _, err := repos.Saldo.UpdateSaldo(&requests.UpdateSaldoRequest{
+			SaldoID:      request.SaldoID,
+			CardNumber:   existing.CardNumber,
+			TotalBalance: existing.TotalBalance,
+		}); err != nil {
+			s.logger.Error("Failed to update saldo", zap.Error(err), zap.String("card_number", request.CardNumber))
+			return saldo_errors.ErrFailedUpdateSaldo
+		}
+
+		if delta := request.TotalBalance - existing.TotalBalance; delta != 0 {
+			if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+				requests.LedgerReferenceSaldoAdjustment,
+				existing.ID,
+				"Manual balance adjustment for card "+request.CardNumber,
+				requests.SystemLedgerAccount(requests.LedgerAccountBalanceAdjustment),
+				requests.CardLedgerAccount(request.CardNumber),
+				delta,
+				existing.Currency,
+			)); err != nil {
+				s.logger.Error("Failed to post balance adjustment journal", zap.Error(err))
+				return ledger_errors.ErrFailedPostLedgerJournal
+			}
+		}
+
+		res, err = repos.Saldo.FindById(existing.ID)
+		if err != nil {
+			s.logger.Error("Failed to reload saldo record", zap.Error(err))
+			return saldo_errors.ErrFailedUpdateSaldo
+		}
+
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Failed to update saldo", zap.Error(err), zap.String("card_number", request.CardNumber))
-		return nil, saldo_errors.ErrFailedUpdateSaldo
+		return nil, response.ToErrorResponse(err, saldo_errors.ErrFailedUpdateSaldo)
 	}
 
 	so := s.mapping.ToSaldoResponse(res)
@@ -317,6 +423,187 @@ func (s *saldoService) UpdateSaldo(request *requests.UpdateSaldoRequest) (*respo
 	return so, nil
 }
 
+func (s *saldoService) FindActiveHoldsByCardNumber(card_number string) ([]*response.SaldoHoldResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching active saldo holds", zap.String("card_number", card_number))
+
+	res, err := s.saldoHoldRepository.FindActiveByCardNumber(card_number)
+
+	if err != nil {
+		s.logger.Error("Failed to retrieve active saldo holds",
+			zap.String("card_number", card_number),
+			zap.Error(err))
+
+		return nil, saldo_errors.ErrFailedFindSaldoHolds
+	}
+
+	so := s.mapping.ToSaldoHoldResponses(res)
+
+	s.logger.Debug("Successfully fetched active saldo holds", zap.String("card_number", card_number), zap.Int("count", len(res)))
+
+	return so, nil
+}
+
+func (s *saldoService) CreateHold(request *requests.CreateSaldoHoldRequest) (*response.SaldoHoldResponse, *response.ErrorResponse) {
+	s.logger.Debug("Placing saldo hold", zap.String("card_number", request.CardNumber), zap.Int("amount", request.Amount))
+
+	if _, err := s.cardRepository.FindCardByCardNumber(request.CardNumber); err != nil {
+		s.logger.Error("Card not found for saldo hold",
+			zap.String("card_number", request.CardNumber),
+			zap.Error(err))
+
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	var hold *record.SaldoHoldRecord
+
+	err := s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		var err error
+
+		hold, err = placeHold(repos, request)
+		if err != nil {
+			s.logger.Error("Failed to place saldo hold", zap.Error(err))
+
+			if errors.Is(err, saldo_errors.ErrInsufficientAvailableSaldo) {
+				return saldo_errors.ErrInsufficientAvailableBalance
+			}
+			return saldo_errors.ErrFailedCreateSaldoHold
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, response.ToErrorResponse(err, saldo_errors.ErrFailedCreateSaldoHold)
+	}
+
+	so := s.mapping.ToSaldoHoldResponse(hold)
+
+	s.logger.Debug("Successfully placed saldo hold", zap.Int("hold_id", hold.ID))
+
+	return so, nil
+}
+
+func (s *saldoService) ReleaseHold(hold_id int) (*response.SaldoHoldResponse, *response.ErrorResponse) {
+	s.logger.Debug("Releasing saldo hold", zap.Int("hold_id", hold_id))
+
+	if errResp := s.ensureManualHold(hold_id); errResp != nil {
+		return nil, errResp
+	}
+
+	var hold *record.SaldoHoldRecord
+
+	err := s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		var err error
+
+		hold, err = releaseHold(repos, hold_id)
+		if err != nil {
+			s.logger.Error("Failed to release saldo hold", zap.Error(err))
+
+			if errors.Is(err, saldo_errors.ErrSaldoHoldStatusConflict) {
+				return saldo_errors.ErrSaldoHoldNotActive
+			}
+			return saldo_errors.ErrFailedReleaseSaldoHold
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, response.ToErrorResponse(err, saldo_errors.ErrFailedReleaseSaldoHold)
+	}
+
+	so := s.mapping.ToSaldoHoldResponse(hold)
+
+	s.logger.Debug("Successfully released saldo hold", zap.Int("hold_id", hold.ID))
+
+	return so, nil
+}
+
+// ConsumeHold settles a manual hold by debiting the held funds from the card
+// into the balance adjustment account.
+func (s *saldoService) ConsumeHold(hold_id int) (*response.SaldoHoldResponse, *response.ErrorResponse) {
+	s.logger.Debug("Consuming saldo hold", zap.Int("hold_id", hold_id))
+
+	if errResp := s.ensureManualHold(hold_id); errResp != nil {
+		return nil, errResp
+	}
+
+	var hold *record.SaldoHoldRecord
+
+	err := s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		var err error
+
+		hold, err = consumeHold(repos, hold_id)
+		if err != nil {
+			s.logger.Error("Failed to consume saldo hold", zap.Error(err))
+
+			if errors.Is(err, saldo_errors.ErrSaldoHoldStatusConflict) {
+				return saldo_errors.ErrSaldoHoldNotActive
+			}
+			return saldo_errors.ErrFailedConsumeSaldoHold
+		}
+
+		if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+			requests.LedgerReferenceSaldoHold,
+			hold.ID,
+			"Consumed hold "+strconv.Itoa(hold.ID)+" on card "+hold.CardNumber,
+			requests.CardLedgerAccount(hold.CardNumber),
+			requests.SystemLedgerAccount(requests.LedgerAccountBalanceAdjustment),
+			hold.Amount,
+			hold.Currency,
+		)); err != nil {
+			s.logger.Error("Failed to post hold consumption journal", zap.Error(err))
+			return ledger_errors.ErrFailedPostLedgerJournal
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, response.ToErrorResponse(err, saldo_errors.ErrFailedConsumeSaldoHold)
+	}
+
+	so := s.mapping.ToSaldoHoldResponse(hold)
+
+	s.logger.Debug("Successfully consumed saldo hold", zap.Int("hold_id", hold.ID))
+
+	return so, nil
+}
+
+// ensureManualHold rejects holds placed by another operation, such as an
+// authorization, which must be settled through that operation instead.
+func (s *saldoService) ensureManualHold(hold_id int) *response.ErrorResponse {
+	hold, err := s.saldoHoldRepository.FindById(hold_id)
+	if err != nil {
+		s.logger.Error("Failed to find saldo hold", zap.Int("hold_id", hold_id), zap.Error(err))
+		return saldo_errors.ErrSaldoHoldNotFound
+	}
+
+	if hold.ReferenceType != nil {
+		s.logger.Error("Saldo hold is managed by its operation",
+			zap.Int("hold_id", hold_id),
+			zap.String("reference_type", *hold.ReferenceType),
+		)
+		return saldo_errors.ErrSaldoHoldManagedByOperation
+	}
+
+	return nil
+}
+
+func (s *saldoService) RebuildFromLedger() ([]*response.SaldoResponse, *response.ErrorResponse) {
+	s.logger.Debug("Rebuilding saldo balances from ledger")
+
+	res, err := s.saldoRepository.RebuildFromLedger()
+
+	if err != nil {
+		s.logger.Error("Failed to rebuild saldo balances", zap.Error(err))
+		return nil, saldo_errors.ErrFailedRebuildSaldos
+	}
+
+	so := s.mapping.ToSaldoResponses(res)
+
+	s.logger.Debug("Successfully rebuilt saldo balances", zap.Int("corrected", len(res)))
+
+	return so, nil
+}
+
 func (s *saldoService) TrashSaldo(saldo_id int) (*response.SaldoResponseDeleteAt, *response.ErrorResponse) {
 	s.logger.Debug("Trashing saldo record", zap.Int("saldo_id", saldo_id))
 
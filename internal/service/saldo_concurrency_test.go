@@ -0,0 +1,202 @@
+package service_test
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	recordmapper "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/record"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/service"
+	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	_ "github.com/lib/pq"
+	"go.uber.org/zap"
+)
+
+// testDatabaseEnv names the PostgreSQL connection string of a migrated,
+// throwaway database. Tests that need a database are skipped without it.
+const testDatabaseEnv = "TEST_DATABASE_DSN"
+
+const (
+	concurrencyWorkers      = 40
+	concurrencyOpsPerWorker = 5
+	concurrencyAmount       = 50000
+	concurrencyOpening      = 2000000
+)
+
+// TestSaldoConcurrentDebits hammers one card with transfers and withdraws
+// from many goroutines. The opening balance covers only part of them, so
+// the saldo lock is what keeps the balance from going negative or losing a
+// debit.
+func TestSaldoConcurrentDebits(t *testing.T) {
+	dsn := os.Getenv(testDatabaseEnv)
+	if dsn == "" {
+		t.Skipf("%s not set", testDatabaseEnv)
+	}
+
+	conn, err := sql.Open("postgres", dsn)
+	if err != nil {
+		t.Fatalf("open database: %v", err)
+	}
+	defer conn.Close()
+
+	if err := conn.Ping(); err != nil {
+		t.Fatalf("ping database: %v", err)
+	}
+
+	ctx := context.Background()
+	DB := db.New(conn)
+	mapperRecord := recordmapper.NewRecordMapper()
+	repos := repository.NewRepositories(repository.Deps{
+		DB:           DB,
+		Ctx:          ctx,
+		MapperRecord: mapperRecord,
+	})
+
+	services := service.NewService(service.Deps{
+		Repositories: repos,
+		UnitOfWork:   repository.NewUnitOfWork(conn, DB, ctx, mapperRecord),
+		Logger:       &logger.Logger{Log: zap.NewNop()},
+		Mapper:       *responseservice.NewResponseServiceMapper(),
+
+		// Withdraws are debited right away instead of waiting for review.
+		WithdrawReviewThreshold: math.MaxInt32,
+	})
+
+	user, err := repos.User.CreateUser(&requests.CreateUserRequest{
+		FirstName: "Concurrency",
+		LastName:  "Test",
+		Email:     fmt.Sprintf("concurrency-%d@example.com", time.Now().UnixNano()),
+		Password:  "not-a-real-hash",
+	})
+	if err != nil {
+		t.Fatalf("create user: %v", err)
+	}
+
+	hot := createFundedCard(t, services, user.ID, concurrencyOpening)
+	other := createFundedCard(t, services, user.ID, 0)
+
+	var (
+		mu        sync.Mutex
+		debited   int
+		credited  int
+		succeeded int
+		wg        sync.WaitGroup
+	)
+
+	for w := 0; w < concurrencyWorkers; w++ {
+		wg.Add(1)
+
+		go func(w int) {
+			defer wg.Done()
+
+			for i := 0; i < concurrencyOpsPerWorker; i++ {
+				if w%2 == 0 {
+					res, errResp := services.Transfer.CreateTransaction(&requests.CreateTransferRequest{
+						TransferFrom:   hot,
+						TransferTo:     other,
+						TransferAmount: concurrencyAmount,
+					})
+					if errResp != nil {
+						continue
+					}
+
+					mu.Lock()
+					debited += res.TransferAmount + res.Fee
+					credited += res.TransferAmount
+					succeeded++
+					mu.Unlock()
+
+					continue
+				}
+
+				res, errResp := services.Withdraw.Create(&requests.CreateWithdrawRequest{
+					CardNumber:     hot,
+					WithdrawAmount: concurrencyAmount,
+					WithdrawTime:   time.Now(),
+				})
+				if errResp != nil {
+					continue
+				}
+
+				mu.Lock()
+				debited += res.WithdrawAmount + res.Fee
+				succeeded++
+				mu.Unlock()
+			}
+		}(w)
+	}
+
+	wg.Wait()
+
+	if succeeded == 0 {
+		t.Fatalf("no debit succeeded")
+	}
+	if succeeded == concurrencyWorkers*concurrencyOpsPerWorker {
+		t.Fatalf("every debit succeeded, the opening balance did not run out")
+	}
+
+	checkBalance(t, services, hot, concurrencyOpening-debited)
+	checkBalance(t, services, other, credited)
+}
+
+func createFundedCard(t *testing.T, services *service.Service, userID, balance int) string {
+	t.Helper()
+
+	card, errResp := services.Card.CreateCard(&requests.CreateCardRequest{
+		UserID:       userID,
+		CardType:     "debit",
+		ExpireDate:   time.Now().AddDate(3, 0, 0),
+		CVV:          "123",
+		CardProvider: "visa",
+		Currency:     "IDR",
+	})
+	if errResp != nil {
+		t.Fatalf("create card: %s", errResp.Message)
+	}
+
+	if _, errResp := services.Saldo.CreateSaldo(&requests.CreateSaldoRequest{
+		CardNumber:   card.CardNumber,
+		TotalBalance: balance,
+	}); errResp != nil {
+		t.Fatalf("create saldo: %s", errResp.Message)
+	}
+
+	return card.CardNumber
+}
+
+// checkBalance compares the saldo of a card with the balance its successful
+// operations leave, and with the sum of its ledger postings.
+func checkBalance(t *testing.T, services *service.Service, cardNumber string, want int) {
+	t.Helper()
+
+	saldo, errResp := services.Saldo.FindByCardNumber(cardNumber)
+	if errResp != nil {
+		t.Fatalf("find saldo of %s: %s", cardNumber, errResp.Message)
+	}
+
+	if saldo.TotalBalance < 0 {
+		t.Errorf("card %s has a negative balance %d", cardNumber, saldo.TotalBalance)
+	}
+
+	if saldo.TotalBalance != want {
+		t.Errorf("card %s balance = %d, want %d", cardNumber, saldo.TotalBalance, want)
+	}
+
+	ledger, errResp := services.Ledger.FindBalanceByCardNumber(cardNumber, nil)
+	if errResp != nil {
+		t.Fatalf("find ledger balance of %s: %s", cardNumber, errResp.Message)
+	}
+
+	if ledger.LedgerBalance != want || ledger.Difference != 0 {
+		t.Errorf("card %s ledger balance = %d (difference %d), want %d", cardNumber, ledger.LedgerBalance, ledger.Difference, want)
+	}
+}
@@ -0,0 +1,113 @@
+package service
+
+import (
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+)
+
+// scheduleTimeLayout is how schedule times are rendered in records. Schedule
+// times are stored in UTC with whole seconds, so they survive the round trip.
+const scheduleTimeLayout = "2006-01-02 15:04:05"
+
+// firstOccurrence returns the first run of a schedule starting at start.
+// Monthly schedules run on the first matching day of month at or after start.
+func firstOccurrence(recurrence string, dayOfMonth *int, start time.Time) time.Time {
+	if recurrence != requests.ScheduleRecurrenceMonthly || dayOfMonth == nil {
+		return start
+	}
+
+	first := onDayOfMonth(start.Year(), start.Month(), *dayOfMonth, start)
+	if first.Before(start) {
+		first = onDayOfMonth(start.Year(), start.Month()+1, *dayOfMonth, start)
+	}
+
+	return first
+}
+
+// nextOccurrence returns the run following from. Once schedules have none.
+func nextOccurrence(recurrence string, dayOfMonth *int, from time.Time) (time.Time, bool) {
+	switch recurrence {
+	case requests.ScheduleRecurrenceDaily:
+		return from.AddDate(0, 0, 1), true
+	case requests.ScheduleRecurrenceWeekly:
+		return from.AddDate(0, 0, 7), true
+	case requests.ScheduleRecurrenceMonthly:
+		day := from.Day()
+		if dayOfMonth != nil {
+			day = *dayOfMonth
+		}
+		return onDayOfMonth(from.Year(), from.Month()+1, day, from), true
+	default:
+		return time.Time{}, false
+	}
+}
+
+// nextOccurrenceAfter returns the first run following from that is after
+// now, or nil when the schedule has no run left before end. Runs missed while
+// the executor was down or the schedule was paused are skipped rather than
+// caught up, so a schedule never sends a burst of transfers.
+func nextOccurrenceAfter(recurrence string, dayOfMonth *int, from time.Time, now time.Time, end *time.Time) *time.Time {
+	next, ok := nextOccurrence(recurrence, dayOfMonth, from)
+	if !ok {
+		return nil
+	}
+
+	for !next.After(now) {
+		next, _ = nextOccurrence(recurrence, dayOfMonth, next)
+	}
+
+	if end != nil && next.After(*end) {
+		return nil
+	}
+
+	return &next
+}
+
+// onDayOfMonth returns the given day of the month at the time of day of
+// clock, using the last day of the month when it is shorter.
+func onDayOfMonth(year int, month time.Month, day int, clock time.Time) time.Time {
+	lastDay := time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+	if day > lastDay {
+		day = lastDay
+	}
+
+	return time.Date(year, month, day, clock.Hour(), clock.Minute(), clock.Second(), 0, time.UTC)
+}
+
+func parseScheduleTime(value *string) (*time.Time, error) {
+	if value == nil {
+		return nil, nil
+	}
+
+	parsed, err := time.Parse(scheduleTimeLayout, *value)
+	if err != nil {
+		return nil, err
+	}
+
+	return &parsed, nil
+}
+
+func normalizeScheduleTime(value time.Time) time.Time {
+	return value.UTC().Truncate(time.Second)
+}
+
+func normalizeScheduleEnd(value *time.Time) *time.Time {
+	if value == nil {
+		return nil
+	}
+
+	end := normalizeScheduleTime(*value)
+	return &end
+}
+
+// defaultDayOfMonth makes monthly schedules without a day run on the day of
+// their start.
+func defaultDayOfMonth(recurrence string, dayOfMonth *int, start time.Time) *int {
+	if recurrence != requests.ScheduleRecurrenceMonthly || dayOfMonth != nil {
+		return dayOfMonth
+	}
+
+	day := start.Day()
+	return &day
+}
@@ -0,0 +1,447 @@
+package service
+
+import (
+	"errors"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/scheduled_transfer_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// scheduledTransferBatchSize caps how many due schedules a single ExecuteDue
+// call runs; the rest are picked up by the next call.
+const scheduledTransferBatchSize = 50
+
+type scheduledTransferService struct {
+	scheduledTransferRepository repository.ScheduledTransferRepository
+	cardRepository              repository.CardRepository
+	transferService             TransferService
+	unitOfWork                  repository.UnitOfWork
+	logger                      logger.LoggerInterface
+	mapping                     responseservice.ScheduledTransferResponseMapper
+}
+
+func NewScheduledTransferService(
+	scheduledTransferRepository repository.ScheduledTransferRepository,
+	cardRepository repository.CardRepository,
+	transferService TransferService,
+	unitOfWork repository.UnitOfWork,
+	logger logger.LoggerInterface,
+	mapping responseservice.ScheduledTransferResponseMapper,
+) *scheduledTransferService {
+	return &scheduledTransferService{
+		scheduledTransferRepository: scheduledTransferRepository,
+		cardRepository:              cardRepository,
+		transferService:             transferService,
+		unitOfWork:                  unitOfWork,
+		logger:                      logger,
+		mapping:                     mapping,
+	}
+}
+
+func (s *scheduledTransferService) FindAll(req *requests.FindAllScheduledTransfers) ([]*response.ScheduledTransferResponse, *int, *response.ErrorResponse) {
+	page := req.Page
+	pageSize := req.PageSize
+	search := req.Search
+
+	s.logger.Debug("Fetching scheduled transfers",
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+		zap.String("search", search))
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	req.Page = page
+	req.PageSize = pageSize
+
+	schedules, totalRecords, err := s.scheduledTransferRepository.FindAll(req)
+
+	if err != nil {
+		s.logger.Error("Failed to fetch scheduled transfers",
+			zap.Error(err),
+			zap.Int("page", page),
+			zap.Int("pageSize", pageSize),
+			zap.String("search", search))
+
+		return nil, nil, scheduled_transfer_errors.ErrFailedFindAllScheduledTransfers
+	}
+
+	so := s.mapping.ToScheduledTransfersResponse(schedules)
+
+	s.logger.Debug("Successfully fetched scheduled transfers",
+		zap.Int("totalRecords", *totalRecords),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return so, totalRecords, nil
+}
+
+func (s *scheduledTransferService) FindById(scheduledTransferID int, requestedBy *int) (*response.ScheduledTransferResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching scheduled transfer by ID", zap.Int("scheduled_transfer_id", scheduledTransferID))
+
+	schedule, errResp := s.findOwnedSchedule(scheduledTransferID, requestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	return s.mapping.ToScheduledTransferResponse(schedule), nil
+}
+
+func (s *scheduledTransferService) FindRuns(req *requests.FindScheduledTransferRuns) ([]*response.ScheduledTransferRunResponse, *int, *response.ErrorResponse) {
+	s.logger.Debug("Fetching scheduled transfer runs", zap.Int("scheduled_transfer_id", req.ScheduledTransferID))
+
+	if _, errResp := s.findOwnedSchedule(req.ScheduledTransferID, req.RequestedBy); errResp != nil {
+		return nil, nil, errResp
+	}
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	runs, totalRecords, err := s.scheduledTransferRepository.FindRuns(req)
+	if err != nil {
+		s.logger.Error("Failed to fetch scheduled transfer runs", zap.Error(err))
+		return nil, nil, scheduled_transfer_errors.ErrFailedFindScheduledTransferRuns
+	}
+
+	return s.mapping.ToScheduledTransferRunsResponse(runs), totalRecords, nil
+}
+
+func (s *scheduledTransferService) CreateScheduledTransfer(request *requests.CreateScheduledTransferRequest) (*response.ScheduledTransferResponse, *response.ErrorResponse) {
+	s.logger.Debug("Creating scheduled transfer", zap.Any("request", request))
+
+	senderCard, err := s.cardRepository.FindCardByCardNumber(request.TransferFrom)
+	if err != nil {
+		s.logger.Error("failed to find sender card by number", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	if request.RequestedBy != nil && senderCard.UserID != *request.RequestedBy {
+		s.logger.Error("unauthorized scheduled transfer request",
+			zap.String("transfer_from", request.TransferFrom),
+			zap.Int("requested_by", *request.RequestedBy),
+		)
+		return nil, scheduled_transfer_errors.ErrScheduledTransferNotAllowed
+	}
+
+	if errResp := checkMinimumAmount(request.TransferAmount, senderCard.Currency); errResp != nil {
+		s.logger.Error("scheduled transfer amount below the currency minimum",
+			zap.Int("transfer_amount", request.TransferAmount),
+			zap.String("currency", senderCard.Currency),
+		)
+		return nil, errResp
+	}
+
+	if _, err := s.cardRepository.FindCardByCardNumber(request.TransferTo); err != nil {
+		s.logger.Error("failed to find receiver card by number", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	request.StartAt = normalizeScheduleTime(request.StartAt)
+	request.EndAt = normalizeScheduleEnd(request.EndAt)
+	request.DayOfMonth = defaultDayOfMonth(request.Recurrence, request.DayOfMonth, request.StartAt)
+
+	nextRunAt := firstOccurrence(request.Recurrence, request.DayOfMonth, request.StartAt)
+	if request.EndAt != nil && nextRunAt.After(*request.EndAt) {
+		return nil, scheduled_transfer_errors.ErrScheduledTransferHasNoRunLeft
+	}
+
+	schedule, err := s.scheduledTransferRepository.CreateScheduledTransfer(senderCard.UserID, request, nextRunAt)
+	if err != nil {
+		s.logger.Error("Failed to create scheduled transfer", zap.Error(err))
+		return nil, scheduled_transfer_errors.ErrFailedCreateScheduledTransfer
+	}
+
+	s.logger.Debug("Successfully created scheduled transfer", zap.Int("scheduled_transfer_id", schedule.ID))
+
+	return s.mapping.ToScheduledTransferResponse(schedule), nil
+}
+
+func (s *scheduledTransferService) UpdateScheduledTransfer(request *requests.UpdateScheduledTransferRequest) (*response.ScheduledTransferResponse, *response.ErrorResponse) {
+	s.logger.Debug("Updating scheduled transfer", zap.Any("request", request))
+
+	existing, errResp := s.findOwnedSchedule(*request.ScheduledTransferID, request.RequestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if request.TransferTo == existing.TransferFrom {
+		return nil, scheduled_transfer_errors.ErrScheduledTransferSameCard
+	}
+
+	senderCard, err := s.cardRepository.FindCardByCardNumber(existing.TransferFrom)
+	if err != nil {
+		s.logger.Error("failed to find sender card by number", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	if errResp := checkMinimumAmount(request.TransferAmount, senderCard.Currency); errResp != nil {
+		s.logger.Error("scheduled transfer amount below the currency minimum",
+			zap.Int("transfer_amount", request.TransferAmount),
+			zap.String("currency", senderCard.Currency),
+		)
+		return nil, errResp
+	}
+
+	if _, err := s.cardRepository.FindCardByCardNumber(request.TransferTo); err != nil {
+		s.logger.Error("failed to find receiver card by number", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	request.StartAt = normalizeScheduleTime(request.StartAt)
+	request.EndAt = normalizeScheduleEnd(request.EndAt)
+	request.DayOfMonth = defaultDayOfMonth(request.Recurrence, request.DayOfMonth, request.StartAt)
+
+	nextRunAt := firstOccurrence(request.Recurrence, request.DayOfMonth, request.StartAt)
+	if request.EndAt != nil && nextRunAt.After(*request.EndAt) {
+		return nil, scheduled_transfer_errors.ErrScheduledTransferHasNoRunLeft
+	}
+
+	schedule, err := s.scheduledTransferRepository.UpdateScheduledTransfer(request, nextRunAt)
+	if err != nil {
+		s.logger.Error("Failed to update scheduled transfer", zap.Error(err))
+
+		if errors.Is(err, scheduled_transfer_errors.ErrScheduledTransferStatusConflict) {
+			return nil, scheduled_transfer_errors.ErrScheduledTransferNotEditable
+		}
+		return nil, scheduled_transfer_errors.ErrFailedUpdateScheduledTransfer
+	}
+
+	s.logger.Debug("Successfully updated scheduled transfer", zap.Int("scheduled_transfer_id", schedule.ID))
+
+	return s.mapping.ToScheduledTransferResponse(schedule), nil
+}
+
+func (s *scheduledTransferService) PauseScheduledTransfer(scheduledTransferID int, requestedBy *int) (*response.ScheduledTransferResponse, *response.ErrorResponse) {
+	s.logger.Debug("Pausing scheduled transfer", zap.Int("scheduled_transfer_id", scheduledTransferID))
+
+	if _, errResp := s.findOwnedSchedule(scheduledTransferID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	schedule, err := s.scheduledTransferRepository.PauseScheduledTransfer(scheduledTransferID)
+	if err != nil {
+		s.logger.Error("Failed to pause scheduled transfer", zap.Error(err))
+
+		if errors.Is(err, scheduled_transfer_errors.ErrScheduledTransferStatusConflict) {
+			return nil, scheduled_transfer_errors.ErrScheduledTransferNotPausable
+		}
+		return nil, scheduled_transfer_errors.ErrFailedPauseScheduledTransfer
+	}
+
+	return s.mapping.ToScheduledTransferResponse(schedule), nil
+}
+
+// ResumeScheduledTransfer reactivates a paused or failed schedule from its
+// next occurrence after now. A once schedule that is no longer in the future
+// runs on the executor's next pass.
+func (s *scheduledTransferService) ResumeScheduledTransfer(scheduledTransferID int, requestedBy *int) (*response.ScheduledTransferResponse, *response.ErrorResponse) {
+	s.logger.Debug("Resuming scheduled transfer", zap.Int("scheduled_transfer_id", scheduledTransferID))
+
+	existing, errResp := s.findOwnedSchedule(scheduledTransferID, requestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if existing.Status != requests.ScheduledTransferStatusPaused && existing.Status != requests.ScheduledTransferStatusFailed {
+		return nil, scheduled_transfer_errors.ErrScheduledTransferNotResumable
+	}
+
+	nextRunAt, err := parseScheduleTime(existing.NextRunAt)
+	if err != nil {
+		s.logger.Error("failed to parse next run of scheduled transfer", zap.Error(err))
+		return nil, scheduled_transfer_errors.ErrFailedResumeScheduledTransfer
+	}
+
+	endAt, err := parseScheduleTime(existing.EndAt)
+	if err != nil {
+		s.logger.Error("failed to parse end of scheduled transfer", zap.Error(err))
+		return nil, scheduled_transfer_errors.ErrFailedResumeScheduledTransfer
+	}
+
+	now := normalizeScheduleTime(time.Now())
+
+	switch {
+	case existing.Recurrence == requests.ScheduleRecurrenceOnce:
+		if nextRunAt == nil || !nextRunAt.After(now) {
+			nextRunAt = &now
+		}
+	case nextRunAt != nil && !nextRunAt.After(now):
+		nextRunAt = nextOccurrenceAfter(existing.Recurrence, existing.DayOfMonth, *nextRunAt, now, endAt)
+	}
+
+	if nextRunAt == nil || (endAt != nil && nextRunAt.After(*endAt)) {
+		return nil, scheduled_transfer_errors.ErrScheduledTransferHasNoRunLeft
+	}
+
+	schedule, err := s.scheduledTransferRepository.ResumeScheduledTransfer(scheduledTransferID, *nextRunAt)
+	if err != nil {
+		s.logger.Error("Failed to resume scheduled transfer", zap.Error(err))
+
+		if errors.Is(err, scheduled_transfer_errors.ErrScheduledTransferStatusConflict) {
+			return nil, scheduled_transfer_errors.ErrScheduledTransferNotResumable
+		}
+		return nil, scheduled_transfer_errors.ErrFailedResumeScheduledTransfer
+	}
+
+	return s.mapping.ToScheduledTransferResponse(schedule), nil
+}
+
+func (s *scheduledTransferService) CancelScheduledTransfer(scheduledTransferID int, requestedBy *int) (*response.ScheduledTransferResponse, *response.ErrorResponse) {
+	s.logger.Debug("Cancelling scheduled transfer", zap.Int("scheduled_transfer_id", scheduledTransferID))
+
+	if _, errResp := s.findOwnedSchedule(scheduledTransferID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	schedule, err := s.scheduledTransferRepository.CancelScheduledTransfer(scheduledTransferID)
+	if err != nil {
+		s.logger.Error("Failed to cancel scheduled transfer", zap.Error(err))
+
+		if errors.Is(err, scheduled_transfer_errors.ErrScheduledTransferStatusConflict) {
+			return nil, scheduled_transfer_errors.ErrScheduledTransferNotCancellable
+		}
+		return nil, scheduled_transfer_errors.ErrFailedCancelScheduledTransfer
+	}
+
+	return s.mapping.ToScheduledTransferResponse(schedule), nil
+}
+
+// ExecuteDue runs the schedules whose next run is due and returns how many
+// runs were started.
+//
+// A run is claimed and the schedule moved to its next occurrence in one
+// database transaction before any money moves, so a run executes at most
+// once: a schedule paused or cancelled in the meantime is not claimed, two
+// executors cannot claim the same occurrence, and a run interrupted before it
+// finished stays in the running state instead of being retried.
+func (s *scheduledTransferService) ExecuteDue() (int, *response.ErrorResponse) {
+	now := normalizeScheduleTime(time.Now())
+
+	due, err := s.scheduledTransferRepository.FindDue(now, scheduledTransferBatchSize)
+	if err != nil {
+		s.logger.Error("failed to find due scheduled transfers", zap.Error(err))
+		return 0, scheduled_transfer_errors.ErrFailedFindDueScheduledTransfers
+	}
+
+	executed := 0
+
+	for _, schedule := range due {
+		run, err := s.claimRun(schedule, now)
+		if err != nil {
+			if !errors.Is(err, scheduled_transfer_errors.ErrScheduledTransferRunAlreadyClaimed) {
+				s.logger.Error("failed to claim scheduled transfer run",
+					zap.Int("scheduled_transfer_id", schedule.ID),
+					zap.Error(err),
+				)
+			}
+			continue
+		}
+
+		s.executeRun(schedule, run)
+		executed++
+	}
+
+	return executed, nil
+}
+
+func (s *scheduledTransferService) claimRun(schedule *record.ScheduledTransferRecord, now time.Time) (*record.ScheduledTransferRunRecord, error) {
+	scheduledFor, err := parseScheduleTime(schedule.NextRunAt)
+	if err != nil || scheduledFor == nil {
+		return nil, scheduled_transfer_errors.ErrClaimScheduledTransferRunFailed
+	}
+
+	endAt, err := parseScheduleTime(schedule.EndAt)
+	if err != nil {
+		return nil, scheduled_transfer_errors.ErrClaimScheduledTransferRunFailed
+	}
+
+	var run *record.ScheduledTransferRunRecord
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		var err error
+
+		run, err = repos.ScheduledTransfer.ClaimRun(schedule.ID, *scheduledFor)
+		if err != nil {
+			return err
+		}
+
+		status := requests.ScheduledTransferStatusActive
+		nextRunAt := nextOccurrenceAfter(schedule.Recurrence, schedule.DayOfMonth, *scheduledFor, now, endAt)
+		if nextRunAt == nil {
+			status = requests.ScheduledTransferStatusCompleted
+		}
+
+		_, err = repos.ScheduledTransfer.AdvanceScheduledTransfer(schedule.ID, nextRunAt, status, *scheduledFor)
+		return err
+	})
+
+	return run, err
+}
+
+// executeRun makes the transfer of a claimed run and records its outcome.
+// A failed run stops the schedule until its owner resumes it.
+func (s *scheduledTransferService) executeRun(schedule *record.ScheduledTransferRecord, run *record.ScheduledTransferRunRecord) {
+	transfer, errResp := s.transferService.CreateTransaction(&requests.CreateTransferRequest{
+		TransferFrom:   schedule.TransferFrom,
+		TransferTo:     schedule.TransferTo,
+		TransferAmount: schedule.TransferAmount,
+	})
+
+	if errResp != nil {
+		s.logger.Error("scheduled transfer run failed",
+			zap.Int("scheduled_transfer_id", schedule.ID),
+			zap.Int("run_id", run.ID),
+			zap.String("reason", errResp.Message),
+		)
+
+		if _, err := s.scheduledTransferRepository.FinishRun(run.ID, requests.ScheduledTransferRunFailed, nil, &errResp.Message); err != nil {
+			s.logger.Error("failed to record scheduled transfer run failure", zap.Int("run_id", run.ID), zap.Error(err))
+		}
+
+		if _, err := s.scheduledTransferRepository.FailScheduledTransfer(schedule.ID); err != nil && !errors.Is(err, scheduled_transfer_errors.ErrScheduledTransferStatusConflict) {
+			s.logger.Error("failed to stop failing scheduled transfer", zap.Int("scheduled_transfer_id", schedule.ID), zap.Error(err))
+		}
+
+		return
+	}
+
+	if _, err := s.scheduledTransferRepository.FinishRun(run.ID, requests.ScheduledTransferRunSuccess, &transfer.ID, nil); err != nil {
+		s.logger.Error("failed to record scheduled transfer run success", zap.Int("run_id", run.ID), zap.Error(err))
+	}
+}
+
+func (s *scheduledTransferService) findOwnedSchedule(scheduledTransferID int, requestedBy *int) (*record.ScheduledTransferRecord, *response.ErrorResponse) {
+	schedule, err := s.scheduledTransferRepository.FindById(scheduledTransferID)
+	if err != nil {
+		s.logger.Error("failed to find scheduled transfer", zap.Int("scheduled_transfer_id", scheduledTransferID), zap.Error(err))
+		return nil, scheduled_transfer_errors.ErrScheduledTransferNotFound
+	}
+
+	if requestedBy != nil && schedule.UserID != *requestedBy {
+		s.logger.Error("scheduled transfer belongs to another user",
+			zap.Int("scheduled_transfer_id", scheduledTransferID),
+			zap.Int("requested_by", *requestedBy),
+		)
+		return nil, scheduled_transfer_errors.ErrScheduledTransferNotAllowed
+	}
+
+	return schedule, nil
+}
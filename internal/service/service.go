@@ -1,45 +1,107 @@
 package service
 
 import (
+	"time"
+
 	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	apikey "github.com/MamangRust/paymentgatewaygraphql/pkg/api-key"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/auth"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/hash"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	topupprovider "github.com/MamangRust/paymentgatewaygraphql/pkg/topup_provider"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/webhook"
 )
 
 type Service struct {
-	Auth        AuthService
-	User        UserService
-	Role        RoleService
-	Saldo       SaldoService
-	Topup       TopupService
-	Transfer    TransferService
-	Withdraw    WithdrawService
-	Card        CardService
-	Merchant    MerchantService
-	Transaction TransactionService
+	Auth               AuthService
+	User               UserService
+	Role               RoleService
+	Saldo              SaldoService
+	Topup              TopupService
+	Transfer           TransferService
+	Withdraw           WithdrawService
+	Card               CardService
+	Merchant           MerchantService
+	Transaction        TransactionService
+	Ledger             LedgerService
+	IdempotencyKey     IdempotencyKeyService
+	Refund             RefundService
+	Dispute            DisputeService
+	Authorization      AuthorizationService
+	ExchangeRate       ExchangeRateService
+	FeeSchedule        FeeScheduleService
+	TransactionLimit   TransactionLimitService
+	ScheduledTransfer  ScheduledTransferService
+	TransferBatch      TransferBatchService
+	StatusHistory      StatusHistoryService
+	ProviderWebhook    ProviderWebhookService
+	MerchantWebhook    MerchantWebhookService
+	Settlement         SettlementService
+	Reconciliation     ReconciliationService
+	MerchantOnboarding MerchantOnboardingService
+	MerchantApiKey     MerchantApiKeyService
+	Invoice            InvoiceService
 }
 
 type Deps struct {
-	Repositories *repository.Repositories
-	Token        auth.TokenManager
-	Hash         hash.HashPassword
-	Logger       logger.LoggerInterface
-	Mapper       responseservice.ResponseServiceMapper
+	Repositories      *repository.Repositories
+	UnitOfWork        repository.UnitOfWork
+	Token             auth.TokenManager
+	Hash              hash.HashPassword
+	Logger            logger.LoggerInterface
+	Mapper            responseservice.ResponseServiceMapper
+	IdempotencyKeyTTL time.Duration
+	AuthorizationTTL  time.Duration
+
+	WithdrawReviewThreshold int
+	WithdrawPayoutProvider  string
+	TopupProviders          *topupprovider.Registry
+
+	ProviderWebhookSecrets   map[string]string
+	ProviderWebhookTolerance time.Duration
+
+	MerchantWebhookSender *webhook.Sender
+
+	RequestSignatureTolerance time.Duration
+	SigningSecretSealer       *apikey.SecretSealer
+
+	MerchantQr MerchantQrConfig
 }
 
 func NewService(deps Deps) *Service {
+	topup := NewTopupService(deps.Repositories.Card, deps.Repositories.Topup, deps.Repositories.Saldo, deps.Repositories.FeeSchedule, deps.UnitOfWork, deps.TopupProviders, deps.Logger, deps.Mapper.TopupResponseMapper)
+	withdraw := NewWithdrawService(deps.Repositories.User, deps.Repositories.Withdraw, deps.Repositories.Saldo, deps.Repositories.Card, deps.Repositories.FeeSchedule, deps.UnitOfWork, deps.WithdrawReviewThreshold, deps.WithdrawPayoutProvider, deps.Logger, deps.Mapper.WithdrawResponseMapper)
+	transfer := NewTransferService(deps.Repositories.User, deps.Repositories.Card, deps.Repositories.Transfer, deps.Repositories.Saldo, deps.Repositories.ExchangeRate, deps.Repositories.FeeSchedule, deps.UnitOfWork, deps.Logger, deps.Mapper.TransferResponseMapper)
+
 	return &Service{
-		Auth:        NewAuthService(deps.Repositories.User, deps.Repositories.RefreshToken, deps.Repositories.Role, deps.Repositories.UserRole, deps.Hash, deps.Token, deps.Logger, deps.Mapper.UserResponseMapper),
-		User:        NewUserService(deps.Repositories.User, deps.Logger, deps.Mapper.UserResponseMapper, deps.Hash),
-		Role:        NewRoleService(deps.Repositories.Role, deps.Logger, deps.Mapper.RoleResponseMapper),
-		Saldo:       NewSaldoService(deps.Repositories.Saldo, deps.Repositories.Card, deps.Logger, deps.Mapper.SaldoResponseMapper),
-		Topup:       NewTopupService(deps.Repositories.Card, deps.Repositories.Topup, deps.Repositories.Saldo, deps.Logger, deps.Mapper.TopupResponseMapper),
-		Transfer:    NewTransferService(deps.Repositories.User, deps.Repositories.Card, deps.Repositories.Transfer, deps.Repositories.Saldo, deps.Logger, deps.Mapper.TransferResponseMapper),
-		Withdraw:    NewWithdrawService(deps.Repositories.User, deps.Repositories.Withdraw, deps.Repositories.Saldo, deps.Logger, deps.Mapper.WithdrawResponseMapper),
-		Card:        NewCardService(deps.Repositories.Card, deps.Repositories.User, deps.Logger, deps.Mapper.CardResponseMapper),
-		Merchant:    NewMerchantService(deps.Repositories.Merchant, deps.Logger, deps.Mapper.MerchantResponseMapper),
-		Transaction: NewTransactionService(deps.Repositories.Merchant, deps.Repositories.Card, deps.Repositories.Saldo, deps.Repositories.Transaction, deps.Logger, deps.Mapper.TransactionResponseMapper),
+		Auth:               NewAuthService(deps.Repositories.User, deps.Repositories.RefreshToken, deps.Repositories.Role, deps.Repositories.UserRole, deps.Hash, deps.Token, deps.Logger, deps.Mapper.UserResponseMapper),
+		User:               NewUserService(deps.Repositories.User, deps.Logger, deps.Mapper.UserResponseMapper, deps.Hash),
+		Role:               NewRoleService(deps.Repositories.Role, deps.Logger, deps.Mapper.RoleResponseMapper),
+		Saldo:              NewSaldoService(deps.Repositories.Saldo, deps.Repositories.SaldoHold, deps.Repositories.Card, deps.UnitOfWork, deps.Logger, deps.Mapper.SaldoResponseMapper),
+		Topup:              topup,
+		Transfer:           transfer,
+		Withdraw:           withdraw,
+		Card:               NewCardService(deps.Repositories.Card, deps.Repositories.User, deps.Logger, deps.Mapper.CardResponseMapper),
+		Merchant:           NewMerchantService(deps.Repositories.Merchant, deps.Repositories.MerchantApiKey, deps.Logger, deps.Mapper.MerchantResponseMapper),
+		Transaction:        NewTransactionService(deps.Repositories.Merchant, deps.Repositories.MerchantApiKey, deps.Repositories.Card, deps.Repositories.Saldo, deps.Repositories.Transaction, deps.Repositories.FeeSchedule, deps.Repositories.MerchantWebhook, deps.Repositories.QrPayment, deps.UnitOfWork, deps.MerchantQr, deps.Logger, deps.Mapper.TransactionResponseMapper),
+		Ledger:             NewLedgerService(deps.Repositories.Ledger, deps.Repositories.Saldo, deps.Repositories.Card, deps.Logger, deps.Mapper.LedgerResponseMapper),
+		IdempotencyKey:     NewIdempotencyKeyService(deps.Repositories.IdempotencyKey, deps.IdempotencyKeyTTL, deps.Logger, deps.Mapper.IdempotencyKeyResponseMapper),
+		Refund:             NewRefundService(deps.Repositories.Refund, deps.Repositories.Transaction, deps.Repositories.Merchant, deps.Repositories.Card, deps.UnitOfWork, deps.Logger, deps.Mapper.RefundResponseMapper),
+		Dispute:            NewDisputeService(deps.Repositories.Dispute, deps.Repositories.Transaction, deps.Repositories.Merchant, deps.Repositories.Card, deps.UnitOfWork, deps.Logger, deps.Mapper.DisputeResponseMapper),
+		Authorization:      NewAuthorizationService(deps.Repositories.Authorization, deps.Repositories.Merchant, deps.Repositories.MerchantApiKey, deps.Repositories.Card, deps.Repositories.FeeSchedule, deps.UnitOfWork, deps.AuthorizationTTL, deps.Logger, deps.Mapper.AuthorizationResponseMapper, deps.Mapper.TransactionResponseMapper),
+		ExchangeRate:       NewExchangeRateService(deps.Repositories.ExchangeRate, deps.Logger, deps.Mapper.ExchangeRateResponseMapper),
+		FeeSchedule:        NewFeeScheduleService(deps.Repositories.FeeSchedule, deps.UnitOfWork, deps.Logger, deps.Mapper.FeeScheduleResponseMapper),
+		TransactionLimit:   NewTransactionLimitService(deps.Repositories.TransactionLimit, deps.Repositories.Card, deps.Logger, deps.Mapper.TransactionLimitResponseMapper),
+		ScheduledTransfer:  NewScheduledTransferService(deps.Repositories.ScheduledTransfer, deps.Repositories.Card, transfer, deps.UnitOfWork, deps.Logger, deps.Mapper.ScheduledTransferResponseMapper),
+		TransferBatch:      NewTransferBatchService(deps.Repositories.TransferBatch, deps.Repositories.Card, deps.Repositories.Saldo, transfer, deps.UnitOfWork, deps.Logger, deps.Mapper.TransferBatchResponseMapper),
+		StatusHistory:      NewStatusHistoryService(deps.Repositories.StatusHistory, deps.Repositories.Topup, deps.Repositories.Withdraw, deps.Repositories.Transfer, deps.Repositories.Transaction, deps.Repositories.Card, deps.Repositories.Merchant, deps.Logger, deps.Mapper.StatusHistoryResponseMapper),
+		ProviderWebhook:    NewProviderWebhookService(deps.Repositories.ProviderWebhook, topup, withdraw, deps.ProviderWebhookSecrets, deps.ProviderWebhookTolerance, deps.Logger, deps.Mapper.ProviderWebhookResponseMapper),
+		MerchantWebhook:    NewMerchantWebhookService(deps.Repositories.MerchantWebhook, deps.Repositories.Merchant, deps.UnitOfWork, deps.MerchantWebhookSender, deps.Logger, deps.Mapper.MerchantWebhookResponseMapper),
+		Settlement:         NewSettlementService(deps.Repositories.Settlement, deps.Repositories.Merchant, deps.Repositories.Card, deps.UnitOfWork, deps.Logger, deps.Mapper.SettlementResponseMapper),
+		Reconciliation:     NewReconciliationService(deps.Repositories.Reconciliation, deps.UnitOfWork, deps.Logger, deps.Mapper.ReconciliationResponseMapper),
+		MerchantOnboarding: NewMerchantOnboardingService(deps.Repositories.MerchantOnboarding, deps.Repositories.Merchant, deps.UnitOfWork, deps.Logger, deps.Mapper.MerchantOnboardingResponseMapper, deps.Mapper.MerchantResponseMapper),
+		MerchantApiKey:     NewMerchantApiKeyService(deps.Repositories.MerchantApiKey, deps.Repositories.Merchant, deps.UnitOfWork, deps.RequestSignatureTolerance, deps.SigningSecretSealer, deps.Logger, deps.Mapper.MerchantApiKeyResponseMapper, deps.Mapper.MerchantResponseMapper),
+		Invoice:            NewInvoiceService(deps.Repositories.Invoice, deps.Repositories.Merchant, deps.Repositories.Card, deps.Repositories.FeeSchedule, deps.UnitOfWork, deps.Logger, deps.Mapper.InvoiceResponseMapper, deps.Mapper.TransactionResponseMapper),
 	}
 }
@@ -0,0 +1,20 @@
+package service
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+)
+
+// accrueSettlementItem records an item of a merchant balance and adds its
+// net amount to the pending balance. The money itself sits on
+// MERCHANT_SETTLEMENT until the settlement job pays the balance out, so the
+// caller posts the matching journal in the same unit of work.
+func accrueSettlementItem(repo repository.SettlementRepository, item *requests.CreateSettlementItem) error {
+	if _, err := repo.CreateItem(item); err != nil {
+		return err
+	}
+
+	_, err := repo.AddPendingBalance(item.MerchantID, item.Currency, item.NetAmount)
+
+	return err
+}
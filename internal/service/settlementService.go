@@ -0,0 +1,331 @@
+package service
+
+import (
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/ledger_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/settlement_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+// errNothingToSettle is returned from inside a settlement unit of work when
+// the items before the cutoff do not add up to a payout. Nothing is written
+// and the items are netted against the next settlement.
+var errNothingToSettle = errors.New("nothing to settle")
+
+type settlementService struct {
+	settlementRepository repository.SettlementRepository
+	merchantRepository   repository.MerchantRepository
+	cardRepository       repository.CardRepository
+	unitOfWork           repository.UnitOfWork
+	logger               logger.LoggerInterface
+	mapping              responseservice.SettlementResponseMapper
+}
+
+func NewSettlementService(
+	settlementRepository repository.SettlementRepository,
+	merchantRepository repository.MerchantRepository,
+	cardRepository repository.CardRepository,
+	unitOfWork repository.UnitOfWork,
+	logger logger.LoggerInterface,
+	mapping responseservice.SettlementResponseMapper,
+) *settlementService {
+	return &settlementService{
+		settlementRepository: settlementRepository,
+		merchantRepository:   merchantRepository,
+		cardRepository:       cardRepository,
+		unitOfWork:           unitOfWork,
+		logger:               logger,
+		mapping:              mapping,
+	}
+}
+
+// FindBalances returns what a merchant is owed in every currency it was
+// paid in and has not been paid out yet.
+func (s *settlementService) FindBalances(merchantID int, requestedBy *int) ([]*response.MerchantBalanceResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching merchant balances", zap.Int("merchant_id", merchantID))
+
+	if _, errResp := s.checkMerchant(merchantID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	balances, err := s.settlementRepository.FindBalancesByMerchant(merchantID)
+	if err != nil {
+		s.logger.Error("Failed to fetch merchant balances", zap.Error(err), zap.Int("merchant_id", merchantID))
+		return nil, settlement_errors.ErrFailedFindMerchantBalances
+	}
+
+	return s.mapping.ToMerchantBalancesResponse(balances), nil
+}
+
+// SetSettlementCard designates the card the balance of a merchant in the
+// card currency is paid out to. The card must belong to the merchant owner.
+func (s *settlementService) SetSettlementCard(request *requests.SetSettlementCardRequest) (*response.MerchantBalanceResponse, *response.ErrorResponse) {
+	s.logger.Debug("Setting settlement card", zap.Int("merchant_id", request.MerchantID))
+
+	merchant, errResp := s.checkMerchant(request.MerchantID, request.RequestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	card, err := s.cardRepository.FindCardByCardNumber(request.CardNumber)
+	if err != nil {
+		s.logger.Error("Failed to find settlement card", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	if card.UserID != merchant.UserID {
+		s.logger.Error("settlement card does not belong to the merchant owner",
+			zap.Int("merchant_id", merchant.ID),
+			zap.Int("card_user_id", card.UserID),
+		)
+		return nil, settlement_errors.ErrSettlementCardNotOwned
+	}
+
+	balance, err := s.settlementRepository.SetSettlementCard(merchant.ID, card.Currency, card.CardNumber)
+	if err != nil {
+		s.logger.Error("Failed to set settlement card", zap.Error(err))
+		return nil, settlement_errors.ErrFailedSetSettlementCard
+	}
+
+	return s.mapping.ToMerchantBalanceResponse(balance), nil
+}
+
+func (s *settlementService) FindBatches(req *requests.FindSettlementBatches) ([]*response.SettlementBatchResponse, *int, *response.ErrorResponse) {
+	s.logger.Debug("Fetching settlement batches", zap.Int("merchant_id", req.MerchantID))
+
+	if _, errResp := s.checkMerchant(req.MerchantID, req.RequestedBy); errResp != nil {
+		return nil, nil, errResp
+	}
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	batches, totalRecords, err := s.settlementRepository.FindBatches(req)
+	if err != nil {
+		s.logger.Error("Failed to fetch settlement batches", zap.Error(err))
+		return nil, nil, settlement_errors.ErrFailedFindSettlementBatches
+	}
+
+	return s.mapping.ToSettlementBatchesResponse(batches), totalRecords, nil
+}
+
+func (s *settlementService) FindBatchById(batchID int, requestedBy *int) (*response.SettlementBatchResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching settlement batch", zap.Int("batch_id", batchID))
+
+	batch, errResp := s.findOwnedBatch(batchID, requestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	return s.mapping.ToSettlementBatchResponse(batch), nil
+}
+
+// FindBatchItems returns the payments, refunds and adjustments a batch paid
+// out.
+func (s *settlementService) FindBatchItems(batchID int, requestedBy *int) ([]*response.SettlementItemResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching settlement batch items", zap.Int("batch_id", batchID))
+
+	if _, errResp := s.findOwnedBatch(batchID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	items, err := s.settlementRepository.FindItemsByBatch(batchID)
+	if err != nil {
+		s.logger.Error("Failed to fetch settlement items", zap.Error(err), zap.Int("batch_id", batchID))
+		return nil, settlement_errors.ErrFailedFindSettlementItems
+	}
+
+	return s.mapping.ToSettlementItemsResponse(items), nil
+}
+
+// SettleDue pays out every merchant balance with items accrued before
+// midnight UTC and reports how many batches were paid. Balances whose items
+// do not add up to a payout, for instance because refunds outweigh
+// payments, are left to the next run.
+func (s *settlementService) SettleDue() (int, *response.ErrorResponse) {
+	cutoff := time.Now().UTC().Truncate(24 * time.Hour)
+
+	balances, err := s.settlementRepository.FindBalancesDue(cutoff)
+	if err != nil {
+		s.logger.Error("Failed to fetch merchant balances due for settlement", zap.Error(err))
+		return 0, settlement_errors.ErrFailedFindMerchantBalancesDue
+	}
+
+	settled := 0
+
+	for _, balance := range balances {
+		batch, err := s.settle(balance, cutoff)
+		if err != nil {
+			if errors.Is(err, errNothingToSettle) {
+				continue
+			}
+
+			s.logger.Error("Failed to settle merchant balance",
+				zap.Error(err),
+				zap.Int("merchant_id", balance.MerchantID),
+				zap.String("currency", balance.Currency),
+			)
+			continue
+		}
+
+		s.logger.Debug("Settled merchant balance",
+			zap.Int("merchant_id", batch.MerchantID),
+			zap.Int("batch_id", batch.ID),
+			zap.Int("net_amount", batch.NetAmount),
+		)
+
+		settled++
+	}
+
+	return settled, nil
+}
+
+// settle pays out the items of one balance accrued before cutoff as a single
+// batch. The balance row stays locked while the batch is built, so
+// concurrent runs cannot pay out the same items twice.
+func (s *settlementService) settle(balance *record.MerchantBalanceRecord, cutoff time.Time) (*record.SettlementBatchRecord, error) {
+	cardNumber, err := s.settlementCard(balance)
+	if err != nil {
+		return nil, err
+	}
+
+	var batch *record.SettlementBatchRecord
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		// The settlement card is locked before the balance row, in the order
+		// payments and refunds take them.
+		if _, err := lockSaldos(repos, cardNumber); err != nil {
+			return saldo_errors.ErrFailedSaldoNotFound
+		}
+
+		if _, err := repos.Settlement.FindBalanceForUpdate(balance.MerchantID, balance.Currency); err != nil {
+			return err
+		}
+
+		totals, err := repos.Settlement.FindUnsettledTotals(balance.MerchantID, balance.Currency, cutoff)
+		if err != nil {
+			return err
+		}
+
+		if totals.ItemCount == 0 || totals.NetAmount <= 0 {
+			return errNothingToSettle
+		}
+
+		batch, err = repos.Settlement.CreateBatch(&requests.CreateSettlementBatch{
+			MerchantID:           balance.MerchantID,
+			Currency:             balance.Currency,
+			SettlementCardNumber: cardNumber,
+			CutoffAt:             cutoff,
+			GrossAmount:          totals.GrossAmount,
+			FeeAmount:            totals.FeeAmount,
+			RefundAmount:         totals.RefundAmount,
+			AdjustmentAmount:     totals.AdjustmentAmount,
+			NetAmount:            totals.NetAmount,
+			ItemCount:            totals.ItemCount,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := repos.Settlement.AssignItems(batch, cutoff); err != nil {
+			return err
+		}
+
+		if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+			requests.LedgerReferenceSettlement,
+			batch.ID,
+			"Settlement "+strconv.Itoa(batch.ID)+" of merchant "+strconv.Itoa(batch.MerchantID)+" to card "+cardNumber,
+			requests.SystemLedgerAccount(requests.LedgerAccountMerchantSettlement),
+			requests.CardLedgerAccount(cardNumber),
+			batch.NetAmount,
+			batch.Currency,
+		)); err != nil {
+			return ledger_errors.ErrFailedPostLedgerJournal
+		}
+
+		_, err = repos.Settlement.AddPendingBalance(batch.MerchantID, batch.Currency, -batch.NetAmount)
+
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return batch, nil
+}
+
+// settlementCard returns the card a balance is paid out to: the designated
+// settlement card, or the first card of the merchant owner when it is in the
+// currency of the balance.
+func (s *settlementService) settlementCard(balance *record.MerchantBalanceRecord) (string, error) {
+	if balance.SettlementCardNumber != nil {
+		return *balance.SettlementCardNumber, nil
+	}
+
+	merchant, err := s.merchantRepository.FindById(balance.MerchantID)
+	if err != nil {
+		return "", err
+	}
+
+	card, err := s.cardRepository.FindCardByUserId(merchant.UserID)
+	if err != nil {
+		return "", err
+	}
+
+	if card.Currency != balance.Currency {
+		return "", card_errors.ErrCurrencyMismatch
+	}
+
+	return card.CardNumber, nil
+}
+
+// checkMerchant makes sure the merchant exists and, unless requestedBy is
+// nil for an admin, belongs to the requesting user.
+func (s *settlementService) checkMerchant(merchantID int, requestedBy *int) (*record.MerchantRecord, *response.ErrorResponse) {
+	merchant, err := s.merchantRepository.FindById(merchantID)
+	if err != nil {
+		s.logger.Error("Failed to find merchant", zap.Error(err), zap.Int("merchant_id", merchantID))
+		return nil, merchant_errors.ErrMerchantNotFoundRes
+	}
+
+	if requestedBy != nil && merchant.UserID != *requestedBy {
+		s.logger.Error("unauthorized settlement request",
+			zap.Int("merchant_id", merchantID),
+			zap.Int("requested_by", *requestedBy),
+		)
+		return nil, settlement_errors.ErrSettlementNotAllowed
+	}
+
+	return merchant, nil
+}
+
+func (s *settlementService) findOwnedBatch(batchID int, requestedBy *int) (*record.SettlementBatchRecord, *response.ErrorResponse) {
+	batch, err := s.settlementRepository.FindBatchById(batchID)
+	if err != nil {
+		s.logger.Error("Failed to find settlement batch", zap.Error(err), zap.Int("batch_id", batchID))
+		return nil, settlement_errors.ErrSettlementBatchNotFound
+	}
+
+	if _, errResp := s.checkMerchant(batch.MerchantID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	return batch, nil
+}
@@ -0,0 +1,154 @@
+package service
+
+import (
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/status_history_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/statemachine"
+	"go.uber.org/zap"
+)
+
+type statusHistoryService struct {
+	statusHistoryRepository repository.StatusHistoryRepository
+	topupRepository         repository.TopupRepository
+	withdrawRepository      repository.WithdrawRepository
+	transferRepository      repository.TransferRepository
+	transactionRepository   repository.TransactionRepository
+	cardRepository          repository.CardRepository
+	merchantRepository      repository.MerchantRepository
+	logger                  logger.LoggerInterface
+	mapping                 responseservice.StatusHistoryResponseMapper
+}
+
+func NewStatusHistoryService(
+	statusHistoryRepository repository.StatusHistoryRepository,
+	topupRepository repository.TopupRepository,
+	withdrawRepository repository.WithdrawRepository,
+	transferRepository repository.TransferRepository,
+	transactionRepository repository.TransactionRepository,
+	cardRepository repository.CardRepository,
+	merchantRepository repository.MerchantRepository,
+	logger logger.LoggerInterface,
+	mapping responseservice.StatusHistoryResponseMapper,
+) *statusHistoryService {
+	return &statusHistoryService{
+		statusHistoryRepository: statusHistoryRepository,
+		topupRepository:         topupRepository,
+		withdrawRepository:      withdrawRepository,
+		transferRepository:      transferRepository,
+		transactionRepository:   transactionRepository,
+		cardRepository:          cardRepository,
+		merchantRepository:      merchantRepository,
+		logger:                  logger,
+		mapping:                 mapping,
+	}
+}
+
+func (s *statusHistoryService) FindByEntity(req *requests.FindStatusHistory) ([]*response.StatusHistoryResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching status history",
+		zap.String("entity_type", req.EntityType),
+		zap.Int("entity_id", req.EntityID),
+	)
+
+	if req.RequestedBy != nil {
+		if errResp := s.checkOwnership(req.EntityType, req.EntityID, *req.RequestedBy); errResp != nil {
+			return nil, errResp
+		}
+	}
+
+	histories, err := s.statusHistoryRepository.FindByEntity(req.EntityType, req.EntityID)
+	if err != nil {
+		s.logger.Error("Failed to fetch status history",
+			zap.String("entity_type", req.EntityType),
+			zap.Int("entity_id", req.EntityID),
+			zap.Error(err),
+		)
+		return nil, status_history_errors.ErrFailedFindStatusHistory
+	}
+
+	so := s.mapping.ToStatusHistoriesResponse(histories)
+
+	s.logger.Debug("Successfully fetched status history",
+		zap.String("entity_type", req.EntityType),
+		zap.Int("entity_id", req.EntityID),
+		zap.Int("total", len(so)),
+	)
+
+	return so, nil
+}
+
+// checkOwnership lets a user see the history of topups and withdraws of their
+// own cards, of transfers from or to their cards, and of transactions paid
+// with their cards or received by their merchants.
+func (s *statusHistoryService) checkOwnership(entityType string, entityID int, userID int) *response.ErrorResponse {
+	var (
+		cardNumbers []string
+		merchantID  *int
+	)
+
+	switch entityType {
+	case statemachine.EntityTopup:
+		topup, err := s.topupRepository.FindById(entityID)
+		if err != nil {
+			s.logger.Error("failed to find topup", zap.Int("topup_id", entityID), zap.Error(err))
+			return status_history_errors.ErrStatusHistoryNotFound
+		}
+		cardNumbers = []string{topup.CardNumber}
+
+	case statemachine.EntityWithdraw:
+		withdraw, err := s.withdrawRepository.FindById(entityID)
+		if err != nil {
+			s.logger.Error("failed to find withdraw", zap.Int("withdraw_id", entityID), zap.Error(err))
+			return status_history_errors.ErrStatusHistoryNotFound
+		}
+		cardNumbers = []string{withdraw.CardNumber}
+
+	case statemachine.EntityTransfer:
+		transfer, err := s.transferRepository.FindById(entityID)
+		if err != nil {
+			s.logger.Error("failed to find transfer", zap.Int("transfer_id", entityID), zap.Error(err))
+			return status_history_errors.ErrStatusHistoryNotFound
+		}
+		cardNumbers = []string{transfer.TransferFrom, transfer.TransferTo}
+
+	case statemachine.EntityTransaction:
+		transaction, err := s.transactionRepository.FindById(entityID)
+		if err != nil {
+			s.logger.Error("failed to find transaction", zap.Int("transaction_id", entityID), zap.Error(err))
+			return status_history_errors.ErrStatusHistoryNotFound
+		}
+		cardNumbers = []string{transaction.CardNumber}
+		merchantID = &transaction.MerchantID
+
+	case statemachine.EntityMerchant:
+		merchantID = &entityID
+
+	default:
+		return status_history_errors.ErrStatusHistoryNotFound
+	}
+
+	for _, cardNumber := range cardNumbers {
+		card, err := s.cardRepository.FindCardByCardNumber(cardNumber)
+		if err == nil && card.UserID == userID {
+			return nil
+		}
+	}
+
+	if merchantID != nil {
+		merchant, err := s.merchantRepository.FindById(*merchantID)
+		if err == nil && merchant.UserID == userID {
+			return nil
+		}
+	}
+
+	s.logger.Error("status history requested for another user's record",
+		zap.String("entity_type", entityType),
+		zap.Int("entity_id", entityID),
+		zap.Int("requested_by", userID),
+	)
+
+	return status_history_errors.ErrStatusHistoryNotAllowed
+}
@@ -1,39 +1,62 @@
 package service
 
 import (
+	"context"
+	"errors"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
 	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"net/http"
 
 	"time"
 
 	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/fee_schedule_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/ledger_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/topup_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/statemachine"
+	topupprovider "github.com/MamangRust/paymentgatewaygraphql/pkg/topup_provider"
 
 	"go.uber.org/zap"
 )
 
+// topupProviderCheckBatchSize caps how many pending topups a single
+// SyncPending call asks providers about; the rest are picked up by the next
+// call.
+const topupProviderCheckBatchSize = 50
+
 type topupService struct {
-	cardRepository  repository.CardRepository
-	topupRepository repository.TopupRepository
-	saldoRepository repository.SaldoRepository
-	logger          logger.LoggerInterface
-	mapping         responseservice.TopupResponseMapper
+	cardRepository        repository.CardRepository
+	topupRepository       repository.TopupRepository
+	saldoRepository       repository.SaldoRepository
+	feeScheduleRepository repository.FeeScheduleRepository
+	unitOfWork            repository.UnitOfWork
+	providers             *topupprovider.Registry
+	logger                logger.LoggerInterface
+	mapping               responseservice.TopupResponseMapper
 }
 
 func NewTopupService(cardRepository repository.CardRepository,
 	topupRepository repository.TopupRepository,
 	saldoRepository repository.SaldoRepository,
+	feeScheduleRepository repository.FeeScheduleRepository,
+	unitOfWork repository.UnitOfWork,
+	providers *topupprovider.Registry,
 	logger logger.LoggerInterface, mapping responseservice.TopupResponseMapper) *topupService {
 	return &topupService{
-		topupRepository: topupRepository,
-		saldoRepository: saldoRepository,
-		cardRepository:  cardRepository,
-		logger:          logger,
-		mapping:         mapping,
+		topupRepository:       topupRepository,
+		saldoRepository:       saldoRepository,
+		cardRepository:        cardRepository,
+		feeScheduleRepository: feeScheduleRepository,
+		unitOfWork:            unitOfWork,
+		providers:             providers,
+		logger:                logger,
+		mapping:               mapping,
 	}
 }
 
@@ -522,6 +545,9 @@ func (s *topupService) FindByTrashed(req *requests.FindAllTopups) ([]*response.T
 	return so, totalRecords, nil
 }
 
+// CreateTopup records a pending topup and asks the provider of its method to
+// collect the payment. The card is only credited once the provider confirms,
+// which may happen right away, on a later status check or through a callback.
 func (s *topupService) CreateTopup(request *requests.CreateTopupRequest) (*response.TopupResponse, *response.ErrorResponse) {
 	s.logger.Debug("Starting CreateTopup process",
 		zap.String("cardNumber", request.CardNumber),
@@ -534,87 +560,336 @@ func (s *topupService) CreateTopup(request *requests.CreateTopupRequest) (*respo
 		return nil, card_errors.ErrCardNotFoundRes
 	}
 
+	if errResp := checkMinimumAmount(request.TopupAmount, card.Currency); errResp != nil {
+		s.logger.Error("topup amount below the currency minimum", zap.Int("topupAmount", request.TopupAmount), zap.String("currency", card.Currency))
+		return nil, errResp
+	}
+
+	// The topup fee is kept out of the credited amount, so the card receives
+	// TopupAmount - Fee.
+	fee, err := quoteFee(s.feeScheduleRepository, &requests.FindApplicableFeeSchedule{
+		TransactionType: requests.FeeTransactionTopup,
+		PaymentMethod:   request.TopupMethod,
+		CardType:        card.CardType,
+		Currency:        card.Currency,
+	}, request.TopupAmount)
+	if err != nil {
+		s.logger.Error("failed to quote topup fee", zap.Error(err))
+		return nil, fee_schedule_errors.ErrFailedQuoteFee
+	}
+
+	if fee >= request.TopupAmount {
+		s.logger.Error("topup fee exceeds amount", zap.Int("fee", fee), zap.Int("topupAmount", request.TopupAmount))
+		return nil, fee_schedule_errors.ErrFeeExceedsAmount
+	}
+
+	request.Fee = fee
+
+	provider, err := s.providers.ForMethod(request.TopupMethod)
+	if err != nil {
+		s.logger.Error("no provider for topup method", zap.String("topupMethod", request.TopupMethod))
+		return nil, topup_errors.ErrTopupMethodNotSupported
+	}
+
 	topup, err := s.topupRepository.CreateTopup(request)
 	if err != nil {
 		s.logger.Error("failed to create topup", zap.Error(err))
 		return nil, topup_errors.ErrFailedCreateTopup
 	}
 
-	saldo, err := s.saldoRepository.FindByCardNumber(request.CardNumber)
+	result, err := provider.Initiate(context.Background(), &topupprovider.InitiateRequest{
+		TopupNo:     topup.TopupNo,
+		CardNumber:  topup.CardNumber,
+		Amount:      topup.TopupAmount,
+		Currency:    topup.Currency,
+		TopupMethod: topup.TopupMethod,
+	})
 	if err != nil {
-		s.logger.Error("failed to find saldo by user id", zap.Error(err))
-		req := requests.UpdateTopupStatus{
-			TopupID: topup.ID,
-			Status:  "failed",
-		}
-		s.topupRepository.UpdateTopupStatus(&req)
-		return nil, saldo_errors.ErrFailedSaldoNotFound
+		s.logger.Error("failed to initiate topup payment", zap.String("provider", provider.Name()), zap.Error(err))
+		s.markTopupFailed(topup.ID)
+		return nil, topup_errors.ErrFailedInitiateTopup
 	}
 
-	newBalance := saldo.TotalBalance + request.TopupAmount
-	_, err = s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-		CardNumber:   request.CardNumber,
-		TotalBalance: newBalance,
-	})
+	if _, err := s.topupRepository.CreateProviderPayment(&requests.CreateTopupProviderPayment{
+		TopupID:           topup.ID,
+		Provider:          provider.Name(),
+		ProviderReference: result.Reference,
+		Message:           &result.Message,
+	}); err != nil {
+		s.logger.Error("failed to record topup provider payment", zap.Error(err))
+		s.markTopupFailed(topup.ID)
+		return nil, topup_errors.ErrFailedCreateTopup
+	}
+
+	topup, errResp := s.applyProviderResult(topup, result)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	so := s.mapping.ToTopupResponse(topup)
+
+	s.logger.Debug("CreateTopup process completed",
+		zap.String("cardNumber", request.CardNumber),
+		zap.Float64("topupAmount", float64(request.TopupAmount)),
+		zap.String("status", topup.Status),
+	)
+
+	return so, nil
+}
+
+// HandleProviderCallback applies a notification sent by a payment provider to
+// the topup it is about. A callback repeating the outcome already recorded is
+// accepted, so providers may deliver it more than once.
+func (s *topupService) HandleProviderCallback(request *requests.TopupProviderCallback) (*response.TopupResponse, *response.ErrorResponse) {
+	s.logger.Debug("Handling topup provider callback", zap.String("provider", request.Provider))
+
+	provider, err := s.providers.ByName(request.Provider)
 	if err != nil {
-		s.logger.Error("failed to update saldo balance", zap.Error(err))
-		req := requests.UpdateTopupStatus{
-			TopupID: topup.ID,
-			Status:  "failed",
+		s.logger.Error("unknown topup provider", zap.String("provider", request.Provider))
+		return nil, topup_errors.ErrTopupProviderNotFound
+	}
+
+	result, err := provider.HandleCallback(context.Background(), request.Payload)
+	if err != nil {
+		s.logger.Error("invalid topup provider callback", zap.String("provider", request.Provider), zap.Error(err))
+		return nil, topup_errors.ErrInvalidTopupProviderCallback
+	}
+
+	payment, err := s.topupRepository.FindProviderPaymentByReference(provider.Name(), result.Reference)
+	if err != nil {
+		s.logger.Error("failed to find topup provider payment", zap.String("reference", result.Reference), zap.Error(err))
+		return nil, topup_errors.ErrTopupProviderPaymentNotFound
+	}
+
+	if _, err := s.topupRepository.TouchProviderPayment(payment.ID, &result.Message); err != nil {
+		s.logger.Error("failed to update topup provider payment", zap.Int("payment_id", payment.ID), zap.Error(err))
+	}
+
+	topup, err := s.topupRepository.FindById(payment.TopupID)
+	if err != nil {
+		s.logger.Error("failed to find topup", zap.Int("topup_id", payment.TopupID), zap.Error(err))
+		return nil, topup_errors.ErrTopupNotFoundRes
+	}
+
+	if result.IsFinal() && topup.Status != statemachine.StatusPending {
+		if topup.Status != result.Status {
+			s.logger.Error("provider callback contradicts topup status",
+				zap.Int("topup_id", topup.ID),
+				zap.String("status", topup.Status),
+				zap.String("callback_status", result.Status),
+			)
+			return nil, topup_errors.ErrTopupStatusNotUpdatable
 		}
-		s.topupRepository.UpdateTopupStatus(&req)
-		return nil, topup_errors.ErrFailedUpdateTopup
+
+		return s.mapping.ToTopupResponse(topup), nil
 	}
 
-	expireDate, err := time.Parse("2006-01-02", card.ExpireDate)
+	topup, errResp := s.applyProviderResult(topup, result)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	return s.mapping.ToTopupResponse(topup), nil
+}
+
+// SyncPending asks providers about topups still waiting for their payment and
+// returns how many were confirmed or failed.
+func (s *topupService) SyncPending() (int, *response.ErrorResponse) {
+	payments, err := s.topupRepository.FindPendingProviderPayments(topupProviderCheckBatchSize)
 	if err != nil {
-		s.logger.Error("failed to parse expire date", zap.Error(err))
-		req := requests.UpdateTopupStatus{
-			TopupID: topup.ID,
-			Status:  "failed",
+		s.logger.Error("failed to find pending topup provider payments", zap.Error(err))
+		return 0, topup_errors.ErrFailedSyncPendingTopups
+	}
+
+	finished := 0
+
+	for _, payment := range payments {
+		result, err := s.checkProviderPayment(payment)
+		if err != nil {
+			s.logger.Error("failed to check topup payment",
+				zap.Int("topup_id", payment.TopupID),
+				zap.String("provider", payment.Provider),
+				zap.Error(err),
+			)
+			continue
 		}
-		s.topupRepository.UpdateTopupStatus(&req)
-		return nil, topup_errors.ErrFailedUpdateTopup
+
+		if !result.IsFinal() {
+			continue
+		}
+
+		topup, err := s.topupRepository.FindById(payment.TopupID)
+		if err != nil {
+			s.logger.Error("failed to find topup", zap.Int("topup_id", payment.TopupID), zap.Error(err))
+			continue
+		}
+
+		if _, errResp := s.applyProviderResult(topup, result); errResp != nil {
+			continue
+		}
+
+		finished++
 	}
 
-	_, err = s.cardRepository.UpdateCard(&requests.UpdateCardRequest{
-		CardID:       card.ID,
-		UserID:       card.UserID,
-		CardType:     card.CardType,
-		ExpireDate:   expireDate,
-		CVV:          card.CVV,
-		CardProvider: card.CardProvider,
-	})
+	return finished, nil
+}
+
+// checkProviderPayment asks the provider for the state of a payment. Every
+// answer, including an error, is recorded on the payment so that it moves to
+// the back of the queue of payments to check.
+func (s *topupService) checkProviderPayment(payment *record.TopupProviderPaymentRecord) (*topupprovider.Result, error) {
+	provider, err := s.providers.ByName(payment.Provider)
+
+	var result *topupprovider.Result
+	if err == nil {
+		result, err = provider.CheckStatus(context.Background(), payment.ProviderReference)
+	}
+
+	var message string
 	if err != nil {
-		s.logger.Error("failed to update card expire date", zap.Error(err))
-		req := requests.UpdateTopupStatus{
+		message = err.Error()
+	} else {
+		message = result.Message
+	}
+
+	if _, touchErr := s.topupRepository.TouchProviderPayment(payment.ID, &message); touchErr != nil {
+		s.logger.Error("failed to update topup provider payment", zap.Int("payment_id", payment.ID), zap.Error(touchErr))
+	}
+
+	return result, err
+}
+
+// applyProviderResult finishes a pending topup once its provider reports a
+// final status, and leaves it pending otherwise.
+func (s *topupService) applyProviderResult(topup *record.TopupRecord, result *topupprovider.Result) (*record.TopupRecord, *response.ErrorResponse) {
+	switch result.Status {
+	case topupprovider.StatusSuccess:
+		return s.confirmTopup(topup)
+
+	case topupprovider.StatusFailed:
+		failed, err := s.topupRepository.UpdateTopupStatus(&requests.UpdateTopupStatus{
 			TopupID: topup.ID,
-			Status:  "failed",
+			Status:  statemachine.StatusFailed,
+		})
+		if err != nil {
+			s.logger.Error("failed to update topup status", zap.Int("topup_id", topup.ID), zap.Error(err))
+			if errors.Is(err, topup_errors.ErrTopupStatusConflict) {
+				return nil, topup_errors.ErrTopupStatusNotUpdatable
+			}
+			return nil, topup_errors.ErrFailedUpdateTopup
 		}
-		s.topupRepository.UpdateTopupStatus(&req)
-		return nil, card_errors.ErrFailedUpdateCard
-	}
 
-	req := requests.UpdateTopupStatus{
-		TopupID: topup.ID,
-		Status:  "success",
+		s.logger.Debug("topup payment failed", zap.Int("topup_id", topup.ID), zap.String("message", result.Message))
+		return failed, nil
+
+	default:
+		return topup, nil
 	}
+}
 
-	_, err = s.topupRepository.UpdateTopupStatus(&req)
+// confirmTopup credits the card of a pending topup and marks it successful.
+// The saldo is locked before the topup status is read, so a topup confirmed
+// at the same time by a callback and by the status check is credited once.
+//
+// A topup whose confirmation fails stays pending: the provider has collected
+// the money, and the next status check retries the credit.
+func (s *topupService) confirmTopup(topup *record.TopupRecord) (*record.TopupRecord, *response.ErrorResponse) {
+	card, err := s.cardRepository.FindCardByCardNumber(topup.CardNumber)
 	if err != nil {
-		s.logger.Error("failed to update topup status", zap.Error(err))
-		return nil, topup_errors.ErrFailedUpdateTopup
+		s.logger.Error("failed to find card by number", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
 	}
 
-	so := s.mapping.ToTopupResponse(topup)
+	var (
+		newBalance int
+		confirmed  *record.TopupRecord
+	)
 
-	s.logger.Debug("CreateTopup process completed",
-		zap.String("cardNumber", request.CardNumber),
-		zap.Float64("topupAmount", float64(request.TopupAmount)),
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		saldos, err := lockSaldos(repos, topup.CardNumber)
+		if err != nil {
+			s.logger.Error("failed to lock saldo by card number", zap.Error(err))
+			return saldo_errors.ErrFailedSaldoNotFound
+		}
+
+		current, err := repos.Topup.FindById(topup.ID)
+		if err != nil {
+			s.logger.Error("failed to find topup", zap.Int("topup_id", topup.ID), zap.Error(err))
+			return topup_errors.ErrTopupNotFoundRes
+		}
+
+		if current.Status != statemachine.StatusPending {
+			s.logger.Error("topup is no longer pending", zap.Int("topup_id", topup.ID), zap.String("status", current.Status))
+			return topup_errors.ErrTopupStatusNotUpdatable
+		}
+
+		newBalance = saldos[current.CardNumber].TotalBalance + current.TopupAmount - current.Fee
+		if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+			requests.LedgerReferenceTopup,
+			current.ID,
+			"Topup via "+current.TopupMethod+" to card "+current.CardNumber,
+			requests.SystemLedgerAccount(requests.LedgerAccountTopupClearing),
+			requests.CardLedgerAccount(current.CardNumber),
+			current.TopupAmount,
+			current.Currency,
+		).WithFee(requests.CardLedgerAccount(current.CardNumber), current.Fee, current.Currency)); err != nil {
+			s.logger.Error("failed to post topup journal", zap.Error(err))
+			return ledger_errors.ErrFailedPostLedgerJournal
+		}
+
+		expireDate, err := time.Parse("2006-01-02", card.ExpireDate)
+		if err != nil {
+			s.logger.Error("failed to parse expire date", zap.Error(err))
+			return topup_errors.ErrFailedUpdateTopup
+		}
+
+		_, err = repos.Card.UpdateCard(&requests.UpdateCardRequest{
+			CardID:       card.ID,
+			UserID:       card.UserID,
+			CardType:     card.CardType,
+			ExpireDate:   expireDate,
+			CVV:          card.CVV,
+			CardProvider: card.CardProvider,
+		})
+		if err != nil {
+			s.logger.Error("failed to update card expire date", zap.Error(err))
+			return card_errors.ErrFailedUpdateCard
+		}
+
+		confirmed, err = repos.Topup.UpdateTopupStatus(&requests.UpdateTopupStatus{
+			TopupID: current.ID,
+			Status:  statemachine.StatusSuccess,
+		})
+		if err != nil {
+			s.logger.Error("failed to update topup status", zap.Error(err))
+			if errors.Is(err, topup_errors.ErrTopupStatusConflict) {
+				return topup_errors.ErrTopupStatusNotUpdatable
+			}
+			return topup_errors.ErrFailedUpdateTopup
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to confirm topup, transaction rolled back", zap.Int("topup_id", topup.ID), zap.Error(err))
+		return nil, response.ToErrorResponse(err, topup_errors.ErrFailedUpdateTopup)
+	}
+
+	s.logger.Debug("topup confirmed",
+		zap.Int("topup_id", confirmed.ID),
 		zap.Float64("newBalance", float64(newBalance)),
 	)
 
-	return so, nil
+	return confirmed, nil
+}
+
+func (s *topupService) markTopupFailed(topupID int) {
+	if _, err := s.topupRepository.UpdateTopupStatus(&requests.UpdateTopupStatus{
+		TopupID: topupID,
+		Status:  statemachine.StatusFailed,
+	}); err != nil {
+		s.logger.Error("failed to update topup status", zap.Error(err))
+	}
 }
 
 func (s *topupService) UpdateTopup(request *requests.UpdateTopupRequest) (*response.TopupResponse, *response.ErrorResponse) {
@@ -624,7 +899,7 @@ func (s *topupService) UpdateTopup(request *requests.UpdateTopupRequest) (*respo
 		zap.Float64("newTopupAmount", float64(request.TopupAmount)),
 	)
 
-	_, err := s.cardRepository.FindCardByCardNumber(request.CardNumber)
+	card, err := s.cardRepository.FindCardByCardNumber(request.CardNumber)
 	if err != nil {
 		s.logger.Error("failed to find card by number", zap.Error(err))
 
@@ -651,95 +926,119 @@ func (s *topupService) UpdateTopup(request *requests.UpdateTopupRequest) (*respo
 		return nil, topup_errors.ErrTopupNotFoundRes
 	}
 
-	topupDifference := request.TopupAmount - existingTopup.TopupAmount
-
-	_, err = s.topupRepository.UpdateTopup(request)
-	if err != nil {
-		s.logger.Error("Failed to update topup amount", zap.Error(err))
+	if existingTopup.Status == statemachine.StatusPending {
+		s.logger.Error("topup is still waiting for its payment", zap.Int("topupID", existingTopup.ID))
+		return nil, topup_errors.ErrTopupAwaitingPayment
+	}
 
-		req := requests.UpdateTopupStatus{
-			TopupID: *request.TopupID,
-			Status:  "failed",
-		}
+	// The card was credited when the topup was made, so moving the topup to
+	// another card would leave its postings on the old one.
+	if request.CardNumber != existingTopup.CardNumber {
+		s.logger.Error("Refusing to move topup to another card", zap.Int("topupID", existingTopup.ID), zap.String("cardNumber", request.CardNumber))
+		return nil, topup_errors.ErrTopupCardNotUpdatable
+	}
 
-		s.topupRepository.UpdateTopupStatus(&req)
-		return nil, topup_errors.ErrFailedUpdateTopup
+	if errResp := checkMinimumAmount(request.TopupAmount, card.Currency); errResp != nil {
+		s.logger.Error("topup amount below the currency minimum", zap.Int("topupAmount", request.TopupAmount), zap.String("currency", card.Currency))
+		return nil, errResp
 	}
 
-	currentSaldo, err := s.saldoRepository.FindByCardNumber(request.CardNumber)
-	if err != nil {
-		s.logger.Error("Failed to retrieve current saldo", zap.Error(err))
+	topupDifference := request.TopupAmount - existingTopup.TopupAmount
 
-		req := requests.UpdateTopupStatus{
-			TopupID: *request.TopupID,
-			Status:  "failed",
+	// The topup fee is quoted again on the amended amount, and the card
+	// carries the difference.
+	fee := existingTopup.Fee
+	if topupDifference != 0 {
+		fee, err = quoteFee(s.feeScheduleRepository, &requests.FindApplicableFeeSchedule{
+			TransactionType: requests.FeeTransactionTopup,
+			PaymentMethod:   request.TopupMethod,
+			CardType:        card.CardType,
+			Currency:        card.Currency,
+		}, request.TopupAmount)
+		if err != nil {
+			s.logger.Error("failed to quote topup fee", zap.Error(err))
+			return nil, fee_schedule_errors.ErrFailedQuoteFee
 		}
 
-		s.topupRepository.UpdateTopupStatus(&req)
-
-		return nil, saldo_errors.ErrFailedSaldoNotFound
+		if fee >= request.TopupAmount {
+			s.logger.Error("topup fee exceeds amount", zap.Int("fee", fee), zap.Int("topupAmount", request.TopupAmount))
+			return nil, fee_schedule_errors.ErrFeeExceedsAmount
+		}
 	}
 
-	if currentSaldo == nil {
-		s.logger.Error("No saldo found for card number", zap.String("card_number", request.CardNumber))
+	request.Fee = fee
+	feeDifference := fee - existingTopup.Fee
 
-		req := requests.UpdateTopupStatus{
-			TopupID: *request.TopupID,
-			Status:  "failed",
-		}
+	var (
+		newBalance   int
+		updatedTopup *record.TopupRecord
+	)
 
-		s.topupRepository.UpdateTopupStatus(&req)
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		_, err := repos.Topup.UpdateTopup(request)
+		if err != nil {
+			s.logger.Error("Failed to update topup amount", zap.Error(err))
+			return topup_errors.ErrFailedUpdateTopup
+		}
 
-		return nil, card_errors.ErrCardNotFoundRes
-	}
+		saldos, err := lockSaldos(repos, request.CardNumber)
+		if err != nil {
+			s.logger.Error("Failed to lock current saldo", zap.Error(err))
+			return saldo_errors.ErrFailedSaldoNotFound
+		}
 
-	newBalance := currentSaldo.TotalBalance + topupDifference
-	_, err = s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-		CardNumber:   request.CardNumber,
-		TotalBalance: newBalance,
-	})
-	if err != nil {
-		s.logger.Error("Failed to update saldo balance", zap.Error(err))
+		newBalance = saldos[request.CardNumber].TotalBalance + topupDifference - feeDifference
+		if newBalance < 0 {
+			s.logger.Error("Insufficient balance to reduce topup", zap.String("card_number", request.CardNumber))
 
-		_, rollbackErr := s.topupRepository.UpdateTopupAmount(&requests.UpdateTopupAmount{
-			TopupID:     *request.TopupID,
-			TopupAmount: existingTopup.TopupAmount,
-		})
-		if rollbackErr != nil {
-			s.logger.Error("Failed to rollback topup update", zap.Error(rollbackErr))
+			return &response.ErrorResponse{
+				Status:  "error",
+				Message: "Insufficient balance to reduce topup amount",
+				Code:    http.StatusBadRequest,
+			}
+		}
+		if topupDifference != 0 {
+			if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+				requests.LedgerReferenceTopup,
+				*request.TopupID,
+				"Topup amount adjustment for card "+request.CardNumber,
+				requests.SystemLedgerAccount(requests.LedgerAccountTopupClearing),
+				requests.CardLedgerAccount(request.CardNumber),
+				topupDifference,
+				saldos[request.CardNumber].Currency,
+			).WithFee(requests.CardLedgerAccount(request.CardNumber), feeDifference, saldos[request.CardNumber].Currency)); err != nil {
+				s.logger.Error("Failed to post topup adjustment journal", zap.Error(err))
+				return ledger_errors.ErrFailedPostLedgerJournal
+			}
 		}
 
-		req := requests.UpdateTopupStatus{
+		updatedTopup, err = repos.Topup.UpdateTopupStatus(&requests.UpdateTopupStatus{
 			TopupID: *request.TopupID,
-			Status:  "failed",
+			Status:  "success",
+		})
+		if err != nil {
+			s.logger.Error("Failed to update topup status", zap.Error(err))
+			if errors.Is(err, topup_errors.ErrTopupStatusConflict) {
+				return topup_errors.ErrTopupStatusNotUpdatable
+			}
+			return topup_errors.ErrFailedUpdateTopup
 		}
 
-		s.topupRepository.UpdateTopupStatus(&req)
-		return nil, saldo_errors.ErrFailedUpdateSaldo
-	}
-
-	updatedTopup, err := s.topupRepository.FindById(*request.TopupID)
-	if err != nil || updatedTopup == nil {
-		s.logger.Error("Failed to find updated topup by ID", zap.Error(err))
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to complete topup update, transaction rolled back", zap.Error(err))
 
 		req := requests.UpdateTopupStatus{
 			TopupID: *request.TopupID,
 			Status:  "failed",
 		}
 
-		s.topupRepository.UpdateTopupStatus(&req)
-		return nil, topup_errors.ErrTopupNotFoundRes
-	}
-
-	req := requests.UpdateTopupStatus{
-		TopupID: *request.TopupID,
-		Status:  "success",
-	}
+		if _, err := s.topupRepository.UpdateTopupStatus(&req); err != nil {
+			s.logger.Error("Failed to update topup status", zap.Error(err))
+		}
 
-	_, err = s.topupRepository.UpdateTopupStatus(&req)
-	if err != nil {
-		s.logger.Error("Failed to update topup status", zap.Error(err))
-		return nil, topup_errors.ErrFailedUpdateTopup
+		return nil, response.ToErrorResponse(err, topup_errors.ErrFailedUpdateTopup)
 	}
 
 	so := s.mapping.ToTopupResponse(updatedTopup)
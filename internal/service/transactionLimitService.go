@@ -0,0 +1,209 @@
+package service
+
+import (
+	"time"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transaction_limit_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+type transactionLimitService struct {
+	transactionLimitRepository repository.TransactionLimitRepository
+	cardRepository             repository.CardRepository
+	logger                     logger.LoggerInterface
+	mapping                    responseservice.TransactionLimitResponseMapper
+}
+
+func NewTransactionLimitService(
+	transactionLimitRepository repository.TransactionLimitRepository,
+	cardRepository repository.CardRepository,
+	logger logger.LoggerInterface,
+	mapping responseservice.TransactionLimitResponseMapper,
+) *transactionLimitService {
+	return &transactionLimitService{
+		transactionLimitRepository: transactionLimitRepository,
+		cardRepository:             cardRepository,
+		logger:                     logger,
+		mapping:                    mapping,
+	}
+}
+
+func (s *transactionLimitService) FindAll(req *requests.FindAllTransactionLimits) ([]*response.TransactionLimitResponse, *int, *response.ErrorResponse) {
+	page := req.Page
+	pageSize := req.PageSize
+	search := req.Search
+
+	s.logger.Debug("Fetching transaction limits",
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+		zap.String("search", search))
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	req.Page = page
+	req.PageSize = pageSize
+
+	limits, totalRecords, err := s.transactionLimitRepository.FindAll(req)
+
+	if err != nil {
+		s.logger.Error("Failed to fetch transaction limits",
+			zap.Error(err),
+			zap.Int("page", page),
+			zap.Int("pageSize", pageSize),
+			zap.String("search", search))
+
+		return nil, nil, transaction_limit_errors.ErrFailedFindAllTransactionLimits
+	}
+
+	so := s.mapping.ToTransactionLimitsResponse(limits)
+
+	s.logger.Debug("Successfully fetched transaction limits",
+		zap.Int("totalRecords", *totalRecords),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return so, totalRecords, nil
+}
+
+func (s *transactionLimitService) FindById(transactionLimitID int) (*response.TransactionLimitResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching transaction limit by ID", zap.Int("transaction_limit_id", transactionLimitID))
+
+	limit, err := s.transactionLimitRepository.FindById(transactionLimitID)
+
+	if err != nil {
+		s.logger.Error("failed to find transaction limit", zap.Error(err))
+
+		return nil, transaction_limit_errors.ErrTransactionLimitNotFound
+	}
+
+	so := s.mapping.ToTransactionLimitResponse(limit)
+
+	s.logger.Debug("Successfully fetched transaction limit", zap.Int("transaction_limit_id", transactionLimitID))
+
+	return so, nil
+}
+
+// FindHeadroom reports, for every limit enforced on the card's operations,
+// how much is used and left in the current period.
+func (s *transactionLimitService) FindHeadroom(req *requests.FindTransactionLimitHeadroom) ([]*response.TransactionLimitHeadroomResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching transaction limit headroom",
+		zap.String("card_number", req.CardNumber),
+		zap.String("operation_type", req.OperationType))
+
+	card, err := s.cardRepository.FindCardByCardNumber(req.CardNumber)
+	if err != nil {
+		s.logger.Error("failed to find card", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	if req.RequestedBy != nil && card.UserID != *req.RequestedBy {
+		s.logger.Error("unauthorized headroom request",
+			zap.String("card_number", req.CardNumber),
+			zap.Int("requested_by", *req.RequestedBy),
+		)
+		return nil, transaction_limit_errors.ErrHeadroomNotAllowed
+	}
+
+	limits, err := s.transactionLimitRepository.FindApplicable(req.OperationType, req.CardNumber)
+	if err != nil {
+		s.logger.Error("failed to find applicable transaction limits", zap.Error(err))
+		return nil, transaction_limit_errors.ErrFailedFindHeadroom
+	}
+
+	now := time.Now()
+	headrooms := make([]*response.TransactionLimitHeadroomResponse, 0, len(limits))
+
+	for _, limit := range limits {
+		start, resetsAt := limitPeriod(limit.Period, now)
+
+		usage, err := s.transactionLimitRepository.FindUsage(req.OperationType, req.CardNumber, start)
+		if err != nil {
+			s.logger.Error("failed to find card usage", zap.Error(err))
+			return nil, transaction_limit_errors.ErrFailedFindHeadroom
+		}
+
+		headrooms = append(headrooms, s.mapping.ToTransactionLimitHeadroomResponse(limit, usage, resetsAt))
+	}
+
+	s.logger.Debug("Successfully fetched transaction limit headroom",
+		zap.String("card_number", req.CardNumber),
+		zap.Int("limits", len(headrooms)))
+
+	return headrooms, nil
+}
+
+func (s *transactionLimitService) CreateTransactionLimit(request *requests.CreateTransactionLimitRequest) (*response.TransactionLimitResponse, *response.ErrorResponse) {
+	s.logger.Debug("Creating transaction limit",
+		zap.String("name", request.Name),
+		zap.String("operation_type", request.OperationType),
+		zap.String("period", request.Period),
+	)
+
+	limit, err := s.transactionLimitRepository.CreateTransactionLimit(request)
+
+	if err != nil {
+		s.logger.Error("failed to create transaction limit", zap.Error(err))
+
+		return nil, transaction_limit_errors.ErrFailedCreateTransactionLimit
+	}
+
+	so := s.mapping.ToTransactionLimitResponse(limit)
+
+	s.logger.Debug("Successfully created transaction limit", zap.Int("transaction_limit_id", so.ID))
+
+	return so, nil
+}
+
+func (s *transactionLimitService) UpdateTransactionLimit(request *requests.UpdateTransactionLimitRequest) (*response.TransactionLimitResponse, *response.ErrorResponse) {
+	s.logger.Debug("Updating transaction limit", zap.Int("transaction_limit_id", *request.TransactionLimitID))
+
+	if _, err := s.transactionLimitRepository.FindById(*request.TransactionLimitID); err != nil {
+		s.logger.Error("failed to find transaction limit", zap.Error(err))
+
+		return nil, transaction_limit_errors.ErrTransactionLimitNotFound
+	}
+
+	limit, err := s.transactionLimitRepository.UpdateTransactionLimit(request)
+
+	if err != nil {
+		s.logger.Error("failed to update transaction limit", zap.Error(err))
+
+		return nil, transaction_limit_errors.ErrFailedUpdateTransactionLimit
+	}
+
+	so := s.mapping.ToTransactionLimitResponse(limit)
+
+	s.logger.Debug("Successfully updated transaction limit", zap.Int("transaction_limit_id", so.ID))
+
+	return so, nil
+}
+
+func (s *transactionLimitService) DeleteTransactionLimit(transactionLimitID int) (*response.TransactionLimitResponse, *response.ErrorResponse) {
+	s.logger.Debug("Deleting transaction limit", zap.Int("transaction_limit_id", transactionLimitID))
+
+	limit, err := s.transactionLimitRepository.DeleteTransactionLimit(transactionLimitID)
+
+	if err != nil {
+		s.logger.Error("failed to delete transaction limit", zap.Error(err))
+
+		return nil, transaction_limit_errors.ErrFailedDeleteTransactionLimit
+	}
+
+	so := s.mapping.ToTransactionLimitResponse(limit)
+
+	s.logger.Debug("Successfully deleted transaction limit", zap.Int("transaction_limit_id", transactionLimitID))
+
+	return so, nil
+}
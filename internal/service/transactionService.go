@@ -1,8 +1,11 @@
 package service
 
 import (
+	"errors"
 	"net/http"
+	"strconv"
 
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
 	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
@@ -10,39 +13,63 @@ import (
 	"time"
 
 	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/currency"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/fee_schedule_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/ledger_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/merchant_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/qr_payment_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/settlement_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transaction_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/qris"
 
 	"go.uber.org/zap"
 )
 
 type transactionService struct {
-	merchantRepository    repository.MerchantRepository
-	cardRepository        repository.CardRepository
-	saldoRepository       repository.SaldoRepository
-	transactionRepository repository.TransactionRepository
-	logger                logger.LoggerInterface
-	mapping               responseservice.TransactionResponseMapper
+	merchantRepository        repository.MerchantRepository
+	merchantApiKeyRepository  repository.MerchantApiKeyRepository
+	cardRepository            repository.CardRepository
+	saldoRepository           repository.SaldoRepository
+	transactionRepository     repository.TransactionRepository
+	feeScheduleRepository     repository.FeeScheduleRepository
+	merchantWebhookRepository repository.MerchantWebhookRepository
+	qrPaymentRepository       repository.QrPaymentRepository
+	unitOfWork                repository.UnitOfWork
+	merchantQr                MerchantQrConfig
+	logger                    logger.LoggerInterface
+	mapping                   responseservice.TransactionResponseMapper
 }
 
 func NewTransactionService(
 	merchantRepository repository.MerchantRepository,
+	merchantApiKeyRepository repository.MerchantApiKeyRepository,
 	cardRepository repository.CardRepository,
 	saldoRepository repository.SaldoRepository,
 	transactionRepository repository.TransactionRepository,
+	feeScheduleRepository repository.FeeScheduleRepository,
+	merchantWebhookRepository repository.MerchantWebhookRepository,
+	qrPaymentRepository repository.QrPaymentRepository,
+	unitOfWork repository.UnitOfWork,
+	merchantQr MerchantQrConfig,
 	logger logger.LoggerInterface,
 	mapping responseservice.TransactionResponseMapper,
 ) *transactionService {
 	return &transactionService{
-		merchantRepository:    merchantRepository,
-		cardRepository:        cardRepository,
-		saldoRepository:       saldoRepository,
-		transactionRepository: transactionRepository,
-		logger:                logger,
-		mapping:               mapping,
+		merchantRepository:        merchantRepository,
+		merchantApiKeyRepository:  merchantApiKeyRepository,
+		cardRepository:            cardRepository,
+		saldoRepository:           saldoRepository,
+		transactionRepository:     transactionRepository,
+		feeScheduleRepository:     feeScheduleRepository,
+		merchantWebhookRepository: merchantWebhookRepository,
+		qrPaymentRepository:       qrPaymentRepository,
+		unitOfWork:                unitOfWork,
+		merchantQr:                merchantQr,
+		logger:                    logger,
+		mapping:                   mapping,
 	}
 }
 
@@ -551,16 +578,22 @@ func (s *transactionService) FindTransactionByMerchantId(merchant_id int) ([]*re
 	return so, nil
 }
 
-func (s *transactionService) Create(apiKey string, request *requests.CreateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse) {
+func (s *transactionService) Create(credential requests.MerchantApiKeyCredential, request *requests.CreateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse) {
 	s.logger.Debug("Starting CreateTransaction process",
-		zap.String("apiKey", apiKey),
 		zap.Any("request", request),
 	)
 
-	merchant, err := s.merchantRepository.FindByApiKey(apiKey)
-	if err != nil {
-		s.logger.Error("failed to find merchant", zap.Error(err))
-		return nil, merchant_errors.ErrFailedFindByApiKey
+	merchant, errResp := authenticateMerchantApiKey(s.merchantApiKeyRepository, s.merchantRepository, s.logger, credential, requests.MerchantApiKeyScopeCreateTransactions)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if errResp := checkMerchantActive(merchant); errResp != nil {
+		s.logger.Error("payment with the API key of an inactive merchant",
+			zap.Int("merchant_id", merchant.ID),
+			zap.String("status", merchant.Status),
+		)
+		return nil, errResp
 	}
 
 	card, err := s.cardRepository.FindCardByCardNumber(request.CardNumber)
@@ -569,60 +602,190 @@ func (s *transactionService) Create(apiKey string, request *requests.CreateTrans
 		return nil, card_errors.ErrFailedFindByCardNumber
 	}
 
-	saldo, err := s.saldoRepository.FindByCardNumber(card.CardNumber)
+	transaction, errResp := s.pay(merchant, card, request, nil)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	so := s.mapping.ToTransactionResponse(transaction)
+
+	s.logger.Debug("CreateTransaction process completed",
+		zap.Int("transactionID", transaction.ID),
+	)
+
+	return so, nil
+}
+
+// pay charges a card for a payment to a merchant: the fee is quoted, the
+// card debited, the net amount accrued to the merchant balance and the
+// merchant notified. A QR payment is recorded along with it, so a dynamic QR
+// code is never paid twice. A payment that fails after the transaction was
+// created leaves it failed.
+func (s *transactionService) pay(merchant *record.MerchantRecord, card *record.CardRecord, request *requests.CreateTransactionRequest, qrPayment *requests.CreateQrPayment) (*record.TransactionRecord, *response.ErrorResponse) {
+	// The payment accrues to the merchant balance and is settled to a card
+	// of the merchant owner later, so it must be in that card's currency.
+	merchantCard, err := s.cardRepository.FindCardByUserId(merchant.UserID)
 	if err != nil {
-		s.logger.Error("failed to find saldo", zap.Error(err))
-		return nil, saldo_errors.ErrFailedSaldoNotFound
+		s.logger.Error("failed to find merchant card", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
 	}
 
-	if saldo.TotalBalance < request.Amount {
-		s.logger.Error("insufficient balance", zap.Int("AvailableBalance", saldo.TotalBalance), zap.Int("TransactionAmount", request.Amount))
-		return nil, &response.ErrorResponse{
-			Status:  "error",
-			Message: "Insufficient balance",
-			Code:    http.StatusBadRequest,
-		}
+	if card.Currency != merchantCard.Currency {
+		s.logger.Error("card and merchant currencies differ",
+			zap.String("card_currency", card.Currency),
+			zap.String("merchant_currency", merchantCard.Currency),
+		)
+		return nil, card_errors.ErrCurrencyMismatch
 	}
 
-	saldo.TotalBalance -= request.Amount
-	if _, err := s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-		CardNumber:   card.CardNumber,
-		TotalBalance: saldo.TotalBalance,
-	}); err != nil {
-		s.logger.Error("failed to update saldo", zap.Error(err))
-		return nil, saldo_errors.ErrFailedUpdateSaldo
+	if errResp := checkMinimumAmount(request.Amount, card.Currency); errResp != nil {
+		s.logger.Error("payment amount below the currency minimum", zap.Int("amount", request.Amount), zap.String("currency", card.Currency))
+		return nil, errResp
 	}
 
 	request.MerchantID = &merchant.ID
 
+	// The merchant discount rate is kept out of the amount credited to the
+	// merchant; the paying card is charged the plain amount.
+	fee, err := quoteFee(s.feeScheduleRepository, &requests.FindApplicableFeeSchedule{
+		TransactionType: requests.FeeTransactionTransaction,
+		PaymentMethod:   request.PaymentMethod,
+		CardType:        card.CardType,
+		Currency:        card.Currency,
+	}, request.Amount)
+	if err != nil {
+		s.logger.Error("failed to quote merchant discount rate", zap.Error(err))
+		return nil, fee_schedule_errors.ErrFailedQuoteFee
+	}
+
+	if fee >= request.Amount {
+		s.logger.Error("merchant discount rate exceeds amount", zap.Int("fee", fee), zap.Int("amount", request.Amount))
+		return nil, fee_schedule_errors.ErrFeeExceedsAmount
+	}
+
+	request.Fee = fee
+
 	transaction, err := s.transactionRepository.CreateTransaction(request)
 	if err != nil {
-		saldo.TotalBalance += request.Amount
-		_, err := s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-			CardNumber:   card.CardNumber,
-			TotalBalance: saldo.TotalBalance,
+		s.logger.Error("failed to create transaction", zap.Error(err))
+		return nil, transaction_errors.ErrFailedCreateTransaction
+	}
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		if qrPayment != nil {
+			qrPayment.TransactionID = transaction.ID
+
+			if _, err := repos.QrPayment.CreateQrPayment(qrPayment); err != nil {
+				s.logger.Error("failed to record QR payment", zap.Error(err))
+
+				if errors.Is(err, qr_payment_errors.ErrQrReferenceUsed) {
+					return qr_payment_errors.ErrQrAlreadyPaid
+				}
+				return qr_payment_errors.ErrFailedRecordQrPayment
+			}
+		}
+
+		saldos, err := lockSaldos(repos, card.CardNumber)
+		if err != nil {
+			s.logger.Error("failed to lock card saldo", zap.Error(err))
+			return saldo_errors.ErrFailedSaldoNotFound
+		}
+
+		saldo := saldos[card.CardNumber]
+		if availableBalance(saldo) < request.Amount {
+			s.logger.Error("insufficient balance", zap.Int("AvailableBalance", availableBalance(saldo)), zap.Int("TransactionAmount", request.Amount))
+			return &response.ErrorResponse{
+				Status:  "error",
+				Message: "Insufficient balance",
+				Code:    http.StatusBadRequest,
+			}
+		}
+
+		if err := enforceTransactionLimits(repos, requests.LimitOperationTransaction, card.CardNumber, request.Amount, time.Now()); err != nil {
+			s.logger.Error("transaction rejected by transaction limits", zap.Error(err), zap.String("card_number", card.CardNumber))
+			return err
+		}
+
+		if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+			requests.LedgerReferenceTransaction,
+			transaction.ID,
+			"Payment from card "+card.CardNumber+" to merchant "+merchant.Name,
+			requests.CardLedgerAccount(card.CardNumber),
+			requests.SystemLedgerAccount(requests.LedgerAccountMerchantSettlement),
+			request.Amount,
+			transaction.Currency,
+		).WithFee(requests.SystemLedgerAccount(requests.LedgerAccountMerchantSettlement), transaction.Fee, transaction.Currency)); err != nil {
+			s.logger.Error("failed to post transaction journal", zap.Error(err))
+			return ledger_errors.ErrFailedPostLedgerJournal
+		}
+
+		if err := accrueSettlementItem(repos.Settlement, &requests.CreateSettlementItem{
+			MerchantID:  merchant.ID,
+			ItemType:    requests.SettlementItemTransaction,
+			ReferenceID: transaction.ID,
+			Amount:      transaction.Amount,
+			Fee:         transaction.Fee,
+			NetAmount:   transaction.Amount - transaction.Fee,
+			Currency:    transaction.Currency,
+		}); err != nil {
+			s.logger.Error("failed to accrue transaction to merchant balance", zap.Error(err))
+			return settlement_errors.ErrFailedAccrueSettlementItem
+		}
+
+		transaction, err = repos.Transaction.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
+			TransactionID: transaction.ID,
+			Status:        "success",
 		})
 		if err != nil {
-			s.logger.Error("failed to update saldo", zap.Error(err))
-			return nil, saldo_errors.ErrFailedUpdateSaldo
+			s.logger.Error("failed to update transaction status", zap.Error(err))
+			return transaction_errors.ErrFailedUpdateTransaction
 		}
 
-		if _, err := s.transactionRepository.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
+		if err := publishMerchantEvent(repos.MerchantWebhook, merchant.ID, requests.MerchantEventTransactionSuccess, s.mapping.ToTransactionResponse(transaction)); err != nil {
+			s.logger.Error("failed to queue merchant webhook", zap.Error(err))
+			return err
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to complete transaction, transaction rolled back", zap.Error(err))
+
+		failed, updateErr := s.transactionRepository.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
 			TransactionID: transaction.ID,
 			Status:        "failed",
-		}); err != nil {
-			s.logger.Error("failed to update transaction status", zap.Error(err))
+		})
+		if updateErr != nil {
+			s.logger.Error("failed to update transaction status", zap.Error(updateErr))
+		} else if err := publishMerchantEvent(s.merchantWebhookRepository, merchant.ID, requests.MerchantEventTransactionFailed, s.mapping.ToTransactionResponse(failed)); err != nil {
+			s.logger.Error("failed to queue merchant webhook", zap.Error(err))
 		}
 
-		return nil, transaction_errors.ErrFailedCreateTransaction
+		return nil, response.ToErrorResponse(err, transaction_errors.ErrFailedCreateTransaction)
 	}
 
-	if _, err := s.transactionRepository.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
-		TransactionID: transaction.ID,
-		Status:        "success",
-	}); err != nil {
-		s.logger.Error("failed to update transaction status", zap.Error(err))
-		return nil, transaction_errors.ErrFailedUpdateTransaction
+	return transaction, nil
+}
+
+// GenerateMerchantQr renders the QR code a merchant presents to its
+// customers, in the currency of the card the merchant is settled to.
+func (s *transactionService) GenerateMerchantQr(request *requests.GenerateMerchantQrRequest) (*response.MerchantQrResponse, *response.ErrorResponse) {
+	s.logger.Debug("Starting GenerateMerchantQr process",
+		zap.Int("merchant_id", request.MerchantID),
+		zap.Int("amount", request.Amount),
+	)
+
+	merchant, errResp := s.findOwnedMerchant(request.MerchantID, request.RequestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	if errResp := checkMerchantActive(merchant); errResp != nil {
+		s.logger.Error("QR code requested for an inactive merchant",
+			zap.Int("merchant_id", merchant.ID),
+			zap.String("status", merchant.Status),
+		)
+		return nil, errResp
 	}
 
 	merchantCard, err := s.cardRepository.FindCardByUserId(merchant.UserID)
@@ -631,34 +794,196 @@ func (s *transactionService) Create(apiKey string, request *requests.CreateTrans
 		return nil, card_errors.ErrCardNotFoundRes
 	}
 
-	merchantSaldo, err := s.saldoRepository.FindByCardNumber(merchantCard.CardNumber)
+	if request.Amount != 0 {
+		if errResp := checkMinimumAmount(request.Amount, merchantCard.Currency); errResp != nil {
+			s.logger.Error("QR code amount below the currency minimum", zap.Int("amount", request.Amount), zap.String("currency", merchantCard.Currency))
+			return nil, errResp
+		}
+	}
+
+	so, err := encodeMerchantQr(s.merchantQr, merchant, merchantCard.Currency, request.Amount, request.Reference)
+	if err != nil {
+		s.logger.Error("failed to encode merchant QR code", zap.Error(err), zap.Int("merchant_id", merchant.ID))
+		return nil, qr_payment_errors.ErrFailedGenerateMerchantQr
+	}
+
+	s.logger.Debug("GenerateMerchantQr process completed", zap.Int("merchant_id", merchant.ID))
+
+	return so, nil
+}
+
+// PayQr pays the merchant named in a scanned QR payload with a card of the
+// paying user, once the CRC of the payload checks out and it names a
+// merchant of this payment gateway.
+func (s *transactionService) PayQr(request *requests.PayQrRequest) (*response.TransactionResponse, *response.ErrorResponse) {
+	s.logger.Debug("Starting PayQr process", zap.Int("user_id", request.UserID))
+
+	payload, err := qris.Parse(request.Payload)
+	if err != nil {
+		s.logger.Error("failed to parse QR payload", zap.Error(err))
+		return nil, qr_payment_errors.ErrInvalidQrPayload
+	}
+
+	account, ok := payload.MerchantAccount(s.merchantQr.AcquirerID)
+	if !ok {
+		s.logger.Error("QR payload of another acquirer")
+		return nil, qr_payment_errors.ErrQrPayloadNotAccepted
+	}
+
+	merchantID, err := strconv.Atoi(account.MerchantID)
+	if err != nil {
+		s.logger.Error("QR payload with an invalid merchant ID", zap.String("merchant_id", account.MerchantID))
+		return nil, qr_payment_errors.ErrQrPayloadNotAccepted
+	}
+
+	payloadCurrency, ok := currency.FromNumericCode(payload.Currency)
+	if !ok {
+		s.logger.Error("QR payload in an unsupported currency", zap.String("currency", payload.Currency))
+		return nil, qr_payment_errors.ErrQrPayloadNotAccepted
+	}
+
+	amount := request.Amount
+	reference := ""
+
+	if payload.Dynamic {
+		// Dynamic QR codes of this gateway always carry a reference; it is
+		// what keeps them from being paid twice.
+		if payload.Reference == "" {
+			s.logger.Error("dynamic QR payload without a reference", zap.Int("merchant_id", merchantID))
+			return nil, qr_payment_errors.ErrQrPayloadNotAccepted
+		}
+
+		amount, err = qris.ParseAmount(payload.Amount, currency.Exponent(payloadCurrency))
+		if err != nil {
+			s.logger.Error("QR payload with an invalid amount", zap.String("amount", payload.Amount))
+			return nil, qr_payment_errors.ErrInvalidQrPayload
+		}
+
+		if request.Amount != 0 && request.Amount != amount {
+			s.logger.Error("amount differs from the QR payload",
+				zap.Int("amount", request.Amount),
+				zap.Int("payload_amount", amount),
+			)
+			return nil, qr_payment_errors.ErrQrAmountMismatch
+		}
+
+		reference = payload.Reference
+	} else if amount == 0 {
+		s.logger.Error("static QR code paid without an amount", zap.Int("merchant_id", merchantID))
+		return nil, qr_payment_errors.ErrQrAmountRequired
+	}
+
+	merchant, err := s.merchantRepository.FindById(merchantID)
 	if err != nil {
-		s.logger.Error("failed to find merchant saldo", zap.Error(err))
-		return nil, saldo_errors.ErrFailedSaldoNotFound
+		s.logger.Error("failed to find merchant", zap.Error(err), zap.Int("merchant_id", merchantID))
+		return nil, merchant_errors.ErrMerchantNotFoundRes
 	}
 
-	merchantSaldo.TotalBalance += request.Amount
-	if _, err := s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-		CardNumber:   merchantCard.CardNumber,
-		TotalBalance: merchantSaldo.TotalBalance,
-	}); err != nil {
-		s.logger.Error("failed to update merchant saldo", zap.Error(err))
-		return nil, saldo_errors.ErrFailedUpdateSaldo
+	if errResp := checkMerchantActive(merchant); errResp != nil {
+		s.logger.Error("QR payment to an inactive merchant",
+			zap.Int("merchant_id", merchant.ID),
+			zap.String("status", merchant.Status),
+		)
+		return nil, errResp
+	}
+
+	card, err := s.cardRepository.FindCardByCardNumber(request.CardNumber)
+	if err != nil {
+		s.logger.Error("failed to find card", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	if card.UserID != request.UserID {
+		s.logger.Error("QR code paid with a card of another user",
+			zap.Int("user_id", request.UserID),
+			zap.Int("card_id", card.ID),
+		)
+		return nil, qr_payment_errors.ErrQrCardNotOwned
+	}
+
+	if card.Currency != payloadCurrency {
+		s.logger.Error("card and QR payload currencies differ",
+			zap.String("card_currency", card.Currency),
+			zap.String("payload_currency", payloadCurrency),
+		)
+		return nil, card_errors.ErrCurrencyMismatch
+	}
+
+	// Recording the payment catches a payment racing this one; checking
+	// first saves creating a transaction only to fail it.
+	if reference != "" {
+		if _, err := s.qrPaymentRepository.FindByReference(merchant.ID, reference); err == nil {
+			s.logger.Error("dynamic QR code paid again",
+				zap.Int("merchant_id", merchant.ID),
+				zap.String("reference", reference),
+			)
+			return nil, qr_payment_errors.ErrQrAlreadyPaid
+		}
+	}
+
+	createReq := &requests.CreateTransactionRequest{
+		CardNumber:      card.CardNumber,
+		Amount:          amount,
+		PaymentMethod:   request.PaymentMethod,
+		MerchantID:      &merchant.ID,
+		TransactionTime: time.Now(),
+	}
+
+	if err := createReq.Validate(); err != nil {
+		s.logger.Error("QR payment is not a valid transaction", zap.Error(err))
+		return nil, qr_payment_errors.ErrQrTransactionInvalid
+	}
+
+	transaction, errResp := s.pay(merchant, card, createReq, &requests.CreateQrPayment{
+		MerchantID: merchant.ID,
+		Reference:  reference,
+	})
+	if errResp != nil {
+		return nil, errResp
 	}
 
 	so := s.mapping.ToTransactionResponse(transaction)
 
-	s.logger.Debug("CreateTransaction process completed",
-		zap.String("apiKey", apiKey),
+	s.logger.Debug("PayQr process completed",
 		zap.Int("transactionID", transaction.ID),
 	)
 
 	return so, nil
 }
 
-func (s *transactionService) Update(apiKey string, request *requests.UpdateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse) {
+// FindQrPayment returns the transaction that paid the dynamic QR code with
+// the given reference.
+func (s *transactionService) FindQrPayment(request *requests.FindQrPaymentRequest) (*response.TransactionResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching QR payment",
+		zap.Int("merchant_id", request.MerchantID),
+		zap.String("reference", request.Reference),
+	)
+
+	if _, errResp := s.findOwnedMerchant(request.MerchantID, request.RequestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	payment, err := s.qrPaymentRepository.FindByReference(request.MerchantID, request.Reference)
+	if err != nil {
+		s.logger.Error("Failed to find QR payment", zap.Error(err))
+		return nil, qr_payment_errors.ErrQrPaymentNotFound
+	}
+
+	transaction, err := s.transactionRepository.FindById(payment.TransactionID)
+	if err != nil {
+		s.logger.Error("Failed to find QR payment transaction", zap.Error(err), zap.Int("transaction_id", payment.TransactionID))
+		return nil, transaction_errors.ErrTransactionNotFound
+	}
+
+	so := s.mapping.ToTransactionResponse(transaction)
+
+	s.logger.Debug("Successfully fetched QR payment", zap.Int("transaction_id", transaction.ID))
+
+	return so, nil
+}
+
+func (s *transactionService) Update(credential requests.MerchantApiKeyCredential, request *requests.UpdateTransactionRequest) (*response.TransactionResponse, *response.ErrorResponse) {
 	s.logger.Debug("Starting UpdateTransaction process",
-		zap.String("apiKey", apiKey),
 		zap.Int("transaction_id", *request.TransactionID),
 	)
 
@@ -676,9 +1001,18 @@ func (s *transactionService) Update(apiKey string, request *requests.UpdateTrans
 		return nil, transaction_errors.ErrFailedUpdateTransaction
 	}
 
-	merchant, err := s.merchantRepository.FindByApiKey(apiKey)
-	if err != nil || transaction.MerchantID != merchant.ID {
-		s.logger.Error("unauthorized access to transaction", zap.Error(err))
+	if transaction.Status == "refunded" || transaction.Status == "partially_refunded" || transaction.Status == "disputed" || transaction.Status == "charged_back" {
+		s.logger.Error("cannot update refunded or disputed transaction",
+			zap.Int("transaction_id", transaction.ID),
+			zap.String("status", transaction.Status),
+		)
+
+		return nil, transaction_errors.ErrTransactionRefunded
+	}
+
+	merchant, errResp := authenticateMerchantApiKey(s.merchantApiKeyRepository, s.merchantRepository, s.logger, credential, requests.MerchantApiKeyScopeCreateTransactions)
+	if errResp != nil || transaction.MerchantID != merchant.ID {
+		s.logger.Error("unauthorized access to transaction", zap.Int("transaction_id", transaction.ID))
 
 		if _, err := s.transactionRepository.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
 			TransactionID: *request.TransactionID,
@@ -704,9 +1038,10 @@ func (s *transactionService) Update(apiKey string, request *requests.UpdateTrans
 		return nil, card_errors.ErrCardNotFoundRes
 	}
 
-	saldo, err := s.saldoRepository.FindByCardNumber(card.CardNumber)
+	layout := "2006-01-02 15:04:05"
+	parsedTime, err := time.Parse(layout, transaction.TransactionTime)
 	if err != nil {
-		s.logger.Error("failed to find saldo", zap.Error(err))
+		s.logger.Error("Failed to parse transaction time", zap.Error(err), zap.String("transaction_time", transaction.TransactionTime))
 
 		if _, err := s.transactionRepository.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
 			TransactionID: *request.TransactionID,
@@ -715,88 +1050,123 @@ func (s *transactionService) Update(apiKey string, request *requests.UpdateTrans
 			s.logger.Error("failed to update transaction status", zap.Error(err))
 		}
 
-		return nil, saldo_errors.ErrFailedSaldoNotFound
+		return nil, transaction_errors.ErrFailedUpdateTransaction
 	}
 
-	saldo.TotalBalance += transaction.Amount
-	s.logger.Debug("Restoring balance for old transaction amount", zap.Int("RestoredBalance", saldo.TotalBalance))
-
-	if _, err := s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-		CardNumber:   card.CardNumber,
-		TotalBalance: saldo.TotalBalance,
-	}); err != nil {
-		s.logger.Error("failed to restore balance", zap.Error(err))
-
-		if _, err := s.transactionRepository.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
-			TransactionID: *request.TransactionID,
-			Status:        "failed",
-		}); err != nil {
-			s.logger.Error("failed to update transaction status", zap.Error(err))
-		}
-
-		return nil, saldo_errors.ErrFailedUpdateSaldo
+	if errResp := checkMinimumAmount(request.Amount, card.Currency); errResp != nil {
+		s.logger.Error("payment amount below the currency minimum", zap.Int("amount", request.Amount), zap.String("currency", card.Currency))
+		return nil, errResp
 	}
 
-	if saldo.TotalBalance < request.Amount {
-		s.logger.Error("insufficient balance for updated amount", zap.Int("AvailableBalance", saldo.TotalBalance), zap.Int("UpdatedAmount", request.Amount))
-
-		if _, err := s.transactionRepository.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
-			TransactionID: *request.TransactionID,
-			Status:        "failed",
-		}); err != nil {
-			s.logger.Error("failed to update transaction status", zap.Error(err))
+	amountDifference := request.Amount - transaction.Amount
+
+	// The merchant discount rate is quoted again on the amended amount, and
+	// the merchant balance carries the difference.
+	fee := transaction.Fee
+	if amountDifference != 0 {
+		fee, err = quoteFee(s.feeScheduleRepository, &requests.FindApplicableFeeSchedule{
+			TransactionType: requests.FeeTransactionTransaction,
+			PaymentMethod:   request.PaymentMethod,
+			CardType:        card.CardType,
+			Currency:        card.Currency,
+		}, request.Amount)
+		if err != nil {
+			s.logger.Error("failed to quote merchant discount rate", zap.Error(err))
+			return nil, fee_schedule_errors.ErrFailedQuoteFee
 		}
 
-		return nil, transaction_errors.ErrFailedUpdateTransaction
+		if fee >= request.Amount {
+			s.logger.Error("merchant discount rate exceeds amount", zap.Int("fee", fee), zap.Int("amount", request.Amount))
+			return nil, fee_schedule_errors.ErrFeeExceedsAmount
+		}
 	}
 
-	saldo.TotalBalance -= request.Amount
-	s.logger.Info("Updating balance for updated transaction amount")
+	feeDifference := fee - transaction.Fee
 
-	if _, err := s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-		CardNumber:   card.CardNumber,
-		TotalBalance: saldo.TotalBalance,
-	}); err != nil {
-		s.logger.Error("failed to update balance", zap.Error(err))
+	var res *record.TransactionRecord
 
-		if _, err := s.transactionRepository.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
-			TransactionID: *request.TransactionID,
-			Status:        "failed",
-		}); err != nil {
-			s.logger.Error("failed to update transaction status", zap.Error(err))
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		saldos, err := lockSaldos(repos, card.CardNumber)
+		if err != nil {
+			s.logger.Error("failed to lock card saldo", zap.Error(err))
+			return saldo_errors.ErrFailedSaldoNotFound
 		}
 
-		return nil, saldo_errors.ErrFailedUpdateSaldo
-	}
+		saldo := saldos[card.CardNumber]
+		restoredBalance := availableBalance(saldo) + transaction.Amount
+		s.logger.Debug("Restoring balance for old transaction amount", zap.Int("RestoredBalance", restoredBalance))
 
-	transaction.Amount = request.Amount
-	transaction.PaymentMethod = request.PaymentMethod
+		if restoredBalance < request.Amount {
+			s.logger.Error("insufficient balance for updated amount", zap.Int("AvailableBalance", restoredBalance), zap.Int("UpdatedAmount", request.Amount))
+			return transaction_errors.ErrFailedUpdateTransaction
+		}
 
-	layout := "2006-01-02 15:04:05"
-	parsedTime, err := time.Parse(layout, transaction.TransactionTime)
-	if err != nil {
-		s.logger.Error("Failed to parse transaction time", zap.Error(err), zap.String("transaction_time", transaction.TransactionTime))
+		if amountDifference > 0 {
+			if err := enforceAmendedTransactionLimits(repos, requests.LimitOperationTransaction, card.CardNumber, request.Amount, amountDifference, time.Now()); err != nil {
+				s.logger.Error("transaction update rejected by transaction limits", zap.Error(err), zap.String("card_number", card.CardNumber))
+				return err
+			}
+		}
 
-		if _, err := s.transactionRepository.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
-			TransactionID: *request.TransactionID,
-			Status:        "failed",
+		if amountDifference != 0 {
+			s.logger.Info("Updating balance for updated transaction amount")
+
+			if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+				requests.LedgerReferenceTransaction,
+				transaction.ID,
+				"Payment amount adjustment from card "+card.CardNumber+" to merchant "+merchant.Name,
+				requests.CardLedgerAccount(card.CardNumber),
+				requests.SystemLedgerAccount(requests.LedgerAccountMerchantSettlement),
+				amountDifference,
+				transaction.Currency,
+			).WithFee(requests.SystemLedgerAccount(requests.LedgerAccountMerchantSettlement), feeDifference, transaction.Currency)); err != nil {
+				s.logger.Error("failed to post transaction adjustment journal", zap.Error(err))
+				return ledger_errors.ErrFailedPostLedgerJournal
+			}
+
+			if err := accrueSettlementItem(repos.Settlement, &requests.CreateSettlementItem{
+				MerchantID:  merchant.ID,
+				ItemType:    requests.SettlementItemAdjustment,
+				ReferenceID: transaction.ID,
+				Amount:      amountDifference,
+				Fee:         feeDifference,
+				NetAmount:   amountDifference - feeDifference,
+				Currency:    transaction.Currency,
+			}); err != nil {
+				s.logger.Error("failed to accrue adjustment to merchant balance", zap.Error(err))
+				return settlement_errors.ErrFailedAccrueSettlementItem
+			}
+		}
+
+		if _, err := repos.Transaction.UpdateTransaction(&requests.UpdateTransactionRequest{
+			TransactionID:   &transaction.ID,
+			CardNumber:      transaction.CardNumber,
+			Amount:          request.Amount,
+			PaymentMethod:   request.PaymentMethod,
+			MerchantID:      &transaction.MerchantID,
+			TransactionTime: parsedTime,
+			Fee:             fee,
 		}); err != nil {
-			s.logger.Error("failed to update transaction status", zap.Error(err))
+			s.logger.Error("failed to update transaction", zap.Error(err))
+			return transaction_errors.ErrFailedUpdateTransaction
 		}
 
-		return nil, transaction_errors.ErrFailedUpdateTransaction
-	}
+		res, err = repos.Transaction.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
+			TransactionID: transaction.ID,
+			Status:        "success",
+		})
+		if err != nil {
+			s.logger.Error("failed to update transaction status", zap.Error(err))
+			if errors.Is(err, transaction_errors.ErrTransactionStatusConflict) {
+				return transaction_errors.ErrTransactionStatusNotUpdatable
+			}
+			return transaction_errors.ErrFailedUpdateTransaction
+		}
 
-	res, err := s.transactionRepository.UpdateTransaction(&requests.UpdateTransactionRequest{
-		TransactionID:   &transaction.ID,
-		CardNumber:      transaction.CardNumber,
-		Amount:          transaction.Amount,
-		PaymentMethod:   transaction.PaymentMethod,
-		MerchantID:      &transaction.MerchantID,
-		TransactionTime: parsedTime,
+		return nil
 	})
 	if err != nil {
-		s.logger.Error("failed to update transaction", zap.Error(err))
+		s.logger.Error("failed to complete transaction update, transaction rolled back", zap.Error(err))
 
 		if _, err := s.transactionRepository.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
 			TransactionID: *request.TransactionID,
@@ -805,21 +1175,12 @@ func (s *transactionService) Update(apiKey string, request *requests.UpdateTrans
 			s.logger.Error("failed to update transaction status", zap.Error(err))
 		}
 
-		return nil, transaction_errors.ErrFailedUpdateTransaction
-	}
-
-	if _, err := s.transactionRepository.UpdateTransactionStatus(&requests.UpdateTransactionStatus{
-		TransactionID: transaction.ID,
-		Status:        "success",
-	}); err != nil {
-		s.logger.Error("failed to update transaction status", zap.Error(err))
-		return nil, transaction_errors.ErrFailedUpdateTransaction
+		return nil, response.ToErrorResponse(err, transaction_errors.ErrFailedUpdateTransaction)
 	}
 
 	so := s.mapping.ToTransactionResponse(res)
 
 	s.logger.Debug("UpdateTransaction process completed",
-		zap.String("apiKey", apiKey),
 		zap.Int("transaction_id", *request.TransactionID),
 	)
 
@@ -917,3 +1278,21 @@ func (s *transactionService) DeleteAllTransactionPermanent() (bool, *response.Er
 
 	return true, nil
 }
+
+func (s *transactionService) findOwnedMerchant(merchantID int, requestedBy *int) (*record.MerchantRecord, *response.ErrorResponse) {
+	merchant, err := s.merchantRepository.FindById(merchantID)
+	if err != nil {
+		s.logger.Error("Failed to find merchant", zap.Error(err), zap.Int("merchant_id", merchantID))
+		return nil, merchant_errors.ErrMerchantNotFoundRes
+	}
+
+	if requestedBy != nil && merchant.UserID != *requestedBy {
+		s.logger.Error("unauthorized merchant QR request",
+			zap.Int("merchant_id", merchantID),
+			zap.Int("requested_by", *requestedBy),
+		)
+		return nil, qr_payment_errors.ErrMerchantQrNotAllowed
+	}
+
+	return merchant, nil
+}
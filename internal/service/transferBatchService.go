@@ -0,0 +1,456 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
+	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transfer_batch_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"go.uber.org/zap"
+)
+
+const (
+	// transferBatchRunLimit caps how many batches a single ProcessPending call
+	// works on; the rest are picked up by the next call.
+	transferBatchRunLimit = 10
+
+	// transferBatchRowPage is how many pending rows are loaded at a time.
+	transferBatchRowPage = 100
+)
+
+type transferBatchService struct {
+	transferBatchRepository repository.TransferBatchRepository
+	cardRepository          repository.CardRepository
+	saldoRepository         repository.SaldoRepository
+	transferService         TransferService
+	unitOfWork              repository.UnitOfWork
+	logger                  logger.LoggerInterface
+	mapping                 responseservice.TransferBatchResponseMapper
+}
+
+func NewTransferBatchService(
+	transferBatchRepository repository.TransferBatchRepository,
+	cardRepository repository.CardRepository,
+	saldoRepository repository.SaldoRepository,
+	transferService TransferService,
+	unitOfWork repository.UnitOfWork,
+	logger logger.LoggerInterface,
+	mapping responseservice.TransferBatchResponseMapper,
+) *transferBatchService {
+	return &transferBatchService{
+		transferBatchRepository: transferBatchRepository,
+		cardRepository:          cardRepository,
+		saldoRepository:         saldoRepository,
+		transferService:         transferService,
+		unitOfWork:              unitOfWork,
+		logger:                  logger,
+		mapping:                 mapping,
+	}
+}
+
+func (s *transferBatchService) FindAll(req *requests.FindAllTransferBatches) ([]*response.TransferBatchResponse, *int, *response.ErrorResponse) {
+	page := req.Page
+	pageSize := req.PageSize
+	search := req.Search
+
+	s.logger.Debug("Fetching transfer batches",
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize),
+		zap.String("search", search))
+
+	if page <= 0 {
+		page = 1
+	}
+
+	if pageSize <= 0 {
+		pageSize = 10
+	}
+
+	req.Page = page
+	req.PageSize = pageSize
+
+	batches, totalRecords, err := s.transferBatchRepository.FindAll(req)
+
+	if err != nil {
+		s.logger.Error("Failed to fetch transfer batches",
+			zap.Error(err),
+			zap.Int("page", page),
+			zap.Int("pageSize", pageSize),
+			zap.String("search", search))
+
+		return nil, nil, transfer_batch_errors.ErrFailedFindAllTransferBatches
+	}
+
+	so := s.mapping.ToTransferBatchesResponse(batches)
+
+	s.logger.Debug("Successfully fetched transfer batches",
+		zap.Int("totalRecords", *totalRecords),
+		zap.Int("page", page),
+		zap.Int("pageSize", pageSize))
+
+	return so, totalRecords, nil
+}
+
+func (s *transferBatchService) FindById(transferBatchID int, requestedBy *int) (*response.TransferBatchResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching transfer batch by ID", zap.Int("transfer_batch_id", transferBatchID))
+
+	batch, errResp := s.findOwnedBatch(transferBatchID, requestedBy)
+	if errResp != nil {
+		return nil, errResp
+	}
+
+	return s.mapping.ToTransferBatchResponse(batch), nil
+}
+
+func (s *transferBatchService) FindRows(req *requests.FindTransferBatchRows) ([]*response.TransferBatchRowResponse, *int, *response.ErrorResponse) {
+	s.logger.Debug("Fetching transfer batch rows", zap.Int("transfer_batch_id", req.TransferBatchID))
+
+	if _, errResp := s.findOwnedBatch(req.TransferBatchID, req.RequestedBy); errResp != nil {
+		return nil, nil, errResp
+	}
+
+	if req.Page <= 0 {
+		req.Page = 1
+	}
+
+	if req.PageSize <= 0 {
+		req.PageSize = 10
+	}
+
+	rows, totalRecords, err := s.transferBatchRepository.FindRows(req)
+	if err != nil {
+		s.logger.Error("Failed to fetch transfer batch rows", zap.Error(err))
+		return nil, nil, transfer_batch_errors.ErrFailedFindTransferBatchRows
+	}
+
+	return s.mapping.ToTransferBatchRowsResponse(rows), totalRecords, nil
+}
+
+// FindReport renders the outcome of every row of a batch as a CSV file.
+func (s *transferBatchService) FindReport(transferBatchID int, requestedBy *int) (*response.TransferBatchReportResponse, *response.ErrorResponse) {
+	s.logger.Debug("Building transfer batch report", zap.Int("transfer_batch_id", transferBatchID))
+
+	if _, errResp := s.findOwnedBatch(transferBatchID, requestedBy); errResp != nil {
+		return nil, errResp
+	}
+
+	rows, err := s.transferBatchRepository.FindReportRows(transferBatchID)
+	if err != nil {
+		s.logger.Error("Failed to fetch transfer batch report rows", zap.Error(err))
+		return nil, transfer_batch_errors.ErrFailedFindTransferBatchRows
+	}
+
+	content, err := buildTransferBatchReport(rows)
+	if err != nil {
+		s.logger.Error("Failed to build transfer batch report", zap.Error(err))
+		return nil, transfer_batch_errors.ErrFailedBuildTransferBatchReport
+	}
+
+	return &response.TransferBatchReportResponse{
+		FileName:    fmt.Sprintf("transfer_batch_%d.csv", transferBatchID),
+		ContentType: "text/csv",
+		Content:     content,
+	}, nil
+}
+
+// CreateTransferBatch validates every row of an uploaded file and stores the
+// batch. A batch with an invalid row is stored as rejected, with the reason
+// on each invalid row, and never runs. An accepted batch is executed later by
+// ProcessPending. Fees are charged per transfer and are not part of the
+// balance check, so a row may still fail when it runs.
+func (s *transferBatchService) CreateTransferBatch(request *requests.CreateTransferBatchRequest) (*response.TransferBatchResponse, *response.ErrorResponse) {
+	s.logger.Debug("Creating transfer batch",
+		zap.String("transfer_from", request.TransferFrom),
+		zap.String("file_format", request.FileFormat))
+
+	senderCard, err := s.cardRepository.FindCardByCardNumber(request.TransferFrom)
+	if err != nil {
+		s.logger.Error("failed to find sender card by number", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
+	}
+
+	if request.RequestedBy != nil && senderCard.UserID != *request.RequestedBy {
+		s.logger.Error("unauthorized transfer batch request",
+			zap.String("transfer_from", request.TransferFrom),
+			zap.Int("requested_by", *request.RequestedBy),
+		)
+		return nil, transfer_batch_errors.ErrTransferBatchNotAllowed
+	}
+
+	rows, err := parseTransferBatchFile(request.FileFormat, request.Content)
+	if err != nil {
+		s.logger.Error("failed to parse transfer batch file", zap.Error(err))
+		return nil, &response.ErrorResponse{
+			Status:  "error",
+			Message: "Invalid transfer batch file: " + err.Error(),
+			Code:    http.StatusBadRequest,
+		}
+	}
+
+	totalAmount, invalidRows := s.validateRows(request.TransferFrom, rows)
+
+	status := requests.TransferBatchStatusPending
+	if invalidRows > 0 {
+		status = requests.TransferBatchStatusRejected
+	} else {
+		saldo, err := s.saldoRepository.FindByCardNumber(request.TransferFrom)
+		if err != nil {
+			s.logger.Error("failed to find sender saldo", zap.Error(err))
+			return nil, saldo_errors.ErrFailedSaldoNotFound
+		}
+
+		if availableBalance(saldo) < totalAmount {
+			s.logger.Error("available balance does not cover transfer batch",
+				zap.Int("available", availableBalance(saldo)),
+				zap.Int("total_amount", totalAmount),
+			)
+			return nil, transfer_batch_errors.ErrTransferBatchInsufficientBalance
+		}
+	}
+
+	var batch *record.TransferBatchRecord
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		var err error
+
+		batch, err = repos.TransferBatch.CreateTransferBatch(&requests.CreateTransferBatch{
+			UserID:       senderCard.UserID,
+			TransferFrom: request.TransferFrom,
+			FileFormat:   request.FileFormat,
+			Status:       status,
+			TotalRows:    len(rows),
+			TotalAmount:  totalAmount,
+			InvalidRows:  invalidRows,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, row := range rows {
+			rowStatus := requests.TransferBatchRowPending
+			if row.FailureReason != nil {
+				rowStatus = requests.TransferBatchRowInvalid
+			}
+
+			if _, err := repos.TransferBatch.CreateTransferBatchRow(batch.ID, rowStatus, row); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		s.logger.Error("Failed to create transfer batch", zap.Error(err))
+		return nil, transfer_batch_errors.ErrFailedCreateTransferBatch
+	}
+
+	s.logger.Debug("Successfully created transfer batch",
+		zap.Int("transfer_batch_id", batch.ID),
+		zap.String("status", batch.Status),
+		zap.Int("invalid_rows", invalidRows))
+
+	return s.mapping.ToTransferBatchResponse(batch), nil
+}
+
+// ProcessPending runs the pending rows of accepted batches and returns how
+// many rows were executed.
+//
+// Each row is claimed before any money moves, so a row executes at most once:
+// two executors cannot run the same row, and a row interrupted before its
+// outcome was recorded stays in the processing state instead of being
+// retried. Such a batch completes with errors.
+func (s *transferBatchService) ProcessPending() (int, *response.ErrorResponse) {
+	batches, err := s.transferBatchRepository.FindRunnable(transferBatchRunLimit)
+	if err != nil {
+		s.logger.Error("failed to find runnable transfer batches", zap.Error(err))
+		return 0, transfer_batch_errors.ErrFailedFindRunnableTransferBatches
+	}
+
+	executed := 0
+
+	for _, batch := range batches {
+		if _, err := s.transferBatchRepository.StartTransferBatch(batch.ID); err != nil {
+			if !errors.Is(err, transfer_batch_errors.ErrTransferBatchStatusConflict) {
+				s.logger.Error("failed to start transfer batch", zap.Int("transfer_batch_id", batch.ID), zap.Error(err))
+			}
+			continue
+		}
+
+		executed += s.processBatch(batch)
+	}
+
+	return executed, nil
+}
+
+func (s *transferBatchService) processBatch(batch *record.TransferBatchRecord) int {
+	executed := 0
+
+	for {
+		rows, err := s.transferBatchRepository.FindPendingRows(batch.ID, transferBatchRowPage)
+		if err != nil {
+			s.logger.Error("failed to find pending transfer batch rows", zap.Int("transfer_batch_id", batch.ID), zap.Error(err))
+			return executed
+		}
+
+		if len(rows) == 0 {
+			break
+		}
+
+		for _, row := range rows {
+			if _, err := s.transferBatchRepository.ClaimRow(row.ID); err != nil {
+				if !errors.Is(err, transfer_batch_errors.ErrTransferBatchRowAlreadyClaimed) {
+					s.logger.Error("failed to claim transfer batch row", zap.Int("row_id", row.ID), zap.Error(err))
+				}
+				continue
+			}
+
+			s.executeRow(batch, row)
+			executed++
+		}
+	}
+
+	s.completeBatch(batch.ID)
+
+	return executed
+}
+
+// executeRow makes the transfer of a claimed row and records its outcome on
+// the row and the batch counters together.
+func (s *transferBatchService) executeRow(batch *record.TransferBatchRecord, row *record.TransferBatchRowRecord) {
+	transfer, errResp := s.transferService.CreateTransaction(&requests.CreateTransferRequest{
+		TransferFrom:   batch.TransferFrom,
+		TransferTo:     row.TransferTo,
+		TransferAmount: row.TransferAmount,
+	})
+
+	status := requests.TransferBatchRowSuccess
+	successRows, failedRows, successAmount := 1, 0, row.TransferAmount
+
+	var (
+		transferID    *int
+		failureReason *string
+	)
+
+	if errResp != nil {
+		s.logger.Error("transfer batch row failed",
+			zap.Int("transfer_batch_id", batch.ID),
+			zap.Int("row_number", row.RowNumber),
+			zap.String("reason", errResp.Message),
+		)
+
+		status = requests.TransferBatchRowFailed
+		successRows, failedRows, successAmount = 0, 1, 0
+		failureReason = &errResp.Message
+	} else {
+		transferID = &transfer.ID
+	}
+
+	err := s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		if _, err := repos.TransferBatch.FinishRow(row.ID, status, transferID, failureReason); err != nil {
+			return err
+		}
+
+		_, err := repos.TransferBatch.AddTransferBatchResult(batch.ID, successRows, failedRows, successAmount)
+		return err
+	})
+
+	if err != nil {
+		s.logger.Error("failed to record transfer batch row outcome",
+			zap.Int("transfer_batch_id", batch.ID),
+			zap.Int("row_id", row.ID),
+			zap.Error(err),
+		)
+	}
+}
+
+// completeBatch ends a batch once no row is pending. Rows still processing
+// were interrupted and count as not succeeded.
+func (s *transferBatchService) completeBatch(transferBatchID int) {
+	batch, err := s.transferBatchRepository.FindById(transferBatchID)
+	if err != nil {
+		s.logger.Error("failed to reload transfer batch", zap.Int("transfer_batch_id", transferBatchID), zap.Error(err))
+		return
+	}
+
+	status := requests.TransferBatchStatusCompleted
+	if batch.FailedRows > 0 || batch.SuccessRows != batch.TotalRows-batch.InvalidRows {
+		status = requests.TransferBatchStatusCompletedWithErrors
+	}
+
+	if _, err := s.transferBatchRepository.CompleteTransferBatch(transferBatchID, status); err != nil && !errors.Is(err, transfer_batch_errors.ErrTransferBatchStatusConflict) {
+		s.logger.Error("failed to complete transfer batch", zap.Int("transfer_batch_id", transferBatchID), zap.Error(err))
+	}
+}
+
+// validateRows checks every row and records why a row is invalid. It returns
+// the total amount of the batch and how many rows are invalid.
+func (s *transferBatchService) validateRows(transferFrom string, rows []*requests.TransferBatchRowInput) (int, int) {
+	knownCards := make(map[string]bool)
+	totalAmount := 0
+	invalidRows := 0
+
+	for _, row := range rows {
+		switch {
+		case row.TransferTo == "":
+			rejectBatchRow(row, "transfer_to is required")
+		case row.TransferTo == transferFrom:
+			rejectBatchRow(row, "transfer_to must differ from the source card")
+		}
+
+		if row.TransferAmount < minTransferBatchAmount {
+			rejectBatchRow(row, fmt.Sprintf("amount must be at least %d", minTransferBatchAmount))
+		}
+
+		if row.Note != nil && len(*row.Note) > maxTransferBatchNote {
+			rejectBatchRow(row, fmt.Sprintf("note is longer than %d characters", maxTransferBatchNote))
+		}
+
+		if row.FailureReason == nil {
+			found, checked := knownCards[row.TransferTo]
+			if !checked {
+				_, err := s.cardRepository.FindCardByCardNumber(row.TransferTo)
+				found = err == nil
+				knownCards[row.TransferTo] = found
+			}
+
+			if !found {
+				rejectBatchRow(row, "transfer_to card not found")
+			}
+		}
+
+		if row.FailureReason != nil {
+			invalidRows++
+		}
+
+		totalAmount += row.TransferAmount
+	}
+
+	return totalAmount, invalidRows
+}
+
+func (s *transferBatchService) findOwnedBatch(transferBatchID int, requestedBy *int) (*record.TransferBatchRecord, *response.ErrorResponse) {
+	batch, err := s.transferBatchRepository.FindById(transferBatchID)
+	if err != nil {
+		s.logger.Error("failed to find transfer batch", zap.Int("transfer_batch_id", transferBatchID), zap.Error(err))
+		return nil, transfer_batch_errors.ErrTransferBatchNotFound
+	}
+
+	if requestedBy != nil && batch.UserID != *requestedBy {
+		s.logger.Error("transfer batch belongs to another user",
+			zap.Int("transfer_batch_id", transferBatchID),
+			zap.Int("requested_by", *requestedBy),
+		)
+		return nil, transfer_batch_errors.ErrTransferBatchNotAllowed
+	}
+
+	return batch, nil
+}
@@ -1,14 +1,21 @@
 package service
 
 import (
+	"errors"
 	"net/http"
+	"time"
 
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
 	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
 
 	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/currency"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/exchange_rate_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/fee_schedule_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/ledger_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/transfer_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
@@ -17,26 +24,35 @@ import (
 )
 
 type transferService struct {
-	userRepository     repository.UserRepository
-	cardRepository     repository.CardRepository
-	saldoRepository    repository.SaldoRepository
-	transferRepository repository.TransferRepository
-	logger             logger.LoggerInterface
-	mapping            responseservice.TransferResponseMapper
+	userRepository         repository.UserRepository
+	cardRepository         repository.CardRepository
+	saldoRepository        repository.SaldoRepository
+	transferRepository     repository.TransferRepository
+	exchangeRateRepository repository.ExchangeRateRepository
+	feeScheduleRepository  repository.FeeScheduleRepository
+	unitOfWork             repository.UnitOfWork
+	logger                 logger.LoggerInterface
+	mapping                responseservice.TransferResponseMapper
 }
 
 func NewTransferService(
 	userRepository repository.UserRepository,
 	cardRepository repository.CardRepository,
 	transferRepository repository.TransferRepository,
-	saldoRepository repository.SaldoRepository, logger logger.LoggerInterface, mapping responseservice.TransferResponseMapper) *transferService {
+	saldoRepository repository.SaldoRepository,
+	exchangeRateRepository repository.ExchangeRateRepository,
+	feeScheduleRepository repository.FeeScheduleRepository,
+	unitOfWork repository.UnitOfWork, logger logger.LoggerInterface, mapping responseservice.TransferResponseMapper) *transferService {
 	return &transferService{
-		userRepository:     userRepository,
-		cardRepository:     cardRepository,
-		transferRepository: transferRepository,
-		saldoRepository:    saldoRepository,
-		logger:             logger,
-		mapping:            mapping,
+		userRepository:         userRepository,
+		cardRepository:         cardRepository,
+		transferRepository:     transferRepository,
+		saldoRepository:        saldoRepository,
+		exchangeRateRepository: exchangeRateRepository,
+		feeScheduleRepository:  feeScheduleRepository,
+		unitOfWork:             unitOfWork,
+		logger:                 logger,
+		mapping:                mapping,
 	}
 }
 
@@ -493,91 +509,141 @@ func (s *transferService) CreateTransaction(request *requests.CreateTransferRequ
 		zap.Any("request", request),
 	)
 
-	_, err := s.cardRepository.FindCardByCardNumber(request.TransferFrom)
+	senderCard, err := s.cardRepository.FindCardByCardNumber(request.TransferFrom)
 	if err != nil {
 		s.logger.Error("failed to find sender card by Number", zap.Error(err))
 		return nil, card_errors.ErrCardNotFoundRes
 	}
 
-	_, err = s.cardRepository.FindCardByCardNumber(request.TransferTo)
+	receiverCard, err := s.cardRepository.FindCardByCardNumber(request.TransferTo)
 	if err != nil {
 		s.logger.Error("failed to find receiver card by number", zap.Error(err))
 		return nil, card_errors.ErrCardNotFoundRes
 	}
 
-	senderSaldo, err := s.saldoRepository.FindByCardNumber(request.TransferFrom)
-	if err != nil {
-		s.logger.Error("failed to find sender saldo by card number", zap.Error(err))
-		return nil, saldo_errors.ErrFailedSaldoNotFound
+	if errResp := checkMinimumAmount(request.TransferAmount, senderCard.Currency); errResp != nil {
+		s.logger.Error("transfer amount below the currency minimum",
+			zap.Int("transfer_amount", request.TransferAmount),
+			zap.String("currency", senderCard.Currency),
+		)
+		return nil, errResp
 	}
 
-	receiverSaldo, err := s.saldoRepository.FindByCardNumber(request.TransferTo)
-	if err != nil {
-		s.logger.Error("failed to find receiver saldo by card number", zap.Error(err))
-		return nil, saldo_errors.ErrFailedSaldoNotFound
-	}
+	if senderCard.Currency != receiverCard.Currency {
+		rate, err := s.exchangeRateRepository.FindByPair(senderCard.Currency, receiverCard.Currency)
+		if err != nil {
+			s.logger.Error("failed to find exchange rate",
+				zap.String("base_currency", senderCard.Currency),
+				zap.String("quote_currency", receiverCard.Currency),
+				zap.Error(err),
+			)
+			return nil, exchange_rate_errors.ErrExchangeRateUnavailable
+		}
+
+		convertedAmount, err := currency.Convert(request.TransferAmount, senderCard.Currency, receiverCard.Currency, rate.Rate, rate.SpreadBps)
+		if err != nil || convertedAmount <= 0 {
+			s.logger.Error("failed to convert transfer amount",
+				zap.Int("transfer_amount", request.TransferAmount),
+				zap.Float64("rate", rate.Rate),
+				zap.Error(err),
+			)
+			return nil, exchange_rate_errors.ErrFailedConvertAmount
+		}
 
-	if senderSaldo.TotalBalance < request.TransferAmount {
-		return nil, &response.ErrorResponse{
-			Status:  "error",
-			Message: "Insufficient balance for sender",
-			Code:    http.StatusBadRequest,
+		request.Conversion = &requests.TransferConversion{
+			ConvertedAmount: convertedAmount,
+			ExchangeRate:    rate.Rate,
+			SpreadBps:       rate.SpreadBps,
 		}
 	}
 
-	senderSaldo.TotalBalance -= request.TransferAmount
-	_, err = s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-		CardNumber:   senderSaldo.CardNumber,
-		TotalBalance: senderSaldo.TotalBalance,
-	})
+	// The sender pays the fee on top of the transfer amount, in its own
+	// currency.
+	fee, err := quoteFee(s.feeScheduleRepository, &requests.FindApplicableFeeSchedule{
+		TransactionType: requests.FeeTransactionTransfer,
+		CardType:        senderCard.CardType,
+		Currency:        senderCard.Currency,
+	}, request.TransferAmount)
 	if err != nil {
-		s.logger.Error("failed to update sender saldo", zap.Error(err))
-		return nil, saldo_errors.ErrFailedUpdateSaldo
+		s.logger.Error("failed to quote transfer fee", zap.Error(err))
+		return nil, fee_schedule_errors.ErrFailedQuoteFee
 	}
 
-	receiverSaldo.TotalBalance += request.TransferAmount
-	_, err = s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-		CardNumber:   receiverSaldo.CardNumber,
-		TotalBalance: receiverSaldo.TotalBalance,
-	})
+	request.Fee = fee
+
+	transfer, err := s.transferRepository.CreateTransfer(request)
 	if err != nil {
-		s.logger.Error("failed to update receiver saldo", zap.Error(err))
+		s.logger.Error("failed to create transfer", zap.Error(err))
+		return nil, transfer_errors.ErrFailedCreateTransfer
+	}
 
-		senderSaldo.TotalBalance += request.TransferAmount
-		_, rollbackErr := s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-			CardNumber:   senderSaldo.CardNumber,
-			TotalBalance: senderSaldo.TotalBalance,
-		})
-		if rollbackErr != nil {
-			s.logger.Error("failed to rollback sender saldo", zap.Error(rollbackErr))
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		saldos, err := lockSaldos(repos, request.TransferFrom, request.TransferTo)
+		if err != nil {
+			s.logger.Error("failed to lock sender and receiver saldo", zap.Error(err))
+			return saldo_errors.ErrFailedSaldoNotFound
 		}
 
-		return nil, saldo_errors.ErrFailedUpdateSaldo
-	}
+		senderSaldo := saldos[request.TransferFrom]
+		receiverSaldo := saldos[request.TransferTo]
 
-	transfer, err := s.transferRepository.CreateTransfer(request)
-	if err != nil {
-		s.logger.Error("failed to create transfer", zap.Error(err))
+		if availableBalance(senderSaldo) < request.TransferAmount+transfer.Fee {
+			return &response.ErrorResponse{
+				Status:  "error",
+				Message: "Insufficient balance for sender",
+				Code:    http.StatusBadRequest,
+			}
+		}
 
-		senderSaldo.TotalBalance += request.TransferAmount
-		receiverSaldo.TotalBalance -= request.TransferAmount
+		if err := enforceTransactionLimits(repos, requests.LimitOperationTransfer, request.TransferFrom, request.TransferAmount, time.Now()); err != nil {
+			s.logger.Error("transfer rejected by transaction limits", zap.Error(err), zap.String("card_number", request.TransferFrom))
+			return err
+		}
 
-		_, rollbackErr := s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-			CardNumber:   senderSaldo.CardNumber,
-			TotalBalance: senderSaldo.TotalBalance,
-		})
-		if rollbackErr != nil {
-			s.logger.Error("failed to rollback sender saldo", zap.Error(rollbackErr))
+		journal := requests.NewLedgerJournal(
+			requests.LedgerReferenceTransfer,
+			transfer.ID,
+			"Transfer from "+senderSaldo.CardNumber+" to "+receiverSaldo.CardNumber,
+			requests.CardLedgerAccount(senderSaldo.CardNumber),
+			requests.CardLedgerAccount(receiverSaldo.CardNumber),
+			request.TransferAmount,
+			transfer.Currency,
+		)
+		if transfer.Currency != transfer.ToCurrency {
+			journal = requests.NewConversionLedgerJournal(
+				requests.LedgerReferenceTransfer,
+				transfer.ID,
+				"Transfer from "+senderSaldo.CardNumber+" to "+receiverSaldo.CardNumber+" converted from "+transfer.Currency+" to "+transfer.ToCurrency,
+				requests.CardLedgerAccount(senderSaldo.CardNumber),
+				requests.CardLedgerAccount(receiverSaldo.CardNumber),
+				transfer.TransferAmount,
+				transfer.Currency,
+				transfer.ConvertedAmount,
+				transfer.ToCurrency,
+			)
+		}
+
+		journal.WithFee(requests.CardLedgerAccount(senderSaldo.CardNumber), transfer.Fee, transfer.Currency)
+
+		if _, err := repos.Ledger.PostJournal(journal); err != nil {
+			s.logger.Error("failed to post transfer journal", zap.Error(err))
+			return ledger_errors.ErrFailedPostLedgerJournal
 		}
 
-		_, rollbackErr = s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-			CardNumber:   receiverSaldo.CardNumber,
-			TotalBalance: receiverSaldo.TotalBalance,
+		transfer, err = repos.Transfer.UpdateTransferStatus(&requests.UpdateTransferStatus{
+			TransferID: transfer.ID,
+			Status:     "success",
 		})
-		if rollbackErr != nil {
-			s.logger.Error("failed to rollback receiver saldo", zap.Error(rollbackErr))
+		if err != nil {
+			s.logger.Error("failed to update transfer status", zap.Error(err))
+			return transfer_errors.ErrFailedUpdateTransfer
 		}
 
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("failed to complete transfer, transaction rolled back", zap.Error(err))
+
 		if _, err := s.transferRepository.UpdateTransferStatus(&requests.UpdateTransferStatus{
 			TransferID: transfer.ID,
 			Status:     "failed",
@@ -585,15 +651,7 @@ func (s *transferService) CreateTransaction(request *requests.CreateTransferRequ
 			s.logger.Error("failed to update transfer status", zap.Error(err))
 		}
 
-		return nil, transfer_errors.ErrFailedCreateTransfer
-	}
-
-	if _, err := s.transferRepository.UpdateTransferStatus(&requests.UpdateTransferStatus{
-		TransferID: transfer.ID,
-		Status:     "success",
-	}); err != nil {
-		s.logger.Error("failed to update transfer status", zap.Error(err))
-		return nil, transfer_errors.ErrFailedUpdateTransfer
+		return nil, response.ToErrorResponse(err, transfer_errors.ErrFailedCreateTransfer)
 	}
 
 	so := s.mapping.ToTransferResponse(transfer)
@@ -617,135 +675,122 @@ func (s *transferService) UpdateTransaction(request *requests.UpdateTransferRequ
 		return nil, transfer_errors.ErrTransferNotFound
 	}
 
-	amountDifference := request.TransferAmount - transfer.TransferAmount
+	if transfer.ExchangeRate != nil {
+		s.logger.Error("Refusing to amend a converted transfer", zap.Int("transfer_id", transfer.ID))
 
-	senderSaldo, err := s.saldoRepository.FindByCardNumber(transfer.TransferFrom)
-	if err != nil {
-		s.logger.Error("Failed to find sender's saldo by user ID", zap.Error(err))
+		return nil, transfer_errors.ErrCrossCurrencyTransferLocked
+	}
 
-		if _, err := s.transferRepository.UpdateTransferStatus(&requests.UpdateTransferStatus{
-			TransferID: *request.TransferID,
-			Status:     "failed",
-		}); err != nil {
-			s.logger.Error("Failed to update transfer status", zap.Error(err))
-		}
+	// Both cards carry postings of the transfer, so only its amount can be
+	// amended.
+	if request.TransferFrom != transfer.TransferFrom || request.TransferTo != transfer.TransferTo {
+		s.logger.Error("Refusing to move transfer to other cards", zap.Int("transfer_id", transfer.ID))
 
-		return nil, saldo_errors.ErrFailedSaldoNotFound
+		return nil, transfer_errors.ErrTransferCardsNotUpdatable
 	}
 
-	newSenderBalance := senderSaldo.TotalBalance - amountDifference
-	if newSenderBalance < 0 {
-		s.logger.Error("Insufficient balance for sender", zap.String("senderID", transfer.TransferFrom))
-
-		if _, err := s.transferRepository.UpdateTransferStatus(&requests.UpdateTransferStatus{
-			TransferID: *request.TransferID,
-			Status:     "failed",
-		}); err != nil {
-			s.logger.Error("Failed to update transfer status", zap.Error(err))
-		}
+	if errResp := checkMinimumAmount(request.TransferAmount, transfer.Currency); errResp != nil {
+		s.logger.Error("Transfer amount below the currency minimum",
+			zap.Int("transfer_amount", request.TransferAmount),
+			zap.String("currency", transfer.Currency),
+		)
 
-		return nil, &response.ErrorResponse{
-			Status:  "error",
-			Message: "Insufficient balance for sender",
-			Code:    http.StatusBadRequest,
-		}
+		return nil, errResp
 	}
 
-	senderSaldo.TotalBalance = newSenderBalance
-	_, err = s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-		CardNumber:   senderSaldo.CardNumber,
-		TotalBalance: senderSaldo.TotalBalance,
-	})
-	if err != nil {
-		s.logger.Error("Failed to update sender's saldo", zap.Error(err))
+	amountDifference := request.TransferAmount - transfer.TransferAmount
 
-		if _, err := s.transferRepository.UpdateTransferStatus(&requests.UpdateTransferStatus{
-			TransferID: *request.TransferID,
-			Status:     "failed",
-		}); err != nil {
-			s.logger.Error("Failed to update transfer status", zap.Error(err))
+	// The fee is quoted again on the amended amount, and the sender pays or
+	// gets back the difference along with the amount.
+	request.Fee = transfer.Fee
+	if amountDifference != 0 {
+		senderCard, err := s.cardRepository.FindCardByCardNumber(transfer.TransferFrom)
+		if err != nil {
+			s.logger.Error("Failed to find sender card by number", zap.Error(err))
+
+			return nil, card_errors.ErrCardNotFoundRes
 		}
 
-		return nil, saldo_errors.ErrFailedUpdateSaldo
+		request.Fee, err = quoteFee(s.feeScheduleRepository, &requests.FindApplicableFeeSchedule{
+			TransactionType: requests.FeeTransactionTransfer,
+			CardType:        senderCard.CardType,
+			Currency:        senderCard.Currency,
+		}, request.TransferAmount)
+		if err != nil {
+			s.logger.Error("Failed to quote transfer fee", zap.Error(err))
+
+			return nil, fee_schedule_errors.ErrFailedQuoteFee
+		}
 	}
 
-	receiverSaldo, err := s.saldoRepository.FindByCardNumber(transfer.TransferTo)
-	if err != nil {
-		s.logger.Error("Failed to find receiver's saldo by user ID", zap.Error(err))
+	feeDifference := request.Fee - transfer.Fee
 
-		rollbackSenderBalance := &requests.UpdateSaldoBalance{
-			CardNumber:   transfer.TransferFrom,
-			TotalBalance: senderSaldo.TotalBalance + amountDifference,
-		}
-		_, rollbackErr := s.saldoRepository.UpdateSaldoBalance(rollbackSenderBalance)
-		if rollbackErr != nil {
-			s.logger.Error("Failed to rollback sender's saldo after receiver lookup failure", zap.Error(rollbackErr))
-		}
+	var updatedTransfer *record.TransferRecord
 
-		if _, err := s.transferRepository.UpdateTransferStatus(&requests.UpdateTransferStatus{
-			TransferID: *request.TransferID,
-			Status:     "failed",
-		}); err != nil {
-			s.logger.Error("Failed to update transfer status", zap.Error(err))
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		saldos, err := lockSaldos(repos, transfer.TransferFrom, transfer.TransferTo)
+		if err != nil {
+			s.logger.Error("Failed to lock sender and receiver saldo", zap.Error(err))
+			return saldo_errors.ErrFailedSaldoNotFound
 		}
 
-		return nil, saldo_errors.ErrFailedSaldoNotFound
-	}
+		senderSaldo := saldos[transfer.TransferFrom]
+		receiverSaldo := saldos[transfer.TransferTo]
 
-	newReceiverBalance := receiverSaldo.TotalBalance + amountDifference
-	receiverSaldo.TotalBalance = newReceiverBalance
-	_, err = s.saldoRepository.UpdateSaldoBalance(&requests.UpdateSaldoBalance{
-		CardNumber:   receiverSaldo.CardNumber,
-		TotalBalance: receiverSaldo.TotalBalance,
-	})
-	if err != nil {
-		s.logger.Error("Failed to update receiver's saldo", zap.Error(err))
+		newSenderBalance := availableBalance(senderSaldo) - amountDifference - feeDifference
+		if newSenderBalance < 0 {
+			s.logger.Error("Insufficient balance for sender", zap.String("senderID", transfer.TransferFrom))
 
-		rollbackSenderBalance := &requests.UpdateSaldoBalance{
-			CardNumber:   transfer.TransferFrom,
-			TotalBalance: senderSaldo.TotalBalance + amountDifference,
+			return &response.ErrorResponse{
+				Status:  "error",
+				Message: "Insufficient balance for sender",
+				Code:    http.StatusBadRequest,
+			}
 		}
-		rollbackReceiverBalance := &requests.UpdateSaldoBalance{
-			CardNumber:   transfer.TransferTo,
-			TotalBalance: receiverSaldo.TotalBalance - amountDifference,
+
+		if amountDifference > 0 {
+			if err := enforceAmendedTransactionLimits(repos, requests.LimitOperationTransfer, transfer.TransferFrom, request.TransferAmount, amountDifference, time.Now()); err != nil {
+				s.logger.Error("Transfer update rejected by transaction limits", zap.Error(err), zap.String("card_number", transfer.TransferFrom))
+				return err
+			}
 		}
 
-		if _, err := s.saldoRepository.UpdateSaldoBalance(rollbackSenderBalance); err != nil {
-			s.logger.Error("Failed to rollback sender's saldo after receiver update failure", zap.Error(err))
+		if amountDifference != 0 {
+			if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+				requests.LedgerReferenceTransfer,
+				transfer.ID,
+				"Transfer amount adjustment from "+senderSaldo.CardNumber+" to "+receiverSaldo.CardNumber,
+				requests.CardLedgerAccount(senderSaldo.CardNumber),
+				requests.CardLedgerAccount(receiverSaldo.CardNumber),
+				amountDifference,
+				senderSaldo.Currency,
+			).WithFee(requests.CardLedgerAccount(senderSaldo.CardNumber), feeDifference, senderSaldo.Currency)); err != nil {
+				s.logger.Error("Failed to post transfer adjustment journal", zap.Error(err))
+				return ledger_errors.ErrFailedPostLedgerJournal
+			}
 		}
-		if _, err := s.saldoRepository.UpdateSaldoBalance(rollbackReceiverBalance); err != nil {
-			s.logger.Error("Failed to rollback receiver's saldo after sender update failure", zap.Error(err))
+
+		if _, err := repos.Transfer.UpdateTransfer(request); err != nil {
+			s.logger.Error("Failed to update transfer", zap.Error(err))
+			return transfer_errors.ErrFailedUpdateTransfer
 		}
 
-		if _, err := s.transferRepository.UpdateTransferStatus(&requests.UpdateTransferStatus{
+		updatedTransfer, err = repos.Transfer.UpdateTransferStatus(&requests.UpdateTransferStatus{
 			TransferID: *request.TransferID,
-			Status:     "failed",
-		}); err != nil {
+			Status:     "success",
+		})
+		if err != nil {
 			s.logger.Error("Failed to update transfer status", zap.Error(err))
+			if errors.Is(err, transfer_errors.ErrTransferStatusConflict) {
+				return transfer_errors.ErrTransferStatusNotUpdatable
+			}
+			return transfer_errors.ErrFailedUpdateTransfer
 		}
 
-		return nil, saldo_errors.ErrFailedUpdateSaldo
-	}
-
-	updatedTransfer, err := s.transferRepository.UpdateTransfer(request)
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Failed to update transfer", zap.Error(err))
-
-		rollbackSenderBalance := &requests.UpdateSaldoBalance{
-			CardNumber:   transfer.TransferFrom,
-			TotalBalance: senderSaldo.TotalBalance + amountDifference,
-		}
-		rollbackReceiverBalance := &requests.UpdateSaldoBalance{
-			CardNumber:   transfer.TransferTo,
-			TotalBalance: receiverSaldo.TotalBalance - amountDifference,
-		}
-
-		if _, err := s.saldoRepository.UpdateSaldoBalance(rollbackSenderBalance); err != nil {
-			s.logger.Error("Failed to rollback sender's saldo after receiver update failure", zap.Error(err))
-		}
-		if _, err := s.saldoRepository.UpdateSaldoBalance(rollbackReceiverBalance); err != nil {
-			s.logger.Error("Failed to rollback receiver's saldo after sender update failure", zap.Error(err))
-		}
+		s.logger.Error("Failed to complete transfer update, transaction rolled back", zap.Error(err))
 
 		if _, err := s.transferRepository.UpdateTransferStatus(&requests.UpdateTransferStatus{
 			TransferID: *request.TransferID,
@@ -754,15 +799,7 @@ func (s *transferService) UpdateTransaction(request *requests.UpdateTransferRequ
 			s.logger.Error("Failed to update transfer status", zap.Error(err))
 		}
 
-		return nil, transfer_errors.ErrFailedUpdateTransfer
-	}
-
-	if _, err := s.transferRepository.UpdateTransferStatus(&requests.UpdateTransferStatus{
-		TransferID: *request.TransferID,
-		Status:     "success",
-	}); err != nil {
-		s.logger.Error("Failed to update transfer status", zap.Error(err))
-		return nil, transfer_errors.ErrFailedUpdateTransfer
+		return nil, response.ToErrorResponse(err, transfer_errors.ErrFailedUpdateTransfer)
 	}
 
 	so := s.mapping.ToTransferResponse(updatedTransfer)
@@ -248,6 +248,27 @@ func (s *userService) UpdateUser(request *requests.UpdateUserRequest) (*response
 	return so, nil
 }
 
+func (s *userService) UpdateUserTier(request *requests.UpdateUserTierRequest) (*response.UserResponse, *response.ErrorResponse) {
+	s.logger.Debug("Updating user tier", zap.Int("user_id", request.UserID), zap.String("tier", request.Tier))
+
+	if _, err := s.userRepository.FindById(request.UserID); err != nil {
+		s.logger.Error("Failed to find user by ID", zap.Error(err))
+		return nil, user_errors.ErrUserNotFoundRes
+	}
+
+	res, err := s.userRepository.UpdateUserTier(request)
+	if err != nil {
+		s.logger.Error("Failed to update user tier", zap.Error(err))
+		return nil, user_errors.ErrFailedUpdateUserTier
+	}
+
+	so := s.mapping.ToUserResponse(res)
+
+	s.logger.Debug("Successfully updated user tier", zap.Int("user_id", so.ID), zap.String("tier", so.Tier))
+
+	return so, nil
+}
+
 func (s *userService) TrashedUser(user_id int) (*response.UserResponseDeleteAt, *response.ErrorResponse) {
 	s.logger.Debug("Trashing user", zap.Int("user_id", user_id))
 
@@ -1,37 +1,60 @@
 package service
 
 import (
+	"errors"
 	"net/http"
+	"time"
 
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/record"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/requests"
 	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
 	responseservice "github.com/MamangRust/paymentgatewaygraphql/internal/mapper/response/service"
 
 	"github.com/MamangRust/paymentgatewaygraphql/internal/repository"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/card_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/fee_schedule_errors"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/ledger_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/saldo_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/errors/withdraw_errors"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/statemachine"
 
 	"go.uber.org/zap"
 )
 
+// withdrawSettlementBatchSize caps how many approved withdraws a single
+// SettleApproved call settles; the rest are picked up by the next call.
+const withdrawSettlementBatchSize = 50
+
 type withdrawService struct {
-	userRepository     repository.UserRepository
-	saldoRepository    repository.SaldoRepository
-	withdrawRepository repository.WithdrawRepository
-	logger             logger.LoggerInterface
-	mapping            responseservice.WithdrawResponseMapper
+	userRepository        repository.UserRepository
+	saldoRepository       repository.SaldoRepository
+	withdrawRepository    repository.WithdrawRepository
+	cardRepository        repository.CardRepository
+	feeScheduleRepository repository.FeeScheduleRepository
+	unitOfWork            repository.UnitOfWork
+	reviewThreshold       int
+	payoutProvider        string
+	logger                logger.LoggerInterface
+	mapping               responseservice.WithdrawResponseMapper
 }
 
 func NewWithdrawService(
 	userRepository repository.UserRepository,
-	withdrawRepository repository.WithdrawRepository, saldoRepository repository.SaldoRepository, logger logger.LoggerInterface, mapping responseservice.WithdrawResponseMapper) *withdrawService {
+	withdrawRepository repository.WithdrawRepository, saldoRepository repository.SaldoRepository,
+	cardRepository repository.CardRepository, feeScheduleRepository repository.FeeScheduleRepository,
+	unitOfWork repository.UnitOfWork, reviewThreshold int, payoutProvider string, logger logger.LoggerInterface, mapping responseservice.WithdrawResponseMapper) *withdrawService {
 	return &withdrawService{
-		userRepository:     userRepository,
-		saldoRepository:    saldoRepository,
-		withdrawRepository: withdrawRepository,
-		logger:             logger,
-		mapping:            mapping,
+		userRepository:        userRepository,
+		saldoRepository:       saldoRepository,
+		withdrawRepository:    withdrawRepository,
+		cardRepository:        cardRepository,
+		feeScheduleRepository: feeScheduleRepository,
+		unitOfWork:            unitOfWork,
+		reviewThreshold:       reviewThreshold,
+		payoutProvider:        payoutProvider,
+		logger:                logger,
+		mapping:               mapping,
 	}
 }
 
@@ -445,67 +468,137 @@ func (s *withdrawService) FindByTrashed(req *requests.FindAllWithdraws) ([]*resp
 func (s *withdrawService) Create(request *requests.CreateWithdrawRequest) (*response.WithdrawResponse, *response.ErrorResponse) {
 	s.logger.Debug("Creating new withdraw", zap.Any("request", request))
 
-	saldo, err := s.saldoRepository.FindByCardNumber(request.CardNumber)
-
+	card, err := s.cardRepository.FindCardByCardNumber(request.CardNumber)
 	if err != nil {
-		s.logger.Error("Failed to find saldo by user ID", zap.Error(err))
-		return nil, saldo_errors.ErrFailedSaldoNotFound
+		s.logger.Error("Failed to find card by number", zap.Error(err))
+		return nil, card_errors.ErrCardNotFoundRes
 	}
 
-	if saldo == nil {
-		s.logger.Error("Saldo not found for user", zap.String("cardNumber", request.CardNumber))
-		return nil, &response.ErrorResponse{
-			Status:  "error",
-			Message: "Saldo not found for the specified user ID.",
-			Code:    http.StatusNotFound,
-		}
-	}
-	if saldo.TotalBalance < request.WithdrawAmount {
-		s.logger.Error("Insufficient balance for user", zap.String("cardNumber", request.CardNumber), zap.Int("requested", request.WithdrawAmount))
-		return nil, &response.ErrorResponse{
-			Status:  "error",
-			Message: "Insufficient balance for withdrawal.",
-			Code:    http.StatusBadRequest,
-		}
-	}
-	newTotalBalance := saldo.TotalBalance - request.WithdrawAmount
-	updateData := &requests.UpdateSaldoWithdraw{
-		CardNumber:     request.CardNumber,
-		TotalBalance:   newTotalBalance,
-		WithdrawAmount: &request.WithdrawAmount,
-		WithdrawTime:   &request.WithdrawTime,
+	if errResp := checkMinimumAmount(request.WithdrawAmount, card.Currency); errResp != nil {
+		s.logger.Error("Withdraw amount below the currency minimum", zap.Int("withdraw_amount", request.WithdrawAmount), zap.String("currency", card.Currency))
+		return nil, errResp
 	}
-	_, err = s.saldoRepository.UpdateSaldoWithdraw(updateData)
+
+	// The card pays the fee on top of the withdrawn amount.
+	fee, err := quoteFee(s.feeScheduleRepository, &requests.FindApplicableFeeSchedule{
+		TransactionType: requests.FeeTransactionWithdraw,
+		CardType:        card.CardType,
+		Currency:        card.Currency,
+	}, request.WithdrawAmount)
 	if err != nil {
-		s.logger.Error("Failed to update saldo after withdrawal", zap.Error(err))
-		return nil, saldo_errors.ErrFailedUpdateSaldo
+		s.logger.Error("Failed to quote withdraw fee", zap.Error(err))
+		return nil, fee_schedule_errors.ErrFailedQuoteFee
 	}
+
+	request.Fee = fee
+	request.PayoutProvider = s.payoutProvider
+
 	withdrawRecord, err := s.withdrawRepository.CreateWithdraw(request)
 	if err != nil {
 		s.logger.Error("Failed to create withdraw record", zap.Error(err))
-		rollbackData := &requests.UpdateSaldoWithdraw{
+		return nil, withdraw_errors.ErrFailedCreateWithdraw
+	}
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		saldos, err := lockSaldos(repos, request.CardNumber)
+		if err != nil {
+			s.logger.Error("Failed to lock saldo by card number", zap.Error(err))
+			return saldo_errors.ErrFailedSaldoNotFound
+		}
+
+		saldo := saldos[request.CardNumber]
+		if availableBalance(saldo) < request.WithdrawAmount+withdrawRecord.Fee {
+			s.logger.Error("Insufficient balance for user", zap.String("cardNumber", request.CardNumber), zap.Int("requested", request.WithdrawAmount), zap.Int("fee", withdrawRecord.Fee))
+			return &response.ErrorResponse{
+				Status:  "error",
+				Message: "Insufficient balance for withdrawal.",
+				Code:    http.StatusBadRequest,
+			}
+		}
+
+		if err := enforceTransactionLimits(repos, requests.LimitOperationWithdraw, request.CardNumber, request.WithdrawAmount, time.Now()); err != nil {
+			s.logger.Error("withdraw rejected by transaction limits", zap.Error(err), zap.String("card_number", request.CardNumber))
+			return err
+		}
+
+		// Withdraws above the review threshold are not debited yet: their
+		// funds are held until an admin approves or rejects them.
+		if request.WithdrawAmount > s.reviewThreshold {
+			referenceType := requests.SaldoHoldReferenceWithdraw
+
+			if _, err := placeHold(repos, &requests.CreateSaldoHoldRequest{
+				CardNumber:    request.CardNumber,
+				Amount:        request.WithdrawAmount + withdrawRecord.Fee,
+				Reason:        "Withdraw " + withdrawRecord.WithdrawNo + " waiting for review",
+				ReferenceType: &referenceType,
+				ReferenceID:   &withdrawRecord.ID,
+			}); err != nil {
+				s.logger.Error("Failed to hold withdraw funds", zap.Error(err))
+				if errors.Is(err, saldo_errors.ErrInsufficientAvailableSaldo) {
+					return &response.ErrorResponse{
+						Status:  "error",
+						Message: "Insufficient balance for withdrawal.",
+						Code:    http.StatusBadRequest,
+					}
+				}
+				return withdraw_errors.ErrFailedCreateWithdraw
+			}
+
+			withdrawRecord, err = repos.Withdraw.UpdateWithdrawStatus(&requests.UpdateWithdrawStatus{
+				WithdrawID: withdrawRecord.ID,
+				Status:     statemachine.StatusPendingReview,
+			})
+			if err != nil {
+				s.logger.Error("Failed to update withdraw status", zap.Error(err))
+				return withdraw_errors.ErrFailedUpdateWithdraw
+			}
+
+			return nil
+		}
+
+		updateData := &requests.UpdateSaldoWithdraw{
 			CardNumber:     request.CardNumber,
-			TotalBalance:   saldo.TotalBalance,
 			WithdrawAmount: &request.WithdrawAmount,
 			WithdrawTime:   &request.WithdrawTime,
 		}
-		if _, rollbackErr := s.saldoRepository.UpdateSaldoWithdraw(rollbackData); rollbackErr != nil {
-			s.logger.Error("Failed to rollback saldo after withdraw creation failure", zap.Error(rollbackErr))
+		if _, err := repos.Saldo.UpdateSaldoWithdraw(updateData); err != nil {
+			s.logger.Error("Failed to update saldo after withdrawal", zap.Error(err))
+			return saldo_errors.ErrFailedUpdateSaldo
 		}
+
+		if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+			requests.LedgerReferenceWithdraw,
+			withdrawRecord.ID,
+			"Withdraw from card "+request.CardNumber,
+			requests.CardLedgerAccount(request.CardNumber),
+			requests.SystemLedgerAccount(requests.LedgerAccountWithdrawClearing),
+			request.WithdrawAmount,
+			withdrawRecord.Currency,
+		).WithFee(requests.CardLedgerAccount(request.CardNumber), withdrawRecord.Fee, withdrawRecord.Currency)); err != nil {
+			s.logger.Error("Failed to post withdraw journal", zap.Error(err))
+			return ledger_errors.ErrFailedPostLedgerJournal
+		}
+
+		withdrawRecord, err = repos.Withdraw.UpdateWithdrawStatus(&requests.UpdateWithdrawStatus{
+			WithdrawID: withdrawRecord.ID,
+			Status:     "success",
+		})
+		if err != nil {
+			s.logger.Error("Failed to update withdraw status", zap.Error(err))
+			return withdraw_errors.ErrFailedUpdateWithdraw
+		}
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to complete withdraw, transaction rolled back", zap.Error(err))
 		if _, err := s.withdrawRepository.UpdateWithdrawStatus(&requests.UpdateWithdrawStatus{
 			WithdrawID: withdrawRecord.ID,
 			Status:     "failed",
 		}); err != nil {
 			s.logger.Error("Failed to update withdraw status", zap.Error(err))
 		}
-		return nil, withdraw_errors.ErrFailedCreateWithdraw
-	}
-	if _, err := s.withdrawRepository.UpdateWithdrawStatus(&requests.UpdateWithdrawStatus{
-		WithdrawID: withdrawRecord.ID,
-		Status:     "success",
-	}); err != nil {
-		s.logger.Error("Failed to update withdraw status", zap.Error(err))
-		return nil, withdraw_errors.ErrFailedUpdateWithdraw
+		return nil, response.ToErrorResponse(err, withdraw_errors.ErrFailedCreateWithdraw)
 	}
 	so := s.mapping.ToWithdrawResponse(withdrawRecord)
 	s.logger.Debug("Successfully created withdraw", zap.Int("withdraw_id", withdrawRecord.ID))
@@ -514,71 +607,417 @@ func (s *withdrawService) Create(request *requests.CreateWithdrawRequest) (*resp
 
 func (s *withdrawService) Update(request *requests.UpdateWithdrawRequest) (*response.WithdrawResponse, *response.ErrorResponse) {
 	s.logger.Debug("Updating withdraw", zap.Int("withdraw_id", *request.WithdrawID), zap.Any("request", request))
-	_, err := s.withdrawRepository.FindById(*request.WithdrawID)
+	existingWithdraw, err := s.withdrawRepository.FindById(*request.WithdrawID)
 	if err != nil {
 		s.logger.Error("Failed to find withdraw record by ID", zap.Error(err))
 		return nil, withdraw_errors.ErrWithdrawNotFound
 	}
-	saldo, err := s.saldoRepository.FindByCardNumber(request.CardNumber)
-	if err != nil {
-		s.logger.Error("Failed to fetch saldo by user ID", zap.Error(err))
-		return nil, saldo_errors.ErrFailedSaldoNotFound
+
+	// The card was debited when the withdraw was made, so moving the
+	// withdraw to another card would leave its postings on the old one.
+	if request.CardNumber != existingWithdraw.CardNumber {
+		s.logger.Error("Refusing to move withdraw to another card", zap.Int("withdraw_id", existingWithdraw.ID), zap.String("card_number", request.CardNumber))
+		return nil, withdraw_errors.ErrWithdrawCardNotUpdatable
 	}
-	if saldo.TotalBalance < request.WithdrawAmount {
-		s.logger.Error("Insufficient balance for user", zap.String("cardNumber", request.CardNumber))
-		return nil, &response.ErrorResponse{
-			Status:  "error",
-			Message: "Insufficient balance for withdrawal update.",
-			Code:    http.StatusBadRequest,
-		}
+
+	if errResp := checkMinimumAmount(request.WithdrawAmount, existingWithdraw.Currency); errResp != nil {
+		s.logger.Error("Withdraw amount below the currency minimum", zap.Int("withdraw_amount", request.WithdrawAmount), zap.String("currency", existingWithdraw.Currency))
+		return nil, errResp
 	}
-	newTotalBalance := saldo.TotalBalance - request.WithdrawAmount
-	updateSaldoData := &requests.UpdateSaldoWithdraw{
-		CardNumber:     saldo.CardNumber,
-		TotalBalance:   newTotalBalance,
-		WithdrawAmount: &request.WithdrawAmount,
-		WithdrawTime:   &request.WithdrawTime,
+
+	withdrawDifference := request.WithdrawAmount - existingWithdraw.WithdrawAmount
+
+	// The fee is quoted again on the amended amount, and the card pays or
+	// gets back the difference along with the amount.
+	request.Fee = existingWithdraw.Fee
+	if withdrawDifference != 0 {
+		card, err := s.cardRepository.FindCardByCardNumber(existingWithdraw.CardNumber)
+		if err != nil {
+			s.logger.Error("Failed to find card by number", zap.Error(err))
+			return nil, card_errors.ErrCardNotFoundRes
+		}
+
+		request.Fee, err = quoteFee(s.feeScheduleRepository, &requests.FindApplicableFeeSchedule{
+			TransactionType: requests.FeeTransactionWithdraw,
+			CardType:        card.CardType,
+			Currency:        card.Currency,
+		}, request.WithdrawAmount)
+		if err != nil {
+			s.logger.Error("Failed to quote withdraw fee", zap.Error(err))
+			return nil, fee_schedule_errors.ErrFailedQuoteFee
+		}
 	}
-	_, err = s.saldoRepository.UpdateSaldoWithdraw(updateSaldoData)
+
+	feeDifference := request.Fee - existingWithdraw.Fee
+
+	var updatedWithdraw *record.WithdrawRecord
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		saldos, err := lockSaldos(repos, existingWithdraw.CardNumber)
+		if err != nil {
+			s.logger.Error("Failed to lock saldo by card number", zap.Error(err))
+			return saldo_errors.ErrFailedSaldoNotFound
+		}
+
+		saldo := saldos[existingWithdraw.CardNumber]
+		if availableBalance(saldo) < withdrawDifference+feeDifference {
+			s.logger.Error("Insufficient balance for user", zap.String("cardNumber", existingWithdraw.CardNumber))
+			return &response.ErrorResponse{
+				Status:  "error",
+				Message: "Insufficient balance for withdrawal update.",
+				Code:    http.StatusBadRequest,
+			}
+		}
+		if withdrawDifference > 0 {
+			if err := enforceAmendedTransactionLimits(repos, requests.LimitOperationWithdraw, existingWithdraw.CardNumber, request.WithdrawAmount, withdrawDifference, time.Now()); err != nil {
+				s.logger.Error("Withdraw update rejected by transaction limits", zap.Error(err), zap.String("card_number", existingWithdraw.CardNumber))
+				return err
+			}
+		}
+		if withdrawDifference != 0 {
+			if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+				requests.LedgerReferenceWithdraw,
+				*request.WithdrawID,
+				"Withdraw amount adjustment for card "+saldo.CardNumber,
+				requests.CardLedgerAccount(saldo.CardNumber),
+				requests.SystemLedgerAccount(requests.LedgerAccountWithdrawClearing),
+				withdrawDifference,
+				saldo.Currency,
+			).WithFee(requests.CardLedgerAccount(saldo.CardNumber), feeDifference, saldo.Currency)); err != nil {
+				s.logger.Error("Failed to post withdraw adjustment journal", zap.Error(err))
+				return ledger_errors.ErrFailedPostLedgerJournal
+			}
+		}
+		if _, err := repos.Withdraw.UpdateWithdraw(request); err != nil {
+			s.logger.Error("Failed to update withdraw record", zap.Error(err))
+			return withdraw_errors.ErrFailedUpdateWithdraw
+		}
+		updatedWithdraw, err = repos.Withdraw.UpdateWithdrawStatus(&requests.UpdateWithdrawStatus{
+			WithdrawID: *request.WithdrawID,
+			Status:     "success",
+		})
+		if err != nil {
+			s.logger.Error("Failed to update withdraw status", zap.Error(err))
+			if errors.Is(err, withdraw_errors.ErrWithdrawStatusConflict) {
+				return withdraw_errors.ErrWithdrawStatusNotUpdatable
+			}
+			return withdraw_errors.ErrFailedUpdateWithdraw
+		}
+		return nil
+	})
 	if err != nil {
-		s.logger.Error("Failed to update saldo balance", zap.Error(err))
+		s.logger.Error("Failed to complete withdraw update, transaction rolled back", zap.Error(err))
 		if _, err := s.withdrawRepository.UpdateWithdrawStatus(&requests.UpdateWithdrawStatus{
 			WithdrawID: *request.WithdrawID,
 			Status:     "failed",
 		}); err != nil {
 			s.logger.Error("Failed to update withdraw status", zap.Error(err))
 		}
-		return nil, withdraw_errors.ErrFailedUpdateWithdraw
+		return nil, response.ToErrorResponse(err, withdraw_errors.ErrFailedUpdateWithdraw)
 	}
-	updatedWithdraw, err := s.withdrawRepository.UpdateWithdraw(request)
-	if err != nil {
-		s.logger.Error("Failed to update withdraw record", zap.Error(err))
-		rollbackData := &requests.UpdateSaldoBalance{
-			CardNumber:   saldo.CardNumber,
-			TotalBalance: saldo.TotalBalance,
+	so := s.mapping.ToWithdrawResponse(updatedWithdraw)
+	s.logger.Debug("Successfully updated withdraw", zap.Int("withdraw_id", so.ID))
+	return so, nil
+}
+
+func (s *withdrawService) ApproveWithdraw(request *requests.ReviewWithdrawRequest) (*response.WithdrawResponse, *response.ErrorResponse) {
+	s.logger.Debug("Approving withdraw", zap.Int("withdraw_id", request.WithdrawID), zap.Int("reviewed_by", request.ReviewedBy))
+
+	return s.review(request, requests.WithdrawReviewApproved, nil)
+}
+
+// RejectWithdraw ends the review of a withdraw and gives the held funds back
+// to the card.
+func (s *withdrawService) RejectWithdraw(request *requests.ReviewWithdrawRequest) (*response.WithdrawResponse, *response.ErrorResponse) {
+	s.logger.Debug("Rejecting withdraw", zap.Int("withdraw_id", request.WithdrawID), zap.Int("reviewed_by", request.ReviewedBy))
+
+	return s.review(request, requests.WithdrawReviewRejected, func(repos *repository.Repositories) error {
+		hold, err := repos.SaldoHold.FindActiveByReference(requests.SaldoHoldReferenceWithdraw, request.WithdrawID)
+		if err != nil {
+			s.logger.Error("Failed to find withdraw hold", zap.Int("withdraw_id", request.WithdrawID), zap.Error(err))
+			return withdraw_errors.ErrFailedReviewWithdraw
 		}
-		_, rollbackErr := s.saldoRepository.UpdateSaldoBalance(rollbackData)
-		if rollbackErr != nil {
-			s.logger.Error("Failed to rollback saldo after withdraw update failure", zap.Error(rollbackErr))
+
+		if _, err := releaseHold(repos, hold.ID); err != nil {
+			s.logger.Error("Failed to release withdraw hold", zap.Int("hold_id", hold.ID), zap.Error(err))
+			return withdraw_errors.ErrFailedReviewWithdraw
 		}
-		if _, err := s.withdrawRepository.UpdateWithdrawStatus(&requests.UpdateWithdrawStatus{
-			WithdrawID: *request.WithdrawID,
-			Status:     "failed",
-		}); err != nil {
+
+		return nil
+	})
+}
+
+// review records the decision on a withdraw waiting for review and moves it
+// to the matching status. settle runs in the same unit of work for decisions
+// that also touch the held funds.
+func (s *withdrawService) review(request *requests.ReviewWithdrawRequest, decision string, settle func(*repository.Repositories) error) (*response.WithdrawResponse, *response.ErrorResponse) {
+	withdraw, err := s.withdrawRepository.FindById(request.WithdrawID)
+	if err != nil {
+		s.logger.Error("Failed to find withdraw record by ID", zap.Error(err))
+		return nil, withdraw_errors.ErrWithdrawNotFound
+	}
+
+	if withdraw.Status != statemachine.StatusPendingReview {
+		s.logger.Error("Withdraw is not waiting for review", zap.Int("withdraw_id", withdraw.ID), zap.String("status", withdraw.Status))
+		return nil, withdraw_errors.ErrWithdrawNotReviewable
+	}
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		var err error
+
+		withdraw, err = repos.Withdraw.UpdateWithdrawStatus(&requests.UpdateWithdrawStatus{
+			WithdrawID: request.WithdrawID,
+			Status:     decision,
+		})
+		if err != nil {
 			s.logger.Error("Failed to update withdraw status", zap.Error(err))
+			if errors.Is(err, withdraw_errors.ErrWithdrawStatusConflict) {
+				return withdraw_errors.ErrWithdrawNotReviewable
+			}
+			return withdraw_errors.ErrFailedReviewWithdraw
+		}
+
+		if settle != nil {
+			if err := settle(repos); err != nil {
+				return err
+			}
+		}
+
+		if _, err := repos.Withdraw.CreateReview(request, decision); err != nil {
+			s.logger.Error("Failed to record withdraw review", zap.Error(err))
+			return withdraw_errors.ErrFailedReviewWithdraw
 		}
-		return nil, withdraw_errors.ErrFailedUpdateWithdraw
+
+		return nil
+	})
+	if err != nil {
+		s.logger.Error("Failed to review withdraw, transaction rolled back", zap.Error(err))
+		return nil, response.ToErrorResponse(err, withdraw_errors.ErrFailedReviewWithdraw)
+	}
+
+	so := s.mapping.ToWithdrawResponse(withdraw)
+	s.logger.Debug("Successfully reviewed withdraw", zap.Int("withdraw_id", withdraw.ID), zap.String("decision", decision))
+	return so, nil
+}
+
+func (s *withdrawService) FindReview(withdraw_id int) (*response.WithdrawReviewResponse, *response.ErrorResponse) {
+	s.logger.Debug("Fetching withdraw review", zap.Int("withdraw_id", withdraw_id))
+
+	review, err := s.withdrawRepository.FindReview(withdraw_id)
+	if err != nil {
+		s.logger.Error("Failed to find withdraw review", zap.Int("withdraw_id", withdraw_id), zap.Error(err))
+		return nil, withdraw_errors.ErrWithdrawReviewNotFound
+	}
+
+	return s.mapping.ToWithdrawReviewResponse(review), nil
+}
+
+// SettleApproved pays out approved withdraws and returns how many settled.
+//
+// A withdraw is first claimed by moving it to processing, so two settlement
+// jobs cannot pay it twice. The held funds are then debited and the withdraw
+// marked settled in one unit of work; a withdraw whose settlement fails stays
+// in processing and is retried by the next call.
+func (s *withdrawService) SettleApproved() (int, *response.ErrorResponse) {
+	withdraws, err := s.withdrawRepository.FindSettleable(withdrawSettlementBatchSize)
+	if err != nil {
+		s.logger.Error("Failed to find settleable withdraws", zap.Error(err))
+		return 0, withdraw_errors.ErrFailedSettleWithdraws
+	}
+
+	settled := 0
+
+	for _, withdraw := range withdraws {
+		if withdraw.Status == statemachine.StatusApproved {
+			if _, err := s.withdrawRepository.UpdateWithdrawStatus(&requests.UpdateWithdrawStatus{
+				WithdrawID: withdraw.ID,
+				Status:     statemachine.StatusProcessing,
+			}); err != nil {
+				if !errors.Is(err, withdraw_errors.ErrWithdrawStatusConflict) {
+					s.logger.Error("Failed to claim withdraw for settlement", zap.Int("withdraw_id", withdraw.ID), zap.Error(err))
+				}
+				continue
+			}
+		}
+
+		if err := s.settle(withdraw); err != nil {
+			s.logger.Error("Failed to settle withdraw", zap.Int("withdraw_id", withdraw.ID), zap.Error(err))
+			continue
+		}
+
+		settled++
 	}
-	if _, err := s.withdrawRepository.UpdateWithdrawStatus(&requests.UpdateWithdrawStatus{
-		WithdrawID: updatedWithdraw.ID,
-		Status:     "success",
+
+	return settled, nil
+}
+
+func (s *withdrawService) settle(withdraw *record.WithdrawRecord) error {
+	return s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		return settleWithdraw(repos, withdraw)
+	})
+}
+
+// settleWithdraw debits the held funds of a withdraw in processing and marks
+// it settled.
+func settleWithdraw(repos *repository.Repositories, withdraw *record.WithdrawRecord) error {
+	if _, err := lockSaldos(repos, withdraw.CardNumber); err != nil {
+		return err
+	}
+
+	hold, err := repos.SaldoHold.FindActiveByReference(requests.SaldoHoldReferenceWithdraw, withdraw.ID)
+	if err != nil {
+		return err
+	}
+
+	if _, err := consumeHold(repos, hold.ID); err != nil {
+		return err
+	}
+
+	settledAt := time.Now()
+
+	if _, err := repos.Saldo.UpdateSaldoWithdraw(&requests.UpdateSaldoWithdraw{
+		CardNumber:     withdraw.CardNumber,
+		WithdrawAmount: &withdraw.WithdrawAmount,
+		WithdrawTime:   &settledAt,
 	}); err != nil {
-		s.logger.Error("Failed to update withdraw status", zap.Error(err))
-		return nil, withdraw_errors.ErrFailedUpdateWithdraw
+		return err
 	}
-	so := s.mapping.ToWithdrawResponse(updatedWithdraw)
-	s.logger.Debug("Successfully updated withdraw", zap.Int("withdraw_id", so.ID))
-	return so, nil
+
+	if _, err := repos.Ledger.PostJournal(requests.NewLedgerJournal(
+		requests.LedgerReferenceWithdraw,
+		withdraw.ID,
+		"Withdraw from card "+withdraw.CardNumber,
+		requests.CardLedgerAccount(withdraw.CardNumber),
+		requests.SystemLedgerAccount(requests.LedgerAccountWithdrawClearing),
+		withdraw.WithdrawAmount,
+		withdraw.Currency,
+	).WithFee(requests.CardLedgerAccount(withdraw.CardNumber), withdraw.Fee, withdraw.Currency)); err != nil {
+		return err
+	}
+
+	_, err = repos.Withdraw.UpdateWithdrawStatus(&requests.UpdateWithdrawStatus{
+		WithdrawID: withdraw.ID,
+		Status:     statemachine.StatusSettled,
+	})
+	return err
+}
+
+// HandlePayoutCallback applies the outcome of a payout reported by the bank
+// that paid a withdraw out. A successful payout settles an approved withdraw
+// the settlement job has not settled yet; a failed one gives the held funds
+// back to the card. A callback repeating the outcome already recorded is
+// accepted, so banks may deliver it more than once.
+func (s *withdrawService) HandlePayoutCallback(request *requests.WithdrawPayoutCallback) (*response.WithdrawResponse, *response.ErrorResponse) {
+	s.logger.Debug("Handling withdraw payout callback", zap.String("provider", request.Provider), zap.String("withdraw_no", request.WithdrawNo), zap.String("status", request.Status))
+
+	withdraw, err := s.withdrawRepository.FindByWithdrawNo(request.WithdrawNo)
+	if err != nil {
+		s.logger.Error("Failed to find withdraw by number", zap.String("withdraw_no", request.WithdrawNo), zap.Error(err))
+		return nil, withdraw_errors.ErrWithdrawNotFound
+	}
+
+	// A provider can only report the payouts it was asked to make.
+	if withdraw.PayoutProvider != request.Provider {
+		s.logger.Error("Payout callback from another provider",
+			zap.Int("withdraw_id", withdraw.ID),
+			zap.String("payout_provider", withdraw.PayoutProvider),
+			zap.String("provider", request.Provider),
+		)
+		return nil, withdraw_errors.ErrWithdrawNotFound
+	}
+
+	// Deliveries of the same callback may arrive together. The withdraw is
+	// locked, after its saldo like everywhere else, before its status is
+	// checked, so the later delivery sees the outcome the first one recorded.
+	var updated *record.WithdrawRecord
+
+	err = s.unitOfWork.WithinTransaction(func(repos *repository.Repositories) error {
+		if _, err := lockSaldos(repos, withdraw.CardNumber); err != nil {
+			return err
+		}
+
+		locked, err := repos.Withdraw.FindByWithdrawNoForUpdate(request.WithdrawNo)
+		if err != nil {
+			return err
+		}
+
+		if payoutAlreadyRecorded(locked.Status, request.Status) {
+			updated = locked
+			return nil
+		}
+
+		if locked.Status != statemachine.StatusApproved && locked.Status != statemachine.StatusProcessing {
+			s.logger.Error("Payout callback contradicts withdraw status",
+				zap.Int("withdraw_id", locked.ID),
+				zap.String("status", locked.Status),
+				zap.String("callback_status", request.Status),
+			)
+			return withdraw_errors.ErrWithdrawPayoutConflict
+		}
+
+		if locked.Status == statemachine.StatusApproved {
+			if _, err := repos.Withdraw.UpdateWithdrawStatus(&requests.UpdateWithdrawStatus{
+				WithdrawID: locked.ID,
+				Status:     statemachine.StatusProcessing,
+			}); err != nil {
+				return err
+			}
+		}
+
+		if request.Status == statemachine.StatusSuccess {
+			err = settleWithdraw(repos, locked)
+		} else {
+			err = failPayout(repos, locked)
+		}
+		if err != nil {
+			return err
+		}
+
+		updated, err = repos.Withdraw.FindById(locked.ID)
+		return err
+	})
+	if err != nil {
+		s.logger.Error("Failed to apply withdraw payout", zap.Int("withdraw_id", withdraw.ID), zap.Error(err))
+		return nil, response.ToErrorResponse(err, withdraw_errors.ErrFailedApplyWithdrawPayout)
+	}
+
+	s.logger.Debug("Applied withdraw payout", zap.Int("withdraw_id", updated.ID), zap.String("status", updated.Status))
+	return s.mapping.ToWithdrawResponse(updated), nil
+}
+
+// failPayout marks a withdraw in processing failed and releases its hold. The
+// saldo is locked first so it cannot interleave with a settlement of the same
+// withdraw.
+func failPayout(repos *repository.Repositories, withdraw *record.WithdrawRecord) error {
+	if _, err := lockSaldos(repos, withdraw.CardNumber); err != nil {
+		return err
+	}
+
+	if _, err := repos.Withdraw.UpdateWithdrawStatus(&requests.UpdateWithdrawStatus{
+		WithdrawID: withdraw.ID,
+		Status:     statemachine.StatusFailed,
+	}); err != nil {
+		return err
+	}
+
+	hold, err := repos.SaldoHold.FindActiveByReference(requests.SaldoHoldReferenceWithdraw, withdraw.ID)
+	if err != nil {
+		return err
+	}
+
+	_, err = releaseHold(repos, hold.ID)
+	return err
+}
+
+// payoutAlreadyRecorded reports whether a withdraw already has the outcome a
+// payout callback reports. Withdraws below the review threshold are paid out
+// when they are created and end in success rather than settled.
+func payoutAlreadyRecorded(withdrawStatus, payoutStatus string) bool {
+	if payoutStatus == statemachine.StatusSuccess {
+		return withdrawStatus == statemachine.StatusSettled || withdrawStatus == statemachine.StatusSuccess
+	}
+
+	return withdrawStatus == statemachine.StatusFailed
 }
 
 func (s *withdrawService) TrashedWithdraw(withdraw_id int) (*response.WithdrawResponseDeleteAt, *response.ErrorResponse) {
@@ -2,9 +2,14 @@ package apikey
 
 import (
 	"crypto/rand"
+	"crypto/sha256"
 	"encoding/hex"
 )
 
+// PrefixLength is how many characters of a key are stored in the clear so a
+// merchant can tell its keys apart.
+const PrefixLength = 8
+
 func GenerateApiKey() string {
 	key := make([]byte, 32)
 	_, err := rand.Read(key)
@@ -13,3 +18,24 @@ func GenerateApiKey() string {
 	}
 	return hex.EncodeToString(key)
 }
+
+// GenerateSigningSecret returns a new secret for signing the requests made
+// with a key.
+func GenerateSigningSecret() string {
+	return "sigsec_" + GenerateApiKey()
+}
+
+// Hash returns the SHA-256 hash of a key as hex. Keys are random, so a
+// plain hash is enough to look them up without storing them.
+func Hash(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// Prefix returns the first PrefixLength characters of a key.
+func Prefix(key string) string {
+	if len(key) < PrefixLength {
+		return key
+	}
+	return key[:PrefixLength]
+}
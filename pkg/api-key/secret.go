@@ -0,0 +1,85 @@
+package apikey
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"strings"
+)
+
+// sealedPrefix marks a signing secret encrypted by a SecretSealer, so
+// secrets stored before encryption was added can be told apart.
+const sealedPrefix = "sealed:v1:"
+
+var (
+	ErrInvalidSealingKey = errors.New("signing secret key must be 32 bytes, base64 encoded")
+	ErrSecretNotSealed   = errors.New("signing secret is not sealed")
+	ErrOpenSecret        = errors.New("signing secret cannot be decrypted with this key")
+)
+
+// SecretSealer encrypts signing secrets with AES-256-GCM before they are
+// stored. Unlike keys, secrets cannot be hashed: verifying a signature needs
+// the secret itself.
+type SecretSealer struct {
+	aead cipher.AEAD
+}
+
+// NewSecretSealer returns a sealer for a base64 encoded 32 byte key.
+func NewSecretSealer(key string) (*SecretSealer, error) {
+	raw, err := base64.StdEncoding.DecodeString(key)
+	if err != nil || len(raw) != 32 {
+		return nil, ErrInvalidSealingKey
+	}
+
+	block, err := aes.NewCipher(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SecretSealer{aead: aead}, nil
+}
+
+// Seal encrypts secret under a random nonce.
+func (s *SecretSealer) Seal(secret string) (string, error) {
+	nonce := make([]byte, s.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+
+	sealed := s.aead.Seal(nonce, nonce, []byte(secret), nil)
+
+	return sealedPrefix + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// Open decrypts a secret returned by Seal.
+func (s *SecretSealer) Open(sealed string) (string, error) {
+	if !IsSealed(sealed) {
+		return "", ErrSecretNotSealed
+	}
+
+	raw, err := base64.StdEncoding.DecodeString(strings.TrimPrefix(sealed, sealedPrefix))
+	if err != nil || len(raw) < s.aead.NonceSize() {
+		return "", ErrOpenSecret
+	}
+
+	nonce, ciphertext := raw[:s.aead.NonceSize()], raw[s.aead.NonceSize():]
+
+	secret, err := s.aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return "", ErrOpenSecret
+	}
+
+	return string(secret), nil
+}
+
+// IsSealed reports whether a stored secret was encrypted by a SecretSealer.
+func IsSealed(value string) bool {
+	return strings.HasPrefix(value, sealedPrefix)
+}
@@ -0,0 +1,93 @@
+package apikey
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Headers a signed merchant request is sent with. The request is signed
+// with the signing secret of the key named by HeaderKeyID, so the key
+// itself never has to be sent.
+const (
+	HeaderKeyID     = "X-Api-Key-Id"
+	HeaderTimestamp = "X-Signature-Timestamp"
+	HeaderNonce     = "X-Signature-Nonce"
+	HeaderSignature = "X-Signature"
+)
+
+var (
+	ErrMissingSignatureHeaders  = errors.New("api key id, timestamp, nonce or signature missing")
+	ErrInvalidSignatureTime     = errors.New("invalid signature timestamp")
+	ErrSignatureTimeOutOfBounds = errors.New("signature timestamp outside the allowed clock skew")
+	ErrInvalidNonce             = errors.New("nonce must be 16 to 64 letters, digits, '-' or '_'")
+	ErrInvalidRequestSignature  = errors.New("invalid request signature")
+)
+
+var nonceFormat = regexp.MustCompile(`^[A-Za-z0-9_-]{16,64}$`)
+
+// SignRequest returns the hex encoded HMAC-SHA256 of a request under secret.
+// What is signed is the method, the path including any query string, the
+// unix timestamp, the nonce and the hex SHA-256 of the body, each on its own
+// line:
+//
+//	POST
+//	/query
+//	1737795600
+//	3f1c9a0e6b2d4f8a
+//	9f86d081884c7d659a2feaa0c55ad015a3bf4f1b2b0b822cd15d6c15b0f00a08
+func SignRequest(secret, method, path string, timestamp time.Time, nonce string, body []byte) string {
+	return signRequest(secret, method, path, strconv.FormatInt(timestamp.Unix(), 10), nonce, body)
+}
+
+// VerifyRequest checks the signature of a request and that it was signed no
+// more than tolerance away from now. Whether the nonce was used before is up
+// to the caller.
+func VerifyRequest(secret, method, path, timestamp, nonce, signature string, body []byte, tolerance time.Duration, now time.Time) error {
+	if timestamp == "" || nonce == "" || signature == "" {
+		return ErrMissingSignatureHeaders
+	}
+
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return ErrInvalidSignatureTime
+	}
+
+	if diff := now.Sub(time.Unix(seconds, 0)); diff > tolerance || diff < -tolerance {
+		return ErrSignatureTimeOutOfBounds
+	}
+
+	if !nonceFormat.MatchString(nonce) {
+		return ErrInvalidNonce
+	}
+
+	expected := signRequest(secret, method, path, timestamp, nonce, body)
+
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(signature))) {
+		return ErrInvalidRequestSignature
+	}
+
+	return nil
+}
+
+func signRequest(secret, method, path, timestamp, nonce string, body []byte) string {
+	bodyHash := sha256.Sum256(body)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strings.ToUpper(method)))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(path))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(hex.EncodeToString(bodyHash[:])))
+
+	return hex.EncodeToString(mac.Sum(nil))
+}
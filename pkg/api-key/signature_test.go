@@ -0,0 +1,71 @@
+package apikey
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerifyRequest(t *testing.T) {
+	const (
+		secret    = "sk_sign_test"
+		nonce     = "3f1c9a0e6b2d4f8a"
+		tolerance = 5 * time.Minute
+	)
+
+	body := []byte(`{"query":"mutation { createTransaction }"}`)
+	signedAt := time.Unix(1737795600, 0)
+	timestamp := strconv.FormatInt(signedAt.Unix(), 10)
+	signature := SignRequest(secret, "POST", "/query", signedAt, nonce, body)
+
+	// The signature with its first hex digit changed.
+	tampered := "f" + signature[1:]
+	if signature[0] == 'f' {
+		tampered = "0" + signature[1:]
+	}
+
+	tests := []struct {
+		name      string
+		secret    string
+		method    string
+		path      string
+		timestamp string
+		nonce     string
+		signature string
+		body      []byte
+		now       time.Time
+		want      error
+	}{
+		{"valid", secret, "POST", "/query", timestamp, nonce, signature, body, signedAt.Add(time.Second), nil},
+		{"lower case method", secret, "post", "/query", timestamp, nonce, signature, body, signedAt, nil},
+		{"upper case signature", secret, "POST", "/query", timestamp, nonce, strings.ToUpper(signature), body, signedAt, nil},
+		{"at the end of the skew", secret, "POST", "/query", timestamp, nonce, signature, body, signedAt.Add(tolerance), nil},
+		{"clock slightly behind", secret, "POST", "/query", timestamp, nonce, signature, body, signedAt.Add(-tolerance), nil},
+		{"expired", secret, "POST", "/query", timestamp, nonce, signature, body, signedAt.Add(tolerance + time.Second), ErrSignatureTimeOutOfBounds},
+		{"from the future", secret, "POST", "/query", timestamp, nonce, signature, body, signedAt.Add(-tolerance - time.Second), ErrSignatureTimeOutOfBounds},
+		{"missing timestamp", secret, "POST", "/query", "", nonce, signature, body, signedAt, ErrMissingSignatureHeaders},
+		{"missing nonce", secret, "POST", "/query", timestamp, "", signature, body, signedAt, ErrMissingSignatureHeaders},
+		{"missing signature", secret, "POST", "/query", timestamp, nonce, "", body, signedAt, ErrMissingSignatureHeaders},
+		{"malformed timestamp", secret, "POST", "/query", "1737795600.5", nonce, signature, body, signedAt, ErrInvalidSignatureTime},
+		{"nonce too short", secret, "POST", "/query", timestamp, "3f1c9a0e", signature, body, signedAt, ErrInvalidNonce},
+		{"nonce with invalid characters", secret, "POST", "/query", timestamp, "3f1c9a0e 6b2d4f8a", signature, body, signedAt, ErrInvalidNonce},
+		{"tampered body", secret, "POST", "/query", timestamp, nonce, signature, []byte(`{"query":"mutation { createWithdraw }"}`), signedAt, ErrInvalidRequestSignature},
+		{"tampered path", secret, "POST", "/query?debug=1", timestamp, nonce, signature, body, signedAt, ErrInvalidRequestSignature},
+		{"tampered method", secret, "GET", "/query", timestamp, nonce, signature, body, signedAt, ErrInvalidRequestSignature},
+		{"replayed with a fresh nonce", secret, "POST", "/query", timestamp, "9b8c7d6e5f4a3b2c", signature, body, signedAt, ErrInvalidRequestSignature},
+		{"replayed with a fresh timestamp", secret, "POST", "/query", strconv.FormatInt(signedAt.Unix()+60, 10), nonce, signature, body, signedAt.Add(time.Minute), ErrInvalidRequestSignature},
+		{"tampered signature", secret, "POST", "/query", timestamp, nonce, tampered, body, signedAt, ErrInvalidRequestSignature},
+		{"wrong secret", "sk_sign_other", "POST", "/query", timestamp, nonce, signature, body, signedAt, ErrInvalidRequestSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := VerifyRequest(tt.secret, tt.method, tt.path, tt.timestamp, tt.nonce, tt.signature, tt.body, tolerance, tt.now)
+			if !errors.Is(err, tt.want) {
+				t.Errorf("VerifyRequest error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
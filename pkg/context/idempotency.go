@@ -0,0 +1,14 @@
+package mycontext
+
+import "context"
+
+const IdempotencyKeyContextKey contextKey = "idempotencyKey"
+
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, IdempotencyKeyContextKey, key)
+}
+
+func IdempotencyKeyFromContext(ctx context.Context) (string, bool) {
+	key, ok := ctx.Value(IdempotencyKeyContextKey).(string)
+	return key, ok && key != ""
+}
@@ -0,0 +1,16 @@
+package mycontext
+
+import "context"
+
+const MerchantApiKeyIDContextKey contextKey = "merchantApiKeyID"
+
+// WithMerchantApiKeyID marks a request as signed with the signing secret of
+// the given API key. Only the signature middleware sets it.
+func WithMerchantApiKeyID(ctx context.Context, apiKeyID int) context.Context {
+	return context.WithValue(ctx, MerchantApiKeyIDContextKey, apiKeyID)
+}
+
+func MerchantApiKeyIDFromContext(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(MerchantApiKeyIDContextKey).(int)
+	return id, ok && id > 0
+}
@@ -0,0 +1,173 @@
+package currency
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	IDR = "IDR"
+	USD = "USD"
+	EUR = "EUR"
+	SGD = "SGD"
+	MYR = "MYR"
+
+	// Default is the currency of cards issued without an explicit one, and
+	// of every amount recorded before currencies were introduced.
+	Default = IDR
+)
+
+// exponents holds the number of minor-unit digits per supported currency.
+// Amounts are always stored as integers in the minor unit.
+var exponents = map[string]int{
+	IDR: 0,
+	USD: 2,
+	EUR: 2,
+	SGD: 2,
+	MYR: 2,
+}
+
+// minimumAmounts holds the smallest amount, in minor units, a payment,
+// transfer or withdraw may move in each supported currency. They are set
+// around Rp.50000 so the same floor applies whatever the card currency.
+var minimumAmounts = map[string]int{
+	IDR: 50000,
+	USD: 300,
+	EUR: 300,
+	SGD: 400,
+	MYR: 1400,
+}
+
+var symbols = map[string]string{
+	IDR: "Rp.",
+	USD: "$",
+	EUR: "€",
+	SGD: "S$",
+	MYR: "RM",
+}
+
+// numericCodes holds the ISO 4217 numeric code of every supported currency,
+// which is how QR payment payloads name the currency.
+var numericCodes = map[string]string{
+	IDR: "360",
+	USD: "840",
+	EUR: "978",
+	SGD: "702",
+	MYR: "458",
+}
+
+var (
+	ErrUnsupportedCurrency = errors.New("currency is not supported")
+	ErrInvalidRate         = errors.New("exchange rate must be positive")
+	ErrInvalidSpread       = errors.New("spread must be between 0 and 9999 basis points")
+)
+
+func IsSupported(code string) bool {
+	_, ok := exponents[code]
+	return ok
+}
+
+// Exponent returns the number of minor-unit digits of the currency, or 0 when
+// it is not supported.
+func Exponent(code string) int {
+	return exponents[code]
+}
+
+// MinimumAmount returns the smallest amount in minor units accepted in the
+// currency, or 0 when it is not supported.
+func MinimumAmount(code string) int {
+	return minimumAmounts[code]
+}
+
+// NumericCode returns the ISO 4217 numeric code of the currency, or "" when
+// it is not supported.
+func NumericCode(code string) string {
+	return numericCodes[code]
+}
+
+// FromNumericCode returns the supported currency with the given ISO 4217
+// numeric code.
+func FromNumericCode(numeric string) (string, bool) {
+	for code, n := range numericCodes {
+		if n == numeric {
+			return code, true
+		}
+	}
+
+	return "", false
+}
+
+// Format renders an amount in minor units with the currency symbol, e.g.
+// "Rp.15000" or "$12.50".
+func Format(amount int, code string) string {
+	symbol, ok := symbols[code]
+	if !ok {
+		symbol = code + " "
+	}
+
+	exp := exponents[code]
+	if exp == 0 {
+		return fmt.Sprintf("%s%d", symbol, amount)
+	}
+
+	sign := ""
+	if amount < 0 {
+		sign = "-"
+		amount = -amount
+	}
+
+	unit := pow10(exp).Int64()
+
+	return fmt.Sprintf("%s%s%d.%0*d", sign, symbol, int64(amount)/unit, exp, int64(amount)%unit)
+}
+
+// Convert converts an amount in minor units of from into minor units of to.
+// rate is the number of to units per from unit in major units, and the spread
+// in basis points is taken off the result. The result is rounded down so the
+// platform never credits more than it debits.
+func Convert(amount int, from, to string, rate float64, spreadBps int) (int, error) {
+	if !IsSupported(from) || !IsSupported(to) {
+		return 0, ErrUnsupportedCurrency
+	}
+
+	if rate <= 0 {
+		return 0, ErrInvalidRate
+	}
+
+	if spreadBps < 0 || spreadBps >= 10000 {
+		return 0, ErrInvalidSpread
+	}
+
+	// The shortest decimal form of the rate is what was stored, so converting
+	// it exactly avoids binary floating point drift in the multiplication.
+	r, ok := new(big.Rat).SetString(strconv.FormatFloat(rate, 'f', -1, 64))
+	if !ok {
+		return 0, ErrInvalidRate
+	}
+
+	result := new(big.Rat).SetInt64(int64(amount))
+	result.Mul(result, r)
+	result.Mul(result, new(big.Rat).SetFrac64(int64(10000-spreadBps), 10000))
+	result.Mul(result, new(big.Rat).SetInt(pow10(exponents[to])))
+	result.Quo(result, new(big.Rat).SetInt(pow10(exponents[from])))
+
+	converted := new(big.Int).Quo(result.Num(), result.Denom())
+
+	if !converted.IsInt64() {
+		return 0, ErrInvalidRate
+	}
+
+	return int(converted.Int64()), nil
+}
+
+// Normalize upper-cases and trims a currency code received from a client.
+func Normalize(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
+
+func pow10(exp int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exp)), nil)
+}
@@ -0,0 +1,90 @@
+package currency
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestConvert(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    int
+		from, to  string
+		rate      float64
+		spreadBps int
+		want      int
+	}{
+		{"same currency", 100000, IDR, IDR, 1, 0, 100000},
+		{"zero amount", 0, IDR, USD, 0.0000625, 0, 0},
+		{"to a currency with cents", 160000, IDR, USD, 0.0000625, 0, 1000},
+		{"from a currency with cents", 1050, USD, IDR, 16000, 0, 168000},
+		{"between currencies with cents", 1000, EUR, USD, 1.1, 0, 1100},
+		{"rounds down", 160001, IDR, USD, 0.0000625, 0, 1000},
+		{"rounds down below one cent", 159, IDR, USD, 0.0000625, 0, 0},
+		{"rate without float drift", 100, USD, EUR, 1.15, 0, 115},
+		{"spread taken off", 1050, USD, IDR, 16000, 100, 166320},
+		{"spread rounds down", 1001, EUR, USD, 1, 50, 995},
+		{"largest spread", 10000, USD, EUR, 1, 9999, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Convert(tt.amount, tt.from, tt.to, tt.rate, tt.spreadBps)
+			if err != nil {
+				t.Fatalf("Convert: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("Convert(%d %s to %s at %v, %d bps) = %d, want %d", tt.amount, tt.from, tt.to, tt.rate, tt.spreadBps, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertInvalid(t *testing.T) {
+	tests := []struct {
+		name      string
+		amount    int
+		from, to  string
+		rate      float64
+		spreadBps int
+		want      error
+	}{
+		{"unsupported source", 1000, "JPY", IDR, 100, 0, ErrUnsupportedCurrency},
+		{"unsupported target", 1000, IDR, "usd", 1, 0, ErrUnsupportedCurrency},
+		{"zero rate", 1000, IDR, USD, 0, 0, ErrInvalidRate},
+		{"negative rate", 1000, IDR, USD, -1, 0, ErrInvalidRate},
+		{"infinite rate", 1000, IDR, USD, math.Inf(1), 0, ErrInvalidRate},
+		{"negative spread", 1000, IDR, USD, 1, -1, ErrInvalidSpread},
+		{"whole amount as spread", 1000, IDR, USD, 1, 10000, ErrInvalidSpread},
+		{"result overflows", math.MaxInt64 / 10, IDR, USD, 1000, 0, ErrInvalidRate},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Convert(tt.amount, tt.from, tt.to, tt.rate, tt.spreadBps); !errors.Is(err, tt.want) {
+				t.Errorf("Convert error = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestMinimumAmount(t *testing.T) {
+	tests := []struct {
+		code string
+		want int
+	}{
+		{IDR, 50000},
+		{USD, 300},
+		{EUR, 300},
+		{SGD, 400},
+		{MYR, 1400},
+		{"JPY", 0},
+	}
+
+	for _, tt := range tests {
+		if got := MinimumAmount(tt.code); got != tt.want {
+			t.Errorf("MinimumAmount(%s) = %d, want %d", tt.code, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "ledger_journals" (
+    "journal_id" SERIAL PRIMARY KEY,
+    "journal_no" UUID NOT NULL DEFAULT gen_random_uuid (),
+    "reference_type" VARCHAR(30) NOT NULL,
+    "reference_id" INT,
+    "description" TEXT NOT NULL DEFAULT '',
+    "posted_at" TIMESTAMP NOT NULL DEFAULT current_timestamp,
+    "created_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE TABLE "ledger_postings" (
+    "posting_id" SERIAL PRIMARY KEY,
+    "journal_id" INT NOT NULL REFERENCES "ledger_journals" ("journal_id"),
+    "account_code" VARCHAR(50) NOT NULL,
+    "card_number" VARCHAR(16) REFERENCES "cards" ("card_number"),
+    "direction" VARCHAR(6) NOT NULL CHECK ("direction" IN ('debit', 'credit')),
+    "amount" INT NOT NULL CHECK ("amount" > 0),
+    "created_at" timestamp DEFAULT current_timestamp,
+    CHECK (("account_code" = 'CARD') = ("card_number" IS NOT NULL))
+);
+
+CREATE UNIQUE INDEX idx_ledger_journals_journal_no ON ledger_journals (journal_no);
+
+CREATE INDEX idx_ledger_journals_reference ON ledger_journals (reference_type, reference_id);
+
+CREATE INDEX idx_ledger_journals_posted_at ON ledger_journals (posted_at);
+
+CREATE INDEX idx_ledger_postings_journal_id ON ledger_postings (journal_id);
+
+CREATE INDEX idx_ledger_postings_card_number ON ledger_postings (card_number);
+
+CREATE INDEX idx_ledger_postings_account_code ON ledger_postings (account_code);
+
+WITH opening AS (
+    INSERT INTO
+        ledger_journals (
+            reference_type,
+            reference_id,
+            description
+        )
+    SELECT 'opening_balance', saldo_id, 'Opening balance for card ' || card_number
+    FROM saldos
+    WHERE total_balance <> 0
+    RETURNING journal_id, reference_id
+)
+INSERT INTO
+    ledger_postings (
+        journal_id,
+        account_code,
+        card_number,
+        direction,
+        amount
+    )
+SELECT o.journal_id, 'CARD', s.card_number, CASE WHEN s.total_balance > 0 THEN 'credit' ELSE 'debit' END, ABS(s.total_balance)
+FROM opening o
+    JOIN saldos s ON s.saldo_id = o.reference_id
+UNION ALL
+SELECT o.journal_id, 'OPENING_BALANCE', NULL, CASE WHEN s.total_balance > 0 THEN 'debit' ELSE 'credit' END, ABS(s.total_balance)
+FROM opening o
+    JOIN saldos s ON s.saldo_id = o.reference_id;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_ledger_postings_account_code;
+
+DROP INDEX IF EXISTS idx_ledger_postings_card_number;
+
+DROP INDEX IF EXISTS idx_ledger_postings_journal_id;
+
+DROP INDEX IF EXISTS idx_ledger_journals_posted_at;
+
+DROP INDEX IF EXISTS idx_ledger_journals_reference;
+
+DROP INDEX IF EXISTS idx_ledger_journals_journal_no;
+
+DROP TABLE IF EXISTS "ledger_postings";
+
+DROP TABLE IF EXISTS "ledger_journals";
+
+-- +goose StatementEnd
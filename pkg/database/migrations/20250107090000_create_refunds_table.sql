@@ -0,0 +1,47 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "transactions"
+ADD COLUMN "refunded_amount" INT NOT NULL DEFAULT 0;
+
+ALTER TABLE "transactions"
+ADD CONSTRAINT chk_transactions_refunded_amount CHECK (
+    refunded_amount >= 0
+    AND refunded_amount <= amount
+);
+
+CREATE TABLE "refunds" (
+    "refund_id" SERIAL PRIMARY KEY,
+    "refund_no" UUID NOT NULL DEFAULT gen_random_uuid (),
+    "transaction_id" INT NOT NULL REFERENCES "transactions" ("transaction_id") ON DELETE CASCADE,
+    "card_number" VARCHAR(16) NOT NULL REFERENCES "cards" ("card_number"),
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id"),
+    "amount" INT NOT NULL CHECK (amount > 0),
+    "reason" TEXT NOT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'success',
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_refunds_transaction_id ON refunds (transaction_id);
+
+CREATE INDEX idx_refunds_merchant_id ON refunds (merchant_id);
+
+CREATE INDEX idx_refunds_card_number ON refunds (card_number);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_refunds_transaction_id;
+
+DROP INDEX IF EXISTS idx_refunds_merchant_id;
+
+DROP INDEX IF EXISTS idx_refunds_card_number;
+
+DROP TABLE IF EXISTS "refunds";
+
+ALTER TABLE "transactions"
+DROP CONSTRAINT IF EXISTS chk_transactions_refunded_amount;
+
+ALTER TABLE "transactions" DROP COLUMN IF EXISTS "refunded_amount";
+
+-- +goose StatementEnd
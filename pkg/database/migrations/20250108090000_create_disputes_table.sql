@@ -0,0 +1,52 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "disputes" (
+    "dispute_id" SERIAL PRIMARY KEY,
+    "dispute_no" UUID NOT NULL DEFAULT gen_random_uuid (),
+    "transaction_id" INT NOT NULL REFERENCES "transactions" ("transaction_id") ON DELETE CASCADE,
+    "card_number" VARCHAR(16) NOT NULL REFERENCES "cards" ("card_number"),
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id"),
+    "amount" INT NOT NULL CHECK (amount > 0),
+    "reason" TEXT NOT NULL,
+    "merchant_evidence" TEXT,
+    "resolution_note" TEXT,
+    "status" VARCHAR(30) NOT NULL DEFAULT 'open' CHECK (
+        status IN (
+            'open',
+            'under_review',
+            'resolved_cardholder',
+            'resolved_merchant'
+        )
+    ),
+    "opened_by" INT NOT NULL REFERENCES "users" ("user_id"),
+    "resolved_by" INT REFERENCES "users" ("user_id"),
+    "responded_at" TIMESTAMP DEFAULT NULL,
+    "resolved_at" TIMESTAMP DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_disputes_transaction_id ON disputes (transaction_id);
+
+CREATE INDEX idx_disputes_merchant_id ON disputes (merchant_id);
+
+CREATE INDEX idx_disputes_status ON disputes (status);
+
+CREATE UNIQUE INDEX uq_disputes_active_transaction ON disputes (transaction_id)
+WHERE
+    status IN ('open', 'under_review');
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uq_disputes_active_transaction;
+
+DROP INDEX IF EXISTS idx_disputes_status;
+
+DROP INDEX IF EXISTS idx_disputes_merchant_id;
+
+DROP INDEX IF EXISTS idx_disputes_transaction_id;
+
+DROP TABLE IF EXISTS "disputes";
+
+-- +goose StatementEnd
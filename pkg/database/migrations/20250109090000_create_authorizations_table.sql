@@ -0,0 +1,64 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE "saldos"
+ADD COLUMN "reserved_balance" INT NOT NULL DEFAULT 0;
+
+ALTER TABLE "saldos"
+ADD CONSTRAINT chk_saldos_reserved_balance CHECK (
+    reserved_balance >= 0
+    AND reserved_balance <= total_balance
+);
+
+CREATE TABLE "authorizations" (
+    "authorization_id" SERIAL PRIMARY KEY,
+    "authorization_no" UUID NOT NULL DEFAULT gen_random_uuid (),
+    "card_number" VARCHAR(16) NOT NULL REFERENCES "cards" ("card_number"),
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id"),
+    "amount" INT NOT NULL CHECK (amount > 0),
+    "captured_amount" INT NOT NULL DEFAULT 0,
+    "payment_method" VARCHAR(50) NOT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'authorized' CHECK (
+        status IN (
+            'authorized',
+            'captured',
+            'voided',
+            'expired'
+        )
+    ),
+    "transaction_id" INT REFERENCES "transactions" ("transaction_id") ON DELETE SET NULL,
+    "expires_at" TIMESTAMP NOT NULL,
+    "captured_at" TIMESTAMP DEFAULT NULL,
+    "voided_at" TIMESTAMP DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp,
+    CONSTRAINT chk_authorizations_captured_amount CHECK (
+        captured_amount >= 0
+        AND captured_amount <= amount
+    )
+);
+
+CREATE INDEX idx_authorizations_card_number ON authorizations (card_number);
+
+CREATE INDEX idx_authorizations_merchant_id ON authorizations (merchant_id);
+
+CREATE INDEX idx_authorizations_pending_expiry ON authorizations (expires_at)
+WHERE
+    status = 'authorized';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_authorizations_pending_expiry;
+
+DROP INDEX IF EXISTS idx_authorizations_merchant_id;
+
+DROP INDEX IF EXISTS idx_authorizations_card_number;
+
+DROP TABLE IF EXISTS "authorizations";
+
+ALTER TABLE "saldos"
+DROP CONSTRAINT IF EXISTS chk_saldos_reserved_balance;
+
+ALTER TABLE "saldos" DROP COLUMN IF EXISTS "reserved_balance";
+
+-- +goose StatementEnd
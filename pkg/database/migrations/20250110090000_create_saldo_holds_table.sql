@@ -0,0 +1,63 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE "saldo_holds" (
+    "hold_id" SERIAL PRIMARY KEY,
+    "hold_no" UUID NOT NULL DEFAULT gen_random_uuid (),
+    "card_number" VARCHAR(16) NOT NULL REFERENCES "cards" ("card_number"),
+    "amount" INT NOT NULL CHECK (amount > 0),
+    "reason" TEXT NOT NULL,
+    "reference_type" VARCHAR(50) DEFAULT NULL,
+    "reference_id" INT DEFAULT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (
+        status IN ('active', 'released', 'consumed')
+    ),
+    "created_by" INT REFERENCES "users" ("user_id"),
+    "released_at" TIMESTAMP DEFAULT NULL,
+    "consumed_at" TIMESTAMP DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp,
+    CONSTRAINT chk_saldo_holds_reference CHECK (
+        (reference_type IS NULL) = (reference_id IS NULL)
+    )
+);
+
+CREATE INDEX idx_saldo_holds_active_card_number ON saldo_holds (card_number)
+WHERE
+    status = 'active';
+
+-- An operation holds funds at most once at a time.
+CREATE UNIQUE INDEX uq_saldo_holds_active_reference ON saldo_holds (reference_type, reference_id)
+WHERE
+    status = 'active'
+    AND reference_type IS NOT NULL;
+
+-- Pending authorizations already reserve their amount on the saldo; record
+-- the matching holds so reserved_balance equals the sum of active holds.
+INSERT INTO
+    saldo_holds (
+        card_number,
+        amount,
+        reason,
+        reference_type,
+        reference_id
+    )
+SELECT
+    card_number,
+    amount,
+    'Authorization ' || authorization_no,
+    'authorization',
+    authorization_id
+FROM authorizations
+WHERE
+    status = 'authorized';
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS uq_saldo_holds_active_reference;
+
+DROP INDEX IF EXISTS idx_saldo_holds_active_card_number;
+
+DROP TABLE IF EXISTS "saldo_holds";
+
+-- +goose StatementEnd
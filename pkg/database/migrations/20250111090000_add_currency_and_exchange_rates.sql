@@ -0,0 +1,129 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Every existing amount was recorded in rupiah, so IDR is used to backfill.
+-- Only cards keep the default; money tables inherit the currency of the card
+-- they move funds for and must state it explicitly from now on.
+ALTER TABLE "cards"
+ADD COLUMN "currency" CHAR(3) NOT NULL DEFAULT 'IDR' CHECK (currency ~ '^[A-Z]{3}$');
+
+ALTER TABLE "saldos" ADD COLUMN "currency" CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE "topups" ADD COLUMN "currency" CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE "withdraws" ADD COLUMN "currency" CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE "transactions" ADD COLUMN "currency" CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE "refunds" ADD COLUMN "currency" CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE "disputes" ADD COLUMN "currency" CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE "authorizations" ADD COLUMN "currency" CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE "saldo_holds" ADD COLUMN "currency" CHAR(3) NOT NULL DEFAULT 'IDR';
+
+ALTER TABLE "ledger_postings" ADD COLUMN "currency" CHAR(3) NOT NULL DEFAULT 'IDR';
+
+-- transfer_amount is debited in the sender currency; converted_amount is
+-- credited in to_currency. exchange_rate and spread_bps are only recorded
+-- when the transfer crossed currencies.
+ALTER TABLE "transfers"
+ADD COLUMN "currency" CHAR(3) NOT NULL DEFAULT 'IDR',
+ADD COLUMN "to_currency" CHAR(3) NOT NULL DEFAULT 'IDR',
+ADD COLUMN "converted_amount" INT,
+ADD COLUMN "exchange_rate" NUMERIC(20, 10) DEFAULT NULL,
+ADD COLUMN "spread_bps" INT DEFAULT NULL,
+ADD CONSTRAINT chk_transfers_conversion CHECK (
+    (exchange_rate IS NULL) = (spread_bps IS NULL)
+    AND (
+        exchange_rate IS NOT NULL
+        OR currency = to_currency
+    )
+);
+
+UPDATE transfers SET converted_amount = transfer_amount;
+
+ALTER TABLE "transfers" ALTER COLUMN "converted_amount" SET NOT NULL;
+
+ALTER TABLE "saldos" ALTER COLUMN "currency" DROP DEFAULT;
+
+ALTER TABLE "topups" ALTER COLUMN "currency" DROP DEFAULT;
+
+ALTER TABLE "withdraws" ALTER COLUMN "currency" DROP DEFAULT;
+
+ALTER TABLE "transactions" ALTER COLUMN "currency" DROP DEFAULT;
+
+ALTER TABLE "refunds" ALTER COLUMN "currency" DROP DEFAULT;
+
+ALTER TABLE "disputes" ALTER COLUMN "currency" DROP DEFAULT;
+
+ALTER TABLE "authorizations" ALTER COLUMN "currency" DROP DEFAULT;
+
+ALTER TABLE "saldo_holds" ALTER COLUMN "currency" DROP DEFAULT;
+
+ALTER TABLE "ledger_postings" ALTER COLUMN "currency" DROP DEFAULT;
+
+ALTER TABLE "transfers" ALTER COLUMN "currency" DROP DEFAULT;
+
+ALTER TABLE "transfers" ALTER COLUMN "to_currency" DROP DEFAULT;
+
+CREATE TABLE "exchange_rates" (
+    "exchange_rate_id" SERIAL PRIMARY KEY,
+    "base_currency" CHAR(3) NOT NULL,
+    "quote_currency" CHAR(3) NOT NULL,
+    "rate" NUMERIC(20, 10) NOT NULL CHECK (rate > 0),
+    "spread_bps" INT NOT NULL DEFAULT 0 CHECK (
+        spread_bps >= 0
+        AND spread_bps < 10000
+    ),
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp,
+    CONSTRAINT chk_exchange_rates_pair CHECK (base_currency <> quote_currency)
+);
+
+CREATE UNIQUE INDEX uq_exchange_rates_pair ON exchange_rates (base_currency, quote_currency);
+
+CREATE INDEX idx_saldos_currency ON saldos (currency);
+
+CREATE INDEX idx_ledger_postings_currency ON ledger_postings (currency);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_ledger_postings_currency;
+
+DROP INDEX IF EXISTS idx_saldos_currency;
+
+DROP INDEX IF EXISTS uq_exchange_rates_pair;
+
+DROP TABLE IF EXISTS "exchange_rates";
+
+ALTER TABLE "transfers"
+DROP CONSTRAINT IF EXISTS chk_transfers_conversion,
+DROP COLUMN IF EXISTS "spread_bps",
+DROP COLUMN IF EXISTS "exchange_rate",
+DROP COLUMN IF EXISTS "converted_amount",
+DROP COLUMN IF EXISTS "to_currency",
+DROP COLUMN IF EXISTS "currency";
+
+ALTER TABLE "ledger_postings" DROP COLUMN IF EXISTS "currency";
+
+ALTER TABLE "saldo_holds" DROP COLUMN IF EXISTS "currency";
+
+ALTER TABLE "authorizations" DROP COLUMN IF EXISTS "currency";
+
+ALTER TABLE "disputes" DROP COLUMN IF EXISTS "currency";
+
+ALTER TABLE "refunds" DROP COLUMN IF EXISTS "currency";
+
+ALTER TABLE "transactions" DROP COLUMN IF EXISTS "currency";
+
+ALTER TABLE "withdraws" DROP COLUMN IF EXISTS "currency";
+
+ALTER TABLE "topups" DROP COLUMN IF EXISTS "currency";
+
+ALTER TABLE "saldos" DROP COLUMN IF EXISTS "currency";
+
+ALTER TABLE "cards" DROP COLUMN IF EXISTS "currency";
+
+-- +goose StatementEnd
@@ -0,0 +1,92 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A schedule applies to one transaction type. payment_method, card_type and
+-- currency narrow it down when set and match anything when NULL; the most
+-- specific active schedule wins. min_fee and max_fee clamp whatever the fee
+-- type computed.
+CREATE TABLE "fee_schedules" (
+    "fee_schedule_id" SERIAL PRIMARY KEY,
+    "name" VARCHAR(100) NOT NULL,
+    "transaction_type" VARCHAR(20) NOT NULL CHECK (
+        transaction_type IN (
+            'transfer',
+            'withdraw',
+            'topup',
+            'transaction'
+        )
+    ),
+    "fee_type" VARCHAR(20) NOT NULL CHECK (
+        fee_type IN (
+            'flat',
+            'percentage',
+            'tiered'
+        )
+    ),
+    "payment_method" VARCHAR(50) DEFAULT NULL,
+    "card_type" VARCHAR(50) DEFAULT NULL,
+    "currency" CHAR(3) DEFAULT NULL,
+    "flat_amount" INT NOT NULL DEFAULT 0 CHECK (flat_amount >= 0),
+    "percentage_bps" INT NOT NULL DEFAULT 0 CHECK (
+        percentage_bps >= 0
+        AND percentage_bps < 10000
+    ),
+    "min_fee" INT DEFAULT NULL CHECK (min_fee >= 0),
+    "max_fee" INT DEFAULT NULL CHECK (max_fee >= 0),
+    "is_active" BOOLEAN NOT NULL DEFAULT TRUE,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp,
+    CONSTRAINT chk_fee_schedules_bounds CHECK (
+        min_fee IS NULL
+        OR max_fee IS NULL
+        OR min_fee <= max_fee
+    )
+);
+
+-- A tier applies from min_amount up to the next tier of the same schedule.
+CREATE TABLE "fee_schedule_tiers" (
+    "fee_schedule_tier_id" SERIAL PRIMARY KEY,
+    "fee_schedule_id" INT NOT NULL REFERENCES fee_schedules (fee_schedule_id) ON DELETE CASCADE,
+    "min_amount" INT NOT NULL CHECK (min_amount >= 0),
+    "flat_amount" INT NOT NULL DEFAULT 0 CHECK (flat_amount >= 0),
+    "percentage_bps" INT NOT NULL DEFAULT 0 CHECK (
+        percentage_bps >= 0
+        AND percentage_bps < 10000
+    ),
+    "created_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE UNIQUE INDEX uq_fee_schedule_tiers_min_amount ON fee_schedule_tiers (fee_schedule_id, min_amount);
+
+CREATE INDEX idx_fee_schedules_lookup ON fee_schedules (transaction_type, is_active);
+
+-- The fee is charged in the currency of the paying card, on top of the amount
+-- for transfers and withdrawals and out of the credited amount for topups
+-- and merchant transactions.
+ALTER TABLE "transfers" ADD COLUMN "fee" INT NOT NULL DEFAULT 0 CHECK (fee >= 0);
+
+ALTER TABLE "withdraws" ADD COLUMN "fee" INT NOT NULL DEFAULT 0 CHECK (fee >= 0);
+
+ALTER TABLE "topups" ADD COLUMN "fee" INT NOT NULL DEFAULT 0 CHECK (fee >= 0);
+
+ALTER TABLE "transactions" ADD COLUMN "fee" INT NOT NULL DEFAULT 0 CHECK (fee >= 0);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "transactions" DROP COLUMN IF EXISTS "fee";
+
+ALTER TABLE "topups" DROP COLUMN IF EXISTS "fee";
+
+ALTER TABLE "withdraws" DROP COLUMN IF EXISTS "fee";
+
+ALTER TABLE "transfers" DROP COLUMN IF EXISTS "fee";
+
+DROP INDEX IF EXISTS idx_fee_schedules_lookup;
+
+DROP INDEX IF EXISTS uq_fee_schedule_tiers_min_amount;
+
+DROP TABLE IF EXISTS "fee_schedule_tiers";
+
+DROP TABLE IF EXISTS "fee_schedules";
+
+-- +goose StatementEnd
@@ -0,0 +1,56 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Tiers group users under the same limits; every existing user starts out on
+-- the standard tier.
+ALTER TABLE "users"
+ADD COLUMN "tier" VARCHAR(20) NOT NULL DEFAULT 'standard' CHECK (
+    tier IN (
+        'standard',
+        'premium',
+        'business'
+    )
+);
+
+-- A limit applies to one operation type over one period. card_type,
+-- user_tier and currency narrow it down when set and match anything when
+-- NULL; for each period only the most specific active limit is enforced.
+-- Caps left NULL are not enforced. Amounts are in minor units of the card.
+CREATE TABLE "transaction_limits" (
+    "transaction_limit_id" SERIAL PRIMARY KEY,
+    "name" VARCHAR(100) NOT NULL,
+    "operation_type" VARCHAR(20) NOT NULL CHECK (
+        operation_type IN (
+            'transfer',
+            'withdraw',
+            'transaction'
+        )
+    ),
+    "period" VARCHAR(10) NOT NULL CHECK (period IN ('daily', 'monthly')),
+    "card_type" VARCHAR(50) DEFAULT NULL,
+    "user_tier" VARCHAR(20) DEFAULT NULL,
+    "currency" CHAR(3) DEFAULT NULL,
+    "max_amount_per_transaction" INT DEFAULT NULL CHECK (max_amount_per_transaction > 0),
+    "max_count" INT DEFAULT NULL CHECK (max_count > 0),
+    "max_total_amount" INT DEFAULT NULL CHECK (max_total_amount > 0),
+    "is_active" BOOLEAN NOT NULL DEFAULT TRUE,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp,
+    CONSTRAINT chk_transaction_limits_caps CHECK (
+        max_amount_per_transaction IS NOT NULL
+        OR max_count IS NOT NULL
+        OR max_total_amount IS NOT NULL
+    )
+);
+
+CREATE INDEX idx_transaction_limits_lookup ON transaction_limits (operation_type, is_active);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transaction_limits_lookup;
+
+DROP TABLE IF EXISTS "transaction_limits";
+
+ALTER TABLE "users" DROP COLUMN IF EXISTS "tier";
+
+-- +goose StatementEnd
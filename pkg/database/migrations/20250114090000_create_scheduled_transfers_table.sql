@@ -0,0 +1,86 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A schedule runs once at next_run_at, or repeatedly for daily, weekly and
+-- monthly recurrences. Monthly schedules run on day_of_month, or on the last
+-- day of shorter months. next_run_at is NULL once nothing is left to run.
+CREATE TABLE "scheduled_transfers" (
+    "scheduled_transfer_id" SERIAL PRIMARY KEY,
+    "user_id" INT NOT NULL REFERENCES users (user_id),
+    "transfer_from" VARCHAR(16) NOT NULL REFERENCES cards (card_number),
+    "transfer_to" VARCHAR(16) NOT NULL REFERENCES cards (card_number),
+    "transfer_amount" INT NOT NULL CHECK (transfer_amount > 0),
+    "recurrence" VARCHAR(10) NOT NULL CHECK (
+        recurrence IN (
+            'once',
+            'daily',
+            'weekly',
+            'monthly'
+        )
+    ),
+    "day_of_month" INT DEFAULT NULL CHECK (
+        day_of_month BETWEEN 1 AND 31
+    ),
+    "next_run_at" TIMESTAMP DEFAULT NULL,
+    "end_at" TIMESTAMP DEFAULT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'active' CHECK (
+        status IN (
+            'active',
+            'paused',
+            'failed',
+            'completed',
+            'cancelled'
+        )
+    ),
+    "last_run_at" TIMESTAMP DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp,
+    CONSTRAINT chk_scheduled_transfers_day_of_month CHECK (
+        (recurrence = 'monthly') = (day_of_month IS NOT NULL)
+    ),
+    CONSTRAINT chk_scheduled_transfers_next_run CHECK (
+        status <> 'active'
+        OR next_run_at IS NOT NULL
+    )
+);
+
+-- A run is inserted before its transfer is attempted. The unique occurrence
+-- is what keeps a schedule from executing the same run twice; a run left in
+-- 'running' was interrupted and is never retried automatically.
+CREATE TABLE "scheduled_transfer_runs" (
+    "scheduled_transfer_run_id" SERIAL PRIMARY KEY,
+    "scheduled_transfer_id" INT NOT NULL REFERENCES scheduled_transfers (scheduled_transfer_id),
+    "scheduled_for" TIMESTAMP NOT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'running' CHECK (
+        status IN ('running', 'success', 'failed')
+    ),
+    "transfer_id" INT DEFAULT NULL REFERENCES transfers (transfer_id),
+    "failure_reason" TEXT DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE UNIQUE INDEX uq_scheduled_transfer_runs_occurrence ON scheduled_transfer_runs (
+    scheduled_transfer_id,
+    scheduled_for
+);
+
+CREATE INDEX idx_scheduled_transfers_due ON scheduled_transfers (next_run_at)
+WHERE
+    status = 'active';
+
+CREATE INDEX idx_scheduled_transfers_user_id ON scheduled_transfers (user_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_scheduled_transfers_user_id;
+
+DROP INDEX IF EXISTS idx_scheduled_transfers_due;
+
+DROP INDEX IF EXISTS uq_scheduled_transfer_runs_occurrence;
+
+DROP TABLE IF EXISTS "scheduled_transfer_runs";
+
+DROP TABLE IF EXISTS "scheduled_transfers";
+
+-- +goose StatementEnd
@@ -0,0 +1,90 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A batch pays many cards from one source card. Every row is validated when
+-- the batch is uploaded: a batch with an invalid row is rejected as a whole
+-- and never runs. Accepted batches are executed in the background, and the
+-- result counters are updated as each row finishes.
+CREATE TABLE "transfer_batches" (
+    "transfer_batch_id" SERIAL PRIMARY KEY,
+    "user_id" INT NOT NULL REFERENCES users (user_id),
+    "transfer_from" VARCHAR(16) NOT NULL REFERENCES cards (card_number),
+    "file_format" VARCHAR(10) NOT NULL CHECK (
+        file_format IN ('csv', 'json')
+    ),
+    "status" VARCHAR(30) NOT NULL DEFAULT 'pending' CHECK (
+        status IN (
+            'pending',
+            'processing',
+            'completed',
+            'completed_with_errors',
+            'rejected'
+        )
+    ),
+    "total_rows" INT NOT NULL CHECK (total_rows > 0),
+    "total_amount" INT NOT NULL DEFAULT 0,
+    "invalid_rows" INT NOT NULL DEFAULT 0,
+    "success_rows" INT NOT NULL DEFAULT 0,
+    "failed_rows" INT NOT NULL DEFAULT 0,
+    "success_amount" INT NOT NULL DEFAULT 0,
+    "started_at" TIMESTAMP DEFAULT NULL,
+    "completed_at" TIMESTAMP DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+-- Rows keep what was uploaded even when it is invalid, so transfer_to is not
+-- a card reference. A row left in 'processing' was interrupted and is never
+-- retried automatically.
+CREATE TABLE "transfer_batch_rows" (
+    "transfer_batch_row_id" SERIAL PRIMARY KEY,
+    "transfer_batch_id" INT NOT NULL REFERENCES transfer_batches (transfer_batch_id),
+    "row_number" INT NOT NULL,
+    "transfer_to" TEXT NOT NULL,
+    "transfer_amount" INT NOT NULL,
+    "note" TEXT DEFAULT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'pending' CHECK (
+        status IN (
+            'pending',
+            'processing',
+            'success',
+            'failed',
+            'invalid'
+        )
+    ),
+    "transfer_id" INT DEFAULT NULL REFERENCES transfers (transfer_id),
+    "failure_reason" TEXT DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE UNIQUE INDEX uq_transfer_batch_rows_row_number ON transfer_batch_rows (
+    transfer_batch_id,
+    row_number
+);
+
+CREATE INDEX idx_transfer_batch_rows_pending ON transfer_batch_rows (transfer_batch_id, row_number)
+WHERE
+    status = 'pending';
+
+CREATE INDEX idx_transfer_batches_runnable ON transfer_batches (created_at)
+WHERE
+    status IN ('pending', 'processing');
+
+CREATE INDEX idx_transfer_batches_user_id ON transfer_batches (user_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_transfer_batches_user_id;
+
+DROP INDEX IF EXISTS idx_transfer_batches_runnable;
+
+DROP INDEX IF EXISTS idx_transfer_batch_rows_pending;
+
+DROP INDEX IF EXISTS uq_transfer_batch_rows_row_number;
+
+DROP TABLE IF EXISTS "transfer_batch_rows";
+
+DROP TABLE IF EXISTS "transfer_batches";
+
+-- +goose StatementEnd
@@ -0,0 +1,69 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Status columns only accept the statuses known to the state machine in
+-- pkg/statemachine; which transitions are allowed is enforced there.
+ALTER TABLE "topups"
+ADD CONSTRAINT chk_topups_status CHECK (
+    status IN ('pending', 'success', 'failed')
+);
+
+ALTER TABLE "withdraws"
+ADD CONSTRAINT chk_withdraws_status CHECK (
+    status IN ('pending', 'success', 'failed')
+);
+
+ALTER TABLE "transfers"
+ADD CONSTRAINT chk_transfers_status CHECK (
+    status IN ('pending', 'success', 'failed')
+);
+
+ALTER TABLE "transactions"
+ADD CONSTRAINT chk_transactions_status CHECK (
+    status IN (
+        'pending',
+        'success',
+        'failed',
+        'refunded',
+        'partially_refunded',
+        'disputed',
+        'charged_back'
+    )
+);
+
+-- One row per status change of a topup, withdraw, transfer or transaction.
+-- Rows are written by the same statement that changes the status, so the
+-- history cannot drift from the record it describes.
+CREATE TABLE "status_histories" (
+    "status_history_id" SERIAL PRIMARY KEY,
+    "entity_type" VARCHAR(20) NOT NULL CHECK (
+        entity_type IN (
+            'topup',
+            'withdraw',
+            'transfer',
+            'transaction'
+        )
+    ),
+    "entity_id" INT NOT NULL,
+    "from_status" VARCHAR(20) NOT NULL,
+    "to_status" VARCHAR(20) NOT NULL,
+    "created_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_status_histories_entity ON status_histories (entity_type, entity_id, created_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_status_histories_entity;
+
+DROP TABLE IF EXISTS "status_histories";
+
+ALTER TABLE "transactions" DROP CONSTRAINT IF EXISTS chk_transactions_status;
+
+ALTER TABLE "transfers" DROP CONSTRAINT IF EXISTS chk_transfers_status;
+
+ALTER TABLE "withdraws" DROP CONSTRAINT IF EXISTS chk_withdraws_status;
+
+ALTER TABLE "topups" DROP CONSTRAINT IF EXISTS chk_topups_status;
+
+-- +goose StatementEnd
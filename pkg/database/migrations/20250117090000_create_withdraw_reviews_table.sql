@@ -0,0 +1,55 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Withdraws above the review threshold wait in pending_review with their funds
+-- held on the card, and are paid out by the settlement job once approved.
+ALTER TABLE "withdraws"
+DROP CONSTRAINT chk_withdraws_status;
+
+ALTER TABLE "withdraws"
+ADD CONSTRAINT chk_withdraws_status CHECK (
+    status IN (
+        'pending',
+        'success',
+        'failed',
+        'pending_review',
+        'approved',
+        'rejected',
+        'processing',
+        'settled'
+    )
+);
+
+-- The decision taken on a withdraw that waited for review. A withdraw is
+-- reviewed at most once.
+CREATE TABLE "withdraw_reviews" (
+    "withdraw_review_id" SERIAL PRIMARY KEY,
+    "withdraw_id" INT NOT NULL UNIQUE REFERENCES withdraws (withdraw_id),
+    "decision" VARCHAR(20) NOT NULL CHECK (
+        decision IN ('approved', 'rejected')
+    ),
+    "note" TEXT DEFAULT NULL,
+    "reviewed_by" INT NOT NULL REFERENCES users (user_id),
+    "created_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_withdraws_settleable ON withdraws (updated_at)
+WHERE
+    status IN ('approved', 'processing')
+    AND deleted_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_withdraws_settleable;
+
+DROP TABLE IF EXISTS "withdraw_reviews";
+
+ALTER TABLE "withdraws"
+DROP CONSTRAINT chk_withdraws_status;
+
+ALTER TABLE "withdraws"
+ADD CONSTRAINT chk_withdraws_status CHECK (
+    status IN ('pending', 'success', 'failed')
+);
+
+-- +goose StatementEnd
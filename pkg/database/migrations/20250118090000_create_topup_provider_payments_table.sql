@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The payment a provider collects for a topup. The topup stays pending until
+-- the provider confirms or fails the payment.
+CREATE TABLE "topup_provider_payments" (
+    "topup_provider_payment_id" SERIAL PRIMARY KEY,
+    "topup_id" INT NOT NULL UNIQUE REFERENCES topups (topup_id),
+    "provider" VARCHAR(50) NOT NULL,
+    "provider_reference" VARCHAR(100) NOT NULL,
+    "last_message" TEXT DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp,
+    UNIQUE (provider, provider_reference)
+);
+
+CREATE INDEX idx_topups_pending ON topups (created_at)
+WHERE
+    status = 'pending'
+    AND deleted_at IS NULL;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_topups_pending;
+
+DROP TABLE IF EXISTS "topup_provider_payments";
+
+-- +goose StatementEnd
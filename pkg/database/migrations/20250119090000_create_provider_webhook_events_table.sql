@@ -0,0 +1,32 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Callbacks received from payment providers. The raw payload is kept so a
+-- callback can be replayed, and the event ID of each provider is unique so a
+-- redelivered callback is stored once.
+CREATE TABLE "provider_webhook_events" (
+    "provider_webhook_event_id" SERIAL PRIMARY KEY,
+    "provider" VARCHAR(50) NOT NULL,
+    "event_id" VARCHAR(100) NOT NULL,
+    "event_type" VARCHAR(50) NOT NULL,
+    "payload" TEXT NOT NULL,
+    "signature" VARCHAR(128) NOT NULL,
+    "sent_at" TIMESTAMP NOT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'received',
+    "attempts" INT NOT NULL DEFAULT 0,
+    "last_error" TEXT DEFAULT NULL,
+    "processed_at" TIMESTAMP DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp,
+    UNIQUE (provider, event_id)
+);
+
+CREATE INDEX idx_provider_webhook_events_status ON provider_webhook_events (status);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_provider_webhook_events_status;
+
+DROP TABLE IF EXISTS "provider_webhook_events";
+
+-- +goose StatementEnd
@@ -0,0 +1,74 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Endpoints merchants register to be told about their payments. The secret
+-- signs every delivery and events lists the event types the endpoint
+-- subscribes to.
+CREATE TABLE "merchant_webhook_endpoints" (
+    "merchant_webhook_endpoint_id" SERIAL PRIMARY KEY,
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id"),
+    "url" VARCHAR(2048) NOT NULL,
+    "secret" VARCHAR(100) NOT NULL,
+    "events" TEXT[] NOT NULL,
+    "is_active" BOOLEAN NOT NULL DEFAULT TRUE,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp,
+    "deleted_at" TIMESTAMP DEFAULT NULL
+);
+
+CREATE INDEX idx_merchant_webhook_endpoints_merchant_id ON merchant_webhook_endpoints (merchant_id);
+
+-- One row per event and endpoint. A delivery is retried with exponential
+-- backoff until the endpoint answers with a 2xx or it runs out of attempts.
+CREATE TABLE "merchant_webhook_deliveries" (
+    "merchant_webhook_delivery_id" SERIAL PRIMARY KEY,
+    "merchant_webhook_endpoint_id" INT NOT NULL REFERENCES "merchant_webhook_endpoints" ("merchant_webhook_endpoint_id"),
+    "event_id" UUID NOT NULL,
+    "event_type" VARCHAR(50) NOT NULL,
+    "payload" TEXT NOT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'pending',
+    "attempts" INT NOT NULL DEFAULT 0,
+    "next_attempt_at" TIMESTAMP DEFAULT NULL,
+    "last_response_code" INT DEFAULT NULL,
+    "last_error" TEXT DEFAULT NULL,
+    "delivered_at" TIMESTAMP DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_merchant_webhook_deliveries_endpoint_id ON merchant_webhook_deliveries (merchant_webhook_endpoint_id);
+
+CREATE INDEX idx_merchant_webhook_deliveries_due ON merchant_webhook_deliveries (next_attempt_at)
+WHERE
+    status = 'pending';
+
+-- The delivery log: every attempt with the code the endpoint answered with.
+CREATE TABLE "merchant_webhook_delivery_attempts" (
+    "merchant_webhook_delivery_attempt_id" SERIAL PRIMARY KEY,
+    "merchant_webhook_delivery_id" INT NOT NULL REFERENCES "merchant_webhook_deliveries" ("merchant_webhook_delivery_id"),
+    "attempt" INT NOT NULL,
+    "response_code" INT DEFAULT NULL,
+    "error" TEXT DEFAULT NULL,
+    "duration_ms" INT NOT NULL,
+    "created_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_merchant_webhook_delivery_attempts_delivery_id ON merchant_webhook_delivery_attempts (merchant_webhook_delivery_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_merchant_webhook_delivery_attempts_delivery_id;
+
+DROP TABLE IF EXISTS "merchant_webhook_delivery_attempts";
+
+DROP INDEX IF EXISTS idx_merchant_webhook_deliveries_due;
+
+DROP INDEX IF EXISTS idx_merchant_webhook_deliveries_endpoint_id;
+
+DROP TABLE IF EXISTS "merchant_webhook_deliveries";
+
+DROP INDEX IF EXISTS idx_merchant_webhook_endpoints_merchant_id;
+
+DROP TABLE IF EXISTS "merchant_webhook_endpoints";
+
+-- +goose StatementEnd
@@ -0,0 +1,72 @@
+-- +goose Up
+-- +goose StatementBegin
+-- What the platform owes a merchant in one currency and has not paid out
+-- yet. Payments accrue here instead of reaching a merchant card directly;
+-- the settlement job pays the balance out to the settlement card, or to the
+-- first card of the merchant owner when none was designated.
+CREATE TABLE "merchant_balances" (
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id"),
+    "currency" CHAR(3) NOT NULL,
+    "pending_balance" INT NOT NULL DEFAULT 0,
+    "settlement_card_number" VARCHAR(16) DEFAULT NULL REFERENCES "cards" ("card_number"),
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp,
+    PRIMARY KEY ("merchant_id", "currency")
+);
+
+-- One payout of a merchant balance: every item accrued before the cutoff,
+-- net of fees and refunds.
+CREATE TABLE "settlement_batches" (
+    "settlement_batch_id" SERIAL PRIMARY KEY,
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id"),
+    "currency" CHAR(3) NOT NULL,
+    "settlement_card_number" VARCHAR(16) NOT NULL REFERENCES "cards" ("card_number"),
+    "cutoff_at" TIMESTAMP NOT NULL,
+    "gross_amount" INT NOT NULL,
+    "fee_amount" INT NOT NULL,
+    "refund_amount" INT NOT NULL,
+    "adjustment_amount" INT NOT NULL,
+    "net_amount" INT NOT NULL,
+    "item_count" INT NOT NULL,
+    "created_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_settlement_batches_merchant_id ON settlement_batches (merchant_id);
+
+-- The line items of a merchant balance. An item belongs to no batch until
+-- it is settled.
+CREATE TABLE "settlement_items" (
+    "settlement_item_id" SERIAL PRIMARY KEY,
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id"),
+    "settlement_batch_id" INT DEFAULT NULL REFERENCES "settlement_batches" ("settlement_batch_id"),
+    "item_type" VARCHAR(20) NOT NULL,
+    "reference_id" INT NOT NULL,
+    "amount" INT NOT NULL,
+    "fee" INT NOT NULL,
+    "net_amount" INT NOT NULL,
+    "currency" CHAR(3) NOT NULL,
+    "created_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_settlement_items_unsettled ON settlement_items (merchant_id, currency, created_at)
+WHERE
+    settlement_batch_id IS NULL;
+
+CREATE INDEX idx_settlement_items_batch_id ON settlement_items (settlement_batch_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_settlement_items_batch_id;
+
+DROP INDEX IF EXISTS idx_settlement_items_unsettled;
+
+DROP TABLE IF EXISTS "settlement_items";
+
+DROP INDEX IF EXISTS idx_settlement_batches_merchant_id;
+
+DROP TABLE IF EXISTS "settlement_batches";
+
+DROP TABLE IF EXISTS "merchant_balances";
+
+-- +goose StatementEnd
@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+-- One pass of the balance reconciliation over every card.
+CREATE TABLE "reconciliation_runs" (
+    "reconciliation_run_id" SERIAL PRIMARY KEY,
+    "cards_checked" INT NOT NULL DEFAULT 0,
+    "discrepancy_count" INT NOT NULL DEFAULT 0,
+    "started_at" TIMESTAMP NOT NULL DEFAULT current_timestamp,
+    "finished_at" TIMESTAMP DEFAULT NULL
+);
+
+-- A card whose saldo does not match the balance recomputed from its
+-- history, or the balance of its ledger postings. The amounts break the
+-- expected balance down by source. A card has at most one unresolved
+-- discrepancy; later runs refresh it and resolve it once the card matches
+-- again.
+CREATE TABLE "balance_discrepancies" (
+    "balance_discrepancy_id" SERIAL PRIMARY KEY,
+    "card_number" VARCHAR(16) NOT NULL REFERENCES "cards" ("card_number"),
+    "reconciliation_run_id" INT NOT NULL REFERENCES "reconciliation_runs" ("reconciliation_run_id"),
+    "saldo_balance" INT NOT NULL,
+    "expected_balance" INT NOT NULL,
+    "ledger_balance" INT NOT NULL,
+    "difference" INT NOT NULL,
+    "topup_amount" INT NOT NULL,
+    "withdraw_amount" INT NOT NULL,
+    "transfer_out_amount" INT NOT NULL,
+    "transfer_in_amount" INT NOT NULL,
+    "payment_amount" INT NOT NULL,
+    "settlement_amount" INT NOT NULL,
+    "other_amount" INT NOT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'open',
+    "acknowledged_by" INT DEFAULT NULL REFERENCES "users" ("user_id"),
+    "acknowledged_at" TIMESTAMP DEFAULT NULL,
+    "note" TEXT DEFAULT NULL,
+    "resolved_at" TIMESTAMP DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE UNIQUE INDEX idx_balance_discrepancies_unresolved ON balance_discrepancies (card_number)
+WHERE
+    status <> 'resolved';
+
+CREATE INDEX idx_balance_discrepancies_status ON balance_discrepancies (status);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_balance_discrepancies_status;
+
+DROP INDEX IF EXISTS idx_balance_discrepancies_unresolved;
+
+DROP TABLE IF EXISTS "balance_discrepancies";
+
+DROP TABLE IF EXISTS "reconciliation_runs";
+
+-- +goose StatementEnd
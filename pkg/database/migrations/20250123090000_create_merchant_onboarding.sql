@@ -0,0 +1,105 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Merchants follow the onboarding lifecycle in pkg/statemachine. Merchants
+-- created as inactive never got past onboarding and start over as pending;
+-- deactivated merchants become suspended.
+UPDATE merchants
+SET
+    status = 'suspended'
+WHERE
+    status = 'deactive';
+
+UPDATE merchants
+SET
+    status = 'pending'
+WHERE
+    status NOT IN (
+        'pending',
+        'in_review',
+        'active',
+        'rejected',
+        'suspended',
+        'terminated'
+    );
+
+ALTER TABLE "merchants"
+ADD CONSTRAINT chk_merchants_status CHECK (
+    status IN (
+        'pending',
+        'in_review',
+        'active',
+        'rejected',
+        'suspended',
+        'terminated'
+    )
+);
+
+-- The business details a merchant submits for review. Resubmitting after a
+-- rejection replaces them.
+CREATE TABLE "merchant_business_profiles" (
+    "merchant_id" INT PRIMARY KEY REFERENCES "merchants" ("merchant_id"),
+    "legal_name" VARCHAR(255) NOT NULL,
+    "business_type" VARCHAR(50) NOT NULL,
+    "tax_id" VARCHAR(50) NOT NULL,
+    "address" TEXT NOT NULL,
+    "website" VARCHAR(255) DEFAULT NULL,
+    "contact_email" VARCHAR(255) NOT NULL,
+    "contact_phone" VARCHAR(30) NOT NULL,
+    "submitted_at" TIMESTAMP NOT NULL DEFAULT current_timestamp,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+-- Merchant status changes are kept with the other status histories, along
+-- with why the status changed and who changed it.
+ALTER TABLE "status_histories"
+DROP CONSTRAINT IF EXISTS status_histories_entity_type_check;
+
+ALTER TABLE "status_histories"
+ADD CONSTRAINT chk_status_histories_entity_type CHECK (
+    entity_type IN (
+        'topup',
+        'withdraw',
+        'transfer',
+        'transaction',
+        'merchant'
+    )
+);
+
+ALTER TABLE "status_histories"
+ADD COLUMN "reason" TEXT DEFAULT NULL,
+ADD COLUMN "changed_by" INT DEFAULT NULL REFERENCES "users" ("user_id");
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM status_histories WHERE entity_type = 'merchant';
+
+ALTER TABLE "status_histories"
+DROP COLUMN IF EXISTS "changed_by",
+DROP COLUMN IF EXISTS "reason";
+
+ALTER TABLE "status_histories"
+DROP CONSTRAINT IF EXISTS chk_status_histories_entity_type;
+
+ALTER TABLE "status_histories"
+ADD CONSTRAINT status_histories_entity_type_check CHECK (
+    entity_type IN (
+        'topup',
+        'withdraw',
+        'transfer',
+        'transaction'
+    )
+);
+
+DROP TABLE IF EXISTS "merchant_business_profiles";
+
+ALTER TABLE "merchants" DROP CONSTRAINT IF EXISTS chk_merchants_status;
+
+UPDATE merchants
+SET
+    status = 'deactive'
+WHERE
+    status IN ('suspended', 'terminated');
+
+-- +goose StatementEnd
@@ -0,0 +1,68 @@
+-- +goose Up
+-- +goose StatementBegin
+-- API keys of a merchant. Only a SHA-256 hash of a key is stored, together
+-- with its first characters so the merchant can tell its keys apart. Scopes
+-- list what a key may be used for; a rotated key keeps working until its
+-- expires_at so clients can switch over.
+CREATE TABLE "merchant_api_keys" (
+    "merchant_api_key_id" SERIAL PRIMARY KEY,
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id"),
+    "label" VARCHAR(100) NOT NULL,
+    "key_prefix" VARCHAR(16) NOT NULL,
+    "key_hash" CHAR(64) UNIQUE NOT NULL,
+    "scopes" TEXT[] NOT NULL,
+    "expires_at" TIMESTAMP DEFAULT NULL,
+    "last_used_at" TIMESTAMP DEFAULT NULL,
+    "revoked_at" TIMESTAMP DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_merchant_api_keys_merchant_id ON merchant_api_keys (merchant_id);
+
+-- The key every merchant already has keeps working with all scopes.
+INSERT INTO
+    merchant_api_keys (
+        merchant_id,
+        label,
+        key_prefix,
+        key_hash,
+        scopes,
+        created_at,
+        updated_at
+    )
+SELECT
+    merchant_id,
+    'Default',
+    LEFT(api_key, 8),
+    encode(sha256(convert_to(api_key, 'UTF8')), 'hex'),
+    ARRAY['transactions:create', 'analytics:read'],
+    created_at,
+    current_timestamp
+FROM merchants;
+
+DROP INDEX IF EXISTS idx_merchants_api_key;
+
+ALTER TABLE "merchants" DROP COLUMN IF EXISTS "api_key";
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+-- The hashes can't be turned back into keys, so every merchant gets a new
+-- random one.
+ALTER TABLE "merchants"
+ADD COLUMN "api_key" VARCHAR(255) UNIQUE;
+
+UPDATE merchants
+SET
+    api_key = encode(gen_random_bytes(32), 'hex');
+
+ALTER TABLE "merchants" ALTER COLUMN "api_key" SET NOT NULL;
+
+CREATE INDEX idx_merchants_api_key ON merchants (api_key);
+
+DROP INDEX IF EXISTS idx_merchant_api_keys_merchant_id;
+
+DROP TABLE IF EXISTS "merchant_api_keys";
+
+-- +goose StatementEnd
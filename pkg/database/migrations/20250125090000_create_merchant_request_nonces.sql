@@ -0,0 +1,37 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Every API key gets a secret merchants sign their requests with, so the key
+-- itself no longer has to be sent. Keys that already exist get a secret no
+-- merchant has seen; rotating them issues a key with a secret that is shown.
+ALTER TABLE "merchant_api_keys"
+ADD COLUMN "signing_secret" VARCHAR(100);
+
+UPDATE merchant_api_keys
+SET
+    signing_secret = 'sigsec_' || encode(gen_random_bytes(32), 'hex');
+
+ALTER TABLE "merchant_api_keys"
+ALTER COLUMN "signing_secret"
+SET NOT NULL;
+
+-- Nonces of signed requests. A nonce can only be used once per key; rows
+-- older than the allowed clock skew can't be replayed anyway and are pruned.
+CREATE TABLE "merchant_request_nonces" (
+    "merchant_api_key_id" INT NOT NULL REFERENCES "merchant_api_keys" ("merchant_api_key_id"),
+    "nonce" VARCHAR(64) NOT NULL,
+    "created_at" timestamp NOT NULL DEFAULT current_timestamp,
+    PRIMARY KEY ("merchant_api_key_id", "nonce")
+);
+
+CREATE INDEX idx_merchant_request_nonces_created_at ON merchant_request_nonces (created_at);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_merchant_request_nonces_created_at;
+
+DROP TABLE IF EXISTS "merchant_request_nonces";
+
+ALTER TABLE "merchant_api_keys" DROP COLUMN IF EXISTS "signing_secret";
+
+-- +goose StatementEnd
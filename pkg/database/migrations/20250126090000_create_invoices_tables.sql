@@ -0,0 +1,71 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Invoices and payment links merchants send their customers. Customers pay
+-- them with their own card using the public code. An invoice is paid once;
+-- a payment link takes any number of payments until it expires or the
+-- merchant cancels it. The amount is in the currency of the merchant.
+CREATE TABLE "invoices" (
+    "invoice_id" SERIAL PRIMARY KEY,
+    "code" VARCHAR(32) UNIQUE NOT NULL,
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id"),
+    "kind" VARCHAR(20) NOT NULL,
+    "description" TEXT NOT NULL,
+    "amount" INT NOT NULL CHECK ("amount" > 0),
+    "currency" CHAR(3) NOT NULL,
+    "status" VARCHAR(20) NOT NULL DEFAULT 'open',
+    "expires_at" TIMESTAMP NOT NULL,
+    "paid_at" TIMESTAMP DEFAULT NULL,
+    "cancelled_at" TIMESTAMP DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    "updated_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_invoices_merchant_id ON invoices (merchant_id);
+
+CREATE INDEX idx_invoices_open_expires_at ON invoices (expires_at)
+WHERE
+    status = 'open';
+
+-- Optional breakdown of an invoice. The line amounts add up to the amount
+-- of the invoice.
+CREATE TABLE "invoice_line_items" (
+    "invoice_line_item_id" SERIAL PRIMARY KEY,
+    "invoice_id" INT NOT NULL REFERENCES "invoices" ("invoice_id"),
+    "description" VARCHAR(255) NOT NULL,
+    "quantity" INT NOT NULL CHECK ("quantity" > 0),
+    "unit_price" INT NOT NULL CHECK ("unit_price" > 0),
+    "amount" INT NOT NULL,
+    "created_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_invoice_line_items_invoice_id ON invoice_line_items (invoice_id);
+
+-- One row per payment, linking it to the merchant transaction it created.
+CREATE TABLE "invoice_payments" (
+    "invoice_payment_id" SERIAL PRIMARY KEY,
+    "invoice_id" INT NOT NULL REFERENCES "invoices" ("invoice_id"),
+    "transaction_id" INT UNIQUE NOT NULL REFERENCES "transactions" ("transaction_id"),
+    "amount" INT NOT NULL,
+    "created_at" timestamp DEFAULT current_timestamp
+);
+
+CREATE INDEX idx_invoice_payments_invoice_id ON invoice_payments (invoice_id);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS idx_invoice_payments_invoice_id;
+
+DROP TABLE IF EXISTS "invoice_payments";
+
+DROP INDEX IF EXISTS idx_invoice_line_items_invoice_id;
+
+DROP TABLE IF EXISTS "invoice_line_items";
+
+DROP INDEX IF EXISTS idx_invoices_open_expires_at;
+
+DROP INDEX IF EXISTS idx_invoices_merchant_id;
+
+DROP TABLE IF EXISTS "invoices";
+
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Merchant transactions paid by scanning a merchant QR code. A dynamic QR
+-- code carries a reference that can be paid only once per merchant; static
+-- QR codes have no reference and can be paid any number of times.
+CREATE TABLE "qr_payments" (
+    "qr_payment_id" SERIAL PRIMARY KEY,
+    "merchant_id" INT NOT NULL REFERENCES "merchants" ("merchant_id"),
+    "transaction_id" INT UNIQUE NOT NULL REFERENCES "transactions" ("transaction_id"),
+    "reference" VARCHAR(25) DEFAULT NULL,
+    "created_at" timestamp DEFAULT current_timestamp,
+    UNIQUE ("merchant_id", "reference")
+);
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS "qr_payments";
+
+-- +goose StatementEnd
@@ -0,0 +1,16 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Signing secrets are stored encrypted, which makes them longer than the
+-- plaintext secrets the column was sized for.
+ALTER TABLE "merchant_api_keys"
+ALTER COLUMN "signing_secret" TYPE TEXT;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+-- Fails while encrypted secrets are stored: the server before this migration
+-- cannot read them anyway.
+ALTER TABLE "merchant_api_keys"
+ALTER COLUMN "signing_secret" TYPE VARCHAR(100);
+
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+-- The simulator was the only payout provider before withdraws recorded
+-- theirs, so it is used to backfill. New withdraws must state it explicitly.
+ALTER TABLE "withdraws"
+ADD COLUMN "payout_provider" VARCHAR(50) NOT NULL DEFAULT 'simulator';
+
+ALTER TABLE "withdraws" ALTER COLUMN "payout_provider" DROP DEFAULT;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "withdraws" DROP COLUMN IF EXISTS "payout_provider";
+
+-- +goose StatementEnd
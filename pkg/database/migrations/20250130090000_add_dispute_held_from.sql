@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Disputes opened before payments accrued to merchant balances held the
+-- funds from the merchant card, so that is used to backfill.
+ALTER TABLE "disputes"
+ADD COLUMN "held_from" VARCHAR(20) NOT NULL DEFAULT 'merchant_card' CHECK (
+    held_from IN ('merchant_card', 'merchant_balance')
+);
+
+ALTER TABLE "disputes" ALTER COLUMN "held_from" DROP DEFAULT;
+
+-- +goose StatementEnd
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE "disputes" DROP COLUMN IF EXISTS "held_from";
+
+-- +goose StatementEnd
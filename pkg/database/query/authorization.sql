@@ -0,0 +1,142 @@
+-- CreateAuthorization: Creates a pending card authorization
+-- Purpose: Record funds reserved on a card for a later merchant capture
+-- Parameters:
+--   $1: card_number - Card whose funds are reserved
+--   $2: merchant_id - Merchant that requested the authorization
+--   $3: amount - Reserved amount
+--   $4: payment_method - Payment method used at checkout
+--   $5: expires_at - Time after which the authorization lapses
+-- Returns:
+--   The created authorization record
+-- Business Logic:
+--   - Authorization number is generated automatically
+--   - Status starts as 'authorized'
+--   - The caller reserves the amount on the card's saldo in the same transaction
+--   - The amount is recorded in the currency of the card
+-- name: CreateAuthorization :one
+INSERT INTO authorizations (
+    card_number,
+    merchant_id,
+    amount,
+    payment_method,
+    expires_at,
+    currency,
+    created_at,
+    updated_at
+)
+VALUES (
+    $1, $2, $3, $4, $5,
+    (SELECT c.currency FROM cards c WHERE c.card_number = $1),
+    current_timestamp, current_timestamp
+)
+RETURNING *;
+
+-- GetAuthorizationByID: Retrieves an authorization by ID
+-- Purpose: Fetch authorization details
+-- Parameters:
+--   $1: authorization_id - ID of the authorization
+-- Returns:
+--   The authorization record
+-- name: GetAuthorizationByID :one
+SELECT * FROM authorizations WHERE authorization_id = $1;
+
+-- GetAuthorizations: Retrieves paginated authorizations with search capability
+-- Purpose: List authorizations for the management UI
+-- Parameters:
+--   $1: search_term - Optional text to filter by card number, payment method or status (NULL for no filter)
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   All authorization fields plus total_count of matching records
+-- Business Logic:
+--   - Supports partial text matching on multiple fields (case-insensitive)
+--   - Orders by created_at (newest first)
+-- name: GetAuthorizations :many
+SELECT
+    *,
+    COUNT(*) OVER() AS total_count
+FROM
+    authorizations
+WHERE
+    ($1::TEXT IS NULL
+        OR card_number ILIKE '%' || $1 || '%'
+        OR payment_method ILIKE '%' || $1 || '%'
+        OR status ILIKE '%' || $1 || '%'
+    )
+ORDER BY
+    created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- CaptureAuthorization: Captures a pending authorization
+-- Purpose: Turn reserved funds into a merchant payment
+-- Parameters:
+--   $1: authorization_id - ID of the authorization
+--   $2: captured_amount - Amount captured, at most the authorized amount
+--   $3: transaction_id - Transaction created for the capture
+-- Returns:
+--   The updated authorization record, or no row if it cannot be captured
+-- Business Logic:
+--   - Only 'authorized' and unexpired authorizations can be captured
+--   - An authorization is captured once; any uncaptured remainder is released
+-- name: CaptureAuthorization :one
+UPDATE authorizations
+SET
+    status = 'captured',
+    captured_amount = $2,
+    transaction_id = $3,
+    captured_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    authorization_id = $1
+    AND status = 'authorized'
+    AND expires_at > current_timestamp
+    AND $2 <= amount
+RETURNING *;
+
+-- VoidAuthorization: Cancels a pending authorization
+-- Purpose: Release reserved funds without charging the card
+-- Parameters:
+--   $1: authorization_id - ID of the authorization
+-- Returns:
+--   The updated authorization record, or no row if it is no longer pending
+-- Business Logic:
+--   - Only 'authorized' authorizations can be voided
+-- name: VoidAuthorization :one
+UPDATE authorizations
+SET
+    status = 'voided',
+    voided_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    authorization_id = $1
+    AND status = 'authorized'
+RETURNING *;
+
+-- ExpireAuthorizations: Expires stale pending authorizations
+-- Purpose: Lapse authorizations that were never captured or voided
+-- Parameters:
+--   $1: limit - Maximum number of authorizations to expire in one batch
+-- Returns:
+--   The expired authorization records
+-- Business Logic:
+--   - Picks 'authorized' rows whose expires_at has passed, oldest first
+--   - SKIP LOCKED lets several workers sweep concurrently without blocking
+--     on authorizations that are being captured or voided
+--   - The caller releases the reserved amounts in the same transaction
+-- name: ExpireAuthorizations :many
+UPDATE authorizations
+SET
+    status = 'expired',
+    updated_at = current_timestamp
+WHERE
+    authorization_id IN (
+        SELECT a.authorization_id
+        FROM authorizations a
+        WHERE
+            a.status = 'authorized'
+            AND a.expires_at <= current_timestamp
+        ORDER BY a.expires_at
+        LIMIT $1
+        FOR UPDATE SKIP LOCKED
+    )
+RETURNING *;
@@ -239,6 +239,64 @@ FROM (
 
 
 
+-- GetTotalAmountsByCurrency: Calculates the dashboard totals per currency
+-- Purpose: Break the system-wide totals down by the currency amounts are kept in
+-- Returns:
+--   currency: Currency the totals are expressed in
+--   total_balance: Sum of active saldo balances
+--   total_topup_amount: Sum of active top-ups
+--   total_withdraw_amount: Sum of active withdrawals
+--   total_transaction_amount: Sum of active transactions
+--   total_transfer_amount: Sum of active transfers in the sender currency
+-- Business Logic:
+--   - Returns one row for every currency a card is issued in
+--   - Currencies without activity are reported with zero totals
+--   - Only includes records of active cards (deleted_at IS NULL)
+-- name: GetTotalAmountsByCurrency :many
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+)
+SELECT
+    cur.currency,
+    COALESCE((
+        SELECT SUM(s.total_balance)
+        FROM saldos s
+        JOIN cards c ON s.card_number = c.card_number
+        WHERE s.currency = cur.currency
+            AND s.deleted_at IS NULL AND c.deleted_at IS NULL
+    ), 0)::bigint AS total_balance,
+    COALESCE((
+        SELECT SUM(t.topup_amount)
+        FROM topups t
+        JOIN cards c ON t.card_number = c.card_number
+        WHERE t.currency = cur.currency
+            AND t.deleted_at IS NULL AND c.deleted_at IS NULL
+    ), 0)::bigint AS total_topup_amount,
+    COALESCE((
+        SELECT SUM(w.withdraw_amount)
+        FROM withdraws w
+        JOIN cards c ON w.card_number = c.card_number
+        WHERE w.currency = cur.currency
+            AND w.deleted_at IS NULL AND c.deleted_at IS NULL
+    ), 0)::bigint AS total_withdraw_amount,
+    COALESCE((
+        SELECT SUM(t.amount)
+        FROM transactions t
+        JOIN cards c ON t.card_number = c.card_number
+        WHERE t.currency = cur.currency
+            AND t.deleted_at IS NULL AND c.deleted_at IS NULL
+    ), 0)::bigint AS total_transaction_amount,
+    COALESCE((
+        SELECT SUM(t.transfer_amount)
+        FROM transfers t
+        WHERE t.currency = cur.currency
+            AND t.deleted_at IS NULL
+    ), 0)::bigint AS total_transfer_amount
+FROM
+    currencies cur
+ORDER BY
+    cur.currency;
+
 -- GetTotalBalanceByCardNumber: Calculates the total balance for a specific card
 -- Purpose: Get the current balance of a particular active card
 -- Parameters:
@@ -352,9 +410,10 @@ WHERE
 -- Business Logic:
 --   - Only includes active transfer records (deleted_at IS NULL)
 --   - Useful for tracking money received by a particular account
+--   - Sums converted_amount so the total is in the receiving card's currency
 -- name: GetTotalTransferAmountByReceiver :one
 SELECT
-    SUM(transfer_amount) AS total_transfer_amount
+    SUM(converted_amount) AS total_transfer_amount
 FROM
     transfers
 WHERE
@@ -371,6 +430,7 @@ WHERE
 -- Returns:
 --   month: 3-letter month abbreviation (e.g., 'Jan')
 --   total_balance: Sum of balances for that month (zero if no data)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Generates a complete 12-month series for the year
 --   - Includes only active saldos and cards (deleted_at IS NULL)
@@ -384,23 +444,32 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(s.total_balance), 0)::int AS total_balance
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     saldos s ON EXTRACT(MONTH FROM s.created_at) = EXTRACT(MONTH FROM m.month)
+    AND s.currency = cur.currency
     AND EXTRACT(YEAR FROM s.created_at) = EXTRACT(YEAR FROM m.month)
     AND s.deleted_at IS NULL
 LEFT JOIN
     cards c ON s.card_number = c.card_number
     AND c.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 -- GetYearlyBalances: Retrieves yearly balance totals for last 5 years
 -- Purpose: Provide annual balance trends for financial reporting
@@ -409,6 +478,7 @@ ORDER BY
 -- Returns:
 --   year: The 4-digit year
 --   total_balance: Sum of balances for that year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Covers a 5-year rolling window (reference_year-4 to reference_year)
 --   - Only includes active saldos and cards
@@ -418,6 +488,7 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM s.created_at) AS year,
+        s.currency,
         SUM(s.total_balance) AS total_balance
     FROM
         saldos s
@@ -428,15 +499,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM s.created_at) >= $1 - 4
         AND EXTRACT(YEAR FROM s.created_at) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM s.created_at)
+        EXTRACT(YEAR FROM s.created_at),
+        s.currency
 )
 SELECT
     year,
+    currency,
     total_balance
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
 
 -- GetMonthlyTopupAmount: Retrieves monthly top-up totals for a given year
 -- Purpose: Analyze monthly top-up patterns and trends
@@ -445,6 +519,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation
 --   total_topup_amount: Sum of top-ups for that month (zero if no data)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Generates complete 12-month series
 --   - Only includes active topups and cards
@@ -458,23 +533,32 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.topup_amount), 0)::int AS total_topup_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     topups t ON EXTRACT(MONTH FROM t.topup_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.topup_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 LEFT JOIN
     cards c ON t.card_number = c.card_number
     AND c.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 -- GetYearlyTopupAmount: Retrieves yearly top-up totals for last 5 years
 -- Purpose: Analyze long-term top-up trends and growth
@@ -483,6 +567,7 @@ ORDER BY
 -- Returns:
 --   year: The 4-digit year
 --   total_topup_amount: Sum of top-ups for that year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Covers a 5-year rolling window
 --   - Only includes active topups and cards
@@ -493,6 +578,7 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.topup_time) AS year,
+        t.currency,
         SUM(t.topup_amount) AS total_topup_amount
     FROM
         topups t
@@ -504,15 +590,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.topup_time) >= $1 - 4
         AND EXTRACT(YEAR FROM t.topup_time) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM t.topup_time)
+        EXTRACT(YEAR FROM t.topup_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_topup_amount
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
     
 
 -- GetMonthlyWithdrawAmount: Retrieves monthly withdraw totals for a given year
@@ -522,6 +611,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation
 --   total_withdraw_amount: Sum of withdraws for that month (zero if no data)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Generates complete 12-month series
 --   - Only includes active withdraws and cards
@@ -535,23 +625,32 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(w.withdraw_amount), 0)::int AS total_withdraw_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     withdraws w ON EXTRACT(MONTH FROM w.withdraw_time) = EXTRACT(MONTH FROM m.month)
+    AND w.currency = cur.currency
     AND EXTRACT(YEAR FROM w.withdraw_time) = EXTRACT(YEAR FROM m.month)
     AND w.deleted_at IS NULL
 LEFT JOIN
     cards c ON w.card_number = c.card_number
     AND c.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 
@@ -562,6 +661,7 @@ ORDER BY
 -- Returns:
 --   year: The 4-digit year
 --   total_withdraw_amount: Sum of withdraws for that year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Covers a 5-year rolling window
 --   - Only includes active withdraws and cards
@@ -572,6 +672,7 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM w.withdraw_time) AS year,
+        w.currency,
         SUM(w.withdraw_amount) AS total_withdraw_amount
     FROM
         withdraws w
@@ -583,15 +684,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM w.withdraw_time) >= $1 - 4
         AND EXTRACT(YEAR FROM w.withdraw_time) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM w.withdraw_time)
+        EXTRACT(YEAR FROM w.withdraw_time),
+        w.currency
 )
 SELECT
     year,
+    currency,
     total_withdraw_amount
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 -- GetMonthlyTransactionAmount: Retrieves monthly transaction totals for a given year
@@ -601,6 +705,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation
 --   total_transaction_amount: Sum of transactions for that month (zero if no data)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Generates complete 12-month series
 --   - Only includes active transactions and cards
@@ -614,23 +719,32 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.amount), 0)::int AS total_transaction_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 LEFT JOIN
     cards c ON t.card_number = c.card_number
     AND c.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 -- GetYearlyTransactionAmount: Retrieves yearly transaction totals for last 5 years
@@ -640,6 +754,7 @@ ORDER BY
 -- Returns:
 --   year: The 4-digit year
 --   total_transaction_amount: Sum of transactions for that year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Covers a 5-year rolling window
 --   - Only includes active transactions and cards
@@ -650,6 +765,7 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time) AS year,
+        t.currency,
         SUM(t.amount) AS total_transaction_amount
     FROM
         transactions t
@@ -661,15 +777,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transaction_time) >= $1 - 4
         AND EXTRACT(YEAR FROM t.transaction_time) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
+        EXTRACT(YEAR FROM t.transaction_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_transaction_amount
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 -- GetMonthlyTransferAmountSender: Retrieves monthly transfer totals for a given year
@@ -679,6 +798,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation
 --   total_sent_amount: Sum of transfers for that month (zero if no data)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Generates complete 12-month series
 --   - Only includes active transfers and cards
@@ -692,20 +812,29 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.transfer_amount), 0)::int AS total_sent_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 -- GetMonthlyTransferAmountReceiver: Retrieves monthly transfer totals for a given year
@@ -715,6 +844,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation
 --   total_received_amount: Sum of transfers for that month (zero if no data)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Generates complete 12-month series
 --   - Only includes active transfers and cards
@@ -728,20 +858,29 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
-    COALESCE(SUM(t.transfer_amount), 0)::int AS total_received_amount
+    cur.currency,
+    COALESCE(SUM(t.converted_amount), 0)::int AS total_received_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.to_currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 -- GetYearlyTransferAmountSender: Retrieves yearly transfer totals for last 5 years
@@ -751,6 +890,7 @@ ORDER BY
 -- Returns:
 --   year: The 4-digit year
 --   total_sent_amount: Sum of transfers for that year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Covers a 5-year rolling window
 --   - Only includes active topups and cards
@@ -761,6 +901,7 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transfer_time) AS year,
+        t.currency,
         SUM(t.transfer_amount) AS total_sent_amount
     FROM
         transfers t
@@ -769,15 +910,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transfer_time) >= $1 - 4
         AND EXTRACT(YEAR FROM t.transfer_time) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM t.transfer_time)
+        EXTRACT(YEAR FROM t.transfer_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_sent_amount
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 -- GetYearlyTransferAmountReceiver: Retrieves yearly transfer totals for last 5 years
@@ -787,6 +931,7 @@ ORDER BY
 -- Returns:
 --   year: The 4-digit year
 --   total_received_amount: Sum of transfers for that year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Covers a 5-year rolling window
 --   - Only includes active topups and cards
@@ -797,7 +942,8 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transfer_time) AS year,
-        SUM(t.transfer_amount) AS total_received_amount
+        t.to_currency AS currency,
+        SUM(t.converted_amount) AS total_received_amount
     FROM
         transfers t
     WHERE
@@ -805,15 +951,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transfer_time) >= $1 - 4
         AND EXTRACT(YEAR FROM t.transfer_time) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM t.transfer_time)
+        EXTRACT(YEAR FROM t.transfer_time),
+        t.to_currency
 )
 SELECT
     year,
+    currency,
     total_received_amount
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 
@@ -826,6 +975,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation (e.g., 'Jan')
 --   total_balance: Monthly balance total (0 if no data)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Generates complete 12-month series for the year
 --   - Filters for specific card number
@@ -839,14 +989,21 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $2
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(s.total_balance), 0)::int AS total_balance
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     saldos s ON EXTRACT(MONTH FROM s.created_at) = EXTRACT(MONTH FROM m.month)
+    AND s.currency = cur.currency
     AND EXTRACT(YEAR FROM s.created_at) = EXTRACT(YEAR FROM m.month)
     AND s.deleted_at IS NULL
 LEFT JOIN
@@ -854,9 +1011,11 @@ LEFT JOIN
     AND c.deleted_at IS NULL
     AND c.card_number = $2
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 -- GetYearlyBalancesByCardNumber: Retrieves 5-year balance history for a specific card
 -- Purpose: Show annual balance trends for individual cardholders
@@ -866,6 +1025,7 @@ ORDER BY
 -- Returns:
 --   year: 4-digit year
 --   total_balance: Annual balance total
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Covers reference_year-4 to reference_year (5 years)
 --   - Strictly filters for specified card
@@ -875,6 +1035,7 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM s.created_at) AS year,
+        s.currency,
         SUM(s.total_balance) AS total_balance
     FROM
         saldos s
@@ -886,15 +1047,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM s.created_at) <= $1
         AND c.card_number = $2
     GROUP BY
-        EXTRACT(YEAR FROM s.created_at)
+        EXTRACT(YEAR FROM s.created_at),
+        s.currency
 )
 SELECT
     year,
+    currency,
     total_balance
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
 
 -- GetMonthlyTopupAmountByCardNumber: Retrieves monthly top-up history for a card
 -- Purpose: Analyze monthly top-up patterns for individual cards
@@ -904,6 +1068,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation
 --   total_topup_amount: Monthly top-up total (0 if none)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Complete 12-month coverage
 --   - Card-specific filtering
@@ -917,14 +1082,21 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.topup_amount), 0)::int AS total_topup_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     topups t ON EXTRACT(MONTH FROM t.topup_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.topup_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 LEFT JOIN
@@ -932,9 +1104,11 @@ LEFT JOIN
     AND c.deleted_at IS NULL
     AND t.card_number = $1
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 -- GetYearlyTopupAmountByCardNumber: Retrieves 5-year top-up history for a card
 -- Purpose: Track long-term top-up trends for individual cards
@@ -944,6 +1118,7 @@ ORDER BY
 -- Returns:
 --   year: 4-digit year
 --   total_topup_amount: Annual top-up total
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - 5-year rolling window analysis
 --   - Strict card number filtering
@@ -954,6 +1129,7 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.topup_time) AS year,
+        t.currency,
         SUM(t.topup_amount) AS total_topup_amount
     FROM
         topups t
@@ -966,15 +1142,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.topup_time) >= $2 - 4
         AND EXTRACT(YEAR FROM t.topup_time) <= $2
     GROUP BY
-        EXTRACT(YEAR FROM t.topup_time)
+        EXTRACT(YEAR FROM t.topup_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_topup_amount
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 -- GetMonthlyWithdrawAmountByCardNumber: Retrieves monthly withdraw history for a card
@@ -985,6 +1164,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation
 --   total_withdraw_amount: Monthly withdraw total (0 if none)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Complete 12-month coverage
 --   - Card-specific filtering
@@ -998,14 +1178,21 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(w.withdraw_amount), 0)::int AS total_withdraw_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     withdraws w ON EXTRACT(MONTH FROM w.withdraw_time) = EXTRACT(MONTH FROM m.month)
+    AND w.currency = cur.currency
     AND EXTRACT(YEAR FROM w.withdraw_time) = EXTRACT(YEAR FROM m.month)
     AND w.deleted_at IS NULL
 LEFT JOIN
@@ -1013,9 +1200,11 @@ LEFT JOIN
     AND c.deleted_at IS NULL
     AND w.card_number = $1
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 -- GetYearlyWithdrawAmountByCardNumber: Retrieves 5-year withdraw history for a card
@@ -1026,6 +1215,7 @@ ORDER BY
 -- Returns:
 --   year: 4-digit year
 --   total_withdraw_amount: Annual withdraw total
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - 5-year rolling window analysis
 --   - Strict card number filtering
@@ -1036,6 +1226,7 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM w.withdraw_time) AS year,
+        w.currency,
         SUM(w.withdraw_amount) AS total_withdraw_amount
     FROM
         withdraws w
@@ -1048,15 +1239,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM w.withdraw_time) >= $2 - 4
         AND EXTRACT(YEAR FROM w.withdraw_time) <= $2
     GROUP BY
-        EXTRACT(YEAR FROM w.withdraw_time)
+        EXTRACT(YEAR FROM w.withdraw_time),
+        w.currency
 )
 SELECT
     year,
+    currency,
     total_withdraw_amount
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 -- GetMonthlyTransactionAmountByCardNumber: Retrieves monthly transaction history for a card
@@ -1067,6 +1261,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation
 --   total_transaction_amount: Monthly transaction total (0 if none)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Complete 12-month coverage
 --   - Card-specific filtering
@@ -1080,14 +1275,21 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.amount), 0)::int AS total_transaction_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 LEFT JOIN
@@ -1095,9 +1297,11 @@ LEFT JOIN
     AND c.deleted_at IS NULL
     AND t.card_number = $1
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 
@@ -1109,6 +1313,7 @@ ORDER BY
 -- Returns:
 --   year: 4-digit year
 --   total_transaction_amount: Annual transaction total
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - 5-year rolling window analysis
 --   - Strict card number filtering
@@ -1119,6 +1324,7 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time) AS year,
+        t.currency,
         SUM(t.amount) AS total_transaction_amount
     FROM
         transactions t
@@ -1131,15 +1337,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transaction_time) >= $2 - 4
         AND EXTRACT(YEAR FROM t.transaction_time) <= $2
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
+        EXTRACT(YEAR FROM t.transaction_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_transaction_amount
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 -- GetMonthlyTransferAmountBySender: Retrieves monthly transfer history for a card
@@ -1150,6 +1359,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation
 --   total_sent_amount: Monthly transfer total (0 if none)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Complete 12-month coverage
 --   - Card-specific filtering
@@ -1163,21 +1373,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.transfer_amount), 0)::int AS total_sent_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
     AND t.transfer_from = $1
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 -- GetMonthlyTransferAmountByReceiver: Retrieves monthly transfer history for a card
 -- Purpose: Analyze monthly transfer patterns for individual cards
@@ -1187,6 +1406,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation
 --   total_sent_amount: Monthly transfer total (0 if none)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Complete 12-month coverage
 --   - Card-specific filtering
@@ -1200,21 +1420,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
-    COALESCE(SUM(t.transfer_amount), 0)::int AS total_received_amount
+    cur.currency,
+    COALESCE(SUM(t.converted_amount), 0)::int AS total_received_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.to_currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
     AND t.transfer_to = $1
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 
@@ -1226,6 +1455,7 @@ ORDER BY
 -- Returns:
 --   year: 4-digit year
 --   total_sent_amount: Annual transfer total
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - 5-year rolling window analysis
 --   - Strict card number filtering
@@ -1236,6 +1466,7 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transfer_time) AS year,
+        t.currency,
         SUM(t.transfer_amount) AS total_sent_amount
     FROM
         transfers t
@@ -1245,15 +1476,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transfer_time) >= $2 - 4
         AND EXTRACT(YEAR FROM t.transfer_time) <= $2
     GROUP BY
-        EXTRACT(YEAR FROM t.transfer_time)
+        EXTRACT(YEAR FROM t.transfer_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_sent_amount
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 -- GetYearlyTransferAmountByReceiver: Retrieves 5-year transfer history for a card
@@ -1264,6 +1498,7 @@ ORDER BY
 -- Returns:
 --   year: 4-digit year
 --   total_sent_amount: Annual transfer total
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - 5-year rolling window analysis
 --   - Strict card number filtering
@@ -1274,7 +1509,8 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transfer_time) AS year,
-        SUM(t.transfer_amount) AS total_received_amount
+        t.to_currency AS currency,
+        SUM(t.converted_amount) AS total_received_amount
     FROM
         transfers t
     WHERE
@@ -1283,15 +1519,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transfer_time) >= $2 - 4
         AND EXTRACT(YEAR FROM t.transfer_time) <= $2
     GROUP BY
-        EXTRACT(YEAR FROM t.transfer_time)
+        EXTRACT(YEAR FROM t.transfer_time),
+        t.to_currency
 )
 SELECT
     year,
+    currency,
     total_received_amount
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 -- CreateCard: Creates a new card record
@@ -1303,10 +1542,12 @@ ORDER BY
 --   $4: expire_date - Expiration date of the card
 --   $5: cvv - Card verification value
 --   $6: card_provider - Provider/issuer of the card
+--   $7: currency - ISO 4217 code the card balance is kept in
 -- Returns: Complete created card record
 -- Business Logic:
 --   - Automatically sets created_at and updated_at timestamps
 --   - Requires all fields to be provided
+--   - The currency cannot be changed once the card is issued
 -- name: CreateCard :one
 INSERT INTO
     cards (
@@ -1316,6 +1557,7 @@ INSERT INTO
         expire_date,
         cvv,
         card_provider,
+        currency,
         created_at,
         updated_at
     )
@@ -1326,6 +1568,7 @@ VALUES (
         $4,
         $5,
         $6,
+        $7,
         current_timestamp,
         current_timestamp
     ) RETURNING *;
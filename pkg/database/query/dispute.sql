@@ -0,0 +1,148 @@
+-- CreateDispute: Opens a dispute on a merchant transaction
+-- Purpose: Record a cardholder contesting a merchant charge
+-- Parameters:
+--   $1: transaction_id - ID of the disputed transaction
+--   $2: card_number - Card that paid the transaction
+--   $3: merchant_id - Merchant that received the payment
+--   $4: amount - Disputed amount held from the merchant
+--   $5: reason - Reason given by the cardholder
+--   $6: opened_by - User who opened the dispute
+--   $7: held_from - Where the disputed amount is held from: merchant_card or merchant_balance
+-- Returns:
+--   The created dispute record
+-- Business Logic:
+--   - Dispute number is generated automatically
+--   - Status starts as 'open'
+--   - Only one open or under-review dispute may exist per transaction
+--   - The amount is recorded in the currency of the disputed transaction
+-- name: CreateDispute :one
+INSERT INTO disputes (
+    transaction_id,
+    card_number,
+    merchant_id,
+    amount,
+    reason,
+    opened_by,
+    held_from,
+    currency,
+    created_at,
+    updated_at
+)
+VALUES (
+    $1, $2, $3, $4, $5, $6, $7,
+    (SELECT t.currency FROM transactions t WHERE t.transaction_id = $1),
+    current_timestamp, current_timestamp
+)
+RETURNING *;
+
+-- GetDisputeByID: Retrieves a dispute by ID
+-- Purpose: Fetch dispute details
+-- Parameters:
+--   $1: dispute_id - ID of the dispute
+-- Returns:
+--   The dispute record
+-- name: GetDisputeByID :one
+SELECT * FROM disputes WHERE dispute_id = $1;
+
+-- GetDisputes: Retrieves paginated disputes with search capability
+-- Purpose: List disputes for the admin review queue
+-- Parameters:
+--   $1: search_term - Optional text to filter by card number, reason or status (NULL for no filter)
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   All dispute fields plus total_count of matching records
+-- Business Logic:
+--   - Supports partial text matching on multiple fields (case-insensitive)
+--   - Orders by created_at (newest first)
+-- name: GetDisputes :many
+SELECT
+    *,
+    COUNT(*) OVER() AS total_count
+FROM
+    disputes
+WHERE
+    ($1::TEXT IS NULL
+        OR card_number ILIKE '%' || $1 || '%'
+        OR reason ILIKE '%' || $1 || '%'
+        OR status ILIKE '%' || $1 || '%'
+    )
+ORDER BY
+    created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- GetDisputesByMerchantID: Retrieves paginated disputes of a merchant
+-- Purpose: Let a merchant see the disputes raised against its transactions
+-- Parameters:
+--   $1: merchant_id - ID of the merchant
+--   $2: search_term - Optional text to filter by card number, reason or status (NULL for no filter)
+--   $3: limit - Maximum number of records to return
+--   $4: offset - Number of records to skip for pagination
+-- Returns:
+--   All dispute fields plus total_count of matching records
+-- Business Logic:
+--   - Supports partial text matching on multiple fields (case-insensitive)
+--   - Orders by created_at (newest first)
+-- name: GetDisputesByMerchantID :many
+SELECT
+    *,
+    COUNT(*) OVER() AS total_count
+FROM
+    disputes
+WHERE
+    merchant_id = $1
+    AND ($2::TEXT IS NULL
+        OR card_number ILIKE '%' || $2 || '%'
+        OR reason ILIKE '%' || $2 || '%'
+        OR status ILIKE '%' || $2 || '%'
+    )
+ORDER BY
+    created_at DESC
+LIMIT $3 OFFSET $4;
+
+-- RespondDispute: Stores the merchant's evidence for a dispute
+-- Purpose: Move an open dispute to admin review
+-- Parameters:
+--   $1: dispute_id - ID of the dispute
+--   $2: merchant_evidence - Evidence notes supplied by the merchant
+-- Returns:
+--   The updated dispute record, or no row if the dispute is not open
+-- Business Logic:
+--   - Only 'open' disputes accept a response
+--   - Status becomes 'under_review'
+-- name: RespondDispute :one
+UPDATE disputes
+SET
+    merchant_evidence = $2,
+    status = 'under_review',
+    responded_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    dispute_id = $1
+    AND status = 'open'
+RETURNING *;
+
+-- ResolveDispute: Records the admin ruling on a dispute
+-- Purpose: Close a dispute in favour of the cardholder or the merchant
+-- Parameters:
+--   $1: dispute_id - ID of the dispute
+--   $2: status - 'resolved_cardholder' or 'resolved_merchant'
+--   $3: resolution_note - Explanation of the ruling
+--   $4: resolved_by - Admin user who ruled
+-- Returns:
+--   The updated dispute record, or no row if the dispute is already resolved
+-- Business Logic:
+--   - Only 'open' and 'under_review' disputes can be resolved
+--   - The status guard makes concurrent rulings resolve the dispute once
+-- name: ResolveDispute :one
+UPDATE disputes
+SET
+    status = $2,
+    resolution_note = $3,
+    resolved_by = $4,
+    resolved_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    dispute_id = $1
+    AND status IN ('open', 'under_review')
+RETURNING *;
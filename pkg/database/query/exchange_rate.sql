@@ -0,0 +1,85 @@
+-- UpsertExchangeRate: Creates or replaces the rate of a currency pair
+-- Purpose: Maintain the rates used to convert transfers between currencies
+-- Parameters:
+--   $1: base_currency - Currency being converted from
+--   $2: quote_currency - Currency being converted to
+--   $3: rate - Units of quote_currency per unit of base_currency
+--   $4: spread_bps - Spread kept by the platform in basis points
+-- Returns:
+--   The stored exchange rate record
+-- Business Logic:
+--   - A pair has at most one rate; setting it again overwrites the previous one
+--   - Rates are directional, the reverse pair is maintained separately
+-- name: UpsertExchangeRate :one
+INSERT INTO exchange_rates (
+    base_currency,
+    quote_currency,
+    rate,
+    spread_bps,
+    created_at,
+    updated_at
+)
+VALUES ($1, $2, $3, $4, current_timestamp, current_timestamp)
+ON CONFLICT (base_currency, quote_currency) DO UPDATE
+SET
+    rate = EXCLUDED.rate,
+    spread_bps = EXCLUDED.spread_bps,
+    updated_at = current_timestamp
+RETURNING *;
+
+-- GetExchangeRates: Retrieves paginated exchange rates with search capability
+-- Purpose: List the maintained currency pairs for administration
+-- Parameters:
+--   $1: search_term - Optional filter on base or quote currency (NULL for no filter)
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   Exchange rate records with total_count for pagination
+-- Business Logic:
+--   - Ordered by currency pair
+-- name: GetExchangeRates :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM exchange_rates
+WHERE (
+        $1::TEXT IS NULL
+        OR base_currency ILIKE '%' || $1 || '%'
+        OR quote_currency ILIKE '%' || $1 || '%'
+    )
+ORDER BY base_currency, quote_currency
+LIMIT $2 OFFSET $3;
+
+-- GetExchangeRateByID: Retrieves a single exchange rate
+-- Purpose: Show the detail of one currency pair
+-- Parameters:
+--   $1: exchange_rate_id - Unique identifier of the rate
+-- Returns:
+--   The exchange rate record
+-- name: GetExchangeRateByID :one
+SELECT * FROM exchange_rates WHERE exchange_rate_id = $1;
+
+-- GetExchangeRateByPair: Retrieves the rate of a currency pair
+-- Purpose: Look up the rate to apply when converting between two currencies
+-- Parameters:
+--   $1: base_currency - Currency being converted from
+--   $2: quote_currency - Currency being converted to
+-- Returns:
+--   The exchange rate record
+-- name: GetExchangeRateByPair :one
+SELECT *
+FROM exchange_rates
+WHERE
+    base_currency = $1
+    AND quote_currency = $2;
+
+-- DeleteExchangeRate: Removes the rate of a currency pair
+-- Purpose: Stop conversions between two currencies
+-- Parameters:
+--   $1: exchange_rate_id - Unique identifier of the rate
+-- Returns:
+--   The deleted exchange rate record
+-- Business Logic:
+--   - Transfers keep the rate they were converted with, so rates can be removed safely
+-- name: DeleteExchangeRate :one
+DELETE FROM exchange_rates WHERE exchange_rate_id = $1 RETURNING *;
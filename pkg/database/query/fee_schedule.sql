@@ -0,0 +1,314 @@
+-- CreateFeeSchedule: Creates a new fee schedule
+-- Purpose: Define how much the platform charges for a type of transaction
+-- Parameters:
+--   $1: name - Display name of the schedule
+--   $2: transaction_type - transfer, withdraw, topup or transaction
+--   $3: fee_type - flat, percentage or tiered
+--   $4: payment_method - Payment or topup method the schedule is limited to (NULL for any)
+--   $5: card_type - Card type the schedule is limited to (NULL for any)
+--   $6: currency - Currency the schedule is limited to (NULL for any)
+--   $7: flat_amount - Fixed fee in minor units, used by flat schedules
+--   $8: percentage_bps - Fee rate in basis points, used by percentage schedules
+--   $9: min_fee - Lower bound of the computed fee (NULL for none)
+--   $10: max_fee - Upper bound of the computed fee (NULL for none)
+--   $11: is_active - Whether the schedule is applied to new transactions
+-- Returns:
+--   The created fee schedule record
+-- Business Logic:
+--   - Tiers of tiered schedules are stored separately in fee_schedule_tiers
+-- name: CreateFeeSchedule :one
+INSERT INTO
+    fee_schedules (
+        name,
+        transaction_type,
+        fee_type,
+        payment_method,
+        card_type,
+        currency,
+        flat_amount,
+        percentage_bps,
+        min_fee,
+        max_fee,
+        is_active,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        current_timestamp,
+        current_timestamp
+    ) RETURNING *;
+
+-- UpdateFeeSchedule: Updates an existing fee schedule
+-- Purpose: Change the pricing or the scope of a fee schedule
+-- Parameters:
+--   $1: fee_schedule_id - Unique identifier of the schedule
+--   $2 - $12: Same fields as CreateFeeSchedule
+-- Returns:
+--   The updated fee schedule record
+-- Business Logic:
+--   - Fees already charged are stored on their transaction and are not recomputed
+-- name: UpdateFeeSchedule :one
+UPDATE fee_schedules
+SET
+    name = $2,
+    transaction_type = $3,
+    fee_type = $4,
+    payment_method = $5,
+    card_type = $6,
+    currency = $7,
+    flat_amount = $8,
+    percentage_bps = $9,
+    min_fee = $10,
+    max_fee = $11,
+    is_active = $12,
+    updated_at = current_timestamp
+WHERE
+    fee_schedule_id = $1
+RETURNING *;
+
+-- GetFeeSchedules: Retrieves paginated fee schedules with search capability
+-- Purpose: List the configured fee schedules for administration
+-- Parameters:
+--   $1: search_term - Optional filter on name or transaction type (NULL for no filter)
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   Fee schedule records with total_count for pagination
+-- Business Logic:
+--   - Ordered by transaction type, then most recently created first
+-- name: GetFeeSchedules :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM fee_schedules
+WHERE (
+        $1::TEXT IS NULL
+        OR name ILIKE '%' || $1 || '%'
+        OR transaction_type ILIKE '%' || $1 || '%'
+    )
+ORDER BY transaction_type, created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- GetFeeScheduleByID: Retrieves a single fee schedule
+-- Purpose: Show the detail of one fee schedule
+-- Parameters:
+--   $1: fee_schedule_id - Unique identifier of the schedule
+-- Returns:
+--   The fee schedule record
+-- name: GetFeeScheduleByID :one
+SELECT * FROM fee_schedules WHERE fee_schedule_id = $1;
+
+-- GetApplicableFeeSchedule: Finds the fee schedule to apply to a transaction
+-- Purpose: Pick the pricing of a new transfer, withdraw, topup or merchant transaction
+-- Parameters:
+--   $1: transaction_type - Type of the transaction being charged
+--   $2: payment_method - Payment or topup method of the transaction ('' when not applicable)
+--   $3: card_type - Type of the paying card
+--   $4: currency - Currency of the paying card
+-- Returns:
+--   The most specific active schedule matching the transaction
+-- Business Logic:
+--   - NULL filters on a schedule match any value
+--   - Schedules with more filters set win over broader ones
+--   - The newest schedule wins between equally specific ones
+-- name: GetApplicableFeeSchedule :one
+SELECT *
+FROM fee_schedules
+WHERE
+    transaction_type = $1
+    AND is_active = TRUE
+    AND (
+        payment_method IS NULL
+        OR payment_method = $2::VARCHAR
+    )
+    AND (
+        card_type IS NULL
+        OR card_type = $3::VARCHAR
+    )
+    AND (
+        currency IS NULL
+        OR currency = $4::CHAR(3)
+    )
+ORDER BY (payment_method IS NOT NULL)::INT + (card_type IS NOT NULL)::INT + (currency IS NOT NULL)::INT DESC, fee_schedule_id DESC
+LIMIT 1;
+
+-- DeleteFeeSchedule: Removes a fee schedule
+-- Purpose: Stop charging a fee
+-- Parameters:
+--   $1: fee_schedule_id - Unique identifier of the schedule
+-- Returns:
+--   The deleted fee schedule record
+-- Business Logic:
+--   - Tiers are removed along with the schedule
+--   - Fees already charged are stored on their transaction and are kept
+-- name: DeleteFeeSchedule :one
+DELETE FROM fee_schedules WHERE fee_schedule_id = $1 RETURNING *;
+
+-- CreateFeeScheduleTier: Adds a tier to a tiered fee schedule
+-- Purpose: Price transactions differently depending on their amount
+-- Parameters:
+--   $1: fee_schedule_id - Schedule the tier belongs to
+--   $2: min_amount - Smallest amount the tier applies to
+--   $3: flat_amount - Fixed part of the fee
+--   $4: percentage_bps - Proportional part of the fee in basis points
+-- Returns:
+--   The created tier record
+-- name: CreateFeeScheduleTier :one
+INSERT INTO
+    fee_schedule_tiers (
+        fee_schedule_id,
+        min_amount,
+        flat_amount,
+        percentage_bps,
+        created_at
+    )
+VALUES ($1, $2, $3, $4, current_timestamp) RETURNING *;
+
+-- DeleteFeeScheduleTiers: Removes every tier of a fee schedule
+-- Purpose: Replace the tiers of a schedule when it is updated
+-- Parameters:
+--   $1: fee_schedule_id - Schedule whose tiers are removed
+-- name: DeleteFeeScheduleTiers :exec
+DELETE FROM fee_schedule_tiers WHERE fee_schedule_id = $1;
+
+-- GetFeeScheduleTiersByScheduleIDs: Retrieves the tiers of one or many schedules
+-- Purpose: Load tiers for one or many schedules in a single round trip
+-- Parameters:
+--   $1: fee_schedule_ids - Array of schedule IDs
+-- Returns:
+--   Tier records ordered by schedule and min_amount
+-- name: GetFeeScheduleTiersByScheduleIDs :many
+SELECT *
+FROM fee_schedule_tiers
+WHERE
+    fee_schedule_id = ANY(sqlc.arg(fee_schedule_ids)::INT[])
+ORDER BY fee_schedule_id, min_amount;
+
+-- GetMonthlyFeeAmounts: Retrieves total fees charged per month for the selected year
+-- Purpose: Report platform fee revenue across months in a given year
+-- Parameters:
+--   $1: reference_date - Any date within the target year
+-- Returns:
+--   month: 3-letter month abbreviation
+--   transaction_type: transfer, withdraw, topup or transaction
+--   currency: Currency the fees were charged in
+--   total_fee: Sum of the fees charged in the month
+-- Business Logic:
+--   - Only successful, non-deleted transactions are counted
+--   - Every month is returned for every transaction type and currency, with 0 when nothing was charged
+-- name: GetMonthlyFeeAmounts :many
+WITH months AS (
+    SELECT generate_series(
+        date_trunc('year', $1::timestamp),
+        date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
+        interval '1 month'
+    ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
+),
+transaction_types AS (
+    SELECT unnest(ARRAY['transfer', 'withdraw', 'topup', 'transaction']) AS transaction_type
+),
+fees AS (
+    SELECT 'transfer' AS transaction_type, t.currency, t.fee, t.transfer_time AS charged_at
+    FROM transfers t
+    WHERE t.status = 'success' AND t.deleted_at IS NULL
+    UNION ALL
+    SELECT 'withdraw', w.currency, w.fee, w.withdraw_time
+    FROM withdraws w
+    WHERE w.status = 'success' AND w.deleted_at IS NULL
+    UNION ALL
+    SELECT 'topup', tp.currency, tp.fee, tp.topup_time
+    FROM topups tp
+    WHERE tp.status = 'success' AND tp.deleted_at IS NULL
+    UNION ALL
+    SELECT 'transaction', tr.currency, tr.fee, tr.transaction_time
+    FROM transactions tr
+    WHERE tr.status = 'success' AND tr.deleted_at IS NULL
+)
+SELECT
+    TO_CHAR(m.month, 'Mon') AS month,
+    tt.transaction_type::TEXT AS transaction_type,
+    cur.currency,
+    COALESCE(SUM(f.fee), 0)::int AS total_fee
+FROM
+    months m
+CROSS JOIN
+    transaction_types tt
+CROSS JOIN
+    currencies cur
+LEFT JOIN
+    fees f ON f.transaction_type = tt.transaction_type
+    AND f.currency = cur.currency
+    AND EXTRACT(MONTH FROM f.charged_at) = EXTRACT(MONTH FROM m.month)
+    AND EXTRACT(YEAR FROM f.charged_at) = EXTRACT(YEAR FROM m.month)
+GROUP BY
+    m.month,
+    tt.transaction_type,
+    cur.currency
+ORDER BY
+    m.month,
+    tt.transaction_type,
+    cur.currency;
+
+-- GetYearlyFeeAmounts: Retrieves yearly totals of fees charged
+-- Purpose: Compare platform fee revenue across years
+-- Parameters:
+--   $1: current_year - The latest year to include, includes 5-year span (current_year - 4)
+-- Returns:
+--   year: Year the fees were charged
+--   transaction_type: transfer, withdraw, topup or transaction
+--   currency: Currency the fees were charged in
+--   total_fee: Sum of the fees charged in the year
+-- Business Logic:
+--   - Only successful, non-deleted transactions are counted
+--   - Only years and types with fees are returned
+-- name: GetYearlyFeeAmounts :many
+WITH fees AS (
+    SELECT 'transfer' AS transaction_type, t.currency, t.fee, t.transfer_time AS charged_at
+    FROM transfers t
+    WHERE t.status = 'success' AND t.deleted_at IS NULL
+    UNION ALL
+    SELECT 'withdraw', w.currency, w.fee, w.withdraw_time
+    FROM withdraws w
+    WHERE w.status = 'success' AND w.deleted_at IS NULL
+    UNION ALL
+    SELECT 'topup', tp.currency, tp.fee, tp.topup_time
+    FROM topups tp
+    WHERE tp.status = 'success' AND tp.deleted_at IS NULL
+    UNION ALL
+    SELECT 'transaction', tr.currency, tr.fee, tr.transaction_time
+    FROM transactions tr
+    WHERE tr.status = 'success' AND tr.deleted_at IS NULL
+)
+SELECT
+    EXTRACT(YEAR FROM f.charged_at)::int AS year,
+    f.transaction_type::TEXT AS transaction_type,
+    f.currency,
+    SUM(f.fee)::int AS total_fee
+FROM
+    fees f
+WHERE
+    f.fee > 0
+    AND EXTRACT(YEAR FROM f.charged_at) >= $1 - 4
+    AND EXTRACT(YEAR FROM f.charged_at) <= $1
+GROUP BY
+    EXTRACT(YEAR FROM f.charged_at),
+    f.transaction_type,
+    f.currency
+ORDER BY
+    year,
+    transaction_type,
+    currency;
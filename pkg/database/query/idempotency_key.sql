@@ -0,0 +1,92 @@
+-- CreateIdempotencyKey: Reserves an idempotency key for a request
+-- Purpose: Claim a client supplied key before a money-creating mutation runs
+-- Parameters:
+--   $1: user_id - ID of the user sending the request
+--   $2: scope - Mutation the key belongs to (e.g. create_transfer)
+--   $3: idempotency_key - The key supplied by the client
+--   $4: request_fingerprint - SHA-256 hash of the request payload
+--   $5: ttl_seconds - Seconds from now after which the key may be reused
+-- Returns: The reserved key record, or no row if a live key already exists
+-- Business Logic:
+--   - Keys are unique per user and scope
+--   - The expiry is computed from the database clock, the same one it is
+--     compared with, so it does not depend on the time zone of the server
+--   - An expired key is taken over and its stored response cleared
+--   - A live key is left untouched so the caller can replay or reject
+-- name: CreateIdempotencyKey :one
+INSERT INTO idempotency_keys (
+    user_id,
+    scope,
+    idempotency_key,
+    request_fingerprint,
+    expires_at,
+    created_at,
+    updated_at
+)
+VALUES (
+    sqlc.arg(user_id),
+    sqlc.arg(scope),
+    sqlc.arg(idempotency_key),
+    sqlc.arg(request_fingerprint),
+    current_timestamp + sqlc.arg(ttl_seconds)::INT * interval '1 second',
+    current_timestamp,
+    current_timestamp
+)
+ON CONFLICT (user_id, scope, idempotency_key) DO UPDATE
+SET
+    request_fingerprint = EXCLUDED.request_fingerprint,
+    response_body = NULL,
+    expires_at = EXCLUDED.expires_at,
+    created_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    idempotency_keys.expires_at <= current_timestamp
+RETURNING *;
+
+-- FindIdempotencyKey: Retrieves a live idempotency key
+-- Purpose: Look up the stored fingerprint and response for a repeated key
+-- Parameters:
+--   $1: user_id - ID of the user sending the request
+--   $2: scope - Mutation the key belongs to
+--   $3: idempotency_key - The key supplied by the client
+-- Returns: The key record if it has not expired
+-- Business Logic:
+--   - Expired keys are ignored
+-- name: FindIdempotencyKey :one
+SELECT *
+FROM idempotency_keys
+WHERE
+    user_id = $1
+    AND scope = $2
+    AND idempotency_key = $3
+    AND expires_at > current_timestamp;
+
+-- UpdateIdempotencyKeyResponse: Stores the response produced for a key
+-- Purpose: Remember the result so repeats can be replayed
+-- Parameters:
+--   $1: idempotency_key_id - ID of the reserved key
+--   $2: response_body - Serialized response returned to the client
+-- Returns: The updated key record
+-- Business Logic:
+--   - Marks the key as completed
+-- name: UpdateIdempotencyKeyResponse :one
+UPDATE idempotency_keys
+SET
+    response_body = $2,
+    updated_at = current_timestamp
+WHERE
+    idempotency_key_id = $1
+RETURNING *;
+
+-- DeleteIdempotencyKey: Releases a reserved idempotency key
+-- Purpose: Allow the client to retry after the request failed
+-- Parameters:
+--   $1: idempotency_key_id - ID of the reserved key
+-- Returns: Nothing
+-- Business Logic:
+--   - Only keys without a stored response are released
+-- name: DeleteIdempotencyKey :exec
+DELETE FROM idempotency_keys
+WHERE
+    idempotency_key_id = $1
+    AND response_body IS NULL;
@@ -0,0 +1,216 @@
+-- CreateInvoice: Creates an open invoice or payment link
+-- Purpose: Let a merchant ask a customer for a payment
+-- Parameters:
+--   $1: code - Public code customers pay with
+--   $2: merchant_id - Merchant that is paid
+--   $3: kind - 'invoice' or 'payment_link'
+--   $4: description - What the payment is for
+--   $5: amount - Amount to pay
+--   $6: currency - Currency of the merchant
+--   $7: expires_at - Time after which it can no longer be paid
+-- Returns:
+--   The created invoice
+-- name: CreateInvoice :one
+INSERT INTO
+    invoices (
+        code,
+        merchant_id,
+        kind,
+        description,
+        amount,
+        currency,
+        expires_at,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        current_timestamp,
+        current_timestamp
+    ) RETURNING *;
+
+-- CreateInvoiceLineItem: Adds a line item to an invoice
+-- Purpose: Break an invoice down into what is charged
+-- Parameters:
+--   $1: invoice_id - Invoice the line belongs to
+--   $2: description - What is charged
+--   $3: quantity - How many units
+--   $4: unit_price - Price of one unit
+--   $5: amount - quantity times unit_price
+-- Returns:
+--   The created line item
+-- name: CreateInvoiceLineItem :one
+INSERT INTO
+    invoice_line_items (
+        invoice_id,
+        description,
+        quantity,
+        unit_price,
+        amount,
+        created_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        current_timestamp
+    ) RETURNING *;
+
+-- GetInvoiceByID: Retrieves an invoice by ID
+-- Purpose: Show a merchant one of its invoices
+-- Parameters:
+--   $1: invoice_id - ID of the invoice
+-- Returns:
+--   The invoice record
+-- name: GetInvoiceByID :one
+SELECT * FROM invoices WHERE invoice_id = $1;
+
+-- GetInvoiceByCode: Retrieves an invoice by its public code
+-- Purpose: Show a customer what they are asked to pay
+-- Parameters:
+--   $1: code - Public code of the invoice
+-- Returns:
+--   The invoice record
+-- name: GetInvoiceByCode :one
+SELECT * FROM invoices WHERE code = $1;
+
+-- GetOpenInvoiceByCodeForUpdate: Locks a payable invoice by its public code
+-- Purpose: Pay an invoice without racing another payment or a cancellation
+-- Parameters:
+--   $1: code - Public code of the invoice
+-- Business Logic:
+--   - Only open invoices that have not expired yet can be paid, even when
+--     the expiry job has not caught up with them
+-- Returns:
+--   The invoice record, locked until the surrounding transaction ends, or no
+--   row if it can't be paid
+-- name: GetOpenInvoiceByCodeForUpdate :one
+SELECT *
+FROM invoices
+WHERE
+    code = $1
+    AND status = 'open'
+    AND expires_at > current_timestamp
+FOR UPDATE;
+
+-- GetInvoicesByMerchant: Retrieves the paginated invoices of a merchant
+-- Purpose: Show a merchant its invoices and payment links
+-- Parameters:
+--   $1: merchant_id - Merchant the invoices belong to
+--   $2: status - Only invoices in this status, or all when empty
+--   $3: limit - Maximum number of records to return
+--   $4: offset - Number of records to skip for pagination
+-- Returns:
+--   Invoice records with total_count for pagination, newest first
+-- name: GetInvoicesByMerchant :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM invoices
+WHERE
+    merchant_id = $1
+    AND (
+        $2::varchar = ''
+        OR status = $2
+    )
+ORDER BY created_at DESC, invoice_id DESC
+LIMIT $3 OFFSET $4;
+
+-- GetInvoiceLineItems: Lists the line items of an invoice
+-- Purpose: Show what an invoice charges for
+-- Parameters:
+--   $1: invoice_id - Invoice the lines belong to
+-- Returns:
+--   The line items in the order they were added
+-- name: GetInvoiceLineItems :many
+SELECT *
+FROM invoice_line_items
+WHERE
+    invoice_id = $1
+ORDER BY invoice_line_item_id;
+
+-- CreateInvoicePayment: Links a payment of an invoice to its transaction
+-- Purpose: Record what paid an invoice or payment link
+-- Parameters:
+--   $1: invoice_id - Invoice that was paid
+--   $2: transaction_id - Merchant transaction the payment created
+--   $3: amount - Amount paid
+-- Returns:
+--   The created payment
+-- name: CreateInvoicePayment :one
+INSERT INTO
+    invoice_payments (
+        invoice_id,
+        transaction_id,
+        amount,
+        created_at
+    )
+VALUES ($1, $2, $3, current_timestamp) RETURNING *;
+
+-- GetInvoicePayments: Lists the payments of an invoice
+-- Purpose: Show a merchant who paid an invoice or payment link
+-- Parameters:
+--   $1: invoice_id - Invoice that was paid
+-- Returns:
+--   The payments, oldest first
+-- name: GetInvoicePayments :many
+SELECT *
+FROM invoice_payments
+WHERE
+    invoice_id = $1
+ORDER BY invoice_payment_id;
+
+-- MarkInvoicePaid: Marks an invoice as paid
+-- Purpose: Close an invoice once its payment succeeded
+-- Parameters:
+--   $1: invoice_id - ID of the invoice
+-- Returns:
+--   The updated invoice, or no row if it is no longer open
+-- name: MarkInvoicePaid :one
+UPDATE invoices
+SET
+    status = 'paid',
+    paid_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    invoice_id = $1
+    AND status = 'open'
+RETURNING *;
+
+-- CancelInvoice: Cancels an open invoice
+-- Purpose: Let a merchant withdraw an invoice or payment link
+-- Parameters:
+--   $1: invoice_id - ID of the invoice
+-- Returns:
+--   The updated invoice, or no row if it is no longer open
+-- name: CancelInvoice :one
+UPDATE invoices
+SET
+    status = 'cancelled',
+    cancelled_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    invoice_id = $1
+    AND status = 'open'
+RETURNING *;
+
+-- ExpireInvoices: Expires open invoices past their expiry
+-- Purpose: Stop stale invoices and payment links from being paid
+-- Returns:
+--   Number of invoices expired
+-- name: ExpireInvoices :execrows
+UPDATE invoices
+SET
+    status = 'expired',
+    updated_at = current_timestamp
+WHERE
+    status = 'open'
+    AND expires_at <= current_timestamp;
@@ -0,0 +1,179 @@
+-- CreateLedgerJournal: Opens a new journal entry in the ledger
+-- Purpose: Group the postings that together describe one money movement
+-- Parameters:
+--   $1: reference_type - Kind of business event (topup, withdraw, transfer, transaction, ...)
+--   $2: reference_id - ID of the originating record (NULL for manual entries)
+--   $3: description - Human readable explanation of the entry
+-- Returns:
+--   The created journal record
+-- Business Logic:
+--   - Journals are append-only and never updated or soft-deleted
+--   - posted_at and journal_no are generated by the database
+-- name: CreateLedgerJournal :one
+INSERT INTO
+    ledger_journals (
+        reference_type,
+        reference_id,
+        description,
+        posted_at,
+        created_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        current_timestamp,
+        current_timestamp
+    ) RETURNING *;
+
+-- CreateLedgerPosting: Adds a debit or credit line to a journal
+-- Purpose: Record one side of a money movement against an account
+-- Parameters:
+--   $1: journal_id - Journal the posting belongs to
+--   $2: account_code - CARD for card accounts, otherwise a system account code
+--   $3: card_number - Card account being posted to (NULL for system accounts)
+--   $4: direction - 'debit' or 'credit'
+--   $5: amount - Positive amount of the posting
+--   $6: currency - Currency the amount is expressed in
+-- Returns:
+--   The created posting record
+-- Business Logic:
+--   - Postings are append-only; corrections are made with new journals
+--   - The caller is responsible for keeping debits and credits balanced per journal and currency
+-- name: CreateLedgerPosting :one
+INSERT INTO
+    ledger_postings (
+        journal_id,
+        account_code,
+        card_number,
+        direction,
+        amount,
+        currency,
+        created_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        current_timestamp
+    ) RETURNING *;
+
+-- ApplyLedgerPostingToSaldo: Projects a card posting onto the saldo balance
+-- Purpose: Keep saldos.total_balance in step with the ledger
+-- Parameters:
+--   $1: delta - Signed amount (credit positive, debit negative)
+--   $2: card_number - Card whose saldo is adjusted
+--   $3: currency - Currency of the posting
+-- Returns:
+--   The updated saldo record
+-- Business Logic:
+--   - Applies a relative change instead of overwriting the balance
+--   - Only active saldos are projected
+--   - Refuses to drive the balance below the reserved balance, so debits
+--     cannot spend funds set aside by active saldo holds; no row is returned then
+--   - Postings in another currency than the saldo are refused the same way
+-- name: ApplyLedgerPostingToSaldo :one
+UPDATE saldos
+SET
+    total_balance = total_balance + sqlc.arg(delta)::INT,
+    updated_at = current_timestamp
+WHERE
+    card_number = sqlc.arg(card_number)
+    AND currency = sqlc.arg(currency)
+    AND deleted_at IS NULL
+    AND total_balance + sqlc.arg(delta)::INT >= reserved_balance
+RETURNING *;
+
+-- GetLedgerJournals: Retrieves paginated ledger journals with search capability
+-- Purpose: Browse the audit trail of money movements
+-- Parameters:
+--   $1: search_term - Optional filter on journal_no, reference_type or description (NULL for no filter)
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   Journal records with total_count for pagination
+-- Business Logic:
+--   - Most recent journals first
+--   - Supports partial, case-insensitive search
+-- name: GetLedgerJournals :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM ledger_journals
+WHERE (
+        $1::TEXT IS NULL
+        OR journal_no::TEXT ILIKE '%' || $1 || '%'
+        OR reference_type ILIKE '%' || $1 || '%'
+        OR description ILIKE '%' || $1 || '%'
+    )
+ORDER BY posted_at DESC, journal_id DESC
+LIMIT $2 OFFSET $3;
+
+-- GetLedgerJournalByID: Retrieves a single journal
+-- Purpose: Show the detail of one ledger entry
+-- Parameters:
+--   $1: journal_id - Unique identifier of the journal
+-- Returns:
+--   The journal record
+-- name: GetLedgerJournalByID :one
+SELECT * FROM ledger_journals WHERE journal_id = $1;
+
+-- GetLedgerPostingsByJournalIDs: Retrieves the postings of a set of journals
+-- Purpose: Load journal lines for one or many journals in a single round trip
+-- Parameters:
+--   $1: journal_ids - Array of journal IDs
+-- Returns:
+--   Posting records ordered by journal and posting ID
+-- name: GetLedgerPostingsByJournalIDs :many
+SELECT *
+FROM ledger_postings
+WHERE
+    journal_id = ANY(sqlc.arg(journal_ids)::INT[])
+ORDER BY journal_id, posting_id;
+
+-- GetLedgerPostingsByCardNumber: Retrieves paginated postings for a card account
+-- Purpose: Explain how the balance of a card was reached
+-- Parameters:
+--   $1: card_number - Card account to inspect
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   Postings joined with their journal reference and total_count for pagination
+-- Business Logic:
+--   - Most recent postings first
+-- name: GetLedgerPostingsByCardNumber :many
+SELECT
+    p.*,
+    j.reference_type,
+    j.reference_id,
+    j.posted_at,
+    COUNT(*) OVER () AS total_count
+FROM
+    ledger_postings p
+    JOIN ledger_journals j ON j.journal_id = p.journal_id
+WHERE
+    p.card_number = $1
+ORDER BY p.posting_id DESC
+LIMIT $2 OFFSET $3;
+
+-- GetLedgerBalanceByCardNumber: Computes a card balance from the ledger
+-- Purpose: Compare the ledger balance against the saldo projection
+-- Parameters:
+--   $1: card_number - Card account to compute
+-- Returns:
+--   Sum of credits minus debits for the card
+-- name: GetLedgerBalanceByCardNumber :one
+SELECT COALESCE(
+        SUM(
+            CASE
+                WHEN direction = 'credit' THEN amount
+                ELSE - amount
+            END
+        ), 0
+    )::INT AS balance
+FROM ledger_postings
+WHERE
+    card_number = $1;
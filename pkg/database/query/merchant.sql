@@ -1,14 +1,14 @@
 -- GetMerchants: Retrieves paginated list of all non-deleted merchants with search capability
 -- Purpose: Display all active (non-trashed) merchants in admin interface
 -- Parameters:
---   $1: search_term - Optional text to filter by name, api_key, or status (NULL for no filter)
+--   $1: search_term - Optional text to filter by name or status (NULL for no filter)
 --   $2: limit - Maximum number of records to return
 --   $3: offset - Number of records to skip for pagination
 -- Returns:
 --   All merchant fields plus total_count of matching records
 -- Business Logic:
 --   - Excludes soft-deleted merchants (deleted_at IS NULL)
---   - Supports partial text search on name, api_key, and status (case-insensitive)
+--   - Supports partial text search on name and status (case-insensitive)
 --   - Returns results ordered by merchant_id
 --   - Provides total_count for pagination calculations
 -- name: GetMerchants :many
@@ -17,7 +17,7 @@ SELECT
     COUNT(*) OVER() AS total_count
 FROM merchants
 WHERE deleted_at IS NULL
-    AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR api_key ILIKE '%' || $1 || '%' OR status ILIKE '%' || $1 || '%')
+    AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR status ILIKE '%' || $1 || '%')
 ORDER BY merchant_id
 LIMIT $2 OFFSET $3;
 
@@ -25,14 +25,14 @@ LIMIT $2 OFFSET $3;
 -- GetActiveMerchants: Retrieves paginated list of active merchants with search capability
 -- Purpose: List currently active merchants (same as GetMerchants)
 -- Parameters:
---   $1: search_term - Optional text to filter by name, api_key, or status (NULL for no filter)
+--   $1: search_term - Optional text to filter by name or status (NULL for no filter)
 --   $2: limit - Maximum number of records to return
 --   $3: offset - Number of records to skip for pagination
 -- Returns:
 --   All merchant fields plus total_count of matching records
 -- Business Logic:
 --   - Excludes soft-deleted merchants (deleted_at IS NULL)
---   - Supports case-insensitive partial matching on name, api_key, and status
+--   - Supports case-insensitive partial matching on name and status
 --   - Returns results ordered by merchant_id
 --   - Provides total_count for pagination calculations
 -- name: GetActiveMerchants :many
@@ -41,7 +41,7 @@ SELECT
     COUNT(*) OVER() AS total_count
 FROM merchants
 WHERE deleted_at IS NULL
-    AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR api_key ILIKE '%' || $1 || '%' OR status ILIKE '%' || $1 || '%')
+    AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR status ILIKE '%' || $1 || '%')
 ORDER BY merchant_id
 LIMIT $2 OFFSET $3;
 
@@ -49,14 +49,14 @@ LIMIT $2 OFFSET $3;
 -- GetTrashedMerchants: Retrieves paginated list of soft-deleted merchants with search capability
 -- Purpose: View trashed merchants for potential restoration or permanent deletion
 -- Parameters:
---   $1: search_term - Optional text to filter by name, api_key, or status (NULL for no filter)
+--   $1: search_term - Optional text to filter by name or status (NULL for no filter)
 --   $2: limit - Maximum number of records to return
 --   $3: offset - Number of records to skip for pagination
 -- Returns:
 --   All merchant fields plus total_count of matching records
 -- Business Logic:
 --   - Only includes soft-deleted merchants (deleted_at IS NOT NULL)
---   - Supports partial text search (case-insensitive) on name, api_key, and status
+--   - Supports partial text search (case-insensitive) on name and status
 --   - Returns results ordered by merchant_id
 --   - Provides total_count for pagination calculations
 -- name: GetTrashedMerchants :many
@@ -65,7 +65,7 @@ SELECT
     COUNT(*) OVER() AS total_count
 FROM merchants
 WHERE deleted_at IS NOT NULL
-    AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR api_key ILIKE '%' || $1 || '%' OR status ILIKE '%' || $1 || '%')
+    AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR status ILIKE '%' || $1 || '%')
 ORDER BY merchant_id
 LIMIT $2 OFFSET $3;
 
@@ -87,17 +87,6 @@ WHERE
     AND deleted_at IS NULL;
 
 
--- GetMerchantByApiKey: Retrieves a merchant by its API key
--- Purpose: Authenticate or lookup a merchant using its API key
--- Parameters:
---   $1: api_key - API key of the merchant
--- Returns:
---   Complete merchant record
--- Business Logic:
---   - Excludes soft-deleted merchants (deleted_at IS NULL)
--- name: GetMerchantByApiKey :one
-SELECT * FROM merchants WHERE api_key = $1 AND deleted_at IS NULL;
-
 -- GetMerchantByName: Retrieves a merchant by its name
 -- Purpose: Find merchant data based on exact name match
 -- Parameters:
@@ -148,6 +137,7 @@ WHERE
 --   - Payment method
 --   - Total transaction amount (0 if no activity)
 -- Business Logic:
+--   - Amounts are net of refunds (amount - refunded_amount)
 --   - Generates complete 12-month series from the reference year
 --   - Cross joins with distinct active payment methods
 --   - Filters only active (non-deleted) transactions and merchants
@@ -170,7 +160,7 @@ payment_methods AS (
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
     pm.payment_method,
-    COALESCE(SUM(t.amount), 0)::int AS total_amount
+    COALESCE(SUM(t.amount - t.refunded_amount), 0)::int AS total_amount
 FROM
     months m
 CROSS JOIN
@@ -200,6 +190,7 @@ ORDER BY
 --   - Payment method
 --   - Total transaction amount
 -- Business Logic:
+--   - Amounts are net of refunds (amount - refunded_amount)
 --   - Aggregates yearly totals for each payment method
 --   - Includes only active (non-deleted) transactions and merchants
 --   - Covers a 5-year range: (current_year - 4) to current_year
@@ -209,7 +200,7 @@ WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time) AS year,
         t.payment_method,
-        SUM(t.amount) AS total_amount
+        SUM(t.amount - t.refunded_amount) AS total_amount
     FROM
         transactions t
     JOIN
@@ -237,9 +228,11 @@ ORDER BY
 -- Parameters:
 --   $1: reference_date - Any date within the target year
 -- Returns:
+--   currency: Currency the totals are expressed in
 --   - Month name (e.g., Jan, Feb)
 --   - Total transaction amount (0 if no activity)
 -- Business Logic:
+--   - Amounts are net of refunds (amount - refunded_amount)
 --   - Generates complete 12-month series
 --   - Includes only active (non-deleted) transactions and merchants
 --   - Uses LEFT JOIN to ensure each month is represented
@@ -252,23 +245,32 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
-    COALESCE(SUM(t.amount), 0)::int AS total_amount
+    cur.currency,
+    COALESCE(SUM(t.amount - t.refunded_amount), 0)::int AS total_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 LEFT JOIN
     merchants mch ON t.merchant_id = mch.merchant_id
     AND mch.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 -- GetYearlyAmountMerchant: Retrieves total transaction amount per year for the last 5 years
@@ -276,9 +278,11 @@ ORDER BY
 -- Parameters:
 --   $1: current_year - The latest year to include in the 5-year window
 -- Returns:
+--   currency: Currency the totals are expressed in
 --   - Year (e.g., 2021, 2022)
 --   - Total transaction amount
 -- Business Logic:
+--   - Amounts are net of refunds (amount - refunded_amount)
 --   - Aggregates yearly transaction amounts
 --   - Filters only active (non-deleted) transactions and merchants
 --   - Includes data for the last 5 calendar years up to the current year
@@ -287,7 +291,8 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time) AS year,
-        SUM(t.amount) AS total_amount
+        t.currency,
+        SUM(t.amount - t.refunded_amount) AS total_amount
     FROM
         transactions t
     JOIN
@@ -297,15 +302,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transaction_time) >= $1 - 4
         AND EXTRACT(YEAR FROM t.transaction_time) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
+        EXTRACT(YEAR FROM t.transaction_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_amount
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 -- GetMonthlyTotalAmountMerchant: Retrieves total transaction amounts for the current and previous month
@@ -313,21 +321,26 @@ ORDER BY
 -- Parameters:
 --   $1: reference_date - Any date within the target (current) month
 -- Returns:
+--   currency: Currency the totals are expressed in
 --   - Year (as text)
 --   - Month (abbreviated name, e.g., Jan, Feb)
 --   - Total transaction amount for each month
 -- Business Logic:
+--   - Amounts are net of refunds (amount - refunded_amount)
 --   - Aggregates total transaction amounts for the target month and the month before
 --   - Filters only active (non-deleted) transactions and merchants
 --   - Includes 0 as total_amount if there's no transaction data for either month
 --   - Uses UNION ALL to combine real data with "missing month" placeholders
 --   - Results are sorted by year and month (most recent first)
 -- name: GetMonthlyTotalAmountMerchant :many
-WITH monthly_data AS (
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+), monthly_data AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time)::text AS year,
         TO_CHAR(t.transaction_time, 'Mon') AS month,
-        COALESCE(SUM(t.amount), 0)::integer AS total_amount
+        t.currency,
+        COALESCE(SUM(t.amount - t.refunded_amount), 0)::integer AS total_amount
     FROM
         transactions t
     INNER JOIN
@@ -341,39 +354,47 @@ WITH monthly_data AS (
         )
     GROUP BY
         EXTRACT(YEAR FROM t.transaction_time),
-        TO_CHAR(t.transaction_time, 'Mon')
+        TO_CHAR(t.transaction_time, 'Mon'),
+        t.currency
 ), missing_months AS (
     SELECT
         EXTRACT(YEAR FROM $1::timestamp)::text AS year,
         TO_CHAR($1::timestamp, 'Mon') AS month,
+        cur.currency,
         0::integer AS total_amount
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM monthly_data
         WHERE year = EXTRACT(YEAR FROM $1::timestamp)::text
         AND month = TO_CHAR($1::timestamp, 'Mon')
+        AND currency = cur.currency
     )
     UNION ALL
     SELECT
         EXTRACT(YEAR FROM date_trunc('month', $1::timestamp) - interval '1 month')::text AS year,
         TO_CHAR(date_trunc('month', $1::timestamp) - interval '1 month', 'Mon') AS month,
+        cur.currency,
         0::integer AS total_amount
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM monthly_data
         WHERE year = EXTRACT(YEAR FROM date_trunc('month', $1::timestamp) - interval '1 month')::text
         AND month = TO_CHAR(date_trunc('month', $1::timestamp) - interval '1 month', 'Mon')
+        AND currency = cur.currency
     )
 )
-SELECT year, month, total_amount
+SELECT year, month, currency, total_amount
 FROM (
-    SELECT year, month, total_amount FROM monthly_data
+    SELECT year, month, currency, total_amount FROM monthly_data
     UNION ALL
-    SELECT year, month, total_amount FROM missing_months
+    SELECT year, month, currency, total_amount FROM missing_months
 ) combined
 ORDER BY
     year DESC,
-    TO_DATE(month, 'Mon') DESC;
+    TO_DATE(month, 'Mon') DESC,
+    currency;
 
 
 -- GetYearlyTotalAmountMerchant: Retrieves total transaction amounts for the current and previous year
@@ -381,19 +402,24 @@ ORDER BY
 -- Parameters:
 --   $1: current_year - The latest year to include in the summary
 -- Returns:
+--   currency: Currency the totals are expressed in
 --   - Year (as text)
 --   - Total transaction amount per year
 -- Business Logic:
+--   - Amounts are net of refunds (amount - refunded_amount)
 --   - Aggregates total amounts for both the current year and the previous year
 --   - Filters only active (non-deleted) transactions and merchants
 --   - Ensures both years appear in the result, even if no data exists (returns 0 in such case)
 --   - Uses UNION ALL to combine actual data with 0-filled placeholders
 --   - Results are ordered in descending order by year
 -- name: GetYearlyTotalAmountMerchant :many
-WITH yearly_data AS (
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+), yearly_data AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time)::integer AS year,
-        COALESCE(SUM(t.amount), 0)::integer AS total_amount
+        t.currency,
+        COALESCE(SUM(t.amount - t.refunded_amount), 0)::integer AS total_amount
     FROM
         transactions t
     INNER JOIN
@@ -406,10 +432,12 @@ WITH yearly_data AS (
             OR EXTRACT(YEAR FROM t.transaction_time) = $1::integer - 1
         )
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
+        EXTRACT(YEAR FROM t.transaction_time),
+        t.currency
 ), formatted_data AS (
     SELECT
         year::text,
+        currency,
         total_amount::integer
     FROM
         yearly_data
@@ -418,27 +446,34 @@ WITH yearly_data AS (
 
     SELECT
         $1::text AS year,
+        cur.currency,
         0::integer AS total_amount
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM yearly_data
         WHERE year = $1::integer
+        AND currency = cur.currency
     )
 
     UNION ALL
 
     SELECT
         ($1::integer - 1)::text AS year,
+        cur.currency,
         0::integer AS total_amount
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM yearly_data
         WHERE year = $1::integer - 1
+        AND currency = cur.currency
     )
 )
 SELECT * FROM formatted_data
 ORDER BY
-    year DESC;
+    year DESC,
+    currency;
 
 
 
@@ -500,6 +535,7 @@ LIMIT $2 OFFSET $3;
 --   - Payment method
 --   - Total transaction amount for each combination
 -- Business Logic:
+--   - Amounts are net of refunds (amount - refunded_amount)
 --   - Generates a complete 12-month series for the given year
 --   - Filters only active (non-deleted) transactions and merchants
 --   - Filters by specific merchant_id
@@ -522,7 +558,7 @@ payment_methods AS (
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
     pm.payment_method,
-    COALESCE(SUM(t.amount), 0)::int AS total_amount
+    COALESCE(SUM(t.amount - t.refunded_amount), 0)::int AS total_amount
 FROM
     months m
 CROSS JOIN
@@ -554,6 +590,7 @@ ORDER BY
 --   - Payment method
 --   - Total transaction amount
 -- Business Logic:
+--   - Amounts are net of refunds (amount - refunded_amount)
 --   - Filters only active (non-deleted) transactions and merchants
 --   - Filters by specific merchant_id
 --   - Includes data for the last 5 calendar years up to the current year
@@ -564,7 +601,7 @@ WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time) AS year,
         t.payment_method,
-        SUM(t.amount) AS total_amount
+        SUM(t.amount - t.refunded_amount) AS total_amount
     FROM
         transactions t
     JOIN
@@ -595,9 +632,11 @@ ORDER BY
 --   $1: reference_date - Any date within the target year
 --   $2: merchant_id - The merchant to filter transactions
 -- Returns:
+--   currency: Currency the totals are expressed in
 --   - Month name (e.g., Jan, Feb)
 --   - Total transaction amount (0 if no activity)
 -- Business Logic:
+--   - Amounts are net of refunds (amount - refunded_amount)
 --   - Generates complete 12-month series for the given year
 --   - Filters only active (non-deleted) transactions and merchants
 --   - Filters by specific merchant_id
@@ -611,14 +650,21 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
-    COALESCE(SUM(t.amount), 0)::int AS total_amount
+    cur.currency,
+    COALESCE(SUM(t.amount - t.refunded_amount), 0)::int AS total_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
     AND t.merchant_id = $2
@@ -626,9 +672,11 @@ LEFT JOIN
     merchants mch ON t.merchant_id = mch.merchant_id
     AND mch.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 -- GetYearlyAmountByMerchants: Retrieves total transaction amount per year for the last 5 years for a specific merchant
@@ -637,9 +685,11 @@ ORDER BY
 --   $1: merchant_id - The merchant to filter transactions
 --   $2: current_year - The latest year to include in the 5-year window
 -- Returns:
+--   currency: Currency the totals are expressed in
 --   - Year (e.g., 2021, 2022)
 --   - Total transaction amount
 -- Business Logic:
+--   - Amounts are net of refunds (amount - refunded_amount)
 --   - Filters only active (non-deleted) transactions and merchants
 --   - Filters by specific merchant_id
 --   - Includes data for the last 5 calendar years up to the current year
@@ -649,7 +699,8 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time) AS year,
-        SUM(t.amount) AS total_amount
+        t.currency,
+        SUM(t.amount - t.refunded_amount) AS total_amount
     FROM
         transactions t
     JOIN
@@ -661,202 +712,38 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transaction_time) >= $2 - 4
         AND EXTRACT(YEAR FROM t.transaction_time) <= $2
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
+        EXTRACT(YEAR FROM t.transaction_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_amount
 FROM
     last_five_years
 ORDER BY
-    year;
-
-
--- GetMonthlyTotalAmountByMerchant: Retrieves total transaction amounts for the current and previous month
--- Purpose: Provide monthly transaction summary including zero values if no transactions exist
--- Parameters:
---   $1: reference_date - Any date within the target (current) month
---   $2: merchant_id - The merchant to filter transactions
--- Returns:
---   - Year (as text)
---   - Month (abbreviated name, e.g., Jan, Feb)
---   - Total transaction amount for each month
--- Business Logic:
---   - Aggregates total transaction amounts for the target month and the month before
---   - Filters only active (non-deleted) transactions and merchants
---   - Includes 0 as total_amount if there's no transaction data for either month
---   - Uses UNION ALL to combine real data with "missing month" placeholders
---   - Results are sorted by year and month (most recent first)
--- name: GetMonthlyTotalAmountByMerchant :many
-WITH monthly_data AS (
-    SELECT
-        EXTRACT(YEAR FROM t.transaction_time)::integer AS year,
-        EXTRACT(MONTH FROM t.transaction_time)::integer AS month,
-        COALESCE(SUM(t.amount), 0)::integer AS total_amount
-    FROM
-        transactions t
-    INNER JOIN
-        merchants m ON t.merchant_id = m.merchant_id
-    WHERE
-        t.deleted_at IS NULL
-        AND m.deleted_at IS NULL
-        AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM $1::timestamp)
-        AND t.merchant_id = $2::integer
-    GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time),
-        EXTRACT(MONTH FROM t.transaction_time)
-), formatted_data AS (
-    SELECT
-        year::text,
-        TO_CHAR(TO_DATE(month::text, 'MM'), 'Mon') AS month,
-        total_amount
-    FROM
-        monthly_data
-    UNION ALL
-
-    SELECT
-        EXTRACT(YEAR FROM gs.month)::text AS year,
-        TO_CHAR(gs.month, 'Mon') AS month,
-        0::integer AS total_amount
-    FROM generate_series(
-        date_trunc('year', $1::timestamp),
-        date_trunc('year', $1::timestamp) + interval '11 month',
-        interval '1 month'
-    ) AS gs(month)
-    WHERE NOT EXISTS (
-        SELECT 1 FROM monthly_data md
-        WHERE md.year = EXTRACT(YEAR FROM gs.month)::integer
-        AND md.month = EXTRACT(MONTH FROM gs.month)::integer
-    )
-)
-SELECT * FROM formatted_data
-ORDER BY
-    year DESC,
-    TO_DATE(month, 'Mon') DESC;
-
-
--- GetYearlyTotalAmountByMerchant: Retrieves total transaction amounts for the current and previous year
--- Purpose: Provide yearly transaction summary with fallback to 0 if no transactions exist
--- Parameters:
---   $1: current_year - The latest year to include in the summary
---   $2: merchant_id - The merchant to filter transactions
--- Returns:
---   - Year (as text)
---   - Total transaction amount per year
--- Business Logic:
---   - Aggregates total amounts for both the current year and the previous year
---   - Filters only active (non-deleted) transactions and merchants
---   - Ensures both years appear in the result, even if no data exists (returns 0 in such case)
---   - Uses UNION ALL to combine actual data with 0-filled placeholders
---   - Results are ordered in descending order by year
--- name: GetYearlyTotalAmountByMerchant :many
-WITH yearly_data AS (
-    SELECT
-        EXTRACT(YEAR FROM t.transaction_time)::integer AS year,
-        COALESCE(SUM(t.amount), 0)::integer AS total_amount
-    FROM
-        transactions t
-    INNER JOIN
-        merchants m ON t.merchant_id = m.merchant_id
-    WHERE
-        t.deleted_at IS NULL
-        AND m.deleted_at IS NULL
-        AND EXTRACT(YEAR FROM t.transaction_time) >= $1::integer - 4
-        AND EXTRACT(YEAR FROM t.transaction_time) <= $1::integer
-        AND t.merchant_id = $2::integer
-    GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
-), formatted_data AS (
-    SELECT
-        year::text,
-        total_amount
-    FROM
-        yearly_data
-    UNION ALL
-
-    SELECT
-        y::text AS year,
-        0::integer AS total_amount
-    FROM generate_series($1::integer - 4, $1::integer) AS y
-    WHERE NOT EXISTS (
-        SELECT 1 FROM yearly_data yd
-        WHERE yd.year = y
-    )
-)
-SELECT * FROM formatted_data
-ORDER BY
-    year DESC;
-
-
--- FindAllTransactions: Retrieves a paginated list of active transactions with optional search
--- Purpose: Display transaction list with merchant info, filtered by card number or payment method
--- Parameters:
---   $1: merchant_id - The merchant to filter transactions
---   $2: search_query (TEXT, nullable) - Optional search string to match card_number or payment_method
---   $3: limit (INTEGER) - Maximum number of records to return (pagination)
---   $4: offset (INTEGER) - Number of records to skip (pagination)
--- Returns:
---   - transaction_id
---   - card_number
---   - amount
---   - payment_method
---   - merchant_id
---   - merchant_name (from join with merchants table)
---   - transaction_time
---   - created_at, updated_at, deleted_at (for audit purposes)
---   - total_count: Total number of records matching the filter (useful for pagination metadata)
--- Business Logic:
---   - Joins `transactions` with `merchants` to retrieve merchant name
---   - Filters out soft-deleted transactions (where deleted_at IS NOT NULL)
---   - Applies case-insensitive partial match on card_number or payment_method if search query is provided
---   - Uses `COUNT(*) OVER()` to include total matching count for pagination without a separate query
---   - Results are ordered by `transaction_time` descending
--- name: FindAllTransactionsByMerchant :many
-SELECT
-    t.transaction_id,
-    t.card_number,
-    t.amount,
-    t.payment_method,
-    t.merchant_id,
-    m.name AS merchant_name,
-    t.transaction_time,
-    t.created_at,
-    t.updated_at,
-    t.deleted_at,
-    COUNT(*) OVER() AS total_count
-FROM
-    transactions t
-JOIN
-    merchants m ON t.merchant_id = m.merchant_id
-WHERE
-    t.deleted_at IS NULL
-    AND t.merchant_id = $1
-    AND ($2::TEXT IS NULL OR t.card_number ILIKE '%' || $2 || '%' OR t.payment_method ILIKE '%' || $2 || '%')
-ORDER BY
-    t.transaction_time DESC
-LIMIT $3 OFFSET $4;
-
-
-
+    year,
+    currency;
 
 
--- GetMonthlyPaymentMethodByApikey: Retrieves total transaction amount per payment method per month for a specific merchant
--- Purpose: Analyze monthly transaction totals by payment method for a specific merchant and year
+-- GetMonthlyDisputeRateByMerchants: Retrieves the dispute rate per month for a specific merchant and year
+-- Purpose: Monitor how often cardholders contest a merchant's charges
 -- Parameters:
 --   $1: reference_date - Any date within the target year
---   $2: api-key - The merchant to filter transactions
+--   $2: merchant_id - The merchant to filter transactions and disputes
 -- Returns:
 --   - Month name (e.g., Jan, Feb)
---   - Payment method
---   - Total transaction amount for each combination
+--   - Number of completed transactions in the month
+--   - Number of disputes opened in the month
+--   - Dispute rate as a percentage of completed transactions (0 if no transactions)
 -- Business Logic:
---   - Generates a complete 12-month series for the given year
---   - Filters only active (non-deleted) transactions and merchants
---   - Filters by specific merchant_id
---   - Uses LEFT JOIN and CROSS JOIN to ensure all months and payment methods are included
---   - Uses COALESCE to return 0 for combinations with no data
+--   - Completed transactions are those that reached 'success', including ones
+--     later refunded, disputed or charged back
+--   - Disputes are bucketed by the month they were opened
+--   - Generates complete 12-month series for the given year
+--   - Filters only active (non-deleted) transactions
 --   - Results are ordered chronologically by month
--- name: GetMonthlyPaymentMethodByApikey :many
+-- name: GetMonthlyDisputeRateByMerchants :many
 WITH months AS (
     SELECT generate_series(
         date_trunc('year', $1::timestamp),
@@ -864,186 +751,139 @@ WITH months AS (
         interval '1 month'
     ) AS month
 ),
-payment_methods AS (
-    SELECT DISTINCT payment_method
-    FROM transactions
-    WHERE deleted_at IS NULL
-)
-SELECT
-    TO_CHAR(m.month, 'Mon') AS month,
-    pm.payment_method,
-    COALESCE(SUM(t.amount), 0)::int AS total_amount
-FROM
-    months m
-CROSS JOIN
-    payment_methods pm
-LEFT JOIN
-    transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
-    AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
-    AND t.payment_method = pm.payment_method
-    AND t.deleted_at IS NULL
-LEFT JOIN
-    merchants mch ON t.merchant_id = mch.merchant_id
-    AND mch.deleted_at IS NULL
-    AND mch.api_key = $2
-GROUP BY
-    m.month,
-    pm.payment_method
-ORDER BY
-    m.month,
-    pm.payment_method;
-
-
--- GetYearlyPaymentMethodByApikey: Retrieves total transaction amount per payment method over the last 5 years for a specific merchant
--- Purpose: Analyze yearly transaction totals grouped by payment method for a merchant
--- Parameters:
---   $1: api-key - The merchant to filter transactions
---   $2: current_year - The latest year to include in the 5-year window
--- Returns:
---   - Year (e.g., 2021, 2022)
---   - Payment method
---   - Total transaction amount
--- Business Logic:
---   - Filters only active (non-deleted) transactions and merchants
---   - Filters by specific merchant_id
---   - Includes data for the last 5 calendar years up to the current year
---   - Groups by calendar year and payment method
---   - Results are ordered chronologically by year
--- name: GetYearlyPaymentMethodByApikey :many
-WITH last_five_years AS (
+monthly_transactions AS (
     SELECT
-        EXTRACT(YEAR FROM t.transaction_time) AS year,
-        t.payment_method,
-        SUM(t.amount) AS total_amount
+        date_trunc('month', t.transaction_time) AS month,
+        COUNT(*) AS total_transactions
     FROM
         transactions t
-    JOIN
-        merchants m ON t.merchant_id = m.merchant_id
     WHERE
         t.deleted_at IS NULL
-        AND m.deleted_at IS NULL
-        AND m.api_key = $1
-        AND EXTRACT(YEAR FROM t.transaction_time) >= $2 - 4
-        AND EXTRACT(YEAR FROM t.transaction_time) <= $2
+        AND t.merchant_id = $2
+        AND t.status IN ('success', 'partially_refunded', 'refunded', 'disputed', 'charged_back')
+        AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM $1::timestamp)
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time),
-        t.payment_method
-)
-SELECT
-    year,
-    payment_method,
-    total_amount
-FROM
-    last_five_years
-ORDER BY
-    year;
-
-
-
--- GetMonthlyAmountByApikey: Retrieves total transaction amount per month for a specific merchant and year
--- Purpose: Generate monthly income report for a specific merchant regardless of payment method
--- Parameters:
---   $1: reference_date - Any date within the target year
---   $2: api-key - The merchant to filter transactions
--- Returns:
---   - Month name (e.g., Jan, Feb)
---   - Total transaction amount (0 if no activity)
--- Business Logic:
---   - Generates complete 12-month series for the given year
---   - Filters only active (non-deleted) transactions and merchants
---   - Filters by specific merchant_id
---   - Uses LEFT JOIN to ensure each month is represented
---   - Uses COALESCE to return 0 if a month has no transaction data
---   - Results are ordered chronologically by month
--- name: GetMonthlyAmountByApikey :many
-WITH months AS (
-    SELECT generate_series(
-        date_trunc('year', $1::timestamp),
-        date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
-        interval '1 month'
-    ) AS month
+        date_trunc('month', t.transaction_time)
+),
+monthly_disputes AS (
+    SELECT
+        date_trunc('month', d.created_at) AS month,
+        COUNT(*) AS total_disputes
+    FROM
+        disputes d
+    WHERE
+        d.merchant_id = $2
+        AND EXTRACT(YEAR FROM d.created_at) = EXTRACT(YEAR FROM $1::timestamp)
+    GROUP BY
+        date_trunc('month', d.created_at)
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
-    COALESCE(SUM(t.amount), 0)::int AS total_amount
+    COALESCE(mt.total_transactions, 0)::int AS total_transactions,
+    COALESCE(md.total_disputes, 0)::int AS total_disputes,
+    (CASE
+        WHEN COALESCE(mt.total_transactions, 0) = 0 THEN 0
+        ELSE ROUND(COALESCE(md.total_disputes, 0)::numeric * 100 / mt.total_transactions, 2)
+    END)::float8 AS dispute_rate
 FROM
     months m
 LEFT JOIN
-    transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
-    AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
-    AND t.deleted_at IS NULL
+    monthly_transactions mt ON mt.month = m.month
 LEFT JOIN
-    merchants mch ON t.merchant_id = mch.merchant_id
-    AND mch.deleted_at IS NULL
-    AND mch.api_key = $2
-GROUP BY
-    m.month
+    monthly_disputes md ON md.month = m.month
 ORDER BY
     m.month;
 
 
--- GetYearlyAmountByMerchants: Retrieves total transaction amount per year for the last 5 years for a specific merchant
--- Purpose: Show overall yearly revenue trends for a merchant across all payment methods
+-- GetYearlyDisputeRateByMerchants: Retrieves the dispute rate per year for the last 5 years for a specific merchant
+-- Purpose: Show long-term dispute trends for a merchant
 -- Parameters:
---   $1: api-key - The merchant to filter transactions
+--   $1: merchant_id - The merchant to filter transactions and disputes
 --   $2: current_year - The latest year to include in the 5-year window
 -- Returns:
 --   - Year (e.g., 2021, 2022)
---   - Total transaction amount
+--   - Number of completed transactions in the year
+--   - Number of disputes opened in the year
+--   - Dispute rate as a percentage of completed transactions (0 if no transactions)
 -- Business Logic:
---   - Filters only active (non-deleted) transactions and merchants
---   - Filters by specific merchant_id
---   - Includes data for the last 5 calendar years up to the current year
---   - Groups by calendar year
+--   - Completed transactions are those that reached 'success', including ones
+--     later refunded, disputed or charged back
+--   - Disputes are bucketed by the year they were opened
+--   - Includes every year of the 5-year window, even without activity
+--   - Filters only active (non-deleted) transactions
 --   - Results are ordered chronologically by year
--- name: GetYearlyAmountByApikey :many
-WITH last_five_years AS (
+-- name: GetYearlyDisputeRateByMerchants :many
+WITH years AS (
+    SELECT generate_series($2::int - 4, $2::int) AS year
+),
+yearly_transactions AS (
     SELECT
-        EXTRACT(YEAR FROM t.transaction_time) AS year,
-        SUM(t.amount) AS total_amount
+        EXTRACT(YEAR FROM t.transaction_time)::int AS year,
+        COUNT(*) AS total_transactions
     FROM
         transactions t
-    JOIN
-        merchants m ON t.merchant_id = m.merchant_id
     WHERE
         t.deleted_at IS NULL
-        AND m.deleted_at IS NULL
-        AND m.api_key = $1
-        AND EXTRACT(YEAR FROM t.transaction_time) >= $2 - 4
-        AND EXTRACT(YEAR FROM t.transaction_time) <= $2
+        AND t.merchant_id = $1
+        AND t.status IN ('success', 'partially_refunded', 'refunded', 'disputed', 'charged_back')
     GROUP BY
         EXTRACT(YEAR FROM t.transaction_time)
+),
+yearly_disputes AS (
+    SELECT
+        EXTRACT(YEAR FROM d.created_at)::int AS year,
+        COUNT(*) AS total_disputes
+    FROM
+        disputes d
+    WHERE
+        d.merchant_id = $1
+    GROUP BY
+        EXTRACT(YEAR FROM d.created_at)
 )
 SELECT
-    year,
-    total_amount
+    y.year::text AS year,
+    COALESCE(yt.total_transactions, 0)::int AS total_transactions,
+    COALESCE(yd.total_disputes, 0)::int AS total_disputes,
+    (CASE
+        WHEN COALESCE(yt.total_transactions, 0) = 0 THEN 0
+        ELSE ROUND(COALESCE(yd.total_disputes, 0)::numeric * 100 / yt.total_transactions, 2)
+    END)::float8 AS dispute_rate
 FROM
-    last_five_years
+    years y
+LEFT JOIN
+    yearly_transactions yt ON yt.year = y.year
+LEFT JOIN
+    yearly_disputes yd ON yd.year = y.year
 ORDER BY
-    year;
+    y.year;
 
 
--- GetMonthlyTotalAmountByApikey: Retrieves total transaction amounts for the current and previous month
+-- GetMonthlyTotalAmountByMerchant: Retrieves total transaction amounts for the current and previous month
 -- Purpose: Provide monthly transaction summary including zero values if no transactions exist
 -- Parameters:
 --   $1: reference_date - Any date within the target (current) month
---   $2: api-key - The merchant to filter transactions
+--   $2: merchant_id - The merchant to filter transactions
 -- Returns:
+--   currency: Currency the totals are expressed in
 --   - Year (as text)
 --   - Month (abbreviated name, e.g., Jan, Feb)
 --   - Total transaction amount for each month
 -- Business Logic:
+--   - Amounts are net of refunds (amount - refunded_amount)
 --   - Aggregates total transaction amounts for the target month and the month before
 --   - Filters only active (non-deleted) transactions and merchants
 --   - Includes 0 as total_amount if there's no transaction data for either month
 --   - Uses UNION ALL to combine real data with "missing month" placeholders
 --   - Results are sorted by year and month (most recent first)
--- name: GetMonthlyTotalAmountByApikey :many
-WITH monthly_data AS (
+-- name: GetMonthlyTotalAmountByMerchant :many
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+), monthly_data AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time)::integer AS year,
         EXTRACT(MONTH FROM t.transaction_time)::integer AS month,
-        COALESCE(SUM(t.amount), 0)::integer AS total_amount
+        t.currency,
+        COALESCE(SUM(t.amount - t.refunded_amount), 0)::integer AS total_amount
     FROM
         transactions t
     INNER JOIN
@@ -1052,14 +892,16 @@ WITH monthly_data AS (
         t.deleted_at IS NULL
         AND m.deleted_at IS NULL
         AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM $1::timestamp)
-        AND m.api_key = $2
+        AND t.merchant_id = $2::integer
     GROUP BY
         EXTRACT(YEAR FROM t.transaction_time),
-        EXTRACT(MONTH FROM t.transaction_time)
+        EXTRACT(MONTH FROM t.transaction_time),
+        t.currency
 ), formatted_data AS (
     SELECT
         year::text,
         TO_CHAR(TO_DATE(month::text, 'MM'), 'Mon') AS month,
+        currency,
         total_amount
     FROM
         monthly_data
@@ -1068,43 +910,52 @@ WITH monthly_data AS (
     SELECT
         EXTRACT(YEAR FROM gs.month)::text AS year,
         TO_CHAR(gs.month, 'Mon') AS month,
+        cur.currency,
         0::integer AS total_amount
     FROM generate_series(
         date_trunc('year', $1::timestamp),
         date_trunc('year', $1::timestamp) + interval '11 month',
         interval '1 month'
     ) AS gs(month)
+    CROSS JOIN currencies cur
     WHERE NOT EXISTS (
         SELECT 1 FROM monthly_data md
         WHERE md.year = EXTRACT(YEAR FROM gs.month)::integer
         AND md.month = EXTRACT(MONTH FROM gs.month)::integer
+        AND md.currency = cur.currency
     )
 )
 SELECT * FROM formatted_data
 ORDER BY
     year DESC,
-    TO_DATE(month, 'Mon') DESC;
+    TO_DATE(month, 'Mon') DESC,
+    currency;
 
 
--- GetYearlyTotalAmountByApikey: Retrieves total transaction amounts for the current and previous year
+-- GetYearlyTotalAmountByMerchant: Retrieves total transaction amounts for the current and previous year
 -- Purpose: Provide yearly transaction summary with fallback to 0 if no transactions exist
 -- Parameters:
 --   $1: current_year - The latest year to include in the summary
---   $2: api-key - The merchant to filter transactions
+--   $2: merchant_id - The merchant to filter transactions
 -- Returns:
+--   currency: Currency the totals are expressed in
 --   - Year (as text)
 --   - Total transaction amount per year
 -- Business Logic:
+--   - Amounts are net of refunds (amount - refunded_amount)
 --   - Aggregates total amounts for both the current year and the previous year
 --   - Filters only active (non-deleted) transactions and merchants
 --   - Ensures both years appear in the result, even if no data exists (returns 0 in such case)
 --   - Uses UNION ALL to combine actual data with 0-filled placeholders
 --   - Results are ordered in descending order by year
--- name: GetYearlyTotalAmountByApikey :many
-WITH yearly_data AS (
+-- name: GetYearlyTotalAmountByMerchant :many
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+), yearly_data AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time)::integer AS year,
-        COALESCE(SUM(t.amount), 0)::integer AS total_amount
+        t.currency,
+        COALESCE(SUM(t.amount - t.refunded_amount), 0)::integer AS total_amount
     FROM
         transactions t
     INNER JOIN
@@ -1114,12 +965,14 @@ WITH yearly_data AS (
         AND m.deleted_at IS NULL
         AND EXTRACT(YEAR FROM t.transaction_time) >= $1::integer - 4
         AND EXTRACT(YEAR FROM t.transaction_time) <= $1::integer
-        AND m.api_key = $2
+        AND t.merchant_id = $2::integer
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
+        EXTRACT(YEAR FROM t.transaction_time),
+        t.currency
 ), formatted_data AS (
     SELECT
         year::text,
+        currency,
         total_amount
     FROM
         yearly_data
@@ -1127,22 +980,26 @@ WITH yearly_data AS (
 
     SELECT
         y::text AS year,
+        cur.currency,
         0::integer AS total_amount
     FROM generate_series($1::integer - 4, $1::integer) AS y
+    CROSS JOIN currencies cur
     WHERE NOT EXISTS (
         SELECT 1 FROM yearly_data yd
         WHERE yd.year = y
+        AND yd.currency = cur.currency
     )
 )
 SELECT * FROM formatted_data
 ORDER BY
-    year DESC;
+    year DESC,
+    currency;
 
 
 -- FindAllTransactions: Retrieves a paginated list of active transactions with optional search
 -- Purpose: Display transaction list with merchant info, filtered by card number or payment method
 -- Parameters:
---   $1: api-key - The merchant to filter transactions
+--   $1: merchant_id - The merchant to filter transactions
 --   $2: search_query (TEXT, nullable) - Optional search string to match card_number or payment_method
 --   $3: limit (INTEGER) - Maximum number of records to return (pagination)
 --   $4: offset (INTEGER) - Number of records to skip (pagination)
@@ -1162,7 +1019,7 @@ ORDER BY
 --   - Applies case-insensitive partial match on card_number or payment_method if search query is provided
 --   - Uses `COUNT(*) OVER()` to include total matching count for pagination without a separate query
 --   - Results are ordered by `transaction_time` descending
--- name: FindAllTransactionsByApikey :many
+-- name: FindAllTransactionsByMerchant :many
 SELECT
     t.transaction_id,
     t.card_number,
@@ -1181,22 +1038,20 @@ JOIN
     merchants m ON t.merchant_id = m.merchant_id
 WHERE
     t.deleted_at IS NULL
-    AND m.api_key = $1
+    AND t.merchant_id = $1
     AND ($2::TEXT IS NULL OR t.card_number ILIKE '%' || $2 || '%' OR t.payment_method ILIKE '%' || $2 || '%')
 ORDER BY
     t.transaction_time DESC
 LIMIT $3 OFFSET $4;
 
 
-
 -- Create Merchant
 -- name: CreateMerchant :one
 -- Purpose: Insert a new merchant record into the database
 -- Parameters:
 --   $1: name - The name of the merchant
---   $2: api_key - Unique API key for the merchant
---   $3: user_id - ID of the user associated with the merchant
---   $4: status - Current status of the merchant (e.g., active, inactive)
+--   $2: user_id - ID of the user associated with the merchant
+--   $3: status - Current status of the merchant (e.g., active, inactive)
 -- Returns:
 --   - The newly created merchant record
 -- Business Logic:
@@ -1207,7 +1062,6 @@ LIMIT $3 OFFSET $4;
 INSERT INTO
     merchants (
         name,
-        api_key,
         user_id,
         status,
         created_at,
@@ -1217,7 +1071,6 @@ VALUES (
         $1,
         $2,
         $3,
-        $4,
         current_timestamp,
         current_timestamp
     ) RETURNING *;
@@ -1230,9 +1083,9 @@ VALUES (
 --   $1: merchant_id - ID of the merchant to be updated
 --   $2: name - The new name for the merchant
 --   $3: user_id - New user ID associated with the merchant
---   $4: status - New status for the merchant
 -- Business Logic:
---   - Updates the specified merchant's name, user_id, and status.
+--   - Updates the specified merchant's name and user_id.
+--   - The status is changed through the onboarding lifecycle only.
 --   - Ensures the merchant is not marked as deleted (deleted_at is NULL).
 --   - Sets the updated_at timestamp to the current time.
 -- name: UpdateMerchant :one
@@ -1240,7 +1093,6 @@ UPDATE merchants
 SET
     name = $2,
     user_id = $3,
-    status = $4,
     updated_at = current_timestamp
 WHERE
     merchant_id = $1
@@ -0,0 +1,178 @@
+-- CreateMerchantApiKey: Issues an API key for a merchant
+-- Purpose: Give a merchant a new key, with the scopes it may be used for
+-- Parameters:
+--   $1: merchant_id - Merchant the key belongs to
+--   $2: label - Name the merchant gave the key
+--   $3: key_prefix - First characters of the key, shown to tell keys apart
+--   $4: key_hash - SHA-256 hash of the key
+--   $5: scopes - What the key may be used for
+--   $6: expires_at - When the key stops working (NULL for never)
+--   $7: signing_secret - Secret requests made with the key are signed with
+-- Returns:
+--   The created key
+-- name: CreateMerchantApiKey :one
+INSERT INTO
+    merchant_api_keys (
+        merchant_id,
+        label,
+        key_prefix,
+        key_hash,
+        scopes,
+        expires_at,
+        signing_secret,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        current_timestamp,
+        current_timestamp
+    ) RETURNING *;
+
+-- GetMerchantApiKeyByID: Retrieves a single API key
+-- Purpose: Rotate or revoke one key
+-- Parameters:
+--   $1: merchant_api_key_id - Unique identifier of the key
+-- Returns:
+--   The key record, including revoked and expired keys
+-- name: GetMerchantApiKeyByID :one
+SELECT *
+FROM merchant_api_keys
+WHERE
+    merchant_api_key_id = $1;
+
+-- GetMerchantApiKeyByHash: Looks up a usable API key by its hash
+-- Purpose: Authenticate a merchant API call
+-- Parameters:
+--   $1: key_hash - SHA-256 hash of the key the caller sent
+-- Returns:
+--   The key, unless it was revoked or has expired
+-- name: GetMerchantApiKeyByHash :one
+SELECT *
+FROM merchant_api_keys
+WHERE
+    key_hash = $1
+    AND revoked_at IS NULL
+    AND (
+        expires_at IS NULL
+        OR expires_at > current_timestamp
+    );
+
+-- GetActiveMerchantApiKeyByID: Looks up a usable API key by its ID
+-- Purpose: Verify a signed merchant API call, which names its key by ID
+-- Parameters:
+--   $1: merchant_api_key_id - Unique identifier of the key
+-- Returns:
+--   The key, unless it was revoked or has expired
+-- name: GetActiveMerchantApiKeyByID :one
+SELECT *
+FROM merchant_api_keys
+WHERE
+    merchant_api_key_id = $1
+    AND revoked_at IS NULL
+    AND (
+        expires_at IS NULL
+        OR expires_at > current_timestamp
+    );
+
+-- GetMerchantApiKeysByMerchant: Lists the API keys of a merchant
+-- Purpose: Show a merchant its keys, without the keys themselves
+-- Parameters:
+--   $1: merchant_id - Merchant the keys belong to
+-- Returns:
+--   All keys of the merchant, including revoked and expired ones, newest first
+-- name: GetMerchantApiKeysByMerchant :many
+SELECT *
+FROM merchant_api_keys
+WHERE
+    merchant_id = $1
+ORDER BY merchant_api_key_id DESC;
+
+-- TouchMerchantApiKey: Records that an API key was used
+-- Purpose: Show a merchant which keys are still in use
+-- Parameters:
+--   $1: merchant_api_key_id - Unique identifier of the key
+-- Business Logic:
+--   - Written at most once a minute per key so busy keys don't cause a
+--     write on every call
+-- name: TouchMerchantApiKey :exec
+UPDATE merchant_api_keys
+SET
+    last_used_at = current_timestamp
+WHERE
+    merchant_api_key_id = $1
+    AND (
+        last_used_at IS NULL
+        OR last_used_at < current_timestamp - INTERVAL '1 minute'
+    );
+
+-- ExpireMerchantApiKey: Lets an API key run out
+-- Purpose: Keep a rotated key working for a grace period
+-- Parameters:
+--   $1: merchant_api_key_id - Unique identifier of the key
+--   $2: expires_at - When the key stops working
+-- Returns:
+--   The updated key
+-- Business Logic:
+--   - A key that already expires earlier keeps its expiry
+-- name: ExpireMerchantApiKey :one
+UPDATE merchant_api_keys
+SET
+    expires_at = LEAST(expires_at, $2),
+    updated_at = current_timestamp
+WHERE
+    merchant_api_key_id = $1
+    AND revoked_at IS NULL
+RETURNING *;
+
+-- RevokeMerchantApiKey: Revokes an API key
+-- Purpose: Stop a key from working immediately
+-- Parameters:
+--   $1: merchant_api_key_id - Unique identifier of the key
+-- Returns:
+--   The revoked key
+-- name: RevokeMerchantApiKey :one
+UPDATE merchant_api_keys
+SET
+    revoked_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    merchant_api_key_id = $1
+    AND revoked_at IS NULL
+RETURNING *;
+
+-- GetMerchantApiKeysWithPlainSigningSecret: Lists keys whose signing secret is not encrypted
+-- Purpose: Encrypt the signing secrets stored before encryption was added
+-- Returns:
+--   All keys whose signing secret lacks the sealed prefix, including revoked
+--   and expired ones
+-- name: GetMerchantApiKeysWithPlainSigningSecret :many
+SELECT *
+FROM merchant_api_keys
+WHERE
+    signing_secret NOT LIKE 'sealed:%'
+ORDER BY merchant_api_key_id;
+
+-- SealMerchantApiKeySigningSecret: Replaces a plain signing secret with its encrypted form
+-- Purpose: Encrypt a signing secret stored before encryption was added
+-- Parameters:
+--   $1: signing_secret - The encrypted secret
+--   $2: merchant_api_key_id - Unique identifier of the key
+--   $3: plain_signing_secret - The secret as it was read
+-- Business Logic:
+--   - Only replaces the secret it was read with, so two servers starting at
+--     once can't encrypt a secret twice
+-- name: SealMerchantApiKeySigningSecret :execrows
+UPDATE merchant_api_keys
+SET
+    signing_secret = sqlc.arg(signing_secret),
+    updated_at = current_timestamp
+WHERE
+    merchant_api_key_id = sqlc.arg(merchant_api_key_id)
+    AND signing_secret = sqlc.arg(plain_signing_secret);
@@ -0,0 +1,129 @@
+-- UpsertMerchantBusinessProfile: Stores the business details a merchant submits for review
+-- Purpose: Keep what an admin reviews before activating a merchant
+-- Parameters:
+--   $1: merchant_id - Merchant the details belong to
+--   $2: legal_name - Registered name of the business
+--   $3: business_type - Kind of business, such as individual or company
+--   $4: tax_id - Tax registration number of the business
+--   $5: address - Registered address of the business
+--   $6: website - Website of the business, if any
+--   $7: contact_email - Email address the business is reached at
+--   $8: contact_phone - Phone number the business is reached at
+-- Returns:
+--   The stored business profile
+-- Business Logic:
+--   - Resubmitting replaces the earlier details and the submission time
+-- name: UpsertMerchantBusinessProfile :one
+INSERT INTO
+    merchant_business_profiles (
+        merchant_id,
+        legal_name,
+        business_type,
+        tax_id,
+        address,
+        website,
+        contact_email,
+        contact_phone,
+        submitted_at,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        current_timestamp,
+        current_timestamp,
+        current_timestamp
+    )
+ON CONFLICT (merchant_id) DO UPDATE
+SET
+    legal_name = EXCLUDED.legal_name,
+    business_type = EXCLUDED.business_type,
+    tax_id = EXCLUDED.tax_id,
+    address = EXCLUDED.address,
+    website = EXCLUDED.website,
+    contact_email = EXCLUDED.contact_email,
+    contact_phone = EXCLUDED.contact_phone,
+    submitted_at = current_timestamp,
+    updated_at = current_timestamp
+RETURNING *;
+
+-- GetMerchantBusinessProfile: Retrieves the business details of a merchant
+-- Parameters:
+--   $1: merchant_id - Merchant the details belong to
+-- Returns:
+--   The business profile
+-- name: GetMerchantBusinessProfile :one
+SELECT *
+FROM merchant_business_profiles
+WHERE
+    merchant_id = $1;
+
+-- TransitionMerchantStatus: Moves a merchant to another status of the onboarding lifecycle
+-- Purpose: Activate, reject, suspend or terminate a merchant with a recorded reason
+-- Parameters:
+--   status: Status the merchant moves to
+--   merchant_id: Merchant whose status changes
+--   from_status: Status the merchant is expected to be in
+--   reason: Why the status changed, if given
+--   changed_by: User who changed the status, if any
+-- Returns:
+--   The updated merchant, or no row when it was no longer in from_status
+-- Business Logic:
+--   - The status history row is written by the same statement, and only
+--     when the merchant was still in from_status
+-- name: TransitionMerchantStatus :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                reason,
+                changed_by,
+                created_at
+            )
+        SELECT 'merchant', merchant_id, status, sqlc.arg(status), sqlc.narg(reason), sqlc.narg(changed_by), current_timestamp
+        FROM merchants
+        WHERE
+            merchant_id = sqlc.arg(merchant_id)
+            AND status = sqlc.arg(from_status)
+            AND deleted_at IS NULL
+        FOR UPDATE
+    )
+UPDATE merchants
+SET
+    status = sqlc.arg(status),
+    updated_at = current_timestamp
+WHERE
+    merchant_id = sqlc.arg(merchant_id)
+    AND status = sqlc.arg(from_status)
+    AND deleted_at IS NULL
+RETURNING *;
+
+-- GetMerchantsByStatus: Retrieves the paginated merchants in one status
+-- Purpose: Give admins a queue of merchants to review
+-- Parameters:
+--   $1: status - Status of the merchants
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   Merchant records with total_count for pagination, least recently updated first
+-- name: GetMerchantsByStatus :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM merchants
+WHERE
+    status = $1
+    AND deleted_at IS NULL
+ORDER BY updated_at, merchant_id
+LIMIT $2 OFFSET $3;
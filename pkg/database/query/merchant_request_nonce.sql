@@ -0,0 +1,27 @@
+-- CreateMerchantRequestNonce: Records the nonce of a signed request
+-- Purpose: Reject a signed request that is sent again
+-- Parameters:
+--   $1: merchant_api_key_id - Key the request was signed with
+--   $2: nonce - Nonce the merchant picked for the request
+-- Returns:
+--   The recorded nonce, or no row when the key already used it
+-- name: CreateMerchantRequestNonce :one
+INSERT INTO
+    merchant_request_nonces (
+        merchant_api_key_id,
+        nonce,
+        created_at
+    )
+VALUES ($1, $2, current_timestamp)
+ON CONFLICT (merchant_api_key_id, nonce) DO NOTHING
+RETURNING *;
+
+-- DeleteMerchantRequestNoncesBefore: Prunes old nonces
+-- Purpose: Keep the nonce store small
+-- Parameters:
+--   $1: created_at - Nonces recorded before this time are deleted
+-- Business Logic:
+--   - Only called with a time far enough back that requests carrying those
+--     nonces are rejected for their timestamp anyway
+-- name: DeleteMerchantRequestNoncesBefore :execrows
+DELETE FROM merchant_request_nonces WHERE created_at < $1;
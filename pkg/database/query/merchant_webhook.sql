@@ -0,0 +1,300 @@
+-- CreateMerchantWebhookEndpoint: Registers a webhook endpoint for a merchant
+-- Purpose: Let a merchant receive payment events instead of polling
+-- Parameters:
+--   $1: merchant_id - Merchant the endpoint belongs to
+--   $2: url - Address the events are posted to
+--   $3: secret - Secret the deliveries are signed with
+--   $4: events - Event types the endpoint subscribes to
+-- Returns:
+--   The created endpoint
+-- name: CreateMerchantWebhookEndpoint :one
+INSERT INTO
+    merchant_webhook_endpoints (
+        merchant_id,
+        url,
+        secret,
+        events,
+        is_active,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        TRUE,
+        current_timestamp,
+        current_timestamp
+    ) RETURNING *;
+
+-- GetMerchantWebhookEndpointByID: Retrieves a single webhook endpoint
+-- Purpose: Show, update or test one endpoint
+-- Parameters:
+--   $1: merchant_webhook_endpoint_id - Unique identifier of the endpoint
+-- Returns:
+--   The endpoint, unless it was deleted
+-- name: GetMerchantWebhookEndpointByID :one
+SELECT *
+FROM merchant_webhook_endpoints
+WHERE
+    merchant_webhook_endpoint_id = $1
+    AND deleted_at IS NULL;
+
+-- GetMerchantWebhookEndpointsByMerchant: Lists the webhook endpoints of a merchant
+-- Purpose: Show a merchant where its events are sent
+-- Parameters:
+--   $1: merchant_id - Merchant the endpoints belong to
+-- Returns:
+--   The endpoints that were not deleted, oldest first
+-- name: GetMerchantWebhookEndpointsByMerchant :many
+SELECT *
+FROM merchant_webhook_endpoints
+WHERE
+    merchant_id = $1
+    AND deleted_at IS NULL
+ORDER BY merchant_webhook_endpoint_id;
+
+-- UpdateMerchantWebhookEndpoint: Changes the address, subscriptions or state of an endpoint
+-- Purpose: Let a merchant manage an endpoint
+-- Parameters:
+--   $1: merchant_webhook_endpoint_id - Unique identifier of the endpoint
+--   $2: url - Address the events are posted to
+--   $3: events - Event types the endpoint subscribes to
+--   $4: is_active - Whether new events are sent to the endpoint
+-- Returns:
+--   The updated endpoint
+-- name: UpdateMerchantWebhookEndpoint :one
+UPDATE merchant_webhook_endpoints
+SET
+    url = $2,
+    events = $3,
+    is_active = $4,
+    updated_at = current_timestamp
+WHERE
+    merchant_webhook_endpoint_id = $1
+    AND deleted_at IS NULL
+RETURNING *;
+
+-- DeleteMerchantWebhookEndpoint: Removes a webhook endpoint
+-- Purpose: Stop sending events to an endpoint
+-- Parameters:
+--   $1: merchant_webhook_endpoint_id - Unique identifier of the endpoint
+-- Returns:
+--   The deleted endpoint
+-- Business Logic:
+--   - The endpoint is soft deleted so its delivery log is kept
+--   - Pending deliveries of a deleted endpoint are no longer attempted
+-- name: DeleteMerchantWebhookEndpoint :one
+UPDATE merchant_webhook_endpoints
+SET
+    is_active = FALSE,
+    deleted_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    merchant_webhook_endpoint_id = $1
+    AND deleted_at IS NULL
+RETURNING *;
+
+-- QueueMerchantWebhookDeliveries: Queues an event for every endpoint subscribed to it
+-- Purpose: Fan out a merchant event to its webhook endpoints
+-- Parameters:
+--   $1: merchant_id - Merchant the event belongs to
+--   $2: event_id - Identifier of the event, shared by all its deliveries
+--   $3: event_type - Type of the event
+--   $4: payload - JSON body of the event
+-- Returns:
+--   The queued deliveries, one per active endpoint subscribed to the event type
+-- Business Logic:
+--   - Runs in the same transaction as the change the event describes, so an
+--     event is only sent when that change is committed
+-- name: QueueMerchantWebhookDeliveries :many
+INSERT INTO
+    merchant_webhook_deliveries (
+        merchant_webhook_endpoint_id,
+        event_id,
+        event_type,
+        payload,
+        status,
+        attempts,
+        next_attempt_at,
+        created_at,
+        updated_at
+    )
+SELECT
+    merchant_webhook_endpoint_id,
+    $2,
+    $3,
+    $4,
+    'pending',
+    0,
+    current_timestamp,
+    current_timestamp,
+    current_timestamp
+FROM merchant_webhook_endpoints
+WHERE
+    merchant_id = $1
+    AND is_active = TRUE
+    AND deleted_at IS NULL
+    AND $3 = ANY (events)
+RETURNING *;
+
+-- CreateMerchantWebhookDelivery: Queues an event for a single endpoint
+-- Purpose: Send a test ping or redeliver an event
+-- Parameters:
+--   $1: merchant_webhook_endpoint_id - Endpoint the event is sent to
+--   $2: event_id - Identifier of the event
+--   $3: event_type - Type of the event
+--   $4: payload - JSON body of the event
+--   $5: next_attempt_at - When the delivery job may pick the delivery up
+-- Returns:
+--   The created delivery
+-- name: CreateMerchantWebhookDelivery :one
+INSERT INTO
+    merchant_webhook_deliveries (
+        merchant_webhook_endpoint_id,
+        event_id,
+        event_type,
+        payload,
+        status,
+        attempts,
+        next_attempt_at,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        'pending',
+        0,
+        $5,
+        current_timestamp,
+        current_timestamp
+    ) RETURNING *;
+
+-- GetMerchantWebhookDeliveryByID: Retrieves a single delivery
+-- Purpose: Show or redeliver one delivery
+-- Parameters:
+--   $1: merchant_webhook_delivery_id - Unique identifier of the delivery
+-- Returns:
+--   The delivery record
+-- name: GetMerchantWebhookDeliveryByID :one
+SELECT *
+FROM merchant_webhook_deliveries
+WHERE
+    merchant_webhook_delivery_id = $1;
+
+-- GetMerchantWebhookDeliveries: Retrieves the paginated deliveries of an endpoint
+-- Purpose: Show a merchant what was sent to an endpoint
+-- Parameters:
+--   $1: merchant_webhook_endpoint_id - Endpoint the deliveries were sent to
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   Delivery records with total_count for pagination, newest first
+-- name: GetMerchantWebhookDeliveries :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM merchant_webhook_deliveries
+WHERE
+    merchant_webhook_endpoint_id = $1
+ORDER BY created_at DESC, merchant_webhook_delivery_id DESC
+LIMIT $2 OFFSET $3;
+
+-- ClaimDueMerchantWebhookDeliveries: Claims the deliveries due for an attempt
+-- Purpose: Feed the background delivery job
+-- Parameters:
+--   $1: limit - Maximum number of deliveries to claim
+--   $2: lease_until - When a claimed delivery may be picked up again
+-- Returns:
+--   The claimed deliveries
+-- Business Logic:
+--   - Claimed rows are skipped by concurrent workers and pushed back to
+--     lease_until, so a worker that dies mid-delivery only delays them
+--   - Deliveries of inactive or deleted endpoints are left alone
+-- name: ClaimDueMerchantWebhookDeliveries :many
+UPDATE merchant_webhook_deliveries
+SET
+    next_attempt_at = $2,
+    updated_at = current_timestamp
+WHERE
+    merchant_webhook_delivery_id IN (
+        SELECT d.merchant_webhook_delivery_id
+        FROM
+            merchant_webhook_deliveries d
+            JOIN merchant_webhook_endpoints e ON e.merchant_webhook_endpoint_id = d.merchant_webhook_endpoint_id
+        WHERE
+            d.status = 'pending'
+            AND d.next_attempt_at <= current_timestamp
+            AND e.is_active = TRUE
+            AND e.deleted_at IS NULL
+        ORDER BY d.next_attempt_at
+        LIMIT $1
+        FOR UPDATE OF d SKIP LOCKED
+    )
+RETURNING *;
+
+-- FinishMerchantWebhookDeliveryAttempt: Records the outcome of a delivery attempt
+-- Purpose: Mark a delivery delivered, failed, or due for a retry
+-- Parameters:
+--   $1: merchant_webhook_delivery_id - Unique identifier of the delivery
+--   $2: status - pending, delivered or failed
+--   $3: next_attempt_at - When to retry (NULL unless the status is pending)
+--   $4: last_response_code - Code the endpoint answered with (NULL when it could not be reached)
+--   $5: last_error - Why the attempt failed (NULL when it succeeded)
+-- Returns:
+--   The updated delivery
+-- name: FinishMerchantWebhookDeliveryAttempt :one
+UPDATE merchant_webhook_deliveries
+SET
+    status = $2,
+    attempts = attempts + 1,
+    next_attempt_at = $3,
+    last_response_code = $4,
+    last_error = $5,
+    delivered_at = CASE
+        WHEN $2 = 'delivered' THEN current_timestamp
+        ELSE delivered_at
+    END,
+    updated_at = current_timestamp
+WHERE
+    merchant_webhook_delivery_id = $1
+RETURNING *;
+
+-- CreateMerchantWebhookDeliveryAttempt: Adds an attempt to the delivery log
+-- Purpose: Keep the response code and timing of every attempt
+-- Parameters:
+--   $1: merchant_webhook_delivery_id - Delivery the attempt belongs to
+--   $2: attempt - Number of the attempt, starting at 1
+--   $3: response_code - Code the endpoint answered with (NULL when it could not be reached)
+--   $4: error - Why the attempt failed (NULL when it succeeded)
+--   $5: duration_ms - How long the attempt took
+-- Returns:
+--   The created attempt
+-- name: CreateMerchantWebhookDeliveryAttempt :one
+INSERT INTO
+    merchant_webhook_delivery_attempts (
+        merchant_webhook_delivery_id,
+        attempt,
+        response_code,
+        error,
+        duration_ms,
+        created_at
+    )
+VALUES ($1, $2, $3, $4, $5, current_timestamp) RETURNING *;
+
+-- GetMerchantWebhookDeliveryAttempts: Retrieves the delivery log of a delivery
+-- Purpose: Show every attempt made to deliver an event
+-- Parameters:
+--   $1: merchant_webhook_delivery_id - Delivery the attempts belong to
+-- Returns:
+--   The attempts, first attempt first
+-- name: GetMerchantWebhookDeliveryAttempts :many
+SELECT *
+FROM merchant_webhook_delivery_attempts
+WHERE
+    merchant_webhook_delivery_id = $1
+ORDER BY attempt;
@@ -0,0 +1,112 @@
+-- CreateProviderWebhookEvent: Stores a callback received from a payment provider
+-- Purpose: Keep the raw callback before it is applied
+-- Parameters:
+--   $1: provider - Name of the provider that sent the callback
+--   $2: event_id - Identifier of the callback at the provider
+--   $3: event_type - Kind of callback, topup.payment or withdraw.payout
+--   $4: payload - Raw body of the callback
+--   $5: signature - Signature the callback was sent with
+--   $6: sent_at - Time the provider signed the callback
+-- Returns:
+--   The created event, or no row when the provider already sent this event ID
+-- Business Logic:
+--   - (provider, event_id) is unique, so redelivered callbacks are stored once
+-- name: CreateProviderWebhookEvent :one
+INSERT INTO
+    provider_webhook_events (
+        provider,
+        event_id,
+        event_type,
+        payload,
+        signature,
+        sent_at,
+        status,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        'received',
+        current_timestamp,
+        current_timestamp
+    ) ON CONFLICT (provider, event_id) DO NOTHING RETURNING *;
+
+-- GetProviderWebhookEventByID: Retrieves a single provider callback
+-- Purpose: Show or replay one stored callback
+-- Parameters:
+--   $1: provider_webhook_event_id - Unique identifier of the event
+-- Returns:
+--   The event record
+-- name: GetProviderWebhookEventByID :one
+SELECT *
+FROM provider_webhook_events
+WHERE
+    provider_webhook_event_id = $1;
+
+-- GetProviderWebhookEventByEventID: Retrieves a callback by its provider event ID
+-- Purpose: Find the stored copy of a redelivered callback
+-- Parameters:
+--   $1: provider - Name of the provider that sent the callback
+--   $2: event_id - Identifier of the callback at the provider
+-- Returns:
+--   The event record
+-- name: GetProviderWebhookEventByEventID :one
+SELECT *
+FROM provider_webhook_events
+WHERE
+    provider = $1
+    AND event_id = $2;
+
+-- GetProviderWebhookEvents: Retrieves paginated provider callbacks with search capability
+-- Purpose: List received callbacks for support
+-- Parameters:
+--   $1: search_term - Optional filter on provider, event ID, event type or status (NULL for no filter)
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   Event records with total_count for pagination, newest first
+-- name: GetProviderWebhookEvents :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM provider_webhook_events
+WHERE (
+        $1::TEXT IS NULL
+        OR provider ILIKE '%' || $1 || '%'
+        OR event_id ILIKE '%' || $1 || '%'
+        OR event_type ILIKE '%' || $1 || '%'
+        OR status ILIKE '%' || $1 || '%'
+    )
+ORDER BY created_at DESC, provider_webhook_event_id DESC
+LIMIT $2 OFFSET $3;
+
+-- FinishProviderWebhookEvent: Records the outcome of applying a callback
+-- Purpose: Mark a callback processed or failed after each attempt
+-- Parameters:
+--   $1: provider_webhook_event_id - Unique identifier of the event
+--   $2: status - processed or failed
+--   $3: last_error - Why the attempt failed (NULL when it succeeded)
+-- Returns:
+--   The updated event record
+-- Business Logic:
+--   - Every attempt, including replays, increments attempts
+--   - processed_at is set the first time the callback is processed
+-- name: FinishProviderWebhookEvent :one
+UPDATE provider_webhook_events
+SET
+    status = $2,
+    last_error = $3,
+    attempts = attempts + 1,
+    processed_at = CASE
+        WHEN $2 = 'processed' THEN COALESCE(processed_at, current_timestamp)
+        ELSE processed_at
+    END,
+    updated_at = current_timestamp
+WHERE
+    provider_webhook_event_id = $1
+RETURNING *;
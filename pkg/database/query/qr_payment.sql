@@ -0,0 +1,34 @@
+-- CreateQrPayment: Links a merchant transaction to the QR code it paid
+-- Purpose: Record QR payments and keep a dynamic QR code from being paid twice
+-- Parameters:
+--   $1: merchant_id - Merchant that was paid
+--   $2: transaction_id - Merchant transaction the payment created
+--   $3: reference - Reference of a dynamic QR code, NULL for static ones
+-- Returns:
+--   The created payment, or no row when the merchant was already paid for
+--   the reference
+-- name: CreateQrPayment :one
+INSERT INTO
+    qr_payments (
+        merchant_id,
+        transaction_id,
+        reference,
+        created_at
+    )
+VALUES ($1, $2, $3, current_timestamp)
+ON CONFLICT (merchant_id, reference) DO NOTHING
+RETURNING *;
+
+-- GetQrPaymentByReference: Retrieves the payment of a dynamic QR code
+-- Purpose: Let a merchant match a QR payment to the order it was for
+-- Parameters:
+--   $1: merchant_id - Merchant that was paid
+--   $2: reference - Reference of the dynamic QR code
+-- Returns:
+--   The payment record
+-- name: GetQrPaymentByReference :one
+SELECT *
+FROM qr_payments
+WHERE
+    merchant_id = $1
+    AND reference = $2;
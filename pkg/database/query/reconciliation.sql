@@ -0,0 +1,335 @@
+-- TryLockReconciliation: Takes the reconciliation lock for the surrounding transaction
+-- Purpose: Keep two reconciliation runs from recording the same discrepancies at once
+-- Returns:
+--   Whether the lock was taken; it is released when the transaction ends
+-- name: TryLockReconciliation :one
+SELECT pg_try_advisory_xact_lock(hashtext('balance_reconciliation'))::boolean AS locked;
+
+-- CreateReconciliationRun: Starts a reconciliation run
+-- Purpose: Record when a run started so its discrepancies can refer to it
+-- Returns:
+--   The created run
+-- name: CreateReconciliationRun :one
+INSERT INTO
+    reconciliation_runs (started_at)
+VALUES (current_timestamp) RETURNING *;
+
+-- FinishReconciliationRun: Records the outcome of a reconciliation run
+-- Parameters:
+--   $1: reconciliation_run_id - Run that finished
+--   $2: cards_checked - Number of cards compared
+--   $3: discrepancy_count - Number of cards that did not match
+-- Returns:
+--   The finished run
+-- name: FinishReconciliationRun :one
+UPDATE reconciliation_runs
+SET
+    cards_checked = $2,
+    discrepancy_count = $3,
+    finished_at = current_timestamp
+WHERE
+    reconciliation_run_id = $1
+RETURNING *;
+
+-- GetReconciliationRuns: Retrieves the paginated reconciliation runs
+-- Purpose: Show admins when balances were last checked and with what outcome
+-- Parameters:
+--   $1: limit - Maximum number of records to return
+--   $2: offset - Number of records to skip for pagination
+-- Returns:
+--   Run records with total_count for pagination, newest first
+-- name: GetReconciliationRuns :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM reconciliation_runs
+ORDER BY started_at DESC, reconciliation_run_id DESC
+LIMIT $1 OFFSET $2;
+
+-- GetCardReconciliations: Recomputes the balance of every card from its history
+-- Purpose: Compare each saldo with what its movements add up to
+-- Returns:
+--   Per card: the saldo, the ledger balance and the movements by source
+-- Business Logic:
+--   - Runs as a single statement, so it sees one consistent snapshot and
+--     takes no locks that would hold up live traffic
+--   - History before the opening balance journal of a card is part of that
+--     opening balance and is not counted again
+--   - Topups add their amount net of fee; withdraws, outgoing transfers and
+--     payments take their amount plus the fee the card paid
+--   - Incoming transfers add the converted amount; settlements add the
+--     amount paid out to the card
+--   - Movements with no history row naming the card, such as opening
+--     balances, adjustments, holds, refunds, disputes and the merchant side
+--     of payments, are taken from the ledger as other_amount
+-- name: GetCardReconciliations :many
+WITH
+    card_openings AS (
+        SELECT ledger_postings.card_number, MAX(ledger_journals.posted_at) AS opened_at
+        FROM ledger_postings
+            JOIN ledger_journals ON ledger_journals.journal_id = ledger_postings.journal_id
+        WHERE
+            ledger_journals.reference_type = 'opening_balance'
+            AND ledger_postings.card_number IS NOT NULL
+        GROUP BY ledger_postings.card_number
+    ),
+    topup_totals AS (
+        SELECT topups.card_number, SUM(topups.topup_amount - topups.fee) AS amount
+        FROM topups
+            LEFT JOIN card_openings ON card_openings.card_number = topups.card_number
+        WHERE
+            topups.status = 'success'
+            AND (
+                card_openings.opened_at IS NULL
+                OR topups.created_at > card_openings.opened_at
+            )
+        GROUP BY topups.card_number
+    ),
+    withdraw_totals AS (
+        SELECT withdraws.card_number, SUM(withdraws.withdraw_amount + withdraws.fee) AS amount
+        FROM withdraws
+            LEFT JOIN card_openings ON card_openings.card_number = withdraws.card_number
+        WHERE
+            withdraws.status IN ('success', 'settled')
+            AND (
+                card_openings.opened_at IS NULL
+                OR withdraws.created_at > card_openings.opened_at
+            )
+        GROUP BY withdraws.card_number
+    ),
+    transfer_out_totals AS (
+        SELECT transfers.transfer_from AS card_number, SUM(transfers.transfer_amount + transfers.fee) AS amount
+        FROM transfers
+            LEFT JOIN card_openings ON card_openings.card_number = transfers.transfer_from
+        WHERE
+            transfers.status = 'success'
+            AND (
+                card_openings.opened_at IS NULL
+                OR transfers.created_at > card_openings.opened_at
+            )
+        GROUP BY transfers.transfer_from
+    ),
+    transfer_in_totals AS (
+        SELECT transfers.transfer_to AS card_number, SUM(transfers.converted_amount) AS amount
+        FROM transfers
+            LEFT JOIN card_openings ON card_openings.card_number = transfers.transfer_to
+        WHERE
+            transfers.status = 'success'
+            AND (
+                card_openings.opened_at IS NULL
+                OR transfers.created_at > card_openings.opened_at
+            )
+        GROUP BY transfers.transfer_to
+    ),
+    payment_totals AS (
+        SELECT transactions.card_number, SUM(transactions.amount) AS amount
+        FROM transactions
+            LEFT JOIN card_openings ON card_openings.card_number = transactions.card_number
+        WHERE
+            transactions.status IN (
+                'success',
+                'partially_refunded',
+                'refunded',
+                'disputed',
+                'charged_back'
+            )
+            AND (
+                card_openings.opened_at IS NULL
+                OR transactions.created_at > card_openings.opened_at
+            )
+        GROUP BY transactions.card_number
+    ),
+    settlement_totals AS (
+        SELECT settlement_batches.settlement_card_number AS card_number, SUM(settlement_batches.net_amount) AS amount
+        FROM settlement_batches
+        GROUP BY settlement_batches.settlement_card_number
+    ),
+    ledger_totals AS (
+        SELECT
+            ledger_postings.card_number,
+            SUM(
+                CASE
+                    WHEN ledger_postings.direction = 'credit' THEN ledger_postings.amount
+                    ELSE - ledger_postings.amount
+                END
+            ) AS balance,
+            SUM(
+                CASE
+                    WHEN ledger_journals.reference_type IN (
+                        'opening_balance',
+                        'saldo_adjustment',
+                        'saldo_hold',
+                        'refund',
+                        'dispute'
+                    )
+                    OR (
+                        ledger_journals.reference_type = 'transaction'
+                        AND ledger_postings.card_number <> transactions.card_number
+                    ) THEN CASE
+                        WHEN ledger_postings.direction = 'credit' THEN ledger_postings.amount
+                        ELSE - ledger_postings.amount
+                    END
+                    ELSE 0
+                END
+            ) AS other_amount
+        FROM ledger_postings
+            JOIN ledger_journals ON ledger_journals.journal_id = ledger_postings.journal_id
+            LEFT JOIN transactions ON ledger_journals.reference_type = 'transaction'
+            AND transactions.transaction_id = ledger_journals.reference_id
+        WHERE
+            ledger_postings.card_number IS NOT NULL
+        GROUP BY ledger_postings.card_number
+    )
+SELECT
+    saldos.card_number,
+    saldos.total_balance AS saldo_balance,
+    COALESCE(ledger_totals.balance, 0)::int AS ledger_balance,
+    COALESCE(topup_totals.amount, 0)::int AS topup_amount,
+    COALESCE(withdraw_totals.amount, 0)::int AS withdraw_amount,
+    COALESCE(transfer_out_totals.amount, 0)::int AS transfer_out_amount,
+    COALESCE(transfer_in_totals.amount, 0)::int AS transfer_in_amount,
+    COALESCE(payment_totals.amount, 0)::int AS payment_amount,
+    COALESCE(settlement_totals.amount, 0)::int AS settlement_amount,
+    COALESCE(ledger_totals.other_amount, 0)::int AS other_amount
+FROM saldos
+    LEFT JOIN ledger_totals ON ledger_totals.card_number = saldos.card_number
+    LEFT JOIN topup_totals ON topup_totals.card_number = saldos.card_number
+    LEFT JOIN withdraw_totals ON withdraw_totals.card_number = saldos.card_number
+    LEFT JOIN transfer_out_totals ON transfer_out_totals.card_number = saldos.card_number
+    LEFT JOIN transfer_in_totals ON transfer_in_totals.card_number = saldos.card_number
+    LEFT JOIN payment_totals ON payment_totals.card_number = saldos.card_number
+    LEFT JOIN settlement_totals ON settlement_totals.card_number = saldos.card_number
+ORDER BY saldos.card_number;
+
+-- UpsertBalanceDiscrepancy: Records that a card did not match
+-- Purpose: Keep one unresolved discrepancy per card that later runs refresh
+-- Parameters:
+--   $1: card_number - Card that did not match
+--   $2: reconciliation_run_id - Run that found the mismatch
+--   $3: saldo_balance - Balance of the saldo
+--   $4: expected_balance - Balance recomputed from the history
+--   $5: ledger_balance - Balance of the ledger postings of the card
+--   $6: difference - saldo_balance minus expected_balance
+--   $7..$13: the movements the expected balance was computed from
+-- Returns:
+--   The created or refreshed discrepancy
+-- Business Logic:
+--   - An acknowledged discrepancy stays acknowledged while it is refreshed
+-- name: UpsertBalanceDiscrepancy :one
+INSERT INTO
+    balance_discrepancies (
+        card_number,
+        reconciliation_run_id,
+        saldo_balance,
+        expected_balance,
+        ledger_balance,
+        difference,
+        topup_amount,
+        withdraw_amount,
+        transfer_out_amount,
+        transfer_in_amount,
+        payment_amount,
+        settlement_amount,
+        other_amount,
+        status,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        $11,
+        $12,
+        $13,
+        'open',
+        current_timestamp,
+        current_timestamp
+    )
+ON CONFLICT (card_number) WHERE status <> 'resolved' DO UPDATE
+SET
+    reconciliation_run_id = EXCLUDED.reconciliation_run_id,
+    saldo_balance = EXCLUDED.saldo_balance,
+    expected_balance = EXCLUDED.expected_balance,
+    ledger_balance = EXCLUDED.ledger_balance,
+    difference = EXCLUDED.difference,
+    topup_amount = EXCLUDED.topup_amount,
+    withdraw_amount = EXCLUDED.withdraw_amount,
+    transfer_out_amount = EXCLUDED.transfer_out_amount,
+    transfer_in_amount = EXCLUDED.transfer_in_amount,
+    payment_amount = EXCLUDED.payment_amount,
+    settlement_amount = EXCLUDED.settlement_amount,
+    other_amount = EXCLUDED.other_amount,
+    updated_at = current_timestamp
+RETURNING *;
+
+-- ResolveBalanceDiscrepancies: Resolves the discrepancies a run no longer found
+-- Purpose: Close discrepancies of cards that match again
+-- Parameters:
+--   $1: reconciliation_run_id - Run that just compared every card
+-- name: ResolveBalanceDiscrepancies :exec
+UPDATE balance_discrepancies
+SET
+    status = 'resolved',
+    resolved_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    status <> 'resolved'
+    AND reconciliation_run_id <> $1;
+
+-- GetBalanceDiscrepancyByID: Retrieves a single balance discrepancy
+-- Parameters:
+--   $1: balance_discrepancy_id - Unique identifier of the discrepancy
+-- Returns:
+--   The discrepancy record
+-- name: GetBalanceDiscrepancyByID :one
+SELECT *
+FROM balance_discrepancies
+WHERE
+    balance_discrepancy_id = $1;
+
+-- GetBalanceDiscrepancies: Retrieves the paginated balance discrepancies
+-- Purpose: Let admins review the cards that did not match
+-- Parameters:
+--   $1: status - Only discrepancies in this status, or all when empty
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   Discrepancy records with total_count for pagination, latest first
+-- name: GetBalanceDiscrepancies :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM balance_discrepancies
+WHERE
+    $1::varchar = ''
+    OR status = $1
+ORDER BY updated_at DESC, balance_discrepancy_id DESC
+LIMIT $2 OFFSET $3;
+
+-- AcknowledgeBalanceDiscrepancy: Marks a discrepancy as known to an admin
+-- Parameters:
+--   $1: balance_discrepancy_id - Discrepancy that is acknowledged
+--   $2: acknowledged_by - Admin acknowledging it
+--   $3: note - What the admin found or is doing about it
+-- Returns:
+--   The acknowledged discrepancy, or no row when it is not open
+-- name: AcknowledgeBalanceDiscrepancy :one
+UPDATE balance_discrepancies
+SET
+    status = 'acknowledged',
+    acknowledged_by = $2,
+    acknowledged_at = current_timestamp,
+    note = $3,
+    updated_at = current_timestamp
+WHERE
+    balance_discrepancy_id = $1
+    AND status = 'open'
+RETURNING *;
@@ -0,0 +1,76 @@
+-- CreateRefund: Creates a refund for a merchant transaction
+-- Purpose: Record money returned from a merchant to the paying card
+-- Parameters:
+--   $1: transaction_id - ID of the refunded transaction
+--   $2: card_number - Card that receives the refund
+--   $3: merchant_id - Merchant that pays the refund
+--   $4: amount - Refunded amount
+--   $5: reason - Reason given by the merchant
+-- Returns:
+--   The created refund record
+-- Business Logic:
+--   - Refund number is generated automatically
+--   - Status defaults to 'success'
+--   - The amount is recorded in the currency of the refunded transaction
+-- name: CreateRefund :one
+INSERT INTO refunds (
+    transaction_id,
+    card_number,
+    merchant_id,
+    amount,
+    reason,
+    currency,
+    created_at,
+    updated_at
+)
+VALUES (
+    $1, $2, $3, $4, $5,
+    (SELECT t.currency FROM transactions t WHERE t.transaction_id = $1),
+    current_timestamp, current_timestamp
+)
+RETURNING *;
+
+-- GetRefundByID: Retrieves a refund by ID
+-- Purpose: Fetch refund details
+-- Parameters:
+--   $1: refund_id - ID of the refund
+-- Returns:
+--   The refund record
+-- name: GetRefundByID :one
+SELECT * FROM refunds WHERE refund_id = $1;
+
+-- GetRefunds: Retrieves paginated refunds with search capability
+-- Purpose: List refunds for the management UI
+-- Parameters:
+--   $1: search_term - Optional text to filter by card number, reason or status (NULL for no filter)
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   All refund fields plus total_count of matching records
+-- Business Logic:
+--   - Supports partial text matching on multiple fields (case-insensitive)
+--   - Orders by created_at (newest first)
+-- name: GetRefunds :many
+SELECT
+    *,
+    COUNT(*) OVER() AS total_count
+FROM
+    refunds
+WHERE
+    ($1::TEXT IS NULL
+        OR card_number ILIKE '%' || $1 || '%'
+        OR reason ILIKE '%' || $1 || '%'
+        OR status ILIKE '%' || $1 || '%'
+    )
+ORDER BY
+    created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- GetRefundsByTransactionID: Retrieves all refunds of a transaction
+-- Purpose: Show the refund history of a single transaction
+-- Parameters:
+--   $1: transaction_id - ID of the transaction
+-- Returns:
+--   All refund records of the transaction, oldest first
+-- name: GetRefundsByTransactionID :many
+SELECT * FROM refunds WHERE transaction_id = $1 ORDER BY created_at ASC, refund_id ASC;
@@ -108,6 +108,7 @@ SELECT * FROM saldos WHERE saldo_id = $1 AND deleted_at IS NOT NULL;
 --   year: The year as text
 --   month: 3-letter month abbreviation
 --   total_balance: Monthly balance total (0 if no data)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Aggregates balances for two customizable time periods
 --   - Only includes active saldos (deleted_at IS NULL)
@@ -115,10 +116,13 @@ SELECT * FROM saldos WHERE saldo_id = $1 AND deleted_at IS NOT NULL;
 --   - Formats output for consistent visualization
 --   - Results ordered by year and month (newest first)
 -- name: GetMonthlyTotalSaldoBalance :many
-WITH monthly_data AS (
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+), monthly_data AS (
     SELECT
         EXTRACT(YEAR FROM s.created_at)::integer AS year,
         EXTRACT(MONTH FROM s.created_at)::integer AS month,
+        s.currency,
         COALESCE(SUM(s.total_balance), 0) AS total_balance
     FROM
         saldos s
@@ -130,11 +134,13 @@ WITH monthly_data AS (
         )
     GROUP BY
         EXTRACT(YEAR FROM s.created_at),
-        EXTRACT(MONTH FROM s.created_at)
+        EXTRACT(MONTH FROM s.created_at),
+        s.currency
 ), formatted_data AS (
     SELECT
         year::text,
         TO_CHAR(TO_DATE(month::text, 'MM'), 'Mon') AS month,
+        currency,
         total_balance::integer
     FROM
         monthly_data
@@ -144,12 +150,15 @@ WITH monthly_data AS (
     SELECT
         EXTRACT(YEAR FROM $1::timestamp)::text AS year,
         TO_CHAR($1::timestamp, 'Mon') AS month,
+        cur.currency,
         0::integer AS total_balance
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM monthly_data
         WHERE year = EXTRACT(YEAR FROM $1::timestamp)::integer
         AND month = EXTRACT(MONTH FROM $1::timestamp)::integer
+        AND currency = cur.currency
     )
 
     UNION ALL
@@ -157,18 +166,22 @@ WITH monthly_data AS (
     SELECT
         EXTRACT(YEAR FROM $3::timestamp)::text AS year,
         TO_CHAR($3::timestamp, 'Mon') AS month,
+        cur.currency,
         0::integer AS total_balance
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM monthly_data
         WHERE year = EXTRACT(YEAR FROM $3::timestamp)::integer
         AND month = EXTRACT(MONTH FROM $3::timestamp)::integer
+        AND currency = cur.currency
     )
 )
 SELECT * FROM formatted_data
 ORDER BY
     year DESC,
-    TO_DATE(month, 'Mon') DESC;
+    TO_DATE(month, 'Mon') DESC,
+    currency;
 
 
 -- GetYearlyTotalSaldoBalances: Retrieves yearly balance totals for current and previous year
@@ -178,6 +191,7 @@ ORDER BY
 -- Returns:
 --   year: The year as text
 --   total_balance: Annual balance total (0 if no data)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Shows comparison between specified year and previous year
 --   - Only includes active saldos (deleted_at IS NULL)
@@ -185,9 +199,12 @@ ORDER BY
 --   - Results ordered by year (newest first)
 --   - Useful for year-over-year financial analysis
 -- name: GetYearlyTotalSaldoBalances :many
-WITH yearly_data AS (
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+), yearly_data AS (
     SELECT
         EXTRACT(YEAR FROM s.created_at)::integer AS year,
+        s.currency,
         COALESCE(SUM(s.total_balance), 0)::integer AS total_balance
     FROM
         saldos s
@@ -198,10 +215,12 @@ WITH yearly_data AS (
             OR EXTRACT(YEAR FROM s.created_at) = $1::integer - 1
         )
     GROUP BY
-        EXTRACT(YEAR FROM s.created_at)
+        EXTRACT(YEAR FROM s.created_at),
+        s.currency
 ), formatted_data AS (
     SELECT
         year::text,
+        currency,
         total_balance::integer
     FROM
         yearly_data
@@ -210,27 +229,34 @@ WITH yearly_data AS (
 
     SELECT
         $1::text AS year,
+        cur.currency,
         0::integer AS total_balance
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM yearly_data
         WHERE year = $1::integer
+        AND currency = cur.currency
     )
 
     UNION ALL
 
     SELECT
         ($1::integer - 1)::text AS year,
+        cur.currency,
         0::integer AS total_balance
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM yearly_data
         WHERE year = $1::integer - 1
+        AND currency = cur.currency
     )
 )
 SELECT * FROM formatted_data
 ORDER BY
-    year DESC;
+    year DESC,
+    currency;
 
 
 
@@ -242,6 +268,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation (e.g., 'Jan', 'Feb')
 --   total_balance: Sum of balances for that month (0 if no data exists)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Generates a complete 12-month series for the specified year
 --   - Uses LEFT JOIN to ensure all months appear in results
@@ -256,20 +283,29 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(s.total_balance), 0)::int AS total_balance
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     saldos s ON EXTRACT(MONTH FROM s.created_at) = EXTRACT(MONTH FROM m.month)
+    AND s.currency = cur.currency
     AND EXTRACT(YEAR FROM s.created_at) = EXTRACT(YEAR FROM m.month)
     AND s.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 -- GetYearlySaldoBalances: Retrieves yearly balance totals for a 5-year period
 -- Purpose: Show annual balance trends for long-term financial analysis
@@ -278,6 +314,7 @@ ORDER BY
 -- Returns:
 --   year: The 4-digit year
 --   total_balance: Sum of balances for that year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Covers a 5-year rolling window (reference_year-4 to reference_year)
 --   - Only includes active saldo records (deleted_at IS NULL)
@@ -288,6 +325,7 @@ ORDER BY
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM s.created_at) AS year,
+        s.currency,
         SUM(s.total_balance) AS total_balance
     FROM
         saldos s
@@ -296,15 +334,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM s.created_at) >= $1 - 4
         AND EXTRACT(YEAR FROM s.created_at) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM s.created_at)
+        EXTRACT(YEAR FROM s.created_at),
+        s.currency
 )
 SELECT
     year,
+    currency,
     total_balance
 FROM
     last_five_years
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 
@@ -320,6 +361,65 @@ ORDER BY
 -- name: GetSaldoByCardNumber :one
 SELECT * FROM saldos WHERE card_number = $1 AND deleted_at IS NULL;
 
+-- LockSaldosByCardNumbers: Locks the saldo rows of the given cards
+-- Purpose: Serialize concurrent balance movements on the same cards
+-- Parameters:
+--   $1: card_numbers - Card numbers whose saldos are locked
+-- Returns:
+--   All saldo fields for the active records matching the card numbers
+-- Business Logic:
+--   - Must run inside a database transaction; locks are held until commit
+--   - Rows are locked in card number order so that movements touching the
+--     same cards in opposite directions cannot deadlock
+--   - Balance checks made on the returned rows stay valid until commit
+-- name: LockSaldosByCardNumbers :many
+SELECT *
+FROM saldos
+WHERE
+    card_number = ANY(sqlc.arg(card_numbers)::VARCHAR[])
+    AND deleted_at IS NULL
+ORDER BY card_number, saldo_id
+FOR UPDATE;
+
+-- ReserveSaldoBalance: Reserves part of a card's balance
+-- Purpose: Set aside funds for an active saldo hold without moving them
+-- Parameters:
+--   $1: card_number - Card whose funds are reserved
+--   $2: amount - Amount to reserve
+-- Returns:
+--   The updated saldo record, or no row if the unreserved balance is too low
+-- Business Logic:
+--   - Only active saldos can be reserved
+--   - The check and the increment happen in one statement
+-- name: ReserveSaldoBalance :one
+UPDATE saldos
+SET
+    reserved_balance = reserved_balance + sqlc.arg(amount)::INT,
+    updated_at = current_timestamp
+WHERE
+    card_number = sqlc.arg(card_number)
+    AND deleted_at IS NULL
+    AND total_balance - reserved_balance >= sqlc.arg(amount)::INT
+RETURNING *;
+
+-- ReleaseSaldoReservation: Releases part of a card's reserved balance
+-- Purpose: Free funds once a saldo hold is released or consumed
+-- Parameters:
+--   $1: card_number - Card whose reservation is released
+--   $2: amount - Amount to release
+-- Returns:
+--   The updated saldo record, or no row if less than amount is reserved
+-- name: ReleaseSaldoReservation :one
+UPDATE saldos
+SET
+    reserved_balance = reserved_balance - sqlc.arg(amount)::INT,
+    updated_at = current_timestamp
+WHERE
+    card_number = sqlc.arg(card_number)
+    AND deleted_at IS NULL
+    AND reserved_balance >= sqlc.arg(amount)::INT
+RETURNING *;
+
 -- CreateSaldo: Creates a new saldo record
 -- Purpose: Initialize a balance record for a new card
 -- Parameters:
@@ -330,36 +430,38 @@ SELECT * FROM saldos WHERE card_number = $1 AND deleted_at IS NULL;
 -- Business Logic:
 --   - Sets creation and update timestamps automatically
 --   - Used when issuing new cards
+--   - The saldo is kept in the currency of the card
 -- name: CreateSaldo :one
 INSERT INTO
     saldos (
         card_number,
         total_balance,
+        currency,
         created_at,
         updated_at
     )
 VALUES (
         $1,
         $2,
+        (SELECT c.currency FROM cards c WHERE c.card_number = $1),
         current_timestamp,
         current_timestamp
     ) RETURNING *;
 
 -- UpdateSaldo: Modifies saldo record details
--- Purpose: Update card number and balance for an existing saldo
+-- Purpose: Update the balance of an existing saldo
 -- Parameters:
 --   $1: saldo_id - The ID of the saldo to update
---   $2: card_number - New card number to associate
---   $3: total_balance - New balance amount
+--   $2: total_balance - New balance amount
 -- Business Logic:
 --   - Only updates active records (deleted_at IS NULL)
 --   - Automatically updates the modification timestamp
+--   - The card is kept, as the ledger postings of the saldo stay on it
 --   - Useful for administrative corrections
 -- name: UpdateSaldo :one
 UPDATE saldos
 SET
-    card_number = $2,
-    total_balance = $3,
+    total_balance = $2,
     updated_at = current_timestamp
 WHERE
     saldo_id = $1
@@ -386,21 +488,20 @@ WHERE
 RETURNING *;
 
 -- UpdateSaldoWithdraw: Processes a withdrawal transaction
--- Purpose: Record a withdrawal and update the remaining balance
+-- Purpose: Record the latest withdrawal on the saldo
 -- Parameters:
 --   $1: card_number - The card used for withdrawal
 --   $2: withdraw_amount - The amount being withdrawn
 --   $3: withdraw_time - Timestamp of the withdrawal
 -- Business Logic:
 --   - Only processes if sufficient balance exists (total_balance >= $2)
---   - Updates both withdrawal amount and remaining balance
---   - Records withdrawal timestamp
+--   - Records withdrawal amount and timestamp
+--   - The balance itself is moved by the withdraw ledger journal
 --   - Only affects active records
 -- name: UpdateSaldoWithdraw :one
 UPDATE saldos
 SET
     withdraw_amount = $2,
-    total_balance = total_balance - $2,
     withdraw_time = $3,
     updated_at = current_timestamp
 WHERE
@@ -409,6 +510,39 @@ WHERE
     AND total_balance >= $2
 RETURNING *;
 
+-- RebuildSaldoBalancesFromLedger: Recomputes saldo balances from the ledger
+-- Purpose: Rebuild the saldos projection when it drifts from the ledger
+-- Returns:
+--   The saldo records whose balance was corrected
+-- Business Logic:
+--   - Saldos without any posting are reset to zero
+--   - Only rows that differ from the ledger are touched
+-- name: RebuildSaldoBalancesFromLedger :many
+WITH
+    ledger AS (
+        SELECT card_number, SUM(
+                CASE
+                    WHEN direction = 'credit' THEN amount
+                    ELSE - amount
+                END
+            )::INT AS balance
+        FROM ledger_postings
+        WHERE
+            card_number IS NOT NULL
+        GROUP BY
+            card_number
+    )
+UPDATE saldos
+SET
+    total_balance = COALESCE(ledger.balance, 0),
+    updated_at = current_timestamp
+FROM saldos current_saldo
+    LEFT JOIN ledger ON ledger.card_number = current_saldo.card_number
+WHERE
+    saldos.saldo_id = current_saldo.saldo_id
+    AND saldos.total_balance <> COALESCE(ledger.balance, 0)
+RETURNING saldos.*;
+
 -- TrashSaldo: Soft-deletes a saldo record
 -- Purpose: Remove a saldo from active use without permanent deletion
 -- Parameters:
@@ -0,0 +1,110 @@
+-- CreateSaldoHold: Records a hold on a card's funds
+-- Purpose: Track money set aside for a pending operation
+-- Parameters:
+--   $1: card_number - Card whose funds are held
+--   $2: amount - Held amount
+--   $3: reason - Why the funds are held
+--   $4: reference_type - Operation that placed the hold (NULL for manual holds)
+--   $5: reference_id - ID of that operation (NULL for manual holds)
+--   $6: created_by - User who placed a manual hold (NULL otherwise)
+-- Returns:
+--   The created hold record
+-- Business Logic:
+--   - Status starts as 'active'
+--   - The caller reserves the amount on the card's saldo in the same transaction
+--   - The amount is recorded in the currency of the card
+-- name: CreateSaldoHold :one
+INSERT INTO saldo_holds (
+    card_number,
+    amount,
+    reason,
+    reference_type,
+    reference_id,
+    created_by,
+    currency,
+    created_at,
+    updated_at
+)
+VALUES (
+    $1, $2, $3, $4, $5, $6,
+    (SELECT c.currency FROM cards c WHERE c.card_number = $1),
+    current_timestamp, current_timestamp
+)
+RETURNING *;
+
+-- GetSaldoHoldByID: Retrieves a hold by ID
+-- Purpose: Fetch hold details
+-- Parameters:
+--   $1: hold_id - ID of the hold
+-- Returns:
+--   The hold record
+-- name: GetSaldoHoldByID :one
+SELECT * FROM saldo_holds WHERE hold_id = $1;
+
+-- GetActiveSaldoHoldByReference: Retrieves the active hold of an operation
+-- Purpose: Find the hold to release or consume when an operation completes
+-- Parameters:
+--   $1: reference_type - Operation type, e.g. 'authorization'
+--   $2: reference_id - ID of the operation
+-- Returns:
+--   The active hold record
+-- name: GetActiveSaldoHoldByReference :one
+SELECT *
+FROM saldo_holds
+WHERE
+    reference_type = $1
+    AND reference_id = $2
+    AND status = 'active';
+
+-- GetActiveSaldoHoldsByCardNumber: Lists the active holds on a card
+-- Purpose: Explain the difference between a card's ledger and available balance
+-- Parameters:
+--   $1: card_number - Card to inspect
+-- Returns:
+--   Active hold records, newest first
+-- name: GetActiveSaldoHoldsByCardNumber :many
+SELECT *
+FROM saldo_holds
+WHERE
+    card_number = $1
+    AND status = 'active'
+ORDER BY created_at DESC;
+
+-- ReleaseSaldoHold: Releases an active hold
+-- Purpose: Return held funds to the available balance
+-- Parameters:
+--   $1: hold_id - ID of the hold
+-- Returns:
+--   The updated hold record, or no row if the hold is no longer active
+-- Business Logic:
+--   - The caller releases the reservation on the saldo in the same transaction
+-- name: ReleaseSaldoHold :one
+UPDATE saldo_holds
+SET
+    status = 'released',
+    released_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    hold_id = $1
+    AND status = 'active'
+RETURNING *;
+
+-- ConsumeSaldoHold: Consumes an active hold
+-- Purpose: Mark held funds as spent by the operation that placed the hold
+-- Parameters:
+--   $1: hold_id - ID of the hold
+-- Returns:
+--   The updated hold record, or no row if the hold is no longer active
+-- Business Logic:
+--   - The caller releases the reservation and posts the debit journal in the
+--     same transaction
+-- name: ConsumeSaldoHold :one
+UPDATE saldo_holds
+SET
+    status = 'consumed',
+    consumed_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    hold_id = $1
+    AND status = 'active'
+RETURNING *;
@@ -0,0 +1,295 @@
+-- CreateScheduledTransfer: Creates a new scheduled transfer
+-- Purpose: Set up a future or recurring transfer between two cards
+-- Parameters:
+--   $1: user_id - Owner of the sending card
+--   $2: transfer_from - Card the funds are taken from
+--   $3: transfer_to - Card the funds are sent to
+--   $4: transfer_amount - Amount of every run
+--   $5: recurrence - once, daily, weekly or monthly
+--   $6: day_of_month - Day monthly schedules run on (NULL otherwise)
+--   $7: next_run_at - When the first run is due
+--   $8: end_at - No run is scheduled after this moment (NULL for no end)
+-- Returns:
+--   The created scheduled transfer record
+-- name: CreateScheduledTransfer :one
+INSERT INTO
+    scheduled_transfers (
+        user_id,
+        transfer_from,
+        transfer_to,
+        transfer_amount,
+        recurrence,
+        day_of_month,
+        next_run_at,
+        end_at,
+        status,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        'active',
+        current_timestamp,
+        current_timestamp
+    ) RETURNING *;
+
+-- UpdateScheduledTransfer: Changes what and when a schedule transfers
+-- Purpose: Edit the recipient, amount or timing of a schedule
+-- Parameters:
+--   $1: scheduled_transfer_id - Unique identifier of the schedule
+--   $2: transfer_to - Card the funds are sent to
+--   $3: transfer_amount - Amount of every run
+--   $4: recurrence - once, daily, weekly or monthly
+--   $5: day_of_month - Day monthly schedules run on (NULL otherwise)
+--   $6: next_run_at - When the next run is due
+--   $7: end_at - No run is scheduled after this moment (NULL for no end)
+-- Returns:
+--   The updated scheduled transfer record
+-- Business Logic:
+--   - Completed and cancelled schedules cannot be changed
+--   - Moving next_run_at makes a concurrent executor lose its claim on the old run
+-- name: UpdateScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+    transfer_to = $2,
+    transfer_amount = $3,
+    recurrence = $4,
+    day_of_month = $5,
+    next_run_at = $6,
+    end_at = $7,
+    updated_at = current_timestamp
+WHERE
+    scheduled_transfer_id = $1
+    AND status IN ('active', 'paused', 'failed')
+RETURNING *;
+
+-- GetScheduledTransfers: Retrieves paginated scheduled transfers with search capability
+-- Purpose: List schedules for their owner or for administration
+-- Parameters:
+--   $1: search_term - Optional filter on card numbers, recurrence or status (NULL for no filter)
+--   $2: user_id - Only return schedules of this user (NULL for all users)
+--   $3: limit - Maximum number of records to return
+--   $4: offset - Number of records to skip for pagination
+-- Returns:
+--   Scheduled transfer records with total_count for pagination
+-- Business Logic:
+--   - Ordered by the next run, schedules with nothing left to run last
+-- name: GetScheduledTransfers :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM scheduled_transfers
+WHERE (
+        $1::TEXT IS NULL
+        OR transfer_from ILIKE '%' || $1 || '%'
+        OR transfer_to ILIKE '%' || $1 || '%'
+        OR recurrence ILIKE '%' || $1 || '%'
+        OR status ILIKE '%' || $1 || '%'
+    )
+    AND (
+        sqlc.narg(user_id)::INT IS NULL
+        OR user_id = sqlc.narg(user_id)::INT
+    )
+ORDER BY next_run_at ASC NULLS LAST, scheduled_transfer_id DESC
+LIMIT $2 OFFSET $3;
+
+-- GetScheduledTransferByID: Retrieves a single scheduled transfer
+-- Purpose: Show the detail of one schedule
+-- Parameters:
+--   $1: scheduled_transfer_id - Unique identifier of the schedule
+-- Returns:
+--   The scheduled transfer record
+-- name: GetScheduledTransferByID :one
+SELECT *
+FROM scheduled_transfers
+WHERE
+    scheduled_transfer_id = $1;
+
+-- PauseScheduledTransfer: Stops an active schedule from running
+-- Purpose: Suspend a schedule without losing it
+-- Parameters:
+--   $1: scheduled_transfer_id - Unique identifier of the schedule
+-- Returns:
+--   The paused scheduled transfer record
+-- Business Logic:
+--   - Only active schedules can be paused
+-- name: PauseScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+    status = 'paused',
+    updated_at = current_timestamp
+WHERE
+    scheduled_transfer_id = $1
+    AND status = 'active'
+RETURNING *;
+
+-- ResumeScheduledTransfer: Reactivates a paused or failed schedule
+-- Purpose: Let a schedule run again after it was paused or a run failed
+-- Parameters:
+--   $1: scheduled_transfer_id - Unique identifier of the schedule
+--   $2: next_run_at - When the next run is due
+-- Returns:
+--   The resumed scheduled transfer record
+-- Business Logic:
+--   - Runs missed while the schedule was not active are not caught up
+-- name: ResumeScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+    status = 'active',
+    next_run_at = $2,
+    updated_at = current_timestamp
+WHERE
+    scheduled_transfer_id = $1
+    AND status IN ('paused', 'failed')
+RETURNING *;
+
+-- CancelScheduledTransfer: Ends a schedule for good
+-- Purpose: Stop all future runs of a schedule
+-- Parameters:
+--   $1: scheduled_transfer_id - Unique identifier of the schedule
+-- Returns:
+--   The cancelled scheduled transfer record
+-- Business Logic:
+--   - Run history is kept
+-- name: CancelScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+    status = 'cancelled',
+    next_run_at = NULL,
+    updated_at = current_timestamp
+WHERE
+    scheduled_transfer_id = $1
+    AND status IN ('active', 'paused', 'failed')
+RETURNING *;
+
+-- GetDueScheduledTransfers: Retrieves schedules whose next run is due
+-- Purpose: Feed the background executor
+-- Parameters:
+--   $1: now - Current time
+--   $2: limit - Maximum number of schedules to return
+-- Returns:
+--   Active schedules due at or before now, oldest first
+-- name: GetDueScheduledTransfers :many
+SELECT *
+FROM scheduled_transfers
+WHERE
+    status = 'active'
+    AND next_run_at <= sqlc.arg(now)::TIMESTAMP
+ORDER BY next_run_at
+LIMIT sqlc.arg(row_limit);
+
+-- ClaimScheduledTransferRun: Records that a run of a schedule is starting
+-- Purpose: Make sure every occurrence of a schedule is executed at most once
+-- Parameters:
+--   $1: scheduled_transfer_id - Unique identifier of the schedule
+--   $2: scheduled_for - The next_run_at the executor saw when picking the schedule
+-- Returns:
+--   The created run, or no row when the run was already claimed, or the
+--   schedule was paused, cancelled or moved in the meantime
+-- Business Logic:
+--   - The schedule row is locked so pausing it waits for the claim to commit
+-- name: ClaimScheduledTransferRun :one
+INSERT INTO
+    scheduled_transfer_runs (
+        scheduled_transfer_id,
+        scheduled_for,
+        status,
+        created_at,
+        updated_at
+    )
+SELECT st.scheduled_transfer_id, st.next_run_at, 'running', current_timestamp, current_timestamp
+FROM scheduled_transfers st
+WHERE
+    st.scheduled_transfer_id = $1
+    AND st.status = 'active'
+    AND st.next_run_at = sqlc.arg(scheduled_for)::TIMESTAMP
+FOR UPDATE
+ON CONFLICT (
+    scheduled_transfer_id,
+    scheduled_for
+) DO NOTHING
+RETURNING *;
+
+-- AdvanceScheduledTransfer: Moves a schedule past the run that was just claimed
+-- Purpose: Set the next occurrence, or complete a schedule with nothing left to run
+-- Parameters:
+--   $1: scheduled_transfer_id - Unique identifier of the schedule
+--   $2: next_run_at - When the next run is due (NULL when completed)
+--   $3: status - active, or completed when nothing is left to run
+--   $4: last_run_at - The occurrence that was claimed
+-- Returns:
+--   The updated scheduled transfer record
+-- name: AdvanceScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+    next_run_at = $2,
+    status = $3,
+    last_run_at = $4,
+    updated_at = current_timestamp
+WHERE
+    scheduled_transfer_id = $1
+RETURNING *;
+
+-- FailScheduledTransfer: Stops a schedule after one of its runs failed
+-- Purpose: Keep a failing schedule from running again until its owner resumes it
+-- Parameters:
+--   $1: scheduled_transfer_id - Unique identifier of the schedule
+-- Returns:
+--   The failed scheduled transfer record
+-- Business Logic:
+--   - Schedules paused or cancelled while the run was in flight keep their status
+-- name: FailScheduledTransfer :one
+UPDATE scheduled_transfers
+SET
+    status = 'failed',
+    updated_at = current_timestamp
+WHERE
+    scheduled_transfer_id = $1
+    AND status IN ('active', 'completed')
+RETURNING *;
+
+-- FinishScheduledTransferRun: Records the outcome of a run
+-- Purpose: Keep the history of what every run did
+-- Parameters:
+--   $1: scheduled_transfer_run_id - Unique identifier of the run
+--   $2: status - success or failed
+--   $3: transfer_id - Transfer created by the run (NULL when none was)
+--   $4: failure_reason - Why the run failed (NULL on success)
+-- Returns:
+--   The updated run record
+-- name: FinishScheduledTransferRun :one
+UPDATE scheduled_transfer_runs
+SET
+    status = $2,
+    transfer_id = $3,
+    failure_reason = $4,
+    updated_at = current_timestamp
+WHERE
+    scheduled_transfer_run_id = $1
+    AND status = 'running'
+RETURNING *;
+
+-- GetScheduledTransferRuns: Retrieves the run history of a schedule
+-- Purpose: Show when a schedule ran and why runs failed
+-- Parameters:
+--   $1: scheduled_transfer_id - Unique identifier of the schedule
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   Run records with total_count for pagination, most recent first
+-- name: GetScheduledTransferRuns :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM scheduled_transfer_runs
+WHERE
+    scheduled_transfer_id = $1
+ORDER BY scheduled_for DESC
+LIMIT $2 OFFSET $3;
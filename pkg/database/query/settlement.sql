@@ -0,0 +1,281 @@
+-- AddMerchantPendingBalance: Accrues an amount to the pending balance of a merchant
+-- Purpose: Record what the platform owes a merchant until it is settled
+-- Parameters:
+--   $1: merchant_id - Merchant the balance belongs to
+--   $2: currency - Currency of the balance
+--   $3: amount - Amount to add; negative amounts reduce the balance
+-- Returns:
+--   The updated balance
+-- Business Logic:
+--   - Creates the balance on the first accrual in a currency
+--   - Locks the balance row until the surrounding transaction ends
+-- name: AddMerchantPendingBalance :one
+INSERT INTO
+    merchant_balances (
+        merchant_id,
+        currency,
+        pending_balance,
+        created_at,
+        updated_at
+    )
+VALUES ($1, $2, $3, current_timestamp, current_timestamp)
+ON CONFLICT (merchant_id, currency) DO UPDATE
+SET
+    pending_balance = merchant_balances.pending_balance + EXCLUDED.pending_balance,
+    updated_at = current_timestamp
+RETURNING *;
+
+-- DeductMerchantPendingBalance: Takes an amount out of the pending balance of a merchant
+-- Purpose: Fund a refund or dispute hold from money that was not paid out yet
+-- Parameters:
+--   $1: merchant_id - Merchant the balance belongs to
+--   $2: currency - Currency of the balance
+--   $3: amount - Amount to take out
+-- Returns:
+--   The updated balance, or no row when the balance does not cover the amount
+-- name: DeductMerchantPendingBalance :one
+UPDATE merchant_balances
+SET
+    pending_balance = pending_balance - $3,
+    updated_at = current_timestamp
+WHERE
+    merchant_id = $1
+    AND currency = $2
+    AND pending_balance >= $3
+RETURNING *;
+
+-- SetMerchantSettlementCard: Designates the card a merchant balance is paid out to
+-- Purpose: Let a merchant choose where its settlements go
+-- Parameters:
+--   $1: merchant_id - Merchant the balance belongs to
+--   $2: currency - Currency of the balance, which is the card currency
+--   $3: settlement_card_number - Card the balance is paid out to
+-- Returns:
+--   The updated balance
+-- name: SetMerchantSettlementCard :one
+INSERT INTO
+    merchant_balances (
+        merchant_id,
+        currency,
+        pending_balance,
+        settlement_card_number,
+        created_at,
+        updated_at
+    )
+VALUES ($1, $2, 0, $3, current_timestamp, current_timestamp)
+ON CONFLICT (merchant_id, currency) DO UPDATE
+SET
+    settlement_card_number = EXCLUDED.settlement_card_number,
+    updated_at = current_timestamp
+RETURNING *;
+
+-- GetMerchantBalances: Lists the balances of a merchant
+-- Purpose: Show a merchant what it is owed and where it is paid out to
+-- Parameters:
+--   $1: merchant_id - Merchant the balances belong to
+-- Returns:
+--   One balance per currency
+-- name: GetMerchantBalances :many
+SELECT *
+FROM merchant_balances
+WHERE
+    merchant_id = $1
+ORDER BY currency;
+
+-- GetMerchantBalanceForUpdate: Locks the balance of a merchant in one currency
+-- Purpose: Keep concurrent settlement runs from paying out the same items
+-- Parameters:
+--   $1: merchant_id - Merchant the balance belongs to
+--   $2: currency - Currency of the balance
+-- Returns:
+--   The balance, locked until the surrounding transaction ends
+-- name: GetMerchantBalanceForUpdate :one
+SELECT *
+FROM merchant_balances
+WHERE
+    merchant_id = $1
+    AND currency = $2
+FOR UPDATE;
+
+-- GetMerchantBalancesDueForSettlement: Lists the balances with items to settle
+-- Purpose: Feed the settlement job
+-- Parameters:
+--   $1: cutoff_at - Items accrued before this time are settled
+-- Returns:
+--   Balances with at least one unsettled item accrued before the cutoff
+-- name: GetMerchantBalancesDueForSettlement :many
+SELECT *
+FROM merchant_balances
+WHERE
+    EXISTS (
+        SELECT 1
+        FROM settlement_items
+        WHERE
+            settlement_items.merchant_id = merchant_balances.merchant_id
+            AND settlement_items.currency = merchant_balances.currency
+            AND settlement_items.settlement_batch_id IS NULL
+            AND settlement_items.created_at < $1
+    )
+ORDER BY merchant_id, currency;
+
+-- CreateSettlementItem: Records a line item of a merchant balance
+-- Purpose: Keep the payments, refunds and adjustments a settlement is made of
+-- Parameters:
+--   $1: merchant_id - Merchant the item belongs to
+--   $2: item_type - transaction, refund or adjustment
+--   $3: reference_id - Transaction or refund the item was recorded for
+--   $4: amount - Amount paid, refunded or adjusted
+--   $5: fee - Fee withheld from the amount
+--   $6: net_amount - What the item adds to the balance
+--   $7: currency - Currency of the item
+-- Returns:
+--   The created item
+-- name: CreateSettlementItem :one
+INSERT INTO
+    settlement_items (
+        merchant_id,
+        item_type,
+        reference_id,
+        amount,
+        fee,
+        net_amount,
+        currency,
+        created_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        current_timestamp
+    ) RETURNING *;
+
+-- GetUnsettledSettlementTotals: Totals the unsettled items of a merchant balance
+-- Purpose: Compute a settlement batch before it is created
+-- Parameters:
+--   $1: merchant_id - Merchant the items belong to
+--   $2: currency - Currency of the items
+--   $3: cutoff_at - Only items accrued before this time are totalled
+-- Returns:
+--   Gross, fee, refund, adjustment and net amounts and the number of items
+-- name: GetUnsettledSettlementTotals :one
+SELECT
+    COALESCE(SUM(amount) FILTER (WHERE item_type = 'transaction'), 0)::int AS gross_amount,
+    COALESCE(SUM(fee), 0)::int AS fee_amount,
+    COALESCE(SUM(amount) FILTER (WHERE item_type = 'refund'), 0)::int AS refund_amount,
+    COALESCE(SUM(amount) FILTER (WHERE item_type = 'adjustment'), 0)::int AS adjustment_amount,
+    COALESCE(SUM(net_amount), 0)::int AS net_amount,
+    COUNT(*)::int AS item_count
+FROM settlement_items
+WHERE
+    merchant_id = $1
+    AND currency = $2
+    AND settlement_batch_id IS NULL
+    AND created_at < $3;
+
+-- CreateSettlementBatch: Records the payout of a merchant balance
+-- Purpose: Keep the payout report of a settlement
+-- Parameters:
+--   $1: merchant_id - Merchant that is paid out
+--   $2: currency - Currency of the payout
+--   $3: settlement_card_number - Card the payout is credited to
+--   $4: cutoff_at - Items accrued before this time are part of the batch
+--   $5: gross_amount - Sum of the payments
+--   $6: fee_amount - Sum of the fees withheld
+--   $7: refund_amount - Sum of the refunds
+--   $8: adjustment_amount - Sum of the amount adjustments
+--   $9: net_amount - Amount paid out
+--   $10: item_count - Number of items in the batch
+-- Returns:
+--   The created batch
+-- name: CreateSettlementBatch :one
+INSERT INTO
+    settlement_batches (
+        merchant_id,
+        currency,
+        settlement_card_number,
+        cutoff_at,
+        gross_amount,
+        fee_amount,
+        refund_amount,
+        adjustment_amount,
+        net_amount,
+        item_count,
+        created_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        current_timestamp
+    ) RETURNING *;
+
+-- AssignSettlementItems: Moves the unsettled items of a merchant balance into a batch
+-- Purpose: Mark the items a batch paid out as settled
+-- Parameters:
+--   $1: settlement_batch_id - Batch the items are settled by
+--   $2: merchant_id - Merchant the items belong to
+--   $3: currency - Currency of the items
+--   $4: cutoff_at - Only items accrued before this time are moved
+-- name: AssignSettlementItems :exec
+UPDATE settlement_items
+SET
+    settlement_batch_id = $1
+WHERE
+    merchant_id = $2
+    AND currency = $3
+    AND settlement_batch_id IS NULL
+    AND created_at < $4;
+
+-- GetSettlementBatchByID: Retrieves a single settlement batch
+-- Purpose: Show one payout report
+-- Parameters:
+--   $1: settlement_batch_id - Unique identifier of the batch
+-- Returns:
+--   The batch record
+-- name: GetSettlementBatchByID :one
+SELECT *
+FROM settlement_batches
+WHERE
+    settlement_batch_id = $1;
+
+-- GetSettlementBatchesByMerchant: Retrieves the paginated settlement batches of a merchant
+-- Purpose: Show a merchant its payout history
+-- Parameters:
+--   $1: merchant_id - Merchant the batches belong to
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   Batch records with total_count for pagination, newest first
+-- name: GetSettlementBatchesByMerchant :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM settlement_batches
+WHERE
+    merchant_id = $1
+ORDER BY created_at DESC, settlement_batch_id DESC
+LIMIT $2 OFFSET $3;
+
+-- GetSettlementItemsByBatch: Lists the line items of a settlement batch
+-- Purpose: Show what a payout was made of
+-- Parameters:
+--   $1: settlement_batch_id - Batch the items were settled by
+-- Returns:
+--   The items in the order they accrued
+-- name: GetSettlementItemsByBatch :many
+SELECT *
+FROM settlement_items
+WHERE
+    settlement_batch_id = $1
+ORDER BY created_at, settlement_item_id;
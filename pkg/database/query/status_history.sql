@@ -0,0 +1,16 @@
+-- GetStatusHistory: Retrieves every status change of one record
+-- Purpose: Show when a topup, withdraw, transfer or transaction changed status
+-- Parameters:
+--   $1: entity_type - topup, withdraw, transfer or transaction
+--   $2: entity_id - ID of the record in its own table
+-- Returns:
+--   Status history records, oldest first
+-- Business Logic:
+--   - Rows are written by the status updates themselves and never changed
+-- name: GetStatusHistory :many
+SELECT *
+FROM status_histories
+WHERE
+    entity_type = $1
+    AND entity_id = $2
+ORDER BY created_at, status_history_id;
@@ -810,6 +810,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation
 --   total_amount: Sum of all topup amounts per month
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Filters soft-deleted entries (deleted_at IS NULL)
 --   - Uses LEFT JOIN to ensure months with no topups are still included with amount = 0
@@ -821,20 +822,29 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.topup_amount), 0)::int AS total_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     topups t ON EXTRACT(MONTH FROM t.topup_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.topup_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 -- GetYearlyTopupAmounts: Retrieves yearly total of topup amounts
@@ -844,6 +854,7 @@ ORDER BY
 -- Returns:
 --   year: Year extracted from topup_time
 --   total_amount: Sum of all topup amounts in the year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Includes topup data from current year and 4 years prior
 --   - Excludes soft-deleted records (deleted_at IS NULL)
@@ -851,6 +862,7 @@ ORDER BY
 -- name: GetYearlyTopupAmounts :many
 SELECT
     EXTRACT(YEAR FROM t.topup_time) AS year,
+    t.currency,
     SUM(t.topup_amount) AS total_amount
 FROM
     topups t
@@ -859,9 +871,11 @@ WHERE
     AND EXTRACT(YEAR FROM t.topup_time) >= $1 - 4
     AND EXTRACT(YEAR FROM t.topup_time) <= $1
 GROUP BY
-    EXTRACT(YEAR FROM t.topup_time)
+    EXTRACT(YEAR FROM t.topup_time),
+    t.currency
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 
@@ -960,6 +974,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation
 --   total_amount: Sum of all topup amounts per month
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Filters soft-deleted entries (deleted_at IS NULL)
 --   - Uses LEFT JOIN to ensure months with no topups are still included with amount = 0
@@ -971,21 +986,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.topup_amount), 0)::int AS total_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     topups t ON EXTRACT(MONTH FROM t.topup_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.topup_time) = EXTRACT(YEAR FROM m.month)
     AND t.card_number = $1
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 -- GetYearlyTopupAmountsByCardNumber: Retrieves yearly total of topup amounts
@@ -996,6 +1020,7 @@ ORDER BY
 -- Returns:
 --   year: Year extracted from topup_time
 --   total_amount: Sum of all topup amounts in the year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Includes topup data from current year and 4 years prior
 --   - Excludes soft-deleted records (deleted_at IS NULL)
@@ -1003,6 +1028,7 @@ ORDER BY
 -- name: GetYearlyTopupAmountsByCardNumber :many
 SELECT
     EXTRACT(YEAR FROM t.topup_time) AS year,
+    t.currency,
     SUM(t.topup_amount) AS total_amount
 FROM
     topups t
@@ -1012,9 +1038,11 @@ WHERE
     AND EXTRACT(YEAR FROM t.created_at) >= $2 - 4
     AND EXTRACT(YEAR FROM t.created_at) <= $2
 GROUP BY
-    EXTRACT(YEAR FROM t.topup_time)
+    EXTRACT(YEAR FROM t.topup_time),
+    t.currency
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 
@@ -1025,10 +1053,12 @@ ORDER BY
 --   $2: topup_amount - Amount of the topup
 --   $3: topup_method - Payment method used (e.g., 'e-wallet', 'bank_transfer')
 --   $4: topup_time - Timestamp of the actual topup transaction
+--   $5: fee - Fee kept out of the amount credited to the card
 -- Returns:
 --   Full topup record including auto-generated fields
 -- Business Logic:
 --   - Automatically sets created_at and updated_at to current timestamp
+--   - The amount is recorded in the currency of the card
 -- name: CreateTopup :one
 INSERT INTO
     topups (
@@ -1036,6 +1066,8 @@ INSERT INTO
         topup_amount,
         topup_method,
         topup_time,
+        currency,
+        fee,
         created_at,
         updated_at
     )
@@ -1044,6 +1076,8 @@ VALUES (
         $2,
         $3,
         $4,
+        (SELECT c.currency FROM cards c WHERE c.card_number = $1),
+        $5,
         current_timestamp,
         current_timestamp
     ) RETURNING *;
@@ -1053,20 +1087,21 @@ VALUES (
 -- Purpose: Modify existing topup information by ID
 -- Parameters:
 --   $1: topup_id - ID of the topup to update
---   $2: card_number - Updated card number
---   $3: topup_amount - Updated amount
---   $4: topup_method - Updated payment method
---   $5: topup_time - Updated transaction time
+--   $2: topup_amount - Updated amount
+--   $3: topup_method - Updated payment method
+--   $4: topup_time - Updated transaction time
+--   $5: fee - Topup fee quoted on the updated amount
 -- Business Logic:
 --   - Skips soft-deleted records (deleted_at IS NULL)
 --   - Updates updated_at automatically
+--   - The card is kept, as the ledger postings of the topup stay on it
 -- name: UpdateTopup :one
 UPDATE topups
 SET
-    card_number = $2,
-    topup_amount = $3,
-    topup_method = $4,
-    topup_time = $5,
+    topup_amount = $2,
+    topup_method = $3,
+    topup_time = $4,
+    fee = $5,
     updated_at = current_timestamp
 WHERE
     topup_id = $1
@@ -1093,21 +1128,45 @@ WHERE
 RETURNING *;
 
 
--- UpdateTopupStatus: Updates the status of a specific topup
--- Purpose: Mark topup as 'success', 'failed', etc.
+-- UpdateTopupStatus: Moves a topup from one status to another
+-- Purpose: Record the outcome of a topup together with its status history
 -- Parameters:
---   $1: topup_id - ID of the topup
---   $2: new status value (e.g., 'success', 'failed')
+--   $1: status - New status
+--   $2: topup_id - ID of the topup
+--   $3: from_status - Status the topup is expected to be in
+-- Returns:
+--   The updated topup, or no row when it is no longer in from_status
 -- Business Logic:
---   - Applies only to active (non-deleted) records
---   - updated_at is refreshed
+--   - Only updates active (non-deleted) records
+--   - Allowed transitions are checked against pkg/statemachine by the caller
+--   - The status history row is written by the same statement, and only
+--     when the row was still in from_status
 -- name: UpdateTopupStatus :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT 'topup', topup_id, status, sqlc.arg(status), current_timestamp
+        FROM topups
+        WHERE
+            topup_id = sqlc.arg(topup_id)
+            AND status = sqlc.arg(from_status)
+            AND deleted_at IS NULL
+        FOR UPDATE
+    )
 UPDATE topups
 SET
-    status = $2,
+    status = sqlc.arg(status),
     updated_at = current_timestamp
 WHERE
-    topup_id = $1
+    topup_id = sqlc.arg(topup_id)
+    AND status = sqlc.arg(from_status)
     AND deleted_at IS NULL
 RETURNING *;
 
@@ -0,0 +1,78 @@
+-- CreateTopupProviderPayment: Records the provider payment of a topup
+-- Purpose: Remember which provider collects a topup and under which reference
+-- Parameters:
+--   $1: topup_id - Topup being paid
+--   $2: provider - Name of the provider adapter
+--   $3: provider_reference - Reference of the payment at the provider
+--   $4: last_message - Message returned by the provider (NULL when none)
+-- Returns:
+--   The created provider payment record
+-- name: CreateTopupProviderPayment :one
+INSERT INTO
+    topup_provider_payments (
+        topup_id,
+        provider,
+        provider_reference,
+        last_message,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        current_timestamp,
+        current_timestamp
+    ) RETURNING *;
+
+-- GetTopupProviderPaymentByReference: Retrieves a provider payment by its reference
+-- Purpose: Find the topup a provider callback is about
+-- Parameters:
+--   $1: provider - Name of the provider adapter
+--   $2: provider_reference - Reference of the payment at the provider
+-- Returns:
+--   The provider payment record
+-- name: GetTopupProviderPaymentByReference :one
+SELECT *
+FROM topup_provider_payments
+WHERE
+    provider = $1
+    AND provider_reference = $2;
+
+-- GetPendingTopupProviderPayments: Retrieves payments of topups still pending
+-- Purpose: Feed the job that asks providers for late confirmations
+-- Parameters:
+--   $1: row_limit - Maximum number of payments to return
+-- Returns:
+--   Provider payments of pending topups, least recently checked first
+-- name: GetPendingTopupProviderPayments :many
+SELECT *
+FROM topup_provider_payments p
+WHERE
+    EXISTS (
+        SELECT 1
+        FROM topups t
+        WHERE
+            t.topup_id = p.topup_id
+            AND t.status = 'pending'
+            AND t.deleted_at IS NULL
+    )
+ORDER BY updated_at, topup_provider_payment_id
+LIMIT sqlc.arg(row_limit);
+
+-- TouchTopupProviderPayment: Records the last answer of a provider
+-- Purpose: Keep the provider message and move the payment to the back of the check queue
+-- Parameters:
+--   $1: topup_provider_payment_id - Unique identifier of the payment
+--   $2: last_message - Message returned by the provider (NULL when none)
+-- Returns:
+--   The updated provider payment record
+-- name: TouchTopupProviderPayment :one
+UPDATE topup_provider_payments
+SET
+    last_message = $2,
+    updated_at = current_timestamp
+WHERE
+    topup_provider_payment_id = $1
+RETURNING *;
@@ -869,6 +869,7 @@ ORDER BY
 -- Parameters:
 --   $1: reference_date - Any date within the target year
 -- Returns:
+--   currency: Currency the totals are expressed in
 --   - month: 3-letter month abbreviation
 --   - total_amount: Sum of transaction amounts in each month
 -- Business Logic:
@@ -881,20 +882,29 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.amount), 0)::int AS total_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 
@@ -904,6 +914,7 @@ ORDER BY
 -- Parameters:
 --   $1: current_year - The most recent year to include (covers current_year - 4 to current_year)
 -- Returns:
+--   currency: Currency the totals are expressed in
 --   - year: Year of the transaction
 --   - total_amount: Total transaction amount for the year
 -- Business Logic:
@@ -911,6 +922,7 @@ ORDER BY
 -- name: GetYearlyAmounts :many
 SELECT
     EXTRACT(YEAR FROM t.created_at) AS year,
+    t.currency,
     SUM(t.amount) AS total_amount
 FROM
     transactions t
@@ -919,9 +931,11 @@ WHERE
     AND EXTRACT(YEAR FROM t.created_at) >= $1 - 4
     AND EXTRACT(YEAR FROM t.created_at) <= $1
 GROUP BY
-    EXTRACT(YEAR FROM t.created_at)
+    EXTRACT(YEAR FROM t.created_at),
+    t.currency
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 -- GetTransactionByCardNumber: Retrieves paginated transactions for a specific card with optional filtering
@@ -1049,6 +1063,7 @@ ORDER BY
 --   $1: card_number  - filter by card_number
 --   $2: reference_date - Any date within the target year
 -- Returns:
+--   currency: Currency the totals are expressed in
 --   - month: 3-letter month abbreviation
 --   - total_amount: Sum of transaction amounts in each month
 -- Business Logic:
@@ -1061,21 +1076,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.amount), 0)::int AS total_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
     AND t.card_number = $1
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 -- GetYearlyAmountsByCardNumber:  Retrieves total transaction amount per year over a 5-year span
@@ -1085,6 +1109,7 @@ ORDER BY
 --   $1: card_number  - filter by card_number
 --   $2: current_year - The most recent year to include (covers current_year - 4 to current_year)
 -- Returns:
+--   currency: Currency the totals are expressed in
 --   - year: Year of the transaction
 --   - total_amount: Total transaction amount for the year
 -- Business Logic:
@@ -1092,6 +1117,7 @@ ORDER BY
 -- name: GetYearlyAmountsByCardNumber :many
 SELECT
     EXTRACT(YEAR FROM t.created_at) AS year,
+    t.currency,
     SUM(t.amount) AS total_amount
 FROM
     transactions t
@@ -1101,9 +1127,11 @@ WHERE
     AND EXTRACT(YEAR FROM t.created_at) >= $2 - 4
     AND EXTRACT(YEAR FROM t.created_at) <= $2
 GROUP BY
-    EXTRACT(YEAR FROM t.created_at)
+    EXTRACT(YEAR FROM t.created_at),
+    t.currency
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 
@@ -1115,11 +1143,13 @@ ORDER BY
 --   $3: payment_method - Payment method used (e.g., 'credit', 'debit')
 --   $4: merchant_id - ID of the merchant where transaction occurred
 --   $5: transaction_time - Timestamp of when transaction occurred
+--   $6: fee - Merchant discount rate kept out of the amount credited to the merchant
 -- Returns:
 --   The newly created transaction record with all fields
 -- Business Logic:
 --   - Sets creation and update timestamps automatically
 --   - Used for recording purchases, payments, and other financial activities
+--   - The amount is recorded in the currency of the paying card
 -- name: CreateTransaction :one
 INSERT INTO
     transactions (
@@ -1128,6 +1158,8 @@ INSERT INTO
         payment_method,
         merchant_id,
         transaction_time,
+        currency,
+        fee,
         created_at,
         updated_at
     )
@@ -1137,6 +1169,8 @@ VALUES (
         $3,
         $4,
         $5,
+        (SELECT c.currency FROM cards c WHERE c.card_number = $1),
+        $6,
         current_timestamp,
         current_timestamp
     ) RETURNING *;
@@ -1150,6 +1184,7 @@ VALUES (
 --   $4: payment_method - Updated payment method
 --   $5: merchant_id - Updated merchant ID
 --   $6: transaction_time - Updated transaction timestamp
+--   $7: fee - Merchant discount rate quoted on the updated amount
 -- Business Logic:
 --   - Only updates active transactions (non-deleted)
 --   - Automatically updates the modification timestamp
@@ -1162,29 +1197,246 @@ SET
     payment_method = $4,
     merchant_id = $5,
     transaction_time = $6,
+    fee = $7,
     updated_at = current_timestamp
 WHERE
     transaction_id = $1
     AND deleted_at IS NULL
 RETURNING *;
 
--- UpdateTransactionStatus: Changes a transaction's status
--- Purpose: Update transaction processing status
+-- UpdateTransactionStatus: Moves a transaction from one status to another
+-- Purpose: Record the outcome of a transaction together with its status history
 -- Parameters:
---   $1: transaction_id - ID of transaction to update
---   $2: status - New status (e.g., 'success', 'failed', 'pending')
+--   $1: status - New status
+--   $2: transaction_id - ID of the transaction
+--   $3: from_status - Status the transaction is expected to be in
+-- Returns:
+--   The updated transaction, or no row when it is no longer in from_status
 -- Business Logic:
---   - Only updates active transactions
---   - Used to reflect transaction processing outcomes
---   - Important for reconciliation and reporting
+--   - Only updates active (non-deleted) records
+--   - Allowed transitions are checked against pkg/statemachine by the caller
+--   - The status history row is written by the same statement, and only
+--     when the row was still in from_status
 -- name: UpdateTransactionStatus :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT 'transaction', transaction_id, status, sqlc.arg(status), current_timestamp
+        FROM transactions
+        WHERE
+            transaction_id = sqlc.arg(transaction_id)
+            AND status = sqlc.arg(from_status)
+            AND deleted_at IS NULL
+        FOR UPDATE
+    )
+UPDATE transactions
+SET
+    status = sqlc.arg(status),
+    updated_at = current_timestamp
+WHERE
+    transaction_id = sqlc.arg(transaction_id)
+    AND status = sqlc.arg(from_status)
+    AND deleted_at IS NULL
+RETURNING *;
+
+-- ApplyTransactionRefund: Records a refunded amount against a transaction
+-- Purpose: Track cumulative refunds and move the transaction to a refund status
+-- Parameters:
+--   $1: transaction_id - ID of the refunded transaction
+--   $2: amount - Amount being refunded
+-- Returns:
+--   The updated transaction record, or no row if the refund is not allowed
+-- Business Logic:
+--   - Only successful or partially refunded transactions can be refunded
+--   - Cumulative refunds are capped at the original amount
+--   - Status becomes 'refunded' once fully refunded, otherwise 'partially_refunded'
+--   - The check and the increment happen in one statement, so concurrent
+--     refunds cannot exceed the original amount
+--   - The status change is written to status_histories by the same statement
+-- name: ApplyTransactionRefund :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT
+            'transaction',
+            transaction_id,
+            status,
+            CASE
+                WHEN refunded_amount + sqlc.arg(amount)::INT = amount THEN 'refunded'
+                ELSE 'partially_refunded'
+            END,
+            current_timestamp
+        FROM transactions
+        WHERE
+            transaction_id = sqlc.arg(transaction_id)
+            AND deleted_at IS NULL
+            AND status IN ('success', 'partially_refunded')
+            AND refunded_amount + sqlc.arg(amount)::INT <= amount
+        FOR UPDATE
+    )
+UPDATE transactions
+SET
+    refunded_amount = refunded_amount + sqlc.arg(amount)::INT,
+    status = CASE
+        WHEN refunded_amount + sqlc.arg(amount)::INT = amount THEN 'refunded'
+        ELSE 'partially_refunded'
+    END,
+    updated_at = current_timestamp
+WHERE
+    transaction_id = sqlc.arg(transaction_id)
+    AND deleted_at IS NULL
+    AND status IN ('success', 'partially_refunded')
+    AND refunded_amount + sqlc.arg(amount)::INT <= amount
+RETURNING *;
+
+-- MarkTransactionDisputed: Puts a transaction under dispute
+-- Purpose: Freeze a transaction while a cardholder dispute is pending
+-- Parameters:
+--   $1: transaction_id - ID of the disputed transaction
+-- Returns:
+--   The updated transaction record, or no row if the transaction cannot be disputed
+-- Business Logic:
+--   - Only successful or partially refunded transactions can be disputed
+--   - Fully refunded transactions have nothing left to dispute
+--   - Disputed transactions cannot be refunded or updated until resolved
+--   - The status change is written to status_histories by the same statement
+-- name: MarkTransactionDisputed :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT 'transaction', transaction_id, status, 'disputed', current_timestamp
+        FROM transactions
+        WHERE
+            transaction_id = $1
+            AND deleted_at IS NULL
+            AND status IN ('success', 'partially_refunded')
+            AND refunded_amount < amount
+        FOR UPDATE
+    )
+UPDATE transactions
+SET
+    status = 'disputed',
+    updated_at = current_timestamp
+WHERE
+    transaction_id = $1
+    AND deleted_at IS NULL
+    AND status IN ('success', 'partially_refunded')
+    AND refunded_amount < amount
+RETURNING *;
+
+-- ReleaseTransactionDispute: Returns a disputed transaction to its previous status
+-- Purpose: Close a dispute that was ruled in favour of the merchant
+-- Parameters:
+--   $1: transaction_id - ID of the disputed transaction
+-- Returns:
+--   The updated transaction record, or no row if the transaction is not disputed
+-- Business Logic:
+--   - Status becomes 'partially_refunded' if refunds exist, otherwise 'success'
+--   - The status change is written to status_histories by the same statement
+-- name: ReleaseTransactionDispute :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT
+            'transaction',
+            transaction_id,
+            status,
+            CASE
+                WHEN refunded_amount > 0 THEN 'partially_refunded'
+                ELSE 'success'
+            END,
+            current_timestamp
+        FROM transactions
+        WHERE
+            transaction_id = $1
+            AND deleted_at IS NULL
+            AND status = 'disputed'
+        FOR UPDATE
+    )
 UPDATE transactions
 SET
-    status = $2,
+    status = CASE
+        WHEN refunded_amount > 0 THEN 'partially_refunded'
+        ELSE 'success'
+    END,
     updated_at = current_timestamp
 WHERE
     transaction_id = $1
     AND deleted_at IS NULL
+    AND status = 'disputed'
+RETURNING *;
+
+-- ChargeBackTransaction: Records a chargeback against a disputed transaction
+-- Purpose: Close a dispute that was ruled in favour of the cardholder
+-- Parameters:
+--   $1: transaction_id - ID of the disputed transaction
+--   $2: amount - Amount returned to the cardholder
+-- Returns:
+--   The updated transaction record, or no row if the chargeback is not allowed
+-- Business Logic:
+--   - Only disputed transactions can be charged back
+--   - The charged back amount counts towards refunded_amount, so merchant
+--     analytics stay net of it
+--   - Status becomes 'charged_back'
+--   - The status change is written to status_histories by the same statement
+-- name: ChargeBackTransaction :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT 'transaction', transaction_id, status, 'charged_back', current_timestamp
+        FROM transactions
+        WHERE
+            transaction_id = sqlc.arg(transaction_id)
+            AND deleted_at IS NULL
+            AND status = 'disputed'
+            AND refunded_amount + sqlc.arg(amount)::INT <= amount
+        FOR UPDATE
+    )
+UPDATE transactions
+SET
+    refunded_amount = refunded_amount + sqlc.arg(amount)::INT,
+    status = 'charged_back',
+    updated_at = current_timestamp
+WHERE
+    transaction_id = sqlc.arg(transaction_id)
+    AND deleted_at IS NULL
+    AND status = 'disputed'
+    AND refunded_amount + sqlc.arg(amount)::INT <= amount
 RETURNING *;
 
 -- TrashTransaction: Soft-deletes a transaction record
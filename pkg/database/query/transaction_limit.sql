@@ -0,0 +1,200 @@
+-- CreateTransactionLimit: Creates a new transaction limit
+-- Purpose: Cap how much and how often a card may move funds
+-- Parameters:
+--   $1: name - Display name of the limit
+--   $2: operation_type - transfer, withdraw or transaction
+--   $3: period - daily or monthly
+--   $4: card_type - Card type the limit is restricted to (NULL for any)
+--   $5: user_tier - User tier the limit is restricted to (NULL for any)
+--   $6: currency - Card currency the limit is restricted to (NULL for any)
+--   $7: max_amount_per_transaction - Largest single amount allowed (NULL for no cap)
+--   $8: max_count - Number of operations allowed in the period (NULL for no cap)
+--   $9: max_total_amount - Cumulative amount allowed in the period (NULL for no cap)
+--   $10: is_active - Whether the limit is enforced
+-- Returns:
+--   The created transaction limit record
+-- name: CreateTransactionLimit :one
+INSERT INTO
+    transaction_limits (
+        name,
+        operation_type,
+        period,
+        card_type,
+        user_tier,
+        currency,
+        max_amount_per_transaction,
+        max_count,
+        max_total_amount,
+        is_active,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        $8,
+        $9,
+        $10,
+        current_timestamp,
+        current_timestamp
+    ) RETURNING *;
+
+-- UpdateTransactionLimit: Updates an existing transaction limit
+-- Purpose: Change the caps or the scope of a limit
+-- Parameters:
+--   $1: transaction_limit_id - Unique identifier of the limit
+--   $2 - $11: Same fields as CreateTransactionLimit
+-- Returns:
+--   The updated transaction limit record
+-- Business Logic:
+--   - Operations already accepted count towards the new caps for the rest of the period
+-- name: UpdateTransactionLimit :one
+UPDATE transaction_limits
+SET
+    name = $2,
+    operation_type = $3,
+    period = $4,
+    card_type = $5,
+    user_tier = $6,
+    currency = $7,
+    max_amount_per_transaction = $8,
+    max_count = $9,
+    max_total_amount = $10,
+    is_active = $11,
+    updated_at = current_timestamp
+WHERE
+    transaction_limit_id = $1
+RETURNING *;
+
+-- GetTransactionLimits: Retrieves paginated transaction limits with search capability
+-- Purpose: List the configured limits for administration
+-- Parameters:
+--   $1: search_term - Optional filter on name, operation type or user tier (NULL for no filter)
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   Transaction limit records with total_count for pagination
+-- Business Logic:
+--   - Ordered by operation type and period, then most recently created first
+-- name: GetTransactionLimits :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM transaction_limits
+WHERE (
+        $1::TEXT IS NULL
+        OR name ILIKE '%' || $1 || '%'
+        OR operation_type ILIKE '%' || $1 || '%'
+        OR user_tier ILIKE '%' || $1 || '%'
+    )
+ORDER BY operation_type, period, created_at DESC
+LIMIT $2 OFFSET $3;
+
+-- GetTransactionLimitByID: Retrieves a single transaction limit
+-- Purpose: Show the detail of one limit
+-- Parameters:
+--   $1: transaction_limit_id - Unique identifier of the limit
+-- Returns:
+--   The transaction limit record
+-- name: GetTransactionLimitByID :one
+SELECT * FROM transaction_limits WHERE transaction_limit_id = $1;
+
+-- GetApplicableTransactionLimits: Finds the limits enforced on an operation of a card
+-- Purpose: Pick the daily and monthly caps of a new transfer, withdraw or merchant transaction
+-- Parameters:
+--   $1: operation_type - Type of the operation being checked
+--   $2: card_number - Card the funds are taken from
+-- Returns:
+--   At most one limit per period, the most specific active one matching the card
+-- Business Logic:
+--   - Card type and currency come from the card, the tier from the card owner
+--   - NULL filters on a limit match any value
+--   - Limits with more filters set win over broader ones
+--   - The newest limit wins between equally specific ones
+-- name: GetApplicableTransactionLimits :many
+SELECT DISTINCT ON (tl.period) tl.*
+FROM
+    transaction_limits tl
+    JOIN cards c ON c.card_number = $2
+    JOIN users u ON u.user_id = c.user_id
+WHERE
+    tl.operation_type = $1
+    AND tl.is_active = TRUE
+    AND (
+        tl.card_type IS NULL
+        OR tl.card_type = c.card_type
+    )
+    AND (
+        tl.user_tier IS NULL
+        OR tl.user_tier = u.tier
+    )
+    AND (
+        tl.currency IS NULL
+        OR tl.currency = c.currency
+    )
+ORDER BY tl.period, (tl.card_type IS NOT NULL)::INT + (tl.user_tier IS NOT NULL)::INT + (tl.currency IS NOT NULL)::INT DESC, tl.transaction_limit_id DESC;
+
+-- GetCardUsageSince: Sums the operations of a card since a point in time
+-- Purpose: Measure how much of a limit a card has used in the current period
+-- Parameters:
+--   $1: operation_type - transfer, withdraw or transaction
+--   $2: card_number - Card the funds were taken from
+--   $3: since - Start of the period
+-- Returns:
+--   total_count: Number of operations in the period
+--   total_amount: Sum of their amounts
+-- Business Logic:
+--   - Pending and failed operations are not counted, nor are deleted ones
+--   - Rejected withdraws are not counted; ones waiting for review are, so a
+--     card cannot exceed its limit with several reviews in flight
+--   - Transfers are counted on the sending card, merchant transactions on the paying card
+-- name: GetCardUsageSince :one
+WITH usage AS (
+    SELECT t.transfer_amount AS amount
+    FROM transfers t
+    WHERE
+        sqlc.arg(operation_type)::TEXT = 'transfer'
+        AND t.transfer_from = sqlc.arg(card_number)
+        AND t.transfer_time >= sqlc.arg(since)
+        AND t.status NOT IN ('pending', 'failed')
+        AND t.deleted_at IS NULL
+    UNION ALL
+    SELECT w.withdraw_amount
+    FROM withdraws w
+    WHERE
+        sqlc.arg(operation_type)::TEXT = 'withdraw'
+        AND w.card_number = sqlc.arg(card_number)
+        AND w.withdraw_time >= sqlc.arg(since)
+        AND w.status NOT IN ('pending', 'failed', 'rejected')
+        AND w.deleted_at IS NULL
+    UNION ALL
+    SELECT tr.amount
+    FROM transactions tr
+    WHERE
+        sqlc.arg(operation_type)::TEXT = 'transaction'
+        AND tr.card_number = sqlc.arg(card_number)
+        AND tr.transaction_time >= sqlc.arg(since)
+        AND tr.status NOT IN ('pending', 'failed')
+        AND tr.deleted_at IS NULL
+)
+SELECT
+    COUNT(*)::int AS total_count,
+    COALESCE(SUM(amount), 0)::bigint AS total_amount
+FROM usage;
+
+-- DeleteTransactionLimit: Removes a transaction limit
+-- Purpose: Stop enforcing a limit
+-- Parameters:
+--   $1: transaction_limit_id - Unique identifier of the limit
+-- Returns:
+--   The deleted transaction limit record
+-- name: DeleteTransactionLimit :one
+DELETE FROM transaction_limits
+WHERE
+    transaction_limit_id = $1
+RETURNING *;
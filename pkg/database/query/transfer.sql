@@ -797,6 +797,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation (e.g., 'Jan')
 --   total_transfer_amount: Sum of transfer amounts in that month
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Generates complete monthly series for the target year
 --   - Includes all transfers regardless of method
@@ -810,20 +811,29 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.transfer_amount), 0)::int AS total_transfer_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 
@@ -834,6 +844,7 @@ ORDER BY
 -- Returns:
 --   year: 4-digit year
 --   total_transfer_amount: Sum of transfer amounts in that year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Covers a 5-year window (current_year - 4 to current_year)
 --   - Filters out soft-deleted records (deleted_at IS NULL)
@@ -842,6 +853,7 @@ ORDER BY
 -- name: GetYearlyTransferAmounts :many
 SELECT
     EXTRACT(YEAR FROM t.created_at) AS year,
+    t.currency,
     SUM(t.transfer_amount) AS total_transfer_amount
 FROM
     transfers t
@@ -850,9 +862,11 @@ WHERE
     AND EXTRACT(YEAR FROM t.created_at) >= $1 - 4
     AND EXTRACT(YEAR FROM t.created_at) <= $1
 GROUP BY
-    EXTRACT(YEAR FROM t.created_at)
+    EXTRACT(YEAR FROM t.created_at),
+    t.currency
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 
@@ -864,6 +878,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation (e.g., 'Jan')
 --   total_transfer_amount: Sum of transfer amounts in that month
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Generates complete monthly series for the target year
 --   - Includes all transfers regardless of method
@@ -877,21 +892,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.transfer_amount), 0)::int AS total_transfer_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.transfer_from = $1
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 
@@ -903,6 +927,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation (e.g., 'Jan')
 --   total_transfer_amount: Sum of transfer amounts in that month
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Generates complete monthly series for the target year
 --   - Includes all transfers regardless of method
@@ -916,21 +941,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
-    COALESCE(SUM(t.transfer_amount), 0)::int AS total_transfer_amount
+    cur.currency,
+    COALESCE(SUM(t.converted_amount), 0)::int AS total_transfer_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.to_currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.transfer_to = $1
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 
 
@@ -942,6 +976,7 @@ ORDER BY
 -- Returns:
 --   year: 4-digit year
 --   total_transfer_amount: Sum of transfer amounts in that year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Covers a 5-year window (current_year - 4 to current_year)
 --   - Filters out soft-deleted records (deleted_at IS NULL)
@@ -950,6 +985,7 @@ ORDER BY
 -- name: GetYearlyTransferAmountsBySenderCardNumber :many
 SELECT
     EXTRACT(YEAR FROM t.created_at) AS year,
+    t.currency,
     SUM(t.transfer_amount) AS total_transfer_amount
 FROM
     transfers t
@@ -959,9 +995,11 @@ WHERE
     AND EXTRACT(YEAR FROM t.created_at) >= $2 - 4
     AND EXTRACT(YEAR FROM t.created_at) <= $2
 GROUP BY
-    EXTRACT(YEAR FROM t.created_at)
+    EXTRACT(YEAR FROM t.created_at),
+    t.currency
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 -- GetYearlyTransferAmountsByReceiverCardNumber: Retrieves yearly transfer amounts
@@ -972,6 +1010,7 @@ ORDER BY
 -- Returns:
 --   year: 4-digit year
 --   total_transfer_amount: Sum of transfer amounts in that year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Covers a 5-year window (current_year - 4 to current_year)
 --   - Filters out soft-deleted records (deleted_at IS NULL)
@@ -980,7 +1019,8 @@ ORDER BY
 -- name: GetYearlyTransferAmountsByReceiverCardNumber :many
 SELECT
     EXTRACT(YEAR FROM t.created_at) AS year,
-    SUM(t.transfer_amount) AS total_transfer_amount
+    t.to_currency AS currency,
+    SUM(t.converted_amount) AS total_transfer_amount
 FROM
     transfers t
 WHERE
@@ -989,9 +1029,11 @@ WHERE
     AND EXTRACT(YEAR FROM t.created_at) >= $2 - 4
     AND EXTRACT(YEAR FROM t.created_at) <= $2
 GROUP BY
-    EXTRACT(YEAR FROM t.created_at)
+    EXTRACT(YEAR FROM t.created_at),
+    t.to_currency
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 -- FindAllTransfersByCardNumberAsSender: Retrieves all transfers where the card was the sender
@@ -1059,11 +1101,16 @@ ORDER BY
 --   $3: transfer_amount - Amount transferred
 --   $4: transfer_time - When the transfer occurred
 --   $5: status - Initial status of the transfer
+--   $6: converted_amount - Amount credited to the receiver in its currency
+--   $7: exchange_rate - Rate applied when the cards use different currencies (NULL otherwise)
+--   $8: spread_bps - Spread applied on top of the rate in basis points (NULL otherwise)
+--   $9: fee - Fee charged to the sender on top of transfer_amount
 -- Returns:
 --   The newly created transfer record
 -- Business Logic:
 --   - Sets creation and update timestamps automatically
 --   - Used for recording money movements between accounts
+--   - currency and to_currency are copied from the sender and receiver cards
 -- name: CreateTransfer :one
 INSERT INTO
     transfers (
@@ -1072,6 +1119,12 @@ INSERT INTO
         transfer_amount,
         transfer_time,
         status,
+        currency,
+        to_currency,
+        converted_amount,
+        exchange_rate,
+        spread_bps,
+        fee,
         created_at,
         updated_at
     )
@@ -1081,33 +1134,41 @@ VALUES (
         $3,
         $4,
         $5,
+        (SELECT c.currency FROM cards c WHERE c.card_number = $1),
+        (SELECT c.currency FROM cards c WHERE c.card_number = $2),
+        $6,
+        $7,
+        $8,
+        $9,
         current_timestamp,
         current_timestamp
     ) RETURNING *;
 
 -- UpdateTransfer: Modifies transfer details
--- Purpose: Update all fields of an existing transfer
+-- Purpose: Update the amount and time of an existing transfer
 -- Parameters:
 --   $1: transfer_id - ID of transfer to update
---   $2: transfer_from - Updated source account
---   $3: transfer_to - Updated destination account
---   $4: transfer_amount - Updated amount
---   $5: transfer_time - Updated timestamp
+--   $2: transfer_amount - Updated amount
+--   $3: transfer_time - Updated timestamp
+--   $4: fee - Fee quoted on the updated amount
 -- Business Logic:
 --   - Only updates active transfers (non-deleted)
 --   - Updates modification timestamp automatically
 --   - Used for correcting transfer details
+--   - The cards are kept, as the ledger postings of the transfer stay on them
+--   - Transfers that crossed currencies keep their recorded conversion
 -- name: UpdateTransfer :one
 UPDATE transfers
 SET
-    transfer_from = $2,
-    transfer_to = $3,
-    transfer_amount = $4,
-    transfer_time = $5,
+    transfer_amount = $2,
+    converted_amount = $2,
+    transfer_time = $3,
+    fee = $4,
     updated_at = current_timestamp
 WHERE
     transfer_id = $1
     AND deleted_at IS NULL
+    AND exchange_rate IS NULL
 RETURNING *;
 
 -- UpdateTransferAmount: Changes only the transfer amount
@@ -1119,33 +1180,59 @@ RETURNING *;
 --   - Only updates active transfers
 --   - Updates both amount and transfer timestamp
 --   - Used for amount corrections
+--   - Transfers that crossed currencies keep their recorded conversion
 -- name: UpdateTransferAmount :one
 UPDATE transfers
 SET
     transfer_amount = $2,
+    converted_amount = $2,
     transfer_time = current_timestamp,
     updated_at = current_timestamp
 WHERE
     transfer_id = $1
     AND deleted_at IS NULL
+    AND exchange_rate IS NULL
 RETURNING *;
 
--- UpdateTransferStatus: Changes transfer status
--- Purpose: Update processing status of a transfer
+-- UpdateTransferStatus: Moves a transfer from one status to another
+-- Purpose: Record the outcome of a transfer together with its status history
 -- Parameters:
---   $1: transfer_id - ID of transfer to update
---   $2: status - New status (e.g., 'completed', 'failed')
+--   $1: status - New status
+--   $2: transfer_id - ID of the transfer
+--   $3: from_status - Status the transfer is expected to be in
+-- Returns:
+--   The updated transfer, or no row when it is no longer in from_status
 -- Business Logic:
---   - Only updates active transfers
---   - Updates modification timestamp
---   - Used to reflect transfer processing outcomes
+--   - Only updates active (non-deleted) records
+--   - Allowed transitions are checked against pkg/statemachine by the caller
+--   - The status history row is written by the same statement, and only
+--     when the row was still in from_status
 -- name: UpdateTransferStatus :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT 'transfer', transfer_id, status, sqlc.arg(status), current_timestamp
+        FROM transfers
+        WHERE
+            transfer_id = sqlc.arg(transfer_id)
+            AND status = sqlc.arg(from_status)
+            AND deleted_at IS NULL
+        FOR UPDATE
+    )
 UPDATE transfers
 SET
-    status = $2,
+    status = sqlc.arg(status),
     updated_at = current_timestamp
 WHERE
-    transfer_id = $1
+    transfer_id = sqlc.arg(transfer_id)
+    AND status = sqlc.arg(from_status)
     AND deleted_at IS NULL
 RETURNING *;
 
@@ -0,0 +1,266 @@
+-- CreateTransferBatch: Creates a new transfer batch
+-- Purpose: Record an uploaded list of transfers from one source card
+-- Parameters:
+--   $1: user_id - Owner of the source card
+--   $2: transfer_from - Card every row is paid from
+--   $3: file_format - csv or json
+--   $4: status - pending, or rejected when a row is invalid
+--   $5: total_rows - Number of rows in the upload
+--   $6: total_amount - Sum of the row amounts
+--   $7: invalid_rows - Number of rows that failed validation
+-- Returns:
+--   The created transfer batch record
+-- name: CreateTransferBatch :one
+INSERT INTO
+    transfer_batches (
+        user_id,
+        transfer_from,
+        file_format,
+        status,
+        total_rows,
+        total_amount,
+        invalid_rows,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        current_timestamp,
+        current_timestamp
+    ) RETURNING *;
+
+-- CreateTransferBatchRow: Adds a row to a transfer batch
+-- Purpose: Keep every uploaded row with its validation outcome
+-- Parameters:
+--   $1: transfer_batch_id - Batch the row belongs to
+--   $2: row_number - Position of the row in the upload, starting at 1
+--   $3: transfer_to - Card the row pays
+--   $4: transfer_amount - Amount of the row
+--   $5: note - Free text of the row (NULL when empty)
+--   $6: status - pending, or invalid when validation failed
+--   $7: failure_reason - Why the row is invalid (NULL otherwise)
+-- Returns:
+--   The created row record
+-- name: CreateTransferBatchRow :one
+INSERT INTO
+    transfer_batch_rows (
+        transfer_batch_id,
+        row_number,
+        transfer_to,
+        transfer_amount,
+        note,
+        status,
+        failure_reason,
+        created_at,
+        updated_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        $5,
+        $6,
+        $7,
+        current_timestamp,
+        current_timestamp
+    ) RETURNING *;
+
+-- GetTransferBatches: Retrieves paginated transfer batches with search capability
+-- Purpose: List batches for their owner or for administration
+-- Parameters:
+--   $1: search_term - Optional filter on source card or status (NULL for no filter)
+--   $2: user_id - Only return batches of this user (NULL for all users)
+--   $3: limit - Maximum number of records to return
+--   $4: offset - Number of records to skip for pagination
+-- Returns:
+--   Transfer batch records with total_count for pagination, newest first
+-- name: GetTransferBatches :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM transfer_batches
+WHERE (
+        $1::TEXT IS NULL
+        OR transfer_from ILIKE '%' || $1 || '%'
+        OR status ILIKE '%' || $1 || '%'
+    )
+    AND (
+        sqlc.narg(user_id)::INT IS NULL
+        OR user_id = sqlc.narg(user_id)::INT
+    )
+ORDER BY created_at DESC, transfer_batch_id DESC
+LIMIT $2 OFFSET $3;
+
+-- GetTransferBatchByID: Retrieves a single transfer batch
+-- Purpose: Show the progress and summary of one batch
+-- Parameters:
+--   $1: transfer_batch_id - Unique identifier of the batch
+-- Returns:
+--   The transfer batch record
+-- name: GetTransferBatchByID :one
+SELECT *
+FROM transfer_batches
+WHERE
+    transfer_batch_id = $1;
+
+-- GetTransferBatchRows: Retrieves the rows of a batch page by page
+-- Purpose: Show the per-row status of a batch
+-- Parameters:
+--   $1: transfer_batch_id - Unique identifier of the batch
+--   $2: limit - Maximum number of records to return
+--   $3: offset - Number of records to skip for pagination
+-- Returns:
+--   Row records with total_count for pagination, in upload order
+-- name: GetTransferBatchRows :many
+SELECT
+    *,
+    COUNT(*) OVER () AS total_count
+FROM transfer_batch_rows
+WHERE
+    transfer_batch_id = $1
+ORDER BY row_number
+LIMIT $2 OFFSET $3;
+
+-- GetTransferBatchReportRows: Retrieves every row of a batch
+-- Purpose: Build the downloadable result report of a batch
+-- Parameters:
+--   $1: transfer_batch_id - Unique identifier of the batch
+-- Returns:
+--   All row records of the batch, in upload order
+-- name: GetTransferBatchReportRows :many
+SELECT *
+FROM transfer_batch_rows
+WHERE
+    transfer_batch_id = $1
+ORDER BY row_number;
+
+-- GetRunnableTransferBatches: Retrieves batches that still have rows to run
+-- Purpose: Feed the background executor
+-- Parameters:
+--   $1: row_limit - Maximum number of batches to return
+-- Returns:
+--   Pending and processing batches, oldest first
+-- name: GetRunnableTransferBatches :many
+SELECT *
+FROM transfer_batches
+WHERE
+    status IN ('pending', 'processing')
+ORDER BY created_at, transfer_batch_id
+LIMIT sqlc.arg(row_limit);
+
+-- StartTransferBatch: Marks a batch as being executed
+-- Purpose: Expose that a batch has started and when
+-- Parameters:
+--   $1: transfer_batch_id - Unique identifier of the batch
+-- Returns:
+--   The processing transfer batch record
+-- Business Logic:
+--   - A batch picked up again after an interruption keeps its first started_at
+-- name: StartTransferBatch :one
+UPDATE transfer_batches
+SET
+    status = 'processing',
+    started_at = COALESCE(started_at, current_timestamp),
+    updated_at = current_timestamp
+WHERE
+    transfer_batch_id = $1
+    AND status IN ('pending', 'processing')
+RETURNING *;
+
+-- GetPendingTransferBatchRows: Retrieves rows of a batch that have not run yet
+-- Purpose: Let the executor work through a batch in upload order
+-- Parameters:
+--   $1: transfer_batch_id - Unique identifier of the batch
+--   $2: row_limit - Maximum number of rows to return
+-- Returns:
+--   Pending row records, in upload order
+-- name: GetPendingTransferBatchRows :many
+SELECT *
+FROM transfer_batch_rows
+WHERE
+    transfer_batch_id = $1
+    AND status = 'pending'
+ORDER BY row_number
+LIMIT sqlc.arg(row_limit);
+
+-- ClaimTransferBatchRow: Records that a row is about to run
+-- Purpose: Make sure every row is executed at most once
+-- Parameters:
+--   $1: transfer_batch_row_id - Unique identifier of the row
+-- Returns:
+--   The processing row, or no row when another executor claimed it first
+-- name: ClaimTransferBatchRow :one
+UPDATE transfer_batch_rows
+SET
+    status = 'processing',
+    updated_at = current_timestamp
+WHERE
+    transfer_batch_row_id = $1
+    AND status = 'pending'
+RETURNING *;
+
+-- FinishTransferBatchRow: Records the outcome of a row
+-- Purpose: Keep what every row did for the result report
+-- Parameters:
+--   $1: transfer_batch_row_id - Unique identifier of the row
+--   $2: status - success or failed
+--   $3: transfer_id - Transfer created by the row (NULL when none was)
+--   $4: failure_reason - Why the row failed (NULL on success)
+-- Returns:
+--   The updated row record
+-- name: FinishTransferBatchRow :one
+UPDATE transfer_batch_rows
+SET
+    status = $2,
+    transfer_id = $3,
+    failure_reason = $4,
+    updated_at = current_timestamp
+WHERE
+    transfer_batch_row_id = $1
+    AND status = 'processing'
+RETURNING *;
+
+-- AddTransferBatchResult: Counts a finished row in its batch
+-- Purpose: Keep batch progress up to date without scanning its rows
+-- Parameters:
+--   $1: success_rows - Successful rows to add
+--   $2: failed_rows - Failed rows to add
+--   $3: success_amount - Amount transferred to add
+--   $4: transfer_batch_id - Unique identifier of the batch
+-- Returns:
+--   The updated transfer batch record
+-- name: AddTransferBatchResult :one
+UPDATE transfer_batches
+SET
+    success_rows = success_rows + sqlc.arg(success_rows)::INT,
+    failed_rows = failed_rows + sqlc.arg(failed_rows)::INT,
+    success_amount = success_amount + sqlc.arg(success_amount)::INT,
+    updated_at = current_timestamp
+WHERE
+    transfer_batch_id = sqlc.arg(transfer_batch_id)
+RETURNING *;
+
+-- CompleteTransferBatch: Ends a batch that has no pending row left
+-- Purpose: Record the final outcome of a batch
+-- Parameters:
+--   $1: transfer_batch_id - Unique identifier of the batch
+--   $2: status - completed, or completed_with_errors when a row did not succeed
+-- Returns:
+--   The completed transfer batch record
+-- name: CompleteTransferBatch :one
+UPDATE transfer_batches
+SET
+    status = $2,
+    completed_at = current_timestamp,
+    updated_at = current_timestamp
+WHERE
+    transfer_batch_id = $1
+    AND status = 'processing'
+RETURNING *;
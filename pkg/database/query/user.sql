@@ -278,3 +278,22 @@ DELETE FROM users
 WHERE
     deleted_at IS NOT NULL;
 
+
+-- UpdateUserTier: Moves a user to another tier
+-- Purpose: Change which transaction limits apply to the user's cards
+-- Parameters:
+--   $1: user_id - ID of user to update
+--   $2: tier - standard, premium or business
+-- Returns: Updated user record
+-- Business Logic:
+--   - Only modifies active (non-deleted) users
+--   - New limits apply from the next operation on
+-- name: UpdateUserTier :one
+UPDATE users
+SET
+    tier = $2,
+    updated_at = current_timestamp
+WHERE
+    user_id = $1
+    AND deleted_at IS NULL
+    RETURNING *;
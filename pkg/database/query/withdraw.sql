@@ -115,6 +115,34 @@ WHERE
     withdraw_id = $1
     AND deleted_at IS NULL;
 
+-- GetWithdrawByNo: Retrieves a single withdrawal by its withdraw number
+-- Purpose: Find the withdrawal a payout callback is about
+-- Parameters:
+--   $1: withdraw_no - The public number of the withdrawal
+-- Returns:
+--   All fields for the specified withdrawal or NULL if not found/deleted
+-- name: GetWithdrawByNo :one
+SELECT *
+FROM withdraws
+WHERE
+    withdraw_no = $1
+    AND deleted_at IS NULL;
+
+-- GetWithdrawByNoForUpdate: Locks a single withdrawal by its withdraw number
+-- Purpose: Apply a payout callback without racing another delivery of it
+-- Parameters:
+--   $1: withdraw_no - The public number of the withdrawal
+-- Returns:
+--   The withdrawal, locked until the surrounding transaction ends, or no row
+--   if not found/deleted
+-- name: GetWithdrawByNoForUpdate :one
+SELECT *
+FROM withdraws
+WHERE
+    withdraw_no = $1
+    AND deleted_at IS NULL
+FOR UPDATE;
+
 -- GetWithdrawsByCardNumber: Retrieves paginated withdrawals for a specific card with search
 -- Purpose: List all withdrawals associated with a particular card
 -- Parameters:
@@ -781,6 +809,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation (e.g., 'Jan')
 --   total_withdraw_amount: Sum of withdrawal amounts for that month (0 if no withdrawals)
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Generates complete monthly series for the specified year
 --   - Includes all withdrawals regardless of card or status
@@ -795,20 +824,29 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(w.withdraw_amount), 0)::int AS total_withdraw_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     withdraws w ON EXTRACT(MONTH FROM w.withdraw_time) = EXTRACT(MONTH FROM m.month)
+    AND w.currency = cur.currency
     AND EXTRACT(YEAR FROM w.withdraw_time) = EXTRACT(YEAR FROM m.month)
     AND w.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 -- GetYearlyWithdraws: Retrieves yearly withdrawal totals for a 5-year period
 -- Purpose: Analyze long-term withdrawal trends
@@ -817,6 +855,7 @@ ORDER BY
 -- Returns:
 --   year: 4-digit year
 --   total_withdraw_amount: Sum of withdrawal amounts for that year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Covers a 5-year rolling window (current_year-4 to current_year)
 --   - Only includes active withdrawal records
@@ -826,6 +865,7 @@ ORDER BY
 -- name: GetYearlyWithdraws :many
 SELECT
     EXTRACT(YEAR FROM w.withdraw_time) AS year,
+    w.currency,
     SUM(w.withdraw_amount) AS total_withdraw_amount
 FROM
     withdraws w
@@ -834,9 +874,11 @@ WHERE
     AND EXTRACT(YEAR FROM w.withdraw_time) >= $1 - 4
     AND EXTRACT(YEAR FROM w.withdraw_time) <= $1
 GROUP BY
-    EXTRACT(YEAR FROM w.withdraw_time)
+    EXTRACT(YEAR FROM w.withdraw_time),
+    w.currency
 ORDER BY
-    year;
+    year,
+    currency;
 
 -- GetMonthlyWithdrawsByCardNumber: Retrieves monthly withdrawals for a specific card
 -- Purpose: Track monthly cash usage patterns for individual cardholders
@@ -846,6 +888,7 @@ ORDER BY
 -- Returns:
 --   month: 3-letter month abbreviation
 --   total_withdraw_amount: Sum of withdrawals for that card by month
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Generates complete monthly series for the year
 --   - Filters withdrawals by specific card number
@@ -859,21 +902,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(w.withdraw_amount), 0)::int AS total_withdraw_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     withdraws w ON EXTRACT(MONTH FROM w.withdraw_time) = EXTRACT(MONTH FROM m.month)
+    AND w.currency = cur.currency
     AND EXTRACT(YEAR FROM w.withdraw_time) = EXTRACT(YEAR FROM m.month)
     AND w.card_number = $1
     AND w.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month;
+    m.month,
+    cur.currency;
 
 -- GetYearlyWithdrawsByCardNumber: Retrieves yearly withdrawals for a specific card
 -- Purpose: Analyze long-term cash usage for individual cardholders
@@ -883,6 +935,7 @@ ORDER BY
 -- Returns:
 --   year: 4-digit year
 --   total_withdraw_amount: Sum of withdrawals for that card by year
+--   currency: Currency the totals are expressed in
 -- Business Logic:
 --   - Covers 5-year period (current_year-4 to current_year)
 --   - Filters by specific card number
@@ -893,6 +946,7 @@ ORDER BY
 -- name: GetYearlyWithdrawsByCardNumber :many
 SELECT
     EXTRACT(YEAR FROM w.created_at) AS year,
+    w.currency,
     SUM(w.withdraw_amount) AS total_withdraw_amount
 FROM
     withdraws w
@@ -902,12 +956,31 @@ WHERE
     AND EXTRACT(YEAR FROM w.created_at) >= $2 - 4
     AND EXTRACT(YEAR FROM w.created_at) <= $2
 GROUP BY
-    EXTRACT(YEAR FROM w.created_at)
+    EXTRACT(YEAR FROM w.created_at),
+    w.currency
 ORDER BY
-    year;
+    year,
+    currency;
 
 
 
+-- GetSettleableWithdraws: Retrieves approved withdraws that still have to be paid out
+-- Purpose: Feed the withdraw settlement job
+-- Parameters:
+--   $1: row_limit - Maximum number of withdraws to return
+-- Returns:
+--   Approved and processing withdraws, oldest decision first
+-- Business Logic:
+--   - A withdraw left in 'processing' was interrupted and is settled again
+-- name: GetSettleableWithdraws :many
+SELECT *
+FROM withdraws
+WHERE
+    status IN ('approved', 'processing')
+    AND deleted_at IS NULL
+ORDER BY updated_at, withdraw_id
+LIMIT sqlc.arg(row_limit);
+
 -- CreateWithdraw: Records a new cash withdrawal
 -- Purpose: Create a withdrawal transaction in the system
 -- Parameters:
@@ -915,18 +988,24 @@ ORDER BY
 --   $2: withdraw_amount - The amount withdrawn
 --   $3: withdraw_time - When the withdrawal occurred
 --   $4: created_at - Timestamp of record creation
+--   $5: fee - Fee charged to the card on top of withdraw_amount
+--   $6: payout_provider - Provider expected to report the payout outcome
 -- Returns:
 --   The newly created withdrawal record with all fields
 -- Business Logic:
 --   - Sets creation and update timestamps automatically
 --   - Used for recording ATM/branch cash withdrawals
 --   - Typically triggered after successful cash dispense
+--   - The amount is recorded in the currency of the card
 -- name: CreateWithdraw :one
 INSERT INTO
     withdraws (
         card_number,
         withdraw_amount,
         withdraw_time,
+        currency,
+        fee,
+        payout_provider,
         created_at,
         updated_at
     )
@@ -934,6 +1013,9 @@ VALUES (
         $1,
         $2,
         $3,
+        (SELECT c.currency FROM cards c WHERE c.card_number = $1),
+        $5,
+        $6,
         $4,
         current_timestamp
     ) RETURNING *;
@@ -942,43 +1024,66 @@ VALUES (
 -- Purpose: Update withdrawal information
 -- Parameters:
 --   $1: withdraw_id - ID of withdrawal to update
---   $2: card_number - Updated card number
---   $3: withdraw_amount - Updated withdrawal amount
---   $4: withdraw_time - Updated withdrawal timestamp
+--   $2: withdraw_amount - Updated withdrawal amount
+--   $3: withdraw_time - Updated withdrawal timestamp
+--   $4: fee - Fee quoted on the updated amount
 -- Business Logic:
 --   - Only updates active withdrawals (non-deleted)
 --   - Updates modification timestamp automatically
 --   - Used for correcting withdrawal records
 --   - Requires original withdrawal record exists
+--   - The card is kept, as the ledger postings of the withdrawal stay on it
 -- name: UpdateWithdraw :one
 UPDATE withdraws
 SET
-    card_number = $2,
-    withdraw_amount = $3,
-    withdraw_time = $4,
+    withdraw_amount = $2,
+    withdraw_time = $3,
+    fee = $4,
     updated_at = current_timestamp
 WHERE
     withdraw_id = $1
     AND deleted_at IS NULL
 RETURNING *;
 
--- UpdateWithdrawStatus: Changes withdrawal status
--- Purpose: Update processing status of a withdrawal
+-- UpdateWithdrawStatus: Moves a withdrawal from one status to another
+-- Purpose: Record the outcome of a withdrawal together with its status history
 -- Parameters:
---   $1: withdraw_id - ID of withdrawal to update
---   $2: status - New status (e.g., 'completed', 'failed', 'pending')
+--   $1: status - New status
+--   $2: withdraw_id - ID of the withdrawal
+--   $3: from_status - Status the withdrawal is expected to be in
+-- Returns:
+--   The updated withdrawal, or no row when it is no longer in from_status
 -- Business Logic:
---   - Only updates active withdrawals
---   - Updates modification timestamp
---   - Used to reflect withdrawal processing outcomes
---   - Important for reconciliation purposes
+--   - Only updates active (non-deleted) records
+--   - Allowed transitions are checked against pkg/statemachine by the caller
+--   - The status history row is written by the same statement, and only
+--     when the row was still in from_status
 -- name: UpdateWithdrawStatus :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT 'withdraw', withdraw_id, status, sqlc.arg(status), current_timestamp
+        FROM withdraws
+        WHERE
+            withdraw_id = sqlc.arg(withdraw_id)
+            AND status = sqlc.arg(from_status)
+            AND deleted_at IS NULL
+        FOR UPDATE
+    )
 UPDATE withdraws
 SET
-    status = $2,
+    status = sqlc.arg(status),
     updated_at = current_timestamp
 WHERE
-    withdraw_id = $1
+    withdraw_id = sqlc.arg(withdraw_id)
+    AND status = sqlc.arg(from_status)
     AND deleted_at IS NULL
 RETURNING *;
 
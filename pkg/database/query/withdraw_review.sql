@@ -0,0 +1,39 @@
+-- CreateWithdrawReview: Records the review decision of a withdraw
+-- Purpose: Keep who approved or rejected a withdraw that waited for review
+-- Parameters:
+--   $1: withdraw_id - Reviewed withdraw
+--   $2: decision - approved or rejected
+--   $3: note - Reason given by the reviewer (NULL when none)
+--   $4: reviewed_by - Admin who took the decision
+-- Returns:
+--   The created review record
+-- Business Logic:
+--   - A withdraw is reviewed at most once
+-- name: CreateWithdrawReview :one
+INSERT INTO
+    withdraw_reviews (
+        withdraw_id,
+        decision,
+        note,
+        reviewed_by,
+        created_at
+    )
+VALUES (
+        $1,
+        $2,
+        $3,
+        $4,
+        current_timestamp
+    ) RETURNING *;
+
+-- GetWithdrawReviewByWithdrawID: Retrieves the review of a withdraw
+-- Purpose: Show who decided on a withdraw that waited for review
+-- Parameters:
+--   $1: withdraw_id - Reviewed withdraw
+-- Returns:
+--   The review record
+-- name: GetWithdrawReviewByWithdrawID :one
+SELECT *
+FROM withdraw_reviews
+WHERE
+    withdraw_id = $1;
@@ -20,6 +20,7 @@ INSERT INTO
         expire_date,
         cvv,
         card_provider,
+        currency,
         created_at,
         updated_at
     )
@@ -30,9 +31,10 @@ VALUES (
         $4,
         $5,
         $6,
+        $7,
         current_timestamp,
         current_timestamp
-    ) RETURNING card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at
+    ) RETURNING card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at, currency
 `
 
 type CreateCardParams struct {
@@ -42,6 +44,7 @@ type CreateCardParams struct {
 	ExpireDate   time.Time `json:"expire_date"`
 	Cvv          string    `json:"cvv"`
 	CardProvider string    `json:"card_provider"`
+	Currency     string    `json:"currency"`
 }
 
 // CreateCard: Creates a new card record
@@ -54,11 +57,13 @@ type CreateCardParams struct {
 //	$4: expire_date - Expiration date of the card
 //	$5: cvv - Card verification value
 //	$6: card_provider - Provider/issuer of the card
+//	$7: currency - ISO 4217 code the card balance is kept in
 //
 // Returns: Complete created card record
 // Business Logic:
 //   - Automatically sets created_at and updated_at timestamps
 //   - Requires all fields to be provided
+//   - The currency cannot be changed once the card is issued
 func (q *Queries) CreateCard(ctx context.Context, arg CreateCardParams) (*Card, error) {
 	row := q.db.QueryRowContext(ctx, createCard,
 		arg.UserID,
@@ -67,6 +72,7 @@ func (q *Queries) CreateCard(ctx context.Context, arg CreateCardParams) (*Card,
 		arg.ExpireDate,
 		arg.Cvv,
 		arg.CardProvider,
+		arg.Currency,
 	)
 	var i Card
 	err := row.Scan(
@@ -80,6 +86,7 @@ func (q *Queries) CreateCard(ctx context.Context, arg CreateCardParams) (*Card,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
 	)
 	return &i, err
 }
@@ -121,7 +128,7 @@ func (q *Queries) DeleteCardPermanently(ctx context.Context, cardID int32) error
 
 const getActiveCardsWithCount = `-- name: GetActiveCardsWithCount :many
 SELECT
-    card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at,
+    card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at, currency,
     COUNT(*) OVER() AS total_count
 FROM cards
 WHERE deleted_at IS NULL
@@ -147,6 +154,7 @@ type GetActiveCardsWithCountRow struct {
 	CreatedAt    sql.NullTime `json:"created_at"`
 	UpdatedAt    sql.NullTime `json:"updated_at"`
 	DeletedAt    sql.NullTime `json:"deleted_at"`
+	Currency     string       `json:"currency"`
 	TotalCount   int64        `json:"total_count"`
 }
 
@@ -187,6 +195,7 @@ func (q *Queries) GetActiveCardsWithCount(ctx context.Context, arg GetActiveCard
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -203,7 +212,7 @@ func (q *Queries) GetActiveCardsWithCount(ctx context.Context, arg GetActiveCard
 }
 
 const getCardByCardNumber = `-- name: GetCardByCardNumber :one
-SELECT card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at FROM cards WHERE card_number = $1 AND deleted_at IS NULL
+SELECT card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at, currency FROM cards WHERE card_number = $1 AND deleted_at IS NULL
 `
 
 // GetCardByCardNumber: Retrieves a single active card by its card number
@@ -234,12 +243,13 @@ func (q *Queries) GetCardByCardNumber(ctx context.Context, cardNumber string) (*
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
 	)
 	return &i, err
 }
 
 const getCardByID = `-- name: GetCardByID :one
-SELECT card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at FROM cards WHERE card_id = $1 AND deleted_at IS NULL
+SELECT card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at, currency FROM cards WHERE card_id = $1 AND deleted_at IS NULL
 `
 
 // GetCardByID: Retrieves a single card by its ID
@@ -269,12 +279,13 @@ func (q *Queries) GetCardByID(ctx context.Context, cardID int32) (*Card, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
 	)
 	return &i, err
 }
 
 const getCardByUserID = `-- name: GetCardByUserID :one
-SELECT card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at
+SELECT card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at, currency
 FROM cards
 WHERE
     user_id = $1
@@ -310,13 +321,14 @@ func (q *Queries) GetCardByUserID(ctx context.Context, userID int32) (*Card, err
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
 	)
 	return &i, err
 }
 
 const getCards = `-- name: GetCards :many
 SELECT
-    card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at,
+    card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at, currency,
     COUNT(*) OVER() AS total_count
 FROM cards
 WHERE deleted_at IS NULL
@@ -342,6 +354,7 @@ type GetCardsRow struct {
 	CreatedAt    sql.NullTime `json:"created_at"`
 	UpdatedAt    sql.NullTime `json:"updated_at"`
 	DeletedAt    sql.NullTime `json:"deleted_at"`
+	Currency     string       `json:"currency"`
 	TotalCount   int64        `json:"total_count"`
 }
 
@@ -382,6 +395,7 @@ func (q *Queries) GetCards(ctx context.Context, arg GetCardsParams) ([]*GetCards
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -404,27 +418,37 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(s.total_balance), 0)::int AS total_balance
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     saldos s ON EXTRACT(MONTH FROM s.created_at) = EXTRACT(MONTH FROM m.month)
+    AND s.currency = cur.currency
     AND EXTRACT(YEAR FROM s.created_at) = EXTRACT(YEAR FROM m.month)
     AND s.deleted_at IS NULL
 LEFT JOIN
     cards c ON s.card_number = c.card_number
     AND c.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyBalancesRow struct {
 	Month        string `json:"month"`
+	Currency     string `json:"currency"`
 	TotalBalance int32  `json:"total_balance"`
 }
 
@@ -438,6 +462,7 @@ type GetMonthlyBalancesRow struct {
 //
 //	month: 3-letter month abbreviation (e.g., 'Jan')
 //	total_balance: Sum of balances for that month (zero if no data)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Generates a complete 12-month series for the year
@@ -454,7 +479,7 @@ func (q *Queries) GetMonthlyBalances(ctx context.Context, dollar_1 time.Time) ([
 	var items []*GetMonthlyBalancesRow
 	for rows.Next() {
 		var i GetMonthlyBalancesRow
-		if err := rows.Scan(&i.Month, &i.TotalBalance); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalBalance); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -475,14 +500,21 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $2
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(s.total_balance), 0)::int AS total_balance
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     saldos s ON EXTRACT(MONTH FROM s.created_at) = EXTRACT(MONTH FROM m.month)
+    AND s.currency = cur.currency
     AND EXTRACT(YEAR FROM s.created_at) = EXTRACT(YEAR FROM m.month)
     AND s.deleted_at IS NULL
 LEFT JOIN
@@ -490,9 +522,11 @@ LEFT JOIN
     AND c.deleted_at IS NULL
     AND c.card_number = $2
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyBalancesByCardNumberParams struct {
@@ -502,6 +536,7 @@ type GetMonthlyBalancesByCardNumberParams struct {
 
 type GetMonthlyBalancesByCardNumberRow struct {
 	Month        string `json:"month"`
+	Currency     string `json:"currency"`
 	TotalBalance int32  `json:"total_balance"`
 }
 
@@ -516,6 +551,7 @@ type GetMonthlyBalancesByCardNumberRow struct {
 //
 //	month: 3-letter month abbreviation (e.g., 'Jan')
 //	total_balance: Monthly balance total (0 if no data)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Generates complete 12-month series for the year
@@ -532,7 +568,7 @@ func (q *Queries) GetMonthlyBalancesByCardNumber(ctx context.Context, arg GetMon
 	var items []*GetMonthlyBalancesByCardNumberRow
 	for rows.Next() {
 		var i GetMonthlyBalancesByCardNumberRow
-		if err := rows.Scan(&i.Month, &i.TotalBalance); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalBalance); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -553,27 +589,37 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.topup_amount), 0)::int AS total_topup_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     topups t ON EXTRACT(MONTH FROM t.topup_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.topup_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 LEFT JOIN
     cards c ON t.card_number = c.card_number
     AND c.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyTopupAmountRow struct {
 	Month            string `json:"month"`
+	Currency         string `json:"currency"`
 	TotalTopupAmount int32  `json:"total_topup_amount"`
 }
 
@@ -587,6 +633,7 @@ type GetMonthlyTopupAmountRow struct {
 //
 //	month: 3-letter month abbreviation
 //	total_topup_amount: Sum of top-ups for that month (zero if no data)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Generates complete 12-month series
@@ -603,7 +650,7 @@ func (q *Queries) GetMonthlyTopupAmount(ctx context.Context, dollar_1 time.Time)
 	var items []*GetMonthlyTopupAmountRow
 	for rows.Next() {
 		var i GetMonthlyTopupAmountRow
-		if err := rows.Scan(&i.Month, &i.TotalTopupAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalTopupAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -624,14 +671,21 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.topup_amount), 0)::int AS total_topup_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     topups t ON EXTRACT(MONTH FROM t.topup_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.topup_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 LEFT JOIN
@@ -639,9 +693,11 @@ LEFT JOIN
     AND c.deleted_at IS NULL
     AND t.card_number = $1
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyTopupAmountByCardNumberParams struct {
@@ -651,6 +707,7 @@ type GetMonthlyTopupAmountByCardNumberParams struct {
 
 type GetMonthlyTopupAmountByCardNumberRow struct {
 	Month            string `json:"month"`
+	Currency         string `json:"currency"`
 	TotalTopupAmount int32  `json:"total_topup_amount"`
 }
 
@@ -665,6 +722,7 @@ type GetMonthlyTopupAmountByCardNumberRow struct {
 //
 //	month: 3-letter month abbreviation
 //	total_topup_amount: Monthly top-up total (0 if none)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Complete 12-month coverage
@@ -681,7 +739,7 @@ func (q *Queries) GetMonthlyTopupAmountByCardNumber(ctx context.Context, arg Get
 	var items []*GetMonthlyTopupAmountByCardNumberRow
 	for rows.Next() {
 		var i GetMonthlyTopupAmountByCardNumberRow
-		if err := rows.Scan(&i.Month, &i.TotalTopupAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalTopupAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -702,27 +760,37 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.amount), 0)::int AS total_transaction_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 LEFT JOIN
     cards c ON t.card_number = c.card_number
     AND c.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyTransactionAmountRow struct {
 	Month                  string `json:"month"`
+	Currency               string `json:"currency"`
 	TotalTransactionAmount int32  `json:"total_transaction_amount"`
 }
 
@@ -736,6 +804,7 @@ type GetMonthlyTransactionAmountRow struct {
 //
 //	month: 3-letter month abbreviation
 //	total_transaction_amount: Sum of transactions for that month (zero if no data)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Generates complete 12-month series
@@ -752,7 +821,7 @@ func (q *Queries) GetMonthlyTransactionAmount(ctx context.Context, dollar_1 time
 	var items []*GetMonthlyTransactionAmountRow
 	for rows.Next() {
 		var i GetMonthlyTransactionAmountRow
-		if err := rows.Scan(&i.Month, &i.TotalTransactionAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalTransactionAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -773,14 +842,21 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.amount), 0)::int AS total_transaction_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 LEFT JOIN
@@ -788,9 +864,11 @@ LEFT JOIN
     AND c.deleted_at IS NULL
     AND t.card_number = $1
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyTransactionAmountByCardNumberParams struct {
@@ -800,6 +878,7 @@ type GetMonthlyTransactionAmountByCardNumberParams struct {
 
 type GetMonthlyTransactionAmountByCardNumberRow struct {
 	Month                  string `json:"month"`
+	Currency               string `json:"currency"`
 	TotalTransactionAmount int32  `json:"total_transaction_amount"`
 }
 
@@ -814,6 +893,7 @@ type GetMonthlyTransactionAmountByCardNumberRow struct {
 //
 //	month: 3-letter month abbreviation
 //	total_transaction_amount: Monthly transaction total (0 if none)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Complete 12-month coverage
@@ -830,7 +910,7 @@ func (q *Queries) GetMonthlyTransactionAmountByCardNumber(ctx context.Context, a
 	var items []*GetMonthlyTransactionAmountByCardNumberRow
 	for rows.Next() {
 		var i GetMonthlyTransactionAmountByCardNumberRow
-		if err := rows.Scan(&i.Month, &i.TotalTransactionAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalTransactionAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -851,21 +931,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
-    COALESCE(SUM(t.transfer_amount), 0)::int AS total_received_amount
+    cur.currency,
+    COALESCE(SUM(t.converted_amount), 0)::int AS total_received_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.to_currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
     AND t.transfer_to = $1
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyTransferAmountByReceiverParams struct {
@@ -875,6 +964,7 @@ type GetMonthlyTransferAmountByReceiverParams struct {
 
 type GetMonthlyTransferAmountByReceiverRow struct {
 	Month               string `json:"month"`
+	Currency            string `json:"currency"`
 	TotalReceivedAmount int32  `json:"total_received_amount"`
 }
 
@@ -889,6 +979,7 @@ type GetMonthlyTransferAmountByReceiverRow struct {
 //
 //	month: 3-letter month abbreviation
 //	total_sent_amount: Monthly transfer total (0 if none)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Complete 12-month coverage
@@ -905,7 +996,7 @@ func (q *Queries) GetMonthlyTransferAmountByReceiver(ctx context.Context, arg Ge
 	var items []*GetMonthlyTransferAmountByReceiverRow
 	for rows.Next() {
 		var i GetMonthlyTransferAmountByReceiverRow
-		if err := rows.Scan(&i.Month, &i.TotalReceivedAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalReceivedAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -926,21 +1017,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.transfer_amount), 0)::int AS total_sent_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
     AND t.transfer_from = $1
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyTransferAmountBySenderParams struct {
@@ -950,6 +1050,7 @@ type GetMonthlyTransferAmountBySenderParams struct {
 
 type GetMonthlyTransferAmountBySenderRow struct {
 	Month           string `json:"month"`
+	Currency        string `json:"currency"`
 	TotalSentAmount int32  `json:"total_sent_amount"`
 }
 
@@ -964,6 +1065,7 @@ type GetMonthlyTransferAmountBySenderRow struct {
 //
 //	month: 3-letter month abbreviation
 //	total_sent_amount: Monthly transfer total (0 if none)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Complete 12-month coverage
@@ -980,7 +1082,7 @@ func (q *Queries) GetMonthlyTransferAmountBySender(ctx context.Context, arg GetM
 	var items []*GetMonthlyTransferAmountBySenderRow
 	for rows.Next() {
 		var i GetMonthlyTransferAmountBySenderRow
-		if err := rows.Scan(&i.Month, &i.TotalSentAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalSentAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1001,24 +1103,34 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
-    COALESCE(SUM(t.transfer_amount), 0)::int AS total_received_amount
+    cur.currency,
+    COALESCE(SUM(t.converted_amount), 0)::int AS total_received_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.to_currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyTransferAmountReceiverRow struct {
 	Month               string `json:"month"`
+	Currency            string `json:"currency"`
 	TotalReceivedAmount int32  `json:"total_received_amount"`
 }
 
@@ -1032,6 +1144,7 @@ type GetMonthlyTransferAmountReceiverRow struct {
 //
 //	month: 3-letter month abbreviation
 //	total_received_amount: Sum of transfers for that month (zero if no data)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Generates complete 12-month series
@@ -1048,7 +1161,7 @@ func (q *Queries) GetMonthlyTransferAmountReceiver(ctx context.Context, dollar_1
 	var items []*GetMonthlyTransferAmountReceiverRow
 	for rows.Next() {
 		var i GetMonthlyTransferAmountReceiverRow
-		if err := rows.Scan(&i.Month, &i.TotalReceivedAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalReceivedAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1069,24 +1182,34 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.transfer_amount), 0)::int AS total_sent_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyTransferAmountSenderRow struct {
 	Month           string `json:"month"`
+	Currency        string `json:"currency"`
 	TotalSentAmount int32  `json:"total_sent_amount"`
 }
 
@@ -1100,6 +1223,7 @@ type GetMonthlyTransferAmountSenderRow struct {
 //
 //	month: 3-letter month abbreviation
 //	total_sent_amount: Sum of transfers for that month (zero if no data)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Generates complete 12-month series
@@ -1116,7 +1240,7 @@ func (q *Queries) GetMonthlyTransferAmountSender(ctx context.Context, dollar_1 t
 	var items []*GetMonthlyTransferAmountSenderRow
 	for rows.Next() {
 		var i GetMonthlyTransferAmountSenderRow
-		if err := rows.Scan(&i.Month, &i.TotalSentAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalSentAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1137,27 +1261,37 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(w.withdraw_amount), 0)::int AS total_withdraw_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     withdraws w ON EXTRACT(MONTH FROM w.withdraw_time) = EXTRACT(MONTH FROM m.month)
+    AND w.currency = cur.currency
     AND EXTRACT(YEAR FROM w.withdraw_time) = EXTRACT(YEAR FROM m.month)
     AND w.deleted_at IS NULL
 LEFT JOIN
     cards c ON w.card_number = c.card_number
     AND c.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyWithdrawAmountRow struct {
 	Month               string `json:"month"`
+	Currency            string `json:"currency"`
 	TotalWithdrawAmount int32  `json:"total_withdraw_amount"`
 }
 
@@ -1171,6 +1305,7 @@ type GetMonthlyWithdrawAmountRow struct {
 //
 //	month: 3-letter month abbreviation
 //	total_withdraw_amount: Sum of withdraws for that month (zero if no data)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Generates complete 12-month series
@@ -1187,7 +1322,7 @@ func (q *Queries) GetMonthlyWithdrawAmount(ctx context.Context, dollar_1 time.Ti
 	var items []*GetMonthlyWithdrawAmountRow
 	for rows.Next() {
 		var i GetMonthlyWithdrawAmountRow
-		if err := rows.Scan(&i.Month, &i.TotalWithdrawAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalWithdrawAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1208,14 +1343,21 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(w.withdraw_amount), 0)::int AS total_withdraw_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     withdraws w ON EXTRACT(MONTH FROM w.withdraw_time) = EXTRACT(MONTH FROM m.month)
+    AND w.currency = cur.currency
     AND EXTRACT(YEAR FROM w.withdraw_time) = EXTRACT(YEAR FROM m.month)
     AND w.deleted_at IS NULL
 LEFT JOIN
@@ -1223,9 +1365,11 @@ LEFT JOIN
     AND c.deleted_at IS NULL
     AND w.card_number = $1
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyWithdrawAmountByCardNumberParams struct {
@@ -1235,6 +1379,7 @@ type GetMonthlyWithdrawAmountByCardNumberParams struct {
 
 type GetMonthlyWithdrawAmountByCardNumberRow struct {
 	Month               string `json:"month"`
+	Currency            string `json:"currency"`
 	TotalWithdrawAmount int32  `json:"total_withdraw_amount"`
 }
 
@@ -1249,6 +1394,7 @@ type GetMonthlyWithdrawAmountByCardNumberRow struct {
 //
 //	month: 3-letter month abbreviation
 //	total_withdraw_amount: Monthly withdraw total (0 if none)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Complete 12-month coverage
@@ -1265,7 +1411,107 @@ func (q *Queries) GetMonthlyWithdrawAmountByCardNumber(ctx context.Context, arg
 	var items []*GetMonthlyWithdrawAmountByCardNumberRow
 	for rows.Next() {
 		var i GetMonthlyWithdrawAmountByCardNumberRow
-		if err := rows.Scan(&i.Month, &i.TotalWithdrawAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalWithdrawAmount); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getTotalAmountsByCurrency = `-- name: GetTotalAmountsByCurrency :many
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+)
+SELECT
+    cur.currency,
+    COALESCE((
+        SELECT SUM(s.total_balance)
+        FROM saldos s
+        JOIN cards c ON s.card_number = c.card_number
+        WHERE s.currency = cur.currency
+            AND s.deleted_at IS NULL AND c.deleted_at IS NULL
+    ), 0)::bigint AS total_balance,
+    COALESCE((
+        SELECT SUM(t.topup_amount)
+        FROM topups t
+        JOIN cards c ON t.card_number = c.card_number
+        WHERE t.currency = cur.currency
+            AND t.deleted_at IS NULL AND c.deleted_at IS NULL
+    ), 0)::bigint AS total_topup_amount,
+    COALESCE((
+        SELECT SUM(w.withdraw_amount)
+        FROM withdraws w
+        JOIN cards c ON w.card_number = c.card_number
+        WHERE w.currency = cur.currency
+            AND w.deleted_at IS NULL AND c.deleted_at IS NULL
+    ), 0)::bigint AS total_withdraw_amount,
+    COALESCE((
+        SELECT SUM(t.amount)
+        FROM transactions t
+        JOIN cards c ON t.card_number = c.card_number
+        WHERE t.currency = cur.currency
+            AND t.deleted_at IS NULL AND c.deleted_at IS NULL
+    ), 0)::bigint AS total_transaction_amount,
+    COALESCE((
+        SELECT SUM(t.transfer_amount)
+        FROM transfers t
+        WHERE t.currency = cur.currency
+            AND t.deleted_at IS NULL
+    ), 0)::bigint AS total_transfer_amount
+FROM
+    currencies cur
+ORDER BY
+    cur.currency
+`
+
+type GetTotalAmountsByCurrencyRow struct {
+	Currency               string `json:"currency"`
+	TotalBalance           int64  `json:"total_balance"`
+	TotalTopupAmount       int64  `json:"total_topup_amount"`
+	TotalWithdrawAmount    int64  `json:"total_withdraw_amount"`
+	TotalTransactionAmount int64  `json:"total_transaction_amount"`
+	TotalTransferAmount    int64  `json:"total_transfer_amount"`
+}
+
+// GetTotalAmountsByCurrency: Calculates the dashboard totals per currency
+// Purpose: Break the system-wide totals down by the currency amounts are kept in
+// Returns:
+//
+//	currency: Currency the totals are expressed in
+//	total_balance: Sum of active saldo balances
+//	total_topup_amount: Sum of active top-ups
+//	total_withdraw_amount: Sum of active withdrawals
+//	total_transaction_amount: Sum of active transactions
+//	total_transfer_amount: Sum of active transfers in the sender currency
+//
+// Business Logic:
+//   - Returns one row for every currency a card is issued in
+//   - Currencies without activity are reported with zero totals
+//   - Only includes records of active cards (deleted_at IS NULL)
+func (q *Queries) GetTotalAmountsByCurrency(ctx context.Context) ([]*GetTotalAmountsByCurrencyRow, error) {
+	rows, err := q.db.QueryContext(ctx, getTotalAmountsByCurrency)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*GetTotalAmountsByCurrencyRow
+	for rows.Next() {
+		var i GetTotalAmountsByCurrencyRow
+		if err := rows.Scan(
+			&i.Currency,
+			&i.TotalBalance,
+			&i.TotalTopupAmount,
+			&i.TotalWithdrawAmount,
+			&i.TotalTransactionAmount,
+			&i.TotalTransferAmount,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1511,7 +1757,7 @@ func (q *Queries) GetTotalTransferAmount(ctx context.Context) (int64, error) {
 
 const getTotalTransferAmountByReceiver = `-- name: GetTotalTransferAmountByReceiver :one
 SELECT
-    SUM(transfer_amount) AS total_transfer_amount
+    SUM(converted_amount) AS total_transfer_amount
 FROM
     transfers
 WHERE
@@ -1532,6 +1778,7 @@ WHERE
 // Business Logic:
 //   - Only includes active transfer records (deleted_at IS NULL)
 //   - Useful for tracking money received by a particular account
+//   - Sums converted_amount so the total is in the receiving card's currency
 func (q *Queries) GetTotalTransferAmountByReceiver(ctx context.Context, transferTo string) (int64, error) {
 	row := q.db.QueryRowContext(ctx, getTotalTransferAmountByReceiver, transferTo)
 	var total_transfer_amount int64
@@ -1634,7 +1881,7 @@ func (q *Queries) GetTotalWithdrawAmountByCardNumber(ctx context.Context, cardNu
 }
 
 const getTrashedCardByID = `-- name: GetTrashedCardByID :one
-SELECT card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at FROM cards WHERE card_id = $1 AND deleted_at IS NOT NULL
+SELECT card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at, currency FROM cards WHERE card_id = $1 AND deleted_at IS NOT NULL
 `
 
 // GetTrashedCardByID: Retrieves a single soft-deleted card by its ID
@@ -1665,13 +1912,14 @@ func (q *Queries) GetTrashedCardByID(ctx context.Context, cardID int32) (*Card,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
 	)
 	return &i, err
 }
 
 const getTrashedCardsWithCount = `-- name: GetTrashedCardsWithCount :many
 SELECT
-    card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at,
+    card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at, currency,
     COUNT(*) OVER() AS total_count
 FROM cards
 WHERE deleted_at IS NOT NULL
@@ -1697,6 +1945,7 @@ type GetTrashedCardsWithCountRow struct {
 	CreatedAt    sql.NullTime `json:"created_at"`
 	UpdatedAt    sql.NullTime `json:"updated_at"`
 	DeletedAt    sql.NullTime `json:"deleted_at"`
+	Currency     string       `json:"currency"`
 	TotalCount   int64        `json:"total_count"`
 }
 
@@ -1737,6 +1986,7 @@ func (q *Queries) GetTrashedCardsWithCount(ctx context.Context, arg GetTrashedCa
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1756,6 +2006,7 @@ const getYearlyBalances = `-- name: GetYearlyBalances :many
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM s.created_at) AS year,
+        s.currency,
         SUM(s.total_balance) AS total_balance
     FROM
         saldos s
@@ -1766,19 +2017,23 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM s.created_at) >= $1 - 4
         AND EXTRACT(YEAR FROM s.created_at) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM s.created_at)
+        EXTRACT(YEAR FROM s.created_at),
+        s.currency
 )
 SELECT
     year,
+    currency,
     total_balance
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyBalancesRow struct {
 	Year         string `json:"year"`
+	Currency     string `json:"currency"`
 	TotalBalance int64  `json:"total_balance"`
 }
 
@@ -1792,6 +2047,7 @@ type GetYearlyBalancesRow struct {
 //
 //	year: The 4-digit year
 //	total_balance: Sum of balances for that year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Covers a 5-year rolling window (reference_year-4 to reference_year)
@@ -1807,7 +2063,7 @@ func (q *Queries) GetYearlyBalances(ctx context.Context, dollar_1 interface{}) (
 	var items []*GetYearlyBalancesRow
 	for rows.Next() {
 		var i GetYearlyBalancesRow
-		if err := rows.Scan(&i.Year, &i.TotalBalance); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalBalance); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1825,6 +2081,7 @@ const getYearlyBalancesByCardNumber = `-- name: GetYearlyBalancesByCardNumber :m
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM s.created_at) AS year,
+        s.currency,
         SUM(s.total_balance) AS total_balance
     FROM
         saldos s
@@ -1836,15 +2093,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM s.created_at) <= $1
         AND c.card_number = $2
     GROUP BY
-        EXTRACT(YEAR FROM s.created_at)
+        EXTRACT(YEAR FROM s.created_at),
+        s.currency
 )
 SELECT
     year,
+    currency,
     total_balance
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyBalancesByCardNumberParams struct {
@@ -1854,6 +2114,7 @@ type GetYearlyBalancesByCardNumberParams struct {
 
 type GetYearlyBalancesByCardNumberRow struct {
 	Year         string `json:"year"`
+	Currency     string `json:"currency"`
 	TotalBalance int64  `json:"total_balance"`
 }
 
@@ -1868,6 +2129,7 @@ type GetYearlyBalancesByCardNumberRow struct {
 //
 //	year: 4-digit year
 //	total_balance: Annual balance total
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Covers reference_year-4 to reference_year (5 years)
@@ -1883,7 +2145,7 @@ func (q *Queries) GetYearlyBalancesByCardNumber(ctx context.Context, arg GetYear
 	var items []*GetYearlyBalancesByCardNumberRow
 	for rows.Next() {
 		var i GetYearlyBalancesByCardNumberRow
-		if err := rows.Scan(&i.Year, &i.TotalBalance); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalBalance); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1901,6 +2163,7 @@ const getYearlyTopupAmount = `-- name: GetYearlyTopupAmount :many
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.topup_time) AS year,
+        t.currency,
         SUM(t.topup_amount) AS total_topup_amount
     FROM
         topups t
@@ -1912,19 +2175,23 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.topup_time) >= $1 - 4
         AND EXTRACT(YEAR FROM t.topup_time) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM t.topup_time)
+        EXTRACT(YEAR FROM t.topup_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_topup_amount
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyTopupAmountRow struct {
 	Year             string `json:"year"`
+	Currency         string `json:"currency"`
 	TotalTopupAmount int64  `json:"total_topup_amount"`
 }
 
@@ -1938,6 +2205,7 @@ type GetYearlyTopupAmountRow struct {
 //
 //	year: The 4-digit year
 //	total_topup_amount: Sum of top-ups for that year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Covers a 5-year rolling window
@@ -1954,7 +2222,7 @@ func (q *Queries) GetYearlyTopupAmount(ctx context.Context, dollar_1 interface{}
 	var items []*GetYearlyTopupAmountRow
 	for rows.Next() {
 		var i GetYearlyTopupAmountRow
-		if err := rows.Scan(&i.Year, &i.TotalTopupAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalTopupAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1972,6 +2240,7 @@ const getYearlyTopupAmountByCardNumber = `-- name: GetYearlyTopupAmountByCardNum
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.topup_time) AS year,
+        t.currency,
         SUM(t.topup_amount) AS total_topup_amount
     FROM
         topups t
@@ -1984,15 +2253,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.topup_time) >= $2 - 4
         AND EXTRACT(YEAR FROM t.topup_time) <= $2
     GROUP BY
-        EXTRACT(YEAR FROM t.topup_time)
+        EXTRACT(YEAR FROM t.topup_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_topup_amount
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyTopupAmountByCardNumberParams struct {
@@ -2002,6 +2274,7 @@ type GetYearlyTopupAmountByCardNumberParams struct {
 
 type GetYearlyTopupAmountByCardNumberRow struct {
 	Year             string `json:"year"`
+	Currency         string `json:"currency"`
 	TotalTopupAmount int64  `json:"total_topup_amount"`
 }
 
@@ -2016,6 +2289,7 @@ type GetYearlyTopupAmountByCardNumberRow struct {
 //
 //	year: 4-digit year
 //	total_topup_amount: Annual top-up total
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - 5-year rolling window analysis
@@ -2032,7 +2306,7 @@ func (q *Queries) GetYearlyTopupAmountByCardNumber(ctx context.Context, arg GetY
 	var items []*GetYearlyTopupAmountByCardNumberRow
 	for rows.Next() {
 		var i GetYearlyTopupAmountByCardNumberRow
-		if err := rows.Scan(&i.Year, &i.TotalTopupAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalTopupAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2050,6 +2324,7 @@ const getYearlyTransactionAmount = `-- name: GetYearlyTransactionAmount :many
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time) AS year,
+        t.currency,
         SUM(t.amount) AS total_transaction_amount
     FROM
         transactions t
@@ -2061,19 +2336,23 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transaction_time) >= $1 - 4
         AND EXTRACT(YEAR FROM t.transaction_time) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
+        EXTRACT(YEAR FROM t.transaction_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_transaction_amount
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyTransactionAmountRow struct {
 	Year                   string `json:"year"`
+	Currency               string `json:"currency"`
 	TotalTransactionAmount int64  `json:"total_transaction_amount"`
 }
 
@@ -2087,6 +2366,7 @@ type GetYearlyTransactionAmountRow struct {
 //
 //	year: The 4-digit year
 //	total_transaction_amount: Sum of transactions for that year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Covers a 5-year rolling window
@@ -2103,7 +2383,7 @@ func (q *Queries) GetYearlyTransactionAmount(ctx context.Context, dollar_1 inter
 	var items []*GetYearlyTransactionAmountRow
 	for rows.Next() {
 		var i GetYearlyTransactionAmountRow
-		if err := rows.Scan(&i.Year, &i.TotalTransactionAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalTransactionAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2121,6 +2401,7 @@ const getYearlyTransactionAmountByCardNumber = `-- name: GetYearlyTransactionAmo
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time) AS year,
+        t.currency,
         SUM(t.amount) AS total_transaction_amount
     FROM
         transactions t
@@ -2133,15 +2414,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transaction_time) >= $2 - 4
         AND EXTRACT(YEAR FROM t.transaction_time) <= $2
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
+        EXTRACT(YEAR FROM t.transaction_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_transaction_amount
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyTransactionAmountByCardNumberParams struct {
@@ -2151,6 +2435,7 @@ type GetYearlyTransactionAmountByCardNumberParams struct {
 
 type GetYearlyTransactionAmountByCardNumberRow struct {
 	Year                   string `json:"year"`
+	Currency               string `json:"currency"`
 	TotalTransactionAmount int64  `json:"total_transaction_amount"`
 }
 
@@ -2165,6 +2450,7 @@ type GetYearlyTransactionAmountByCardNumberRow struct {
 //
 //	year: 4-digit year
 //	total_transaction_amount: Annual transaction total
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - 5-year rolling window analysis
@@ -2181,7 +2467,7 @@ func (q *Queries) GetYearlyTransactionAmountByCardNumber(ctx context.Context, ar
 	var items []*GetYearlyTransactionAmountByCardNumberRow
 	for rows.Next() {
 		var i GetYearlyTransactionAmountByCardNumberRow
-		if err := rows.Scan(&i.Year, &i.TotalTransactionAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalTransactionAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2199,7 +2485,8 @@ const getYearlyTransferAmountByReceiver = `-- name: GetYearlyTransferAmountByRec
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transfer_time) AS year,
-        SUM(t.transfer_amount) AS total_received_amount
+        t.to_currency AS currency,
+        SUM(t.converted_amount) AS total_received_amount
     FROM
         transfers t
     WHERE
@@ -2208,15 +2495,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transfer_time) >= $2 - 4
         AND EXTRACT(YEAR FROM t.transfer_time) <= $2
     GROUP BY
-        EXTRACT(YEAR FROM t.transfer_time)
+        EXTRACT(YEAR FROM t.transfer_time),
+        t.to_currency
 )
 SELECT
     year,
+    currency,
     total_received_amount
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyTransferAmountByReceiverParams struct {
@@ -2226,6 +2516,7 @@ type GetYearlyTransferAmountByReceiverParams struct {
 
 type GetYearlyTransferAmountByReceiverRow struct {
 	Year                string `json:"year"`
+	Currency            string `json:"currency"`
 	TotalReceivedAmount int64  `json:"total_received_amount"`
 }
 
@@ -2240,6 +2531,7 @@ type GetYearlyTransferAmountByReceiverRow struct {
 //
 //	year: 4-digit year
 //	total_sent_amount: Annual transfer total
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - 5-year rolling window analysis
@@ -2256,7 +2548,7 @@ func (q *Queries) GetYearlyTransferAmountByReceiver(ctx context.Context, arg Get
 	var items []*GetYearlyTransferAmountByReceiverRow
 	for rows.Next() {
 		var i GetYearlyTransferAmountByReceiverRow
-		if err := rows.Scan(&i.Year, &i.TotalReceivedAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalReceivedAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2274,6 +2566,7 @@ const getYearlyTransferAmountBySender = `-- name: GetYearlyTransferAmountBySende
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transfer_time) AS year,
+        t.currency,
         SUM(t.transfer_amount) AS total_sent_amount
     FROM
         transfers t
@@ -2283,15 +2576,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transfer_time) >= $2 - 4
         AND EXTRACT(YEAR FROM t.transfer_time) <= $2
     GROUP BY
-        EXTRACT(YEAR FROM t.transfer_time)
+        EXTRACT(YEAR FROM t.transfer_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_sent_amount
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyTransferAmountBySenderParams struct {
@@ -2301,6 +2597,7 @@ type GetYearlyTransferAmountBySenderParams struct {
 
 type GetYearlyTransferAmountBySenderRow struct {
 	Year            string `json:"year"`
+	Currency        string `json:"currency"`
 	TotalSentAmount int64  `json:"total_sent_amount"`
 }
 
@@ -2315,6 +2612,7 @@ type GetYearlyTransferAmountBySenderRow struct {
 //
 //	year: 4-digit year
 //	total_sent_amount: Annual transfer total
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - 5-year rolling window analysis
@@ -2331,7 +2629,7 @@ func (q *Queries) GetYearlyTransferAmountBySender(ctx context.Context, arg GetYe
 	var items []*GetYearlyTransferAmountBySenderRow
 	for rows.Next() {
 		var i GetYearlyTransferAmountBySenderRow
-		if err := rows.Scan(&i.Year, &i.TotalSentAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalSentAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2349,7 +2647,8 @@ const getYearlyTransferAmountReceiver = `-- name: GetYearlyTransferAmountReceive
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transfer_time) AS year,
-        SUM(t.transfer_amount) AS total_received_amount
+        t.to_currency AS currency,
+        SUM(t.converted_amount) AS total_received_amount
     FROM
         transfers t
     WHERE
@@ -2357,19 +2656,23 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transfer_time) >= $1 - 4
         AND EXTRACT(YEAR FROM t.transfer_time) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM t.transfer_time)
+        EXTRACT(YEAR FROM t.transfer_time),
+        t.to_currency
 )
 SELECT
     year,
+    currency,
     total_received_amount
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyTransferAmountReceiverRow struct {
 	Year                string `json:"year"`
+	Currency            string `json:"currency"`
 	TotalReceivedAmount int64  `json:"total_received_amount"`
 }
 
@@ -2383,6 +2686,7 @@ type GetYearlyTransferAmountReceiverRow struct {
 //
 //	year: The 4-digit year
 //	total_received_amount: Sum of transfers for that year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Covers a 5-year rolling window
@@ -2399,7 +2703,7 @@ func (q *Queries) GetYearlyTransferAmountReceiver(ctx context.Context, dollar_1
 	var items []*GetYearlyTransferAmountReceiverRow
 	for rows.Next() {
 		var i GetYearlyTransferAmountReceiverRow
-		if err := rows.Scan(&i.Year, &i.TotalReceivedAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalReceivedAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2417,6 +2721,7 @@ const getYearlyTransferAmountSender = `-- name: GetYearlyTransferAmountSender :m
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transfer_time) AS year,
+        t.currency,
         SUM(t.transfer_amount) AS total_sent_amount
     FROM
         transfers t
@@ -2425,19 +2730,23 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transfer_time) >= $1 - 4
         AND EXTRACT(YEAR FROM t.transfer_time) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM t.transfer_time)
+        EXTRACT(YEAR FROM t.transfer_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_sent_amount
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyTransferAmountSenderRow struct {
 	Year            string `json:"year"`
+	Currency        string `json:"currency"`
 	TotalSentAmount int64  `json:"total_sent_amount"`
 }
 
@@ -2451,6 +2760,7 @@ type GetYearlyTransferAmountSenderRow struct {
 //
 //	year: The 4-digit year
 //	total_sent_amount: Sum of transfers for that year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Covers a 5-year rolling window
@@ -2467,7 +2777,7 @@ func (q *Queries) GetYearlyTransferAmountSender(ctx context.Context, dollar_1 in
 	var items []*GetYearlyTransferAmountSenderRow
 	for rows.Next() {
 		var i GetYearlyTransferAmountSenderRow
-		if err := rows.Scan(&i.Year, &i.TotalSentAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalSentAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2485,6 +2795,7 @@ const getYearlyWithdrawAmount = `-- name: GetYearlyWithdrawAmount :many
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM w.withdraw_time) AS year,
+        w.currency,
         SUM(w.withdraw_amount) AS total_withdraw_amount
     FROM
         withdraws w
@@ -2496,19 +2807,23 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM w.withdraw_time) >= $1 - 4
         AND EXTRACT(YEAR FROM w.withdraw_time) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM w.withdraw_time)
+        EXTRACT(YEAR FROM w.withdraw_time),
+        w.currency
 )
 SELECT
     year,
+    currency,
     total_withdraw_amount
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyWithdrawAmountRow struct {
 	Year                string `json:"year"`
+	Currency            string `json:"currency"`
 	TotalWithdrawAmount int64  `json:"total_withdraw_amount"`
 }
 
@@ -2522,6 +2837,7 @@ type GetYearlyWithdrawAmountRow struct {
 //
 //	year: The 4-digit year
 //	total_withdraw_amount: Sum of withdraws for that year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Covers a 5-year rolling window
@@ -2538,7 +2854,7 @@ func (q *Queries) GetYearlyWithdrawAmount(ctx context.Context, dollar_1 interfac
 	var items []*GetYearlyWithdrawAmountRow
 	for rows.Next() {
 		var i GetYearlyWithdrawAmountRow
-		if err := rows.Scan(&i.Year, &i.TotalWithdrawAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalWithdrawAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2556,6 +2872,7 @@ const getYearlyWithdrawAmountByCardNumber = `-- name: GetYearlyWithdrawAmountByC
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM w.withdraw_time) AS year,
+        w.currency,
         SUM(w.withdraw_amount) AS total_withdraw_amount
     FROM
         withdraws w
@@ -2568,15 +2885,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM w.withdraw_time) >= $2 - 4
         AND EXTRACT(YEAR FROM w.withdraw_time) <= $2
     GROUP BY
-        EXTRACT(YEAR FROM w.withdraw_time)
+        EXTRACT(YEAR FROM w.withdraw_time),
+        w.currency
 )
 SELECT
     year,
+    currency,
     total_withdraw_amount
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyWithdrawAmountByCardNumberParams struct {
@@ -2586,6 +2906,7 @@ type GetYearlyWithdrawAmountByCardNumberParams struct {
 
 type GetYearlyWithdrawAmountByCardNumberRow struct {
 	Year                string `json:"year"`
+	Currency            string `json:"currency"`
 	TotalWithdrawAmount int64  `json:"total_withdraw_amount"`
 }
 
@@ -2600,6 +2921,7 @@ type GetYearlyWithdrawAmountByCardNumberRow struct {
 //
 //	year: 4-digit year
 //	total_withdraw_amount: Annual withdraw total
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - 5-year rolling window analysis
@@ -2616,7 +2938,7 @@ func (q *Queries) GetYearlyWithdrawAmountByCardNumber(ctx context.Context, arg G
 	var items []*GetYearlyWithdrawAmountByCardNumberRow
 	for rows.Next() {
 		var i GetYearlyWithdrawAmountByCardNumberRow
-		if err := rows.Scan(&i.Year, &i.TotalWithdrawAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalWithdrawAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2656,7 +2978,7 @@ SET
 WHERE
     card_id = $1
     AND deleted_at IS NOT NULL
-RETURNING card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at
+RETURNING card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at, currency
 `
 
 // RestoreCard: Restores a previously trashed card
@@ -2683,6 +3005,7 @@ func (q *Queries) RestoreCard(ctx context.Context, cardID int32) (*Card, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
 	)
 	return &i, err
 }
@@ -2694,7 +3017,7 @@ SET
 WHERE
     card_id = $1
     AND deleted_at IS NULL
-RETURNING card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at
+RETURNING card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at, currency
 `
 
 // TrashCard: Soft-deletes a card by marking deleted_at
@@ -2721,6 +3044,7 @@ func (q *Queries) TrashCard(ctx context.Context, cardID int32) (*Card, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
 	)
 	return &i, err
 }
@@ -2736,7 +3060,7 @@ SET
 WHERE
     card_id = $1
     AND deleted_at IS NULL
-RETURNING card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at
+RETURNING card_id, user_id, card_number, card_type, expire_date, cvv, card_provider, created_at, updated_at, deleted_at, currency
 `
 
 type UpdateCardParams struct {
@@ -2781,6 +3105,7 @@ func (q *Queries) UpdateCard(ctx context.Context, arg UpdateCardParams) (*Card,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
 	)
 	return &i, err
 }
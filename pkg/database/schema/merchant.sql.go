@@ -17,7 +17,6 @@ const createMerchant = `-- name: CreateMerchant :one
 INSERT INTO
     merchants (
         name,
-        api_key,
         user_id,
         status,
         created_at,
@@ -27,15 +26,13 @@ VALUES (
         $1,
         $2,
         $3,
-        $4,
         current_timestamp,
         current_timestamp
-    ) RETURNING merchant_id, merchant_no, name, api_key, user_id, status, created_at, updated_at, deleted_at
+    ) RETURNING merchant_id, merchant_no, name, user_id, status, created_at, updated_at, deleted_at
 `
 
 type CreateMerchantParams struct {
 	Name   string `json:"name"`
-	ApiKey string `json:"api_key"`
 	UserID int32  `json:"user_id"`
 	Status string `json:"status"`
 }
@@ -45,9 +42,8 @@ type CreateMerchantParams struct {
 // Parameters:
 //
 //	$1: name - The name of the merchant
-//	$2: api_key - Unique API key for the merchant
-//	$3: user_id - ID of the user associated with the merchant
-//	$4: status - Current status of the merchant (e.g., active, inactive)
+//	$2: user_id - ID of the user associated with the merchant
+//	$3: status - Current status of the merchant (e.g., active, inactive)
 //
 // Returns:
 //   - The newly created merchant record
@@ -59,7 +55,6 @@ type CreateMerchantParams struct {
 func (q *Queries) CreateMerchant(ctx context.Context, arg CreateMerchantParams) (*Merchant, error) {
 	row := q.db.QueryRowContext(ctx, createMerchant,
 		arg.Name,
-		arg.ApiKey,
 		arg.UserID,
 		arg.Status,
 	)
@@ -68,7 +63,6 @@ func (q *Queries) CreateMerchant(ctx context.Context, arg CreateMerchantParams)
 		&i.MerchantID,
 		&i.MerchantNo,
 		&i.Name,
-		&i.ApiKey,
 		&i.UserID,
 		&i.Status,
 		&i.CreatedAt,
@@ -217,119 +211,6 @@ func (q *Queries) FindAllTransactions(ctx context.Context, arg FindAllTransactio
 	return items, nil
 }
 
-const findAllTransactionsByApikey = `-- name: FindAllTransactionsByApikey :many
-SELECT
-    t.transaction_id,
-    t.card_number,
-    t.amount,
-    t.payment_method,
-    t.merchant_id,
-    m.name AS merchant_name,
-    t.transaction_time,
-    t.created_at,
-    t.updated_at,
-    t.deleted_at,
-    COUNT(*) OVER() AS total_count
-FROM
-    transactions t
-JOIN
-    merchants m ON t.merchant_id = m.merchant_id
-WHERE
-    t.deleted_at IS NULL
-    AND m.api_key = $1
-    AND ($2::TEXT IS NULL OR t.card_number ILIKE '%' || $2 || '%' OR t.payment_method ILIKE '%' || $2 || '%')
-ORDER BY
-    t.transaction_time DESC
-LIMIT $3 OFFSET $4
-`
-
-type FindAllTransactionsByApikeyParams struct {
-	ApiKey  string `json:"api_key"`
-	Column2 string `json:"column_2"`
-	Limit   int32  `json:"limit"`
-	Offset  int32  `json:"offset"`
-}
-
-type FindAllTransactionsByApikeyRow struct {
-	TransactionID   int32        `json:"transaction_id"`
-	CardNumber      string       `json:"card_number"`
-	Amount          int32        `json:"amount"`
-	PaymentMethod   string       `json:"payment_method"`
-	MerchantID      int32        `json:"merchant_id"`
-	MerchantName    string       `json:"merchant_name"`
-	TransactionTime time.Time    `json:"transaction_time"`
-	CreatedAt       sql.NullTime `json:"created_at"`
-	UpdatedAt       sql.NullTime `json:"updated_at"`
-	DeletedAt       sql.NullTime `json:"deleted_at"`
-	TotalCount      int64        `json:"total_count"`
-}
-
-// FindAllTransactions: Retrieves a paginated list of active transactions with optional search
-// Purpose: Display transaction list with merchant info, filtered by card number or payment method
-// Parameters:
-//
-//	$1: api-key - The merchant to filter transactions
-//	$2: search_query (TEXT, nullable) - Optional search string to match card_number or payment_method
-//	$3: limit (INTEGER) - Maximum number of records to return (pagination)
-//	$4: offset (INTEGER) - Number of records to skip (pagination)
-//
-// Returns:
-//   - transaction_id
-//   - card_number
-//   - amount
-//   - payment_method
-//   - merchant_id
-//   - merchant_name (from join with merchants table)
-//   - transaction_time
-//   - created_at, updated_at, deleted_at (for audit purposes)
-//   - total_count: Total number of records matching the filter (useful for pagination metadata)
-//
-// Business Logic:
-//   - Joins `transactions` with `merchants` to retrieve merchant name
-//   - Filters out soft-deleted transactions (where deleted_at IS NOT NULL)
-//   - Applies case-insensitive partial match on card_number or payment_method if search query is provided
-//   - Uses `COUNT(*) OVER()` to include total matching count for pagination without a separate query
-//   - Results are ordered by `transaction_time` descending
-func (q *Queries) FindAllTransactionsByApikey(ctx context.Context, arg FindAllTransactionsByApikeyParams) ([]*FindAllTransactionsByApikeyRow, error) {
-	rows, err := q.db.QueryContext(ctx, findAllTransactionsByApikey,
-		arg.ApiKey,
-		arg.Column2,
-		arg.Limit,
-		arg.Offset,
-	)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []*FindAllTransactionsByApikeyRow
-	for rows.Next() {
-		var i FindAllTransactionsByApikeyRow
-		if err := rows.Scan(
-			&i.TransactionID,
-			&i.CardNumber,
-			&i.Amount,
-			&i.PaymentMethod,
-			&i.MerchantID,
-			&i.MerchantName,
-			&i.TransactionTime,
-			&i.CreatedAt,
-			&i.UpdatedAt,
-			&i.DeletedAt,
-			&i.TotalCount,
-		); err != nil {
-			return nil, err
-		}
-		items = append(items, &i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const findAllTransactionsByMerchant = `-- name: FindAllTransactionsByMerchant :many
 SELECT
     t.transaction_id,
@@ -445,11 +326,11 @@ func (q *Queries) FindAllTransactionsByMerchant(ctx context.Context, arg FindAll
 
 const getActiveMerchants = `-- name: GetActiveMerchants :many
 SELECT
-    merchant_id, merchant_no, name, api_key, user_id, status, created_at, updated_at, deleted_at,
+    merchant_id, merchant_no, name, user_id, status, created_at, updated_at, deleted_at,
     COUNT(*) OVER() AS total_count
 FROM merchants
 WHERE deleted_at IS NULL
-    AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR api_key ILIKE '%' || $1 || '%' OR status ILIKE '%' || $1 || '%')
+    AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR status ILIKE '%' || $1 || '%')
 ORDER BY merchant_id
 LIMIT $2 OFFSET $3
 `
@@ -464,7 +345,6 @@ type GetActiveMerchantsRow struct {
 	MerchantID int32        `json:"merchant_id"`
 	MerchantNo uuid.UUID    `json:"merchant_no"`
 	Name       string       `json:"name"`
-	ApiKey     string       `json:"api_key"`
 	UserID     int32        `json:"user_id"`
 	Status     string       `json:"status"`
 	CreatedAt  sql.NullTime `json:"created_at"`
@@ -477,7 +357,7 @@ type GetActiveMerchantsRow struct {
 // Purpose: List currently active merchants (same as GetMerchants)
 // Parameters:
 //
-//	$1: search_term - Optional text to filter by name, api_key, or status (NULL for no filter)
+//	$1: search_term - Optional text to filter by name or status (NULL for no filter)
 //	$2: limit - Maximum number of records to return
 //	$3: offset - Number of records to skip for pagination
 //
@@ -487,7 +367,7 @@ type GetActiveMerchantsRow struct {
 //
 // Business Logic:
 //   - Excludes soft-deleted merchants (deleted_at IS NULL)
-//   - Supports case-insensitive partial matching on name, api_key, and status
+//   - Supports case-insensitive partial matching on name and status
 //   - Returns results ordered by merchant_id
 //   - Provides total_count for pagination calculations
 func (q *Queries) GetActiveMerchants(ctx context.Context, arg GetActiveMerchantsParams) ([]*GetActiveMerchantsRow, error) {
@@ -503,7 +383,6 @@ func (q *Queries) GetActiveMerchants(ctx context.Context, arg GetActiveMerchants
 			&i.MerchantID,
 			&i.MerchantNo,
 			&i.Name,
-			&i.ApiKey,
 			&i.UserID,
 			&i.Status,
 			&i.CreatedAt,
@@ -524,41 +403,8 @@ func (q *Queries) GetActiveMerchants(ctx context.Context, arg GetActiveMerchants
 	return items, nil
 }
 
-const getMerchantByApiKey = `-- name: GetMerchantByApiKey :one
-SELECT merchant_id, merchant_no, name, api_key, user_id, status, created_at, updated_at, deleted_at FROM merchants WHERE api_key = $1 AND deleted_at IS NULL
-`
-
-// GetMerchantByApiKey: Retrieves a merchant by its API key
-// Purpose: Authenticate or lookup a merchant using its API key
-// Parameters:
-//
-//	$1: api_key - API key of the merchant
-//
-// Returns:
-//
-//	Complete merchant record
-//
-// Business Logic:
-//   - Excludes soft-deleted merchants (deleted_at IS NULL)
-func (q *Queries) GetMerchantByApiKey(ctx context.Context, apiKey string) (*Merchant, error) {
-	row := q.db.QueryRowContext(ctx, getMerchantByApiKey, apiKey)
-	var i Merchant
-	err := row.Scan(
-		&i.MerchantID,
-		&i.MerchantNo,
-		&i.Name,
-		&i.ApiKey,
-		&i.UserID,
-		&i.Status,
-		&i.CreatedAt,
-		&i.UpdatedAt,
-		&i.DeletedAt,
-	)
-	return &i, err
-}
-
 const getMerchantByID = `-- name: GetMerchantByID :one
-SELECT merchant_id, merchant_no, name, api_key, user_id, status, created_at, updated_at, deleted_at
+SELECT merchant_id, merchant_no, name, user_id, status, created_at, updated_at, deleted_at
 FROM merchants
 WHERE
     merchant_id = $1
@@ -584,7 +430,6 @@ func (q *Queries) GetMerchantByID(ctx context.Context, merchantID int32) (*Merch
 		&i.MerchantID,
 		&i.MerchantNo,
 		&i.Name,
-		&i.ApiKey,
 		&i.UserID,
 		&i.Status,
 		&i.CreatedAt,
@@ -595,7 +440,7 @@ func (q *Queries) GetMerchantByID(ctx context.Context, merchantID int32) (*Merch
 }
 
 const getMerchantByName = `-- name: GetMerchantByName :one
-SELECT merchant_id, merchant_no, name, api_key, user_id, status, created_at, updated_at, deleted_at FROM merchants WHERE name = $1 AND deleted_at IS NULL
+SELECT merchant_id, merchant_no, name, user_id, status, created_at, updated_at, deleted_at FROM merchants WHERE name = $1 AND deleted_at IS NULL
 `
 
 // GetMerchantByName: Retrieves a merchant by its name
@@ -617,7 +462,6 @@ func (q *Queries) GetMerchantByName(ctx context.Context, name string) (*Merchant
 		&i.MerchantID,
 		&i.MerchantNo,
 		&i.Name,
-		&i.ApiKey,
 		&i.UserID,
 		&i.Status,
 		&i.CreatedAt,
@@ -629,11 +473,11 @@ func (q *Queries) GetMerchantByName(ctx context.Context, name string) (*Merchant
 
 const getMerchants = `-- name: GetMerchants :many
 SELECT
-    merchant_id, merchant_no, name, api_key, user_id, status, created_at, updated_at, deleted_at,
+    merchant_id, merchant_no, name, user_id, status, created_at, updated_at, deleted_at,
     COUNT(*) OVER() AS total_count
 FROM merchants
 WHERE deleted_at IS NULL
-    AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR api_key ILIKE '%' || $1 || '%' OR status ILIKE '%' || $1 || '%')
+    AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR status ILIKE '%' || $1 || '%')
 ORDER BY merchant_id
 LIMIT $2 OFFSET $3
 `
@@ -648,7 +492,6 @@ type GetMerchantsRow struct {
 	MerchantID int32        `json:"merchant_id"`
 	MerchantNo uuid.UUID    `json:"merchant_no"`
 	Name       string       `json:"name"`
-	ApiKey     string       `json:"api_key"`
 	UserID     int32        `json:"user_id"`
 	Status     string       `json:"status"`
 	CreatedAt  sql.NullTime `json:"created_at"`
@@ -661,7 +504,7 @@ type GetMerchantsRow struct {
 // Purpose: Display all active (non-trashed) merchants in admin interface
 // Parameters:
 //
-//	$1: search_term - Optional text to filter by name, api_key, or status (NULL for no filter)
+//	$1: search_term - Optional text to filter by name or status (NULL for no filter)
 //	$2: limit - Maximum number of records to return
 //	$3: offset - Number of records to skip for pagination
 //
@@ -671,7 +514,7 @@ type GetMerchantsRow struct {
 //
 // Business Logic:
 //   - Excludes soft-deleted merchants (deleted_at IS NULL)
-//   - Supports partial text search on name, api_key, and status (case-insensitive)
+//   - Supports partial text search on name and status (case-insensitive)
 //   - Returns results ordered by merchant_id
 //   - Provides total_count for pagination calculations
 func (q *Queries) GetMerchants(ctx context.Context, arg GetMerchantsParams) ([]*GetMerchantsRow, error) {
@@ -687,7 +530,6 @@ func (q *Queries) GetMerchants(ctx context.Context, arg GetMerchantsParams) ([]*
 			&i.MerchantID,
 			&i.MerchantNo,
 			&i.Name,
-			&i.ApiKey,
 			&i.UserID,
 			&i.Status,
 			&i.CreatedAt,
@@ -709,7 +551,7 @@ func (q *Queries) GetMerchants(ctx context.Context, arg GetMerchantsParams) ([]*
 }
 
 const getMerchantsByUserID = `-- name: GetMerchantsByUserID :many
-SELECT merchant_id, merchant_no, name, api_key, user_id, status, created_at, updated_at, deleted_at FROM merchants WHERE user_id = $1 AND deleted_at IS NULL
+SELECT merchant_id, merchant_no, name, user_id, status, created_at, updated_at, deleted_at FROM merchants WHERE user_id = $1 AND deleted_at IS NULL
 `
 
 // GetMerchantsByUserID: Retrieves all merchants associated with a user
@@ -737,7 +579,6 @@ func (q *Queries) GetMerchantsByUserID(ctx context.Context, userID int32) ([]*Me
 			&i.MerchantID,
 			&i.MerchantNo,
 			&i.Name,
-			&i.ApiKey,
 			&i.UserID,
 			&i.Status,
 			&i.CreatedAt,
@@ -757,84 +598,6 @@ func (q *Queries) GetMerchantsByUserID(ctx context.Context, userID int32) ([]*Me
 	return items, nil
 }
 
-const getMonthlyAmountByApikey = `-- name: GetMonthlyAmountByApikey :many
-WITH months AS (
-    SELECT generate_series(
-        date_trunc('year', $1::timestamp),
-        date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
-        interval '1 month'
-    ) AS month
-)
-SELECT
-    TO_CHAR(m.month, 'Mon') AS month,
-    COALESCE(SUM(t.amount), 0)::int AS total_amount
-FROM
-    months m
-LEFT JOIN
-    transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
-    AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
-    AND t.deleted_at IS NULL
-LEFT JOIN
-    merchants mch ON t.merchant_id = mch.merchant_id
-    AND mch.deleted_at IS NULL
-    AND mch.api_key = $2
-GROUP BY
-    m.month
-ORDER BY
-    m.month
-`
-
-type GetMonthlyAmountByApikeyParams struct {
-	Column1 time.Time `json:"column_1"`
-	ApiKey  string    `json:"api_key"`
-}
-
-type GetMonthlyAmountByApikeyRow struct {
-	Month       string `json:"month"`
-	TotalAmount int32  `json:"total_amount"`
-}
-
-// GetMonthlyAmountByApikey: Retrieves total transaction amount per month for a specific merchant and year
-// Purpose: Generate monthly income report for a specific merchant regardless of payment method
-// Parameters:
-//
-//	$1: reference_date - Any date within the target year
-//	$2: api-key - The merchant to filter transactions
-//
-// Returns:
-//   - Month name (e.g., Jan, Feb)
-//   - Total transaction amount (0 if no activity)
-//
-// Business Logic:
-//   - Generates complete 12-month series for the given year
-//   - Filters only active (non-deleted) transactions and merchants
-//   - Filters by specific merchant_id
-//   - Uses LEFT JOIN to ensure each month is represented
-//   - Uses COALESCE to return 0 if a month has no transaction data
-//   - Results are ordered chronologically by month
-func (q *Queries) GetMonthlyAmountByApikey(ctx context.Context, arg GetMonthlyAmountByApikeyParams) ([]*GetMonthlyAmountByApikeyRow, error) {
-	rows, err := q.db.QueryContext(ctx, getMonthlyAmountByApikey, arg.Column1, arg.ApiKey)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []*GetMonthlyAmountByApikeyRow
-	for rows.Next() {
-		var i GetMonthlyAmountByApikeyRow
-		if err := rows.Scan(&i.Month, &i.TotalAmount); err != nil {
-			return nil, err
-		}
-		items = append(items, &i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const getMonthlyAmountByMerchants = `-- name: GetMonthlyAmountByMerchants :many
 WITH months AS (
     SELECT generate_series(
@@ -842,14 +605,21 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
-    COALESCE(SUM(t.amount), 0)::int AS total_amount
+    cur.currency,
+    COALESCE(SUM(t.amount - t.refunded_amount), 0)::int AS total_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
     AND t.merchant_id = $2
@@ -857,9 +627,11 @@ LEFT JOIN
     merchants mch ON t.merchant_id = mch.merchant_id
     AND mch.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyAmountByMerchantsParams struct {
@@ -869,6 +641,7 @@ type GetMonthlyAmountByMerchantsParams struct {
 
 type GetMonthlyAmountByMerchantsRow struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -880,10 +653,13 @@ type GetMonthlyAmountByMerchantsRow struct {
 //	$2: merchant_id - The merchant to filter transactions
 //
 // Returns:
-//   - Month name (e.g., Jan, Feb)
-//   - Total transaction amount (0 if no activity)
+//
+//	currency: Currency the totals are expressed in
+//	- Month name (e.g., Jan, Feb)
+//	- Total transaction amount (0 if no activity)
 //
 // Business Logic:
+//   - Amounts are net of refunds (amount - refunded_amount)
 //   - Generates complete 12-month series for the given year
 //   - Filters only active (non-deleted) transactions and merchants
 //   - Filters by specific merchant_id
@@ -899,7 +675,7 @@ func (q *Queries) GetMonthlyAmountByMerchants(ctx context.Context, arg GetMonthl
 	var items []*GetMonthlyAmountByMerchantsRow
 	for rows.Next() {
 		var i GetMonthlyAmountByMerchantsRow
-		if err := rows.Scan(&i.Month, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -920,27 +696,37 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
-    COALESCE(SUM(t.amount), 0)::int AS total_amount
+    cur.currency,
+    COALESCE(SUM(t.amount - t.refunded_amount), 0)::int AS total_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 LEFT JOIN
     merchants mch ON t.merchant_id = mch.merchant_id
     AND mch.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyAmountMerchantRow struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -951,10 +737,13 @@ type GetMonthlyAmountMerchantRow struct {
 //	$1: reference_date - Any date within the target year
 //
 // Returns:
-//   - Month name (e.g., Jan, Feb)
-//   - Total transaction amount (0 if no activity)
+//
+//	currency: Currency the totals are expressed in
+//	- Month name (e.g., Jan, Feb)
+//	- Total transaction amount (0 if no activity)
 //
 // Business Logic:
+//   - Amounts are net of refunds (amount - refunded_amount)
 //   - Generates complete 12-month series
 //   - Includes only active (non-deleted) transactions and merchants
 //   - Uses LEFT JOIN to ensure each month is represented
@@ -969,7 +758,7 @@ func (q *Queries) GetMonthlyAmountMerchant(ctx context.Context, dollar_1 time.Ti
 	var items []*GetMonthlyAmountMerchantRow
 	for rows.Next() {
 		var i GetMonthlyAmountMerchantRow
-		if err := rows.Scan(&i.Month, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -983,7 +772,7 @@ func (q *Queries) GetMonthlyAmountMerchant(ctx context.Context, dollar_1 time.Ti
 	return items, nil
 }
 
-const getMonthlyPaymentMethodByApikey = `-- name: GetMonthlyPaymentMethodByApikey :many
+const getMonthlyDisputeRateByMerchants = `-- name: GetMonthlyDisputeRateByMerchants :many
 WITH months AS (
     SELECT generate_series(
         date_trunc('year', $1::timestamp),
@@ -991,76 +780,97 @@ WITH months AS (
         interval '1 month'
     ) AS month
 ),
-payment_methods AS (
-    SELECT DISTINCT payment_method
-    FROM transactions
-    WHERE deleted_at IS NULL
+monthly_transactions AS (
+    SELECT
+        date_trunc('month', t.transaction_time) AS month,
+        COUNT(*) AS total_transactions
+    FROM
+        transactions t
+    WHERE
+        t.deleted_at IS NULL
+        AND t.merchant_id = $2
+        AND t.status IN ('success', 'partially_refunded', 'refunded', 'disputed', 'charged_back')
+        AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM $1::timestamp)
+    GROUP BY
+        date_trunc('month', t.transaction_time)
+),
+monthly_disputes AS (
+    SELECT
+        date_trunc('month', d.created_at) AS month,
+        COUNT(*) AS total_disputes
+    FROM
+        disputes d
+    WHERE
+        d.merchant_id = $2
+        AND EXTRACT(YEAR FROM d.created_at) = EXTRACT(YEAR FROM $1::timestamp)
+    GROUP BY
+        date_trunc('month', d.created_at)
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
-    pm.payment_method,
-    COALESCE(SUM(t.amount), 0)::int AS total_amount
+    COALESCE(mt.total_transactions, 0)::int AS total_transactions,
+    COALESCE(md.total_disputes, 0)::int AS total_disputes,
+    (CASE
+        WHEN COALESCE(mt.total_transactions, 0) = 0 THEN 0
+        ELSE ROUND(COALESCE(md.total_disputes, 0)::numeric * 100 / mt.total_transactions, 2)
+    END)::float8 AS dispute_rate
 FROM
     months m
-CROSS JOIN
-    payment_methods pm
 LEFT JOIN
-    transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
-    AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
-    AND t.payment_method = pm.payment_method
-    AND t.deleted_at IS NULL
+    monthly_transactions mt ON mt.month = m.month
 LEFT JOIN
-    merchants mch ON t.merchant_id = mch.merchant_id
-    AND mch.deleted_at IS NULL
-    AND mch.api_key = $2
-GROUP BY
-    m.month,
-    pm.payment_method
+    monthly_disputes md ON md.month = m.month
 ORDER BY
-    m.month,
-    pm.payment_method
+    m.month
 `
 
-type GetMonthlyPaymentMethodByApikeyParams struct {
-	Column1 time.Time `json:"column_1"`
-	ApiKey  string    `json:"api_key"`
+type GetMonthlyDisputeRateByMerchantsParams struct {
+	Column1    time.Time `json:"column_1"`
+	MerchantID int32     `json:"merchant_id"`
 }
 
-type GetMonthlyPaymentMethodByApikeyRow struct {
-	Month         string `json:"month"`
-	PaymentMethod string `json:"payment_method"`
-	TotalAmount   int32  `json:"total_amount"`
+type GetMonthlyDisputeRateByMerchantsRow struct {
+	Month             string  `json:"month"`
+	TotalTransactions int32   `json:"total_transactions"`
+	TotalDisputes     int32   `json:"total_disputes"`
+	DisputeRate       float64 `json:"dispute_rate"`
 }
 
-// GetMonthlyPaymentMethodByApikey: Retrieves total transaction amount per payment method per month for a specific merchant
-// Purpose: Analyze monthly transaction totals by payment method for a specific merchant and year
+// GetMonthlyDisputeRateByMerchants: Retrieves the dispute rate per month for a specific merchant and year
+// Purpose: Monitor how often cardholders contest a merchant's charges
 // Parameters:
 //
 //	$1: reference_date - Any date within the target year
-//	$2: api-key - The merchant to filter transactions
+//	$2: merchant_id - The merchant to filter transactions and disputes
 //
 // Returns:
 //   - Month name (e.g., Jan, Feb)
-//   - Payment method
-//   - Total transaction amount for each combination
+//   - Number of completed transactions in the month
+//   - Number of disputes opened in the month
+//   - Dispute rate as a percentage of completed transactions (0 if no transactions)
 //
 // Business Logic:
-//   - Generates a complete 12-month series for the given year
-//   - Filters only active (non-deleted) transactions and merchants
-//   - Filters by specific merchant_id
-//   - Uses LEFT JOIN and CROSS JOIN to ensure all months and payment methods are included
-//   - Uses COALESCE to return 0 for combinations with no data
+//   - Completed transactions are those that reached 'success', including ones
+//     later refunded, disputed or charged back
+//   - Disputes are bucketed by the month they were opened
+//   - Generates complete 12-month series for the given year
+//   - Filters only active (non-deleted) transactions
 //   - Results are ordered chronologically by month
-func (q *Queries) GetMonthlyPaymentMethodByApikey(ctx context.Context, arg GetMonthlyPaymentMethodByApikeyParams) ([]*GetMonthlyPaymentMethodByApikeyRow, error) {
-	rows, err := q.db.QueryContext(ctx, getMonthlyPaymentMethodByApikey, arg.Column1, arg.ApiKey)
+func (q *Queries) GetMonthlyDisputeRateByMerchants(ctx context.Context, arg GetMonthlyDisputeRateByMerchantsParams) ([]*GetMonthlyDisputeRateByMerchantsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getMonthlyDisputeRateByMerchants, arg.Column1, arg.MerchantID)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*GetMonthlyPaymentMethodByApikeyRow
+	var items []*GetMonthlyDisputeRateByMerchantsRow
 	for rows.Next() {
-		var i GetMonthlyPaymentMethodByApikeyRow
-		if err := rows.Scan(&i.Month, &i.PaymentMethod, &i.TotalAmount); err != nil {
+		var i GetMonthlyDisputeRateByMerchantsRow
+		if err := rows.Scan(
+			&i.Month,
+			&i.TotalTransactions,
+			&i.TotalDisputes,
+			&i.DisputeRate,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1090,7 +900,7 @@ payment_methods AS (
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
     pm.payment_method,
-    COALESCE(SUM(t.amount), 0)::int AS total_amount
+    COALESCE(SUM(t.amount - t.refunded_amount), 0)::int AS total_amount
 FROM
     months m
 CROSS JOIN
@@ -1136,6 +946,7 @@ type GetMonthlyPaymentMethodByMerchantsRow struct {
 //   - Total transaction amount for each combination
 //
 // Business Logic:
+//   - Amounts are net of refunds (amount - refunded_amount)
 //   - Generates a complete 12-month series for the given year
 //   - Filters only active (non-deleted) transactions and merchants
 //   - Filters by specific merchant_id
@@ -1176,166 +987,67 @@ WITH months AS (
 payment_methods AS (
     SELECT DISTINCT payment_method
     FROM transactions
-    WHERE deleted_at IS NULL
-)
-SELECT
-    TO_CHAR(m.month, 'Mon') AS month,
-    pm.payment_method,
-    COALESCE(SUM(t.amount), 0)::int AS total_amount
-FROM
-    months m
-CROSS JOIN
-    payment_methods pm
-LEFT JOIN
-    transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
-    AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
-    AND t.payment_method = pm.payment_method
-    AND t.deleted_at IS NULL
-LEFT JOIN
-    merchants mch ON t.merchant_id = mch.merchant_id
-    AND mch.deleted_at IS NULL
-GROUP BY
-    m.month,
-    pm.payment_method
-ORDER BY
-    m.month,
-    pm.payment_method
-`
-
-type GetMonthlyPaymentMethodsMerchantRow struct {
-	Month         string `json:"month"`
-	PaymentMethod string `json:"payment_method"`
-	TotalAmount   int32  `json:"total_amount"`
-}
-
-// GetMonthlyPaymentMethodsMerchant: Retrieves monthly transaction totals per payment method
-// Purpose: Analyze monthly transaction distribution across payment methods for a given year
-// Parameters:
-//
-//	$1: reference_date - Any date within the target year
-//
-// Returns:
-//   - Month name (e.g., Jan, Feb)
-//   - Payment method
-//   - Total transaction amount (0 if no activity)
-//
-// Business Logic:
-//   - Generates complete 12-month series from the reference year
-//   - Cross joins with distinct active payment methods
-//   - Filters only active (non-deleted) transactions and merchants
-//   - Uses LEFT JOIN to ensure all month-method combinations are included
-//   - Uses COALESCE to display 0 for months with no transactions
-//   - Ordered by month and payment method
-func (q *Queries) GetMonthlyPaymentMethodsMerchant(ctx context.Context, dollar_1 time.Time) ([]*GetMonthlyPaymentMethodsMerchantRow, error) {
-	rows, err := q.db.QueryContext(ctx, getMonthlyPaymentMethodsMerchant, dollar_1)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []*GetMonthlyPaymentMethodsMerchantRow
-	for rows.Next() {
-		var i GetMonthlyPaymentMethodsMerchantRow
-		if err := rows.Scan(&i.Month, &i.PaymentMethod, &i.TotalAmount); err != nil {
-			return nil, err
-		}
-		items = append(items, &i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
-const getMonthlyTotalAmountByApikey = `-- name: GetMonthlyTotalAmountByApikey :many
-WITH monthly_data AS (
-    SELECT
-        EXTRACT(YEAR FROM t.transaction_time)::integer AS year,
-        EXTRACT(MONTH FROM t.transaction_time)::integer AS month,
-        COALESCE(SUM(t.amount), 0)::integer AS total_amount
-    FROM
-        transactions t
-    INNER JOIN
-        merchants m ON t.merchant_id = m.merchant_id
-    WHERE
-        t.deleted_at IS NULL
-        AND m.deleted_at IS NULL
-        AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM $1::timestamp)
-        AND m.api_key = $2
-    GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time),
-        EXTRACT(MONTH FROM t.transaction_time)
-), formatted_data AS (
-    SELECT
-        year::text,
-        TO_CHAR(TO_DATE(month::text, 'MM'), 'Mon') AS month,
-        total_amount
-    FROM
-        monthly_data
-    UNION ALL
-
-    SELECT
-        EXTRACT(YEAR FROM gs.month)::text AS year,
-        TO_CHAR(gs.month, 'Mon') AS month,
-        0::integer AS total_amount
-    FROM generate_series(
-        date_trunc('year', $1::timestamp),
-        date_trunc('year', $1::timestamp) + interval '11 month',
-        interval '1 month'
-    ) AS gs(month)
-    WHERE NOT EXISTS (
-        SELECT 1 FROM monthly_data md
-        WHERE md.year = EXTRACT(YEAR FROM gs.month)::integer
-        AND md.month = EXTRACT(MONTH FROM gs.month)::integer
-    )
+    WHERE deleted_at IS NULL
 )
-SELECT year, month, total_amount FROM formatted_data
+SELECT
+    TO_CHAR(m.month, 'Mon') AS month,
+    pm.payment_method,
+    COALESCE(SUM(t.amount - t.refunded_amount), 0)::int AS total_amount
+FROM
+    months m
+CROSS JOIN
+    payment_methods pm
+LEFT JOIN
+    transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
+    AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
+    AND t.payment_method = pm.payment_method
+    AND t.deleted_at IS NULL
+LEFT JOIN
+    merchants mch ON t.merchant_id = mch.merchant_id
+    AND mch.deleted_at IS NULL
+GROUP BY
+    m.month,
+    pm.payment_method
 ORDER BY
-    year DESC,
-    TO_DATE(month, 'Mon') DESC
+    m.month,
+    pm.payment_method
 `
 
-type GetMonthlyTotalAmountByApikeyParams struct {
-	Column1 time.Time `json:"column_1"`
-	ApiKey  string    `json:"api_key"`
-}
-
-type GetMonthlyTotalAmountByApikeyRow struct {
-	Year        string `json:"year"`
-	Month       string `json:"month"`
-	TotalAmount int32  `json:"total_amount"`
+type GetMonthlyPaymentMethodsMerchantRow struct {
+	Month         string `json:"month"`
+	PaymentMethod string `json:"payment_method"`
+	TotalAmount   int32  `json:"total_amount"`
 }
 
-// GetMonthlyTotalAmountByApikey: Retrieves total transaction amounts for the current and previous month
-// Purpose: Provide monthly transaction summary including zero values if no transactions exist
+// GetMonthlyPaymentMethodsMerchant: Retrieves monthly transaction totals per payment method
+// Purpose: Analyze monthly transaction distribution across payment methods for a given year
 // Parameters:
 //
-//	$1: reference_date - Any date within the target (current) month
-//	$2: api-key - The merchant to filter transactions
+//	$1: reference_date - Any date within the target year
 //
 // Returns:
-//   - Year (as text)
-//   - Month (abbreviated name, e.g., Jan, Feb)
-//   - Total transaction amount for each month
+//   - Month name (e.g., Jan, Feb)
+//   - Payment method
+//   - Total transaction amount (0 if no activity)
 //
 // Business Logic:
-//   - Aggregates total transaction amounts for the target month and the month before
+//   - Amounts are net of refunds (amount - refunded_amount)
+//   - Generates complete 12-month series from the reference year
+//   - Cross joins with distinct active payment methods
 //   - Filters only active (non-deleted) transactions and merchants
-//   - Includes 0 as total_amount if there's no transaction data for either month
-//   - Uses UNION ALL to combine real data with "missing month" placeholders
-//   - Results are sorted by year and month (most recent first)
-func (q *Queries) GetMonthlyTotalAmountByApikey(ctx context.Context, arg GetMonthlyTotalAmountByApikeyParams) ([]*GetMonthlyTotalAmountByApikeyRow, error) {
-	rows, err := q.db.QueryContext(ctx, getMonthlyTotalAmountByApikey, arg.Column1, arg.ApiKey)
+//   - Uses LEFT JOIN to ensure all month-method combinations are included
+//   - Uses COALESCE to display 0 for months with no transactions
+//   - Ordered by month and payment method
+func (q *Queries) GetMonthlyPaymentMethodsMerchant(ctx context.Context, dollar_1 time.Time) ([]*GetMonthlyPaymentMethodsMerchantRow, error) {
+	rows, err := q.db.QueryContext(ctx, getMonthlyPaymentMethodsMerchant, dollar_1)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*GetMonthlyTotalAmountByApikeyRow
+	var items []*GetMonthlyPaymentMethodsMerchantRow
 	for rows.Next() {
-		var i GetMonthlyTotalAmountByApikeyRow
-		if err := rows.Scan(&i.Year, &i.Month, &i.TotalAmount); err != nil {
+		var i GetMonthlyPaymentMethodsMerchantRow
+		if err := rows.Scan(&i.Month, &i.PaymentMethod, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1350,11 +1062,14 @@ func (q *Queries) GetMonthlyTotalAmountByApikey(ctx context.Context, arg GetMont
 }
 
 const getMonthlyTotalAmountByMerchant = `-- name: GetMonthlyTotalAmountByMerchant :many
-WITH monthly_data AS (
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+), monthly_data AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time)::integer AS year,
         EXTRACT(MONTH FROM t.transaction_time)::integer AS month,
-        COALESCE(SUM(t.amount), 0)::integer AS total_amount
+        t.currency,
+        COALESCE(SUM(t.amount - t.refunded_amount), 0)::integer AS total_amount
     FROM
         transactions t
     INNER JOIN
@@ -1366,11 +1081,13 @@ WITH monthly_data AS (
         AND t.merchant_id = $2::integer
     GROUP BY
         EXTRACT(YEAR FROM t.transaction_time),
-        EXTRACT(MONTH FROM t.transaction_time)
+        EXTRACT(MONTH FROM t.transaction_time),
+        t.currency
 ), formatted_data AS (
     SELECT
         year::text,
         TO_CHAR(TO_DATE(month::text, 'MM'), 'Mon') AS month,
+        currency,
         total_amount
     FROM
         monthly_data
@@ -1379,22 +1096,26 @@ WITH monthly_data AS (
     SELECT
         EXTRACT(YEAR FROM gs.month)::text AS year,
         TO_CHAR(gs.month, 'Mon') AS month,
+        cur.currency,
         0::integer AS total_amount
     FROM generate_series(
         date_trunc('year', $1::timestamp),
         date_trunc('year', $1::timestamp) + interval '11 month',
         interval '1 month'
     ) AS gs(month)
+    CROSS JOIN currencies cur
     WHERE NOT EXISTS (
         SELECT 1 FROM monthly_data md
         WHERE md.year = EXTRACT(YEAR FROM gs.month)::integer
         AND md.month = EXTRACT(MONTH FROM gs.month)::integer
+        AND md.currency = cur.currency
     )
 )
-SELECT year, month, total_amount FROM formatted_data
+SELECT year, month, currency, total_amount FROM formatted_data
 ORDER BY
     year DESC,
-    TO_DATE(month, 'Mon') DESC
+    TO_DATE(month, 'Mon') DESC,
+    currency
 `
 
 type GetMonthlyTotalAmountByMerchantParams struct {
@@ -1405,6 +1126,7 @@ type GetMonthlyTotalAmountByMerchantParams struct {
 type GetMonthlyTotalAmountByMerchantRow struct {
 	Year        string `json:"year"`
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -1416,11 +1138,14 @@ type GetMonthlyTotalAmountByMerchantRow struct {
 //	$2: merchant_id - The merchant to filter transactions
 //
 // Returns:
-//   - Year (as text)
-//   - Month (abbreviated name, e.g., Jan, Feb)
-//   - Total transaction amount for each month
+//
+//	currency: Currency the totals are expressed in
+//	- Year (as text)
+//	- Month (abbreviated name, e.g., Jan, Feb)
+//	- Total transaction amount for each month
 //
 // Business Logic:
+//   - Amounts are net of refunds (amount - refunded_amount)
 //   - Aggregates total transaction amounts for the target month and the month before
 //   - Filters only active (non-deleted) transactions and merchants
 //   - Includes 0 as total_amount if there's no transaction data for either month
@@ -1435,7 +1160,12 @@ func (q *Queries) GetMonthlyTotalAmountByMerchant(ctx context.Context, arg GetMo
 	var items []*GetMonthlyTotalAmountByMerchantRow
 	for rows.Next() {
 		var i GetMonthlyTotalAmountByMerchantRow
-		if err := rows.Scan(&i.Year, &i.Month, &i.TotalAmount); err != nil {
+		if err := rows.Scan(
+			&i.Year,
+			&i.Month,
+			&i.Currency,
+			&i.TotalAmount,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1450,11 +1180,14 @@ func (q *Queries) GetMonthlyTotalAmountByMerchant(ctx context.Context, arg GetMo
 }
 
 const getMonthlyTotalAmountMerchant = `-- name: GetMonthlyTotalAmountMerchant :many
-WITH monthly_data AS (
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+), monthly_data AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time)::text AS year,
         TO_CHAR(t.transaction_time, 'Mon') AS month,
-        COALESCE(SUM(t.amount), 0)::integer AS total_amount
+        t.currency,
+        COALESCE(SUM(t.amount - t.refunded_amount), 0)::integer AS total_amount
     FROM
         transactions t
     INNER JOIN
@@ -1468,44 +1201,53 @@ WITH monthly_data AS (
         )
     GROUP BY
         EXTRACT(YEAR FROM t.transaction_time),
-        TO_CHAR(t.transaction_time, 'Mon')
+        TO_CHAR(t.transaction_time, 'Mon'),
+        t.currency
 ), missing_months AS (
     SELECT
         EXTRACT(YEAR FROM $1::timestamp)::text AS year,
         TO_CHAR($1::timestamp, 'Mon') AS month,
+        cur.currency,
         0::integer AS total_amount
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM monthly_data
         WHERE year = EXTRACT(YEAR FROM $1::timestamp)::text
         AND month = TO_CHAR($1::timestamp, 'Mon')
+        AND currency = cur.currency
     )
     UNION ALL
     SELECT
         EXTRACT(YEAR FROM date_trunc('month', $1::timestamp) - interval '1 month')::text AS year,
         TO_CHAR(date_trunc('month', $1::timestamp) - interval '1 month', 'Mon') AS month,
+        cur.currency,
         0::integer AS total_amount
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM monthly_data
         WHERE year = EXTRACT(YEAR FROM date_trunc('month', $1::timestamp) - interval '1 month')::text
         AND month = TO_CHAR(date_trunc('month', $1::timestamp) - interval '1 month', 'Mon')
+        AND currency = cur.currency
     )
 )
-SELECT year, month, total_amount
+SELECT year, month, currency, total_amount
 FROM (
-    SELECT year, month, total_amount FROM monthly_data
+    SELECT year, month, currency, total_amount FROM monthly_data
     UNION ALL
-    SELECT year, month, total_amount FROM missing_months
+    SELECT year, month, currency, total_amount FROM missing_months
 ) combined
 ORDER BY
     year DESC,
-    TO_DATE(month, 'Mon') DESC
+    TO_DATE(month, 'Mon') DESC,
+    currency
 `
 
 type GetMonthlyTotalAmountMerchantRow struct {
 	Year        string `json:"year"`
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -1516,11 +1258,14 @@ type GetMonthlyTotalAmountMerchantRow struct {
 //	$1: reference_date - Any date within the target (current) month
 //
 // Returns:
-//   - Year (as text)
-//   - Month (abbreviated name, e.g., Jan, Feb)
-//   - Total transaction amount for each month
+//
+//	currency: Currency the totals are expressed in
+//	- Year (as text)
+//	- Month (abbreviated name, e.g., Jan, Feb)
+//	- Total transaction amount for each month
 //
 // Business Logic:
+//   - Amounts are net of refunds (amount - refunded_amount)
 //   - Aggregates total transaction amounts for the target month and the month before
 //   - Filters only active (non-deleted) transactions and merchants
 //   - Includes 0 as total_amount if there's no transaction data for either month
@@ -1535,7 +1280,12 @@ func (q *Queries) GetMonthlyTotalAmountMerchant(ctx context.Context, dollar_1 ti
 	var items []*GetMonthlyTotalAmountMerchantRow
 	for rows.Next() {
 		var i GetMonthlyTotalAmountMerchantRow
-		if err := rows.Scan(&i.Year, &i.Month, &i.TotalAmount); err != nil {
+		if err := rows.Scan(
+			&i.Year,
+			&i.Month,
+			&i.Currency,
+			&i.TotalAmount,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1550,7 +1300,7 @@ func (q *Queries) GetMonthlyTotalAmountMerchant(ctx context.Context, dollar_1 ti
 }
 
 const getTrashedMerchantByID = `-- name: GetTrashedMerchantByID :one
-SELECT merchant_id, merchant_no, name, api_key, user_id, status, created_at, updated_at, deleted_at
+SELECT merchant_id, merchant_no, name, user_id, status, created_at, updated_at, deleted_at
 FROM merchants
 WHERE
     merchant_id = $1
@@ -1576,7 +1326,6 @@ func (q *Queries) GetTrashedMerchantByID(ctx context.Context, merchantID int32)
 		&i.MerchantID,
 		&i.MerchantNo,
 		&i.Name,
-		&i.ApiKey,
 		&i.UserID,
 		&i.Status,
 		&i.CreatedAt,
@@ -1588,11 +1337,11 @@ func (q *Queries) GetTrashedMerchantByID(ctx context.Context, merchantID int32)
 
 const getTrashedMerchants = `-- name: GetTrashedMerchants :many
 SELECT
-    merchant_id, merchant_no, name, api_key, user_id, status, created_at, updated_at, deleted_at,
+    merchant_id, merchant_no, name, user_id, status, created_at, updated_at, deleted_at,
     COUNT(*) OVER() AS total_count
 FROM merchants
 WHERE deleted_at IS NOT NULL
-    AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR api_key ILIKE '%' || $1 || '%' OR status ILIKE '%' || $1 || '%')
+    AND ($1::TEXT IS NULL OR name ILIKE '%' || $1 || '%' OR status ILIKE '%' || $1 || '%')
 ORDER BY merchant_id
 LIMIT $2 OFFSET $3
 `
@@ -1607,7 +1356,6 @@ type GetTrashedMerchantsRow struct {
 	MerchantID int32        `json:"merchant_id"`
 	MerchantNo uuid.UUID    `json:"merchant_no"`
 	Name       string       `json:"name"`
-	ApiKey     string       `json:"api_key"`
 	UserID     int32        `json:"user_id"`
 	Status     string       `json:"status"`
 	CreatedAt  sql.NullTime `json:"created_at"`
@@ -1620,7 +1368,7 @@ type GetTrashedMerchantsRow struct {
 // Purpose: View trashed merchants for potential restoration or permanent deletion
 // Parameters:
 //
-//	$1: search_term - Optional text to filter by name, api_key, or status (NULL for no filter)
+//	$1: search_term - Optional text to filter by name or status (NULL for no filter)
 //	$2: limit - Maximum number of records to return
 //	$3: offset - Number of records to skip for pagination
 //
@@ -1630,7 +1378,7 @@ type GetTrashedMerchantsRow struct {
 //
 // Business Logic:
 //   - Only includes soft-deleted merchants (deleted_at IS NOT NULL)
-//   - Supports partial text search (case-insensitive) on name, api_key, and status
+//   - Supports partial text search (case-insensitive) on name and status
 //   - Returns results ordered by merchant_id
 //   - Provides total_count for pagination calculations
 func (q *Queries) GetTrashedMerchants(ctx context.Context, arg GetTrashedMerchantsParams) ([]*GetTrashedMerchantsRow, error) {
@@ -1646,7 +1394,6 @@ func (q *Queries) GetTrashedMerchants(ctx context.Context, arg GetTrashedMerchan
 			&i.MerchantID,
 			&i.MerchantNo,
 			&i.Name,
-			&i.ApiKey,
 			&i.UserID,
 			&i.Status,
 			&i.CreatedAt,
@@ -1667,88 +1414,12 @@ func (q *Queries) GetTrashedMerchants(ctx context.Context, arg GetTrashedMerchan
 	return items, nil
 }
 
-const getYearlyAmountByApikey = `-- name: GetYearlyAmountByApikey :many
-WITH last_five_years AS (
-    SELECT
-        EXTRACT(YEAR FROM t.transaction_time) AS year,
-        SUM(t.amount) AS total_amount
-    FROM
-        transactions t
-    JOIN
-        merchants m ON t.merchant_id = m.merchant_id
-    WHERE
-        t.deleted_at IS NULL
-        AND m.deleted_at IS NULL
-        AND m.api_key = $1
-        AND EXTRACT(YEAR FROM t.transaction_time) >= $2 - 4
-        AND EXTRACT(YEAR FROM t.transaction_time) <= $2
-    GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
-)
-SELECT
-    year,
-    total_amount
-FROM
-    last_five_years
-ORDER BY
-    year
-`
-
-type GetYearlyAmountByApikeyParams struct {
-	ApiKey  string      `json:"api_key"`
-	Column2 interface{} `json:"column_2"`
-}
-
-type GetYearlyAmountByApikeyRow struct {
-	Year        string `json:"year"`
-	TotalAmount int64  `json:"total_amount"`
-}
-
-// GetYearlyAmountByMerchants: Retrieves total transaction amount per year for the last 5 years for a specific merchant
-// Purpose: Show overall yearly revenue trends for a merchant across all payment methods
-// Parameters:
-//
-//	$1: api-key - The merchant to filter transactions
-//	$2: current_year - The latest year to include in the 5-year window
-//
-// Returns:
-//   - Year (e.g., 2021, 2022)
-//   - Total transaction amount
-//
-// Business Logic:
-//   - Filters only active (non-deleted) transactions and merchants
-//   - Filters by specific merchant_id
-//   - Includes data for the last 5 calendar years up to the current year
-//   - Groups by calendar year
-//   - Results are ordered chronologically by year
-func (q *Queries) GetYearlyAmountByApikey(ctx context.Context, arg GetYearlyAmountByApikeyParams) ([]*GetYearlyAmountByApikeyRow, error) {
-	rows, err := q.db.QueryContext(ctx, getYearlyAmountByApikey, arg.ApiKey, arg.Column2)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []*GetYearlyAmountByApikeyRow
-	for rows.Next() {
-		var i GetYearlyAmountByApikeyRow
-		if err := rows.Scan(&i.Year, &i.TotalAmount); err != nil {
-			return nil, err
-		}
-		items = append(items, &i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const getYearlyAmountByMerchants = `-- name: GetYearlyAmountByMerchants :many
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time) AS year,
-        SUM(t.amount) AS total_amount
+        t.currency,
+        SUM(t.amount - t.refunded_amount) AS total_amount
     FROM
         transactions t
     JOIN
@@ -1760,15 +1431,18 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transaction_time) >= $2 - 4
         AND EXTRACT(YEAR FROM t.transaction_time) <= $2
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
+        EXTRACT(YEAR FROM t.transaction_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_amount
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyAmountByMerchantsParams struct {
@@ -1778,6 +1452,7 @@ type GetYearlyAmountByMerchantsParams struct {
 
 type GetYearlyAmountByMerchantsRow struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int64  `json:"total_amount"`
 }
 
@@ -1789,10 +1464,13 @@ type GetYearlyAmountByMerchantsRow struct {
 //	$2: current_year - The latest year to include in the 5-year window
 //
 // Returns:
-//   - Year (e.g., 2021, 2022)
-//   - Total transaction amount
+//
+//	currency: Currency the totals are expressed in
+//	- Year (e.g., 2021, 2022)
+//	- Total transaction amount
 //
 // Business Logic:
+//   - Amounts are net of refunds (amount - refunded_amount)
 //   - Filters only active (non-deleted) transactions and merchants
 //   - Filters by specific merchant_id
 //   - Includes data for the last 5 calendar years up to the current year
@@ -1807,7 +1485,7 @@ func (q *Queries) GetYearlyAmountByMerchants(ctx context.Context, arg GetYearlyA
 	var items []*GetYearlyAmountByMerchantsRow
 	for rows.Next() {
 		var i GetYearlyAmountByMerchantsRow
-		if err := rows.Scan(&i.Year, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1825,7 +1503,8 @@ const getYearlyAmountMerchant = `-- name: GetYearlyAmountMerchant :many
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time) AS year,
-        SUM(t.amount) AS total_amount
+        t.currency,
+        SUM(t.amount - t.refunded_amount) AS total_amount
     FROM
         transactions t
     JOIN
@@ -1835,19 +1514,23 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM t.transaction_time) >= $1 - 4
         AND EXTRACT(YEAR FROM t.transaction_time) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
+        EXTRACT(YEAR FROM t.transaction_time),
+        t.currency
 )
 SELECT
     year,
+    currency,
     total_amount
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyAmountMerchantRow struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int64  `json:"total_amount"`
 }
 
@@ -1858,10 +1541,13 @@ type GetYearlyAmountMerchantRow struct {
 //	$1: current_year - The latest year to include in the 5-year window
 //
 // Returns:
-//   - Year (e.g., 2021, 2022)
-//   - Total transaction amount
+//
+//	currency: Currency the totals are expressed in
+//	- Year (e.g., 2021, 2022)
+//	- Total transaction amount
 //
 // Business Logic:
+//   - Amounts are net of refunds (amount - refunded_amount)
 //   - Aggregates yearly transaction amounts
 //   - Filters only active (non-deleted) transactions and merchants
 //   - Includes data for the last 5 calendar years up to the current year
@@ -1875,7 +1561,7 @@ func (q *Queries) GetYearlyAmountMerchant(ctx context.Context, dollar_1 interfac
 	var items []*GetYearlyAmountMerchantRow
 	for rows.Next() {
 		var i GetYearlyAmountMerchantRow
-		if err := rows.Scan(&i.Year, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1889,75 +1575,99 @@ func (q *Queries) GetYearlyAmountMerchant(ctx context.Context, dollar_1 interfac
 	return items, nil
 }
 
-const getYearlyPaymentMethodByApikey = `-- name: GetYearlyPaymentMethodByApikey :many
-WITH last_five_years AS (
+const getYearlyDisputeRateByMerchants = `-- name: GetYearlyDisputeRateByMerchants :many
+WITH years AS (
+    SELECT generate_series($2::int - 4, $2::int) AS year
+),
+yearly_transactions AS (
     SELECT
-        EXTRACT(YEAR FROM t.transaction_time) AS year,
-        t.payment_method,
-        SUM(t.amount) AS total_amount
+        EXTRACT(YEAR FROM t.transaction_time)::int AS year,
+        COUNT(*) AS total_transactions
     FROM
         transactions t
-    JOIN
-        merchants m ON t.merchant_id = m.merchant_id
     WHERE
         t.deleted_at IS NULL
-        AND m.deleted_at IS NULL
-        AND m.api_key = $1
-        AND EXTRACT(YEAR FROM t.transaction_time) >= $2 - 4
-        AND EXTRACT(YEAR FROM t.transaction_time) <= $2
+        AND t.merchant_id = $1
+        AND t.status IN ('success', 'partially_refunded', 'refunded', 'disputed', 'charged_back')
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time),
-        t.payment_method
+        EXTRACT(YEAR FROM t.transaction_time)
+),
+yearly_disputes AS (
+    SELECT
+        EXTRACT(YEAR FROM d.created_at)::int AS year,
+        COUNT(*) AS total_disputes
+    FROM
+        disputes d
+    WHERE
+        d.merchant_id = $1
+    GROUP BY
+        EXTRACT(YEAR FROM d.created_at)
 )
 SELECT
-    year,
-    payment_method,
-    total_amount
+    y.year::text AS year,
+    COALESCE(yt.total_transactions, 0)::int AS total_transactions,
+    COALESCE(yd.total_disputes, 0)::int AS total_disputes,
+    (CASE
+        WHEN COALESCE(yt.total_transactions, 0) = 0 THEN 0
+        ELSE ROUND(COALESCE(yd.total_disputes, 0)::numeric * 100 / yt.total_transactions, 2)
+    END)::float8 AS dispute_rate
 FROM
-    last_five_years
+    years y
+LEFT JOIN
+    yearly_transactions yt ON yt.year = y.year
+LEFT JOIN
+    yearly_disputes yd ON yd.year = y.year
 ORDER BY
-    year
+    y.year
 `
 
-type GetYearlyPaymentMethodByApikeyParams struct {
-	ApiKey  string      `json:"api_key"`
-	Column2 interface{} `json:"column_2"`
+type GetYearlyDisputeRateByMerchantsParams struct {
+	MerchantID int32 `json:"merchant_id"`
+	Column2    int32 `json:"column_2"`
 }
 
-type GetYearlyPaymentMethodByApikeyRow struct {
-	Year          string `json:"year"`
-	PaymentMethod string `json:"payment_method"`
-	TotalAmount   int64  `json:"total_amount"`
+type GetYearlyDisputeRateByMerchantsRow struct {
+	Year              string  `json:"year"`
+	TotalTransactions int32   `json:"total_transactions"`
+	TotalDisputes     int32   `json:"total_disputes"`
+	DisputeRate       float64 `json:"dispute_rate"`
 }
 
-// GetYearlyPaymentMethodByApikey: Retrieves total transaction amount per payment method over the last 5 years for a specific merchant
-// Purpose: Analyze yearly transaction totals grouped by payment method for a merchant
+// GetYearlyDisputeRateByMerchants: Retrieves the dispute rate per year for the last 5 years for a specific merchant
+// Purpose: Show long-term dispute trends for a merchant
 // Parameters:
 //
-//	$1: api-key - The merchant to filter transactions
+//	$1: merchant_id - The merchant to filter transactions and disputes
 //	$2: current_year - The latest year to include in the 5-year window
 //
 // Returns:
 //   - Year (e.g., 2021, 2022)
-//   - Payment method
-//   - Total transaction amount
+//   - Number of completed transactions in the year
+//   - Number of disputes opened in the year
+//   - Dispute rate as a percentage of completed transactions (0 if no transactions)
 //
 // Business Logic:
-//   - Filters only active (non-deleted) transactions and merchants
-//   - Filters by specific merchant_id
-//   - Includes data for the last 5 calendar years up to the current year
-//   - Groups by calendar year and payment method
+//   - Completed transactions are those that reached 'success', including ones
+//     later refunded, disputed or charged back
+//   - Disputes are bucketed by the year they were opened
+//   - Includes every year of the 5-year window, even without activity
+//   - Filters only active (non-deleted) transactions
 //   - Results are ordered chronologically by year
-func (q *Queries) GetYearlyPaymentMethodByApikey(ctx context.Context, arg GetYearlyPaymentMethodByApikeyParams) ([]*GetYearlyPaymentMethodByApikeyRow, error) {
-	rows, err := q.db.QueryContext(ctx, getYearlyPaymentMethodByApikey, arg.ApiKey, arg.Column2)
+func (q *Queries) GetYearlyDisputeRateByMerchants(ctx context.Context, arg GetYearlyDisputeRateByMerchantsParams) ([]*GetYearlyDisputeRateByMerchantsRow, error) {
+	rows, err := q.db.QueryContext(ctx, getYearlyDisputeRateByMerchants, arg.MerchantID, arg.Column2)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []*GetYearlyPaymentMethodByApikeyRow
+	var items []*GetYearlyDisputeRateByMerchantsRow
 	for rows.Next() {
-		var i GetYearlyPaymentMethodByApikeyRow
-		if err := rows.Scan(&i.Year, &i.PaymentMethod, &i.TotalAmount); err != nil {
+		var i GetYearlyDisputeRateByMerchantsRow
+		if err := rows.Scan(
+			&i.Year,
+			&i.TotalTransactions,
+			&i.TotalDisputes,
+			&i.DisputeRate,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1976,7 +1686,7 @@ WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time) AS year,
         t.payment_method,
-        SUM(t.amount) AS total_amount
+        SUM(t.amount - t.refunded_amount) AS total_amount
     FROM
         transactions t
     JOIN
@@ -2025,6 +1735,7 @@ type GetYearlyPaymentMethodByMerchantsRow struct {
 //   - Total transaction amount
 //
 // Business Logic:
+//   - Amounts are net of refunds (amount - refunded_amount)
 //   - Filters only active (non-deleted) transactions and merchants
 //   - Filters by specific merchant_id
 //   - Includes data for the last 5 calendar years up to the current year
@@ -2058,7 +1769,7 @@ WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time) AS year,
         t.payment_method,
-        SUM(t.amount) AS total_amount
+        SUM(t.amount - t.refunded_amount) AS total_amount
     FROM
         transactions t
     JOIN
@@ -2099,6 +1810,7 @@ type GetYearlyPaymentMethodMerchantRow struct {
 //   - Total transaction amount
 //
 // Business Logic:
+//   - Amounts are net of refunds (amount - refunded_amount)
 //   - Aggregates yearly totals for each payment method
 //   - Includes only active (non-deleted) transactions and merchants
 //   - Covers a 5-year range: (current_year - 4) to current_year
@@ -2126,100 +1838,14 @@ func (q *Queries) GetYearlyPaymentMethodMerchant(ctx context.Context, dollar_1 i
 	return items, nil
 }
 
-const getYearlyTotalAmountByApikey = `-- name: GetYearlyTotalAmountByApikey :many
-WITH yearly_data AS (
-    SELECT
-        EXTRACT(YEAR FROM t.transaction_time)::integer AS year,
-        COALESCE(SUM(t.amount), 0)::integer AS total_amount
-    FROM
-        transactions t
-    INNER JOIN
-        merchants m ON t.merchant_id = m.merchant_id
-    WHERE
-        t.deleted_at IS NULL
-        AND m.deleted_at IS NULL
-        AND EXTRACT(YEAR FROM t.transaction_time) >= $1::integer - 4
-        AND EXTRACT(YEAR FROM t.transaction_time) <= $1::integer
-        AND m.api_key = $2
-    GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
-), formatted_data AS (
-    SELECT
-        year::text,
-        total_amount
-    FROM
-        yearly_data
-    UNION ALL
-
-    SELECT
-        y::text AS year,
-        0::integer AS total_amount
-    FROM generate_series($1::integer - 4, $1::integer) AS y
-    WHERE NOT EXISTS (
-        SELECT 1 FROM yearly_data yd
-        WHERE yd.year = y
-    )
-)
-SELECT year, total_amount FROM formatted_data
-ORDER BY
-    year DESC
-`
-
-type GetYearlyTotalAmountByApikeyParams struct {
-	Column1 int32  `json:"column_1"`
-	ApiKey  string `json:"api_key"`
-}
-
-type GetYearlyTotalAmountByApikeyRow struct {
-	Year        string `json:"year"`
-	TotalAmount int32  `json:"total_amount"`
-}
-
-// GetYearlyTotalAmountByApikey: Retrieves total transaction amounts for the current and previous year
-// Purpose: Provide yearly transaction summary with fallback to 0 if no transactions exist
-// Parameters:
-//
-//	$1: current_year - The latest year to include in the summary
-//	$2: api-key - The merchant to filter transactions
-//
-// Returns:
-//   - Year (as text)
-//   - Total transaction amount per year
-//
-// Business Logic:
-//   - Aggregates total amounts for both the current year and the previous year
-//   - Filters only active (non-deleted) transactions and merchants
-//   - Ensures both years appear in the result, even if no data exists (returns 0 in such case)
-//   - Uses UNION ALL to combine actual data with 0-filled placeholders
-//   - Results are ordered in descending order by year
-func (q *Queries) GetYearlyTotalAmountByApikey(ctx context.Context, arg GetYearlyTotalAmountByApikeyParams) ([]*GetYearlyTotalAmountByApikeyRow, error) {
-	rows, err := q.db.QueryContext(ctx, getYearlyTotalAmountByApikey, arg.Column1, arg.ApiKey)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-	var items []*GetYearlyTotalAmountByApikeyRow
-	for rows.Next() {
-		var i GetYearlyTotalAmountByApikeyRow
-		if err := rows.Scan(&i.Year, &i.TotalAmount); err != nil {
-			return nil, err
-		}
-		items = append(items, &i)
-	}
-	if err := rows.Close(); err != nil {
-		return nil, err
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
-	}
-	return items, nil
-}
-
 const getYearlyTotalAmountByMerchant = `-- name: GetYearlyTotalAmountByMerchant :many
-WITH yearly_data AS (
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+), yearly_data AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time)::integer AS year,
-        COALESCE(SUM(t.amount), 0)::integer AS total_amount
+        t.currency,
+        COALESCE(SUM(t.amount - t.refunded_amount), 0)::integer AS total_amount
     FROM
         transactions t
     INNER JOIN
@@ -2231,10 +1857,12 @@ WITH yearly_data AS (
         AND EXTRACT(YEAR FROM t.transaction_time) <= $1::integer
         AND t.merchant_id = $2::integer
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
+        EXTRACT(YEAR FROM t.transaction_time),
+        t.currency
 ), formatted_data AS (
     SELECT
         year::text,
+        currency,
         total_amount
     FROM
         yearly_data
@@ -2242,16 +1870,20 @@ WITH yearly_data AS (
 
     SELECT
         y::text AS year,
+        cur.currency,
         0::integer AS total_amount
     FROM generate_series($1::integer - 4, $1::integer) AS y
+    CROSS JOIN currencies cur
     WHERE NOT EXISTS (
         SELECT 1 FROM yearly_data yd
         WHERE yd.year = y
+        AND yd.currency = cur.currency
     )
 )
-SELECT year, total_amount FROM formatted_data
+SELECT year, currency, total_amount FROM formatted_data
 ORDER BY
-    year DESC
+    year DESC,
+    currency
 `
 
 type GetYearlyTotalAmountByMerchantParams struct {
@@ -2261,6 +1893,7 @@ type GetYearlyTotalAmountByMerchantParams struct {
 
 type GetYearlyTotalAmountByMerchantRow struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -2272,10 +1905,13 @@ type GetYearlyTotalAmountByMerchantRow struct {
 //	$2: merchant_id - The merchant to filter transactions
 //
 // Returns:
-//   - Year (as text)
-//   - Total transaction amount per year
+//
+//	currency: Currency the totals are expressed in
+//	- Year (as text)
+//	- Total transaction amount per year
 //
 // Business Logic:
+//   - Amounts are net of refunds (amount - refunded_amount)
 //   - Aggregates total amounts for both the current year and the previous year
 //   - Filters only active (non-deleted) transactions and merchants
 //   - Ensures both years appear in the result, even if no data exists (returns 0 in such case)
@@ -2290,7 +1926,7 @@ func (q *Queries) GetYearlyTotalAmountByMerchant(ctx context.Context, arg GetYea
 	var items []*GetYearlyTotalAmountByMerchantRow
 	for rows.Next() {
 		var i GetYearlyTotalAmountByMerchantRow
-		if err := rows.Scan(&i.Year, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2305,10 +1941,13 @@ func (q *Queries) GetYearlyTotalAmountByMerchant(ctx context.Context, arg GetYea
 }
 
 const getYearlyTotalAmountMerchant = `-- name: GetYearlyTotalAmountMerchant :many
-WITH yearly_data AS (
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+), yearly_data AS (
     SELECT
         EXTRACT(YEAR FROM t.transaction_time)::integer AS year,
-        COALESCE(SUM(t.amount), 0)::integer AS total_amount
+        t.currency,
+        COALESCE(SUM(t.amount - t.refunded_amount), 0)::integer AS total_amount
     FROM
         transactions t
     INNER JOIN
@@ -2321,10 +1960,12 @@ WITH yearly_data AS (
             OR EXTRACT(YEAR FROM t.transaction_time) = $1::integer - 1
         )
     GROUP BY
-        EXTRACT(YEAR FROM t.transaction_time)
+        EXTRACT(YEAR FROM t.transaction_time),
+        t.currency
 ), formatted_data AS (
     SELECT
         year::text,
+        currency,
         total_amount::integer
     FROM
         yearly_data
@@ -2333,31 +1974,39 @@ WITH yearly_data AS (
 
     SELECT
         $1::text AS year,
+        cur.currency,
         0::integer AS total_amount
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM yearly_data
         WHERE year = $1::integer
+        AND currency = cur.currency
     )
 
     UNION ALL
 
     SELECT
         ($1::integer - 1)::text AS year,
+        cur.currency,
         0::integer AS total_amount
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM yearly_data
         WHERE year = $1::integer - 1
+        AND currency = cur.currency
     )
 )
-SELECT year, total_amount FROM formatted_data
+SELECT year, currency, total_amount FROM formatted_data
 ORDER BY
-    year DESC
+    year DESC,
+    currency
 `
 
 type GetYearlyTotalAmountMerchantRow struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -2368,10 +2017,13 @@ type GetYearlyTotalAmountMerchantRow struct {
 //	$1: current_year - The latest year to include in the summary
 //
 // Returns:
-//   - Year (as text)
-//   - Total transaction amount per year
+//
+//	currency: Currency the totals are expressed in
+//	- Year (as text)
+//	- Total transaction amount per year
 //
 // Business Logic:
+//   - Amounts are net of refunds (amount - refunded_amount)
 //   - Aggregates total amounts for both the current year and the previous year
 //   - Filters only active (non-deleted) transactions and merchants
 //   - Ensures both years appear in the result, even if no data exists (returns 0 in such case)
@@ -2386,7 +2038,7 @@ func (q *Queries) GetYearlyTotalAmountMerchant(ctx context.Context, dollar_1 int
 	var items []*GetYearlyTotalAmountMerchantRow
 	for rows.Next() {
 		var i GetYearlyTotalAmountMerchantRow
-		if err := rows.Scan(&i.Year, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2425,7 +2077,7 @@ SET
 WHERE
     merchant_id = $1
     AND deleted_at IS NOT NULL
-RETURNING merchant_id, merchant_no, name, api_key, user_id, status, created_at, updated_at, deleted_at
+RETURNING merchant_id, merchant_no, name, user_id, status, created_at, updated_at, deleted_at
 `
 
 // Restore Trashed Merchant
@@ -2444,7 +2096,6 @@ func (q *Queries) RestoreMerchant(ctx context.Context, merchantID int32) (*Merch
 		&i.MerchantID,
 		&i.MerchantNo,
 		&i.Name,
-		&i.ApiKey,
 		&i.UserID,
 		&i.Status,
 		&i.CreatedAt,
@@ -2461,7 +2112,7 @@ SET
 WHERE
     merchant_id = $1
     AND deleted_at IS NULL
-RETURNING merchant_id, merchant_no, name, api_key, user_id, status, created_at, updated_at, deleted_at
+RETURNING merchant_id, merchant_no, name, user_id, status, created_at, updated_at, deleted_at
 `
 
 // Trash Merchant
@@ -2481,7 +2132,6 @@ func (q *Queries) TrashMerchant(ctx context.Context, merchantID int32) (*Merchan
 		&i.MerchantID,
 		&i.MerchantNo,
 		&i.Name,
-		&i.ApiKey,
 		&i.UserID,
 		&i.Status,
 		&i.CreatedAt,
@@ -2496,19 +2146,17 @@ UPDATE merchants
 SET
     name = $2,
     user_id = $3,
-    status = $4,
     updated_at = current_timestamp
 WHERE
     merchant_id = $1
     AND deleted_at IS NULL
-RETURNING merchant_id, merchant_no, name, api_key, user_id, status, created_at, updated_at, deleted_at
+RETURNING merchant_id, merchant_no, name, user_id, status, created_at, updated_at, deleted_at
 `
 
 type UpdateMerchantParams struct {
 	MerchantID int32  `json:"merchant_id"`
 	Name       string `json:"name"`
 	UserID     int32  `json:"user_id"`
-	Status     string `json:"status"`
 }
 
 // Update Merchant
@@ -2518,10 +2166,10 @@ type UpdateMerchantParams struct {
 //	$1: merchant_id - ID of the merchant to be updated
 //	$2: name - The new name for the merchant
 //	$3: user_id - New user ID associated with the merchant
-//	$4: status - New status for the merchant
 //
 // Business Logic:
-//   - Updates the specified merchant's name, user_id, and status.
+//   - Updates the specified merchant's name and user_id.
+//   - The status is changed through the onboarding lifecycle only.
 //   - Ensures the merchant is not marked as deleted (deleted_at is NULL).
 //   - Sets the updated_at timestamp to the current time.
 func (q *Queries) UpdateMerchant(ctx context.Context, arg UpdateMerchantParams) (*Merchant, error) {
@@ -2529,14 +2177,12 @@ func (q *Queries) UpdateMerchant(ctx context.Context, arg UpdateMerchantParams)
 		arg.MerchantID,
 		arg.Name,
 		arg.UserID,
-		arg.Status,
 	)
 	var i Merchant
 	err := row.Scan(
 		&i.MerchantID,
 		&i.MerchantNo,
 		&i.Name,
-		&i.ApiKey,
 		&i.UserID,
 		&i.Status,
 		&i.CreatedAt,
@@ -2554,7 +2200,7 @@ SET
 WHERE
     merchant_id = $1
     AND deleted_at IS NULL
-RETURNING merchant_id, merchant_no, name, api_key, user_id, status, created_at, updated_at, deleted_at
+RETURNING merchant_id, merchant_no, name, user_id, status, created_at, updated_at, deleted_at
 `
 
 type UpdateMerchantStatusParams struct {
@@ -2579,7 +2225,6 @@ func (q *Queries) UpdateMerchantStatus(ctx context.Context, arg UpdateMerchantSt
 		&i.MerchantID,
 		&i.MerchantNo,
 		&i.Name,
-		&i.ApiKey,
 		&i.UserID,
 		&i.Status,
 		&i.CreatedAt,
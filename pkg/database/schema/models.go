@@ -11,6 +11,48 @@ import (
 	"github.com/google/uuid"
 )
 
+type Authorization struct {
+	AuthorizationID int32         `json:"authorization_id"`
+	AuthorizationNo uuid.UUID     `json:"authorization_no"`
+	CardNumber      string        `json:"card_number"`
+	MerchantID      int32         `json:"merchant_id"`
+	Amount          int32         `json:"amount"`
+	CapturedAmount  int32         `json:"captured_amount"`
+	PaymentMethod   string        `json:"payment_method"`
+	Status          string        `json:"status"`
+	TransactionID   sql.NullInt32 `json:"transaction_id"`
+	ExpiresAt       time.Time     `json:"expires_at"`
+	CapturedAt      sql.NullTime  `json:"captured_at"`
+	VoidedAt        sql.NullTime  `json:"voided_at"`
+	CreatedAt       sql.NullTime  `json:"created_at"`
+	UpdatedAt       sql.NullTime  `json:"updated_at"`
+	Currency        string        `json:"currency"`
+}
+
+type BalanceDiscrepancy struct {
+	BalanceDiscrepancyID int32          `json:"balance_discrepancy_id"`
+	CardNumber           string         `json:"card_number"`
+	ReconciliationRunID  int32          `json:"reconciliation_run_id"`
+	SaldoBalance         int32          `json:"saldo_balance"`
+	ExpectedBalance      int32          `json:"expected_balance"`
+	LedgerBalance        int32          `json:"ledger_balance"`
+	Difference           int32          `json:"difference"`
+	TopupAmount          int32          `json:"topup_amount"`
+	WithdrawAmount       int32          `json:"withdraw_amount"`
+	TransferOutAmount    int32          `json:"transfer_out_amount"`
+	TransferInAmount     int32          `json:"transfer_in_amount"`
+	PaymentAmount        int32          `json:"payment_amount"`
+	SettlementAmount     int32          `json:"settlement_amount"`
+	OtherAmount          int32          `json:"other_amount"`
+	Status               string         `json:"status"`
+	AcknowledgedBy       sql.NullInt32  `json:"acknowledged_by"`
+	AcknowledgedAt       sql.NullTime   `json:"acknowledged_at"`
+	Note                 sql.NullString `json:"note"`
+	ResolvedAt           sql.NullTime   `json:"resolved_at"`
+	CreatedAt            sql.NullTime   `json:"created_at"`
+	UpdatedAt            sql.NullTime   `json:"updated_at"`
+}
+
 type Card struct {
 	CardID       int32        `json:"card_id"`
 	UserID       int32        `json:"user_id"`
@@ -22,13 +64,137 @@ type Card struct {
 	CreatedAt    sql.NullTime `json:"created_at"`
 	UpdatedAt    sql.NullTime `json:"updated_at"`
 	DeletedAt    sql.NullTime `json:"deleted_at"`
+	Currency     string       `json:"currency"`
+}
+
+type Dispute struct {
+	DisputeID        int32          `json:"dispute_id"`
+	DisputeNo        uuid.UUID      `json:"dispute_no"`
+	TransactionID    int32          `json:"transaction_id"`
+	CardNumber       string         `json:"card_number"`
+	MerchantID       int32          `json:"merchant_id"`
+	Amount           int32          `json:"amount"`
+	Reason           string         `json:"reason"`
+	MerchantEvidence sql.NullString `json:"merchant_evidence"`
+	ResolutionNote   sql.NullString `json:"resolution_note"`
+	Status           string         `json:"status"`
+	OpenedBy         int32          `json:"opened_by"`
+	ResolvedBy       sql.NullInt32  `json:"resolved_by"`
+	RespondedAt      sql.NullTime   `json:"responded_at"`
+	ResolvedAt       sql.NullTime   `json:"resolved_at"`
+	CreatedAt        sql.NullTime   `json:"created_at"`
+	UpdatedAt        sql.NullTime   `json:"updated_at"`
+	Currency         string         `json:"currency"`
+	HeldFrom         string         `json:"held_from"`
+}
+
+type ExchangeRate struct {
+	ExchangeRateID int32        `json:"exchange_rate_id"`
+	BaseCurrency   string       `json:"base_currency"`
+	QuoteCurrency  string       `json:"quote_currency"`
+	Rate           float64      `json:"rate"`
+	SpreadBps      int32        `json:"spread_bps"`
+	CreatedAt      sql.NullTime `json:"created_at"`
+	UpdatedAt      sql.NullTime `json:"updated_at"`
+}
+
+type FeeSchedule struct {
+	FeeScheduleID   int32          `json:"fee_schedule_id"`
+	Name            string         `json:"name"`
+	TransactionType string         `json:"transaction_type"`
+	FeeType         string         `json:"fee_type"`
+	PaymentMethod   sql.NullString `json:"payment_method"`
+	CardType        sql.NullString `json:"card_type"`
+	Currency        sql.NullString `json:"currency"`
+	FlatAmount      int32          `json:"flat_amount"`
+	PercentageBps   int32          `json:"percentage_bps"`
+	MinFee          sql.NullInt32  `json:"min_fee"`
+	MaxFee          sql.NullInt32  `json:"max_fee"`
+	IsActive        bool           `json:"is_active"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	UpdatedAt       sql.NullTime   `json:"updated_at"`
+}
+
+type FeeScheduleTier struct {
+	FeeScheduleTierID int32        `json:"fee_schedule_tier_id"`
+	FeeScheduleID     int32        `json:"fee_schedule_id"`
+	MinAmount         int32        `json:"min_amount"`
+	FlatAmount        int32        `json:"flat_amount"`
+	PercentageBps     int32        `json:"percentage_bps"`
+	CreatedAt         sql.NullTime `json:"created_at"`
+}
+
+type IdempotencyKey struct {
+	IdempotencyKeyID   int32          `json:"idempotency_key_id"`
+	UserID             int32          `json:"user_id"`
+	Scope              string         `json:"scope"`
+	IdempotencyKey     string         `json:"idempotency_key"`
+	RequestFingerprint string         `json:"request_fingerprint"`
+	ResponseBody       sql.NullString `json:"response_body"`
+	ExpiresAt          time.Time      `json:"expires_at"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	UpdatedAt          sql.NullTime   `json:"updated_at"`
+}
+
+type Invoice struct {
+	InvoiceID   int32        `json:"invoice_id"`
+	Code        string       `json:"code"`
+	MerchantID  int32        `json:"merchant_id"`
+	Kind        string       `json:"kind"`
+	Description string       `json:"description"`
+	Amount      int32        `json:"amount"`
+	Currency    string       `json:"currency"`
+	Status      string       `json:"status"`
+	ExpiresAt   time.Time    `json:"expires_at"`
+	PaidAt      sql.NullTime `json:"paid_at"`
+	CancelledAt sql.NullTime `json:"cancelled_at"`
+	CreatedAt   sql.NullTime `json:"created_at"`
+	UpdatedAt   sql.NullTime `json:"updated_at"`
+}
+
+type InvoiceLineItem struct {
+	InvoiceLineItemID int32        `json:"invoice_line_item_id"`
+	InvoiceID         int32        `json:"invoice_id"`
+	Description       string       `json:"description"`
+	Quantity          int32        `json:"quantity"`
+	UnitPrice         int32        `json:"unit_price"`
+	Amount            int32        `json:"amount"`
+	CreatedAt         sql.NullTime `json:"created_at"`
+}
+
+type InvoicePayment struct {
+	InvoicePaymentID int32        `json:"invoice_payment_id"`
+	InvoiceID        int32        `json:"invoice_id"`
+	TransactionID    int32        `json:"transaction_id"`
+	Amount           int32        `json:"amount"`
+	CreatedAt        sql.NullTime `json:"created_at"`
+}
+
+type LedgerJournal struct {
+	JournalID     int32         `json:"journal_id"`
+	JournalNo     uuid.UUID     `json:"journal_no"`
+	ReferenceType string        `json:"reference_type"`
+	ReferenceID   sql.NullInt32 `json:"reference_id"`
+	Description   string        `json:"description"`
+	PostedAt      time.Time     `json:"posted_at"`
+	CreatedAt     sql.NullTime  `json:"created_at"`
+}
+
+type LedgerPosting struct {
+	PostingID   int32          `json:"posting_id"`
+	JournalID   int32          `json:"journal_id"`
+	AccountCode string         `json:"account_code"`
+	CardNumber  sql.NullString `json:"card_number"`
+	Direction   string         `json:"direction"`
+	Amount      int32          `json:"amount"`
+	CreatedAt   sql.NullTime   `json:"created_at"`
+	Currency    string         `json:"currency"`
 }
 
 type Merchant struct {
 	MerchantID int32        `json:"merchant_id"`
 	MerchantNo uuid.UUID    `json:"merchant_no"`
 	Name       string       `json:"name"`
-	ApiKey     string       `json:"api_key"`
 	UserID     int32        `json:"user_id"`
 	Status     string       `json:"status"`
 	CreatedAt  sql.NullTime `json:"created_at"`
@@ -36,6 +202,120 @@ type Merchant struct {
 	DeletedAt  sql.NullTime `json:"deleted_at"`
 }
 
+type MerchantApiKey struct {
+	MerchantApiKeyID int32        `json:"merchant_api_key_id"`
+	MerchantID       int32        `json:"merchant_id"`
+	Label            string       `json:"label"`
+	KeyPrefix        string       `json:"key_prefix"`
+	KeyHash          string       `json:"key_hash"`
+	Scopes           []string     `json:"scopes"`
+	ExpiresAt        sql.NullTime `json:"expires_at"`
+	LastUsedAt       sql.NullTime `json:"last_used_at"`
+	RevokedAt        sql.NullTime `json:"revoked_at"`
+	CreatedAt        sql.NullTime `json:"created_at"`
+	UpdatedAt        sql.NullTime `json:"updated_at"`
+	SigningSecret    string       `json:"signing_secret"`
+}
+
+type MerchantBalance struct {
+	MerchantID           int32          `json:"merchant_id"`
+	Currency             string         `json:"currency"`
+	PendingBalance       int32          `json:"pending_balance"`
+	SettlementCardNumber sql.NullString `json:"settlement_card_number"`
+	CreatedAt            sql.NullTime   `json:"created_at"`
+	UpdatedAt            sql.NullTime   `json:"updated_at"`
+}
+
+type MerchantBusinessProfile struct {
+	MerchantID   int32          `json:"merchant_id"`
+	LegalName    string         `json:"legal_name"`
+	BusinessType string         `json:"business_type"`
+	TaxID        string         `json:"tax_id"`
+	Address      string         `json:"address"`
+	Website      sql.NullString `json:"website"`
+	ContactEmail string         `json:"contact_email"`
+	ContactPhone string         `json:"contact_phone"`
+	SubmittedAt  time.Time      `json:"submitted_at"`
+	CreatedAt    sql.NullTime   `json:"created_at"`
+	UpdatedAt    sql.NullTime   `json:"updated_at"`
+}
+
+type MerchantRequestNonce struct {
+	MerchantApiKeyID int32     `json:"merchant_api_key_id"`
+	Nonce            string    `json:"nonce"`
+	CreatedAt        time.Time `json:"created_at"`
+}
+
+type MerchantWebhookDelivery struct {
+	MerchantWebhookDeliveryID int32          `json:"merchant_webhook_delivery_id"`
+	MerchantWebhookEndpointID int32          `json:"merchant_webhook_endpoint_id"`
+	EventID                   uuid.UUID      `json:"event_id"`
+	EventType                 string         `json:"event_type"`
+	Payload                   string         `json:"payload"`
+	Status                    string         `json:"status"`
+	Attempts                  int32          `json:"attempts"`
+	NextAttemptAt             sql.NullTime   `json:"next_attempt_at"`
+	LastResponseCode          sql.NullInt32  `json:"last_response_code"`
+	LastError                 sql.NullString `json:"last_error"`
+	DeliveredAt               sql.NullTime   `json:"delivered_at"`
+	CreatedAt                 sql.NullTime   `json:"created_at"`
+	UpdatedAt                 sql.NullTime   `json:"updated_at"`
+}
+
+type MerchantWebhookDeliveryAttempt struct {
+	MerchantWebhookDeliveryAttemptID int32          `json:"merchant_webhook_delivery_attempt_id"`
+	MerchantWebhookDeliveryID        int32          `json:"merchant_webhook_delivery_id"`
+	Attempt                          int32          `json:"attempt"`
+	ResponseCode                     sql.NullInt32  `json:"response_code"`
+	Error                            sql.NullString `json:"error"`
+	DurationMs                       int32          `json:"duration_ms"`
+	CreatedAt                        sql.NullTime   `json:"created_at"`
+}
+
+type MerchantWebhookEndpoint struct {
+	MerchantWebhookEndpointID int32        `json:"merchant_webhook_endpoint_id"`
+	MerchantID                int32        `json:"merchant_id"`
+	Url                       string       `json:"url"`
+	Secret                    string       `json:"secret"`
+	Events                    []string     `json:"events"`
+	IsActive                  bool         `json:"is_active"`
+	CreatedAt                 sql.NullTime `json:"created_at"`
+	UpdatedAt                 sql.NullTime `json:"updated_at"`
+	DeletedAt                 sql.NullTime `json:"deleted_at"`
+}
+
+type ProviderWebhookEvent struct {
+	ProviderWebhookEventID int32          `json:"provider_webhook_event_id"`
+	Provider               string         `json:"provider"`
+	EventID                string         `json:"event_id"`
+	EventType              string         `json:"event_type"`
+	Payload                string         `json:"payload"`
+	Signature              string         `json:"signature"`
+	SentAt                 time.Time      `json:"sent_at"`
+	Status                 string         `json:"status"`
+	Attempts               int32          `json:"attempts"`
+	LastError              sql.NullString `json:"last_error"`
+	ProcessedAt            sql.NullTime   `json:"processed_at"`
+	CreatedAt              sql.NullTime   `json:"created_at"`
+	UpdatedAt              sql.NullTime   `json:"updated_at"`
+}
+
+type QrPayment struct {
+	QrPaymentID   int32          `json:"qr_payment_id"`
+	MerchantID    int32          `json:"merchant_id"`
+	TransactionID int32          `json:"transaction_id"`
+	Reference     sql.NullString `json:"reference"`
+	CreatedAt     sql.NullTime   `json:"created_at"`
+}
+
+type ReconciliationRun struct {
+	ReconciliationRunID int32        `json:"reconciliation_run_id"`
+	CardsChecked        int32        `json:"cards_checked"`
+	DiscrepancyCount    int32        `json:"discrepancy_count"`
+	StartedAt           time.Time    `json:"started_at"`
+	FinishedAt          sql.NullTime `json:"finished_at"`
+}
+
 type RefreshToken struct {
 	RefreshTokenID int32        `json:"refresh_token_id"`
 	UserID         int32        `json:"user_id"`
@@ -46,6 +326,20 @@ type RefreshToken struct {
 	DeletedAt      sql.NullTime `json:"deleted_at"`
 }
 
+type Refund struct {
+	RefundID      int32        `json:"refund_id"`
+	RefundNo      uuid.UUID    `json:"refund_no"`
+	TransactionID int32        `json:"transaction_id"`
+	CardNumber    string       `json:"card_number"`
+	MerchantID    int32        `json:"merchant_id"`
+	Amount        int32        `json:"amount"`
+	Reason        string       `json:"reason"`
+	Status        string       `json:"status"`
+	CreatedAt     sql.NullTime `json:"created_at"`
+	UpdatedAt     sql.NullTime `json:"updated_at"`
+	Currency      string       `json:"currency"`
+}
+
 type Role struct {
 	RoleID    int32        `json:"role_id"`
 	RoleName  string       `json:"role_name"`
@@ -55,14 +349,99 @@ type Role struct {
 }
 
 type Saldo struct {
-	SaldoID        int32         `json:"saldo_id"`
-	CardNumber     string        `json:"card_number"`
-	TotalBalance   int32         `json:"total_balance"`
-	WithdrawAmount sql.NullInt32 `json:"withdraw_amount"`
-	WithdrawTime   sql.NullTime  `json:"withdraw_time"`
-	CreatedAt      sql.NullTime  `json:"created_at"`
-	UpdatedAt      sql.NullTime  `json:"updated_at"`
-	DeletedAt      sql.NullTime  `json:"deleted_at"`
+	SaldoID         int32         `json:"saldo_id"`
+	CardNumber      string        `json:"card_number"`
+	TotalBalance    int32         `json:"total_balance"`
+	WithdrawAmount  sql.NullInt32 `json:"withdraw_amount"`
+	WithdrawTime    sql.NullTime  `json:"withdraw_time"`
+	CreatedAt       sql.NullTime  `json:"created_at"`
+	UpdatedAt       sql.NullTime  `json:"updated_at"`
+	DeletedAt       sql.NullTime  `json:"deleted_at"`
+	ReservedBalance int32         `json:"reserved_balance"`
+	Currency        string        `json:"currency"`
+}
+
+type SaldoHold struct {
+	HoldID        int32          `json:"hold_id"`
+	HoldNo        uuid.UUID      `json:"hold_no"`
+	CardNumber    string         `json:"card_number"`
+	Amount        int32          `json:"amount"`
+	Reason        string         `json:"reason"`
+	ReferenceType sql.NullString `json:"reference_type"`
+	ReferenceID   sql.NullInt32  `json:"reference_id"`
+	Status        string         `json:"status"`
+	CreatedBy     sql.NullInt32  `json:"created_by"`
+	ReleasedAt    sql.NullTime   `json:"released_at"`
+	ConsumedAt    sql.NullTime   `json:"consumed_at"`
+	CreatedAt     sql.NullTime   `json:"created_at"`
+	UpdatedAt     sql.NullTime   `json:"updated_at"`
+	Currency      string         `json:"currency"`
+}
+
+type ScheduledTransfer struct {
+	ScheduledTransferID int32         `json:"scheduled_transfer_id"`
+	UserID              int32         `json:"user_id"`
+	TransferFrom        string        `json:"transfer_from"`
+	TransferTo          string        `json:"transfer_to"`
+	TransferAmount      int32         `json:"transfer_amount"`
+	Recurrence          string        `json:"recurrence"`
+	DayOfMonth          sql.NullInt32 `json:"day_of_month"`
+	NextRunAt           sql.NullTime  `json:"next_run_at"`
+	EndAt               sql.NullTime  `json:"end_at"`
+	Status              string        `json:"status"`
+	LastRunAt           sql.NullTime  `json:"last_run_at"`
+	CreatedAt           sql.NullTime  `json:"created_at"`
+	UpdatedAt           sql.NullTime  `json:"updated_at"`
+}
+
+type ScheduledTransferRun struct {
+	ScheduledTransferRunID int32          `json:"scheduled_transfer_run_id"`
+	ScheduledTransferID    int32          `json:"scheduled_transfer_id"`
+	ScheduledFor           time.Time      `json:"scheduled_for"`
+	Status                 string         `json:"status"`
+	TransferID             sql.NullInt32  `json:"transfer_id"`
+	FailureReason          sql.NullString `json:"failure_reason"`
+	CreatedAt              sql.NullTime   `json:"created_at"`
+	UpdatedAt              sql.NullTime   `json:"updated_at"`
+}
+
+type SettlementBatch struct {
+	SettlementBatchID    int32        `json:"settlement_batch_id"`
+	MerchantID           int32        `json:"merchant_id"`
+	Currency             string       `json:"currency"`
+	SettlementCardNumber string       `json:"settlement_card_number"`
+	CutoffAt             time.Time    `json:"cutoff_at"`
+	GrossAmount          int32        `json:"gross_amount"`
+	FeeAmount            int32        `json:"fee_amount"`
+	RefundAmount         int32        `json:"refund_amount"`
+	AdjustmentAmount     int32        `json:"adjustment_amount"`
+	NetAmount            int32        `json:"net_amount"`
+	ItemCount            int32        `json:"item_count"`
+	CreatedAt            sql.NullTime `json:"created_at"`
+}
+
+type SettlementItem struct {
+	SettlementItemID  int32         `json:"settlement_item_id"`
+	MerchantID        int32         `json:"merchant_id"`
+	SettlementBatchID sql.NullInt32 `json:"settlement_batch_id"`
+	ItemType          string        `json:"item_type"`
+	ReferenceID       int32         `json:"reference_id"`
+	Amount            int32         `json:"amount"`
+	Fee               int32         `json:"fee"`
+	NetAmount         int32         `json:"net_amount"`
+	Currency          string        `json:"currency"`
+	CreatedAt         sql.NullTime  `json:"created_at"`
+}
+
+type StatusHistory struct {
+	StatusHistoryID int32          `json:"status_history_id"`
+	EntityType      string         `json:"entity_type"`
+	EntityID        int32          `json:"entity_id"`
+	FromStatus      string         `json:"from_status"`
+	ToStatus        string         `json:"to_status"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	Reason          sql.NullString `json:"reason"`
+	ChangedBy       sql.NullInt32  `json:"changed_by"`
 }
 
 type Topup struct {
@@ -76,6 +455,18 @@ type Topup struct {
 	CreatedAt   sql.NullTime `json:"created_at"`
 	UpdatedAt   sql.NullTime `json:"updated_at"`
 	DeletedAt   sql.NullTime `json:"deleted_at"`
+	Currency    string       `json:"currency"`
+	Fee         int32        `json:"fee"`
+}
+
+type TopupProviderPayment struct {
+	TopupProviderPaymentID int32          `json:"topup_provider_payment_id"`
+	TopupID                int32          `json:"topup_id"`
+	Provider               string         `json:"provider"`
+	ProviderReference      string         `json:"provider_reference"`
+	LastMessage            sql.NullString `json:"last_message"`
+	CreatedAt              sql.NullTime   `json:"created_at"`
+	UpdatedAt              sql.NullTime   `json:"updated_at"`
 }
 
 type Transaction struct {
@@ -90,19 +481,76 @@ type Transaction struct {
 	CreatedAt       sql.NullTime `json:"created_at"`
 	UpdatedAt       sql.NullTime `json:"updated_at"`
 	DeletedAt       sql.NullTime `json:"deleted_at"`
+	RefundedAmount  int32        `json:"refunded_amount"`
+	Currency        string       `json:"currency"`
+	Fee             int32        `json:"fee"`
+}
+
+type TransactionLimit struct {
+	TransactionLimitID      int32          `json:"transaction_limit_id"`
+	Name                    string         `json:"name"`
+	OperationType           string         `json:"operation_type"`
+	Period                  string         `json:"period"`
+	CardType                sql.NullString `json:"card_type"`
+	UserTier                sql.NullString `json:"user_tier"`
+	Currency                sql.NullString `json:"currency"`
+	MaxAmountPerTransaction sql.NullInt32  `json:"max_amount_per_transaction"`
+	MaxCount                sql.NullInt32  `json:"max_count"`
+	MaxTotalAmount          sql.NullInt32  `json:"max_total_amount"`
+	IsActive                bool           `json:"is_active"`
+	CreatedAt               sql.NullTime   `json:"created_at"`
+	UpdatedAt               sql.NullTime   `json:"updated_at"`
 }
 
 type Transfer struct {
-	TransferID     int32        `json:"transfer_id"`
-	TransferNo     uuid.UUID    `json:"transfer_no"`
-	TransferFrom   string       `json:"transfer_from"`
-	TransferTo     string       `json:"transfer_to"`
-	TransferAmount int32        `json:"transfer_amount"`
-	TransferTime   time.Time    `json:"transfer_time"`
-	Status         string       `json:"status"`
-	CreatedAt      sql.NullTime `json:"created_at"`
-	UpdatedAt      sql.NullTime `json:"updated_at"`
-	DeletedAt      sql.NullTime `json:"deleted_at"`
+	TransferID      int32          `json:"transfer_id"`
+	TransferNo      uuid.UUID      `json:"transfer_no"`
+	TransferFrom    string         `json:"transfer_from"`
+	TransferTo      string         `json:"transfer_to"`
+	TransferAmount  int32          `json:"transfer_amount"`
+	TransferTime    time.Time      `json:"transfer_time"`
+	Status          string         `json:"status"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	UpdatedAt       sql.NullTime   `json:"updated_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	Currency        string         `json:"currency"`
+	ToCurrency      string         `json:"to_currency"`
+	ConvertedAmount int32          `json:"converted_amount"`
+	ExchangeRate    sql.NullString `json:"exchange_rate"`
+	SpreadBps       sql.NullInt32  `json:"spread_bps"`
+	Fee             int32          `json:"fee"`
+}
+
+type TransferBatch struct {
+	TransferBatchID int32        `json:"transfer_batch_id"`
+	UserID          int32        `json:"user_id"`
+	TransferFrom    string       `json:"transfer_from"`
+	FileFormat      string       `json:"file_format"`
+	Status          string       `json:"status"`
+	TotalRows       int32        `json:"total_rows"`
+	TotalAmount     int32        `json:"total_amount"`
+	InvalidRows     int32        `json:"invalid_rows"`
+	SuccessRows     int32        `json:"success_rows"`
+	FailedRows      int32        `json:"failed_rows"`
+	SuccessAmount   int32        `json:"success_amount"`
+	StartedAt       sql.NullTime `json:"started_at"`
+	CompletedAt     sql.NullTime `json:"completed_at"`
+	CreatedAt       sql.NullTime `json:"created_at"`
+	UpdatedAt       sql.NullTime `json:"updated_at"`
+}
+
+type TransferBatchRow struct {
+	TransferBatchRowID int32          `json:"transfer_batch_row_id"`
+	TransferBatchID    int32          `json:"transfer_batch_id"`
+	RowNumber          int32          `json:"row_number"`
+	TransferTo         string         `json:"transfer_to"`
+	TransferAmount     int32          `json:"transfer_amount"`
+	Note               sql.NullString `json:"note"`
+	Status             string         `json:"status"`
+	TransferID         sql.NullInt32  `json:"transfer_id"`
+	FailureReason      sql.NullString `json:"failure_reason"`
+	CreatedAt          sql.NullTime   `json:"created_at"`
+	UpdatedAt          sql.NullTime   `json:"updated_at"`
 }
 
 type User struct {
@@ -114,6 +562,7 @@ type User struct {
 	CreatedAt sql.NullTime `json:"created_at"`
 	UpdatedAt sql.NullTime `json:"updated_at"`
 	DeletedAt sql.NullTime `json:"deleted_at"`
+	Tier      string       `json:"tier"`
 }
 
 type UserRole struct {
@@ -135,4 +584,16 @@ type Withdraw struct {
 	CreatedAt      sql.NullTime `json:"created_at"`
 	UpdatedAt      sql.NullTime `json:"updated_at"`
 	DeletedAt      sql.NullTime `json:"deleted_at"`
+	Currency       string       `json:"currency"`
+	Fee            int32        `json:"fee"`
+	PayoutProvider string       `json:"payout_provider"`
+}
+
+type WithdrawReview struct {
+	WithdrawReviewID int32          `json:"withdraw_review_id"`
+	WithdrawID       int32          `json:"withdraw_id"`
+	Decision         string         `json:"decision"`
+	Note             sql.NullString `json:"note"`
+	ReviewedBy       int32          `json:"reviewed_by"`
+	CreatedAt        sql.NullTime   `json:"created_at"`
 }
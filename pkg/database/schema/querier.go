@@ -8,9 +8,81 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/google/uuid"
 )
 
 type Querier interface {
+	// AcknowledgeBalanceDiscrepancy: Marks a discrepancy as known to an admin
+	// Parameters:
+	//   $1: balance_discrepancy_id - Discrepancy that is acknowledged
+	//   $2: acknowledged_by - Admin acknowledging it
+	//   $3: note - What the admin found or is doing about it
+	// Returns:
+	//   The acknowledged discrepancy, or no row when it is not open
+	AcknowledgeBalanceDiscrepancy(ctx context.Context, arg AcknowledgeBalanceDiscrepancyParams) (*BalanceDiscrepancy, error)
+	// AddMerchantPendingBalance: Accrues an amount to the pending balance of a merchant
+	// Purpose: Record what the platform owes a merchant until it is settled
+	// Parameters:
+	//   $1: merchant_id - Merchant the balance belongs to
+	//   $2: currency - Currency of the balance
+	//   $3: amount - Amount to add; negative amounts reduce the balance
+	// Returns:
+	//   The updated balance
+	// Business Logic:
+	//   - Creates the balance on the first accrual in a currency
+	//   - Locks the balance row until the surrounding transaction ends
+	AddMerchantPendingBalance(ctx context.Context, arg AddMerchantPendingBalanceParams) (*MerchantBalance, error)
+	// AddTransferBatchResult: Counts a finished row in its batch
+	// Purpose: Keep batch progress up to date without scanning its rows
+	// Parameters:
+	//   $1: success_rows - Successful rows to add
+	//   $2: failed_rows - Failed rows to add
+	//   $3: success_amount - Amount transferred to add
+	//   $4: transfer_batch_id - Unique identifier of the batch
+	// Returns:
+	//   The updated transfer batch record
+	AddTransferBatchResult(ctx context.Context, arg AddTransferBatchResultParams) (*TransferBatch, error)
+	// AdvanceScheduledTransfer: Moves a schedule past the run that was just claimed
+	// Purpose: Set the next occurrence, or complete a schedule with nothing left to run
+	// Parameters:
+	//   $1: scheduled_transfer_id - Unique identifier of the schedule
+	//   $2: next_run_at - When the next run is due (NULL when completed)
+	//   $3: status - active, or completed when nothing is left to run
+	//   $4: last_run_at - The occurrence that was claimed
+	// Returns:
+	//   The updated scheduled transfer record
+	AdvanceScheduledTransfer(ctx context.Context, arg AdvanceScheduledTransferParams) (*ScheduledTransfer, error)
+	// ApplyLedgerPostingToSaldo: Projects a card posting onto the saldo balance
+	// Purpose: Keep saldos.total_balance in step with the ledger
+	// Parameters:
+	//   $1: delta - Signed amount (credit positive, debit negative)
+	//   $2: card_number - Card whose saldo is adjusted
+	//   $3: currency - Currency of the posting
+	// Returns:
+	//   The updated saldo record
+	// Business Logic:
+	//   - Applies a relative change instead of overwriting the balance
+	//   - Only active saldos are projected
+	//   - Refuses to drive the balance below the reserved balance, so debits
+	//     cannot spend funds set aside by active saldo holds; no row is returned then
+	//   - Postings in another currency than the saldo are refused the same way
+	ApplyLedgerPostingToSaldo(ctx context.Context, arg ApplyLedgerPostingToSaldoParams) (*Saldo, error)
+	// ApplyTransactionRefund: Records a refunded amount against a transaction
+	// Purpose: Track cumulative refunds and move the transaction to a refund status
+	// Parameters:
+	//   $1: transaction_id - ID of the refunded transaction
+	//   $2: amount - Amount being refunded
+	// Returns:
+	//   The updated transaction record, or no row if the refund is not allowed
+	// Business Logic:
+	//   - Only successful or partially refunded transactions can be refunded
+	//   - Cumulative refunds are capped at the original amount
+	//   - Status becomes 'refunded' once fully refunded, otherwise 'partially_refunded'
+	//   - The check and the increment happen in one statement, so concurrent
+	//     refunds cannot exceed the original amount
+	//   - The status change is written to status_histories by the same statement
+	ApplyTransactionRefund(ctx context.Context, arg ApplyTransactionRefundParams) (*Transaction, error)
 	// AssignRoleToUser: Assigns a role to a user (creates a user-role relation)
 	// Purpose: Role management for user access control
 	// Parameters:
@@ -22,6 +94,120 @@ type Querier interface {
 	//   - Adds a new entry in the user_roles mapping table
 	//   - Timestamps created_at and updated_at auto-set to current
 	AssignRoleToUser(ctx context.Context, arg AssignRoleToUserParams) (*UserRole, error)
+	// AssignSettlementItems: Moves the unsettled items of a merchant balance into a batch
+	// Purpose: Mark the items a batch paid out as settled
+	// Parameters:
+	//   $1: settlement_batch_id - Batch the items are settled by
+	//   $2: merchant_id - Merchant the items belong to
+	//   $3: currency - Currency of the items
+	//   $4: cutoff_at - Only items accrued before this time are moved
+	AssignSettlementItems(ctx context.Context, arg AssignSettlementItemsParams) error
+	// CancelInvoice: Cancels an open invoice
+	// Purpose: Let a merchant withdraw an invoice or payment link
+	// Parameters:
+	//   $1: invoice_id - ID of the invoice
+	// Returns:
+	//   The updated invoice, or no row if it is no longer open
+	CancelInvoice(ctx context.Context, invoiceID int32) (*Invoice, error)
+	// CancelScheduledTransfer: Ends a schedule for good
+	// Purpose: Stop all future runs of a schedule
+	// Parameters:
+	//   $1: scheduled_transfer_id - Unique identifier of the schedule
+	// Returns:
+	//   The cancelled scheduled transfer record
+	// Business Logic:
+	//   - Run history is kept
+	CancelScheduledTransfer(ctx context.Context, scheduledTransferID int32) (*ScheduledTransfer, error)
+	// CaptureAuthorization: Captures a pending authorization
+	// Purpose: Turn reserved funds into a merchant payment
+	// Parameters:
+	//   $1: authorization_id - ID of the authorization
+	//   $2: captured_amount - Amount captured, at most the authorized amount
+	//   $3: transaction_id - Transaction created for the capture
+	// Returns:
+	//   The updated authorization record, or no row if it cannot be captured
+	// Business Logic:
+	//   - Only 'authorized' and unexpired authorizations can be captured
+	//   - An authorization is captured once; any uncaptured remainder is released
+	CaptureAuthorization(ctx context.Context, arg CaptureAuthorizationParams) (*Authorization, error)
+	// ChargeBackTransaction: Records a chargeback against a disputed transaction
+	// Purpose: Close a dispute that was ruled in favour of the cardholder
+	// Parameters:
+	//   $1: transaction_id - ID of the disputed transaction
+	//   $2: amount - Amount returned to the cardholder
+	// Returns:
+	//   The updated transaction record, or no row if the chargeback is not allowed
+	// Business Logic:
+	//   - Only disputed transactions can be charged back
+	//   - The charged back amount counts towards refunded_amount, so merchant
+	//     analytics stay net of it
+	//   - Status becomes 'charged_back'
+	//   - The status change is written to status_histories by the same statement
+	ChargeBackTransaction(ctx context.Context, arg ChargeBackTransactionParams) (*Transaction, error)
+	// ClaimDueMerchantWebhookDeliveries: Claims the deliveries due for an attempt
+	// Purpose: Feed the background delivery job
+	// Parameters:
+	//   $1: limit - Maximum number of deliveries to claim
+	//   $2: lease_until - When a claimed delivery may be picked up again
+	// Returns:
+	//   The claimed deliveries
+	// Business Logic:
+	//   - Claimed rows are skipped by concurrent workers and pushed back to
+	//     lease_until, so a worker that dies mid-delivery only delays them
+	//   - Deliveries of inactive or deleted endpoints are left alone
+	ClaimDueMerchantWebhookDeliveries(ctx context.Context, arg ClaimDueMerchantWebhookDeliveriesParams) ([]*MerchantWebhookDelivery, error)
+	// ClaimScheduledTransferRun: Records that a run of a schedule is starting
+	// Purpose: Make sure every occurrence of a schedule is executed at most once
+	// Parameters:
+	//   $1: scheduled_transfer_id - Unique identifier of the schedule
+	//   $2: scheduled_for - The next_run_at the executor saw when picking the schedule
+	// Returns:
+	//   The created run, or no row when the run was already claimed, or the
+	//   schedule was paused, cancelled or moved in the meantime
+	// Business Logic:
+	//   - The schedule row is locked so pausing it waits for the claim to commit
+	ClaimScheduledTransferRun(ctx context.Context, arg ClaimScheduledTransferRunParams) (*ScheduledTransferRun, error)
+	// ClaimTransferBatchRow: Records that a row is about to run
+	// Purpose: Make sure every row is executed at most once
+	// Parameters:
+	//   $1: transfer_batch_row_id - Unique identifier of the row
+	// Returns:
+	//   The processing row, or no row when another executor claimed it first
+	ClaimTransferBatchRow(ctx context.Context, transferBatchRowID int32) (*TransferBatchRow, error)
+	// CompleteTransferBatch: Ends a batch that has no pending row left
+	// Purpose: Record the final outcome of a batch
+	// Parameters:
+	//   $1: transfer_batch_id - Unique identifier of the batch
+	//   $2: status - completed, or completed_with_errors when a row did not succeed
+	// Returns:
+	//   The completed transfer batch record
+	CompleteTransferBatch(ctx context.Context, arg CompleteTransferBatchParams) (*TransferBatch, error)
+	// ConsumeSaldoHold: Consumes an active hold
+	// Purpose: Mark held funds as spent by the operation that placed the hold
+	// Parameters:
+	//   $1: hold_id - ID of the hold
+	// Returns:
+	//   The updated hold record, or no row if the hold is no longer active
+	// Business Logic:
+	//   - The caller releases the reservation and posts the debit journal in the
+	//     same transaction
+	ConsumeSaldoHold(ctx context.Context, holdID int32) (*SaldoHold, error)
+	// CreateAuthorization: Creates a pending card authorization
+	// Purpose: Record funds reserved on a card for a later merchant capture
+	// Parameters:
+	//   $1: card_number - Card whose funds are reserved
+	//   $2: merchant_id - Merchant that requested the authorization
+	//   $3: amount - Reserved amount
+	//   $4: payment_method - Payment method used at checkout
+	//   $5: expires_at - Time after which the authorization lapses
+	// Returns:
+	//   The created authorization record
+	// Business Logic:
+	//   - Authorization number is generated automatically
+	//   - Status starts as 'authorized'
+	//   - The caller reserves the amount on the card's saldo in the same transaction
+	//   - The amount is recorded in the currency of the card
+	CreateAuthorization(ctx context.Context, arg CreateAuthorizationParams) (*Authorization, error)
 	// CreateCard: Creates a new card record
 	// Purpose: Add a new card to the system for a specific user
 	// Parameters:
@@ -31,18 +217,142 @@ type Querier interface {
 	//   $4: expire_date - Expiration date of the card
 	//   $5: cvv - Card verification value
 	//   $6: card_provider - Provider/issuer of the card
+	//   $7: currency - ISO 4217 code the card balance is kept in
 	// Returns: Complete created card record
 	// Business Logic:
 	//   - Automatically sets created_at and updated_at timestamps
 	//   - Requires all fields to be provided
+	//   - The currency cannot be changed once the card is issued
 	CreateCard(ctx context.Context, arg CreateCardParams) (*Card, error)
+	// CreateDispute: Opens a dispute on a merchant transaction
+	// Purpose: Record a cardholder contesting a merchant charge
+	// Parameters:
+	//   $1: transaction_id - ID of the disputed transaction
+	//   $2: card_number - Card that paid the transaction
+	//   $3: merchant_id - Merchant that received the payment
+	//   $4: amount - Disputed amount held from the merchant
+	//   $5: reason - Reason given by the cardholder
+	//   $6: opened_by - User who opened the dispute
+	//   $7: held_from - Where the disputed amount is held from: merchant_card or merchant_balance
+	// Returns:
+	//   The created dispute record
+	// Business Logic:
+	//   - Dispute number is generated automatically
+	//   - Status starts as 'open'
+	//   - Only one open or under-review dispute may exist per transaction
+	//   - The amount is recorded in the currency of the disputed transaction
+	CreateDispute(ctx context.Context, arg CreateDisputeParams) (*Dispute, error)
+	// CreateFeeSchedule: Creates a new fee schedule
+	// Purpose: Define how much the platform charges for a type of transaction
+	// Parameters:
+	//   $1: name - Display name of the schedule
+	//   $2: transaction_type - transfer, withdraw, topup or transaction
+	//   $3: fee_type - flat, percentage or tiered
+	//   $4: payment_method - Payment or topup method the schedule is limited to (NULL for any)
+	//   $5: card_type - Card type the schedule is limited to (NULL for any)
+	//   $6: currency - Currency the schedule is limited to (NULL for any)
+	//   $7: flat_amount - Fixed fee in minor units, used by flat schedules
+	//   $8: percentage_bps - Fee rate in basis points, used by percentage schedules
+	//   $9: min_fee - Lower bound of the computed fee (NULL for none)
+	//   $10: max_fee - Upper bound of the computed fee (NULL for none)
+	//   $11: is_active - Whether the schedule is applied to new transactions
+	// Returns:
+	//   The created fee schedule record
+	// Business Logic:
+	//   - Tiers of tiered schedules are stored separately in fee_schedule_tiers
+	CreateFeeSchedule(ctx context.Context, arg CreateFeeScheduleParams) (*FeeSchedule, error)
+	// CreateFeeScheduleTier: Adds a tier to a tiered fee schedule
+	// Purpose: Price transactions differently depending on their amount
+	// Parameters:
+	//   $1: fee_schedule_id - Schedule the tier belongs to
+	//   $2: min_amount - Smallest amount the tier applies to
+	//   $3: flat_amount - Fixed part of the fee
+	//   $4: percentage_bps - Proportional part of the fee in basis points
+	// Returns:
+	//   The created tier record
+	CreateFeeScheduleTier(ctx context.Context, arg CreateFeeScheduleTierParams) (*FeeScheduleTier, error)
+	// CreateIdempotencyKey: Reserves an idempotency key for a request
+	// Purpose: Claim a client supplied key before a money-creating mutation runs
+	// Parameters:
+	//   $1: user_id - ID of the user sending the request
+	//   $2: scope - Mutation the key belongs to (e.g. create_transfer)
+	//   $3: idempotency_key - The key supplied by the client
+	//   $4: request_fingerprint - SHA-256 hash of the request payload
+	//   $5: ttl_seconds - Seconds from now after which the key may be reused
+	// Returns: The reserved key record, or no row if a live key already exists
+	// Business Logic:
+	//   - Keys are unique per user and scope
+	//   - The expiry is computed from the database clock, the same one it is
+	//     compared with, so it does not depend on the time zone of the server
+	//   - An expired key is taken over and its stored response cleared
+	//   - A live key is left untouched so the caller can replay or reject
+	CreateIdempotencyKey(ctx context.Context, arg CreateIdempotencyKeyParams) (*IdempotencyKey, error)
+	// CreateInvoice: Creates an open invoice or payment link
+	// Purpose: Let a merchant ask a customer for a payment
+	// Parameters:
+	//   $1: code - Public code customers pay with
+	//   $2: merchant_id - Merchant that is paid
+	//   $3: kind - 'invoice' or 'payment_link'
+	//   $4: description - What the payment is for
+	//   $5: amount - Amount to pay
+	//   $6: currency - Currency of the merchant
+	//   $7: expires_at - Time after which it can no longer be paid
+	// Returns:
+	//   The created invoice
+	CreateInvoice(ctx context.Context, arg CreateInvoiceParams) (*Invoice, error)
+	// CreateInvoiceLineItem: Adds a line item to an invoice
+	// Purpose: Break an invoice down into what is charged
+	// Parameters:
+	//   $1: invoice_id - Invoice the line belongs to
+	//   $2: description - What is charged
+	//   $3: quantity - How many units
+	//   $4: unit_price - Price of one unit
+	//   $5: amount - quantity times unit_price
+	// Returns:
+	//   The created line item
+	CreateInvoiceLineItem(ctx context.Context, arg CreateInvoiceLineItemParams) (*InvoiceLineItem, error)
+	// CreateInvoicePayment: Links a payment of an invoice to its transaction
+	// Purpose: Record what paid an invoice or payment link
+	// Parameters:
+	//   $1: invoice_id - Invoice that was paid
+	//   $2: transaction_id - Merchant transaction the payment created
+	//   $3: amount - Amount paid
+	// Returns:
+	//   The created payment
+	CreateInvoicePayment(ctx context.Context, arg CreateInvoicePaymentParams) (*InvoicePayment, error)
+	// CreateLedgerJournal: Opens a new journal entry in the ledger
+	// Purpose: Group the postings that together describe one money movement
+	// Parameters:
+	//   $1: reference_type - Kind of business event (topup, withdraw, transfer, transaction, ...)
+	//   $2: reference_id - ID of the originating record (NULL for manual entries)
+	//   $3: description - Human readable explanation of the entry
+	// Returns:
+	//   The created journal record
+	// Business Logic:
+	//   - Journals are append-only and never updated or soft-deleted
+	//   - posted_at and journal_no are generated by the database
+	CreateLedgerJournal(ctx context.Context, arg CreateLedgerJournalParams) (*LedgerJournal, error)
+	// CreateLedgerPosting: Adds a debit or credit line to a journal
+	// Purpose: Record one side of a money movement against an account
+	// Parameters:
+	//   $1: journal_id - Journal the posting belongs to
+	//   $2: account_code - CARD for card accounts, otherwise a system account code
+	//   $3: card_number - Card account being posted to (NULL for system accounts)
+	//   $4: direction - 'debit' or 'credit'
+	//   $5: amount - Positive amount of the posting
+	//   $6: currency - Currency the amount is expressed in
+	// Returns:
+	//   The created posting record
+	// Business Logic:
+	//   - Postings are append-only; corrections are made with new journals
+	//   - The caller is responsible for keeping debits and credits balanced per journal and currency
+	CreateLedgerPosting(ctx context.Context, arg CreateLedgerPostingParams) (*LedgerPosting, error)
 	// Create Merchant
 	// Purpose: Insert a new merchant record into the database
 	// Parameters:
 	//   $1: name - The name of the merchant
-	//   $2: api_key - Unique API key for the merchant
-	//   $3: user_id - ID of the user associated with the merchant
-	//   $4: status - Current status of the merchant (e.g., active, inactive)
+	//   $2: user_id - ID of the user associated with the merchant
+	//   $3: status - Current status of the merchant (e.g., active, inactive)
 	// Returns:
 	//   - The newly created merchant record
 	// Business Logic:
@@ -50,6 +360,88 @@ type Querier interface {
 	//   - Sets the created_at and updated_at timestamps to the current time.
 	//   - Returns the created merchant's data using the RETURNING clause.
 	CreateMerchant(ctx context.Context, arg CreateMerchantParams) (*Merchant, error)
+	// CreateMerchantApiKey: Issues an API key for a merchant
+	// Purpose: Give a merchant a new key, with the scopes it may be used for
+	// Parameters:
+	//   $1: merchant_id - Merchant the key belongs to
+	//   $2: label - Name the merchant gave the key
+	//   $3: key_prefix - First characters of the key, shown to tell keys apart
+	//   $4: key_hash - SHA-256 hash of the key
+	//   $5: scopes - What the key may be used for
+	//   $6: expires_at - When the key stops working (NULL for never)
+	//   $7: signing_secret - Secret requests made with the key are signed with
+	// Returns:
+	//   The created key
+	CreateMerchantApiKey(ctx context.Context, arg CreateMerchantApiKeyParams) (*MerchantApiKey, error)
+	// CreateMerchantRequestNonce: Records the nonce of a signed request
+	// Purpose: Reject a signed request that is sent again
+	// Parameters:
+	//   $1: merchant_api_key_id - Key the request was signed with
+	//   $2: nonce - Nonce the merchant picked for the request
+	// Returns:
+	//   The recorded nonce, or no row when the key already used it
+	CreateMerchantRequestNonce(ctx context.Context, arg CreateMerchantRequestNonceParams) (*MerchantRequestNonce, error)
+	// CreateMerchantWebhookDelivery: Queues an event for a single endpoint
+	// Purpose: Send a test ping or redeliver an event
+	// Parameters:
+	//   $1: merchant_webhook_endpoint_id - Endpoint the event is sent to
+	//   $2: event_id - Identifier of the event
+	//   $3: event_type - Type of the event
+	//   $4: payload - JSON body of the event
+	//   $5: next_attempt_at - When the delivery job may pick the delivery up
+	// Returns:
+	//   The created delivery
+	CreateMerchantWebhookDelivery(ctx context.Context, arg CreateMerchantWebhookDeliveryParams) (*MerchantWebhookDelivery, error)
+	// CreateMerchantWebhookDeliveryAttempt: Adds an attempt to the delivery log
+	// Purpose: Keep the response code and timing of every attempt
+	// Parameters:
+	//   $1: merchant_webhook_delivery_id - Delivery the attempt belongs to
+	//   $2: attempt - Number of the attempt, starting at 1
+	//   $3: response_code - Code the endpoint answered with (NULL when it could not be reached)
+	//   $4: error - Why the attempt failed (NULL when it succeeded)
+	//   $5: duration_ms - How long the attempt took
+	// Returns:
+	//   The created attempt
+	CreateMerchantWebhookDeliveryAttempt(ctx context.Context, arg CreateMerchantWebhookDeliveryAttemptParams) (*MerchantWebhookDeliveryAttempt, error)
+	// CreateMerchantWebhookEndpoint: Registers a webhook endpoint for a merchant
+	// Purpose: Let a merchant receive payment events instead of polling
+	// Parameters:
+	//   $1: merchant_id - Merchant the endpoint belongs to
+	//   $2: url - Address the events are posted to
+	//   $3: secret - Secret the deliveries are signed with
+	//   $4: events - Event types the endpoint subscribes to
+	// Returns:
+	//   The created endpoint
+	CreateMerchantWebhookEndpoint(ctx context.Context, arg CreateMerchantWebhookEndpointParams) (*MerchantWebhookEndpoint, error)
+	// CreateProviderWebhookEvent: Stores a callback received from a payment provider
+	// Purpose: Keep the raw callback before it is applied
+	// Parameters:
+	//   $1: provider - Name of the provider that sent the callback
+	//   $2: event_id - Identifier of the callback at the provider
+	//   $3: event_type - Kind of callback, topup.payment or withdraw.payout
+	//   $4: payload - Raw body of the callback
+	//   $5: signature - Signature the callback was sent with
+	//   $6: sent_at - Time the provider signed the callback
+	// Returns:
+	//   The created event, or no row when the provider already sent this event ID
+	// Business Logic:
+	//   - (provider, event_id) is unique, so redelivered callbacks are stored once
+	CreateProviderWebhookEvent(ctx context.Context, arg CreateProviderWebhookEventParams) (*ProviderWebhookEvent, error)
+	// CreateQrPayment: Links a merchant transaction to the QR code it paid
+	// Purpose: Record QR payments and keep a dynamic QR code from being paid twice
+	// Parameters:
+	//   $1: merchant_id - Merchant that was paid
+	//   $2: transaction_id - Merchant transaction the payment created
+	//   $3: reference - Reference of a dynamic QR code, NULL for static ones
+	// Returns:
+	//   The created payment, or no row when the merchant was already paid for
+	//   the reference
+	CreateQrPayment(ctx context.Context, arg CreateQrPaymentParams) (*QrPayment, error)
+	// CreateReconciliationRun: Starts a reconciliation run
+	// Purpose: Record when a run started so its discrepancies can refer to it
+	// Returns:
+	//   The created run
+	CreateReconciliationRun(ctx context.Context) (*ReconciliationRun, error)
 	// CreateRefreshToken: Creates a new refresh token
 	// Purpose: Generate a refresh token for user authentication
 	// Parameters:
@@ -62,6 +454,21 @@ type Querier interface {
 	//   - Used in JWT refresh token rotation
 	//   - Typically created during login/auth flows
 	CreateRefreshToken(ctx context.Context, arg CreateRefreshTokenParams) (*RefreshToken, error)
+	// CreateRefund: Creates a refund for a merchant transaction
+	// Purpose: Record money returned from a merchant to the paying card
+	// Parameters:
+	//   $1: transaction_id - ID of the refunded transaction
+	//   $2: card_number - Card that receives the refund
+	//   $3: merchant_id - Merchant that pays the refund
+	//   $4: amount - Refunded amount
+	//   $5: reason - Reason given by the merchant
+	// Returns:
+	//   The created refund record
+	// Business Logic:
+	//   - Refund number is generated automatically
+	//   - Status defaults to 'success'
+	//   - The amount is recorded in the currency of the refunded transaction
+	CreateRefund(ctx context.Context, arg CreateRefundParams) (*Refund, error)
 	// CreateRole: Inserts a new role into the system
 	// Purpose: Add new role definitions (e.g., Admin, Cashier, etc.)
 	// Parameters:
@@ -79,7 +486,67 @@ type Querier interface {
 	// Business Logic:
 	//   - Sets creation and update timestamps automatically
 	//   - Used when issuing new cards
+	//   - The saldo is kept in the currency of the card
 	CreateSaldo(ctx context.Context, arg CreateSaldoParams) (*Saldo, error)
+	// CreateSaldoHold: Records a hold on a card's funds
+	// Purpose: Track money set aside for a pending operation
+	// Parameters:
+	//   $1: card_number - Card whose funds are held
+	//   $2: amount - Held amount
+	//   $3: reason - Why the funds are held
+	//   $4: reference_type - Operation that placed the hold (NULL for manual holds)
+	//   $5: reference_id - ID of that operation (NULL for manual holds)
+	//   $6: created_by - User who placed a manual hold (NULL otherwise)
+	// Returns:
+	//   The created hold record
+	// Business Logic:
+	//   - Status starts as 'active'
+	//   - The caller reserves the amount on the card's saldo in the same transaction
+	//   - The amount is recorded in the currency of the card
+	CreateSaldoHold(ctx context.Context, arg CreateSaldoHoldParams) (*SaldoHold, error)
+	// CreateScheduledTransfer: Creates a new scheduled transfer
+	// Purpose: Set up a future or recurring transfer between two cards
+	// Parameters:
+	//   $1: user_id - Owner of the sending card
+	//   $2: transfer_from - Card the funds are taken from
+	//   $3: transfer_to - Card the funds are sent to
+	//   $4: transfer_amount - Amount of every run
+	//   $5: recurrence - once, daily, weekly or monthly
+	//   $6: day_of_month - Day monthly schedules run on (NULL otherwise)
+	//   $7: next_run_at - When the first run is due
+	//   $8: end_at - No run is scheduled after this moment (NULL for no end)
+	// Returns:
+	//   The created scheduled transfer record
+	CreateScheduledTransfer(ctx context.Context, arg CreateScheduledTransferParams) (*ScheduledTransfer, error)
+	// CreateSettlementBatch: Records the payout of a merchant balance
+	// Purpose: Keep the payout report of a settlement
+	// Parameters:
+	//   $1: merchant_id - Merchant that is paid out
+	//   $2: currency - Currency of the payout
+	//   $3: settlement_card_number - Card the payout is credited to
+	//   $4: cutoff_at - Items accrued before this time are part of the batch
+	//   $5: gross_amount - Sum of the payments
+	//   $6: fee_amount - Sum of the fees withheld
+	//   $7: refund_amount - Sum of the refunds
+	//   $8: adjustment_amount - Sum of the amount adjustments
+	//   $9: net_amount - Amount paid out
+	//   $10: item_count - Number of items in the batch
+	// Returns:
+	//   The created batch
+	CreateSettlementBatch(ctx context.Context, arg CreateSettlementBatchParams) (*SettlementBatch, error)
+	// CreateSettlementItem: Records a line item of a merchant balance
+	// Purpose: Keep the payments, refunds and adjustments a settlement is made of
+	// Parameters:
+	//   $1: merchant_id - Merchant the item belongs to
+	//   $2: item_type - transaction, refund or adjustment
+	//   $3: reference_id - Transaction or refund the item was recorded for
+	//   $4: amount - Amount paid, refunded or adjusted
+	//   $5: fee - Fee withheld from the amount
+	//   $6: net_amount - What the item adds to the balance
+	//   $7: currency - Currency of the item
+	// Returns:
+	//   The created item
+	CreateSettlementItem(ctx context.Context, arg CreateSettlementItemParams) (*SettlementItem, error)
 	// CreateTopup: Inserts a new topup transaction into the topups table
 	// Purpose: Used when a user performs a topup action
 	// Parameters:
@@ -87,11 +554,23 @@ type Querier interface {
 	//   $2: topup_amount - Amount of the topup
 	//   $3: topup_method - Payment method used (e.g., 'e-wallet', 'bank_transfer')
 	//   $4: topup_time - Timestamp of the actual topup transaction
+	//   $5: fee - Fee kept out of the amount credited to the card
 	// Returns:
 	//   Full topup record including auto-generated fields
 	// Business Logic:
 	//   - Automatically sets created_at and updated_at to current timestamp
+	//   - The amount is recorded in the currency of the card
 	CreateTopup(ctx context.Context, arg CreateTopupParams) (*Topup, error)
+	// CreateTopupProviderPayment: Records the provider payment of a topup
+	// Purpose: Remember which provider collects a topup and under which reference
+	// Parameters:
+	//   $1: topup_id - Topup being paid
+	//   $2: provider - Name of the provider adapter
+	//   $3: provider_reference - Reference of the payment at the provider
+	//   $4: last_message - Message returned by the provider (NULL when none)
+	// Returns:
+	//   The created provider payment record
+	CreateTopupProviderPayment(ctx context.Context, arg CreateTopupProviderPaymentParams) (*TopupProviderPayment, error)
 	// CreateTransaction: Creates a new transaction record
 	// Purpose: Record a financial transaction in the system
 	// Parameters:
@@ -100,12 +579,30 @@ type Querier interface {
 	//   $3: payment_method - Payment method used (e.g., 'credit', 'debit')
 	//   $4: merchant_id - ID of the merchant where transaction occurred
 	//   $5: transaction_time - Timestamp of when transaction occurred
+	//   $6: fee - Merchant discount rate kept out of the amount credited to the merchant
 	// Returns:
 	//   The newly created transaction record with all fields
 	// Business Logic:
 	//   - Sets creation and update timestamps automatically
 	//   - Used for recording purchases, payments, and other financial activities
+	//   - The amount is recorded in the currency of the paying card
 	CreateTransaction(ctx context.Context, arg CreateTransactionParams) (*Transaction, error)
+	// CreateTransactionLimit: Creates a new transaction limit
+	// Purpose: Cap how much and how often a card may move funds
+	// Parameters:
+	//   $1: name - Display name of the limit
+	//   $2: operation_type - transfer, withdraw or transaction
+	//   $3: period - daily or monthly
+	//   $4: card_type - Card type the limit is restricted to (NULL for any)
+	//   $5: user_tier - User tier the limit is restricted to (NULL for any)
+	//   $6: currency - Card currency the limit is restricted to (NULL for any)
+	//   $7: max_amount_per_transaction - Largest single amount allowed (NULL for no cap)
+	//   $8: max_count - Number of operations allowed in the period (NULL for no cap)
+	//   $9: max_total_amount - Cumulative amount allowed in the period (NULL for no cap)
+	//   $10: is_active - Whether the limit is enforced
+	// Returns:
+	//   The created transaction limit record
+	CreateTransactionLimit(ctx context.Context, arg CreateTransactionLimitParams) (*TransactionLimit, error)
 	// CreateTransfer: Records a new transfer transaction
 	// Purpose: Create a transfer between accounts/cards
 	// Parameters:
@@ -114,12 +611,43 @@ type Querier interface {
 	//   $3: transfer_amount - Amount transferred
 	//   $4: transfer_time - When the transfer occurred
 	//   $5: status - Initial status of the transfer
+	//   $6: converted_amount - Amount credited to the receiver in its currency
+	//   $7: exchange_rate - Rate applied when the cards use different currencies (NULL otherwise)
+	//   $8: spread_bps - Spread applied on top of the rate in basis points (NULL otherwise)
+	//   $9: fee - Fee charged to the sender on top of transfer_amount
 	// Returns:
 	//   The newly created transfer record
 	// Business Logic:
 	//   - Sets creation and update timestamps automatically
 	//   - Used for recording money movements between accounts
+	//   - currency and to_currency are copied from the sender and receiver cards
 	CreateTransfer(ctx context.Context, arg CreateTransferParams) (*Transfer, error)
+	// CreateTransferBatch: Creates a new transfer batch
+	// Purpose: Record an uploaded list of transfers from one source card
+	// Parameters:
+	//   $1: user_id - Owner of the source card
+	//   $2: transfer_from - Card every row is paid from
+	//   $3: file_format - csv or json
+	//   $4: status - pending, or rejected when a row is invalid
+	//   $5: total_rows - Number of rows in the upload
+	//   $6: total_amount - Sum of the row amounts
+	//   $7: invalid_rows - Number of rows that failed validation
+	// Returns:
+	//   The created transfer batch record
+	CreateTransferBatch(ctx context.Context, arg CreateTransferBatchParams) (*TransferBatch, error)
+	// CreateTransferBatchRow: Adds a row to a transfer batch
+	// Purpose: Keep every uploaded row with its validation outcome
+	// Parameters:
+	//   $1: transfer_batch_id - Batch the row belongs to
+	//   $2: row_number - Position of the row in the upload, starting at 1
+	//   $3: transfer_to - Card the row pays
+	//   $4: transfer_amount - Amount of the row
+	//   $5: note - Free text of the row (NULL when empty)
+	//   $6: status - pending, or invalid when validation failed
+	//   $7: failure_reason - Why the row is invalid (NULL otherwise)
+	// Returns:
+	//   The created row record
+	CreateTransferBatchRow(ctx context.Context, arg CreateTransferBatchRowParams) (*TransferBatchRow, error)
 	// CreateUser: Insert a new user into the users table
 	// Purpose: Add a new user to the system.
 	// Parameters:
@@ -139,13 +667,37 @@ type Querier interface {
 	//   $2: withdraw_amount - The amount withdrawn
 	//   $3: withdraw_time - When the withdrawal occurred
 	//   $4: created_at - Timestamp of record creation
+	//   $5: fee - Fee charged to the card on top of withdraw_amount
+	//   $6: payout_provider - Provider expected to report the payout outcome
 	// Returns:
 	//   The newly created withdrawal record with all fields
 	// Business Logic:
 	//   - Sets creation and update timestamps automatically
 	//   - Used for recording ATM/branch cash withdrawals
 	//   - Typically triggered after successful cash dispense
+	//   - The amount is recorded in the currency of the card
 	CreateWithdraw(ctx context.Context, arg CreateWithdrawParams) (*Withdraw, error)
+	// CreateWithdrawReview: Records the review decision of a withdraw
+	// Purpose: Keep who approved or rejected a withdraw that waited for review
+	// Parameters:
+	//   $1: withdraw_id - Reviewed withdraw
+	//   $2: decision - approved or rejected
+	//   $3: note - Reason given by the reviewer (NULL when none)
+	//   $4: reviewed_by - Admin who took the decision
+	// Returns:
+	//   The created review record
+	// Business Logic:
+	//   - A withdraw is reviewed at most once
+	CreateWithdrawReview(ctx context.Context, arg CreateWithdrawReviewParams) (*WithdrawReview, error)
+	// DeductMerchantPendingBalance: Takes an amount out of the pending balance of a merchant
+	// Purpose: Fund a refund or dispute hold from money that was not paid out yet
+	// Parameters:
+	//   $1: merchant_id - Merchant the balance belongs to
+	//   $2: currency - Currency of the balance
+	//   $3: amount - Amount to take out
+	// Returns:
+	//   The updated balance, or no row when the balance does not cover the amount
+	DeductMerchantPendingBalance(ctx context.Context, arg DeductMerchantPendingBalanceParams) (*MerchantBalance, error)
 	// DeleteAllPermanentCards: Permanently deletes all trashed cards
 	// Purpose: Bulk-delete all cards that have been soft-deleted
 	// Parameters: None
@@ -213,6 +765,38 @@ type Querier interface {
 	// Business Logic:
 	//   - Only deletes cards that are currently trashed
 	DeleteCardPermanently(ctx context.Context, cardID int32) error
+	// DeleteExchangeRate: Removes the rate of a currency pair
+	// Purpose: Stop conversions between two currencies
+	// Parameters:
+	//   $1: exchange_rate_id - Unique identifier of the rate
+	// Returns:
+	//   The deleted exchange rate record
+	// Business Logic:
+	//   - Transfers keep the rate they were converted with, so rates can be removed safely
+	DeleteExchangeRate(ctx context.Context, exchangeRateID int32) (*ExchangeRate, error)
+	// DeleteFeeSchedule: Removes a fee schedule
+	// Purpose: Stop charging a fee
+	// Parameters:
+	//   $1: fee_schedule_id - Unique identifier of the schedule
+	// Returns:
+	//   The deleted fee schedule record
+	// Business Logic:
+	//   - Tiers are removed along with the schedule
+	//   - Fees already charged are stored on their transaction and are kept
+	DeleteFeeSchedule(ctx context.Context, feeScheduleID int32) (*FeeSchedule, error)
+	// DeleteFeeScheduleTiers: Removes every tier of a fee schedule
+	// Purpose: Replace the tiers of a schedule when it is updated
+	// Parameters:
+	//   $1: fee_schedule_id - Schedule whose tiers are removed
+	DeleteFeeScheduleTiers(ctx context.Context, feeScheduleID int32) error
+	// DeleteIdempotencyKey: Releases a reserved idempotency key
+	// Purpose: Allow the client to retry after the request failed
+	// Parameters:
+	//   $1: idempotency_key_id - ID of the reserved key
+	// Returns: Nothing
+	// Business Logic:
+	//   - Only keys without a stored response are released
+	DeleteIdempotencyKey(ctx context.Context, idempotencyKeyID int32) error
 	// Delete Merchant Permanently
 	// Purpose: Permanently delete a merchant from the database
 	// Parameters:
@@ -221,6 +805,24 @@ type Querier interface {
 	//   - Deletes the specified merchant from the database.
 	//   - Ensures the merchant is marked as deleted (deleted_at is not NULL).
 	DeleteMerchantPermanently(ctx context.Context, merchantID int32) error
+	// DeleteMerchantRequestNoncesBefore: Prunes old nonces
+	// Purpose: Keep the nonce store small
+	// Parameters:
+	//   $1: created_at - Nonces recorded before this time are deleted
+	// Business Logic:
+	//   - Only called with a time far enough back that requests carrying those
+	//     nonces are rejected for their timestamp anyway
+	DeleteMerchantRequestNoncesBefore(ctx context.Context, createdAt time.Time) (int64, error)
+	// DeleteMerchantWebhookEndpoint: Removes a webhook endpoint
+	// Purpose: Stop sending events to an endpoint
+	// Parameters:
+	//   $1: merchant_webhook_endpoint_id - Unique identifier of the endpoint
+	// Returns:
+	//   The deleted endpoint
+	// Business Logic:
+	//   - The endpoint is soft deleted so its delivery log is kept
+	//   - Pending deliveries of a deleted endpoint are no longer attempted
+	DeleteMerchantWebhookEndpoint(ctx context.Context, merchantWebhookEndpointID int32) (*MerchantWebhookEndpoint, error)
 	// DeletePermanentRole: Permanently deletes a trashed role
 	// Purpose: Remove role from DB after soft delete
 	// Parameters:
@@ -261,6 +863,13 @@ type Querier interface {
 	//   - No soft-delete; data is permanently erased
 	//   - Use with caution
 	DeleteTopupPermanently(ctx context.Context, topupID int32) error
+	// DeleteTransactionLimit: Removes a transaction limit
+	// Purpose: Stop enforcing a limit
+	// Parameters:
+	//   $1: transaction_limit_id - Unique identifier of the limit
+	// Returns:
+	//   The deleted transaction limit record
+	DeleteTransactionLimit(ctx context.Context, transactionLimitID int32) (*TransactionLimit, error)
 	// DeleteTransactionPermanently: Hard-deletes a trashed transaction
 	// Purpose: Permanently remove a transaction from the system
 	// Parameters:
@@ -298,6 +907,42 @@ type Querier interface {
 	//   - Irreversible operation
 	//   - Used after retention period expires
 	DeleteWithdrawPermanently(ctx context.Context, withdrawID int32) error
+	// ExpireAuthorizations: Expires stale pending authorizations
+	// Purpose: Lapse authorizations that were never captured or voided
+	// Parameters:
+	//   $1: limit - Maximum number of authorizations to expire in one batch
+	// Returns:
+	//   The expired authorization records
+	// Business Logic:
+	//   - Picks 'authorized' rows whose expires_at has passed, oldest first
+	//   - SKIP LOCKED lets several workers sweep concurrently without blocking
+	//     on authorizations that are being captured or voided
+	//   - The caller releases the reserved amounts in the same transaction
+	ExpireAuthorizations(ctx context.Context, limit int32) ([]*Authorization, error)
+	// ExpireInvoices: Expires open invoices past their expiry
+	// Purpose: Stop stale invoices and payment links from being paid
+	// Returns:
+	//   Number of invoices expired
+	ExpireInvoices(ctx context.Context) (int64, error)
+	// ExpireMerchantApiKey: Lets an API key run out
+	// Purpose: Keep a rotated key working for a grace period
+	// Parameters:
+	//   $1: merchant_api_key_id - Unique identifier of the key
+	//   $2: expires_at - When the key stops working
+	// Returns:
+	//   The updated key
+	// Business Logic:
+	//   - A key that already expires earlier keeps its expiry
+	ExpireMerchantApiKey(ctx context.Context, arg ExpireMerchantApiKeyParams) (*MerchantApiKey, error)
+	// FailScheduledTransfer: Stops a schedule after one of its runs failed
+	// Purpose: Keep a failing schedule from running again until its owner resumes it
+	// Parameters:
+	//   $1: scheduled_transfer_id - Unique identifier of the schedule
+	// Returns:
+	//   The failed scheduled transfer record
+	// Business Logic:
+	//   - Schedules paused or cancelled while the run was in flight keep their status
+	FailScheduledTransfer(ctx context.Context, scheduledTransferID int32) (*ScheduledTransfer, error)
 	// FindAllTransactions: Retrieves a paginated list of active transactions with optional search
 	// Purpose: Display transaction list with merchant info, filtered by card number or payment method
 	// Parameters:
@@ -324,30 +969,6 @@ type Querier interface {
 	// FindAllTransactions: Retrieves a paginated list of active transactions with optional search
 	// Purpose: Display transaction list with merchant info, filtered by card number or payment method
 	// Parameters:
-	//   $1: api-key - The merchant to filter transactions
-	//   $2: search_query (TEXT, nullable) - Optional search string to match card_number or payment_method
-	//   $3: limit (INTEGER) - Maximum number of records to return (pagination)
-	//   $4: offset (INTEGER) - Number of records to skip (pagination)
-	// Returns:
-	//   - transaction_id
-	//   - card_number
-	//   - amount
-	//   - payment_method
-	//   - merchant_id
-	//   - merchant_name (from join with merchants table)
-	//   - transaction_time
-	//   - created_at, updated_at, deleted_at (for audit purposes)
-	//   - total_count: Total number of records matching the filter (useful for pagination metadata)
-	// Business Logic:
-	//   - Joins `transactions` with `merchants` to retrieve merchant name
-	//   - Filters out soft-deleted transactions (where deleted_at IS NOT NULL)
-	//   - Applies case-insensitive partial match on card_number or payment_method if search query is provided
-	//   - Uses `COUNT(*) OVER()` to include total matching count for pagination without a separate query
-	//   - Results are ordered by `transaction_time` descending
-	FindAllTransactionsByApikey(ctx context.Context, arg FindAllTransactionsByApikeyParams) ([]*FindAllTransactionsByApikeyRow, error)
-	// FindAllTransactions: Retrieves a paginated list of active transactions with optional search
-	// Purpose: Display transaction list with merchant info, filtered by card number or payment method
-	// Parameters:
 	//   $1: merchant_id - The merchant to filter transactions
 	//   $2: search_query (TEXT, nullable) - Optional search string to match card_number or payment_method
 	//   $3: limit (INTEGER) - Maximum number of records to return (pagination)
@@ -403,6 +1024,16 @@ type Querier interface {
 	//   - Orders by withdraw_time (newest first)
 	//   - Useful for complete withdrawal history exports
 	FindAllWithdrawsByCardNumber(ctx context.Context, cardNumber string) ([]*FindAllWithdrawsByCardNumberRow, error)
+	// FindIdempotencyKey: Retrieves a live idempotency key
+	// Purpose: Look up the stored fingerprint and response for a repeated key
+	// Parameters:
+	//   $1: user_id - ID of the user sending the request
+	//   $2: scope - Mutation the key belongs to
+	//   $3: idempotency_key - The key supplied by the client
+	// Returns: The key record if it has not expired
+	// Business Logic:
+	//   - Expired keys are ignored
+	FindIdempotencyKey(ctx context.Context, arg FindIdempotencyKeyParams) (*IdempotencyKey, error)
 	// FindRefreshTokenByToken: Retrieves active refresh token by token string
 	// Purpose: Validate and lookup refresh token
 	// Parameters:
@@ -424,6 +1055,57 @@ type Querier interface {
 	//   - Used for token management and validation
 	//   - Limits to 1 result to get latest token
 	FindRefreshTokenByUserId(ctx context.Context, userID int32) (*RefreshToken, error)
+	// FinishMerchantWebhookDeliveryAttempt: Records the outcome of a delivery attempt
+	// Purpose: Mark a delivery delivered, failed, or due for a retry
+	// Parameters:
+	//   $1: merchant_webhook_delivery_id - Unique identifier of the delivery
+	//   $2: status - pending, delivered or failed
+	//   $3: next_attempt_at - When to retry (NULL unless the status is pending)
+	//   $4: last_response_code - Code the endpoint answered with (NULL when it could not be reached)
+	//   $5: last_error - Why the attempt failed (NULL when it succeeded)
+	// Returns:
+	//   The updated delivery
+	FinishMerchantWebhookDeliveryAttempt(ctx context.Context, arg FinishMerchantWebhookDeliveryAttemptParams) (*MerchantWebhookDelivery, error)
+	// FinishProviderWebhookEvent: Records the outcome of applying a callback
+	// Purpose: Mark a callback processed or failed after each attempt
+	// Parameters:
+	//   $1: provider_webhook_event_id - Unique identifier of the event
+	//   $2: status - processed or failed
+	//   $3: last_error - Why the attempt failed (NULL when it succeeded)
+	// Returns:
+	//   The updated event record
+	// Business Logic:
+	//   - Every attempt, including replays, increments attempts
+	//   - processed_at is set the first time the callback is processed
+	FinishProviderWebhookEvent(ctx context.Context, arg FinishProviderWebhookEventParams) (*ProviderWebhookEvent, error)
+	// FinishReconciliationRun: Records the outcome of a reconciliation run
+	// Parameters:
+	//   $1: reconciliation_run_id - Run that finished
+	//   $2: cards_checked - Number of cards compared
+	//   $3: discrepancy_count - Number of cards that did not match
+	// Returns:
+	//   The finished run
+	FinishReconciliationRun(ctx context.Context, arg FinishReconciliationRunParams) (*ReconciliationRun, error)
+	// FinishScheduledTransferRun: Records the outcome of a run
+	// Purpose: Keep the history of what every run did
+	// Parameters:
+	//   $1: scheduled_transfer_run_id - Unique identifier of the run
+	//   $2: status - success or failed
+	//   $3: transfer_id - Transfer created by the run (NULL when none was)
+	//   $4: failure_reason - Why the run failed (NULL on success)
+	// Returns:
+	//   The updated run record
+	FinishScheduledTransferRun(ctx context.Context, arg FinishScheduledTransferRunParams) (*ScheduledTransferRun, error)
+	// FinishTransferBatchRow: Records the outcome of a row
+	// Purpose: Keep what every row did for the result report
+	// Parameters:
+	//   $1: transfer_batch_row_id - Unique identifier of the row
+	//   $2: status - success or failed
+	//   $3: transfer_id - Transfer created by the row (NULL when none was)
+	//   $4: failure_reason - Why the row failed (NULL on success)
+	// Returns:
+	//   The updated row record
+	FinishTransferBatchRow(ctx context.Context, arg FinishTransferBatchRowParams) (*TransferBatchRow, error)
 	// GetActiveCardsWithCount: Retrieves paginated list of active cards with search capability
 	// Purpose: List all active cards for management UI (alternative to GetCards with same functionality)
 	// Parameters:
@@ -438,17 +1120,24 @@ type Querier interface {
 	//   - Returns cards ordered by card_id
 	//   - Provides total_count for pagination calculations
 	GetActiveCardsWithCount(ctx context.Context, arg GetActiveCardsWithCountParams) ([]*GetActiveCardsWithCountRow, error)
+	// GetActiveMerchantApiKeyByID: Looks up a usable API key by its ID
+	// Purpose: Verify a signed merchant API call, which names its key by ID
+	// Parameters:
+	//   $1: merchant_api_key_id - Unique identifier of the key
+	// Returns:
+	//   The key, unless it was revoked or has expired
+	GetActiveMerchantApiKeyByID(ctx context.Context, merchantApiKeyID int32) (*MerchantApiKey, error)
 	// GetActiveMerchants: Retrieves paginated list of active merchants with search capability
 	// Purpose: List currently active merchants (same as GetMerchants)
 	// Parameters:
-	//   $1: search_term - Optional text to filter by name, api_key, or status (NULL for no filter)
+	//   $1: search_term - Optional text to filter by name or status (NULL for no filter)
 	//   $2: limit - Maximum number of records to return
 	//   $3: offset - Number of records to skip for pagination
 	// Returns:
 	//   All merchant fields plus total_count of matching records
 	// Business Logic:
 	//   - Excludes soft-deleted merchants (deleted_at IS NULL)
-	//   - Supports case-insensitive partial matching on name, api_key, and status
+	//   - Supports case-insensitive partial matching on name and status
 	//   - Returns results ordered by merchant_id
 	//   - Provides total_count for pagination calculations
 	GetActiveMerchants(ctx context.Context, arg GetActiveMerchantsParams) ([]*GetActiveMerchantsRow, error)
@@ -461,6 +1150,21 @@ type Querier interface {
 	// Returns:
 	//   role_id, role_name, timestamps, and total_count
 	GetActiveRoles(ctx context.Context, arg GetActiveRolesParams) ([]*GetActiveRolesRow, error)
+	// GetActiveSaldoHoldByReference: Retrieves the active hold of an operation
+	// Purpose: Find the hold to release or consume when an operation completes
+	// Parameters:
+	//   $1: reference_type - Operation type, e.g. 'authorization'
+	//   $2: reference_id - ID of the operation
+	// Returns:
+	//   The active hold record
+	GetActiveSaldoHoldByReference(ctx context.Context, arg GetActiveSaldoHoldByReferenceParams) (*SaldoHold, error)
+	// GetActiveSaldoHoldsByCardNumber: Lists the active holds on a card
+	// Purpose: Explain the difference between a card's ledger and available balance
+	// Parameters:
+	//   $1: card_number - Card to inspect
+	// Returns:
+	//   Active hold records, newest first
+	GetActiveSaldoHoldsByCardNumber(ctx context.Context, cardNumber string) ([]*SaldoHold, error)
 	// GetActiveSaldos: Retrieves active saldos with pagination and optional search
 	// Purpose: List all non-deleted saldos with optional filtering for administrative views
 	// Parameters:
@@ -548,6 +1252,67 @@ type Querier interface {
 	//   - Provides pagination metadata
 	//   - Used in withdrawal management interfaces
 	GetActiveWithdraws(ctx context.Context, arg GetActiveWithdrawsParams) ([]*GetActiveWithdrawsRow, error)
+	// GetApplicableFeeSchedule: Finds the fee schedule to apply to a transaction
+	// Purpose: Pick the pricing of a new transfer, withdraw, topup or merchant transaction
+	// Parameters:
+	//   $1: transaction_type - Type of the transaction being charged
+	//   $2: payment_method - Payment or topup method of the transaction ('' when not applicable)
+	//   $3: card_type - Type of the paying card
+	//   $4: currency - Currency of the paying card
+	// Returns:
+	//   The most specific active schedule matching the transaction
+	// Business Logic:
+	//   - NULL filters on a schedule match any value
+	//   - Schedules with more filters set win over broader ones
+	//   - The newest schedule wins between equally specific ones
+	GetApplicableFeeSchedule(ctx context.Context, arg GetApplicableFeeScheduleParams) (*FeeSchedule, error)
+	// GetApplicableTransactionLimits: Finds the limits enforced on an operation of a card
+	// Purpose: Pick the daily and monthly caps of a new transfer, withdraw or merchant transaction
+	// Parameters:
+	//   $1: operation_type - Type of the operation being checked
+	//   $2: card_number - Card the funds are taken from
+	// Returns:
+	//   At most one limit per period, the most specific active one matching the card
+	// Business Logic:
+	//   - Card type and currency come from the card, the tier from the card owner
+	//   - NULL filters on a limit match any value
+	//   - Limits with more filters set win over broader ones
+	//   - The newest limit wins between equally specific ones
+	GetApplicableTransactionLimits(ctx context.Context, arg GetApplicableTransactionLimitsParams) ([]*TransactionLimit, error)
+	// GetAuthorizationByID: Retrieves an authorization by ID
+	// Purpose: Fetch authorization details
+	// Parameters:
+	//   $1: authorization_id - ID of the authorization
+	// Returns:
+	//   The authorization record
+	GetAuthorizationByID(ctx context.Context, authorizationID int32) (*Authorization, error)
+	// GetAuthorizations: Retrieves paginated authorizations with search capability
+	// Purpose: List authorizations for the management UI
+	// Parameters:
+	//   $1: search_term - Optional text to filter by card number, payment method or status (NULL for no filter)
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   All authorization fields plus total_count of matching records
+	// Business Logic:
+	//   - Supports partial text matching on multiple fields (case-insensitive)
+	//   - Orders by created_at (newest first)
+	GetAuthorizations(ctx context.Context, arg GetAuthorizationsParams) ([]*GetAuthorizationsRow, error)
+	// GetBalanceDiscrepancies: Retrieves the paginated balance discrepancies
+	// Purpose: Let admins review the cards that did not match
+	// Parameters:
+	//   $1: status - Only discrepancies in this status, or all when empty
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   Discrepancy records with total_count for pagination, latest first
+	GetBalanceDiscrepancies(ctx context.Context, arg GetBalanceDiscrepanciesParams) ([]*GetBalanceDiscrepanciesRow, error)
+	// GetBalanceDiscrepancyByID: Retrieves a single balance discrepancy
+	// Parameters:
+	//   $1: balance_discrepancy_id - Unique identifier of the discrepancy
+	// Returns:
+	//   The discrepancy record
+	GetBalanceDiscrepancyByID(ctx context.Context, balanceDiscrepancyID int32) (*BalanceDiscrepancy, error)
 	// GetCardByCardNumber: Retrieves a single active card by its card number
 	// Purpose: Lookup card information using the physical card number
 	// Parameters:
@@ -580,6 +1345,38 @@ type Querier interface {
 	//   - Returns at most one card (LIMIT 1) even if multiple cards exist for the user
 	//   - Useful for displaying a user's primary/default card
 	GetCardByUserID(ctx context.Context, userID int32) (*Card, error)
+	// GetCardReconciliations: Recomputes the balance of every card from its history
+	// Purpose: Compare each saldo with what its movements add up to
+	// Returns:
+	//   Per card: the saldo, the ledger balance and the movements by source
+	// Business Logic:
+	//   - Runs as a single statement, so it sees one consistent snapshot and
+	//     takes no locks that would hold up live traffic
+	//   - History before the opening balance journal of a card is part of that
+	//     opening balance and is not counted again
+	//   - Topups add their amount net of fee; withdraws, outgoing transfers and
+	//     payments take their amount plus the fee the card paid
+	//   - Incoming transfers add the converted amount; settlements add the
+	//     amount paid out to the card
+	//   - Movements with no history row naming the card, such as opening
+	//     balances, adjustments, holds, refunds, disputes and the merchant side
+	//     of payments, are taken from the ledger as other_amount
+	GetCardReconciliations(ctx context.Context) ([]*GetCardReconciliationsRow, error)
+	// GetCardUsageSince: Sums the operations of a card since a point in time
+	// Purpose: Measure how much of a limit a card has used in the current period
+	// Parameters:
+	//   $1: operation_type - transfer, withdraw or transaction
+	//   $2: card_number - Card the funds were taken from
+	//   $3: since - Start of the period
+	// Returns:
+	//   total_count: Number of operations in the period
+	//   total_amount: Sum of their amounts
+	// Business Logic:
+	//   - Pending and failed operations are not counted, nor are deleted ones
+	//   - Rejected withdraws are not counted; ones waiting for review are, so a
+	//     card cannot exceed its limit with several reviews in flight
+	//   - Transfers are counted on the sending card, merchant transactions on the paying card
+	GetCardUsageSince(ctx context.Context, arg GetCardUsageSinceParams) (*GetCardUsageSinceRow, error)
 	// GetCards: Retrieves paginated list of active cards with search capability
 	// Purpose: List all active cards for management UI
 	// Parameters:
@@ -594,15 +1391,234 @@ type Querier interface {
 	//   - Returns cards ordered by card_id
 	//   - Provides total_count for pagination calculations
 	GetCards(ctx context.Context, arg GetCardsParams) ([]*GetCardsRow, error)
-	// GetMerchantByApiKey: Retrieves a merchant by its API key
-	// Purpose: Authenticate or lookup a merchant using its API key
+	// GetDisputeByID: Retrieves a dispute by ID
+	// Purpose: Fetch dispute details
 	// Parameters:
-	//   $1: api_key - API key of the merchant
+	//   $1: dispute_id - ID of the dispute
 	// Returns:
-	//   Complete merchant record
+	//   The dispute record
+	GetDisputeByID(ctx context.Context, disputeID int32) (*Dispute, error)
+	// GetDisputes: Retrieves paginated disputes with search capability
+	// Purpose: List disputes for the admin review queue
+	// Parameters:
+	//   $1: search_term - Optional text to filter by card number, reason or status (NULL for no filter)
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   All dispute fields plus total_count of matching records
 	// Business Logic:
-	//   - Excludes soft-deleted merchants (deleted_at IS NULL)
-	GetMerchantByApiKey(ctx context.Context, apiKey string) (*Merchant, error)
+	//   - Supports partial text matching on multiple fields (case-insensitive)
+	//   - Orders by created_at (newest first)
+	GetDisputes(ctx context.Context, arg GetDisputesParams) ([]*GetDisputesRow, error)
+	// GetDisputesByMerchantID: Retrieves paginated disputes of a merchant
+	// Purpose: Let a merchant see the disputes raised against its transactions
+	// Parameters:
+	//   $1: merchant_id - ID of the merchant
+	//   $2: search_term - Optional text to filter by card number, reason or status (NULL for no filter)
+	//   $3: limit - Maximum number of records to return
+	//   $4: offset - Number of records to skip for pagination
+	// Returns:
+	//   All dispute fields plus total_count of matching records
+	// Business Logic:
+	//   - Supports partial text matching on multiple fields (case-insensitive)
+	//   - Orders by created_at (newest first)
+	GetDisputesByMerchantID(ctx context.Context, arg GetDisputesByMerchantIDParams) ([]*GetDisputesByMerchantIDRow, error)
+	// GetDueScheduledTransfers: Retrieves schedules whose next run is due
+	// Purpose: Feed the background executor
+	// Parameters:
+	//   $1: now - Current time
+	//   $2: limit - Maximum number of schedules to return
+	// Returns:
+	//   Active schedules due at or before now, oldest first
+	GetDueScheduledTransfers(ctx context.Context, arg GetDueScheduledTransfersParams) ([]*ScheduledTransfer, error)
+	// GetExchangeRateByID: Retrieves a single exchange rate
+	// Purpose: Show the detail of one currency pair
+	// Parameters:
+	//   $1: exchange_rate_id - Unique identifier of the rate
+	// Returns:
+	//   The exchange rate record
+	GetExchangeRateByID(ctx context.Context, exchangeRateID int32) (*ExchangeRate, error)
+	// GetExchangeRateByPair: Retrieves the rate of a currency pair
+	// Purpose: Look up the rate to apply when converting between two currencies
+	// Parameters:
+	//   $1: base_currency - Currency being converted from
+	//   $2: quote_currency - Currency being converted to
+	// Returns:
+	//   The exchange rate record
+	GetExchangeRateByPair(ctx context.Context, arg GetExchangeRateByPairParams) (*ExchangeRate, error)
+	// GetExchangeRates: Retrieves paginated exchange rates with search capability
+	// Purpose: List the maintained currency pairs for administration
+	// Parameters:
+	//   $1: search_term - Optional filter on base or quote currency (NULL for no filter)
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   Exchange rate records with total_count for pagination
+	// Business Logic:
+	//   - Ordered by currency pair
+	GetExchangeRates(ctx context.Context, arg GetExchangeRatesParams) ([]*GetExchangeRatesRow, error)
+	// GetFeeScheduleByID: Retrieves a single fee schedule
+	// Purpose: Show the detail of one fee schedule
+	// Parameters:
+	//   $1: fee_schedule_id - Unique identifier of the schedule
+	// Returns:
+	//   The fee schedule record
+	GetFeeScheduleByID(ctx context.Context, feeScheduleID int32) (*FeeSchedule, error)
+	// GetFeeScheduleTiersByScheduleIDs: Retrieves the tiers of one or many schedules
+	// Purpose: Load tiers for one or many schedules in a single round trip
+	// Parameters:
+	//   $1: fee_schedule_ids - Array of schedule IDs
+	// Returns:
+	//   Tier records ordered by schedule and min_amount
+	GetFeeScheduleTiersByScheduleIDs(ctx context.Context, feeScheduleIds []int32) ([]*FeeScheduleTier, error)
+	// GetFeeSchedules: Retrieves paginated fee schedules with search capability
+	// Purpose: List the configured fee schedules for administration
+	// Parameters:
+	//   $1: search_term - Optional filter on name or transaction type (NULL for no filter)
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   Fee schedule records with total_count for pagination
+	// Business Logic:
+	//   - Ordered by transaction type, then most recently created first
+	GetFeeSchedules(ctx context.Context, arg GetFeeSchedulesParams) ([]*GetFeeSchedulesRow, error)
+	// GetInvoiceByCode: Retrieves an invoice by its public code
+	// Purpose: Show a customer what they are asked to pay
+	// Parameters:
+	//   $1: code - Public code of the invoice
+	// Returns:
+	//   The invoice record
+	GetInvoiceByCode(ctx context.Context, code string) (*Invoice, error)
+	// GetInvoiceByID: Retrieves an invoice by ID
+	// Purpose: Show a merchant one of its invoices
+	// Parameters:
+	//   $1: invoice_id - ID of the invoice
+	// Returns:
+	//   The invoice record
+	GetInvoiceByID(ctx context.Context, invoiceID int32) (*Invoice, error)
+	// GetInvoiceLineItems: Lists the line items of an invoice
+	// Purpose: Show what an invoice charges for
+	// Parameters:
+	//   $1: invoice_id - Invoice the lines belong to
+	// Returns:
+	//   The line items in the order they were added
+	GetInvoiceLineItems(ctx context.Context, invoiceID int32) ([]*InvoiceLineItem, error)
+	// GetInvoicePayments: Lists the payments of an invoice
+	// Purpose: Show a merchant who paid an invoice or payment link
+	// Parameters:
+	//   $1: invoice_id - Invoice that was paid
+	// Returns:
+	//   The payments, oldest first
+	GetInvoicePayments(ctx context.Context, invoiceID int32) ([]*InvoicePayment, error)
+	// GetInvoicesByMerchant: Retrieves the paginated invoices of a merchant
+	// Purpose: Show a merchant its invoices and payment links
+	// Parameters:
+	//   $1: merchant_id - Merchant the invoices belong to
+	//   $2: status - Only invoices in this status, or all when empty
+	//   $3: limit - Maximum number of records to return
+	//   $4: offset - Number of records to skip for pagination
+	// Returns:
+	//   Invoice records with total_count for pagination, newest first
+	GetInvoicesByMerchant(ctx context.Context, arg GetInvoicesByMerchantParams) ([]*GetInvoicesByMerchantRow, error)
+	// GetLedgerBalanceByCardNumber: Computes a card balance from the ledger
+	// Purpose: Compare the ledger balance against the saldo projection
+	// Parameters:
+	//   $1: card_number - Card account to compute
+	// Returns:
+	//   Sum of credits minus debits for the card
+	GetLedgerBalanceByCardNumber(ctx context.Context, cardNumber sql.NullString) (int32, error)
+	// GetLedgerJournalByID: Retrieves a single journal
+	// Purpose: Show the detail of one ledger entry
+	// Parameters:
+	//   $1: journal_id - Unique identifier of the journal
+	// Returns:
+	//   The journal record
+	GetLedgerJournalByID(ctx context.Context, journalID int32) (*LedgerJournal, error)
+	// GetLedgerJournals: Retrieves paginated ledger journals with search capability
+	// Purpose: Browse the audit trail of money movements
+	// Parameters:
+	//   $1: search_term - Optional filter on journal_no, reference_type or description (NULL for no filter)
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   Journal records with total_count for pagination
+	// Business Logic:
+	//   - Most recent journals first
+	//   - Supports partial, case-insensitive search
+	GetLedgerJournals(ctx context.Context, arg GetLedgerJournalsParams) ([]*GetLedgerJournalsRow, error)
+	// GetLedgerPostingsByCardNumber: Retrieves paginated postings for a card account
+	// Purpose: Explain how the balance of a card was reached
+	// Parameters:
+	//   $1: card_number - Card account to inspect
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   Postings joined with their journal reference and total_count for pagination
+	// Business Logic:
+	//   - Most recent postings first
+	GetLedgerPostingsByCardNumber(ctx context.Context, arg GetLedgerPostingsByCardNumberParams) ([]*GetLedgerPostingsByCardNumberRow, error)
+	// GetLedgerPostingsByJournalIDs: Retrieves the postings of a set of journals
+	// Purpose: Load journal lines for one or many journals in a single round trip
+	// Parameters:
+	//   $1: journal_ids - Array of journal IDs
+	// Returns:
+	//   Posting records ordered by journal and posting ID
+	GetLedgerPostingsByJournalIDs(ctx context.Context, journalIds []int32) ([]*LedgerPosting, error)
+	// GetMerchantApiKeyByHash: Looks up a usable API key by its hash
+	// Purpose: Authenticate a merchant API call
+	// Parameters:
+	//   $1: key_hash - SHA-256 hash of the key the caller sent
+	// Returns:
+	//   The key, unless it was revoked or has expired
+	GetMerchantApiKeyByHash(ctx context.Context, keyHash string) (*MerchantApiKey, error)
+	// GetMerchantApiKeyByID: Retrieves a single API key
+	// Purpose: Rotate or revoke one key
+	// Parameters:
+	//   $1: merchant_api_key_id - Unique identifier of the key
+	// Returns:
+	//   The key record, including revoked and expired keys
+	GetMerchantApiKeyByID(ctx context.Context, merchantApiKeyID int32) (*MerchantApiKey, error)
+	// GetMerchantApiKeysByMerchant: Lists the API keys of a merchant
+	// Purpose: Show a merchant its keys, without the keys themselves
+	// Parameters:
+	//   $1: merchant_id - Merchant the keys belong to
+	// Returns:
+	//   All keys of the merchant, including revoked and expired ones, newest first
+	GetMerchantApiKeysByMerchant(ctx context.Context, merchantID int32) ([]*MerchantApiKey, error)
+	// GetMerchantApiKeysWithPlainSigningSecret: Lists keys whose signing secret is not encrypted
+	// Purpose: Encrypt the signing secrets stored before encryption was added
+	// Returns:
+	//   All keys whose signing secret lacks the sealed prefix, including revoked
+	//   and expired ones
+	GetMerchantApiKeysWithPlainSigningSecret(ctx context.Context) ([]*MerchantApiKey, error)
+	// GetMerchantBalanceForUpdate: Locks the balance of a merchant in one currency
+	// Purpose: Keep concurrent settlement runs from paying out the same items
+	// Parameters:
+	//   $1: merchant_id - Merchant the balance belongs to
+	//   $2: currency - Currency of the balance
+	// Returns:
+	//   The balance, locked until the surrounding transaction ends
+	GetMerchantBalanceForUpdate(ctx context.Context, arg GetMerchantBalanceForUpdateParams) (*MerchantBalance, error)
+	// GetMerchantBalances: Lists the balances of a merchant
+	// Purpose: Show a merchant what it is owed and where it is paid out to
+	// Parameters:
+	//   $1: merchant_id - Merchant the balances belong to
+	// Returns:
+	//   One balance per currency
+	GetMerchantBalances(ctx context.Context, merchantID int32) ([]*MerchantBalance, error)
+	// GetMerchantBalancesDueForSettlement: Lists the balances with items to settle
+	// Purpose: Feed the settlement job
+	// Parameters:
+	//   $1: cutoff_at - Items accrued before this time are settled
+	// Returns:
+	//   Balances with at least one unsettled item accrued before the cutoff
+	GetMerchantBalancesDueForSettlement(ctx context.Context, createdAt sql.NullTime) ([]*MerchantBalance, error)
+	// GetMerchantBusinessProfile: Retrieves the business details of a merchant
+	// Parameters:
+	//   $1: merchant_id - Merchant the details belong to
+	// Returns:
+	//   The business profile
+	GetMerchantBusinessProfile(ctx context.Context, merchantID int32) (*MerchantBusinessProfile, error)
 	// GetMerchantByID: Retrieves a merchant by its unique ID
 	// Purpose: Fetch details of a single merchant if not soft-deleted
 	// Parameters:
@@ -621,20 +1637,66 @@ type Querier interface {
 	// Business Logic:
 	//   - Excludes soft-deleted merchants (deleted_at IS NULL)
 	GetMerchantByName(ctx context.Context, name string) (*Merchant, error)
+	// GetMerchantWebhookDeliveries: Retrieves the paginated deliveries of an endpoint
+	// Purpose: Show a merchant what was sent to an endpoint
+	// Parameters:
+	//   $1: merchant_webhook_endpoint_id - Endpoint the deliveries were sent to
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   Delivery records with total_count for pagination, newest first
+	GetMerchantWebhookDeliveries(ctx context.Context, arg GetMerchantWebhookDeliveriesParams) ([]*GetMerchantWebhookDeliveriesRow, error)
+	// GetMerchantWebhookDeliveryAttempts: Retrieves the delivery log of a delivery
+	// Purpose: Show every attempt made to deliver an event
+	// Parameters:
+	//   $1: merchant_webhook_delivery_id - Delivery the attempts belong to
+	// Returns:
+	//   The attempts, first attempt first
+	GetMerchantWebhookDeliveryAttempts(ctx context.Context, merchantWebhookDeliveryID int32) ([]*MerchantWebhookDeliveryAttempt, error)
+	// GetMerchantWebhookDeliveryByID: Retrieves a single delivery
+	// Purpose: Show or redeliver one delivery
+	// Parameters:
+	//   $1: merchant_webhook_delivery_id - Unique identifier of the delivery
+	// Returns:
+	//   The delivery record
+	GetMerchantWebhookDeliveryByID(ctx context.Context, merchantWebhookDeliveryID int32) (*MerchantWebhookDelivery, error)
+	// GetMerchantWebhookEndpointByID: Retrieves a single webhook endpoint
+	// Purpose: Show, update or test one endpoint
+	// Parameters:
+	//   $1: merchant_webhook_endpoint_id - Unique identifier of the endpoint
+	// Returns:
+	//   The endpoint, unless it was deleted
+	GetMerchantWebhookEndpointByID(ctx context.Context, merchantWebhookEndpointID int32) (*MerchantWebhookEndpoint, error)
+	// GetMerchantWebhookEndpointsByMerchant: Lists the webhook endpoints of a merchant
+	// Purpose: Show a merchant where its events are sent
+	// Parameters:
+	//   $1: merchant_id - Merchant the endpoints belong to
+	// Returns:
+	//   The endpoints that were not deleted, oldest first
+	GetMerchantWebhookEndpointsByMerchant(ctx context.Context, merchantID int32) ([]*MerchantWebhookEndpoint, error)
 	// GetMerchants: Retrieves paginated list of all non-deleted merchants with search capability
 	// Purpose: Display all active (non-trashed) merchants in admin interface
 	// Parameters:
-	//   $1: search_term - Optional text to filter by name, api_key, or status (NULL for no filter)
+	//   $1: search_term - Optional text to filter by name or status (NULL for no filter)
 	//   $2: limit - Maximum number of records to return
 	//   $3: offset - Number of records to skip for pagination
 	// Returns:
 	//   All merchant fields plus total_count of matching records
 	// Business Logic:
 	//   - Excludes soft-deleted merchants (deleted_at IS NULL)
-	//   - Supports partial text search on name, api_key, and status (case-insensitive)
+	//   - Supports partial text search on name and status (case-insensitive)
 	//   - Returns results ordered by merchant_id
 	//   - Provides total_count for pagination calculations
 	GetMerchants(ctx context.Context, arg GetMerchantsParams) ([]*GetMerchantsRow, error)
+	// GetMerchantsByStatus: Retrieves the paginated merchants in one status
+	// Purpose: Give admins a queue of merchants to review
+	// Parameters:
+	//   $1: status - Status of the merchants
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   Merchant records with total_count for pagination, least recently updated first
+	GetMerchantsByStatus(ctx context.Context, arg GetMerchantsByStatusParams) ([]*GetMerchantsByStatusRow, error)
 	// GetMerchantsByUserID: Retrieves all merchants associated with a user
 	// Purpose: List all merchants that belong to a specific user
 	// Parameters:
@@ -952,31 +2014,17 @@ type Querier interface {
 	//   - Useful for identifying seasonal cash withdrawal patterns
 	GetMonthWithdrawStatusSuccess(ctx context.Context, arg GetMonthWithdrawStatusSuccessParams) ([]*GetMonthWithdrawStatusSuccessRow, error)
 	GetMonthWithdrawStatusSuccessCardNumber(ctx context.Context, arg GetMonthWithdrawStatusSuccessCardNumberParams) ([]*GetMonthWithdrawStatusSuccessCardNumberRow, error)
-	// GetMonthlyAmountByApikey: Retrieves total transaction amount per month for a specific merchant and year
-	// Purpose: Generate monthly income report for a specific merchant regardless of payment method
-	// Parameters:
-	//   $1: reference_date - Any date within the target year
-	//   $2: api-key - The merchant to filter transactions
-	// Returns:
-	//   - Month name (e.g., Jan, Feb)
-	//   - Total transaction amount (0 if no activity)
-	// Business Logic:
-	//   - Generates complete 12-month series for the given year
-	//   - Filters only active (non-deleted) transactions and merchants
-	//   - Filters by specific merchant_id
-	//   - Uses LEFT JOIN to ensure each month is represented
-	//   - Uses COALESCE to return 0 if a month has no transaction data
-	//   - Results are ordered chronologically by month
-	GetMonthlyAmountByApikey(ctx context.Context, arg GetMonthlyAmountByApikeyParams) ([]*GetMonthlyAmountByApikeyRow, error)
 	// GetMonthlyAmountByMerchants: Retrieves total transaction amount per month for a specific merchant and year
 	// Purpose: Generate monthly income report for a specific merchant regardless of payment method
 	// Parameters:
 	//   $1: reference_date - Any date within the target year
 	//   $2: merchant_id - The merchant to filter transactions
 	// Returns:
+	//   currency: Currency the totals are expressed in
 	//   - Month name (e.g., Jan, Feb)
 	//   - Total transaction amount (0 if no activity)
 	// Business Logic:
+	//   - Amounts are net of refunds (amount - refunded_amount)
 	//   - Generates complete 12-month series for the given year
 	//   - Filters only active (non-deleted) transactions and merchants
 	//   - Filters by specific merchant_id
@@ -989,9 +2037,11 @@ type Querier interface {
 	// Parameters:
 	//   $1: reference_date - Any date within the target year
 	// Returns:
+	//   currency: Currency the totals are expressed in
 	//   - Month name (e.g., Jan, Feb)
 	//   - Total transaction amount (0 if no activity)
 	// Business Logic:
+	//   - Amounts are net of refunds (amount - refunded_amount)
 	//   - Generates complete 12-month series
 	//   - Includes only active (non-deleted) transactions and merchants
 	//   - Uses LEFT JOIN to ensure each month is represented
@@ -1004,6 +2054,7 @@ type Querier interface {
 	// Parameters:
 	//   $1: reference_date - Any date within the target year
 	// Returns:
+	//   currency: Currency the totals are expressed in
 	//   - month: 3-letter month abbreviation
 	//   - total_amount: Sum of transaction amounts in each month
 	// Business Logic:
@@ -1017,6 +2068,7 @@ type Querier interface {
 	//   $1: card_number  - filter by card_number
 	//   $2: reference_date - Any date within the target year
 	// Returns:
+	//   currency: Currency the totals are expressed in
 	//   - month: 3-letter month abbreviation
 	//   - total_amount: Sum of transaction amounts in each month
 	// Business Logic:
@@ -1030,6 +2082,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation (e.g., 'Jan')
 	//   total_balance: Sum of balances for that month (zero if no data)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Generates a complete 12-month series for the year
 	//   - Includes only active saldos and cards (deleted_at IS NULL)
@@ -1045,6 +2098,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation (e.g., 'Jan')
 	//   total_balance: Monthly balance total (0 if no data)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Generates complete 12-month series for the year
 	//   - Filters for specific card number
@@ -1052,23 +2106,37 @@ type Querier interface {
 	//   - Ensures all months appear with COALESCE default
 	//   - Useful for cardholder spending pattern analysis
 	GetMonthlyBalancesByCardNumber(ctx context.Context, arg GetMonthlyBalancesByCardNumberParams) ([]*GetMonthlyBalancesByCardNumberRow, error)
-	// GetMonthlyPaymentMethodByApikey: Retrieves total transaction amount per payment method per month for a specific merchant
-	// Purpose: Analyze monthly transaction totals by payment method for a specific merchant and year
+	// GetMonthlyDisputeRateByMerchants: Retrieves the dispute rate per month for a specific merchant and year
+	// Purpose: Monitor how often cardholders contest a merchant's charges
 	// Parameters:
 	//   $1: reference_date - Any date within the target year
-	//   $2: api-key - The merchant to filter transactions
+	//   $2: merchant_id - The merchant to filter transactions and disputes
 	// Returns:
 	//   - Month name (e.g., Jan, Feb)
-	//   - Payment method
-	//   - Total transaction amount for each combination
+	//   - Number of completed transactions in the month
+	//   - Number of disputes opened in the month
+	//   - Dispute rate as a percentage of completed transactions (0 if no transactions)
 	// Business Logic:
-	//   - Generates a complete 12-month series for the given year
-	//   - Filters only active (non-deleted) transactions and merchants
-	//   - Filters by specific merchant_id
-	//   - Uses LEFT JOIN and CROSS JOIN to ensure all months and payment methods are included
-	//   - Uses COALESCE to return 0 for combinations with no data
+	//   - Completed transactions are those that reached 'success', including ones
+	//     later refunded, disputed or charged back
+	//   - Disputes are bucketed by the month they were opened
+	//   - Generates complete 12-month series for the given year
+	//   - Filters only active (non-deleted) transactions
 	//   - Results are ordered chronologically by month
-	GetMonthlyPaymentMethodByApikey(ctx context.Context, arg GetMonthlyPaymentMethodByApikeyParams) ([]*GetMonthlyPaymentMethodByApikeyRow, error)
+	GetMonthlyDisputeRateByMerchants(ctx context.Context, arg GetMonthlyDisputeRateByMerchantsParams) ([]*GetMonthlyDisputeRateByMerchantsRow, error)
+	// GetMonthlyFeeAmounts: Retrieves total fees charged per month for the selected year
+	// Purpose: Report platform fee revenue across months in a given year
+	// Parameters:
+	//   $1: reference_date - Any date within the target year
+	// Returns:
+	//   month: 3-letter month abbreviation
+	//   transaction_type: transfer, withdraw, topup or transaction
+	//   currency: Currency the fees were charged in
+	//   total_fee: Sum of the fees charged in the month
+	// Business Logic:
+	//   - Only successful, non-deleted transactions are counted
+	//   - Every month is returned for every transaction type and currency, with 0 when nothing was charged
+	GetMonthlyFeeAmounts(ctx context.Context, dollar_1 time.Time) ([]*GetMonthlyFeeAmountsRow, error)
 	// GetMonthlyPaymentMethodByMerchants: Retrieves total transaction amount per payment method per month for a specific merchant
 	// Purpose: Analyze monthly transaction totals by payment method for a specific merchant and year
 	// Parameters:
@@ -1079,6 +2147,7 @@ type Querier interface {
 	//   - Payment method
 	//   - Total transaction amount for each combination
 	// Business Logic:
+	//   - Amounts are net of refunds (amount - refunded_amount)
 	//   - Generates a complete 12-month series for the given year
 	//   - Filters only active (non-deleted) transactions and merchants
 	//   - Filters by specific merchant_id
@@ -1126,6 +2195,7 @@ type Querier interface {
 	//   - Payment method
 	//   - Total transaction amount (0 if no activity)
 	// Business Logic:
+	//   - Amounts are net of refunds (amount - refunded_amount)
 	//   - Generates complete 12-month series from the reference year
 	//   - Cross joins with distinct active payment methods
 	//   - Filters only active (non-deleted) transactions and merchants
@@ -1140,6 +2210,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation (e.g., 'Jan', 'Feb')
 	//   total_balance: Sum of balances for that month (0 if no data exists)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Generates a complete 12-month series for the specified year
 	//   - Uses LEFT JOIN to ensure all months appear in results
@@ -1155,6 +2226,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation
 	//   total_topup_amount: Sum of top-ups for that month (zero if no data)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Generates complete 12-month series
 	//   - Only includes active topups and cards
@@ -1170,6 +2242,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation
 	//   total_topup_amount: Monthly top-up total (0 if none)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Complete 12-month coverage
 	//   - Card-specific filtering
@@ -1184,6 +2257,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation
 	//   total_amount: Sum of all topup amounts per month
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Filters soft-deleted entries (deleted_at IS NULL)
 	//   - Uses LEFT JOIN to ensure months with no topups are still included with amount = 0
@@ -1197,6 +2271,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation
 	//   total_amount: Sum of all topup amounts per month
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Filters soft-deleted entries (deleted_at IS NULL)
 	//   - Uses LEFT JOIN to ensure months with no topups are still included with amount = 0
@@ -1233,32 +2308,18 @@ type Querier interface {
 	//   - Uses CROSS JOIN to combine months with all available methods
 	//   - Useful for visualizing adoption trends of each topup method monthly
 	GetMonthlyTopupMethodsByCardNumber(ctx context.Context, arg GetMonthlyTopupMethodsByCardNumberParams) ([]*GetMonthlyTopupMethodsByCardNumberRow, error)
-	// GetMonthlyTotalAmountByApikey: Retrieves total transaction amounts for the current and previous month
-	// Purpose: Provide monthly transaction summary including zero values if no transactions exist
-	// Parameters:
-	//   $1: reference_date - Any date within the target (current) month
-	//   $2: api-key - The merchant to filter transactions
-	// Returns:
-	//   - Year (as text)
-	//   - Month (abbreviated name, e.g., Jan, Feb)
-	//   - Total transaction amount for each month
-	// Business Logic:
-	//   - Aggregates total transaction amounts for the target month and the month before
-	//   - Filters only active (non-deleted) transactions and merchants
-	//   - Includes 0 as total_amount if there's no transaction data for either month
-	//   - Uses UNION ALL to combine real data with "missing month" placeholders
-	//   - Results are sorted by year and month (most recent first)
-	GetMonthlyTotalAmountByApikey(ctx context.Context, arg GetMonthlyTotalAmountByApikeyParams) ([]*GetMonthlyTotalAmountByApikeyRow, error)
 	// GetMonthlyTotalAmountByMerchant: Retrieves total transaction amounts for the current and previous month
 	// Purpose: Provide monthly transaction summary including zero values if no transactions exist
 	// Parameters:
 	//   $1: reference_date - Any date within the target (current) month
 	//   $2: merchant_id - The merchant to filter transactions
 	// Returns:
+	//   currency: Currency the totals are expressed in
 	//   - Year (as text)
 	//   - Month (abbreviated name, e.g., Jan, Feb)
 	//   - Total transaction amount for each month
 	// Business Logic:
+	//   - Amounts are net of refunds (amount - refunded_amount)
 	//   - Aggregates total transaction amounts for the target month and the month before
 	//   - Filters only active (non-deleted) transactions and merchants
 	//   - Includes 0 as total_amount if there's no transaction data for either month
@@ -1270,10 +2331,12 @@ type Querier interface {
 	// Parameters:
 	//   $1: reference_date - Any date within the target (current) month
 	// Returns:
+	//   currency: Currency the totals are expressed in
 	//   - Year (as text)
 	//   - Month (abbreviated name, e.g., Jan, Feb)
 	//   - Total transaction amount for each month
 	// Business Logic:
+	//   - Amounts are net of refunds (amount - refunded_amount)
 	//   - Aggregates total transaction amounts for the target month and the month before
 	//   - Filters only active (non-deleted) transactions and merchants
 	//   - Includes 0 as total_amount if there's no transaction data for either month
@@ -1291,6 +2354,7 @@ type Querier interface {
 	//   year: The year as text
 	//   month: 3-letter month abbreviation
 	//   total_balance: Monthly balance total (0 if no data)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Aggregates balances for two customizable time periods
 	//   - Only includes active saldos (deleted_at IS NULL)
@@ -1305,6 +2369,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation
 	//   total_transaction_amount: Sum of transactions for that month (zero if no data)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Generates complete 12-month series
 	//   - Only includes active transactions and cards
@@ -1320,6 +2385,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation
 	//   total_transaction_amount: Monthly transaction total (0 if none)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Complete 12-month coverage
 	//   - Card-specific filtering
@@ -1335,6 +2401,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation
 	//   total_sent_amount: Monthly transfer total (0 if none)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Complete 12-month coverage
 	//   - Card-specific filtering
@@ -1350,6 +2417,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation
 	//   total_sent_amount: Monthly transfer total (0 if none)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Complete 12-month coverage
 	//   - Card-specific filtering
@@ -1364,6 +2432,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation
 	//   total_received_amount: Sum of transfers for that month (zero if no data)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Generates complete 12-month series
 	//   - Only includes active transfers and cards
@@ -1378,6 +2447,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation
 	//   total_sent_amount: Sum of transfers for that month (zero if no data)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Generates complete 12-month series
 	//   - Only includes active transfers and cards
@@ -1392,6 +2462,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation (e.g., 'Jan')
 	//   total_transfer_amount: Sum of transfer amounts in that month
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Generates complete monthly series for the target year
 	//   - Includes all transfers regardless of method
@@ -1407,6 +2478,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation (e.g., 'Jan')
 	//   total_transfer_amount: Sum of transfer amounts in that month
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Generates complete monthly series for the target year
 	//   - Includes all transfers regardless of method
@@ -1422,6 +2494,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation (e.g., 'Jan')
 	//   total_transfer_amount: Sum of transfer amounts in that month
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Generates complete monthly series for the target year
 	//   - Includes all transfers regardless of method
@@ -1436,6 +2509,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation
 	//   total_withdraw_amount: Sum of withdraws for that month (zero if no data)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Generates complete 12-month series
 	//   - Only includes active withdraws and cards
@@ -1451,6 +2525,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation
 	//   total_withdraw_amount: Monthly withdraw total (0 if none)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Complete 12-month coverage
 	//   - Card-specific filtering
@@ -1465,6 +2540,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation (e.g., 'Jan')
 	//   total_withdraw_amount: Sum of withdrawal amounts for that month (0 if no withdrawals)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Generates complete monthly series for the specified year
 	//   - Includes all withdrawals regardless of card or status
@@ -1481,6 +2557,7 @@ type Querier interface {
 	// Returns:
 	//   month: 3-letter month abbreviation
 	//   total_withdraw_amount: Sum of withdrawals for that card by month
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Generates complete monthly series for the year
 	//   - Filters withdrawals by specific card number
@@ -1488,6 +2565,98 @@ type Querier interface {
 	//   - Orders chronologically
 	//   - Useful for individual spending pattern analysis
 	GetMonthlyWithdrawsByCardNumber(ctx context.Context, arg GetMonthlyWithdrawsByCardNumberParams) ([]*GetMonthlyWithdrawsByCardNumberRow, error)
+	// GetOpenInvoiceByCodeForUpdate: Locks a payable invoice by its public code
+	// Purpose: Pay an invoice without racing another payment or a cancellation
+	// Parameters:
+	//   $1: code - Public code of the invoice
+	// Business Logic:
+	//   - Only open invoices that have not expired yet can be paid, even when
+	//     the expiry job has not caught up with them
+	// Returns:
+	//   The invoice record, locked until the surrounding transaction ends, or no
+	//   row if it can't be paid
+	GetOpenInvoiceByCodeForUpdate(ctx context.Context, code string) (*Invoice, error)
+	// GetPendingTopupProviderPayments: Retrieves payments of topups still pending
+	// Purpose: Feed the job that asks providers for late confirmations
+	// Parameters:
+	//   $1: row_limit - Maximum number of payments to return
+	// Returns:
+	//   Provider payments of pending topups, least recently checked first
+	GetPendingTopupProviderPayments(ctx context.Context, rowLimit int32) ([]*TopupProviderPayment, error)
+	// GetPendingTransferBatchRows: Retrieves rows of a batch that have not run yet
+	// Purpose: Let the executor work through a batch in upload order
+	// Parameters:
+	//   $1: transfer_batch_id - Unique identifier of the batch
+	//   $2: row_limit - Maximum number of rows to return
+	// Returns:
+	//   Pending row records, in upload order
+	GetPendingTransferBatchRows(ctx context.Context, arg GetPendingTransferBatchRowsParams) ([]*TransferBatchRow, error)
+	// GetProviderWebhookEventByEventID: Retrieves a callback by its provider event ID
+	// Purpose: Find the stored copy of a redelivered callback
+	// Parameters:
+	//   $1: provider - Name of the provider that sent the callback
+	//   $2: event_id - Identifier of the callback at the provider
+	// Returns:
+	//   The event record
+	GetProviderWebhookEventByEventID(ctx context.Context, arg GetProviderWebhookEventByEventIDParams) (*ProviderWebhookEvent, error)
+	// GetProviderWebhookEventByID: Retrieves a single provider callback
+	// Purpose: Show or replay one stored callback
+	// Parameters:
+	//   $1: provider_webhook_event_id - Unique identifier of the event
+	// Returns:
+	//   The event record
+	GetProviderWebhookEventByID(ctx context.Context, providerWebhookEventID int32) (*ProviderWebhookEvent, error)
+	// GetProviderWebhookEvents: Retrieves paginated provider callbacks with search capability
+	// Purpose: List received callbacks for support
+	// Parameters:
+	//   $1: search_term - Optional filter on provider, event ID, event type or status (NULL for no filter)
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   Event records with total_count for pagination, newest first
+	GetProviderWebhookEvents(ctx context.Context, arg GetProviderWebhookEventsParams) ([]*GetProviderWebhookEventsRow, error)
+	// GetQrPaymentByReference: Retrieves the payment of a dynamic QR code
+	// Purpose: Let a merchant match a QR payment to the order it was for
+	// Parameters:
+	//   $1: merchant_id - Merchant that was paid
+	//   $2: reference - Reference of the dynamic QR code
+	// Returns:
+	//   The payment record
+	GetQrPaymentByReference(ctx context.Context, arg GetQrPaymentByReferenceParams) (*QrPayment, error)
+	// GetReconciliationRuns: Retrieves the paginated reconciliation runs
+	// Purpose: Show admins when balances were last checked and with what outcome
+	// Parameters:
+	//   $1: limit - Maximum number of records to return
+	//   $2: offset - Number of records to skip for pagination
+	// Returns:
+	//   Run records with total_count for pagination, newest first
+	GetReconciliationRuns(ctx context.Context, arg GetReconciliationRunsParams) ([]*GetReconciliationRunsRow, error)
+	// GetRefundByID: Retrieves a refund by ID
+	// Purpose: Fetch refund details
+	// Parameters:
+	//   $1: refund_id - ID of the refund
+	// Returns:
+	//   The refund record
+	GetRefundByID(ctx context.Context, refundID int32) (*Refund, error)
+	// GetRefunds: Retrieves paginated refunds with search capability
+	// Purpose: List refunds for the management UI
+	// Parameters:
+	//   $1: search_term - Optional text to filter by card number, reason or status (NULL for no filter)
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   All refund fields plus total_count of matching records
+	// Business Logic:
+	//   - Supports partial text matching on multiple fields (case-insensitive)
+	//   - Orders by created_at (newest first)
+	GetRefunds(ctx context.Context, arg GetRefundsParams) ([]*GetRefundsRow, error)
+	// GetRefundsByTransactionID: Retrieves all refunds of a transaction
+	// Purpose: Show the refund history of a single transaction
+	// Parameters:
+	//   $1: transaction_id - ID of the transaction
+	// Returns:
+	//   All refund records of the transaction, oldest first
+	GetRefundsByTransactionID(ctx context.Context, transactionID int32) ([]*Refund, error)
 	// GetRole: Retrieves role details by role_id
 	// Purpose: Fetch a single role record (regardless of deleted status)
 	// Parameters:
@@ -1515,6 +2684,13 @@ type Querier interface {
 	//   - Includes both active and trashed roles
 	//   - Useful for admin panels with filters and pagination
 	GetRoles(ctx context.Context, arg GetRolesParams) ([]*GetRolesRow, error)
+	// GetRunnableTransferBatches: Retrieves batches that still have rows to run
+	// Purpose: Feed the background executor
+	// Parameters:
+	//   $1: row_limit - Maximum number of batches to return
+	// Returns:
+	//   Pending and processing batches, oldest first
+	GetRunnableTransferBatches(ctx context.Context, rowLimit int32) ([]*TransferBatch, error)
 	// GetSaldoByCardNumber: Retrieves saldo information for a specific card
 	// Purpose: Get the current balance and details for a particular card
 	// Parameters:
@@ -1535,6 +2711,13 @@ type Querier interface {
 	//   - Ensures only active saldos are returned (soft-deleted saldos are excluded)
 	//   - Used for detail views or transaction lookups
 	GetSaldoByID(ctx context.Context, saldoID int32) (*Saldo, error)
+	// GetSaldoHoldByID: Retrieves a hold by ID
+	// Purpose: Fetch hold details
+	// Parameters:
+	//   $1: hold_id - ID of the hold
+	// Returns:
+	//   The hold record
+	GetSaldoHoldByID(ctx context.Context, holdID int32) (*SaldoHold, error)
 	// GetSaldos: Retrieves paginated list of active saldos with search capability
 	// Purpose: List all active saldos for admin or user dashboard with optional filtering
 	// Parameters:
@@ -1549,6 +2732,76 @@ type Querier interface {
 	//   - Returns saldos ordered by saldo_id
 	//   - Provides total_count for pagination calculations
 	GetSaldos(ctx context.Context, arg GetSaldosParams) ([]*GetSaldosRow, error)
+	// GetScheduledTransferByID: Retrieves a single scheduled transfer
+	// Purpose: Show the detail of one schedule
+	// Parameters:
+	//   $1: scheduled_transfer_id - Unique identifier of the schedule
+	// Returns:
+	//   The scheduled transfer record
+	GetScheduledTransferByID(ctx context.Context, scheduledTransferID int32) (*ScheduledTransfer, error)
+	// GetScheduledTransferRuns: Retrieves the run history of a schedule
+	// Purpose: Show when a schedule ran and why runs failed
+	// Parameters:
+	//   $1: scheduled_transfer_id - Unique identifier of the schedule
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   Run records with total_count for pagination, most recent first
+	GetScheduledTransferRuns(ctx context.Context, arg GetScheduledTransferRunsParams) ([]*GetScheduledTransferRunsRow, error)
+	// GetScheduledTransfers: Retrieves paginated scheduled transfers with search capability
+	// Purpose: List schedules for their owner or for administration
+	// Parameters:
+	//   $1: search_term - Optional filter on card numbers, recurrence or status (NULL for no filter)
+	//   $2: user_id - Only return schedules of this user (NULL for all users)
+	//   $3: limit - Maximum number of records to return
+	//   $4: offset - Number of records to skip for pagination
+	// Returns:
+	//   Scheduled transfer records with total_count for pagination
+	// Business Logic:
+	//   - Ordered by the next run, schedules with nothing left to run last
+	GetScheduledTransfers(ctx context.Context, arg GetScheduledTransfersParams) ([]*GetScheduledTransfersRow, error)
+	// GetSettleableWithdraws: Retrieves approved withdraws that still have to be paid out
+	// Purpose: Feed the withdraw settlement job
+	// Parameters:
+	//   $1: row_limit - Maximum number of withdraws to return
+	// Returns:
+	//   Approved and processing withdraws, oldest decision first
+	// Business Logic:
+	//   - A withdraw left in 'processing' was interrupted and is settled again
+	GetSettleableWithdraws(ctx context.Context, rowLimit int32) ([]*Withdraw, error)
+	// GetSettlementBatchByID: Retrieves a single settlement batch
+	// Purpose: Show one payout report
+	// Parameters:
+	//   $1: settlement_batch_id - Unique identifier of the batch
+	// Returns:
+	//   The batch record
+	GetSettlementBatchByID(ctx context.Context, settlementBatchID int32) (*SettlementBatch, error)
+	// GetSettlementBatchesByMerchant: Retrieves the paginated settlement batches of a merchant
+	// Purpose: Show a merchant its payout history
+	// Parameters:
+	//   $1: merchant_id - Merchant the batches belong to
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   Batch records with total_count for pagination, newest first
+	GetSettlementBatchesByMerchant(ctx context.Context, arg GetSettlementBatchesByMerchantParams) ([]*GetSettlementBatchesByMerchantRow, error)
+	// GetSettlementItemsByBatch: Lists the line items of a settlement batch
+	// Purpose: Show what a payout was made of
+	// Parameters:
+	//   $1: settlement_batch_id - Batch the items were settled by
+	// Returns:
+	//   The items in the order they accrued
+	GetSettlementItemsByBatch(ctx context.Context, settlementBatchID sql.NullInt32) ([]*SettlementItem, error)
+	// GetStatusHistory: Retrieves every status change of one record
+	// Purpose: Show when a topup, withdraw, transfer or transaction changed status
+	// Parameters:
+	//   $1: entity_type - topup, withdraw, transfer or transaction
+	//   $2: entity_id - ID of the record in its own table
+	// Returns:
+	//   Status history records, oldest first
+	// Business Logic:
+	//   - Rows are written by the status updates themselves and never changed
+	GetStatusHistory(ctx context.Context, arg GetStatusHistoryParams) ([]*StatusHistory, error)
 	// GetTopupByID: Retrieves a specific topup by ID
 	// Purpose: Used to display details of a single topup transaction
 	// Parameters:
@@ -1558,6 +2811,14 @@ type Querier interface {
 	// Business Logic:
 	//   - Only returns record if it is active (deleted_at IS NULL)
 	GetTopupByID(ctx context.Context, topupID int32) (*Topup, error)
+	// GetTopupProviderPaymentByReference: Retrieves a provider payment by its reference
+	// Purpose: Find the topup a provider callback is about
+	// Parameters:
+	//   $1: provider - Name of the provider adapter
+	//   $2: provider_reference - Reference of the payment at the provider
+	// Returns:
+	//   The provider payment record
+	GetTopupProviderPaymentByReference(ctx context.Context, arg GetTopupProviderPaymentByReferenceParams) (*TopupProviderPayment, error)
 	// GetTopups: Retrieves paginated list of active topups with search capability
 	// Purpose: Provide admin or user access to topup history with search support
 	// Parameters:
@@ -1585,6 +2846,20 @@ type Querier interface {
 	//   - Skips soft-deleted records
 	//   - Ordered by topup_time descending
 	GetTopupsByCardNumber(ctx context.Context, arg GetTopupsByCardNumberParams) ([]*GetTopupsByCardNumberRow, error)
+	// GetTotalAmountsByCurrency: Calculates the dashboard totals per currency
+	// Purpose: Break the system-wide totals down by the currency amounts are kept in
+	// Returns:
+	//   currency: Currency the totals are expressed in
+	//   total_balance: Sum of active saldo balances
+	//   total_topup_amount: Sum of active top-ups
+	//   total_withdraw_amount: Sum of active withdrawals
+	//   total_transaction_amount: Sum of active transactions
+	//   total_transfer_amount: Sum of active transfers in the sender currency
+	// Business Logic:
+	//   - Returns one row for every currency a card is issued in
+	//   - Currencies without activity are reported with zero totals
+	//   - Only includes records of active cards (deleted_at IS NULL)
+	GetTotalAmountsByCurrency(ctx context.Context) ([]*GetTotalAmountsByCurrencyRow, error)
 	// GetTotalBalance: Calculates the sum of all active card balances
 	// Purpose: Get the total balance across all active cards in the system
 	// Returns:
@@ -1671,6 +2946,7 @@ type Querier interface {
 	// Business Logic:
 	//   - Only includes active transfer records (deleted_at IS NULL)
 	//   - Useful for tracking money received by a particular account
+	//   - Sums converted_amount so the total is in the receiving card's currency
 	GetTotalTransferAmountByReceiver(ctx context.Context, transferTo string) (int64, error)
 	// GetTotalTransferAmountBySender: Calculates total outgoing transfers from an account
 	// Purpose: Get the total amount sent from a specific card/account
@@ -1731,6 +3007,24 @@ type Querier interface {
 	//   - Only returns active transactions (deleted_at IS NULL)
 	//   - Useful for transaction details viewing and verification
 	GetTransactionByID(ctx context.Context, transactionID int32) (*Transaction, error)
+	// GetTransactionLimitByID: Retrieves a single transaction limit
+	// Purpose: Show the detail of one limit
+	// Parameters:
+	//   $1: transaction_limit_id - Unique identifier of the limit
+	// Returns:
+	//   The transaction limit record
+	GetTransactionLimitByID(ctx context.Context, transactionLimitID int32) (*TransactionLimit, error)
+	// GetTransactionLimits: Retrieves paginated transaction limits with search capability
+	// Purpose: List the configured limits for administration
+	// Parameters:
+	//   $1: search_term - Optional filter on name, operation type or user tier (NULL for no filter)
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   Transaction limit records with total_count for pagination
+	// Business Logic:
+	//   - Ordered by operation type and period, then most recently created first
+	GetTransactionLimits(ctx context.Context, arg GetTransactionLimitsParams) ([]*GetTransactionLimitsRow, error)
 	// GetTransactions: Retrieves paginated transaction records with search capability
 	// Purpose: List all transactions for management UI with filtering options
 	// Parameters:
@@ -1774,6 +3068,39 @@ type Querier interface {
 	//   - No pagination (assumes manageable number of records per merchant)
 	//   - Useful for merchant transaction reports
 	GetTransactionsByMerchantID(ctx context.Context, merchantID int32) ([]*Transaction, error)
+	// GetTransferBatchByID: Retrieves a single transfer batch
+	// Purpose: Show the progress and summary of one batch
+	// Parameters:
+	//   $1: transfer_batch_id - Unique identifier of the batch
+	// Returns:
+	//   The transfer batch record
+	GetTransferBatchByID(ctx context.Context, transferBatchID int32) (*TransferBatch, error)
+	// GetTransferBatchReportRows: Retrieves every row of a batch
+	// Purpose: Build the downloadable result report of a batch
+	// Parameters:
+	//   $1: transfer_batch_id - Unique identifier of the batch
+	// Returns:
+	//   All row records of the batch, in upload order
+	GetTransferBatchReportRows(ctx context.Context, transferBatchID int32) ([]*TransferBatchRow, error)
+	// GetTransferBatchRows: Retrieves the rows of a batch page by page
+	// Purpose: Show the per-row status of a batch
+	// Parameters:
+	//   $1: transfer_batch_id - Unique identifier of the batch
+	//   $2: limit - Maximum number of records to return
+	//   $3: offset - Number of records to skip for pagination
+	// Returns:
+	//   Row records with total_count for pagination, in upload order
+	GetTransferBatchRows(ctx context.Context, arg GetTransferBatchRowsParams) ([]*GetTransferBatchRowsRow, error)
+	// GetTransferBatches: Retrieves paginated transfer batches with search capability
+	// Purpose: List batches for their owner or for administration
+	// Parameters:
+	//   $1: search_term - Optional filter on source card or status (NULL for no filter)
+	//   $2: user_id - Only return batches of this user (NULL for all users)
+	//   $3: limit - Maximum number of records to return
+	//   $4: offset - Number of records to skip for pagination
+	// Returns:
+	//   Transfer batch records with total_count for pagination, newest first
+	GetTransferBatches(ctx context.Context, arg GetTransferBatchesParams) ([]*GetTransferBatchesRow, error)
 	// GetTransferByID: Retrieves a single transfer by its ID
 	// Purpose: Get detailed information about a specific transfer
 	// Parameters:
@@ -1869,14 +3196,14 @@ type Querier interface {
 	// GetTrashedMerchants: Retrieves paginated list of soft-deleted merchants with search capability
 	// Purpose: View trashed merchants for potential restoration or permanent deletion
 	// Parameters:
-	//   $1: search_term - Optional text to filter by name, api_key, or status (NULL for no filter)
+	//   $1: search_term - Optional text to filter by name or status (NULL for no filter)
 	//   $2: limit - Maximum number of records to return
 	//   $3: offset - Number of records to skip for pagination
 	// Returns:
 	//   All merchant fields plus total_count of matching records
 	// Business Logic:
 	//   - Only includes soft-deleted merchants (deleted_at IS NOT NULL)
-	//   - Supports partial text search (case-insensitive) on name, api_key, and status
+	//   - Supports partial text search (case-insensitive) on name and status
 	//   - Returns results ordered by merchant_id
 	//   - Provides total_count for pagination calculations
 	GetTrashedMerchants(ctx context.Context, arg GetTrashedMerchantsParams) ([]*GetTrashedMerchantsRow, error)
@@ -2040,6 +3367,15 @@ type Querier interface {
 	//   - Maintains newest-first ordering
 	//   - Used in admin interfaces for withdrawal recovery
 	GetTrashedWithdraws(ctx context.Context, arg GetTrashedWithdrawsParams) ([]*GetTrashedWithdrawsRow, error)
+	// GetUnsettledSettlementTotals: Totals the unsettled items of a merchant balance
+	// Purpose: Compute a settlement batch before it is created
+	// Parameters:
+	//   $1: merchant_id - Merchant the items belong to
+	//   $2: currency - Currency of the items
+	//   $3: cutoff_at - Only items accrued before this time are totalled
+	// Returns:
+	//   Gross, fee, refund, adjustment and net amounts and the number of items
+	GetUnsettledSettlementTotals(ctx context.Context, arg GetUnsettledSettlementTotalsParams) (*GetUnsettledSettlementTotalsRow, error)
 	// GetUserByEmail: Retrieve a user by their email
 	// Purpose: Fetch a specific user based on their email.
 	// Parameters:
@@ -2091,6 +3427,28 @@ type Querier interface {
 	//   - Only returns active withdrawals (deleted_at IS NULL)
 	//   - Useful for withdrawal details viewing and verification
 	GetWithdrawByID(ctx context.Context, withdrawID int32) (*Withdraw, error)
+	// GetWithdrawByNo: Retrieves a single withdrawal by its withdraw number
+	// Purpose: Find the withdrawal a payout callback is about
+	// Parameters:
+	//   $1: withdraw_no - The public number of the withdrawal
+	// Returns:
+	//   All fields for the specified withdrawal or NULL if not found/deleted
+	GetWithdrawByNo(ctx context.Context, withdrawNo uuid.UUID) (*Withdraw, error)
+	// GetWithdrawByNoForUpdate: Locks a single withdrawal by its withdraw number
+	// Purpose: Apply a payout callback without racing another delivery of it
+	// Parameters:
+	//   $1: withdraw_no - The public number of the withdrawal
+	// Returns:
+	//   The withdrawal, locked until the surrounding transaction ends, or no row
+	//   if not found/deleted
+	GetWithdrawByNoForUpdate(ctx context.Context, withdrawNo uuid.UUID) (*Withdraw, error)
+	// GetWithdrawReviewByWithdrawID: Retrieves the review of a withdraw
+	// Purpose: Show who decided on a withdraw that waited for review
+	// Parameters:
+	//   $1: withdraw_id - Reviewed withdraw
+	// Returns:
+	//   The review record
+	GetWithdrawReviewByWithdrawID(ctx context.Context, withdrawID int32) (*WithdrawReview, error)
 	// GetWithdraws: Retrieves paginated withdrawal records with search capability
 	// Purpose: List all withdrawals for management UI with filtering options
 	// Parameters:
@@ -2132,27 +3490,14 @@ type Querier interface {
 	// GetYearlyAmountByMerchants: Retrieves total transaction amount per year for the last 5 years for a specific merchant
 	// Purpose: Show overall yearly revenue trends for a merchant across all payment methods
 	// Parameters:
-	//   $1: api-key - The merchant to filter transactions
-	//   $2: current_year - The latest year to include in the 5-year window
-	// Returns:
-	//   - Year (e.g., 2021, 2022)
-	//   - Total transaction amount
-	// Business Logic:
-	//   - Filters only active (non-deleted) transactions and merchants
-	//   - Filters by specific merchant_id
-	//   - Includes data for the last 5 calendar years up to the current year
-	//   - Groups by calendar year
-	//   - Results are ordered chronologically by year
-	GetYearlyAmountByApikey(ctx context.Context, arg GetYearlyAmountByApikeyParams) ([]*GetYearlyAmountByApikeyRow, error)
-	// GetYearlyAmountByMerchants: Retrieves total transaction amount per year for the last 5 years for a specific merchant
-	// Purpose: Show overall yearly revenue trends for a merchant across all payment methods
-	// Parameters:
 	//   $1: merchant_id - The merchant to filter transactions
 	//   $2: current_year - The latest year to include in the 5-year window
 	// Returns:
+	//   currency: Currency the totals are expressed in
 	//   - Year (e.g., 2021, 2022)
 	//   - Total transaction amount
 	// Business Logic:
+	//   - Amounts are net of refunds (amount - refunded_amount)
 	//   - Filters only active (non-deleted) transactions and merchants
 	//   - Filters by specific merchant_id
 	//   - Includes data for the last 5 calendar years up to the current year
@@ -2164,9 +3509,11 @@ type Querier interface {
 	// Parameters:
 	//   $1: current_year - The latest year to include in the 5-year window
 	// Returns:
+	//   currency: Currency the totals are expressed in
 	//   - Year (e.g., 2021, 2022)
 	//   - Total transaction amount
 	// Business Logic:
+	//   - Amounts are net of refunds (amount - refunded_amount)
 	//   - Aggregates yearly transaction amounts
 	//   - Filters only active (non-deleted) transactions and merchants
 	//   - Includes data for the last 5 calendar years up to the current year
@@ -2178,6 +3525,7 @@ type Querier interface {
 	// Parameters:
 	//   $1: current_year - The most recent year to include (covers current_year - 4 to current_year)
 	// Returns:
+	//   currency: Currency the totals are expressed in
 	//   - year: Year of the transaction
 	//   - total_amount: Total transaction amount for the year
 	// Business Logic:
@@ -2190,6 +3538,7 @@ type Querier interface {
 	//   $1: card_number  - filter by card_number
 	//   $2: current_year - The most recent year to include (covers current_year - 4 to current_year)
 	// Returns:
+	//   currency: Currency the totals are expressed in
 	//   - year: Year of the transaction
 	//   - total_amount: Total transaction amount for the year
 	// Business Logic:
@@ -2202,6 +3551,7 @@ type Querier interface {
 	// Returns:
 	//   year: The 4-digit year
 	//   total_balance: Sum of balances for that year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Covers a 5-year rolling window (reference_year-4 to reference_year)
 	//   - Only includes active saldos and cards
@@ -2216,28 +3566,44 @@ type Querier interface {
 	// Returns:
 	//   year: 4-digit year
 	//   total_balance: Annual balance total
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Covers reference_year-4 to reference_year (5 years)
 	//   - Strictly filters for specified card
 	//   - Only includes active records
 	//   - Useful for long-term financial planning
 	GetYearlyBalancesByCardNumber(ctx context.Context, arg GetYearlyBalancesByCardNumberParams) ([]*GetYearlyBalancesByCardNumberRow, error)
-	// GetYearlyPaymentMethodByApikey: Retrieves total transaction amount per payment method over the last 5 years for a specific merchant
-	// Purpose: Analyze yearly transaction totals grouped by payment method for a merchant
+	// GetYearlyDisputeRateByMerchants: Retrieves the dispute rate per year for the last 5 years for a specific merchant
+	// Purpose: Show long-term dispute trends for a merchant
 	// Parameters:
-	//   $1: api-key - The merchant to filter transactions
+	//   $1: merchant_id - The merchant to filter transactions and disputes
 	//   $2: current_year - The latest year to include in the 5-year window
 	// Returns:
 	//   - Year (e.g., 2021, 2022)
-	//   - Payment method
-	//   - Total transaction amount
-	// Business Logic:
-	//   - Filters only active (non-deleted) transactions and merchants
-	//   - Filters by specific merchant_id
-	//   - Includes data for the last 5 calendar years up to the current year
-	//   - Groups by calendar year and payment method
+	//   - Number of completed transactions in the year
+	//   - Number of disputes opened in the year
+	//   - Dispute rate as a percentage of completed transactions (0 if no transactions)
+	// Business Logic:
+	//   - Completed transactions are those that reached 'success', including ones
+	//     later refunded, disputed or charged back
+	//   - Disputes are bucketed by the year they were opened
+	//   - Includes every year of the 5-year window, even without activity
+	//   - Filters only active (non-deleted) transactions
 	//   - Results are ordered chronologically by year
-	GetYearlyPaymentMethodByApikey(ctx context.Context, arg GetYearlyPaymentMethodByApikeyParams) ([]*GetYearlyPaymentMethodByApikeyRow, error)
+	GetYearlyDisputeRateByMerchants(ctx context.Context, arg GetYearlyDisputeRateByMerchantsParams) ([]*GetYearlyDisputeRateByMerchantsRow, error)
+	// GetYearlyFeeAmounts: Retrieves yearly totals of fees charged
+	// Purpose: Compare platform fee revenue across years
+	// Parameters:
+	//   $1: current_year - The latest year to include, includes 5-year span (current_year - 4)
+	// Returns:
+	//   year: Year the fees were charged
+	//   transaction_type: transfer, withdraw, topup or transaction
+	//   currency: Currency the fees were charged in
+	//   total_fee: Sum of the fees charged in the year
+	// Business Logic:
+	//   - Only successful, non-deleted transactions are counted
+	//   - Only years and types with fees are returned
+	GetYearlyFeeAmounts(ctx context.Context, dollar_1 interface{}) ([]*GetYearlyFeeAmountsRow, error)
 	// GetYearlyPaymentMethodByMerchants: Retrieves total transaction amount per payment method over the last 5 years for a specific merchant
 	// Purpose: Analyze yearly transaction totals grouped by payment method for a merchant
 	// Parameters:
@@ -2248,6 +3614,7 @@ type Querier interface {
 	//   - Payment method
 	//   - Total transaction amount
 	// Business Logic:
+	//   - Amounts are net of refunds (amount - refunded_amount)
 	//   - Filters only active (non-deleted) transactions and merchants
 	//   - Filters by specific merchant_id
 	//   - Includes data for the last 5 calendar years up to the current year
@@ -2263,6 +3630,7 @@ type Querier interface {
 	//   - Payment method
 	//   - Total transaction amount
 	// Business Logic:
+	//   - Amounts are net of refunds (amount - refunded_amount)
 	//   - Aggregates yearly totals for each payment method
 	//   - Includes only active (non-deleted) transactions and merchants
 	//   - Covers a 5-year range: (current_year - 4) to current_year
@@ -2304,6 +3672,7 @@ type Querier interface {
 	// Returns:
 	//   year: The 4-digit year
 	//   total_balance: Sum of balances for that year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Covers a 5-year rolling window (reference_year-4 to reference_year)
 	//   - Only includes active saldo records (deleted_at IS NULL)
@@ -2318,6 +3687,7 @@ type Querier interface {
 	// Returns:
 	//   year: The 4-digit year
 	//   total_topup_amount: Sum of top-ups for that year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Covers a 5-year rolling window
 	//   - Only includes active topups and cards
@@ -2333,6 +3703,7 @@ type Querier interface {
 	// Returns:
 	//   year: 4-digit year
 	//   total_topup_amount: Annual top-up total
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - 5-year rolling window analysis
 	//   - Strict card number filtering
@@ -2347,6 +3718,7 @@ type Querier interface {
 	// Returns:
 	//   year: Year extracted from topup_time
 	//   total_amount: Sum of all topup amounts in the year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Includes topup data from current year and 4 years prior
 	//   - Excludes soft-deleted records (deleted_at IS NULL)
@@ -2360,6 +3732,7 @@ type Querier interface {
 	// Returns:
 	//   year: Year extracted from topup_time
 	//   total_amount: Sum of all topup amounts in the year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Includes topup data from current year and 4 years prior
 	//   - Excludes soft-deleted records (deleted_at IS NULL)
@@ -2456,30 +3829,17 @@ type Querier interface {
 	//   - Orders by year (newest first)
 	//   - Useful for year-over-year growth analysis
 	GetYearlyTopupStatusSuccessCardNumber(ctx context.Context, arg GetYearlyTopupStatusSuccessCardNumberParams) ([]*GetYearlyTopupStatusSuccessCardNumberRow, error)
-	// GetYearlyTotalAmountByApikey: Retrieves total transaction amounts for the current and previous year
-	// Purpose: Provide yearly transaction summary with fallback to 0 if no transactions exist
-	// Parameters:
-	//   $1: current_year - The latest year to include in the summary
-	//   $2: api-key - The merchant to filter transactions
-	// Returns:
-	//   - Year (as text)
-	//   - Total transaction amount per year
-	// Business Logic:
-	//   - Aggregates total amounts for both the current year and the previous year
-	//   - Filters only active (non-deleted) transactions and merchants
-	//   - Ensures both years appear in the result, even if no data exists (returns 0 in such case)
-	//   - Uses UNION ALL to combine actual data with 0-filled placeholders
-	//   - Results are ordered in descending order by year
-	GetYearlyTotalAmountByApikey(ctx context.Context, arg GetYearlyTotalAmountByApikeyParams) ([]*GetYearlyTotalAmountByApikeyRow, error)
 	// GetYearlyTotalAmountByMerchant: Retrieves total transaction amounts for the current and previous year
 	// Purpose: Provide yearly transaction summary with fallback to 0 if no transactions exist
 	// Parameters:
 	//   $1: current_year - The latest year to include in the summary
 	//   $2: merchant_id - The merchant to filter transactions
 	// Returns:
+	//   currency: Currency the totals are expressed in
 	//   - Year (as text)
 	//   - Total transaction amount per year
 	// Business Logic:
+	//   - Amounts are net of refunds (amount - refunded_amount)
 	//   - Aggregates total amounts for both the current year and the previous year
 	//   - Filters only active (non-deleted) transactions and merchants
 	//   - Ensures both years appear in the result, even if no data exists (returns 0 in such case)
@@ -2491,9 +3851,11 @@ type Querier interface {
 	// Parameters:
 	//   $1: current_year - The latest year to include in the summary
 	// Returns:
+	//   currency: Currency the totals are expressed in
 	//   - Year (as text)
 	//   - Total transaction amount per year
 	// Business Logic:
+	//   - Amounts are net of refunds (amount - refunded_amount)
 	//   - Aggregates total amounts for both the current year and the previous year
 	//   - Filters only active (non-deleted) transactions and merchants
 	//   - Ensures both years appear in the result, even if no data exists (returns 0 in such case)
@@ -2507,6 +3869,7 @@ type Querier interface {
 	// Returns:
 	//   year: The year as text
 	//   total_balance: Annual balance total (0 if no data)
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Shows comparison between specified year and previous year
 	//   - Only includes active saldos (deleted_at IS NULL)
@@ -2521,6 +3884,7 @@ type Querier interface {
 	// Returns:
 	//   year: The 4-digit year
 	//   total_transaction_amount: Sum of transactions for that year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Covers a 5-year rolling window
 	//   - Only includes active transactions and cards
@@ -2536,6 +3900,7 @@ type Querier interface {
 	// Returns:
 	//   year: 4-digit year
 	//   total_transaction_amount: Annual transaction total
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - 5-year rolling window analysis
 	//   - Strict card number filtering
@@ -2617,6 +3982,7 @@ type Querier interface {
 	// Returns:
 	//   year: 4-digit year
 	//   total_sent_amount: Annual transfer total
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - 5-year rolling window analysis
 	//   - Strict card number filtering
@@ -2632,6 +3998,7 @@ type Querier interface {
 	// Returns:
 	//   year: 4-digit year
 	//   total_sent_amount: Annual transfer total
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - 5-year rolling window analysis
 	//   - Strict card number filtering
@@ -2646,6 +4013,7 @@ type Querier interface {
 	// Returns:
 	//   year: The 4-digit year
 	//   total_received_amount: Sum of transfers for that year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Covers a 5-year rolling window
 	//   - Only includes active topups and cards
@@ -2660,6 +4028,7 @@ type Querier interface {
 	// Returns:
 	//   year: The 4-digit year
 	//   total_sent_amount: Sum of transfers for that year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Covers a 5-year rolling window
 	//   - Only includes active topups and cards
@@ -2674,6 +4043,7 @@ type Querier interface {
 	// Returns:
 	//   year: 4-digit year
 	//   total_transfer_amount: Sum of transfer amounts in that year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Covers a 5-year window (current_year - 4 to current_year)
 	//   - Filters out soft-deleted records (deleted_at IS NULL)
@@ -2688,6 +4058,7 @@ type Querier interface {
 	// Returns:
 	//   year: 4-digit year
 	//   total_transfer_amount: Sum of transfer amounts in that year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Covers a 5-year window (current_year - 4 to current_year)
 	//   - Filters out soft-deleted records (deleted_at IS NULL)
@@ -2702,6 +4073,7 @@ type Querier interface {
 	// Returns:
 	//   year: 4-digit year
 	//   total_transfer_amount: Sum of transfer amounts in that year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Covers a 5-year window (current_year - 4 to current_year)
 	//   - Filters out soft-deleted records (deleted_at IS NULL)
@@ -2781,6 +4153,7 @@ type Querier interface {
 	// Returns:
 	//   year: The 4-digit year
 	//   total_withdraw_amount: Sum of withdraws for that year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Covers a 5-year rolling window
 	//   - Only includes active withdraws and cards
@@ -2796,6 +4169,7 @@ type Querier interface {
 	// Returns:
 	//   year: 4-digit year
 	//   total_withdraw_amount: Annual withdraw total
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - 5-year rolling window analysis
 	//   - Strict card number filtering
@@ -2860,6 +4234,7 @@ type Querier interface {
 	// Returns:
 	//   year: 4-digit year
 	//   total_withdraw_amount: Sum of withdrawal amounts for that year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Covers a 5-year rolling window (current_year-4 to current_year)
 	//   - Only includes active withdrawal records
@@ -2875,6 +4250,7 @@ type Querier interface {
 	// Returns:
 	//   year: 4-digit year
 	//   total_withdraw_amount: Sum of withdrawals for that card by year
+	//   currency: Currency the totals are expressed in
 	// Business Logic:
 	//   - Covers 5-year period (current_year-4 to current_year)
 	//   - Filters by specific card number
@@ -2883,6 +4259,94 @@ type Querier interface {
 	//   - Orders chronologically
 	//   - Useful for customer spending habit analysis
 	GetYearlyWithdrawsByCardNumber(ctx context.Context, arg GetYearlyWithdrawsByCardNumberParams) ([]*GetYearlyWithdrawsByCardNumberRow, error)
+	// LockSaldosByCardNumbers: Locks the saldo rows of the given cards
+	// Purpose: Serialize concurrent balance movements on the same cards
+	// Parameters:
+	//   $1: card_numbers - Card numbers whose saldos are locked
+	// Returns:
+	//   All saldo fields for the active records matching the card numbers
+	// Business Logic:
+	//   - Must run inside a database transaction; locks are held until commit
+	//   - Rows are locked in card number order so that movements touching the
+	//     same cards in opposite directions cannot deadlock
+	//   - Balance checks made on the returned rows stay valid until commit
+	LockSaldosByCardNumbers(ctx context.Context, cardNumbers []string) ([]*Saldo, error)
+	// MarkInvoicePaid: Marks an invoice as paid
+	// Purpose: Close an invoice once its payment succeeded
+	// Parameters:
+	//   $1: invoice_id - ID of the invoice
+	// Returns:
+	//   The updated invoice, or no row if it is no longer open
+	MarkInvoicePaid(ctx context.Context, invoiceID int32) (*Invoice, error)
+	// MarkTransactionDisputed: Puts a transaction under dispute
+	// Purpose: Freeze a transaction while a cardholder dispute is pending
+	// Parameters:
+	//   $1: transaction_id - ID of the disputed transaction
+	// Returns:
+	//   The updated transaction record, or no row if the transaction cannot be disputed
+	// Business Logic:
+	//   - Only successful or partially refunded transactions can be disputed
+	//   - Fully refunded transactions have nothing left to dispute
+	//   - Disputed transactions cannot be refunded or updated until resolved
+	//   - The status change is written to status_histories by the same statement
+	MarkTransactionDisputed(ctx context.Context, transactionID int32) (*Transaction, error)
+	// PauseScheduledTransfer: Stops an active schedule from running
+	// Purpose: Suspend a schedule without losing it
+	// Parameters:
+	//   $1: scheduled_transfer_id - Unique identifier of the schedule
+	// Returns:
+	//   The paused scheduled transfer record
+	// Business Logic:
+	//   - Only active schedules can be paused
+	PauseScheduledTransfer(ctx context.Context, scheduledTransferID int32) (*ScheduledTransfer, error)
+	// QueueMerchantWebhookDeliveries: Queues an event for every endpoint subscribed to it
+	// Purpose: Fan out a merchant event to its webhook endpoints
+	// Parameters:
+	//   $1: merchant_id - Merchant the event belongs to
+	//   $2: event_id - Identifier of the event, shared by all its deliveries
+	//   $3: event_type - Type of the event
+	//   $4: payload - JSON body of the event
+	// Returns:
+	//   The queued deliveries, one per active endpoint subscribed to the event type
+	// Business Logic:
+	//   - Runs in the same transaction as the change the event describes, so an
+	//     event is only sent when that change is committed
+	QueueMerchantWebhookDeliveries(ctx context.Context, arg QueueMerchantWebhookDeliveriesParams) ([]*MerchantWebhookDelivery, error)
+	// RebuildSaldoBalancesFromLedger: Recomputes saldo balances from the ledger
+	// Purpose: Rebuild the saldos projection when it drifts from the ledger
+	// Returns:
+	//   The saldo records whose balance was corrected
+	// Business Logic:
+	//   - Saldos without any posting are reset to zero
+	//   - Only rows that differ from the ledger are touched
+	RebuildSaldoBalancesFromLedger(ctx context.Context) ([]*Saldo, error)
+	// ReleaseSaldoHold: Releases an active hold
+	// Purpose: Return held funds to the available balance
+	// Parameters:
+	//   $1: hold_id - ID of the hold
+	// Returns:
+	//   The updated hold record, or no row if the hold is no longer active
+	// Business Logic:
+	//   - The caller releases the reservation on the saldo in the same transaction
+	ReleaseSaldoHold(ctx context.Context, holdID int32) (*SaldoHold, error)
+	// ReleaseSaldoReservation: Releases part of a card's reserved balance
+	// Purpose: Free funds once a saldo hold is released or consumed
+	// Parameters:
+	//   $1: card_number - Card whose reservation is released
+	//   $2: amount - Amount to release
+	// Returns:
+	//   The updated saldo record, or no row if less than amount is reserved
+	ReleaseSaldoReservation(ctx context.Context, arg ReleaseSaldoReservationParams) (*Saldo, error)
+	// ReleaseTransactionDispute: Returns a disputed transaction to its previous status
+	// Purpose: Close a dispute that was ruled in favour of the merchant
+	// Parameters:
+	//   $1: transaction_id - ID of the disputed transaction
+	// Returns:
+	//   The updated transaction record, or no row if the transaction is not disputed
+	// Business Logic:
+	//   - Status becomes 'partially_refunded' if refunds exist, otherwise 'success'
+	//   - The status change is written to status_histories by the same statement
+	ReleaseTransactionDispute(ctx context.Context, transactionID int32) (*Transaction, error)
 	// RemoveRoleFromUser: Permanently removes a role from a user
 	// Purpose: Hard delete of a user-role mapping (bypasses trash)
 	// Parameters:
@@ -2892,6 +4356,46 @@ type Querier interface {
 	//   - Deletes the record instead of soft-deleting
 	//   - Use cautiously if audit/history is important
 	RemoveRoleFromUser(ctx context.Context, arg RemoveRoleFromUserParams) error
+	// ReserveSaldoBalance: Reserves part of a card's balance
+	// Purpose: Set aside funds for an active saldo hold without moving them
+	// Parameters:
+	//   $1: card_number - Card whose funds are reserved
+	//   $2: amount - Amount to reserve
+	// Returns:
+	//   The updated saldo record, or no row if the unreserved balance is too low
+	// Business Logic:
+	//   - Only active saldos can be reserved
+	//   - The check and the increment happen in one statement
+	ReserveSaldoBalance(ctx context.Context, arg ReserveSaldoBalanceParams) (*Saldo, error)
+	// ResolveBalanceDiscrepancies: Resolves the discrepancies a run no longer found
+	// Purpose: Close discrepancies of cards that match again
+	// Parameters:
+	//   $1: reconciliation_run_id - Run that just compared every card
+	ResolveBalanceDiscrepancies(ctx context.Context, reconciliationRunID int32) error
+	// ResolveDispute: Records the admin ruling on a dispute
+	// Purpose: Close a dispute in favour of the cardholder or the merchant
+	// Parameters:
+	//   $1: dispute_id - ID of the dispute
+	//   $2: status - 'resolved_cardholder' or 'resolved_merchant'
+	//   $3: resolution_note - Explanation of the ruling
+	//   $4: resolved_by - Admin user who ruled
+	// Returns:
+	//   The updated dispute record, or no row if the dispute is already resolved
+	// Business Logic:
+	//   - Only 'open' and 'under_review' disputes can be resolved
+	//   - The status guard makes concurrent rulings resolve the dispute once
+	ResolveDispute(ctx context.Context, arg ResolveDisputeParams) (*Dispute, error)
+	// RespondDispute: Stores the merchant's evidence for a dispute
+	// Purpose: Move an open dispute to admin review
+	// Parameters:
+	//   $1: dispute_id - ID of the dispute
+	//   $2: merchant_evidence - Evidence notes supplied by the merchant
+	// Returns:
+	//   The updated dispute record, or no row if the dispute is not open
+	// Business Logic:
+	//   - Only 'open' disputes accept a response
+	//   - Status becomes 'under_review'
+	RespondDispute(ctx context.Context, arg RespondDisputeParams) (*Dispute, error)
 	// RestoreAllCards: Restores all trashed cards
 	// Purpose: Bulk-restore all soft-deleted cards
 	// Parameters: None
@@ -3028,6 +4532,33 @@ type Querier interface {
 	//   - Only works on currently trashed withdrawals
 	//   - Used for data recovery purposes
 	RestoreWithdraw(ctx context.Context, withdrawID int32) (*Withdraw, error)
+	// ResumeScheduledTransfer: Reactivates a paused or failed schedule
+	// Purpose: Let a schedule run again after it was paused or a run failed
+	// Parameters:
+	//   $1: scheduled_transfer_id - Unique identifier of the schedule
+	//   $2: next_run_at - When the next run is due
+	// Returns:
+	//   The resumed scheduled transfer record
+	// Business Logic:
+	//   - Runs missed while the schedule was not active are not caught up
+	ResumeScheduledTransfer(ctx context.Context, arg ResumeScheduledTransferParams) (*ScheduledTransfer, error)
+	// RevokeMerchantApiKey: Revokes an API key
+	// Purpose: Stop a key from working immediately
+	// Parameters:
+	//   $1: merchant_api_key_id - Unique identifier of the key
+	// Returns:
+	//   The revoked key
+	RevokeMerchantApiKey(ctx context.Context, merchantApiKeyID int32) (*MerchantApiKey, error)
+	// SealMerchantApiKeySigningSecret: Replaces a plain signing secret with its encrypted form
+	// Purpose: Encrypt a signing secret stored before encryption was added
+	// Parameters:
+	//   $1: signing_secret - The encrypted secret
+	//   $2: merchant_api_key_id - Unique identifier of the key
+	//   $3: plain_signing_secret - The secret as it was read
+	// Business Logic:
+	//   - Only replaces the secret it was read with, so two servers starting at
+	//     once can't encrypt a secret twice
+	SealMerchantApiKeySigningSecret(ctx context.Context, arg SealMerchantApiKeySigningSecretParams) (int64, error)
 	// SearchUsersByEmail: Search users by email with case-insensitive matching
 	// Purpose: Allows searching for users whose email matches a given search term (case-insensitive).
 	// Parameters:
@@ -3039,6 +4570,54 @@ type Querier interface {
 	//   - Uses `ILIKE` to perform a case-insensitive search on the `email` column.
 	//   - Only returns active users (`deleted_at IS NULL`).
 	SearchUsersByEmail(ctx context.Context, dollar_1 sql.NullString) ([]*User, error)
+	// SetMerchantSettlementCard: Designates the card a merchant balance is paid out to
+	// Purpose: Let a merchant choose where its settlements go
+	// Parameters:
+	//   $1: merchant_id - Merchant the balance belongs to
+	//   $2: currency - Currency of the balance, which is the card currency
+	//   $3: settlement_card_number - Card the balance is paid out to
+	// Returns:
+	//   The updated balance
+	SetMerchantSettlementCard(ctx context.Context, arg SetMerchantSettlementCardParams) (*MerchantBalance, error)
+	// StartTransferBatch: Marks a batch as being executed
+	// Purpose: Expose that a batch has started and when
+	// Parameters:
+	//   $1: transfer_batch_id - Unique identifier of the batch
+	// Returns:
+	//   The processing transfer batch record
+	// Business Logic:
+	//   - A batch picked up again after an interruption keeps its first started_at
+	StartTransferBatch(ctx context.Context, transferBatchID int32) (*TransferBatch, error)
+	// TouchMerchantApiKey: Records that an API key was used
+	// Purpose: Show a merchant which keys are still in use
+	// Parameters:
+	//   $1: merchant_api_key_id - Unique identifier of the key
+	// Business Logic:
+	//   - Written at most once a minute per key so busy keys don't cause a
+	//     write on every call
+	TouchMerchantApiKey(ctx context.Context, merchantApiKeyID int32) error
+	// TouchTopupProviderPayment: Records the last answer of a provider
+	// Purpose: Keep the provider message and move the payment to the back of the check queue
+	// Parameters:
+	//   $1: topup_provider_payment_id - Unique identifier of the payment
+	//   $2: last_message - Message returned by the provider (NULL when none)
+	// Returns:
+	//   The updated provider payment record
+	TouchTopupProviderPayment(ctx context.Context, arg TouchTopupProviderPaymentParams) (*TopupProviderPayment, error)
+	// TransitionMerchantStatus: Moves a merchant to another status of the onboarding lifecycle
+	// Purpose: Activate, reject, suspend or terminate a merchant with a recorded reason
+	// Parameters:
+	//   status: Status the merchant moves to
+	//   merchant_id: Merchant whose status changes
+	//   from_status: Status the merchant is expected to be in
+	//   reason: Why the status changed, if given
+	//   changed_by: User who changed the status, if any
+	// Returns:
+	//   The updated merchant, or no row when it was no longer in from_status
+	// Business Logic:
+	//   - The status history row is written by the same statement, and only
+	//     when the merchant was still in from_status
+	TransitionMerchantStatus(ctx context.Context, arg TransitionMerchantStatusParams) (*Merchant, error)
 	// TrashCard: Soft-deletes a card by marking deleted_at
 	// Purpose: Temporarily remove a card without deleting it permanently
 	// Parameters:
@@ -3125,6 +4704,11 @@ type Querier interface {
 	//   - Preserves data for audit/recovery purposes
 	//   - Withdrawal remains in database but hidden
 	TrashWithdraw(ctx context.Context, withdrawID int32) (*Withdraw, error)
+	// TryLockReconciliation: Takes the reconciliation lock for the surrounding transaction
+	// Purpose: Keep two reconciliation runs from recording the same discrepancies at once
+	// Returns:
+	//   Whether the lock was taken; it is released when the transaction ends
+	TryLockReconciliation(ctx context.Context) (bool, error)
 	// UpdateCard: Updates an existing card's details
 	// Purpose: Modify card attributes for a specific card
 	// Parameters:
@@ -3138,15 +4722,34 @@ type Querier interface {
 	//   - Automatically updates updated_at timestamp
 	//   - Only updates cards that are not soft-deleted
 	UpdateCard(ctx context.Context, arg UpdateCardParams) (*Card, error)
+	// UpdateFeeSchedule: Updates an existing fee schedule
+	// Purpose: Change the pricing or the scope of a fee schedule
+	// Parameters:
+	//   $1: fee_schedule_id - Unique identifier of the schedule
+	//   $2 - $12: Same fields as CreateFeeSchedule
+	// Returns:
+	//   The updated fee schedule record
+	// Business Logic:
+	//   - Fees already charged are stored on their transaction and are not recomputed
+	UpdateFeeSchedule(ctx context.Context, arg UpdateFeeScheduleParams) (*FeeSchedule, error)
+	// UpdateIdempotencyKeyResponse: Stores the response produced for a key
+	// Purpose: Remember the result so repeats can be replayed
+	// Parameters:
+	//   $1: idempotency_key_id - ID of the reserved key
+	//   $2: response_body - Serialized response returned to the client
+	// Returns: The updated key record
+	// Business Logic:
+	//   - Marks the key as completed
+	UpdateIdempotencyKeyResponse(ctx context.Context, arg UpdateIdempotencyKeyResponseParams) (*IdempotencyKey, error)
 	// Update Merchant
 	// Purpose: Update an existing merchant record
 	// Parameters:
 	//   $1: merchant_id - ID of the merchant to be updated
 	//   $2: name - The new name for the merchant
 	//   $3: user_id - New user ID associated with the merchant
-	//   $4: status - New status for the merchant
 	// Business Logic:
-	//   - Updates the specified merchant's name, user_id, and status.
+	//   - Updates the specified merchant's name and user_id.
+	//   - The status is changed through the onboarding lifecycle only.
 	//   - Ensures the merchant is not marked as deleted (deleted_at is NULL).
 	//   - Sets the updated_at timestamp to the current time.
 	UpdateMerchant(ctx context.Context, arg UpdateMerchantParams) (*Merchant, error)
@@ -3159,6 +4762,16 @@ type Querier interface {
 	//   - Ensures the merchant is not marked as deleted (deleted_at is NULL).
 	//   - Sets the updated_at timestamp to the current time.
 	UpdateMerchantStatus(ctx context.Context, arg UpdateMerchantStatusParams) (*Merchant, error)
+	// UpdateMerchantWebhookEndpoint: Changes the address, subscriptions or state of an endpoint
+	// Purpose: Let a merchant manage an endpoint
+	// Parameters:
+	//   $1: merchant_webhook_endpoint_id - Unique identifier of the endpoint
+	//   $2: url - Address the events are posted to
+	//   $3: events - Event types the endpoint subscribes to
+	//   $4: is_active - Whether new events are sent to the endpoint
+	// Returns:
+	//   The updated endpoint
+	UpdateMerchantWebhookEndpoint(ctx context.Context, arg UpdateMerchantWebhookEndpointParams) (*MerchantWebhookEndpoint, error)
 	// UpdateRefreshTokenByUserId: Updates refresh token for a user
 	// Purpose: Rotate/refresh token for a user
 	// Parameters:
@@ -3180,14 +4793,14 @@ type Querier interface {
 	//   Updated role's data
 	UpdateRole(ctx context.Context, arg UpdateRoleParams) (*Role, error)
 	// UpdateSaldo: Modifies saldo record details
-	// Purpose: Update card number and balance for an existing saldo
+	// Purpose: Update the balance of an existing saldo
 	// Parameters:
 	//   $1: saldo_id - The ID of the saldo to update
-	//   $2: card_number - New card number to associate
-	//   $3: total_balance - New balance amount
+	//   $2: total_balance - New balance amount
 	// Business Logic:
 	//   - Only updates active records (deleted_at IS NULL)
 	//   - Automatically updates the modification timestamp
+	//   - The card is kept, as the ledger postings of the saldo stay on it
 	//   - Useful for administrative corrections
 	UpdateSaldo(ctx context.Context, arg UpdateSaldoParams) (*Saldo, error)
 	// UpdateSaldoBalance: Updates only the balance amount for a card
@@ -3201,28 +4814,45 @@ type Querier interface {
 	//   - Useful for balance adjustments and corrections
 	UpdateSaldoBalance(ctx context.Context, arg UpdateSaldoBalanceParams) (*Saldo, error)
 	// UpdateSaldoWithdraw: Processes a withdrawal transaction
-	// Purpose: Record a withdrawal and update the remaining balance
+	// Purpose: Record the latest withdrawal on the saldo
 	// Parameters:
 	//   $1: card_number - The card used for withdrawal
 	//   $2: withdraw_amount - The amount being withdrawn
 	//   $3: withdraw_time - Timestamp of the withdrawal
 	// Business Logic:
 	//   - Only processes if sufficient balance exists (total_balance >= $2)
-	//   - Updates both withdrawal amount and remaining balance
-	//   - Records withdrawal timestamp
+	//   - Records withdrawal amount and timestamp
+	//   - The balance itself is moved by the withdraw ledger journal
 	//   - Only affects active records
 	UpdateSaldoWithdraw(ctx context.Context, arg UpdateSaldoWithdrawParams) (*Saldo, error)
+	// UpdateScheduledTransfer: Changes what and when a schedule transfers
+	// Purpose: Edit the recipient, amount or timing of a schedule
+	// Parameters:
+	//   $1: scheduled_transfer_id - Unique identifier of the schedule
+	//   $2: transfer_to - Card the funds are sent to
+	//   $3: transfer_amount - Amount of every run
+	//   $4: recurrence - once, daily, weekly or monthly
+	//   $5: day_of_month - Day monthly schedules run on (NULL otherwise)
+	//   $6: next_run_at - When the next run is due
+	//   $7: end_at - No run is scheduled after this moment (NULL for no end)
+	// Returns:
+	//   The updated scheduled transfer record
+	// Business Logic:
+	//   - Completed and cancelled schedules cannot be changed
+	//   - Moving next_run_at makes a concurrent executor lose its claim on the old run
+	UpdateScheduledTransfer(ctx context.Context, arg UpdateScheduledTransferParams) (*ScheduledTransfer, error)
 	// UpdateTopup: Updates an existing topup transaction
 	// Purpose: Modify existing topup information by ID
 	// Parameters:
 	//   $1: topup_id - ID of the topup to update
-	//   $2: card_number - Updated card number
-	//   $3: topup_amount - Updated amount
-	//   $4: topup_method - Updated payment method
-	//   $5: topup_time - Updated transaction time
+	//   $2: topup_amount - Updated amount
+	//   $3: topup_method - Updated payment method
+	//   $4: topup_time - Updated transaction time
+	//   $5: fee - Topup fee quoted on the updated amount
 	// Business Logic:
 	//   - Skips soft-deleted records (deleted_at IS NULL)
 	//   - Updates updated_at automatically
+	//   - The card is kept, as the ledger postings of the topup stay on it
 	UpdateTopup(ctx context.Context, arg UpdateTopupParams) (*Topup, error)
 	// UpdateTopupAmount: Updates only the topup_amount field for a specific topup
 	// Purpose: Allow adjustment of topup amount without affecting other fields
@@ -3233,14 +4863,19 @@ type Querier interface {
 	//   - Ignores deleted entries
 	//   - Automatically updates the updated_at timestamp
 	UpdateTopupAmount(ctx context.Context, arg UpdateTopupAmountParams) (*Topup, error)
-	// UpdateTopupStatus: Updates the status of a specific topup
-	// Purpose: Mark topup as 'success', 'failed', etc.
+	// UpdateTopupStatus: Moves a topup from one status to another
+	// Purpose: Record the outcome of a topup together with its status history
 	// Parameters:
-	//   $1: topup_id - ID of the topup
-	//   $2: new status value (e.g., 'success', 'failed')
+	//   $1: status - New status
+	//   $2: topup_id - ID of the topup
+	//   $3: from_status - Status the topup is expected to be in
+	// Returns:
+	//   The updated topup, or no row when it is no longer in from_status
 	// Business Logic:
-	//   - Applies only to active (non-deleted) records
-	//   - updated_at is refreshed
+	//   - Only updates active (non-deleted) records
+	//   - Allowed transitions are checked against pkg/statemachine by the caller
+	//   - The status history row is written by the same statement, and only
+	//     when the row was still in from_status
 	UpdateTopupStatus(ctx context.Context, arg UpdateTopupStatusParams) (*Topup, error)
 	// UpdateTransaction: Modifies an existing transaction's details
 	// Purpose: Update transaction information
@@ -3251,33 +4886,49 @@ type Querier interface {
 	//   $4: payment_method - Updated payment method
 	//   $5: merchant_id - Updated merchant ID
 	//   $6: transaction_time - Updated transaction timestamp
+	//   $7: fee - Merchant discount rate quoted on the updated amount
 	// Business Logic:
 	//   - Only updates active transactions (non-deleted)
 	//   - Automatically updates the modification timestamp
 	//   - Used for correcting transaction details
 	UpdateTransaction(ctx context.Context, arg UpdateTransactionParams) (*Transaction, error)
-	// UpdateTransactionStatus: Changes a transaction's status
-	// Purpose: Update transaction processing status
+	// UpdateTransactionLimit: Updates an existing transaction limit
+	// Purpose: Change the caps or the scope of a limit
 	// Parameters:
-	//   $1: transaction_id - ID of transaction to update
-	//   $2: status - New status (e.g., 'success', 'failed', 'pending')
+	//   $1: transaction_limit_id - Unique identifier of the limit
+	//   $2 - $11: Same fields as CreateTransactionLimit
+	// Returns:
+	//   The updated transaction limit record
 	// Business Logic:
-	//   - Only updates active transactions
-	//   - Used to reflect transaction processing outcomes
-	//   - Important for reconciliation and reporting
+	//   - Operations already accepted count towards the new caps for the rest of the period
+	UpdateTransactionLimit(ctx context.Context, arg UpdateTransactionLimitParams) (*TransactionLimit, error)
+	// UpdateTransactionStatus: Moves a transaction from one status to another
+	// Purpose: Record the outcome of a transaction together with its status history
+	// Parameters:
+	//   $1: status - New status
+	//   $2: transaction_id - ID of the transaction
+	//   $3: from_status - Status the transaction is expected to be in
+	// Returns:
+	//   The updated transaction, or no row when it is no longer in from_status
+	// Business Logic:
+	//   - Only updates active (non-deleted) records
+	//   - Allowed transitions are checked against pkg/statemachine by the caller
+	//   - The status history row is written by the same statement, and only
+	//     when the row was still in from_status
 	UpdateTransactionStatus(ctx context.Context, arg UpdateTransactionStatusParams) (*Transaction, error)
 	// UpdateTransfer: Modifies transfer details
-	// Purpose: Update all fields of an existing transfer
+	// Purpose: Update the amount and time of an existing transfer
 	// Parameters:
 	//   $1: transfer_id - ID of transfer to update
-	//   $2: transfer_from - Updated source account
-	//   $3: transfer_to - Updated destination account
-	//   $4: transfer_amount - Updated amount
-	//   $5: transfer_time - Updated timestamp
+	//   $2: transfer_amount - Updated amount
+	//   $3: transfer_time - Updated timestamp
+	//   $4: fee - Fee quoted on the updated amount
 	// Business Logic:
 	//   - Only updates active transfers (non-deleted)
 	//   - Updates modification timestamp automatically
 	//   - Used for correcting transfer details
+	//   - The cards are kept, as the ledger postings of the transfer stay on them
+	//   - Transfers that crossed currencies keep their recorded conversion
 	UpdateTransfer(ctx context.Context, arg UpdateTransferParams) (*Transfer, error)
 	// UpdateTransferAmount: Changes only the transfer amount
 	// Purpose: Adjust the amount of a transfer
@@ -3288,16 +4939,21 @@ type Querier interface {
 	//   - Only updates active transfers
 	//   - Updates both amount and transfer timestamp
 	//   - Used for amount corrections
+	//   - Transfers that crossed currencies keep their recorded conversion
 	UpdateTransferAmount(ctx context.Context, arg UpdateTransferAmountParams) (*Transfer, error)
-	// UpdateTransferStatus: Changes transfer status
-	// Purpose: Update processing status of a transfer
+	// UpdateTransferStatus: Moves a transfer from one status to another
+	// Purpose: Record the outcome of a transfer together with its status history
 	// Parameters:
-	//   $1: transfer_id - ID of transfer to update
-	//   $2: status - New status (e.g., 'completed', 'failed')
+	//   $1: status - New status
+	//   $2: transfer_id - ID of the transfer
+	//   $3: from_status - Status the transfer is expected to be in
+	// Returns:
+	//   The updated transfer, or no row when it is no longer in from_status
 	// Business Logic:
-	//   - Only updates active transfers
-	//   - Updates modification timestamp
-	//   - Used to reflect transfer processing outcomes
+	//   - Only updates active (non-deleted) records
+	//   - Allowed transitions are checked against pkg/statemachine by the caller
+	//   - The status history row is written by the same statement, and only
+	//     when the row was still in from_status
 	UpdateTransferStatus(ctx context.Context, arg UpdateTransferStatusParams) (*Transfer, error)
 	// UpdateUser: Modifies user account information
 	// Purpose: Update user profile details
@@ -3314,30 +4970,97 @@ type Querier interface {
 	//   - Validates email uniqueness
 	//   - Password field optional (can maintain existing)
 	UpdateUser(ctx context.Context, arg UpdateUserParams) (*User, error)
+	// UpdateUserTier: Moves a user to another tier
+	// Purpose: Change which transaction limits apply to the user's cards
+	// Parameters:
+	//   $1: user_id - ID of user to update
+	//   $2: tier - standard, premium or business
+	// Returns: Updated user record
+	// Business Logic:
+	//   - Only modifies active (non-deleted) users
+	//   - New limits apply from the next operation on
+	UpdateUserTier(ctx context.Context, arg UpdateUserTierParams) (*User, error)
 	// UpdateWithdraw: Modifies withdrawal details
 	// Purpose: Update withdrawal information
 	// Parameters:
 	//   $1: withdraw_id - ID of withdrawal to update
-	//   $2: card_number - Updated card number
-	//   $3: withdraw_amount - Updated withdrawal amount
-	//   $4: withdraw_time - Updated withdrawal timestamp
+	//   $2: withdraw_amount - Updated withdrawal amount
+	//   $3: withdraw_time - Updated withdrawal timestamp
+	//   $4: fee - Fee quoted on the updated amount
 	// Business Logic:
 	//   - Only updates active withdrawals (non-deleted)
 	//   - Updates modification timestamp automatically
 	//   - Used for correcting withdrawal records
 	//   - Requires original withdrawal record exists
+	//   - The card is kept, as the ledger postings of the withdrawal stay on it
 	UpdateWithdraw(ctx context.Context, arg UpdateWithdrawParams) (*Withdraw, error)
-	// UpdateWithdrawStatus: Changes withdrawal status
-	// Purpose: Update processing status of a withdrawal
+	// UpdateWithdrawStatus: Moves a withdrawal from one status to another
+	// Purpose: Record the outcome of a withdrawal together with its status history
 	// Parameters:
-	//   $1: withdraw_id - ID of withdrawal to update
-	//   $2: status - New status (e.g., 'completed', 'failed', 'pending')
+	//   $1: status - New status
+	//   $2: withdraw_id - ID of the withdrawal
+	//   $3: from_status - Status the withdrawal is expected to be in
+	// Returns:
+	//   The updated withdrawal, or no row when it is no longer in from_status
 	// Business Logic:
-	//   - Only updates active withdrawals
-	//   - Updates modification timestamp
-	//   - Used to reflect withdrawal processing outcomes
-	//   - Important for reconciliation purposes
+	//   - Only updates active (non-deleted) records
+	//   - Allowed transitions are checked against pkg/statemachine by the caller
+	//   - The status history row is written by the same statement, and only
+	//     when the row was still in from_status
 	UpdateWithdrawStatus(ctx context.Context, arg UpdateWithdrawStatusParams) (*Withdraw, error)
+	// UpsertBalanceDiscrepancy: Records that a card did not match
+	// Purpose: Keep one unresolved discrepancy per card that later runs refresh
+	// Parameters:
+	//   $1: card_number - Card that did not match
+	//   $2: reconciliation_run_id - Run that found the mismatch
+	//   $3: saldo_balance - Balance of the saldo
+	//   $4: expected_balance - Balance recomputed from the history
+	//   $5: ledger_balance - Balance of the ledger postings of the card
+	//   $6: difference - saldo_balance minus expected_balance
+	//   $7..$13: the movements the expected balance was computed from
+	// Returns:
+	//   The created or refreshed discrepancy
+	// Business Logic:
+	//   - An acknowledged discrepancy stays acknowledged while it is refreshed
+	UpsertBalanceDiscrepancy(ctx context.Context, arg UpsertBalanceDiscrepancyParams) (*BalanceDiscrepancy, error)
+	// UpsertExchangeRate: Creates or replaces the rate of a currency pair
+	// Purpose: Maintain the rates used to convert transfers between currencies
+	// Parameters:
+	//   $1: base_currency - Currency being converted from
+	//   $2: quote_currency - Currency being converted to
+	//   $3: rate - Units of quote_currency per unit of base_currency
+	//   $4: spread_bps - Spread kept by the platform in basis points
+	// Returns:
+	//   The stored exchange rate record
+	// Business Logic:
+	//   - A pair has at most one rate; setting it again overwrites the previous one
+	//   - Rates are directional, the reverse pair is maintained separately
+	UpsertExchangeRate(ctx context.Context, arg UpsertExchangeRateParams) (*ExchangeRate, error)
+	// UpsertMerchantBusinessProfile: Stores the business details a merchant submits for review
+	// Purpose: Keep what an admin reviews before activating a merchant
+	// Parameters:
+	//   $1: merchant_id - Merchant the details belong to
+	//   $2: legal_name - Registered name of the business
+	//   $3: business_type - Kind of business, such as individual or company
+	//   $4: tax_id - Tax registration number of the business
+	//   $5: address - Registered address of the business
+	//   $6: website - Website of the business, if any
+	//   $7: contact_email - Email address the business is reached at
+	//   $8: contact_phone - Phone number the business is reached at
+	// Returns:
+	//   The stored business profile
+	// Business Logic:
+	//   - Resubmitting replaces the earlier details and the submission time
+	UpsertMerchantBusinessProfile(ctx context.Context, arg UpsertMerchantBusinessProfileParams) (*MerchantBusinessProfile, error)
+	// VoidAuthorization: Cancels a pending authorization
+	// Purpose: Release reserved funds without charging the card
+	// Parameters:
+	//   $1: authorization_id - ID of the authorization
+	// Returns:
+	//   The updated authorization record, or no row if it is no longer pending
+	// Business Logic:
+	//   - Only 'authorized' authorizations can be voided
+	VoidAuthorization(ctx context.Context, authorizationID int32) (*Authorization, error)
 }
 
 var _ Querier = (*Queries)(nil)
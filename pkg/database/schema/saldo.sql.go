@@ -9,6 +9,8 @@ import (
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 const createSaldo = `-- name: CreateSaldo :one
@@ -16,15 +18,17 @@ INSERT INTO
     saldos (
         card_number,
         total_balance,
+        currency,
         created_at,
         updated_at
     )
 VALUES (
         $1,
         $2,
+        (SELECT c.currency FROM cards c WHERE c.card_number = $1),
         current_timestamp,
         current_timestamp
-    ) RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at
+    ) RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency
 `
 
 type CreateSaldoParams struct {
@@ -46,6 +50,7 @@ type CreateSaldoParams struct {
 // Business Logic:
 //   - Sets creation and update timestamps automatically
 //   - Used when issuing new cards
+//   - The saldo is kept in the currency of the card
 func (q *Queries) CreateSaldo(ctx context.Context, arg CreateSaldoParams) (*Saldo, error) {
 	row := q.db.QueryRowContext(ctx, createSaldo, arg.CardNumber, arg.TotalBalance)
 	var i Saldo
@@ -58,6 +63,8 @@ func (q *Queries) CreateSaldo(ctx context.Context, arg CreateSaldoParams) (*Sald
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ReservedBalance,
+		&i.Currency,
 	)
 	return &i, err
 }
@@ -100,7 +107,7 @@ func (q *Queries) DeleteSaldoPermanently(ctx context.Context, saldoID int32) err
 
 const getActiveSaldos = `-- name: GetActiveSaldos :many
 SELECT
-    saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at,
+    saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency,
     COUNT(*) OVER() AS total_count
 FROM saldos
 WHERE deleted_at IS NULL
@@ -116,15 +123,17 @@ type GetActiveSaldosParams struct {
 }
 
 type GetActiveSaldosRow struct {
-	SaldoID        int32         `json:"saldo_id"`
-	CardNumber     string        `json:"card_number"`
-	TotalBalance   int32         `json:"total_balance"`
-	WithdrawAmount sql.NullInt32 `json:"withdraw_amount"`
-	WithdrawTime   sql.NullTime  `json:"withdraw_time"`
-	CreatedAt      sql.NullTime  `json:"created_at"`
-	UpdatedAt      sql.NullTime  `json:"updated_at"`
-	DeletedAt      sql.NullTime  `json:"deleted_at"`
-	TotalCount     int64         `json:"total_count"`
+	SaldoID         int32         `json:"saldo_id"`
+	CardNumber      string        `json:"card_number"`
+	TotalBalance    int32         `json:"total_balance"`
+	WithdrawAmount  sql.NullInt32 `json:"withdraw_amount"`
+	WithdrawTime    sql.NullTime  `json:"withdraw_time"`
+	CreatedAt       sql.NullTime  `json:"created_at"`
+	UpdatedAt       sql.NullTime  `json:"updated_at"`
+	DeletedAt       sql.NullTime  `json:"deleted_at"`
+	ReservedBalance int32         `json:"reserved_balance"`
+	Currency        string        `json:"currency"`
+	TotalCount      int64         `json:"total_count"`
 }
 
 // GetActiveSaldos: Retrieves active saldos with pagination and optional search
@@ -161,6 +170,8 @@ func (q *Queries) GetActiveSaldos(ctx context.Context, arg GetActiveSaldosParams
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.ReservedBalance,
+			&i.Currency,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -183,24 +194,34 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(s.total_balance), 0)::int AS total_balance
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     saldos s ON EXTRACT(MONTH FROM s.created_at) = EXTRACT(MONTH FROM m.month)
+    AND s.currency = cur.currency
     AND EXTRACT(YEAR FROM s.created_at) = EXTRACT(YEAR FROM m.month)
     AND s.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlySaldoBalancesRow struct {
 	Month        string `json:"month"`
+	Currency     string `json:"currency"`
 	TotalBalance int32  `json:"total_balance"`
 }
 
@@ -214,6 +235,7 @@ type GetMonthlySaldoBalancesRow struct {
 //
 //	month: 3-letter month abbreviation (e.g., 'Jan', 'Feb')
 //	total_balance: Sum of balances for that month (0 if no data exists)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Generates a complete 12-month series for the specified year
@@ -231,7 +253,7 @@ func (q *Queries) GetMonthlySaldoBalances(ctx context.Context, dollar_1 time.Tim
 	var items []*GetMonthlySaldoBalancesRow
 	for rows.Next() {
 		var i GetMonthlySaldoBalancesRow
-		if err := rows.Scan(&i.Month, &i.TotalBalance); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalBalance); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -246,10 +268,13 @@ func (q *Queries) GetMonthlySaldoBalances(ctx context.Context, dollar_1 time.Tim
 }
 
 const getMonthlyTotalSaldoBalance = `-- name: GetMonthlyTotalSaldoBalance :many
-WITH monthly_data AS (
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+), monthly_data AS (
     SELECT
         EXTRACT(YEAR FROM s.created_at)::integer AS year,
         EXTRACT(MONTH FROM s.created_at)::integer AS month,
+        s.currency,
         COALESCE(SUM(s.total_balance), 0) AS total_balance
     FROM
         saldos s
@@ -261,11 +286,13 @@ WITH monthly_data AS (
         )
     GROUP BY
         EXTRACT(YEAR FROM s.created_at),
-        EXTRACT(MONTH FROM s.created_at)
+        EXTRACT(MONTH FROM s.created_at),
+        s.currency
 ), formatted_data AS (
     SELECT
         year::text,
         TO_CHAR(TO_DATE(month::text, 'MM'), 'Mon') AS month,
+        currency,
         total_balance::integer
     FROM
         monthly_data
@@ -275,12 +302,15 @@ WITH monthly_data AS (
     SELECT
         EXTRACT(YEAR FROM $1::timestamp)::text AS year,
         TO_CHAR($1::timestamp, 'Mon') AS month,
+        cur.currency,
         0::integer AS total_balance
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM monthly_data
         WHERE year = EXTRACT(YEAR FROM $1::timestamp)::integer
         AND month = EXTRACT(MONTH FROM $1::timestamp)::integer
+        AND currency = cur.currency
     )
 
     UNION ALL
@@ -288,18 +318,22 @@ WITH monthly_data AS (
     SELECT
         EXTRACT(YEAR FROM $3::timestamp)::text AS year,
         TO_CHAR($3::timestamp, 'Mon') AS month,
+        cur.currency,
         0::integer AS total_balance
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM monthly_data
         WHERE year = EXTRACT(YEAR FROM $3::timestamp)::integer
         AND month = EXTRACT(MONTH FROM $3::timestamp)::integer
+        AND currency = cur.currency
     )
 )
-SELECT year, month, total_balance FROM formatted_data
+SELECT year, month, currency, total_balance FROM formatted_data
 ORDER BY
     year DESC,
-    TO_DATE(month, 'Mon') DESC
+    TO_DATE(month, 'Mon') DESC,
+    currency
 `
 
 type GetMonthlyTotalSaldoBalanceParams struct {
@@ -312,6 +346,7 @@ type GetMonthlyTotalSaldoBalanceParams struct {
 type GetMonthlyTotalSaldoBalanceRow struct {
 	Year         string `json:"year"`
 	Month        string `json:"month"`
+	Currency     string `json:"currency"`
 	TotalBalance int32  `json:"total_balance"`
 }
 
@@ -329,6 +364,7 @@ type GetMonthlyTotalSaldoBalanceRow struct {
 //	year: The year as text
 //	month: 3-letter month abbreviation
 //	total_balance: Monthly balance total (0 if no data)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Aggregates balances for two customizable time periods
@@ -350,7 +386,12 @@ func (q *Queries) GetMonthlyTotalSaldoBalance(ctx context.Context, arg GetMonthl
 	var items []*GetMonthlyTotalSaldoBalanceRow
 	for rows.Next() {
 		var i GetMonthlyTotalSaldoBalanceRow
-		if err := rows.Scan(&i.Year, &i.Month, &i.TotalBalance); err != nil {
+		if err := rows.Scan(
+			&i.Year,
+			&i.Month,
+			&i.Currency,
+			&i.TotalBalance,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -365,7 +406,7 @@ func (q *Queries) GetMonthlyTotalSaldoBalance(ctx context.Context, arg GetMonthl
 }
 
 const getSaldoByCardNumber = `-- name: GetSaldoByCardNumber :one
-SELECT saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at FROM saldos WHERE card_number = $1 AND deleted_at IS NULL
+SELECT saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency FROM saldos WHERE card_number = $1 AND deleted_at IS NULL
 `
 
 // GetSaldoByCardNumber: Retrieves saldo information for a specific card
@@ -393,12 +434,14 @@ func (q *Queries) GetSaldoByCardNumber(ctx context.Context, cardNumber string) (
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ReservedBalance,
+		&i.Currency,
 	)
 	return &i, err
 }
 
 const getSaldoByID = `-- name: GetSaldoByID :one
-SELECT saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at FROM saldos WHERE saldo_id = $1 AND deleted_at IS NULL
+SELECT saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency FROM saldos WHERE saldo_id = $1 AND deleted_at IS NULL
 `
 
 // GetSaldoByID: Retrieves single active saldo by ID
@@ -426,13 +469,15 @@ func (q *Queries) GetSaldoByID(ctx context.Context, saldoID int32) (*Saldo, erro
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ReservedBalance,
+		&i.Currency,
 	)
 	return &i, err
 }
 
 const getSaldos = `-- name: GetSaldos :many
 SELECT
-    saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at,
+    saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency,
     COUNT(*) OVER() AS total_count
 FROM saldos
 WHERE deleted_at IS NULL
@@ -448,15 +493,17 @@ type GetSaldosParams struct {
 }
 
 type GetSaldosRow struct {
-	SaldoID        int32         `json:"saldo_id"`
-	CardNumber     string        `json:"card_number"`
-	TotalBalance   int32         `json:"total_balance"`
-	WithdrawAmount sql.NullInt32 `json:"withdraw_amount"`
-	WithdrawTime   sql.NullTime  `json:"withdraw_time"`
-	CreatedAt      sql.NullTime  `json:"created_at"`
-	UpdatedAt      sql.NullTime  `json:"updated_at"`
-	DeletedAt      sql.NullTime  `json:"deleted_at"`
-	TotalCount     int64         `json:"total_count"`
+	SaldoID         int32         `json:"saldo_id"`
+	CardNumber      string        `json:"card_number"`
+	TotalBalance    int32         `json:"total_balance"`
+	WithdrawAmount  sql.NullInt32 `json:"withdraw_amount"`
+	WithdrawTime    sql.NullTime  `json:"withdraw_time"`
+	CreatedAt       sql.NullTime  `json:"created_at"`
+	UpdatedAt       sql.NullTime  `json:"updated_at"`
+	DeletedAt       sql.NullTime  `json:"deleted_at"`
+	ReservedBalance int32         `json:"reserved_balance"`
+	Currency        string        `json:"currency"`
+	TotalCount      int64         `json:"total_count"`
 }
 
 // GetSaldos: Retrieves paginated list of active saldos with search capability
@@ -494,6 +541,8 @@ func (q *Queries) GetSaldos(ctx context.Context, arg GetSaldosParams) ([]*GetSal
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.ReservedBalance,
+			&i.Currency,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -510,7 +559,7 @@ func (q *Queries) GetSaldos(ctx context.Context, arg GetSaldosParams) ([]*GetSal
 }
 
 const getTrashedSaldoByID = `-- name: GetTrashedSaldoByID :one
-SELECT saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at FROM saldos WHERE saldo_id = $1 AND deleted_at IS NOT NULL
+SELECT saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency FROM saldos WHERE saldo_id = $1 AND deleted_at IS NOT NULL
 `
 
 // GetTrashedSaldoByID: Retrieves a single soft-deleted saldo record by ID
@@ -539,13 +588,15 @@ func (q *Queries) GetTrashedSaldoByID(ctx context.Context, saldoID int32) (*Sald
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ReservedBalance,
+		&i.Currency,
 	)
 	return &i, err
 }
 
 const getTrashedSaldos = `-- name: GetTrashedSaldos :many
 SELECT
-    saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at,
+    saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency,
     COUNT(*) OVER() AS total_count
 FROM saldos
 WHERE deleted_at IS NOT NULL
@@ -561,15 +612,17 @@ type GetTrashedSaldosParams struct {
 }
 
 type GetTrashedSaldosRow struct {
-	SaldoID        int32         `json:"saldo_id"`
-	CardNumber     string        `json:"card_number"`
-	TotalBalance   int32         `json:"total_balance"`
-	WithdrawAmount sql.NullInt32 `json:"withdraw_amount"`
-	WithdrawTime   sql.NullTime  `json:"withdraw_time"`
-	CreatedAt      sql.NullTime  `json:"created_at"`
-	UpdatedAt      sql.NullTime  `json:"updated_at"`
-	DeletedAt      sql.NullTime  `json:"deleted_at"`
-	TotalCount     int64         `json:"total_count"`
+	SaldoID         int32         `json:"saldo_id"`
+	CardNumber      string        `json:"card_number"`
+	TotalBalance    int32         `json:"total_balance"`
+	WithdrawAmount  sql.NullInt32 `json:"withdraw_amount"`
+	WithdrawTime    sql.NullTime  `json:"withdraw_time"`
+	CreatedAt       sql.NullTime  `json:"created_at"`
+	UpdatedAt       sql.NullTime  `json:"updated_at"`
+	DeletedAt       sql.NullTime  `json:"deleted_at"`
+	ReservedBalance int32         `json:"reserved_balance"`
+	Currency        string        `json:"currency"`
+	TotalCount      int64         `json:"total_count"`
 }
 
 // GetTrashedSaldos: Retrieves soft-deleted saldos with search and pagination
@@ -606,6 +659,8 @@ func (q *Queries) GetTrashedSaldos(ctx context.Context, arg GetTrashedSaldosPara
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.ReservedBalance,
+			&i.Currency,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -625,6 +680,7 @@ const getYearlySaldoBalances = `-- name: GetYearlySaldoBalances :many
 WITH last_five_years AS (
     SELECT
         EXTRACT(YEAR FROM s.created_at) AS year,
+        s.currency,
         SUM(s.total_balance) AS total_balance
     FROM
         saldos s
@@ -633,19 +689,23 @@ WITH last_five_years AS (
         AND EXTRACT(YEAR FROM s.created_at) >= $1 - 4
         AND EXTRACT(YEAR FROM s.created_at) <= $1
     GROUP BY
-        EXTRACT(YEAR FROM s.created_at)
+        EXTRACT(YEAR FROM s.created_at),
+        s.currency
 )
 SELECT
     year,
+    currency,
     total_balance
 FROM
     last_five_years
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlySaldoBalancesRow struct {
 	Year         string `json:"year"`
+	Currency     string `json:"currency"`
 	TotalBalance int64  `json:"total_balance"`
 }
 
@@ -659,6 +719,7 @@ type GetYearlySaldoBalancesRow struct {
 //
 //	year: The 4-digit year
 //	total_balance: Sum of balances for that year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Covers a 5-year rolling window (reference_year-4 to reference_year)
@@ -675,7 +736,7 @@ func (q *Queries) GetYearlySaldoBalances(ctx context.Context, dollar_1 interface
 	var items []*GetYearlySaldoBalancesRow
 	for rows.Next() {
 		var i GetYearlySaldoBalancesRow
-		if err := rows.Scan(&i.Year, &i.TotalBalance); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalBalance); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -690,9 +751,12 @@ func (q *Queries) GetYearlySaldoBalances(ctx context.Context, dollar_1 interface
 }
 
 const getYearlyTotalSaldoBalances = `-- name: GetYearlyTotalSaldoBalances :many
-WITH yearly_data AS (
+WITH currencies AS (
+    SELECT DISTINCT currency FROM cards
+), yearly_data AS (
     SELECT
         EXTRACT(YEAR FROM s.created_at)::integer AS year,
+        s.currency,
         COALESCE(SUM(s.total_balance), 0)::integer AS total_balance
     FROM
         saldos s
@@ -703,10 +767,12 @@ WITH yearly_data AS (
             OR EXTRACT(YEAR FROM s.created_at) = $1::integer - 1
         )
     GROUP BY
-        EXTRACT(YEAR FROM s.created_at)
+        EXTRACT(YEAR FROM s.created_at),
+        s.currency
 ), formatted_data AS (
     SELECT
         year::text,
+        currency,
         total_balance::integer
     FROM
         yearly_data
@@ -715,31 +781,39 @@ WITH yearly_data AS (
 
     SELECT
         $1::text AS year,
+        cur.currency,
         0::integer AS total_balance
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM yearly_data
         WHERE year = $1::integer
+        AND currency = cur.currency
     )
 
     UNION ALL
 
     SELECT
         ($1::integer - 1)::text AS year,
+        cur.currency,
         0::integer AS total_balance
+    FROM currencies cur
     WHERE NOT EXISTS (
         SELECT 1
         FROM yearly_data
         WHERE year = $1::integer - 1
+        AND currency = cur.currency
     )
 )
-SELECT year, total_balance FROM formatted_data
+SELECT year, currency, total_balance FROM formatted_data
 ORDER BY
-    year DESC
+    year DESC,
+    currency
 `
 
 type GetYearlyTotalSaldoBalancesRow struct {
 	Year         string `json:"year"`
+	Currency     string `json:"currency"`
 	TotalBalance int32  `json:"total_balance"`
 }
 
@@ -753,6 +827,7 @@ type GetYearlyTotalSaldoBalancesRow struct {
 //
 //	year: The year as text
 //	total_balance: Annual balance total (0 if no data)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Shows comparison between specified year and previous year
@@ -769,7 +844,136 @@ func (q *Queries) GetYearlyTotalSaldoBalances(ctx context.Context, dollar_1 int3
 	var items []*GetYearlyTotalSaldoBalancesRow
 	for rows.Next() {
 		var i GetYearlyTotalSaldoBalancesRow
-		if err := rows.Scan(&i.Year, &i.TotalBalance); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalBalance); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const lockSaldosByCardNumbers = `-- name: LockSaldosByCardNumbers :many
+SELECT saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency
+FROM saldos
+WHERE
+    card_number = ANY($1::VARCHAR[])
+    AND deleted_at IS NULL
+ORDER BY card_number, saldo_id
+FOR UPDATE
+`
+
+// LockSaldosByCardNumbers: Locks the saldo rows of the given cards
+// Purpose: Serialize concurrent balance movements on the same cards
+// Parameters:
+//
+//	$1: card_numbers - Card numbers whose saldos are locked
+//
+// Returns:
+//
+//	All saldo fields for the active records matching the card numbers
+//
+// Business Logic:
+//   - Must run inside a database transaction; locks are held until commit
+//   - Rows are locked in card number order so that movements touching the
+//     same cards in opposite directions cannot deadlock
+//   - Balance checks made on the returned rows stay valid until commit
+func (q *Queries) LockSaldosByCardNumbers(ctx context.Context, cardNumbers []string) ([]*Saldo, error) {
+	rows, err := q.db.QueryContext(ctx, lockSaldosByCardNumbers, pq.Array(cardNumbers))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Saldo
+	for rows.Next() {
+		var i Saldo
+		if err := rows.Scan(
+			&i.SaldoID,
+			&i.CardNumber,
+			&i.TotalBalance,
+			&i.WithdrawAmount,
+			&i.WithdrawTime,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ReservedBalance,
+			&i.Currency,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const rebuildSaldoBalancesFromLedger = `-- name: RebuildSaldoBalancesFromLedger :many
+WITH
+    ledger AS (
+        SELECT card_number, SUM(
+                CASE
+                    WHEN direction = 'credit' THEN amount
+                    ELSE - amount
+                END
+            )::INT AS balance
+        FROM ledger_postings
+        WHERE
+            card_number IS NOT NULL
+        GROUP BY
+            card_number
+    )
+UPDATE saldos
+SET
+    total_balance = COALESCE(ledger.balance, 0),
+    updated_at = current_timestamp
+FROM saldos current_saldo
+    LEFT JOIN ledger ON ledger.card_number = current_saldo.card_number
+WHERE
+    saldos.saldo_id = current_saldo.saldo_id
+    AND saldos.total_balance <> COALESCE(ledger.balance, 0)
+RETURNING saldos.saldo_id, saldos.card_number, saldos.total_balance, saldos.withdraw_amount, saldos.withdraw_time, saldos.created_at, saldos.updated_at, saldos.deleted_at, saldos.reserved_balance, saldos.currency
+`
+
+// RebuildSaldoBalancesFromLedger: Recomputes saldo balances from the ledger
+// Purpose: Rebuild the saldos projection when it drifts from the ledger
+// Returns:
+//
+//	The saldo records whose balance was corrected
+//
+// Business Logic:
+//   - Saldos without any posting are reset to zero
+//   - Only rows that differ from the ledger are touched
+func (q *Queries) RebuildSaldoBalancesFromLedger(ctx context.Context) ([]*Saldo, error) {
+	rows, err := q.db.QueryContext(ctx, rebuildSaldoBalancesFromLedger)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Saldo
+	for rows.Next() {
+		var i Saldo
+		if err := rows.Scan(
+			&i.SaldoID,
+			&i.CardNumber,
+			&i.TotalBalance,
+			&i.WithdrawAmount,
+			&i.WithdrawTime,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.ReservedBalance,
+			&i.Currency,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -783,6 +987,100 @@ func (q *Queries) GetYearlyTotalSaldoBalances(ctx context.Context, dollar_1 int3
 	return items, nil
 }
 
+const releaseSaldoReservation = `-- name: ReleaseSaldoReservation :one
+UPDATE saldos
+SET
+    reserved_balance = reserved_balance - $1::INT,
+    updated_at = current_timestamp
+WHERE
+    card_number = $2
+    AND deleted_at IS NULL
+    AND reserved_balance >= $1::INT
+RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency
+`
+
+type ReleaseSaldoReservationParams struct {
+	Amount     int32  `json:"amount"`
+	CardNumber string `json:"card_number"`
+}
+
+// ReleaseSaldoReservation: Releases part of a card's reserved balance
+// Purpose: Free funds once a saldo hold is released or consumed
+// Parameters:
+//
+//	$1: card_number - Card whose reservation is released
+//	$2: amount - Amount to release
+//
+// Returns:
+//
+//	The updated saldo record, or no row if less than amount is reserved
+func (q *Queries) ReleaseSaldoReservation(ctx context.Context, arg ReleaseSaldoReservationParams) (*Saldo, error) {
+	row := q.db.QueryRowContext(ctx, releaseSaldoReservation, arg.Amount, arg.CardNumber)
+	var i Saldo
+	err := row.Scan(
+		&i.SaldoID,
+		&i.CardNumber,
+		&i.TotalBalance,
+		&i.WithdrawAmount,
+		&i.WithdrawTime,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ReservedBalance,
+		&i.Currency,
+	)
+	return &i, err
+}
+
+const reserveSaldoBalance = `-- name: ReserveSaldoBalance :one
+UPDATE saldos
+SET
+    reserved_balance = reserved_balance + $1::INT,
+    updated_at = current_timestamp
+WHERE
+    card_number = $2
+    AND deleted_at IS NULL
+    AND total_balance - reserved_balance >= $1::INT
+RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency
+`
+
+type ReserveSaldoBalanceParams struct {
+	Amount     int32  `json:"amount"`
+	CardNumber string `json:"card_number"`
+}
+
+// ReserveSaldoBalance: Reserves part of a card's balance
+// Purpose: Set aside funds for an active saldo hold without moving them
+// Parameters:
+//
+//	$1: card_number - Card whose funds are reserved
+//	$2: amount - Amount to reserve
+//
+// Returns:
+//
+//	The updated saldo record, or no row if the unreserved balance is too low
+//
+// Business Logic:
+//   - Only active saldos can be reserved
+//   - The check and the increment happen in one statement
+func (q *Queries) ReserveSaldoBalance(ctx context.Context, arg ReserveSaldoBalanceParams) (*Saldo, error) {
+	row := q.db.QueryRowContext(ctx, reserveSaldoBalance, arg.Amount, arg.CardNumber)
+	var i Saldo
+	err := row.Scan(
+		&i.SaldoID,
+		&i.CardNumber,
+		&i.TotalBalance,
+		&i.WithdrawAmount,
+		&i.WithdrawTime,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.ReservedBalance,
+		&i.Currency,
+	)
+	return &i, err
+}
+
 const restoreAllSaldos = `-- name: RestoreAllSaldos :exec
 UPDATE saldos
 SET
@@ -808,7 +1106,7 @@ SET
 WHERE
     saldo_id = $1
     AND deleted_at IS NOT NULL
-RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at
+RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency
 `
 
 // RestoreSaldo: Recovers a soft-deleted saldo
@@ -832,6 +1130,8 @@ func (q *Queries) RestoreSaldo(ctx context.Context, saldoID int32) (*Saldo, erro
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ReservedBalance,
+		&i.Currency,
 	)
 	return &i, err
 }
@@ -843,7 +1143,7 @@ SET
 WHERE
     saldo_id = $1
     AND deleted_at IS NULL
-RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at
+RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency
 `
 
 // TrashSaldo: Soft-deletes a saldo record
@@ -868,6 +1168,8 @@ func (q *Queries) TrashSaldo(ctx context.Context, saldoID int32) (*Saldo, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ReservedBalance,
+		&i.Currency,
 	)
 	return &i, err
 }
@@ -875,35 +1177,33 @@ func (q *Queries) TrashSaldo(ctx context.Context, saldoID int32) (*Saldo, error)
 const updateSaldo = `-- name: UpdateSaldo :one
 UPDATE saldos
 SET
-    card_number = $2,
-    total_balance = $3,
+    total_balance = $2,
     updated_at = current_timestamp
 WHERE
     saldo_id = $1
     AND deleted_at IS NULL
-RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at
+RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency
 `
 
 type UpdateSaldoParams struct {
-	SaldoID      int32  `json:"saldo_id"`
-	CardNumber   string `json:"card_number"`
-	TotalBalance int32  `json:"total_balance"`
+	SaldoID      int32 `json:"saldo_id"`
+	TotalBalance int32 `json:"total_balance"`
 }
 
 // UpdateSaldo: Modifies saldo record details
-// Purpose: Update card number and balance for an existing saldo
+// Purpose: Update the balance of an existing saldo
 // Parameters:
 //
 //	$1: saldo_id - The ID of the saldo to update
-//	$2: card_number - New card number to associate
-//	$3: total_balance - New balance amount
+//	$2: total_balance - New balance amount
 //
 // Business Logic:
 //   - Only updates active records (deleted_at IS NULL)
 //   - Automatically updates the modification timestamp
+//   - The card is kept, as the ledger postings of the saldo stay on it
 //   - Useful for administrative corrections
 func (q *Queries) UpdateSaldo(ctx context.Context, arg UpdateSaldoParams) (*Saldo, error) {
-	row := q.db.QueryRowContext(ctx, updateSaldo, arg.SaldoID, arg.CardNumber, arg.TotalBalance)
+	row := q.db.QueryRowContext(ctx, updateSaldo, arg.SaldoID, arg.TotalBalance)
 	var i Saldo
 	err := row.Scan(
 		&i.SaldoID,
@@ -914,6 +1214,8 @@ func (q *Queries) UpdateSaldo(ctx context.Context, arg UpdateSaldoParams) (*Sald
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ReservedBalance,
+		&i.Currency,
 	)
 	return &i, err
 }
@@ -926,7 +1228,7 @@ SET
 WHERE
     card_number = $1
     AND deleted_at IS NULL
-RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at
+RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency
 `
 
 type UpdateSaldoBalanceParams struct {
@@ -957,6 +1259,8 @@ func (q *Queries) UpdateSaldoBalance(ctx context.Context, arg UpdateSaldoBalance
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ReservedBalance,
+		&i.Currency,
 	)
 	return &i, err
 }
@@ -965,14 +1269,13 @@ const updateSaldoWithdraw = `-- name: UpdateSaldoWithdraw :one
 UPDATE saldos
 SET
     withdraw_amount = $2,
-    total_balance = total_balance - $2,
     withdraw_time = $3,
     updated_at = current_timestamp
 WHERE
     card_number = $1
     AND deleted_at IS NULL
     AND total_balance >= $2
-RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at
+RETURNING saldo_id, card_number, total_balance, withdraw_amount, withdraw_time, created_at, updated_at, deleted_at, reserved_balance, currency
 `
 
 type UpdateSaldoWithdrawParams struct {
@@ -982,7 +1285,7 @@ type UpdateSaldoWithdrawParams struct {
 }
 
 // UpdateSaldoWithdraw: Processes a withdrawal transaction
-// Purpose: Record a withdrawal and update the remaining balance
+// Purpose: Record the latest withdrawal on the saldo
 // Parameters:
 //
 //	$1: card_number - The card used for withdrawal
@@ -991,8 +1294,8 @@ type UpdateSaldoWithdrawParams struct {
 //
 // Business Logic:
 //   - Only processes if sufficient balance exists (total_balance >= $2)
-//   - Updates both withdrawal amount and remaining balance
-//   - Records withdrawal timestamp
+//   - Records withdrawal amount and timestamp
+//   - The balance itself is moved by the withdraw ledger journal
 //   - Only affects active records
 func (q *Queries) UpdateSaldoWithdraw(ctx context.Context, arg UpdateSaldoWithdrawParams) (*Saldo, error) {
 	row := q.db.QueryRowContext(ctx, updateSaldoWithdraw, arg.CardNumber, arg.WithdrawAmount, arg.WithdrawTime)
@@ -1006,6 +1309,8 @@ func (q *Queries) UpdateSaldoWithdraw(ctx context.Context, arg UpdateSaldoWithdr
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.ReservedBalance,
+		&i.Currency,
 	)
 	return &i, err
 }
@@ -20,6 +20,8 @@ INSERT INTO
         topup_amount,
         topup_method,
         topup_time,
+        currency,
+        fee,
         created_at,
         updated_at
     )
@@ -28,9 +30,11 @@ VALUES (
         $2,
         $3,
         $4,
+        (SELECT c.currency FROM cards c WHERE c.card_number = $1),
+        $5,
         current_timestamp,
         current_timestamp
-    ) RETURNING topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at
+    ) RETURNING topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at, currency, fee
 `
 
 type CreateTopupParams struct {
@@ -38,6 +42,7 @@ type CreateTopupParams struct {
 	TopupAmount int32     `json:"topup_amount"`
 	TopupMethod string    `json:"topup_method"`
 	TopupTime   time.Time `json:"topup_time"`
+	Fee         int32     `json:"fee"`
 }
 
 // CreateTopup: Inserts a new topup transaction into the topups table
@@ -48,6 +53,7 @@ type CreateTopupParams struct {
 //	$2: topup_amount - Amount of the topup
 //	$3: topup_method - Payment method used (e.g., 'e-wallet', 'bank_transfer')
 //	$4: topup_time - Timestamp of the actual topup transaction
+//	$5: fee - Fee kept out of the amount credited to the card
 //
 // Returns:
 //
@@ -55,12 +61,14 @@ type CreateTopupParams struct {
 //
 // Business Logic:
 //   - Automatically sets created_at and updated_at to current timestamp
+//   - The amount is recorded in the currency of the card
 func (q *Queries) CreateTopup(ctx context.Context, arg CreateTopupParams) (*Topup, error) {
 	row := q.db.QueryRowContext(ctx, createTopup,
 		arg.CardNumber,
 		arg.TopupAmount,
 		arg.TopupMethod,
 		arg.TopupTime,
+		arg.Fee,
 	)
 	var i Topup
 	err := row.Scan(
@@ -74,6 +82,8 @@ func (q *Queries) CreateTopup(ctx context.Context, arg CreateTopupParams) (*Topu
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
@@ -114,7 +124,7 @@ func (q *Queries) DeleteTopupPermanently(ctx context.Context, topupID int32) err
 
 const getActiveTopups = `-- name: GetActiveTopups :many
 SELECT
-    topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at,
+    topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at, currency, fee,
     COUNT(*) OVER() AS total_count
 FROM
     topups
@@ -148,6 +158,8 @@ type GetActiveTopupsRow struct {
 	CreatedAt   sql.NullTime `json:"created_at"`
 	UpdatedAt   sql.NullTime `json:"updated_at"`
 	DeletedAt   sql.NullTime `json:"deleted_at"`
+	Currency    string       `json:"currency"`
+	Fee         int32        `json:"fee"`
 	TotalCount  int64        `json:"total_count"`
 }
 
@@ -188,6 +200,8 @@ func (q *Queries) GetActiveTopups(ctx context.Context, arg GetActiveTopupsParams
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.Fee,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -746,24 +760,34 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.topup_amount), 0)::int AS total_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     topups t ON EXTRACT(MONTH FROM t.topup_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.topup_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyTopupAmountsRow struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -777,6 +801,7 @@ type GetMonthlyTopupAmountsRow struct {
 //
 //	month: 3-letter month abbreviation
 //	total_amount: Sum of all topup amounts per month
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Filters soft-deleted entries (deleted_at IS NULL)
@@ -791,7 +816,7 @@ func (q *Queries) GetMonthlyTopupAmounts(ctx context.Context, dollar_1 time.Time
 	var items []*GetMonthlyTopupAmountsRow
 	for rows.Next() {
 		var i GetMonthlyTopupAmountsRow
-		if err := rows.Scan(&i.Month, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -812,21 +837,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.topup_amount), 0)::int AS total_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     topups t ON EXTRACT(MONTH FROM t.topup_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.topup_time) = EXTRACT(YEAR FROM m.month)
     AND t.card_number = $1
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyTopupAmountsByCardNumberParams struct {
@@ -836,6 +870,7 @@ type GetMonthlyTopupAmountsByCardNumberParams struct {
 
 type GetMonthlyTopupAmountsByCardNumberRow struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -850,6 +885,7 @@ type GetMonthlyTopupAmountsByCardNumberRow struct {
 //
 //	month: 3-letter month abbreviation
 //	total_amount: Sum of all topup amounts per month
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Filters soft-deleted entries (deleted_at IS NULL)
@@ -864,7 +900,7 @@ func (q *Queries) GetMonthlyTopupAmountsByCardNumber(ctx context.Context, arg Ge
 	var items []*GetMonthlyTopupAmountsByCardNumberRow
 	for rows.Next() {
 		var i GetMonthlyTopupAmountsByCardNumberRow
-		if err := rows.Scan(&i.Month, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1062,7 +1098,7 @@ func (q *Queries) GetMonthlyTopupMethodsByCardNumber(ctx context.Context, arg Ge
 }
 
 const getTopupByID = `-- name: GetTopupByID :one
-SELECT topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at FROM topups WHERE topup_id = $1 AND deleted_at IS NULL
+SELECT topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at, currency, fee FROM topups WHERE topup_id = $1 AND deleted_at IS NULL
 `
 
 // GetTopupByID: Retrieves a specific topup by ID
@@ -1091,13 +1127,15 @@ func (q *Queries) GetTopupByID(ctx context.Context, topupID int32) (*Topup, erro
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
 
 const getTopups = `-- name: GetTopups :many
 SELECT
-    topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at,
+    topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at, currency, fee,
     COUNT(*) OVER() AS total_count
 FROM
     topups
@@ -1132,6 +1170,8 @@ type GetTopupsRow struct {
 	CreatedAt   sql.NullTime `json:"created_at"`
 	UpdatedAt   sql.NullTime `json:"updated_at"`
 	DeletedAt   sql.NullTime `json:"deleted_at"`
+	Currency    string       `json:"currency"`
+	Fee         int32        `json:"fee"`
 	TotalCount  int64        `json:"total_count"`
 }
 
@@ -1172,6 +1212,8 @@ func (q *Queries) GetTopups(ctx context.Context, arg GetTopupsParams) ([]*GetTop
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.Fee,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1189,7 +1231,7 @@ func (q *Queries) GetTopups(ctx context.Context, arg GetTopupsParams) ([]*GetTop
 
 const getTopupsByCardNumber = `-- name: GetTopupsByCardNumber :many
 SELECT
-    topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at,
+    topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at, currency, fee,
     COUNT(*) OVER() AS total_count
 FROM
     topups
@@ -1225,6 +1267,8 @@ type GetTopupsByCardNumberRow struct {
 	CreatedAt   sql.NullTime `json:"created_at"`
 	UpdatedAt   sql.NullTime `json:"updated_at"`
 	DeletedAt   sql.NullTime `json:"deleted_at"`
+	Currency    string       `json:"currency"`
+	Fee         int32        `json:"fee"`
 	TotalCount  int64        `json:"total_count"`
 }
 
@@ -1269,6 +1313,8 @@ func (q *Queries) GetTopupsByCardNumber(ctx context.Context, arg GetTopupsByCard
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.Fee,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1285,7 +1331,7 @@ func (q *Queries) GetTopupsByCardNumber(ctx context.Context, arg GetTopupsByCard
 }
 
 const getTrashedTopupByID = `-- name: GetTrashedTopupByID :one
-SELECT topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at
+SELECT topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at, currency, fee
 FROM topups
 WHERE
     topup_id = $1
@@ -1315,13 +1361,15 @@ func (q *Queries) GetTrashedTopupByID(ctx context.Context, topupID int32) (*Topu
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
 
 const getTrashedTopups = `-- name: GetTrashedTopups :many
 SELECT
-    topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at,
+    topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at, currency, fee,
     COUNT(*) OVER() AS total_count
 FROM
     topups
@@ -1355,6 +1403,8 @@ type GetTrashedTopupsRow struct {
 	CreatedAt   sql.NullTime `json:"created_at"`
 	UpdatedAt   sql.NullTime `json:"updated_at"`
 	DeletedAt   sql.NullTime `json:"deleted_at"`
+	Currency    string       `json:"currency"`
+	Fee         int32        `json:"fee"`
 	TotalCount  int64        `json:"total_count"`
 }
 
@@ -1394,6 +1444,8 @@ func (q *Queries) GetTrashedTopups(ctx context.Context, arg GetTrashedTopupsPara
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.Fee,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1412,6 +1464,7 @@ func (q *Queries) GetTrashedTopups(ctx context.Context, arg GetTrashedTopupsPara
 const getYearlyTopupAmounts = `-- name: GetYearlyTopupAmounts :many
 SELECT
     EXTRACT(YEAR FROM t.topup_time) AS year,
+    t.currency,
     SUM(t.topup_amount) AS total_amount
 FROM
     topups t
@@ -1420,13 +1473,16 @@ WHERE
     AND EXTRACT(YEAR FROM t.topup_time) >= $1 - 4
     AND EXTRACT(YEAR FROM t.topup_time) <= $1
 GROUP BY
-    EXTRACT(YEAR FROM t.topup_time)
+    EXTRACT(YEAR FROM t.topup_time),
+    t.currency
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyTopupAmountsRow struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int64  `json:"total_amount"`
 }
 
@@ -1440,6 +1496,7 @@ type GetYearlyTopupAmountsRow struct {
 //
 //	year: Year extracted from topup_time
 //	total_amount: Sum of all topup amounts in the year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Includes topup data from current year and 4 years prior
@@ -1454,7 +1511,7 @@ func (q *Queries) GetYearlyTopupAmounts(ctx context.Context, dollar_1 interface{
 	var items []*GetYearlyTopupAmountsRow
 	for rows.Next() {
 		var i GetYearlyTopupAmountsRow
-		if err := rows.Scan(&i.Year, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1471,6 +1528,7 @@ func (q *Queries) GetYearlyTopupAmounts(ctx context.Context, dollar_1 interface{
 const getYearlyTopupAmountsByCardNumber = `-- name: GetYearlyTopupAmountsByCardNumber :many
 SELECT
     EXTRACT(YEAR FROM t.topup_time) AS year,
+    t.currency,
     SUM(t.topup_amount) AS total_amount
 FROM
     topups t
@@ -1480,9 +1538,11 @@ WHERE
     AND EXTRACT(YEAR FROM t.created_at) >= $2 - 4
     AND EXTRACT(YEAR FROM t.created_at) <= $2
 GROUP BY
-    EXTRACT(YEAR FROM t.topup_time)
+    EXTRACT(YEAR FROM t.topup_time),
+    t.currency
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyTopupAmountsByCardNumberParams struct {
@@ -1492,6 +1552,7 @@ type GetYearlyTopupAmountsByCardNumberParams struct {
 
 type GetYearlyTopupAmountsByCardNumberRow struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int64  `json:"total_amount"`
 }
 
@@ -1506,6 +1567,7 @@ type GetYearlyTopupAmountsByCardNumberRow struct {
 //
 //	year: Year extracted from topup_time
 //	total_amount: Sum of all topup amounts in the year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Includes topup data from current year and 4 years prior
@@ -1520,7 +1582,7 @@ func (q *Queries) GetYearlyTopupAmountsByCardNumber(ctx context.Context, arg Get
 	var items []*GetYearlyTopupAmountsByCardNumberRow
 	for rows.Next() {
 		var i GetYearlyTopupAmountsByCardNumberRow
-		if err := rows.Scan(&i.Year, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2125,7 +2187,7 @@ SET
 WHERE
     topup_id = $1
     AND deleted_at IS NOT NULL
-RETURNING topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at
+RETURNING topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at, currency, fee
 `
 
 // RestoreTopup: Restores a soft-deleted topup by nullifying deleted_at
@@ -2150,6 +2212,8 @@ func (q *Queries) RestoreTopup(ctx context.Context, topupID int32) (*Topup, erro
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
@@ -2161,7 +2225,7 @@ SET
 WHERE
     topup_id = $1
     AND deleted_at IS NULL
-RETURNING topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at
+RETURNING topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at, currency, fee
 `
 
 // TrashTopup: Soft deletes a topup by setting deleted_at
@@ -2187,6 +2251,8 @@ func (q *Queries) TrashTopup(ctx context.Context, topupID int32) (*Topup, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
@@ -2194,23 +2260,23 @@ func (q *Queries) TrashTopup(ctx context.Context, topupID int32) (*Topup, error)
 const updateTopup = `-- name: UpdateTopup :one
 UPDATE topups
 SET
-    card_number = $2,
-    topup_amount = $3,
-    topup_method = $4,
-    topup_time = $5,
+    topup_amount = $2,
+    topup_method = $3,
+    topup_time = $4,
+    fee = $5,
     updated_at = current_timestamp
 WHERE
     topup_id = $1
     AND deleted_at IS NULL
-RETURNING topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at
+RETURNING topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at, currency, fee
 `
 
 type UpdateTopupParams struct {
 	TopupID     int32     `json:"topup_id"`
-	CardNumber  string    `json:"card_number"`
 	TopupAmount int32     `json:"topup_amount"`
 	TopupMethod string    `json:"topup_method"`
 	TopupTime   time.Time `json:"topup_time"`
+	Fee         int32     `json:"fee"`
 }
 
 // UpdateTopup: Updates an existing topup transaction
@@ -2218,21 +2284,22 @@ type UpdateTopupParams struct {
 // Parameters:
 //
 //	$1: topup_id - ID of the topup to update
-//	$2: card_number - Updated card number
-//	$3: topup_amount - Updated amount
-//	$4: topup_method - Updated payment method
-//	$5: topup_time - Updated transaction time
+//	$2: topup_amount - Updated amount
+//	$3: topup_method - Updated payment method
+//	$4: topup_time - Updated transaction time
+//	$5: fee - Topup fee quoted on the updated amount
 //
 // Business Logic:
 //   - Skips soft-deleted records (deleted_at IS NULL)
 //   - Updates updated_at automatically
+//   - The card is kept, as the ledger postings of the topup stay on it
 func (q *Queries) UpdateTopup(ctx context.Context, arg UpdateTopupParams) (*Topup, error) {
 	row := q.db.QueryRowContext(ctx, updateTopup,
 		arg.TopupID,
-		arg.CardNumber,
 		arg.TopupAmount,
 		arg.TopupMethod,
 		arg.TopupTime,
+		arg.Fee,
 	)
 	var i Topup
 	err := row.Scan(
@@ -2246,6 +2313,8 @@ func (q *Queries) UpdateTopup(ctx context.Context, arg UpdateTopupParams) (*Topu
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
@@ -2258,7 +2327,7 @@ SET
 WHERE
     topup_id = $1
     AND deleted_at IS NULL
-RETURNING topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at
+RETURNING topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at, currency, fee
 `
 
 type UpdateTopupAmountParams struct {
@@ -2290,38 +2359,67 @@ func (q *Queries) UpdateTopupAmount(ctx context.Context, arg UpdateTopupAmountPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
 
 const updateTopupStatus = `-- name: UpdateTopupStatus :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT 'topup', topup_id, status, $1, current_timestamp
+        FROM topups
+        WHERE
+            topup_id = $2
+            AND status = $3
+            AND deleted_at IS NULL
+        FOR UPDATE
+    )
 UPDATE topups
 SET
-    status = $2,
+    status = $1,
     updated_at = current_timestamp
 WHERE
-    topup_id = $1
+    topup_id = $2
+    AND status = $3
     AND deleted_at IS NULL
-RETURNING topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at
+RETURNING topup_id, topup_no, card_number, topup_amount, topup_method, topup_time, status, created_at, updated_at, deleted_at, currency, fee
 `
 
 type UpdateTopupStatusParams struct {
-	TopupID int32  `json:"topup_id"`
-	Status  string `json:"status"`
+	Status     string `json:"status"`
+	TopupID    int32  `json:"topup_id"`
+	FromStatus string `json:"from_status"`
 }
 
-// UpdateTopupStatus: Updates the status of a specific topup
-// Purpose: Mark topup as 'success', 'failed', etc.
+// UpdateTopupStatus: Moves a topup from one status to another
+// Purpose: Record the outcome of a topup together with its status history
 // Parameters:
 //
-//	$1: topup_id - ID of the topup
-//	$2: new status value (e.g., 'success', 'failed')
+//	$1: status - New status
+//	$2: topup_id - ID of the topup
+//	$3: from_status - Status the topup is expected to be in
+//
+// Returns:
+//
+//	The updated topup, or no row when it is no longer in from_status
 //
 // Business Logic:
-//   - Applies only to active (non-deleted) records
-//   - updated_at is refreshed
+//   - Only updates active (non-deleted) records
+//   - Allowed transitions are checked against pkg/statemachine by the caller
+//   - The status history row is written by the same statement, and only
+//     when the row was still in from_status
 func (q *Queries) UpdateTopupStatus(ctx context.Context, arg UpdateTopupStatusParams) (*Topup, error) {
-	row := q.db.QueryRowContext(ctx, updateTopupStatus, arg.TopupID, arg.Status)
+	row := q.db.QueryRowContext(ctx, updateTopupStatus, arg.Status, arg.TopupID, arg.FromStatus)
 	var i Topup
 	err := row.Scan(
 		&i.TopupID,
@@ -2334,6 +2432,8 @@ func (q *Queries) UpdateTopupStatus(ctx context.Context, arg UpdateTopupStatusPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
@@ -13,6 +13,172 @@ import (
 	"github.com/google/uuid"
 )
 
+const applyTransactionRefund = `-- name: ApplyTransactionRefund :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT
+            'transaction',
+            transaction_id,
+            status,
+            CASE
+                WHEN refunded_amount + $1::INT = amount THEN 'refunded'
+                ELSE 'partially_refunded'
+            END,
+            current_timestamp
+        FROM transactions
+        WHERE
+            transaction_id = $2
+            AND deleted_at IS NULL
+            AND status IN ('success', 'partially_refunded')
+            AND refunded_amount + $1::INT <= amount
+        FOR UPDATE
+    )
+UPDATE transactions
+SET
+    refunded_amount = refunded_amount + $1::INT,
+    status = CASE
+        WHEN refunded_amount + $1::INT = amount THEN 'refunded'
+        ELSE 'partially_refunded'
+    END,
+    updated_at = current_timestamp
+WHERE
+    transaction_id = $2
+    AND deleted_at IS NULL
+    AND status IN ('success', 'partially_refunded')
+    AND refunded_amount + $1::INT <= amount
+RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee
+`
+
+type ApplyTransactionRefundParams struct {
+	Amount        int32 `json:"amount"`
+	TransactionID int32 `json:"transaction_id"`
+}
+
+// ApplyTransactionRefund: Records a refunded amount against a transaction
+// Purpose: Track cumulative refunds and move the transaction to a refund status
+// Parameters:
+//
+//	$1: transaction_id - ID of the refunded transaction
+//	$2: amount - Amount being refunded
+//
+// Returns:
+//
+//	The updated transaction record, or no row if the refund is not allowed
+//
+// Business Logic:
+//   - Only successful or partially refunded transactions can be refunded
+//   - Cumulative refunds are capped at the original amount
+//   - Status becomes 'refunded' once fully refunded, otherwise 'partially_refunded'
+//   - The check and the increment happen in one statement, so concurrent
+//     refunds cannot exceed the original amount
+//   - The status change is written to status_histories by the same statement
+func (q *Queries) ApplyTransactionRefund(ctx context.Context, arg ApplyTransactionRefundParams) (*Transaction, error) {
+	row := q.db.QueryRowContext(ctx, applyTransactionRefund, arg.Amount, arg.TransactionID)
+	var i Transaction
+	err := row.Scan(
+		&i.TransactionID,
+		&i.TransactionNo,
+		&i.CardNumber,
+		&i.Amount,
+		&i.PaymentMethod,
+		&i.MerchantID,
+		&i.TransactionTime,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.Currency,
+		&i.Fee,
+	)
+	return &i, err
+}
+
+const chargeBackTransaction = `-- name: ChargeBackTransaction :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT 'transaction', transaction_id, status, 'charged_back', current_timestamp
+        FROM transactions
+        WHERE
+            transaction_id = $1
+            AND deleted_at IS NULL
+            AND status = 'disputed'
+            AND refunded_amount + $2::INT <= amount
+        FOR UPDATE
+    )
+UPDATE transactions
+SET
+    refunded_amount = refunded_amount + $2::INT,
+    status = 'charged_back',
+    updated_at = current_timestamp
+WHERE
+    transaction_id = $1
+    AND deleted_at IS NULL
+    AND status = 'disputed'
+    AND refunded_amount + $2::INT <= amount
+RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee
+`
+
+type ChargeBackTransactionParams struct {
+	TransactionID int32 `json:"transaction_id"`
+	Amount        int32 `json:"amount"`
+}
+
+// ChargeBackTransaction: Records a chargeback against a disputed transaction
+// Purpose: Close a dispute that was ruled in favour of the cardholder
+// Parameters:
+//
+//	$1: transaction_id - ID of the disputed transaction
+//	$2: amount - Amount returned to the cardholder
+//
+// Returns:
+//
+//	The updated transaction record, or no row if the chargeback is not allowed
+//
+// Business Logic:
+//   - Only disputed transactions can be charged back
+//   - The charged back amount counts towards refunded_amount, so merchant
+//     analytics stay net of it
+//   - Status becomes 'charged_back'
+//   - The status change is written to status_histories by the same statement
+func (q *Queries) ChargeBackTransaction(ctx context.Context, arg ChargeBackTransactionParams) (*Transaction, error) {
+	row := q.db.QueryRowContext(ctx, chargeBackTransaction, arg.TransactionID, arg.Amount)
+	var i Transaction
+	err := row.Scan(
+		&i.TransactionID,
+		&i.TransactionNo,
+		&i.CardNumber,
+		&i.Amount,
+		&i.PaymentMethod,
+		&i.MerchantID,
+		&i.TransactionTime,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.Currency,
+		&i.Fee,
+	)
+	return &i, err
+}
+
 const createTransaction = `-- name: CreateTransaction :one
 INSERT INTO
     transactions (
@@ -21,6 +187,8 @@ INSERT INTO
         payment_method,
         merchant_id,
         transaction_time,
+        currency,
+        fee,
         created_at,
         updated_at
     )
@@ -30,9 +198,11 @@ VALUES (
         $3,
         $4,
         $5,
+        (SELECT c.currency FROM cards c WHERE c.card_number = $1),
+        $6,
         current_timestamp,
         current_timestamp
-    ) RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at
+    ) RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee
 `
 
 type CreateTransactionParams struct {
@@ -41,6 +211,7 @@ type CreateTransactionParams struct {
 	PaymentMethod   string    `json:"payment_method"`
 	MerchantID      int32     `json:"merchant_id"`
 	TransactionTime time.Time `json:"transaction_time"`
+	Fee             int32     `json:"fee"`
 }
 
 // CreateTransaction: Creates a new transaction record
@@ -52,6 +223,7 @@ type CreateTransactionParams struct {
 //	$3: payment_method - Payment method used (e.g., 'credit', 'debit')
 //	$4: merchant_id - ID of the merchant where transaction occurred
 //	$5: transaction_time - Timestamp of when transaction occurred
+//	$6: fee - Merchant discount rate kept out of the amount credited to the merchant
 //
 // Returns:
 //
@@ -60,6 +232,7 @@ type CreateTransactionParams struct {
 // Business Logic:
 //   - Sets creation and update timestamps automatically
 //   - Used for recording purchases, payments, and other financial activities
+//   - The amount is recorded in the currency of the paying card
 func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionParams) (*Transaction, error) {
 	row := q.db.QueryRowContext(ctx, createTransaction,
 		arg.CardNumber,
@@ -67,6 +240,7 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 		arg.PaymentMethod,
 		arg.MerchantID,
 		arg.TransactionTime,
+		arg.Fee,
 	)
 	var i Transaction
 	err := row.Scan(
@@ -81,6 +255,9 @@ func (q *Queries) CreateTransaction(ctx context.Context, arg CreateTransactionPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
@@ -125,7 +302,7 @@ func (q *Queries) DeleteTransactionPermanently(ctx context.Context, transactionI
 
 const getActiveTransactions = `-- name: GetActiveTransactions :many
 SELECT
-    transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at,
+    transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee,
     COUNT(*) OVER() AS total_count
 FROM
     transactions
@@ -155,6 +332,9 @@ type GetActiveTransactionsRow struct {
 	CreatedAt       sql.NullTime `json:"created_at"`
 	UpdatedAt       sql.NullTime `json:"updated_at"`
 	DeletedAt       sql.NullTime `json:"deleted_at"`
+	RefundedAmount  int32        `json:"refunded_amount"`
+	Currency        string       `json:"currency"`
+	Fee             int32        `json:"fee"`
 	TotalCount      int64        `json:"total_count"`
 }
 
@@ -197,6 +377,9 @@ func (q *Queries) GetActiveTransactions(ctx context.Context, arg GetActiveTransa
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.RefundedAmount,
+			&i.Currency,
+			&i.Fee,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -755,24 +938,34 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.amount), 0)::int AS total_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyAmountsRow struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -786,8 +979,10 @@ type GetMonthlyAmountsRow struct {
 //	$1: reference_date - Any date within the target year
 //
 // Returns:
-//   - month: 3-letter month abbreviation
-//   - total_amount: Sum of transaction amounts in each month
+//
+//	currency: Currency the totals are expressed in
+//	- month: 3-letter month abbreviation
+//	- total_amount: Sum of transaction amounts in each month
 //
 // Business Logic:
 //   - Uses LEFT JOIN to ensure all months are included, even with 0 transactions
@@ -801,7 +996,7 @@ func (q *Queries) GetMonthlyAmounts(ctx context.Context, dollar_1 time.Time) ([]
 	var items []*GetMonthlyAmountsRow
 	for rows.Next() {
 		var i GetMonthlyAmountsRow
-		if err := rows.Scan(&i.Month, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -822,21 +1017,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.amount), 0)::int AS total_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transactions t ON EXTRACT(MONTH FROM t.transaction_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transaction_time) = EXTRACT(YEAR FROM m.month)
     AND t.card_number = $1
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyAmountsByCardNumberParams struct {
@@ -846,6 +1050,7 @@ type GetMonthlyAmountsByCardNumberParams struct {
 
 type GetMonthlyAmountsByCardNumberRow struct {
 	Month       string `json:"month"`
+	Currency    string `json:"currency"`
 	TotalAmount int32  `json:"total_amount"`
 }
 
@@ -860,8 +1065,10 @@ type GetMonthlyAmountsByCardNumberRow struct {
 //	$2: reference_date - Any date within the target year
 //
 // Returns:
-//   - month: 3-letter month abbreviation
-//   - total_amount: Sum of transaction amounts in each month
+//
+//	currency: Currency the totals are expressed in
+//	- month: 3-letter month abbreviation
+//	- total_amount: Sum of transaction amounts in each month
 //
 // Business Logic:
 //   - Uses LEFT JOIN to ensure all months are included, even with 0 transactions
@@ -875,7 +1082,7 @@ func (q *Queries) GetMonthlyAmountsByCardNumber(ctx context.Context, arg GetMont
 	var items []*GetMonthlyAmountsByCardNumberRow
 	for rows.Next() {
 		var i GetMonthlyAmountsByCardNumberRow
-		if err := rows.Scan(&i.Month, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1076,7 +1283,7 @@ func (q *Queries) GetMonthlyPaymentMethodsByCardNumber(ctx context.Context, arg
 
 const getTransactionByCardNumber = `-- name: GetTransactionByCardNumber :many
 SELECT
-    transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at,
+    transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee,
     COUNT(*) OVER() AS total_count
 FROM
     transactions
@@ -1108,6 +1315,9 @@ type GetTransactionByCardNumberRow struct {
 	CreatedAt       sql.NullTime `json:"created_at"`
 	UpdatedAt       sql.NullTime `json:"updated_at"`
 	DeletedAt       sql.NullTime `json:"deleted_at"`
+	RefundedAmount  int32        `json:"refunded_amount"`
+	Currency        string       `json:"currency"`
+	Fee             int32        `json:"fee"`
 	TotalCount      int64        `json:"total_count"`
 }
 
@@ -1157,6 +1367,9 @@ func (q *Queries) GetTransactionByCardNumber(ctx context.Context, arg GetTransac
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.RefundedAmount,
+			&i.Currency,
+			&i.Fee,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1173,7 +1386,7 @@ func (q *Queries) GetTransactionByCardNumber(ctx context.Context, arg GetTransac
 }
 
 const getTransactionByID = `-- name: GetTransactionByID :one
-SELECT transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at
+SELECT transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee
 FROM transactions
 WHERE
     transaction_id = $1
@@ -1208,13 +1421,16 @@ func (q *Queries) GetTransactionByID(ctx context.Context, transactionID int32) (
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
 
 const getTransactions = `-- name: GetTransactions :many
 SELECT
-    transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at,
+    transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee,
     COUNT(*) OVER() AS total_count
 FROM
     transactions
@@ -1248,6 +1464,9 @@ type GetTransactionsRow struct {
 	CreatedAt       sql.NullTime `json:"created_at"`
 	UpdatedAt       sql.NullTime `json:"updated_at"`
 	DeletedAt       sql.NullTime `json:"deleted_at"`
+	RefundedAmount  int32        `json:"refunded_amount"`
+	Currency        string       `json:"currency"`
+	Fee             int32        `json:"fee"`
 	TotalCount      int64        `json:"total_count"`
 }
 
@@ -1290,6 +1509,9 @@ func (q *Queries) GetTransactions(ctx context.Context, arg GetTransactionsParams
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.RefundedAmount,
+			&i.Currency,
+			&i.Fee,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1307,7 +1529,7 @@ func (q *Queries) GetTransactions(ctx context.Context, arg GetTransactionsParams
 
 const getTransactionsByCardNumber = `-- name: GetTransactionsByCardNumber :many
 SELECT
-    transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at,
+    transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee,
     COUNT(*) OVER() AS total_count
 FROM
     transactions
@@ -1343,6 +1565,9 @@ type GetTransactionsByCardNumberRow struct {
 	CreatedAt       sql.NullTime `json:"created_at"`
 	UpdatedAt       sql.NullTime `json:"updated_at"`
 	DeletedAt       sql.NullTime `json:"deleted_at"`
+	RefundedAmount  int32        `json:"refunded_amount"`
+	Currency        string       `json:"currency"`
+	Fee             int32        `json:"fee"`
 	TotalCount      int64        `json:"total_count"`
 }
 
@@ -1391,6 +1616,9 @@ func (q *Queries) GetTransactionsByCardNumber(ctx context.Context, arg GetTransa
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.RefundedAmount,
+			&i.Currency,
+			&i.Fee,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1407,7 +1635,7 @@ func (q *Queries) GetTransactionsByCardNumber(ctx context.Context, arg GetTransa
 }
 
 const getTransactionsByMerchantID = `-- name: GetTransactionsByMerchantID :many
-SELECT transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at
+SELECT transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee
 FROM transactions
 WHERE
     merchant_id = $1
@@ -1451,6 +1679,9 @@ func (q *Queries) GetTransactionsByMerchantID(ctx context.Context, merchantID in
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.RefundedAmount,
+			&i.Currency,
+			&i.Fee,
 		); err != nil {
 			return nil, err
 		}
@@ -1466,7 +1697,7 @@ func (q *Queries) GetTransactionsByMerchantID(ctx context.Context, merchantID in
 }
 
 const getTrashedTransactionByID = `-- name: GetTrashedTransactionByID :one
-SELECT transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at
+SELECT transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee
 FROM transactions
 WHERE
     transaction_id = $1
@@ -1501,13 +1732,16 @@ func (q *Queries) GetTrashedTransactionByID(ctx context.Context, transactionID i
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
 
 const getTrashedTransactions = `-- name: GetTrashedTransactions :many
 SELECT
-    transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at,
+    transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee,
     COUNT(*) OVER() AS total_count
 FROM
     transactions
@@ -1537,6 +1771,9 @@ type GetTrashedTransactionsRow struct {
 	CreatedAt       sql.NullTime `json:"created_at"`
 	UpdatedAt       sql.NullTime `json:"updated_at"`
 	DeletedAt       sql.NullTime `json:"deleted_at"`
+	RefundedAmount  int32        `json:"refunded_amount"`
+	Currency        string       `json:"currency"`
+	Fee             int32        `json:"fee"`
 	TotalCount      int64        `json:"total_count"`
 }
 
@@ -1578,6 +1815,9 @@ func (q *Queries) GetTrashedTransactions(ctx context.Context, arg GetTrashedTran
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.RefundedAmount,
+			&i.Currency,
+			&i.Fee,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1596,6 +1836,7 @@ func (q *Queries) GetTrashedTransactions(ctx context.Context, arg GetTrashedTran
 const getYearlyAmounts = `-- name: GetYearlyAmounts :many
 SELECT
     EXTRACT(YEAR FROM t.created_at) AS year,
+    t.currency,
     SUM(t.amount) AS total_amount
 FROM
     transactions t
@@ -1604,13 +1845,16 @@ WHERE
     AND EXTRACT(YEAR FROM t.created_at) >= $1 - 4
     AND EXTRACT(YEAR FROM t.created_at) <= $1
 GROUP BY
-    EXTRACT(YEAR FROM t.created_at)
+    EXTRACT(YEAR FROM t.created_at),
+    t.currency
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyAmountsRow struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int64  `json:"total_amount"`
 }
 
@@ -1624,8 +1868,10 @@ type GetYearlyAmountsRow struct {
 //	$1: current_year - The most recent year to include (covers current_year - 4 to current_year)
 //
 // Returns:
-//   - year: Year of the transaction
-//   - total_amount: Total transaction amount for the year
+//
+//	currency: Currency the totals are expressed in
+//	- year: Year of the transaction
+//	- total_amount: Total transaction amount for the year
 //
 // Business Logic:
 //   - Excludes soft-deleted transactions (deleted_at IS NULL)
@@ -1638,7 +1884,7 @@ func (q *Queries) GetYearlyAmounts(ctx context.Context, dollar_1 interface{}) ([
 	var items []*GetYearlyAmountsRow
 	for rows.Next() {
 		var i GetYearlyAmountsRow
-		if err := rows.Scan(&i.Year, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1655,6 +1901,7 @@ func (q *Queries) GetYearlyAmounts(ctx context.Context, dollar_1 interface{}) ([
 const getYearlyAmountsByCardNumber = `-- name: GetYearlyAmountsByCardNumber :many
 SELECT
     EXTRACT(YEAR FROM t.created_at) AS year,
+    t.currency,
     SUM(t.amount) AS total_amount
 FROM
     transactions t
@@ -1664,9 +1911,11 @@ WHERE
     AND EXTRACT(YEAR FROM t.created_at) >= $2 - 4
     AND EXTRACT(YEAR FROM t.created_at) <= $2
 GROUP BY
-    EXTRACT(YEAR FROM t.created_at)
+    EXTRACT(YEAR FROM t.created_at),
+    t.currency
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyAmountsByCardNumberParams struct {
@@ -1676,6 +1925,7 @@ type GetYearlyAmountsByCardNumberParams struct {
 
 type GetYearlyAmountsByCardNumberRow struct {
 	Year        string `json:"year"`
+	Currency    string `json:"currency"`
 	TotalAmount int64  `json:"total_amount"`
 }
 
@@ -1690,8 +1940,10 @@ type GetYearlyAmountsByCardNumberRow struct {
 //	$2: current_year - The most recent year to include (covers current_year - 4 to current_year)
 //
 // Returns:
-//   - year: Year of the transaction
-//   - total_amount: Total transaction amount for the year
+//
+//	currency: Currency the totals are expressed in
+//	- year: Year of the transaction
+//	- total_amount: Total transaction amount for the year
 //
 // Business Logic:
 //   - Excludes soft-deleted transactions (deleted_at IS NULL)
@@ -1704,7 +1956,7 @@ func (q *Queries) GetYearlyAmountsByCardNumber(ctx context.Context, arg GetYearl
 	var items []*GetYearlyAmountsByCardNumberRow
 	for rows.Next() {
 		var i GetYearlyAmountsByCardNumberRow
-		if err := rows.Scan(&i.Year, &i.TotalAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2291,6 +2543,151 @@ func (q *Queries) GetYearlyTransactionStatusSuccessCardNumber(ctx context.Contex
 	return items, nil
 }
 
+const markTransactionDisputed = `-- name: MarkTransactionDisputed :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT 'transaction', transaction_id, status, 'disputed', current_timestamp
+        FROM transactions
+        WHERE
+            transaction_id = $1
+            AND deleted_at IS NULL
+            AND status IN ('success', 'partially_refunded')
+            AND refunded_amount < amount
+        FOR UPDATE
+    )
+UPDATE transactions
+SET
+    status = 'disputed',
+    updated_at = current_timestamp
+WHERE
+    transaction_id = $1
+    AND deleted_at IS NULL
+    AND status IN ('success', 'partially_refunded')
+    AND refunded_amount < amount
+RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee
+`
+
+// MarkTransactionDisputed: Puts a transaction under dispute
+// Purpose: Freeze a transaction while a cardholder dispute is pending
+// Parameters:
+//
+//	$1: transaction_id - ID of the disputed transaction
+//
+// Returns:
+//
+//	The updated transaction record, or no row if the transaction cannot be disputed
+//
+// Business Logic:
+//   - Only successful or partially refunded transactions can be disputed
+//   - Fully refunded transactions have nothing left to dispute
+//   - Disputed transactions cannot be refunded or updated until resolved
+//   - The status change is written to status_histories by the same statement
+func (q *Queries) MarkTransactionDisputed(ctx context.Context, transactionID int32) (*Transaction, error) {
+	row := q.db.QueryRowContext(ctx, markTransactionDisputed, transactionID)
+	var i Transaction
+	err := row.Scan(
+		&i.TransactionID,
+		&i.TransactionNo,
+		&i.CardNumber,
+		&i.Amount,
+		&i.PaymentMethod,
+		&i.MerchantID,
+		&i.TransactionTime,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.Currency,
+		&i.Fee,
+	)
+	return &i, err
+}
+
+const releaseTransactionDispute = `-- name: ReleaseTransactionDispute :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT
+            'transaction',
+            transaction_id,
+            status,
+            CASE
+                WHEN refunded_amount > 0 THEN 'partially_refunded'
+                ELSE 'success'
+            END,
+            current_timestamp
+        FROM transactions
+        WHERE
+            transaction_id = $1
+            AND deleted_at IS NULL
+            AND status = 'disputed'
+        FOR UPDATE
+    )
+UPDATE transactions
+SET
+    status = CASE
+        WHEN refunded_amount > 0 THEN 'partially_refunded'
+        ELSE 'success'
+    END,
+    updated_at = current_timestamp
+WHERE
+    transaction_id = $1
+    AND deleted_at IS NULL
+    AND status = 'disputed'
+RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee
+`
+
+// ReleaseTransactionDispute: Returns a disputed transaction to its previous status
+// Purpose: Close a dispute that was ruled in favour of the merchant
+// Parameters:
+//
+//	$1: transaction_id - ID of the disputed transaction
+//
+// Returns:
+//
+//	The updated transaction record, or no row if the transaction is not disputed
+//
+// Business Logic:
+//   - Status becomes 'partially_refunded' if refunds exist, otherwise 'success'
+//   - The status change is written to status_histories by the same statement
+func (q *Queries) ReleaseTransactionDispute(ctx context.Context, transactionID int32) (*Transaction, error) {
+	row := q.db.QueryRowContext(ctx, releaseTransactionDispute, transactionID)
+	var i Transaction
+	err := row.Scan(
+		&i.TransactionID,
+		&i.TransactionNo,
+		&i.CardNumber,
+		&i.Amount,
+		&i.PaymentMethod,
+		&i.MerchantID,
+		&i.TransactionTime,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.Currency,
+		&i.Fee,
+	)
+	return &i, err
+}
+
 const restoreAllTransactions = `-- name: RestoreAllTransactions :exec
 UPDATE transactions
 SET
@@ -2317,7 +2714,7 @@ SET
 WHERE
     transaction_id = $1
     AND deleted_at IS NOT NULL
-RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at
+RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee
 `
 
 // RestoreTransaction: Recovers a soft-deleted transaction
@@ -2345,6 +2742,9 @@ func (q *Queries) RestoreTransaction(ctx context.Context, transactionID int32) (
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
@@ -2356,7 +2756,7 @@ SET
 WHERE
     transaction_id = $1
     AND deleted_at IS NULL
-RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at
+RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee
 `
 
 // TrashTransaction: Soft-deletes a transaction record
@@ -2384,6 +2784,9 @@ func (q *Queries) TrashTransaction(ctx context.Context, transactionID int32) (*T
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
@@ -2396,11 +2799,12 @@ SET
     payment_method = $4,
     merchant_id = $5,
     transaction_time = $6,
+    fee = $7,
     updated_at = current_timestamp
 WHERE
     transaction_id = $1
     AND deleted_at IS NULL
-RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at
+RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee
 `
 
 type UpdateTransactionParams struct {
@@ -2410,6 +2814,7 @@ type UpdateTransactionParams struct {
 	PaymentMethod   string    `json:"payment_method"`
 	MerchantID      int32     `json:"merchant_id"`
 	TransactionTime time.Time `json:"transaction_time"`
+	Fee             int32     `json:"fee"`
 }
 
 // UpdateTransaction: Modifies an existing transaction's details
@@ -2422,6 +2827,7 @@ type UpdateTransactionParams struct {
 //	$4: payment_method - Updated payment method
 //	$5: merchant_id - Updated merchant ID
 //	$6: transaction_time - Updated transaction timestamp
+//	$7: fee - Merchant discount rate quoted on the updated amount
 //
 // Business Logic:
 //   - Only updates active transactions (non-deleted)
@@ -2435,6 +2841,7 @@ func (q *Queries) UpdateTransaction(ctx context.Context, arg UpdateTransactionPa
 		arg.PaymentMethod,
 		arg.MerchantID,
 		arg.TransactionTime,
+		arg.Fee,
 	)
 	var i Transaction
 	err := row.Scan(
@@ -2449,39 +2856,68 @@ func (q *Queries) UpdateTransaction(ctx context.Context, arg UpdateTransactionPa
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
 
 const updateTransactionStatus = `-- name: UpdateTransactionStatus :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT 'transaction', transaction_id, status, $1, current_timestamp
+        FROM transactions
+        WHERE
+            transaction_id = $2
+            AND status = $3
+            AND deleted_at IS NULL
+        FOR UPDATE
+    )
 UPDATE transactions
 SET
-    status = $2,
+    status = $1,
     updated_at = current_timestamp
 WHERE
-    transaction_id = $1
+    transaction_id = $2
+    AND status = $3
     AND deleted_at IS NULL
-RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at
+RETURNING transaction_id, transaction_no, card_number, amount, payment_method, merchant_id, transaction_time, status, created_at, updated_at, deleted_at, refunded_amount, currency, fee
 `
 
 type UpdateTransactionStatusParams struct {
-	TransactionID int32  `json:"transaction_id"`
 	Status        string `json:"status"`
+	TransactionID int32  `json:"transaction_id"`
+	FromStatus    string `json:"from_status"`
 }
 
-// UpdateTransactionStatus: Changes a transaction's status
-// Purpose: Update transaction processing status
+// UpdateTransactionStatus: Moves a transaction from one status to another
+// Purpose: Record the outcome of a transaction together with its status history
 // Parameters:
 //
-//	$1: transaction_id - ID of transaction to update
-//	$2: status - New status (e.g., 'success', 'failed', 'pending')
+//	$1: status - New status
+//	$2: transaction_id - ID of the transaction
+//	$3: from_status - Status the transaction is expected to be in
+//
+// Returns:
+//
+//	The updated transaction, or no row when it is no longer in from_status
 //
 // Business Logic:
-//   - Only updates active transactions
-//   - Used to reflect transaction processing outcomes
-//   - Important for reconciliation and reporting
+//   - Only updates active (non-deleted) records
+//   - Allowed transitions are checked against pkg/statemachine by the caller
+//   - The status history row is written by the same statement, and only
+//     when the row was still in from_status
 func (q *Queries) UpdateTransactionStatus(ctx context.Context, arg UpdateTransactionStatusParams) (*Transaction, error) {
-	row := q.db.QueryRowContext(ctx, updateTransactionStatus, arg.TransactionID, arg.Status)
+	row := q.db.QueryRowContext(ctx, updateTransactionStatus, arg.Status, arg.TransactionID, arg.FromStatus)
 	var i Transaction
 	err := row.Scan(
 		&i.TransactionID,
@@ -2495,6 +2931,9 @@ func (q *Queries) UpdateTransactionStatus(ctx context.Context, arg UpdateTransac
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.RefundedAmount,
+		&i.Currency,
+		&i.Fee,
 	)
 	return &i, err
 }
@@ -21,6 +21,12 @@ INSERT INTO
         transfer_amount,
         transfer_time,
         status,
+        currency,
+        to_currency,
+        converted_amount,
+        exchange_rate,
+        spread_bps,
+        fee,
         created_at,
         updated_at
     )
@@ -30,17 +36,27 @@ VALUES (
         $3,
         $4,
         $5,
+        (SELECT c.currency FROM cards c WHERE c.card_number = $1),
+        (SELECT c.currency FROM cards c WHERE c.card_number = $2),
+        $6,
+        $7,
+        $8,
+        $9,
         current_timestamp,
         current_timestamp
-    ) RETURNING transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at
+    ) RETURNING transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee
 `
 
 type CreateTransferParams struct {
-	TransferFrom   string    `json:"transfer_from"`
-	TransferTo     string    `json:"transfer_to"`
-	TransferAmount int32     `json:"transfer_amount"`
-	TransferTime   time.Time `json:"transfer_time"`
-	Status         string    `json:"status"`
+	TransferFrom    string         `json:"transfer_from"`
+	TransferTo      string         `json:"transfer_to"`
+	TransferAmount  int32          `json:"transfer_amount"`
+	TransferTime    time.Time      `json:"transfer_time"`
+	Status          string         `json:"status"`
+	ConvertedAmount int32          `json:"converted_amount"`
+	ExchangeRate    sql.NullString `json:"exchange_rate"`
+	SpreadBps       sql.NullInt32  `json:"spread_bps"`
+	Fee             int32          `json:"fee"`
 }
 
 // CreateTransfer: Records a new transfer transaction
@@ -52,6 +68,10 @@ type CreateTransferParams struct {
 //	$3: transfer_amount - Amount transferred
 //	$4: transfer_time - When the transfer occurred
 //	$5: status - Initial status of the transfer
+//	$6: converted_amount - Amount credited to the receiver in its currency
+//	$7: exchange_rate - Rate applied when the cards use different currencies (NULL otherwise)
+//	$8: spread_bps - Spread applied on top of the rate in basis points (NULL otherwise)
+//	$9: fee - Fee charged to the sender on top of transfer_amount
 //
 // Returns:
 //
@@ -60,6 +80,7 @@ type CreateTransferParams struct {
 // Business Logic:
 //   - Sets creation and update timestamps automatically
 //   - Used for recording money movements between accounts
+//   - currency and to_currency are copied from the sender and receiver cards
 func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams) (*Transfer, error) {
 	row := q.db.QueryRowContext(ctx, createTransfer,
 		arg.TransferFrom,
@@ -67,6 +88,10 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		arg.TransferAmount,
 		arg.TransferTime,
 		arg.Status,
+		arg.ConvertedAmount,
+		arg.ExchangeRate,
+		arg.SpreadBps,
+		arg.Fee,
 	)
 	var i Transfer
 	err := row.Scan(
@@ -80,6 +105,12 @@ func (q *Queries) CreateTransfer(ctx context.Context, arg CreateTransferParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.ToCurrency,
+		&i.ConvertedAmount,
+		&i.ExchangeRate,
+		&i.SpreadBps,
+		&i.Fee,
 	)
 	return &i, err
 }
@@ -274,7 +305,7 @@ func (q *Queries) FindAllTransfersByCardNumberAsSender(ctx context.Context, tran
 
 const getActiveTransfers = `-- name: GetActiveTransfers :many
 SELECT
-    transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at,
+    transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee,
     COUNT(*) OVER() AS total_count
 FROM
     transfers
@@ -293,17 +324,23 @@ type GetActiveTransfersParams struct {
 }
 
 type GetActiveTransfersRow struct {
-	TransferID     int32        `json:"transfer_id"`
-	TransferNo     uuid.UUID    `json:"transfer_no"`
-	TransferFrom   string       `json:"transfer_from"`
-	TransferTo     string       `json:"transfer_to"`
-	TransferAmount int32        `json:"transfer_amount"`
-	TransferTime   time.Time    `json:"transfer_time"`
-	Status         string       `json:"status"`
-	CreatedAt      sql.NullTime `json:"created_at"`
-	UpdatedAt      sql.NullTime `json:"updated_at"`
-	DeletedAt      sql.NullTime `json:"deleted_at"`
-	TotalCount     int64        `json:"total_count"`
+	TransferID      int32          `json:"transfer_id"`
+	TransferNo      uuid.UUID      `json:"transfer_no"`
+	TransferFrom    string         `json:"transfer_from"`
+	TransferTo      string         `json:"transfer_to"`
+	TransferAmount  int32          `json:"transfer_amount"`
+	TransferTime    time.Time      `json:"transfer_time"`
+	Status          string         `json:"status"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	UpdatedAt       sql.NullTime   `json:"updated_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	Currency        string         `json:"currency"`
+	ToCurrency      string         `json:"to_currency"`
+	ConvertedAmount int32          `json:"converted_amount"`
+	ExchangeRate    sql.NullString `json:"exchange_rate"`
+	SpreadBps       sql.NullInt32  `json:"spread_bps"`
+	Fee             int32          `json:"fee"`
+	TotalCount      int64          `json:"total_count"`
 }
 
 // GetActiveTransfers: Retrieves paginated active transfers with search
@@ -344,6 +381,12 @@ func (q *Queries) GetActiveTransfers(ctx context.Context, arg GetActiveTransfers
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.ToCurrency,
+			&i.ConvertedAmount,
+			&i.ExchangeRate,
+			&i.SpreadBps,
+			&i.Fee,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -902,24 +945,34 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.transfer_amount), 0)::int AS total_transfer_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyTransferAmountsRow struct {
 	Month               string `json:"month"`
+	Currency            string `json:"currency"`
 	TotalTransferAmount int32  `json:"total_transfer_amount"`
 }
 
@@ -933,6 +986,7 @@ type GetMonthlyTransferAmountsRow struct {
 //
 //	month: 3-letter month abbreviation (e.g., 'Jan')
 //	total_transfer_amount: Sum of transfer amounts in that month
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Generates complete monthly series for the target year
@@ -949,7 +1003,7 @@ func (q *Queries) GetMonthlyTransferAmounts(ctx context.Context, dollar_1 time.T
 	var items []*GetMonthlyTransferAmountsRow
 	for rows.Next() {
 		var i GetMonthlyTransferAmountsRow
-		if err := rows.Scan(&i.Month, &i.TotalTransferAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalTransferAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -970,21 +1024,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
-    COALESCE(SUM(t.transfer_amount), 0)::int AS total_transfer_amount
+    cur.currency,
+    COALESCE(SUM(t.converted_amount), 0)::int AS total_transfer_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.to_currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.transfer_to = $1
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyTransferAmountsByReceiverCardNumberParams struct {
@@ -994,6 +1057,7 @@ type GetMonthlyTransferAmountsByReceiverCardNumberParams struct {
 
 type GetMonthlyTransferAmountsByReceiverCardNumberRow struct {
 	Month               string `json:"month"`
+	Currency            string `json:"currency"`
 	TotalTransferAmount int32  `json:"total_transfer_amount"`
 }
 
@@ -1008,6 +1072,7 @@ type GetMonthlyTransferAmountsByReceiverCardNumberRow struct {
 //
 //	month: 3-letter month abbreviation (e.g., 'Jan')
 //	total_transfer_amount: Sum of transfer amounts in that month
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Generates complete monthly series for the target year
@@ -1024,7 +1089,7 @@ func (q *Queries) GetMonthlyTransferAmountsByReceiverCardNumber(ctx context.Cont
 	var items []*GetMonthlyTransferAmountsByReceiverCardNumberRow
 	for rows.Next() {
 		var i GetMonthlyTransferAmountsByReceiverCardNumberRow
-		if err := rows.Scan(&i.Month, &i.TotalTransferAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalTransferAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1045,21 +1110,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(t.transfer_amount), 0)::int AS total_transfer_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     transfers t ON EXTRACT(MONTH FROM t.transfer_time) = EXTRACT(MONTH FROM m.month)
+    AND t.currency = cur.currency
     AND EXTRACT(YEAR FROM t.transfer_time) = EXTRACT(YEAR FROM m.month)
     AND t.transfer_from = $1
     AND t.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyTransferAmountsBySenderCardNumberParams struct {
@@ -1069,6 +1143,7 @@ type GetMonthlyTransferAmountsBySenderCardNumberParams struct {
 
 type GetMonthlyTransferAmountsBySenderCardNumberRow struct {
 	Month               string `json:"month"`
+	Currency            string `json:"currency"`
 	TotalTransferAmount int32  `json:"total_transfer_amount"`
 }
 
@@ -1083,6 +1158,7 @@ type GetMonthlyTransferAmountsBySenderCardNumberRow struct {
 //
 //	month: 3-letter month abbreviation (e.g., 'Jan')
 //	total_transfer_amount: Sum of transfer amounts in that month
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Generates complete monthly series for the target year
@@ -1099,7 +1175,7 @@ func (q *Queries) GetMonthlyTransferAmountsBySenderCardNumber(ctx context.Contex
 	var items []*GetMonthlyTransferAmountsBySenderCardNumberRow
 	for rows.Next() {
 		var i GetMonthlyTransferAmountsBySenderCardNumberRow
-		if err := rows.Scan(&i.Month, &i.TotalTransferAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalTransferAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1114,7 +1190,7 @@ func (q *Queries) GetMonthlyTransferAmountsBySenderCardNumber(ctx context.Contex
 }
 
 const getTransferByID = `-- name: GetTransferByID :one
-SELECT transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at
+SELECT transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee
 FROM transfers
 WHERE
     transfer_id = $1
@@ -1148,13 +1224,19 @@ func (q *Queries) GetTransferByID(ctx context.Context, transferID int32) (*Trans
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.ToCurrency,
+		&i.ConvertedAmount,
+		&i.ExchangeRate,
+		&i.SpreadBps,
+		&i.Fee,
 	)
 	return &i, err
 }
 
 const getTransfers = `-- name: GetTransfers :many
 SELECT
-    transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at,
+    transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee,
     COUNT(*) OVER() AS total_count
 FROM
     transfers
@@ -1173,17 +1255,23 @@ type GetTransfersParams struct {
 }
 
 type GetTransfersRow struct {
-	TransferID     int32        `json:"transfer_id"`
-	TransferNo     uuid.UUID    `json:"transfer_no"`
-	TransferFrom   string       `json:"transfer_from"`
-	TransferTo     string       `json:"transfer_to"`
-	TransferAmount int32        `json:"transfer_amount"`
-	TransferTime   time.Time    `json:"transfer_time"`
-	Status         string       `json:"status"`
-	CreatedAt      sql.NullTime `json:"created_at"`
-	UpdatedAt      sql.NullTime `json:"updated_at"`
-	DeletedAt      sql.NullTime `json:"deleted_at"`
-	TotalCount     int64        `json:"total_count"`
+	TransferID      int32          `json:"transfer_id"`
+	TransferNo      uuid.UUID      `json:"transfer_no"`
+	TransferFrom    string         `json:"transfer_from"`
+	TransferTo      string         `json:"transfer_to"`
+	TransferAmount  int32          `json:"transfer_amount"`
+	TransferTime    time.Time      `json:"transfer_time"`
+	Status          string         `json:"status"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	UpdatedAt       sql.NullTime   `json:"updated_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	Currency        string         `json:"currency"`
+	ToCurrency      string         `json:"to_currency"`
+	ConvertedAmount int32          `json:"converted_amount"`
+	ExchangeRate    sql.NullString `json:"exchange_rate"`
+	SpreadBps       sql.NullInt32  `json:"spread_bps"`
+	Fee             int32          `json:"fee"`
+	TotalCount      int64          `json:"total_count"`
 }
 
 // GetTransfers: Retrieves paginated transfer records with search capability
@@ -1224,6 +1312,12 @@ func (q *Queries) GetTransfers(ctx context.Context, arg GetTransfersParams) ([]*
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.ToCurrency,
+			&i.ConvertedAmount,
+			&i.ExchangeRate,
+			&i.SpreadBps,
+			&i.Fee,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1240,7 +1334,7 @@ func (q *Queries) GetTransfers(ctx context.Context, arg GetTransfersParams) ([]*
 }
 
 const getTransfersByCardNumber = `-- name: GetTransfersByCardNumber :many
-SELECT transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at
+SELECT transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee
 FROM transfers
 WHERE
     deleted_at IS NULL
@@ -1287,6 +1381,12 @@ func (q *Queries) GetTransfersByCardNumber(ctx context.Context, transferFrom str
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.ToCurrency,
+			&i.ConvertedAmount,
+			&i.ExchangeRate,
+			&i.SpreadBps,
+			&i.Fee,
 		); err != nil {
 			return nil, err
 		}
@@ -1302,7 +1402,7 @@ func (q *Queries) GetTransfersByCardNumber(ctx context.Context, transferFrom str
 }
 
 const getTransfersByDestinationCard = `-- name: GetTransfersByDestinationCard :many
-SELECT transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at
+SELECT transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee
 FROM transfers
 WHERE
     deleted_at IS NULL
@@ -1346,6 +1446,12 @@ func (q *Queries) GetTransfersByDestinationCard(ctx context.Context, transferTo
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.ToCurrency,
+			&i.ConvertedAmount,
+			&i.ExchangeRate,
+			&i.SpreadBps,
+			&i.Fee,
 		); err != nil {
 			return nil, err
 		}
@@ -1361,7 +1467,7 @@ func (q *Queries) GetTransfersByDestinationCard(ctx context.Context, transferTo
 }
 
 const getTransfersBySourceCard = `-- name: GetTransfersBySourceCard :many
-SELECT transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at
+SELECT transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee
 FROM transfers
 WHERE
     deleted_at IS NULL
@@ -1405,6 +1511,12 @@ func (q *Queries) GetTransfersBySourceCard(ctx context.Context, transferFrom str
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.ToCurrency,
+			&i.ConvertedAmount,
+			&i.ExchangeRate,
+			&i.SpreadBps,
+			&i.Fee,
 		); err != nil {
 			return nil, err
 		}
@@ -1420,7 +1532,7 @@ func (q *Queries) GetTransfersBySourceCard(ctx context.Context, transferFrom str
 }
 
 const getTrashedTransferByID = `-- name: GetTrashedTransferByID :one
-SELECT transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at
+SELECT transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee
 FROM transfers
 WHERE
     transfer_id = $1
@@ -1456,13 +1568,19 @@ func (q *Queries) GetTrashedTransferByID(ctx context.Context, transferID int32)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.ToCurrency,
+		&i.ConvertedAmount,
+		&i.ExchangeRate,
+		&i.SpreadBps,
+		&i.Fee,
 	)
 	return &i, err
 }
 
 const getTrashedTransfers = `-- name: GetTrashedTransfers :many
 SELECT
-    transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at,
+    transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee,
     COUNT(*) OVER() AS total_count
 FROM
     transfers
@@ -1481,17 +1599,23 @@ type GetTrashedTransfersParams struct {
 }
 
 type GetTrashedTransfersRow struct {
-	TransferID     int32        `json:"transfer_id"`
-	TransferNo     uuid.UUID    `json:"transfer_no"`
-	TransferFrom   string       `json:"transfer_from"`
-	TransferTo     string       `json:"transfer_to"`
-	TransferAmount int32        `json:"transfer_amount"`
-	TransferTime   time.Time    `json:"transfer_time"`
-	Status         string       `json:"status"`
-	CreatedAt      sql.NullTime `json:"created_at"`
-	UpdatedAt      sql.NullTime `json:"updated_at"`
-	DeletedAt      sql.NullTime `json:"deleted_at"`
-	TotalCount     int64        `json:"total_count"`
+	TransferID      int32          `json:"transfer_id"`
+	TransferNo      uuid.UUID      `json:"transfer_no"`
+	TransferFrom    string         `json:"transfer_from"`
+	TransferTo      string         `json:"transfer_to"`
+	TransferAmount  int32          `json:"transfer_amount"`
+	TransferTime    time.Time      `json:"transfer_time"`
+	Status          string         `json:"status"`
+	CreatedAt       sql.NullTime   `json:"created_at"`
+	UpdatedAt       sql.NullTime   `json:"updated_at"`
+	DeletedAt       sql.NullTime   `json:"deleted_at"`
+	Currency        string         `json:"currency"`
+	ToCurrency      string         `json:"to_currency"`
+	ConvertedAmount int32          `json:"converted_amount"`
+	ExchangeRate    sql.NullString `json:"exchange_rate"`
+	SpreadBps       sql.NullInt32  `json:"spread_bps"`
+	Fee             int32          `json:"fee"`
+	TotalCount      int64          `json:"total_count"`
 }
 
 // GetTrashedTransfers: Retrieves paginated soft-deleted transfers
@@ -1531,6 +1655,12 @@ func (q *Queries) GetTrashedTransfers(ctx context.Context, arg GetTrashedTransfe
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.ToCurrency,
+			&i.ConvertedAmount,
+			&i.ExchangeRate,
+			&i.SpreadBps,
+			&i.Fee,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1549,6 +1679,7 @@ func (q *Queries) GetTrashedTransfers(ctx context.Context, arg GetTrashedTransfe
 const getYearlyTransferAmounts = `-- name: GetYearlyTransferAmounts :many
 SELECT
     EXTRACT(YEAR FROM t.created_at) AS year,
+    t.currency,
     SUM(t.transfer_amount) AS total_transfer_amount
 FROM
     transfers t
@@ -1557,13 +1688,16 @@ WHERE
     AND EXTRACT(YEAR FROM t.created_at) >= $1 - 4
     AND EXTRACT(YEAR FROM t.created_at) <= $1
 GROUP BY
-    EXTRACT(YEAR FROM t.created_at)
+    EXTRACT(YEAR FROM t.created_at),
+    t.currency
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyTransferAmountsRow struct {
 	Year                string `json:"year"`
+	Currency            string `json:"currency"`
 	TotalTransferAmount int64  `json:"total_transfer_amount"`
 }
 
@@ -1577,6 +1711,7 @@ type GetYearlyTransferAmountsRow struct {
 //
 //	year: 4-digit year
 //	total_transfer_amount: Sum of transfer amounts in that year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Covers a 5-year window (current_year - 4 to current_year)
@@ -1592,7 +1727,7 @@ func (q *Queries) GetYearlyTransferAmounts(ctx context.Context, dollar_1 interfa
 	var items []*GetYearlyTransferAmountsRow
 	for rows.Next() {
 		var i GetYearlyTransferAmountsRow
-		if err := rows.Scan(&i.Year, &i.TotalTransferAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalTransferAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1609,7 +1744,8 @@ func (q *Queries) GetYearlyTransferAmounts(ctx context.Context, dollar_1 interfa
 const getYearlyTransferAmountsByReceiverCardNumber = `-- name: GetYearlyTransferAmountsByReceiverCardNumber :many
 SELECT
     EXTRACT(YEAR FROM t.created_at) AS year,
-    SUM(t.transfer_amount) AS total_transfer_amount
+    t.to_currency AS currency,
+    SUM(t.converted_amount) AS total_transfer_amount
 FROM
     transfers t
 WHERE
@@ -1618,9 +1754,11 @@ WHERE
     AND EXTRACT(YEAR FROM t.created_at) >= $2 - 4
     AND EXTRACT(YEAR FROM t.created_at) <= $2
 GROUP BY
-    EXTRACT(YEAR FROM t.created_at)
+    EXTRACT(YEAR FROM t.created_at),
+    t.to_currency
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyTransferAmountsByReceiverCardNumberParams struct {
@@ -1630,6 +1768,7 @@ type GetYearlyTransferAmountsByReceiverCardNumberParams struct {
 
 type GetYearlyTransferAmountsByReceiverCardNumberRow struct {
 	Year                string `json:"year"`
+	Currency            string `json:"currency"`
 	TotalTransferAmount int64  `json:"total_transfer_amount"`
 }
 
@@ -1644,6 +1783,7 @@ type GetYearlyTransferAmountsByReceiverCardNumberRow struct {
 //
 //	year: 4-digit year
 //	total_transfer_amount: Sum of transfer amounts in that year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Covers a 5-year window (current_year - 4 to current_year)
@@ -1659,7 +1799,7 @@ func (q *Queries) GetYearlyTransferAmountsByReceiverCardNumber(ctx context.Conte
 	var items []*GetYearlyTransferAmountsByReceiverCardNumberRow
 	for rows.Next() {
 		var i GetYearlyTransferAmountsByReceiverCardNumberRow
-		if err := rows.Scan(&i.Year, &i.TotalTransferAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalTransferAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1676,6 +1816,7 @@ func (q *Queries) GetYearlyTransferAmountsByReceiverCardNumber(ctx context.Conte
 const getYearlyTransferAmountsBySenderCardNumber = `-- name: GetYearlyTransferAmountsBySenderCardNumber :many
 SELECT
     EXTRACT(YEAR FROM t.created_at) AS year,
+    t.currency,
     SUM(t.transfer_amount) AS total_transfer_amount
 FROM
     transfers t
@@ -1685,9 +1826,11 @@ WHERE
     AND EXTRACT(YEAR FROM t.created_at) >= $2 - 4
     AND EXTRACT(YEAR FROM t.created_at) <= $2
 GROUP BY
-    EXTRACT(YEAR FROM t.created_at)
+    EXTRACT(YEAR FROM t.created_at),
+    t.currency
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyTransferAmountsBySenderCardNumberParams struct {
@@ -1697,6 +1840,7 @@ type GetYearlyTransferAmountsBySenderCardNumberParams struct {
 
 type GetYearlyTransferAmountsBySenderCardNumberRow struct {
 	Year                string `json:"year"`
+	Currency            string `json:"currency"`
 	TotalTransferAmount int64  `json:"total_transfer_amount"`
 }
 
@@ -1711,6 +1855,7 @@ type GetYearlyTransferAmountsBySenderCardNumberRow struct {
 //
 //	year: 4-digit year
 //	total_transfer_amount: Sum of transfer amounts in that year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Covers a 5-year window (current_year - 4 to current_year)
@@ -1726,7 +1871,7 @@ func (q *Queries) GetYearlyTransferAmountsBySenderCardNumber(ctx context.Context
 	var items []*GetYearlyTransferAmountsBySenderCardNumberRow
 	for rows.Next() {
 		var i GetYearlyTransferAmountsBySenderCardNumberRow
-		if err := rows.Scan(&i.Year, &i.TotalTransferAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalTransferAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -2188,7 +2333,7 @@ SET
 WHERE
     transfer_id = $1
     AND deleted_at IS NOT NULL
-RETURNING transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at
+RETURNING transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee
 `
 
 // RestoreTransfer: Recovers a soft-deleted transfer
@@ -2214,6 +2359,12 @@ func (q *Queries) RestoreTransfer(ctx context.Context, transferID int32) (*Trans
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.ToCurrency,
+		&i.ConvertedAmount,
+		&i.ExchangeRate,
+		&i.SpreadBps,
+		&i.Fee,
 	)
 	return &i, err
 }
@@ -2225,7 +2376,7 @@ SET
 WHERE
     transfer_id = $1
     AND deleted_at IS NULL
-RETURNING transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at
+RETURNING transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee
 `
 
 // TrashTransfer: Soft-deletes a transfer
@@ -2252,6 +2403,12 @@ func (q *Queries) TrashTransfer(ctx context.Context, transferID int32) (*Transfe
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.ToCurrency,
+		&i.ConvertedAmount,
+		&i.ExchangeRate,
+		&i.SpreadBps,
+		&i.Fee,
 	)
 	return &i, err
 }
@@ -2259,46 +2416,46 @@ func (q *Queries) TrashTransfer(ctx context.Context, transferID int32) (*Transfe
 const updateTransfer = `-- name: UpdateTransfer :one
 UPDATE transfers
 SET
-    transfer_from = $2,
-    transfer_to = $3,
-    transfer_amount = $4,
-    transfer_time = $5,
+    transfer_amount = $2,
+    converted_amount = $2,
+    transfer_time = $3,
+    fee = $4,
     updated_at = current_timestamp
 WHERE
     transfer_id = $1
     AND deleted_at IS NULL
-RETURNING transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at
+    AND exchange_rate IS NULL
+RETURNING transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee
 `
 
 type UpdateTransferParams struct {
 	TransferID     int32     `json:"transfer_id"`
-	TransferFrom   string    `json:"transfer_from"`
-	TransferTo     string    `json:"transfer_to"`
 	TransferAmount int32     `json:"transfer_amount"`
 	TransferTime   time.Time `json:"transfer_time"`
+	Fee            int32     `json:"fee"`
 }
 
 // UpdateTransfer: Modifies transfer details
-// Purpose: Update all fields of an existing transfer
+// Purpose: Update the amount and time of an existing transfer
 // Parameters:
 //
 //	$1: transfer_id - ID of transfer to update
-//	$2: transfer_from - Updated source account
-//	$3: transfer_to - Updated destination account
-//	$4: transfer_amount - Updated amount
-//	$5: transfer_time - Updated timestamp
+//	$2: transfer_amount - Updated amount
+//	$3: transfer_time - Updated timestamp
+//	$4: fee - Fee quoted on the updated amount
 //
 // Business Logic:
 //   - Only updates active transfers (non-deleted)
 //   - Updates modification timestamp automatically
 //   - Used for correcting transfer details
+//   - The cards are kept, as the ledger postings of the transfer stay on them
+//   - Transfers that crossed currencies keep their recorded conversion
 func (q *Queries) UpdateTransfer(ctx context.Context, arg UpdateTransferParams) (*Transfer, error) {
 	row := q.db.QueryRowContext(ctx, updateTransfer,
 		arg.TransferID,
-		arg.TransferFrom,
-		arg.TransferTo,
 		arg.TransferAmount,
 		arg.TransferTime,
+		arg.Fee,
 	)
 	var i Transfer
 	err := row.Scan(
@@ -2312,6 +2469,12 @@ func (q *Queries) UpdateTransfer(ctx context.Context, arg UpdateTransferParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.ToCurrency,
+		&i.ConvertedAmount,
+		&i.ExchangeRate,
+		&i.SpreadBps,
+		&i.Fee,
 	)
 	return &i, err
 }
@@ -2320,12 +2483,14 @@ const updateTransferAmount = `-- name: UpdateTransferAmount :one
 UPDATE transfers
 SET
     transfer_amount = $2,
+    converted_amount = $2,
     transfer_time = current_timestamp,
     updated_at = current_timestamp
 WHERE
     transfer_id = $1
     AND deleted_at IS NULL
-RETURNING transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at
+    AND exchange_rate IS NULL
+RETURNING transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee
 `
 
 type UpdateTransferAmountParams struct {
@@ -2344,6 +2509,7 @@ type UpdateTransferAmountParams struct {
 //   - Only updates active transfers
 //   - Updates both amount and transfer timestamp
 //   - Used for amount corrections
+//   - Transfers that crossed currencies keep their recorded conversion
 func (q *Queries) UpdateTransferAmount(ctx context.Context, arg UpdateTransferAmountParams) (*Transfer, error) {
 	row := q.db.QueryRowContext(ctx, updateTransferAmount, arg.TransferID, arg.TransferAmount)
 	var i Transfer
@@ -2358,39 +2524,71 @@ func (q *Queries) UpdateTransferAmount(ctx context.Context, arg UpdateTransferAm
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.ToCurrency,
+		&i.ConvertedAmount,
+		&i.ExchangeRate,
+		&i.SpreadBps,
+		&i.Fee,
 	)
 	return &i, err
 }
 
 const updateTransferStatus = `-- name: UpdateTransferStatus :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT 'transfer', transfer_id, status, $1, current_timestamp
+        FROM transfers
+        WHERE
+            transfer_id = $2
+            AND status = $3
+            AND deleted_at IS NULL
+        FOR UPDATE
+    )
 UPDATE transfers
 SET
-    status = $2,
+    status = $1,
     updated_at = current_timestamp
 WHERE
-    transfer_id = $1
+    transfer_id = $2
+    AND status = $3
     AND deleted_at IS NULL
-RETURNING transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at
+RETURNING transfer_id, transfer_no, transfer_from, transfer_to, transfer_amount, transfer_time, status, created_at, updated_at, deleted_at, currency, to_currency, converted_amount, exchange_rate, spread_bps, fee
 `
 
 type UpdateTransferStatusParams struct {
-	TransferID int32  `json:"transfer_id"`
 	Status     string `json:"status"`
+	TransferID int32  `json:"transfer_id"`
+	FromStatus string `json:"from_status"`
 }
 
-// UpdateTransferStatus: Changes transfer status
-// Purpose: Update processing status of a transfer
+// UpdateTransferStatus: Moves a transfer from one status to another
+// Purpose: Record the outcome of a transfer together with its status history
 // Parameters:
 //
-//	$1: transfer_id - ID of transfer to update
-//	$2: status - New status (e.g., 'completed', 'failed')
+//	$1: status - New status
+//	$2: transfer_id - ID of the transfer
+//	$3: from_status - Status the transfer is expected to be in
+//
+// Returns:
+//
+//	The updated transfer, or no row when it is no longer in from_status
 //
 // Business Logic:
-//   - Only updates active transfers
-//   - Updates modification timestamp
-//   - Used to reflect transfer processing outcomes
+//   - Only updates active (non-deleted) records
+//   - Allowed transitions are checked against pkg/statemachine by the caller
+//   - The status history row is written by the same statement, and only
+//     when the row was still in from_status
 func (q *Queries) UpdateTransferStatus(ctx context.Context, arg UpdateTransferStatusParams) (*Transfer, error) {
-	row := q.db.QueryRowContext(ctx, updateTransferStatus, arg.TransferID, arg.Status)
+	row := q.db.QueryRowContext(ctx, updateTransferStatus, arg.Status, arg.TransferID, arg.FromStatus)
 	var i Transfer
 	err := row.Scan(
 		&i.TransferID,
@@ -2403,6 +2601,12 @@ func (q *Queries) UpdateTransferStatus(ctx context.Context, arg UpdateTransferSt
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.ToCurrency,
+		&i.ConvertedAmount,
+		&i.ExchangeRate,
+		&i.SpreadBps,
+		&i.Fee,
 	)
 	return &i, err
 }
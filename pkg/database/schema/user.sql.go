@@ -27,7 +27,7 @@ VALUES (
         $4,
         current_timestamp,
         current_timestamp
-    ) RETURNING user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at
+    ) RETURNING user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at, tier
 `
 
 type CreateUserParams struct {
@@ -68,6 +68,7 @@ func (q *Queries) CreateUser(ctx context.Context, arg CreateUserParams) (*User,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Tier,
 	)
 	return &i, err
 }
@@ -107,7 +108,7 @@ func (q *Queries) DeleteUserPermanently(ctx context.Context, userID int32) error
 
 const getActiveUsersWithPagination = `-- name: GetActiveUsersWithPagination :many
 SELECT
-    user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at,
+    user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at, tier,
     COUNT(*) OVER() AS total_count
 FROM users
 WHERE deleted_at IS NULL
@@ -131,6 +132,7 @@ type GetActiveUsersWithPaginationRow struct {
 	CreatedAt  sql.NullTime `json:"created_at"`
 	UpdatedAt  sql.NullTime `json:"updated_at"`
 	DeletedAt  sql.NullTime `json:"deleted_at"`
+	Tier       string       `json:"tier"`
 	TotalCount int64        `json:"total_count"`
 }
 
@@ -169,6 +171,7 @@ func (q *Queries) GetActiveUsersWithPagination(ctx context.Context, arg GetActiv
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Tier,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -185,7 +188,7 @@ func (q *Queries) GetActiveUsersWithPagination(ctx context.Context, arg GetActiv
 }
 
 const getTrashedUserByID = `-- name: GetTrashedUserByID :one
-SELECT user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at
+SELECT user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at, tier
 FROM users
 WHERE
     user_id = $1
@@ -216,13 +219,14 @@ func (q *Queries) GetTrashedUserByID(ctx context.Context, userID int32) (*User,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Tier,
 	)
 	return &i, err
 }
 
 const getTrashedUsersWithPagination = `-- name: GetTrashedUsersWithPagination :many
 SELECT
-    user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at,
+    user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at, tier,
     COUNT(*) OVER() AS total_count
 FROM users
 WHERE deleted_at IS NOT NULL
@@ -246,6 +250,7 @@ type GetTrashedUsersWithPaginationRow struct {
 	CreatedAt  sql.NullTime `json:"created_at"`
 	UpdatedAt  sql.NullTime `json:"updated_at"`
 	DeletedAt  sql.NullTime `json:"deleted_at"`
+	Tier       string       `json:"tier"`
 	TotalCount int64        `json:"total_count"`
 }
 
@@ -284,6 +289,7 @@ func (q *Queries) GetTrashedUsersWithPagination(ctx context.Context, arg GetTras
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Tier,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -300,7 +306,7 @@ func (q *Queries) GetTrashedUsersWithPagination(ctx context.Context, arg GetTras
 }
 
 const getUserByEmail = `-- name: GetUserByEmail :one
-SELECT user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at FROM users WHERE email = $1 AND deleted_at IS NULL
+SELECT user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at, tier FROM users WHERE email = $1 AND deleted_at IS NULL
 `
 
 // GetUserByEmail: Retrieve a user by their email
@@ -327,12 +333,13 @@ func (q *Queries) GetUserByEmail(ctx context.Context, email string) (*User, erro
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Tier,
 	)
 	return &i, err
 }
 
 const getUserByID = `-- name: GetUserByID :one
-SELECT user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at FROM users WHERE user_id = $1 AND deleted_at IS NULL
+SELECT user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at, tier FROM users WHERE user_id = $1 AND deleted_at IS NULL
 `
 
 // GetUserByID: Retrieve a user by their ID
@@ -359,13 +366,14 @@ func (q *Queries) GetUserByID(ctx context.Context, userID int32) (*User, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Tier,
 	)
 	return &i, err
 }
 
 const getUsersWithPagination = `-- name: GetUsersWithPagination :many
 SELECT
-    user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at,
+    user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at, tier,
     COUNT(*) OVER() AS total_count
 FROM users
 WHERE deleted_at IS NULL
@@ -389,6 +397,7 @@ type GetUsersWithPaginationRow struct {
 	CreatedAt  sql.NullTime `json:"created_at"`
 	UpdatedAt  sql.NullTime `json:"updated_at"`
 	DeletedAt  sql.NullTime `json:"deleted_at"`
+	Tier       string       `json:"tier"`
 	TotalCount int64        `json:"total_count"`
 }
 
@@ -426,6 +435,7 @@ func (q *Queries) GetUsersWithPagination(ctx context.Context, arg GetUsersWithPa
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Tier,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -465,7 +475,7 @@ SET
 WHERE
     user_id = $1
     AND deleted_at IS NOT NULL
-    RETURNING user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at
+    RETURNING user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at, tier
 `
 
 // RestoreUser: Recovers a soft-deleted user
@@ -492,12 +502,13 @@ func (q *Queries) RestoreUser(ctx context.Context, userID int32) (*User, error)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Tier,
 	)
 	return &i, err
 }
 
 const searchUsersByEmail = `-- name: SearchUsersByEmail :many
-SELECT user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at
+SELECT user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at, tier
 FROM users
 WHERE
     deleted_at IS NULL
@@ -536,6 +547,7 @@ func (q *Queries) SearchUsersByEmail(ctx context.Context, dollar_1 sql.NullStrin
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Tier,
 		); err != nil {
 			return nil, err
 		}
@@ -557,7 +569,7 @@ SET
 WHERE
     user_id = $1
     AND deleted_at IS NULL
-    RETURNING user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at
+    RETURNING user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at, tier
 `
 
 // TrashUser: Soft-deletes a user account
@@ -584,6 +596,7 @@ func (q *Queries) TrashUser(ctx context.Context, userID int32) (*User, error) {
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Tier,
 	)
 	return &i, err
 }
@@ -599,7 +612,7 @@ SET
 WHERE
     user_id = $1
     AND deleted_at IS NULL
-    RETURNING user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at
+    RETURNING user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at, tier
 `
 
 type UpdateUserParams struct {
@@ -644,6 +657,51 @@ func (q *Queries) UpdateUser(ctx context.Context, arg UpdateUserParams) (*User,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Tier,
+	)
+	return &i, err
+}
+
+const updateUserTier = `-- name: UpdateUserTier :one
+UPDATE users
+SET
+    tier = $2,
+    updated_at = current_timestamp
+WHERE
+    user_id = $1
+    AND deleted_at IS NULL
+    RETURNING user_id, firstname, lastname, email, password, created_at, updated_at, deleted_at, tier
+`
+
+type UpdateUserTierParams struct {
+	UserID int32  `json:"user_id"`
+	Tier   string `json:"tier"`
+}
+
+// UpdateUserTier: Moves a user to another tier
+// Purpose: Change which transaction limits apply to the user's cards
+// Parameters:
+//
+//	$1: user_id - ID of user to update
+//	$2: tier - standard, premium or business
+//
+// Returns: Updated user record
+// Business Logic:
+//   - Only modifies active (non-deleted) users
+//   - New limits apply from the next operation on
+func (q *Queries) UpdateUserTier(ctx context.Context, arg UpdateUserTierParams) (*User, error) {
+	row := q.db.QueryRowContext(ctx, updateUserTier, arg.UserID, arg.Tier)
+	var i User
+	err := row.Scan(
+		&i.UserID,
+		&i.Firstname,
+		&i.Lastname,
+		&i.Email,
+		&i.Password,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Tier,
 	)
 	return &i, err
 }
@@ -19,6 +19,9 @@ INSERT INTO
         card_number,
         withdraw_amount,
         withdraw_time,
+        currency,
+        fee,
+        payout_provider,
         created_at,
         updated_at
     )
@@ -26,9 +29,12 @@ VALUES (
         $1,
         $2,
         $3,
+        (SELECT c.currency FROM cards c WHERE c.card_number = $1),
+        $5,
+        $6,
         $4,
         current_timestamp
-    ) RETURNING withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at
+    ) RETURNING withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider
 `
 
 type CreateWithdrawParams struct {
@@ -36,6 +42,8 @@ type CreateWithdrawParams struct {
 	WithdrawAmount int32        `json:"withdraw_amount"`
 	WithdrawTime   time.Time    `json:"withdraw_time"`
 	CreatedAt      sql.NullTime `json:"created_at"`
+	Fee            int32        `json:"fee"`
+	PayoutProvider string       `json:"payout_provider"`
 }
 
 // CreateWithdraw: Records a new cash withdrawal
@@ -46,6 +54,8 @@ type CreateWithdrawParams struct {
 //	$2: withdraw_amount - The amount withdrawn
 //	$3: withdraw_time - When the withdrawal occurred
 //	$4: created_at - Timestamp of record creation
+//	$5: fee - Fee charged to the card on top of withdraw_amount
+//	$6: payout_provider - Provider expected to report the payout outcome
 //
 // Returns:
 //
@@ -55,12 +65,15 @@ type CreateWithdrawParams struct {
 //   - Sets creation and update timestamps automatically
 //   - Used for recording ATM/branch cash withdrawals
 //   - Typically triggered after successful cash dispense
+//   - The amount is recorded in the currency of the card
 func (q *Queries) CreateWithdraw(ctx context.Context, arg CreateWithdrawParams) (*Withdraw, error) {
 	row := q.db.QueryRowContext(ctx, createWithdraw,
 		arg.CardNumber,
 		arg.WithdrawAmount,
 		arg.WithdrawTime,
 		arg.CreatedAt,
+		arg.Fee,
+		arg.PayoutProvider,
 	)
 	var i Withdraw
 	err := row.Scan(
@@ -73,6 +86,9 @@ func (q *Queries) CreateWithdraw(ctx context.Context, arg CreateWithdrawParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
+		&i.PayoutProvider,
 	)
 	return &i, err
 }
@@ -192,7 +208,7 @@ func (q *Queries) FindAllWithdrawsByCardNumber(ctx context.Context, cardNumber s
 
 const getActiveWithdraws = `-- name: GetActiveWithdraws :many
 SELECT
-    withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at,
+    withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider,
     COUNT(*) OVER() AS total_count
 FROM
     withdraws
@@ -225,6 +241,9 @@ type GetActiveWithdrawsRow struct {
 	CreatedAt      sql.NullTime `json:"created_at"`
 	UpdatedAt      sql.NullTime `json:"updated_at"`
 	DeletedAt      sql.NullTime `json:"deleted_at"`
+	Currency       string       `json:"currency"`
+	Fee            int32        `json:"fee"`
+	PayoutProvider string       `json:"payout_provider"`
 	TotalCount     int64        `json:"total_count"`
 }
 
@@ -265,6 +284,9 @@ func (q *Queries) GetActiveWithdraws(ctx context.Context, arg GetActiveWithdraws
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.Fee,
+			&i.PayoutProvider,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -799,24 +821,34 @@ WITH months AS (
         date_trunc('year', $1::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT DISTINCT currency FROM cards
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(w.withdraw_amount), 0)::int AS total_withdraw_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     withdraws w ON EXTRACT(MONTH FROM w.withdraw_time) = EXTRACT(MONTH FROM m.month)
+    AND w.currency = cur.currency
     AND EXTRACT(YEAR FROM w.withdraw_time) = EXTRACT(YEAR FROM m.month)
     AND w.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyWithdrawsRow struct {
 	Month               string `json:"month"`
+	Currency            string `json:"currency"`
 	TotalWithdrawAmount int32  `json:"total_withdraw_amount"`
 }
 
@@ -830,6 +862,7 @@ type GetMonthlyWithdrawsRow struct {
 //
 //	month: 3-letter month abbreviation (e.g., 'Jan')
 //	total_withdraw_amount: Sum of withdrawal amounts for that month (0 if no withdrawals)
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Generates complete monthly series for the specified year
@@ -847,7 +880,7 @@ func (q *Queries) GetMonthlyWithdraws(ctx context.Context, dollar_1 time.Time) (
 	var items []*GetMonthlyWithdrawsRow
 	for rows.Next() {
 		var i GetMonthlyWithdrawsRow
-		if err := rows.Scan(&i.Month, &i.TotalWithdrawAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalWithdrawAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -868,21 +901,30 @@ WITH months AS (
         date_trunc('year', $2::timestamp) + interval '1 year' - interval '1 day',
         interval '1 month'
     ) AS month
+),
+currencies AS (
+    SELECT currency FROM cards WHERE card_number = $1
 )
 SELECT
     TO_CHAR(m.month, 'Mon') AS month,
+    cur.currency,
     COALESCE(SUM(w.withdraw_amount), 0)::int AS total_withdraw_amount
 FROM
     months m
+CROSS JOIN
+    currencies cur
 LEFT JOIN
     withdraws w ON EXTRACT(MONTH FROM w.withdraw_time) = EXTRACT(MONTH FROM m.month)
+    AND w.currency = cur.currency
     AND EXTRACT(YEAR FROM w.withdraw_time) = EXTRACT(YEAR FROM m.month)
     AND w.card_number = $1
     AND w.deleted_at IS NULL
 GROUP BY
-    m.month
+    m.month,
+    cur.currency
 ORDER BY
-    m.month
+    m.month,
+    cur.currency
 `
 
 type GetMonthlyWithdrawsByCardNumberParams struct {
@@ -892,6 +934,7 @@ type GetMonthlyWithdrawsByCardNumberParams struct {
 
 type GetMonthlyWithdrawsByCardNumberRow struct {
 	Month               string `json:"month"`
+	Currency            string `json:"currency"`
 	TotalWithdrawAmount int32  `json:"total_withdraw_amount"`
 }
 
@@ -906,6 +949,7 @@ type GetMonthlyWithdrawsByCardNumberRow struct {
 //
 //	month: 3-letter month abbreviation
 //	total_withdraw_amount: Sum of withdrawals for that card by month
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Generates complete monthly series for the year
@@ -922,7 +966,65 @@ func (q *Queries) GetMonthlyWithdrawsByCardNumber(ctx context.Context, arg GetMo
 	var items []*GetMonthlyWithdrawsByCardNumberRow
 	for rows.Next() {
 		var i GetMonthlyWithdrawsByCardNumberRow
-		if err := rows.Scan(&i.Month, &i.TotalWithdrawAmount); err != nil {
+		if err := rows.Scan(&i.Month, &i.Currency, &i.TotalWithdrawAmount); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getSettleableWithdraws = `-- name: GetSettleableWithdraws :many
+SELECT withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider
+FROM withdraws
+WHERE
+    status IN ('approved', 'processing')
+    AND deleted_at IS NULL
+ORDER BY updated_at, withdraw_id
+LIMIT $1
+`
+
+// GetSettleableWithdraws: Retrieves approved withdraws that still have to be paid out
+// Purpose: Feed the withdraw settlement job
+// Parameters:
+//
+//	$1: row_limit - Maximum number of withdraws to return
+//
+// Returns:
+//
+//	Approved and processing withdraws, oldest decision first
+//
+// Business Logic:
+//   - A withdraw left in 'processing' was interrupted and is settled again
+func (q *Queries) GetSettleableWithdraws(ctx context.Context, rowLimit int32) ([]*Withdraw, error) {
+	rows, err := q.db.QueryContext(ctx, getSettleableWithdraws, rowLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []*Withdraw
+	for rows.Next() {
+		var i Withdraw
+		if err := rows.Scan(
+			&i.WithdrawID,
+			&i.WithdrawNo,
+			&i.CardNumber,
+			&i.WithdrawAmount,
+			&i.WithdrawTime,
+			&i.Status,
+			&i.CreatedAt,
+			&i.UpdatedAt,
+			&i.DeletedAt,
+			&i.Currency,
+			&i.Fee,
+			&i.PayoutProvider,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -937,7 +1039,7 @@ func (q *Queries) GetMonthlyWithdrawsByCardNumber(ctx context.Context, arg GetMo
 }
 
 const getTrashedWithdrawByID = `-- name: GetTrashedWithdrawByID :one
-SELECT withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at
+SELECT withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider
 FROM withdraws
 WHERE
     withdraw_id = $1
@@ -970,13 +1072,16 @@ func (q *Queries) GetTrashedWithdrawByID(ctx context.Context, withdrawID int32)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
+		&i.PayoutProvider,
 	)
 	return &i, err
 }
 
 const getTrashedWithdraws = `-- name: GetTrashedWithdraws :many
 SELECT
-    withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at,
+    withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider,
     COUNT(*) OVER() AS total_count
 FROM
     withdraws
@@ -1009,6 +1114,9 @@ type GetTrashedWithdrawsRow struct {
 	CreatedAt      sql.NullTime `json:"created_at"`
 	UpdatedAt      sql.NullTime `json:"updated_at"`
 	DeletedAt      sql.NullTime `json:"deleted_at"`
+	Currency       string       `json:"currency"`
+	Fee            int32        `json:"fee"`
+	PayoutProvider string       `json:"payout_provider"`
 	TotalCount     int64        `json:"total_count"`
 }
 
@@ -1048,6 +1156,9 @@ func (q *Queries) GetTrashedWithdraws(ctx context.Context, arg GetTrashedWithdra
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.Fee,
+			&i.PayoutProvider,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1064,7 +1175,7 @@ func (q *Queries) GetTrashedWithdraws(ctx context.Context, arg GetTrashedWithdra
 }
 
 const getWithdrawByID = `-- name: GetWithdrawByID :one
-SELECT withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at
+SELECT withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider
 FROM withdraws
 WHERE
     withdraw_id = $1
@@ -1097,13 +1208,92 @@ func (q *Queries) GetWithdrawByID(ctx context.Context, withdrawID int32) (*Withd
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
+		&i.PayoutProvider,
+	)
+	return &i, err
+}
+
+const getWithdrawByNo = `-- name: GetWithdrawByNo :one
+SELECT withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider
+FROM withdraws
+WHERE
+    withdraw_no = $1
+    AND deleted_at IS NULL
+`
+
+// GetWithdrawByNo: Retrieves a single withdrawal by its withdraw number
+// Purpose: Find the withdrawal a payout callback is about
+// Parameters:
+//
+//	$1: withdraw_no - The public number of the withdrawal
+//
+// Returns:
+//
+//	All fields for the specified withdrawal or NULL if not found/deleted
+func (q *Queries) GetWithdrawByNo(ctx context.Context, withdrawNo uuid.UUID) (*Withdraw, error) {
+	row := q.db.QueryRowContext(ctx, getWithdrawByNo, withdrawNo)
+	var i Withdraw
+	err := row.Scan(
+		&i.WithdrawID,
+		&i.WithdrawNo,
+		&i.CardNumber,
+		&i.WithdrawAmount,
+		&i.WithdrawTime,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
+		&i.PayoutProvider,
+	)
+	return &i, err
+}
+
+const getWithdrawByNoForUpdate = `-- name: GetWithdrawByNoForUpdate :one
+SELECT withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider
+FROM withdraws
+WHERE
+    withdraw_no = $1
+    AND deleted_at IS NULL
+FOR UPDATE
+`
+
+// GetWithdrawByNoForUpdate: Locks a single withdrawal by its withdraw number
+// Purpose: Apply a payout callback without racing another delivery of it
+// Parameters:
+//
+//	$1: withdraw_no - The public number of the withdrawal
+//
+// Returns:
+//
+//	The withdrawal, locked until the surrounding transaction ends, or no row
+//	if not found/deleted
+func (q *Queries) GetWithdrawByNoForUpdate(ctx context.Context, withdrawNo uuid.UUID) (*Withdraw, error) {
+	row := q.db.QueryRowContext(ctx, getWithdrawByNoForUpdate, withdrawNo)
+	var i Withdraw
+	err := row.Scan(
+		&i.WithdrawID,
+		&i.WithdrawNo,
+		&i.CardNumber,
+		&i.WithdrawAmount,
+		&i.WithdrawTime,
+		&i.Status,
+		&i.CreatedAt,
+		&i.UpdatedAt,
+		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
+		&i.PayoutProvider,
 	)
 	return &i, err
 }
 
 const getWithdraws = `-- name: GetWithdraws :many
 SELECT
-    withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at,
+    withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider,
     COUNT(*) OVER() AS total_count
 FROM
     withdraws
@@ -1136,6 +1326,9 @@ type GetWithdrawsRow struct {
 	CreatedAt      sql.NullTime `json:"created_at"`
 	UpdatedAt      sql.NullTime `json:"updated_at"`
 	DeletedAt      sql.NullTime `json:"deleted_at"`
+	Currency       string       `json:"currency"`
+	Fee            int32        `json:"fee"`
+	PayoutProvider string       `json:"payout_provider"`
 	TotalCount     int64        `json:"total_count"`
 }
 
@@ -1180,6 +1373,9 @@ func (q *Queries) GetWithdraws(ctx context.Context, arg GetWithdrawsParams) ([]*
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.Fee,
+			&i.PayoutProvider,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1197,7 +1393,7 @@ func (q *Queries) GetWithdraws(ctx context.Context, arg GetWithdrawsParams) ([]*
 
 const getWithdrawsByCardNumber = `-- name: GetWithdrawsByCardNumber :many
 SELECT
-    withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at,
+    withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider,
     COUNT(*) OVER() AS total_count
 FROM
     withdraws
@@ -1232,6 +1428,9 @@ type GetWithdrawsByCardNumberRow struct {
 	CreatedAt      sql.NullTime `json:"created_at"`
 	UpdatedAt      sql.NullTime `json:"updated_at"`
 	DeletedAt      sql.NullTime `json:"deleted_at"`
+	Currency       string       `json:"currency"`
+	Fee            int32        `json:"fee"`
+	PayoutProvider string       `json:"payout_provider"`
 	TotalCount     int64        `json:"total_count"`
 }
 
@@ -1281,6 +1480,9 @@ func (q *Queries) GetWithdrawsByCardNumber(ctx context.Context, arg GetWithdraws
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
+			&i.Currency,
+			&i.Fee,
+			&i.PayoutProvider,
 			&i.TotalCount,
 		); err != nil {
 			return nil, err
@@ -1701,6 +1903,7 @@ func (q *Queries) GetYearlyWithdrawStatusSuccessCardNumber(ctx context.Context,
 const getYearlyWithdraws = `-- name: GetYearlyWithdraws :many
 SELECT
     EXTRACT(YEAR FROM w.withdraw_time) AS year,
+    w.currency,
     SUM(w.withdraw_amount) AS total_withdraw_amount
 FROM
     withdraws w
@@ -1709,13 +1912,16 @@ WHERE
     AND EXTRACT(YEAR FROM w.withdraw_time) >= $1 - 4
     AND EXTRACT(YEAR FROM w.withdraw_time) <= $1
 GROUP BY
-    EXTRACT(YEAR FROM w.withdraw_time)
+    EXTRACT(YEAR FROM w.withdraw_time),
+    w.currency
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyWithdrawsRow struct {
 	Year                string `json:"year"`
+	Currency            string `json:"currency"`
 	TotalWithdrawAmount int64  `json:"total_withdraw_amount"`
 }
 
@@ -1729,6 +1935,7 @@ type GetYearlyWithdrawsRow struct {
 //
 //	year: 4-digit year
 //	total_withdraw_amount: Sum of withdrawal amounts for that year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Covers a 5-year rolling window (current_year-4 to current_year)
@@ -1745,7 +1952,7 @@ func (q *Queries) GetYearlyWithdraws(ctx context.Context, dollar_1 interface{})
 	var items []*GetYearlyWithdrawsRow
 	for rows.Next() {
 		var i GetYearlyWithdrawsRow
-		if err := rows.Scan(&i.Year, &i.TotalWithdrawAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalWithdrawAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1762,6 +1969,7 @@ func (q *Queries) GetYearlyWithdraws(ctx context.Context, dollar_1 interface{})
 const getYearlyWithdrawsByCardNumber = `-- name: GetYearlyWithdrawsByCardNumber :many
 SELECT
     EXTRACT(YEAR FROM w.created_at) AS year,
+    w.currency,
     SUM(w.withdraw_amount) AS total_withdraw_amount
 FROM
     withdraws w
@@ -1771,9 +1979,11 @@ WHERE
     AND EXTRACT(YEAR FROM w.created_at) >= $2 - 4
     AND EXTRACT(YEAR FROM w.created_at) <= $2
 GROUP BY
-    EXTRACT(YEAR FROM w.created_at)
+    EXTRACT(YEAR FROM w.created_at),
+    w.currency
 ORDER BY
-    year
+    year,
+    currency
 `
 
 type GetYearlyWithdrawsByCardNumberParams struct {
@@ -1783,6 +1993,7 @@ type GetYearlyWithdrawsByCardNumberParams struct {
 
 type GetYearlyWithdrawsByCardNumberRow struct {
 	Year                string `json:"year"`
+	Currency            string `json:"currency"`
 	TotalWithdrawAmount int64  `json:"total_withdraw_amount"`
 }
 
@@ -1797,6 +2008,7 @@ type GetYearlyWithdrawsByCardNumberRow struct {
 //
 //	year: 4-digit year
 //	total_withdraw_amount: Sum of withdrawals for that card by year
+//	currency: Currency the totals are expressed in
 //
 // Business Logic:
 //   - Covers 5-year period (current_year-4 to current_year)
@@ -1814,7 +2026,7 @@ func (q *Queries) GetYearlyWithdrawsByCardNumber(ctx context.Context, arg GetYea
 	var items []*GetYearlyWithdrawsByCardNumberRow
 	for rows.Next() {
 		var i GetYearlyWithdrawsByCardNumberRow
-		if err := rows.Scan(&i.Year, &i.TotalWithdrawAmount); err != nil {
+		if err := rows.Scan(&i.Year, &i.Currency, &i.TotalWithdrawAmount); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1855,7 +2067,7 @@ SET
 WHERE
     withdraw_id = $1
     AND deleted_at IS NOT NULL
-RETURNING withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at
+RETURNING withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider
 `
 
 // RestoreWithdraw: Recovers a soft-deleted withdrawal
@@ -1881,6 +2093,9 @@ func (q *Queries) RestoreWithdraw(ctx context.Context, withdrawID int32) (*Withd
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
+		&i.PayoutProvider,
 	)
 	return &i, err
 }
@@ -1892,7 +2107,7 @@ SET
 WHERE
     withdraw_id = $1
     AND deleted_at IS NULL
-RETURNING withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at
+RETURNING withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider
 `
 
 // TrashWithdraw: Soft-deletes a withdrawal record
@@ -1919,6 +2134,9 @@ func (q *Queries) TrashWithdraw(ctx context.Context, withdrawID int32) (*Withdra
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
+		&i.PayoutProvider,
 	)
 	return &i, err
 }
@@ -1926,21 +2144,21 @@ func (q *Queries) TrashWithdraw(ctx context.Context, withdrawID int32) (*Withdra
 const updateWithdraw = `-- name: UpdateWithdraw :one
 UPDATE withdraws
 SET
-    card_number = $2,
-    withdraw_amount = $3,
-    withdraw_time = $4,
+    withdraw_amount = $2,
+    withdraw_time = $3,
+    fee = $4,
     updated_at = current_timestamp
 WHERE
     withdraw_id = $1
     AND deleted_at IS NULL
-RETURNING withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at
+RETURNING withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider
 `
 
 type UpdateWithdrawParams struct {
 	WithdrawID     int32     `json:"withdraw_id"`
-	CardNumber     string    `json:"card_number"`
 	WithdrawAmount int32     `json:"withdraw_amount"`
 	WithdrawTime   time.Time `json:"withdraw_time"`
+	Fee            int32     `json:"fee"`
 }
 
 // UpdateWithdraw: Modifies withdrawal details
@@ -1948,21 +2166,22 @@ type UpdateWithdrawParams struct {
 // Parameters:
 //
 //	$1: withdraw_id - ID of withdrawal to update
-//	$2: card_number - Updated card number
-//	$3: withdraw_amount - Updated withdrawal amount
-//	$4: withdraw_time - Updated withdrawal timestamp
+//	$2: withdraw_amount - Updated withdrawal amount
+//	$3: withdraw_time - Updated withdrawal timestamp
+//	$4: fee - Fee quoted on the updated amount
 //
 // Business Logic:
 //   - Only updates active withdrawals (non-deleted)
 //   - Updates modification timestamp automatically
 //   - Used for correcting withdrawal records
 //   - Requires original withdrawal record exists
+//   - The card is kept, as the ledger postings of the withdrawal stay on it
 func (q *Queries) UpdateWithdraw(ctx context.Context, arg UpdateWithdrawParams) (*Withdraw, error) {
 	row := q.db.QueryRowContext(ctx, updateWithdraw,
 		arg.WithdrawID,
-		arg.CardNumber,
 		arg.WithdrawAmount,
 		arg.WithdrawTime,
+		arg.Fee,
 	)
 	var i Withdraw
 	err := row.Scan(
@@ -1975,40 +2194,68 @@ func (q *Queries) UpdateWithdraw(ctx context.Context, arg UpdateWithdrawParams)
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
+		&i.PayoutProvider,
 	)
 	return &i, err
 }
 
 const updateWithdrawStatus = `-- name: UpdateWithdrawStatus :one
+WITH
+    history AS (
+        INSERT INTO
+            status_histories (
+                entity_type,
+                entity_id,
+                from_status,
+                to_status,
+                created_at
+            )
+        SELECT 'withdraw', withdraw_id, status, $1, current_timestamp
+        FROM withdraws
+        WHERE
+            withdraw_id = $2
+            AND status = $3
+            AND deleted_at IS NULL
+        FOR UPDATE
+    )
 UPDATE withdraws
 SET
-    status = $2,
+    status = $1,
     updated_at = current_timestamp
 WHERE
-    withdraw_id = $1
+    withdraw_id = $2
+    AND status = $3
     AND deleted_at IS NULL
-RETURNING withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at
+RETURNING withdraw_id, withdraw_no, card_number, withdraw_amount, withdraw_time, status, created_at, updated_at, deleted_at, currency, fee, payout_provider
 `
 
 type UpdateWithdrawStatusParams struct {
-	WithdrawID int32  `json:"withdraw_id"`
 	Status     string `json:"status"`
+	WithdrawID int32  `json:"withdraw_id"`
+	FromStatus string `json:"from_status"`
 }
 
-// UpdateWithdrawStatus: Changes withdrawal status
-// Purpose: Update processing status of a withdrawal
+// UpdateWithdrawStatus: Moves a withdrawal from one status to another
+// Purpose: Record the outcome of a withdrawal together with its status history
 // Parameters:
 //
-//	$1: withdraw_id - ID of withdrawal to update
-//	$2: status - New status (e.g., 'completed', 'failed', 'pending')
+//	$1: status - New status
+//	$2: withdraw_id - ID of the withdrawal
+//	$3: from_status - Status the withdrawal is expected to be in
+//
+// Returns:
+//
+//	The updated withdrawal, or no row when it is no longer in from_status
 //
 // Business Logic:
-//   - Only updates active withdrawals
-//   - Updates modification timestamp
-//   - Used to reflect withdrawal processing outcomes
-//   - Important for reconciliation purposes
+//   - Only updates active (non-deleted) records
+//   - Allowed transitions are checked against pkg/statemachine by the caller
+//   - The status history row is written by the same statement, and only
+//     when the row was still in from_status
 func (q *Queries) UpdateWithdrawStatus(ctx context.Context, arg UpdateWithdrawStatusParams) (*Withdraw, error) {
-	row := q.db.QueryRowContext(ctx, updateWithdrawStatus, arg.WithdrawID, arg.Status)
+	row := q.db.QueryRowContext(ctx, updateWithdrawStatus, arg.Status, arg.WithdrawID, arg.FromStatus)
 	var i Withdraw
 	err := row.Scan(
 		&i.WithdrawID,
@@ -2020,6 +2267,9 @@ func (q *Queries) UpdateWithdrawStatus(ctx context.Context, arg UpdateWithdrawSt
 		&i.CreatedAt,
 		&i.UpdatedAt,
 		&i.DeletedAt,
+		&i.Currency,
+		&i.Fee,
+		&i.PayoutProvider,
 	)
 	return &i, err
 }
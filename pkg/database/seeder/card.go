@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/MamangRust/paymentgatewaygraphql/pkg/currency"
 	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/date"
 	"github.com/MamangRust/paymentgatewaygraphql/pkg/logger"
@@ -61,6 +62,7 @@ func (r *cardSeeder) Seed() error {
 			ExpireDate:   date.GenerateExpireDate(),
 			Cvv:          fmt.Sprintf("%03d", rand.Intn(1000)),
 			CardProvider: cardProviders[i%len(cardProviders)],
+			Currency:     currency.Default,
 		}
 
 		card, err := r.db.CreateCard(r.ctx, request)
@@ -30,7 +30,7 @@ func (r *merchantSeeder) Seed() error {
 	adjectives := []string{"Blue", "Green", "Red", "Yellow", "Fast", "Smart", "Global", "Local", "Happy", "Bright"}
 	nouns := []string{"Shop", "Store", "Mart", "Market", "Hub", "Center", "Place", "Corner", "Zone", "Point"}
 
-	statusOptions := []string{"pending", "active", "deactive"}
+	statusOptions := []string{"pending", "active", "suspended"}
 
 	totalMerchants := 40
 	activeMerchants := 20
@@ -41,12 +41,10 @@ func (r *merchantSeeder) Seed() error {
 		noun := nouns[rand.Intn(len(nouns))]
 		merchantName := fmt.Sprintf("%s %s", adjective, noun)
 
-		apiKey := apikey.GenerateApiKey()
-
 		req := db.CreateMerchantParams{
 			Name:   merchantName,
 			UserID: int32(rand.Intn(40) + 1),
-			ApiKey: apiKey,
+			Status: "pending",
 		}
 
 		merchant, err := r.db.CreateMerchant(r.ctx, req)
@@ -55,6 +53,21 @@ func (r *merchantSeeder) Seed() error {
 			return fmt.Errorf("failed to seed merchant %d: %w", i+1, err)
 		}
 
+		apiKey := apikey.GenerateApiKey()
+
+		_, err = r.db.CreateMerchantApiKey(r.ctx, db.CreateMerchantApiKeyParams{
+			MerchantID:    merchant.MerchantID,
+			Label:         "Default",
+			KeyPrefix:     apikey.Prefix(apiKey),
+			KeyHash:       apikey.Hash(apiKey),
+			Scopes:        []string{"transactions:create", "analytics:read"},
+			SigningSecret: apikey.GenerateSigningSecret(),
+		})
+		if err != nil {
+			r.logger.Error("failed to seed merchant api key", zap.Int("merchantID", int(merchant.MerchantID)), zap.Error(err))
+			return fmt.Errorf("failed to seed api key for merchant ID %d: %w", merchant.MerchantID, err)
+		}
+
 		status := statusOptions[rand.Intn(len(statusOptions))]
 
 		_, err = r.db.UpdateMerchantStatus(r.ctx, db.UpdateMerchantStatusParams{
@@ -2,6 +2,7 @@ package seeder
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 
 	db "github.com/MamangRust/paymentgatewaygraphql/pkg/database/schema"
@@ -62,6 +63,11 @@ func (r *saldoSeeder) Seed() error {
 			return fmt.Errorf("failed to seed saldo for card %s: %w", card.CardNumber, err)
 		}
 
+		if err := r.seedOpeningBalance(saldo); err != nil {
+			r.logger.Error("failed to seed opening balance journal", zap.String("card", card.CardNumber), zap.Error(err))
+			return fmt.Errorf("failed to seed opening balance journal for card %s: %w", card.CardNumber, err)
+		}
+
 		if i >= activeSaldos {
 			_, err = r.db.TrashSaldo(r.ctx, saldo.SaldoID)
 			if err != nil {
@@ -75,3 +81,42 @@ func (r *saldoSeeder) Seed() error {
 
 	return nil
 }
+
+// seedOpeningBalance records the seeded balance in the ledger so the saldo
+// projection can be rebuilt from it.
+func (r *saldoSeeder) seedOpeningBalance(saldo *db.Saldo) error {
+	journal, err := r.db.CreateLedgerJournal(r.ctx, db.CreateLedgerJournalParams{
+		ReferenceType: "opening_balance",
+		ReferenceID:   sql.NullInt32{Int32: saldo.SaldoID, Valid: true},
+		Description:   "Opening balance for card " + saldo.CardNumber,
+	})
+	if err != nil {
+		return err
+	}
+
+	postings := []db.CreateLedgerPostingParams{
+		{
+			JournalID:   journal.JournalID,
+			AccountCode: "OPENING_BALANCE",
+			Direction:   "debit",
+			Amount:      saldo.TotalBalance,
+			Currency:    saldo.Currency,
+		},
+		{
+			JournalID:   journal.JournalID,
+			AccountCode: "CARD",
+			CardNumber:  sql.NullString{String: saldo.CardNumber, Valid: true},
+			Direction:   "credit",
+			Amount:      saldo.TotalBalance,
+			Currency:    saldo.Currency,
+		},
+	}
+
+	for _, posting := range postings {
+		if _, err := r.db.CreateLedgerPosting(r.ctx, posting); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -81,13 +81,16 @@ func (r *topupSeeder) Seed() error {
 		}
 
 		status := statusOptions[rand.Intn(len(statusOptions))]
-		_, err = r.db.UpdateTopupStatus(r.ctx, db.UpdateTopupStatusParams{
-			TopupID: topup.TopupID,
-			Status:  status,
-		})
-		if err != nil {
-			r.logger.Error("failed to update topup status", zap.Int("topupID", int(topup.TopupID)), zap.String("status", status), zap.Error(err))
-			return fmt.Errorf("failed to update topup status for topup ID %d: %w", topup.TopupID, err)
+		if status != topup.Status {
+			_, err = r.db.UpdateTopupStatus(r.ctx, db.UpdateTopupStatusParams{
+				TopupID:    topup.TopupID,
+				FromStatus: topup.Status,
+				Status:     status,
+			})
+			if err != nil {
+				r.logger.Error("failed to update topup status", zap.Int("topupID", int(topup.TopupID)), zap.String("status", status), zap.Error(err))
+				return fmt.Errorf("failed to update topup status for topup ID %d: %w", topup.TopupID, err)
+			}
 		}
 
 		if i >= activeTopups {
@@ -91,14 +91,17 @@ func (r *transactionSeeder) Seed() error {
 			return fmt.Errorf("failed to seed transaction %d: %w", i+1, err)
 		}
 
-		_, err = r.db.UpdateTransactionStatus(r.ctx, db.UpdateTransactionStatusParams{
-			TransactionID: transaction.TransactionID,
-			Status:        status,
-		})
+		if status != transaction.Status {
+			_, err = r.db.UpdateTransactionStatus(r.ctx, db.UpdateTransactionStatusParams{
+				TransactionID: transaction.TransactionID,
+				FromStatus:    transaction.Status,
+				Status:        status,
+			})
 
-		if err != nil {
-			r.logger.Error("failed to update transaction status", zap.Int("transactionID", int(transaction.TransactionID)), zap.String("status", status), zap.Error(err))
-			return fmt.Errorf("failed to update transaction status for transaction ID %d: %w", transaction.TransactionID, err)
+			if err != nil {
+				r.logger.Error("failed to update transaction status", zap.Int("transactionID", int(transaction.TransactionID)), zap.String("status", status), zap.Error(err))
+				return fmt.Errorf("failed to update transaction status for transaction ID %d: %w", transaction.TransactionID, err)
+			}
 		}
 
 		if i < 20 {
@@ -68,11 +68,12 @@ func (r *transferSeeder) Seed() error {
 		transferTime := months[monthIndex].Add(time.Duration(rand.Intn(28)) * 24 * time.Hour)
 
 		request := db.CreateTransferParams{
-			TransferFrom:   transferFrom,
-			TransferTo:     transferTo,
-			TransferAmount: transferAmount,
-			TransferTime:   transferTime,
-			Status:         status,
+			TransferFrom:    transferFrom,
+			TransferTo:      transferTo,
+			TransferAmount:  transferAmount,
+			ConvertedAmount: transferAmount,
+			TransferTime:    transferTime,
+			Status:          status,
 		}
 
 		transfer, err := r.db.CreateTransfer(r.ctx, request)
@@ -70,14 +70,17 @@ func (r *withdrawSeeder) Seed() error {
 			return fmt.Errorf("failed to seed withdraw %d: %w", i+1, err)
 		}
 
-		_, err = r.db.UpdateWithdrawStatus(r.ctx, db.UpdateWithdrawStatusParams{
-			WithdrawID: withdraw.WithdrawID,
-			Status:     status,
-		})
+		if status != withdraw.Status {
+			_, err = r.db.UpdateWithdrawStatus(r.ctx, db.UpdateWithdrawStatusParams{
+				WithdrawID: withdraw.WithdrawID,
+				FromStatus: withdraw.Status,
+				Status:     status,
+			})
 
-		if err != nil {
-			r.logger.Error("failed to update withdraw status", zap.Int("withdrawID", int(withdraw.WithdrawID)), zap.String("status", status), zap.Error(err))
-			return fmt.Errorf("failed to update withdraw status for withdraw ID %d: %w", withdraw.WithdrawID, err)
+			if err != nil {
+				r.logger.Error("failed to update withdraw status", zap.Int("withdrawID", int(withdraw.WithdrawID)), zap.String("status", status), zap.Error(err))
+				return fmt.Errorf("failed to update withdraw status for withdraw ID %d: %w", withdraw.WithdrawID, err)
+			}
 		}
 
 		if i < 20 {
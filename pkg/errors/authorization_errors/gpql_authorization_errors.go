@@ -0,0 +1,13 @@
+package authorization_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlAuthorizationInvalidID       = response.NewGraphqlError("authorization", "Invalid Authorization ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateAuthorize            = response.NewGraphqlError("authorization", "Invalid input for authorize transaction", int(http.StatusBadRequest))
+	ErrGraphqlValidateCaptureAuthorization = response.NewGraphqlError("authorization", "Invalid input for capture transaction", int(http.StatusBadRequest))
+)
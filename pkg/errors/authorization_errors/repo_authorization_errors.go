@@ -0,0 +1,15 @@
+package authorization_errors
+
+import "errors"
+
+var (
+	ErrFindAllAuthorizationsFailed = errors.New("failed to find all authorizations")
+	ErrFindAuthorizationByIdFailed = errors.New("failed to find authorization by ID")
+
+	ErrCreateAuthorizationFailed  = errors.New("failed to create authorization")
+	ErrCaptureAuthorizationFailed = errors.New("failed to capture authorization")
+	ErrVoidAuthorizationFailed    = errors.New("failed to void authorization")
+	ErrExpireAuthorizationsFailed = errors.New("failed to expire authorizations")
+
+	ErrAuthorizationStatusConflict = errors.New("authorization is not in a state that allows this action")
+)
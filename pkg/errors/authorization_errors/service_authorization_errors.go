@@ -0,0 +1,23 @@
+package authorization_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrFailedFindAllAuthorizations = response.NewErrorResponse("Failed to fetch authorizations", http.StatusInternalServerError)
+	ErrAuthorizationNotFound       = response.NewErrorResponse("Authorization not found", http.StatusNotFound)
+
+	ErrFailedAuthorizeTransaction = response.NewErrorResponse("Failed to authorize transaction", http.StatusInternalServerError)
+	ErrFailedCaptureAuthorization = response.NewErrorResponse("Failed to capture authorization", http.StatusInternalServerError)
+	ErrFailedVoidAuthorization    = response.NewErrorResponse("Failed to void authorization", http.StatusInternalServerError)
+	ErrFailedExpireAuthorizations = response.NewErrorResponse("Failed to expire authorizations", http.StatusInternalServerError)
+
+	ErrAuthorizationNotAllowed      = response.NewErrorResponse("Authorization belongs to another merchant", http.StatusForbidden)
+	ErrAuthorizationNotCapturable   = response.NewErrorResponse("Authorization is no longer pending or has expired", http.StatusConflict)
+	ErrAuthorizationNotVoidable     = response.NewErrorResponse("Authorization is no longer pending", http.StatusConflict)
+	ErrCaptureExceedsAuthorization  = response.NewErrorResponse("Capture amount exceeds the authorized amount", http.StatusBadRequest)
+	ErrInsufficientAvailableBalance = response.NewErrorResponse("Insufficient available balance to authorize transaction", http.StatusBadRequest)
+)
@@ -15,6 +15,7 @@ var (
 	ErrGetTotalWithdrawAmountFailed    = errors.New("failed to get total withdraw amount")
 	ErrGetTotalTransactionAmountFailed = errors.New("failed to get total transaction amount")
 	ErrGetTotalTransferAmountFailed    = errors.New("failed to get total transfer amount")
+	ErrGetTotalAmountsByCurrencyFailed = errors.New("failed to get total amounts by currency")
 
 	ErrGetTotalBalanceByCardFailed            = errors.New("failed to get total balance by card")
 	ErrGetTotalTopupAmountByCardFailed        = errors.New("failed to get total topup amount by card")
@@ -20,6 +20,7 @@ var (
 	ErrFailedFindTotalWithdrawAmount    = response.NewErrorResponse("Failed to Find total withdraw amount", http.StatusInternalServerError)
 	ErrFailedFindTotalTransactionAmount = response.NewErrorResponse("Failed to Find total transaction amount", http.StatusInternalServerError)
 	ErrFailedFindTotalTransferAmount    = response.NewErrorResponse("Failed to Find total transfer amount", http.StatusInternalServerError)
+	ErrFailedFindTotalAmountsByCurrency = response.NewErrorResponse("Failed to Find total amounts by currency", http.StatusInternalServerError)
 
 	ErrFailedFindTotalBalanceByCard            = response.NewErrorResponse("Failed to Find total balance by card", http.StatusInternalServerError)
 	ErrFailedFindTotalTopupAmountByCard        = response.NewErrorResponse("Failed to Find total topup amount by card", http.StatusInternalServerError)
@@ -66,4 +67,6 @@ var (
 
 	ErrFailedRestoreAllCards = response.NewErrorResponse("Failed to restore all Cards", http.StatusInternalServerError)
 	ErrFailedDeleteAllCards  = response.NewErrorResponse("Failed to delete all Cards permanently", http.StatusInternalServerError)
+
+	ErrCurrencyMismatch = response.NewErrorResponse("Card currency does not match the merchant currency", http.StatusBadRequest)
 )
@@ -0,0 +1,14 @@
+package dispute_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlDisputeInvalidID       = response.NewGraphqlError("dispute", "Invalid Dispute ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateOpenDispute    = response.NewGraphqlError("dispute", "Invalid input for open dispute", int(http.StatusBadRequest))
+	ErrGraphqlValidateRespondDispute = response.NewGraphqlError("dispute", "Invalid input for respond dispute", int(http.StatusBadRequest))
+	ErrGraphqlValidateResolveDispute = response.NewGraphqlError("dispute", "Invalid input for resolve dispute", int(http.StatusBadRequest))
+)
@@ -0,0 +1,15 @@
+package dispute_errors
+
+import "errors"
+
+var (
+	ErrFindAllDisputesFailed        = errors.New("failed to find all disputes")
+	ErrFindDisputeByIdFailed        = errors.New("failed to find dispute by ID")
+	ErrFindDisputesByMerchantFailed = errors.New("failed to find disputes by merchant ID")
+
+	ErrCreateDisputeFailed  = errors.New("failed to create dispute")
+	ErrRespondDisputeFailed = errors.New("failed to respond to dispute")
+	ErrResolveDisputeFailed = errors.New("failed to resolve dispute")
+
+	ErrDisputeStatusConflict = errors.New("dispute is not in a state that allows this action")
+)
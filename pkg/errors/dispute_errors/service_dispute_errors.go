@@ -0,0 +1,23 @@
+package dispute_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrFailedFindAllDisputes        = response.NewErrorResponse("Failed to fetch disputes", http.StatusInternalServerError)
+	ErrDisputeNotFound              = response.NewErrorResponse("Dispute not found", http.StatusNotFound)
+	ErrFailedFindDisputesByMerchant = response.NewErrorResponse("Failed to fetch disputes by merchant", http.StatusInternalServerError)
+
+	ErrFailedOpenDispute    = response.NewErrorResponse("Failed to open dispute", http.StatusInternalServerError)
+	ErrFailedRespondDispute = response.NewErrorResponse("Failed to respond to dispute", http.StatusInternalServerError)
+	ErrFailedResolveDispute = response.NewErrorResponse("Failed to resolve dispute", http.StatusInternalServerError)
+
+	ErrDisputeNotAllowed         = response.NewErrorResponse("Dispute action is not allowed for this user", http.StatusForbidden)
+	ErrTransactionNotDisputable  = response.NewErrorResponse("Transaction cannot be disputed", http.StatusBadRequest)
+	ErrDisputeNotOpen            = response.NewErrorResponse("Dispute is not open for a merchant response", http.StatusConflict)
+	ErrDisputeAlreadyResolved    = response.NewErrorResponse("Dispute has already been resolved", http.StatusConflict)
+	ErrInsufficientMerchantSaldo = response.NewErrorResponse("Insufficient merchant balance to hold disputed funds", http.StatusBadRequest)
+)
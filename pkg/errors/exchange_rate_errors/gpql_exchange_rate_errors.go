@@ -0,0 +1,12 @@
+package exchange_rate_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlExchangeRateInvalidID      = response.NewGraphqlError("exchange_rate", "Invalid Exchange Rate ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateUpsertExchangeRate = response.NewGraphqlError("exchange_rate", "Invalid input for exchange rate", int(http.StatusBadRequest))
+)
@@ -0,0 +1,11 @@
+package exchange_rate_errors
+
+import "errors"
+
+var (
+	ErrFindAllExchangeRatesFailed   = errors.New("failed to find all exchange rates")
+	ErrFindExchangeRateByIdFailed   = errors.New("failed to find exchange rate by ID")
+	ErrFindExchangeRateByPairFailed = errors.New("failed to find exchange rate by currency pair")
+	ErrUpsertExchangeRateFailed     = errors.New("failed to upsert exchange rate")
+	ErrDeleteExchangeRateFailed     = errors.New("failed to delete exchange rate")
+)
@@ -0,0 +1,17 @@
+package exchange_rate_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrFailedFindAllExchangeRates = response.NewErrorResponse("Failed to fetch exchange rates", http.StatusInternalServerError)
+	ErrExchangeRateNotFound       = response.NewErrorResponse("Exchange rate not found", http.StatusNotFound)
+	ErrExchangeRateUnavailable    = response.NewErrorResponse("No exchange rate is configured for this currency pair", http.StatusBadRequest)
+	ErrFailedConvertAmount        = response.NewErrorResponse("Failed to convert amount between currencies", http.StatusBadRequest)
+
+	ErrFailedUpsertExchangeRate = response.NewErrorResponse("Failed to save exchange rate", http.StatusInternalServerError)
+	ErrFailedDeleteExchangeRate = response.NewErrorResponse("Failed to delete exchange rate", http.StatusInternalServerError)
+)
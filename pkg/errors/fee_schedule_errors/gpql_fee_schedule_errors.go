@@ -0,0 +1,14 @@
+package fee_schedule_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlFeeScheduleInvalidID      = response.NewGraphqlError("fee_schedule", "Invalid Fee Schedule ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateCreateFeeSchedule = response.NewGraphqlError("fee_schedule", "Invalid input for create fee schedule", int(http.StatusBadRequest))
+	ErrGraphqlValidateUpdateFeeSchedule = response.NewGraphqlError("fee_schedule", "Invalid input for update fee schedule", int(http.StatusBadRequest))
+	ErrGraphqlInvalidYear               = response.NewGraphqlError("year", "Invalid year", int(http.StatusBadRequest))
+)
@@ -0,0 +1,17 @@
+package fee_schedule_errors
+
+import "errors"
+
+var (
+	ErrFindAllFeeSchedulesFailed       = errors.New("failed to find all fee schedules")
+	ErrFindFeeScheduleByIdFailed       = errors.New("failed to find fee schedule by ID")
+	ErrFindApplicableFeeScheduleFailed = errors.New("failed to find applicable fee schedule")
+	ErrNoApplicableFeeSchedule         = errors.New("no fee schedule applies")
+	ErrFindFeeScheduleTiersFailed      = errors.New("failed to find fee schedule tiers")
+	ErrCreateFeeScheduleFailed         = errors.New("failed to create fee schedule")
+	ErrUpdateFeeScheduleFailed         = errors.New("failed to update fee schedule")
+	ErrDeleteFeeScheduleFailed         = errors.New("failed to delete fee schedule")
+	ErrReplaceFeeScheduleTiersFailed   = errors.New("failed to replace fee schedule tiers")
+	ErrGetMonthlyFeeAmountsFailed      = errors.New("failed to get monthly fee amounts")
+	ErrGetYearlyFeeAmountsFailed       = errors.New("failed to get yearly fee amounts")
+)
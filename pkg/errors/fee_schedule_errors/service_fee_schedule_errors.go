@@ -0,0 +1,21 @@
+package fee_schedule_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrFailedFindAllFeeSchedules = response.NewErrorResponse("Failed to fetch fee schedules", http.StatusInternalServerError)
+	ErrFeeScheduleNotFound       = response.NewErrorResponse("Fee schedule not found", http.StatusNotFound)
+	ErrFailedQuoteFee            = response.NewErrorResponse("Failed to compute fee", http.StatusInternalServerError)
+	ErrFeeExceedsAmount          = response.NewErrorResponse("Fee exceeds the transaction amount", http.StatusBadRequest)
+
+	ErrFailedCreateFeeSchedule = response.NewErrorResponse("Failed to create fee schedule", http.StatusInternalServerError)
+	ErrFailedUpdateFeeSchedule = response.NewErrorResponse("Failed to update fee schedule", http.StatusInternalServerError)
+	ErrFailedDeleteFeeSchedule = response.NewErrorResponse("Failed to delete fee schedule", http.StatusInternalServerError)
+
+	ErrFailedFindMonthlyFeeAmounts = response.NewErrorResponse("Failed to get monthly fee amounts", http.StatusInternalServerError)
+	ErrFailedFindYearlyFeeAmounts  = response.NewErrorResponse("Failed to get yearly fee amounts", http.StatusInternalServerError)
+)
@@ -0,0 +1,11 @@
+package idempotency_errors
+
+import "errors"
+
+var (
+	ErrIdempotencyKeyAlreadyExists = errors.New("idempotency key already exists")
+	ErrCreateIdempotencyKeyFailed  = errors.New("failed to create idempotency key")
+	ErrIdempotencyKeyNotFound      = errors.New("idempotency key not found")
+	ErrUpdateIdempotencyKeyFailed  = errors.New("failed to store idempotency key response")
+	ErrDeleteIdempotencyKeyFailed  = errors.New("failed to delete idempotency key")
+)
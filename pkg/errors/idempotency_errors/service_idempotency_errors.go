@@ -0,0 +1,16 @@
+package idempotency_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrInvalidIdempotencyKey        = response.NewErrorResponse("Invalid idempotency key", http.StatusBadRequest)
+	ErrFailedReserveIdempotencyKey  = response.NewErrorResponse("Failed to reserve idempotency key", http.StatusInternalServerError)
+	ErrIdempotencyKeyReused         = response.NewErrorResponse("Idempotency key was already used with a different request payload", http.StatusUnprocessableEntity)
+	ErrIdempotencyKeyInProgress     = response.NewErrorResponse("A request with this idempotency key is still being processed", http.StatusConflict)
+	ErrFailedCompleteIdempotencyKey = response.NewErrorResponse("Failed to store idempotent response", http.StatusInternalServerError)
+	ErrFailedReleaseIdempotencyKey  = response.NewErrorResponse("Failed to release idempotency key", http.StatusInternalServerError)
+)
@@ -0,0 +1,14 @@
+package invoice_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlInvoiceInvalidID      = response.NewGraphqlError("invoice", "Invalid Invoice ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateCreateInvoice = response.NewGraphqlError("invoice", "Invalid input for create invoice", int(http.StatusBadRequest))
+	ErrGraphqlValidateFindInvoices  = response.NewGraphqlError("invoice", "Invalid input for invoices", int(http.StatusBadRequest))
+	ErrGraphqlValidatePayInvoice    = response.NewGraphqlError("invoice", "Invalid input for pay invoice", int(http.StatusBadRequest))
+)
@@ -0,0 +1,20 @@
+package invoice_errors
+
+import "errors"
+
+var (
+	ErrFindInvoiceByIdFailed        = errors.New("failed to find invoice by ID")
+	ErrFindInvoiceByCodeFailed      = errors.New("failed to find invoice by code")
+	ErrFindInvoicesByMerchantFailed = errors.New("failed to find invoices by merchant")
+	ErrFindInvoiceLineItemsFailed   = errors.New("failed to find invoice line items")
+	ErrFindInvoicePaymentsFailed    = errors.New("failed to find invoice payments")
+
+	ErrCreateInvoiceFailed         = errors.New("failed to create invoice")
+	ErrCreateInvoiceLineItemFailed = errors.New("failed to create invoice line item")
+	ErrCreateInvoicePaymentFailed  = errors.New("failed to create invoice payment")
+	ErrMarkInvoicePaidFailed       = errors.New("failed to mark invoice paid")
+	ErrCancelInvoiceFailed         = errors.New("failed to cancel invoice")
+	ErrExpireInvoicesFailed        = errors.New("failed to expire invoices")
+
+	ErrInvoiceStatusConflict = errors.New("invoice is not in a state that allows this action")
+)
@@ -0,0 +1,26 @@
+package invoice_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrInvoiceNotFound           = response.NewErrorResponse("Invoice not found", http.StatusNotFound)
+	ErrFailedFindInvoices        = response.NewErrorResponse("Failed to fetch invoices", http.StatusInternalServerError)
+	ErrFailedFindInvoicePayments = response.NewErrorResponse("Failed to fetch invoice payments", http.StatusInternalServerError)
+	ErrFailedCreateInvoice       = response.NewErrorResponse("Failed to create invoice", http.StatusInternalServerError)
+	ErrFailedCancelInvoice       = response.NewErrorResponse("Failed to cancel invoice", http.StatusInternalServerError)
+	ErrFailedPayInvoice          = response.NewErrorResponse("Failed to pay invoice", http.StatusInternalServerError)
+	ErrFailedExpireInvoices      = response.NewErrorResponse("Failed to expire invoices", http.StatusInternalServerError)
+	ErrFailedGenerateInvoiceCode = response.NewErrorResponse("Failed to generate invoice code", http.StatusInternalServerError)
+
+	ErrInvoiceNotAllowed            = response.NewErrorResponse("Merchant does not belong to the requesting user", http.StatusForbidden)
+	ErrInvoiceExpiryInPast          = response.NewErrorResponse("Invoice expiry must be in the future", http.StatusBadRequest)
+	ErrInvoiceLineItemsMismatch     = response.NewErrorResponse("Invoice line items do not add up to the amount", http.StatusBadRequest)
+	ErrInvoiceNotOpen               = response.NewErrorResponse("Invoice is no longer open", http.StatusConflict)
+	ErrInvoiceNotPayable            = response.NewErrorResponse("Invoice is no longer open or has expired", http.StatusConflict)
+	ErrInvoiceCardNotOwned          = response.NewErrorResponse("Card does not belong to the paying user", http.StatusForbidden)
+	ErrInsufficientAvailableBalance = response.NewErrorResponse("Insufficient available balance to pay invoice", http.StatusBadRequest)
+)
@@ -0,0 +1,12 @@
+package ledger_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlLedgerJournalInvalidID  = response.NewGraphqlError("ledger", "Invalid Ledger Journal ID", int(http.StatusBadRequest))
+	ErrGraphqlLedgerInvalidCardNumber = response.NewGraphqlError("ledger", "Invalid Ledger Card Number", int(http.StatusBadRequest))
+)
@@ -0,0 +1,15 @@
+package ledger_errors
+
+import "errors"
+
+var (
+	ErrInvalidLedgerJournal        = errors.New("ledger journal is invalid or unbalanced")
+	ErrCreateLedgerJournalFailed   = errors.New("failed to create ledger journal")
+	ErrCreateLedgerPostingFailed   = errors.New("failed to create ledger posting")
+	ErrApplyLedgerPostingFailed    = errors.New("failed to apply ledger posting to saldo")
+	ErrLedgerPostingOverdraft      = errors.New("ledger posting would overdraw the card balance")
+	ErrFindAllLedgerJournalsFailed = errors.New("failed to find all ledger journals")
+	ErrFindLedgerJournalByIdFailed = errors.New("failed to find ledger journal by ID")
+	ErrFindLedgerPostingsFailed    = errors.New("failed to find ledger postings")
+	ErrFindLedgerBalanceFailed     = errors.New("failed to compute ledger balance")
+)
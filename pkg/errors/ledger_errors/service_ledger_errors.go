@@ -0,0 +1,17 @@
+package ledger_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrFailedPostLedgerJournal = response.NewErrorResponse("Failed to post ledger journal", http.StatusInternalServerError)
+
+	ErrFailedFindAllLedgerJournals = response.NewErrorResponse("Failed to fetch ledger journals", http.StatusInternalServerError)
+	ErrLedgerJournalNotFound       = response.NewErrorResponse("Ledger journal not found", http.StatusNotFound)
+	ErrFailedFindLedgerPostings    = response.NewErrorResponse("Failed to fetch ledger postings", http.StatusInternalServerError)
+	ErrFailedFindLedgerBalance     = response.NewErrorResponse("Failed to compute ledger balance", http.StatusInternalServerError)
+	ErrLedgerCardNotAllowed        = response.NewErrorResponse("Card does not belong to the requesting user", http.StatusForbidden)
+)
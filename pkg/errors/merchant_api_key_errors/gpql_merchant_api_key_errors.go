@@ -0,0 +1,13 @@
+package merchant_api_key_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlMerchantApiKeyInvalidID      = response.NewGraphqlError("merchant_api_key", "Invalid Merchant Api Key ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateCreateMerchantApiKey = response.NewGraphqlError("merchant_api_key", "Invalid input for create merchant api key", int(http.StatusBadRequest))
+	ErrGraphqlValidateRotateMerchantApiKey = response.NewGraphqlError("merchant_api_key", "Invalid input for rotate merchant api key", int(http.StatusBadRequest))
+)
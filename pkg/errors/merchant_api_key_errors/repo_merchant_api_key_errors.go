@@ -0,0 +1,18 @@
+package merchant_api_key_errors
+
+import "errors"
+
+var (
+	ErrCreateMerchantApiKeyFailed     = errors.New("failed to create merchant api key")
+	ErrFindMerchantApiKeyByIdFailed   = errors.New("failed to find merchant api key by ID")
+	ErrFindMerchantApiKeyByHashFailed = errors.New("failed to find merchant api key by hash")
+	ErrFindMerchantApiKeysFailed      = errors.New("failed to find merchant api keys")
+	ErrTouchMerchantApiKeyFailed      = errors.New("failed to record merchant api key use")
+	ErrExpireMerchantApiKeyFailed     = errors.New("failed to expire merchant api key")
+	ErrRevokeMerchantApiKeyFailed     = errors.New("failed to revoke merchant api key")
+	ErrMerchantRequestNonceUsed       = errors.New("nonce was already used with this merchant api key")
+	ErrUseMerchantRequestNonceFailed  = errors.New("failed to record merchant request nonce")
+	ErrDeleteMerchantRequestNonces    = errors.New("failed to delete merchant request nonces")
+	ErrFindPlainSigningSecretsFailed  = errors.New("failed to find merchant api keys with a plain signing secret")
+	ErrSealSigningSecretFailed        = errors.New("failed to seal merchant api key signing secret")
+)
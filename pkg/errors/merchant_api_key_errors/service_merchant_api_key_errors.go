@@ -0,0 +1,25 @@
+package merchant_api_key_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrMerchantApiKeyNotFound     = response.NewErrorResponse("Merchant api key not found", http.StatusNotFound)
+	ErrMerchantApiKeyNotAllowed   = response.NewErrorResponse("Merchant does not belong to the requesting user", http.StatusForbidden)
+	ErrMerchantApiKeyExpiryInPast = response.NewErrorResponse("Merchant api key must expire in the future", http.StatusBadRequest)
+	ErrMerchantApiKeyRevoked      = response.NewErrorResponse("Merchant api key is revoked", http.StatusConflict)
+	ErrInvalidMerchantApiKey      = response.NewErrorResponse("Invalid API key", http.StatusUnauthorized)
+	ErrMerchantApiKeyMissingScope = response.NewErrorResponse("API key is not allowed to do this", http.StatusForbidden)
+	ErrInvalidRequestSignature    = response.NewErrorResponse("Invalid request signature", http.StatusUnauthorized)
+	ErrMerchantRequestReplayed    = response.NewErrorResponse("Request nonce was already used", http.StatusConflict)
+	ErrFailedFindMerchantApiKeys  = response.NewErrorResponse("Failed to fetch merchant api keys", http.StatusInternalServerError)
+	ErrFailedCreateMerchantApiKey = response.NewErrorResponse("Failed to create merchant api key", http.StatusInternalServerError)
+	ErrFailedRotateMerchantApiKey = response.NewErrorResponse("Failed to rotate merchant api key", http.StatusInternalServerError)
+	ErrFailedRevokeMerchantApiKey = response.NewErrorResponse("Failed to revoke merchant api key", http.StatusInternalServerError)
+	ErrFailedVerifyRequest        = response.NewErrorResponse("Failed to verify request signature", http.StatusInternalServerError)
+	ErrFailedPruneRequestNonces   = response.NewErrorResponse("Failed to prune request nonces", http.StatusInternalServerError)
+	ErrFailedSealSigningSecret    = response.NewErrorResponse("Failed to seal merchant api key signing secret", http.StatusInternalServerError)
+)
@@ -12,7 +12,7 @@ var (
 	ErrFailedFindActiveMerchants  = response.NewErrorResponse("Failed to fetch active Merchants", http.StatusInternalServerError)
 	ErrFailedFindTrashedMerchants = response.NewErrorResponse("Failed to fetch trashed Merchants", http.StatusInternalServerError)
 	ErrFailedFindMerchantById     = response.NewErrorResponse("Failed to find Merchant by ID", http.StatusInternalServerError)
-	ErrFailedFindByApiKey         = response.NewErrorResponse("Failed to find Merchant by API key", http.StatusInternalServerError)
+	ErrMerchantNotActive          = response.NewErrorResponse("Merchant is not active", http.StatusForbidden)
 	ErrFailedFindByMerchantUserId = response.NewErrorResponse("Failed to find Merchant by User ID", http.StatusInternalServerError)
 
 	ErrFailedFindAllTransactions           = response.NewErrorResponse("Failed to fetch Merchant transactions", http.StatusInternalServerError)
@@ -26,12 +26,14 @@ var (
 	ErrFailedFindMonthlyPaymentMethodByApikeys   = response.NewErrorResponse("Failed to get monthly payment methods by API key", http.StatusInternalServerError)
 	ErrFailedFindYearlyPaymentMethodByApikeys    = response.NewErrorResponse("Failed to get yearly payment method by API key", http.StatusInternalServerError)
 
-	ErrFailedFindMonthlyAmountMerchant    = response.NewErrorResponse("Failed to get monthly amount", http.StatusInternalServerError)
-	ErrFailedFindYearlyAmountMerchant     = response.NewErrorResponse("Failed to get yearly amount", http.StatusInternalServerError)
-	ErrFailedFindMonthlyAmountByMerchants = response.NewErrorResponse("Failed to get monthly amount by Merchant", http.StatusInternalServerError)
-	ErrFailedFindYearlyAmountByMerchants  = response.NewErrorResponse("Failed to get yearly amount by Merchant", http.StatusInternalServerError)
-	ErrFailedFindMonthlyAmountByApikeys   = response.NewErrorResponse("Failed to get monthly amount by API key", http.StatusInternalServerError)
-	ErrFailedFindYearlyAmountByApikeys    = response.NewErrorResponse("Failed to get yearly amount by API key", http.StatusInternalServerError)
+	ErrFailedFindMonthlyAmountMerchant         = response.NewErrorResponse("Failed to get monthly amount", http.StatusInternalServerError)
+	ErrFailedFindYearlyAmountMerchant          = response.NewErrorResponse("Failed to get yearly amount", http.StatusInternalServerError)
+	ErrFailedFindMonthlyAmountByMerchants      = response.NewErrorResponse("Failed to get monthly amount by Merchant", http.StatusInternalServerError)
+	ErrFailedFindMonthlyDisputeRateByMerchants = response.NewErrorResponse("Failed to get monthly dispute rate by Merchant", http.StatusInternalServerError)
+	ErrFailedFindYearlyDisputeRateByMerchants  = response.NewErrorResponse("Failed to get yearly dispute rate by Merchant", http.StatusInternalServerError)
+	ErrFailedFindYearlyAmountByMerchants       = response.NewErrorResponse("Failed to get yearly amount by Merchant", http.StatusInternalServerError)
+	ErrFailedFindMonthlyAmountByApikeys        = response.NewErrorResponse("Failed to get monthly amount by API key", http.StatusInternalServerError)
+	ErrFailedFindYearlyAmountByApikeys         = response.NewErrorResponse("Failed to get yearly amount by API key", http.StatusInternalServerError)
 
 	ErrFailedFindMonthlyTotalAmountMerchant    = response.NewErrorResponse("Failed to get monthly total amount", http.StatusInternalServerError)
 	ErrFailedFindYearlyTotalAmountMerchant     = response.NewErrorResponse("Failed to get yearly total amount", http.StatusInternalServerError)
@@ -0,0 +1,13 @@
+package merchant_onboarding_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlValidateSubmitOnboarding      = response.NewGraphqlError("merchant_onboarding", "Invalid input for merchant onboarding", int(http.StatusBadRequest))
+	ErrGraphqlValidateReviewMerchant        = response.NewGraphqlError("merchant_onboarding", "Invalid input for merchant review", int(http.StatusBadRequest))
+	ErrGraphqlValidateFindMerchantsByStatus = response.NewGraphqlError("merchant_onboarding", "Invalid input for merchants by status", int(http.StatusBadRequest))
+)
@@ -0,0 +1,10 @@
+package merchant_onboarding_errors
+
+import "errors"
+
+var (
+	ErrUpsertBusinessProfileFailed = errors.New("failed to store merchant business profile")
+	ErrBusinessProfileNotFound     = errors.New("merchant business profile not found")
+	ErrFindBusinessProfileFailed   = errors.New("failed to find merchant business profile")
+	ErrFindMerchantsByStatusFailed = errors.New("failed to find merchants by status")
+)
@@ -0,0 +1,19 @@
+package merchant_onboarding_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrMerchantOnboardingNotAllowed  = response.NewErrorResponse("Merchant does not belong to the requesting user", http.StatusForbidden)
+	ErrBusinessProfileNotFoundRes    = response.NewErrorResponse("Merchant business profile not found", http.StatusNotFound)
+	ErrFailedSubmitOnboarding        = response.NewErrorResponse("Failed to submit merchant onboarding", http.StatusInternalServerError)
+	ErrFailedFindBusinessProfile     = response.NewErrorResponse("Failed to fetch merchant business profile", http.StatusInternalServerError)
+	ErrFailedFindMerchantsByStatus   = response.NewErrorResponse("Failed to fetch merchants by status", http.StatusInternalServerError)
+	ErrOnboardingAlreadySubmitted    = response.NewErrorResponse("Merchant onboarding can only be submitted while pending or rejected", http.StatusConflict)
+	ErrMerchantReviewReasonRequired  = response.NewErrorResponse("A reason is required to reject, suspend or terminate a merchant", http.StatusBadRequest)
+	ErrMerchantStatusConflictRes     = response.NewErrorResponse("Merchant status does not allow this change", http.StatusConflict)
+	ErrFailedUpdateMerchantStatusRes = response.NewErrorResponse("Failed to update merchant status", http.StatusInternalServerError)
+)
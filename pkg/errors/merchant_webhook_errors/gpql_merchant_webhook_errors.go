@@ -0,0 +1,15 @@
+package merchant_webhook_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlMerchantWebhookEndpointInvalidID = response.NewGraphqlError("merchant_webhook", "Invalid Merchant Webhook Endpoint ID", int(http.StatusBadRequest))
+	ErrGraphqlMerchantWebhookDeliveryInvalidID = response.NewGraphqlError("merchant_webhook", "Invalid Merchant Webhook Delivery ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateCreateMerchantWebhook    = response.NewGraphqlError("merchant_webhook", "Invalid input for create merchant webhook endpoint", int(http.StatusBadRequest))
+	ErrGraphqlValidateUpdateMerchantWebhook    = response.NewGraphqlError("merchant_webhook", "Invalid input for update merchant webhook endpoint", int(http.StatusBadRequest))
+	ErrGraphqlValidateMerchantWebhookDelivery  = response.NewGraphqlError("merchant_webhook", "Invalid input for merchant webhook deliveries", int(http.StatusBadRequest))
+)
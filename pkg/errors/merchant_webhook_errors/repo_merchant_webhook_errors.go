@@ -0,0 +1,18 @@
+package merchant_webhook_errors
+
+import "errors"
+
+var (
+	ErrCreateMerchantWebhookEndpointFailed       = errors.New("failed to create merchant webhook endpoint")
+	ErrFindMerchantWebhookEndpointByIdFailed     = errors.New("failed to find merchant webhook endpoint by ID")
+	ErrFindMerchantWebhookEndpointsFailed        = errors.New("failed to find merchant webhook endpoints")
+	ErrUpdateMerchantWebhookEndpointFailed       = errors.New("failed to update merchant webhook endpoint")
+	ErrDeleteMerchantWebhookEndpointFailed       = errors.New("failed to delete merchant webhook endpoint")
+	ErrQueueMerchantWebhookDeliveriesFailed      = errors.New("failed to queue merchant webhook deliveries")
+	ErrCreateMerchantWebhookDeliveryFailed       = errors.New("failed to create merchant webhook delivery")
+	ErrFindMerchantWebhookDeliveryByIdFailed     = errors.New("failed to find merchant webhook delivery by ID")
+	ErrFindMerchantWebhookDeliveriesFailed       = errors.New("failed to find merchant webhook deliveries")
+	ErrClaimMerchantWebhookDeliveriesFailed      = errors.New("failed to claim due merchant webhook deliveries")
+	ErrFinishMerchantWebhookDeliveryFailed       = errors.New("failed to record merchant webhook delivery attempt")
+	ErrFindMerchantWebhookDeliveryAttemptsFailed = errors.New("failed to find merchant webhook delivery attempts")
+)
@@ -0,0 +1,22 @@
+package merchant_webhook_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrMerchantWebhookEndpointNotFound            = response.NewErrorResponse("Merchant webhook endpoint not found", http.StatusNotFound)
+	ErrMerchantWebhookDeliveryNotFound            = response.NewErrorResponse("Merchant webhook delivery not found", http.StatusNotFound)
+	ErrMerchantWebhookNotAllowed                  = response.NewErrorResponse("Merchant does not belong to the requesting user", http.StatusForbidden)
+	ErrFailedFindMerchantWebhookEndpoints         = response.NewErrorResponse("Failed to fetch merchant webhook endpoints", http.StatusInternalServerError)
+	ErrFailedCreateMerchantWebhookEndpoint        = response.NewErrorResponse("Failed to create merchant webhook endpoint", http.StatusInternalServerError)
+	ErrFailedUpdateMerchantWebhookEndpoint        = response.NewErrorResponse("Failed to update merchant webhook endpoint", http.StatusInternalServerError)
+	ErrFailedDeleteMerchantWebhookEndpoint        = response.NewErrorResponse("Failed to delete merchant webhook endpoint", http.StatusInternalServerError)
+	ErrFailedFindMerchantWebhookDeliveries        = response.NewErrorResponse("Failed to fetch merchant webhook deliveries", http.StatusInternalServerError)
+	ErrFailedFindMerchantWebhookDeliveryLog       = response.NewErrorResponse("Failed to fetch merchant webhook delivery log", http.StatusInternalServerError)
+	ErrFailedCreateMerchantWebhookDelivery        = response.NewErrorResponse("Failed to create merchant webhook delivery", http.StatusInternalServerError)
+	ErrFailedClaimMerchantWebhookDeliveries       = response.NewErrorResponse("Failed to claim due merchant webhook deliveries", http.StatusInternalServerError)
+	ErrFailedRecordMerchantWebhookDeliveryAttempt = response.NewErrorResponse("Failed to record merchant webhook delivery attempt", http.StatusInternalServerError)
+)
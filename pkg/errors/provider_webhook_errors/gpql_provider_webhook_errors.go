@@ -0,0 +1,11 @@
+package provider_webhook_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlProviderWebhookEventInvalidID = response.NewGraphqlError("provider_webhook", "Invalid Provider Webhook Event ID", int(http.StatusBadRequest))
+)
@@ -0,0 +1,12 @@
+package provider_webhook_errors
+
+import "errors"
+
+var (
+	ErrFindAllProviderWebhookEventsFailed = errors.New("failed to find all provider webhook events")
+	ErrFindProviderWebhookEventByIdFailed = errors.New("failed to find provider webhook event by ID")
+	ErrFindProviderWebhookEventFailed     = errors.New("failed to find provider webhook event by event ID")
+	ErrCreateProviderWebhookEventFailed   = errors.New("failed to create provider webhook event")
+	ErrProviderWebhookEventDuplicate      = errors.New("provider webhook event was already received")
+	ErrFinishProviderWebhookEventFailed   = errors.New("failed to finish provider webhook event")
+)
@@ -0,0 +1,17 @@
+package provider_webhook_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrFailedFindAllProviderWebhookEvents = response.NewErrorResponse("Failed to fetch provider webhook events", http.StatusInternalServerError)
+	ErrProviderWebhookEventNotFound       = response.NewErrorResponse("Provider webhook event not found", http.StatusNotFound)
+	ErrUnknownWebhookProvider             = response.NewErrorResponse("Unknown webhook provider", http.StatusNotFound)
+	ErrInvalidProviderWebhookSignature    = response.NewErrorResponse("Invalid webhook signature or timestamp", http.StatusUnauthorized)
+	ErrInvalidProviderWebhookPayload      = response.NewErrorResponse("Invalid webhook payload", http.StatusBadRequest)
+	ErrFailedStoreProviderWebhookEvent    = response.NewErrorResponse("Failed to store provider webhook event", http.StatusInternalServerError)
+	ErrFailedProcessProviderWebhookEvent  = response.NewErrorResponse("Failed to process provider webhook event", http.StatusInternalServerError)
+)
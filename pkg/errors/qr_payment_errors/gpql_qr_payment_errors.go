@@ -0,0 +1,13 @@
+package qr_payment_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlValidateGenerateMerchantQr = response.NewGraphqlError("qr_payment", "Invalid input for generate merchant QR code", int(http.StatusBadRequest))
+	ErrGraphqlValidatePayQr              = response.NewGraphqlError("qr_payment", "Invalid input for pay QR code", int(http.StatusBadRequest))
+	ErrGraphqlValidateFindQrPayment      = response.NewGraphqlError("qr_payment", "Invalid input for QR payment", int(http.StatusBadRequest))
+)
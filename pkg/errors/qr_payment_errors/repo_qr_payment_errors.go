@@ -0,0 +1,10 @@
+package qr_payment_errors
+
+import "errors"
+
+var (
+	ErrFindQrPaymentByReferenceFailed = errors.New("failed to find QR payment by reference")
+	ErrCreateQrPaymentFailed          = errors.New("failed to create QR payment")
+
+	ErrQrReferenceUsed = errors.New("QR reference was already paid")
+)
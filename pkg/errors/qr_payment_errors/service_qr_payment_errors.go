@@ -0,0 +1,22 @@
+package qr_payment_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrQrPaymentNotFound        = response.NewErrorResponse("QR payment not found", http.StatusNotFound)
+	ErrFailedGenerateMerchantQr = response.NewErrorResponse("Failed to generate merchant QR code", http.StatusInternalServerError)
+	ErrFailedRecordQrPayment    = response.NewErrorResponse("Failed to record QR payment", http.StatusInternalServerError)
+
+	ErrMerchantQrNotAllowed = response.NewErrorResponse("Merchant does not belong to the requesting user", http.StatusForbidden)
+	ErrInvalidQrPayload     = response.NewErrorResponse("QR payload is malformed or its CRC does not match", http.StatusBadRequest)
+	ErrQrPayloadNotAccepted = response.NewErrorResponse("QR payload does not name a merchant of this payment gateway", http.StatusBadRequest)
+	ErrQrAmountRequired     = response.NewErrorResponse("Amount is required to pay a static QR code", http.StatusBadRequest)
+	ErrQrAmountMismatch     = response.NewErrorResponse("Amount differs from the amount of the QR code", http.StatusBadRequest)
+	ErrQrTransactionInvalid = response.NewErrorResponse("QR code does not make a valid merchant transaction", http.StatusBadRequest)
+	ErrQrAlreadyPaid        = response.NewErrorResponse("QR code has already been paid", http.StatusConflict)
+	ErrQrCardNotOwned       = response.NewErrorResponse("Card does not belong to the paying user", http.StatusForbidden)
+)
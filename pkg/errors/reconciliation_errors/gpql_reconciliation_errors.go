@@ -0,0 +1,14 @@
+package reconciliation_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlBalanceDiscrepancyInvalidID      = response.NewGraphqlError("reconciliation", "Invalid Balance Discrepancy ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateFindReconciliationRuns   = response.NewGraphqlError("reconciliation", "Invalid input for reconciliation runs", int(http.StatusBadRequest))
+	ErrGraphqlValidateFindBalanceDiscrepancies = response.NewGraphqlError("reconciliation", "Invalid input for balance discrepancies", int(http.StatusBadRequest))
+	ErrGraphqlValidateAcknowledgeDiscrepancy   = response.NewGraphqlError("reconciliation", "Invalid input for acknowledge balance discrepancy", int(http.StatusBadRequest))
+)
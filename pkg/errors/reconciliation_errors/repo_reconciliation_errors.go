@@ -0,0 +1,19 @@
+package reconciliation_errors
+
+import "errors"
+
+var (
+	ErrLockReconciliationFailed        = errors.New("failed to take reconciliation lock")
+	ErrReconciliationAlreadyRunning    = errors.New("another reconciliation run is in progress")
+	ErrCreateReconciliationRunFailed   = errors.New("failed to create reconciliation run")
+	ErrFinishReconciliationRunFailed   = errors.New("failed to finish reconciliation run")
+	ErrFindReconciliationRunsFailed    = errors.New("failed to find reconciliation runs")
+	ErrFindCardReconciliationsFailed   = errors.New("failed to recompute card balances")
+	ErrUpsertBalanceDiscrepancyFailed  = errors.New("failed to record balance discrepancy")
+	ErrResolveBalanceDiscrepancyFailed = errors.New("failed to resolve balance discrepancies")
+	ErrBalanceDiscrepancyNotFound      = errors.New("balance discrepancy not found")
+	ErrFindBalanceDiscrepancyFailed    = errors.New("failed to find balance discrepancy by ID")
+	ErrFindBalanceDiscrepanciesFailed  = errors.New("failed to find balance discrepancies")
+	ErrBalanceDiscrepancyNotOpen       = errors.New("balance discrepancy is not open")
+	ErrAcknowledgeDiscrepancyFailed    = errors.New("failed to acknowledge balance discrepancy")
+)
@@ -0,0 +1,17 @@
+package reconciliation_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrReconciliationInProgress       = response.NewErrorResponse("Another reconciliation run is in progress", http.StatusConflict)
+	ErrFailedReconcileBalances        = response.NewErrorResponse("Failed to reconcile balances", http.StatusInternalServerError)
+	ErrFailedFindReconciliationRuns   = response.NewErrorResponse("Failed to fetch reconciliation runs", http.StatusInternalServerError)
+	ErrBalanceDiscrepancyNotFoundRes  = response.NewErrorResponse("Balance discrepancy not found", http.StatusNotFound)
+	ErrFailedFindBalanceDiscrepancies = response.NewErrorResponse("Failed to fetch balance discrepancies", http.StatusInternalServerError)
+	ErrBalanceDiscrepancyNotOpenRes   = response.NewErrorResponse("Only open balance discrepancies can be acknowledged", http.StatusConflict)
+	ErrFailedAcknowledgeDiscrepancy   = response.NewErrorResponse("Failed to acknowledge balance discrepancy", http.StatusInternalServerError)
+)
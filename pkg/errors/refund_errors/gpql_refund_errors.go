@@ -0,0 +1,12 @@
+package refund_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlRefundInvalidID      = response.NewGraphqlError("refund", "Invalid Refund ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateCreateRefund = response.NewGraphqlError("refund", "Invalid input for refund transaction", int(http.StatusBadRequest))
+)
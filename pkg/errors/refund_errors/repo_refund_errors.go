@@ -0,0 +1,10 @@
+package refund_errors
+
+import "errors"
+
+var (
+	ErrFindAllRefundsFailed           = errors.New("failed to find all refunds")
+	ErrFindRefundByIdFailed           = errors.New("failed to find refund by ID")
+	ErrFindRefundsByTransactionFailed = errors.New("failed to find refunds by transaction ID")
+	ErrCreateRefundFailed             = errors.New("failed to create refund")
+)
@@ -0,0 +1,18 @@
+package refund_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrFailedFindAllRefunds           = response.NewErrorResponse("Failed to fetch refunds", http.StatusInternalServerError)
+	ErrRefundNotFound                 = response.NewErrorResponse("Refund not found", http.StatusNotFound)
+	ErrFailedFindRefundsByTransaction = response.NewErrorResponse("Failed to fetch refunds by transaction", http.StatusInternalServerError)
+
+	ErrFailedCreateRefund        = response.NewErrorResponse("Failed to refund transaction", http.StatusInternalServerError)
+	ErrRefundNotAllowed          = response.NewErrorResponse("Transaction cannot be refunded by this user", http.StatusForbidden)
+	ErrRefundExceedsTransaction  = response.NewErrorResponse("Refund exceeds the remaining refundable amount or the transaction is not refundable", http.StatusBadRequest)
+	ErrInsufficientMerchantSaldo = response.NewErrorResponse("Insufficient merchant balance for refund", http.StatusBadRequest)
+)
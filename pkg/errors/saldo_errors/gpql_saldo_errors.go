@@ -15,4 +15,7 @@ var (
 
 	ErrGraphqlValidateCreateSaldo = response.NewGraphqlError("saldo", "Invalid input for create saldo", int(http.StatusBadRequest))
 	ErrGraphqlValidateUpdateSaldo = response.NewGraphqlError("saldo", "Invalid input for update saldo", int(http.StatusBadRequest))
+
+	ErrGraphqlSaldoHoldInvalidID      = response.NewGraphqlError("saldo", "Invalid Saldo Hold ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateCreateSaldoHold = response.NewGraphqlError("saldo", "Invalid input for create saldo hold", int(http.StatusBadRequest))
 )
@@ -14,10 +14,22 @@ var (
 	ErrGetMonthlySaldoBalancesFailed     = errors.New("failed to get monthly saldo balances")
 	ErrGetYearlySaldoBalancesFailed      = errors.New("failed to get yearly saldo balances")
 
-	ErrCreateSaldoFailed         = errors.New("failed to create saldo record")
-	ErrUpdateSaldoFailed         = errors.New("failed to update saldo record")
-	ErrUpdateSaldoBalanceFailed  = errors.New("failed to update saldo balance")
-	ErrUpdateSaldoWithdrawFailed = errors.New("failed to update saldo withdrawal")
+	ErrCreateSaldoFailed          = errors.New("failed to create saldo record")
+	ErrUpdateSaldoFailed          = errors.New("failed to update saldo record")
+	ErrUpdateSaldoBalanceFailed   = errors.New("failed to update saldo balance")
+	ErrUpdateSaldoWithdrawFailed  = errors.New("failed to update saldo withdrawal")
+	ErrRebuildSaldosFailed        = errors.New("failed to rebuild saldo balances from ledger")
+	ErrLockSaldoFailed            = errors.New("failed to lock saldo rows")
+	ErrReserveSaldoFailed         = errors.New("failed to reserve saldo balance")
+	ErrReleaseSaldoFailed         = errors.New("failed to release saldo reservation")
+	ErrInsufficientAvailableSaldo = errors.New("insufficient available saldo balance")
+
+	ErrCreateSaldoHoldFailed      = errors.New("failed to create saldo hold")
+	ErrFindSaldoHoldByIdFailed    = errors.New("failed to find saldo hold by ID")
+	ErrFindActiveSaldoHoldsFailed = errors.New("failed to find active saldo holds")
+	ErrReleaseSaldoHoldFailed     = errors.New("failed to release saldo hold")
+	ErrConsumeSaldoHoldFailed     = errors.New("failed to consume saldo hold")
+	ErrSaldoHoldStatusConflict    = errors.New("saldo hold is no longer active")
 
 	ErrTrashSaldoFailed           = errors.New("failed to trash saldo record")
 	ErrRestoreSaldoFailed         = errors.New("failed to restore saldo record")
@@ -19,12 +19,24 @@ var (
 	ErrFailedFindActiveSaldos      = response.NewErrorResponse("Failed to fetch active saldos", http.StatusInternalServerError)
 	ErrFailedFindTrashedSaldos     = response.NewErrorResponse("Failed to fetch trashed saldos", http.StatusInternalServerError)
 
-	ErrFailedCreateSaldo = response.NewErrorResponse("Failed to create saldo", http.StatusInternalServerError)
-	ErrFailedUpdateSaldo = response.NewErrorResponse("Failed to update saldo", http.StatusInternalServerError)
+	ErrFailedCreateSaldo     = response.NewErrorResponse("Failed to create saldo", http.StatusInternalServerError)
+	ErrFailedUpdateSaldo     = response.NewErrorResponse("Failed to update saldo", http.StatusInternalServerError)
+	ErrSaldoCardNotUpdatable = response.NewErrorResponse("Saldo cannot be moved to another card", http.StatusBadRequest)
+
+	ErrFailedRebuildSaldos = response.NewErrorResponse("Failed to rebuild saldo balances from ledger", http.StatusInternalServerError)
 
 	ErrFailedTrashSaldo              = response.NewErrorResponse("Failed to trash saldo", http.StatusInternalServerError)
 	ErrFailedRestoreSaldo            = response.NewErrorResponse("Failed to restore saldo", http.StatusInternalServerError)
 	ErrFailedDeleteSaldoPermanent    = response.NewErrorResponse("Failed to permanently delete saldo", http.StatusInternalServerError)
 	ErrFailedRestoreAllSaldo         = response.NewErrorResponse("Failed to restore all saldos", http.StatusInternalServerError)
 	ErrFailedDeleteAllSaldoPermanent = response.NewErrorResponse("Failed to permanently delete all saldos", http.StatusInternalServerError)
+
+	ErrSaldoHoldNotFound            = response.NewErrorResponse("Saldo hold not found", http.StatusNotFound)
+	ErrFailedFindSaldoHolds         = response.NewErrorResponse("Failed to fetch saldo holds", http.StatusInternalServerError)
+	ErrFailedCreateSaldoHold        = response.NewErrorResponse("Failed to place saldo hold", http.StatusInternalServerError)
+	ErrFailedReleaseSaldoHold       = response.NewErrorResponse("Failed to release saldo hold", http.StatusInternalServerError)
+	ErrFailedConsumeSaldoHold       = response.NewErrorResponse("Failed to consume saldo hold", http.StatusInternalServerError)
+	ErrSaldoHoldNotActive           = response.NewErrorResponse("Saldo hold is no longer active", http.StatusConflict)
+	ErrSaldoHoldManagedByOperation  = response.NewErrorResponse("Saldo hold belongs to a pending operation and is settled by it", http.StatusConflict)
+	ErrInsufficientAvailableBalance = response.NewErrorResponse("Insufficient available balance", http.StatusBadRequest)
 )
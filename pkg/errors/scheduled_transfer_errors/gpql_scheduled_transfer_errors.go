@@ -0,0 +1,15 @@
+package scheduled_transfer_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlScheduledTransferInvalidID      = response.NewGraphqlError("scheduled_transfer", "Invalid Scheduled Transfer ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateCreateScheduledTransfer = response.NewGraphqlError("scheduled_transfer", "Invalid input for create scheduled transfer", int(http.StatusBadRequest))
+	ErrGraphqlValidateUpdateScheduledTransfer = response.NewGraphqlError("scheduled_transfer", "Invalid input for update scheduled transfer", int(http.StatusBadRequest))
+	ErrGraphqlValidateScheduledTransferRuns   = response.NewGraphqlError("scheduled_transfer", "Invalid input for scheduled transfer runs", int(http.StatusBadRequest))
+	ErrGraphqlInvalidScheduleTime             = response.NewGraphqlError("scheduled_transfer", "Schedule times must be RFC 3339 timestamps", int(http.StatusBadRequest))
+)
@@ -0,0 +1,21 @@
+package scheduled_transfer_errors
+
+import "errors"
+
+var (
+	ErrFindAllScheduledTransfersFailed    = errors.New("failed to find all scheduled transfers")
+	ErrFindScheduledTransferByIdFailed    = errors.New("failed to find scheduled transfer by ID")
+	ErrFindDueScheduledTransfersFailed    = errors.New("failed to find due scheduled transfers")
+	ErrFindScheduledTransferRunsFailed    = errors.New("failed to find scheduled transfer runs")
+	ErrCreateScheduledTransferFailed      = errors.New("failed to create scheduled transfer")
+	ErrUpdateScheduledTransferFailed      = errors.New("failed to update scheduled transfer")
+	ErrPauseScheduledTransferFailed       = errors.New("failed to pause scheduled transfer")
+	ErrResumeScheduledTransferFailed      = errors.New("failed to resume scheduled transfer")
+	ErrCancelScheduledTransferFailed      = errors.New("failed to cancel scheduled transfer")
+	ErrFailScheduledTransferFailed        = errors.New("failed to mark scheduled transfer as failed")
+	ErrAdvanceScheduledTransferFailed     = errors.New("failed to advance scheduled transfer")
+	ErrClaimScheduledTransferRunFailed    = errors.New("failed to claim scheduled transfer run")
+	ErrFinishScheduledTransferRunFailed   = errors.New("failed to finish scheduled transfer run")
+	ErrScheduledTransferStatusConflict    = errors.New("scheduled transfer is not in a state that allows this action")
+	ErrScheduledTransferRunAlreadyClaimed = errors.New("scheduled transfer run was already claimed or is no longer due")
+)
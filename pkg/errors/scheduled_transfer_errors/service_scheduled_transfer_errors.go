@@ -0,0 +1,27 @@
+package scheduled_transfer_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrFailedFindAllScheduledTransfers = response.NewErrorResponse("Failed to fetch scheduled transfers", http.StatusInternalServerError)
+	ErrScheduledTransferNotFound       = response.NewErrorResponse("Scheduled transfer not found", http.StatusNotFound)
+	ErrFailedFindScheduledTransferRuns = response.NewErrorResponse("Failed to fetch scheduled transfer runs", http.StatusInternalServerError)
+	ErrFailedFindDueScheduledTransfers = response.NewErrorResponse("Failed to fetch due scheduled transfers", http.StatusInternalServerError)
+	ErrScheduledTransferNotAllowed     = response.NewErrorResponse("Scheduled transfer does not belong to the requesting user", http.StatusForbidden)
+	ErrScheduledTransferSameCard       = response.NewErrorResponse("Cannot schedule a transfer to the same card", http.StatusBadRequest)
+	ErrScheduledTransferHasNoRunLeft   = response.NewErrorResponse("Scheduled transfer has no run left before its end date", http.StatusUnprocessableEntity)
+	ErrScheduledTransferNotEditable    = response.NewErrorResponse("Completed or cancelled scheduled transfers cannot be changed", http.StatusConflict)
+	ErrScheduledTransferNotPausable    = response.NewErrorResponse("Only active scheduled transfers can be paused", http.StatusConflict)
+	ErrScheduledTransferNotResumable   = response.NewErrorResponse("Only paused or failed scheduled transfers can be resumed", http.StatusConflict)
+	ErrScheduledTransferNotCancellable = response.NewErrorResponse("Scheduled transfer is already completed or cancelled", http.StatusConflict)
+	ErrFailedCreateScheduledTransfer   = response.NewErrorResponse("Failed to create scheduled transfer", http.StatusInternalServerError)
+	ErrFailedUpdateScheduledTransfer   = response.NewErrorResponse("Failed to update scheduled transfer", http.StatusInternalServerError)
+	ErrFailedPauseScheduledTransfer    = response.NewErrorResponse("Failed to pause scheduled transfer", http.StatusInternalServerError)
+	ErrFailedResumeScheduledTransfer   = response.NewErrorResponse("Failed to resume scheduled transfer", http.StatusInternalServerError)
+	ErrFailedCancelScheduledTransfer   = response.NewErrorResponse("Failed to cancel scheduled transfer", http.StatusInternalServerError)
+	ErrFailedExecuteScheduledTransfers = response.NewErrorResponse("Failed to execute scheduled transfers", http.StatusInternalServerError)
+)
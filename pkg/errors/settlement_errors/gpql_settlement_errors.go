@@ -0,0 +1,13 @@
+package settlement_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlSettlementBatchInvalidID    = response.NewGraphqlError("settlement", "Invalid Settlement Batch ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateSetSettlementCard   = response.NewGraphqlError("settlement", "Invalid input for set settlement card", int(http.StatusBadRequest))
+	ErrGraphqlValidateFindSettlementBatch = response.NewGraphqlError("settlement", "Invalid input for settlement batches", int(http.StatusBadRequest))
+)
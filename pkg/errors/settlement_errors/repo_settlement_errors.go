@@ -0,0 +1,20 @@
+package settlement_errors
+
+import "errors"
+
+var (
+	ErrAddMerchantPendingBalanceFailed     = errors.New("failed to add to merchant pending balance")
+	ErrInsufficientMerchantPendingBalance  = errors.New("merchant pending balance does not cover the amount")
+	ErrDeductMerchantPendingBalanceFailed  = errors.New("failed to deduct from merchant pending balance")
+	ErrSetMerchantSettlementCardFailed     = errors.New("failed to set merchant settlement card")
+	ErrFindMerchantBalancesFailed          = errors.New("failed to find merchant balances")
+	ErrFindMerchantBalanceFailed           = errors.New("failed to find merchant balance")
+	ErrFindMerchantBalancesDueFailed       = errors.New("failed to find merchant balances due for settlement")
+	ErrCreateSettlementItemFailed          = errors.New("failed to create settlement item")
+	ErrFindUnsettledSettlementTotalsFailed = errors.New("failed to total unsettled settlement items")
+	ErrCreateSettlementBatchFailed         = errors.New("failed to create settlement batch")
+	ErrAssignSettlementItemsFailed         = errors.New("failed to assign settlement items")
+	ErrFindSettlementBatchByIdFailed       = errors.New("failed to find settlement batch by ID")
+	ErrFindSettlementBatchesFailed         = errors.New("failed to find settlement batches")
+	ErrFindSettlementItemsFailed           = errors.New("failed to find settlement items")
+)
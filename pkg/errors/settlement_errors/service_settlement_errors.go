@@ -0,0 +1,19 @@
+package settlement_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrSettlementBatchNotFound       = response.NewErrorResponse("Settlement batch not found", http.StatusNotFound)
+	ErrSettlementNotAllowed          = response.NewErrorResponse("Merchant does not belong to the requesting user", http.StatusForbidden)
+	ErrSettlementCardNotOwned        = response.NewErrorResponse("Settlement card does not belong to the merchant owner", http.StatusBadRequest)
+	ErrFailedAccrueSettlementItem    = response.NewErrorResponse("Failed to accrue settlement item", http.StatusInternalServerError)
+	ErrFailedFindMerchantBalances    = response.NewErrorResponse("Failed to fetch merchant balances", http.StatusInternalServerError)
+	ErrFailedSetSettlementCard       = response.NewErrorResponse("Failed to set settlement card", http.StatusInternalServerError)
+	ErrFailedFindSettlementBatches   = response.NewErrorResponse("Failed to fetch settlement batches", http.StatusInternalServerError)
+	ErrFailedFindSettlementItems     = response.NewErrorResponse("Failed to fetch settlement items", http.StatusInternalServerError)
+	ErrFailedFindMerchantBalancesDue = response.NewErrorResponse("Failed to fetch merchant balances due for settlement", http.StatusInternalServerError)
+)
@@ -0,0 +1,11 @@
+package status_history_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlValidateFindStatusHistory = response.NewGraphqlError("status_history", "Invalid input for status history", int(http.StatusBadRequest))
+)
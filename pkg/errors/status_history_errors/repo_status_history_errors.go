@@ -0,0 +1,7 @@
+package status_history_errors
+
+import "errors"
+
+var (
+	ErrFindStatusHistoryFailed = errors.New("failed to find status history")
+)
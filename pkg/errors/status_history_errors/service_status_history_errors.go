@@ -0,0 +1,13 @@
+package status_history_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrFailedFindStatusHistory = response.NewErrorResponse("Failed to fetch status history", http.StatusInternalServerError)
+	ErrStatusHistoryNotFound   = response.NewErrorResponse("Record not found", http.StatusNotFound)
+	ErrStatusHistoryNotAllowed = response.NewErrorResponse("Record does not belong to the requesting user", http.StatusForbidden)
+)
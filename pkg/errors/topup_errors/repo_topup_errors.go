@@ -32,6 +32,12 @@ var (
 	ErrUpdateTopupFailed       = errors.New("failed to update topup")
 	ErrUpdateTopupAmountFailed = errors.New("failed to update topup amount")
 	ErrUpdateTopupStatusFailed = errors.New("failed to update topup status")
+	ErrTopupStatusConflict     = errors.New("topup is not in a status that allows this change")
+
+	ErrCreateTopupProviderPaymentFailed       = errors.New("failed to create topup provider payment")
+	ErrFindTopupProviderPaymentFailed         = errors.New("failed to find topup provider payment")
+	ErrFindPendingTopupProviderPaymentsFailed = errors.New("failed to find pending topup provider payments")
+	ErrUpdateTopupProviderPaymentFailed       = errors.New("failed to update topup provider payment")
 
 	ErrTrashedTopupFailed            = errors.New("failed to soft-delete (trash) topup")
 	ErrRestoreTopupFailed            = errors.New("failed to restore topup")
@@ -33,8 +33,18 @@ var (
 	ErrFailedFindMonthlyTopupAmountsByCard = response.NewErrorResponse("Failed to get monthly topup amounts by card", http.StatusInternalServerError)
 	ErrFailedFindYearlyTopupAmountsByCard  = response.NewErrorResponse("Failed to get yearly topup amounts by card", http.StatusInternalServerError)
 
-	ErrFailedCreateTopup = response.NewErrorResponse("Failed to create Topup", http.StatusInternalServerError)
-	ErrFailedUpdateTopup = response.NewErrorResponse("Failed to update Topup", http.StatusInternalServerError)
+	ErrFailedCreateTopup       = response.NewErrorResponse("Failed to create Topup", http.StatusInternalServerError)
+	ErrFailedUpdateTopup       = response.NewErrorResponse("Failed to update Topup", http.StatusInternalServerError)
+	ErrTopupStatusNotUpdatable = response.NewErrorResponse("Topup has already settled and can no longer change status", http.StatusConflict)
+	ErrTopupCardNotUpdatable   = response.NewErrorResponse("Topup cannot be moved to another card", http.StatusBadRequest)
+
+	ErrTopupAwaitingPayment         = response.NewErrorResponse("Topup is still waiting for its payment", http.StatusConflict)
+	ErrTopupMethodNotSupported      = response.NewErrorResponse("No payment provider handles this topup method", http.StatusBadRequest)
+	ErrFailedInitiateTopup          = response.NewErrorResponse("Payment provider could not start the topup", http.StatusBadGateway)
+	ErrTopupProviderNotFound        = response.NewErrorResponse("Payment provider not found", http.StatusNotFound)
+	ErrInvalidTopupProviderCallback = response.NewErrorResponse("Invalid payment provider callback", http.StatusBadRequest)
+	ErrTopupProviderPaymentNotFound = response.NewErrorResponse("Topup payment not found", http.StatusNotFound)
+	ErrFailedSyncPendingTopups      = response.NewErrorResponse("Failed to check pending topups", http.StatusInternalServerError)
 
 	ErrFailedTrashTopup   = response.NewErrorResponse("Failed to trash Topup", http.StatusInternalServerError)
 	ErrFailedRestoreTopup = response.NewErrorResponse("Failed to restore Topup", http.StatusInternalServerError)
@@ -33,6 +33,13 @@ var (
 	ErrCreateTransactionFailed       = errors.New("failed to create transaction")
 	ErrUpdateTransactionFailed       = errors.New("failed to update transaction")
 	ErrUpdateTransactionStatusFailed = errors.New("failed to update transaction status")
+	ErrTransactionStatusConflict     = errors.New("transaction is not in a status that allows this change")
+	ErrApplyTransactionRefundFailed  = errors.New("failed to apply refund to transaction")
+	ErrTransactionNotRefundable      = errors.New("transaction is not refundable for the requested amount")
+	ErrMarkTransactionDisputedFailed = errors.New("failed to mark transaction as disputed")
+	ErrReleaseTransactionDispute     = errors.New("failed to release disputed transaction")
+	ErrChargeBackTransactionFailed   = errors.New("failed to charge back transaction")
+	ErrTransactionNotDisputable      = errors.New("transaction is not in a state that allows this dispute action")
 
 	ErrTrashedTransactionFailed             = errors.New("failed to soft-delete (trash) transaction")
 	ErrRestoreTransactionFailed             = errors.New("failed to restore transaction")
@@ -35,8 +35,10 @@ var (
 	ErrFailedFindByTrashedTransactions = response.NewErrorResponse("Failed to fetch trashed transactions", http.StatusInternalServerError)
 	ErrFailedFindByMerchantID          = response.NewErrorResponse("Failed to fetch transactions by merchant ID", http.StatusInternalServerError)
 
-	ErrFailedCreateTransaction = response.NewErrorResponse("Failed to create transaction", http.StatusInternalServerError)
-	ErrFailedUpdateTransaction = response.NewErrorResponse("Failed to update transaction", http.StatusInternalServerError)
+	ErrFailedCreateTransaction       = response.NewErrorResponse("Failed to create transaction", http.StatusInternalServerError)
+	ErrFailedUpdateTransaction       = response.NewErrorResponse("Failed to update transaction", http.StatusInternalServerError)
+	ErrTransactionStatusNotUpdatable = response.NewErrorResponse("Transaction has already settled and can no longer change status", http.StatusConflict)
+	ErrTransactionRefunded           = response.NewErrorResponse("Refunded or disputed transactions cannot be updated", http.StatusBadRequest)
 
 	ErrFailedTrashedTransaction         = response.NewErrorResponse("Failed to trash transaction", http.StatusInternalServerError)
 	ErrFailedRestoreTransaction         = response.NewErrorResponse("Failed to restore transaction", http.StatusInternalServerError)
@@ -0,0 +1,14 @@
+package transaction_limit_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlTransactionLimitInvalidID      = response.NewGraphqlError("transaction_limit", "Invalid Transaction Limit ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateCreateTransactionLimit = response.NewGraphqlError("transaction_limit", "Invalid input for create transaction limit", int(http.StatusBadRequest))
+	ErrGraphqlValidateUpdateTransactionLimit = response.NewGraphqlError("transaction_limit", "Invalid input for update transaction limit", int(http.StatusBadRequest))
+	ErrGraphqlValidateHeadroom               = response.NewGraphqlError("transaction_limit", "Invalid input for transaction limit headroom", int(http.StatusBadRequest))
+)
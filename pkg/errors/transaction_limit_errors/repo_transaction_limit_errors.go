@@ -0,0 +1,13 @@
+package transaction_limit_errors
+
+import "errors"
+
+var (
+	ErrFindAllTransactionLimitsFailed        = errors.New("failed to find all transaction limits")
+	ErrFindTransactionLimitByIdFailed        = errors.New("failed to find transaction limit by ID")
+	ErrFindApplicableTransactionLimitsFailed = errors.New("failed to find applicable transaction limits")
+	ErrFindCardUsageFailed                   = errors.New("failed to find card usage")
+	ErrCreateTransactionLimitFailed          = errors.New("failed to create transaction limit")
+	ErrUpdateTransactionLimitFailed          = errors.New("failed to update transaction limit")
+	ErrDeleteTransactionLimitFailed          = errors.New("failed to delete transaction limit")
+)
@@ -0,0 +1,29 @@
+package transaction_limit_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+// StatusLimitExceeded is the status of every limit breach, so clients can
+// tell them apart from other rejected operations.
+const StatusLimitExceeded = "limit_exceeded"
+
+var (
+	ErrFailedFindAllTransactionLimits = response.NewErrorResponse("Failed to fetch transaction limits", http.StatusInternalServerError)
+	ErrTransactionLimitNotFound       = response.NewErrorResponse("Transaction limit not found", http.StatusNotFound)
+	ErrFailedCheckTransactionLimits   = response.NewErrorResponse("Failed to check transaction limits", http.StatusInternalServerError)
+	ErrFailedFindHeadroom             = response.NewErrorResponse("Failed to fetch transaction limit headroom", http.StatusInternalServerError)
+	ErrHeadroomNotAllowed             = response.NewErrorResponse("Card does not belong to the requesting user", http.StatusForbidden)
+
+	ErrFailedCreateTransactionLimit = response.NewErrorResponse("Failed to create transaction limit", http.StatusInternalServerError)
+	ErrFailedUpdateTransactionLimit = response.NewErrorResponse("Failed to update transaction limit", http.StatusInternalServerError)
+	ErrFailedDeleteTransactionLimit = response.NewErrorResponse("Failed to delete transaction limit", http.StatusInternalServerError)
+
+	ErrAmountBelowMinimum = response.NewErrorResponse("Amount is below the minimum for the card currency", http.StatusBadRequest)
+
+	ErrAmountPerTransactionExceeded = &response.ErrorResponse{Status: StatusLimitExceeded, Message: "Amount exceeds the per-transaction limit", Code: http.StatusUnprocessableEntity}
+	ErrCountLimitExceeded           = &response.ErrorResponse{Status: StatusLimitExceeded, Message: "Number of operations exceeds the limit for the period", Code: http.StatusUnprocessableEntity}
+	ErrTotalAmountLimitExceeded     = &response.ErrorResponse{Status: StatusLimitExceeded, Message: "Cumulative amount exceeds the limit for the period", Code: http.StatusUnprocessableEntity}
+)
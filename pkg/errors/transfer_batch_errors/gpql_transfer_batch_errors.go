@@ -0,0 +1,13 @@
+package transfer_batch_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrGraphqlTransferBatchInvalidID      = response.NewGraphqlError("transfer_batch", "Invalid Transfer Batch ID", int(http.StatusBadRequest))
+	ErrGraphqlValidateCreateTransferBatch = response.NewGraphqlError("transfer_batch", "Invalid input for create transfer batch", int(http.StatusBadRequest))
+	ErrGraphqlValidateTransferBatchRows   = response.NewGraphqlError("transfer_batch", "Invalid input for transfer batch rows", int(http.StatusBadRequest))
+)
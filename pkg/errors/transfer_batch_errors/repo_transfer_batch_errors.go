@@ -0,0 +1,20 @@
+package transfer_batch_errors
+
+import "errors"
+
+var (
+	ErrFindAllTransferBatchesFailed       = errors.New("failed to find all transfer batches")
+	ErrFindTransferBatchByIdFailed        = errors.New("failed to find transfer batch by ID")
+	ErrFindRunnableTransferBatchesFailed  = errors.New("failed to find runnable transfer batches")
+	ErrFindTransferBatchRowsFailed        = errors.New("failed to find transfer batch rows")
+	ErrFindPendingTransferBatchRowsFailed = errors.New("failed to find pending transfer batch rows")
+	ErrCreateTransferBatchFailed          = errors.New("failed to create transfer batch")
+	ErrCreateTransferBatchRowFailed       = errors.New("failed to create transfer batch row")
+	ErrStartTransferBatchFailed           = errors.New("failed to start transfer batch")
+	ErrCompleteTransferBatchFailed        = errors.New("failed to complete transfer batch")
+	ErrAddTransferBatchResultFailed       = errors.New("failed to add transfer batch result")
+	ErrClaimTransferBatchRowFailed        = errors.New("failed to claim transfer batch row")
+	ErrFinishTransferBatchRowFailed       = errors.New("failed to finish transfer batch row")
+	ErrTransferBatchStatusConflict        = errors.New("transfer batch is not in a state that allows this action")
+	ErrTransferBatchRowAlreadyClaimed     = errors.New("transfer batch row was already claimed")
+)
@@ -0,0 +1,18 @@
+package transfer_batch_errors
+
+import (
+	"net/http"
+
+	"github.com/MamangRust/paymentgatewaygraphql/internal/domain/response"
+)
+
+var (
+	ErrFailedFindAllTransferBatches      = response.NewErrorResponse("Failed to fetch transfer batches", http.StatusInternalServerError)
+	ErrTransferBatchNotFound             = response.NewErrorResponse("Transfer batch not found", http.StatusNotFound)
+	ErrFailedFindTransferBatchRows       = response.NewErrorResponse("Failed to fetch transfer batch rows", http.StatusInternalServerError)
+	ErrFailedFindRunnableTransferBatches = response.NewErrorResponse("Failed to fetch runnable transfer batches", http.StatusInternalServerError)
+	ErrTransferBatchNotAllowed           = response.NewErrorResponse("Transfer batch does not belong to the requesting user", http.StatusForbidden)
+	ErrTransferBatchInsufficientBalance  = response.NewErrorResponse("Available balance does not cover the batch total", http.StatusUnprocessableEntity)
+	ErrFailedCreateTransferBatch         = response.NewErrorResponse("Failed to create transfer batch", http.StatusInternalServerError)
+	ErrFailedBuildTransferBatchReport    = response.NewErrorResponse("Failed to build transfer batch report", http.StatusInternalServerError)
+)
@@ -32,6 +32,7 @@ var (
 	ErrUpdateTransferFailed       = errors.New("failed to update transfer")
 	ErrUpdateTransferAmountFailed = errors.New("failed to update transfer amount")
 	ErrUpdateTransferStatusFailed = errors.New("failed to update transfer status")
+	ErrTransferStatusConflict     = errors.New("transfer is not in a status that allows this change")
 
 	ErrTrashedTransferFailed             = errors.New("failed to soft-delete (trash) transfer")
 	ErrRestoreTransferFailed             = errors.New("failed to restore transfer")
@@ -33,8 +33,12 @@ var (
 	ErrFailedFindTransfersBySender   = response.NewErrorResponse("Failed to fetch transfers by sender", http.StatusInternalServerError)
 	ErrFailedFindTransfersByReceiver = response.NewErrorResponse("Failed to fetch transfers by receiver", http.StatusInternalServerError)
 
-	ErrFailedCreateTransfer = response.NewErrorResponse("Failed to create transfer", http.StatusInternalServerError)
-	ErrFailedUpdateTransfer = response.NewErrorResponse("Failed to update transfer", http.StatusInternalServerError)
+	ErrFailedCreateTransfer       = response.NewErrorResponse("Failed to create transfer", http.StatusInternalServerError)
+	ErrFailedUpdateTransfer       = response.NewErrorResponse("Failed to update transfer", http.StatusInternalServerError)
+	ErrTransferStatusNotUpdatable = response.NewErrorResponse("Transfer has already settled and can no longer change status", http.StatusConflict)
+
+	ErrCrossCurrencyTransferLocked = response.NewErrorResponse("Transfers converted between currencies cannot be amended", http.StatusBadRequest)
+	ErrTransferCardsNotUpdatable   = response.NewErrorResponse("Transfer cannot be moved to other cards", http.StatusBadRequest)
 
 	ErrFailedTrashedTransfer             = response.NewErrorResponse("Failed to trash transfer", http.StatusInternalServerError)
 	ErrFailedRestoreTransfer             = response.NewErrorResponse("Failed to restore transfer", http.StatusInternalServerError)
@@ -0,0 +1,9 @@
+package unit_of_work_errors
+
+import "errors"
+
+var (
+	ErrBeginTransactionFailed    = errors.New("failed to begin database transaction")
+	ErrCommitTransactionFailed   = errors.New("failed to commit database transaction")
+	ErrRollbackTransactionFailed = errors.New("failed to rollback database transaction")
+)
@@ -9,4 +9,5 @@ import (
 var (
 	ErrGraphqlUserNotFound  = response.NewGraphqlError("error", "User not found", int(http.StatusNotFound))
 	ErrGraphqlUserInvalidId = response.NewGraphqlError("error", "Invalid User ID", int(http.StatusNotFound))
+	ErrGraphqlValidateTier  = response.NewGraphqlError("error", "Invalid input for update user tier", int(http.StatusBadRequest))
 )
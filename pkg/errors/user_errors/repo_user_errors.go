@@ -9,8 +9,9 @@ var (
 	ErrFindTrashedUsers = errors.New("failed to find trashed users")
 	ErrUserConflict     = errors.New("failed user already exists")
 
-	ErrCreateUser = errors.New("failed to create user")
-	ErrUpdateUser = errors.New("failed to update user")
+	ErrCreateUser     = errors.New("failed to create user")
+	ErrUpdateUser     = errors.New("failed to update user")
+	ErrUpdateUserTier = errors.New("failed to update user tier")
 
 	ErrTrashedUser         = errors.New("failed to move user to trash")
 	ErrRestoreUser         = errors.New("failed to restore user from trash")
@@ -14,8 +14,9 @@ var (
 	ErrFailedFindActive  = response.NewErrorResponse("Failed to fetch active users", http.StatusInternalServerError)
 	ErrFailedFindTrashed = response.NewErrorResponse("Failed to fetch trashed users", http.StatusInternalServerError)
 
-	ErrFailedCreateUser = response.NewErrorResponse("Failed to create user", http.StatusInternalServerError)
-	ErrFailedUpdateUser = response.NewErrorResponse("Failed to update user", http.StatusInternalServerError)
+	ErrFailedCreateUser     = response.NewErrorResponse("Failed to create user", http.StatusInternalServerError)
+	ErrFailedUpdateUser     = response.NewErrorResponse("Failed to update user", http.StatusInternalServerError)
+	ErrFailedUpdateUserTier = response.NewErrorResponse("Failed to update user tier", http.StatusInternalServerError)
 
 	ErrFailedTrashedUser     = response.NewErrorResponse("Failed to move user to trash", http.StatusInternalServerError)
 	ErrFailedRestoreUser     = response.NewErrorResponse("Failed to restore user", http.StatusInternalServerError)
@@ -16,4 +16,5 @@ var (
 
 	ErrGraphqlValidateCreateWithdrawRequest = response.NewGraphqlError("withdraw", "Invalid input for create withdraw", int(http.StatusBadRequest))
 	ErrGraphqlValidateUpdateWithdrawRequest = response.NewGraphqlError("withdraw", "Invalid input for update withdraw", int(http.StatusBadRequest))
+	ErrGraphqlValidateReviewWithdrawRequest = response.NewGraphqlError("withdraw", "Invalid input for review withdraw", int(http.StatusBadRequest))
 )
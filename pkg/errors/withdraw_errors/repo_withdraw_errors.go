@@ -8,6 +8,7 @@ var (
 	ErrFindTrashedWithdrawsFailed      = errors.New("failed to find trashed withdraws")
 	ErrFindWithdrawsByCardNumberFailed = errors.New("failed to find withdraws by card number")
 	ErrFindWithdrawByIdFailed          = errors.New("failed to find withdraw by ID")
+	ErrFindWithdrawByNoFailed          = errors.New("failed to find withdraw by withdraw number")
 
 	ErrGetMonthWithdrawStatusSuccessFailed        = errors.New("failed to get monthly withdraw status success")
 	ErrGetYearlyWithdrawStatusSuccessFailed       = errors.New("failed to get yearly withdraw status success")
@@ -27,6 +28,11 @@ var (
 	ErrCreateWithdrawFailed       = errors.New("failed to create withdraw")
 	ErrUpdateWithdrawFailed       = errors.New("failed to update withdraw")
 	ErrUpdateWithdrawStatusFailed = errors.New("failed to update withdraw status")
+	ErrWithdrawStatusConflict     = errors.New("withdraw is not in a status that allows this change")
+
+	ErrFindSettleableWithdrawsFailed = errors.New("failed to find settleable withdraws")
+	ErrCreateWithdrawReviewFailed    = errors.New("failed to create withdraw review")
+	ErrFindWithdrawReviewFailed      = errors.New("failed to find withdraw review")
 
 	ErrTrashedWithdrawFailed             = errors.New("failed to soft-delete (trash) withdraw")
 	ErrRestoreWithdrawFailed             = errors.New("failed to restore withdraw")
@@ -29,8 +29,19 @@ var (
 	ErrFailedFindActiveWithdraws  = response.NewErrorResponse("Failed to fetch active withdraws", http.StatusInternalServerError)
 	ErrFailedFindTrashedWithdraws = response.NewErrorResponse("Failed to fetch trashed withdraws", http.StatusInternalServerError)
 
-	ErrFailedCreateWithdraw = response.NewErrorResponse("Failed to create withdraw", http.StatusInternalServerError)
-	ErrFailedUpdateWithdraw = response.NewErrorResponse("Failed to update withdraw", http.StatusInternalServerError)
+	ErrFailedCreateWithdraw       = response.NewErrorResponse("Failed to create withdraw", http.StatusInternalServerError)
+	ErrFailedUpdateWithdraw       = response.NewErrorResponse("Failed to update withdraw", http.StatusInternalServerError)
+	ErrWithdrawStatusNotUpdatable = response.NewErrorResponse("Withdraw has already settled and can no longer change status", http.StatusConflict)
+	ErrWithdrawCardNotUpdatable   = response.NewErrorResponse("Withdraw cannot be moved to another card", http.StatusBadRequest)
+
+	ErrWithdrawNotReviewable  = response.NewErrorResponse("Withdraw is not waiting for review", http.StatusConflict)
+	ErrFailedReviewWithdraw   = response.NewErrorResponse("Failed to review withdraw", http.StatusInternalServerError)
+	ErrWithdrawReviewNotFound = response.NewErrorResponse("Withdraw review not found", http.StatusNotFound)
+	ErrFailedSettleWithdraws  = response.NewErrorResponse("Failed to settle approved withdraws", http.StatusInternalServerError)
+
+	ErrInvalidWithdrawPayoutCallback = response.NewErrorResponse("Invalid withdraw payout callback", http.StatusBadRequest)
+	ErrWithdrawPayoutConflict        = response.NewErrorResponse("Payout callback contradicts the withdraw status", http.StatusConflict)
+	ErrFailedApplyWithdrawPayout     = response.NewErrorResponse("Failed to apply withdraw payout", http.StatusInternalServerError)
 
 	ErrFailedTrashedWithdraw            = response.NewErrorResponse("Failed to trash withdraw", http.StatusInternalServerError)
 	ErrFailedRestoreWithdraw            = response.NewErrorResponse("Failed to restore withdraw", http.StatusInternalServerError)
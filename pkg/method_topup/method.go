@@ -2,31 +2,39 @@ package methodtopup
 
 import "strings"
 
-func PaymentMethodValidator(paymentMethod string) bool {
-	paymentRules := []string{
-		"alfamart",
-		"indomart",
-		"lawson",
-		"dana",
-		"ovo",
-		"gopay",
-		"linkaja",
-		"jenius",
-		"fastpay",
-		"kudo",
-		"bri",
-		"mandiri",
-		"bca",
-		"bni",
-		"bukopin",
-		"e-banking",
-		"visa",
-		"mastercard",
-		"discover",
-		"american express",
-		"paypal",
-	}
+var paymentRules = []string{
+	"alfamart",
+	"indomart",
+	"lawson",
+	"dana",
+	"ovo",
+	"gopay",
+	"linkaja",
+	"jenius",
+	"fastpay",
+	"kudo",
+	"bri",
+	"mandiri",
+	"bca",
+	"bni",
+	"bukopin",
+	"e-banking",
+	"visa",
+	"mastercard",
+	"discover",
+	"american express",
+	"paypal",
+}
+
+// PaymentMethods returns the names of every supported payment method.
+func PaymentMethods() []string {
+	methods := make([]string, len(paymentRules))
+	copy(methods, paymentRules)
 
+	return methods
+}
+
+func PaymentMethodValidator(paymentMethod string) bool {
 	paymentMethodLower := strings.ToLower(paymentMethod)
 	for _, rule := range paymentRules {
 		if paymentMethodLower == rule {
@@ -0,0 +1,325 @@
+package qrcode
+
+import (
+	"bytes"
+	"errors"
+	"image/png"
+	"strings"
+	"testing"
+)
+
+func TestReedSolomonRemainder(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+		ecc  []byte
+	}{
+		{
+			// ISO/IEC 18004 annex I: "01234567" as version 1-M.
+			name: "01234567 1-M",
+			data: []byte{0x10, 0x20, 0x0C, 0x56, 0x61, 0x80, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11},
+			ecc:  []byte{0xA5, 0x24, 0xD4, 0xC1, 0xED, 0x36, 0xC7, 0x87, 0x2C, 0x55},
+		},
+		{
+			name: "HELLO WORLD 1-M",
+			data: []byte{0x20, 0x5B, 0x0B, 0x78, 0xD1, 0x72, 0xDC, 0x4D, 0x43, 0x40, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11},
+			ecc:  []byte{0xC4, 0x23, 0x27, 0x77, 0xEB, 0xD7, 0xE7, 0xE2, 0x5D, 0x17},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := reedSolomonRemainder(tt.data, reedSolomonDivisor(len(tt.ecc)))
+			if !bytes.Equal(got, tt.ecc) {
+				t.Errorf("ecc = % X, want % X", got, tt.ecc)
+			}
+		})
+	}
+}
+
+func TestEncodeSegment(t *testing.T) {
+	// Mode 0100, count 00000111, "PAYMENT", terminator 0000, then pad bytes.
+	want := []byte{0x40, 0x75, 0x04, 0x15, 0x94, 0xD4, 0x54, 0xE5, 0x40, 0xEC, 0x11, 0xEC, 0x11, 0xEC, 0x11, 0xEC}
+
+	got := encodeSegment([]byte("PAYMENT"), 1, Medium)
+	if !bytes.Equal(got, want) {
+		t.Errorf("codewords = % X, want % X", got, want)
+	}
+}
+
+func TestAddEccAndInterleave(t *testing.T) {
+	// Version 5-Q has two blocks of 15 and two of 16 data codewords, each
+	// with 18 error correction codewords.
+	const version, level = 5, Quartile
+
+	data := make([]byte, numDataCodewords(version, level))
+	for i := range data {
+		data[i] = byte(i*7 + 3)
+	}
+
+	got := addEccAndInterleave(data, version, level)
+	if len(got) != numRawDataModules(version)/8 {
+		t.Fatalf("len = %d, want %d", len(got), numRawDataModules(version)/8)
+	}
+
+	blockLens := []int{15, 15, 16, 16}
+	blocks := make([][]byte, len(blockLens))
+
+	k := 0
+	for i := 0; i < 16; i++ {
+		for b, n := range blockLens {
+			if i < n {
+				blocks[b] = append(blocks[b], got[k])
+				k++
+			}
+		}
+	}
+
+	start := 0
+	for b, n := range blockLens {
+		if !bytes.Equal(blocks[b], data[start:start+n]) {
+			t.Errorf("block %d data = % X, want % X", b, blocks[b], data[start:start+n])
+		}
+		start += n
+	}
+
+	divisor := reedSolomonDivisor(18)
+	for i := 0; i < 18; i++ {
+		for b := range blocks {
+			blocks[b] = append(blocks[b], got[k])
+			k++
+		}
+	}
+
+	for b, n := range blockLens {
+		want := reedSolomonRemainder(blocks[b][:n], divisor)
+		if !bytes.Equal(blocks[b][n:], want) {
+			t.Errorf("block %d ecc = % X, want % X", b, blocks[b][n:], want)
+		}
+	}
+}
+
+func TestDrawFormatBits(t *testing.T) {
+	// Format information strings from table C.1 of the specification.
+	tests := []struct {
+		level Level
+		mask  int
+		bits  string
+	}{
+		{Low, 0, "111011111000100"},
+		{Low, 4, "110011000101111"},
+		{Medium, 0, "101010000010010"},
+		{Medium, 5, "100000011001110"},
+		{Quartile, 2, "011111100110001"},
+		{Quartile, 7, "010101111101101"},
+		{High, 1, "001001110111110"},
+		{High, 6, "000110100001100"},
+	}
+
+	for _, tt := range tests {
+		c := &Code{Version: 1, Level: tt.level, Size: 21, modules: newGrid(21), isFunction: newGrid(21)}
+		c.drawFormatBits(tt.mask)
+
+		if got := readFormatBits(c); got != tt.bits {
+			t.Errorf("level %d mask %d: format = %s, want %s", tt.level, tt.mask, got, tt.bits)
+		}
+	}
+}
+
+func TestDrawVersion(t *testing.T) {
+	// Version information from table D.1 of the specification.
+	tests := []struct {
+		version int
+		bits    int
+	}{
+		{7, 0x07C94},
+		{8, 0x085BC},
+		{10, 0x0A4D3},
+		{40, 0x28C69},
+	}
+
+	for _, tt := range tests {
+		size := tt.version*4 + 17
+		c := &Code{Version: tt.version, Size: size, modules: newGrid(size), isFunction: newGrid(size)}
+		c.drawVersion()
+
+		got, mirrored := 0, 0
+		for i := 0; i < 18; i++ {
+			if c.Dark(size-11+i%3, i/3) {
+				got |= 1 << i
+			}
+			if c.Dark(i/3, size-11+i%3) {
+				mirrored |= 1 << i
+			}
+		}
+
+		if got != tt.bits || mirrored != tt.bits {
+			t.Errorf("version %d: info = %05X and %05X, want %05X", tt.version, got, mirrored, tt.bits)
+		}
+	}
+}
+
+func TestEncodeVersionOneSymbol(t *testing.T) {
+	want := []string{
+		"111111100111101111111",
+		"100000101110001000001",
+		"101110100010001011101",
+		"101110100101001011101",
+		"101110101010101011101",
+		"100000100010101000001",
+		"111111101010101111111",
+		"000000000011100000000",
+		"101010100101000010010",
+		"010011001110001101010",
+		"000111111110100011111",
+		"110110001100001100010",
+		"011101100010101111101",
+		"000000001001010001100",
+		"111111100111011111111",
+		"100000100001110110001",
+		"101110101101011100111",
+		"101110100000001100110",
+		"101110101100100111101",
+		"100000100110001000010",
+		"111111101010101110111",
+	}
+
+	c, err := Encode([]byte("PAYMENT"), Medium)
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	if c.Version != 1 || c.Size != 21 {
+		t.Fatalf("version %d size %d, want version 1 size 21", c.Version, c.Size)
+	}
+
+	for y, row := range want {
+		var sb strings.Builder
+		for x := 0; x < c.Size; x++ {
+			if c.Dark(x, y) {
+				sb.WriteByte('1')
+			} else {
+				sb.WriteByte('0')
+			}
+		}
+
+		if sb.String() != row {
+			t.Errorf("row %2d = %s, want %s", y, sb.String(), row)
+		}
+	}
+}
+
+func TestEncodeVersionSelection(t *testing.T) {
+	// Byte mode capacities from table 7 of the specification.
+	tests := []struct {
+		level    Level
+		version  int
+		capacity int
+	}{
+		{Low, 1, 17},
+		{Medium, 1, 14},
+		{Quartile, 1, 11},
+		{High, 1, 7},
+		{Low, 9, 230},
+		{Medium, 9, 180},
+		{Quartile, 9, 130},
+		{High, 9, 98},
+		{Low, 10, 271},
+		{Medium, 10, 213},
+		{Quartile, 10, 151},
+		{High, 10, 119},
+	}
+
+	for _, tt := range tests {
+		c, err := Encode(bytes.Repeat([]byte("a"), tt.capacity), tt.level)
+		if err != nil {
+			t.Fatalf("level %d, %d bytes: %v", tt.level, tt.capacity, err)
+		}
+		if c.Version != tt.version {
+			t.Errorf("level %d, %d bytes: version %d, want %d", tt.level, tt.capacity, c.Version, tt.version)
+		}
+
+		c, err = Encode(bytes.Repeat([]byte("a"), tt.capacity+1), tt.level)
+		if err != nil {
+			t.Fatalf("level %d, %d bytes: %v", tt.level, tt.capacity+1, err)
+		}
+		if c.Version != tt.version+1 {
+			t.Errorf("level %d, %d bytes: version %d, want %d", tt.level, tt.capacity+1, c.Version, tt.version+1)
+		}
+	}
+}
+
+func TestEncodeTooLong(t *testing.T) {
+	capacities := map[Level]int{Low: 2953, Medium: 2331, Quartile: 1663, High: 1273}
+
+	for level, capacity := range capacities {
+		c, err := Encode(bytes.Repeat([]byte("a"), capacity), level)
+		if err != nil || c.Version != 40 {
+			t.Errorf("level %d, %d bytes: got %v, want version 40", level, capacity, err)
+		}
+
+		if _, err := Encode(bytes.Repeat([]byte("a"), capacity+1), level); !errors.Is(err, ErrDataTooLong) {
+			t.Errorf("level %d, %d bytes: err = %v, want %v", level, capacity+1, err, ErrDataTooLong)
+		}
+	}
+}
+
+func TestEncodeInvalidLevel(t *testing.T) {
+	if _, err := Encode([]byte("a"), Level(4)); !errors.Is(err, ErrInvalidLevel) {
+		t.Errorf("err = %v, want %v", err, ErrInvalidLevel)
+	}
+}
+
+func TestRender(t *testing.T) {
+	c, err := Encode([]byte("PAYMENT"), Medium)
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	data, err := c.PNG(4)
+	if err != nil {
+		t.Fatalf("PNG: %v", err)
+	}
+
+	img, err := png.Decode(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("decode PNG: %v", err)
+	}
+
+	if width := (21 + quietZone*2) * 4; img.Bounds().Dx() != width || img.Bounds().Dy() != width {
+		t.Errorf("PNG is %v, want %dx%d", img.Bounds(), width, width)
+	}
+
+	// The top left module of the finder pattern is dark, the quiet zone is
+	// light.
+	if r, _, _, _ := img.At(quietZone*4, quietZone*4).RGBA(); r != 0 {
+		t.Errorf("finder module is not dark")
+	}
+	if r, _, _, _ := img.At(0, 0).RGBA(); r == 0 {
+		t.Errorf("quiet zone is not light")
+	}
+
+	svg := c.SVG()
+	if !strings.Contains(svg, `viewBox="0 0 29 29"`) || !strings.Contains(svg, "M4,4h1v1h-1z") {
+		t.Errorf("SVG does not draw the symbol:\n%s", svg)
+	}
+}
+
+// readFormatBits reads the copy of the format information around the top
+// left finder pattern, most significant bit first.
+func readFormatBits(c *Code) string {
+	positions := [15][2]int{
+		{8, 0}, {8, 1}, {8, 2}, {8, 3}, {8, 4}, {8, 5}, {8, 7}, {8, 8},
+		{7, 8}, {5, 8}, {4, 8}, {3, 8}, {2, 8}, {1, 8}, {0, 8},
+	}
+
+	bits := make([]byte, 15)
+	for i, p := range positions {
+		bits[14-i] = '0'
+		if c.Dark(p[0], p[1]) {
+			bits[14-i] = '1'
+		}
+	}
+
+	return string(bits)
+}
@@ -0,0 +1,64 @@
+package qrcode
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"strings"
+)
+
+// quietZone is the light border, in modules, scanners need around a symbol.
+const quietZone = 4
+
+// PNG renders the symbol as a black and white PNG image with every module
+// scale pixels wide.
+func (c *Code) PNG(scale int) ([]byte, error) {
+	if scale < 1 {
+		scale = 1
+	}
+
+	width := (c.Size + quietZone*2) * scale
+	img := image.NewPaletted(image.Rect(0, 0, width, width), color.Palette{color.White, color.Black})
+
+	for y := 0; y < width; y++ {
+		for x := 0; x < width; x++ {
+			if c.Dark(x/scale-quietZone, y/scale-quietZone) {
+				img.SetColorIndex(x, y, 1)
+			}
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// SVG renders the symbol as an SVG document measured in modules, so it can
+// be scaled to any size without losing sharpness.
+func (c *Code) SVG() string {
+	width := c.Size + quietZone*2
+
+	var path strings.Builder
+	for y := 0; y < c.Size; y++ {
+		for x := 0; x < c.Size; x++ {
+			if c.Dark(x, y) {
+				fmt.Fprintf(&path, "M%d,%dh1v1h-1z", x+quietZone, y+quietZone)
+			}
+		}
+	}
+
+	var sb strings.Builder
+	sb.WriteString(`<?xml version="1.0" encoding="UTF-8"?>` + "\n")
+	fmt.Fprintf(&sb, `<svg xmlns="http://www.w3.org/2000/svg" version="1.1" viewBox="0 0 %d %d" shape-rendering="crispEdges">`, width, width)
+	sb.WriteString("\n")
+	sb.WriteString(`<rect width="100%" height="100%" fill="#FFFFFF"/>` + "\n")
+	fmt.Fprintf(&sb, `<path d="%s" fill="#000000"/>`, path.String())
+	sb.WriteString("\n</svg>\n")
+
+	return sb.String()
+}
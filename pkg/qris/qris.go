@@ -0,0 +1,405 @@
+// Package qris encodes and parses merchant-presented QR payloads in the EMV
+// QR Code Specification for Payment Systems format that QRIS follows.
+//
+// A payload is a string of TLV fields, each a two digit tag, a two digit
+// length and the value, ending with a CRC field over everything before its
+// value:
+//
+//	000201 010211 26310013ID.CO.EXAMPLE0210MERCHANT01 ... 6304XXXX
+//
+// A static payload (point of initiation 11) can be paid any number of times
+// and leaves the amount to the payer; a dynamic payload (12) carries the
+// amount and is meant for a single payment.
+package qris
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Tags of the root payload fields.
+const (
+	TagPayloadFormatIndicator = "00"
+	TagPointOfInitiation      = "01"
+	TagMerchantCategoryCode   = "52"
+	TagTransactionCurrency    = "53"
+	TagTransactionAmount      = "54"
+	TagCountryCode            = "58"
+	TagMerchantName           = "59"
+	TagMerchantCity           = "60"
+	TagAdditionalDataTemplate = "62"
+	TagCRC                    = "63"
+)
+
+// Tags 26 to 51 hold merchant account information templates, one per
+// network the merchant can be paid through.
+const (
+	minMerchantAccountTemplate = 26
+	maxMerchantAccountTemplate = 51
+)
+
+// Tags of the fields inside a merchant account template.
+const (
+	TagGloballyUniqueIdentifier = "00"
+	TagMerchantID               = "02"
+)
+
+// Tags of the fields inside the additional data template.
+const (
+	TagReferenceLabel = "05"
+)
+
+const (
+	PayloadFormatIndicator = "01"
+	InitiationStatic       = "11"
+	InitiationDynamic      = "12"
+)
+
+const (
+	maxValueLength  = 99
+	maxAmountLength = 13
+	crcFieldLength  = 8
+)
+
+var (
+	ErrMalformedPayload = errors.New("malformed QR payload")
+	ErrInvalidCRC       = errors.New("QR payload CRC does not match")
+	ErrMissingField     = errors.New("QR payload is missing a required field")
+	ErrInvalidValue     = errors.New("QR payload field value is invalid")
+	ErrInvalidAmount    = errors.New("QR payload amount is invalid")
+)
+
+// Field is a single TLV field. The value of a template field is itself a
+// string of TLV fields.
+type Field struct {
+	Tag   string
+	Value string
+}
+
+// MerchantAccount is a merchant account information template. The globally
+// unique identifier names the network or acquirer the merchant ID belongs
+// to.
+type MerchantAccount struct {
+	Tag                      string
+	GloballyUniqueIdentifier string
+	MerchantID               string
+}
+
+// Payload holds the fields of a merchant-presented payload this package
+// understands. Amount is the decimal amount as written in the payload and
+// empty in static payloads; Currency is the ISO 4217 numeric code.
+type Payload struct {
+	Dynamic              bool
+	MerchantAccounts     []MerchantAccount
+	MerchantCategoryCode string
+	Currency             string
+	Amount               string
+	CountryCode          string
+	MerchantName         string
+	MerchantCity         string
+	Reference            string
+}
+
+// MerchantAccount returns the merchant account template with the given
+// globally unique identifier.
+func (p *Payload) MerchantAccount(gui string) (MerchantAccount, bool) {
+	for _, account := range p.MerchantAccounts {
+		if account.GloballyUniqueIdentifier == gui {
+			return account, true
+		}
+	}
+
+	return MerchantAccount{}, false
+}
+
+// Encode renders the payload with its CRC. Dynamic payloads must carry an
+// amount.
+func Encode(p Payload) (string, error) {
+	if len(p.MerchantAccounts) == 0 || p.MerchantCategoryCode == "" || p.Currency == "" ||
+		p.CountryCode == "" || p.MerchantName == "" || p.MerchantCity == "" {
+		return "", ErrMissingField
+	}
+
+	if p.Dynamic && p.Amount == "" {
+		return "", ErrMissingField
+	}
+
+	if p.Amount != "" && !validAmount(p.Amount) {
+		return "", ErrInvalidAmount
+	}
+
+	initiation := InitiationStatic
+	if p.Dynamic {
+		initiation = InitiationDynamic
+	}
+
+	fields := []Field{
+		{Tag: TagPayloadFormatIndicator, Value: PayloadFormatIndicator},
+		{Tag: TagPointOfInitiation, Value: initiation},
+	}
+
+	for _, account := range p.MerchantAccounts {
+		tag, err := strconv.Atoi(account.Tag)
+		if err != nil || len(account.Tag) != 2 || tag < minMerchantAccountTemplate || tag > maxMerchantAccountTemplate {
+			return "", ErrInvalidValue
+		}
+
+		template, err := EncodeFields([]Field{
+			{Tag: TagGloballyUniqueIdentifier, Value: account.GloballyUniqueIdentifier},
+			{Tag: TagMerchantID, Value: account.MerchantID},
+		})
+		if err != nil {
+			return "", err
+		}
+
+		fields = append(fields, Field{Tag: account.Tag, Value: template})
+	}
+
+	fields = append(fields,
+		Field{Tag: TagMerchantCategoryCode, Value: p.MerchantCategoryCode},
+		Field{Tag: TagTransactionCurrency, Value: p.Currency},
+	)
+
+	if p.Amount != "" {
+		fields = append(fields, Field{Tag: TagTransactionAmount, Value: p.Amount})
+	}
+
+	fields = append(fields,
+		Field{Tag: TagCountryCode, Value: p.CountryCode},
+		Field{Tag: TagMerchantName, Value: p.MerchantName},
+		Field{Tag: TagMerchantCity, Value: p.MerchantCity},
+	)
+
+	if p.Reference != "" {
+		additional, err := EncodeFields([]Field{{Tag: TagReferenceLabel, Value: p.Reference}})
+		if err != nil {
+			return "", err
+		}
+
+		fields = append(fields, Field{Tag: TagAdditionalDataTemplate, Value: additional})
+	}
+
+	payload, err := EncodeFields(fields)
+	if err != nil {
+		return "", err
+	}
+
+	payload += TagCRC + "04"
+
+	return payload + fmt.Sprintf("%04X", CRC16([]byte(payload))), nil
+}
+
+// Parse checks the CRC of a payload and returns its fields. Fields this
+// package does not know are skipped.
+func Parse(s string) (*Payload, error) {
+	if len(s) < crcFieldLength || s[len(s)-crcFieldLength:len(s)-4] != TagCRC+"04" {
+		return nil, ErrMissingField
+	}
+
+	crc, err := strconv.ParseUint(s[len(s)-4:], 16, 16)
+	if err != nil {
+		return nil, ErrMalformedPayload
+	}
+
+	if uint16(crc) != CRC16([]byte(s[:len(s)-4])) {
+		return nil, ErrInvalidCRC
+	}
+
+	fields, err := ParseFields(s[:len(s)-crcFieldLength])
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fields) == 0 || fields[0].Tag != TagPayloadFormatIndicator || fields[0].Value != PayloadFormatIndicator {
+		return nil, ErrMalformedPayload
+	}
+
+	p := &Payload{}
+
+	for _, field := range fields[1:] {
+		switch field.Tag {
+		case TagPointOfInitiation:
+			switch field.Value {
+			case InitiationStatic:
+			case InitiationDynamic:
+				p.Dynamic = true
+			default:
+				return nil, ErrInvalidValue
+			}
+		case TagMerchantCategoryCode:
+			p.MerchantCategoryCode = field.Value
+		case TagTransactionCurrency:
+			p.Currency = field.Value
+		case TagTransactionAmount:
+			if !validAmount(field.Value) {
+				return nil, ErrInvalidAmount
+			}
+			p.Amount = field.Value
+		case TagCountryCode:
+			p.CountryCode = field.Value
+		case TagMerchantName:
+			p.MerchantName = field.Value
+		case TagMerchantCity:
+			p.MerchantCity = field.Value
+		case TagAdditionalDataTemplate:
+			additional, err := ParseFields(field.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			for _, sub := range additional {
+				if sub.Tag == TagReferenceLabel {
+					p.Reference = sub.Value
+				}
+			}
+		default:
+			if tag, _ := strconv.Atoi(field.Tag); tag < minMerchantAccountTemplate || tag > maxMerchantAccountTemplate {
+				continue
+			}
+
+			template, err := ParseFields(field.Value)
+			if err != nil {
+				return nil, err
+			}
+
+			account := MerchantAccount{Tag: field.Tag}
+			for _, sub := range template {
+				switch sub.Tag {
+				case TagGloballyUniqueIdentifier:
+					account.GloballyUniqueIdentifier = sub.Value
+				case TagMerchantID:
+					account.MerchantID = sub.Value
+				}
+			}
+
+			p.MerchantAccounts = append(p.MerchantAccounts, account)
+		}
+	}
+
+	if len(p.MerchantAccounts) == 0 || p.MerchantCategoryCode == "" || p.Currency == "" ||
+		p.CountryCode == "" || p.MerchantName == "" || p.MerchantCity == "" {
+		return nil, ErrMissingField
+	}
+
+	if p.Dynamic && p.Amount == "" {
+		return nil, ErrMissingField
+	}
+
+	return p, nil
+}
+
+// EncodeFields renders fields as TLV in the order given. Values must be
+// printable ASCII of at most 99 characters.
+func EncodeFields(fields []Field) (string, error) {
+	var sb strings.Builder
+
+	for _, field := range fields {
+		if !validTag(field.Tag) || len(field.Value) > maxValueLength || !printableASCII(field.Value) {
+			return "", ErrInvalidValue
+		}
+
+		fmt.Fprintf(&sb, "%s%02d%s", field.Tag, len(field.Value), field.Value)
+	}
+
+	return sb.String(), nil
+}
+
+// ParseFields splits a string of TLV fields.
+func ParseFields(s string) ([]Field, error) {
+	var fields []Field
+
+	for len(s) > 0 {
+		if len(s) < 4 || !validTag(s[:2]) {
+			return nil, ErrMalformedPayload
+		}
+
+		length, err := strconv.Atoi(s[2:4])
+		if err != nil || length < 0 || len(s)-4 < length {
+			return nil, ErrMalformedPayload
+		}
+
+		fields = append(fields, Field{Tag: s[:2], Value: s[4 : 4+length]})
+		s = s[4+length:]
+	}
+
+	return fields, nil
+}
+
+// FormatAmount writes an amount in minor units as the decimal amount of a
+// payload, e.g. 15000 with exponent 0 as "15000" and 1250 with exponent 2
+// as "12.50".
+func FormatAmount(amount, exponent int) string {
+	if exponent == 0 {
+		return strconv.Itoa(amount)
+	}
+
+	unit := 1
+	for i := 0; i < exponent; i++ {
+		unit *= 10
+	}
+
+	return fmt.Sprintf("%d.%0*d", amount/unit, exponent, amount%unit)
+}
+
+// ParseAmount reads the decimal amount of a payload into minor units of a
+// currency with the given exponent.
+func ParseAmount(s string, exponent int) (int, error) {
+	if !validAmount(s) {
+		return 0, ErrInvalidAmount
+	}
+
+	whole, fraction, _ := strings.Cut(s, ".")
+	if len(fraction) > exponent {
+		return 0, ErrInvalidAmount
+	}
+
+	fraction += strings.Repeat("0", exponent-len(fraction))
+
+	amount, err := strconv.Atoi(whole + fraction)
+	if err != nil {
+		return 0, ErrInvalidAmount
+	}
+
+	return amount, nil
+}
+
+// validAmount reports whether s is a non-empty decimal of at most 13
+// characters with at most one decimal point and digits on both sides of it.
+func validAmount(s string) bool {
+	if s == "" || len(s) > maxAmountLength {
+		return false
+	}
+
+	whole, fraction, hasPoint := strings.Cut(s, ".")
+	if whole == "" || (hasPoint && fraction == "") {
+		return false
+	}
+
+	return digits(whole) && digits(fraction)
+}
+
+func validTag(tag string) bool {
+	return len(tag) == 2 && digits(tag)
+}
+
+func digits(s string) bool {
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+func printableASCII(s string) bool {
+	for _, r := range s {
+		if r < 0x20 || r > 0x7E {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,270 @@
+package qris
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+// qrisSample is a static QRIS payload as printed by a merchant, with two
+// merchant account templates and fields this package does not read.
+const qrisSample = "00020101021126570011ID.DANA.WWW011893600915302259148102090225914810303UMI51440014ID.CO.QRIS.WWW0215ID10200176114730303UMI5204581253033605802ID5922Warung Sayur Bu Sugeng6010Kab. Demak610559567630458C7"
+
+func TestCRC16(t *testing.T) {
+	if got := CRC16([]byte("123456789")); got != 0x29B1 {
+		t.Errorf("CRC16 = %04X, want 29B1", got)
+	}
+}
+
+func TestParseSample(t *testing.T) {
+	p, err := Parse(qrisSample)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	want := &Payload{
+		Dynamic: false,
+		MerchantAccounts: []MerchantAccount{
+			{Tag: "26", GloballyUniqueIdentifier: "ID.DANA.WWW", MerchantID: "022591481"},
+			{Tag: "51", GloballyUniqueIdentifier: "ID.CO.QRIS.WWW", MerchantID: "ID1020017611473"},
+		},
+		MerchantCategoryCode: "5812",
+		Currency:             "360",
+		CountryCode:          "ID",
+		MerchantName:         "Warung Sayur Bu Sugeng",
+		MerchantCity:         "Kab. Demak",
+	}
+
+	if !reflect.DeepEqual(p, want) {
+		t.Errorf("Parse = %+v, want %+v", p, want)
+	}
+
+	if account, ok := p.MerchantAccount("ID.CO.QRIS.WWW"); !ok || account.Tag != "51" {
+		t.Errorf("MerchantAccount = %+v, %v", account, ok)
+	}
+}
+
+func TestSampleFieldsRoundTrip(t *testing.T) {
+	fields, err := ParseFields(qrisSample[:len(qrisSample)-crcFieldLength])
+	if err != nil {
+		t.Fatalf("ParseFields: %v", err)
+	}
+
+	body, err := EncodeFields(fields)
+	if err != nil {
+		t.Fatalf("EncodeFields: %v", err)
+	}
+
+	if got := withCRC(body); got != qrisSample {
+		t.Errorf("re-encoded payload = %s, want %s", got, qrisSample)
+	}
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	payloads := []Payload{
+		{
+			MerchantAccounts:     []MerchantAccount{{Tag: "26", GloballyUniqueIdentifier: "ID.CO.EXAMPLE.WWW", MerchantID: "12"}},
+			MerchantCategoryCode: "5999",
+			Currency:             "360",
+			CountryCode:          "ID",
+			MerchantName:         "Toko Maju",
+			MerchantCity:         "JAKARTA",
+		},
+		{
+			Dynamic:              true,
+			MerchantAccounts:     []MerchantAccount{{Tag: "26", GloballyUniqueIdentifier: "ID.CO.EXAMPLE.WWW", MerchantID: "12"}},
+			MerchantCategoryCode: "5999",
+			Currency:             "360",
+			Amount:               "150000",
+			CountryCode:          "ID",
+			MerchantName:         "Toko Maju",
+			MerchantCity:         "JAKARTA",
+			Reference:            "INV-0001",
+		},
+	}
+
+	for _, p := range payloads {
+		s, err := Encode(p)
+		if err != nil {
+			t.Fatalf("Encode: %v", err)
+		}
+
+		got, err := Parse(s)
+		if err != nil {
+			t.Fatalf("Parse(%s): %v", s, err)
+		}
+
+		if !reflect.DeepEqual(*got, p) {
+			t.Errorf("Parse(Encode(p)) = %+v, want %+v", *got, p)
+		}
+
+		again, err := Encode(*got)
+		if err != nil || again != s {
+			t.Errorf("Encode(Parse(s)) = %s, %v, want %s", again, err, s)
+		}
+	}
+}
+
+func TestEncodeDynamic(t *testing.T) {
+	s, err := Encode(Payload{
+		Dynamic:              true,
+		MerchantAccounts:     []MerchantAccount{{Tag: "26", GloballyUniqueIdentifier: "ID.CO.EXAMPLE", MerchantID: "12"}},
+		MerchantCategoryCode: "5999",
+		Currency:             "360",
+		Amount:               "150000",
+		CountryCode:          "ID",
+		MerchantName:         "Toko",
+		MerchantCity:         "JAKARTA",
+		Reference:            "INV-1",
+	})
+	if err != nil {
+		t.Fatalf("Encode: %v", err)
+	}
+
+	want := "00020101021226230013ID.CO.EXAMPLE02021252045999530336054061500005802ID5904Toko6007JAKARTA62090505INV-1"
+	if want = withCRC(want); s != want {
+		t.Errorf("Encode = %s, want %s", s, want)
+	}
+}
+
+func TestParseRejects(t *testing.T) {
+	sample := []byte(qrisSample)
+	sample[20] = 'X'
+
+	tests := []struct {
+		name    string
+		payload string
+		err     error
+	}{
+		{"bad CRC", string(sample), ErrInvalidCRC},
+		{"non hex CRC", qrisSample[:len(qrisSample)-4] + "58CZ", ErrMalformedPayload},
+		{"no CRC", qrisSample[:len(qrisSample)-crcFieldLength], ErrMissingField},
+		{"empty", "", ErrMissingField},
+		{"truncated TLV", withCRC("0002010102"), ErrMalformedPayload},
+		{"length past end", withCRC("000201010211269912"), ErrMalformedPayload},
+		{"non numeric tag", withCRC("0002010102115A041234"), ErrMalformedPayload},
+		{"wrong format indicator", withCRC("000202010211"), ErrMalformedPayload},
+		{"bad initiation", withCRC("000201010213" + staticBody), ErrInvalidValue},
+		{"missing merchant account", withCRC("0002010102115204599953033605802ID5904Toko6007JAKARTA"), ErrMissingField},
+		{"bad amount", withCRC("000201010211" + staticBody + "5403abc"), ErrInvalidAmount},
+		{"dynamic without amount", withCRC("000201010212" + staticBody), ErrMissingField},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Parse(tt.payload); !errors.Is(err, tt.err) {
+				t.Errorf("Parse(%s) err = %v, want %v", tt.payload, err, tt.err)
+			}
+		})
+	}
+}
+
+func TestEncodeRejects(t *testing.T) {
+	valid := Payload{
+		MerchantAccounts:     []MerchantAccount{{Tag: "26", GloballyUniqueIdentifier: "ID.CO.EXAMPLE", MerchantID: "12"}},
+		MerchantCategoryCode: "5999",
+		Currency:             "360",
+		CountryCode:          "ID",
+		MerchantName:         "Toko",
+		MerchantCity:         "JAKARTA",
+	}
+
+	tests := []struct {
+		name   string
+		modify func(p *Payload)
+		err    error
+	}{
+		{"dynamic without amount", func(p *Payload) { p.Dynamic = true }, ErrMissingField},
+		{"no merchant account", func(p *Payload) { p.MerchantAccounts = nil }, ErrMissingField},
+		{"no merchant name", func(p *Payload) { p.MerchantName = "" }, ErrMissingField},
+		{"amount with two points", func(p *Payload) { p.Amount = "1.2.3" }, ErrInvalidAmount},
+		{"amount without whole part", func(p *Payload) { p.Amount = ".5" }, ErrInvalidAmount},
+		{"amount without fraction", func(p *Payload) { p.Amount = "5." }, ErrInvalidAmount},
+		{"negative amount", func(p *Payload) { p.Amount = "-5" }, ErrInvalidAmount},
+		{"amount too long", func(p *Payload) { p.Amount = "12345678901234" }, ErrInvalidAmount},
+		{"account tag out of range", func(p *Payload) { p.MerchantAccounts[0].Tag = "52" }, ErrInvalidValue},
+		{"value too long", func(p *Payload) { p.MerchantName = fmt.Sprintf("%0100d", 0) }, ErrInvalidValue},
+		{"value not ASCII", func(p *Payload) { p.MerchantCity = "Café" }, ErrInvalidValue},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := valid
+			p.MerchantAccounts = append([]MerchantAccount(nil), valid.MerchantAccounts...)
+			tt.modify(&p)
+
+			if _, err := Encode(p); !errors.Is(err, tt.err) {
+				t.Errorf("Encode err = %v, want %v", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestAmounts(t *testing.T) {
+	tests := []struct {
+		amount   int
+		exponent int
+		text     string
+	}{
+		{15000, 0, "15000"},
+		{0, 0, "0"},
+		{1250, 2, "12.50"},
+		{5, 2, "0.05"},
+		{100, 2, "1.00"},
+		{123456789, 3, "123456.789"},
+	}
+
+	for _, tt := range tests {
+		if got := FormatAmount(tt.amount, tt.exponent); got != tt.text {
+			t.Errorf("FormatAmount(%d, %d) = %s, want %s", tt.amount, tt.exponent, got, tt.text)
+		}
+
+		got, err := ParseAmount(tt.text, tt.exponent)
+		if err != nil || got != tt.amount {
+			t.Errorf("ParseAmount(%s, %d) = %d, %v, want %d", tt.text, tt.exponent, got, err, tt.amount)
+		}
+	}
+
+	parsed := []struct {
+		text     string
+		exponent int
+		amount   int
+	}{
+		{"12.5", 2, 1250},
+		{"12", 2, 1200},
+		{"007", 0, 7},
+	}
+
+	for _, tt := range parsed {
+		if got, err := ParseAmount(tt.text, tt.exponent); err != nil || got != tt.amount {
+			t.Errorf("ParseAmount(%s, %d) = %d, %v, want %d", tt.text, tt.exponent, got, err, tt.amount)
+		}
+	}
+
+	invalid := []struct {
+		text     string
+		exponent int
+	}{
+		{"12.505", 2},
+		{"12.5", 0},
+		{"", 0},
+		{"1e5", 0},
+		{"1,000", 0},
+	}
+
+	for _, tt := range invalid {
+		if _, err := ParseAmount(tt.text, tt.exponent); !errors.Is(err, ErrInvalidAmount) {
+			t.Errorf("ParseAmount(%s, %d) err = %v, want %v", tt.text, tt.exponent, err, ErrInvalidAmount)
+		}
+	}
+}
+
+// staticBody is the rest of a minimal payload after the point of initiation.
+const staticBody = "26230013ID.CO.EXAMPLE020212520459995303360" + "5802ID5904Toko6007JAKARTA"
+
+func withCRC(body string) string {
+	body += TagCRC + "04"
+
+	return body + fmt.Sprintf("%04X", CRC16([]byte(body)))
+}
@@ -0,0 +1,156 @@
+// Package statemachine defines the statuses of money movements, merchants and
+// invoices and the transitions allowed between them. Repositories consult it
+// before writing a status, so a settled record can never be moved back to an
+// earlier or contradictory status.
+package statemachine
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	EntityTopup       = "topup"
+	EntityWithdraw    = "withdraw"
+	EntityTransfer    = "transfer"
+	EntityTransaction = "transaction"
+	EntityMerchant    = "merchant"
+	EntityInvoice     = "invoice"
+)
+
+const (
+	StatusPending           = "pending"
+	StatusSuccess           = "success"
+	StatusFailed            = "failed"
+	StatusRefunded          = "refunded"
+	StatusPartiallyRefunded = "partially_refunded"
+	StatusDisputed          = "disputed"
+	StatusChargedBack       = "charged_back"
+	StatusPendingReview     = "pending_review"
+	StatusApproved          = "approved"
+	StatusRejected          = "rejected"
+	StatusProcessing        = "processing"
+	StatusSettled           = "settled"
+	StatusInReview          = "in_review"
+	StatusActive            = "active"
+	StatusSuspended         = "suspended"
+	StatusTerminated        = "terminated"
+	StatusOpen              = "open"
+	StatusPaid              = "paid"
+	StatusExpired           = "expired"
+	StatusCancelled         = "cancelled"
+)
+
+// ErrIllegalTransition is wrapped by every error Transition returns.
+var ErrIllegalTransition = errors.New("illegal status transition")
+
+// Machine holds the allowed transitions of one entity.
+type Machine struct {
+	entity      string
+	transitions map[string][]string
+}
+
+// New builds a machine from a map of status to the statuses it may move to.
+// A status with no entry, or an empty one, is final.
+func New(entity string, transitions map[string][]string) *Machine {
+	return &Machine{
+		entity:      entity,
+		transitions: transitions,
+	}
+}
+
+var (
+	Topup = New(EntityTopup, map[string][]string{
+		StatusPending: {StatusSuccess, StatusFailed},
+	})
+
+	// Withdraw above the review threshold wait in pending_review with their
+	// funds held, and are paid out by the settlement job once approved. A
+	// payout the bank reports as failed releases the funds instead.
+	Withdraw = New(EntityWithdraw, map[string][]string{
+		StatusPending:       {StatusSuccess, StatusFailed, StatusPendingReview},
+		StatusPendingReview: {StatusApproved, StatusRejected},
+		StatusApproved:      {StatusProcessing},
+		StatusProcessing:    {StatusSettled, StatusFailed},
+	})
+
+	Transfer = New(EntityTransfer, map[string][]string{
+		StatusPending: {StatusSuccess, StatusFailed},
+	})
+
+	// Transaction also covers refunds and disputes, which are recorded on the
+	// transaction itself.
+	Transaction = New(EntityTransaction, map[string][]string{
+		StatusPending:           {StatusSuccess, StatusFailed},
+		StatusSuccess:           {StatusPartiallyRefunded, StatusRefunded, StatusDisputed},
+		StatusPartiallyRefunded: {StatusPartiallyRefunded, StatusRefunded, StatusDisputed},
+		StatusDisputed:          {StatusSuccess, StatusPartiallyRefunded, StatusChargedBack},
+	})
+
+	// Merchant is the onboarding lifecycle: a merchant submits its business
+	// details for review, and an admin activates or rejects it. A rejected
+	// merchant may submit again. Only an active merchant takes payments.
+	Merchant = New(EntityMerchant, map[string][]string{
+		StatusPending:   {StatusInReview},
+		StatusInReview:  {StatusActive, StatusRejected},
+		StatusRejected:  {StatusInReview},
+		StatusActive:    {StatusSuspended, StatusTerminated},
+		StatusSuspended: {StatusActive, StatusTerminated},
+	})
+
+	// Invoice also covers payment links. A payment link stays open while it
+	// is paid, so only invoices move to paid.
+	Invoice = New(EntityInvoice, map[string][]string{
+		StatusOpen: {StatusPaid, StatusExpired, StatusCancelled},
+	})
+)
+
+// ForEntity returns the machine of an entity, or false when it has none.
+func ForEntity(entity string) (*Machine, bool) {
+	switch entity {
+	case EntityTopup:
+		return Topup, true
+	case EntityWithdraw:
+		return Withdraw, true
+	case EntityTransfer:
+		return Transfer, true
+	case EntityTransaction:
+		return Transaction, true
+	case EntityMerchant:
+		return Merchant, true
+	case EntityInvoice:
+		return Invoice, true
+	}
+
+	return nil, false
+}
+
+func (m *Machine) Entity() string {
+	return m.entity
+}
+
+// CanTransition reports whether a record in status from may move to status to.
+func (m *Machine) CanTransition(from, to string) bool {
+	for _, next := range m.transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+
+	return false
+}
+
+// IsFinal reports whether no transition leaves status.
+func (m *Machine) IsFinal(status string) bool {
+	return len(m.transitions[status]) == 0
+}
+
+// Transition returns an error wrapping ErrIllegalTransition when a record in
+// status from may not move to status to.
+func (m *Machine) Transition(from, to string) error {
+	if !m.CanTransition(from, to) {
+		return fmt.Errorf("%w: %s cannot move from %q to %q", ErrIllegalTransition, m.entity, from, to)
+	}
+
+	return nil
+}
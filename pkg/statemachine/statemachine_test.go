@@ -0,0 +1,118 @@
+package statemachine
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestTransition(t *testing.T) {
+	tests := []struct {
+		name    string
+		machine *Machine
+		from    string
+		to      string
+		legal   bool
+	}{
+		{"topup succeeds", Topup, StatusPending, StatusSuccess, true},
+		{"topup fails", Topup, StatusPending, StatusFailed, true},
+		{"topup success is final", Topup, StatusSuccess, StatusFailed, false},
+		{"topup failure is final", Topup, StatusFailed, StatusSuccess, false},
+		{"topup stays pending", Topup, StatusPending, StatusPending, false},
+
+		{"withdraw held for review", Withdraw, StatusPending, StatusPendingReview, true},
+		{"withdraw approved", Withdraw, StatusPendingReview, StatusApproved, true},
+		{"withdraw rejected", Withdraw, StatusPendingReview, StatusRejected, true},
+		{"approved withdraw paid out", Withdraw, StatusApproved, StatusProcessing, true},
+		{"payout settled", Withdraw, StatusProcessing, StatusSettled, true},
+		{"payout failed", Withdraw, StatusProcessing, StatusFailed, true},
+		{"review skipped", Withdraw, StatusPending, StatusApproved, false},
+		{"approval skipped", Withdraw, StatusPendingReview, StatusProcessing, false},
+		{"settled withdraw reopened", Withdraw, StatusSettled, StatusProcessing, false},
+		{"rejected withdraw approved", Withdraw, StatusRejected, StatusApproved, false},
+
+		{"transfer succeeds", Transfer, StatusPending, StatusSuccess, true},
+		{"transfer refunded", Transfer, StatusSuccess, StatusRefunded, false},
+
+		{"payment succeeds", Transaction, StatusPending, StatusSuccess, true},
+		{"payment partially refunded", Transaction, StatusSuccess, StatusPartiallyRefunded, true},
+		{"partial refund repeated", Transaction, StatusPartiallyRefunded, StatusPartiallyRefunded, true},
+		{"partial refund completed", Transaction, StatusPartiallyRefunded, StatusRefunded, true},
+		{"payment disputed", Transaction, StatusSuccess, StatusDisputed, true},
+		{"dispute won", Transaction, StatusDisputed, StatusSuccess, true},
+		{"dispute lost", Transaction, StatusDisputed, StatusChargedBack, true},
+		{"pending payment refunded", Transaction, StatusPending, StatusRefunded, false},
+		{"refunded payment disputed", Transaction, StatusRefunded, StatusDisputed, false},
+		{"charge back reversed", Transaction, StatusChargedBack, StatusSuccess, false},
+		{"failed payment succeeds", Transaction, StatusFailed, StatusSuccess, false},
+
+		{"merchant submitted", Merchant, StatusPending, StatusInReview, true},
+		{"merchant activated", Merchant, StatusInReview, StatusActive, true},
+		{"rejected merchant resubmits", Merchant, StatusRejected, StatusInReview, true},
+		{"merchant suspended", Merchant, StatusActive, StatusSuspended, true},
+		{"suspension lifted", Merchant, StatusSuspended, StatusActive, true},
+		{"review skipped", Merchant, StatusPending, StatusActive, false},
+		{"terminated merchant reactivated", Merchant, StatusTerminated, StatusActive, false},
+
+		{"invoice paid", Invoice, StatusOpen, StatusPaid, true},
+		{"invoice expires", Invoice, StatusOpen, StatusExpired, true},
+		{"paid invoice cancelled", Invoice, StatusPaid, StatusCancelled, false},
+		{"expired invoice paid", Invoice, StatusExpired, StatusPaid, false},
+
+		{"unknown status", Topup, "unknown", StatusSuccess, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.machine.Entity()+"/"+tt.name, func(t *testing.T) {
+			if got := tt.machine.CanTransition(tt.from, tt.to); got != tt.legal {
+				t.Errorf("CanTransition(%s, %s) = %v, want %v", tt.from, tt.to, got, tt.legal)
+			}
+
+			err := tt.machine.Transition(tt.from, tt.to)
+			if tt.legal && err != nil {
+				t.Errorf("Transition(%s, %s) = %v, want nil", tt.from, tt.to, err)
+			}
+			if !tt.legal && !errors.Is(err, ErrIllegalTransition) {
+				t.Errorf("Transition(%s, %s) = %v, want %v", tt.from, tt.to, err, ErrIllegalTransition)
+			}
+		})
+	}
+}
+
+func TestIsFinal(t *testing.T) {
+	tests := []struct {
+		machine *Machine
+		status  string
+		want    bool
+	}{
+		{Topup, StatusPending, false},
+		{Topup, StatusSuccess, true},
+		{Withdraw, StatusApproved, false},
+		{Withdraw, StatusSettled, true},
+		{Withdraw, StatusRejected, true},
+		{Transaction, StatusDisputed, false},
+		{Transaction, StatusRefunded, true},
+		{Transaction, StatusChargedBack, true},
+		{Merchant, StatusRejected, false},
+		{Merchant, StatusTerminated, true},
+		{Invoice, StatusPaid, true},
+	}
+
+	for _, tt := range tests {
+		if got := tt.machine.IsFinal(tt.status); got != tt.want {
+			t.Errorf("%s IsFinal(%s) = %v, want %v", tt.machine.Entity(), tt.status, got, tt.want)
+		}
+	}
+}
+
+func TestForEntity(t *testing.T) {
+	for _, entity := range []string{EntityTopup, EntityWithdraw, EntityTransfer, EntityTransaction, EntityMerchant, EntityInvoice} {
+		m, ok := ForEntity(entity)
+		if !ok || m.Entity() != entity {
+			t.Errorf("ForEntity(%s) = %v, %v", entity, m, ok)
+		}
+	}
+
+	if m, ok := ForEntity("refund"); ok || m != nil {
+		t.Errorf("ForEntity(refund) = %v, %v, want nil, false", m, ok)
+	}
+}
@@ -0,0 +1,59 @@
+// Package topupprovider connects topups to the payment providers that collect
+// the money. A topup stays pending until its provider confirms the payment,
+// either when it is initiated, when its status is checked later, or through a
+// callback sent by the provider.
+package topupprovider
+
+import (
+	"context"
+	"errors"
+)
+
+// Statuses a provider reports for a payment.
+const (
+	StatusPending = "pending"
+	StatusSuccess = "success"
+	StatusFailed  = "failed"
+)
+
+var (
+	ErrNoProvider       = errors.New("no topup provider for payment method")
+	ErrUnknownReference = errors.New("unknown provider reference")
+	ErrInvalidCallback  = errors.New("invalid provider callback")
+)
+
+type InitiateRequest struct {
+	TopupNo     string
+	CardNumber  string
+	Amount      int
+	Currency    string
+	TopupMethod string
+}
+
+// Result is the state of a payment as reported by its provider.
+type Result struct {
+	Reference string
+	Status    string
+	Message   string
+}
+
+func (r *Result) IsFinal() bool {
+	return r.Status == StatusSuccess || r.Status == StatusFailed
+}
+
+// TopupProvider is implemented by every payment provider adapter.
+type TopupProvider interface {
+	// Name identifies the provider; it is stored with each payment so later
+	// status checks and callbacks reach the same adapter.
+	Name() string
+
+	// Initiate asks the provider to collect a topup. The returned reference
+	// identifies the payment in later calls.
+	Initiate(ctx context.Context, req *InitiateRequest) (*Result, error)
+
+	// CheckStatus asks the provider for the current state of a payment.
+	CheckStatus(ctx context.Context, reference string) (*Result, error)
+
+	// HandleCallback parses a notification sent by the provider.
+	HandleCallback(ctx context.Context, payload []byte) (*Result, error)
+}
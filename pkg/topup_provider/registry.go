@@ -0,0 +1,59 @@
+package topupprovider
+
+import (
+	"strings"
+	"sync"
+)
+
+// Registry maps payment methods to the provider that handles them.
+type Registry struct {
+	mu        sync.RWMutex
+	byMethod  map[string]TopupProvider
+	providers map[string]TopupProvider
+}
+
+func NewRegistry() *Registry {
+	return &Registry{
+		byMethod:  make(map[string]TopupProvider),
+		providers: make(map[string]TopupProvider),
+	}
+}
+
+// Register makes provider handle the given payment methods. A method
+// registered again is moved to the new provider.
+func (r *Registry) Register(provider TopupProvider, methods ...string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.providers[provider.Name()] = provider
+
+	for _, method := range methods {
+		r.byMethod[strings.ToLower(method)] = provider
+	}
+}
+
+// ForMethod returns the provider of a payment method, or ErrNoProvider.
+func (r *Registry) ForMethod(method string) (TopupProvider, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	provider, ok := r.byMethod[strings.ToLower(method)]
+	if !ok {
+		return nil, ErrNoProvider
+	}
+
+	return provider, nil
+}
+
+// ByName returns a registered provider by its name, or ErrNoProvider.
+func (r *Registry) ByName(name string) (TopupProvider, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	provider, ok := r.providers[name]
+	if !ok {
+		return nil, ErrNoProvider
+	}
+
+	return provider, nil
+}
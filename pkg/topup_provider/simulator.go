@@ -0,0 +1,137 @@
+package topupprovider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// SimulatorName is the name the simulator is registered under.
+const SimulatorName = "simulator"
+
+// Outcomes the simulator can be configured with.
+const (
+	SimulatorSucceed     = "succeed"
+	SimulatorFail        = "fail"
+	SimulatorConfirmLate = "late"
+)
+
+type SimulatorConfig struct {
+	// Outcome is succeed, fail or late. Late payments stay pending until
+	// ConfirmAfter has passed and are then reported as successful.
+	Outcome      string
+	ConfirmAfter time.Duration
+}
+
+type simulatorCallback struct {
+	Reference string `json:"reference"`
+	Status    string `json:"status"`
+	Message   string `json:"message"`
+}
+
+// Simulator is a provider that moves no money. It lets topups be exercised
+// locally and in test environments without a real payment provider.
+//
+// It keeps no state: the time a payment was initiated is part of its
+// reference, so late confirmations survive a restart.
+type Simulator struct {
+	config SimulatorConfig
+	now    func() time.Time
+}
+
+func NewSimulator(config SimulatorConfig) *Simulator {
+	switch config.Outcome {
+	case SimulatorSucceed, SimulatorFail, SimulatorConfirmLate:
+	default:
+		config.Outcome = SimulatorSucceed
+	}
+
+	return &Simulator{
+		config: config,
+		now:    time.Now,
+	}
+}
+
+func (s *Simulator) Name() string {
+	return SimulatorName
+}
+
+func (s *Simulator) Initiate(ctx context.Context, req *InitiateRequest) (*Result, error) {
+	reference := fmt.Sprintf("sim_%d_%s", s.now().Unix(), uuid.NewString())
+
+	return s.result(reference, s.now())
+}
+
+func (s *Simulator) CheckStatus(ctx context.Context, reference string) (*Result, error) {
+	initiatedAt, err := parseSimulatorReference(reference)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.result(reference, initiatedAt)
+}
+
+// HandleCallback accepts a JSON object with the reference and status of a
+// payment, which lets a pending payment be confirmed or failed by hand.
+func (s *Simulator) HandleCallback(ctx context.Context, payload []byte) (*Result, error) {
+	var callback simulatorCallback
+
+	if err := json.Unmarshal(payload, &callback); err != nil {
+		return nil, ErrInvalidCallback
+	}
+
+	if _, err := parseSimulatorReference(callback.Reference); err != nil {
+		return nil, err
+	}
+
+	switch callback.Status {
+	case StatusPending, StatusSuccess, StatusFailed:
+	default:
+		return nil, ErrInvalidCallback
+	}
+
+	return &Result{
+		Reference: callback.Reference,
+		Status:    callback.Status,
+		Message:   callback.Message,
+	}, nil
+}
+
+func (s *Simulator) result(reference string, initiatedAt time.Time) (*Result, error) {
+	res := &Result{Reference: reference}
+
+	switch s.config.Outcome {
+	case SimulatorFail:
+		res.Status = StatusFailed
+		res.Message = "payment declined by simulator"
+	case SimulatorConfirmLate:
+		if s.now().Sub(initiatedAt) < s.config.ConfirmAfter {
+			res.Status = StatusPending
+		} else {
+			res.Status = StatusSuccess
+		}
+	default:
+		res.Status = StatusSuccess
+	}
+
+	return res, nil
+}
+
+func parseSimulatorReference(reference string) (time.Time, error) {
+	parts := strings.SplitN(reference, "_", 3)
+	if len(parts) != 3 || parts[0] != "sim" {
+		return time.Time{}, ErrUnknownReference
+	}
+
+	seconds, err := strconv.ParseInt(parts[1], 10, 64)
+	if err != nil {
+		return time.Time{}, ErrUnknownReference
+	}
+
+	return time.Unix(seconds, 0), nil
+}
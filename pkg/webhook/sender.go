@@ -0,0 +1,132 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"strconv"
+	"syscall"
+	"time"
+)
+
+var (
+	ErrInsecureURL        = errors.New("webhook url must use https")
+	ErrAddressNotAllowed  = errors.New("webhook endpoint resolves to a private or reserved address")
+	ErrRedirectNotAllowed = errors.New("webhook endpoint answered with a redirect")
+)
+
+// blockedPrefixes are the reserved ranges not covered by the netip helpers
+// used in allowedAddr.
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),
+	netip.MustParsePrefix("100.64.0.0/10"),
+	netip.MustParsePrefix("192.0.0.0/24"),
+	netip.MustParsePrefix("192.0.2.0/24"),
+	netip.MustParsePrefix("198.18.0.0/15"),
+	netip.MustParsePrefix("198.51.100.0/24"),
+	netip.MustParsePrefix("203.0.113.0/24"),
+	netip.MustParsePrefix("240.0.0.0/4"),
+	netip.MustParsePrefix("64:ff9b::/96"),
+	netip.MustParsePrefix("100::/64"),
+	netip.MustParsePrefix("2001:db8::/32"),
+}
+
+// Sender posts signed deliveries to webhook endpoints. Endpoints are given
+// by merchants, so it only connects to public addresses over https and does
+// not follow redirects.
+type Sender struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+// NewSender returns a Sender that gives up on an endpoint after timeout.
+func NewSender(timeout time.Duration) *Sender {
+	dialer := &net.Dialer{
+		Timeout: timeout,
+		// Control runs once the host name has been resolved, so the check
+		// also covers names pointing at internal addresses.
+		Control: func(network, address string, _ syscall.RawConn) error {
+			addrPort, err := netip.ParseAddrPort(address)
+			if err != nil {
+				return err
+			}
+
+			if !allowedAddr(addrPort.Addr()) {
+				return ErrAddressNotAllowed
+			}
+
+			return nil
+		},
+	}
+
+	return &Sender{
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: timeout,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return ErrRedirectNotAllowed
+			},
+		},
+		now: time.Now,
+	}
+}
+
+// Send posts payload to url, signed with secret under the given event ID, and
+// returns the status code the endpoint answered with. The error is only set
+// when no answer was received.
+func (s *Sender) Send(ctx context.Context, endpoint, secret, id string, payload []byte) (int, error) {
+	if u, err := url.Parse(endpoint); err != nil || u.Scheme != "https" {
+		return 0, ErrInsecureURL
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(payload))
+	if err != nil {
+		return 0, err
+	}
+
+	timestamp := s.now()
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderID, id)
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp.Unix(), 10))
+	req.Header.Set(HeaderSignature, Sign(secret, id, timestamp, payload))
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer res.Body.Close()
+
+	// Drain a little of the body so the connection can be reused.
+	_, _ = io.Copy(io.Discard, io.LimitReader(res.Body, 4096))
+
+	return res.StatusCode, nil
+}
+
+// allowedAddr reports whether addr is a public unicast address.
+func allowedAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+
+	if !addr.IsGlobalUnicast() || addr.IsPrivate() || addr.IsLoopback() ||
+		addr.IsLinkLocalUnicast() || addr.IsMulticast() || addr.IsUnspecified() {
+		return false
+	}
+
+	for _, prefix := range blockedPrefixes {
+		if prefix.Contains(addr) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,72 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"testing"
+	"time"
+)
+
+func TestAllowedAddr(t *testing.T) {
+	tests := []struct {
+		addr string
+		want bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1:248:1893:25c8:1946", true},
+		{"127.0.0.1", false},
+		{"10.0.0.8", false},
+		{"172.16.4.1", false},
+		{"192.168.1.10", false},
+		{"169.254.169.254", false},
+		{"100.64.0.1", false},
+		{"0.0.0.0", false},
+		{"198.18.0.1", false},
+		{"224.0.0.1", false},
+		{"255.255.255.255", false},
+		{"::1", false},
+		{"fd00::1", false},
+		{"fe80::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"::ffff:169.254.169.254", false},
+		{"64:ff9b::7f00:1", false},
+	}
+
+	for _, tt := range tests {
+		if got := allowedAddr(netip.MustParseAddr(tt.addr)); got != tt.want {
+			t.Errorf("allowedAddr(%s) = %v, want %v", tt.addr, got, tt.want)
+		}
+	}
+}
+
+func TestSendRejectsUnsafeEndpoints(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name     string
+		endpoint string
+		want     error
+	}{
+		{"plain http", "http://example.com/hooks", ErrInsecureURL},
+		{"no scheme", "example.com/hooks", ErrInsecureURL},
+		{"loopback", server.URL, ErrAddressNotAllowed},
+		{"cloud metadata", "https://169.254.169.254/latest/meta-data", ErrAddressNotAllowed},
+	}
+
+	sender := NewSender(2 * time.Second)
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := sender.Send(context.Background(), tt.endpoint, "whsec_test", "evt_01", []byte(`{}`))
+			if !errors.Is(err, tt.want) || status != 0 {
+				t.Errorf("Send(%s) = %d, %v, want 0, %v", tt.endpoint, status, err, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+package webhook
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestVerify(t *testing.T) {
+	const (
+		secret    = "whsec_test"
+		id        = "evt_01"
+		tolerance = 5 * time.Minute
+	)
+
+	payload := []byte(`{"reference":"PAY-1","status":"success"}`)
+	sentAt := time.Unix(1737795600, 0)
+	timestamp := strconv.FormatInt(sentAt.Unix(), 10)
+	signature := Sign(secret, id, sentAt, payload)
+
+	// The signature with its first hex digit changed.
+	tampered := "f" + signature[1:]
+	if signature[0] == 'f' {
+		tampered = "0" + signature[1:]
+	}
+
+	tests := []struct {
+		name      string
+		secret    string
+		id        string
+		timestamp string
+		signature string
+		payload   []byte
+		now       time.Time
+		want      error
+	}{
+		{"valid", secret, id, timestamp, signature, payload, sentAt.Add(time.Second), nil},
+		{"upper case signature", secret, id, timestamp, strings.ToUpper(signature), payload, sentAt, nil},
+		{"at the end of the tolerance", secret, id, timestamp, signature, payload, sentAt.Add(tolerance), nil},
+		{"clock slightly behind", secret, id, timestamp, signature, payload, sentAt.Add(-tolerance), nil},
+		{"expired", secret, id, timestamp, signature, payload, sentAt.Add(tolerance + time.Second), ErrTimestampTooOld},
+		{"from the future", secret, id, timestamp, signature, payload, sentAt.Add(-tolerance - time.Second), ErrTimestampTooOld},
+		{"missing id", secret, "", timestamp, signature, payload, sentAt, ErrMissingHeaders},
+		{"missing timestamp", secret, id, "", signature, payload, sentAt, ErrMissingHeaders},
+		{"missing signature", secret, id, timestamp, "", payload, sentAt, ErrMissingHeaders},
+		{"malformed timestamp", secret, id, "2025-01-25T09:00:00Z", signature, payload, sentAt, ErrInvalidTimestamp},
+		{"tampered payload", secret, id, timestamp, signature, []byte(`{"reference":"PAY-1","status":"failed"}`), sentAt, ErrInvalidSignature},
+		{"replayed under another id", secret, "evt_02", timestamp, signature, payload, sentAt, ErrInvalidSignature},
+		{"replayed with a fresh timestamp", secret, id, strconv.FormatInt(sentAt.Unix()+60, 10), signature, payload, sentAt.Add(time.Minute), ErrInvalidSignature},
+		{"tampered signature", secret, id, timestamp, tampered, payload, sentAt, ErrInvalidSignature},
+		{"wrong secret", "whsec_other", id, timestamp, signature, payload, sentAt, ErrInvalidSignature},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Verify(tt.secret, tt.id, tt.timestamp, tt.signature, tt.payload, tolerance, tt.now)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("Verify error = %v, want %v", err, tt.want)
+			}
+			if err == nil && !got.Equal(sentAt) {
+				t.Errorf("Verify = %v, want %v", got, sentAt)
+			}
+		})
+	}
+}
+
+func TestParseSecrets(t *testing.T) {
+	tests := []struct {
+		name  string
+		value string
+		want  map[string]string
+	}{
+		{"empty", "", map[string]string{}},
+		{"one", "simulator:secret", map[string]string{"simulator": "secret"}},
+		{"several with spaces", "midtrans:abc, xendit:def", map[string]string{"midtrans": "abc", "xendit": "def"}},
+		{"colon in secret", "simulator:a:b", map[string]string{"simulator": "a:b"}},
+		{"malformed pairs skipped", "simulator,:secret,xendit:,midtrans:abc", map[string]string{"midtrans": "abc"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := ParseSecrets(tt.value); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ParseSecrets(%q) = %v, want %v", tt.value, got, tt.want)
+			}
+		})
+	}
+}